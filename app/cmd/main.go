@@ -2,25 +2,26 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 
 	_ "github.com/lib/pq"
 
 	"github.com/Prrromanssss/chat-server/internal/app"
+	"github.com/Prrromanssss/chat-server/internal/logger"
 )
 
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	log.Println("Starting auth app")
+	slog.Info("Starting chat app")
 
 	app, err := app.NewApp(ctx)
 	if err != nil {
-		log.Panicf("Cannot start app: %v", err)
+		logger.Fatal("Cannot start app", slog.String("error", err.Error()))
 	}
 
 	if err = app.Run(ctx, cancel); err != nil {
-		log.Panicf("Cannot start auth app: %v", err)
+		logger.Fatal("Cannot start chat app", slog.String("error", err.Error()))
 	}
 }
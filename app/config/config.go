@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/pkg/errors"
@@ -11,8 +12,25 @@ import (
 
 // Config holds the configuration for the application, including server and database settings.
 type Config struct {
-	GRPC     Server   `validate:"required" yaml:"grpc"`
-	Postgres Database `validate:"required" yaml:"postgres"`
+	GRPC          Server        `validate:"required" yaml:"grpc"`
+	HTTP          Server        `validate:"required" yaml:"http"`
+	CORS          CORS          `yaml:"cors"`
+	Postgres      Database      `validate:"required" yaml:"postgres"`
+	Health        Health        `yaml:"health"`
+	Tracing       Tracing       `yaml:"tracing"`
+	Logger        Logger        `yaml:"logger"`
+	Redaction     Redaction     `yaml:"redaction"`
+	AuditLog      AuditLog      `yaml:"audit_log"`
+	Admin         Admin         `yaml:"admin"`
+	Outbox        Outbox        `yaml:"outbox"`
+	Webhooks      Webhooks      `yaml:"webhooks"`
+	Bots          Bots          `yaml:"bots"`
+	Updates       Updates       `yaml:"updates"`
+	Ephemeral     Ephemeral     `yaml:"ephemeral"`
+	Presence      Presence      `yaml:"presence"`
+	Users         Users         `yaml:"users"`
+	Notifications Notifications `yaml:"notifications"`
+	Scheduler     Scheduler     `yaml:"scheduler"`
 }
 
 // Server holds the configuration for the gRPC server.
@@ -25,6 +43,11 @@ func (s *Server) Address() string {
 	return fmt.Sprintf("%s:%s", s.Host, s.Port)
 }
 
+// CORS holds the cross-origin settings for browser clients of the HTTP server.
+type CORS struct {
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
 // Database holds the configuration for the PostgreSQL database.
 type Database struct {
 	Host     string `validate:"required" yaml:"host"`
@@ -49,6 +72,229 @@ func (d *Database) DSN() string {
 	return connStr
 }
 
+// Health holds the configuration for the readiness checks of the database.
+type Health struct {
+	CheckInterval time.Duration `yaml:"check_interval" env-default:"10s"`
+	CheckTimeout  time.Duration `yaml:"check_timeout" env-default:"2s"`
+}
+
+// Tracing holds the configuration for exporting OpenTelemetry traces over OTLP.
+type Tracing struct {
+	Enabled      bool    `yaml:"enabled"`
+	ServiceName  string  `yaml:"service_name" env-default:"chat-server"`
+	OTLPEndpoint string  `yaml:"otlp_endpoint" env-default:"localhost:4317"`
+	Insecure     bool    `yaml:"insecure"`
+	SampleRatio  float64 `yaml:"sample_ratio" env-default:"1"`
+}
+
+// Logger holds the configuration for the structured application logger.
+type Logger struct {
+	Level  string `yaml:"level" env-default:"info"`
+	Format string `yaml:"format" env-default:"json"`
+}
+
+// Redaction holds the actions (keep, mask or drop) applied to sensitive data
+// before it is stored in the audit log or written to the logs.
+type Redaction struct {
+	Email string `yaml:"email" env:"REDACTION_EMAIL" env-default:"mask"`
+	Text  string `yaml:"text" env:"REDACTION_TEXT" env-default:"drop"`
+}
+
+// Modes of writing the API audit log.
+const (
+	AuditLogModeSync  = "sync"
+	AuditLogModeAsync = "async"
+)
+
+// AuditLog holds the configuration of the API audit log. In sync mode entries are written
+// before the response is sent, in async mode they are buffered and written in batches.
+type AuditLog struct {
+	Mode          string        `yaml:"mode" env:"AUDIT_LOG_MODE" env-default:"sync"`
+	BufferSize    int           `yaml:"buffer_size" env-default:"10000"`
+	BatchSize     int           `yaml:"batch_size" env-default:"500"`
+	FlushInterval time.Duration `yaml:"flush_interval" env-default:"1s"`
+	FlushTimeout  time.Duration `yaml:"flush_timeout" env-default:"5s"`
+
+	Retention AuditLogRetention `yaml:"retention"`
+}
+
+// AuditLogRetention holds the settings of the maintenance of the daily partitions of the audit log.
+// Partitions are created PremakeDays ahead. Partitions older than Period are archived to gzipped
+// JSON Lines files in ArchiveDir, if set, and dropped. A zero Period keeps the entries forever.
+type AuditLogRetention struct {
+	Period        time.Duration `yaml:"period" env:"AUDIT_LOG_RETENTION_PERIOD" env-default:"2160h"`
+	PremakeDays   int           `yaml:"premake_days" env-default:"7"`
+	CheckInterval time.Duration `yaml:"check_interval" env-default:"1h"`
+	ArchiveDir    string        `yaml:"archive_dir" env:"AUDIT_LOG_ARCHIVE_DIR"`
+}
+
+// Admin holds the bearer tokens of the administrators allowed to call admin-only RPCs, keyed by name.
+type Admin struct {
+	Tokens map[string]string `yaml:"tokens" env:"ADMIN_TOKENS"`
+}
+
+// Publishers of the chat domain events stored in the outbox.
+const (
+	OutboxPublisherNone  = "none"
+	OutboxPublisherKafka = "kafka"
+)
+
+// Outbox holds the configuration of the relay publishing the chat domain events stored in the outbox
+// to the configured publisher and to the webhooks, if enabled. With neither of them the events are stored
+// but not relayed, until one is configured.
+// Published events are deleted after PublishedRetention, a zero value keeps them forever.
+// A single instance relays the events at a time, under a lease taken over by another instance once
+// it has not been renewed for RelayLease, which must exceed the time to publish a batch.
+type Outbox struct {
+	Publisher          string        `yaml:"publisher" env:"OUTBOX_PUBLISHER" env-default:"none"`
+	BatchSize          int           `yaml:"batch_size" env-default:"100"`
+	PollInterval       time.Duration `yaml:"poll_interval" env-default:"1s"`
+	PublishedRetention time.Duration `yaml:"published_retention" env-default:"168h"`
+	RelayLease         time.Duration `yaml:"relay_lease" env-default:"1m"`
+
+	Kafka Kafka `yaml:"kafka"`
+}
+
+// Kafka holds the configuration of the Kafka producer of the chat domain events.
+type Kafka struct {
+	Brokers      []string      `yaml:"brokers" env:"KAFKA_BROKERS" env-separator:","`
+	Topic        string        `yaml:"topic" env:"KAFKA_TOPIC" env-default:"chat-events"`
+	BatchTimeout time.Duration `yaml:"batch_timeout" env-default:"10ms"`
+	WriteTimeout time.Duration `yaml:"write_timeout" env-default:"10s"`
+}
+
+// Webhooks holds the configuration of the outgoing webhooks. A failed delivery is retried with a backoff
+// doubling from InitialBackoff up to MaxBackoff, MaxAttempts times at most, and a webhook is disabled after
+// DisableAfter consecutive failed attempts. Finished deliveries are deleted after Retention.
+// The deliveries of a batch are claimed for Lease, after which another instance retries the ones whose outcome
+// was not recorded, so it must exceed the time to send a batch.
+type Webhooks struct {
+	Enabled        bool          `yaml:"enabled" env:"WEBHOOKS_ENABLED" env-default:"true"`
+	BatchSize      int           `yaml:"batch_size" env-default:"50"`
+	PollInterval   time.Duration `yaml:"poll_interval" env-default:"1s"`
+	Timeout        time.Duration `yaml:"timeout" env-default:"10s"`
+	MaxAttempts    int           `yaml:"max_attempts" env-default:"10"`
+	InitialBackoff time.Duration `yaml:"initial_backoff" env-default:"10s"`
+	MaxBackoff     time.Duration `yaml:"max_backoff" env-default:"1h"`
+	DisableAfter   int           `yaml:"disable_after" env-default:"50"`
+	Retention      time.Duration `yaml:"retention" env-default:"168h"`
+	Lease          time.Duration `yaml:"lease" env-default:"10m"`
+}
+
+// Bots holds the configuration of the slash commands handled by the bots. A bot is given CommandTimeout
+// to reply to a command POSTed to its webhook.
+type Bots struct {
+	CommandTimeout time.Duration `yaml:"command_timeout" env-default:"5s"`
+}
+
+// Updates holds the configuration of the live updates of the chats streamed to the subscribers.
+// A subscriber falling SubscriberBuffer updates behind is disconnected and has to subscribe again.
+// The listener of the updates reconnects to the database after ReconnectInterval, the updates
+// broadcast in the meantime are lost. The users blocked by a subscriber are reloaded every
+// BlocksRefreshInterval, so a block or an unblock reaches the open subscriptions within that interval.
+type Updates struct {
+	SubscriberBuffer      int           `yaml:"subscriber_buffer" env-default:"64"`
+	ReconnectInterval     time.Duration `yaml:"reconnect_interval" env-default:"1s"`
+	BlocksRefreshInterval time.Duration `yaml:"blocks_refresh_interval" env-default:"30s"`
+}
+
+// Ephemeral holds the configuration of the ephemeral events of the chats, such as the typing indicators,
+// which are broadcast but not stored. A participant sends at most one event of a type to a chat every
+// Throttle, the others are dropped. A typing indicator expires after TypingTTL.
+type Ephemeral struct {
+	Throttle  time.Duration `yaml:"throttle" env-default:"2s"`
+	TypingTTL time.Duration `yaml:"typing_ttl" env-default:"5s"`
+}
+
+// Presence holds the configuration of the presence of the users. A user is online while subscribed
+// to a chat on any instance or sending heartbeats, away if all their clients report being idle, and offline
+// once no instance has seen them for Timeout. Every Interval each instance refreshes its subscribed users
+// and recomputes the statuses, so Timeout must span several intervals.
+type Presence struct {
+	Interval time.Duration `yaml:"interval" env-default:"15s"`
+	Timeout  time.Duration `yaml:"timeout" env-default:"45s"`
+}
+
+// Notifications holds the configuration of the notifications of the offline users. The participants offline
+// when a message is sent are notified of it through the enabled channels, in digests batching the messages
+// of DigestDelay at least. A user receives at most one digest every RateLimit, with at most MaxPerDigest
+// messages, the others waiting for the next digest. A digest no channel accepted is retried at the next one,
+// MaxAttempts times at most. Finished notifications are deleted after Retention. The recipients of a batch
+// are claimed for Lease, after which another instance retries the ones whose digest was not recorded,
+// so it must exceed the time to send a batch.
+type Notifications struct {
+	Enabled      bool          `yaml:"enabled" env:"NOTIFICATIONS_ENABLED" env-default:"false"`
+	BatchSize    int           `yaml:"batch_size" env-default:"50"`
+	PollInterval time.Duration `yaml:"poll_interval" env-default:"10s"`
+	DigestDelay  time.Duration `yaml:"digest_delay" env-default:"2m"`
+	RateLimit    time.Duration `yaml:"rate_limit" env-default:"15m"`
+	MaxPerDigest int           `yaml:"max_per_digest" env-default:"20"`
+	MaxAttempts  int           `yaml:"max_attempts" env-default:"5"`
+	Retention    time.Duration `yaml:"retention" env-default:"168h"`
+	Lease        time.Duration `yaml:"lease" env-default:"10m"`
+
+	Email EmailNotifications `yaml:"email"`
+	Push  PushNotifications  `yaml:"push"`
+}
+
+// EmailNotifications holds the configuration of the channel sending the digests by email through
+// an SMTP server. The server is authenticated with PLAIN if Username is set.
+type EmailNotifications struct {
+	Enabled  bool          `yaml:"enabled" env:"NOTIFICATIONS_EMAIL_ENABLED" env-default:"false"`
+	Address  string        `yaml:"address" env:"SMTP_ADDRESS" env-default:"localhost:25"`
+	Username string        `yaml:"username" env:"SMTP_USERNAME"`
+	Password string        `yaml:"password" env:"SMTP_PASSWORD"`
+	From     string        `yaml:"from" env:"SMTP_FROM" env-default:"chat-server@localhost"`
+	Timeout  time.Duration `yaml:"timeout" env-default:"10s"`
+}
+
+// PushNotifications holds the configuration of the channel POSTing the digests to a push provider,
+// signed like the webhook deliveries with Secret.
+type PushNotifications struct {
+	Enabled bool          `yaml:"enabled" env:"NOTIFICATIONS_PUSH_ENABLED" env-default:"false"`
+	URL     string        `yaml:"url" env:"PUSH_URL"`
+	Secret  string        `yaml:"secret" env:"PUSH_SECRET"`
+	Timeout time.Duration `yaml:"timeout" env-default:"10s"`
+}
+
+// Scheduler holds the configuration of the scheduled messages, scheduled at most MaxDelay ahead. Every PollInterval
+// the due messages are sent, BatchSize at most, each in the transaction marking it sent, so that a message is sent
+// exactly once whatever the number of instances. A message failing to send is retried after RetryInterval,
+// MaxAttempts times at most. Finished messages are deleted after Retention.
+type Scheduler struct {
+	BatchSize     int           `yaml:"batch_size" env-default:"50"`
+	PollInterval  time.Duration `yaml:"poll_interval" env-default:"1s"`
+	RetryInterval time.Duration `yaml:"retry_interval" env-default:"30s"`
+	MaxAttempts   int           `yaml:"max_attempts" env-default:"5"`
+	MaxDelay      time.Duration `yaml:"max_delay" env-default:"8760h"`
+	Retention     time.Duration `yaml:"retention" env-default:"168h"`
+}
+
+// Sources of the users participating in the chats.
+const (
+	UsersSourceLocal = "local"
+	UsersSourceAuth  = "auth"
+)
+
+// Users holds the configuration of the resolution of the participants of the new chats. With the local
+// source any email is accepted and its user is created on demand. With the auth source the emails are
+// checked against the user service of the auth service and the unknown users are rejected.
+type Users struct {
+	Source string `yaml:"source" env:"USERS_SOURCE" env-default:"local"`
+
+	Auth AuthUsers `yaml:"auth"`
+}
+
+// AuthUsers holds the configuration of the client of the user service of the auth service.
+// The registered users are cached for CacheTTL and the unknown ones for NegativeCacheTTL,
+// so that a user registered in the meantime is soon accepted.
+type AuthUsers struct {
+	Address          string        `yaml:"address" env:"AUTH_ADDRESS" env-default:"localhost:50051"`
+	Timeout          time.Duration `yaml:"timeout" env-default:"2s"`
+	CacheTTL         time.Duration `yaml:"cache_ttl" env-default:"5m"`
+	NegativeCacheTTL time.Duration `yaml:"negative_cache_ttl" env-default:"30s"`
+}
+
 // LoadConfig reads and parses the configuration from a file specified by the CONFIG_PATH environment variable.
 func LoadConfig() (*Config, error) {
 	configPath := os.Getenv("CONFIG_PATH")
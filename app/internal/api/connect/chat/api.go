@@ -0,0 +1,497 @@
+package connect
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+	"github.com/Prrromanssss/chat-server/pkg/chat_v1/chat_v1connect"
+)
+
+// ConnectHandlers serves the ChatV1 service over the Connect, gRPC and gRPC-Web protocols.
+// It delegates every call to the gRPC implementation through the same interceptors
+// as the gRPC server, so both transports behave identically.
+type ConnectHandlers struct {
+	chat_v1connect.UnimplementedChatV1Handler
+	grpcHandlers      pb.ChatV1Server
+	interceptor       grpc.UnaryServerInterceptor
+	streamInterceptor grpc.StreamServerInterceptor
+}
+
+// NewConnectHandlers creates a new instance of ConnectHandlers wrapping the provided gRPC implementation
+// and the interceptors applied to every unary and streaming call.
+func NewConnectHandlers(
+	grpcHandlers pb.ChatV1Server,
+	interceptor grpc.UnaryServerInterceptor,
+	streamInterceptor grpc.StreamServerInterceptor,
+) *ConnectHandlers {
+	return &ConnectHandlers{
+		grpcHandlers:      grpcHandlers,
+		interceptor:       interceptor,
+		streamInterceptor: streamInterceptor,
+	}
+}
+
+// Create handles the Connect call to create a new chat.
+func (h *ConnectHandlers) Create(
+	ctx context.Context,
+	req *connect.Request[pb.CreateRequest],
+) (*connect.Response[pb.CreateResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.Create)
+}
+
+// Delete handles the Connect call to delete an existing chat.
+func (h *ConnectHandlers) Delete(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.Delete)
+}
+
+// SendMessage handles the Connect call to send a message to a chat.
+func (h *ConnectHandlers) SendMessage(
+	ctx context.Context,
+	req *connect.Request[pb.SendMessageRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.SendMessage)
+}
+
+// ListMessages handles the Connect call to list the history of a chat.
+func (h *ConnectHandlers) ListMessages(
+	ctx context.Context,
+	req *connect.Request[pb.ListMessagesRequest],
+) (*connect.Response[pb.ListMessagesResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ListMessages)
+}
+
+// ListChats handles the Connect call to list the chats of a participant with their settings.
+func (h *ConnectHandlers) ListChats(
+	ctx context.Context,
+	req *connect.Request[pb.ListChatsRequest],
+) (*connect.Response[pb.ListChatsResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ListChats)
+}
+
+// UpdateChatSettings handles the Connect call to update the settings of a chat for a participant.
+func (h *ConnectHandlers) UpdateChatSettings(
+	ctx context.Context,
+	req *connect.Request[pb.UpdateChatSettingsRequest],
+) (*connect.Response[pb.ChatSettings], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.UpdateChatSettings)
+}
+
+// ListMentions handles the Connect call to list the mentions of a user.
+func (h *ConnectHandlers) ListMentions(
+	ctx context.Context,
+	req *connect.Request[pb.ListMentionsRequest],
+) (*connect.Response[pb.ListMentionsResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ListMentions)
+}
+
+// PinMessage handles the Connect call to pin a message.
+func (h *ConnectHandlers) PinMessage(
+	ctx context.Context,
+	req *connect.Request[pb.PinMessageRequest],
+) (*connect.Response[pb.PinnedMessage], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.PinMessage)
+}
+
+// UnpinMessage handles the Connect call to unpin a message.
+func (h *ConnectHandlers) UnpinMessage(
+	ctx context.Context,
+	req *connect.Request[pb.UnpinMessageRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.UnpinMessage)
+}
+
+// ListPinnedMessages handles the Connect call to list the pinned messages of a chat.
+func (h *ConnectHandlers) ListPinnedMessages(
+	ctx context.Context,
+	req *connect.Request[pb.ListPinnedMessagesRequest],
+) (*connect.Response[pb.ListPinnedMessagesResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ListPinnedMessages)
+}
+
+// SetPinPolicy handles the Connect call to set the pin policy of a chat.
+func (h *ConnectHandlers) SetPinPolicy(
+	ctx context.Context,
+	req *connect.Request[pb.SetPinPolicyRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.SetPinPolicy)
+}
+
+// ScheduleMessage handles the Connect call to schedule a message.
+func (h *ConnectHandlers) ScheduleMessage(
+	ctx context.Context,
+	req *connect.Request[pb.ScheduleMessageRequest],
+) (*connect.Response[pb.ScheduleMessageResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ScheduleMessage)
+}
+
+// ListScheduledMessages handles the Connect call to list the messages scheduled by a sender.
+func (h *ConnectHandlers) ListScheduledMessages(
+	ctx context.Context,
+	req *connect.Request[pb.ListScheduledMessagesRequest],
+) (*connect.Response[pb.ListScheduledMessagesResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ListScheduledMessages)
+}
+
+// CancelScheduledMessage handles the Connect call to cancel a message scheduled by a sender.
+func (h *ConnectHandlers) CancelScheduledMessage(
+	ctx context.Context,
+	req *connect.Request[pb.CancelScheduledMessageRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.CancelScheduledMessage)
+}
+
+// ListAuditLog handles the Connect call to list the audit log of api actions.
+func (h *ConnectHandlers) ListAuditLog(
+	ctx context.Context,
+	req *connect.Request[pb.ListAuditLogRequest],
+) (*connect.Response[pb.ListAuditLogResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ListAuditLog)
+}
+
+// CreateWebhook handles the Connect call to subscribe an endpoint to chat events.
+func (h *ConnectHandlers) CreateWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.CreateWebhookRequest],
+) (*connect.Response[pb.CreateWebhookResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.CreateWebhook)
+}
+
+// ListWebhooks handles the Connect call to list the webhooks.
+func (h *ConnectHandlers) ListWebhooks(
+	ctx context.Context,
+	req *connect.Request[pb.ListWebhooksRequest],
+) (*connect.Response[pb.ListWebhooksResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ListWebhooks)
+}
+
+// DeleteWebhook handles the Connect call to delete a webhook.
+func (h *ConnectHandlers) DeleteWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteWebhookRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.DeleteWebhook)
+}
+
+// CreateIncomingWebhook handles the Connect call to create an incoming webhook of a chat.
+func (h *ConnectHandlers) CreateIncomingWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.CreateIncomingWebhookRequest],
+) (*connect.Response[pb.CreateIncomingWebhookResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.CreateIncomingWebhook)
+}
+
+// ListIncomingWebhooks handles the Connect call to list the incoming webhooks.
+func (h *ConnectHandlers) ListIncomingWebhooks(
+	ctx context.Context,
+	req *connect.Request[pb.ListIncomingWebhooksRequest],
+) (*connect.Response[pb.ListIncomingWebhooksResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ListIncomingWebhooks)
+}
+
+// DeleteIncomingWebhook handles the Connect call to revoke an incoming webhook.
+func (h *ConnectHandlers) DeleteIncomingWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteIncomingWebhookRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.DeleteIncomingWebhook)
+}
+
+// CreateBot handles the Connect call to create a bot.
+func (h *ConnectHandlers) CreateBot(
+	ctx context.Context,
+	req *connect.Request[pb.CreateBotRequest],
+) (*connect.Response[pb.CreateBotResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.CreateBot)
+}
+
+// ListBots handles the Connect call to list the bots.
+func (h *ConnectHandlers) ListBots(
+	ctx context.Context,
+	req *connect.Request[emptypb.Empty],
+) (*connect.Response[pb.ListBotsResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ListBots)
+}
+
+// DeleteBot handles the Connect call to delete a bot.
+func (h *ConnectHandlers) DeleteBot(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteBotRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.DeleteBot)
+}
+
+// CreatePoll handles the Connect call to post a poll to a chat.
+func (h *ConnectHandlers) CreatePoll(
+	ctx context.Context,
+	req *connect.Request[pb.CreatePollRequest],
+) (*connect.Response[pb.CreatePollResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.CreatePoll)
+}
+
+// Vote handles the Connect call to vote in a poll.
+func (h *ConnectHandlers) Vote(
+	ctx context.Context,
+	req *connect.Request[pb.VoteRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.Vote)
+}
+
+// ClosePoll handles the Connect call to close a poll.
+func (h *ConnectHandlers) ClosePoll(
+	ctx context.Context,
+	req *connect.Request[pb.ClosePollRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ClosePoll)
+}
+
+// GetPoll handles the Connect call to get a poll with its results.
+func (h *ConnectHandlers) GetPoll(
+	ctx context.Context,
+	req *connect.Request[pb.GetPollRequest],
+) (*connect.Response[pb.Poll], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.GetPoll)
+}
+
+// SendTypingEvent handles the Connect call to tell the subscribers of a chat that a participant is typing.
+func (h *ConnectHandlers) SendTypingEvent(
+	ctx context.Context,
+	req *connect.Request[pb.SendTypingEventRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.SendTypingEvent)
+}
+
+// Heartbeat handles the Connect call to mark a user seen, away if idle.
+func (h *ConnectHandlers) Heartbeat(
+	ctx context.Context,
+	req *connect.Request[pb.HeartbeatRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.Heartbeat)
+}
+
+// GetPresence handles the Connect call to get whether the users are online, away or offline.
+func (h *ConnectHandlers) GetPresence(
+	ctx context.Context,
+	req *connect.Request[pb.GetPresenceRequest],
+) (*connect.Response[pb.GetPresenceResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.GetPresence)
+}
+
+// GetUser handles the Connect call to get a user with their profile.
+func (h *ConnectHandlers) GetUser(
+	ctx context.Context,
+	req *connect.Request[pb.GetUserRequest],
+) (*connect.Response[pb.User], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.GetUser)
+}
+
+// UpdateProfile handles the Connect call to update the profile of a user.
+func (h *ConnectHandlers) UpdateProfile(
+	ctx context.Context,
+	req *connect.Request[pb.UpdateProfileRequest],
+) (*connect.Response[pb.User], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.UpdateProfile)
+}
+
+// SearchUsers handles the Connect call to find the users by the prefix of their email or display name.
+func (h *ConnectHandlers) SearchUsers(
+	ctx context.Context,
+	req *connect.Request[pb.SearchUsersRequest],
+) (*connect.Response[pb.SearchUsersResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.SearchUsers)
+}
+
+// BlockUser handles the Connect call to block a user.
+func (h *ConnectHandlers) BlockUser(
+	ctx context.Context,
+	req *connect.Request[pb.BlockUserRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.BlockUser)
+}
+
+// UnblockUser handles the Connect call to lift the block of a user.
+func (h *ConnectHandlers) UnblockUser(
+	ctx context.Context,
+	req *connect.Request[pb.UnblockUserRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.UnblockUser)
+}
+
+// ListBlocked handles the Connect call to list the users blocked by a user.
+func (h *ConnectHandlers) ListBlocked(
+	ctx context.Context,
+	req *connect.Request[pb.ListBlockedRequest],
+) (*connect.Response[pb.ListBlockedResponse], error) {
+	return unary(ctx, req, h.interceptor, h.grpcHandlers.ListBlocked)
+}
+
+// Subscribe handles the Connect call to stream the live updates of a chat.
+func (h *ConnectHandlers) Subscribe(
+	ctx context.Context,
+	req *connect.Request[pb.SubscribeRequest],
+	stream *connect.ServerStream[pb.ChatUpdate],
+) error {
+	return serverStream(ctx, req, stream, h.streamInterceptor,
+		func(req *pb.SubscribeRequest, ss grpc.ServerStream) error {
+			return h.grpcHandlers.Subscribe(req, &chatV1SubscribeServer{ServerStream: ss})
+		},
+	)
+}
+
+// unary calls the gRPC handler through the interceptor with the request headers exposed
+// as incoming gRPC metadata and converts the returned gRPC status into a Connect error.
+func unary[Req, Resp any](
+	ctx context.Context,
+	req *connect.Request[Req],
+	interceptor grpc.UnaryServerInterceptor,
+	handler func(context.Context, *Req) (*Resp, error),
+) (*connect.Response[Resp], error) {
+	info := &grpc.UnaryServerInfo{
+		FullMethod: req.Spec().Procedure,
+	}
+
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: peerAddr(req.Peer().Addr)})
+
+	resp, err := interceptor(
+		incomingContext(ctx, req.Header()),
+		req.Msg,
+		info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return handler(ctx, req.(*Req))
+		},
+	)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return connect.NewResponse(resp.(*Resp)), nil
+}
+
+// serverStream calls the gRPC streaming handler through the interceptor with the request headers exposed
+// as incoming gRPC metadata and converts the returned gRPC status into a Connect error.
+func serverStream[Req, Resp any](
+	ctx context.Context,
+	req *connect.Request[Req],
+	stream *connect.ServerStream[Resp],
+	interceptor grpc.StreamServerInterceptor,
+	handler func(*Req, grpc.ServerStream) error,
+) error {
+	info := &grpc.StreamServerInfo{
+		FullMethod:     req.Spec().Procedure,
+		IsServerStream: true,
+	}
+
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: peerAddr(req.Peer().Addr)})
+
+	err := interceptor(
+		nil,
+		&connectServerStream[Resp]{ctx: incomingContext(ctx, req.Header()), stream: stream},
+		info,
+		func(_ interface{}, ss grpc.ServerStream) error {
+			return handler(req.Msg, ss)
+		},
+	)
+	if err != nil {
+		return convertError(err)
+	}
+
+	return nil
+}
+
+// connectServerStream exposes a Connect server stream as a gRPC server stream.
+type connectServerStream[Resp any] struct {
+	ctx    context.Context
+	stream *connect.ServerStream[Resp]
+}
+
+// SetHeader adds the metadata to the response headers.
+func (s *connectServerStream[Resp]) SetHeader(md metadata.MD) error {
+	for key, values := range md {
+		for _, value := range values {
+			s.stream.ResponseHeader().Add(key, value)
+		}
+	}
+
+	return nil
+}
+
+// SendHeader adds the metadata to the response headers, which are sent with the first message.
+func (s *connectServerStream[Resp]) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+// SetTrailer adds the metadata to the response trailers.
+func (s *connectServerStream[Resp]) SetTrailer(md metadata.MD) {
+	for key, values := range md {
+		for _, value := range values {
+			s.stream.ResponseTrailer().Add(key, value)
+		}
+	}
+}
+
+// Context returns the context of the call.
+func (s *connectServerStream[Resp]) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg sends a message to the client.
+func (s *connectServerStream[Resp]) SendMsg(m interface{}) error {
+	return s.stream.Send(m.(*Resp))
+}
+
+// RecvMsg fails, as the request of a server stream is received before the handler is called.
+func (s *connectServerStream[Resp]) RecvMsg(_ interface{}) error {
+	return errors.New("server streams receive no messages")
+}
+
+// chatV1SubscribeServer implements pb.ChatV1_SubscribeServer on top of a gRPC server stream.
+type chatV1SubscribeServer struct {
+	grpc.ServerStream
+}
+
+// Send sends an update to the client.
+func (s *chatV1SubscribeServer) Send(m *pb.ChatUpdate) error {
+	return s.ServerStream.SendMsg(m)
+}
+
+// incomingContext copies HTTP headers into incoming gRPC metadata.
+func incomingContext(ctx context.Context, header http.Header) context.Context {
+	md := metadata.MD{}
+	for key, values := range header {
+		md.Append(strings.ToLower(key), values...)
+	}
+
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// peerAddr is the address of the client as reported by the HTTP server.
+type peerAddr string
+
+// Network returns the name of the network of the address.
+func (a peerAddr) Network() string {
+	return "tcp"
+}
+
+// String returns the address in the host:port form.
+func (a peerAddr) String() string {
+	return string(a)
+}
+
+// convertError maps a gRPC status error to the equivalent Connect error.
+func convertError(err error) error {
+	st := status.Convert(err)
+
+	return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+}
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/brianvoe/gofakeit"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	chatConnectAPI "github.com/Prrromanssss/chat-server/internal/api/connect/chat"
+	chatAPI "github.com/Prrromanssss/chat-server/internal/api/grpc/chat"
+	"github.com/Prrromanssss/chat-server/internal/interceptor"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	serviceMocks "github.com/Prrromanssss/chat-server/internal/service/mocks"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+	"github.com/Prrromanssss/chat-server/pkg/chat_v1/chat_v1connect"
+)
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	type chatServiceMockFunc func(mc *minimock.Controller) service.ChatService
+
+	var (
+		mc = minimock.NewController(t)
+
+		id     = gofakeit.Int64()
+		email1 = gofakeit.Email()
+		email2 = gofakeit.Email()
+
+		req = &pb.CreateRequest{
+			Emails: []string{email1, email2},
+		}
+
+		serviceParams = model.CreateChatParams{
+			Emails: []string{email1, email2},
+		}
+
+		serviceResp = model.CreateChatResponse{
+			ChatID: id,
+		}
+	)
+
+	protocols := map[string][]connect.ClientOption{
+		"connect":  nil,
+		"grpc-web": {connect.WithGRPCWeb()},
+	}
+
+	tests := []struct {
+		name            string
+		want            *pb.CreateResponse
+		code            connect.Code
+		chatServiceMock chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			want: &pb.CreateResponse{Id: id},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.CreateChatMock.ExpectParamsParam2(serviceParams).Return(serviceResp, nil)
+				return mock
+			},
+		},
+		{
+			name: "service status error case",
+			code: connect.CodeNotFound,
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.CreateChatMock.ExpectParamsParam2(serviceParams).Return(model.CreateChatResponse{}, status.Error(codes.NotFound, "not found"))
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		for protocol, opts := range protocols {
+			t.Run(tt.name+" over "+protocol, func(t *testing.T) {
+				t.Parallel()
+
+				chatServiceMock := tt.chatServiceMock(mc)
+				api := chatConnectAPI.NewConnectHandlers(
+					chatAPI.NewGRPCHandlers(chatServiceMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil),
+					interceptor.ChainUnary(),
+					interceptor.ChainStream(),
+				)
+
+				mux := http.NewServeMux()
+				mux.Handle(chat_v1connect.NewChatV1Handler(api))
+
+				server := httptest.NewServer(mux)
+				defer server.Close()
+
+				client := chat_v1connect.NewChatV1Client(server.Client(), server.URL, opts...)
+
+				resp, err := client.Create(context.Background(), connect.NewRequest(req))
+				if tt.want == nil {
+					require.Equal(t, tt.code, connect.CodeOf(err))
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, tt.want.GetId(), resp.Msg.GetId())
+			})
+		}
+	}
+}
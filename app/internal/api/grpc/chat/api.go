@@ -2,37 +2,86 @@ package grpc
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"time"
 
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/Prrromanssss/chat-server/internal/converter"
+	"github.com/Prrromanssss/chat-server/internal/interceptor"
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
 	"github.com/Prrromanssss/chat-server/internal/service"
 	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
 )
 
 // GRPCHandlers implements the gRPC server for chat operations.
-// It uses a ChatRepository to interact with chat data.
+// It uses a ChatService to interact with chat data, an AuditService to query the audit log,
+// a WebhookService to manage the webhooks, a BotService to manage the bots, a PollService to manage
+// the polls, a SubscriptionService to stream the live updates of the chats, an EphemeralService
+// to broadcast the typing indicators, a PresenceService to track the presence of the users,
+// a UserService to manage their profiles, a BlockService to manage the blocked users, a PinService
+// to manage the pinned messages and a ScheduleService to schedule messages.
 type GRPCHandlers struct {
 	pb.UnimplementedChatV1Server
-	chatService service.ChatService
+	chatService         service.ChatService
+	auditService        service.AuditService
+	webhookService      service.WebhookService
+	botService          service.BotService
+	pollService         service.PollService
+	subscriptionService service.SubscriptionService
+	ephemeralService    service.EphemeralService
+	presenceService     service.PresenceService
+	userService         service.UserService
+	blockService        service.BlockService
+	pinService          service.PinService
+	scheduleService     service.ScheduleService
 }
 
-// NewGRPCHandlers creates a new instance of GRPCHandlers with the provided ChatRepository.
-func NewGRPCHandlers(chatService service.ChatService) *GRPCHandlers {
+// NewGRPCHandlers creates a new instance of GRPCHandlers with the provided services.
+func NewGRPCHandlers(
+	chatService service.ChatService,
+	auditService service.AuditService,
+	webhookService service.WebhookService,
+	botService service.BotService,
+	pollService service.PollService,
+	subscriptionService service.SubscriptionService,
+	ephemeralService service.EphemeralService,
+	presenceService service.PresenceService,
+	userService service.UserService,
+	blockService service.BlockService,
+	pinService service.PinService,
+	scheduleService service.ScheduleService,
+) *GRPCHandlers {
 	return &GRPCHandlers{
-		chatService: chatService,
+		chatService:         chatService,
+		auditService:        auditService,
+		webhookService:      webhookService,
+		botService:          botService,
+		pollService:         pollService,
+		subscriptionService: subscriptionService,
+		ephemeralService:    ephemeralService,
+		presenceService:     presenceService,
+		userService:         userService,
+		blockService:        blockService,
+		pinService:          pinService,
+		scheduleService:     scheduleService,
 	}
 }
 
 // Create handles the RPC call to create a new chat.
 // It takes a CreateRequest, creates a chat, and returns a CreateResponse with the new chat ID.
 func (h *GRPCHandlers) Create(ctx context.Context, req *pb.CreateRequest) (*pb.CreateResponse, error) {
-	log.Printf("rpc Create, request: %+v", req)
+	params := converter.ConvertCreateRequestFromHandlerToService(req)
 
-	resp, err := h.chatService.CreateChat(ctx, converter.ConvertCreateRequestFromHandlerToService(req))
+	logger.FromContext(ctx).Debug("rpc Create", slog.Any("params", params))
+
+	resp, err := h.chatService.CreateChat(ctx, params)
 	if err != nil {
-		return nil, err
+		return nil, convertError(err)
 	}
 
 	return converter.ConvertCreateChatResponseFromServiceToHandler(resp), nil
@@ -41,11 +90,13 @@ func (h *GRPCHandlers) Create(ctx context.Context, req *pb.CreateRequest) (*pb.C
 // Delete handles the RPC call to delete an existing chat.
 // It takes a DeleteRequest, deletes the chat, and returns an empty response.
 func (h *GRPCHandlers) Delete(ctx context.Context, req *pb.DeleteRequest) (*emptypb.Empty, error) {
-	log.Printf("rpc Delete, request: %+v", req)
+	params := converter.ConvertDeleteRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc Delete", slog.Any("params", params))
 
-	err := h.chatService.DeleteChat(ctx, converter.ConvertDeleteRequestFromHandlerToService(req))
+	err := h.chatService.DeleteChat(ctx, params)
 	if err != nil {
-		return nil, err
+		return nil, convertError(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -54,12 +105,772 @@ func (h *GRPCHandlers) Delete(ctx context.Context, req *pb.DeleteRequest) (*empt
 // SendMessage handles the RPC call to send a message to a chat.
 // It takes a SendMessageRequest, sends the message, and returns an empty response.
 func (h *GRPCHandlers) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*emptypb.Empty, error) {
-	log.Printf("rpc SendMessage, request: %+v", req)
+	params := converter.ConvertSendMessageRequestFromHandlerToService(req)
+
+	// Bots always send as themselves.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc SendMessage", slog.Any("params", params))
+
+	err := h.chatService.SendMessage(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListMessages handles the RPC call to list the history of a chat.
+func (h *GRPCHandlers) ListMessages(ctx context.Context, req *pb.ListMessagesRequest) (*pb.ListMessagesResponse, error) {
+	params, err := converter.ConvertListMessagesRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots always read as themselves.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc ListMessages", slog.Any("params", params))
+
+	messages, err := h.chatService.ListMessages(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertMessagesFromServiceToHandler(messages), nil
+}
+
+// ListChats handles the RPC call to list the chats of a participant with their settings.
+func (h *GRPCHandlers) ListChats(ctx context.Context, req *pb.ListChatsRequest) (*pb.ListChatsResponse, error) {
+	params := converter.ConvertListChatsRequestFromHandlerToService(req)
+
+	// Bots always list their own chats.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc ListChats", slog.Any("params", params))
+
+	chats, err := h.chatService.ListChats(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertUserChatsFromServiceToHandler(chats), nil
+}
+
+// UpdateChatSettings handles the RPC call to update the settings of a chat for a participant.
+func (h *GRPCHandlers) UpdateChatSettings(
+	ctx context.Context,
+	req *pb.UpdateChatSettingsRequest,
+) (*pb.ChatSettings, error) {
+	params, err := converter.ConvertUpdateChatSettingsRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots only update their own settings.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc UpdateChatSettings", slog.Any("params", params))
+
+	settings, err := h.chatService.UpdateChatSettings(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertChatSettingsFromServiceToHandler(settings), nil
+}
+
+// ListMentions handles the RPC call to list the mentions of a user.
+func (h *GRPCHandlers) ListMentions(ctx context.Context, req *pb.ListMentionsRequest) (*pb.ListMentionsResponse, error) {
+	params, err := converter.ConvertListMentionsRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots always list their own mentions.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.Email = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc ListMentions", slog.Any("params", params))
+
+	mentions, err := h.chatService.ListMentions(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertMentionsFromServiceToHandler(mentions), nil
+}
+
+// PinMessage handles the RPC call to pin a message. Administrators may pin the messages of any chat.
+func (h *GRPCHandlers) PinMessage(ctx context.Context, req *pb.PinMessageRequest) (*pb.PinnedMessage, error) {
+	params, err := converter.ConvertPinMessageRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if name, ok := interceptor.AdminFromContext(ctx); ok {
+		params.Admin = true
+		if params.From == "" {
+			params.From = name
+		}
+	} else if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc PinMessage", slog.Any("params", params))
+
+	pinned, err := h.pinService.PinMessage(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertPinnedMessageFromServiceToHandler(pinned), nil
+}
+
+// UnpinMessage handles the RPC call to unpin a message. Administrators may unpin the messages of any chat.
+func (h *GRPCHandlers) UnpinMessage(ctx context.Context, req *pb.UnpinMessageRequest) (*emptypb.Empty, error) {
+	params, err := converter.ConvertUnpinMessageRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if name, ok := interceptor.AdminFromContext(ctx); ok {
+		params.Admin = true
+		if params.From == "" {
+			params.From = name
+		}
+	} else if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc UnpinMessage", slog.Any("params", params))
+
+	err = h.pinService.UnpinMessage(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListPinnedMessages handles the RPC call to list the pinned messages of a chat.
+func (h *GRPCHandlers) ListPinnedMessages(
+	ctx context.Context,
+	req *pb.ListPinnedMessagesRequest,
+) (*pb.ListPinnedMessagesResponse, error) {
+	params := converter.ConvertListPinnedMessagesRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc ListPinnedMessages", slog.Any("params", params))
+
+	pinned, err := h.pinService.ListPinnedMessages(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertPinnedMessagesFromServiceToHandler(pinned), nil
+}
+
+// SetPinPolicy handles the RPC call to set the pin policy of a chat.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) SetPinPolicy(ctx context.Context, req *pb.SetPinPolicyRequest) (*emptypb.Empty, error) {
+	params := converter.ConvertSetPinPolicyRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc SetPinPolicy", slog.Any("params", params))
+
+	err := h.pinService.SetPinPolicy(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
 
-	err := h.chatService.SendMessage(ctx, converter.ConvertSendMessageRequestFromHandlerToService(req))
+	return &emptypb.Empty{}, nil
+}
+
+// ScheduleMessage handles the RPC call to schedule a message.
+func (h *GRPCHandlers) ScheduleMessage(
+	ctx context.Context,
+	req *pb.ScheduleMessageRequest,
+) (*pb.ScheduleMessageResponse, error) {
+	params, err := converter.ConvertScheduleMessageRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots always schedule as themselves.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	if params.From == "" {
+		return nil, status.Error(codes.InvalidArgument, "from is required")
+	}
+
+	logger.FromContext(ctx).Debug("rpc ScheduleMessage", slog.Any("params", params))
+
+	scheduledMessageID, err := h.scheduleService.ScheduleMessage(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &pb.ScheduleMessageResponse{Id: scheduledMessageID}, nil
+}
+
+// ListScheduledMessages handles the RPC call to list the messages scheduled by a sender.
+func (h *GRPCHandlers) ListScheduledMessages(
+	ctx context.Context,
+	req *pb.ListScheduledMessagesRequest,
+) (*pb.ListScheduledMessagesResponse, error) {
+	params := converter.ConvertListScheduledMessagesRequestFromHandlerToService(req)
+
+	// Bots always list their own messages.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	if params.From == "" {
+		return nil, status.Error(codes.InvalidArgument, "from is required")
+	}
+
+	logger.FromContext(ctx).Debug("rpc ListScheduledMessages", slog.Any("params", params))
+
+	messages, err := h.scheduleService.ListScheduledMessages(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertScheduledMessagesFromServiceToHandler(messages), nil
+}
+
+// CancelScheduledMessage handles the RPC call to cancel a message scheduled by a sender.
+func (h *GRPCHandlers) CancelScheduledMessage(
+	ctx context.Context,
+	req *pb.CancelScheduledMessageRequest,
+) (*emptypb.Empty, error) {
+	params := converter.ConvertCancelScheduledMessageRequestFromHandlerToService(req)
+
+	// Bots always cancel their own messages.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	if params.From == "" {
+		return nil, status.Error(codes.InvalidArgument, "from is required")
+	}
+
+	logger.FromContext(ctx).Debug("rpc CancelScheduledMessage", slog.Any("params", params))
+
+	err := h.scheduleService.CancelScheduledMessage(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListAuditLog handles the RPC call to list the audit log of api actions.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) ListAuditLog(
+	ctx context.Context,
+	req *pb.ListAuditLogRequest,
+) (*pb.ListAuditLogResponse, error) {
+	params, err := converter.ConvertListAuditLogRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	logger.FromContext(ctx).Debug("rpc ListAuditLog", slog.Any("params", params))
+
+	resp, err := h.auditService.ListAuditLog(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertListAuditLogResponseFromServiceToHandler(resp)
+}
+
+// CreateWebhook handles the RPC call to subscribe an endpoint to chat events.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) CreateWebhook(
+	ctx context.Context,
+	req *pb.CreateWebhookRequest,
+) (*pb.CreateWebhookResponse, error) {
+	params, err := converter.ConvertCreateWebhookRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	logger.FromContext(ctx).Debug("rpc CreateWebhook", slog.Int64("chat_id", params.ChatID))
+
+	resp, err := h.webhookService.CreateWebhook(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertCreateWebhookResponseFromServiceToHandler(resp), nil
+}
+
+// ListWebhooks handles the RPC call to list the webhooks of a chat, or all webhooks.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) ListWebhooks(
+	ctx context.Context,
+	req *pb.ListWebhooksRequest,
+) (*pb.ListWebhooksResponse, error) {
+	params := converter.ConvertListWebhooksRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc ListWebhooks", slog.Any("params", params))
+
+	webhooks, err := h.webhookService.ListWebhooks(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertWebhooksFromServiceToHandler(webhooks), nil
+}
+
+// DeleteWebhook handles the RPC call to delete a webhook.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	params := converter.ConvertDeleteWebhookRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc DeleteWebhook", slog.Any("params", params))
+
+	err := h.webhookService.DeleteWebhook(ctx, params)
 	if err != nil {
-		return nil, err
+		return nil, convertError(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// CreateIncomingWebhook handles the RPC call to create an incoming webhook of a chat.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) CreateIncomingWebhook(
+	ctx context.Context,
+	req *pb.CreateIncomingWebhookRequest,
+) (*pb.CreateIncomingWebhookResponse, error) {
+	params, err := converter.ConvertCreateIncomingWebhookRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	logger.FromContext(ctx).Debug("rpc CreateIncomingWebhook", slog.Int64("chat_id", params.ChatID))
+
+	resp, err := h.webhookService.CreateIncomingWebhook(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertCreateIncomingWebhookResponseFromServiceToHandler(resp), nil
+}
+
+// ListIncomingWebhooks handles the RPC call to list the incoming webhooks of a chat, or all of them.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) ListIncomingWebhooks(
+	ctx context.Context,
+	req *pb.ListIncomingWebhooksRequest,
+) (*pb.ListIncomingWebhooksResponse, error) {
+	params := converter.ConvertListIncomingWebhooksRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc ListIncomingWebhooks", slog.Any("params", params))
+
+	webhooks, err := h.webhookService.ListIncomingWebhooks(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertIncomingWebhooksFromServiceToHandler(webhooks), nil
+}
+
+// DeleteIncomingWebhook handles the RPC call to revoke an incoming webhook.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) DeleteIncomingWebhook(
+	ctx context.Context,
+	req *pb.DeleteIncomingWebhookRequest,
+) (*emptypb.Empty, error) {
+	params := converter.ConvertDeleteIncomingWebhookRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc DeleteIncomingWebhook", slog.Any("params", params))
+
+	err := h.webhookService.DeleteIncomingWebhook(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CreateBot handles the RPC call to create a bot.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) CreateBot(ctx context.Context, req *pb.CreateBotRequest) (*pb.CreateBotResponse, error) {
+	params, err := converter.ConvertCreateBotRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	logger.FromContext(ctx).Debug("rpc CreateBot", slog.String("name", params.Name))
+
+	resp, err := h.botService.CreateBot(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertCreateBotResponseFromServiceToHandler(resp), nil
+}
+
+// ListBots handles the RPC call to list the bots.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) ListBots(ctx context.Context, _ *emptypb.Empty) (*pb.ListBotsResponse, error) {
+	logger.FromContext(ctx).Debug("rpc ListBots")
+
+	bots, err := h.botService.ListBots(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertBotsFromServiceToHandler(bots), nil
+}
+
+// DeleteBot handles the RPC call to delete a bot.
+// Only administrators are allowed to call it, which is enforced by the auth interceptor.
+func (h *GRPCHandlers) DeleteBot(ctx context.Context, req *pb.DeleteBotRequest) (*emptypb.Empty, error) {
+	params := converter.ConvertDeleteBotRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc DeleteBot", slog.Any("params", params))
+
+	err := h.botService.DeleteBot(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CreatePoll handles the RPC call to post a poll to a chat.
+func (h *GRPCHandlers) CreatePoll(ctx context.Context, req *pb.CreatePollRequest) (*pb.CreatePollResponse, error) {
+	params, err := converter.ConvertCreatePollRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots always post as themselves.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc CreatePoll", slog.Any("params", params))
+
+	resp, err := h.pollService.CreatePoll(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertCreatePollResponseFromServiceToHandler(resp), nil
+}
+
+// Vote handles the RPC call to vote in a poll.
+func (h *GRPCHandlers) Vote(ctx context.Context, req *pb.VoteRequest) (*emptypb.Empty, error) {
+	params, err := converter.ConvertVoteRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots always vote as themselves.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc Vote", slog.Any("params", params))
+
+	err = h.pollService.Vote(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ClosePoll handles the RPC call to close a poll. Administrators may close any poll.
+func (h *GRPCHandlers) ClosePoll(ctx context.Context, req *pb.ClosePollRequest) (*emptypb.Empty, error) {
+	params := converter.ConvertClosePollRequestFromHandlerToService(req)
+
+	if _, ok := interceptor.AdminFromContext(ctx); ok {
+		params.Admin = true
+	} else if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc ClosePoll", slog.Any("params", params))
+
+	err := h.pollService.ClosePoll(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetPoll handles the RPC call to get a poll with its results.
+func (h *GRPCHandlers) GetPoll(ctx context.Context, req *pb.GetPollRequest) (*pb.Poll, error) {
+	params := converter.ConvertGetPollRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc GetPoll", slog.Any("params", params))
+
+	poll, err := h.pollService.GetPoll(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertPollFromServiceToHandler(poll), nil
+}
+
+// Subscribe handles the streaming RPC call to receive the live updates of a chat.
+// The stream ends with Unavailable if the subscriber does not keep up, the client should subscribe again
+// and get the current state of what it displays.
+func (h *GRPCHandlers) Subscribe(req *pb.SubscribeRequest, stream pb.ChatV1_SubscribeServer) error {
+	ctx := stream.Context()
+
+	params := converter.ConvertSubscribeRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc Subscribe", slog.Any("params", params))
+
+	updates, err := h.subscriptionService.Subscribe(ctx, params)
+	if err != nil {
+		return convertError(err)
+	}
+
+	// The subscriber is online while subscribed.
+	err = h.presenceService.Connect(ctx, model.ConnectParams{Email: params.From})
+	if err != nil {
+		return convertError(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case update, ok := <-updates:
+			if !ok {
+				return status.Error(codes.Unavailable, "subscription ended, subscribe again")
+			}
+
+			// The ephemeral updates delayed past their expiration are no longer relevant.
+			if update.IsExpired(time.Now()) {
+				continue
+			}
+
+			msg, err := converter.ConvertChatUpdateFromServiceToHandler(update)
+			if err != nil {
+				logger.FromContext(ctx).Warn("chat update not sent", slog.String("error", err.Error()))
+				continue
+			}
+
+			err = stream.Send(msg)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SendTypingEvent handles the RPC call to tell the subscribers of a chat that a participant is typing.
+// The events sent too often are dropped without an error.
+func (h *GRPCHandlers) SendTypingEvent(ctx context.Context, req *pb.SendTypingEventRequest) (*emptypb.Empty, error) {
+	params, err := converter.ConvertSendTypingEventRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots always type as themselves.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	err = h.ephemeralService.SendTypingEvent(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Heartbeat handles the RPC call to mark a user seen, away if idle.
+func (h *GRPCHandlers) Heartbeat(ctx context.Context, req *pb.HeartbeatRequest) (*emptypb.Empty, error) {
+	params, err := converter.ConvertHeartbeatRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots are always seen as themselves.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.Email = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc Heartbeat", slog.Any("params", params))
+
+	err = h.presenceService.Heartbeat(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetPresence handles the RPC call to get whether the users are online, away or offline.
+func (h *GRPCHandlers) GetPresence(ctx context.Context, req *pb.GetPresenceRequest) (*pb.GetPresenceResponse, error) {
+	params, err := converter.ConvertGetPresenceRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	logger.FromContext(ctx).Debug("rpc GetPresence", slog.Any("params", params))
+
+	presences, err := h.presenceService.GetPresence(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertPresencesFromServiceToHandler(presences), nil
+}
+
+// GetUser handles the RPC call to get a user with their profile.
+func (h *GRPCHandlers) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	params := converter.ConvertGetUserRequestFromHandlerToService(req)
+
+	logger.FromContext(ctx).Debug("rpc GetUser", slog.Any("params", params))
+
+	user, err := h.userService.GetUser(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertUserFromServiceToHandler(user), nil
+}
+
+// UpdateProfile handles the RPC call to update the profile of a user.
+func (h *GRPCHandlers) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.User, error) {
+	params, err := converter.ConvertUpdateProfileRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots only update their own profile.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.Email = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc UpdateProfile", slog.Any("params", params))
+
+	user, err := h.userService.UpdateProfile(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertUserFromServiceToHandler(user), nil
+}
+
+// SearchUsers handles the RPC call to find the users by the prefix of their email or display name.
+func (h *GRPCHandlers) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	params, err := converter.ConvertSearchUsersRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	logger.FromContext(ctx).Debug("rpc SearchUsers", slog.Any("params", params))
+
+	users, err := h.userService.SearchUsers(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertUsersFromServiceToHandler(users), nil
+}
+
+// BlockUser handles the RPC call to block a user.
+func (h *GRPCHandlers) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*emptypb.Empty, error) {
+	params, err := converter.ConvertBlockUserRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots always block as themselves.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc BlockUser", slog.Any("params", params))
+
+	err = h.blockService.BlockUser(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// UnblockUser handles the RPC call to lift the block of a user.
+func (h *GRPCHandlers) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*emptypb.Empty, error) {
+	params, err := converter.ConvertUnblockUserRequestFromHandlerToService(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Bots always unblock as themselves.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc UnblockUser", slog.Any("params", params))
+
+	err = h.blockService.UnblockUser(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListBlocked handles the RPC call to list the users blocked by a user.
+func (h *GRPCHandlers) ListBlocked(ctx context.Context, req *pb.ListBlockedRequest) (*pb.ListBlockedResponse, error) {
+	params := converter.ConvertListBlockedRequestFromHandlerToService(req)
+
+	// Bots always list their own blocks.
+	if bot, ok := interceptor.BotFromContext(ctx); ok {
+		params.From = bot.Name
+	}
+
+	logger.FromContext(ctx).Debug("rpc ListBlocked", slog.Any("params", params))
+
+	users, err := h.blockService.ListBlocked(ctx, params)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return converter.ConvertBlockedUsersFromServiceToHandler(users), nil
+}
+
+// convertError maps the errors of the services to the gRPC status matching their model error,
+// the other errors are returned as they are.
+func convertError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, model.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrPollClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
@@ -95,7 +95,7 @@ func TestCreate(t *testing.T) {
 			t.Parallel()
 
 			chatServiceMock := tt.chatServiceMock(mc)
-			api := chatAPI.NewGRPCHandlers(chatServiceMock)
+			api := chatAPI.NewGRPCHandlers(chatServiceMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			resp, err := api.Create(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -86,7 +86,7 @@ func TestDelete(t *testing.T) {
 			t.Parallel()
 
 			chatServiceMock := tt.chatServiceMock(mc)
-			api := chatAPI.NewGRPCHandlers(chatServiceMock)
+			api := chatAPI.NewGRPCHandlers(chatServiceMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			resp, err := api.Delete(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
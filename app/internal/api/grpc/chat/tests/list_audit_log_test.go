@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	chatAPI "github.com/Prrromanssss/chat-server/internal/api/grpc/chat"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	serviceMocks "github.com/Prrromanssss/chat-server/internal/service/mocks"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+func TestListAuditLog(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		timestamp = time.Date(2024, time.August, 4, 9, 6, 33, 123456000, time.UTC)
+		from      = timestamp.Add(-time.Hour)
+
+		entry = model.AuditLogEntry{
+			ID:           7,
+			ActionType:   "Create",
+			RequestData:  []byte(`{"Emails":["a***@example.com"]}`),
+			ResponseData: []byte(`{"chat_id":1}`),
+			Timestamp:    timestamp,
+		}
+	)
+
+	auditServiceMock := serviceMocks.NewAuditServiceMock(mc)
+	auditServiceMock.ListAuditLogMock.
+		When(ctx, model.ListAuditLogParams{
+			ActionTypes: []string{"Create"},
+			From:        from,
+			RequestData: map[string]interface{}{},
+			PageSize:    1,
+		}).
+		Then(model.ListAuditLogResponse{
+			Entries:    []model.AuditLogEntry{entry},
+			NextCursor: &model.AuditLogCursor{Timestamp: timestamp, ID: entry.ID},
+		}, nil)
+	auditServiceMock.ListAuditLogMock.
+		When(ctx, model.ListAuditLogParams{
+			RequestData: map[string]interface{}{},
+			PageSize:    1,
+			Cursor:      &model.AuditLogCursor{Timestamp: timestamp, ID: entry.ID},
+		}).
+		Then(model.ListAuditLogResponse{Entries: []model.AuditLogEntry{}}, nil)
+
+	api := chatAPI.NewGRPCHandlers(nil, auditServiceMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	resp, err := api.ListAuditLog(ctx, &pb.ListAuditLogRequest{
+		ActionTypes: []string{"Create"},
+		From:        timestamppb.New(from),
+		PageSize:    1,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.NextPageToken)
+	require.Len(t, resp.Entries, 1)
+	require.Equal(t, int64(7), resp.Entries[0].Id)
+	require.Equal(t, timestamp, resp.Entries[0].Timestamp.AsTime())
+	require.Equal(t, float64(1), resp.Entries[0].ResponseData.AsMap()["chat_id"])
+	require.Equal(t, []interface{}{"a***@example.com"}, resp.Entries[0].RequestData.AsMap()["Emails"])
+
+	resp, err = api.ListAuditLog(ctx, &pb.ListAuditLogRequest{
+		PageSize:  1,
+		PageToken: resp.NextPageToken,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.NextPageToken)
+	require.Empty(t, resp.Entries)
+
+	_, err = api.ListAuditLog(ctx, &pb.ListAuditLogRequest{PageToken: "not a token"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/brianvoe/gofakeit"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -86,6 +88,20 @@ func TestSendMessage(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "not a participant case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.PermissionDenied, model.ErrPermissionDenied.Error()),
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.SendMessageMock.Expect(ctx, serviceParams).Return(model.ErrPermissionDenied)
+				return mock
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -93,7 +109,7 @@ func TestSendMessage(t *testing.T) {
 			t.Parallel()
 
 			chatServiceMock := tt.chatServiceMock(mc)
-			api := chatAPI.NewGRPCHandlers(chatServiceMock)
+			api := chatAPI.NewGRPCHandlers(chatServiceMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			resp, err := api.SendMessage(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	chatAPI "github.com/Prrromanssss/chat-server/internal/api/grpc/chat"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	serviceMocks "github.com/Prrromanssss/chat-server/internal/service/mocks"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+func TestUpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		email       = "alice@example.com"
+		displayName = "Alice"
+		avatarURL   = "https://cdn.example.com/alice.png"
+		createdAt   = time.Date(2024, 7, 26, 18, 14, 5, 0, time.UTC)
+
+		ErrService = errors.New("service error")
+
+		serviceParams = model.UpdateProfileParams{
+			Email:       email,
+			DisplayName: &displayName,
+			AvatarURL:   &avatarURL,
+		}
+
+		user = model.User{
+			ID:          1,
+			Email:       email,
+			DisplayName: displayName,
+			AvatarURL:   avatarURL,
+			CreatedAt:   createdAt,
+		}
+
+		noService = func(mc *minimock.Controller) service.UserService {
+			return serviceMocks.NewUserServiceMock(mc)
+		}
+
+		ptr = func(s string) *string { return &s }
+	)
+
+	tests := []struct {
+		name            string
+		req             *pb.UpdateProfileRequest
+		want            *pb.User
+		code            codes.Code
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "success case",
+			req:  &pb.UpdateProfileRequest{Email: email, DisplayName: &displayName, AvatarUrl: &avatarURL},
+			want: &pb.User{
+				Id:          1,
+				Email:       email,
+				DisplayName: displayName,
+				AvatarUrl:   avatarURL,
+				CreatedAt:   timestamppb.New(createdAt),
+			},
+			code: codes.OK,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.UpdateProfileMock.Expect(ctx, serviceParams).Return(user, nil)
+				return mock
+			},
+		},
+		{
+			name:            "avatar is not an http url",
+			req:             &pb.UpdateProfileRequest{Email: email, AvatarUrl: ptr("javascript:alert(1)")},
+			code:            codes.InvalidArgument,
+			userServiceMock: noService,
+		},
+		{
+			name:            "display name is too long",
+			req:             &pb.UpdateProfileRequest{Email: email, DisplayName: ptr(string(make([]rune, 65)))},
+			code:            codes.InvalidArgument,
+			userServiceMock: noService,
+		},
+		{
+			name: "unknown user",
+			req:  &pb.UpdateProfileRequest{Email: email, DisplayName: &displayName, AvatarUrl: &avatarURL},
+			code: codes.NotFound,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.UpdateProfileMock.Expect(ctx, serviceParams).Return(model.User{}, errors.Wrap(model.ErrNotFound, "user"))
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			req:  &pb.UpdateProfileRequest{Email: email, DisplayName: &displayName, AvatarUrl: &avatarURL},
+			code: codes.Unknown,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.UpdateProfileMock.Expect(ctx, serviceParams).Return(model.User{}, ErrService)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userServiceMock := tt.userServiceMock(mc)
+			api := chatAPI.NewGRPCHandlers(nil, nil, nil, nil, nil, nil, nil, nil, userServiceMock, nil, nil, nil)
+
+			resp, err := api.UpdateProfile(ctx, tt.req)
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
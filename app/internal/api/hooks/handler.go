@@ -0,0 +1,77 @@
+package hooks
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/service"
+)
+
+// Pattern is the route of the incoming webhooks, with the token as the last path segment.
+const Pattern = "POST /hooks/{token}"
+
+// maxBodySize is the maximum size in bytes of the body of a post.
+const maxBodySize = 64 << 10
+
+// payload is the JSON body of a post.
+type payload struct {
+	Text string `json:"text"`
+}
+
+// Handler serves the incoming webhooks, letting tools without a gRPC client post messages to a chat
+// with a plain HTTP request:
+//
+//	curl -X POST -H 'Content-Type: application/json' -d '{"text": "Build passed"}' http://host/hooks/<token>
+//
+// It must be registered with Pattern. It responds 204 on success, 400 on an invalid body
+// and 404 on an unknown token.
+type Handler struct {
+	webhookService service.WebhookService
+}
+
+// NewHandler creates a new instance of Handler posting the messages through the provided WebhookService.
+func NewHandler(webhookService service.WebhookService) *Handler {
+	return &Handler{
+		webhookService: webhookService,
+	}
+}
+
+// ServeHTTP posts the message of the request to the chat of the incoming webhook.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body payload
+
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&body)
+	if err != nil {
+		http.Error(w, "invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	if strings.TrimSpace(body.Text) == "" {
+		http.Error(w, "text is required", http.StatusBadRequest)
+		return
+	}
+
+	err = h.webhookService.PostIncomingMessage(r.Context(), model.PostIncomingMessageParams{
+		Token: r.PathValue("token"),
+		Text:  body.Text,
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			http.Error(w, "unknown webhook", http.StatusNotFound)
+			return
+		}
+
+		logger.FromContext(r.Context()).Error("incoming webhook failed", slog.String("error", err.Error()))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
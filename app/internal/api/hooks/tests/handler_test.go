@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/api/hooks"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	serviceMocks "github.com/Prrromanssss/chat-server/internal/service/mocks"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	type webhookServiceMockFunc func(mc *minimock.Controller) service.WebhookService
+
+	const token = "secret-token"
+
+	tests := []struct {
+		name               string
+		body               string
+		code               int
+		webhookServiceMock webhookServiceMockFunc
+	}{
+		{
+			name: "message posted",
+			body: `{"text": "Build passed"}`,
+			code: http.StatusNoContent,
+			webhookServiceMock: func(mc *minimock.Controller) service.WebhookService {
+				mock := serviceMocks.NewWebhookServiceMock(mc)
+				mock.PostIncomingMessageMock.Expect(minimock.AnyContext, model.PostIncomingMessageParams{
+					Token: token,
+					Text:  "Build passed",
+				}).Return(nil)
+
+				return mock
+			},
+		},
+		{
+			name: "invalid JSON",
+			body: `text=Build passed`,
+			code: http.StatusBadRequest,
+			webhookServiceMock: func(mc *minimock.Controller) service.WebhookService {
+				return serviceMocks.NewWebhookServiceMock(mc)
+			},
+		},
+		{
+			name: "blank text",
+			body: `{"text": "  "}`,
+			code: http.StatusBadRequest,
+			webhookServiceMock: func(mc *minimock.Controller) service.WebhookService {
+				return serviceMocks.NewWebhookServiceMock(mc)
+			},
+		},
+		{
+			name: "unknown token",
+			body: `{"text": "Build passed"}`,
+			code: http.StatusNotFound,
+			webhookServiceMock: func(mc *minimock.Controller) service.WebhookService {
+				mock := serviceMocks.NewWebhookServiceMock(mc)
+				mock.PostIncomingMessageMock.Return(errors.Wrap(model.ErrNotFound, "incoming webhook"))
+
+				return mock
+			},
+		},
+		{
+			name: "service error",
+			body: `{"text": "Build passed"}`,
+			code: http.StatusInternalServerError,
+			webhookServiceMock: func(mc *minimock.Controller) service.WebhookService {
+				mock := serviceMocks.NewWebhookServiceMock(mc)
+				mock.PostIncomingMessageMock.Return(errors.New("service error"))
+
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			mux := http.NewServeMux()
+			mux.Handle(hooks.Pattern, hooks.NewHandler(tt.webhookServiceMock(mc)))
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/hooks/"+token, strings.NewReader(tt.body)))
+
+			require.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
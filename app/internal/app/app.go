@@ -2,27 +2,45 @@ package app
 
 import (
 	"context"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/Prrromanssss/platform_common/pkg/closer"
 
-	"github.com/gofiber/fiber/v2/log"
 	"github.com/pkg/errors"
+	"github.com/rs/cors"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/api/hooks"
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/metric"
+	"github.com/Prrromanssss/chat-server/internal/redact"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
 	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+	"github.com/Prrromanssss/chat-server/pkg/chat_v1/chat_v1connect"
+)
+
+const (
+	readHeaderTimeout = 5 * time.Second
+	shutdownTimeout   = 10 * time.Second
 )
 
 type App struct {
 	cfg             *config.Config
 	serviceProvider *serviceProvider
 	grpcServer      *grpc.Server
+	httpServer      *http.Server
 }
 
 func NewApp(ctx context.Context) (*App, error) {
@@ -39,8 +57,11 @@ func NewApp(ctx context.Context) (*App, error) {
 func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(ctx context.Context) error{
 		a.initConfig,
+		a.initLogger,
+		a.initTracing,
 		a.initServiceProvider,
 		a.initGRPCServer,
+		a.initHTTPServer,
 	}
 
 	for _, f := range inits {
@@ -59,13 +80,47 @@ func (a *App) initConfig(_ context.Context) error {
 		return err
 	}
 
-	log.Info("Config loaded")
-
 	a.cfg = cfg
 
 	return nil
 }
 
+// initLogger sets up the structured logger configured for the application as the default one.
+func (a *App) initLogger(_ context.Context) error {
+	redactor, err := redact.New(a.cfg.Redaction)
+	if err != nil {
+		return err
+	}
+
+	_, err = logger.Init(a.cfg.Logger, redactor)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Config loaded")
+
+	return nil
+}
+
+// initTracing sets up the global OpenTelemetry tracer provider and flushes pending spans on close.
+func (a *App) initTracing(ctx context.Context) error {
+	provider, err := tracing.Init(ctx, a.cfg.Tracing)
+	if err != nil {
+		return err
+	}
+
+	if provider != nil {
+		closer.Add(func() error {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+
+			return provider.Shutdown(shutdownCtx)
+		})
+	}
+
+	return nil
+}
+
 func (a *App) initServiceProvider(_ context.Context) error {
 	a.serviceProvider = newServiceProvider(a.cfg)
 
@@ -73,11 +128,55 @@ func (a *App) initServiceProvider(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		grpc.ChainUnaryInterceptor(a.serviceProvider.UnaryInterceptors(ctx)...),
+		grpc.ChainStreamInterceptor(a.serviceProvider.StreamInterceptors(ctx)...),
+	)
 
 	reflection.Register(a.grpcServer)
 
 	pb.RegisterChatV1Server(a.grpcServer, a.serviceProvider.ChatAPI(ctx))
+	healthpb.RegisterHealthServer(a.grpcServer, a.serviceProvider.HealthChecker(ctx).Server())
+
+	return nil
+}
+
+// initHTTPServer sets up the HTTP server that serves the ChatV1 service over the Connect and gRPC-Web protocols
+// and the incoming webhooks.
+// HTTP/2 without TLS is enabled through h2c, so HTTP/1.1 and HTTP/2 clients share the same port.
+func (a *App) initHTTPServer(ctx context.Context) error {
+	mux := http.NewServeMux()
+
+	mux.Handle(chat_v1connect.NewChatV1Handler(a.serviceProvider.ChatConnectAPI(ctx)))
+	mux.Handle(hooks.Pattern, hooks.NewHandler(a.serviceProvider.WebhookService(ctx)))
+	mux.Handle("/healthz", a.serviceProvider.HealthChecker(ctx).LivenessHandler())
+	mux.Handle("/readyz", a.serviceProvider.HealthChecker(ctx).ReadinessHandler())
+	mux.Handle("/metrics", metric.Handler())
+
+	corsMiddleware := cors.New(cors.Options{
+		AllowedOrigins: a.cfg.CORS.AllowedOrigins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders: []string{
+			"Content-Type",
+			"Connect-Protocol-Version",
+			"Connect-Timeout-Ms",
+			"Grpc-Timeout",
+			"X-Grpc-Web",
+			"X-User-Agent",
+		},
+		ExposedHeaders: []string{
+			"Grpc-Status",
+			"Grpc-Message",
+			"Grpc-Status-Details-Bin",
+		},
+	})
+
+	a.httpServer = &http.Server{
+		Addr:              a.cfg.HTTP.Address(),
+		Handler:           h2c.NewHandler(corsMiddleware.Handler(mux), &http2.Server{}),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
 
 	return nil
 }
@@ -88,11 +187,73 @@ func (a *App) Run(ctx context.Context, cancel context.CancelFunc) error {
 		closer.Wait()
 	}()
 
+	// Starting database health checks
+	healthCtx, healthCancel := context.WithCancel(ctx)
+	defer healthCancel()
+
+	go a.serviceProvider.HealthChecker(ctx).Run(healthCtx)
+
+	// Starting audit log partitions maintenance
+	retentionCtx, retentionCancel := context.WithCancel(ctx)
+	defer retentionCancel()
+
+	go a.serviceProvider.RetentionJob(ctx).Run(retentionCtx)
+
+	// Starting outbox relay
+	relayCtx, relayCancel := context.WithCancel(ctx)
+	defer relayCancel()
+
+	if relay := a.serviceProvider.OutboxRelay(ctx); relay != nil {
+		go relay.Run(relayCtx)
+	}
+
+	// Starting webhook deliveries
+	webhookCtx, webhookCancel := context.WithCancel(ctx)
+	defer webhookCancel()
+
+	if dispatcher := a.serviceProvider.WebhookDispatcher(ctx); dispatcher != nil {
+		go dispatcher.Run(webhookCtx)
+	}
+
+	// Starting notifications of the offline users
+	notificationCtx, notificationCancel := context.WithCancel(ctx)
+	defer notificationCancel()
+
+	if dispatcher := a.serviceProvider.NotificationDispatcher(ctx); dispatcher != nil {
+		go dispatcher.Run(notificationCtx)
+	}
+
+	// Starting delivery of the scheduled messages
+	schedulerCtx, schedulerCancel := context.WithCancel(ctx)
+	defer schedulerCancel()
+
+	go a.serviceProvider.Scheduler(ctx).Run(schedulerCtx)
+
+	// Starting live updates of the chats
+	updatesCtx, updatesCancel := context.WithCancel(ctx)
+	defer updatesCancel()
+
+	go a.serviceProvider.UpdateListener(ctx).Run(updatesCtx)
+
+	// Starting presence tracking
+	presenceCtx, presenceCancel := context.WithCancel(ctx)
+	defer presenceCancel()
+
+	go a.serviceProvider.PresenceTracker(ctx).Run(presenceCtx)
+
 	// Starting gRPC server
 	go func() {
 		err := a.runGRPCServer()
 		if err != nil {
-			log.Panic(err)
+			logger.Fatal("gRPC server failed", slog.String("error", err.Error()))
+		}
+	}()
+
+	// Starting HTTP server
+	go func() {
+		err := a.runHTTPServer()
+		if err != nil {
+			logger.Fatal("HTTP server failed", slog.String("error", err.Error()))
 		}
 	}()
 
@@ -102,14 +263,42 @@ func (a *App) Run(ctx context.Context, cancel context.CancelFunc) error {
 
 	select {
 	case <-ctx.Done():
-		log.Info("Context cancelled, initiating graceful shutdown...")
-		a.grpcServer.GracefulStop()
+		slog.Info("Context cancelled, initiating graceful shutdown...")
 	case <-quit:
-		log.Info("Received termination signal, initiating graceful shutdown...")
-		a.grpcServer.GracefulStop()
+		slog.Info("Received termination signal, initiating graceful shutdown...")
+	}
+
+	a.serviceProvider.HealthChecker(ctx).Shutdown()
+	healthCancel()
+	retentionCancel()
+	relayCancel()
+	webhookCancel()
+	notificationCancel()
+	schedulerCancel()
+	updatesCancel()
+	presenceCancel()
+
+	// Ending the subscriptions, so that the streams do not hold the graceful stop
+	a.serviceProvider.UpdateHub(ctx).Close()
+
+	a.grpcServer.GracefulStop()
+	slog.Info("gRPC server shut down gracefully")
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer shutdownCancel()
+
+	if err := a.httpServer.Shutdown(shutdownCtx); err != nil {
+		slog.Error("HTTP server shutdown failed", slog.String("error", err.Error()))
+	} else {
+		slog.Info("HTTP server shut down gracefully")
+	}
+
+	if err := a.serviceProvider.CloseLogRepository(); err != nil {
+		slog.Error("audit log drain failed", slog.String("error", err.Error()))
+	} else {
+		slog.Info("audit log drained")
 	}
 
-	log.Info("gRPC server shut down gracefully")
 	cancel()
 
 	return nil
@@ -124,7 +313,7 @@ func (a *App) runGRPCServer() error {
 		return errors.Wrapf(err, "Error starting listener")
 	}
 
-	log.Infof("Starting gRPC server on %s", a.cfg.GRPC.Address())
+	slog.Info("Starting gRPC server", slog.String("address", a.cfg.GRPC.Address()))
 
 	if err := a.grpcServer.Serve(listener); err != nil {
 		return errors.Wrapf(err, "Error starting gRPC server")
@@ -132,3 +321,14 @@ func (a *App) runGRPCServer() error {
 
 	return nil
 }
+
+func (a *App) runHTTPServer() error {
+	slog.Info("Starting HTTP server", slog.String("address", a.cfg.HTTP.Address()))
+
+	err := a.httpServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return errors.Wrapf(err, "Error starting HTTP server")
+	}
+
+	return nil
+}
@@ -2,21 +2,66 @@ package app
 
 import (
 	"context"
-	"log"
+	"log/slog"
 
 	"github.com/Prrromanssss/platform_common/pkg/closer"
 	"github.com/Prrromanssss/platform_common/pkg/db"
 	"github.com/Prrromanssss/platform_common/pkg/db/pg"
 	"github.com/Prrromanssss/platform_common/pkg/db/transaction"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/Prrromanssss/chat-server/config"
+	chatConnectAPI "github.com/Prrromanssss/chat-server/internal/api/connect/chat"
 	chatAPI "github.com/Prrromanssss/chat-server/internal/api/grpc/chat"
+	"github.com/Prrromanssss/chat-server/internal/broadcast"
+	"github.com/Prrromanssss/chat-server/internal/converter"
+	"github.com/Prrromanssss/chat-server/internal/health"
+	"github.com/Prrromanssss/chat-server/internal/interceptor"
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/metric"
+	"github.com/Prrromanssss/chat-server/internal/notification"
+	"github.com/Prrromanssss/chat-server/internal/outbox"
+	"github.com/Prrromanssss/chat-server/internal/presence"
+	"github.com/Prrromanssss/chat-server/internal/redact"
 
 	"github.com/Prrromanssss/chat-server/internal/repository"
+	blockRepository "github.com/Prrromanssss/chat-server/internal/repository/block"
+	botRepository "github.com/Prrromanssss/chat-server/internal/repository/bot"
 	chatRepository "github.com/Prrromanssss/chat-server/internal/repository/chat"
 	logRepository "github.com/Prrromanssss/chat-server/internal/repository/log"
+	mentionRepository "github.com/Prrromanssss/chat-server/internal/repository/mention"
+	notificationRepository "github.com/Prrromanssss/chat-server/internal/repository/notification"
+	outboxRepository "github.com/Prrromanssss/chat-server/internal/repository/outbox"
+	pinRepository "github.com/Prrromanssss/chat-server/internal/repository/pin"
+	pollRepository "github.com/Prrromanssss/chat-server/internal/repository/poll"
+	presenceRepository "github.com/Prrromanssss/chat-server/internal/repository/presence"
+	scheduleRepository "github.com/Prrromanssss/chat-server/internal/repository/schedule"
+	updateRepository "github.com/Prrromanssss/chat-server/internal/repository/update"
+	userRepository "github.com/Prrromanssss/chat-server/internal/repository/user"
+	webhookRepository "github.com/Prrromanssss/chat-server/internal/repository/webhook"
+	"github.com/Prrromanssss/chat-server/internal/retention"
+	"github.com/Prrromanssss/chat-server/internal/scheduler"
 	"github.com/Prrromanssss/chat-server/internal/service"
+	auditService "github.com/Prrromanssss/chat-server/internal/service/audit"
+	blockService "github.com/Prrromanssss/chat-server/internal/service/block"
+	botService "github.com/Prrromanssss/chat-server/internal/service/bot"
 	chatService "github.com/Prrromanssss/chat-server/internal/service/chat"
+	commandService "github.com/Prrromanssss/chat-server/internal/service/command"
+	ephemeralService "github.com/Prrromanssss/chat-server/internal/service/ephemeral"
+	pinService "github.com/Prrromanssss/chat-server/internal/service/pin"
+	pollService "github.com/Prrromanssss/chat-server/internal/service/poll"
+	presenceService "github.com/Prrromanssss/chat-server/internal/service/presence"
+	scheduleService "github.com/Prrromanssss/chat-server/internal/service/schedule"
+	subscriptionService "github.com/Prrromanssss/chat-server/internal/service/subscription"
+	userService "github.com/Prrromanssss/chat-server/internal/service/user"
+	webhookService "github.com/Prrromanssss/chat-server/internal/service/webhook"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
+	"github.com/Prrromanssss/chat-server/internal/userresolver"
+	"github.com/Prrromanssss/chat-server/internal/webhook"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+	"github.com/Prrromanssss/chat-server/pkg/chat_v1/chat_v1connect"
+	userV1 "github.com/Prrromanssss/chat-server/pkg/user_v1"
 )
 
 type serviceProvider struct {
@@ -25,11 +70,64 @@ type serviceProvider struct {
 	db        db.Client
 	txManager db.TxManager
 
+	instanceID string
+
 	chatRepository repository.ChatRepository
 	logRepository  repository.LogRepository
 
-	chatService service.ChatService
-	chatAPI     *chatAPI.GRPCHandlers
+	asyncLogRepository *logRepository.AsyncRepository
+
+	logPartitionRepository repository.LogPartitionRepository
+	retentionJob           *retention.Job
+
+	outboxRepository repository.OutboxRepository
+	eventPublisher   outbox.EventPublisher
+	outboxRelay      *outbox.Relay
+
+	webhookRepository repository.WebhookRepository
+	botRepository     repository.BotRepository
+	webhookDispatcher *webhook.Dispatcher
+
+	pollRepository   repository.PollRepository
+	updateRepository repository.ChatUpdateRepository
+	updateHub        *broadcast.Hub
+	updateListener   *broadcast.Listener
+
+	presenceRepository repository.PresenceRepository
+	presenceTracker    *presence.Tracker
+
+	userRepository  repository.UserRepository
+	userResolver    userresolver.UserResolver
+	blockRepository repository.BlockRepository
+
+	mentionRepository repository.MentionRepository
+	pinRepository     repository.PinRepository
+
+	notificationRepository repository.NotificationRepository
+	notificationDispatcher *notification.Dispatcher
+
+	scheduledMessageRepository repository.ScheduledMessageRepository
+	scheduler                  *scheduler.Scheduler
+
+	redactor *redact.Redactor
+
+	chatService         service.ChatService
+	auditService        service.AuditService
+	webhookService      service.WebhookService
+	botService          service.BotService
+	commandService      service.CommandService
+	pollService         service.PollService
+	subscriptionService service.SubscriptionService
+	ephemeralService    service.EphemeralService
+	presenceService     service.PresenceService
+	userService         service.UserService
+	blockService        service.BlockService
+	pinService          service.PinService
+	scheduleService     service.ScheduleService
+	chatAPI             *chatAPI.GRPCHandlers
+	chatConnectAPI      *chatConnectAPI.ConnectHandlers
+
+	healthChecker *health.Checker
 }
 
 func newServiceProvider(cfg *config.Config) *serviceProvider {
@@ -42,16 +140,16 @@ func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if s.db == nil {
 		cl, err := pg.New(ctx, s.cfg.Postgres.DSN())
 		if err != nil {
-			log.Fatalf("failed to create db client: %v", err)
+			logger.Fatal("failed to create db client", slog.String("error", err.Error()))
 		}
 
 		err = cl.DB().Ping(ctx)
 		if err != nil {
-			log.Fatalf("ping error: %s", err.Error())
+			logger.Fatal("ping error", slog.String("error", err.Error()))
 		}
 		closer.Add(cl.Close)
 
-		s.db = cl
+		s.db = tracing.NewDBClient(metric.NewDBClient(cl))
 	}
 
 	return s.db
@@ -67,36 +165,568 @@ func (s *serviceProvider) ChatRepository(ctx context.Context) repository.ChatRep
 
 func (s *serviceProvider) LogRepository(ctx context.Context) repository.LogRepository {
 	if s.logRepository == nil {
-		s.logRepository = logRepository.NewRepository(s.DBClient(ctx))
+		switch s.cfg.AuditLog.Mode {
+		case config.AuditLogModeSync:
+			s.logRepository = logRepository.NewRepository(s.DBClient(ctx), s.Redactor(ctx))
+		case config.AuditLogModeAsync:
+			s.asyncLogRepository = logRepository.NewAsyncRepository(s.DBClient(ctx), s.Redactor(ctx), s.cfg.AuditLog)
+			go s.asyncLogRepository.Run()
+
+			s.logRepository = s.asyncLogRepository
+		default:
+			logger.Fatal("invalid audit log mode", slog.String("mode", s.cfg.AuditLog.Mode))
+		}
 	}
 
 	return s.logRepository
 }
 
+// CloseLogRepository drains the asynchronous audit log, if enabled.
+// It must be called once no more requests are served and before the database is closed.
+func (s *serviceProvider) CloseLogRepository() error {
+	if s.asyncLogRepository == nil {
+		return nil
+	}
+
+	return s.asyncLogRepository.Close()
+}
+
+func (s *serviceProvider) LogPartitionRepository(ctx context.Context) repository.LogPartitionRepository {
+	if s.logPartitionRepository == nil {
+		s.logPartitionRepository = logRepository.NewPartitionRepository(s.DBClient(ctx))
+	}
+
+	return s.logPartitionRepository
+}
+
+func (s *serviceProvider) RetentionJob(ctx context.Context) *retention.Job {
+	if s.retentionJob == nil {
+		s.retentionJob = retention.NewJob(
+			s.LogPartitionRepository(ctx),
+			s.TxManager(ctx),
+			s.cfg.AuditLog.Retention,
+		)
+	}
+
+	return s.retentionJob
+}
+
+func (s *serviceProvider) OutboxRepository(ctx context.Context) repository.OutboxRepository {
+	if s.outboxRepository == nil {
+		s.outboxRepository = outboxRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.outboxRepository
+}
+
+// EventPublisher returns the publisher of the outbox events, nil if neither a publisher
+// nor the webhooks nor the notifications are enabled.
+func (s *serviceProvider) EventPublisher(ctx context.Context) outbox.EventPublisher {
+	if s.eventPublisher == nil {
+		var publishers []outbox.EventPublisher
+
+		switch s.cfg.Outbox.Publisher {
+		case config.OutboxPublisherNone:
+		case config.OutboxPublisherKafka:
+			publishers = append(publishers, outbox.NewKafkaPublisher(s.cfg.Outbox.Kafka))
+		default:
+			logger.Fatal("invalid outbox publisher", slog.String("publisher", s.cfg.Outbox.Publisher))
+		}
+
+		if s.cfg.Webhooks.Enabled {
+			publishers = append(publishers, webhook.NewPublisher(s.WebhookRepository(ctx)))
+		}
+
+		if s.cfg.Notifications.Enabled {
+			publishers = append(publishers, notification.NewPublisher(s.NotificationRepository(ctx)))
+		}
+
+		if len(publishers) == 0 {
+			return nil
+		}
+
+		s.eventPublisher = outbox.NewMultiPublisher(publishers...)
+		closer.Add(s.eventPublisher.Close)
+	}
+
+	return s.eventPublisher
+}
+
+// OutboxRelay returns the relay of the outbox events, nil if there is nothing to relay them to.
+func (s *serviceProvider) OutboxRelay(ctx context.Context) *outbox.Relay {
+	if s.outboxRelay == nil && s.EventPublisher(ctx) != nil {
+		s.outboxRelay = outbox.NewRelay(
+			s.OutboxRepository(ctx),
+			s.TxManager(ctx),
+			s.EventPublisher(ctx),
+			s.InstanceID(ctx),
+			s.cfg.Outbox,
+		)
+	}
+
+	return s.outboxRelay
+}
+
+func (s *serviceProvider) WebhookRepository(ctx context.Context) repository.WebhookRepository {
+	if s.webhookRepository == nil {
+		s.webhookRepository = webhookRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.webhookRepository
+}
+
+// WebhookDispatcher returns the dispatcher of the webhook deliveries, nil if the webhooks are disabled.
+func (s *serviceProvider) WebhookDispatcher(ctx context.Context) *webhook.Dispatcher {
+	if s.webhookDispatcher == nil && s.cfg.Webhooks.Enabled {
+		s.webhookDispatcher = webhook.NewDispatcher(
+			s.WebhookRepository(ctx),
+			s.TxManager(ctx),
+			s.cfg.Webhooks,
+		)
+	}
+
+	return s.webhookDispatcher
+}
+
+func (s *serviceProvider) BotRepository(ctx context.Context) repository.BotRepository {
+	if s.botRepository == nil {
+		s.botRepository = botRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.botRepository
+}
+
+func (s *serviceProvider) PollRepository(ctx context.Context) repository.PollRepository {
+	if s.pollRepository == nil {
+		s.pollRepository = pollRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.pollRepository
+}
+
+func (s *serviceProvider) ChatUpdateRepository(ctx context.Context) repository.ChatUpdateRepository {
+	if s.updateRepository == nil {
+		s.updateRepository = updateRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.updateRepository
+}
+
+// UpdateHub returns the hub of the subscribers to the live updates of the chats on this instance.
+func (s *serviceProvider) UpdateHub(_ context.Context) *broadcast.Hub {
+	if s.updateHub == nil {
+		s.updateHub = broadcast.NewHub(s.cfg.Updates.SubscriberBuffer)
+	}
+
+	return s.updateHub
+}
+
+// UpdateListener returns the listener publishing the live updates notified by every instance to the hub.
+func (s *serviceProvider) UpdateListener(ctx context.Context) *broadcast.Listener {
+	if s.updateListener == nil {
+		s.updateListener = broadcast.NewListener(s.cfg.Postgres.DSN(), s.UpdateHub(ctx), s.cfg.Updates)
+	}
+
+	return s.updateListener
+}
+
+func (s *serviceProvider) PresenceRepository(ctx context.Context) repository.PresenceRepository {
+	if s.presenceRepository == nil {
+		s.presenceRepository = presenceRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.presenceRepository
+}
+
+func (s *serviceProvider) UserRepository(ctx context.Context) repository.UserRepository {
+	if s.userRepository == nil {
+		s.userRepository = userRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.userRepository
+}
+
+func (s *serviceProvider) BlockRepository(ctx context.Context) repository.BlockRepository {
+	if s.blockRepository == nil {
+		s.blockRepository = blockRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.blockRepository
+}
+
+func (s *serviceProvider) MentionRepository(ctx context.Context) repository.MentionRepository {
+	if s.mentionRepository == nil {
+		s.mentionRepository = mentionRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.mentionRepository
+}
+
+func (s *serviceProvider) PinRepository(ctx context.Context) repository.PinRepository {
+	if s.pinRepository == nil {
+		s.pinRepository = pinRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.pinRepository
+}
+
+func (s *serviceProvider) NotificationRepository(ctx context.Context) repository.NotificationRepository {
+	if s.notificationRepository == nil {
+		s.notificationRepository = notificationRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.notificationRepository
+}
+
+// NotificationDispatcher returns the dispatcher of the digests of the notifications, nil if the notifications
+// are disabled. Enabling them without any channel is a configuration error.
+func (s *serviceProvider) NotificationDispatcher(ctx context.Context) *notification.Dispatcher {
+	if s.notificationDispatcher == nil && s.cfg.Notifications.Enabled {
+		var channels []notification.Channel
+
+		if s.cfg.Notifications.Email.Enabled {
+			channels = append(channels, notification.NewEmailChannel(s.cfg.Notifications.Email))
+		}
+
+		if s.cfg.Notifications.Push.Enabled {
+			channels = append(channels, notification.NewPushChannel(s.cfg.Notifications.Push))
+		}
+
+		if len(channels) == 0 {
+			logger.Fatal("notifications enabled without any channel")
+		}
+
+		s.notificationDispatcher = notification.NewDispatcher(
+			s.NotificationRepository(ctx),
+			s.TxManager(ctx),
+			channels,
+			s.cfg.Notifications,
+		)
+	}
+
+	return s.notificationDispatcher
+}
+
+func (s *serviceProvider) ScheduledMessageRepository(ctx context.Context) repository.ScheduledMessageRepository {
+	if s.scheduledMessageRepository == nil {
+		s.scheduledMessageRepository = scheduleRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.scheduledMessageRepository
+}
+
+// Scheduler returns the scheduler sending the due scheduled messages through the chat service.
+func (s *serviceProvider) Scheduler(ctx context.Context) *scheduler.Scheduler {
+	if s.scheduler == nil {
+		s.scheduler = scheduler.NewScheduler(
+			s.ScheduledMessageRepository(ctx),
+			s.TxManager(ctx),
+			s.ChatService(ctx),
+			s.cfg.Scheduler,
+		)
+	}
+
+	return s.scheduler
+}
+
+// UserResolver returns the resolver checking the participants of the new chats against the configured source.
+func (s *serviceProvider) UserResolver(_ context.Context) userresolver.UserResolver {
+	if s.userResolver == nil {
+		switch s.cfg.Users.Source {
+		case config.UsersSourceLocal:
+			s.userResolver = userresolver.NewLocalResolver()
+		case config.UsersSourceAuth:
+			conn, err := grpc.NewClient(
+				s.cfg.Users.Auth.Address,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+			if err != nil {
+				logger.Fatal("failed to create auth service client", slog.String("error", err.Error()))
+			}
+			closer.Add(conn.Close)
+
+			s.userResolver = userresolver.NewGRPCResolver(userV1.NewUserV1Client(conn), s.cfg.Users.Auth)
+		default:
+			logger.Fatal("invalid users source", slog.String("source", s.cfg.Users.Source))
+		}
+	}
+
+	return s.userResolver
+}
+
+// InstanceID returns the unique ID of this instance, holding its presence sessions and its leases.
+func (s *serviceProvider) InstanceID(_ context.Context) string {
+	if s.instanceID == "" {
+		instanceID, err := presence.NewInstanceID()
+		if err != nil {
+			logger.Fatal("failed to create instance id", slog.String("error", err.Error()))
+		}
+
+		s.instanceID = instanceID
+	}
+
+	return s.instanceID
+}
+
+// PresenceTracker returns the tracker of the presence of the users connected to this instance.
+func (s *serviceProvider) PresenceTracker(ctx context.Context) *presence.Tracker {
+	if s.presenceTracker == nil {
+		s.presenceTracker = presence.NewTracker(
+			s.PresenceRepository(ctx),
+			s.ChatUpdateRepository(ctx),
+			s.InstanceID(ctx),
+			s.cfg.Presence,
+		)
+	}
+
+	return s.presenceTracker
+}
+
+func (s *serviceProvider) Redactor(_ context.Context) *redact.Redactor {
+	if s.redactor == nil {
+		redactor, err := redact.New(s.cfg.Redaction)
+		if err != nil {
+			logger.Fatal("failed to create redactor", slog.String("error", err.Error()))
+		}
+
+		s.redactor = redactor
+	}
+
+	return s.redactor
+}
+
 func (s *serviceProvider) ChatService(ctx context.Context) service.ChatService {
 	if s.chatService == nil {
 		s.chatService = chatService.NewService(
 			s.ChatRepository(ctx),
-			s.LogRepository(ctx),
+			s.OutboxRepository(ctx),
+			s.ChatUpdateRepository(ctx),
+			s.BlockRepository(ctx),
+			s.MentionRepository(ctx),
 			s.TxManager(ctx),
+			s.UserResolver(ctx),
+			s.CommandService(ctx),
 		)
 	}
 
 	return s.chatService
 }
 
+func (s *serviceProvider) AuditService(ctx context.Context) service.AuditService {
+	if s.auditService == nil {
+		s.auditService = auditService.NewService(s.LogRepository(ctx))
+	}
+
+	return s.auditService
+}
+
+func (s *serviceProvider) WebhookService(ctx context.Context) service.WebhookService {
+	if s.webhookService == nil {
+		s.webhookService = webhookService.NewService(s.WebhookRepository(ctx), s.ChatService(ctx))
+	}
+
+	return s.webhookService
+}
+
+func (s *serviceProvider) BotService(ctx context.Context) service.BotService {
+	if s.botService == nil {
+		s.botService = botService.NewService(s.BotRepository(ctx))
+	}
+
+	return s.botService
+}
+
+func (s *serviceProvider) CommandService(ctx context.Context) service.CommandService {
+	if s.commandService == nil {
+		s.commandService = commandService.NewService(
+			s.ChatRepository(ctx),
+			s.BotRepository(ctx),
+			s.PollService(ctx),
+			s.cfg.Bots,
+		)
+	}
+
+	return s.commandService
+}
+
+func (s *serviceProvider) PollService(ctx context.Context) service.PollService {
+	if s.pollService == nil {
+		s.pollService = pollService.NewService(
+			s.ChatRepository(ctx),
+			s.PollRepository(ctx),
+			s.OutboxRepository(ctx),
+			s.ChatUpdateRepository(ctx),
+			s.TxManager(ctx),
+		)
+	}
+
+	return s.pollService
+}
+
+func (s *serviceProvider) SubscriptionService(ctx context.Context) service.SubscriptionService {
+	if s.subscriptionService == nil {
+		s.subscriptionService = subscriptionService.NewService(
+			s.UpdateHub(ctx),
+			s.ChatRepository(ctx),
+			s.BlockRepository(ctx),
+			s.cfg.Updates,
+		)
+	}
+
+	return s.subscriptionService
+}
+
+func (s *serviceProvider) EphemeralService(ctx context.Context) service.EphemeralService {
+	if s.ephemeralService == nil {
+		s.ephemeralService = ephemeralService.NewService(
+			s.ChatRepository(ctx),
+			s.ChatUpdateRepository(ctx),
+			s.UserRepository(ctx),
+			s.cfg.Ephemeral,
+		)
+	}
+
+	return s.ephemeralService
+}
+
+func (s *serviceProvider) PresenceService(ctx context.Context) service.PresenceService {
+	if s.presenceService == nil {
+		s.presenceService = presenceService.NewService(
+			s.PresenceTracker(ctx),
+			s.PresenceRepository(ctx),
+			s.cfg.Presence,
+		)
+	}
+
+	return s.presenceService
+}
+
+func (s *serviceProvider) UserService(ctx context.Context) service.UserService {
+	if s.userService == nil {
+		s.userService = userService.NewService(s.UserRepository(ctx))
+	}
+
+	return s.userService
+}
+
+func (s *serviceProvider) BlockService(ctx context.Context) service.BlockService {
+	if s.blockService == nil {
+		s.blockService = blockService.NewService(s.BlockRepository(ctx))
+	}
+
+	return s.blockService
+}
+
+func (s *serviceProvider) PinService(ctx context.Context) service.PinService {
+	if s.pinService == nil {
+		s.pinService = pinService.NewService(
+			s.PinRepository(ctx),
+			s.ChatUpdateRepository(ctx),
+			s.TxManager(ctx),
+		)
+	}
+
+	return s.pinService
+}
+
+func (s *serviceProvider) ScheduleService(ctx context.Context) service.ScheduleService {
+	if s.scheduleService == nil {
+		s.scheduleService = scheduleService.NewService(s.ScheduledMessageRepository(ctx), s.cfg.Scheduler)
+	}
+
+	return s.scheduleService
+}
+
 func (s *serviceProvider) ChatAPI(ctx context.Context) *chatAPI.GRPCHandlers {
 	if s.chatAPI == nil {
-		s.chatAPI = chatAPI.NewGRPCHandlers(s.ChatService(ctx))
+		s.chatAPI = chatAPI.NewGRPCHandlers(
+			s.ChatService(ctx),
+			s.AuditService(ctx),
+			s.WebhookService(ctx),
+			s.BotService(ctx),
+			s.PollService(ctx),
+			s.SubscriptionService(ctx),
+			s.EphemeralService(ctx),
+			s.PresenceService(ctx),
+			s.UserService(ctx),
+			s.BlockService(ctx),
+			s.PinService(ctx),
+			s.ScheduleService(ctx),
+		)
 	}
 
 	return s.chatAPI
 }
 
+func (s *serviceProvider) ChatConnectAPI(ctx context.Context) *chatConnectAPI.ConnectHandlers {
+	if s.chatConnectAPI == nil {
+		s.chatConnectAPI = chatConnectAPI.NewConnectHandlers(
+			s.ChatAPI(ctx),
+			interceptor.ChainUnary(s.UnaryInterceptors(ctx)...),
+			interceptor.ChainStream(s.StreamInterceptors(ctx)...),
+		)
+	}
+
+	return s.chatConnectAPI
+}
+
 func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 	if s.txManager == nil {
-		s.txManager = transaction.NewTransactionManager(s.DBClient(ctx).DB())
+		s.txManager = tracing.NewTxManager(
+			metric.NewTxManager(transaction.NewTransactionManager(s.DBClient(ctx).DB())),
+		)
 	}
 
 	return s.txManager
 }
+
+func (s *serviceProvider) HealthChecker(ctx context.Context) *health.Checker {
+	if s.healthChecker == nil {
+		s.healthChecker = health.NewChecker(
+			s.DBClient(ctx).DB(),
+			s.cfg.Health.CheckInterval,
+			s.cfg.Health.CheckTimeout,
+			pb.ChatV1_ServiceDesc.ServiceName,
+		)
+	}
+
+	return s.healthChecker
+}
+
+// UnaryInterceptors returns the interceptors applied to every unary call, whatever the transport.
+func (s *serviceProvider) UnaryInterceptors(ctx context.Context) []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		interceptor.TracingInterceptor,
+		interceptor.LoggerInterceptor,
+		interceptor.MetricsInterceptor,
+		interceptor.NewAuthInterceptor(s.cfg.Admin.Tokens),
+		interceptor.NewBotAuthInterceptor(s.BotService(ctx).Authenticate),
+		interceptor.NewAuditInterceptor(
+			s.LogRepository(ctx),
+			converter.ConvertRequestFromHandlerToAudit,
+			converter.ConvertResponseFromHandlerToAudit,
+		),
+		interceptor.NewAdminInterceptor(
+			chat_v1connect.ChatV1ListAuditLogProcedure,
+			chat_v1connect.ChatV1CreateWebhookProcedure,
+			chat_v1connect.ChatV1ListWebhooksProcedure,
+			chat_v1connect.ChatV1DeleteWebhookProcedure,
+			chat_v1connect.ChatV1CreateIncomingWebhookProcedure,
+			chat_v1connect.ChatV1ListIncomingWebhooksProcedure,
+			chat_v1connect.ChatV1DeleteIncomingWebhookProcedure,
+			chat_v1connect.ChatV1CreateBotProcedure,
+			chat_v1connect.ChatV1ListBotsProcedure,
+			chat_v1connect.ChatV1DeleteBotProcedure,
+			chat_v1connect.ChatV1SetPinPolicyProcedure,
+		),
+	}
+}
+
+// StreamInterceptors returns the interceptors applied to every streaming call.
+func (s *serviceProvider) StreamInterceptors(_ context.Context) []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		interceptor.TracingStreamInterceptor,
+		interceptor.LoggerStreamInterceptor,
+		interceptor.MetricsStreamInterceptor,
+	}
+}
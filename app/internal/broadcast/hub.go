@@ -0,0 +1,97 @@
+package broadcast
+
+import (
+	"sync"
+
+	"github.com/Prrromanssss/chat-server/internal/metric"
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+// Hub fans the live updates of the chats out to their subscribers on this instance.
+// Publishing never blocks: a subscriber whose buffer is full is dropped, its channel is closed.
+type Hub struct {
+	mu          sync.Mutex
+	bufferSize  int
+	closed      bool
+	subscribers map[int64]map[chan model.ChatUpdate]struct{}
+}
+
+// NewHub creates a new instance of Hub buffering bufferSize updates per subscriber.
+func NewHub(bufferSize int) *Hub {
+	return &Hub{
+		bufferSize:  bufferSize,
+		subscribers: make(map[int64]map[chan model.ChatUpdate]struct{}),
+	}
+}
+
+// Subscribe returns the channel of the updates of the chat and the function ending the subscription.
+// The channel is closed when the subscription ends, the subscriber is dropped or the hub is closed.
+func (h *Hub) Subscribe(chatID int64) (updates <-chan model.ChatUpdate, unsubscribe func()) {
+	ch := make(chan model.ChatUpdate, h.bufferSize)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(ch)
+		return ch, func() {}
+	}
+
+	if h.subscribers[chatID] == nil {
+		h.subscribers[chatID] = make(map[chan model.ChatUpdate]struct{})
+	}
+
+	h.subscribers[chatID][ch] = struct{}{}
+	metric.IncSubscribers()
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		h.remove(chatID, ch)
+	}
+}
+
+// Publish sends the update to the subscribers of its chat.
+func (h *Hub) Publish(update model.ChatUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers[update.ChatID] {
+		select {
+		case ch <- update:
+		default:
+			h.remove(update.ChatID, ch)
+			metric.IncDroppedSubscribers()
+		}
+	}
+}
+
+// Close ends all subscriptions and rejects the new ones, so that the streams end on shutdown.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+
+	for chatID, subscribers := range h.subscribers {
+		for ch := range subscribers {
+			h.remove(chatID, ch)
+		}
+	}
+}
+
+// remove closes the channel of the subscriber unless it is already removed. The mutex must be held.
+func (h *Hub) remove(chatID int64, ch chan model.ChatUpdate) {
+	if _, ok := h.subscribers[chatID][ch]; !ok {
+		return
+	}
+
+	delete(h.subscribers[chatID], ch)
+	if len(h.subscribers[chatID]) == 0 {
+		delete(h.subscribers, chatID)
+	}
+
+	close(ch)
+	metric.DecSubscribers()
+}
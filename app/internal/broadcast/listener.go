@@ -0,0 +1,85 @@
+package broadcast
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/repository/update"
+	"github.com/Prrromanssss/chat-server/internal/repository/update/converter"
+)
+
+// Listener receives the live updates of the chats notified by every instance and publishes them
+// to the subscribers on this instance. It holds a dedicated connection, as a pooled one cannot listen.
+type Listener struct {
+	dsn string
+	hub *Hub
+	cfg config.Updates
+}
+
+// NewListener creates a new instance of Listener connecting to the database with the provided DSN
+// and publishing to the hub.
+func NewListener(dsn string, hub *Hub, cfg config.Updates) *Listener {
+	return &Listener{
+		dsn: dsn,
+		hub: hub,
+		cfg: cfg,
+	}
+}
+
+// Run listens to the updates until the context is cancelled, reconnecting after a failure.
+func (l *Listener) Run(ctx context.Context) {
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		slog.Error("chat updates listener failed", slog.String("error", err.Error()))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(l.cfg.ReconnectInterval):
+		}
+	}
+}
+
+// listen connects to the database and publishes the notifications until the connection fails.
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, l.dsn)
+	if err != nil {
+		return errors.Wrap(err, "Cannot connect to database")
+	}
+
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_ = conn.Close(closeCtx)
+	}()
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{update.Channel}.Sanitize())
+	if err != nil {
+		return errors.Wrapf(err, "Cannot listen to channel %s", update.Channel)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return errors.Wrap(err, "Cannot receive notification")
+		}
+
+		chatUpdate, err := converter.ConvertNotificationFromRepoToService(notification.Payload)
+		if err != nil {
+			slog.Warn("malformed chat update", slog.String("error", err.Error()))
+			continue
+		}
+
+		l.hub.Publish(chatUpdate)
+	}
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/broadcast"
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+func TestHub(t *testing.T) {
+	t.Parallel()
+
+	update := func(chatID int64) model.ChatUpdate {
+		return model.ChatUpdate{ChatID: chatID, Type: model.UpdateTypePollUpdated, Payload: []byte(`{}`)}
+	}
+
+	t.Run("updates reach the subscribers of their chat", func(t *testing.T) {
+		t.Parallel()
+
+		hub := broadcast.NewHub(1)
+
+		first, unsubscribeFirst := hub.Subscribe(1)
+		defer unsubscribeFirst()
+
+		second, unsubscribeSecond := hub.Subscribe(1)
+		defer unsubscribeSecond()
+
+		other, unsubscribeOther := hub.Subscribe(2)
+		defer unsubscribeOther()
+
+		hub.Publish(update(1))
+
+		require.Equal(t, update(1), <-first)
+		require.Equal(t, update(1), <-second)
+		require.Empty(t, other)
+	})
+
+	t.Run("slow subscribers are dropped", func(t *testing.T) {
+		t.Parallel()
+
+		hub := broadcast.NewHub(1)
+
+		updates, unsubscribe := hub.Subscribe(1)
+		defer unsubscribe()
+
+		hub.Publish(update(1))
+		hub.Publish(update(1))
+
+		require.Equal(t, update(1), <-updates)
+
+		_, ok := <-updates
+		require.False(t, ok)
+	})
+
+	t.Run("unsubscribe closes the channel once", func(t *testing.T) {
+		t.Parallel()
+
+		hub := broadcast.NewHub(1)
+
+		updates, unsubscribe := hub.Subscribe(1)
+		unsubscribe()
+		unsubscribe()
+
+		hub.Publish(update(1))
+
+		_, ok := <-updates
+		require.False(t, ok)
+	})
+
+	t.Run("close ends the subscriptions and rejects new ones", func(t *testing.T) {
+		t.Parallel()
+
+		hub := broadcast.NewHub(1)
+
+		updates, unsubscribe := hub.Subscribe(1)
+		defer unsubscribe()
+
+		hub.Close()
+
+		_, ok := <-updates
+		require.False(t, ok)
+
+		updates, unsubscribe = hub.Subscribe(1)
+		defer unsubscribe()
+
+		_, ok = <-updates
+		require.False(t, ok)
+	})
+}
@@ -0,0 +1,61 @@
+package converter
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// ConvertBlockUserRequestFromHandlerToService converts a BlockUserRequest from the api layer
+// to BlockUserParams for the service layer. It fails without a blocked user.
+func ConvertBlockUserRequestFromHandlerToService(params *pb.BlockUserRequest) (model.BlockUserParams, error) {
+	if params.Email == "" {
+		return model.BlockUserParams{}, errors.New("email is required")
+	}
+
+	return model.BlockUserParams{
+		From:  params.From,
+		Email: params.Email,
+	}, nil
+}
+
+// ConvertUnblockUserRequestFromHandlerToService converts an UnblockUserRequest from the api layer
+// to UnblockUserParams for the service layer. It fails without a blocked user.
+func ConvertUnblockUserRequestFromHandlerToService(params *pb.UnblockUserRequest) (model.UnblockUserParams, error) {
+	if params.Email == "" {
+		return model.UnblockUserParams{}, errors.New("email is required")
+	}
+
+	return model.UnblockUserParams{
+		From:  params.From,
+		Email: params.Email,
+	}, nil
+}
+
+// ConvertListBlockedRequestFromHandlerToService converts a ListBlockedRequest from the api layer
+// to ListBlockedParams for the service layer.
+func ConvertListBlockedRequestFromHandlerToService(params *pb.ListBlockedRequest) model.ListBlockedParams {
+	return model.ListBlockedParams{
+		From: params.From,
+	}
+}
+
+// ConvertBlockedUsersFromServiceToHandler converts the blocked users from the service layer
+// to a ListBlockedResponse for the api layer.
+func ConvertBlockedUsersFromServiceToHandler(users []model.BlockedUser) *pb.ListBlockedResponse {
+	resp := &pb.ListBlockedResponse{
+		Users: make([]*pb.BlockedUser, len(users)),
+	}
+
+	for i, user := range users {
+		resp.Users[i] = &pb.BlockedUser{
+			Email:       user.Email,
+			DisplayName: user.DisplayName,
+			BlockedAt:   timestamppb.New(user.BlockedAt),
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,93 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// ConvertCreateBotRequestFromHandlerToService converts a CreateBotRequest from the api layer
+// to CreateBotParams for the service layer. It fails on a blank name or a name containing "@",
+// which is kept for the emails of the users, on an invalid command name and on commands without
+// a valid webhook URL.
+func ConvertCreateBotRequestFromHandlerToService(params *pb.CreateBotRequest) (model.CreateBotParams, error) {
+	name := strings.TrimSpace(params.Name)
+	if name == "" || strings.Contains(name, "@") {
+		return model.CreateBotParams{}, errors.New(`name is required and must not contain "@"`)
+	}
+
+	if params.WebhookUrl != "" {
+		err := validateWebhookURL(params.WebhookUrl)
+		if err != nil {
+			return model.CreateBotParams{}, err
+		}
+	} else if len(params.Commands) > 0 {
+		return model.CreateBotParams{}, errors.New("webhook url is required to handle commands")
+	}
+
+	commands := make([]model.BotCommand, len(params.Commands))
+	for i, command := range params.Commands {
+		if !model.IsCommandName(command.Name) {
+			return model.CreateBotParams{}, errors.Errorf("invalid command name %q", command.Name)
+		}
+
+		commands[i] = model.BotCommand{
+			Name:        command.Name,
+			Description: command.Description,
+		}
+	}
+
+	return model.CreateBotParams{
+		Name:       name,
+		WebhookURL: params.WebhookUrl,
+		Commands:   commands,
+	}, nil
+}
+
+// ConvertCreateBotResponseFromServiceToHandler converts a CreateBotResponse from the service layer
+// to a CreateBotResponse for the api layer.
+func ConvertCreateBotResponseFromServiceToHandler(params model.CreateBotResponse) *pb.CreateBotResponse {
+	return &pb.CreateBotResponse{
+		Id:            params.BotID,
+		Token:         params.Token,
+		SigningSecret: params.SigningSecret,
+	}
+}
+
+// ConvertBotsFromServiceToHandler converts bots from the service layer to a ListBotsResponse for the api layer.
+func ConvertBotsFromServiceToHandler(bots []model.Bot) *pb.ListBotsResponse {
+	result := make([]*pb.Bot, len(bots))
+	for i, bot := range bots {
+		commands := make([]*pb.BotCommand, len(bot.Commands))
+		for j, command := range bot.Commands {
+			commands[j] = &pb.BotCommand{
+				Name:        command.Name,
+				Description: command.Description,
+			}
+		}
+
+		result[i] = &pb.Bot{
+			Id:         bot.ID,
+			Name:       bot.Name,
+			WebhookUrl: bot.WebhookURL,
+			Commands:   commands,
+			CreatedAt:  timestamppb.New(bot.CreatedAt),
+		}
+	}
+
+	return &pb.ListBotsResponse{
+		Bots: result,
+	}
+}
+
+// ConvertDeleteBotRequestFromHandlerToService converts a DeleteBotRequest from the api layer
+// to DeleteBotParams for the service layer.
+func ConvertDeleteBotRequestFromHandlerToService(params *pb.DeleteBotRequest) model.DeleteBotParams {
+	return model.DeleteBotParams{
+		BotID: params.Id,
+	}
+}
@@ -0,0 +1,78 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// ConvertUpdateChatSettingsRequestFromHandlerToService converts an UpdateChatSettingsRequest from the api layer
+// to UpdateChatSettingsParams for the service layer. It fails on an unknown notification level.
+func ConvertUpdateChatSettingsRequestFromHandlerToService(
+	params *pb.UpdateChatSettingsRequest,
+) (model.UpdateChatSettingsParams, error) {
+	settings := model.UpdateChatSettingsParams{
+		ChatID:            params.ChatId,
+		From:              params.From,
+		NotificationLevel: params.NotificationLevel,
+		Pinned:            params.Pinned,
+		Archived:          params.Archived,
+	}
+
+	if params.MutedUntil != nil {
+		mutedUntil := params.MutedUntil.AsTime()
+		settings.MutedUntil = &mutedUntil
+	}
+
+	err := settings.Validate()
+	if err != nil {
+		return model.UpdateChatSettingsParams{}, err
+	}
+
+	return settings, nil
+}
+
+// ConvertChatSettingsFromServiceToHandler converts ChatSettings from the service layer to ChatSettings
+// for the api layer.
+func ConvertChatSettingsFromServiceToHandler(settings model.ChatSettings) *pb.ChatSettings {
+	resp := &pb.ChatSettings{
+		ChatId:            settings.ChatID,
+		NotificationLevel: settings.NotificationLevel,
+		Pinned:            settings.Pinned,
+		Archived:          settings.Archived,
+	}
+
+	if settings.MutedUntil != nil {
+		resp.MutedUntil = timestamppb.New(*settings.MutedUntil)
+	}
+
+	return resp
+}
+
+// ConvertListChatsRequestFromHandlerToService converts a ListChatsRequest from the api layer
+// to ListChatsParams for the service layer.
+func ConvertListChatsRequestFromHandlerToService(params *pb.ListChatsRequest) model.ListChatsParams {
+	return model.ListChatsParams{
+		From:            params.From,
+		IncludeArchived: params.IncludeArchived,
+	}
+}
+
+// ConvertUserChatsFromServiceToHandler converts the chats of a participant from the service layer
+// to a ListChatsResponse for the api layer.
+func ConvertUserChatsFromServiceToHandler(chats []model.UserChat) *pb.ListChatsResponse {
+	resp := &pb.ListChatsResponse{
+		Chats: make([]*pb.Chat, len(chats)),
+	}
+
+	for i, chat := range chats {
+		resp.Chats[i] = &pb.Chat{
+			Id:           chat.ChatID,
+			Participants: chat.Participants,
+			Settings:     ConvertChatSettingsFromServiceToHandler(chat.Settings),
+		}
+	}
+
+	return resp
+}
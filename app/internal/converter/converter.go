@@ -1,6 +1,18 @@
 package converter
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/Prrromanssss/chat-server/internal/model"
 	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
 )
@@ -29,8 +41,271 @@ func ConvertDeleteRequestFromHandlerToService(params *pb.DeleteRequest) model.De
 // ConvertSendMessageRequestFromHandlerToService converts a SendMessageRequest from the api layer to SendMessageParams for the service layer.
 func ConvertSendMessageRequestFromHandlerToService(params *pb.SendMessageRequest) model.SendMessageParams {
 	return model.SendMessageParams{
+		ChatID: params.ChatId,
 		From:   params.From,
 		Text:   params.Text,
 		SentAt: params.Timestamp.AsTime(),
 	}
 }
+
+// ConvertListMessagesRequestFromHandlerToService converts a ListMessagesRequest from the api layer
+// to ListMessagesParams for the service layer. It fails on a page out of range.
+func ConvertListMessagesRequestFromHandlerToService(params *pb.ListMessagesRequest) (model.ListMessagesParams, error) {
+	if params.BeforeId < 0 {
+		return model.ListMessagesParams{}, errors.New("before_id must not be negative")
+	}
+
+	if params.Limit < 0 || params.Limit > model.ListMessagesMaxLimit {
+		return model.ListMessagesParams{}, errors.Errorf("limit must be between 0 and %d", model.ListMessagesMaxLimit)
+	}
+
+	return model.ListMessagesParams{
+		ChatID:   params.ChatId,
+		From:     params.From,
+		BeforeID: params.BeforeId,
+		Limit:    params.Limit,
+	}, nil
+}
+
+// ConvertMessagesFromServiceToHandler converts the messages of the history from the service layer
+// to a ListMessagesResponse for the api layer.
+func ConvertMessagesFromServiceToHandler(messages []model.Message) *pb.ListMessagesResponse {
+	resp := &pb.ListMessagesResponse{
+		Messages: make([]*pb.Message, len(messages)),
+	}
+
+	for i, message := range messages {
+		resp.Messages[i] = &pb.Message{
+			Id:     message.ID,
+			ChatId: message.ChatID,
+			From:   message.From,
+			Text:   message.Text,
+			Type:   message.Type,
+			SentAt: timestamppb.New(message.SentAt),
+		}
+	}
+
+	return resp
+}
+
+// ConvertListAuditLogRequestFromHandlerToService converts a ListAuditLogRequest from the api layer
+// to ListAuditLogParams for the service layer. It fails if the page token is malformed.
+func ConvertListAuditLogRequestFromHandlerToService(params *pb.ListAuditLogRequest) (model.ListAuditLogParams, error) {
+	cursor, err := decodePageToken(params.PageToken)
+	if err != nil {
+		return model.ListAuditLogParams{}, err
+	}
+
+	var from, to time.Time
+	if params.From != nil {
+		from = params.From.AsTime()
+	}
+
+	if params.To != nil {
+		to = params.To.AsTime()
+	}
+
+	return model.ListAuditLogParams{
+		ActionTypes: params.ActionTypes,
+		From:        from,
+		To:          to,
+		ChatID:      params.ChatId,
+		Sender:      params.Sender,
+		RequestData: params.RequestData.AsMap(),
+		PageSize:    int(params.PageSize),
+		Cursor:      cursor,
+	}, nil
+}
+
+// ConvertListAuditLogResponseFromServiceToHandler converts a ListAuditLogResponse from the service layer
+// to a ListAuditLogResponse for the api layer.
+func ConvertListAuditLogResponseFromServiceToHandler(params model.ListAuditLogResponse) (*pb.ListAuditLogResponse, error) {
+	entries := make([]*pb.AuditLogEntry, len(params.Entries))
+
+	for i, entry := range params.Entries {
+		requestData, err := convertJSONToStruct(entry.RequestData)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Cannot convert request data of audit log entry(id: %d)", entry.ID)
+		}
+
+		responseData, err := convertJSONToStruct(entry.ResponseData)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Cannot convert response data of audit log entry(id: %d)", entry.ID)
+		}
+
+		entries[i] = &pb.AuditLogEntry{
+			Id:           entry.ID,
+			ActionType:   entry.ActionType,
+			RequestData:  requestData,
+			ResponseData: responseData,
+			Timestamp:    timestamppb.New(entry.Timestamp),
+			Caller:       entry.Caller,
+			Peer:         entry.Peer,
+			RequestId:    entry.RequestID,
+			StatusCode:   entry.StatusCode,
+			Duration:     durationpb.New(entry.Duration),
+		}
+	}
+
+	return &pb.ListAuditLogResponse{
+		Entries:       entries,
+		NextPageToken: encodePageToken(params.NextCursor),
+	}, nil
+}
+
+// ConvertRequestFromHandlerToAudit converts a request of the api layer to the data stored in the audit log:
+// its populated fields keyed by their names in the api, without the credentials they may carry.
+// The sensitive fields are redacted by the log repository. The switch overrides the requests whose fields
+// are not named as in the other requests.
+func ConvertRequestFromHandlerToAudit(msg interface{}) interface{} {
+	switch msg := msg.(type) {
+	case *pb.DeleteRequest:
+		// The chat is keyed by chat_id, as in the other requests, for the chat filter of the audit log.
+		return ConvertDeleteRequestFromHandlerToService(msg)
+	case proto.Message:
+		return convertProtoToAudit(msg.ProtoReflect())
+	default:
+		return nil
+	}
+}
+
+// ConvertResponseFromHandlerToAudit converts a response of the api layer to the data stored in the audit log.
+// Only the ids of the created entities are stored, the other responses, such as pages of messages
+// or of the audit log, are not.
+func ConvertResponseFromHandlerToAudit(msg interface{}) interface{} {
+	switch msg := msg.(type) {
+	case *pb.CreateResponse:
+		return model.CreateChatResponse{ChatID: msg.Id}
+	case *pb.CreateWebhookResponse:
+		return model.CreateWebhookResponse{WebhookID: msg.Id}
+	case *pb.CreateIncomingWebhookResponse:
+		// The token is left out, as it authenticates the posts.
+		return model.CreateIncomingWebhookResponse{IncomingWebhookID: msg.Id}
+	case *pb.CreateBotResponse:
+		// The token and the signing secret are left out, as they authenticate the bot.
+		return model.CreateBotResponse{BotID: msg.Id}
+	case *pb.CreatePollResponse:
+		return model.CreatePollResponse{PollID: msg.Id, MessageID: msg.MessageId}
+	case *pb.ScheduleMessageResponse:
+		return model.ScheduledMessage{ID: msg.Id}
+	default:
+		return nil
+	}
+}
+
+// auditOmittedFields are the fields left out of the audit log, as they may authenticate their holder
+// or embed credentials of an endpoint.
+var auditOmittedFields = map[protoreflect.Name]bool{
+	"url":         true,
+	"webhook_url": true,
+	"token":       true,
+	"secret":      true,
+}
+
+// convertProtoToAudit converts the populated fields of a message to a map keyed by their names.
+func convertProtoToAudit(msg protoreflect.Message) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if auditOmittedFields[fd.Name()] {
+			return true
+		}
+
+		switch {
+		case fd.IsList():
+			list := value.List()
+			items := make([]interface{}, list.Len())
+			for i := range items {
+				items[i] = convertProtoValueToAudit(fd, list.Get(i))
+			}
+
+			data[string(fd.Name())] = items
+		case fd.IsMap():
+			items := map[string]interface{}{}
+			value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				items[key.String()] = convertProtoValueToAudit(fd.MapValue(), value)
+				return true
+			})
+
+			data[string(fd.Name())] = items
+		default:
+			data[string(fd.Name())] = convertProtoValueToAudit(fd, value)
+		}
+
+		return true
+	})
+
+	return data
+}
+
+// convertProtoValueToAudit converts a single value of a field, the well-known types to their Go equivalent.
+func convertProtoValueToAudit(fd protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+
+		return int32(value.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch msg := value.Message().Interface().(type) {
+		case *timestamppb.Timestamp:
+			return msg.AsTime()
+		case *durationpb.Duration:
+			return msg.AsDuration().String()
+		case *structpb.Struct:
+			return msg.AsMap()
+		default:
+			return convertProtoToAudit(value.Message())
+		}
+	default:
+		return value.Interface()
+	}
+}
+
+// convertJSONToStruct converts a JSON object to a protobuf Struct, nil for a missing value.
+func convertJSONToStruct(data json.RawMessage) (*structpb.Struct, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return structpb.NewStruct(fields)
+}
+
+// encodePageToken encodes the cursor as an opaque page token, empty for a nil cursor.
+func encodePageToken(cursor *model.AuditLogCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	token := fmt.Sprintf("%d:%d", cursor.Timestamp.UnixMicro(), cursor.ID)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+// decodePageToken decodes a page token produced by encodePageToken, nil for an empty token.
+func decodePageToken(token string) (*model.AuditLogCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid page token")
+	}
+
+	var micros, id int64
+	if _, err := fmt.Sscanf(string(data), "%d:%d", &micros, &id); err != nil {
+		return nil, errors.Wrap(err, "invalid page token")
+	}
+
+	return &model.AuditLogCursor{
+		Timestamp: time.UnixMicro(micros).UTC(),
+		ID:        id,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package converter
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// ConvertSendTypingEventRequestFromHandlerToService converts a SendTypingEventRequest from the api layer
+// to SendTypingEventParams for the service layer. It fails without a chat or a sender.
+func ConvertSendTypingEventRequestFromHandlerToService(
+	params *pb.SendTypingEventRequest,
+) (model.SendTypingEventParams, error) {
+	if params.ChatId <= 0 || params.From == "" {
+		return model.SendTypingEventParams{}, errors.New("chat_id and from are required")
+	}
+
+	return model.SendTypingEventParams{
+		ChatID: params.ChatId,
+		From:   params.From,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package converter
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// ConvertListMentionsRequestFromHandlerToService converts a ListMentionsRequest from the api layer
+// to ListMentionsParams for the service layer. It fails on a page out of range.
+func ConvertListMentionsRequestFromHandlerToService(params *pb.ListMentionsRequest) (model.ListMentionsParams, error) {
+	if params.BeforeId < 0 {
+		return model.ListMentionsParams{}, errors.New("before_id must not be negative")
+	}
+
+	if params.Limit < 0 || params.Limit > model.ListMentionsMaxLimit {
+		return model.ListMentionsParams{}, errors.Errorf("limit must be between 0 and %d", model.ListMentionsMaxLimit)
+	}
+
+	return model.ListMentionsParams{
+		Email:    params.Email,
+		BeforeID: params.BeforeId,
+		Limit:    params.Limit,
+	}, nil
+}
+
+// ConvertMentionsFromServiceToHandler converts the mentions of a user from the service layer
+// to a ListMentionsResponse for the api layer.
+func ConvertMentionsFromServiceToHandler(mentions []model.Mention) *pb.ListMentionsResponse {
+	resp := &pb.ListMentionsResponse{
+		Mentions: make([]*pb.Mention, len(mentions)),
+	}
+
+	for i, mention := range mentions {
+		resp.Mentions[i] = &pb.Mention{
+			Id:        mention.ID,
+			MessageId: mention.MessageID,
+			ChatId:    mention.ChatID,
+			From:      mention.From,
+			Text:      mention.Text,
+			SentAt:    timestamppb.New(mention.SentAt),
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,87 @@
+package converter
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// ConvertPinMessageRequestFromHandlerToService converts a PinMessageRequest from the api layer
+// to PinMessageParams for the service layer. It fails without a chat or a message.
+func ConvertPinMessageRequestFromHandlerToService(params *pb.PinMessageRequest) (model.PinMessageParams, error) {
+	if params.ChatId <= 0 || params.MessageId <= 0 {
+		return model.PinMessageParams{}, errors.New("chat_id and message_id are required")
+	}
+
+	return model.PinMessageParams{
+		ChatID:    params.ChatId,
+		MessageID: params.MessageId,
+		From:      params.From,
+	}, nil
+}
+
+// ConvertUnpinMessageRequestFromHandlerToService converts an UnpinMessageRequest from the api layer
+// to UnpinMessageParams for the service layer. It fails without a chat or a message.
+func ConvertUnpinMessageRequestFromHandlerToService(params *pb.UnpinMessageRequest) (model.UnpinMessageParams, error) {
+	if params.ChatId <= 0 || params.MessageId <= 0 {
+		return model.UnpinMessageParams{}, errors.New("chat_id and message_id are required")
+	}
+
+	return model.UnpinMessageParams{
+		ChatID:    params.ChatId,
+		MessageID: params.MessageId,
+		From:      params.From,
+	}, nil
+}
+
+// ConvertListPinnedMessagesRequestFromHandlerToService converts a ListPinnedMessagesRequest from the api layer
+// to ListPinnedMessagesParams for the service layer.
+func ConvertListPinnedMessagesRequestFromHandlerToService(
+	params *pb.ListPinnedMessagesRequest,
+) model.ListPinnedMessagesParams {
+	return model.ListPinnedMessagesParams{
+		ChatID: params.ChatId,
+	}
+}
+
+// ConvertSetPinPolicyRequestFromHandlerToService converts a SetPinPolicyRequest from the api layer
+// to SetPinPolicyParams for the service layer.
+func ConvertSetPinPolicyRequestFromHandlerToService(params *pb.SetPinPolicyRequest) model.SetPinPolicyParams {
+	return model.SetPinPolicyParams{
+		ChatID: params.ChatId,
+		Policy: params.Policy,
+	}
+}
+
+// ConvertPinnedMessageFromServiceToHandler converts a pinned message from the service layer
+// to the api layer format.
+func ConvertPinnedMessageFromServiceToHandler(pinned model.PinnedMessage) *pb.PinnedMessage {
+	return &pb.PinnedMessage{
+		Message: &pb.Message{
+			Id:     pinned.MessageID,
+			ChatId: pinned.ChatID,
+			From:   pinned.From,
+			Text:   pinned.Text,
+			Type:   pinned.Type,
+			SentAt: timestamppb.New(pinned.SentAt),
+		},
+		PinnedBy: pinned.PinnedBy,
+		PinnedAt: timestamppb.New(pinned.PinnedAt),
+	}
+}
+
+// ConvertPinnedMessagesFromServiceToHandler converts the pinned messages of a chat from the service layer
+// to a ListPinnedMessagesResponse for the api layer.
+func ConvertPinnedMessagesFromServiceToHandler(pinned []model.PinnedMessage) *pb.ListPinnedMessagesResponse {
+	resp := &pb.ListPinnedMessagesResponse{
+		Messages: make([]*pb.PinnedMessage, len(pinned)),
+	}
+
+	for i, pin := range pinned {
+		resp.Messages[i] = ConvertPinnedMessageFromServiceToHandler(pin)
+	}
+
+	return resp
+}
@@ -0,0 +1,152 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// ConvertCreatePollRequestFromHandlerToService converts a CreatePollRequest from the api layer
+// to CreatePollParams for the service layer. It fails on a poll out of the limits of the polls
+// and on a closing time in the past.
+func ConvertCreatePollRequestFromHandlerToService(params *pb.CreatePollRequest) (model.CreatePollParams, error) {
+	poll := model.CreatePollParams{
+		ChatID:      params.ChatId,
+		From:        params.From,
+		Question:    params.Question,
+		Options:     params.Options,
+		MultiChoice: params.MultiChoice,
+	}
+
+	err := poll.Validate()
+	if err != nil {
+		return model.CreatePollParams{}, err
+	}
+
+	if params.ClosesAt != nil {
+		poll.ClosesAt = params.ClosesAt.AsTime()
+
+		if !poll.ClosesAt.After(time.Now()) {
+			return model.CreatePollParams{}, errors.New("closes_at must be in the future")
+		}
+	}
+
+	return poll, nil
+}
+
+// ConvertCreatePollResponseFromServiceToHandler converts a CreatePollResponse from the service layer
+// to a CreatePollResponse for the api layer.
+func ConvertCreatePollResponseFromServiceToHandler(params model.CreatePollResponse) *pb.CreatePollResponse {
+	return &pb.CreatePollResponse{
+		Id:        params.PollID,
+		MessageId: params.MessageID,
+	}
+}
+
+// ConvertVoteRequestFromHandlerToService converts a VoteRequest from the api layer to VoteParams
+// for the service layer. It fails without options or with an option chosen twice.
+func ConvertVoteRequestFromHandlerToService(params *pb.VoteRequest) (model.VoteParams, error) {
+	if len(params.OptionIds) == 0 {
+		return model.VoteParams{}, errors.New("at least one option is required")
+	}
+
+	seen := make(map[int64]struct{}, len(params.OptionIds))
+	for _, optionID := range params.OptionIds {
+		if _, ok := seen[optionID]; ok {
+			return model.VoteParams{}, errors.Errorf("option %d is chosen twice", optionID)
+		}
+
+		seen[optionID] = struct{}{}
+	}
+
+	return model.VoteParams{
+		PollID:    params.PollId,
+		From:      params.From,
+		OptionIDs: params.OptionIds,
+	}, nil
+}
+
+// ConvertClosePollRequestFromHandlerToService converts a ClosePollRequest from the api layer
+// to ClosePollParams for the service layer.
+func ConvertClosePollRequestFromHandlerToService(params *pb.ClosePollRequest) model.ClosePollParams {
+	return model.ClosePollParams{
+		PollID: params.PollId,
+		From:   params.From,
+	}
+}
+
+// ConvertGetPollRequestFromHandlerToService converts a GetPollRequest from the api layer
+// to GetPollParams for the service layer.
+func ConvertGetPollRequestFromHandlerToService(params *pb.GetPollRequest) model.GetPollParams {
+	return model.GetPollParams{
+		PollID: params.PollId,
+	}
+}
+
+// ConvertPollFromServiceToHandler converts a Poll from the service layer to a Poll for the api layer.
+func ConvertPollFromServiceToHandler(poll model.Poll) *pb.Poll {
+	options := make([]*pb.PollOption, len(poll.Options))
+	for i, option := range poll.Options {
+		options[i] = &pb.PollOption{
+			Id:    option.ID,
+			Text:  option.Text,
+			Votes: option.Votes,
+		}
+	}
+
+	resp := &pb.Poll{
+		Id:                   poll.ID,
+		ChatId:               poll.ChatID,
+		MessageId:            poll.MessageID,
+		CreatedBy:            poll.CreatedBy,
+		CreatedByDisplayName: poll.CreatedByDisplayName,
+		Question:             poll.Question,
+		MultiChoice:          poll.MultiChoice,
+		Options:              options,
+		Voters:               poll.Voters,
+		Closed:               poll.IsClosed(time.Now()),
+		CreatedAt:            timestamppb.New(poll.CreatedAt),
+	}
+
+	if poll.ClosesAt != nil {
+		resp.ClosesAt = timestamppb.New(*poll.ClosesAt)
+	}
+
+	return resp
+}
+
+// ConvertSubscribeRequestFromHandlerToService converts a SubscribeRequest from the api layer
+// to SubscribeParams for the service layer.
+func ConvertSubscribeRequestFromHandlerToService(params *pb.SubscribeRequest) model.SubscribeParams {
+	return model.SubscribeParams{
+		ChatID: params.ChatId,
+		From:   params.From,
+	}
+}
+
+// ConvertChatUpdateFromServiceToHandler converts a ChatUpdate from the service layer to a ChatUpdate
+// for the api layer. It fails if the payload is not a JSON object.
+func ConvertChatUpdateFromServiceToHandler(update model.ChatUpdate) (*pb.ChatUpdate, error) {
+	payload, err := convertJSONToStruct(update.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot convert payload of chat update(type: %s)", update.Type)
+	}
+
+	resp := &pb.ChatUpdate{
+		ChatId:    update.ChatID,
+		Type:      update.Type,
+		Sender:    update.Sender,
+		Payload:   payload,
+		CreatedAt: timestamppb.New(update.CreatedAt),
+	}
+
+	if update.ExpiresAt != nil {
+		resp.ExpiresAt = timestamppb.New(*update.ExpiresAt)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,59 @@
+package converter
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// maxPresenceEmails is the maximum number of users whose presence is requested at once.
+const maxPresenceEmails = 100
+
+// ConvertHeartbeatRequestFromHandlerToService converts a HeartbeatRequest from the api layer
+// to HeartbeatParams for the service layer. It fails without a user.
+func ConvertHeartbeatRequestFromHandlerToService(params *pb.HeartbeatRequest) (model.HeartbeatParams, error) {
+	if params.From == "" {
+		return model.HeartbeatParams{}, errors.New("from is required")
+	}
+
+	return model.HeartbeatParams{
+		Email: params.From,
+		Away:  params.Away,
+	}, nil
+}
+
+// ConvertGetPresenceRequestFromHandlerToService converts a GetPresenceRequest from the api layer
+// to GetPresenceParams for the service layer. It fails without emails or with too many of them.
+func ConvertGetPresenceRequestFromHandlerToService(params *pb.GetPresenceRequest) (model.GetPresenceParams, error) {
+	if len(params.Emails) == 0 || len(params.Emails) > maxPresenceEmails {
+		return model.GetPresenceParams{}, errors.Errorf("between 1 and %d emails are required", maxPresenceEmails)
+	}
+
+	return model.GetPresenceParams{
+		Emails: params.Emails,
+	}, nil
+}
+
+// ConvertPresencesFromServiceToHandler converts the presences from the service layer
+// to a GetPresenceResponse for the api layer.
+func ConvertPresencesFromServiceToHandler(presences []model.Presence) *pb.GetPresenceResponse {
+	resp := &pb.GetPresenceResponse{
+		Presences: make([]*pb.Presence, len(presences)),
+	}
+
+	for i, presence := range presences {
+		resp.Presences[i] = &pb.Presence{
+			Email:       presence.Email,
+			DisplayName: presence.DisplayName,
+			Status:      presence.Status,
+		}
+
+		if presence.LastSeenAt != nil {
+			resp.Presences[i].LastSeenAt = timestamppb.New(*presence.LastSeenAt)
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,72 @@
+package converter
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// ConvertScheduleMessageRequestFromHandlerToService converts a ScheduleMessageRequest from the api layer
+// to ScheduleMessageParams for the service layer. It fails without a chat, a text or a send time.
+func ConvertScheduleMessageRequestFromHandlerToService(
+	params *pb.ScheduleMessageRequest,
+) (model.ScheduleMessageParams, error) {
+	if params.ChatId <= 0 || params.Text == "" || params.SendAt == nil {
+		return model.ScheduleMessageParams{}, errors.New("chat_id, text and send_at are required")
+	}
+
+	return model.ScheduleMessageParams{
+		ChatID: params.ChatId,
+		From:   params.From,
+		Text:   params.Text,
+		SendAt: params.SendAt.AsTime(),
+	}, nil
+}
+
+// ConvertListScheduledMessagesRequestFromHandlerToService converts a ListScheduledMessagesRequest
+// from the api layer to ListScheduledMessagesParams for the service layer.
+func ConvertListScheduledMessagesRequestFromHandlerToService(
+	params *pb.ListScheduledMessagesRequest,
+) model.ListScheduledMessagesParams {
+	return model.ListScheduledMessagesParams{
+		From:   params.From,
+		ChatID: params.ChatId,
+	}
+}
+
+// ConvertCancelScheduledMessageRequestFromHandlerToService converts a CancelScheduledMessageRequest
+// from the api layer to CancelScheduledMessageParams for the service layer.
+func ConvertCancelScheduledMessageRequestFromHandlerToService(
+	params *pb.CancelScheduledMessageRequest,
+) model.CancelScheduledMessageParams {
+	return model.CancelScheduledMessageParams{
+		ScheduledMessageID: params.Id,
+		From:               params.From,
+	}
+}
+
+// ConvertScheduledMessagesFromServiceToHandler converts the scheduled messages from the service layer
+// to a ListScheduledMessagesResponse for the api layer.
+func ConvertScheduledMessagesFromServiceToHandler(
+	messages []model.ScheduledMessage,
+) *pb.ListScheduledMessagesResponse {
+	resp := &pb.ListScheduledMessagesResponse{
+		Messages: make([]*pb.ScheduledMessage, len(messages)),
+	}
+
+	for i, message := range messages {
+		resp.Messages[i] = &pb.ScheduledMessage{
+			Id:        message.ID,
+			ChatId:    message.ChatID,
+			From:      message.From,
+			Text:      message.Text,
+			SendAt:    timestamppb.New(message.SendAt),
+			Attempts:  int32(message.Attempts),
+			CreatedAt: timestamppb.New(message.CreatedAt),
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,95 @@
+package converter
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// maxSearchQueryLength is the maximum length of the prefix the users are searched by.
+const maxSearchQueryLength = 64
+
+// ConvertGetUserRequestFromHandlerToService converts a GetUserRequest from the api layer
+// to GetUserParams for the service layer.
+func ConvertGetUserRequestFromHandlerToService(params *pb.GetUserRequest) model.GetUserParams {
+	return model.GetUserParams{
+		Email: params.Email,
+	}
+}
+
+// ConvertUpdateProfileRequestFromHandlerToService converts an UpdateProfileRequest from the api layer
+// to UpdateProfileParams for the service layer. It fails without a user and on a profile out of the limits
+// of the profiles.
+func ConvertUpdateProfileRequestFromHandlerToService(
+	params *pb.UpdateProfileRequest,
+) (model.UpdateProfileParams, error) {
+	if params.Email == "" {
+		return model.UpdateProfileParams{}, errors.New("email is required")
+	}
+
+	profile := model.UpdateProfileParams{
+		Email:       params.Email,
+		DisplayName: params.DisplayName,
+		AvatarURL:   params.AvatarUrl,
+		StatusText:  params.StatusText,
+	}
+
+	err := profile.Validate()
+	if err != nil {
+		return model.UpdateProfileParams{}, err
+	}
+
+	return profile, nil
+}
+
+// ConvertSearchUsersRequestFromHandlerToService converts a SearchUsersRequest from the api layer
+// to SearchUsersParams for the service layer. It fails on an empty or too long query and on a limit
+// out of range.
+func ConvertSearchUsersRequestFromHandlerToService(params *pb.SearchUsersRequest) (model.SearchUsersParams, error) {
+	query := strings.TrimSpace(params.Query)
+	if query == "" || utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return model.SearchUsersParams{}, errors.Errorf(
+			"query is required and must be at most %d characters", maxSearchQueryLength,
+		)
+	}
+
+	if params.Limit < 0 || params.Limit > model.SearchUsersMaxLimit {
+		return model.SearchUsersParams{}, errors.Errorf("limit must be between 0 and %d", model.SearchUsersMaxLimit)
+	}
+
+	return model.SearchUsersParams{
+		Query: query,
+		Limit: params.Limit,
+	}, nil
+}
+
+// ConvertUserFromServiceToHandler converts a User from the service layer to a User for the api layer.
+func ConvertUserFromServiceToHandler(user model.User) *pb.User {
+	return &pb.User{
+		Id:          user.ID,
+		Email:       user.Email,
+		DisplayName: user.DisplayName,
+		AvatarUrl:   user.AvatarURL,
+		StatusText:  user.StatusText,
+		CreatedAt:   timestamppb.New(user.CreatedAt),
+	}
+}
+
+// ConvertUsersFromServiceToHandler converts the users found by the service layer
+// to a SearchUsersResponse for the api layer.
+func ConvertUsersFromServiceToHandler(users []model.User) *pb.SearchUsersResponse {
+	resp := &pb.SearchUsersResponse{
+		Users: make([]*pb.User, len(users)),
+	}
+
+	for i, user := range users {
+		resp.Users[i] = ConvertUserFromServiceToHandler(user)
+	}
+
+	return resp
+}
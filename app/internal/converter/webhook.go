@@ -0,0 +1,159 @@
+package converter
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+// ConvertCreateWebhookRequestFromHandlerToService converts a CreateWebhookRequest from the api layer
+// to CreateWebhookParams for the service layer. It fails unless the URL is an absolute http or https URL
+// and the event types are known.
+func ConvertCreateWebhookRequestFromHandlerToService(params *pb.CreateWebhookRequest) (model.CreateWebhookParams, error) {
+	err := validateWebhookURL(params.Url)
+	if err != nil {
+		return model.CreateWebhookParams{}, err
+	}
+
+	for _, eventType := range params.EventTypes {
+		if !model.IsEventType(eventType) {
+			return model.CreateWebhookParams{}, errors.Errorf("unknown event type %q", eventType)
+		}
+	}
+
+	return model.CreateWebhookParams{
+		URL:        params.Url,
+		ChatID:     params.ChatId,
+		EventTypes: params.EventTypes,
+	}, nil
+}
+
+// validateWebhookURL checks that the URL is an absolute http or https URL.
+func validateWebhookURL(rawURL string) error {
+	endpoint, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrap(err, "invalid url")
+	}
+
+	if (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return errors.New("invalid url: an absolute http or https url is required")
+	}
+
+	return nil
+}
+
+// ConvertCreateWebhookResponseFromServiceToHandler converts a CreateWebhookResponse from the service layer
+// to a CreateWebhookResponse for the api layer.
+func ConvertCreateWebhookResponseFromServiceToHandler(params model.CreateWebhookResponse) *pb.CreateWebhookResponse {
+	return &pb.CreateWebhookResponse{
+		Id:     params.WebhookID,
+		Secret: params.Secret,
+	}
+}
+
+// ConvertListWebhooksRequestFromHandlerToService converts a ListWebhooksRequest from the api layer
+// to ListWebhooksParams for the service layer.
+func ConvertListWebhooksRequestFromHandlerToService(params *pb.ListWebhooksRequest) model.ListWebhooksParams {
+	return model.ListWebhooksParams{
+		ChatID: params.ChatId,
+	}
+}
+
+// ConvertWebhooksFromServiceToHandler converts webhooks from the service layer
+// to a ListWebhooksResponse for the api layer.
+func ConvertWebhooksFromServiceToHandler(webhooks []model.Webhook) *pb.ListWebhooksResponse {
+	result := make([]*pb.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		result[i] = &pb.Webhook{
+			Id:                  webhook.ID,
+			Url:                 webhook.URL,
+			ChatId:              webhook.ChatID,
+			EventTypes:          webhook.EventTypes,
+			Disabled:            webhook.Disabled,
+			ConsecutiveFailures: int32(webhook.ConsecutiveFailures),
+			CreatedAt:           timestamppb.New(webhook.CreatedAt),
+		}
+	}
+
+	return &pb.ListWebhooksResponse{
+		Webhooks: result,
+	}
+}
+
+// ConvertDeleteWebhookRequestFromHandlerToService converts a DeleteWebhookRequest from the api layer
+// to DeleteWebhookParams for the service layer.
+func ConvertDeleteWebhookRequestFromHandlerToService(params *pb.DeleteWebhookRequest) model.DeleteWebhookParams {
+	return model.DeleteWebhookParams{
+		WebhookID: params.Id,
+	}
+}
+
+// ConvertCreateIncomingWebhookRequestFromHandlerToService converts a CreateIncomingWebhookRequest from the api layer
+// to CreateIncomingWebhookParams for the service layer. It fails on a blank name.
+func ConvertCreateIncomingWebhookRequestFromHandlerToService(
+	params *pb.CreateIncomingWebhookRequest,
+) (model.CreateIncomingWebhookParams, error) {
+	name := strings.TrimSpace(params.Name)
+	if name == "" {
+		return model.CreateIncomingWebhookParams{}, errors.New("name is required")
+	}
+
+	return model.CreateIncomingWebhookParams{
+		ChatID: params.ChatId,
+		Name:   name,
+	}, nil
+}
+
+// ConvertCreateIncomingWebhookResponseFromServiceToHandler converts a CreateIncomingWebhookResponse
+// from the service layer to a CreateIncomingWebhookResponse for the api layer.
+func ConvertCreateIncomingWebhookResponseFromServiceToHandler(
+	params model.CreateIncomingWebhookResponse,
+) *pb.CreateIncomingWebhookResponse {
+	return &pb.CreateIncomingWebhookResponse{
+		Id:    params.IncomingWebhookID,
+		Token: params.Token,
+	}
+}
+
+// ConvertListIncomingWebhooksRequestFromHandlerToService converts a ListIncomingWebhooksRequest from the api layer
+// to ListIncomingWebhooksParams for the service layer.
+func ConvertListIncomingWebhooksRequestFromHandlerToService(
+	params *pb.ListIncomingWebhooksRequest,
+) model.ListIncomingWebhooksParams {
+	return model.ListIncomingWebhooksParams{
+		ChatID: params.ChatId,
+	}
+}
+
+// ConvertIncomingWebhooksFromServiceToHandler converts incoming webhooks from the service layer
+// to a ListIncomingWebhooksResponse for the api layer.
+func ConvertIncomingWebhooksFromServiceToHandler(webhooks []model.IncomingWebhook) *pb.ListIncomingWebhooksResponse {
+	result := make([]*pb.IncomingWebhook, len(webhooks))
+	for i, webhook := range webhooks {
+		result[i] = &pb.IncomingWebhook{
+			Id:        webhook.ID,
+			ChatId:    webhook.ChatID,
+			Name:      webhook.Name,
+			CreatedAt: timestamppb.New(webhook.CreatedAt),
+		}
+	}
+
+	return &pb.ListIncomingWebhooksResponse{
+		IncomingWebhooks: result,
+	}
+}
+
+// ConvertDeleteIncomingWebhookRequestFromHandlerToService converts a DeleteIncomingWebhookRequest
+// from the api layer to DeleteIncomingWebhookParams for the service layer.
+func ConvertDeleteIncomingWebhookRequestFromHandlerToService(
+	params *pb.DeleteIncomingWebhookRequest,
+) model.DeleteIncomingWebhookParams {
+	return model.DeleteIncomingWebhookParams{
+		IncomingWebhookID: params.Id,
+	}
+}
@@ -0,0 +1,145 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+)
+
+// Checker tracks the readiness of the application based on the database availability
+// and reports it through the standard gRPC health service and HTTP probes.
+type Checker struct {
+	pinger   db.Pinger
+	server   *health.Server
+	services []string
+	interval time.Duration
+	timeout  time.Duration
+
+	mu           sync.RWMutex
+	ready        bool
+	shuttingDown bool
+}
+
+// NewChecker creates a new instance of Checker that reports NOT_SERVING for the overall server
+// and for every given service until the first successful database ping.
+func NewChecker(pinger db.Pinger, interval, timeout time.Duration, services ...string) *Checker {
+	c := &Checker{
+		pinger:   pinger,
+		server:   health.NewServer(),
+		services: append([]string{""}, services...),
+		interval: interval,
+		timeout:  timeout,
+	}
+
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return c
+}
+
+// Server returns the gRPC health server to be registered in the gRPC server.
+func (c *Checker) Server() healthpb.HealthServer {
+	return c.server
+}
+
+// Run pings the database immediately and then periodically until the context is cancelled,
+// switching the serving status whenever the database availability changes.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown marks every service as NOT_SERVING and ignores all further status updates.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shuttingDown = true
+	c.ready = false
+	c.server.Shutdown()
+}
+
+// LivenessHandler reports that the process is alive and able to serve HTTP requests.
+func (c *Checker) LivenessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		writeStatus(w, http.StatusOK, "ok")
+	}
+}
+
+// ReadinessHandler reports whether the application is ready to accept traffic.
+func (c *Checker) ReadinessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		c.mu.RLock()
+		ready := c.ready
+		c.mu.RUnlock()
+
+		if !ready {
+			writeStatus(w, http.StatusServiceUnavailable, "not ready")
+			return
+		}
+
+		writeStatus(w, http.StatusOK, "ready")
+	}
+}
+
+// check pings the database and updates the serving status accordingly.
+func (c *Checker) check(ctx context.Context) {
+	pingCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	err := c.pinger.Ping(pingCtx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.shuttingDown {
+		return
+	}
+
+	if err != nil {
+		if c.ready {
+			logger.FromContext(ctx).Error("Database ping failed, reporting NOT_SERVING", slog.String("error", err.Error()))
+		}
+
+		c.ready = false
+		c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+		return
+	}
+
+	if !c.ready {
+		logger.FromContext(ctx).Info("Database is reachable, reporting SERVING")
+	}
+
+	c.ready = true
+	c.setStatus(healthpb.HealthCheckResponse_SERVING)
+}
+
+func (c *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+func writeStatus(w http.ResponseWriter, code int, body string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(code)
+	_, _ = w.Write([]byte(body))
+}
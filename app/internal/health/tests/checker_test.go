@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/Prrromanssss/chat-server/internal/health"
+)
+
+const serviceName = "chat_v1.ChatV1"
+
+type pinger struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (p *pinger) Ping(_ context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.err
+}
+
+func (p *pinger) setErr(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.err = err
+}
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		db  = &pinger{}
+
+		errPing = errors.New("connection refused")
+	)
+
+	db.setErr(errPing)
+
+	checker := health.NewChecker(db, 10*time.Millisecond, time.Second, serviceName)
+
+	requireStatus := func(want healthpb.HealthCheckResponse_ServingStatus, wantReady int) {
+		require.Eventually(t, func() bool {
+			resp, err := checker.Server().Check(ctx, &healthpb.HealthCheckRequest{Service: serviceName})
+			if err != nil || resp.GetStatus() != want {
+				return false
+			}
+
+			rec := httptest.NewRecorder()
+			checker.ReadinessHandler()(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			return rec.Code == wantReady
+		}, time.Second, 5*time.Millisecond)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go checker.Run(runCtx)
+
+	// The database has not been pinged successfully yet.
+	requireStatus(healthpb.HealthCheckResponse_NOT_SERVING, http.StatusServiceUnavailable)
+
+	db.setErr(nil)
+	requireStatus(healthpb.HealthCheckResponse_SERVING, http.StatusOK)
+
+	db.setErr(errPing)
+	requireStatus(healthpb.HealthCheckResponse_NOT_SERVING, http.StatusServiceUnavailable)
+
+	db.setErr(nil)
+	requireStatus(healthpb.HealthCheckResponse_SERVING, http.StatusOK)
+
+	// Once shut down, successful pings must not bring the service back.
+	checker.Shutdown()
+	requireStatus(healthpb.HealthCheckResponse_NOT_SERVING, http.StatusServiceUnavailable)
+
+	rec := httptest.NewRecorder()
+	checker.LivenessHandler()(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
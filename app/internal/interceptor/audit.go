@@ -0,0 +1,88 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+)
+
+// AuditDataFunc converts the request or the response of an RPC into the data stored in the audit log,
+// nil if nothing is to be stored.
+type AuditDataFunc func(msg interface{}) interface{}
+
+// NewAuditInterceptor creates an interceptor writing an audit log entry for every unary RPC,
+// including the failed and rejected ones, with the caller identified by the auth interceptors,
+// the peer address, the request id, the status code and the duration of the call.
+// The request and the response are converted by their own AuditDataFunc.
+// An audit failure is logged and does not change the outcome of the call.
+func NewAuditInterceptor(
+	logRepository repository.LogRepository,
+	requestData AuditDataFunc,
+	responseData AuditDataFunc,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		_, method := splitMethod(info.FullMethod)
+		caller := callerName(ctx)
+
+		params := model.CreateAPILogParams{
+			Method:      method,
+			RequestData: requestData(req),
+			Caller:      caller,
+			Peer:        peerAddress(ctx),
+			RequestID:   RequestIDFromContext(ctx),
+			StatusCode:  status.Code(err).String(),
+			Duration:    time.Since(start),
+		}
+
+		if err == nil {
+			params.ResponseData = responseData(resp)
+		}
+
+		// The entry is written even if the caller has gone away in the meantime.
+		auditErr := logRepository.CreateAPILog(context.WithoutCancel(ctx), params)
+		if auditErr != nil {
+			logger.FromContext(ctx).Error("failed to write audit log", slog.String("error", auditErr.Error()))
+		}
+
+		return resp, err
+	}
+}
+
+// callerName returns the name of the administrator, or of the bot prefixed with "bot:", making the call.
+func callerName(ctx context.Context) string {
+	if name, ok := AdminFromContext(ctx); ok {
+		return name
+	}
+
+	if bot, ok := BotFromContext(ctx); ok {
+		return "bot:" + bot.Name
+	}
+
+	return ""
+}
+
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	return p.Addr.String()
+}
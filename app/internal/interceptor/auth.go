@@ -0,0 +1,82 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AuthorizationHeader is the metadata key carrying the bearer token of the caller.
+	AuthorizationHeader = "authorization"
+
+	bearerPrefix = "Bearer "
+)
+
+type adminKey struct{}
+
+// AdminFromContext returns the name of the administrator identified by the auth interceptor.
+func AdminFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(adminKey{}).(string)
+	return name, ok
+}
+
+// NewAuthInterceptor creates an interceptor identifying the administrators by their bearer tokens,
+// keyed by administrator name. It never rejects a call, see NewAdminInterceptor.
+func NewAuthInterceptor(tokens map[string]string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if name, ok := authenticateAdmin(ctx, tokens); ok {
+			ctx = context.WithValue(ctx, adminKey{}, name)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// NewAdminInterceptor creates an interceptor restricting the given methods to the administrators
+// identified by the interceptor created with NewAuthInterceptor, which must run before it.
+func NewAdminInterceptor(methods ...string) grpc.UnaryServerInterceptor {
+	adminMethods := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		adminMethods[method] = struct{}{}
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, ok := adminMethods[info.FullMethod]; ok {
+			if _, authenticated := AdminFromContext(ctx); !authenticated {
+				return nil, status.Error(codes.Unauthenticated, "admin token required")
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// authenticateAdmin returns the name of the administrator owning the bearer token of the request.
+func authenticateAdmin(ctx context.Context, tokens map[string]string) (string, bool) {
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return "", false
+	}
+
+	for name, adminToken := range tokens {
+		if adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1 {
+			return name, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,70 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+// BotAuthenticateFunc returns the bot owning the API token, or model.ErrNotFound.
+type BotAuthenticateFunc func(ctx context.Context, token string) (model.Bot, error)
+
+type botKey struct{}
+
+// BotFromContext returns the bot identified by the bot auth interceptor.
+func BotFromContext(ctx context.Context) (model.Bot, bool) {
+	bot, ok := ctx.Value(botKey{}).(model.Bot)
+	return bot, ok
+}
+
+// NewBotAuthInterceptor creates an interceptor identifying the bots by their bearer API tokens.
+// It runs after the interceptor created with NewAuthInterceptor and leaves the calls of the administrators
+// alone. Like it, it never rejects a call with an unknown token.
+func NewBotAuthInterceptor(authenticate BotAuthenticateFunc) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, admin := AdminFromContext(ctx); admin {
+			return handler(ctx, req)
+		}
+
+		token, ok := bearerToken(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		bot, err := authenticate(ctx, token)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return handler(ctx, req)
+			}
+
+			return nil, err
+		}
+
+		return handler(context.WithValue(ctx, botKey{}, bot), req)
+	}
+}
+
+// bearerToken returns the bearer token of the request.
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(AuthorizationHeader)
+	if len(values) == 0 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(values[0], bearerPrefix), true
+}
@@ -0,0 +1,124 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+)
+
+// RequestIDHeader is the metadata key carrying the request id between services.
+const RequestIDHeader = "x-request-id"
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the id assigned to the current request by LoggerInterceptor.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// requestLogger assigns a request id, reusing the one sent by the caller, and returns
+// a context carrying it together with a logger annotated with the id, the method and the trace id.
+func requestLogger(ctx context.Context, fullMethod string) (context.Context, *slog.Logger) {
+	requestID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+
+	if requestID == "" {
+		requestID = newRequestID()
+	}
+
+	l := logger.FromContext(ctx).With(
+		slog.String("request_id", requestID),
+		slog.String("method", fullMethod),
+	)
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		l = l.With(slog.String("trace_id", spanContext.TraceID().String()))
+	}
+
+	ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+
+	return logger.WithContext(ctx, l), l
+}
+
+// logResult logs the outcome of a finished RPC at a level matching its status.
+func logResult(ctx context.Context, l *slog.Logger, err error, duration time.Duration) {
+	code := status.Code(err)
+
+	attrs := []slog.Attr{
+		slog.String("code", code.String()),
+		slog.Duration("duration", duration),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		l.LogAttrs(ctx, slog.LevelError, "request failed", attrs...)
+
+		return
+	}
+
+	l.LogAttrs(ctx, slog.LevelInfo, "request handled", attrs...)
+}
+
+// LoggerInterceptor injects a request-scoped logger into the context of every unary RPC
+// and logs the outcome of the call.
+func LoggerInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+
+	ctx, l := requestLogger(ctx, info.FullMethod)
+
+	// The header is only available for calls served by the gRPC server.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, RequestIDFromContext(ctx)))
+
+	resp, err := handler(ctx, req)
+
+	logResult(ctx, l, err, time.Since(start))
+
+	return resp, err
+}
+
+// LoggerStreamInterceptor injects a request-scoped logger into the context of every streaming RPC
+// and logs the outcome of the call.
+func LoggerStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+
+	ctx, l := requestLogger(ss.Context(), info.FullMethod)
+
+	_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, RequestIDFromContext(ctx)))
+
+	err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+
+	logResult(ctx, l, err, time.Since(start))
+
+	return err
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
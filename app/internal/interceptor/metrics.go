@@ -0,0 +1,46 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/Prrromanssss/chat-server/internal/metric"
+)
+
+// MetricsInterceptor records the count, status code and latency of every unary RPC.
+func MetricsInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+
+	resp, err := handler(ctx, req)
+
+	metric.ObserveRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+
+	return resp, err
+}
+
+// MetricsStreamInterceptor tracks the number of open streams and records every finished streaming RPC.
+func MetricsStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+
+	metric.IncActiveStreams()
+	defer metric.DecActiveStreams()
+
+	err := handler(srv, ss)
+
+	metric.ObserveRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+
+	return err
+}
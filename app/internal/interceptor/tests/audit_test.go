@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/Prrromanssss/chat-server/internal/converter"
+	"github.com/Prrromanssss/chat-server/internal/interceptor"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	pb "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr = &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5000}
+		req  = &pb.CreateRequest{Emails: []string{"user@example.com"}}
+		resp = &pb.CreateResponse{Id: 7}
+
+		requestData = map[string]interface{}{"emails": []interface{}{"user@example.com"}}
+
+		errAudit = errors.New("audit log error")
+	)
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		handlerErr    error
+		auditErr      error
+		expected      model.CreateAPILogParams
+	}{
+		{
+			name:          "success by administrator",
+			method:        "/chat_v1.ChatV1/Create",
+			authorization: "Bearer secret",
+			expected: model.CreateAPILogParams{
+				Method:       "Create",
+				RequestData:  requestData,
+				ResponseData: model.CreateChatResponse{ChatID: resp.Id},
+				Caller:       "support",
+				Peer:         addr.String(),
+				RequestID:    "req-1",
+				StatusCode:   codes.OK.String(),
+			},
+		},
+		{
+			name:       "failure by anonymous caller",
+			method:     "/chat_v1.ChatV1/Create",
+			handlerErr: status.Error(codes.InvalidArgument, "invalid emails"),
+			expected: model.CreateAPILogParams{
+				Method:      "Create",
+				RequestData: requestData,
+				Peer:        addr.String(),
+				RequestID:   "req-1",
+				StatusCode:  codes.InvalidArgument.String(),
+			},
+		},
+		{
+			name:     "rejected admin method",
+			method:   "/chat_v1.ChatV1/ListAuditLog",
+			auditErr: errAudit,
+			expected: model.CreateAPILogParams{
+				Method:      "ListAuditLog",
+				RequestData: requestData,
+				Peer:        addr.String(),
+				RequestID:   "req-1",
+				StatusCode:  codes.Unauthenticated.String(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			var recorded model.CreateAPILogParams
+
+			logRepositoryMock := repositoryMocks.NewLogRepositoryMock(mc)
+			logRepositoryMock.CreateAPILogMock.Set(func(_ context.Context, params model.CreateAPILogParams) error {
+				recorded = params
+				return tt.auditErr
+			})
+
+			chain := interceptor.ChainUnary(
+				interceptor.LoggerInterceptor,
+				interceptor.NewAuthInterceptor(map[string]string{"support": "secret"}),
+				interceptor.NewAuditInterceptor(
+					logRepositoryMock,
+					converter.ConvertRequestFromHandlerToAudit,
+					converter.ConvertResponseFromHandlerToAudit,
+				),
+				interceptor.NewAdminInterceptor("/chat_v1.ChatV1/ListAuditLog"),
+			)
+
+			md := metadata.Pairs(interceptor.RequestIDHeader, "req-1")
+			if tt.authorization != "" {
+				md.Set(interceptor.AuthorizationHeader, tt.authorization)
+			}
+
+			ctx := peer.NewContext(metadata.NewIncomingContext(context.Background(), md), &peer.Peer{Addr: addr})
+
+			got, err := chain(ctx, req, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(_ context.Context, _ interface{}) (interface{}, error) {
+					if tt.handlerErr != nil {
+						return nil, tt.handlerErr
+					}
+
+					return resp, nil
+				},
+			)
+			require.Equal(t, tt.expected.StatusCode, status.Code(err).String())
+			if err == nil {
+				require.Equal(t, resp, got)
+			}
+
+			require.GreaterOrEqual(t, recorded.Duration, time.Duration(0))
+			recorded.Duration = 0
+			require.Equal(t, tt.expected, recorded)
+		})
+	}
+}
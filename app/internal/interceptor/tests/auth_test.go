@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Prrromanssss/chat-server/internal/interceptor"
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+func TestAdminInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const adminMethod = "/chat_v1.ChatV1/ListAuditLog"
+
+	authInterceptor := interceptor.ChainUnary(
+		interceptor.NewAuthInterceptor(map[string]string{"support": "secret"}),
+		interceptor.NewAdminInterceptor(adminMethod),
+	)
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		code          codes.Code
+		admin         string
+	}{
+		{
+			name:          "admin method with valid token",
+			method:        adminMethod,
+			authorization: "Bearer secret",
+			code:          codes.OK,
+			admin:         "support",
+		},
+		{
+			name:          "admin method with invalid token",
+			method:        adminMethod,
+			authorization: "Bearer guess",
+			code:          codes.Unauthenticated,
+		},
+		{
+			name:   "admin method without token",
+			method: adminMethod,
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "public method without token",
+			method: "/chat_v1.ChatV1/Create",
+			code:   codes.OK,
+		},
+		{
+			name:          "public method with valid token",
+			method:        "/chat_v1.ChatV1/Create",
+			authorization: "Bearer secret",
+			code:          codes.OK,
+			admin:         "support",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(interceptor.AuthorizationHeader, tt.authorization))
+			}
+
+			var admin string
+
+			_, err := authInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					admin, _ = interceptor.AdminFromContext(ctx)
+					return nil, nil
+				},
+			)
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.admin, admin)
+		})
+	}
+}
+
+func TestBotAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	bot := model.Bot{ID: 1, Name: "deployer"}
+
+	authInterceptor := interceptor.ChainUnary(
+		interceptor.NewAuthInterceptor(map[string]string{"support": "secret"}),
+		interceptor.NewBotAuthInterceptor(func(_ context.Context, token string) (model.Bot, error) {
+			switch token {
+			case "bot-token":
+				return bot, nil
+			case "broken":
+				return model.Bot{}, errors.New("bot repository error")
+			default:
+				return model.Bot{}, errors.Wrap(model.ErrNotFound, "bot")
+			}
+		}),
+	)
+
+	tests := []struct {
+		name          string
+		authorization string
+		code          codes.Code
+		bot           bool
+	}{
+		{
+			name:          "bot token",
+			authorization: "Bearer bot-token",
+			code:          codes.OK,
+			bot:           true,
+		},
+		{
+			name:          "admin token",
+			authorization: "Bearer secret",
+			code:          codes.OK,
+		},
+		{
+			name:          "unknown token",
+			authorization: "Bearer guess",
+			code:          codes.OK,
+		},
+		{
+			name: "no token",
+			code: codes.OK,
+		},
+		{
+			name:          "authentication error",
+			authorization: "Bearer broken",
+			code:          codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(interceptor.AuthorizationHeader, tt.authorization))
+			}
+
+			var identified bool
+
+			_, err := authInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/chat_v1.ChatV1/SendMessage"},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					var got model.Bot
+					got, identified = interceptor.BotFromContext(ctx)
+					if identified {
+						require.Equal(t, bot, got)
+					}
+
+					return nil, nil
+				},
+			)
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.bot, identified)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	fiberlog "github.com/gofiber/fiber/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/redact"
+)
+
+// Formats of the log output.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+type ctxKey struct{}
+
+// New creates a structured logger writing to w with the level and format from the configuration.
+// Every record goes through the redactor, if any, before being written.
+func New(cfg config.Logger, w io.Writer, redactor *redact.Redactor) (*slog.Logger, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return nil, errors.Wrapf(err, "invalid log level %q", cfg.Level)
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+
+	switch strings.ToLower(cfg.Format) {
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	case FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return nil, errors.Errorf("invalid log format %q", cfg.Format)
+	}
+
+	if redactor != nil {
+		handler = redact.NewHandler(handler, redactor)
+	}
+
+	return slog.New(handler), nil
+}
+
+// Init creates the application logger, makes it the default one and redirects the output
+// of the standard and fiber loggers used by dependencies to it.
+func Init(cfg config.Logger, redactor *redact.Redactor) (*slog.Logger, error) {
+	l, err := New(cfg, os.Stderr, redactor)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.SetDefault(l)
+
+	// Dependencies log every executed query with its raw arguments through the standard logger.
+	// Keep it at debug level, or discard it when message texts must not be logged,
+	// since the arguments cannot be told apart.
+	log.SetFlags(0)
+	if redactor != nil && redactor.Action(redact.KindText) != redact.ActionKeep {
+		log.SetOutput(io.Discard)
+	} else {
+		log.SetOutput(slog.NewLogLogger(l.Handler(), slog.LevelDebug).Writer())
+	}
+
+	fiberlog.SetOutput(slog.NewLogLogger(l.Handler(), slog.LevelWarn).Writer())
+
+	return l, nil
+}
+
+// WithContext returns a copy of the context carrying the logger.
+func WithContext(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext returns the request-scoped logger stored in the context or the default logger.
+func FromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(ctxKey{}).(*slog.Logger); ok {
+		return l
+	}
+
+	return slog.Default()
+}
+
+// Fatal logs the message at error level and terminates the application.
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
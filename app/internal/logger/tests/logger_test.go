@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/interceptor"
+	"github.com/Prrromanssss/chat-server/internal/logger"
+)
+
+func decodeLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+
+	var records []map[string]interface{}
+
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		record := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(line, &record))
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := logger.New(config.Logger{Level: "verbose", Format: logger.FormatJSON}, &bytes.Buffer{}, nil)
+	require.Error(t, err)
+
+	_, err = logger.New(config.Logger{Level: "info", Format: "xml"}, &bytes.Buffer{}, nil)
+	require.Error(t, err)
+
+	buf := &bytes.Buffer{}
+	l, err := logger.New(config.Logger{Level: "warn", Format: logger.FormatJSON}, buf, nil)
+	require.NoError(t, err)
+
+	l.Info("skipped")
+	l.Warn("kept")
+
+	records := decodeLines(t, buf)
+	require.Len(t, records, 1)
+	require.Equal(t, "kept", records[0]["msg"])
+}
+
+func TestLoggerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buf    = &bytes.Buffer{}
+		method = "/chat_v1.ChatV1/Create"
+		info   = &grpc.UnaryServerInfo{FullMethod: method}
+	)
+
+	l, err := logger.New(config.Logger{Level: "debug", Format: logger.FormatJSON}, buf, nil)
+	require.NoError(t, err)
+
+	ctx := logger.WithContext(context.Background(), l)
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(interceptor.RequestIDHeader, "req-1"))
+
+	_, err = interceptor.LoggerInterceptor(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		require.Equal(t, "req-1", interceptor.RequestIDFromContext(ctx))
+		logger.FromContext(ctx).Debug("chatService.CreateChat")
+
+		return nil, status.Error(codes.InvalidArgument, "invalid emails")
+	})
+	require.Error(t, err)
+
+	records := decodeLines(t, buf)
+	require.Len(t, records, 2)
+
+	for _, record := range records {
+		require.Equal(t, "req-1", record["request_id"])
+		require.Equal(t, method, record["method"])
+	}
+
+	require.Equal(t, "chatService.CreateChat", records[0]["msg"])
+	require.Equal(t, "ERROR", records[1]["level"])
+	require.Equal(t, codes.InvalidArgument.String(), records[1]["code"])
+}
+
+func TestLoggerInterceptorGeneratesRequestID(t *testing.T) {
+	t.Parallel()
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/chat_v1.ChatV1/Delete"}
+
+	var first, second string
+
+	_, err := interceptor.LoggerInterceptor(context.Background(), nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		first = interceptor.RequestIDFromContext(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	_, err = interceptor.LoggerInterceptor(context.Background(), nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		second = interceptor.RequestIDFromContext(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, first)
+	require.NotEqual(t, first, second)
+}
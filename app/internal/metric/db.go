@@ -0,0 +1,123 @@
+package metric
+
+import (
+	"context"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/Prrromanssss/platform_common/pkg/db/pg"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+// batchQueryName labels batches, which are sent without a db.Query name.
+const batchQueryName = "batch"
+
+type dbClient struct {
+	client db.Client
+	db     db.DB
+}
+
+// NewDBClient wraps the database client so that every named query records its latency.
+func NewDBClient(client db.Client) db.Client {
+	return &dbClient{
+		client: client,
+		db:     &instrumentedDB{DB: client.DB()},
+	}
+}
+
+// DB returns the instrumented database connection.
+func (c *dbClient) DB() db.DB {
+	return c.db
+}
+
+// Close closes the underlying database client.
+func (c *dbClient) Close() error {
+	return c.client.Close()
+}
+
+type instrumentedDB struct {
+	db.DB
+}
+
+// ScanOneContext executes a query, scans a single row into dest and records the query latency.
+func (d *instrumentedDB) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	start := time.Now()
+	err := d.DB.ScanOneContext(ctx, dest, q, args...)
+	ObserveQuery(q.Name, err, time.Since(start))
+
+	return err
+}
+
+// ScanAllContext executes a query, scans all rows into dest and records the query latency.
+func (d *instrumentedDB) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	start := time.Now()
+	err := d.DB.ScanAllContext(ctx, dest, q, args...)
+	ObserveQuery(q.Name, err, time.Since(start))
+
+	return err
+}
+
+// ExecContext executes a query without returning any rows and records the query latency.
+func (d *instrumentedDB) ExecContext(ctx context.Context, q db.Query, args ...interface{}) (pgconn.CommandTag, error) {
+	start := time.Now()
+	tag, err := d.DB.ExecContext(ctx, q, args...)
+	ObserveQuery(q.Name, err, time.Since(start))
+
+	return tag, err
+}
+
+// QueryContext executes a query and records the latency until the rows are available.
+func (d *instrumentedDB) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
+	start := time.Now()
+	rows, err := d.DB.QueryContext(ctx, q, args...)
+	ObserveQuery(q.Name, err, time.Since(start))
+
+	return rows, err
+}
+
+// QueryRowContext executes a query returning a single row and records the query latency.
+func (d *instrumentedDB) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
+	start := time.Now()
+	row := d.DB.QueryRowContext(ctx, q, args...)
+	ObserveQuery(q.Name, nil, time.Since(start))
+
+	return row
+}
+
+// SendBatchContext sends a batch and records the latency of sending it.
+func (d *instrumentedDB) SendBatchContext(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	start := time.Now()
+	br := d.DB.SendBatchContext(ctx, b)
+	ObserveQuery(batchQueryName, nil, time.Since(start))
+
+	return br
+}
+
+type txManager struct {
+	txManager db.TxManager
+}
+
+// NewTxManager wraps the transaction manager so that every top-level transaction
+// is counted as committed or rolled back.
+func NewTxManager(manager db.TxManager) db.TxManager {
+	return &txManager{txManager: manager}
+}
+
+// ReadCommitted executes the handler within a read committed transaction and records its result.
+func (m *txManager) ReadCommitted(ctx context.Context, f db.Handler) error {
+	// Nested transactions are executed within the outer one and are counted there.
+	if _, ok := ctx.Value(pg.TxKey).(pgx.Tx); ok {
+		return m.txManager.ReadCommitted(ctx, f)
+	}
+
+	err := m.txManager.ReadCommitted(ctx, f)
+	if err != nil {
+		IncTransactions(TxRollback)
+		return err
+	}
+
+	IncTransactions(TxCommit)
+
+	return nil
+}
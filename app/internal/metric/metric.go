@@ -0,0 +1,195 @@
+package metric
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "chat_server"
+
+// Results of asynchronously written audit log entries used as label values of the audit log counter.
+const (
+	AuditLogWritten = "written"
+	AuditLogDropped = "dropped"
+)
+
+// Results of relayed outbox events used as label values of the outbox counter.
+const (
+	OutboxPublished = "published"
+	OutboxFailed    = "failed"
+)
+
+// Transaction results used as label values of the transactions counter.
+const (
+	TxCommit   = "commit"
+	TxRollback = "rollback"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+	factory  = promauto.With(registry)
+
+	requestsTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Total number of RPCs handled by the server, by method and status code.",
+	}, []string{"method", "code"})
+
+	requestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of RPCs handled by the server, by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	queryDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Latency of database queries, by query name and result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"query", "status"})
+
+	transactionsTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "transactions_total",
+		Help:      "Total number of database transactions, by result.",
+	}, []string{"result"})
+
+	chatsCreatedTotal = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "chats_created_total",
+		Help:      "Total number of chats created.",
+	})
+
+	messagesSentTotal = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_sent_total",
+		Help:      "Total number of messages sent.",
+	})
+
+	auditLogEntriesTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "audit_log",
+		Name:      "entries_total",
+		Help:      "Total number of API audit log entries handled asynchronously, by result.",
+	}, []string{"result"})
+
+	outboxEventsTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "outbox",
+		Name:      "events_total",
+		Help:      "Total number of attempts to publish outbox events, by result.",
+	}, []string{"result"})
+
+	activeStreams = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_streams",
+		Help:      "Number of currently open streaming RPCs.",
+	})
+
+	updateSubscribers = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "updates",
+		Name:      "subscribers",
+		Help:      "Number of current subscribers to the live updates of the chats.",
+	})
+
+	droppedSubscribersTotal = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "updates",
+		Name:      "dropped_subscribers_total",
+		Help:      "Total number of subscribers dropped for not keeping up with the live updates.",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler returns the HTTP handler exposing all metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// Registry returns the registry holding all application metrics.
+func Registry() *prometheus.Registry {
+	return registry
+}
+
+// ObserveRequest records a handled RPC with its status code and duration.
+func ObserveRequest(method, code string, duration time.Duration) {
+	requestsTotal.WithLabelValues(method, code).Inc()
+	requestDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+}
+
+// ObserveQuery records the duration of a named database query.
+func ObserveQuery(query string, err error, duration time.Duration) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+
+	queryDuration.WithLabelValues(query, status).Observe(duration.Seconds())
+}
+
+// IncTransactions increments the number of finished transactions with the given result.
+func IncTransactions(result string) {
+	transactionsTotal.WithLabelValues(result).Inc()
+}
+
+// IncChatsCreated increments the number of created chats.
+func IncChatsCreated() {
+	chatsCreatedTotal.Inc()
+}
+
+// IncMessagesSent increments the number of sent messages.
+func IncMessagesSent() {
+	messagesSentTotal.Inc()
+}
+
+// AddAuditLogEntries adds n audit log entries to the counter of the given result.
+func AddAuditLogEntries(result string, n int) {
+	auditLogEntriesTotal.WithLabelValues(result).Add(float64(n))
+}
+
+// AddOutboxEvents adds n outbox events to the counter of the given result.
+func AddOutboxEvents(result string, n int) {
+	outboxEventsTotal.WithLabelValues(result).Add(float64(n))
+}
+
+// IncActiveStreams increments the number of open streams.
+func IncActiveStreams() {
+	activeStreams.Inc()
+}
+
+// DecActiveStreams decrements the number of open streams.
+func DecActiveStreams() {
+	activeStreams.Dec()
+}
+
+// IncSubscribers increments the number of subscribers to the live updates.
+func IncSubscribers() {
+	updateSubscribers.Inc()
+}
+
+// DecSubscribers decrements the number of subscribers to the live updates.
+func DecSubscribers() {
+	updateSubscribers.Dec()
+}
+
+// IncDroppedSubscribers increments the number of subscribers dropped for not keeping up.
+func IncDroppedSubscribers() {
+	droppedSubscribersTotal.Inc()
+}
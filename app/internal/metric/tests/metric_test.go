@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Prrromanssss/chat-server/internal/interceptor"
+	"github.com/Prrromanssss/chat-server/internal/metric"
+)
+
+// metricValue returns the value of the counter or the sample count of the histogram
+// with the given name and label values.
+func metricValue(t *testing.T, name string, labels map[string]string) float64 {
+	t.Helper()
+
+	families, err := metric.Registry().Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+	metrics:
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if labels[label.GetName()] != label.GetValue() {
+					continue metrics
+				}
+			}
+
+			if m.GetHistogram() != nil {
+				return float64(m.GetHistogram().GetSampleCount())
+			}
+
+			return m.GetCounter().GetValue()
+		}
+	}
+
+	return 0
+}
+
+func TestTxManager(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		errTx = errors.New("tx error")
+	)
+
+	mock := dbMocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	txManager := metric.NewTxManager(mock)
+
+	commits := metricValue(t, "chat_server_db_transactions_total", map[string]string{"result": metric.TxCommit})
+	rollbacks := metricValue(t, "chat_server_db_transactions_total", map[string]string{"result": metric.TxRollback})
+
+	require.NoError(t, txManager.ReadCommitted(ctx, func(context.Context) error { return nil }))
+	require.ErrorIs(t, txManager.ReadCommitted(ctx, func(context.Context) error { return errTx }), errTx)
+
+	require.Equal(t, commits+1, metricValue(t, "chat_server_db_transactions_total", map[string]string{"result": metric.TxCommit}))
+	require.Equal(t, rollbacks+1, metricValue(t, "chat_server_db_transactions_total", map[string]string{"result": metric.TxRollback}))
+}
+
+func TestMetricsInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		method = "/chat_v1.ChatV1/TestMetricsInterceptor"
+		info   = &grpc.UnaryServerInfo{FullMethod: method}
+	)
+
+	_, err := interceptor.MetricsInterceptor(ctx, nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "chat not found")
+	})
+	require.Error(t, err)
+
+	_, err = interceptor.MetricsInterceptor(ctx, nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return struct{}{}, nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, float64(1), metricValue(t, "chat_server_grpc_requests_total", map[string]string{
+		"method": method,
+		"code":   codes.NotFound.String(),
+	}))
+	require.Equal(t, float64(1), metricValue(t, "chat_server_grpc_request_duration_seconds", map[string]string{
+		"method": method,
+		"code":   codes.OK.String(),
+	}))
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ListAuditLogParams holds the filters and the page of the audit log to list.
+// Zero values disable the corresponding filter.
+type ListAuditLogParams struct {
+	ActionTypes []string               `json:"action_types"`
+	From        time.Time              `json:"from"`
+	To          time.Time              `json:"to"`
+	ChatID      int64                  `json:"chat_id"`
+	Sender      string                 `json:"sender" redact:"email"`
+	RequestData map[string]interface{} `json:"request_data"`
+	PageSize    int                    `json:"page_size"`
+	Cursor      *AuditLogCursor        `json:"cursor"`
+}
+
+// AuditLogCursor points at the last entry of a page, the next page starts right after it.
+type AuditLogCursor struct {
+	Timestamp time.Time
+	ID        int64
+}
+
+// AuditLogEntry represents a stored log of an api action.
+type AuditLogEntry struct {
+	ID           int64
+	ActionType   string
+	RequestData  json.RawMessage
+	ResponseData json.RawMessage
+	Caller       string
+	Peer         string
+	RequestID    string
+	StatusCode   string
+	Duration     time.Duration
+	Timestamp    time.Time
+}
+
+// AuditLogPartition is a partition of the audit log holding the entries created before UpperBound.
+type AuditLogPartition struct {
+	Name       string
+	UpperBound time.Time
+}
+
+// ListAuditLogResponse holds a page of the audit log and the cursor of the next page, nil on the last page.
+type ListAuditLogResponse struct {
+	Entries    []AuditLogEntry
+	NextCursor *AuditLogCursor
+}
@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// DirectChatParticipants is the number of the participants of a direct chat, which cannot be created
+// between users blocking one another.
+const DirectChatParticipants = 2
+
+// BlockUserParams holds the user blocking another one and the blocked user.
+type BlockUserParams struct {
+	From  string `json:"from" redact:"email"`
+	Email string `json:"email" redact:"email"`
+}
+
+// UnblockUserParams holds the user lifting the block and the blocked user.
+type UnblockUserParams struct {
+	From  string `json:"from" redact:"email"`
+	Email string `json:"email" redact:"email"`
+}
+
+// ListBlockedParams holds the user whose blocked users are listed.
+type ListBlockedParams struct {
+	From string `json:"from" redact:"email"`
+}
+
+// BlockedUser represents a user blocked by another one.
+type BlockedUser struct {
+	Email       string    `json:"email" redact:"email"`
+	DisplayName string    `json:"display_name" redact:"text"`
+	BlockedAt   time.Time `json:"blocked_at"`
+}
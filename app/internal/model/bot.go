@@ -0,0 +1,127 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Types of the users.
+const (
+	UserTypeUser = "user"
+	UserTypeBot  = "bot"
+)
+
+// BotCommand represents a slash command handled by a bot or by the server.
+type BotCommand struct {
+	Name        string
+	Description string
+}
+
+// CreateBotParams holds the parameters for creating a bot user. Commands sent to a chat are POSTed
+// to WebhookURL, if set, signed with SigningSecret. The token and the secret are generated by the service,
+// only the hash of the token is stored.
+type CreateBotParams struct {
+	Name          string       `json:"name"`
+	WebhookURL    string       `json:"webhook_url"`
+	Commands      []BotCommand `json:"commands"`
+	TokenHash     string       `json:"token_hash"`
+	SigningSecret string       `json:"signing_secret"`
+}
+
+// CreateBotResponse represents the response after creating a bot, including its API token
+// and the signing secret of its commands.
+type CreateBotResponse struct {
+	BotID         int64  `json:"bot_id"`
+	Token         string `json:"token"`
+	SigningSecret string `json:"signing_secret"`
+}
+
+// DeleteBotParams holds the ID of the bot to be deleted.
+type DeleteBotParams struct {
+	BotID int64 `json:"bot_id"`
+}
+
+// Bot represents a bot user, sending messages under its name. Its signing secret keys the signature
+// of the commands POSTed to its webhook.
+type Bot struct {
+	ID            int64
+	Name          string
+	WebhookURL    string
+	TokenHash     string
+	SigningSecret string
+	Commands      []BotCommand
+	CreatedAt     time.Time
+}
+
+// Command represents a slash command sent to a chat, e.g. `/poll "Lunch?" Pizza Sushi`.
+type Command struct {
+	ChatID int64
+	From   string `redact:"email"`
+	Name   string
+	Args   []string `redact:"text"`
+}
+
+// CommandReply represents the message posted back to the chat after a command, nothing if Text is empty.
+type CommandReply struct {
+	From string
+	Text string `redact:"text"`
+}
+
+var (
+	commandPattern     = regexp.MustCompile(`^/([a-z][a-z0-9_-]{0,31})(?:\s+|$)`)
+	commandNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+)
+
+// IsCommandName reports whether the name is valid for a slash command, e.g. "deploy".
+func IsCommandName(name string) bool {
+	return commandNamePattern.MatchString(name)
+}
+
+// ParseCommand parses a message starting with a slash command. The arguments are separated by spaces,
+// double quotes group an argument containing spaces.
+func ParseCommand(text string) (Command, bool) {
+	match := commandPattern.FindStringSubmatchIndex(text)
+	if match == nil {
+		return Command{}, false
+	}
+
+	return Command{
+		Name: text[match[2]:match[3]],
+		Args: splitArgs(text[match[1]:]),
+	}, true
+}
+
+// splitArgs splits the arguments of a command on spaces outside double quotes.
+func splitArgs(s string) []string {
+	var (
+		args    []string
+		arg     strings.Builder
+		inArg   bool
+		inQuote bool
+	)
+
+	for _, r := range s {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			inArg = true
+		case unicode.IsSpace(r) && !inQuote:
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if inArg {
+		args = append(args, arg.String())
+	}
+
+	return args
+}
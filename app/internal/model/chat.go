@@ -4,17 +4,17 @@ import "time"
 
 // CreateChatParams contains the parameters for creating users in a chat.
 type CreateChatParams struct {
-	Emails []string
+	Emails []string `json:"emails" redact:"email"`
 }
 
 // CreateChatResponse represents the response after creating a chat, including the ChatID.
 type CreateChatResponse struct {
-	ChatID int64
+	ChatID int64 `json:"chat_id"`
 }
 
 // CreateUsersForChatParams contains the parameters for creating users in a chat.
 type CreateUsersForChatParams struct {
-	Emails []string
+	Emails []string `redact:"email"`
 }
 
 // CreateUsersForChatResponse represents the response after creating users for a chat.
@@ -30,14 +30,47 @@ type LinkParticipantsToChatParams struct {
 
 // DeleteChatParams holds the ID of the chat to be deleted.
 type DeleteChatParams struct {
-	ChatID int64
+	ChatID int64 `json:"chat_id"`
 }
 
-// SendMessageParams holds the data for sending a message.
+// SendMessageParams holds the data for sending a message to a chat.
 type SendMessageParams struct {
-	From   string
-	Text   string
-	SentAt time.Time
+	ChatID int64     `json:"chat_id"`
+	From   string    `json:"from" redact:"email"`
+	Text   string    `json:"text" redact:"text"`
+	SentAt time.Time `json:"sent_at"`
+	Type   string    `json:"type,omitempty"`
+}
+
+// SendMessageResponse represents the response after sending a message, including the MessageID.
+type SendMessageResponse struct {
+	MessageID int64
+}
+
+// Limits of the pages of the history of the chats.
+const (
+	ListMessagesDefaultLimit = 50
+	ListMessagesMaxLimit     = 200
+)
+
+// ListMessagesParams holds the chat whose history is listed, the reader, a participant of the chat, whose
+// blocked users' messages are hidden, and the page: at most Limit messages older than the message BeforeID,
+// if set.
+type ListMessagesParams struct {
+	ChatID   int64  `json:"chat_id"`
+	From     string `json:"from" redact:"email"`
+	BeforeID int64  `json:"before_id"`
+	Limit    int64  `json:"limit"`
+}
+
+// Message represents a message of the history of a chat.
+type Message struct {
+	ID     int64     `json:"id"`
+	ChatID int64     `json:"chat_id"`
+	From   string    `json:"from" redact:"email"`
+	Text   string    `json:"text" redact:"text"`
+	Type   string    `json:"type"`
+	SentAt time.Time `json:"sent_at"`
 }
 
 // UnlinkParticipantsFromChatParams holds the ID of the chat from which users will be unlinked.
@@ -45,9 +78,15 @@ type UnlinkParticipantsFromChatParams struct {
 	ChatID int64
 }
 
-// CreateAPILogParams holds the parameters for logging API actions related to user creation.
+// CreateAPILogParams holds the parameters for logging API actions: who called which method
+// from where, with what data and outcome.
 type CreateAPILogParams struct {
 	Method       string
 	RequestData  interface{}
 	ResponseData interface{}
+	Caller       string
+	Peer         string
+	RequestID    string
+	StatusCode   string
+	Duration     time.Duration
 }
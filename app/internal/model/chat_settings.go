@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Notification levels of the participants of the chats.
+const (
+	NotificationLevelAll      = "all"
+	NotificationLevelMentions = "mentions"
+	NotificationLevelNone     = "none"
+)
+
+// ChatSettings represents the settings of a chat for one of its participants.
+type ChatSettings struct {
+	ChatID            int64      `json:"chat_id"`
+	MutedUntil        *time.Time `json:"muted_until,omitempty"`
+	NotificationLevel string     `json:"notification_level"`
+	Pinned            bool       `json:"pinned"`
+	Archived          bool       `json:"archived"`
+}
+
+// IsMuted reports whether the chat is muted at the given time.
+func (s ChatSettings) IsMuted(now time.Time) bool {
+	return s.MutedUntil != nil && now.Before(*s.MutedUntil)
+}
+
+// UpdateChatSettingsParams holds the settings of a chat to update for the participant, the nil fields
+// are left unchanged. A MutedUntil not after the time of the update unmutes the chat.
+type UpdateChatSettingsParams struct {
+	ChatID            int64      `json:"chat_id"`
+	From              string     `json:"from" redact:"email"`
+	MutedUntil        *time.Time `json:"muted_until,omitempty"`
+	NotificationLevel *string    `json:"notification_level,omitempty"`
+	Pinned            *bool      `json:"pinned,omitempty"`
+	Archived          *bool      `json:"archived,omitempty"`
+}
+
+// Validate checks the notification level.
+func (p UpdateChatSettingsParams) Validate() error {
+	if p.NotificationLevel == nil {
+		return nil
+	}
+
+	switch *p.NotificationLevel {
+	case NotificationLevelAll, NotificationLevelMentions, NotificationLevelNone:
+		return nil
+	default:
+		return errors.Errorf(
+			"notification_level must be one of %s, %s, %s",
+			NotificationLevelAll, NotificationLevelMentions, NotificationLevelNone,
+		)
+	}
+}
+
+// ListChatsParams holds the participant whose chats are listed, without the archived ones unless included.
+type ListChatsParams struct {
+	From            string `json:"from" redact:"email"`
+	IncludeArchived bool   `json:"include_archived"`
+}
+
+// UserChat represents a chat of a participant with their settings of the chat.
+type UserChat struct {
+	ChatID       int64        `json:"chat_id"`
+	Participants []string     `json:"participants" redact:"email"`
+	Settings     ChatSettings `json:"settings"`
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// EphemeralEvent holds an event of a chat that is broadcast to its subscribers but not stored,
+// such as a typing indicator. The payload is encoded to JSON and the event expires after its TTL.
+type EphemeralEvent struct {
+	ChatID  int64
+	Type    string
+	From    string `redact:"email"`
+	Payload interface{}
+	TTL     time.Duration
+}
+
+// SendTypingEventParams holds the participant typing in a chat.
+type SendTypingEventParams struct {
+	ChatID int64  `json:"chat_id"`
+	From   string `json:"from" redact:"email"`
+}
+
+// TypingEvent is the payload of the typing update.
+type TypingEvent struct {
+	From        string `json:"from" redact:"email"`
+	DisplayName string `json:"display_name,omitempty" redact:"text"`
+}
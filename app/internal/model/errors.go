@@ -0,0 +1,20 @@
+package model
+
+import "github.com/pkg/errors"
+
+var (
+	// ErrNotFound is returned when the requested entity does not exist.
+	ErrNotFound = errors.New("not found")
+
+	// ErrAlreadyExists is returned when the entity to create conflicts with an existing one.
+	ErrAlreadyExists = errors.New("already exists")
+
+	// ErrPermissionDenied is returned when the caller is not allowed to act on the entity.
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrInvalidArgument is returned when the request does not fit the state of the entity.
+	ErrInvalidArgument = errors.New("invalid argument")
+
+	// ErrPollClosed is returned when voting on a closed poll.
+	ErrPollClosed = errors.New("poll is closed")
+)
@@ -0,0 +1,75 @@
+package model
+
+import "time"
+
+// Types of the chat domain events published through the outbox.
+const (
+	EventTypeChatCreated    = "chat.created"
+	EventTypeChatDeleted    = "chat.deleted"
+	EventTypeMessageSent    = "message.sent"
+	EventTypeMentionCreated = "mention.created"
+)
+
+// IsEventType reports whether t is the type of a chat domain event.
+func IsEventType(t string) bool {
+	switch t {
+	case EventTypeChatCreated, EventTypeChatDeleted, EventTypeMessageSent, EventTypeMentionCreated:
+		return true
+	default:
+		return false
+	}
+}
+
+// CreateEventParams holds the parameters for storing a chat domain event in the outbox.
+// The payload is stored as JSON.
+type CreateEventParams struct {
+	Type    string
+	ChatID  int64
+	Payload interface{}
+}
+
+// Event represents a chat domain event stored in the outbox. The events of a chat
+// are published in the order of their IDs, which is the order they were committed in.
+type Event struct {
+	ID        int64
+	Type      string
+	ChatID    int64
+	Payload   []byte
+	CreatedAt time.Time
+	Attempts  int
+}
+
+// ChatCreatedEvent is the payload of the chat.created event.
+type ChatCreatedEvent struct {
+	ChatID int64    `json:"chat_id"`
+	Emails []string `json:"emails" redact:"email"`
+}
+
+// ChatDeletedEvent is the payload of the chat.deleted event.
+type ChatDeletedEvent struct {
+	ChatID int64 `json:"chat_id"`
+}
+
+// MessageSentEvent is the payload of the message.sent event, and of the live update of the message
+// with its text truncated to MaxUpdateTextLength.
+type MessageSentEvent struct {
+	MessageID int64     `json:"message_id"`
+	ChatID    int64     `json:"chat_id"`
+	From      string    `json:"from" redact:"email"`
+	Text      string    `json:"text" redact:"text"`
+	SentAt    time.Time `json:"sent_at"`
+	Type      string    `json:"type,omitempty"`
+	Truncated bool      `json:"truncated,omitempty"`
+}
+
+// MentionCreatedEvent is the payload of the mention.created event, emitted to the mentioned participant
+// unless their notification level of the chat is none, even if they muted the chat.
+type MentionCreatedEvent struct {
+	MentionID int64     `json:"mention_id"`
+	MessageID int64     `json:"message_id"`
+	ChatID    int64     `json:"chat_id"`
+	From      string    `json:"from" redact:"email"`
+	Email     string    `json:"email" redact:"email"`
+	Text      string    `json:"text" redact:"text"`
+	SentAt    time.Time `json:"sent_at"`
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Limits of the mentions of the messages and of the pages of the mentions inbox.
+const (
+	MessageMaxMentions = 50
+
+	ListMentionsDefaultLimit = 50
+	ListMentionsMaxLimit     = 200
+)
+
+// mentionPattern matches the mentions of the messages: an "@" at the start of a word followed by an email
+// or by a display name without spaces.
+var mentionPattern = regexp.MustCompile(`(?:^|[\s(])@([\p{L}\p{N}._%+\-]+(?:@[\p{L}\p{N}.\-]+)?)`)
+
+// ParseMentions returns the distinct lower-cased emails and display names mentioned in the text,
+// in their order of appearance, at most MessageMaxMentions of them.
+func ParseMentions(text string) []string {
+	var mentions []string
+
+	seen := make(map[string]struct{})
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		mention := strings.ToLower(strings.TrimRight(match[1], "."))
+		if mention == "" {
+			continue
+		}
+
+		if _, ok := seen[mention]; ok {
+			continue
+		}
+
+		seen[mention] = struct{}{}
+		mentions = append(mentions, mention)
+
+		if len(mentions) == MessageMaxMentions {
+			break
+		}
+	}
+
+	return mentions
+}
+
+// CreateMentionsParams holds a message and the names mentioned in it, resolved against the participants
+// of its chat.
+type CreateMentionsParams struct {
+	MessageID int64
+	ChatID    int64
+	From      string   `redact:"email"`
+	Mentions  []string `redact:"text"`
+}
+
+// MentionedUser represents a participant mentioned in a message, with their notification level of the chat.
+type MentionedUser struct {
+	MentionID         int64
+	Email             string `redact:"email"`
+	NotificationLevel string
+}
+
+// ListMentionsParams holds the user whose mentions are listed and the page: at most Limit mentions
+// older than the mention BeforeID, if set.
+type ListMentionsParams struct {
+	Email    string `json:"email" redact:"email"`
+	BeforeID int64  `json:"before_id"`
+	Limit    int64  `json:"limit"`
+}
+
+// Mention represents a mention of a user in a message.
+type Mention struct {
+	ID        int64     `json:"id"`
+	MessageID int64     `json:"message_id"`
+	ChatID    int64     `json:"chat_id"`
+	From      string    `json:"from" redact:"email"`
+	Text      string    `json:"text" redact:"text"`
+	SentAt    time.Time `json:"sent_at"`
+}
@@ -0,0 +1,77 @@
+package model
+
+import "time"
+
+// Statuses of the notifications. A notification is skipped if its recipient is back online before
+// it is sent, and failed once no channel accepted it after the maximum number of attempts.
+const (
+	NotificationPending = "pending"
+	NotificationSent    = "sent"
+	NotificationSkipped = "skipped"
+	NotificationFailed  = "failed"
+)
+
+// ClaimDueRecipientsParams holds the filter of the recipients due a digest: the ones with a notification
+// created before CreatedBefore, no digest since DigestBefore and no lease at Now. They are leased until LeaseUntil.
+type ClaimDueRecipientsParams struct {
+	CreatedBefore time.Time
+	DigestBefore  time.Time
+	Now           time.Time
+	LeaseUntil    time.Time
+	Limit         int
+}
+
+// NotificationRecipient represents a user with pending notifications.
+type NotificationRecipient struct {
+	UserID      int64
+	Email       string
+	DisplayName string
+	Online      bool
+}
+
+// Notification represents a pending notification of a user, with the payload of its event.
+type Notification struct {
+	ID        int64
+	EventType string
+	ChatID    int64
+	Payload   []byte
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// FinishNotificationsParams holds the final status of notifications.
+type FinishNotificationsParams struct {
+	IDs    []int64
+	Status string
+}
+
+// RecordNotificationsFailureParams holds notifications no channel accepted, which fail
+// after MaxAttempts attempts.
+type RecordNotificationsFailureParams struct {
+	IDs         []int64
+	MaxAttempts int
+}
+
+// UpdateRecipientParams holds the time of the last digest sent or attempted to a user.
+type UpdateRecipientParams struct {
+	UserID       int64
+	LastDigestAt time.Time
+}
+
+// NotificationItem is a message of a digest, decoded from the payload of the message.sent
+// or mention.created event. Mention reports whether the recipient was mentioned.
+type NotificationItem struct {
+	ChatID    int64     `json:"chat_id"`
+	MessageID int64     `json:"message_id"`
+	From      string    `json:"from" redact:"email"`
+	Text      string    `json:"text" redact:"text"`
+	SentAt    time.Time `json:"sent_at"`
+	Mention   bool      `json:"mention"`
+}
+
+// NotificationDigest represents the messages an offline user missed, sent through the notification channels.
+type NotificationDigest struct {
+	Email       string             `json:"email" redact:"email"`
+	DisplayName string             `json:"display_name"`
+	Items       []NotificationItem `json:"items"`
+}
@@ -0,0 +1,73 @@
+package model
+
+import "time"
+
+// Pin policies of the chats, telling who besides the administrators may pin and unpin their messages.
+const (
+	PinPolicyAdmins       = "admins"
+	PinPolicyParticipants = "participants"
+)
+
+// PinMessageParams holds the message to pin on behalf of a participant, or of an administrator if Admin is set.
+type PinMessageParams struct {
+	ChatID    int64  `json:"chat_id"`
+	MessageID int64  `json:"message_id"`
+	From      string `json:"from" redact:"email"`
+	Admin     bool   `json:"admin"`
+}
+
+// UnpinMessageParams holds the message to unpin on behalf of a participant, or of an administrator if Admin is set.
+type UnpinMessageParams struct {
+	ChatID    int64  `json:"chat_id"`
+	MessageID int64  `json:"message_id"`
+	From      string `json:"from" redact:"email"`
+	Admin     bool   `json:"admin"`
+}
+
+// ListPinnedMessagesParams holds the chat whose pinned messages are listed.
+type ListPinnedMessagesParams struct {
+	ChatID int64 `json:"chat_id"`
+}
+
+// SetPinPolicyParams holds the pin policy of a chat.
+type SetPinPolicyParams struct {
+	ChatID int64  `json:"chat_id"`
+	Policy string `json:"policy"`
+}
+
+// GetPinPolicyParams holds the chat whose pin policy is returned and the user acting on its pins.
+type GetPinPolicyParams struct {
+	ChatID int64
+	From   string `redact:"email"`
+}
+
+// PinPolicy represents the pin policy of a chat and whether the user acting on its pins participates in it.
+type PinPolicy struct {
+	Policy      string
+	Participant bool
+}
+
+// Allows reports whether a user, an administrator if admin is set, may pin and unpin the messages of the chat.
+func (p PinPolicy) Allows(admin bool) bool {
+	return admin || (p.Policy == PinPolicyParticipants && p.Participant)
+}
+
+// PinnedMessage represents a message pinned in its chat, with who pinned it and when.
+type PinnedMessage struct {
+	ChatID    int64     `json:"chat_id"`
+	MessageID int64     `json:"message_id"`
+	From      string    `json:"from" redact:"email"`
+	Text      string    `json:"text" redact:"text"`
+	Type      string    `json:"type"`
+	SentAt    time.Time `json:"sent_at"`
+	PinnedBy  string    `json:"pinned_by" redact:"email"`
+	PinnedAt  time.Time `json:"pinned_at"`
+	Truncated bool      `json:"truncated,omitempty"`
+}
+
+// UnpinnedMessage is the payload of the live update of a message unpinned from its chat.
+type UnpinnedMessage struct {
+	ChatID     int64  `json:"chat_id"`
+	MessageID  int64  `json:"message_id"`
+	UnpinnedBy string `json:"unpinned_by" redact:"email"`
+}
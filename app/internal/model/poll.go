@@ -0,0 +1,128 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// Limits of the polls.
+const (
+	PollMinOptions        = 2
+	PollMaxOptions        = 10
+	PollMaxQuestionLength = 300
+	PollMaxOptionLength   = 100
+)
+
+// Types of the messages.
+const (
+	MessageTypeText = "text"
+	MessageTypePoll = "poll"
+)
+
+// CreatePollParams holds the parameters for posting a poll to a chat. A zero ClosesAt never closes it.
+// MessageID is set by the service to the message the poll is posted as.
+type CreatePollParams struct {
+	ChatID      int64     `json:"chat_id"`
+	MessageID   int64     `json:"message_id"`
+	From        string    `json:"from" redact:"email"`
+	Question    string    `json:"question" redact:"text"`
+	Options     []string  `json:"options" redact:"text"`
+	MultiChoice bool      `json:"multi_choice"`
+	ClosesAt    time.Time `json:"closes_at"`
+}
+
+// Validate checks the question and the options of the poll against the limits of the polls.
+// The options must be distinct.
+func (p CreatePollParams) Validate() error {
+	if strings.TrimSpace(p.Question) == "" || utf8.RuneCountInString(p.Question) > PollMaxQuestionLength {
+		return errors.Errorf("question is required and must be at most %d characters", PollMaxQuestionLength)
+	}
+
+	if len(p.Options) < PollMinOptions || len(p.Options) > PollMaxOptions {
+		return errors.Errorf("a poll has %d to %d options", PollMinOptions, PollMaxOptions)
+	}
+
+	seen := make(map[string]struct{}, len(p.Options))
+	for _, option := range p.Options {
+		if strings.TrimSpace(option) == "" || utf8.RuneCountInString(option) > PollMaxOptionLength {
+			return errors.Errorf("options are required and must be at most %d characters", PollMaxOptionLength)
+		}
+
+		if _, ok := seen[option]; ok {
+			return errors.Errorf("duplicate option %q", option)
+		}
+
+		seen[option] = struct{}{}
+	}
+
+	return nil
+}
+
+// CreatePollResponse represents the response after creating a poll, including its message.
+type CreatePollResponse struct {
+	PollID    int64 `json:"poll_id"`
+	MessageID int64 `json:"message_id"`
+}
+
+// VoteParams holds the ballot of a participant of the chat of a poll.
+type VoteParams struct {
+	PollID    int64   `json:"poll_id"`
+	From      string  `json:"from" redact:"email"`
+	OptionIDs []int64 `json:"option_ids"`
+}
+
+// ClosePollParams holds the poll to close on behalf of its author, or of an administrator if Admin is set.
+// ClosedAt is set by the service.
+type ClosePollParams struct {
+	PollID   int64     `json:"poll_id"`
+	From     string    `json:"from" redact:"email"`
+	Admin    bool      `json:"admin"`
+	ClosedAt time.Time `json:"closed_at"`
+}
+
+// GetPollParams holds the ID of the poll to be returned.
+type GetPollParams struct {
+	PollID int64 `json:"poll_id"`
+}
+
+// PollOption represents an option of a poll with the number of ballots choosing it.
+type PollOption struct {
+	ID    int64  `json:"id"`
+	Text  string `json:"text" redact:"text"`
+	Votes int64  `json:"votes"`
+}
+
+// Poll represents a poll with its results.
+type Poll struct {
+	ID                   int64        `json:"id"`
+	ChatID               int64        `json:"chat_id"`
+	MessageID            int64        `json:"message_id"`
+	CreatedBy            string       `json:"created_by" redact:"email"`
+	CreatedByDisplayName string       `json:"created_by_display_name,omitempty" redact:"text"`
+	Question             string       `json:"question" redact:"text"`
+	MultiChoice          bool         `json:"multi_choice"`
+	Options              []PollOption `json:"options"`
+	Voters               int64        `json:"voters"`
+	ClosesAt             *time.Time   `json:"closes_at,omitempty"`
+	ClosedAt             *time.Time   `json:"closed_at,omitempty"`
+	CreatedAt            time.Time    `json:"created_at"`
+}
+
+// IsClosed reports whether the voting of the poll is over at the given time.
+func (p Poll) IsClosed(now time.Time) bool {
+	return p.ClosedAt != nil || (p.ClosesAt != nil && !now.Before(*p.ClosesAt))
+}
+
+// HasOption reports whether the option belongs to the poll.
+func (p Poll) HasOption(optionID int64) bool {
+	for _, option := range p.Options {
+		if option.ID == optionID {
+			return true
+		}
+	}
+
+	return false
+}
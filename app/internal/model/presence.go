@@ -0,0 +1,67 @@
+package model
+
+import "time"
+
+// Presence statuses of the users.
+const (
+	PresenceOnline  = "online"
+	PresenceAway    = "away"
+	PresenceOffline = "offline"
+)
+
+// ConnectParams holds the user connected to a stream, online while connected.
+type ConnectParams struct {
+	Email string `redact:"email"`
+}
+
+// HeartbeatParams holds the heartbeat of a user, who is away if idle.
+type HeartbeatParams struct {
+	Email string `json:"email" redact:"email"`
+	Away  bool   `json:"away"`
+}
+
+// GetPresenceParams holds the emails of the users whose presence is returned.
+type GetPresenceParams struct {
+	Emails []string `json:"emails" redact:"email"`
+}
+
+// Presence represents the presence status of a user and the last time the user was seen online or away.
+type Presence struct {
+	Email       string     `json:"email" redact:"email"`
+	DisplayName string     `json:"display_name,omitempty" redact:"text"`
+	Status      string     `json:"status"`
+	LastSeenAt  *time.Time `json:"last_seen_at,omitempty"`
+}
+
+// TouchPresenceParams holds the users seen by an instance at SeenAt.
+type TouchPresenceParams struct {
+	InstanceID string
+	Emails     []string `redact:"email"`
+	Away       bool
+	SeenAt     time.Time
+}
+
+// DeletePresenceSessionParams holds the session of a user on an instance to delete.
+type DeletePresenceSessionParams struct {
+	InstanceID string
+	Email      string `redact:"email"`
+}
+
+// RefreshPresenceParams holds the users whose presence status is recomputed from the sessions seen
+// after Since, all the users not offline or with a fresh session if Emails is empty.
+type RefreshPresenceParams struct {
+	Emails []string `redact:"email"`
+	Since  time.Time
+}
+
+// ListPresenceParams holds the users whose presence is computed from the sessions seen after Since.
+type ListPresenceParams struct {
+	Emails []string `redact:"email"`
+	Since  time.Time
+}
+
+// PresenceChange represents a new presence status of a user with the chats the user participates in.
+type PresenceChange struct {
+	Presence Presence
+	ChatIDs  []int64
+}
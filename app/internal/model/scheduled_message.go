@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+// Statuses of the scheduled messages.
+const (
+	ScheduledMessagePending   = "pending"
+	ScheduledMessageSent      = "sent"
+	ScheduledMessageCancelled = "cancelled"
+	ScheduledMessageFailed    = "failed"
+)
+
+// ScheduleMessageParams holds a message to send to a chat at SendAt.
+type ScheduleMessageParams struct {
+	ChatID int64     `json:"chat_id"`
+	From   string    `json:"from" redact:"email"`
+	Text   string    `json:"text" redact:"text"`
+	SendAt time.Time `json:"send_at"`
+}
+
+// ListScheduledMessagesParams holds the sender whose pending scheduled messages are listed,
+// in the chat if ChatID is set, in all chats otherwise.
+type ListScheduledMessagesParams struct {
+	From   string `json:"from" redact:"email"`
+	ChatID int64  `json:"chat_id"`
+}
+
+// CancelScheduledMessageParams holds the scheduled message to cancel on behalf of its sender.
+// CancelledAt is set by the service.
+type CancelScheduledMessageParams struct {
+	ScheduledMessageID int64     `json:"scheduled_message_id"`
+	From               string    `json:"from" redact:"email"`
+	CancelledAt        time.Time `json:"cancelled_at"`
+}
+
+// ScheduledMessage represents a message waiting to be sent to a chat.
+type ScheduledMessage struct {
+	ID        int64     `json:"id"`
+	ChatID    int64     `json:"chat_id"`
+	From      string    `json:"from" redact:"email"`
+	Text      string    `json:"text" redact:"text"`
+	SendAt    time.Time `json:"send_at"`
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RecordScheduledMessageFailureParams holds a failed attempt to send a scheduled message at FailedAt,
+// which is retried at NextAttemptAt unless it reached MaxAttempts attempts.
+type RecordScheduledMessageFailureParams struct {
+	ScheduledMessageID int64
+	Error              string
+	FailedAt           time.Time
+	NextAttemptAt      time.Time
+	MaxAttempts        int
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+	"unicode/utf8"
+)
+
+// Types of the live updates of the chats.
+const (
+	UpdateTypeMessageSent     = "message.sent"
+	UpdateTypeMessagePinned   = "message.pinned"
+	UpdateTypeMessageUnpinned = "message.unpinned"
+	UpdateTypePollUpdated     = "poll.updated"
+	UpdateTypeTyping          = "typing"
+	UpdateTypePresence        = "presence.updated"
+)
+
+// MaxUpdateTextLength is the maximum number of characters of the text of a message in a live update.
+// The updates are sent as PostgreSQL notifications, whose payload must stay below 8000 bytes, so the longer
+// texts are cut and the update is marked truncated, the clients getting the whole message with ListMessages.
+// A character takes 6 bytes at most once encoded to JSON.
+const MaxUpdateTextLength = 1000
+
+// TruncateUpdateText returns the text cut to MaxUpdateTextLength characters and whether it was cut.
+func TruncateUpdateText(text string) (string, bool) {
+	if utf8.RuneCountInString(text) <= MaxUpdateTextLength {
+		return text, false
+	}
+
+	runes := 0
+	for i := range text {
+		if runes == MaxUpdateTextLength {
+			return text[:i], true
+		}
+
+		runes++
+	}
+
+	return text, false
+}
+
+// CreateChatUpdateParams holds a live update of a chat to broadcast to its subscribers.
+// The payload is encoded to JSON. An ephemeral update expires after its TTL, a zero TTL never expires.
+// Sender is the user the update originates from, if any, hidden from the subscribers blocking them.
+type CreateChatUpdateParams struct {
+	ChatID  int64
+	Type    string
+	Sender  string
+	Payload interface{}
+	TTL     time.Duration
+}
+
+// ChatUpdate represents a live update of a chat, broadcast to its subscribers but not stored.
+type ChatUpdate struct {
+	ChatID    int64           `json:"chat_id"`
+	Type      string          `json:"type"`
+	Sender    string          `json:"sender,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+	ExpiresAt *time.Time      `json:"expires_at,omitempty"`
+}
+
+// IsExpired reports whether the update is no longer relevant at the given time.
+func (u ChatUpdate) IsExpired(now time.Time) bool {
+	return u.ExpiresAt != nil && !now.Before(*u.ExpiresAt)
+}
+
+// SubscribeParams holds the chat whose live updates are streamed and the subscriber, a participant
+// of the chat, who is online while subscribed.
+type SubscribeParams struct {
+	ChatID int64
+	From   string `redact:"email"`
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// Limits of the profiles of the users and of the searches of the directory.
+const (
+	UserMaxDisplayNameLength = 64
+	UserMaxStatusTextLength  = 140
+	UserMaxAvatarURLLength   = 2048
+
+	SearchUsersDefaultLimit = 20
+	SearchUsersMaxLimit     = 100
+)
+
+// User represents a user of the chats with their profile.
+type User struct {
+	ID          int64     `json:"id"`
+	Email       string    `json:"email" redact:"email"`
+	DisplayName string    `json:"display_name" redact:"text"`
+	AvatarURL   string    `json:"avatar_url"`
+	StatusText  string    `json:"status_text" redact:"text"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// GetUserParams holds the email of the user to be returned.
+type GetUserParams struct {
+	Email string `json:"email" redact:"email"`
+}
+
+// UpdateProfileParams holds the profile of a user to update, the nil fields are left unchanged.
+type UpdateProfileParams struct {
+	Email       string  `json:"email" redact:"email"`
+	DisplayName *string `json:"display_name,omitempty" redact:"text"`
+	AvatarURL   *string `json:"avatar_url,omitempty"`
+	StatusText  *string `json:"status_text,omitempty" redact:"text"`
+}
+
+// Validate checks the profile against the limits of the profiles. The avatar is an absolute http(s) URL,
+// or empty to remove it.
+func (p UpdateProfileParams) Validate() error {
+	if p.DisplayName != nil && utf8.RuneCountInString(*p.DisplayName) > UserMaxDisplayNameLength {
+		return errors.Errorf("display_name must be at most %d characters", UserMaxDisplayNameLength)
+	}
+
+	if p.StatusText != nil && utf8.RuneCountInString(*p.StatusText) > UserMaxStatusTextLength {
+		return errors.Errorf("status_text must be at most %d characters", UserMaxStatusTextLength)
+	}
+
+	if p.AvatarURL != nil && *p.AvatarURL != "" {
+		if len(*p.AvatarURL) > UserMaxAvatarURLLength {
+			return errors.Errorf("avatar_url must be at most %d characters", UserMaxAvatarURLLength)
+		}
+
+		u, err := url.Parse(*p.AvatarURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("avatar_url must be an absolute http(s) URL")
+		}
+	}
+
+	return nil
+}
+
+// SearchUsersParams holds the prefix of the emails or of the display names of the users to find.
+type SearchUsersParams struct {
+	Query string `json:"query" redact:"text"`
+	Limit int64  `json:"limit"`
+}
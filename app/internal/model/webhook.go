@@ -0,0 +1,124 @@
+package model
+
+import "time"
+
+// Statuses of the webhook deliveries.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// CreateWebhookParams holds the parameters for subscribing an endpoint to chat events.
+// A zero ChatID subscribes to the events of all chats, no EventTypes to all types.
+// The secret is generated by the service.
+type CreateWebhookParams struct {
+	URL        string   `json:"url"`
+	ChatID     int64    `json:"chat_id"`
+	EventTypes []string `json:"event_types"`
+	Secret     string   `json:"secret"`
+}
+
+// CreateWebhookResponse represents the response after creating a webhook, including the signing secret.
+type CreateWebhookResponse struct {
+	WebhookID int64  `json:"webhook_id"`
+	Secret    string `json:"secret"`
+}
+
+// ListWebhooksParams holds the filter of the webhooks to list, all webhooks for a zero ChatID.
+type ListWebhooksParams struct {
+	ChatID int64 `json:"chat_id"`
+}
+
+// DeleteWebhookParams holds the ID of the webhook to be deleted.
+type DeleteWebhookParams struct {
+	WebhookID int64 `json:"webhook_id"`
+}
+
+// Webhook represents an endpoint subscribed to chat events.
+type Webhook struct {
+	ID                  int64
+	URL                 string
+	ChatID              int64
+	EventTypes          []string
+	Disabled            bool
+	ConsecutiveFailures int
+	CreatedAt           time.Time
+}
+
+// WebhookDelivery represents a pending delivery of an event to a webhook, with the endpoint and its secret.
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	URL            string
+	Secret         string
+	EventID        int64
+	EventType      string
+	ChatID         int64
+	Payload        []byte
+	EventCreatedAt time.Time
+	Attempts       int
+}
+
+// CreateWebhookAttemptParams holds the outcome of an attempt to deliver an event to a webhook.
+// StatusCode is zero if no response was received.
+type CreateWebhookAttemptParams struct {
+	DeliveryID int64
+	StatusCode int
+	Error      string
+	Duration   time.Duration
+}
+
+// UpdateWebhookDeliveryParams holds the state of a delivery after an attempt.
+type UpdateWebhookDeliveryParams struct {
+	DeliveryID    int64
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+}
+
+// RecordWebhookResultParams holds the outcome of an attempt for the health of the webhook,
+// which is disabled after DisableAfter consecutive failures.
+type RecordWebhookResultParams struct {
+	WebhookID    int64
+	Success      bool
+	DisableAfter int
+}
+
+// CreateIncomingWebhookParams holds the parameters for creating an incoming webhook posting to a chat
+// as the bot named Name. The token is generated by the service, only its hash is stored.
+type CreateIncomingWebhookParams struct {
+	ChatID    int64  `json:"chat_id"`
+	Name      string `json:"name"`
+	TokenHash string `json:"token_hash"`
+}
+
+// CreateIncomingWebhookResponse represents the response after creating an incoming webhook, including its token.
+type CreateIncomingWebhookResponse struct {
+	IncomingWebhookID int64  `json:"incoming_webhook_id"`
+	Token             string `json:"token"`
+}
+
+// ListIncomingWebhooksParams holds the filter of the incoming webhooks to list, all of them for a zero ChatID.
+type ListIncomingWebhooksParams struct {
+	ChatID int64 `json:"chat_id"`
+}
+
+// DeleteIncomingWebhookParams holds the ID of the incoming webhook to be deleted.
+type DeleteIncomingWebhookParams struct {
+	IncomingWebhookID int64 `json:"incoming_webhook_id"`
+}
+
+// IncomingWebhook represents a token allowing external tools to post messages to a chat.
+type IncomingWebhook struct {
+	ID        int64
+	ChatID    int64
+	Name      string
+	CreatedAt time.Time
+}
+
+// PostIncomingMessageParams holds a message posted through an incoming webhook authenticated by Token.
+type PostIncomingMessageParams struct {
+	Token string
+	Text  string `redact:"text"`
+}
@@ -0,0 +1,16 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+// Channel delivers the digests of the notifications to the offline users.
+type Channel interface {
+	// Name returns the name of the channel, used in the logs.
+	Name() string
+
+	// Send delivers the digest and returns once it is accepted.
+	Send(ctx context.Context, digest model.NotificationDigest) error
+}
@@ -0,0 +1,211 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+)
+
+// Dispatcher sends the pending notifications to their recipients in digests through the channels.
+// A digest is sent once the oldest of its notifications waited DigestDelay, and at most once every RateLimit
+// to a user. It succeeds if any channel accepts it, otherwise its notifications are retried in the next digest
+// until MaxAttempts. The notifications of a user back online are skipped, the user sees the messages in the chats.
+// Several instances share the recipients, each claiming its own for a lease. A digest is sent outside
+// of any transaction and its outcome is recorded in a short one, so a digest is sent again only if
+// the instance sending it dies before recording it.
+type Dispatcher struct {
+	notificationRepository repository.NotificationRepository
+	txManager              db.TxManager
+	channels               []Channel
+	cfg                    config.Notifications
+}
+
+// NewDispatcher creates a new instance of Dispatcher with the provided repository, transaction manager,
+// channels and settings.
+func NewDispatcher(
+	notificationRepository repository.NotificationRepository,
+	txManager db.TxManager,
+	channels []Channel,
+	cfg config.Notifications,
+) *Dispatcher {
+	return &Dispatcher{
+		notificationRepository: notificationRepository,
+		txManager:              txManager,
+		channels:               channels,
+		cfg:                    cfg,
+	}
+}
+
+// Run sends the due digests immediately and then periodically until the context is cancelled.
+// A full batch is followed by the next one without waiting, to catch up with a backlog.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		processed, err := d.RunOnce(ctx, time.Now())
+		if err != nil {
+			slog.Error("notification dispatch failed", slog.String("error", err.Error()))
+		}
+
+		if err == nil && processed == d.cfg.BatchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce sends a batch of digests due at now and deletes the notifications finished before
+// the retention period. It returns the number of recipients processed.
+func (d *Dispatcher) RunOnce(ctx context.Context, now time.Time) (processed int, err error) {
+	now = now.UTC()
+
+	recipients, err := d.notificationRepository.ClaimDueRecipients(ctx, model.ClaimDueRecipientsParams{
+		CreatedBefore: now.Add(-d.cfg.DigestDelay),
+		DigestBefore:  now.Add(-d.cfg.RateLimit),
+		Now:           now,
+		LeaseUntil:    now.Add(d.cfg.Lease),
+		Limit:         d.cfg.BatchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, recipient := range recipients {
+		err = d.notify(ctx, recipient, now)
+		if err != nil {
+			return processed, err
+		}
+
+		processed++
+	}
+
+	if d.cfg.Retention > 0 {
+		_, err = d.notificationRepository.DeleteFinishedNotifications(ctx, now.Add(-d.cfg.Retention))
+		if err != nil {
+			return processed, err
+		}
+	}
+
+	return processed, nil
+}
+
+// notify sends the digest of the pending notifications of the claimed recipient and records its outcome.
+// The lease of the recipient is released on every outcome, the lease expiring only if recording it fails.
+func (d *Dispatcher) notify(ctx context.Context, recipient model.NotificationRecipient, now time.Time) error {
+	notifications, err := d.notificationRepository.ListPendingNotifications(ctx, recipient.UserID, d.cfg.MaxPerDigest)
+	if err != nil {
+		return err
+	}
+
+	if len(notifications) == 0 {
+		return d.notificationRepository.ReleaseRecipient(ctx, recipient.UserID)
+	}
+
+	ids := make([]int64, len(notifications))
+	for i, notification := range notifications {
+		ids[i] = notification.ID
+	}
+
+	if recipient.Online {
+		return d.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+			txErr := d.notificationRepository.FinishNotifications(ctx, model.FinishNotificationsParams{
+				IDs:    ids,
+				Status: model.NotificationSkipped,
+			})
+			if txErr != nil {
+				return txErr
+			}
+
+			return d.notificationRepository.ReleaseRecipient(ctx, recipient.UserID)
+		})
+	}
+
+	sent := d.send(ctx, digest(recipient, notifications))
+
+	return d.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		if sent {
+			txErr = d.notificationRepository.FinishNotifications(ctx, model.FinishNotificationsParams{
+				IDs:    ids,
+				Status: model.NotificationSent,
+			})
+		} else {
+			txErr = d.notificationRepository.RecordNotificationsFailure(ctx, model.RecordNotificationsFailureParams{
+				IDs:         ids,
+				MaxAttempts: d.cfg.MaxAttempts,
+			})
+		}
+
+		if txErr != nil {
+			return txErr
+		}
+
+		return d.notificationRepository.UpdateRecipient(ctx, model.UpdateRecipientParams{
+			UserID:       recipient.UserID,
+			LastDigestAt: now,
+		})
+	})
+}
+
+// send sends the digest through every channel and reports whether any of them accepted it.
+func (d *Dispatcher) send(ctx context.Context, digest model.NotificationDigest) (sent bool) {
+	for _, channel := range d.channels {
+		err := channel.Send(ctx, digest)
+		if err != nil {
+			slog.Warn("notification digest failed",
+				slog.String("channel", channel.Name()),
+				slog.Int("items", len(digest.Items)),
+				slog.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		sent = true
+	}
+
+	return sent
+}
+
+// digest returns the digest of the notifications of the recipient. The notifications whose payload
+// cannot be decoded are left out.
+func digest(recipient model.NotificationRecipient, notifications []model.Notification) model.NotificationDigest {
+	result := model.NotificationDigest{
+		Email:       recipient.Email,
+		DisplayName: recipient.DisplayName,
+		Items:       make([]model.NotificationItem, 0, len(notifications)),
+	}
+
+	for _, notification := range notifications {
+		var item model.NotificationItem
+
+		err := json.Unmarshal(notification.Payload, &item)
+		if err != nil {
+			slog.Warn("cannot decode notification",
+				slog.Int64("notification_id", notification.ID),
+				slog.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		item.Mention = notification.EventType == model.EventTypeMentionCreated
+		result.Items = append(result.Items, item)
+	}
+
+	return result
+}
@@ -0,0 +1,155 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+type emailChannel struct {
+	cfg config.EmailNotifications
+}
+
+// NewEmailChannel creates a Channel sending the digests by email through the configured SMTP server,
+// upgrading the connection with STARTTLS if the server supports it.
+func NewEmailChannel(cfg config.EmailNotifications) Channel {
+	return &emailChannel{
+		cfg: cfg,
+	}
+}
+
+// Name returns the name of the channel.
+func (c *emailChannel) Name() string {
+	return "email"
+}
+
+// Send sends the digest to the email of its recipient within the configured timeout.
+func (c *emailChannel) Send(ctx context.Context, digest model.NotificationDigest) error {
+	if c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+
+	host, _, err := net.SplitHostPort(c.cfg.Address)
+	if err != nil {
+		return errors.Wrapf(err, "Invalid SMTP address %q", c.cfg.Address)
+	}
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", c.cfg.Address)
+	if err != nil {
+		return errors.Wrap(err, "Cannot connect to SMTP server")
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return errors.Wrap(err, "Cannot start SMTP session")
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12})
+		if err != nil {
+			return errors.Wrap(err, "Cannot start TLS")
+		}
+	}
+
+	if c.cfg.Username != "" {
+		err = client.Auth(smtp.PlainAuth("", c.cfg.Username, c.cfg.Password, host))
+		if err != nil {
+			return errors.Wrap(err, "Cannot authenticate to SMTP server")
+		}
+	}
+
+	err = client.Mail(c.cfg.From)
+	if err != nil {
+		return errors.Wrap(err, "SMTP server rejected the sender")
+	}
+
+	err = client.Rcpt(digest.Email)
+	if err != nil {
+		return errors.Wrap(err, "SMTP server rejected the recipient")
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "Cannot send email")
+	}
+
+	_, err = w.Write(c.message(digest, time.Now()))
+	if err != nil {
+		return errors.Wrap(err, "Cannot send email")
+	}
+
+	err = w.Close()
+	if err != nil {
+		return errors.Wrap(err, "SMTP server rejected the email")
+	}
+
+	return client.Quit()
+}
+
+// message returns the plain text email of the digest.
+func (c *emailChannel) message(digest model.NotificationDigest, now time.Time) []byte {
+	var buf bytes.Buffer
+
+	to := mail.Address{Name: digest.DisplayName, Address: digest.Email}
+
+	fmt.Fprintf(&buf, "From: %s\r\n", (&mail.Address{Address: c.cfg.From}).String())
+	fmt.Fprintf(&buf, "To: %s\r\n", to.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", subject(len(digest.Items)))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+
+	name := digest.DisplayName
+	if name == "" {
+		name = digest.Email
+	}
+
+	fmt.Fprintf(&buf, "Hi %s,\r\n\r\nYou missed %s while you were away.\r\n", name, messages(len(digest.Items)))
+
+	for _, item := range digest.Items {
+		action := "wrote"
+		if item.Mention {
+			action = "mentioned you"
+		}
+
+		fmt.Fprintf(&buf, "\r\nChat %d, %s %s at %s:\r\n%s\r\n",
+			item.ChatID, item.From, action, item.SentAt.UTC().Format("2006-01-02 15:04 MST"), item.Text)
+	}
+
+	return buf.Bytes()
+}
+
+// subject returns the subject of a digest of n messages.
+func subject(n int) string {
+	return fmt.Sprintf("You have %s", messages(n))
+}
+
+// messages returns the number of new messages in words.
+func messages(n int) string {
+	if n == 1 {
+		return "1 new message"
+	}
+
+	return fmt.Sprintf("%d new messages", n)
+}
@@ -0,0 +1,192 @@
+// Package notificationtest provides an SMTP server keeping the emails it receives in memory, to test
+// the email notifications without a mail server.
+package notificationtest
+
+import (
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+// SMTPMessage is an email received by an SMTPServer.
+type SMTPMessage struct {
+	From string
+	To   []string
+	Data string
+}
+
+// SMTPServer is an SMTP server listening on the loopback interface and keeping the received emails
+// in memory. It supports neither TLS nor authentication.
+type SMTPServer struct {
+	listener net.Listener
+
+	mu        sync.Mutex
+	messages  []SMTPMessage
+	rejecting bool
+
+	wg sync.WaitGroup
+}
+
+// NewSMTPServer starts an SMTPServer on a random port of the loopback interface.
+func NewSMTPServer() (*SMTPServer, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &SMTPServer{
+		listener: listener,
+	}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	return s, nil
+}
+
+// Addr returns the address the server listens on.
+func (s *SMTPServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Messages returns the received emails in the order they were received.
+func (s *SMTPServer) Messages() []SMTPMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]SMTPMessage(nil), s.messages...)
+}
+
+// SetRejecting makes the server reject the recipients of the next emails, or accept them again.
+func (s *SMTPServer) SetRejecting(rejecting bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rejecting = rejecting
+}
+
+// Close stops the server and waits for the open sessions to end.
+func (s *SMTPServer) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+
+	return err
+}
+
+func (s *SMTPServer) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.session(conn)
+		}()
+	}
+}
+
+// session handles the commands of a client until it quits or disconnects.
+func (s *SMTPServer) session(conn net.Conn) {
+	c := textproto.NewConn(conn)
+	defer c.Close()
+
+	var message SMTPMessage
+
+	reply := func(format string, args ...interface{}) bool {
+		return c.PrintfLine(format, args...) == nil
+	}
+
+	if !reply("220 localhost fake SMTP server") {
+		return
+	}
+
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "HELO", "EHLO":
+			message = SMTPMessage{}
+			if !reply("250 localhost") {
+				return
+			}
+		case "MAIL":
+			message = SMTPMessage{From: address(arg)}
+			if !reply("250 OK") {
+				return
+			}
+		case "RCPT":
+			s.mu.Lock()
+			rejecting := s.rejecting
+			s.mu.Unlock()
+
+			if rejecting {
+				if !reply("550 mailbox unavailable") {
+					return
+				}
+
+				continue
+			}
+
+			message.To = append(message.To, address(arg))
+			if !reply("250 OK") {
+				return
+			}
+		case "DATA":
+			if !reply("354 End data with <CR><LF>.<CR><LF>") {
+				return
+			}
+
+			lines, err := c.ReadDotLines()
+			if err != nil {
+				return
+			}
+
+			message.Data = strings.Join(lines, "\r\n")
+
+			s.mu.Lock()
+			s.messages = append(s.messages, message)
+			s.mu.Unlock()
+
+			message = SMTPMessage{}
+			if !reply("250 OK") {
+				return
+			}
+		case "RSET":
+			message = SMTPMessage{}
+			if !reply("250 OK") {
+				return
+			}
+		case "NOOP":
+			if !reply("250 OK") {
+				return
+			}
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			if !reply("502 command not implemented") {
+				return
+			}
+		}
+	}
+}
+
+// address returns the address of a MAIL FROM or RCPT TO argument.
+func address(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr = strings.TrimSpace(addr)
+	addr, _, _ = strings.Cut(addr, " ")
+
+	return strings.Trim(addr, "<>")
+}
@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/outbox"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+)
+
+type publisher struct {
+	notificationRepository repository.NotificationRepository
+}
+
+// NewPublisher creates an EventPublisher notifying the offline participants of the sent messages and
+// of their mentions. The outbox relay publishes an event at least once and outside of its transactions,
+// so the notifications of an event published again are created only once. They are sent by the Dispatcher.
+func NewPublisher(notificationRepository repository.NotificationRepository) outbox.EventPublisher {
+	return &publisher{
+		notificationRepository: notificationRepository,
+	}
+}
+
+// Publish creates the notifications of a message.sent or mention.created event and ignores the other events.
+func (p *publisher) Publish(ctx context.Context, event model.Event) error {
+	switch event.Type {
+	case model.EventTypeMessageSent, model.EventTypeMentionCreated:
+		return p.notificationRepository.CreateNotifications(ctx, event)
+	default:
+		return nil
+	}
+}
+
+// Close does nothing, notifications are sent by the Dispatcher.
+func (p *publisher) Close() error {
+	return nil
+}
@@ -0,0 +1,77 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/webhook"
+)
+
+// EventDigest is the event header of the digests POSTed to the push provider.
+const EventDigest = "notification.digest"
+
+// maxErrorBodySize is the number of bytes of the body of a failed response reported in the error.
+const maxErrorBodySize = 512
+
+type pushChannel struct {
+	client *http.Client
+	cfg    config.PushNotifications
+}
+
+// NewPushChannel creates a Channel POSTing the digests as JSON to a push provider, with the event,
+// timestamp and signature headers of the webhook deliveries.
+func NewPushChannel(cfg config.PushNotifications) Channel {
+	return &pushChannel{
+		client: &http.Client{Timeout: cfg.Timeout},
+		cfg:    cfg,
+	}
+}
+
+// Name returns the name of the channel.
+func (c *pushChannel) Name() string {
+	return "push"
+}
+
+// Send POSTs the signed digest and returns an error unless the status code of the response is 2xx.
+func (c *pushChannel) Send(ctx context.Context, digest model.NotificationDigest) error {
+	body, err := json.Marshal(digest)
+	if err != nil {
+		return errors.Wrap(err, "Cannot encode digest")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "Cannot create request")
+	}
+
+	timestamp := time.Now().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.HeaderEvent, EventDigest)
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(c.cfg.Secret, timestamp, body))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return errors.Errorf("unexpected status %d: %s", resp.StatusCode, respBody)
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/notification"
+	"github.com/Prrromanssss/chat-server/internal/notification/notificationtest"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	"github.com/Prrromanssss/chat-server/internal/webhook"
+)
+
+func txManagerMock(mc *minimock.Controller) db.TxManager {
+	mock := dbMocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	return mock
+}
+
+func TestDispatcherRunOnce(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		now = time.Date(2024, time.October, 20, 12, 0, 0, 0, time.UTC)
+		cfg = config.Notifications{
+			BatchSize:    10,
+			DigestDelay:  2 * time.Minute,
+			RateLimit:    15 * time.Minute,
+			MaxPerDigest: 20,
+			MaxAttempts:  5,
+			Retention:    time.Hour,
+			Lease:        time.Minute,
+		}
+
+		secret = "secret"
+		sentAt = now.Add(-5 * time.Minute)
+
+		recipient = model.NotificationRecipient{
+			UserID:      1,
+			Email:       "bob@example.com",
+			DisplayName: "Bob",
+		}
+
+		notifications = []model.Notification{
+			{
+				ID:        10,
+				EventType: model.EventTypeMessageSent,
+				ChatID:    4,
+				Payload:   []byte(`{"message_id":5,"chat_id":4,"from":"alice@example.com","text":"hello","sent_at":"2024-10-20T11:55:00Z"}`),
+			},
+			{
+				ID:        11,
+				EventType: model.EventTypeMentionCreated,
+				ChatID:    4,
+				Payload:   []byte(`{"mention_id":7,"message_id":6,"chat_id":4,"from":"alice@example.com","email":"bob@example.com","text":"hi @bob","sent_at":"2024-10-20T11:55:00Z"}`),
+			},
+		}
+
+		expectedDigest = model.NotificationDigest{
+			Email:       recipient.Email,
+			DisplayName: recipient.DisplayName,
+			Items: []model.NotificationItem{
+				{ChatID: 4, MessageID: 5, From: "alice@example.com", Text: "hello", SentAt: sentAt},
+				{ChatID: 4, MessageID: 6, From: "alice@example.com", Text: "hi @bob", SentAt: sentAt, Mention: true},
+			},
+		}
+	)
+
+	tests := []struct {
+		name           string
+		empty          bool
+		online         bool
+		smtpRejecting  bool
+		pushStatusCode int
+		expectedStatus string
+		expectedEmails int
+		expectedPushes int
+	}{
+		{
+			name:           "sent through every channel",
+			pushStatusCode: http.StatusNoContent,
+			expectedStatus: model.NotificationSent,
+			expectedEmails: 1,
+			expectedPushes: 1,
+		},
+		{
+			name:           "sent if any channel accepts the digest",
+			smtpRejecting:  true,
+			pushStatusCode: http.StatusOK,
+			expectedStatus: model.NotificationSent,
+			expectedPushes: 1,
+		},
+		{
+			name:           "failure recorded if no channel accepts the digest",
+			smtpRejecting:  true,
+			pushStatusCode: http.StatusServiceUnavailable,
+			expectedStatus: model.NotificationPending,
+			expectedPushes: 1,
+		},
+		{
+			name:           "skipped for a recipient back online",
+			online:         true,
+			pushStatusCode: http.StatusNoContent,
+			expectedStatus: model.NotificationSkipped,
+		},
+		{
+			name:           "lease released if nothing is pending",
+			empty:          true,
+			pushStatusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			smtpServer, err := notificationtest.NewSMTPServer()
+			require.NoError(t, err)
+			defer smtpServer.Close()
+
+			smtpServer.SetRejecting(tt.smtpRejecting)
+
+			pushes := make(chan model.NotificationDigest, 1)
+			pushServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				timestamp, err := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+				require.NoError(t, err)
+				require.Equal(t, webhook.Sign(secret, timestamp, body), r.Header.Get(webhook.HeaderSignature))
+				require.Equal(t, notification.EventDigest, r.Header.Get(webhook.HeaderEvent))
+
+				var digest model.NotificationDigest
+				require.NoError(t, json.Unmarshal(body, &digest))
+				pushes <- digest
+
+				w.WriteHeader(tt.pushStatusCode)
+			}))
+			defer pushServer.Close()
+
+			channels := []notification.Channel{
+				notification.NewEmailChannel(config.EmailNotifications{
+					Address: smtpServer.Addr(),
+					From:    "chat-server@example.com",
+					Timeout: time.Second,
+				}),
+				notification.NewPushChannel(config.PushNotifications{
+					URL:     pushServer.URL,
+					Secret:  secret,
+					Timeout: time.Second,
+				}),
+			}
+
+			recipient := recipient
+			recipient.Online = tt.online
+
+			notificationRepositoryMock := repositoryMocks.NewNotificationRepositoryMock(mc)
+			notificationRepositoryMock.ClaimDueRecipientsMock.Expect(minimock.AnyContext, model.ClaimDueRecipientsParams{
+				CreatedBefore: now.Add(-cfg.DigestDelay),
+				DigestBefore:  now.Add(-cfg.RateLimit),
+				Now:           now,
+				LeaseUntil:    now.Add(cfg.Lease),
+				Limit:         cfg.BatchSize,
+			}).Return([]model.NotificationRecipient{recipient}, nil)
+			pending := notifications
+			if tt.empty {
+				pending = nil
+			}
+
+			notificationRepositoryMock.ListPendingNotificationsMock.
+				Expect(minimock.AnyContext, recipient.UserID, cfg.MaxPerDigest).
+				Return(pending, nil)
+			notificationRepositoryMock.DeleteFinishedNotificationsMock.
+				Expect(minimock.AnyContext, now.Add(-cfg.Retention)).
+				Return(0, nil)
+
+			ids := []int64{10, 11}
+
+			switch tt.expectedStatus {
+			case "":
+			case model.NotificationPending:
+				notificationRepositoryMock.RecordNotificationsFailureMock.
+					Expect(minimock.AnyContext, model.RecordNotificationsFailureParams{
+						IDs:         ids,
+						MaxAttempts: cfg.MaxAttempts,
+					}).
+					Return(nil)
+			default:
+				notificationRepositoryMock.FinishNotificationsMock.
+					Expect(minimock.AnyContext, model.FinishNotificationsParams{
+						IDs:    ids,
+						Status: tt.expectedStatus,
+					}).
+					Return(nil)
+			}
+
+			if tt.empty || tt.online {
+				notificationRepositoryMock.ReleaseRecipientMock.Expect(minimock.AnyContext, recipient.UserID).Return(nil)
+			} else {
+				notificationRepositoryMock.UpdateRecipientMock.Expect(minimock.AnyContext, model.UpdateRecipientParams{
+					UserID:       recipient.UserID,
+					LastDigestAt: now,
+				}).Return(nil)
+			}
+
+			dispatcher := notification.NewDispatcher(notificationRepositoryMock, txManagerMock(mc), channels, cfg)
+
+			processed, err := dispatcher.RunOnce(ctx, now)
+			require.NoError(t, err)
+			require.Equal(t, 1, processed)
+
+			emails := smtpServer.Messages()
+			require.Len(t, emails, tt.expectedEmails)
+
+			if tt.expectedEmails > 0 {
+				require.Equal(t, "chat-server@example.com", emails[0].From)
+				require.Equal(t, []string{recipient.Email}, emails[0].To)
+				require.Contains(t, emails[0].Data, "Subject: You have 2 new messages")
+				require.Contains(t, emails[0].Data, "alice@example.com mentioned you")
+				require.Contains(t, emails[0].Data, "hi @bob")
+			}
+
+			require.Len(t, pushes, tt.expectedPushes)
+
+			if tt.expectedPushes > 0 {
+				require.Equal(t, expectedDigest, <-pushes)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package outbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+// Headers of the Kafka messages carrying the metadata of the events.
+const (
+	HeaderEventID   = "event_id"
+	HeaderEventType = "event_type"
+)
+
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaPublisher creates an EventPublisher producing the events to the configured topic.
+// Messages are keyed by chat ID, so that the events of a chat land in the same partition in order.
+func NewKafkaPublisher(cfg config.Kafka) EventPublisher {
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(cfg.Brokers...),
+			Topic:                  cfg.Topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			BatchTimeout:           cfg.BatchTimeout,
+			WriteTimeout:           cfg.WriteTimeout,
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+// Publish produces the event and waits for all in-sync replicas to acknowledge it.
+func (p *kafkaPublisher) Publish(ctx context.Context, event model.Event) error {
+	err := p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(strconv.FormatInt(event.ChatID, 10)),
+		Value: event.Payload,
+		Headers: []kafka.Header{
+			{Key: HeaderEventID, Value: []byte(strconv.FormatInt(event.ID, 10))},
+			{Key: HeaderEventType, Value: []byte(event.Type)},
+		},
+		Time: event.CreatedAt,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Cannot publish event(id: %d)", event.ID)
+	}
+
+	return nil
+}
+
+// Close flushes the pending messages and closes the connections to the brokers.
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,57 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+// MemoryPublisher is an EventPublisher keeping the published events in memory, meant for tests.
+type MemoryPublisher struct {
+	mu      sync.Mutex
+	events  []model.Event
+	failure func(event model.Event) error
+}
+
+// NewMemoryPublisher creates a new empty instance of MemoryPublisher.
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// Publish appends the event to the published events, unless the failure set with SetFailure rejects it.
+func (p *MemoryPublisher) Publish(_ context.Context, event model.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.failure != nil {
+		if err := p.failure(event); err != nil {
+			return err
+		}
+	}
+
+	p.events = append(p.events, event)
+
+	return nil
+}
+
+// SetFailure makes Publish fail with the error returned by fn, if any, to simulate an unavailable broker.
+func (p *MemoryPublisher) SetFailure(fn func(event model.Event) error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.failure = fn
+}
+
+// Events returns a copy of the published events in the order they were published.
+func (p *MemoryPublisher) Events() []model.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]model.Event(nil), p.events...)
+}
+
+// Close does nothing.
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
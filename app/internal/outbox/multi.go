@@ -0,0 +1,43 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+type multiPublisher struct {
+	publishers []EventPublisher
+}
+
+// NewMultiPublisher creates an EventPublisher publishing every event to all the publishers in turn.
+// An event is published once all of them succeeded, so a retried event may be published twice by some.
+func NewMultiPublisher(publishers ...EventPublisher) EventPublisher {
+	return &multiPublisher{
+		publishers: publishers,
+	}
+}
+
+// Publish publishes the event to every publisher and stops at the first failure.
+func (p *multiPublisher) Publish(ctx context.Context, event model.Event) error {
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close closes every publisher and returns the first error.
+func (p *multiPublisher) Close() error {
+	var firstErr error
+
+	for _, publisher := range p.publishers {
+		if err := publisher.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
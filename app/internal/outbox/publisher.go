@@ -0,0 +1,18 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+// EventPublisher publishes the chat domain events to downstream systems.
+type EventPublisher interface {
+	// Publish publishes the event and returns once it is acknowledged. The relay publishes an event at least
+	// once, outside of its transactions, so Publish must be idempotent and consumers must tolerate duplicates,
+	// identified by the event ID.
+	Publish(ctx context.Context, event model.Event) error
+
+	// Close flushes and releases the resources of the publisher.
+	Close() error
+}
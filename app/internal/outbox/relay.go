@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/metric"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+)
+
+// Relay publishes the chat domain events stored in the outbox. Every event is published at least once,
+// and the events of a chat in the order they were stored: at the first failure the relay skips
+// the following events of the chat and retries from the failed one on its next run.
+// The events are published outside of any transaction, under the relay lease of the instance,
+// and marked published afterwards, so an event may be published again if the instance stops in between.
+type Relay struct {
+	outboxRepository repository.OutboxRepository
+	txManager        db.TxManager
+	publisher        EventPublisher
+	instanceID       string
+	cfg              config.Outbox
+}
+
+// NewRelay creates a new instance of Relay with the provided repository, transaction manager,
+// publisher, ID of the instance holding the relay lease and settings.
+func NewRelay(
+	outboxRepository repository.OutboxRepository,
+	txManager db.TxManager,
+	publisher EventPublisher,
+	instanceID string,
+	cfg config.Outbox,
+) *Relay {
+	return &Relay{
+		outboxRepository: outboxRepository,
+		txManager:        txManager,
+		publisher:        publisher,
+		instanceID:       instanceID,
+		cfg:              cfg,
+	}
+}
+
+// Run relays the pending events immediately and then periodically until the context is cancelled.
+// A fully published batch is followed by the next one without waiting, to catch up with a backlog.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		published, err := r.RunOnce(ctx, time.Now())
+		if err != nil {
+			slog.Error("outbox relay failed", slog.String("error", err.Error()))
+		}
+
+		if err == nil && published == r.cfg.BatchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce publishes a batch of pending events and deletes the events published before
+// the retention period. It returns the number of published events and does nothing
+// if another instance holds the relay lease.
+func (r *Relay) RunOnce(ctx context.Context, now time.Time) (published int, err error) {
+	now = now.UTC()
+
+	locked, err := r.outboxRepository.LockRelay(ctx, r.instanceID, now, now.Add(r.cfg.RelayLease))
+	if err != nil || !locked {
+		return 0, err
+	}
+
+	events, err := r.outboxRepository.ListPendingEvents(ctx, r.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		publishedIDs = make([]int64, 0, len(events))
+		failedEvents = make([]model.Event, 0)
+		failures     = make([]string, 0)
+		failedChats  = make(map[int64]struct{})
+	)
+
+	for _, event := range events {
+		if _, failed := failedChats[event.ChatID]; failed {
+			continue
+		}
+
+		publishErr := r.publisher.Publish(ctx, event)
+		if publishErr != nil {
+			failedChats[event.ChatID] = struct{}{}
+			failedEvents = append(failedEvents, event)
+			failures = append(failures, publishErr.Error())
+
+			slog.Warn("outbox event not published",
+				slog.Int64("event_id", event.ID),
+				slog.Int64("chat_id", event.ChatID),
+				slog.Int("attempts", event.Attempts+1),
+				slog.String("error", publishErr.Error()),
+			)
+
+			continue
+		}
+
+		publishedIDs = append(publishedIDs, event.ID)
+	}
+
+	metric.AddOutboxEvents(metric.OutboxPublished, len(publishedIDs))
+	metric.AddOutboxEvents(metric.OutboxFailed, len(failedChats))
+
+	err = r.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		for i, event := range failedEvents {
+			err := r.outboxRepository.MarkEventFailed(ctx, event.ID, failures[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(publishedIDs) > 0 {
+			return r.outboxRepository.MarkEventsPublished(ctx, publishedIDs)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if r.cfg.PublishedRetention > 0 {
+		_, err = r.outboxRepository.DeletePublishedEvents(ctx, now.Add(-r.cfg.PublishedRetention))
+		if err != nil {
+			return len(publishedIDs), err
+		}
+	}
+
+	return len(publishedIDs), nil
+}
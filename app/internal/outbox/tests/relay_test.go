@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/outbox"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+)
+
+func txManagerMock(mc *minimock.Controller) db.TxManager {
+	mock := dbMocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	return mock
+}
+
+func TestRelayRunOnce(t *testing.T) {
+	t.Parallel()
+
+	type outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+
+	var (
+		ctx = context.Background()
+		now = time.Date(2024, time.October, 19, 12, 0, 0, 0, time.UTC)
+		cfg = config.Outbox{BatchSize: 10, PublishedRetention: time.Hour, RelayLease: time.Minute}
+
+		instanceID = "chat-server-1"
+
+		ErrBroker     = errors.New("broker unavailable")
+		ErrRepository = errors.New("outbox repository error")
+
+		events = []model.Event{
+			{ID: 1, Type: model.EventTypeChatCreated, ChatID: 1},
+			{ID: 2, Type: model.EventTypeChatCreated, ChatID: 2},
+			{ID: 3, Type: model.EventTypeMessageSent, ChatID: 1},
+			{ID: 4, Type: model.EventTypeMessageSent, ChatID: 2},
+			{ID: 5, Type: model.EventTypeChatDeleted, ChatID: 1},
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		failChat             int64
+		published            []int64
+		err                  error
+		outboxRepositoryMock outboxRepositoryMockFunc
+	}{
+		{
+			name:      "publishes pending events in order",
+			published: []int64{1, 2, 3, 4, 5},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.LockRelayMock.Expect(minimock.AnyContext, instanceID, now, now.Add(cfg.RelayLease)).Return(true, nil)
+				mock.ListPendingEventsMock.Expect(minimock.AnyContext, cfg.BatchSize).Return(events, nil)
+				mock.MarkEventsPublishedMock.Expect(minimock.AnyContext, []int64{1, 2, 3, 4, 5}).Return(nil)
+				mock.DeletePublishedEventsMock.Expect(minimock.AnyContext, now.Add(-time.Hour)).Return(3, nil)
+
+				return mock
+			},
+		},
+		{
+			name:      "skips the following events of a chat after a failure",
+			failChat:  1,
+			published: []int64{2, 4},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.LockRelayMock.Return(true, nil)
+				mock.ListPendingEventsMock.Return(events, nil)
+				mock.MarkEventFailedMock.Expect(minimock.AnyContext, 1, ErrBroker.Error()).Return(nil)
+				mock.MarkEventsPublishedMock.Expect(minimock.AnyContext, []int64{2, 4}).Return(nil)
+				mock.DeletePublishedEventsMock.Return(0, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "another instance holds the relay lease",
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.LockRelayMock.Return(false, nil)
+
+				return mock
+			},
+		},
+		{
+			name:      "published again on the next run if not marked published",
+			published: []int64{1, 2, 3, 4, 5},
+			err:       ErrRepository,
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.LockRelayMock.Return(true, nil)
+				mock.ListPendingEventsMock.Return(events, nil)
+				mock.MarkEventsPublishedMock.Return(ErrRepository)
+
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			publisher := outbox.NewMemoryPublisher()
+			publisher.SetFailure(func(event model.Event) error {
+				if event.ChatID == tt.failChat {
+					return ErrBroker
+				}
+
+				return nil
+			})
+
+			relay := outbox.NewRelay(tt.outboxRepositoryMock(mc), txManagerMock(mc), publisher, instanceID, cfg)
+
+			published, err := relay.RunOnce(ctx, now)
+			require.ErrorIs(t, err, tt.err)
+
+			var ids []int64
+			for _, event := range publisher.Events() {
+				ids = append(ids, event.ID)
+			}
+
+			require.Equal(t, tt.published, ids)
+
+			if tt.err == nil {
+				require.Equal(t, len(tt.published), published)
+			}
+		})
+	}
+}
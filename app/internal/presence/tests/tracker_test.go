@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/presence"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+)
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = context.Background()
+		cfg        = config.Presence{Interval: time.Minute, Timeout: 3 * time.Minute}
+		instanceID = "chat-server-1"
+		email      = "alice@example.com"
+
+		change = func(status string) model.PresenceChange {
+			return model.PresenceChange{
+				Presence: model.Presence{Email: email, Status: status},
+				ChatIDs:  []int64{1, 2},
+			}
+		}
+	)
+
+	t.Run("user stays online until the last connection ends", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		presenceMock := repositoryMocks.NewPresenceRepositoryMock(mc)
+		presenceMock.TouchPresenceMock.Set(func(_ context.Context, params model.TouchPresenceParams) (int64, error) {
+			require.Equal(t, instanceID, params.InstanceID)
+			require.Equal(t, []string{email}, params.Emails)
+			require.False(t, params.Away)
+
+			return 1, nil
+		})
+		presenceMock.DeletePresenceSessionMock.
+			Expect(minimock.AnyContext, model.DeletePresenceSessionParams{InstanceID: instanceID, Email: email}).
+			Return(nil)
+		presenceMock.RefreshPresenceMock.Set(func(_ context.Context, _ model.RefreshPresenceParams) ([]model.PresenceChange, error) {
+			if len(presenceMock.DeletePresenceSessionMock.Calls()) == 0 {
+				return []model.PresenceChange{change(model.PresenceOnline)}, nil
+			}
+
+			return []model.PresenceChange{change(model.PresenceOffline)}, nil
+		})
+
+		updateMock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+		updateMock.NotifyChatUpdateMock.Set(func(_ context.Context, params model.CreateChatUpdateParams) error {
+			require.Equal(t, model.UpdateTypePresence, params.Type)
+
+			return nil
+		})
+
+		tracker := presence.NewTracker(presenceMock, updateMock, instanceID, cfg)
+
+		disconnectFirst, err := tracker.Connect(ctx, email)
+		require.NoError(t, err)
+
+		disconnectSecond, err := tracker.Connect(ctx, email)
+		require.NoError(t, err)
+
+		disconnectFirst(ctx)
+		disconnectFirst(ctx)
+		require.Empty(t, presenceMock.DeletePresenceSessionMock.Calls())
+
+		disconnectSecond(ctx)
+
+		require.Len(t, presenceMock.TouchPresenceMock.Calls(), 1)
+		require.Len(t, presenceMock.DeletePresenceSessionMock.Calls(), 1)
+		require.Len(t, updateMock.NotifyChatUpdateMock.Calls(), 4)
+	})
+
+	t.Run("unknown user cannot connect", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		presenceMock := repositoryMocks.NewPresenceRepositoryMock(mc)
+		presenceMock.TouchPresenceMock.Return(0, nil)
+
+		updateMock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+
+		tracker := presence.NewTracker(presenceMock, updateMock, instanceID, cfg)
+
+		_, err := tracker.Connect(ctx, email)
+		require.ErrorIs(t, err, model.ErrNotFound)
+
+		// The failed connection is not counted, so the next one touches the session again.
+		_, err = tracker.Connect(ctx, email)
+		require.ErrorIs(t, err, model.ErrNotFound)
+
+		require.Len(t, presenceMock.TouchPresenceMock.Calls(), 2)
+	})
+
+	t.Run("refresh touches the connected users and expires the stale sessions", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		now := time.Date(2024, 10, 19, 20, 0, 0, 0, time.UTC)
+
+		var (
+			touches   []model.TouchPresenceParams
+			refreshes []model.RefreshPresenceParams
+		)
+
+		presenceMock := repositoryMocks.NewPresenceRepositoryMock(mc)
+		presenceMock.TouchPresenceMock.Set(func(_ context.Context, params model.TouchPresenceParams) (int64, error) {
+			touches = append(touches, params)
+			return 1, nil
+		})
+		presenceMock.RefreshPresenceMock.Set(func(_ context.Context, params model.RefreshPresenceParams) ([]model.PresenceChange, error) {
+			refreshes = append(refreshes, params)
+			return nil, nil
+		})
+		presenceMock.DeleteStalePresenceSessionsMock.
+			Expect(minimock.AnyContext, now.Add(-cfg.Timeout)).
+			Return(1, nil)
+
+		updateMock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+
+		tracker := presence.NewTracker(presenceMock, updateMock, instanceID, cfg)
+
+		_, err := tracker.Connect(ctx, email)
+		require.NoError(t, err)
+
+		require.NoError(t, tracker.Heartbeat(ctx, model.HeartbeatParams{Email: email, Away: true}))
+
+		require.NoError(t, tracker.RunOnce(ctx, now))
+
+		require.Len(t, touches, 3)
+		require.Equal(t, model.TouchPresenceParams{
+			InstanceID: instanceID,
+			Emails:     []string{email},
+			Away:       true,
+			SeenAt:     now,
+		}, touches[2])
+		require.Equal(t, model.RefreshPresenceParams{Since: now.Add(-cfg.Timeout)}, refreshes[len(refreshes)-1])
+	})
+}
@@ -0,0 +1,255 @@
+package presence
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+)
+
+// connection is the presence of a user subscribed on this instance.
+type connection struct {
+	streams int
+	away    bool
+}
+
+// Tracker tracks the presence of the users connected to this instance. Each instance stores a session
+// per connected user, so that a user connected to several instances stays online until the last one
+// loses them, and a session left behind by a stopped instance expires after the configured timeout.
+// The changes of the statuses are broadcast to the chats of the users as live updates.
+type Tracker struct {
+	presenceRepository repository.PresenceRepository
+	updateRepository   repository.ChatUpdateRepository
+	instanceID         string
+	cfg                config.Presence
+
+	mu          sync.Mutex
+	connections map[string]*connection
+}
+
+// NewInstanceID returns a unique ID of this instance, the host name followed by a random suffix,
+// so that the restarts of an instance do not take over the sessions of the previous run.
+func NewInstanceID() (string, error) {
+	host, err := os.Hostname()
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot get host name")
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", errors.Wrap(err, "Cannot read random bytes")
+	}
+
+	return host + "-" + hex.EncodeToString(suffix), nil
+}
+
+// NewTracker creates a new instance of Tracker storing the sessions of the instance with the provided ID.
+func NewTracker(
+	presenceRepository repository.PresenceRepository,
+	updateRepository repository.ChatUpdateRepository,
+	instanceID string,
+	cfg config.Presence,
+) *Tracker {
+	return &Tracker{
+		presenceRepository: presenceRepository,
+		updateRepository:   updateRepository,
+		instanceID:         instanceID,
+		cfg:                cfg,
+		connections:        make(map[string]*connection),
+	}
+}
+
+// Connect marks the user online while connected and returns the function to call on disconnection.
+// It returns model.ErrNotFound for an unknown user.
+func (t *Tracker) Connect(ctx context.Context, email string) (disconnect func(ctx context.Context), err error) {
+	t.mu.Lock()
+	conn, ok := t.connections[email]
+	if !ok {
+		conn = &connection{}
+		t.connections[email] = conn
+	}
+	conn.streams++
+	t.mu.Unlock()
+
+	if !ok {
+		err = t.touch(ctx, email, false, time.Now().UTC())
+		if err != nil {
+			t.release(email)
+			return nil, err
+		}
+	}
+
+	var once sync.Once
+
+	return func(ctx context.Context) {
+		once.Do(func() {
+			if !t.release(email) {
+				return
+			}
+
+			err := t.presenceRepository.DeletePresenceSession(ctx, model.DeletePresenceSessionParams{
+				InstanceID: t.instanceID,
+				Email:      email,
+			})
+			if err == nil {
+				err = t.refresh(ctx, []string{email}, time.Now().UTC())
+			}
+			if err != nil {
+				slog.Error("presence disconnection failed", slog.String("error", err.Error()))
+			}
+		})
+	}, nil
+}
+
+// Heartbeat marks the user seen, away if idle. The heartbeats keep online the users not subscribed
+// to any chat, and tell whether the subscribed ones are idle. It returns model.ErrNotFound for an unknown user.
+func (t *Tracker) Heartbeat(ctx context.Context, params model.HeartbeatParams) error {
+	t.mu.Lock()
+	if conn, ok := t.connections[params.Email]; ok {
+		conn.away = params.Away
+	}
+	t.mu.Unlock()
+
+	return t.touch(ctx, params.Email, params.Away, time.Now().UTC())
+}
+
+// Run refreshes the presence periodically until the context is cancelled.
+func (t *Tracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := t.RunOnce(ctx, time.Now().UTC()); err != nil {
+			slog.Error("presence refresh failed", slog.String("error", err.Error()))
+		}
+	}
+}
+
+// RunOnce marks the users connected to this instance seen at now, recomputes the statuses of all the
+// users, so that the ones whose sessions expired go offline, and deletes the expired sessions.
+func (t *Tracker) RunOnce(ctx context.Context, now time.Time) error {
+	var active, away []string
+
+	t.mu.Lock()
+	for email, conn := range t.connections {
+		if conn.away {
+			away = append(away, email)
+		} else {
+			active = append(active, email)
+		}
+	}
+	t.mu.Unlock()
+
+	for _, group := range []struct {
+		emails []string
+		away   bool
+	}{
+		{emails: active, away: false},
+		{emails: away, away: true},
+	} {
+		if len(group.emails) == 0 {
+			continue
+		}
+
+		_, err := t.presenceRepository.TouchPresence(ctx, model.TouchPresenceParams{
+			InstanceID: t.instanceID,
+			Emails:     group.emails,
+			Away:       group.away,
+			SeenAt:     now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err := t.refresh(ctx, nil, now)
+	if err != nil {
+		return err
+	}
+
+	_, err = t.presenceRepository.DeleteStalePresenceSessions(ctx, now.Add(-t.cfg.Timeout))
+
+	return err
+}
+
+// touch stores the session of the user on this instance and broadcasts the change of their status, if any.
+func (t *Tracker) touch(ctx context.Context, email string, away bool, now time.Time) error {
+	touched, err := t.presenceRepository.TouchPresence(ctx, model.TouchPresenceParams{
+		InstanceID: t.instanceID,
+		Emails:     []string{email},
+		Away:       away,
+		SeenAt:     now,
+	})
+	if err != nil {
+		return err
+	}
+
+	if touched == 0 {
+		return errors.Wrap(model.ErrNotFound, "user")
+	}
+
+	return t.refresh(ctx, []string{email}, now)
+}
+
+// refresh recomputes the statuses of the users, all of them if emails is empty, and broadcasts
+// the changes to the chats of the users.
+func (t *Tracker) refresh(ctx context.Context, emails []string, now time.Time) error {
+	changes, err := t.presenceRepository.RefreshPresence(ctx, model.RefreshPresenceParams{
+		Emails: emails,
+		Since:  now.Add(-t.cfg.Timeout),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		for _, chatID := range change.ChatIDs {
+			err = t.updateRepository.NotifyChatUpdate(ctx, model.CreateChatUpdateParams{
+				ChatID:  chatID,
+				Type:    model.UpdateTypePresence,
+				Sender:  change.Presence.Email,
+				Payload: change.Presence,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// release ends a connection of the user and reports whether it was the last one.
+func (t *Tracker) release(email string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	conn, ok := t.connections[email]
+	if !ok {
+		return false
+	}
+
+	conn.streams--
+	if conn.streams > 0 {
+		return false
+	}
+
+	delete(t.connections, email)
+
+	return true
+}
@@ -0,0 +1,78 @@
+package redact
+
+import (
+	"context"
+	"log/slog"
+)
+
+// handler is a slog.Handler redacting every attribute and message before passing the record on.
+type handler struct {
+	next     slog.Handler
+	redactor *Redactor
+}
+
+// NewHandler wraps the handler so that nothing sensitive reaches the log output.
+func NewHandler(next slog.Handler, redactor *Redactor) slog.Handler {
+	return &handler{
+		next:     next,
+		redactor: redactor,
+	}
+}
+
+// Enabled reports whether the wrapped handler handles records at the given level.
+func (h *handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle redacts the message and the attributes of the record and passes it to the wrapped handler.
+func (h *handler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, h.redactor.MaskEmails(record.Message), record.PC)
+
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.redactAttr(attr))
+		return true
+	})
+
+	return h.next.Handle(ctx, redacted)
+}
+
+// WithAttrs returns a handler whose attributes are redacted once up front.
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		redacted[i] = h.redactAttr(attr)
+	}
+
+	return NewHandler(h.next.WithAttrs(redacted), h.redactor)
+}
+
+// WithGroup returns a handler nesting the following attributes in the group.
+func (h *handler) WithGroup(name string) slog.Handler {
+	return NewHandler(h.next.WithGroup(name), h.redactor)
+}
+
+func (h *handler) redactAttr(attr slog.Attr) slog.Attr {
+	value := attr.Value.Resolve()
+
+	switch value.Kind() {
+	case slog.KindString:
+		return slog.String(attr.Key, h.redactor.MaskEmails(value.String()))
+	case slog.KindAny:
+		if err, ok := value.Any().(error); ok {
+			return slog.String(attr.Key, h.redactor.MaskEmails(err.Error()))
+		}
+
+		return slog.Any(attr.Key, h.redactor.Redact(value.Any()))
+	case slog.KindGroup:
+		group := value.Group()
+
+		redacted := make([]any, len(group))
+		for i, groupAttr := range group {
+			redacted[i] = h.redactAttr(groupAttr)
+		}
+
+		return slog.Group(attr.Key, redacted...)
+	default:
+		return attr
+	}
+}
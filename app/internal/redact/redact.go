@@ -0,0 +1,321 @@
+package redact
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+)
+
+// TagKey is the struct tag marking sensitive fields, e.g. `redact:"email"`.
+const TagKey = "redact"
+
+// Kinds of sensitive data that can be marked with the redact tag.
+const (
+	KindEmail = "email"
+	KindText  = "text"
+)
+
+// Action defines what happens to a sensitive value.
+type Action string
+
+// Supported redaction actions.
+const (
+	ActionKeep Action = "keep"
+	ActionMask Action = "mask"
+	ActionDrop Action = "drop"
+)
+
+// maskedText replaces masked values that are not emails.
+const maskedText = "***"
+
+// fieldKinds marks the sensitive strings of the maps by their key, as the requests of the api are stored
+// keyed by their field names.
+var fieldKinds = map[string]string{
+	"from":         KindEmail,
+	"email":        KindEmail,
+	"emails":       KindEmail,
+	"sender":       KindEmail,
+	"text":         KindText,
+	"question":     KindText,
+	"options":      KindText,
+	"display_name": KindText,
+	"status_text":  KindText,
+	"query":        KindText,
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	anyType           = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// Redactor removes or masks sensitive fields according to the configured action of every kind.
+type Redactor struct {
+	actions map[string]Action
+}
+
+// New creates a new instance of Redactor from the redaction settings of the environment.
+func New(cfg config.Redaction) (*Redactor, error) {
+	actions := map[string]Action{
+		KindEmail: Action(cfg.Email),
+		KindText:  Action(cfg.Text),
+	}
+
+	for kind, action := range actions {
+		switch action {
+		case ActionKeep, ActionMask, ActionDrop:
+		default:
+			return nil, errors.Errorf("invalid redaction action %q for %s", action, kind)
+		}
+	}
+
+	return &Redactor{actions: actions}, nil
+}
+
+// Action returns the action configured for the kind of sensitive data.
+func (r *Redactor) Action(kind string) Action {
+	if action, ok := r.actions[kind]; ok {
+		return action
+	}
+
+	return ActionKeep
+}
+
+// Redact returns a representation of v safe to be stored or logged.
+// Structs are converted to maps keyed like encoding/json would name their fields, without dropped fields,
+// so the result marshals to the same JSON as v minus the sensitive data.
+func (r *Redactor) Redact(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	return r.redactValue(reflect.ValueOf(v), "")
+}
+
+// String returns the value of the kind as it is stored once redacted
+// and false if values of the kind are dropped.
+func (r *Redactor) String(kind, value string) (string, bool) {
+	switch r.Action(kind) {
+	case ActionDrop:
+		return "", false
+	case ActionMask:
+		if kind == KindEmail {
+			return MaskEmail(value), true
+		}
+
+		return maskedText, true
+	default:
+		return value, true
+	}
+}
+
+// MaskEmails masks every email address found in the string if emails are not kept.
+func (r *Redactor) MaskEmails(s string) string {
+	if r.Action(KindEmail) == ActionKeep {
+		return s
+	}
+
+	return emailPattern.ReplaceAllStringFunc(s, MaskEmail)
+}
+
+// MaskEmail hides the local part of the email address except its first character.
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return maskedText
+	}
+
+	return email[:1] + maskedText + email[at:]
+}
+
+// redactValue converts the value applying the action of the kind it was tagged with.
+func (r *Redactor) redactValue(v reflect.Value, kind string) interface{} {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+
+		v = v.Elem()
+	}
+
+	if kind != "" && r.Action(kind) != ActionKeep {
+		return r.redactTagged(v, kind)
+	}
+
+	if v.Type().Implements(jsonMarshalerType) || v.Type().Implements(textMarshalerType) {
+		return r.redactMarshaler(v)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return r.redactStruct(v)
+	case reflect.Map:
+		if v.IsNil() {
+			return v.Interface()
+		}
+
+		items := reflect.MakeMapWithSize(reflect.MapOf(v.Type().Key(), anyType), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			kind := mapEntryKind(iter.Key(), iter.Value())
+			if kind != "" && r.Action(kind) == ActionDrop {
+				continue
+			}
+
+			item := reflect.ValueOf(r.redactValue(iter.Value(), kind))
+			if !item.IsValid() {
+				item = reflect.Zero(anyType)
+			}
+
+			items.SetMapIndex(iter.Key(), item)
+		}
+
+		return items.Interface()
+	case reflect.Slice, reflect.Array:
+		if (v.Kind() == reflect.Slice && v.IsNil()) || v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface()
+		}
+
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = r.redactValue(v.Index(i), "")
+		}
+
+		return items
+	case reflect.String:
+		return r.MaskEmails(v.String())
+	default:
+		return v.Interface()
+	}
+}
+
+// redactMarshaler masks the emails in the output of a type marshaling itself. The value is returned as is
+// when its output holds no email, otherwise the masked output replaces it.
+func (r *Redactor) redactMarshaler(v reflect.Value) interface{} {
+	if marshaler, ok := v.Interface().(json.Marshaler); ok {
+		data, err := marshaler.MarshalJSON()
+		if err != nil {
+			return maskedText
+		}
+
+		if masked := r.MaskEmails(string(data)); masked != string(data) {
+			return json.RawMessage(masked)
+		}
+
+		return v.Interface()
+	}
+
+	text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		return maskedText
+	}
+
+	if masked := r.MaskEmails(string(text)); masked != string(text) {
+		return masked
+	}
+
+	return v.Interface()
+}
+
+// redactStruct converts a struct into a map without its dropped fields.
+func (r *Redactor) redactStruct(v reflect.Value) interface{} {
+	t := v.Type()
+	fields := make(map[string]interface{}, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			tagName, options, _ := strings.Cut(tag, ",")
+			if tagName == "-" {
+				continue
+			}
+
+			if tagName != "" {
+				name = tagName
+			}
+
+			if strings.Contains(","+options+",", ",omitempty,") && isEmptyValue(v.Field(i)) {
+				continue
+			}
+		}
+
+		kind := field.Tag.Get(TagKey)
+		if kind != "" && r.Action(kind) == ActionDrop {
+			continue
+		}
+
+		fields[name] = r.redactValue(v.Field(i), kind)
+	}
+
+	return fields
+}
+
+// redactTagged masks a tagged string or every string of a tagged slice.
+func (r *Redactor) redactTagged(v reflect.Value, kind string) interface{} {
+	switch v.Kind() {
+	case reflect.String:
+		if kind == KindEmail {
+			return MaskEmail(v.String())
+		}
+
+		return maskedText
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = r.redactValue(v.Index(i), kind)
+		}
+
+		return items
+	default:
+		return maskedText
+	}
+}
+
+// mapEntryKind returns the kind of the strings held by the map entry, empty if they are not sensitive.
+func mapEntryKind(key, value reflect.Value) string {
+	if key.Kind() != reflect.String {
+		return ""
+	}
+
+	for value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	switch {
+	case value.Kind() == reflect.String:
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() != reflect.Uint8:
+	default:
+		return ""
+	}
+
+	return fieldKinds[key.String()]
+}
+
+// isEmptyValue reports whether encoding/json omits the value of an omitempty field.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return v.IsZero()
+	default:
+		return false
+	}
+}
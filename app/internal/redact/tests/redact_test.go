@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/redact"
+)
+
+func toJSON(t *testing.T, v interface{}) map[string]interface{} {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	result := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(data, &result))
+
+	return result
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := redact.New(config.Redaction{Email: "hide", Text: "drop"})
+	require.Error(t, err)
+
+	_, err = redact.New(config.Redaction{Email: "mask", Text: "keep"})
+	require.NoError(t, err)
+}
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	sentAt := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+	params := &model.SendMessageParams{
+		ChatID: 7,
+		From:   "alice@example.com",
+		Text:   "ping bob@example.com",
+		SentAt: sentAt,
+	}
+
+	tests := []struct {
+		name string
+		cfg  config.Redaction
+		want map[string]interface{}
+	}{
+		{
+			name: "keep",
+			cfg:  config.Redaction{Email: "keep", Text: "keep"},
+			want: map[string]interface{}{
+				"chat_id": float64(7),
+				"from":    "alice@example.com",
+				"text":    "ping bob@example.com",
+				"sent_at": sentAt.Format(time.RFC3339),
+			},
+		},
+		{
+			name: "mask",
+			cfg:  config.Redaction{Email: "mask", Text: "mask"},
+			want: map[string]interface{}{
+				"chat_id": float64(7),
+				"from":    "a***@example.com",
+				"text":    "***",
+				"sent_at": sentAt.Format(time.RFC3339),
+			},
+		},
+		{
+			name: "drop text",
+			cfg:  config.Redaction{Email: "mask", Text: "drop"},
+			want: map[string]interface{}{
+				"chat_id": float64(7),
+				"from":    "a***@example.com",
+				"sent_at": sentAt.Format(time.RFC3339),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			redactor, err := redact.New(tt.cfg)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.want, toJSON(t, redactor.Redact(params)))
+		})
+	}
+}
+
+func TestRedactUntaggedStrings(t *testing.T) {
+	t.Parallel()
+
+	redactor, err := redact.New(config.Redaction{Email: "mask", Text: "drop"})
+	require.NoError(t, err)
+
+	require.Equal(t,
+		map[string]interface{}{"emails": []interface{}{"a***@example.com", "b***@example.com"}},
+		toJSON(t, redactor.Redact(model.CreateChatParams{Emails: []string{"alice@example.com", "bob@example.com"}})),
+	)
+
+	require.Equal(t, "user c***@example.com not found", redactor.Redact("user carol@example.com not found"))
+	require.Nil(t, redactor.Redact(nil))
+}
+
+// address marshals itself, hiding its fields from the redactor.
+type address struct {
+	email string
+}
+
+func (a address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"email": a.email})
+}
+
+func TestRedactMapsAndMarshalers(t *testing.T) {
+	t.Parallel()
+
+	redactor, err := redact.New(config.Redaction{Email: "mask", Text: "drop"})
+	require.NoError(t, err)
+
+	require.Equal(t,
+		map[string]interface{}{
+			"params": map[string]interface{}{"chat_id": float64(7), "from": "a***@example.com", "sent_at": "0001-01-01T00:00:00Z"},
+			"owner":  map[string]interface{}{"email": "b***@example.com"},
+			"note":   "ask c***@example.com",
+		},
+		toJSON(t, redactor.Redact(map[string]interface{}{
+			"params": model.SendMessageParams{ChatID: 7, From: "alice@example.com", Text: "secret"},
+			"owner":  address{email: "bob@example.com"},
+			"note":   "ask carol@example.com",
+		})),
+	)
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	redactor, err := redact.New(config.Redaction{Email: "mask", Text: "drop"})
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	l := slog.New(redact.NewHandler(slog.NewJSONHandler(buf, nil), redactor)).
+		With(slog.String("user", "dave@example.com"))
+
+	l.Info("message from alice@example.com",
+		slog.Any("params", model.SendMessageParams{From: "alice@example.com", Text: "secret"}),
+		slog.Any("error", errors.New("bob@example.com is blocked")),
+	)
+
+	record := toJSON(t, json.RawMessage(buf.Bytes()))
+
+	require.Equal(t, "message from a***@example.com", record["msg"])
+	require.Equal(t, "d***@example.com", record["user"])
+	require.Equal(t, "b***@example.com is blocked", record["error"])
+
+	params, ok := record["params"].(map[string]interface{})
+	require.True(t, ok)
+	require.Equal(t, "a***@example.com", params["from"])
+	require.NotContains(t, params, "text")
+}
@@ -0,0 +1,16 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/block/model"
+)
+
+// ConvertBlockedUserFromRepoToService converts a stored block from the repository layer
+// to the service layer format.
+func ConvertBlockedUserFromRepoToService(user modelRepo.BlockedUser) model.BlockedUser {
+	return model.BlockedUser{
+		Email:       user.Email,
+		DisplayName: user.DisplayName,
+		BlockedAt:   user.BlockedAt,
+	}
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+// BlockedUser represents a stored block of a user, with the profile of the blocked user.
+type BlockedUser struct {
+	Email       string    `db:"email"`
+	DisplayName string    `db:"display_name"`
+	BlockedAt   time.Time `db:"created_at"`
+}
@@ -0,0 +1,106 @@
+package block
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/block/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/block/model"
+)
+
+type blockPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of blockPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.BlockRepository {
+	return &blockPGRepo{
+		db: db,
+	}
+}
+
+// BlockUser stores the block of the user by another one, keeping the first block of the pair.
+func (p *blockPGRepo) BlockUser(ctx context.Context, params model.BlockUserParams) (err error) {
+	logger.FromContext(ctx).Debug("blockPGRepo.BlockUser")
+
+	q := db.Query{
+		Name:     "blockPGRepo.BlockUser",
+		QueryRaw: queryBlockUser,
+	}
+
+	var found int64
+
+	err = p.db.DB().ScanOneContext(ctx, &found, q, params.From, params.Email)
+	if err != nil {
+		return errors.Wrap(err, "Cannot block user")
+	}
+
+	if found == 0 {
+		return errors.Wrap(model.ErrNotFound, "user")
+	}
+
+	return nil
+}
+
+// UnblockUser removes the block of the user, if any.
+func (p *blockPGRepo) UnblockUser(ctx context.Context, params model.UnblockUserParams) (err error) {
+	logger.FromContext(ctx).Debug("blockPGRepo.UnblockUser")
+
+	q := db.Query{
+		Name:     "blockPGRepo.UnblockUser",
+		QueryRaw: queryUnblockUser,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, params.From, params.Email)
+	if err != nil {
+		return errors.Wrap(err, "Cannot unblock user")
+	}
+
+	return nil
+}
+
+// ListBlocked returns the users blocked by the user, most recently blocked first.
+func (p *blockPGRepo) ListBlocked(ctx context.Context, params model.ListBlockedParams) (users []model.BlockedUser, err error) {
+	logger.FromContext(ctx).Debug("blockPGRepo.ListBlocked")
+
+	q := db.Query{
+		Name:     "blockPGRepo.ListBlocked",
+		QueryRaw: queryListBlocked,
+	}
+
+	var usersRepo []modelRepo.BlockedUser
+
+	err = p.db.DB().ScanAllContext(ctx, &usersRepo, q, params.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list blocked users")
+	}
+
+	users = make([]model.BlockedUser, len(usersRepo))
+	for i, user := range usersRepo {
+		users[i] = converter.ConvertBlockedUserFromRepoToService(user)
+	}
+
+	return users, nil
+}
+
+// BlockedBetween reports whether either user blocks the other one.
+func (p *blockPGRepo) BlockedBetween(ctx context.Context, first, second string) (blocked bool, err error) {
+	logger.FromContext(ctx).Debug("blockPGRepo.BlockedBetween")
+
+	q := db.Query{
+		Name:     "blockPGRepo.BlockedBetween",
+		QueryRaw: queryBlockedBetween,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &blocked, q, first, second)
+	if err != nil {
+		return false, errors.Wrap(err, "Cannot check blocks")
+	}
+
+	return blocked, nil
+}
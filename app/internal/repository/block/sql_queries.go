@@ -0,0 +1,49 @@
+package block
+
+const (
+	// queryBlockUser returns the number of the known pairs of users, 0 if either user is unknown.
+	queryBlockUser = `
+		WITH pair AS (
+			SELECT blocker.id AS blocker_id, blocked.id AS blocked_id
+			FROM chats.users blocker, chats.users blocked
+			WHERE blocker.email = $1
+				AND blocked.email = $2
+		), ins AS (
+			INSERT INTO chats.user_blocks (blocker_id, blocked_id)
+			SELECT blocker_id, blocked_id
+			FROM pair
+			ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+		)
+		SELECT count(*)
+		FROM pair;
+	`
+
+	queryUnblockUser = `
+		DELETE FROM chats.user_blocks b
+		USING chats.users blocker, chats.users blocked
+		WHERE b.blocker_id = blocker.id
+			AND b.blocked_id = blocked.id
+			AND blocker.email = $1
+			AND blocked.email = $2;
+	`
+
+	queryListBlocked = `
+		SELECT blocked.email, blocked.display_name, b.created_at
+		FROM chats.user_blocks b
+		JOIN chats.users blocker ON blocker.id = b.blocker_id
+		JOIN chats.users blocked ON blocked.id = b.blocked_id
+		WHERE blocker.email = $1
+		ORDER BY b.created_at DESC, blocked.email;
+	`
+
+	queryBlockedBetween = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM chats.user_blocks b
+			JOIN chats.users u1 ON u1.id = b.blocker_id
+			JOIN chats.users u2 ON u2.id = b.blocked_id
+			WHERE (u1.email = $1 AND u2.email = $2)
+				OR (u1.email = $2 AND u2.email = $1)
+		);
+	`
+)
@@ -0,0 +1,69 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/bot/model"
+)
+
+// ConvertCreateBotParamsFromServiceToRepo converts CreateBotParams from the service layer
+// to the repository layer format.
+func ConvertCreateBotParamsFromServiceToRepo(params model.CreateBotParams) modelRepo.CreateBotParams {
+	names := make([]string, len(params.Commands))
+	descriptions := make([]string, len(params.Commands))
+
+	for i, command := range params.Commands {
+		names[i] = command.Name
+		descriptions[i] = command.Description
+	}
+
+	return modelRepo.CreateBotParams{
+		Name:                params.Name,
+		TokenHash:           params.TokenHash,
+		WebhookURL:          params.WebhookURL,
+		SigningSecret:       params.SigningSecret,
+		CommandNames:        names,
+		CommandDescriptions: descriptions,
+	}
+}
+
+// ConvertBotFromRepoToService converts a stored bot from the repository layer to the service layer format.
+func ConvertBotFromRepoToService(bot modelRepo.Bot) model.Bot {
+	commands := make([]model.BotCommand, len(bot.CommandNames))
+	for i, name := range bot.CommandNames {
+		commands[i] = model.BotCommand{
+			Name:        name,
+			Description: bot.CommandDescriptions[i],
+		}
+	}
+
+	return model.Bot{
+		ID:            bot.ID,
+		Name:          bot.Name,
+		WebhookURL:    bot.WebhookURL,
+		TokenHash:     bot.TokenHash,
+		SigningSecret: bot.SigningSecret,
+		Commands:      commands,
+		CreatedAt:     bot.CreatedAt,
+	}
+}
+
+// ConvertBotsFromRepoToService converts stored bots from the repository layer to the service layer format.
+func ConvertBotsFromRepoToService(bots []modelRepo.Bot) []model.Bot {
+	result := make([]model.Bot, len(bots))
+	for i, bot := range bots {
+		result[i] = ConvertBotFromRepoToService(bot)
+	}
+
+	return result
+}
+
+// ConvertBotCommandsFromRepoToService converts stored commands from the repository layer
+// to the service layer format.
+func ConvertBotCommandsFromRepoToService(commands []modelRepo.BotCommand) []model.BotCommand {
+	result := make([]model.BotCommand, len(commands))
+	for i, command := range commands {
+		result[i] = model.BotCommand(command)
+	}
+
+	return result
+}
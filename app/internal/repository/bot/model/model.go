@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// CreateBotParams holds the data of a bot to create, with its commands as parallel arrays.
+type CreateBotParams struct {
+	Name                string   `db:"email"`
+	TokenHash           string   `db:"api_token_hash"`
+	WebhookURL          string   `db:"webhook_url"`
+	SigningSecret       string   `db:"signing_secret"`
+	CommandNames        []string `db:"command_names"`
+	CommandDescriptions []string `db:"command_descriptions"`
+}
+
+// Bot represents a stored bot with its commands as parallel arrays.
+type Bot struct {
+	ID                  int64     `db:"id"`
+	Name                string    `db:"name"`
+	WebhookURL          string    `db:"webhook_url"`
+	TokenHash           string    `db:"token_hash"`
+	SigningSecret       string    `db:"signing_secret"`
+	CreatedAt           time.Time `db:"created_at"`
+	CommandNames        []string  `db:"command_names"`
+	CommandDescriptions []string  `db:"command_descriptions"`
+}
+
+// BotCommand represents a stored command of a bot.
+type BotCommand struct {
+	Name        string `db:"command"`
+	Description string `db:"description"`
+}
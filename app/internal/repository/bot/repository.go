@@ -0,0 +1,164 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/bot/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/bot/model"
+)
+
+// uniqueViolation is the PostgreSQL error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+type botPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of botPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.BotRepository {
+	return &botPGRepo{
+		db: db,
+	}
+}
+
+// CreateBot creates a bot user with its commands and returns its ID.
+func (p *botPGRepo) CreateBot(ctx context.Context, params model.CreateBotParams) (botID int64, err error) {
+	logger.FromContext(ctx).Debug("botPGRepo.CreateBot", slog.String("name", params.Name))
+
+	paramsRepo := converter.ConvertCreateBotParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "botPGRepo.CreateBot",
+		QueryRaw: queryCreateBot,
+	}
+
+	err = p.db.DB().ScanOneContext(
+		ctx,
+		&botID,
+		q,
+		paramsRepo.Name,
+		paramsRepo.TokenHash,
+		paramsRepo.WebhookURL,
+		paramsRepo.SigningSecret,
+		paramsRepo.CommandNames,
+		paramsRepo.CommandDescriptions,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return 0, errors.Wrapf(model.ErrAlreadyExists, "bot name or command(name: %s)", params.Name)
+		}
+
+		return 0, errors.Wrapf(err, "Cannot create bot(name: %s)", params.Name)
+	}
+
+	return botID, nil
+}
+
+// ListBots returns the bots with their commands.
+func (p *botPGRepo) ListBots(ctx context.Context) (bots []model.Bot, err error) {
+	logger.FromContext(ctx).Debug("botPGRepo.ListBots")
+
+	q := db.Query{
+		Name:     "botPGRepo.ListBots",
+		QueryRaw: queryListBots,
+	}
+
+	var botsRepo []modelRepo.Bot
+
+	err = p.db.DB().ScanAllContext(ctx, &botsRepo, q)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list bots")
+	}
+
+	return converter.ConvertBotsFromRepoToService(botsRepo), nil
+}
+
+// DeleteBot removes a bot user, its commands are removed in cascade.
+func (p *botPGRepo) DeleteBot(ctx context.Context, params model.DeleteBotParams) (err error) {
+	logger.FromContext(ctx).Debug("botPGRepo.DeleteBot", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "botPGRepo.DeleteBot",
+		QueryRaw: queryDeleteBot,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, params.BotID)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot delete bot(botID: %d)", params.BotID)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(model.ErrNotFound, "bot(botID: %d)", params.BotID)
+	}
+
+	return nil
+}
+
+// GetBotByTokenHash returns the bot whose API token has the given hash.
+func (p *botPGRepo) GetBotByTokenHash(ctx context.Context, tokenHash string) (bot model.Bot, err error) {
+	logger.FromContext(ctx).Debug("botPGRepo.GetBotByTokenHash")
+
+	q := db.Query{
+		Name:     "botPGRepo.GetBotByTokenHash",
+		QueryRaw: queryGetBotByTokenHash,
+	}
+
+	return p.getBot(ctx, q, tokenHash)
+}
+
+// GetBotByCommand returns the bot handling the command.
+func (p *botPGRepo) GetBotByCommand(ctx context.Context, command string) (bot model.Bot, err error) {
+	logger.FromContext(ctx).Debug("botPGRepo.GetBotByCommand", slog.String("command", command))
+
+	q := db.Query{
+		Name:     "botPGRepo.GetBotByCommand",
+		QueryRaw: queryGetBotByCommand,
+	}
+
+	return p.getBot(ctx, q, command)
+}
+
+// ListBotCommands returns the commands of all bots ordered by name.
+func (p *botPGRepo) ListBotCommands(ctx context.Context) (commands []model.BotCommand, err error) {
+	logger.FromContext(ctx).Debug("botPGRepo.ListBotCommands")
+
+	q := db.Query{
+		Name:     "botPGRepo.ListBotCommands",
+		QueryRaw: queryListBotCommands,
+	}
+
+	var commandsRepo []modelRepo.BotCommand
+
+	err = p.db.DB().ScanAllContext(ctx, &commandsRepo, q)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list bot commands")
+	}
+
+	return converter.ConvertBotCommandsFromRepoToService(commandsRepo), nil
+}
+
+// getBot returns the single bot selected by the query, or model.ErrNotFound.
+func (p *botPGRepo) getBot(ctx context.Context, q db.Query, arg interface{}) (model.Bot, error) {
+	var botRepo modelRepo.Bot
+
+	err := p.db.DB().ScanOneContext(ctx, &botRepo, q, arg)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Bot{}, errors.Wrap(model.ErrNotFound, "bot")
+		}
+
+		return model.Bot{}, errors.Wrapf(err, "Cannot get bot(%s)", q.Name)
+	}
+
+	return converter.ConvertBotFromRepoToService(botRepo), nil
+}
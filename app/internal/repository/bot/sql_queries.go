@@ -0,0 +1,59 @@
+package bot
+
+const (
+	// queryCreateBot creates the bot user with its commands.
+	queryCreateBot = `
+		WITH bot AS (
+			INSERT INTO chats.users
+				(email, user_type, api_token_hash, webhook_url, signing_secret)
+			VALUES
+				($1, 'bot', $2, NULLIF($3, ''), $4)
+			RETURNING id
+		), commands AS (
+			INSERT INTO chats.bot_commands
+				(bot_id, command, description)
+			SELECT bot.id, c.command, c.description
+			FROM bot, unnest($5::varchar[], $6::text[]) AS c(command, description)
+		)
+		SELECT id FROM bot;
+	`
+
+	// queryBots selects the bots with their commands ordered by name.
+	queryBots = `
+		SELECT u.id, u.email AS name, COALESCE(u.webhook_url, '') AS webhook_url, u.api_token_hash AS token_hash,
+			COALESCE(u.signing_secret, '') AS signing_secret, u.created_at,
+			COALESCE(array_agg(c.command ORDER BY c.command) FILTER (WHERE c.command IS NOT NULL), '{}')
+				AS command_names,
+			COALESCE(array_agg(c.description ORDER BY c.command) FILTER (WHERE c.command IS NOT NULL), '{}')
+				AS command_descriptions
+		FROM chats.users u
+		LEFT JOIN chats.bot_commands c ON c.bot_id = u.id
+	`
+
+	queryListBots = queryBots + `
+		WHERE u.user_type = 'bot'
+		GROUP BY u.id
+		ORDER BY u.id;
+	`
+
+	queryGetBotByTokenHash = queryBots + `
+		WHERE u.user_type = 'bot' AND u.api_token_hash = $1
+		GROUP BY u.id;
+	`
+
+	queryGetBotByCommand = queryBots + `
+		WHERE u.user_type = 'bot' AND u.id = (SELECT bot_id FROM chats.bot_commands WHERE command = $1)
+		GROUP BY u.id;
+	`
+
+	queryDeleteBot = `
+		DELETE FROM chats.users
+		WHERE id = $1 AND user_type = 'bot';
+	`
+
+	queryListBotCommands = `
+		SELECT command, description
+		FROM chats.bot_commands
+		ORDER BY command;
+	`
+)
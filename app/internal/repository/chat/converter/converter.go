@@ -13,6 +13,14 @@ func ConvertCreateChatResponseFromRepoToService(params modelRepo.CreateChatRespo
 	}
 }
 
+// ConvertSendMessageResponseFromRepoToService converts a SendMessageResponse from the repository layer
+// to a SendMessageResponse used in the service layer.
+func ConvertSendMessageResponseFromRepoToService(params modelRepo.SendMessageResponse) model.SendMessageResponse {
+	return model.SendMessageResponse{
+		MessageID: params.MessageID,
+	}
+}
+
 // ConvertCreateUsersForChatParamsFromServiceToRepo converts CreateUsersForChatParams
 // from the service layer format to the repository layer format.
 func ConvertCreateUsersForChatParamsFromServiceToRepo(params model.CreateUsersForChatParams) modelRepo.CreateUsersForChatParams {
@@ -25,10 +33,21 @@ func ConvertCreateUsersForChatParamsFromServiceToRepo(params model.CreateUsersFo
 // from the service layer format to the repository layer format.
 func ConvertSendMessageParamsFromServiceToRepo(params model.SendMessageParams) modelRepo.SendMessageParams {
 	return modelRepo.SendMessageParams{
+		ChatID: params.ChatID,
 		From:   params.From,
 		Text:   params.Text,
 		SentAt: params.SentAt,
+		Type:   messageType(params.Type),
+	}
+}
+
+// messageType returns the type of the message, text by default.
+func messageType(t string) string {
+	if t == "" {
+		return model.MessageTypeText
 	}
+
+	return t
 }
 
 // ConvertCreateUsersForChatResponseFromRepoToService converts CreateUsersForChatResponse
@@ -63,3 +82,59 @@ func ConvertDeleteChatParamsFromServiceToRepo(params model.DeleteChatParams) mod
 		ChatID: params.ChatID,
 	}
 }
+
+// ConvertMessageFromRepoToService converts a stored message from the repository layer
+// to the service layer format.
+func ConvertMessageFromRepoToService(message modelRepo.Message) model.Message {
+	return model.Message{
+		ID:     message.ID,
+		ChatID: message.ChatID,
+		From:   message.From,
+		Text:   message.Text,
+		Type:   message.Type,
+		SentAt: message.SentAt,
+	}
+}
+
+// ConvertUpdateChatSettingsParamsFromServiceToRepo converts UpdateChatSettingsParams from the service layer
+// to the repository layer format. A zero MutedUntil removes the mute.
+func ConvertUpdateChatSettingsParamsFromServiceToRepo(
+	params model.UpdateChatSettingsParams,
+) modelRepo.UpdateChatSettingsParams {
+	paramsRepo := modelRepo.UpdateChatSettingsParams{
+		ChatID:            params.ChatID,
+		From:              params.From,
+		SetMutedUntil:     params.MutedUntil != nil,
+		NotificationLevel: params.NotificationLevel,
+		Pinned:            params.Pinned,
+		Archived:          params.Archived,
+	}
+
+	if params.MutedUntil != nil && !params.MutedUntil.IsZero() {
+		paramsRepo.MutedUntil = params.MutedUntil
+	}
+
+	return paramsRepo
+}
+
+// ConvertChatSettingsFromRepoToService converts the stored settings of a chat from the repository layer
+// to the service layer format.
+func ConvertChatSettingsFromRepoToService(settings modelRepo.ChatSettings) model.ChatSettings {
+	return model.ChatSettings{
+		ChatID:            settings.ChatID,
+		MutedUntil:        settings.MutedUntil,
+		NotificationLevel: settings.NotificationLevel,
+		Pinned:            settings.Pinned,
+		Archived:          settings.Archived,
+	}
+}
+
+// ConvertUserChatFromRepoToService converts a stored chat of a participant from the repository layer
+// to the service layer format.
+func ConvertUserChatFromRepoToService(chat modelRepo.UserChat) model.UserChat {
+	return model.UserChat{
+		ChatID:       chat.ChatID,
+		Participants: chat.Participants,
+		Settings:     ConvertChatSettingsFromRepoToService(chat.ChatSettings),
+	}
+}
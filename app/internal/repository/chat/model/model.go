@@ -19,6 +19,11 @@ type CreateUsersForChatResponse struct {
 	UserIDs []int64
 }
 
+// SendMessageResponse represents the response after sending a message, including the MessageID.
+type SendMessageResponse struct {
+	MessageID int64 `db:"id"`
+}
+
 // DeleteChatParams holds the ID of the chat to be deleted.
 type DeleteChatParams struct {
 	ChatID int64 `db:"id"`
@@ -26,9 +31,11 @@ type DeleteChatParams struct {
 
 // SendMessageParams holds the data for sending a message.
 type SendMessageParams struct {
+	ChatID int64     `db:"chat_id"`      // Chat the message is sent to
 	From   string    `db:"sender"`       // Sender of the message
 	Text   string    `db:"message_text"` // Message content
 	SentAt time.Time `db:"sent_at"`      // Timestamp of the message
+	Type   string    `db:"message_type"` // Type of the message, e.g. text or poll
 }
 
 // LinkParticipantsToChatParams holds the data for linking users to a chat.
@@ -41,3 +48,40 @@ type LinkParticipantsToChatParams struct {
 type UnlinkParticipantsFromChatParams struct {
 	ChatID int64 `db:"chat_id"`
 }
+
+// Message represents a stored message of a chat.
+type Message struct {
+	ID     int64     `db:"id"`
+	ChatID int64     `db:"chat_id"`
+	From   string    `db:"sender"`
+	Text   string    `db:"message_text"`
+	Type   string    `db:"message_type"`
+	SentAt time.Time `db:"sent_at"`
+}
+
+// ChatSettings represents the stored settings of a chat for one of its participants.
+type ChatSettings struct {
+	ChatID            int64      `db:"chat_id"`
+	MutedUntil        *time.Time `db:"muted_until"`
+	NotificationLevel string     `db:"notification_level"`
+	Pinned            bool       `db:"pinned"`
+	Archived          bool       `db:"archived"`
+}
+
+// UpdateChatSettingsParams holds the settings of a chat to update for the participant. The mute is replaced
+// if SetMutedUntil, by no mute if MutedUntil is nil.
+type UpdateChatSettingsParams struct {
+	ChatID            int64
+	From              string
+	SetMutedUntil     bool
+	MutedUntil        *time.Time
+	NotificationLevel *string
+	Pinned            *bool
+	Archived          *bool
+}
+
+// UserChat represents a stored chat of a participant with their settings of the chat.
+type UserChat struct {
+	ChatSettings
+	Participants []string `db:"participants"`
+}
@@ -2,12 +2,13 @@ package chat
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/Prrromanssss/platform_common/pkg/db"
-	"github.com/gofiber/fiber/v2/log"
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 
+	"github.com/Prrromanssss/chat-server/internal/logger"
 	"github.com/Prrromanssss/chat-server/internal/model"
 	"github.com/Prrromanssss/chat-server/internal/repository"
 	"github.com/Prrromanssss/chat-server/internal/repository/chat/converter"
@@ -27,7 +28,7 @@ func NewRepository(db db.Client) repository.ChatRepository {
 
 // CreateChat creates a new chat and links participants to it.
 func (p *chatPGRepo) CreateChat(ctx context.Context) (resp model.CreateChatResponse, err error) {
-	log.Infof("chatPGRepo.CreateChat, params")
+	logger.FromContext(ctx).Debug("chatPGRepo.CreateChat")
 
 	var respRepo modelRepo.CreateChatResponse
 
@@ -50,7 +51,7 @@ func (p *chatPGRepo) CreateUsersForChat(
 	ctx context.Context,
 	params model.CreateUsersForChatParams,
 ) (resp model.CreateUsersForChatResponse, err error) {
-	log.Infof("chatPGRepo.CreateUsersForChat, params: %+v", params)
+	logger.FromContext(ctx).Debug("chatPGRepo.CreateUsersForChat", slog.Any("params", params))
 
 	paramsRepo := converter.ConvertCreateUsersForChatParamsFromServiceToRepo(params)
 
@@ -85,7 +86,7 @@ func (p *chatPGRepo) LinkParticipantsToChat(
 	ctx context.Context,
 	params model.LinkParticipantsToChatParams,
 ) (err error) {
-	log.Infof("chatPGRepo.LinkParticipantsToChat, params: %+v", params)
+	logger.FromContext(ctx).Debug("chatPGRepo.LinkParticipantsToChat", slog.Any("params", params))
 
 	paramsRepo := converter.ConvertLinkParticipantsToChatParamsFromServiceToRepo(params)
 
@@ -115,7 +116,7 @@ func (p *chatPGRepo) UnlinkParticipantsFromChat(
 	ctx context.Context,
 	params model.UnlinkParticipantsFromChatParams,
 ) (err error) {
-	log.Infof("chatPGRepo.UnlinkParticipantsFromChat, params: %+v", params)
+	logger.FromContext(ctx).Debug("chatPGRepo.UnlinkParticipantsFromChat", slog.Any("params", params))
 
 	paramsRepo := converter.ConvertUnlinkParticipantsFromChatParamsFromServiceToRepo(params)
 
@@ -139,7 +140,7 @@ func (p *chatPGRepo) UnlinkParticipantsFromChat(
 
 // DeleteChat removes a chat and unlinks its participants based on the provided chat ID.
 func (p *chatPGRepo) DeleteChat(ctx context.Context, params model.DeleteChatParams) (err error) {
-	log.Infof("chatPGRepo.DeleteChat, params: %+v", params)
+	logger.FromContext(ctx).Debug("chatPGRepo.DeleteChat", slog.Any("params", params))
 
 	paramsRepo := converter.ConvertDeleteChatParamsFromServiceToRepo(params)
 
@@ -157,9 +158,12 @@ func (p *chatPGRepo) DeleteChat(ctx context.Context, params model.DeleteChatPara
 	return nil
 }
 
-// SendMessage sends a message to a chat.
-func (p *chatPGRepo) SendMessage(ctx context.Context, params model.SendMessageParams) (err error) {
-	log.Infof("chatPGRepo.SendMessage, params: %+v", params)
+// SendMessage sends a message to a chat and returns the ID of the message.
+func (p *chatPGRepo) SendMessage(
+	ctx context.Context,
+	params model.SendMessageParams,
+) (resp model.SendMessageResponse, err error) {
+	logger.FromContext(ctx).Debug("chatPGRepo.SendMessage", slog.Any("params", params))
 
 	paramsRepo := converter.ConvertSendMessageParamsFromServiceToRepo(params)
 
@@ -168,11 +172,127 @@ func (p *chatPGRepo) SendMessage(ctx context.Context, params model.SendMessagePa
 		QueryRaw: querySendMessage,
 	}
 
-	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.From, paramsRepo.Text, paramsRepo.SentAt)
+	var respRepo modelRepo.SendMessageResponse
+
+	err = p.db.DB().ScanOneContext(
+		ctx,
+		&respRepo,
+		q,
+		paramsRepo.ChatID,
+		paramsRepo.From,
+		paramsRepo.Text,
+		paramsRepo.SentAt,
+		paramsRepo.Type,
+	)
 	if err != nil {
-		err = errors.Wrapf(err, "Cannot send message (from: %s)", paramsRepo.From)
+		err = errors.Wrapf(err, "Cannot send message (chatID: %d, from: %s)", paramsRepo.ChatID, paramsRepo.From)
 		return
 	}
 
-	return nil
+	return converter.ConvertSendMessageResponseFromRepoToService(respRepo), nil
+}
+
+// ListMessages returns a page of the history of the chat, newest first, without the messages
+// of the users blocked by the reader.
+func (p *chatPGRepo) ListMessages(ctx context.Context, params model.ListMessagesParams) (messages []model.Message, err error) {
+	logger.FromContext(ctx).Debug("chatPGRepo.ListMessages", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "chatPGRepo.ListMessages",
+		QueryRaw: queryListMessages,
+	}
+
+	var messagesRepo []modelRepo.Message
+
+	err = p.db.DB().ScanAllContext(ctx, &messagesRepo, q, params.ChatID, params.From, params.BeforeID, params.Limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot list messages (chatID: %d)", params.ChatID)
+	}
+
+	messages = make([]model.Message, len(messagesRepo))
+	for i, message := range messagesRepo {
+		messages[i] = converter.ConvertMessageFromRepoToService(message)
+	}
+
+	return messages, nil
+}
+
+// UpdateChatSettings updates the non-nil settings of the chat for the participant and returns the settings.
+func (p *chatPGRepo) UpdateChatSettings(
+	ctx context.Context,
+	params model.UpdateChatSettingsParams,
+) (settings model.ChatSettings, err error) {
+	logger.FromContext(ctx).Debug("chatPGRepo.UpdateChatSettings", slog.Any("params", params))
+
+	paramsRepo := converter.ConvertUpdateChatSettingsParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "chatPGRepo.UpdateChatSettings",
+		QueryRaw: queryUpdateChatSettings,
+	}
+
+	var settingsRepo modelRepo.ChatSettings
+
+	err = p.db.DB().ScanOneContext(
+		ctx,
+		&settingsRepo,
+		q,
+		paramsRepo.ChatID,
+		paramsRepo.From,
+		paramsRepo.SetMutedUntil,
+		paramsRepo.MutedUntil,
+		paramsRepo.NotificationLevel,
+		paramsRepo.Pinned,
+		paramsRepo.Archived,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ChatSettings{}, errors.Wrap(model.ErrNotFound, "participant")
+		}
+
+		return model.ChatSettings{}, errors.Wrapf(err, "Cannot update chat settings (chatID: %d)", params.ChatID)
+	}
+
+	return converter.ConvertChatSettingsFromRepoToService(settingsRepo), nil
+}
+
+// ListChats returns the chats of the participant with their settings, pinned first.
+func (p *chatPGRepo) ListChats(ctx context.Context, params model.ListChatsParams) (chats []model.UserChat, err error) {
+	logger.FromContext(ctx).Debug("chatPGRepo.ListChats", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "chatPGRepo.ListChats",
+		QueryRaw: queryListChats,
+	}
+
+	var chatsRepo []modelRepo.UserChat
+
+	err = p.db.DB().ScanAllContext(ctx, &chatsRepo, q, params.From, params.IncludeArchived)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list chats")
+	}
+
+	chats = make([]model.UserChat, len(chatsRepo))
+	for i, chat := range chatsRepo {
+		chats[i] = converter.ConvertUserChatFromRepoToService(chat)
+	}
+
+	return chats, nil
+}
+
+// IsParticipant reports whether the user participates in the chat.
+func (p *chatPGRepo) IsParticipant(ctx context.Context, chatID int64, email string) (participant bool, err error) {
+	logger.FromContext(ctx).Debug("chatPGRepo.IsParticipant", slog.Int64("chat_id", chatID))
+
+	q := db.Query{
+		Name:     "chatPGRepo.IsParticipant",
+		QueryRaw: queryIsParticipant,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &participant, q, chatID, email)
+	if err != nil {
+		return false, errors.Wrapf(err, "Cannot check participant (chatID: %d)", chatID)
+	}
+
+	return participant, nil
 }
@@ -42,8 +42,71 @@ const (
 
 	querySendMessage = `
 		INSERT INTO chats.messages
-			(sender, message_text, sent_at)
+			(chat_id, sender, message_text, sent_at, message_type)
 		VALUES
-			($1, $2, $3);
+			($1, $2, $3, $4, $5)
+		RETURNING id;
+	`
+
+	// queryListMessages hides the messages of the users blocked by the reader.
+	queryListMessages = `
+		SELECT m.id, m.chat_id, m.sender, m.message_text, m.message_type, m.sent_at
+		FROM chats.messages m
+		WHERE m.chat_id = $1
+			AND ($3::bigint = 0 OR m.id < $3)
+			AND NOT EXISTS (
+				SELECT 1
+				FROM chats.user_blocks b
+				JOIN chats.users blocker ON blocker.id = b.blocker_id
+				JOIN chats.users blocked ON blocked.id = b.blocked_id
+				WHERE blocker.email = $2
+					AND blocked.email = m.sender
+			)
+		ORDER BY m.id DESC
+		LIMIT $4;
+	`
+
+	queryUpdateChatSettings = `
+		UPDATE chats.chat_participants p
+		SET muted_until = CASE WHEN $3::boolean THEN $4::timestamp ELSE p.muted_until END,
+			notification_level = COALESCE($5, p.notification_level),
+			pinned = COALESCE($6, p.pinned),
+			archived = COALESCE($7, p.archived)
+		FROM chats.users u
+		WHERE p.user_id = u.id
+			AND p.chat_id = $1
+			AND u.email = $2
+		RETURNING p.chat_id, p.muted_until, p.notification_level, p.pinned, p.archived;
+	`
+
+	queryIsParticipant = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM chats.chat_participants p
+			JOIN chats.users u ON u.id = p.user_id
+			WHERE p.chat_id = $1 AND u.email = $2
+		);
+	`
+
+	// queryListChats lists the pinned chats first, then the newest ones.
+	queryListChats = `
+		SELECT
+			p.chat_id,
+			p.muted_until,
+			p.notification_level,
+			p.pinned,
+			p.archived,
+			ARRAY(
+				SELECT pu.email
+				FROM chats.chat_participants pp
+				JOIN chats.users pu ON pu.id = pp.user_id
+				WHERE pp.chat_id = p.chat_id
+				ORDER BY pu.email
+			) AS participants
+		FROM chats.chat_participants p
+		JOIN chats.users u ON u.id = p.user_id
+		WHERE u.email = $1
+			AND ($2::boolean OR NOT p.archived)
+		ORDER BY p.pinned DESC, p.chat_id DESC;
 	`
 )
@@ -3,3 +3,16 @@ package repository
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i ChatRepository -o ./mocks/ -s "_minimock.go"
 //go:generate minimock -i LogRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i LogPartitionRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i OutboxRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i WebhookRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i BotRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PollRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ChatUpdateRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PresenceRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i UserRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i BlockRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i MentionRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i NotificationRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PinRepository -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ScheduledMessageRepository -o ./mocks/ -s "_minimock.go"
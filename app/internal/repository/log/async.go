@@ -0,0 +1,216 @@
+package log
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/metric"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/redact"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/log/model"
+)
+
+// apiLogEntry is an audit log entry waiting in the buffer together with the time it was created.
+type apiLogEntry struct {
+	params    modelRepo.CreateAPILogParams
+	createdAt time.Time
+}
+
+// AsyncRepository is a LogRepository that does not write entries in the caller's goroutine.
+// Entries are put into a bounded buffer and written in batches with COPY by Run,
+// so audit writes do not add latency to RPCs. Entries are dropped when the buffer is full.
+type AsyncRepository struct {
+	db       db.Client
+	redactor *redact.Redactor
+
+	batchSize     int
+	flushInterval time.Duration
+	flushTimeout  time.Duration
+
+	mu      sync.RWMutex
+	closed  bool
+	entries chan apiLogEntry
+	done    chan struct{}
+}
+
+// NewAsyncRepository creates a new instance of AsyncRepository with the provided database connection,
+// the redactor applied to the request and response data and the buffering settings.
+func NewAsyncRepository(db db.Client, redactor *redact.Redactor, cfg config.AuditLog) *AsyncRepository {
+	return &AsyncRepository{
+		db:            db,
+		redactor:      redactor,
+		batchSize:     cfg.BatchSize,
+		flushInterval: cfg.FlushInterval,
+		flushTimeout:  cfg.FlushTimeout,
+		entries:       make(chan apiLogEntry, cfg.BufferSize),
+		done:          make(chan struct{}),
+	}
+}
+
+// CreateAPILog enqueues the log of an api action. It only fails when the data cannot be encoded.
+func (r *AsyncRepository) CreateAPILog(ctx context.Context, params model.CreateAPILogParams) error {
+	logger.FromContext(ctx).Debug("AsyncRepository.CreateAPILog", slog.Any("params", params))
+
+	paramsRepo, err := prepareAPILog(r.redactor, params)
+	if err != nil {
+		return err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.closed {
+		r.drop(ctx, params.Method, "audit log is closed")
+		return nil
+	}
+
+	select {
+	case r.entries <- apiLogEntry{params: paramsRepo, createdAt: time.Now()}:
+	default:
+		r.drop(ctx, params.Method, "audit log buffer is full")
+	}
+
+	return nil
+}
+
+// ListAPILogs returns the logs of api actions matching the filters, newest first.
+// Entries still waiting in the buffer are not returned.
+func (r *AsyncRepository) ListAPILogs(
+	ctx context.Context,
+	params model.ListAuditLogParams,
+) ([]model.AuditLogEntry, error) {
+	return listAPILogs(ctx, r.db, r.redactor, params)
+}
+
+// Run writes the buffered entries every time a batch is full or the flush interval elapses.
+// It returns once Close has been called and every remaining entry has been written.
+func (r *AsyncRepository) Run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]apiLogEntry, 0, r.batchSize)
+
+	for {
+		select {
+		case entry, ok := <-r.entries:
+			if !ok {
+				r.flush(batch)
+				return
+			}
+
+			batch = append(batch, entry)
+			if len(batch) >= r.batchSize {
+				r.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			r.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+// Close stops accepting entries and waits until Run has drained the buffer.
+func (r *AsyncRepository) Close() error {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.entries)
+	}
+	r.mu.Unlock()
+
+	<-r.done
+
+	return nil
+}
+
+// flush writes the batch in a single COPY, dropping it if the database rejects it.
+func (r *AsyncRepository) flush(batch []apiLogEntry) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.flushTimeout)
+	defer cancel()
+
+	err := r.copyEntries(ctx, batch)
+	if err != nil {
+		slog.Error("failed to write audit log batch",
+			slog.Int("entries", len(batch)),
+			slog.String("error", err.Error()),
+		)
+		metric.AddAuditLogEntries(metric.AuditLogDropped, len(batch))
+
+		return
+	}
+
+	metric.AddAuditLogEntries(metric.AuditLogWritten, len(batch))
+}
+
+func (r *AsyncRepository) copyEntries(ctx context.Context, batch []apiLogEntry) (err error) {
+	rows := make([][]interface{}, len(batch))
+	for i, entry := range batch {
+		rows[i] = []interface{}{
+			entry.params.Method,
+			entry.params.RequestData,
+			nullable(entry.params.ResponseData),
+			nullable(entry.params.Caller),
+			nullable(entry.params.Peer),
+			nullable(entry.params.RequestID),
+			entry.params.StatusCode,
+			entry.params.DurationUS,
+			entry.createdAt,
+		}
+	}
+
+	tx, err := r.db.DB().BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return errors.Wrap(err, "Cannot begin audit log transaction")
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	_, err = tx.CopyFrom(ctx, apiLogTable, apiLogColumns, pgx.CopyFromRows(rows))
+	if err != nil {
+		return errors.Wrap(err, "Cannot copy audit log entries")
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Cannot commit audit log entries")
+	}
+
+	return nil
+}
+
+// nullable converts the string to a COPY value, nil for NULL.
+func nullable(s sql.NullString) interface{} {
+	if !s.Valid {
+		return nil
+	}
+
+	return s.String
+}
+
+func (r *AsyncRepository) drop(ctx context.Context, method, reason string) {
+	logger.FromContext(ctx).Warn("audit log entry dropped",
+		slog.String("action", method),
+		slog.String("reason", reason),
+	)
+	metric.AddAuditLogEntries(metric.AuditLogDropped, 1)
+}
@@ -3,6 +3,7 @@ package converter
 import (
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/Prrromanssss/chat-server/internal/model"
 	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/log/model"
@@ -33,5 +34,57 @@ func ConvertCreateAPILogParamsFromServiceToRepo(params model.CreateAPILogParams)
 		Method:       params.Method,
 		RequestData:  requestData,
 		ResponseData: responseData,
+		Caller:       toNullString(params.Caller),
+		Peer:         toNullString(params.Peer),
+		RequestID:    toNullString(params.RequestID),
+		StatusCode:   params.StatusCode,
+		DurationUS:   params.Duration.Microseconds(),
 	}, nil
 }
+
+// toNullString converts an empty string to NULL.
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// ConvertAPILogsFromRepoToService converts stored api logs from the repository layer
+// to audit log entries of the service layer.
+func ConvertAPILogsFromRepoToService(logs []modelRepo.APILog) []model.AuditLogEntry {
+	entries := make([]model.AuditLogEntry, len(logs))
+	for i, log := range logs {
+		entries[i] = ConvertAPILogFromRepoToService(log)
+	}
+
+	return entries
+}
+
+// ConvertAPILogFromRepoToService converts a stored api log from the repository layer
+// to an audit log entry of the service layer.
+func ConvertAPILogFromRepoToService(log modelRepo.APILog) model.AuditLogEntry {
+	return model.AuditLogEntry{
+		ID:           log.ID,
+		ActionType:   log.ActionType,
+		RequestData:  log.RequestData,
+		ResponseData: log.ResponseData,
+		Caller:       log.Caller.String,
+		Peer:         log.Peer.String,
+		RequestID:    log.RequestID.String,
+		StatusCode:   log.StatusCode,
+		Duration:     time.Duration(log.DurationUS) * time.Microsecond,
+		Timestamp:    log.Timestamp,
+	}
+}
+
+// ConvertAPILogPartitionsFromRepoToService converts the partitions of the api log table
+// from the repository layer to the service layer format.
+func ConvertAPILogPartitionsFromRepoToService(partitions []modelRepo.APILogPartition) []model.AuditLogPartition {
+	result := make([]model.AuditLogPartition, len(partitions))
+	for i, partition := range partitions {
+		result[i] = model.AuditLogPartition{
+			Name:       partition.Name,
+			UpperBound: partition.UpperBound,
+		}
+	}
+
+	return result
+}
@@ -1,10 +1,38 @@
 package model
 
-import "database/sql"
+import (
+	"database/sql"
+	"time"
+)
 
 // CreateAPILogParams holds the parameters for logging API actions related to user creation.
 type CreateAPILogParams struct {
 	Method       string         `db:"action_type"`
 	RequestData  string         `db:"request_data"`
 	ResponseData sql.NullString `db:"response_data"`
+	Caller       sql.NullString `db:"caller"`
+	Peer         sql.NullString `db:"peer"`
+	RequestID    sql.NullString `db:"request_id"`
+	StatusCode   string         `db:"status_code"`
+	DurationUS   int64          `db:"duration_us"`
+}
+
+// APILog represents a stored log of an api action.
+type APILog struct {
+	ID           int64          `db:"id"`
+	ActionType   string         `db:"action_type"`
+	RequestData  []byte         `db:"request_data"`
+	ResponseData []byte         `db:"response_data"`
+	Caller       sql.NullString `db:"caller"`
+	Peer         sql.NullString `db:"peer"`
+	RequestID    sql.NullString `db:"request_id"`
+	StatusCode   string         `db:"status_code"`
+	DurationUS   int64          `db:"duration_us"`
+	Timestamp    time.Time      `db:"timestamp"`
+}
+
+// APILogPartition represents a partition of the api log table.
+type APILogPartition struct {
+	Name       string    `db:"name"`
+	UpperBound time.Time `db:"upper_bound"`
 }
@@ -0,0 +1,157 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/log/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/log/model"
+)
+
+type logPartitionPGRepo struct {
+	db db.Client
+}
+
+// NewPartitionRepository creates a new instance of logPartitionPGRepo with the provided database connection.
+func NewPartitionRepository(db db.Client) repository.LogPartitionRepository {
+	return &logPartitionPGRepo{db: db}
+}
+
+// LockAPILogMaintenance takes the maintenance lock, released when the transaction of the context ends.
+func (p *logPartitionPGRepo) LockAPILogMaintenance(ctx context.Context) (locked bool, err error) {
+	logger.FromContext(ctx).Debug("logPartitionPGRepo.LockAPILogMaintenance")
+
+	q := db.Query{
+		Name:     "logPartitionPGRepo.LockAPILogMaintenance",
+		QueryRaw: queryLockAPILogMaintenance,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &locked, q)
+	if err != nil {
+		return false, errors.Wrap(err, "Cannot take api log maintenance lock")
+	}
+
+	return locked, nil
+}
+
+// ListAPILogPartitions returns the partitions of the audit log ordered by their upper bound.
+func (p *logPartitionPGRepo) ListAPILogPartitions(ctx context.Context) (partitions []model.AuditLogPartition, err error) {
+	logger.FromContext(ctx).Debug("logPartitionPGRepo.ListAPILogPartitions")
+
+	q := db.Query{
+		Name:     "logPartitionPGRepo.ListAPILogPartitions",
+		QueryRaw: queryListAPILogPartitions,
+	}
+
+	var partitionsRepo []modelRepo.APILogPartition
+
+	err = p.db.DB().ScanAllContext(ctx, &partitionsRepo, q)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list api log partitions")
+	}
+
+	return converter.ConvertAPILogPartitionsFromRepoToService(partitionsRepo), nil
+}
+
+// CreateAPILogPartition creates the daily partition starting at from, named after its first day.
+func (p *logPartitionPGRepo) CreateAPILogPartition(ctx context.Context, from, to time.Time) (err error) {
+	logger.FromContext(ctx).Debug("logPartitionPGRepo.CreateAPILogPartition",
+		slog.Time("from", from),
+		slog.Time("to", to),
+	)
+
+	q := db.Query{
+		Name: "logPartitionPGRepo.CreateAPILogPartition",
+		QueryRaw: fmt.Sprintf(
+			queryCreateAPILogPartition,
+			partitionIdentifier(apiLogPartitionPrefix+from.Format(apiLogPartitionLayout)),
+			from.Format(apiLogBoundLayout),
+			to.Format(apiLogBoundLayout),
+		),
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create api log partition(from: %s)", from.Format(apiLogBoundLayout))
+	}
+
+	return nil
+}
+
+// ExportAPILogPartition streams the entries of the partition to fn, oldest first.
+func (p *logPartitionPGRepo) ExportAPILogPartition(
+	ctx context.Context,
+	name string,
+	fn func(entry model.AuditLogEntry) error,
+) (err error) {
+	logger.FromContext(ctx).Debug("logPartitionPGRepo.ExportAPILogPartition", slog.String("partition", name))
+
+	q := db.Query{
+		Name:     "logPartitionPGRepo.ExportAPILogPartition",
+		QueryRaw: fmt.Sprintf(queryExportAPILogPartition, partitionIdentifier(name)),
+	}
+
+	rows, err := p.db.DB().QueryContext(ctx, q)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot export api log partition(name: %s)", name)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var log modelRepo.APILog
+
+		err = rows.Scan(
+			&log.ID,
+			&log.ActionType,
+			&log.RequestData,
+			&log.ResponseData,
+			&log.Caller,
+			&log.Peer,
+			&log.RequestID,
+			&log.StatusCode,
+			&log.DurationUS,
+			&log.Timestamp,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "Cannot scan api log of partition(name: %s)", name)
+		}
+
+		err = fn(converter.ConvertAPILogFromRepoToService(log))
+		if err != nil {
+			return err
+		}
+	}
+
+	return errors.Wrapf(rows.Err(), "Cannot export api log partition(name: %s)", name)
+}
+
+// DropAPILogPartition drops the partition with all its entries.
+func (p *logPartitionPGRepo) DropAPILogPartition(ctx context.Context, name string) (err error) {
+	logger.FromContext(ctx).Debug("logPartitionPGRepo.DropAPILogPartition", slog.String("partition", name))
+
+	q := db.Query{
+		Name:     "logPartitionPGRepo.DropAPILogPartition",
+		QueryRaw: fmt.Sprintf(queryDropAPILogPartition, partitionIdentifier(name)),
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot drop api log partition(name: %s)", name)
+	}
+
+	return nil
+}
+
+// partitionIdentifier returns the quoted qualified name of the partition.
+func partitionIdentifier(name string) string {
+	return pgx.Identifier{apiLogSchema, name}.Sanitize()
+}
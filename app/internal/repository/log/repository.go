@@ -2,23 +2,33 @@ package log
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"strings"
 
 	"github.com/Prrromanssss/platform_common/pkg/db"
-	"github.com/gofiber/fiber/v2/log"
 	"github.com/pkg/errors"
 
+	"github.com/Prrromanssss/chat-server/internal/logger"
 	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/redact"
 	"github.com/Prrromanssss/chat-server/internal/repository"
 	"github.com/Prrromanssss/chat-server/internal/repository/log/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/log/model"
 )
 
 type logPGRepo struct {
-	db db.Client
+	db       db.Client
+	redactor *redact.Redactor
 }
 
-// NewRepository creates a new instance of logPGRepo with the provided database connection.
-func NewRepository(db db.Client) repository.LogRepository {
-	return &logPGRepo{db: db}
+// NewRepository creates a new instance of logPGRepo with the provided database connection
+// and the redactor applied to the request and response data before they are stored.
+func NewRepository(db db.Client, redactor *redact.Redactor) repository.LogRepository {
+	return &logPGRepo{
+		db:       db,
+		redactor: redactor,
+	}
 }
 
 // CreateAPILog creates log in database of every api action.
@@ -26,9 +36,9 @@ func (p *logPGRepo) CreateAPILog(
 	ctx context.Context,
 	params model.CreateAPILogParams,
 ) (err error) {
-	log.Infof("logPGRepo.CreateAPILog, params: %+v", params)
+	logger.FromContext(ctx).Debug("logPGRepo.CreateAPILog", slog.Any("params", params))
 
-	paramsRepo, err := converter.ConvertCreateAPILogParamsFromServiceToRepo(params)
+	paramsRepo, err := prepareAPILog(p.redactor, params)
 	if err != nil {
 		return err
 	}
@@ -38,7 +48,18 @@ func (p *logPGRepo) CreateAPILog(
 		QueryRaw: queryCreateAPILog,
 	}
 
-	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.Method, paramsRepo.RequestData, paramsRepo.ResponseData)
+	_, err = p.db.DB().ExecContext(
+		ctx,
+		q,
+		paramsRepo.Method,
+		paramsRepo.RequestData,
+		paramsRepo.ResponseData,
+		paramsRepo.Caller,
+		paramsRepo.Peer,
+		paramsRepo.RequestID,
+		paramsRepo.StatusCode,
+		paramsRepo.DurationUS,
+	)
 	if err != nil {
 		return errors.Wrapf(
 			err,
@@ -48,3 +69,101 @@ func (p *logPGRepo) CreateAPILog(
 
 	return nil
 }
+
+// prepareAPILog redacts the request and response data and converts the log entry to the repository format.
+func prepareAPILog(redactor *redact.Redactor, params model.CreateAPILogParams) (modelRepo.CreateAPILogParams, error) {
+	params.RequestData = redactor.Redact(params.RequestData)
+	params.ResponseData = redactor.Redact(params.ResponseData)
+
+	return converter.ConvertCreateAPILogParamsFromServiceToRepo(params)
+}
+
+// ListAPILogs returns the logs of api actions matching the filters, newest first.
+func (p *logPGRepo) ListAPILogs(
+	ctx context.Context,
+	params model.ListAuditLogParams,
+) (logs []model.AuditLogEntry, err error) {
+	return listAPILogs(ctx, p.db, p.redactor, params)
+}
+
+// listAPILogs selects a page of logs. The chat and the sender are matched on the keys the audited params
+// are marshaled with, see their json tags. A masked email matches every sender with the same first character
+// and domain, so the sender filter is only supported when the emails are kept.
+func listAPILogs(
+	ctx context.Context,
+	dbc db.Client,
+	redactor *redact.Redactor,
+	params model.ListAuditLogParams,
+) ([]model.AuditLogEntry, error) {
+	logger.FromContext(ctx).Debug("logPGRepo.ListAPILogs", slog.Any("params", params))
+
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if len(params.ActionTypes) > 0 {
+		conditions = append(conditions, "action_type = ANY("+arg(params.ActionTypes)+")")
+	}
+
+	if !params.From.IsZero() {
+		conditions = append(conditions, "timestamp >= "+arg(params.From))
+	}
+
+	if !params.To.IsZero() {
+		conditions = append(conditions, "timestamp < "+arg(params.To))
+	}
+
+	if params.ChatID != 0 {
+		chatID := arg(fmt.Sprintf(`{"chat_id": %d}`, params.ChatID))
+		conditions = append(conditions, fmt.Sprintf("(request_data @> %s OR response_data @> %s)", chatID, chatID))
+	}
+
+	if params.Sender != "" {
+		switch redactor.Action(redact.KindEmail) {
+		case redact.ActionDrop:
+			// Senders are not stored at all.
+			return []model.AuditLogEntry{}, nil
+		case redact.ActionMask:
+			return nil, errors.Wrap(model.ErrInvalidArgument, "sender filter is not supported with masked emails")
+		}
+
+		conditions = append(conditions, "request_data @> "+arg(map[string]string{"from": params.Sender}))
+	}
+
+	if len(params.RequestData) > 0 {
+		conditions = append(conditions, "request_data @> "+arg(params.RequestData))
+	}
+
+	if params.Cursor != nil {
+		conditions = append(conditions, fmt.Sprintf(
+			"(timestamp, id) < (%s, %s)",
+			arg(params.Cursor.Timestamp),
+			arg(params.Cursor.ID),
+		))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	q := db.Query{
+		Name:     "logPGRepo.ListAPILogs",
+		QueryRaw: fmt.Sprintf(queryListAPILogs, where, arg(params.PageSize)),
+	}
+
+	var logs []modelRepo.APILog
+
+	err := dbc.DB().ScanAllContext(ctx, &logs, q, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list api logs")
+	}
+
+	return converter.ConvertAPILogsFromRepoToService(logs), nil
+}
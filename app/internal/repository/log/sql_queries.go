@@ -1,10 +1,78 @@
 package log
 
+import "github.com/jackc/pgx/v4"
+
 const (
 	queryCreateAPILog = `
 		INSERT INTO chats.api_chat_log
-			(action_type, request_data, response_data)
+			(action_type, request_data, response_data, caller, peer, request_id, status_code, duration_us)
 		VALUES
-			($1, $2, $3);
+			($1, $2, $3, $4, $5, $6, $7, $8);
+	`
+
+	queryListAPILogs = `
+		SELECT
+			id, action_type, request_data, response_data,
+			caller, peer, request_id, status_code, duration_us, timestamp
+		FROM chats.api_chat_log
+		%s
+		ORDER BY timestamp DESC, id DESC
+		LIMIT %s;
+	`
+
+	// queryLockAPILogMaintenance takes a transaction-level advisory lock, so that
+	// a single instance maintains the partitions at a time.
+	queryLockAPILogMaintenance = `
+		SELECT pg_try_advisory_xact_lock(hashtext('chats.api_chat_log maintenance'));
+	`
+
+	// queryListAPILogPartitions returns the partitions with the upper bound of their range,
+	// which is always a literal timestamp.
+	queryListAPILogPartitions = `
+		SELECT
+			c.relname AS name,
+			substring(pg_get_expr(c.relpartbound, c.oid) FROM 'TO \(''([^'']+)''\)')::timestamp AS upper_bound
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'chats.api_chat_log'::regclass
+		ORDER BY upper_bound;
+	`
+
+	queryCreateAPILogPartition = `
+		CREATE TABLE IF NOT EXISTS %s PARTITION OF chats.api_chat_log
+		FOR VALUES FROM ('%s') TO ('%s');
+	`
+
+	queryExportAPILogPartition = `
+		SELECT
+			id, action_type, request_data, response_data,
+			caller, peer, request_id, status_code, duration_us, timestamp
+		FROM %s
+		ORDER BY timestamp, id;
+	`
+
+	queryDropAPILogPartition = `
+		DROP TABLE %s;
 	`
 )
+
+const (
+	// apiLogSchema is the schema of the audit log table and its partitions.
+	apiLogSchema = "chats"
+
+	// apiLogPartitionPrefix and apiLogPartitionLayout name the daily partitions, e.g. api_chat_log_p20240804.
+	apiLogPartitionPrefix = "api_chat_log_p"
+	apiLogPartitionLayout = "20060102"
+
+	// apiLogBoundLayout formats the bounds of the partition ranges.
+	apiLogBoundLayout = "2006-01-02 15:04:05"
+)
+
+var (
+	// apiLogTable and apiLogColumns are the target of the COPY used to write audit log entries in batches.
+	apiLogTable   = pgx.Identifier{"chats", "api_chat_log"}
+	apiLogColumns = []string{
+		"action_type", "request_data", "response_data",
+		"caller", "peer", "request_id", "status_code", "duration_us", "timestamp",
+	}
+)
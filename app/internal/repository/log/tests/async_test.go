@@ -0,0 +1,182 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/redact"
+	logRepository "github.com/Prrromanssss/chat-server/internal/repository/log"
+)
+
+// fakeDB records the rows copied in every committed transaction.
+type fakeDB struct {
+	db.DB
+
+	mu      sync.Mutex
+	copyErr error
+	batches [][][]interface{}
+}
+
+func (d *fakeDB) BeginTx(_ context.Context, _ pgx.TxOptions) (pgx.Tx, error) {
+	return &fakeTx{db: d}, nil
+}
+
+func (d *fakeDB) committed() [][][]interface{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.batches
+}
+
+type fakeTx struct {
+	pgx.Tx
+
+	db   *fakeDB
+	rows [][]interface{}
+}
+
+func (tx *fakeTx) CopyFrom(
+	ctx context.Context,
+	table pgx.Identifier,
+	columns []string,
+	src pgx.CopyFromSource,
+) (int64, error) {
+	if tx.db.copyErr != nil {
+		return 0, tx.db.copyErr
+	}
+
+	for src.Next() {
+		values, err := src.Values()
+		if err != nil {
+			return 0, err
+		}
+
+		tx.rows = append(tx.rows, values)
+	}
+
+	return int64(len(tx.rows)), nil
+}
+
+func (tx *fakeTx) Commit(_ context.Context) error {
+	tx.db.mu.Lock()
+	defer tx.db.mu.Unlock()
+
+	tx.db.batches = append(tx.db.batches, tx.rows)
+
+	return nil
+}
+
+func (tx *fakeTx) Rollback(_ context.Context) error {
+	return nil
+}
+
+type fakeClient struct {
+	db *fakeDB
+}
+
+func (c *fakeClient) DB() db.DB {
+	return c.db
+}
+
+func (c *fakeClient) Close() error {
+	return nil
+}
+
+func newAsyncRepository(t *testing.T, d *fakeDB, cfg config.AuditLog) *logRepository.AsyncRepository {
+	t.Helper()
+
+	redactor, err := redact.New(config.Redaction{Email: "mask", Text: "drop"})
+	require.NoError(t, err)
+
+	return logRepository.NewAsyncRepository(&fakeClient{db: d}, redactor, cfg)
+}
+
+func TestAsyncRepositoryBatches(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		d   = &fakeDB{}
+
+		params = model.CreateAPILogParams{
+			Method: "SendMessage",
+			RequestData: model.SendMessageParams{
+				From: "alice@example.com",
+				Text: "hello",
+			},
+		}
+	)
+
+	repo := newAsyncRepository(t, d, config.AuditLog{
+		BufferSize:    10,
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+		FlushTimeout:  time.Second,
+	})
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, repo.CreateAPILog(ctx, params))
+	}
+
+	go repo.Run()
+
+	require.Eventually(t, func() bool { return len(d.committed()) == 1 }, time.Second, time.Millisecond)
+
+	// The last entry does not fill a batch and is only written when the repository is drained.
+	require.NoError(t, repo.Close())
+
+	batches := d.committed()
+	require.Len(t, batches, 2)
+	require.Len(t, batches[0], 2)
+	require.Len(t, batches[1], 1)
+
+	row := batches[1][0]
+	require.Equal(t, "SendMessage", row[0])
+	require.Nil(t, row[2])
+
+	requestData := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal([]byte(row[1].(string)), &requestData))
+	require.Equal(t, "a***@example.com", requestData["from"])
+	require.NotContains(t, requestData, "Text")
+
+	// Entries created after the drain are dropped without failing the caller.
+	require.NoError(t, repo.CreateAPILog(ctx, params))
+	require.Len(t, d.committed(), 2)
+}
+
+func TestAsyncRepositoryDropsEntries(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		d      = &fakeDB{copyErr: errors.New("copy error")}
+		params = model.CreateAPILogParams{Method: "Delete", RequestData: model.DeleteChatParams{ChatID: 1}}
+	)
+
+	repo := newAsyncRepository(t, d, config.AuditLog{
+		BufferSize:    1,
+		BatchSize:     10,
+		FlushInterval: time.Hour,
+		FlushTimeout:  time.Second,
+	})
+
+	// The second entry does not fit in the buffer.
+	require.NoError(t, repo.CreateAPILog(ctx, params))
+	require.NoError(t, repo.CreateAPILog(ctx, params))
+
+	go repo.Run()
+
+	// A batch rejected by the database is dropped as well.
+	require.NoError(t, repo.Close())
+	require.Empty(t, d.committed())
+}
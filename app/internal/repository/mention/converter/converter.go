@@ -0,0 +1,28 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/mention/model"
+)
+
+// ConvertMentionedUserFromRepoToService converts a stored mention of a participant from the repository layer
+// to the service layer format.
+func ConvertMentionedUserFromRepoToService(user modelRepo.MentionedUser) model.MentionedUser {
+	return model.MentionedUser{
+		MentionID:         user.MentionID,
+		Email:             user.Email,
+		NotificationLevel: user.NotificationLevel,
+	}
+}
+
+// ConvertMentionFromRepoToService converts a stored mention from the repository layer to the service layer format.
+func ConvertMentionFromRepoToService(mention modelRepo.Mention) model.Mention {
+	return model.Mention{
+		ID:        mention.ID,
+		MessageID: mention.MessageID,
+		ChatID:    mention.ChatID,
+		From:      mention.From,
+		Text:      mention.Text,
+		SentAt:    mention.SentAt,
+	}
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// MentionedUser represents a stored mention of a participant with their notification level of the chat.
+type MentionedUser struct {
+	MentionID         int64  `db:"id"`
+	Email             string `db:"email"`
+	NotificationLevel string `db:"notification_level"`
+}
+
+// Mention represents a stored mention of a user with the message mentioning them.
+type Mention struct {
+	ID        int64     `db:"id"`
+	MessageID int64     `db:"message_id"`
+	ChatID    int64     `db:"chat_id"`
+	From      string    `db:"sender"`
+	Text      string    `db:"message_text"`
+	SentAt    time.Time `db:"sent_at"`
+}
@@ -0,0 +1,80 @@
+package mention
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/mention/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/mention/model"
+)
+
+type mentionPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of mentionPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.MentionRepository {
+	return &mentionPGRepo{
+		db: db,
+	}
+}
+
+// CreateMentions stores the mentions of the participants named in the message and returns them.
+func (p *mentionPGRepo) CreateMentions(
+	ctx context.Context,
+	params model.CreateMentionsParams,
+) (users []model.MentionedUser, err error) {
+	logger.FromContext(ctx).Debug("mentionPGRepo.CreateMentions", slog.Int64("message_id", params.MessageID))
+
+	q := db.Query{
+		Name:     "mentionPGRepo.CreateMentions",
+		QueryRaw: queryCreateMentions,
+	}
+
+	var usersRepo []modelRepo.MentionedUser
+
+	err = p.db.DB().ScanAllContext(ctx, &usersRepo, q, params.MessageID, params.ChatID, params.From, params.Mentions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot create mentions (messageID: %d)", params.MessageID)
+	}
+
+	users = make([]model.MentionedUser, len(usersRepo))
+	for i, user := range usersRepo {
+		users[i] = converter.ConvertMentionedUserFromRepoToService(user)
+	}
+
+	return users, nil
+}
+
+// ListMentions returns a page of the mentions of the user, newest first.
+func (p *mentionPGRepo) ListMentions(
+	ctx context.Context,
+	params model.ListMentionsParams,
+) (mentions []model.Mention, err error) {
+	logger.FromContext(ctx).Debug("mentionPGRepo.ListMentions", slog.Int64("limit", params.Limit))
+
+	q := db.Query{
+		Name:     "mentionPGRepo.ListMentions",
+		QueryRaw: queryListMentions,
+	}
+
+	var mentionsRepo []modelRepo.Mention
+
+	err = p.db.DB().ScanAllContext(ctx, &mentionsRepo, q, params.Email, params.BeforeID, params.Limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list mentions")
+	}
+
+	mentions = make([]model.Mention, len(mentionsRepo))
+	for i, mention := range mentionsRepo {
+		mentions[i] = converter.ConvertMentionFromRepoToService(mention)
+	}
+
+	return mentions, nil
+}
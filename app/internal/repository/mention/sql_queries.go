@@ -0,0 +1,44 @@
+package mention
+
+const (
+	// queryCreateMentions resolves the mentioned emails and display names, lower-cased, against the participants
+	// of the chat other than the sender, skipping the participants who block the sender.
+	queryCreateMentions = `
+		WITH mentioned AS (
+			SELECT u.id, u.email, p.notification_level
+			FROM chats.chat_participants p
+			JOIN chats.users u ON u.id = p.user_id
+			WHERE p.chat_id = $2
+				AND u.email <> $3
+				AND (lower(u.email) = ANY($4) OR (u.display_name <> '' AND lower(u.display_name) = ANY($4)))
+				AND NOT EXISTS (
+					SELECT 1
+					FROM chats.user_blocks b
+					JOIN chats.users sender ON sender.id = b.blocked_id
+					WHERE b.blocker_id = u.id
+						AND sender.email = $3
+				)
+		), ins AS (
+			INSERT INTO chats.mentions (message_id, chat_id, user_id, mentioned_by)
+			SELECT $1, $2, id, $3
+			FROM mentioned
+			ON CONFLICT (message_id, user_id) DO NOTHING
+			RETURNING id, user_id
+		)
+		SELECT ins.id, m.email, m.notification_level
+		FROM ins
+		JOIN mentioned m ON m.id = ins.user_id
+		ORDER BY ins.id;
+	`
+
+	queryListMentions = `
+		SELECT mn.id, mn.message_id, mn.chat_id, m.sender, m.message_text, m.sent_at
+		FROM chats.mentions mn
+		JOIN chats.users u ON u.id = mn.user_id
+		JOIN chats.messages m ON m.id = mn.message_id
+		WHERE u.email = $1
+			AND ($2::bigint = 0 OR mn.id < $2)
+		ORDER BY mn.id DESC
+		LIMIT $3;
+	`
+)
@@ -0,0 +1,1420 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.BlockRepository -o block_repository_minimock.go -n BlockRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// BlockRepositoryMock implements repository.BlockRepository
+type BlockRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcBlockUser          func(ctx context.Context, params model.BlockUserParams) (err error)
+	inspectFuncBlockUser   func(ctx context.Context, params model.BlockUserParams)
+	afterBlockUserCounter  uint64
+	beforeBlockUserCounter uint64
+	BlockUserMock          mBlockRepositoryMockBlockUser
+
+	funcBlockedBetween          func(ctx context.Context, first string, second string) (blocked bool, err error)
+	inspectFuncBlockedBetween   func(ctx context.Context, first string, second string)
+	afterBlockedBetweenCounter  uint64
+	beforeBlockedBetweenCounter uint64
+	BlockedBetweenMock          mBlockRepositoryMockBlockedBetween
+
+	funcListBlocked          func(ctx context.Context, params model.ListBlockedParams) (users []model.BlockedUser, err error)
+	inspectFuncListBlocked   func(ctx context.Context, params model.ListBlockedParams)
+	afterListBlockedCounter  uint64
+	beforeListBlockedCounter uint64
+	ListBlockedMock          mBlockRepositoryMockListBlocked
+
+	funcUnblockUser          func(ctx context.Context, params model.UnblockUserParams) (err error)
+	inspectFuncUnblockUser   func(ctx context.Context, params model.UnblockUserParams)
+	afterUnblockUserCounter  uint64
+	beforeUnblockUserCounter uint64
+	UnblockUserMock          mBlockRepositoryMockUnblockUser
+}
+
+// NewBlockRepositoryMock returns a mock for repository.BlockRepository
+func NewBlockRepositoryMock(t minimock.Tester) *BlockRepositoryMock {
+	m := &BlockRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.BlockUserMock = mBlockRepositoryMockBlockUser{mock: m}
+	m.BlockUserMock.callArgs = []*BlockRepositoryMockBlockUserParams{}
+
+	m.BlockedBetweenMock = mBlockRepositoryMockBlockedBetween{mock: m}
+	m.BlockedBetweenMock.callArgs = []*BlockRepositoryMockBlockedBetweenParams{}
+
+	m.ListBlockedMock = mBlockRepositoryMockListBlocked{mock: m}
+	m.ListBlockedMock.callArgs = []*BlockRepositoryMockListBlockedParams{}
+
+	m.UnblockUserMock = mBlockRepositoryMockUnblockUser{mock: m}
+	m.UnblockUserMock.callArgs = []*BlockRepositoryMockUnblockUserParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mBlockRepositoryMockBlockUser struct {
+	optional           bool
+	mock               *BlockRepositoryMock
+	defaultExpectation *BlockRepositoryMockBlockUserExpectation
+	expectations       []*BlockRepositoryMockBlockUserExpectation
+
+	callArgs []*BlockRepositoryMockBlockUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BlockRepositoryMockBlockUserExpectation specifies expectation struct of the BlockRepository.BlockUser
+type BlockRepositoryMockBlockUserExpectation struct {
+	mock      *BlockRepositoryMock
+	params    *BlockRepositoryMockBlockUserParams
+	paramPtrs *BlockRepositoryMockBlockUserParamPtrs
+	results   *BlockRepositoryMockBlockUserResults
+	Counter   uint64
+}
+
+// BlockRepositoryMockBlockUserParams contains parameters of the BlockRepository.BlockUser
+type BlockRepositoryMockBlockUserParams struct {
+	ctx    context.Context
+	params model.BlockUserParams
+}
+
+// BlockRepositoryMockBlockUserParamPtrs contains pointers to parameters of the BlockRepository.BlockUser
+type BlockRepositoryMockBlockUserParamPtrs struct {
+	ctx    *context.Context
+	params *model.BlockUserParams
+}
+
+// BlockRepositoryMockBlockUserResults contains results of the BlockRepository.BlockUser
+type BlockRepositoryMockBlockUserResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBlockUser *mBlockRepositoryMockBlockUser) Optional() *mBlockRepositoryMockBlockUser {
+	mmBlockUser.optional = true
+	return mmBlockUser
+}
+
+// Expect sets up expected params for BlockRepository.BlockUser
+func (mmBlockUser *mBlockRepositoryMockBlockUser) Expect(ctx context.Context, params model.BlockUserParams) *mBlockRepositoryMockBlockUser {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("BlockRepositoryMock.BlockUser mock is already set by Set")
+	}
+
+	if mmBlockUser.defaultExpectation == nil {
+		mmBlockUser.defaultExpectation = &BlockRepositoryMockBlockUserExpectation{}
+	}
+
+	if mmBlockUser.defaultExpectation.paramPtrs != nil {
+		mmBlockUser.mock.t.Fatalf("BlockRepositoryMock.BlockUser mock is already set by ExpectParams functions")
+	}
+
+	mmBlockUser.defaultExpectation.params = &BlockRepositoryMockBlockUserParams{ctx, params}
+	for _, e := range mmBlockUser.expectations {
+		if minimock.Equal(e.params, mmBlockUser.defaultExpectation.params) {
+			mmBlockUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBlockUser.defaultExpectation.params)
+		}
+	}
+
+	return mmBlockUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlockRepository.BlockUser
+func (mmBlockUser *mBlockRepositoryMockBlockUser) ExpectCtxParam1(ctx context.Context) *mBlockRepositoryMockBlockUser {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("BlockRepositoryMock.BlockUser mock is already set by Set")
+	}
+
+	if mmBlockUser.defaultExpectation == nil {
+		mmBlockUser.defaultExpectation = &BlockRepositoryMockBlockUserExpectation{}
+	}
+
+	if mmBlockUser.defaultExpectation.params != nil {
+		mmBlockUser.mock.t.Fatalf("BlockRepositoryMock.BlockUser mock is already set by Expect")
+	}
+
+	if mmBlockUser.defaultExpectation.paramPtrs == nil {
+		mmBlockUser.defaultExpectation.paramPtrs = &BlockRepositoryMockBlockUserParamPtrs{}
+	}
+	mmBlockUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmBlockUser
+}
+
+// ExpectParamsParam2 sets up expected param params for BlockRepository.BlockUser
+func (mmBlockUser *mBlockRepositoryMockBlockUser) ExpectParamsParam2(params model.BlockUserParams) *mBlockRepositoryMockBlockUser {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("BlockRepositoryMock.BlockUser mock is already set by Set")
+	}
+
+	if mmBlockUser.defaultExpectation == nil {
+		mmBlockUser.defaultExpectation = &BlockRepositoryMockBlockUserExpectation{}
+	}
+
+	if mmBlockUser.defaultExpectation.params != nil {
+		mmBlockUser.mock.t.Fatalf("BlockRepositoryMock.BlockUser mock is already set by Expect")
+	}
+
+	if mmBlockUser.defaultExpectation.paramPtrs == nil {
+		mmBlockUser.defaultExpectation.paramPtrs = &BlockRepositoryMockBlockUserParamPtrs{}
+	}
+	mmBlockUser.defaultExpectation.paramPtrs.params = &params
+
+	return mmBlockUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlockRepository.BlockUser
+func (mmBlockUser *mBlockRepositoryMockBlockUser) Inspect(f func(ctx context.Context, params model.BlockUserParams)) *mBlockRepositoryMockBlockUser {
+	if mmBlockUser.mock.inspectFuncBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("Inspect function is already set for BlockRepositoryMock.BlockUser")
+	}
+
+	mmBlockUser.mock.inspectFuncBlockUser = f
+
+	return mmBlockUser
+}
+
+// Return sets up results that will be returned by BlockRepository.BlockUser
+func (mmBlockUser *mBlockRepositoryMockBlockUser) Return(err error) *BlockRepositoryMock {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("BlockRepositoryMock.BlockUser mock is already set by Set")
+	}
+
+	if mmBlockUser.defaultExpectation == nil {
+		mmBlockUser.defaultExpectation = &BlockRepositoryMockBlockUserExpectation{mock: mmBlockUser.mock}
+	}
+	mmBlockUser.defaultExpectation.results = &BlockRepositoryMockBlockUserResults{err}
+	return mmBlockUser.mock
+}
+
+// Set uses given function f to mock the BlockRepository.BlockUser method
+func (mmBlockUser *mBlockRepositoryMockBlockUser) Set(f func(ctx context.Context, params model.BlockUserParams) (err error)) *BlockRepositoryMock {
+	if mmBlockUser.defaultExpectation != nil {
+		mmBlockUser.mock.t.Fatalf("Default expectation is already set for the BlockRepository.BlockUser method")
+	}
+
+	if len(mmBlockUser.expectations) > 0 {
+		mmBlockUser.mock.t.Fatalf("Some expectations are already set for the BlockRepository.BlockUser method")
+	}
+
+	mmBlockUser.mock.funcBlockUser = f
+	return mmBlockUser.mock
+}
+
+// When sets expectation for the BlockRepository.BlockUser which will trigger the result defined by the following
+// Then helper
+func (mmBlockUser *mBlockRepositoryMockBlockUser) When(ctx context.Context, params model.BlockUserParams) *BlockRepositoryMockBlockUserExpectation {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("BlockRepositoryMock.BlockUser mock is already set by Set")
+	}
+
+	expectation := &BlockRepositoryMockBlockUserExpectation{
+		mock:   mmBlockUser.mock,
+		params: &BlockRepositoryMockBlockUserParams{ctx, params},
+	}
+	mmBlockUser.expectations = append(mmBlockUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlockRepository.BlockUser return parameters for the expectation previously defined by the When method
+func (e *BlockRepositoryMockBlockUserExpectation) Then(err error) *BlockRepositoryMock {
+	e.results = &BlockRepositoryMockBlockUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times BlockRepository.BlockUser should be invoked
+func (mmBlockUser *mBlockRepositoryMockBlockUser) Times(n uint64) *mBlockRepositoryMockBlockUser {
+	if n == 0 {
+		mmBlockUser.mock.t.Fatalf("Times of BlockRepositoryMock.BlockUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBlockUser.expectedInvocations, n)
+	return mmBlockUser
+}
+
+func (mmBlockUser *mBlockRepositoryMockBlockUser) invocationsDone() bool {
+	if len(mmBlockUser.expectations) == 0 && mmBlockUser.defaultExpectation == nil && mmBlockUser.mock.funcBlockUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBlockUser.mock.afterBlockUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBlockUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BlockUser implements repository.BlockRepository
+func (mmBlockUser *BlockRepositoryMock) BlockUser(ctx context.Context, params model.BlockUserParams) (err error) {
+	mm_atomic.AddUint64(&mmBlockUser.beforeBlockUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmBlockUser.afterBlockUserCounter, 1)
+
+	if mmBlockUser.inspectFuncBlockUser != nil {
+		mmBlockUser.inspectFuncBlockUser(ctx, params)
+	}
+
+	mm_params := BlockRepositoryMockBlockUserParams{ctx, params}
+
+	// Record call args
+	mmBlockUser.BlockUserMock.mutex.Lock()
+	mmBlockUser.BlockUserMock.callArgs = append(mmBlockUser.BlockUserMock.callArgs, &mm_params)
+	mmBlockUser.BlockUserMock.mutex.Unlock()
+
+	for _, e := range mmBlockUser.BlockUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmBlockUser.BlockUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBlockUser.BlockUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmBlockUser.BlockUserMock.defaultExpectation.params
+		mm_want_ptrs := mmBlockUser.BlockUserMock.defaultExpectation.paramPtrs
+
+		mm_got := BlockRepositoryMockBlockUserParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBlockUser.t.Errorf("BlockRepositoryMock.BlockUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmBlockUser.t.Errorf("BlockRepositoryMock.BlockUser got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBlockUser.t.Errorf("BlockRepositoryMock.BlockUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBlockUser.BlockUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBlockUser.t.Fatal("No results are set for the BlockRepositoryMock.BlockUser")
+		}
+		return (*mm_results).err
+	}
+	if mmBlockUser.funcBlockUser != nil {
+		return mmBlockUser.funcBlockUser(ctx, params)
+	}
+	mmBlockUser.t.Fatalf("Unexpected call to BlockRepositoryMock.BlockUser. %v %v", ctx, params)
+	return
+}
+
+// BlockUserAfterCounter returns a count of finished BlockRepositoryMock.BlockUser invocations
+func (mmBlockUser *BlockRepositoryMock) BlockUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlockUser.afterBlockUserCounter)
+}
+
+// BlockUserBeforeCounter returns a count of BlockRepositoryMock.BlockUser invocations
+func (mmBlockUser *BlockRepositoryMock) BlockUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlockUser.beforeBlockUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlockRepositoryMock.BlockUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBlockUser *mBlockRepositoryMockBlockUser) Calls() []*BlockRepositoryMockBlockUserParams {
+	mmBlockUser.mutex.RLock()
+
+	argCopy := make([]*BlockRepositoryMockBlockUserParams, len(mmBlockUser.callArgs))
+	copy(argCopy, mmBlockUser.callArgs)
+
+	mmBlockUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBlockUserDone returns true if the count of the BlockUser invocations corresponds
+// the number of defined expectations
+func (m *BlockRepositoryMock) MinimockBlockUserDone() bool {
+	if m.BlockUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BlockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BlockUserMock.invocationsDone()
+}
+
+// MinimockBlockUserInspect logs each unmet expectation
+func (m *BlockRepositoryMock) MinimockBlockUserInspect() {
+	for _, e := range m.BlockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlockRepositoryMock.BlockUser with params: %#v", *e.params)
+		}
+	}
+
+	afterBlockUserCounter := mm_atomic.LoadUint64(&m.afterBlockUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BlockUserMock.defaultExpectation != nil && afterBlockUserCounter < 1 {
+		if m.BlockUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BlockRepositoryMock.BlockUser")
+		} else {
+			m.t.Errorf("Expected call to BlockRepositoryMock.BlockUser with params: %#v", *m.BlockUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBlockUser != nil && afterBlockUserCounter < 1 {
+		m.t.Error("Expected call to BlockRepositoryMock.BlockUser")
+	}
+
+	if !m.BlockUserMock.invocationsDone() && afterBlockUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlockRepositoryMock.BlockUser but found %d calls",
+			mm_atomic.LoadUint64(&m.BlockUserMock.expectedInvocations), afterBlockUserCounter)
+	}
+}
+
+type mBlockRepositoryMockBlockedBetween struct {
+	optional           bool
+	mock               *BlockRepositoryMock
+	defaultExpectation *BlockRepositoryMockBlockedBetweenExpectation
+	expectations       []*BlockRepositoryMockBlockedBetweenExpectation
+
+	callArgs []*BlockRepositoryMockBlockedBetweenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BlockRepositoryMockBlockedBetweenExpectation specifies expectation struct of the BlockRepository.BlockedBetween
+type BlockRepositoryMockBlockedBetweenExpectation struct {
+	mock      *BlockRepositoryMock
+	params    *BlockRepositoryMockBlockedBetweenParams
+	paramPtrs *BlockRepositoryMockBlockedBetweenParamPtrs
+	results   *BlockRepositoryMockBlockedBetweenResults
+	Counter   uint64
+}
+
+// BlockRepositoryMockBlockedBetweenParams contains parameters of the BlockRepository.BlockedBetween
+type BlockRepositoryMockBlockedBetweenParams struct {
+	ctx    context.Context
+	first  string
+	second string
+}
+
+// BlockRepositoryMockBlockedBetweenParamPtrs contains pointers to parameters of the BlockRepository.BlockedBetween
+type BlockRepositoryMockBlockedBetweenParamPtrs struct {
+	ctx    *context.Context
+	first  *string
+	second *string
+}
+
+// BlockRepositoryMockBlockedBetweenResults contains results of the BlockRepository.BlockedBetween
+type BlockRepositoryMockBlockedBetweenResults struct {
+	blocked bool
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) Optional() *mBlockRepositoryMockBlockedBetween {
+	mmBlockedBetween.optional = true
+	return mmBlockedBetween
+}
+
+// Expect sets up expected params for BlockRepository.BlockedBetween
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) Expect(ctx context.Context, first string, second string) *mBlockRepositoryMockBlockedBetween {
+	if mmBlockedBetween.mock.funcBlockedBetween != nil {
+		mmBlockedBetween.mock.t.Fatalf("BlockRepositoryMock.BlockedBetween mock is already set by Set")
+	}
+
+	if mmBlockedBetween.defaultExpectation == nil {
+		mmBlockedBetween.defaultExpectation = &BlockRepositoryMockBlockedBetweenExpectation{}
+	}
+
+	if mmBlockedBetween.defaultExpectation.paramPtrs != nil {
+		mmBlockedBetween.mock.t.Fatalf("BlockRepositoryMock.BlockedBetween mock is already set by ExpectParams functions")
+	}
+
+	mmBlockedBetween.defaultExpectation.params = &BlockRepositoryMockBlockedBetweenParams{ctx, first, second}
+	for _, e := range mmBlockedBetween.expectations {
+		if minimock.Equal(e.params, mmBlockedBetween.defaultExpectation.params) {
+			mmBlockedBetween.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBlockedBetween.defaultExpectation.params)
+		}
+	}
+
+	return mmBlockedBetween
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlockRepository.BlockedBetween
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) ExpectCtxParam1(ctx context.Context) *mBlockRepositoryMockBlockedBetween {
+	if mmBlockedBetween.mock.funcBlockedBetween != nil {
+		mmBlockedBetween.mock.t.Fatalf("BlockRepositoryMock.BlockedBetween mock is already set by Set")
+	}
+
+	if mmBlockedBetween.defaultExpectation == nil {
+		mmBlockedBetween.defaultExpectation = &BlockRepositoryMockBlockedBetweenExpectation{}
+	}
+
+	if mmBlockedBetween.defaultExpectation.params != nil {
+		mmBlockedBetween.mock.t.Fatalf("BlockRepositoryMock.BlockedBetween mock is already set by Expect")
+	}
+
+	if mmBlockedBetween.defaultExpectation.paramPtrs == nil {
+		mmBlockedBetween.defaultExpectation.paramPtrs = &BlockRepositoryMockBlockedBetweenParamPtrs{}
+	}
+	mmBlockedBetween.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmBlockedBetween
+}
+
+// ExpectFirstParam2 sets up expected param first for BlockRepository.BlockedBetween
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) ExpectFirstParam2(first string) *mBlockRepositoryMockBlockedBetween {
+	if mmBlockedBetween.mock.funcBlockedBetween != nil {
+		mmBlockedBetween.mock.t.Fatalf("BlockRepositoryMock.BlockedBetween mock is already set by Set")
+	}
+
+	if mmBlockedBetween.defaultExpectation == nil {
+		mmBlockedBetween.defaultExpectation = &BlockRepositoryMockBlockedBetweenExpectation{}
+	}
+
+	if mmBlockedBetween.defaultExpectation.params != nil {
+		mmBlockedBetween.mock.t.Fatalf("BlockRepositoryMock.BlockedBetween mock is already set by Expect")
+	}
+
+	if mmBlockedBetween.defaultExpectation.paramPtrs == nil {
+		mmBlockedBetween.defaultExpectation.paramPtrs = &BlockRepositoryMockBlockedBetweenParamPtrs{}
+	}
+	mmBlockedBetween.defaultExpectation.paramPtrs.first = &first
+
+	return mmBlockedBetween
+}
+
+// ExpectSecondParam3 sets up expected param second for BlockRepository.BlockedBetween
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) ExpectSecondParam3(second string) *mBlockRepositoryMockBlockedBetween {
+	if mmBlockedBetween.mock.funcBlockedBetween != nil {
+		mmBlockedBetween.mock.t.Fatalf("BlockRepositoryMock.BlockedBetween mock is already set by Set")
+	}
+
+	if mmBlockedBetween.defaultExpectation == nil {
+		mmBlockedBetween.defaultExpectation = &BlockRepositoryMockBlockedBetweenExpectation{}
+	}
+
+	if mmBlockedBetween.defaultExpectation.params != nil {
+		mmBlockedBetween.mock.t.Fatalf("BlockRepositoryMock.BlockedBetween mock is already set by Expect")
+	}
+
+	if mmBlockedBetween.defaultExpectation.paramPtrs == nil {
+		mmBlockedBetween.defaultExpectation.paramPtrs = &BlockRepositoryMockBlockedBetweenParamPtrs{}
+	}
+	mmBlockedBetween.defaultExpectation.paramPtrs.second = &second
+
+	return mmBlockedBetween
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlockRepository.BlockedBetween
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) Inspect(f func(ctx context.Context, first string, second string)) *mBlockRepositoryMockBlockedBetween {
+	if mmBlockedBetween.mock.inspectFuncBlockedBetween != nil {
+		mmBlockedBetween.mock.t.Fatalf("Inspect function is already set for BlockRepositoryMock.BlockedBetween")
+	}
+
+	mmBlockedBetween.mock.inspectFuncBlockedBetween = f
+
+	return mmBlockedBetween
+}
+
+// Return sets up results that will be returned by BlockRepository.BlockedBetween
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) Return(blocked bool, err error) *BlockRepositoryMock {
+	if mmBlockedBetween.mock.funcBlockedBetween != nil {
+		mmBlockedBetween.mock.t.Fatalf("BlockRepositoryMock.BlockedBetween mock is already set by Set")
+	}
+
+	if mmBlockedBetween.defaultExpectation == nil {
+		mmBlockedBetween.defaultExpectation = &BlockRepositoryMockBlockedBetweenExpectation{mock: mmBlockedBetween.mock}
+	}
+	mmBlockedBetween.defaultExpectation.results = &BlockRepositoryMockBlockedBetweenResults{blocked, err}
+	return mmBlockedBetween.mock
+}
+
+// Set uses given function f to mock the BlockRepository.BlockedBetween method
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) Set(f func(ctx context.Context, first string, second string) (blocked bool, err error)) *BlockRepositoryMock {
+	if mmBlockedBetween.defaultExpectation != nil {
+		mmBlockedBetween.mock.t.Fatalf("Default expectation is already set for the BlockRepository.BlockedBetween method")
+	}
+
+	if len(mmBlockedBetween.expectations) > 0 {
+		mmBlockedBetween.mock.t.Fatalf("Some expectations are already set for the BlockRepository.BlockedBetween method")
+	}
+
+	mmBlockedBetween.mock.funcBlockedBetween = f
+	return mmBlockedBetween.mock
+}
+
+// When sets expectation for the BlockRepository.BlockedBetween which will trigger the result defined by the following
+// Then helper
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) When(ctx context.Context, first string, second string) *BlockRepositoryMockBlockedBetweenExpectation {
+	if mmBlockedBetween.mock.funcBlockedBetween != nil {
+		mmBlockedBetween.mock.t.Fatalf("BlockRepositoryMock.BlockedBetween mock is already set by Set")
+	}
+
+	expectation := &BlockRepositoryMockBlockedBetweenExpectation{
+		mock:   mmBlockedBetween.mock,
+		params: &BlockRepositoryMockBlockedBetweenParams{ctx, first, second},
+	}
+	mmBlockedBetween.expectations = append(mmBlockedBetween.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlockRepository.BlockedBetween return parameters for the expectation previously defined by the When method
+func (e *BlockRepositoryMockBlockedBetweenExpectation) Then(blocked bool, err error) *BlockRepositoryMock {
+	e.results = &BlockRepositoryMockBlockedBetweenResults{blocked, err}
+	return e.mock
+}
+
+// Times sets number of times BlockRepository.BlockedBetween should be invoked
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) Times(n uint64) *mBlockRepositoryMockBlockedBetween {
+	if n == 0 {
+		mmBlockedBetween.mock.t.Fatalf("Times of BlockRepositoryMock.BlockedBetween mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBlockedBetween.expectedInvocations, n)
+	return mmBlockedBetween
+}
+
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) invocationsDone() bool {
+	if len(mmBlockedBetween.expectations) == 0 && mmBlockedBetween.defaultExpectation == nil && mmBlockedBetween.mock.funcBlockedBetween == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBlockedBetween.mock.afterBlockedBetweenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBlockedBetween.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BlockedBetween implements repository.BlockRepository
+func (mmBlockedBetween *BlockRepositoryMock) BlockedBetween(ctx context.Context, first string, second string) (blocked bool, err error) {
+	mm_atomic.AddUint64(&mmBlockedBetween.beforeBlockedBetweenCounter, 1)
+	defer mm_atomic.AddUint64(&mmBlockedBetween.afterBlockedBetweenCounter, 1)
+
+	if mmBlockedBetween.inspectFuncBlockedBetween != nil {
+		mmBlockedBetween.inspectFuncBlockedBetween(ctx, first, second)
+	}
+
+	mm_params := BlockRepositoryMockBlockedBetweenParams{ctx, first, second}
+
+	// Record call args
+	mmBlockedBetween.BlockedBetweenMock.mutex.Lock()
+	mmBlockedBetween.BlockedBetweenMock.callArgs = append(mmBlockedBetween.BlockedBetweenMock.callArgs, &mm_params)
+	mmBlockedBetween.BlockedBetweenMock.mutex.Unlock()
+
+	for _, e := range mmBlockedBetween.BlockedBetweenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.blocked, e.results.err
+		}
+	}
+
+	if mmBlockedBetween.BlockedBetweenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBlockedBetween.BlockedBetweenMock.defaultExpectation.Counter, 1)
+		mm_want := mmBlockedBetween.BlockedBetweenMock.defaultExpectation.params
+		mm_want_ptrs := mmBlockedBetween.BlockedBetweenMock.defaultExpectation.paramPtrs
+
+		mm_got := BlockRepositoryMockBlockedBetweenParams{ctx, first, second}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBlockedBetween.t.Errorf("BlockRepositoryMock.BlockedBetween got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.first != nil && !minimock.Equal(*mm_want_ptrs.first, mm_got.first) {
+				mmBlockedBetween.t.Errorf("BlockRepositoryMock.BlockedBetween got unexpected parameter first, want: %#v, got: %#v%s\n", *mm_want_ptrs.first, mm_got.first, minimock.Diff(*mm_want_ptrs.first, mm_got.first))
+			}
+
+			if mm_want_ptrs.second != nil && !minimock.Equal(*mm_want_ptrs.second, mm_got.second) {
+				mmBlockedBetween.t.Errorf("BlockRepositoryMock.BlockedBetween got unexpected parameter second, want: %#v, got: %#v%s\n", *mm_want_ptrs.second, mm_got.second, minimock.Diff(*mm_want_ptrs.second, mm_got.second))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBlockedBetween.t.Errorf("BlockRepositoryMock.BlockedBetween got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBlockedBetween.BlockedBetweenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBlockedBetween.t.Fatal("No results are set for the BlockRepositoryMock.BlockedBetween")
+		}
+		return (*mm_results).blocked, (*mm_results).err
+	}
+	if mmBlockedBetween.funcBlockedBetween != nil {
+		return mmBlockedBetween.funcBlockedBetween(ctx, first, second)
+	}
+	mmBlockedBetween.t.Fatalf("Unexpected call to BlockRepositoryMock.BlockedBetween. %v %v %v", ctx, first, second)
+	return
+}
+
+// BlockedBetweenAfterCounter returns a count of finished BlockRepositoryMock.BlockedBetween invocations
+func (mmBlockedBetween *BlockRepositoryMock) BlockedBetweenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlockedBetween.afterBlockedBetweenCounter)
+}
+
+// BlockedBetweenBeforeCounter returns a count of BlockRepositoryMock.BlockedBetween invocations
+func (mmBlockedBetween *BlockRepositoryMock) BlockedBetweenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlockedBetween.beforeBlockedBetweenCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlockRepositoryMock.BlockedBetween.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBlockedBetween *mBlockRepositoryMockBlockedBetween) Calls() []*BlockRepositoryMockBlockedBetweenParams {
+	mmBlockedBetween.mutex.RLock()
+
+	argCopy := make([]*BlockRepositoryMockBlockedBetweenParams, len(mmBlockedBetween.callArgs))
+	copy(argCopy, mmBlockedBetween.callArgs)
+
+	mmBlockedBetween.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBlockedBetweenDone returns true if the count of the BlockedBetween invocations corresponds
+// the number of defined expectations
+func (m *BlockRepositoryMock) MinimockBlockedBetweenDone() bool {
+	if m.BlockedBetweenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BlockedBetweenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BlockedBetweenMock.invocationsDone()
+}
+
+// MinimockBlockedBetweenInspect logs each unmet expectation
+func (m *BlockRepositoryMock) MinimockBlockedBetweenInspect() {
+	for _, e := range m.BlockedBetweenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlockRepositoryMock.BlockedBetween with params: %#v", *e.params)
+		}
+	}
+
+	afterBlockedBetweenCounter := mm_atomic.LoadUint64(&m.afterBlockedBetweenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BlockedBetweenMock.defaultExpectation != nil && afterBlockedBetweenCounter < 1 {
+		if m.BlockedBetweenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BlockRepositoryMock.BlockedBetween")
+		} else {
+			m.t.Errorf("Expected call to BlockRepositoryMock.BlockedBetween with params: %#v", *m.BlockedBetweenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBlockedBetween != nil && afterBlockedBetweenCounter < 1 {
+		m.t.Error("Expected call to BlockRepositoryMock.BlockedBetween")
+	}
+
+	if !m.BlockedBetweenMock.invocationsDone() && afterBlockedBetweenCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlockRepositoryMock.BlockedBetween but found %d calls",
+			mm_atomic.LoadUint64(&m.BlockedBetweenMock.expectedInvocations), afterBlockedBetweenCounter)
+	}
+}
+
+type mBlockRepositoryMockListBlocked struct {
+	optional           bool
+	mock               *BlockRepositoryMock
+	defaultExpectation *BlockRepositoryMockListBlockedExpectation
+	expectations       []*BlockRepositoryMockListBlockedExpectation
+
+	callArgs []*BlockRepositoryMockListBlockedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BlockRepositoryMockListBlockedExpectation specifies expectation struct of the BlockRepository.ListBlocked
+type BlockRepositoryMockListBlockedExpectation struct {
+	mock      *BlockRepositoryMock
+	params    *BlockRepositoryMockListBlockedParams
+	paramPtrs *BlockRepositoryMockListBlockedParamPtrs
+	results   *BlockRepositoryMockListBlockedResults
+	Counter   uint64
+}
+
+// BlockRepositoryMockListBlockedParams contains parameters of the BlockRepository.ListBlocked
+type BlockRepositoryMockListBlockedParams struct {
+	ctx    context.Context
+	params model.ListBlockedParams
+}
+
+// BlockRepositoryMockListBlockedParamPtrs contains pointers to parameters of the BlockRepository.ListBlocked
+type BlockRepositoryMockListBlockedParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListBlockedParams
+}
+
+// BlockRepositoryMockListBlockedResults contains results of the BlockRepository.ListBlocked
+type BlockRepositoryMockListBlockedResults struct {
+	users []model.BlockedUser
+	err   error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListBlocked *mBlockRepositoryMockListBlocked) Optional() *mBlockRepositoryMockListBlocked {
+	mmListBlocked.optional = true
+	return mmListBlocked
+}
+
+// Expect sets up expected params for BlockRepository.ListBlocked
+func (mmListBlocked *mBlockRepositoryMockListBlocked) Expect(ctx context.Context, params model.ListBlockedParams) *mBlockRepositoryMockListBlocked {
+	if mmListBlocked.mock.funcListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("BlockRepositoryMock.ListBlocked mock is already set by Set")
+	}
+
+	if mmListBlocked.defaultExpectation == nil {
+		mmListBlocked.defaultExpectation = &BlockRepositoryMockListBlockedExpectation{}
+	}
+
+	if mmListBlocked.defaultExpectation.paramPtrs != nil {
+		mmListBlocked.mock.t.Fatalf("BlockRepositoryMock.ListBlocked mock is already set by ExpectParams functions")
+	}
+
+	mmListBlocked.defaultExpectation.params = &BlockRepositoryMockListBlockedParams{ctx, params}
+	for _, e := range mmListBlocked.expectations {
+		if minimock.Equal(e.params, mmListBlocked.defaultExpectation.params) {
+			mmListBlocked.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListBlocked.defaultExpectation.params)
+		}
+	}
+
+	return mmListBlocked
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlockRepository.ListBlocked
+func (mmListBlocked *mBlockRepositoryMockListBlocked) ExpectCtxParam1(ctx context.Context) *mBlockRepositoryMockListBlocked {
+	if mmListBlocked.mock.funcListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("BlockRepositoryMock.ListBlocked mock is already set by Set")
+	}
+
+	if mmListBlocked.defaultExpectation == nil {
+		mmListBlocked.defaultExpectation = &BlockRepositoryMockListBlockedExpectation{}
+	}
+
+	if mmListBlocked.defaultExpectation.params != nil {
+		mmListBlocked.mock.t.Fatalf("BlockRepositoryMock.ListBlocked mock is already set by Expect")
+	}
+
+	if mmListBlocked.defaultExpectation.paramPtrs == nil {
+		mmListBlocked.defaultExpectation.paramPtrs = &BlockRepositoryMockListBlockedParamPtrs{}
+	}
+	mmListBlocked.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListBlocked
+}
+
+// ExpectParamsParam2 sets up expected param params for BlockRepository.ListBlocked
+func (mmListBlocked *mBlockRepositoryMockListBlocked) ExpectParamsParam2(params model.ListBlockedParams) *mBlockRepositoryMockListBlocked {
+	if mmListBlocked.mock.funcListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("BlockRepositoryMock.ListBlocked mock is already set by Set")
+	}
+
+	if mmListBlocked.defaultExpectation == nil {
+		mmListBlocked.defaultExpectation = &BlockRepositoryMockListBlockedExpectation{}
+	}
+
+	if mmListBlocked.defaultExpectation.params != nil {
+		mmListBlocked.mock.t.Fatalf("BlockRepositoryMock.ListBlocked mock is already set by Expect")
+	}
+
+	if mmListBlocked.defaultExpectation.paramPtrs == nil {
+		mmListBlocked.defaultExpectation.paramPtrs = &BlockRepositoryMockListBlockedParamPtrs{}
+	}
+	mmListBlocked.defaultExpectation.paramPtrs.params = &params
+
+	return mmListBlocked
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlockRepository.ListBlocked
+func (mmListBlocked *mBlockRepositoryMockListBlocked) Inspect(f func(ctx context.Context, params model.ListBlockedParams)) *mBlockRepositoryMockListBlocked {
+	if mmListBlocked.mock.inspectFuncListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("Inspect function is already set for BlockRepositoryMock.ListBlocked")
+	}
+
+	mmListBlocked.mock.inspectFuncListBlocked = f
+
+	return mmListBlocked
+}
+
+// Return sets up results that will be returned by BlockRepository.ListBlocked
+func (mmListBlocked *mBlockRepositoryMockListBlocked) Return(users []model.BlockedUser, err error) *BlockRepositoryMock {
+	if mmListBlocked.mock.funcListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("BlockRepositoryMock.ListBlocked mock is already set by Set")
+	}
+
+	if mmListBlocked.defaultExpectation == nil {
+		mmListBlocked.defaultExpectation = &BlockRepositoryMockListBlockedExpectation{mock: mmListBlocked.mock}
+	}
+	mmListBlocked.defaultExpectation.results = &BlockRepositoryMockListBlockedResults{users, err}
+	return mmListBlocked.mock
+}
+
+// Set uses given function f to mock the BlockRepository.ListBlocked method
+func (mmListBlocked *mBlockRepositoryMockListBlocked) Set(f func(ctx context.Context, params model.ListBlockedParams) (users []model.BlockedUser, err error)) *BlockRepositoryMock {
+	if mmListBlocked.defaultExpectation != nil {
+		mmListBlocked.mock.t.Fatalf("Default expectation is already set for the BlockRepository.ListBlocked method")
+	}
+
+	if len(mmListBlocked.expectations) > 0 {
+		mmListBlocked.mock.t.Fatalf("Some expectations are already set for the BlockRepository.ListBlocked method")
+	}
+
+	mmListBlocked.mock.funcListBlocked = f
+	return mmListBlocked.mock
+}
+
+// When sets expectation for the BlockRepository.ListBlocked which will trigger the result defined by the following
+// Then helper
+func (mmListBlocked *mBlockRepositoryMockListBlocked) When(ctx context.Context, params model.ListBlockedParams) *BlockRepositoryMockListBlockedExpectation {
+	if mmListBlocked.mock.funcListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("BlockRepositoryMock.ListBlocked mock is already set by Set")
+	}
+
+	expectation := &BlockRepositoryMockListBlockedExpectation{
+		mock:   mmListBlocked.mock,
+		params: &BlockRepositoryMockListBlockedParams{ctx, params},
+	}
+	mmListBlocked.expectations = append(mmListBlocked.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlockRepository.ListBlocked return parameters for the expectation previously defined by the When method
+func (e *BlockRepositoryMockListBlockedExpectation) Then(users []model.BlockedUser, err error) *BlockRepositoryMock {
+	e.results = &BlockRepositoryMockListBlockedResults{users, err}
+	return e.mock
+}
+
+// Times sets number of times BlockRepository.ListBlocked should be invoked
+func (mmListBlocked *mBlockRepositoryMockListBlocked) Times(n uint64) *mBlockRepositoryMockListBlocked {
+	if n == 0 {
+		mmListBlocked.mock.t.Fatalf("Times of BlockRepositoryMock.ListBlocked mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListBlocked.expectedInvocations, n)
+	return mmListBlocked
+}
+
+func (mmListBlocked *mBlockRepositoryMockListBlocked) invocationsDone() bool {
+	if len(mmListBlocked.expectations) == 0 && mmListBlocked.defaultExpectation == nil && mmListBlocked.mock.funcListBlocked == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListBlocked.mock.afterListBlockedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListBlocked.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListBlocked implements repository.BlockRepository
+func (mmListBlocked *BlockRepositoryMock) ListBlocked(ctx context.Context, params model.ListBlockedParams) (users []model.BlockedUser, err error) {
+	mm_atomic.AddUint64(&mmListBlocked.beforeListBlockedCounter, 1)
+	defer mm_atomic.AddUint64(&mmListBlocked.afterListBlockedCounter, 1)
+
+	if mmListBlocked.inspectFuncListBlocked != nil {
+		mmListBlocked.inspectFuncListBlocked(ctx, params)
+	}
+
+	mm_params := BlockRepositoryMockListBlockedParams{ctx, params}
+
+	// Record call args
+	mmListBlocked.ListBlockedMock.mutex.Lock()
+	mmListBlocked.ListBlockedMock.callArgs = append(mmListBlocked.ListBlockedMock.callArgs, &mm_params)
+	mmListBlocked.ListBlockedMock.mutex.Unlock()
+
+	for _, e := range mmListBlocked.ListBlockedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.users, e.results.err
+		}
+	}
+
+	if mmListBlocked.ListBlockedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListBlocked.ListBlockedMock.defaultExpectation.Counter, 1)
+		mm_want := mmListBlocked.ListBlockedMock.defaultExpectation.params
+		mm_want_ptrs := mmListBlocked.ListBlockedMock.defaultExpectation.paramPtrs
+
+		mm_got := BlockRepositoryMockListBlockedParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListBlocked.t.Errorf("BlockRepositoryMock.ListBlocked got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListBlocked.t.Errorf("BlockRepositoryMock.ListBlocked got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListBlocked.t.Errorf("BlockRepositoryMock.ListBlocked got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListBlocked.ListBlockedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListBlocked.t.Fatal("No results are set for the BlockRepositoryMock.ListBlocked")
+		}
+		return (*mm_results).users, (*mm_results).err
+	}
+	if mmListBlocked.funcListBlocked != nil {
+		return mmListBlocked.funcListBlocked(ctx, params)
+	}
+	mmListBlocked.t.Fatalf("Unexpected call to BlockRepositoryMock.ListBlocked. %v %v", ctx, params)
+	return
+}
+
+// ListBlockedAfterCounter returns a count of finished BlockRepositoryMock.ListBlocked invocations
+func (mmListBlocked *BlockRepositoryMock) ListBlockedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBlocked.afterListBlockedCounter)
+}
+
+// ListBlockedBeforeCounter returns a count of BlockRepositoryMock.ListBlocked invocations
+func (mmListBlocked *BlockRepositoryMock) ListBlockedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBlocked.beforeListBlockedCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlockRepositoryMock.ListBlocked.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListBlocked *mBlockRepositoryMockListBlocked) Calls() []*BlockRepositoryMockListBlockedParams {
+	mmListBlocked.mutex.RLock()
+
+	argCopy := make([]*BlockRepositoryMockListBlockedParams, len(mmListBlocked.callArgs))
+	copy(argCopy, mmListBlocked.callArgs)
+
+	mmListBlocked.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListBlockedDone returns true if the count of the ListBlocked invocations corresponds
+// the number of defined expectations
+func (m *BlockRepositoryMock) MinimockListBlockedDone() bool {
+	if m.ListBlockedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListBlockedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListBlockedMock.invocationsDone()
+}
+
+// MinimockListBlockedInspect logs each unmet expectation
+func (m *BlockRepositoryMock) MinimockListBlockedInspect() {
+	for _, e := range m.ListBlockedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlockRepositoryMock.ListBlocked with params: %#v", *e.params)
+		}
+	}
+
+	afterListBlockedCounter := mm_atomic.LoadUint64(&m.afterListBlockedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListBlockedMock.defaultExpectation != nil && afterListBlockedCounter < 1 {
+		if m.ListBlockedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BlockRepositoryMock.ListBlocked")
+		} else {
+			m.t.Errorf("Expected call to BlockRepositoryMock.ListBlocked with params: %#v", *m.ListBlockedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListBlocked != nil && afterListBlockedCounter < 1 {
+		m.t.Error("Expected call to BlockRepositoryMock.ListBlocked")
+	}
+
+	if !m.ListBlockedMock.invocationsDone() && afterListBlockedCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlockRepositoryMock.ListBlocked but found %d calls",
+			mm_atomic.LoadUint64(&m.ListBlockedMock.expectedInvocations), afterListBlockedCounter)
+	}
+}
+
+type mBlockRepositoryMockUnblockUser struct {
+	optional           bool
+	mock               *BlockRepositoryMock
+	defaultExpectation *BlockRepositoryMockUnblockUserExpectation
+	expectations       []*BlockRepositoryMockUnblockUserExpectation
+
+	callArgs []*BlockRepositoryMockUnblockUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BlockRepositoryMockUnblockUserExpectation specifies expectation struct of the BlockRepository.UnblockUser
+type BlockRepositoryMockUnblockUserExpectation struct {
+	mock      *BlockRepositoryMock
+	params    *BlockRepositoryMockUnblockUserParams
+	paramPtrs *BlockRepositoryMockUnblockUserParamPtrs
+	results   *BlockRepositoryMockUnblockUserResults
+	Counter   uint64
+}
+
+// BlockRepositoryMockUnblockUserParams contains parameters of the BlockRepository.UnblockUser
+type BlockRepositoryMockUnblockUserParams struct {
+	ctx    context.Context
+	params model.UnblockUserParams
+}
+
+// BlockRepositoryMockUnblockUserParamPtrs contains pointers to parameters of the BlockRepository.UnblockUser
+type BlockRepositoryMockUnblockUserParamPtrs struct {
+	ctx    *context.Context
+	params *model.UnblockUserParams
+}
+
+// BlockRepositoryMockUnblockUserResults contains results of the BlockRepository.UnblockUser
+type BlockRepositoryMockUnblockUserResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) Optional() *mBlockRepositoryMockUnblockUser {
+	mmUnblockUser.optional = true
+	return mmUnblockUser
+}
+
+// Expect sets up expected params for BlockRepository.UnblockUser
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) Expect(ctx context.Context, params model.UnblockUserParams) *mBlockRepositoryMockUnblockUser {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockRepositoryMock.UnblockUser mock is already set by Set")
+	}
+
+	if mmUnblockUser.defaultExpectation == nil {
+		mmUnblockUser.defaultExpectation = &BlockRepositoryMockUnblockUserExpectation{}
+	}
+
+	if mmUnblockUser.defaultExpectation.paramPtrs != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockRepositoryMock.UnblockUser mock is already set by ExpectParams functions")
+	}
+
+	mmUnblockUser.defaultExpectation.params = &BlockRepositoryMockUnblockUserParams{ctx, params}
+	for _, e := range mmUnblockUser.expectations {
+		if minimock.Equal(e.params, mmUnblockUser.defaultExpectation.params) {
+			mmUnblockUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnblockUser.defaultExpectation.params)
+		}
+	}
+
+	return mmUnblockUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlockRepository.UnblockUser
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) ExpectCtxParam1(ctx context.Context) *mBlockRepositoryMockUnblockUser {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockRepositoryMock.UnblockUser mock is already set by Set")
+	}
+
+	if mmUnblockUser.defaultExpectation == nil {
+		mmUnblockUser.defaultExpectation = &BlockRepositoryMockUnblockUserExpectation{}
+	}
+
+	if mmUnblockUser.defaultExpectation.params != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockRepositoryMock.UnblockUser mock is already set by Expect")
+	}
+
+	if mmUnblockUser.defaultExpectation.paramPtrs == nil {
+		mmUnblockUser.defaultExpectation.paramPtrs = &BlockRepositoryMockUnblockUserParamPtrs{}
+	}
+	mmUnblockUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUnblockUser
+}
+
+// ExpectParamsParam2 sets up expected param params for BlockRepository.UnblockUser
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) ExpectParamsParam2(params model.UnblockUserParams) *mBlockRepositoryMockUnblockUser {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockRepositoryMock.UnblockUser mock is already set by Set")
+	}
+
+	if mmUnblockUser.defaultExpectation == nil {
+		mmUnblockUser.defaultExpectation = &BlockRepositoryMockUnblockUserExpectation{}
+	}
+
+	if mmUnblockUser.defaultExpectation.params != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockRepositoryMock.UnblockUser mock is already set by Expect")
+	}
+
+	if mmUnblockUser.defaultExpectation.paramPtrs == nil {
+		mmUnblockUser.defaultExpectation.paramPtrs = &BlockRepositoryMockUnblockUserParamPtrs{}
+	}
+	mmUnblockUser.defaultExpectation.paramPtrs.params = &params
+
+	return mmUnblockUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlockRepository.UnblockUser
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) Inspect(f func(ctx context.Context, params model.UnblockUserParams)) *mBlockRepositoryMockUnblockUser {
+	if mmUnblockUser.mock.inspectFuncUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("Inspect function is already set for BlockRepositoryMock.UnblockUser")
+	}
+
+	mmUnblockUser.mock.inspectFuncUnblockUser = f
+
+	return mmUnblockUser
+}
+
+// Return sets up results that will be returned by BlockRepository.UnblockUser
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) Return(err error) *BlockRepositoryMock {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockRepositoryMock.UnblockUser mock is already set by Set")
+	}
+
+	if mmUnblockUser.defaultExpectation == nil {
+		mmUnblockUser.defaultExpectation = &BlockRepositoryMockUnblockUserExpectation{mock: mmUnblockUser.mock}
+	}
+	mmUnblockUser.defaultExpectation.results = &BlockRepositoryMockUnblockUserResults{err}
+	return mmUnblockUser.mock
+}
+
+// Set uses given function f to mock the BlockRepository.UnblockUser method
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) Set(f func(ctx context.Context, params model.UnblockUserParams) (err error)) *BlockRepositoryMock {
+	if mmUnblockUser.defaultExpectation != nil {
+		mmUnblockUser.mock.t.Fatalf("Default expectation is already set for the BlockRepository.UnblockUser method")
+	}
+
+	if len(mmUnblockUser.expectations) > 0 {
+		mmUnblockUser.mock.t.Fatalf("Some expectations are already set for the BlockRepository.UnblockUser method")
+	}
+
+	mmUnblockUser.mock.funcUnblockUser = f
+	return mmUnblockUser.mock
+}
+
+// When sets expectation for the BlockRepository.UnblockUser which will trigger the result defined by the following
+// Then helper
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) When(ctx context.Context, params model.UnblockUserParams) *BlockRepositoryMockUnblockUserExpectation {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockRepositoryMock.UnblockUser mock is already set by Set")
+	}
+
+	expectation := &BlockRepositoryMockUnblockUserExpectation{
+		mock:   mmUnblockUser.mock,
+		params: &BlockRepositoryMockUnblockUserParams{ctx, params},
+	}
+	mmUnblockUser.expectations = append(mmUnblockUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlockRepository.UnblockUser return parameters for the expectation previously defined by the When method
+func (e *BlockRepositoryMockUnblockUserExpectation) Then(err error) *BlockRepositoryMock {
+	e.results = &BlockRepositoryMockUnblockUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times BlockRepository.UnblockUser should be invoked
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) Times(n uint64) *mBlockRepositoryMockUnblockUser {
+	if n == 0 {
+		mmUnblockUser.mock.t.Fatalf("Times of BlockRepositoryMock.UnblockUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUnblockUser.expectedInvocations, n)
+	return mmUnblockUser
+}
+
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) invocationsDone() bool {
+	if len(mmUnblockUser.expectations) == 0 && mmUnblockUser.defaultExpectation == nil && mmUnblockUser.mock.funcUnblockUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUnblockUser.mock.afterUnblockUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnblockUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UnblockUser implements repository.BlockRepository
+func (mmUnblockUser *BlockRepositoryMock) UnblockUser(ctx context.Context, params model.UnblockUserParams) (err error) {
+	mm_atomic.AddUint64(&mmUnblockUser.beforeUnblockUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnblockUser.afterUnblockUserCounter, 1)
+
+	if mmUnblockUser.inspectFuncUnblockUser != nil {
+		mmUnblockUser.inspectFuncUnblockUser(ctx, params)
+	}
+
+	mm_params := BlockRepositoryMockUnblockUserParams{ctx, params}
+
+	// Record call args
+	mmUnblockUser.UnblockUserMock.mutex.Lock()
+	mmUnblockUser.UnblockUserMock.callArgs = append(mmUnblockUser.UnblockUserMock.callArgs, &mm_params)
+	mmUnblockUser.UnblockUserMock.mutex.Unlock()
+
+	for _, e := range mmUnblockUser.UnblockUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUnblockUser.UnblockUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnblockUser.UnblockUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnblockUser.UnblockUserMock.defaultExpectation.params
+		mm_want_ptrs := mmUnblockUser.UnblockUserMock.defaultExpectation.paramPtrs
+
+		mm_got := BlockRepositoryMockUnblockUserParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUnblockUser.t.Errorf("BlockRepositoryMock.UnblockUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUnblockUser.t.Errorf("BlockRepositoryMock.UnblockUser got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnblockUser.t.Errorf("BlockRepositoryMock.UnblockUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnblockUser.UnblockUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnblockUser.t.Fatal("No results are set for the BlockRepositoryMock.UnblockUser")
+		}
+		return (*mm_results).err
+	}
+	if mmUnblockUser.funcUnblockUser != nil {
+		return mmUnblockUser.funcUnblockUser(ctx, params)
+	}
+	mmUnblockUser.t.Fatalf("Unexpected call to BlockRepositoryMock.UnblockUser. %v %v", ctx, params)
+	return
+}
+
+// UnblockUserAfterCounter returns a count of finished BlockRepositoryMock.UnblockUser invocations
+func (mmUnblockUser *BlockRepositoryMock) UnblockUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnblockUser.afterUnblockUserCounter)
+}
+
+// UnblockUserBeforeCounter returns a count of BlockRepositoryMock.UnblockUser invocations
+func (mmUnblockUser *BlockRepositoryMock) UnblockUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnblockUser.beforeUnblockUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlockRepositoryMock.UnblockUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnblockUser *mBlockRepositoryMockUnblockUser) Calls() []*BlockRepositoryMockUnblockUserParams {
+	mmUnblockUser.mutex.RLock()
+
+	argCopy := make([]*BlockRepositoryMockUnblockUserParams, len(mmUnblockUser.callArgs))
+	copy(argCopy, mmUnblockUser.callArgs)
+
+	mmUnblockUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnblockUserDone returns true if the count of the UnblockUser invocations corresponds
+// the number of defined expectations
+func (m *BlockRepositoryMock) MinimockUnblockUserDone() bool {
+	if m.UnblockUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UnblockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UnblockUserMock.invocationsDone()
+}
+
+// MinimockUnblockUserInspect logs each unmet expectation
+func (m *BlockRepositoryMock) MinimockUnblockUserInspect() {
+	for _, e := range m.UnblockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlockRepositoryMock.UnblockUser with params: %#v", *e.params)
+		}
+	}
+
+	afterUnblockUserCounter := mm_atomic.LoadUint64(&m.afterUnblockUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnblockUserMock.defaultExpectation != nil && afterUnblockUserCounter < 1 {
+		if m.UnblockUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BlockRepositoryMock.UnblockUser")
+		} else {
+			m.t.Errorf("Expected call to BlockRepositoryMock.UnblockUser with params: %#v", *m.UnblockUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnblockUser != nil && afterUnblockUserCounter < 1 {
+		m.t.Error("Expected call to BlockRepositoryMock.UnblockUser")
+	}
+
+	if !m.UnblockUserMock.invocationsDone() && afterUnblockUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlockRepositoryMock.UnblockUser but found %d calls",
+			mm_atomic.LoadUint64(&m.UnblockUserMock.expectedInvocations), afterUnblockUserCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BlockRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockBlockUserInspect()
+
+			m.MinimockBlockedBetweenInspect()
+
+			m.MinimockListBlockedInspect()
+
+			m.MinimockUnblockUserInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BlockRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *BlockRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockBlockUserDone() &&
+		m.MinimockBlockedBetweenDone() &&
+		m.MinimockListBlockedDone() &&
+		m.MinimockUnblockUserDone()
+}
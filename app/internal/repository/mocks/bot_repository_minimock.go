@@ -0,0 +1,2003 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.BotRepository -o bot_repository_minimock.go -n BotRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// BotRepositoryMock implements repository.BotRepository
+type BotRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateBot          func(ctx context.Context, params model.CreateBotParams) (botID int64, err error)
+	inspectFuncCreateBot   func(ctx context.Context, params model.CreateBotParams)
+	afterCreateBotCounter  uint64
+	beforeCreateBotCounter uint64
+	CreateBotMock          mBotRepositoryMockCreateBot
+
+	funcDeleteBot          func(ctx context.Context, params model.DeleteBotParams) (err error)
+	inspectFuncDeleteBot   func(ctx context.Context, params model.DeleteBotParams)
+	afterDeleteBotCounter  uint64
+	beforeDeleteBotCounter uint64
+	DeleteBotMock          mBotRepositoryMockDeleteBot
+
+	funcGetBotByCommand          func(ctx context.Context, command string) (bot model.Bot, err error)
+	inspectFuncGetBotByCommand   func(ctx context.Context, command string)
+	afterGetBotByCommandCounter  uint64
+	beforeGetBotByCommandCounter uint64
+	GetBotByCommandMock          mBotRepositoryMockGetBotByCommand
+
+	funcGetBotByTokenHash          func(ctx context.Context, tokenHash string) (bot model.Bot, err error)
+	inspectFuncGetBotByTokenHash   func(ctx context.Context, tokenHash string)
+	afterGetBotByTokenHashCounter  uint64
+	beforeGetBotByTokenHashCounter uint64
+	GetBotByTokenHashMock          mBotRepositoryMockGetBotByTokenHash
+
+	funcListBotCommands          func(ctx context.Context) (commands []model.BotCommand, err error)
+	inspectFuncListBotCommands   func(ctx context.Context)
+	afterListBotCommandsCounter  uint64
+	beforeListBotCommandsCounter uint64
+	ListBotCommandsMock          mBotRepositoryMockListBotCommands
+
+	funcListBots          func(ctx context.Context) (bots []model.Bot, err error)
+	inspectFuncListBots   func(ctx context.Context)
+	afterListBotsCounter  uint64
+	beforeListBotsCounter uint64
+	ListBotsMock          mBotRepositoryMockListBots
+}
+
+// NewBotRepositoryMock returns a mock for repository.BotRepository
+func NewBotRepositoryMock(t minimock.Tester) *BotRepositoryMock {
+	m := &BotRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateBotMock = mBotRepositoryMockCreateBot{mock: m}
+	m.CreateBotMock.callArgs = []*BotRepositoryMockCreateBotParams{}
+
+	m.DeleteBotMock = mBotRepositoryMockDeleteBot{mock: m}
+	m.DeleteBotMock.callArgs = []*BotRepositoryMockDeleteBotParams{}
+
+	m.GetBotByCommandMock = mBotRepositoryMockGetBotByCommand{mock: m}
+	m.GetBotByCommandMock.callArgs = []*BotRepositoryMockGetBotByCommandParams{}
+
+	m.GetBotByTokenHashMock = mBotRepositoryMockGetBotByTokenHash{mock: m}
+	m.GetBotByTokenHashMock.callArgs = []*BotRepositoryMockGetBotByTokenHashParams{}
+
+	m.ListBotCommandsMock = mBotRepositoryMockListBotCommands{mock: m}
+	m.ListBotCommandsMock.callArgs = []*BotRepositoryMockListBotCommandsParams{}
+
+	m.ListBotsMock = mBotRepositoryMockListBots{mock: m}
+	m.ListBotsMock.callArgs = []*BotRepositoryMockListBotsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mBotRepositoryMockCreateBot struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockCreateBotExpectation
+	expectations       []*BotRepositoryMockCreateBotExpectation
+
+	callArgs []*BotRepositoryMockCreateBotParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BotRepositoryMockCreateBotExpectation specifies expectation struct of the BotRepository.CreateBot
+type BotRepositoryMockCreateBotExpectation struct {
+	mock      *BotRepositoryMock
+	params    *BotRepositoryMockCreateBotParams
+	paramPtrs *BotRepositoryMockCreateBotParamPtrs
+	results   *BotRepositoryMockCreateBotResults
+	Counter   uint64
+}
+
+// BotRepositoryMockCreateBotParams contains parameters of the BotRepository.CreateBot
+type BotRepositoryMockCreateBotParams struct {
+	ctx    context.Context
+	params model.CreateBotParams
+}
+
+// BotRepositoryMockCreateBotParamPtrs contains pointers to parameters of the BotRepository.CreateBot
+type BotRepositoryMockCreateBotParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateBotParams
+}
+
+// BotRepositoryMockCreateBotResults contains results of the BotRepository.CreateBot
+type BotRepositoryMockCreateBotResults struct {
+	botID int64
+	err   error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateBot *mBotRepositoryMockCreateBot) Optional() *mBotRepositoryMockCreateBot {
+	mmCreateBot.optional = true
+	return mmCreateBot
+}
+
+// Expect sets up expected params for BotRepository.CreateBot
+func (mmCreateBot *mBotRepositoryMockCreateBot) Expect(ctx context.Context, params model.CreateBotParams) *mBotRepositoryMockCreateBot {
+	if mmCreateBot.mock.funcCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("BotRepositoryMock.CreateBot mock is already set by Set")
+	}
+
+	if mmCreateBot.defaultExpectation == nil {
+		mmCreateBot.defaultExpectation = &BotRepositoryMockCreateBotExpectation{}
+	}
+
+	if mmCreateBot.defaultExpectation.paramPtrs != nil {
+		mmCreateBot.mock.t.Fatalf("BotRepositoryMock.CreateBot mock is already set by ExpectParams functions")
+	}
+
+	mmCreateBot.defaultExpectation.params = &BotRepositoryMockCreateBotParams{ctx, params}
+	for _, e := range mmCreateBot.expectations {
+		if minimock.Equal(e.params, mmCreateBot.defaultExpectation.params) {
+			mmCreateBot.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateBot.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateBot
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.CreateBot
+func (mmCreateBot *mBotRepositoryMockCreateBot) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockCreateBot {
+	if mmCreateBot.mock.funcCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("BotRepositoryMock.CreateBot mock is already set by Set")
+	}
+
+	if mmCreateBot.defaultExpectation == nil {
+		mmCreateBot.defaultExpectation = &BotRepositoryMockCreateBotExpectation{}
+	}
+
+	if mmCreateBot.defaultExpectation.params != nil {
+		mmCreateBot.mock.t.Fatalf("BotRepositoryMock.CreateBot mock is already set by Expect")
+	}
+
+	if mmCreateBot.defaultExpectation.paramPtrs == nil {
+		mmCreateBot.defaultExpectation.paramPtrs = &BotRepositoryMockCreateBotParamPtrs{}
+	}
+	mmCreateBot.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateBot
+}
+
+// ExpectParamsParam2 sets up expected param params for BotRepository.CreateBot
+func (mmCreateBot *mBotRepositoryMockCreateBot) ExpectParamsParam2(params model.CreateBotParams) *mBotRepositoryMockCreateBot {
+	if mmCreateBot.mock.funcCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("BotRepositoryMock.CreateBot mock is already set by Set")
+	}
+
+	if mmCreateBot.defaultExpectation == nil {
+		mmCreateBot.defaultExpectation = &BotRepositoryMockCreateBotExpectation{}
+	}
+
+	if mmCreateBot.defaultExpectation.params != nil {
+		mmCreateBot.mock.t.Fatalf("BotRepositoryMock.CreateBot mock is already set by Expect")
+	}
+
+	if mmCreateBot.defaultExpectation.paramPtrs == nil {
+		mmCreateBot.defaultExpectation.paramPtrs = &BotRepositoryMockCreateBotParamPtrs{}
+	}
+	mmCreateBot.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateBot
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.CreateBot
+func (mmCreateBot *mBotRepositoryMockCreateBot) Inspect(f func(ctx context.Context, params model.CreateBotParams)) *mBotRepositoryMockCreateBot {
+	if mmCreateBot.mock.inspectFuncCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.CreateBot")
+	}
+
+	mmCreateBot.mock.inspectFuncCreateBot = f
+
+	return mmCreateBot
+}
+
+// Return sets up results that will be returned by BotRepository.CreateBot
+func (mmCreateBot *mBotRepositoryMockCreateBot) Return(botID int64, err error) *BotRepositoryMock {
+	if mmCreateBot.mock.funcCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("BotRepositoryMock.CreateBot mock is already set by Set")
+	}
+
+	if mmCreateBot.defaultExpectation == nil {
+		mmCreateBot.defaultExpectation = &BotRepositoryMockCreateBotExpectation{mock: mmCreateBot.mock}
+	}
+	mmCreateBot.defaultExpectation.results = &BotRepositoryMockCreateBotResults{botID, err}
+	return mmCreateBot.mock
+}
+
+// Set uses given function f to mock the BotRepository.CreateBot method
+func (mmCreateBot *mBotRepositoryMockCreateBot) Set(f func(ctx context.Context, params model.CreateBotParams) (botID int64, err error)) *BotRepositoryMock {
+	if mmCreateBot.defaultExpectation != nil {
+		mmCreateBot.mock.t.Fatalf("Default expectation is already set for the BotRepository.CreateBot method")
+	}
+
+	if len(mmCreateBot.expectations) > 0 {
+		mmCreateBot.mock.t.Fatalf("Some expectations are already set for the BotRepository.CreateBot method")
+	}
+
+	mmCreateBot.mock.funcCreateBot = f
+	return mmCreateBot.mock
+}
+
+// When sets expectation for the BotRepository.CreateBot which will trigger the result defined by the following
+// Then helper
+func (mmCreateBot *mBotRepositoryMockCreateBot) When(ctx context.Context, params model.CreateBotParams) *BotRepositoryMockCreateBotExpectation {
+	if mmCreateBot.mock.funcCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("BotRepositoryMock.CreateBot mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockCreateBotExpectation{
+		mock:   mmCreateBot.mock,
+		params: &BotRepositoryMockCreateBotParams{ctx, params},
+	}
+	mmCreateBot.expectations = append(mmCreateBot.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.CreateBot return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockCreateBotExpectation) Then(botID int64, err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockCreateBotResults{botID, err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.CreateBot should be invoked
+func (mmCreateBot *mBotRepositoryMockCreateBot) Times(n uint64) *mBotRepositoryMockCreateBot {
+	if n == 0 {
+		mmCreateBot.mock.t.Fatalf("Times of BotRepositoryMock.CreateBot mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateBot.expectedInvocations, n)
+	return mmCreateBot
+}
+
+func (mmCreateBot *mBotRepositoryMockCreateBot) invocationsDone() bool {
+	if len(mmCreateBot.expectations) == 0 && mmCreateBot.defaultExpectation == nil && mmCreateBot.mock.funcCreateBot == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateBot.mock.afterCreateBotCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateBot.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateBot implements repository.BotRepository
+func (mmCreateBot *BotRepositoryMock) CreateBot(ctx context.Context, params model.CreateBotParams) (botID int64, err error) {
+	mm_atomic.AddUint64(&mmCreateBot.beforeCreateBotCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateBot.afterCreateBotCounter, 1)
+
+	if mmCreateBot.inspectFuncCreateBot != nil {
+		mmCreateBot.inspectFuncCreateBot(ctx, params)
+	}
+
+	mm_params := BotRepositoryMockCreateBotParams{ctx, params}
+
+	// Record call args
+	mmCreateBot.CreateBotMock.mutex.Lock()
+	mmCreateBot.CreateBotMock.callArgs = append(mmCreateBot.CreateBotMock.callArgs, &mm_params)
+	mmCreateBot.CreateBotMock.mutex.Unlock()
+
+	for _, e := range mmCreateBot.CreateBotMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.botID, e.results.err
+		}
+	}
+
+	if mmCreateBot.CreateBotMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateBot.CreateBotMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateBot.CreateBotMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateBot.CreateBotMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockCreateBotParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateBot.t.Errorf("BotRepositoryMock.CreateBot got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateBot.t.Errorf("BotRepositoryMock.CreateBot got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateBot.t.Errorf("BotRepositoryMock.CreateBot got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateBot.CreateBotMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateBot.t.Fatal("No results are set for the BotRepositoryMock.CreateBot")
+		}
+		return (*mm_results).botID, (*mm_results).err
+	}
+	if mmCreateBot.funcCreateBot != nil {
+		return mmCreateBot.funcCreateBot(ctx, params)
+	}
+	mmCreateBot.t.Fatalf("Unexpected call to BotRepositoryMock.CreateBot. %v %v", ctx, params)
+	return
+}
+
+// CreateBotAfterCounter returns a count of finished BotRepositoryMock.CreateBot invocations
+func (mmCreateBot *BotRepositoryMock) CreateBotAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateBot.afterCreateBotCounter)
+}
+
+// CreateBotBeforeCounter returns a count of BotRepositoryMock.CreateBot invocations
+func (mmCreateBot *BotRepositoryMock) CreateBotBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateBot.beforeCreateBotCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.CreateBot.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateBot *mBotRepositoryMockCreateBot) Calls() []*BotRepositoryMockCreateBotParams {
+	mmCreateBot.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockCreateBotParams, len(mmCreateBot.callArgs))
+	copy(argCopy, mmCreateBot.callArgs)
+
+	mmCreateBot.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateBotDone returns true if the count of the CreateBot invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockCreateBotDone() bool {
+	if m.CreateBotMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateBotMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateBotMock.invocationsDone()
+}
+
+// MinimockCreateBotInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockCreateBotInspect() {
+	for _, e := range m.CreateBotMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.CreateBot with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateBotCounter := mm_atomic.LoadUint64(&m.afterCreateBotCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateBotMock.defaultExpectation != nil && afterCreateBotCounter < 1 {
+		if m.CreateBotMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BotRepositoryMock.CreateBot")
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.CreateBot with params: %#v", *m.CreateBotMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateBot != nil && afterCreateBotCounter < 1 {
+		m.t.Error("Expected call to BotRepositoryMock.CreateBot")
+	}
+
+	if !m.CreateBotMock.invocationsDone() && afterCreateBotCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.CreateBot but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateBotMock.expectedInvocations), afterCreateBotCounter)
+	}
+}
+
+type mBotRepositoryMockDeleteBot struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockDeleteBotExpectation
+	expectations       []*BotRepositoryMockDeleteBotExpectation
+
+	callArgs []*BotRepositoryMockDeleteBotParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BotRepositoryMockDeleteBotExpectation specifies expectation struct of the BotRepository.DeleteBot
+type BotRepositoryMockDeleteBotExpectation struct {
+	mock      *BotRepositoryMock
+	params    *BotRepositoryMockDeleteBotParams
+	paramPtrs *BotRepositoryMockDeleteBotParamPtrs
+	results   *BotRepositoryMockDeleteBotResults
+	Counter   uint64
+}
+
+// BotRepositoryMockDeleteBotParams contains parameters of the BotRepository.DeleteBot
+type BotRepositoryMockDeleteBotParams struct {
+	ctx    context.Context
+	params model.DeleteBotParams
+}
+
+// BotRepositoryMockDeleteBotParamPtrs contains pointers to parameters of the BotRepository.DeleteBot
+type BotRepositoryMockDeleteBotParamPtrs struct {
+	ctx    *context.Context
+	params *model.DeleteBotParams
+}
+
+// BotRepositoryMockDeleteBotResults contains results of the BotRepository.DeleteBot
+type BotRepositoryMockDeleteBotResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) Optional() *mBotRepositoryMockDeleteBot {
+	mmDeleteBot.optional = true
+	return mmDeleteBot
+}
+
+// Expect sets up expected params for BotRepository.DeleteBot
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) Expect(ctx context.Context, params model.DeleteBotParams) *mBotRepositoryMockDeleteBot {
+	if mmDeleteBot.mock.funcDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("BotRepositoryMock.DeleteBot mock is already set by Set")
+	}
+
+	if mmDeleteBot.defaultExpectation == nil {
+		mmDeleteBot.defaultExpectation = &BotRepositoryMockDeleteBotExpectation{}
+	}
+
+	if mmDeleteBot.defaultExpectation.paramPtrs != nil {
+		mmDeleteBot.mock.t.Fatalf("BotRepositoryMock.DeleteBot mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteBot.defaultExpectation.params = &BotRepositoryMockDeleteBotParams{ctx, params}
+	for _, e := range mmDeleteBot.expectations {
+		if minimock.Equal(e.params, mmDeleteBot.defaultExpectation.params) {
+			mmDeleteBot.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteBot.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteBot
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.DeleteBot
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockDeleteBot {
+	if mmDeleteBot.mock.funcDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("BotRepositoryMock.DeleteBot mock is already set by Set")
+	}
+
+	if mmDeleteBot.defaultExpectation == nil {
+		mmDeleteBot.defaultExpectation = &BotRepositoryMockDeleteBotExpectation{}
+	}
+
+	if mmDeleteBot.defaultExpectation.params != nil {
+		mmDeleteBot.mock.t.Fatalf("BotRepositoryMock.DeleteBot mock is already set by Expect")
+	}
+
+	if mmDeleteBot.defaultExpectation.paramPtrs == nil {
+		mmDeleteBot.defaultExpectation.paramPtrs = &BotRepositoryMockDeleteBotParamPtrs{}
+	}
+	mmDeleteBot.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteBot
+}
+
+// ExpectParamsParam2 sets up expected param params for BotRepository.DeleteBot
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) ExpectParamsParam2(params model.DeleteBotParams) *mBotRepositoryMockDeleteBot {
+	if mmDeleteBot.mock.funcDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("BotRepositoryMock.DeleteBot mock is already set by Set")
+	}
+
+	if mmDeleteBot.defaultExpectation == nil {
+		mmDeleteBot.defaultExpectation = &BotRepositoryMockDeleteBotExpectation{}
+	}
+
+	if mmDeleteBot.defaultExpectation.params != nil {
+		mmDeleteBot.mock.t.Fatalf("BotRepositoryMock.DeleteBot mock is already set by Expect")
+	}
+
+	if mmDeleteBot.defaultExpectation.paramPtrs == nil {
+		mmDeleteBot.defaultExpectation.paramPtrs = &BotRepositoryMockDeleteBotParamPtrs{}
+	}
+	mmDeleteBot.defaultExpectation.paramPtrs.params = &params
+
+	return mmDeleteBot
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.DeleteBot
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) Inspect(f func(ctx context.Context, params model.DeleteBotParams)) *mBotRepositoryMockDeleteBot {
+	if mmDeleteBot.mock.inspectFuncDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.DeleteBot")
+	}
+
+	mmDeleteBot.mock.inspectFuncDeleteBot = f
+
+	return mmDeleteBot
+}
+
+// Return sets up results that will be returned by BotRepository.DeleteBot
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) Return(err error) *BotRepositoryMock {
+	if mmDeleteBot.mock.funcDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("BotRepositoryMock.DeleteBot mock is already set by Set")
+	}
+
+	if mmDeleteBot.defaultExpectation == nil {
+		mmDeleteBot.defaultExpectation = &BotRepositoryMockDeleteBotExpectation{mock: mmDeleteBot.mock}
+	}
+	mmDeleteBot.defaultExpectation.results = &BotRepositoryMockDeleteBotResults{err}
+	return mmDeleteBot.mock
+}
+
+// Set uses given function f to mock the BotRepository.DeleteBot method
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) Set(f func(ctx context.Context, params model.DeleteBotParams) (err error)) *BotRepositoryMock {
+	if mmDeleteBot.defaultExpectation != nil {
+		mmDeleteBot.mock.t.Fatalf("Default expectation is already set for the BotRepository.DeleteBot method")
+	}
+
+	if len(mmDeleteBot.expectations) > 0 {
+		mmDeleteBot.mock.t.Fatalf("Some expectations are already set for the BotRepository.DeleteBot method")
+	}
+
+	mmDeleteBot.mock.funcDeleteBot = f
+	return mmDeleteBot.mock
+}
+
+// When sets expectation for the BotRepository.DeleteBot which will trigger the result defined by the following
+// Then helper
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) When(ctx context.Context, params model.DeleteBotParams) *BotRepositoryMockDeleteBotExpectation {
+	if mmDeleteBot.mock.funcDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("BotRepositoryMock.DeleteBot mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockDeleteBotExpectation{
+		mock:   mmDeleteBot.mock,
+		params: &BotRepositoryMockDeleteBotParams{ctx, params},
+	}
+	mmDeleteBot.expectations = append(mmDeleteBot.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.DeleteBot return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockDeleteBotExpectation) Then(err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockDeleteBotResults{err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.DeleteBot should be invoked
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) Times(n uint64) *mBotRepositoryMockDeleteBot {
+	if n == 0 {
+		mmDeleteBot.mock.t.Fatalf("Times of BotRepositoryMock.DeleteBot mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteBot.expectedInvocations, n)
+	return mmDeleteBot
+}
+
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) invocationsDone() bool {
+	if len(mmDeleteBot.expectations) == 0 && mmDeleteBot.defaultExpectation == nil && mmDeleteBot.mock.funcDeleteBot == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteBot.mock.afterDeleteBotCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteBot.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteBot implements repository.BotRepository
+func (mmDeleteBot *BotRepositoryMock) DeleteBot(ctx context.Context, params model.DeleteBotParams) (err error) {
+	mm_atomic.AddUint64(&mmDeleteBot.beforeDeleteBotCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteBot.afterDeleteBotCounter, 1)
+
+	if mmDeleteBot.inspectFuncDeleteBot != nil {
+		mmDeleteBot.inspectFuncDeleteBot(ctx, params)
+	}
+
+	mm_params := BotRepositoryMockDeleteBotParams{ctx, params}
+
+	// Record call args
+	mmDeleteBot.DeleteBotMock.mutex.Lock()
+	mmDeleteBot.DeleteBotMock.callArgs = append(mmDeleteBot.DeleteBotMock.callArgs, &mm_params)
+	mmDeleteBot.DeleteBotMock.mutex.Unlock()
+
+	for _, e := range mmDeleteBot.DeleteBotMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteBot.DeleteBotMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteBot.DeleteBotMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteBot.DeleteBotMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteBot.DeleteBotMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockDeleteBotParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteBot.t.Errorf("BotRepositoryMock.DeleteBot got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmDeleteBot.t.Errorf("BotRepositoryMock.DeleteBot got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteBot.t.Errorf("BotRepositoryMock.DeleteBot got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteBot.DeleteBotMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteBot.t.Fatal("No results are set for the BotRepositoryMock.DeleteBot")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteBot.funcDeleteBot != nil {
+		return mmDeleteBot.funcDeleteBot(ctx, params)
+	}
+	mmDeleteBot.t.Fatalf("Unexpected call to BotRepositoryMock.DeleteBot. %v %v", ctx, params)
+	return
+}
+
+// DeleteBotAfterCounter returns a count of finished BotRepositoryMock.DeleteBot invocations
+func (mmDeleteBot *BotRepositoryMock) DeleteBotAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteBot.afterDeleteBotCounter)
+}
+
+// DeleteBotBeforeCounter returns a count of BotRepositoryMock.DeleteBot invocations
+func (mmDeleteBot *BotRepositoryMock) DeleteBotBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteBot.beforeDeleteBotCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.DeleteBot.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteBot *mBotRepositoryMockDeleteBot) Calls() []*BotRepositoryMockDeleteBotParams {
+	mmDeleteBot.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockDeleteBotParams, len(mmDeleteBot.callArgs))
+	copy(argCopy, mmDeleteBot.callArgs)
+
+	mmDeleteBot.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteBotDone returns true if the count of the DeleteBot invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockDeleteBotDone() bool {
+	if m.DeleteBotMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteBotMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteBotMock.invocationsDone()
+}
+
+// MinimockDeleteBotInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockDeleteBotInspect() {
+	for _, e := range m.DeleteBotMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.DeleteBot with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteBotCounter := mm_atomic.LoadUint64(&m.afterDeleteBotCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteBotMock.defaultExpectation != nil && afterDeleteBotCounter < 1 {
+		if m.DeleteBotMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BotRepositoryMock.DeleteBot")
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.DeleteBot with params: %#v", *m.DeleteBotMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteBot != nil && afterDeleteBotCounter < 1 {
+		m.t.Error("Expected call to BotRepositoryMock.DeleteBot")
+	}
+
+	if !m.DeleteBotMock.invocationsDone() && afterDeleteBotCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.DeleteBot but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteBotMock.expectedInvocations), afterDeleteBotCounter)
+	}
+}
+
+type mBotRepositoryMockGetBotByCommand struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockGetBotByCommandExpectation
+	expectations       []*BotRepositoryMockGetBotByCommandExpectation
+
+	callArgs []*BotRepositoryMockGetBotByCommandParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BotRepositoryMockGetBotByCommandExpectation specifies expectation struct of the BotRepository.GetBotByCommand
+type BotRepositoryMockGetBotByCommandExpectation struct {
+	mock      *BotRepositoryMock
+	params    *BotRepositoryMockGetBotByCommandParams
+	paramPtrs *BotRepositoryMockGetBotByCommandParamPtrs
+	results   *BotRepositoryMockGetBotByCommandResults
+	Counter   uint64
+}
+
+// BotRepositoryMockGetBotByCommandParams contains parameters of the BotRepository.GetBotByCommand
+type BotRepositoryMockGetBotByCommandParams struct {
+	ctx     context.Context
+	command string
+}
+
+// BotRepositoryMockGetBotByCommandParamPtrs contains pointers to parameters of the BotRepository.GetBotByCommand
+type BotRepositoryMockGetBotByCommandParamPtrs struct {
+	ctx     *context.Context
+	command *string
+}
+
+// BotRepositoryMockGetBotByCommandResults contains results of the BotRepository.GetBotByCommand
+type BotRepositoryMockGetBotByCommandResults struct {
+	bot model.Bot
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) Optional() *mBotRepositoryMockGetBotByCommand {
+	mmGetBotByCommand.optional = true
+	return mmGetBotByCommand
+}
+
+// Expect sets up expected params for BotRepository.GetBotByCommand
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) Expect(ctx context.Context, command string) *mBotRepositoryMockGetBotByCommand {
+	if mmGetBotByCommand.mock.funcGetBotByCommand != nil {
+		mmGetBotByCommand.mock.t.Fatalf("BotRepositoryMock.GetBotByCommand mock is already set by Set")
+	}
+
+	if mmGetBotByCommand.defaultExpectation == nil {
+		mmGetBotByCommand.defaultExpectation = &BotRepositoryMockGetBotByCommandExpectation{}
+	}
+
+	if mmGetBotByCommand.defaultExpectation.paramPtrs != nil {
+		mmGetBotByCommand.mock.t.Fatalf("BotRepositoryMock.GetBotByCommand mock is already set by ExpectParams functions")
+	}
+
+	mmGetBotByCommand.defaultExpectation.params = &BotRepositoryMockGetBotByCommandParams{ctx, command}
+	for _, e := range mmGetBotByCommand.expectations {
+		if minimock.Equal(e.params, mmGetBotByCommand.defaultExpectation.params) {
+			mmGetBotByCommand.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBotByCommand.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBotByCommand
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.GetBotByCommand
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockGetBotByCommand {
+	if mmGetBotByCommand.mock.funcGetBotByCommand != nil {
+		mmGetBotByCommand.mock.t.Fatalf("BotRepositoryMock.GetBotByCommand mock is already set by Set")
+	}
+
+	if mmGetBotByCommand.defaultExpectation == nil {
+		mmGetBotByCommand.defaultExpectation = &BotRepositoryMockGetBotByCommandExpectation{}
+	}
+
+	if mmGetBotByCommand.defaultExpectation.params != nil {
+		mmGetBotByCommand.mock.t.Fatalf("BotRepositoryMock.GetBotByCommand mock is already set by Expect")
+	}
+
+	if mmGetBotByCommand.defaultExpectation.paramPtrs == nil {
+		mmGetBotByCommand.defaultExpectation.paramPtrs = &BotRepositoryMockGetBotByCommandParamPtrs{}
+	}
+	mmGetBotByCommand.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetBotByCommand
+}
+
+// ExpectCommandParam2 sets up expected param command for BotRepository.GetBotByCommand
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) ExpectCommandParam2(command string) *mBotRepositoryMockGetBotByCommand {
+	if mmGetBotByCommand.mock.funcGetBotByCommand != nil {
+		mmGetBotByCommand.mock.t.Fatalf("BotRepositoryMock.GetBotByCommand mock is already set by Set")
+	}
+
+	if mmGetBotByCommand.defaultExpectation == nil {
+		mmGetBotByCommand.defaultExpectation = &BotRepositoryMockGetBotByCommandExpectation{}
+	}
+
+	if mmGetBotByCommand.defaultExpectation.params != nil {
+		mmGetBotByCommand.mock.t.Fatalf("BotRepositoryMock.GetBotByCommand mock is already set by Expect")
+	}
+
+	if mmGetBotByCommand.defaultExpectation.paramPtrs == nil {
+		mmGetBotByCommand.defaultExpectation.paramPtrs = &BotRepositoryMockGetBotByCommandParamPtrs{}
+	}
+	mmGetBotByCommand.defaultExpectation.paramPtrs.command = &command
+
+	return mmGetBotByCommand
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.GetBotByCommand
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) Inspect(f func(ctx context.Context, command string)) *mBotRepositoryMockGetBotByCommand {
+	if mmGetBotByCommand.mock.inspectFuncGetBotByCommand != nil {
+		mmGetBotByCommand.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.GetBotByCommand")
+	}
+
+	mmGetBotByCommand.mock.inspectFuncGetBotByCommand = f
+
+	return mmGetBotByCommand
+}
+
+// Return sets up results that will be returned by BotRepository.GetBotByCommand
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) Return(bot model.Bot, err error) *BotRepositoryMock {
+	if mmGetBotByCommand.mock.funcGetBotByCommand != nil {
+		mmGetBotByCommand.mock.t.Fatalf("BotRepositoryMock.GetBotByCommand mock is already set by Set")
+	}
+
+	if mmGetBotByCommand.defaultExpectation == nil {
+		mmGetBotByCommand.defaultExpectation = &BotRepositoryMockGetBotByCommandExpectation{mock: mmGetBotByCommand.mock}
+	}
+	mmGetBotByCommand.defaultExpectation.results = &BotRepositoryMockGetBotByCommandResults{bot, err}
+	return mmGetBotByCommand.mock
+}
+
+// Set uses given function f to mock the BotRepository.GetBotByCommand method
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) Set(f func(ctx context.Context, command string) (bot model.Bot, err error)) *BotRepositoryMock {
+	if mmGetBotByCommand.defaultExpectation != nil {
+		mmGetBotByCommand.mock.t.Fatalf("Default expectation is already set for the BotRepository.GetBotByCommand method")
+	}
+
+	if len(mmGetBotByCommand.expectations) > 0 {
+		mmGetBotByCommand.mock.t.Fatalf("Some expectations are already set for the BotRepository.GetBotByCommand method")
+	}
+
+	mmGetBotByCommand.mock.funcGetBotByCommand = f
+	return mmGetBotByCommand.mock
+}
+
+// When sets expectation for the BotRepository.GetBotByCommand which will trigger the result defined by the following
+// Then helper
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) When(ctx context.Context, command string) *BotRepositoryMockGetBotByCommandExpectation {
+	if mmGetBotByCommand.mock.funcGetBotByCommand != nil {
+		mmGetBotByCommand.mock.t.Fatalf("BotRepositoryMock.GetBotByCommand mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockGetBotByCommandExpectation{
+		mock:   mmGetBotByCommand.mock,
+		params: &BotRepositoryMockGetBotByCommandParams{ctx, command},
+	}
+	mmGetBotByCommand.expectations = append(mmGetBotByCommand.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.GetBotByCommand return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockGetBotByCommandExpectation) Then(bot model.Bot, err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockGetBotByCommandResults{bot, err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.GetBotByCommand should be invoked
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) Times(n uint64) *mBotRepositoryMockGetBotByCommand {
+	if n == 0 {
+		mmGetBotByCommand.mock.t.Fatalf("Times of BotRepositoryMock.GetBotByCommand mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBotByCommand.expectedInvocations, n)
+	return mmGetBotByCommand
+}
+
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) invocationsDone() bool {
+	if len(mmGetBotByCommand.expectations) == 0 && mmGetBotByCommand.defaultExpectation == nil && mmGetBotByCommand.mock.funcGetBotByCommand == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBotByCommand.mock.afterGetBotByCommandCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBotByCommand.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBotByCommand implements repository.BotRepository
+func (mmGetBotByCommand *BotRepositoryMock) GetBotByCommand(ctx context.Context, command string) (bot model.Bot, err error) {
+	mm_atomic.AddUint64(&mmGetBotByCommand.beforeGetBotByCommandCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBotByCommand.afterGetBotByCommandCounter, 1)
+
+	if mmGetBotByCommand.inspectFuncGetBotByCommand != nil {
+		mmGetBotByCommand.inspectFuncGetBotByCommand(ctx, command)
+	}
+
+	mm_params := BotRepositoryMockGetBotByCommandParams{ctx, command}
+
+	// Record call args
+	mmGetBotByCommand.GetBotByCommandMock.mutex.Lock()
+	mmGetBotByCommand.GetBotByCommandMock.callArgs = append(mmGetBotByCommand.GetBotByCommandMock.callArgs, &mm_params)
+	mmGetBotByCommand.GetBotByCommandMock.mutex.Unlock()
+
+	for _, e := range mmGetBotByCommand.GetBotByCommandMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.bot, e.results.err
+		}
+	}
+
+	if mmGetBotByCommand.GetBotByCommandMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBotByCommand.GetBotByCommandMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBotByCommand.GetBotByCommandMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBotByCommand.GetBotByCommandMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockGetBotByCommandParams{ctx, command}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBotByCommand.t.Errorf("BotRepositoryMock.GetBotByCommand got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.command != nil && !minimock.Equal(*mm_want_ptrs.command, mm_got.command) {
+				mmGetBotByCommand.t.Errorf("BotRepositoryMock.GetBotByCommand got unexpected parameter command, want: %#v, got: %#v%s\n", *mm_want_ptrs.command, mm_got.command, minimock.Diff(*mm_want_ptrs.command, mm_got.command))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBotByCommand.t.Errorf("BotRepositoryMock.GetBotByCommand got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBotByCommand.GetBotByCommandMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBotByCommand.t.Fatal("No results are set for the BotRepositoryMock.GetBotByCommand")
+		}
+		return (*mm_results).bot, (*mm_results).err
+	}
+	if mmGetBotByCommand.funcGetBotByCommand != nil {
+		return mmGetBotByCommand.funcGetBotByCommand(ctx, command)
+	}
+	mmGetBotByCommand.t.Fatalf("Unexpected call to BotRepositoryMock.GetBotByCommand. %v %v", ctx, command)
+	return
+}
+
+// GetBotByCommandAfterCounter returns a count of finished BotRepositoryMock.GetBotByCommand invocations
+func (mmGetBotByCommand *BotRepositoryMock) GetBotByCommandAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBotByCommand.afterGetBotByCommandCounter)
+}
+
+// GetBotByCommandBeforeCounter returns a count of BotRepositoryMock.GetBotByCommand invocations
+func (mmGetBotByCommand *BotRepositoryMock) GetBotByCommandBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBotByCommand.beforeGetBotByCommandCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.GetBotByCommand.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBotByCommand *mBotRepositoryMockGetBotByCommand) Calls() []*BotRepositoryMockGetBotByCommandParams {
+	mmGetBotByCommand.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockGetBotByCommandParams, len(mmGetBotByCommand.callArgs))
+	copy(argCopy, mmGetBotByCommand.callArgs)
+
+	mmGetBotByCommand.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBotByCommandDone returns true if the count of the GetBotByCommand invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockGetBotByCommandDone() bool {
+	if m.GetBotByCommandMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBotByCommandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBotByCommandMock.invocationsDone()
+}
+
+// MinimockGetBotByCommandInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockGetBotByCommandInspect() {
+	for _, e := range m.GetBotByCommandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.GetBotByCommand with params: %#v", *e.params)
+		}
+	}
+
+	afterGetBotByCommandCounter := mm_atomic.LoadUint64(&m.afterGetBotByCommandCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBotByCommandMock.defaultExpectation != nil && afterGetBotByCommandCounter < 1 {
+		if m.GetBotByCommandMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BotRepositoryMock.GetBotByCommand")
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.GetBotByCommand with params: %#v", *m.GetBotByCommandMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBotByCommand != nil && afterGetBotByCommandCounter < 1 {
+		m.t.Error("Expected call to BotRepositoryMock.GetBotByCommand")
+	}
+
+	if !m.GetBotByCommandMock.invocationsDone() && afterGetBotByCommandCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.GetBotByCommand but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBotByCommandMock.expectedInvocations), afterGetBotByCommandCounter)
+	}
+}
+
+type mBotRepositoryMockGetBotByTokenHash struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockGetBotByTokenHashExpectation
+	expectations       []*BotRepositoryMockGetBotByTokenHashExpectation
+
+	callArgs []*BotRepositoryMockGetBotByTokenHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BotRepositoryMockGetBotByTokenHashExpectation specifies expectation struct of the BotRepository.GetBotByTokenHash
+type BotRepositoryMockGetBotByTokenHashExpectation struct {
+	mock      *BotRepositoryMock
+	params    *BotRepositoryMockGetBotByTokenHashParams
+	paramPtrs *BotRepositoryMockGetBotByTokenHashParamPtrs
+	results   *BotRepositoryMockGetBotByTokenHashResults
+	Counter   uint64
+}
+
+// BotRepositoryMockGetBotByTokenHashParams contains parameters of the BotRepository.GetBotByTokenHash
+type BotRepositoryMockGetBotByTokenHashParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// BotRepositoryMockGetBotByTokenHashParamPtrs contains pointers to parameters of the BotRepository.GetBotByTokenHash
+type BotRepositoryMockGetBotByTokenHashParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// BotRepositoryMockGetBotByTokenHashResults contains results of the BotRepository.GetBotByTokenHash
+type BotRepositoryMockGetBotByTokenHashResults struct {
+	bot model.Bot
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) Optional() *mBotRepositoryMockGetBotByTokenHash {
+	mmGetBotByTokenHash.optional = true
+	return mmGetBotByTokenHash
+}
+
+// Expect sets up expected params for BotRepository.GetBotByTokenHash
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) Expect(ctx context.Context, tokenHash string) *mBotRepositoryMockGetBotByTokenHash {
+	if mmGetBotByTokenHash.mock.funcGetBotByTokenHash != nil {
+		mmGetBotByTokenHash.mock.t.Fatalf("BotRepositoryMock.GetBotByTokenHash mock is already set by Set")
+	}
+
+	if mmGetBotByTokenHash.defaultExpectation == nil {
+		mmGetBotByTokenHash.defaultExpectation = &BotRepositoryMockGetBotByTokenHashExpectation{}
+	}
+
+	if mmGetBotByTokenHash.defaultExpectation.paramPtrs != nil {
+		mmGetBotByTokenHash.mock.t.Fatalf("BotRepositoryMock.GetBotByTokenHash mock is already set by ExpectParams functions")
+	}
+
+	mmGetBotByTokenHash.defaultExpectation.params = &BotRepositoryMockGetBotByTokenHashParams{ctx, tokenHash}
+	for _, e := range mmGetBotByTokenHash.expectations {
+		if minimock.Equal(e.params, mmGetBotByTokenHash.defaultExpectation.params) {
+			mmGetBotByTokenHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBotByTokenHash.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBotByTokenHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.GetBotByTokenHash
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockGetBotByTokenHash {
+	if mmGetBotByTokenHash.mock.funcGetBotByTokenHash != nil {
+		mmGetBotByTokenHash.mock.t.Fatalf("BotRepositoryMock.GetBotByTokenHash mock is already set by Set")
+	}
+
+	if mmGetBotByTokenHash.defaultExpectation == nil {
+		mmGetBotByTokenHash.defaultExpectation = &BotRepositoryMockGetBotByTokenHashExpectation{}
+	}
+
+	if mmGetBotByTokenHash.defaultExpectation.params != nil {
+		mmGetBotByTokenHash.mock.t.Fatalf("BotRepositoryMock.GetBotByTokenHash mock is already set by Expect")
+	}
+
+	if mmGetBotByTokenHash.defaultExpectation.paramPtrs == nil {
+		mmGetBotByTokenHash.defaultExpectation.paramPtrs = &BotRepositoryMockGetBotByTokenHashParamPtrs{}
+	}
+	mmGetBotByTokenHash.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetBotByTokenHash
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for BotRepository.GetBotByTokenHash
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) ExpectTokenHashParam2(tokenHash string) *mBotRepositoryMockGetBotByTokenHash {
+	if mmGetBotByTokenHash.mock.funcGetBotByTokenHash != nil {
+		mmGetBotByTokenHash.mock.t.Fatalf("BotRepositoryMock.GetBotByTokenHash mock is already set by Set")
+	}
+
+	if mmGetBotByTokenHash.defaultExpectation == nil {
+		mmGetBotByTokenHash.defaultExpectation = &BotRepositoryMockGetBotByTokenHashExpectation{}
+	}
+
+	if mmGetBotByTokenHash.defaultExpectation.params != nil {
+		mmGetBotByTokenHash.mock.t.Fatalf("BotRepositoryMock.GetBotByTokenHash mock is already set by Expect")
+	}
+
+	if mmGetBotByTokenHash.defaultExpectation.paramPtrs == nil {
+		mmGetBotByTokenHash.defaultExpectation.paramPtrs = &BotRepositoryMockGetBotByTokenHashParamPtrs{}
+	}
+	mmGetBotByTokenHash.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+
+	return mmGetBotByTokenHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.GetBotByTokenHash
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) Inspect(f func(ctx context.Context, tokenHash string)) *mBotRepositoryMockGetBotByTokenHash {
+	if mmGetBotByTokenHash.mock.inspectFuncGetBotByTokenHash != nil {
+		mmGetBotByTokenHash.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.GetBotByTokenHash")
+	}
+
+	mmGetBotByTokenHash.mock.inspectFuncGetBotByTokenHash = f
+
+	return mmGetBotByTokenHash
+}
+
+// Return sets up results that will be returned by BotRepository.GetBotByTokenHash
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) Return(bot model.Bot, err error) *BotRepositoryMock {
+	if mmGetBotByTokenHash.mock.funcGetBotByTokenHash != nil {
+		mmGetBotByTokenHash.mock.t.Fatalf("BotRepositoryMock.GetBotByTokenHash mock is already set by Set")
+	}
+
+	if mmGetBotByTokenHash.defaultExpectation == nil {
+		mmGetBotByTokenHash.defaultExpectation = &BotRepositoryMockGetBotByTokenHashExpectation{mock: mmGetBotByTokenHash.mock}
+	}
+	mmGetBotByTokenHash.defaultExpectation.results = &BotRepositoryMockGetBotByTokenHashResults{bot, err}
+	return mmGetBotByTokenHash.mock
+}
+
+// Set uses given function f to mock the BotRepository.GetBotByTokenHash method
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) Set(f func(ctx context.Context, tokenHash string) (bot model.Bot, err error)) *BotRepositoryMock {
+	if mmGetBotByTokenHash.defaultExpectation != nil {
+		mmGetBotByTokenHash.mock.t.Fatalf("Default expectation is already set for the BotRepository.GetBotByTokenHash method")
+	}
+
+	if len(mmGetBotByTokenHash.expectations) > 0 {
+		mmGetBotByTokenHash.mock.t.Fatalf("Some expectations are already set for the BotRepository.GetBotByTokenHash method")
+	}
+
+	mmGetBotByTokenHash.mock.funcGetBotByTokenHash = f
+	return mmGetBotByTokenHash.mock
+}
+
+// When sets expectation for the BotRepository.GetBotByTokenHash which will trigger the result defined by the following
+// Then helper
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) When(ctx context.Context, tokenHash string) *BotRepositoryMockGetBotByTokenHashExpectation {
+	if mmGetBotByTokenHash.mock.funcGetBotByTokenHash != nil {
+		mmGetBotByTokenHash.mock.t.Fatalf("BotRepositoryMock.GetBotByTokenHash mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockGetBotByTokenHashExpectation{
+		mock:   mmGetBotByTokenHash.mock,
+		params: &BotRepositoryMockGetBotByTokenHashParams{ctx, tokenHash},
+	}
+	mmGetBotByTokenHash.expectations = append(mmGetBotByTokenHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.GetBotByTokenHash return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockGetBotByTokenHashExpectation) Then(bot model.Bot, err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockGetBotByTokenHashResults{bot, err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.GetBotByTokenHash should be invoked
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) Times(n uint64) *mBotRepositoryMockGetBotByTokenHash {
+	if n == 0 {
+		mmGetBotByTokenHash.mock.t.Fatalf("Times of BotRepositoryMock.GetBotByTokenHash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBotByTokenHash.expectedInvocations, n)
+	return mmGetBotByTokenHash
+}
+
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) invocationsDone() bool {
+	if len(mmGetBotByTokenHash.expectations) == 0 && mmGetBotByTokenHash.defaultExpectation == nil && mmGetBotByTokenHash.mock.funcGetBotByTokenHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBotByTokenHash.mock.afterGetBotByTokenHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBotByTokenHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBotByTokenHash implements repository.BotRepository
+func (mmGetBotByTokenHash *BotRepositoryMock) GetBotByTokenHash(ctx context.Context, tokenHash string) (bot model.Bot, err error) {
+	mm_atomic.AddUint64(&mmGetBotByTokenHash.beforeGetBotByTokenHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBotByTokenHash.afterGetBotByTokenHashCounter, 1)
+
+	if mmGetBotByTokenHash.inspectFuncGetBotByTokenHash != nil {
+		mmGetBotByTokenHash.inspectFuncGetBotByTokenHash(ctx, tokenHash)
+	}
+
+	mm_params := BotRepositoryMockGetBotByTokenHashParams{ctx, tokenHash}
+
+	// Record call args
+	mmGetBotByTokenHash.GetBotByTokenHashMock.mutex.Lock()
+	mmGetBotByTokenHash.GetBotByTokenHashMock.callArgs = append(mmGetBotByTokenHash.GetBotByTokenHashMock.callArgs, &mm_params)
+	mmGetBotByTokenHash.GetBotByTokenHashMock.mutex.Unlock()
+
+	for _, e := range mmGetBotByTokenHash.GetBotByTokenHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.bot, e.results.err
+		}
+	}
+
+	if mmGetBotByTokenHash.GetBotByTokenHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBotByTokenHash.GetBotByTokenHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBotByTokenHash.GetBotByTokenHashMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBotByTokenHash.GetBotByTokenHashMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockGetBotByTokenHashParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBotByTokenHash.t.Errorf("BotRepositoryMock.GetBotByTokenHash got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmGetBotByTokenHash.t.Errorf("BotRepositoryMock.GetBotByTokenHash got unexpected parameter tokenHash, want: %#v, got: %#v%s\n", *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBotByTokenHash.t.Errorf("BotRepositoryMock.GetBotByTokenHash got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBotByTokenHash.GetBotByTokenHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBotByTokenHash.t.Fatal("No results are set for the BotRepositoryMock.GetBotByTokenHash")
+		}
+		return (*mm_results).bot, (*mm_results).err
+	}
+	if mmGetBotByTokenHash.funcGetBotByTokenHash != nil {
+		return mmGetBotByTokenHash.funcGetBotByTokenHash(ctx, tokenHash)
+	}
+	mmGetBotByTokenHash.t.Fatalf("Unexpected call to BotRepositoryMock.GetBotByTokenHash. %v %v", ctx, tokenHash)
+	return
+}
+
+// GetBotByTokenHashAfterCounter returns a count of finished BotRepositoryMock.GetBotByTokenHash invocations
+func (mmGetBotByTokenHash *BotRepositoryMock) GetBotByTokenHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBotByTokenHash.afterGetBotByTokenHashCounter)
+}
+
+// GetBotByTokenHashBeforeCounter returns a count of BotRepositoryMock.GetBotByTokenHash invocations
+func (mmGetBotByTokenHash *BotRepositoryMock) GetBotByTokenHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBotByTokenHash.beforeGetBotByTokenHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.GetBotByTokenHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBotByTokenHash *mBotRepositoryMockGetBotByTokenHash) Calls() []*BotRepositoryMockGetBotByTokenHashParams {
+	mmGetBotByTokenHash.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockGetBotByTokenHashParams, len(mmGetBotByTokenHash.callArgs))
+	copy(argCopy, mmGetBotByTokenHash.callArgs)
+
+	mmGetBotByTokenHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBotByTokenHashDone returns true if the count of the GetBotByTokenHash invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockGetBotByTokenHashDone() bool {
+	if m.GetBotByTokenHashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBotByTokenHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBotByTokenHashMock.invocationsDone()
+}
+
+// MinimockGetBotByTokenHashInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockGetBotByTokenHashInspect() {
+	for _, e := range m.GetBotByTokenHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.GetBotByTokenHash with params: %#v", *e.params)
+		}
+	}
+
+	afterGetBotByTokenHashCounter := mm_atomic.LoadUint64(&m.afterGetBotByTokenHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBotByTokenHashMock.defaultExpectation != nil && afterGetBotByTokenHashCounter < 1 {
+		if m.GetBotByTokenHashMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BotRepositoryMock.GetBotByTokenHash")
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.GetBotByTokenHash with params: %#v", *m.GetBotByTokenHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBotByTokenHash != nil && afterGetBotByTokenHashCounter < 1 {
+		m.t.Error("Expected call to BotRepositoryMock.GetBotByTokenHash")
+	}
+
+	if !m.GetBotByTokenHashMock.invocationsDone() && afterGetBotByTokenHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.GetBotByTokenHash but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBotByTokenHashMock.expectedInvocations), afterGetBotByTokenHashCounter)
+	}
+}
+
+type mBotRepositoryMockListBotCommands struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockListBotCommandsExpectation
+	expectations       []*BotRepositoryMockListBotCommandsExpectation
+
+	callArgs []*BotRepositoryMockListBotCommandsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BotRepositoryMockListBotCommandsExpectation specifies expectation struct of the BotRepository.ListBotCommands
+type BotRepositoryMockListBotCommandsExpectation struct {
+	mock      *BotRepositoryMock
+	params    *BotRepositoryMockListBotCommandsParams
+	paramPtrs *BotRepositoryMockListBotCommandsParamPtrs
+	results   *BotRepositoryMockListBotCommandsResults
+	Counter   uint64
+}
+
+// BotRepositoryMockListBotCommandsParams contains parameters of the BotRepository.ListBotCommands
+type BotRepositoryMockListBotCommandsParams struct {
+	ctx context.Context
+}
+
+// BotRepositoryMockListBotCommandsParamPtrs contains pointers to parameters of the BotRepository.ListBotCommands
+type BotRepositoryMockListBotCommandsParamPtrs struct {
+	ctx *context.Context
+}
+
+// BotRepositoryMockListBotCommandsResults contains results of the BotRepository.ListBotCommands
+type BotRepositoryMockListBotCommandsResults struct {
+	commands []model.BotCommand
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListBotCommands *mBotRepositoryMockListBotCommands) Optional() *mBotRepositoryMockListBotCommands {
+	mmListBotCommands.optional = true
+	return mmListBotCommands
+}
+
+// Expect sets up expected params for BotRepository.ListBotCommands
+func (mmListBotCommands *mBotRepositoryMockListBotCommands) Expect(ctx context.Context) *mBotRepositoryMockListBotCommands {
+	if mmListBotCommands.mock.funcListBotCommands != nil {
+		mmListBotCommands.mock.t.Fatalf("BotRepositoryMock.ListBotCommands mock is already set by Set")
+	}
+
+	if mmListBotCommands.defaultExpectation == nil {
+		mmListBotCommands.defaultExpectation = &BotRepositoryMockListBotCommandsExpectation{}
+	}
+
+	if mmListBotCommands.defaultExpectation.paramPtrs != nil {
+		mmListBotCommands.mock.t.Fatalf("BotRepositoryMock.ListBotCommands mock is already set by ExpectParams functions")
+	}
+
+	mmListBotCommands.defaultExpectation.params = &BotRepositoryMockListBotCommandsParams{ctx}
+	for _, e := range mmListBotCommands.expectations {
+		if minimock.Equal(e.params, mmListBotCommands.defaultExpectation.params) {
+			mmListBotCommands.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListBotCommands.defaultExpectation.params)
+		}
+	}
+
+	return mmListBotCommands
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.ListBotCommands
+func (mmListBotCommands *mBotRepositoryMockListBotCommands) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockListBotCommands {
+	if mmListBotCommands.mock.funcListBotCommands != nil {
+		mmListBotCommands.mock.t.Fatalf("BotRepositoryMock.ListBotCommands mock is already set by Set")
+	}
+
+	if mmListBotCommands.defaultExpectation == nil {
+		mmListBotCommands.defaultExpectation = &BotRepositoryMockListBotCommandsExpectation{}
+	}
+
+	if mmListBotCommands.defaultExpectation.params != nil {
+		mmListBotCommands.mock.t.Fatalf("BotRepositoryMock.ListBotCommands mock is already set by Expect")
+	}
+
+	if mmListBotCommands.defaultExpectation.paramPtrs == nil {
+		mmListBotCommands.defaultExpectation.paramPtrs = &BotRepositoryMockListBotCommandsParamPtrs{}
+	}
+	mmListBotCommands.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListBotCommands
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.ListBotCommands
+func (mmListBotCommands *mBotRepositoryMockListBotCommands) Inspect(f func(ctx context.Context)) *mBotRepositoryMockListBotCommands {
+	if mmListBotCommands.mock.inspectFuncListBotCommands != nil {
+		mmListBotCommands.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.ListBotCommands")
+	}
+
+	mmListBotCommands.mock.inspectFuncListBotCommands = f
+
+	return mmListBotCommands
+}
+
+// Return sets up results that will be returned by BotRepository.ListBotCommands
+func (mmListBotCommands *mBotRepositoryMockListBotCommands) Return(commands []model.BotCommand, err error) *BotRepositoryMock {
+	if mmListBotCommands.mock.funcListBotCommands != nil {
+		mmListBotCommands.mock.t.Fatalf("BotRepositoryMock.ListBotCommands mock is already set by Set")
+	}
+
+	if mmListBotCommands.defaultExpectation == nil {
+		mmListBotCommands.defaultExpectation = &BotRepositoryMockListBotCommandsExpectation{mock: mmListBotCommands.mock}
+	}
+	mmListBotCommands.defaultExpectation.results = &BotRepositoryMockListBotCommandsResults{commands, err}
+	return mmListBotCommands.mock
+}
+
+// Set uses given function f to mock the BotRepository.ListBotCommands method
+func (mmListBotCommands *mBotRepositoryMockListBotCommands) Set(f func(ctx context.Context) (commands []model.BotCommand, err error)) *BotRepositoryMock {
+	if mmListBotCommands.defaultExpectation != nil {
+		mmListBotCommands.mock.t.Fatalf("Default expectation is already set for the BotRepository.ListBotCommands method")
+	}
+
+	if len(mmListBotCommands.expectations) > 0 {
+		mmListBotCommands.mock.t.Fatalf("Some expectations are already set for the BotRepository.ListBotCommands method")
+	}
+
+	mmListBotCommands.mock.funcListBotCommands = f
+	return mmListBotCommands.mock
+}
+
+// When sets expectation for the BotRepository.ListBotCommands which will trigger the result defined by the following
+// Then helper
+func (mmListBotCommands *mBotRepositoryMockListBotCommands) When(ctx context.Context) *BotRepositoryMockListBotCommandsExpectation {
+	if mmListBotCommands.mock.funcListBotCommands != nil {
+		mmListBotCommands.mock.t.Fatalf("BotRepositoryMock.ListBotCommands mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockListBotCommandsExpectation{
+		mock:   mmListBotCommands.mock,
+		params: &BotRepositoryMockListBotCommandsParams{ctx},
+	}
+	mmListBotCommands.expectations = append(mmListBotCommands.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.ListBotCommands return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockListBotCommandsExpectation) Then(commands []model.BotCommand, err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockListBotCommandsResults{commands, err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.ListBotCommands should be invoked
+func (mmListBotCommands *mBotRepositoryMockListBotCommands) Times(n uint64) *mBotRepositoryMockListBotCommands {
+	if n == 0 {
+		mmListBotCommands.mock.t.Fatalf("Times of BotRepositoryMock.ListBotCommands mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListBotCommands.expectedInvocations, n)
+	return mmListBotCommands
+}
+
+func (mmListBotCommands *mBotRepositoryMockListBotCommands) invocationsDone() bool {
+	if len(mmListBotCommands.expectations) == 0 && mmListBotCommands.defaultExpectation == nil && mmListBotCommands.mock.funcListBotCommands == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListBotCommands.mock.afterListBotCommandsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListBotCommands.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListBotCommands implements repository.BotRepository
+func (mmListBotCommands *BotRepositoryMock) ListBotCommands(ctx context.Context) (commands []model.BotCommand, err error) {
+	mm_atomic.AddUint64(&mmListBotCommands.beforeListBotCommandsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListBotCommands.afterListBotCommandsCounter, 1)
+
+	if mmListBotCommands.inspectFuncListBotCommands != nil {
+		mmListBotCommands.inspectFuncListBotCommands(ctx)
+	}
+
+	mm_params := BotRepositoryMockListBotCommandsParams{ctx}
+
+	// Record call args
+	mmListBotCommands.ListBotCommandsMock.mutex.Lock()
+	mmListBotCommands.ListBotCommandsMock.callArgs = append(mmListBotCommands.ListBotCommandsMock.callArgs, &mm_params)
+	mmListBotCommands.ListBotCommandsMock.mutex.Unlock()
+
+	for _, e := range mmListBotCommands.ListBotCommandsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.commands, e.results.err
+		}
+	}
+
+	if mmListBotCommands.ListBotCommandsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListBotCommands.ListBotCommandsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListBotCommands.ListBotCommandsMock.defaultExpectation.params
+		mm_want_ptrs := mmListBotCommands.ListBotCommandsMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockListBotCommandsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListBotCommands.t.Errorf("BotRepositoryMock.ListBotCommands got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListBotCommands.t.Errorf("BotRepositoryMock.ListBotCommands got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListBotCommands.ListBotCommandsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListBotCommands.t.Fatal("No results are set for the BotRepositoryMock.ListBotCommands")
+		}
+		return (*mm_results).commands, (*mm_results).err
+	}
+	if mmListBotCommands.funcListBotCommands != nil {
+		return mmListBotCommands.funcListBotCommands(ctx)
+	}
+	mmListBotCommands.t.Fatalf("Unexpected call to BotRepositoryMock.ListBotCommands. %v", ctx)
+	return
+}
+
+// ListBotCommandsAfterCounter returns a count of finished BotRepositoryMock.ListBotCommands invocations
+func (mmListBotCommands *BotRepositoryMock) ListBotCommandsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBotCommands.afterListBotCommandsCounter)
+}
+
+// ListBotCommandsBeforeCounter returns a count of BotRepositoryMock.ListBotCommands invocations
+func (mmListBotCommands *BotRepositoryMock) ListBotCommandsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBotCommands.beforeListBotCommandsCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.ListBotCommands.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListBotCommands *mBotRepositoryMockListBotCommands) Calls() []*BotRepositoryMockListBotCommandsParams {
+	mmListBotCommands.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockListBotCommandsParams, len(mmListBotCommands.callArgs))
+	copy(argCopy, mmListBotCommands.callArgs)
+
+	mmListBotCommands.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListBotCommandsDone returns true if the count of the ListBotCommands invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockListBotCommandsDone() bool {
+	if m.ListBotCommandsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListBotCommandsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListBotCommandsMock.invocationsDone()
+}
+
+// MinimockListBotCommandsInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockListBotCommandsInspect() {
+	for _, e := range m.ListBotCommandsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.ListBotCommands with params: %#v", *e.params)
+		}
+	}
+
+	afterListBotCommandsCounter := mm_atomic.LoadUint64(&m.afterListBotCommandsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListBotCommandsMock.defaultExpectation != nil && afterListBotCommandsCounter < 1 {
+		if m.ListBotCommandsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BotRepositoryMock.ListBotCommands")
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.ListBotCommands with params: %#v", *m.ListBotCommandsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListBotCommands != nil && afterListBotCommandsCounter < 1 {
+		m.t.Error("Expected call to BotRepositoryMock.ListBotCommands")
+	}
+
+	if !m.ListBotCommandsMock.invocationsDone() && afterListBotCommandsCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.ListBotCommands but found %d calls",
+			mm_atomic.LoadUint64(&m.ListBotCommandsMock.expectedInvocations), afterListBotCommandsCounter)
+	}
+}
+
+type mBotRepositoryMockListBots struct {
+	optional           bool
+	mock               *BotRepositoryMock
+	defaultExpectation *BotRepositoryMockListBotsExpectation
+	expectations       []*BotRepositoryMockListBotsExpectation
+
+	callArgs []*BotRepositoryMockListBotsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BotRepositoryMockListBotsExpectation specifies expectation struct of the BotRepository.ListBots
+type BotRepositoryMockListBotsExpectation struct {
+	mock      *BotRepositoryMock
+	params    *BotRepositoryMockListBotsParams
+	paramPtrs *BotRepositoryMockListBotsParamPtrs
+	results   *BotRepositoryMockListBotsResults
+	Counter   uint64
+}
+
+// BotRepositoryMockListBotsParams contains parameters of the BotRepository.ListBots
+type BotRepositoryMockListBotsParams struct {
+	ctx context.Context
+}
+
+// BotRepositoryMockListBotsParamPtrs contains pointers to parameters of the BotRepository.ListBots
+type BotRepositoryMockListBotsParamPtrs struct {
+	ctx *context.Context
+}
+
+// BotRepositoryMockListBotsResults contains results of the BotRepository.ListBots
+type BotRepositoryMockListBotsResults struct {
+	bots []model.Bot
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListBots *mBotRepositoryMockListBots) Optional() *mBotRepositoryMockListBots {
+	mmListBots.optional = true
+	return mmListBots
+}
+
+// Expect sets up expected params for BotRepository.ListBots
+func (mmListBots *mBotRepositoryMockListBots) Expect(ctx context.Context) *mBotRepositoryMockListBots {
+	if mmListBots.mock.funcListBots != nil {
+		mmListBots.mock.t.Fatalf("BotRepositoryMock.ListBots mock is already set by Set")
+	}
+
+	if mmListBots.defaultExpectation == nil {
+		mmListBots.defaultExpectation = &BotRepositoryMockListBotsExpectation{}
+	}
+
+	if mmListBots.defaultExpectation.paramPtrs != nil {
+		mmListBots.mock.t.Fatalf("BotRepositoryMock.ListBots mock is already set by ExpectParams functions")
+	}
+
+	mmListBots.defaultExpectation.params = &BotRepositoryMockListBotsParams{ctx}
+	for _, e := range mmListBots.expectations {
+		if minimock.Equal(e.params, mmListBots.defaultExpectation.params) {
+			mmListBots.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListBots.defaultExpectation.params)
+		}
+	}
+
+	return mmListBots
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotRepository.ListBots
+func (mmListBots *mBotRepositoryMockListBots) ExpectCtxParam1(ctx context.Context) *mBotRepositoryMockListBots {
+	if mmListBots.mock.funcListBots != nil {
+		mmListBots.mock.t.Fatalf("BotRepositoryMock.ListBots mock is already set by Set")
+	}
+
+	if mmListBots.defaultExpectation == nil {
+		mmListBots.defaultExpectation = &BotRepositoryMockListBotsExpectation{}
+	}
+
+	if mmListBots.defaultExpectation.params != nil {
+		mmListBots.mock.t.Fatalf("BotRepositoryMock.ListBots mock is already set by Expect")
+	}
+
+	if mmListBots.defaultExpectation.paramPtrs == nil {
+		mmListBots.defaultExpectation.paramPtrs = &BotRepositoryMockListBotsParamPtrs{}
+	}
+	mmListBots.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListBots
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotRepository.ListBots
+func (mmListBots *mBotRepositoryMockListBots) Inspect(f func(ctx context.Context)) *mBotRepositoryMockListBots {
+	if mmListBots.mock.inspectFuncListBots != nil {
+		mmListBots.mock.t.Fatalf("Inspect function is already set for BotRepositoryMock.ListBots")
+	}
+
+	mmListBots.mock.inspectFuncListBots = f
+
+	return mmListBots
+}
+
+// Return sets up results that will be returned by BotRepository.ListBots
+func (mmListBots *mBotRepositoryMockListBots) Return(bots []model.Bot, err error) *BotRepositoryMock {
+	if mmListBots.mock.funcListBots != nil {
+		mmListBots.mock.t.Fatalf("BotRepositoryMock.ListBots mock is already set by Set")
+	}
+
+	if mmListBots.defaultExpectation == nil {
+		mmListBots.defaultExpectation = &BotRepositoryMockListBotsExpectation{mock: mmListBots.mock}
+	}
+	mmListBots.defaultExpectation.results = &BotRepositoryMockListBotsResults{bots, err}
+	return mmListBots.mock
+}
+
+// Set uses given function f to mock the BotRepository.ListBots method
+func (mmListBots *mBotRepositoryMockListBots) Set(f func(ctx context.Context) (bots []model.Bot, err error)) *BotRepositoryMock {
+	if mmListBots.defaultExpectation != nil {
+		mmListBots.mock.t.Fatalf("Default expectation is already set for the BotRepository.ListBots method")
+	}
+
+	if len(mmListBots.expectations) > 0 {
+		mmListBots.mock.t.Fatalf("Some expectations are already set for the BotRepository.ListBots method")
+	}
+
+	mmListBots.mock.funcListBots = f
+	return mmListBots.mock
+}
+
+// When sets expectation for the BotRepository.ListBots which will trigger the result defined by the following
+// Then helper
+func (mmListBots *mBotRepositoryMockListBots) When(ctx context.Context) *BotRepositoryMockListBotsExpectation {
+	if mmListBots.mock.funcListBots != nil {
+		mmListBots.mock.t.Fatalf("BotRepositoryMock.ListBots mock is already set by Set")
+	}
+
+	expectation := &BotRepositoryMockListBotsExpectation{
+		mock:   mmListBots.mock,
+		params: &BotRepositoryMockListBotsParams{ctx},
+	}
+	mmListBots.expectations = append(mmListBots.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotRepository.ListBots return parameters for the expectation previously defined by the When method
+func (e *BotRepositoryMockListBotsExpectation) Then(bots []model.Bot, err error) *BotRepositoryMock {
+	e.results = &BotRepositoryMockListBotsResults{bots, err}
+	return e.mock
+}
+
+// Times sets number of times BotRepository.ListBots should be invoked
+func (mmListBots *mBotRepositoryMockListBots) Times(n uint64) *mBotRepositoryMockListBots {
+	if n == 0 {
+		mmListBots.mock.t.Fatalf("Times of BotRepositoryMock.ListBots mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListBots.expectedInvocations, n)
+	return mmListBots
+}
+
+func (mmListBots *mBotRepositoryMockListBots) invocationsDone() bool {
+	if len(mmListBots.expectations) == 0 && mmListBots.defaultExpectation == nil && mmListBots.mock.funcListBots == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListBots.mock.afterListBotsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListBots.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListBots implements repository.BotRepository
+func (mmListBots *BotRepositoryMock) ListBots(ctx context.Context) (bots []model.Bot, err error) {
+	mm_atomic.AddUint64(&mmListBots.beforeListBotsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListBots.afterListBotsCounter, 1)
+
+	if mmListBots.inspectFuncListBots != nil {
+		mmListBots.inspectFuncListBots(ctx)
+	}
+
+	mm_params := BotRepositoryMockListBotsParams{ctx}
+
+	// Record call args
+	mmListBots.ListBotsMock.mutex.Lock()
+	mmListBots.ListBotsMock.callArgs = append(mmListBots.ListBotsMock.callArgs, &mm_params)
+	mmListBots.ListBotsMock.mutex.Unlock()
+
+	for _, e := range mmListBots.ListBotsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.bots, e.results.err
+		}
+	}
+
+	if mmListBots.ListBotsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListBots.ListBotsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListBots.ListBotsMock.defaultExpectation.params
+		mm_want_ptrs := mmListBots.ListBotsMock.defaultExpectation.paramPtrs
+
+		mm_got := BotRepositoryMockListBotsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListBots.t.Errorf("BotRepositoryMock.ListBots got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListBots.t.Errorf("BotRepositoryMock.ListBots got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListBots.ListBotsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListBots.t.Fatal("No results are set for the BotRepositoryMock.ListBots")
+		}
+		return (*mm_results).bots, (*mm_results).err
+	}
+	if mmListBots.funcListBots != nil {
+		return mmListBots.funcListBots(ctx)
+	}
+	mmListBots.t.Fatalf("Unexpected call to BotRepositoryMock.ListBots. %v", ctx)
+	return
+}
+
+// ListBotsAfterCounter returns a count of finished BotRepositoryMock.ListBots invocations
+func (mmListBots *BotRepositoryMock) ListBotsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBots.afterListBotsCounter)
+}
+
+// ListBotsBeforeCounter returns a count of BotRepositoryMock.ListBots invocations
+func (mmListBots *BotRepositoryMock) ListBotsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBots.beforeListBotsCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotRepositoryMock.ListBots.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListBots *mBotRepositoryMockListBots) Calls() []*BotRepositoryMockListBotsParams {
+	mmListBots.mutex.RLock()
+
+	argCopy := make([]*BotRepositoryMockListBotsParams, len(mmListBots.callArgs))
+	copy(argCopy, mmListBots.callArgs)
+
+	mmListBots.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListBotsDone returns true if the count of the ListBots invocations corresponds
+// the number of defined expectations
+func (m *BotRepositoryMock) MinimockListBotsDone() bool {
+	if m.ListBotsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListBotsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListBotsMock.invocationsDone()
+}
+
+// MinimockListBotsInspect logs each unmet expectation
+func (m *BotRepositoryMock) MinimockListBotsInspect() {
+	for _, e := range m.ListBotsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotRepositoryMock.ListBots with params: %#v", *e.params)
+		}
+	}
+
+	afterListBotsCounter := mm_atomic.LoadUint64(&m.afterListBotsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListBotsMock.defaultExpectation != nil && afterListBotsCounter < 1 {
+		if m.ListBotsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BotRepositoryMock.ListBots")
+		} else {
+			m.t.Errorf("Expected call to BotRepositoryMock.ListBots with params: %#v", *m.ListBotsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListBots != nil && afterListBotsCounter < 1 {
+		m.t.Error("Expected call to BotRepositoryMock.ListBots")
+	}
+
+	if !m.ListBotsMock.invocationsDone() && afterListBotsCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotRepositoryMock.ListBots but found %d calls",
+			mm_atomic.LoadUint64(&m.ListBotsMock.expectedInvocations), afterListBotsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BotRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateBotInspect()
+
+			m.MinimockDeleteBotInspect()
+
+			m.MinimockGetBotByCommandInspect()
+
+			m.MinimockGetBotByTokenHashInspect()
+
+			m.MinimockListBotCommandsInspect()
+
+			m.MinimockListBotsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BotRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *BotRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateBotDone() &&
+		m.MinimockDeleteBotDone() &&
+		m.MinimockGetBotByCommandDone() &&
+		m.MinimockGetBotByTokenHashDone() &&
+		m.MinimockListBotCommandsDone() &&
+		m.MinimockListBotsDone()
+}
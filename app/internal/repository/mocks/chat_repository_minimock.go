@@ -37,13 +37,31 @@ type ChatRepositoryMock struct {
 	beforeDeleteChatCounter uint64
 	DeleteChatMock          mChatRepositoryMockDeleteChat
 
+	funcIsParticipant          func(ctx context.Context, chatID int64, email string) (participant bool, err error)
+	inspectFuncIsParticipant   func(ctx context.Context, chatID int64, email string)
+	afterIsParticipantCounter  uint64
+	beforeIsParticipantCounter uint64
+	IsParticipantMock          mChatRepositoryMockIsParticipant
+
 	funcLinkParticipantsToChat          func(ctx context.Context, params model.LinkParticipantsToChatParams) (err error)
 	inspectFuncLinkParticipantsToChat   func(ctx context.Context, params model.LinkParticipantsToChatParams)
 	afterLinkParticipantsToChatCounter  uint64
 	beforeLinkParticipantsToChatCounter uint64
 	LinkParticipantsToChatMock          mChatRepositoryMockLinkParticipantsToChat
 
-	funcSendMessage          func(ctx context.Context, params model.SendMessageParams) (err error)
+	funcListChats          func(ctx context.Context, params model.ListChatsParams) (chats []model.UserChat, err error)
+	inspectFuncListChats   func(ctx context.Context, params model.ListChatsParams)
+	afterListChatsCounter  uint64
+	beforeListChatsCounter uint64
+	ListChatsMock          mChatRepositoryMockListChats
+
+	funcListMessages          func(ctx context.Context, params model.ListMessagesParams) (messages []model.Message, err error)
+	inspectFuncListMessages   func(ctx context.Context, params model.ListMessagesParams)
+	afterListMessagesCounter  uint64
+	beforeListMessagesCounter uint64
+	ListMessagesMock          mChatRepositoryMockListMessages
+
+	funcSendMessage          func(ctx context.Context, params model.SendMessageParams) (resp model.SendMessageResponse, err error)
 	inspectFuncSendMessage   func(ctx context.Context, params model.SendMessageParams)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
@@ -54,6 +72,12 @@ type ChatRepositoryMock struct {
 	afterUnlinkParticipantsFromChatCounter  uint64
 	beforeUnlinkParticipantsFromChatCounter uint64
 	UnlinkParticipantsFromChatMock          mChatRepositoryMockUnlinkParticipantsFromChat
+
+	funcUpdateChatSettings          func(ctx context.Context, params model.UpdateChatSettingsParams) (settings model.ChatSettings, err error)
+	inspectFuncUpdateChatSettings   func(ctx context.Context, params model.UpdateChatSettingsParams)
+	afterUpdateChatSettingsCounter  uint64
+	beforeUpdateChatSettingsCounter uint64
+	UpdateChatSettingsMock          mChatRepositoryMockUpdateChatSettings
 }
 
 // NewChatRepositoryMock returns a mock for repository.ChatRepository
@@ -73,15 +97,27 @@ func NewChatRepositoryMock(t minimock.Tester) *ChatRepositoryMock {
 	m.DeleteChatMock = mChatRepositoryMockDeleteChat{mock: m}
 	m.DeleteChatMock.callArgs = []*ChatRepositoryMockDeleteChatParams{}
 
+	m.IsParticipantMock = mChatRepositoryMockIsParticipant{mock: m}
+	m.IsParticipantMock.callArgs = []*ChatRepositoryMockIsParticipantParams{}
+
 	m.LinkParticipantsToChatMock = mChatRepositoryMockLinkParticipantsToChat{mock: m}
 	m.LinkParticipantsToChatMock.callArgs = []*ChatRepositoryMockLinkParticipantsToChatParams{}
 
+	m.ListChatsMock = mChatRepositoryMockListChats{mock: m}
+	m.ListChatsMock.callArgs = []*ChatRepositoryMockListChatsParams{}
+
+	m.ListMessagesMock = mChatRepositoryMockListMessages{mock: m}
+	m.ListMessagesMock.callArgs = []*ChatRepositoryMockListMessagesParams{}
+
 	m.SendMessageMock = mChatRepositoryMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatRepositoryMockSendMessageParams{}
 
 	m.UnlinkParticipantsFromChatMock = mChatRepositoryMockUnlinkParticipantsFromChat{mock: m}
 	m.UnlinkParticipantsFromChatMock.callArgs = []*ChatRepositoryMockUnlinkParticipantsFromChatParams{}
 
+	m.UpdateChatSettingsMock = mChatRepositoryMockUpdateChatSettings{mock: m}
+	m.UpdateChatSettingsMock.callArgs = []*ChatRepositoryMockUpdateChatSettingsParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -1021,6 +1057,355 @@ func (m *ChatRepositoryMock) MinimockDeleteChatInspect() {
 	}
 }
 
+type mChatRepositoryMockIsParticipant struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockIsParticipantExpectation
+	expectations       []*ChatRepositoryMockIsParticipantExpectation
+
+	callArgs []*ChatRepositoryMockIsParticipantParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatRepositoryMockIsParticipantExpectation specifies expectation struct of the ChatRepository.IsParticipant
+type ChatRepositoryMockIsParticipantExpectation struct {
+	mock      *ChatRepositoryMock
+	params    *ChatRepositoryMockIsParticipantParams
+	paramPtrs *ChatRepositoryMockIsParticipantParamPtrs
+	results   *ChatRepositoryMockIsParticipantResults
+	Counter   uint64
+}
+
+// ChatRepositoryMockIsParticipantParams contains parameters of the ChatRepository.IsParticipant
+type ChatRepositoryMockIsParticipantParams struct {
+	ctx    context.Context
+	chatID int64
+	email  string
+}
+
+// ChatRepositoryMockIsParticipantParamPtrs contains pointers to parameters of the ChatRepository.IsParticipant
+type ChatRepositoryMockIsParticipantParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+	email  *string
+}
+
+// ChatRepositoryMockIsParticipantResults contains results of the ChatRepository.IsParticipant
+type ChatRepositoryMockIsParticipantResults struct {
+	participant bool
+	err         error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) Optional() *mChatRepositoryMockIsParticipant {
+	mmIsParticipant.optional = true
+	return mmIsParticipant
+}
+
+// Expect sets up expected params for ChatRepository.IsParticipant
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) Expect(ctx context.Context, chatID int64, email string) *mChatRepositoryMockIsParticipant {
+	if mmIsParticipant.mock.funcIsParticipant != nil {
+		mmIsParticipant.mock.t.Fatalf("ChatRepositoryMock.IsParticipant mock is already set by Set")
+	}
+
+	if mmIsParticipant.defaultExpectation == nil {
+		mmIsParticipant.defaultExpectation = &ChatRepositoryMockIsParticipantExpectation{}
+	}
+
+	if mmIsParticipant.defaultExpectation.paramPtrs != nil {
+		mmIsParticipant.mock.t.Fatalf("ChatRepositoryMock.IsParticipant mock is already set by ExpectParams functions")
+	}
+
+	mmIsParticipant.defaultExpectation.params = &ChatRepositoryMockIsParticipantParams{ctx, chatID, email}
+	for _, e := range mmIsParticipant.expectations {
+		if minimock.Equal(e.params, mmIsParticipant.defaultExpectation.params) {
+			mmIsParticipant.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsParticipant.defaultExpectation.params)
+		}
+	}
+
+	return mmIsParticipant
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.IsParticipant
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockIsParticipant {
+	if mmIsParticipant.mock.funcIsParticipant != nil {
+		mmIsParticipant.mock.t.Fatalf("ChatRepositoryMock.IsParticipant mock is already set by Set")
+	}
+
+	if mmIsParticipant.defaultExpectation == nil {
+		mmIsParticipant.defaultExpectation = &ChatRepositoryMockIsParticipantExpectation{}
+	}
+
+	if mmIsParticipant.defaultExpectation.params != nil {
+		mmIsParticipant.mock.t.Fatalf("ChatRepositoryMock.IsParticipant mock is already set by Expect")
+	}
+
+	if mmIsParticipant.defaultExpectation.paramPtrs == nil {
+		mmIsParticipant.defaultExpectation.paramPtrs = &ChatRepositoryMockIsParticipantParamPtrs{}
+	}
+	mmIsParticipant.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmIsParticipant
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.IsParticipant
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockIsParticipant {
+	if mmIsParticipant.mock.funcIsParticipant != nil {
+		mmIsParticipant.mock.t.Fatalf("ChatRepositoryMock.IsParticipant mock is already set by Set")
+	}
+
+	if mmIsParticipant.defaultExpectation == nil {
+		mmIsParticipant.defaultExpectation = &ChatRepositoryMockIsParticipantExpectation{}
+	}
+
+	if mmIsParticipant.defaultExpectation.params != nil {
+		mmIsParticipant.mock.t.Fatalf("ChatRepositoryMock.IsParticipant mock is already set by Expect")
+	}
+
+	if mmIsParticipant.defaultExpectation.paramPtrs == nil {
+		mmIsParticipant.defaultExpectation.paramPtrs = &ChatRepositoryMockIsParticipantParamPtrs{}
+	}
+	mmIsParticipant.defaultExpectation.paramPtrs.chatID = &chatID
+
+	return mmIsParticipant
+}
+
+// ExpectEmailParam3 sets up expected param email for ChatRepository.IsParticipant
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) ExpectEmailParam3(email string) *mChatRepositoryMockIsParticipant {
+	if mmIsParticipant.mock.funcIsParticipant != nil {
+		mmIsParticipant.mock.t.Fatalf("ChatRepositoryMock.IsParticipant mock is already set by Set")
+	}
+
+	if mmIsParticipant.defaultExpectation == nil {
+		mmIsParticipant.defaultExpectation = &ChatRepositoryMockIsParticipantExpectation{}
+	}
+
+	if mmIsParticipant.defaultExpectation.params != nil {
+		mmIsParticipant.mock.t.Fatalf("ChatRepositoryMock.IsParticipant mock is already set by Expect")
+	}
+
+	if mmIsParticipant.defaultExpectation.paramPtrs == nil {
+		mmIsParticipant.defaultExpectation.paramPtrs = &ChatRepositoryMockIsParticipantParamPtrs{}
+	}
+	mmIsParticipant.defaultExpectation.paramPtrs.email = &email
+
+	return mmIsParticipant
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.IsParticipant
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) Inspect(f func(ctx context.Context, chatID int64, email string)) *mChatRepositoryMockIsParticipant {
+	if mmIsParticipant.mock.inspectFuncIsParticipant != nil {
+		mmIsParticipant.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.IsParticipant")
+	}
+
+	mmIsParticipant.mock.inspectFuncIsParticipant = f
+
+	return mmIsParticipant
+}
+
+// Return sets up results that will be returned by ChatRepository.IsParticipant
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) Return(participant bool, err error) *ChatRepositoryMock {
+	if mmIsParticipant.mock.funcIsParticipant != nil {
+		mmIsParticipant.mock.t.Fatalf("ChatRepositoryMock.IsParticipant mock is already set by Set")
+	}
+
+	if mmIsParticipant.defaultExpectation == nil {
+		mmIsParticipant.defaultExpectation = &ChatRepositoryMockIsParticipantExpectation{mock: mmIsParticipant.mock}
+	}
+	mmIsParticipant.defaultExpectation.results = &ChatRepositoryMockIsParticipantResults{participant, err}
+	return mmIsParticipant.mock
+}
+
+// Set uses given function f to mock the ChatRepository.IsParticipant method
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) Set(f func(ctx context.Context, chatID int64, email string) (participant bool, err error)) *ChatRepositoryMock {
+	if mmIsParticipant.defaultExpectation != nil {
+		mmIsParticipant.mock.t.Fatalf("Default expectation is already set for the ChatRepository.IsParticipant method")
+	}
+
+	if len(mmIsParticipant.expectations) > 0 {
+		mmIsParticipant.mock.t.Fatalf("Some expectations are already set for the ChatRepository.IsParticipant method")
+	}
+
+	mmIsParticipant.mock.funcIsParticipant = f
+	return mmIsParticipant.mock
+}
+
+// When sets expectation for the ChatRepository.IsParticipant which will trigger the result defined by the following
+// Then helper
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) When(ctx context.Context, chatID int64, email string) *ChatRepositoryMockIsParticipantExpectation {
+	if mmIsParticipant.mock.funcIsParticipant != nil {
+		mmIsParticipant.mock.t.Fatalf("ChatRepositoryMock.IsParticipant mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockIsParticipantExpectation{
+		mock:   mmIsParticipant.mock,
+		params: &ChatRepositoryMockIsParticipantParams{ctx, chatID, email},
+	}
+	mmIsParticipant.expectations = append(mmIsParticipant.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.IsParticipant return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockIsParticipantExpectation) Then(participant bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockIsParticipantResults{participant, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.IsParticipant should be invoked
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) Times(n uint64) *mChatRepositoryMockIsParticipant {
+	if n == 0 {
+		mmIsParticipant.mock.t.Fatalf("Times of ChatRepositoryMock.IsParticipant mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsParticipant.expectedInvocations, n)
+	return mmIsParticipant
+}
+
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) invocationsDone() bool {
+	if len(mmIsParticipant.expectations) == 0 && mmIsParticipant.defaultExpectation == nil && mmIsParticipant.mock.funcIsParticipant == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsParticipant.mock.afterIsParticipantCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsParticipant.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsParticipant implements repository.ChatRepository
+func (mmIsParticipant *ChatRepositoryMock) IsParticipant(ctx context.Context, chatID int64, email string) (participant bool, err error) {
+	mm_atomic.AddUint64(&mmIsParticipant.beforeIsParticipantCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsParticipant.afterIsParticipantCounter, 1)
+
+	if mmIsParticipant.inspectFuncIsParticipant != nil {
+		mmIsParticipant.inspectFuncIsParticipant(ctx, chatID, email)
+	}
+
+	mm_params := ChatRepositoryMockIsParticipantParams{ctx, chatID, email}
+
+	// Record call args
+	mmIsParticipant.IsParticipantMock.mutex.Lock()
+	mmIsParticipant.IsParticipantMock.callArgs = append(mmIsParticipant.IsParticipantMock.callArgs, &mm_params)
+	mmIsParticipant.IsParticipantMock.mutex.Unlock()
+
+	for _, e := range mmIsParticipant.IsParticipantMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.participant, e.results.err
+		}
+	}
+
+	if mmIsParticipant.IsParticipantMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsParticipant.IsParticipantMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsParticipant.IsParticipantMock.defaultExpectation.params
+		mm_want_ptrs := mmIsParticipant.IsParticipantMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockIsParticipantParams{ctx, chatID, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsParticipant.t.Errorf("ChatRepositoryMock.IsParticipant got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmIsParticipant.t.Errorf("ChatRepositoryMock.IsParticipant got unexpected parameter chatID, want: %#v, got: %#v%s\n", *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmIsParticipant.t.Errorf("ChatRepositoryMock.IsParticipant got unexpected parameter email, want: %#v, got: %#v%s\n", *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsParticipant.t.Errorf("ChatRepositoryMock.IsParticipant got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsParticipant.IsParticipantMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsParticipant.t.Fatal("No results are set for the ChatRepositoryMock.IsParticipant")
+		}
+		return (*mm_results).participant, (*mm_results).err
+	}
+	if mmIsParticipant.funcIsParticipant != nil {
+		return mmIsParticipant.funcIsParticipant(ctx, chatID, email)
+	}
+	mmIsParticipant.t.Fatalf("Unexpected call to ChatRepositoryMock.IsParticipant. %v %v %v", ctx, chatID, email)
+	return
+}
+
+// IsParticipantAfterCounter returns a count of finished ChatRepositoryMock.IsParticipant invocations
+func (mmIsParticipant *ChatRepositoryMock) IsParticipantAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsParticipant.afterIsParticipantCounter)
+}
+
+// IsParticipantBeforeCounter returns a count of ChatRepositoryMock.IsParticipant invocations
+func (mmIsParticipant *ChatRepositoryMock) IsParticipantBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsParticipant.beforeIsParticipantCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.IsParticipant.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsParticipant *mChatRepositoryMockIsParticipant) Calls() []*ChatRepositoryMockIsParticipantParams {
+	mmIsParticipant.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockIsParticipantParams, len(mmIsParticipant.callArgs))
+	copy(argCopy, mmIsParticipant.callArgs)
+
+	mmIsParticipant.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsParticipantDone returns true if the count of the IsParticipant invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockIsParticipantDone() bool {
+	if m.IsParticipantMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IsParticipantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IsParticipantMock.invocationsDone()
+}
+
+// MinimockIsParticipantInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockIsParticipantInspect() {
+	for _, e := range m.IsParticipantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsParticipant with params: %#v", *e.params)
+		}
+	}
+
+	afterIsParticipantCounter := mm_atomic.LoadUint64(&m.afterIsParticipantCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsParticipantMock.defaultExpectation != nil && afterIsParticipantCounter < 1 {
+		if m.IsParticipantMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatRepositoryMock.IsParticipant")
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsParticipant with params: %#v", *m.IsParticipantMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsParticipant != nil && afterIsParticipantCounter < 1 {
+		m.t.Error("Expected call to ChatRepositoryMock.IsParticipant")
+	}
+
+	if !m.IsParticipantMock.invocationsDone() && afterIsParticipantCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.IsParticipant but found %d calls",
+			mm_atomic.LoadUint64(&m.IsParticipantMock.expectedInvocations), afterIsParticipantCounter)
+	}
+}
+
 type mChatRepositoryMockLinkParticipantsToChat struct {
 	optional           bool
 	mock               *ChatRepositoryMock
@@ -1341,42 +1726,43 @@ func (m *ChatRepositoryMock) MinimockLinkParticipantsToChatInspect() {
 	}
 }
 
-type mChatRepositoryMockSendMessage struct {
+type mChatRepositoryMockListChats struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockSendMessageExpectation
-	expectations       []*ChatRepositoryMockSendMessageExpectation
+	defaultExpectation *ChatRepositoryMockListChatsExpectation
+	expectations       []*ChatRepositoryMockListChatsExpectation
 
-	callArgs []*ChatRepositoryMockSendMessageParams
+	callArgs []*ChatRepositoryMockListChatsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// ChatRepositoryMockSendMessageExpectation specifies expectation struct of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageExpectation struct {
+// ChatRepositoryMockListChatsExpectation specifies expectation struct of the ChatRepository.ListChats
+type ChatRepositoryMockListChatsExpectation struct {
 	mock      *ChatRepositoryMock
-	params    *ChatRepositoryMockSendMessageParams
-	paramPtrs *ChatRepositoryMockSendMessageParamPtrs
-	results   *ChatRepositoryMockSendMessageResults
+	params    *ChatRepositoryMockListChatsParams
+	paramPtrs *ChatRepositoryMockListChatsParamPtrs
+	results   *ChatRepositoryMockListChatsResults
 	Counter   uint64
 }
 
-// ChatRepositoryMockSendMessageParams contains parameters of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageParams struct {
+// ChatRepositoryMockListChatsParams contains parameters of the ChatRepository.ListChats
+type ChatRepositoryMockListChatsParams struct {
 	ctx    context.Context
-	params model.SendMessageParams
+	params model.ListChatsParams
 }
 
-// ChatRepositoryMockSendMessageParamPtrs contains pointers to parameters of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageParamPtrs struct {
+// ChatRepositoryMockListChatsParamPtrs contains pointers to parameters of the ChatRepository.ListChats
+type ChatRepositoryMockListChatsParamPtrs struct {
 	ctx    *context.Context
-	params *model.SendMessageParams
+	params *model.ListChatsParams
 }
 
-// ChatRepositoryMockSendMessageResults contains results of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageResults struct {
-	err error
+// ChatRepositoryMockListChatsResults contains results of the ChatRepository.ListChats
+type ChatRepositoryMockListChatsResults struct {
+	chats []model.UserChat
+	err   error
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1384,110 +1770,752 @@ type ChatRepositoryMockSendMessageResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSendMessage *mChatRepositoryMockSendMessage) Optional() *mChatRepositoryMockSendMessage {
-	mmSendMessage.optional = true
-	return mmSendMessage
+func (mmListChats *mChatRepositoryMockListChats) Optional() *mChatRepositoryMockListChats {
+	mmListChats.optional = true
+	return mmListChats
 }
 
-// Expect sets up expected params for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Expect(ctx context.Context, params model.SendMessageParams) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// Expect sets up expected params for ChatRepository.ListChats
+func (mmListChats *mChatRepositoryMockListChats) Expect(ctx context.Context, params model.ListChatsParams) *mChatRepositoryMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatRepositoryMockListChatsExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by ExpectParams functions")
+	if mmListChats.defaultExpectation.paramPtrs != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by ExpectParams functions")
 	}
 
-	mmSendMessage.defaultExpectation.params = &ChatRepositoryMockSendMessageParams{ctx, params}
-	for _, e := range mmSendMessage.expectations {
-		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
-			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+	mmListChats.defaultExpectation.params = &ChatRepositoryMockListChatsParams{ctx, params}
+	for _, e := range mmListChats.expectations {
+		if minimock.Equal(e.params, mmListChats.defaultExpectation.params) {
+			mmListChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChats.defaultExpectation.params)
 		}
 	}
 
-	return mmSendMessage
+	return mmListChats
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListChats
+func (mmListChats *mChatRepositoryMockListChats) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatRepositoryMockListChatsExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatRepositoryMockListChatsParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListChats.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmSendMessage
+	return mmListChats
 }
 
-// ExpectParamsParam2 sets up expected param params for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectParamsParam2(params model.SendMessageParams) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// ExpectParamsParam2 sets up expected param params for ChatRepository.ListChats
+func (mmListChats *mChatRepositoryMockListChats) ExpectParamsParam2(params model.ListChatsParams) *mChatRepositoryMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatRepositoryMockListChatsExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatRepositoryMockListChatsParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.params = &params
+	mmListChats.defaultExpectation.paramPtrs.params = &params
 
-	return mmSendMessage
+	return mmListChats
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Inspect(f func(ctx context.Context, params model.SendMessageParams)) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.inspectFuncSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SendMessage")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListChats
+func (mmListChats *mChatRepositoryMockListChats) Inspect(f func(ctx context.Context, params model.ListChatsParams)) *mChatRepositoryMockListChats {
+	if mmListChats.mock.inspectFuncListChats != nil {
+		mmListChats.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListChats")
 	}
 
-	mmSendMessage.mock.inspectFuncSendMessage = f
+	mmListChats.mock.inspectFuncListChats = f
 
-	return mmSendMessage
+	return mmListChats
 }
 
-// Return sets up results that will be returned by ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Return(err error) *ChatRepositoryMock {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// Return sets up results that will be returned by ChatRepository.ListChats
+func (mmListChats *mChatRepositoryMockListChats) Return(chats []model.UserChat, err error) *ChatRepositoryMock {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{mock: mmSendMessage.mock}
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatRepositoryMockListChatsExpectation{mock: mmListChats.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatRepositoryMockSendMessageResults{err}
-	return mmSendMessage.mock
+	mmListChats.defaultExpectation.results = &ChatRepositoryMockListChatsResults{chats, err}
+	return mmListChats.mock
 }
 
-// Set uses given function f to mock the ChatRepository.SendMessage method
-func (mmSendMessage *mChatRepositoryMockSendMessage) Set(f func(ctx context.Context, params model.SendMessageParams) (err error)) *ChatRepositoryMock {
-	if mmSendMessage.defaultExpectation != nil {
-		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SendMessage method")
+// Set uses given function f to mock the ChatRepository.ListChats method
+func (mmListChats *mChatRepositoryMockListChats) Set(f func(ctx context.Context, params model.ListChatsParams) (chats []model.UserChat, err error)) *ChatRepositoryMock {
+	if mmListChats.defaultExpectation != nil {
+		mmListChats.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListChats method")
 	}
 
-	if len(mmSendMessage.expectations) > 0 {
+	if len(mmListChats.expectations) > 0 {
+		mmListChats.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListChats method")
+	}
+
+	mmListChats.mock.funcListChats = f
+	return mmListChats.mock
+}
+
+// When sets expectation for the ChatRepository.ListChats which will trigger the result defined by the following
+// Then helper
+func (mmListChats *mChatRepositoryMockListChats) When(ctx context.Context, params model.ListChatsParams) *ChatRepositoryMockListChatsExpectation {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListChatsExpectation{
+		mock:   mmListChats.mock,
+		params: &ChatRepositoryMockListChatsParams{ctx, params},
+	}
+	mmListChats.expectations = append(mmListChats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListChats return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListChatsExpectation) Then(chats []model.UserChat, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListChatsResults{chats, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListChats should be invoked
+func (mmListChats *mChatRepositoryMockListChats) Times(n uint64) *mChatRepositoryMockListChats {
+	if n == 0 {
+		mmListChats.mock.t.Fatalf("Times of ChatRepositoryMock.ListChats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListChats.expectedInvocations, n)
+	return mmListChats
+}
+
+func (mmListChats *mChatRepositoryMockListChats) invocationsDone() bool {
+	if len(mmListChats.expectations) == 0 && mmListChats.defaultExpectation == nil && mmListChats.mock.funcListChats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListChats.mock.afterListChatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListChats implements repository.ChatRepository
+func (mmListChats *ChatRepositoryMock) ListChats(ctx context.Context, params model.ListChatsParams) (chats []model.UserChat, err error) {
+	mm_atomic.AddUint64(&mmListChats.beforeListChatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChats.afterListChatsCounter, 1)
+
+	if mmListChats.inspectFuncListChats != nil {
+		mmListChats.inspectFuncListChats(ctx, params)
+	}
+
+	mm_params := ChatRepositoryMockListChatsParams{ctx, params}
+
+	// Record call args
+	mmListChats.ListChatsMock.mutex.Lock()
+	mmListChats.ListChatsMock.callArgs = append(mmListChats.ListChatsMock.callArgs, &mm_params)
+	mmListChats.ListChatsMock.mutex.Unlock()
+
+	for _, e := range mmListChats.ListChatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.chats, e.results.err
+		}
+	}
+
+	if mmListChats.ListChatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChats.ListChatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChats.ListChatsMock.defaultExpectation.params
+		mm_want_ptrs := mmListChats.ListChatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListChatsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListChats.t.Errorf("ChatRepositoryMock.ListChats got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListChats.t.Errorf("ChatRepositoryMock.ListChats got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListChats.t.Errorf("ChatRepositoryMock.ListChats got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListChats.ListChatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListChats.t.Fatal("No results are set for the ChatRepositoryMock.ListChats")
+		}
+		return (*mm_results).chats, (*mm_results).err
+	}
+	if mmListChats.funcListChats != nil {
+		return mmListChats.funcListChats(ctx, params)
+	}
+	mmListChats.t.Fatalf("Unexpected call to ChatRepositoryMock.ListChats. %v %v", ctx, params)
+	return
+}
+
+// ListChatsAfterCounter returns a count of finished ChatRepositoryMock.ListChats invocations
+func (mmListChats *ChatRepositoryMock) ListChatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.afterListChatsCounter)
+}
+
+// ListChatsBeforeCounter returns a count of ChatRepositoryMock.ListChats invocations
+func (mmListChats *ChatRepositoryMock) ListChatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.beforeListChatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListChats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListChats *mChatRepositoryMockListChats) Calls() []*ChatRepositoryMockListChatsParams {
+	mmListChats.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListChatsParams, len(mmListChats.callArgs))
+	copy(argCopy, mmListChats.callArgs)
+
+	mmListChats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListChatsDone returns true if the count of the ListChats invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListChatsDone() bool {
+	if m.ListChatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListChatsMock.invocationsDone()
+}
+
+// MinimockListChatsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListChatsInspect() {
+	for _, e := range m.ListChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChats with params: %#v", *e.params)
+		}
+	}
+
+	afterListChatsCounter := mm_atomic.LoadUint64(&m.afterListChatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListChatsMock.defaultExpectation != nil && afterListChatsCounter < 1 {
+		if m.ListChatsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatRepositoryMock.ListChats")
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChats with params: %#v", *m.ListChatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListChats != nil && afterListChatsCounter < 1 {
+		m.t.Error("Expected call to ChatRepositoryMock.ListChats")
+	}
+
+	if !m.ListChatsMock.invocationsDone() && afterListChatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListChats but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChatsMock.expectedInvocations), afterListChatsCounter)
+	}
+}
+
+type mChatRepositoryMockListMessages struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListMessagesExpectation
+	expectations       []*ChatRepositoryMockListMessagesExpectation
+
+	callArgs []*ChatRepositoryMockListMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatRepositoryMockListMessagesExpectation specifies expectation struct of the ChatRepository.ListMessages
+type ChatRepositoryMockListMessagesExpectation struct {
+	mock      *ChatRepositoryMock
+	params    *ChatRepositoryMockListMessagesParams
+	paramPtrs *ChatRepositoryMockListMessagesParamPtrs
+	results   *ChatRepositoryMockListMessagesResults
+	Counter   uint64
+}
+
+// ChatRepositoryMockListMessagesParams contains parameters of the ChatRepository.ListMessages
+type ChatRepositoryMockListMessagesParams struct {
+	ctx    context.Context
+	params model.ListMessagesParams
+}
+
+// ChatRepositoryMockListMessagesParamPtrs contains pointers to parameters of the ChatRepository.ListMessages
+type ChatRepositoryMockListMessagesParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListMessagesParams
+}
+
+// ChatRepositoryMockListMessagesResults contains results of the ChatRepository.ListMessages
+type ChatRepositoryMockListMessagesResults struct {
+	messages []model.Message
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessages *mChatRepositoryMockListMessages) Optional() *mChatRepositoryMockListMessages {
+	mmListMessages.optional = true
+	return mmListMessages
+}
+
+// Expect sets up expected params for ChatRepository.ListMessages
+func (mmListMessages *mChatRepositoryMockListMessages) Expect(ctx context.Context, params model.ListMessagesParams) *mChatRepositoryMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatRepositoryMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListMessages.defaultExpectation.params = &ChatRepositoryMockListMessagesParams{ctx, params}
+	for _, e := range mmListMessages.expectations {
+		if minimock.Equal(e.params, mmListMessages.defaultExpectation.params) {
+			mmListMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListMessages
+func (mmListMessages *mChatRepositoryMockListMessages) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatRepositoryMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListMessages
+}
+
+// ExpectParamsParam2 sets up expected param params for ChatRepository.ListMessages
+func (mmListMessages *mChatRepositoryMockListMessages) ExpectParamsParam2(params model.ListMessagesParams) *mChatRepositoryMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatRepositoryMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatRepositoryMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.params = &params
+
+	return mmListMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListMessages
+func (mmListMessages *mChatRepositoryMockListMessages) Inspect(f func(ctx context.Context, params model.ListMessagesParams)) *mChatRepositoryMockListMessages {
+	if mmListMessages.mock.inspectFuncListMessages != nil {
+		mmListMessages.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListMessages")
+	}
+
+	mmListMessages.mock.inspectFuncListMessages = f
+
+	return mmListMessages
+}
+
+// Return sets up results that will be returned by ChatRepository.ListMessages
+func (mmListMessages *mChatRepositoryMockListMessages) Return(messages []model.Message, err error) *ChatRepositoryMock {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatRepositoryMockListMessagesExpectation{mock: mmListMessages.mock}
+	}
+	mmListMessages.defaultExpectation.results = &ChatRepositoryMockListMessagesResults{messages, err}
+	return mmListMessages.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListMessages method
+func (mmListMessages *mChatRepositoryMockListMessages) Set(f func(ctx context.Context, params model.ListMessagesParams) (messages []model.Message, err error)) *ChatRepositoryMock {
+	if mmListMessages.defaultExpectation != nil {
+		mmListMessages.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListMessages method")
+	}
+
+	if len(mmListMessages.expectations) > 0 {
+		mmListMessages.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListMessages method")
+	}
+
+	mmListMessages.mock.funcListMessages = f
+	return mmListMessages.mock
+}
+
+// When sets expectation for the ChatRepository.ListMessages which will trigger the result defined by the following
+// Then helper
+func (mmListMessages *mChatRepositoryMockListMessages) When(ctx context.Context, params model.ListMessagesParams) *ChatRepositoryMockListMessagesExpectation {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListMessagesExpectation{
+		mock:   mmListMessages.mock,
+		params: &ChatRepositoryMockListMessagesParams{ctx, params},
+	}
+	mmListMessages.expectations = append(mmListMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListMessages return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListMessagesExpectation) Then(messages []model.Message, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListMessagesResults{messages, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListMessages should be invoked
+func (mmListMessages *mChatRepositoryMockListMessages) Times(n uint64) *mChatRepositoryMockListMessages {
+	if n == 0 {
+		mmListMessages.mock.t.Fatalf("Times of ChatRepositoryMock.ListMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessages.expectedInvocations, n)
+	return mmListMessages
+}
+
+func (mmListMessages *mChatRepositoryMockListMessages) invocationsDone() bool {
+	if len(mmListMessages.expectations) == 0 && mmListMessages.defaultExpectation == nil && mmListMessages.mock.funcListMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessages.mock.afterListMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessages implements repository.ChatRepository
+func (mmListMessages *ChatRepositoryMock) ListMessages(ctx context.Context, params model.ListMessagesParams) (messages []model.Message, err error) {
+	mm_atomic.AddUint64(&mmListMessages.beforeListMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessages.afterListMessagesCounter, 1)
+
+	if mmListMessages.inspectFuncListMessages != nil {
+		mmListMessages.inspectFuncListMessages(ctx, params)
+	}
+
+	mm_params := ChatRepositoryMockListMessagesParams{ctx, params}
+
+	// Record call args
+	mmListMessages.ListMessagesMock.mutex.Lock()
+	mmListMessages.ListMessagesMock.callArgs = append(mmListMessages.ListMessagesMock.callArgs, &mm_params)
+	mmListMessages.ListMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListMessages.ListMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.messages, e.results.err
+		}
+	}
+
+	if mmListMessages.ListMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessages.ListMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessages.ListMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessages.ListMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListMessagesParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessages.t.Errorf("ChatRepositoryMock.ListMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListMessages.t.Errorf("ChatRepositoryMock.ListMessages got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessages.t.Errorf("ChatRepositoryMock.ListMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessages.ListMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessages.t.Fatal("No results are set for the ChatRepositoryMock.ListMessages")
+		}
+		return (*mm_results).messages, (*mm_results).err
+	}
+	if mmListMessages.funcListMessages != nil {
+		return mmListMessages.funcListMessages(ctx, params)
+	}
+	mmListMessages.t.Fatalf("Unexpected call to ChatRepositoryMock.ListMessages. %v %v", ctx, params)
+	return
+}
+
+// ListMessagesAfterCounter returns a count of finished ChatRepositoryMock.ListMessages invocations
+func (mmListMessages *ChatRepositoryMock) ListMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.afterListMessagesCounter)
+}
+
+// ListMessagesBeforeCounter returns a count of ChatRepositoryMock.ListMessages invocations
+func (mmListMessages *ChatRepositoryMock) ListMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.beforeListMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessages *mChatRepositoryMockListMessages) Calls() []*ChatRepositoryMockListMessagesParams {
+	mmListMessages.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListMessagesParams, len(mmListMessages.callArgs))
+	copy(argCopy, mmListMessages.callArgs)
+
+	mmListMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessagesDone returns true if the count of the ListMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListMessagesDone() bool {
+	if m.ListMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessagesMock.invocationsDone()
+}
+
+// MinimockListMessagesInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListMessagesInspect() {
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterListMessagesCounter := mm_atomic.LoadUint64(&m.afterListMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessagesMock.defaultExpectation != nil && afterListMessagesCounter < 1 {
+		if m.ListMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatRepositoryMock.ListMessages")
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListMessages with params: %#v", *m.ListMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessages != nil && afterListMessagesCounter < 1 {
+		m.t.Error("Expected call to ChatRepositoryMock.ListMessages")
+	}
+
+	if !m.ListMessagesMock.invocationsDone() && afterListMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessagesMock.expectedInvocations), afterListMessagesCounter)
+	}
+}
+
+type mChatRepositoryMockSendMessage struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockSendMessageExpectation
+	expectations       []*ChatRepositoryMockSendMessageExpectation
+
+	callArgs []*ChatRepositoryMockSendMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatRepositoryMockSendMessageExpectation specifies expectation struct of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageExpectation struct {
+	mock      *ChatRepositoryMock
+	params    *ChatRepositoryMockSendMessageParams
+	paramPtrs *ChatRepositoryMockSendMessageParamPtrs
+	results   *ChatRepositoryMockSendMessageResults
+	Counter   uint64
+}
+
+// ChatRepositoryMockSendMessageParams contains parameters of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageParams struct {
+	ctx    context.Context
+	params model.SendMessageParams
+}
+
+// ChatRepositoryMockSendMessageParamPtrs contains pointers to parameters of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.SendMessageParams
+}
+
+// ChatRepositoryMockSendMessageResults contains results of the ChatRepository.SendMessage
+type ChatRepositoryMockSendMessageResults struct {
+	resp model.SendMessageResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendMessage *mChatRepositoryMockSendMessage) Optional() *mChatRepositoryMockSendMessage {
+	mmSendMessage.optional = true
+	return mmSendMessage
+}
+
+// Expect sets up expected params for ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) Expect(ctx context.Context, params model.SendMessageParams) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendMessage.defaultExpectation.params = &ChatRepositoryMockSendMessageParams{ctx, params}
+	for _, e := range mmSendMessage.expectations {
+		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
+			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSendMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectParamsParam2(params model.SendMessageParams) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmSendMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) Inspect(f func(ctx context.Context, params model.SendMessageParams)) *mChatRepositoryMockSendMessage {
+	if mmSendMessage.mock.inspectFuncSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SendMessage")
+	}
+
+	mmSendMessage.mock.inspectFuncSendMessage = f
+
+	return mmSendMessage
+}
+
+// Return sets up results that will be returned by ChatRepository.SendMessage
+func (mmSendMessage *mChatRepositoryMockSendMessage) Return(resp model.SendMessageResponse, err error) *ChatRepositoryMock {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{mock: mmSendMessage.mock}
+	}
+	mmSendMessage.defaultExpectation.results = &ChatRepositoryMockSendMessageResults{resp, err}
+	return mmSendMessage.mock
+}
+
+// Set uses given function f to mock the ChatRepository.SendMessage method
+func (mmSendMessage *mChatRepositoryMockSendMessage) Set(f func(ctx context.Context, params model.SendMessageParams) (resp model.SendMessageResponse, err error)) *ChatRepositoryMock {
+	if mmSendMessage.defaultExpectation != nil {
+		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SendMessage method")
+	}
+
+	if len(mmSendMessage.expectations) > 0 {
 		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SendMessage method")
 	}
 
@@ -1511,8 +2539,8 @@ func (mmSendMessage *mChatRepositoryMockSendMessage) When(ctx context.Context, p
 }
 
 // Then sets up ChatRepository.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockSendMessageExpectation) Then(err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockSendMessageResults{err}
+func (e *ChatRepositoryMockSendMessageExpectation) Then(resp model.SendMessageResponse, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSendMessageResults{resp, err}
 	return e.mock
 }
 
@@ -1537,7 +2565,7 @@ func (mmSendMessage *mChatRepositoryMockSendMessage) invocationsDone() bool {
 }
 
 // SendMessage implements repository.ChatRepository
-func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, params model.SendMessageParams) (err error) {
+func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, params model.SendMessageParams) (resp model.SendMessageResponse, err error) {
 	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
 	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
 
@@ -1555,7 +2583,7 @@ func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, params
 	for _, e := range mmSendMessage.SendMessageMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.resp, e.results.err
 		}
 	}
 
@@ -1584,7 +2612,7 @@ func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, params
 		if mm_results == nil {
 			mmSendMessage.t.Fatal("No results are set for the ChatRepositoryMock.SendMessage")
 		}
-		return (*mm_results).err
+		return (*mm_results).resp, (*mm_results).err
 	}
 	if mmSendMessage.funcSendMessage != nil {
 		return mmSendMessage.funcSendMessage(ctx, params)
@@ -1981,6 +3009,327 @@ func (m *ChatRepositoryMock) MinimockUnlinkParticipantsFromChatInspect() {
 	}
 }
 
+type mChatRepositoryMockUpdateChatSettings struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockUpdateChatSettingsExpectation
+	expectations       []*ChatRepositoryMockUpdateChatSettingsExpectation
+
+	callArgs []*ChatRepositoryMockUpdateChatSettingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatRepositoryMockUpdateChatSettingsExpectation specifies expectation struct of the ChatRepository.UpdateChatSettings
+type ChatRepositoryMockUpdateChatSettingsExpectation struct {
+	mock      *ChatRepositoryMock
+	params    *ChatRepositoryMockUpdateChatSettingsParams
+	paramPtrs *ChatRepositoryMockUpdateChatSettingsParamPtrs
+	results   *ChatRepositoryMockUpdateChatSettingsResults
+	Counter   uint64
+}
+
+// ChatRepositoryMockUpdateChatSettingsParams contains parameters of the ChatRepository.UpdateChatSettings
+type ChatRepositoryMockUpdateChatSettingsParams struct {
+	ctx    context.Context
+	params model.UpdateChatSettingsParams
+}
+
+// ChatRepositoryMockUpdateChatSettingsParamPtrs contains pointers to parameters of the ChatRepository.UpdateChatSettings
+type ChatRepositoryMockUpdateChatSettingsParamPtrs struct {
+	ctx    *context.Context
+	params *model.UpdateChatSettingsParams
+}
+
+// ChatRepositoryMockUpdateChatSettingsResults contains results of the ChatRepository.UpdateChatSettings
+type ChatRepositoryMockUpdateChatSettingsResults struct {
+	settings model.ChatSettings
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) Optional() *mChatRepositoryMockUpdateChatSettings {
+	mmUpdateChatSettings.optional = true
+	return mmUpdateChatSettings
+}
+
+// Expect sets up expected params for ChatRepository.UpdateChatSettings
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) Expect(ctx context.Context, params model.UpdateChatSettingsParams) *mChatRepositoryMockUpdateChatSettings {
+	if mmUpdateChatSettings.mock.funcUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatRepositoryMock.UpdateChatSettings mock is already set by Set")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation == nil {
+		mmUpdateChatSettings.defaultExpectation = &ChatRepositoryMockUpdateChatSettingsExpectation{}
+	}
+
+	if mmUpdateChatSettings.defaultExpectation.paramPtrs != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatRepositoryMock.UpdateChatSettings mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateChatSettings.defaultExpectation.params = &ChatRepositoryMockUpdateChatSettingsParams{ctx, params}
+	for _, e := range mmUpdateChatSettings.expectations {
+		if minimock.Equal(e.params, mmUpdateChatSettings.defaultExpectation.params) {
+			mmUpdateChatSettings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateChatSettings.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateChatSettings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.UpdateChatSettings
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockUpdateChatSettings {
+	if mmUpdateChatSettings.mock.funcUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatRepositoryMock.UpdateChatSettings mock is already set by Set")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation == nil {
+		mmUpdateChatSettings.defaultExpectation = &ChatRepositoryMockUpdateChatSettingsExpectation{}
+	}
+
+	if mmUpdateChatSettings.defaultExpectation.params != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatRepositoryMock.UpdateChatSettings mock is already set by Expect")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation.paramPtrs == nil {
+		mmUpdateChatSettings.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateChatSettingsParamPtrs{}
+	}
+	mmUpdateChatSettings.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateChatSettings
+}
+
+// ExpectParamsParam2 sets up expected param params for ChatRepository.UpdateChatSettings
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) ExpectParamsParam2(params model.UpdateChatSettingsParams) *mChatRepositoryMockUpdateChatSettings {
+	if mmUpdateChatSettings.mock.funcUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatRepositoryMock.UpdateChatSettings mock is already set by Set")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation == nil {
+		mmUpdateChatSettings.defaultExpectation = &ChatRepositoryMockUpdateChatSettingsExpectation{}
+	}
+
+	if mmUpdateChatSettings.defaultExpectation.params != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatRepositoryMock.UpdateChatSettings mock is already set by Expect")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation.paramPtrs == nil {
+		mmUpdateChatSettings.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateChatSettingsParamPtrs{}
+	}
+	mmUpdateChatSettings.defaultExpectation.paramPtrs.params = &params
+
+	return mmUpdateChatSettings
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.UpdateChatSettings
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) Inspect(f func(ctx context.Context, params model.UpdateChatSettingsParams)) *mChatRepositoryMockUpdateChatSettings {
+	if mmUpdateChatSettings.mock.inspectFuncUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.UpdateChatSettings")
+	}
+
+	mmUpdateChatSettings.mock.inspectFuncUpdateChatSettings = f
+
+	return mmUpdateChatSettings
+}
+
+// Return sets up results that will be returned by ChatRepository.UpdateChatSettings
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) Return(settings model.ChatSettings, err error) *ChatRepositoryMock {
+	if mmUpdateChatSettings.mock.funcUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatRepositoryMock.UpdateChatSettings mock is already set by Set")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation == nil {
+		mmUpdateChatSettings.defaultExpectation = &ChatRepositoryMockUpdateChatSettingsExpectation{mock: mmUpdateChatSettings.mock}
+	}
+	mmUpdateChatSettings.defaultExpectation.results = &ChatRepositoryMockUpdateChatSettingsResults{settings, err}
+	return mmUpdateChatSettings.mock
+}
+
+// Set uses given function f to mock the ChatRepository.UpdateChatSettings method
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) Set(f func(ctx context.Context, params model.UpdateChatSettingsParams) (settings model.ChatSettings, err error)) *ChatRepositoryMock {
+	if mmUpdateChatSettings.defaultExpectation != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("Default expectation is already set for the ChatRepository.UpdateChatSettings method")
+	}
+
+	if len(mmUpdateChatSettings.expectations) > 0 {
+		mmUpdateChatSettings.mock.t.Fatalf("Some expectations are already set for the ChatRepository.UpdateChatSettings method")
+	}
+
+	mmUpdateChatSettings.mock.funcUpdateChatSettings = f
+	return mmUpdateChatSettings.mock
+}
+
+// When sets expectation for the ChatRepository.UpdateChatSettings which will trigger the result defined by the following
+// Then helper
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) When(ctx context.Context, params model.UpdateChatSettingsParams) *ChatRepositoryMockUpdateChatSettingsExpectation {
+	if mmUpdateChatSettings.mock.funcUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatRepositoryMock.UpdateChatSettings mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockUpdateChatSettingsExpectation{
+		mock:   mmUpdateChatSettings.mock,
+		params: &ChatRepositoryMockUpdateChatSettingsParams{ctx, params},
+	}
+	mmUpdateChatSettings.expectations = append(mmUpdateChatSettings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.UpdateChatSettings return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockUpdateChatSettingsExpectation) Then(settings model.ChatSettings, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockUpdateChatSettingsResults{settings, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.UpdateChatSettings should be invoked
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) Times(n uint64) *mChatRepositoryMockUpdateChatSettings {
+	if n == 0 {
+		mmUpdateChatSettings.mock.t.Fatalf("Times of ChatRepositoryMock.UpdateChatSettings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateChatSettings.expectedInvocations, n)
+	return mmUpdateChatSettings
+}
+
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) invocationsDone() bool {
+	if len(mmUpdateChatSettings.expectations) == 0 && mmUpdateChatSettings.defaultExpectation == nil && mmUpdateChatSettings.mock.funcUpdateChatSettings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateChatSettings.mock.afterUpdateChatSettingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateChatSettings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateChatSettings implements repository.ChatRepository
+func (mmUpdateChatSettings *ChatRepositoryMock) UpdateChatSettings(ctx context.Context, params model.UpdateChatSettingsParams) (settings model.ChatSettings, err error) {
+	mm_atomic.AddUint64(&mmUpdateChatSettings.beforeUpdateChatSettingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateChatSettings.afterUpdateChatSettingsCounter, 1)
+
+	if mmUpdateChatSettings.inspectFuncUpdateChatSettings != nil {
+		mmUpdateChatSettings.inspectFuncUpdateChatSettings(ctx, params)
+	}
+
+	mm_params := ChatRepositoryMockUpdateChatSettingsParams{ctx, params}
+
+	// Record call args
+	mmUpdateChatSettings.UpdateChatSettingsMock.mutex.Lock()
+	mmUpdateChatSettings.UpdateChatSettingsMock.callArgs = append(mmUpdateChatSettings.UpdateChatSettingsMock.callArgs, &mm_params)
+	mmUpdateChatSettings.UpdateChatSettingsMock.mutex.Unlock()
+
+	for _, e := range mmUpdateChatSettings.UpdateChatSettingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.settings, e.results.err
+		}
+	}
+
+	if mmUpdateChatSettings.UpdateChatSettingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateChatSettings.UpdateChatSettingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateChatSettings.UpdateChatSettingsMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateChatSettings.UpdateChatSettingsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockUpdateChatSettingsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateChatSettings.t.Errorf("ChatRepositoryMock.UpdateChatSettings got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUpdateChatSettings.t.Errorf("ChatRepositoryMock.UpdateChatSettings got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateChatSettings.t.Errorf("ChatRepositoryMock.UpdateChatSettings got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateChatSettings.UpdateChatSettingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateChatSettings.t.Fatal("No results are set for the ChatRepositoryMock.UpdateChatSettings")
+		}
+		return (*mm_results).settings, (*mm_results).err
+	}
+	if mmUpdateChatSettings.funcUpdateChatSettings != nil {
+		return mmUpdateChatSettings.funcUpdateChatSettings(ctx, params)
+	}
+	mmUpdateChatSettings.t.Fatalf("Unexpected call to ChatRepositoryMock.UpdateChatSettings. %v %v", ctx, params)
+	return
+}
+
+// UpdateChatSettingsAfterCounter returns a count of finished ChatRepositoryMock.UpdateChatSettings invocations
+func (mmUpdateChatSettings *ChatRepositoryMock) UpdateChatSettingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateChatSettings.afterUpdateChatSettingsCounter)
+}
+
+// UpdateChatSettingsBeforeCounter returns a count of ChatRepositoryMock.UpdateChatSettings invocations
+func (mmUpdateChatSettings *ChatRepositoryMock) UpdateChatSettingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateChatSettings.beforeUpdateChatSettingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.UpdateChatSettings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateChatSettings *mChatRepositoryMockUpdateChatSettings) Calls() []*ChatRepositoryMockUpdateChatSettingsParams {
+	mmUpdateChatSettings.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockUpdateChatSettingsParams, len(mmUpdateChatSettings.callArgs))
+	copy(argCopy, mmUpdateChatSettings.callArgs)
+
+	mmUpdateChatSettings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateChatSettingsDone returns true if the count of the UpdateChatSettings invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockUpdateChatSettingsDone() bool {
+	if m.UpdateChatSettingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateChatSettingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateChatSettingsMock.invocationsDone()
+}
+
+// MinimockUpdateChatSettingsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockUpdateChatSettingsInspect() {
+	for _, e := range m.UpdateChatSettingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateChatSettings with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateChatSettingsCounter := mm_atomic.LoadUint64(&m.afterUpdateChatSettingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateChatSettingsMock.defaultExpectation != nil && afterUpdateChatSettingsCounter < 1 {
+		if m.UpdateChatSettingsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatRepositoryMock.UpdateChatSettings")
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateChatSettings with params: %#v", *m.UpdateChatSettingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateChatSettings != nil && afterUpdateChatSettingsCounter < 1 {
+		m.t.Error("Expected call to ChatRepositoryMock.UpdateChatSettings")
+	}
+
+	if !m.UpdateChatSettingsMock.invocationsDone() && afterUpdateChatSettingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.UpdateChatSettings but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateChatSettingsMock.expectedInvocations), afterUpdateChatSettingsCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *ChatRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
@@ -1991,11 +3340,19 @@ func (m *ChatRepositoryMock) MinimockFinish() {
 
 			m.MinimockDeleteChatInspect()
 
+			m.MinimockIsParticipantInspect()
+
 			m.MinimockLinkParticipantsToChatInspect()
 
+			m.MinimockListChatsInspect()
+
+			m.MinimockListMessagesInspect()
+
 			m.MinimockSendMessageInspect()
 
 			m.MinimockUnlinkParticipantsFromChatInspect()
+
+			m.MinimockUpdateChatSettingsInspect()
 		}
 	})
 }
@@ -2022,7 +3379,11 @@ func (m *ChatRepositoryMock) minimockDone() bool {
 		m.MinimockCreateChatDone() &&
 		m.MinimockCreateUsersForChatDone() &&
 		m.MinimockDeleteChatDone() &&
+		m.MinimockIsParticipantDone() &&
 		m.MinimockLinkParticipantsToChatDone() &&
+		m.MinimockListChatsDone() &&
+		m.MinimockListMessagesDone() &&
 		m.MinimockSendMessageDone() &&
-		m.MinimockUnlinkParticipantsFromChatDone()
+		m.MinimockUnlinkParticipantsFromChatDone() &&
+		m.MinimockUpdateChatSettingsDone()
 }
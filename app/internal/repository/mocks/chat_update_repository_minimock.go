@@ -0,0 +1,394 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.ChatUpdateRepository -o chat_update_repository_minimock.go -n ChatUpdateRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ChatUpdateRepositoryMock implements repository.ChatUpdateRepository
+type ChatUpdateRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcNotifyChatUpdate          func(ctx context.Context, params model.CreateChatUpdateParams) (err error)
+	inspectFuncNotifyChatUpdate   func(ctx context.Context, params model.CreateChatUpdateParams)
+	afterNotifyChatUpdateCounter  uint64
+	beforeNotifyChatUpdateCounter uint64
+	NotifyChatUpdateMock          mChatUpdateRepositoryMockNotifyChatUpdate
+}
+
+// NewChatUpdateRepositoryMock returns a mock for repository.ChatUpdateRepository
+func NewChatUpdateRepositoryMock(t minimock.Tester) *ChatUpdateRepositoryMock {
+	m := &ChatUpdateRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.NotifyChatUpdateMock = mChatUpdateRepositoryMockNotifyChatUpdate{mock: m}
+	m.NotifyChatUpdateMock.callArgs = []*ChatUpdateRepositoryMockNotifyChatUpdateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mChatUpdateRepositoryMockNotifyChatUpdate struct {
+	optional           bool
+	mock               *ChatUpdateRepositoryMock
+	defaultExpectation *ChatUpdateRepositoryMockNotifyChatUpdateExpectation
+	expectations       []*ChatUpdateRepositoryMockNotifyChatUpdateExpectation
+
+	callArgs []*ChatUpdateRepositoryMockNotifyChatUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatUpdateRepositoryMockNotifyChatUpdateExpectation specifies expectation struct of the ChatUpdateRepository.NotifyChatUpdate
+type ChatUpdateRepositoryMockNotifyChatUpdateExpectation struct {
+	mock      *ChatUpdateRepositoryMock
+	params    *ChatUpdateRepositoryMockNotifyChatUpdateParams
+	paramPtrs *ChatUpdateRepositoryMockNotifyChatUpdateParamPtrs
+	results   *ChatUpdateRepositoryMockNotifyChatUpdateResults
+	Counter   uint64
+}
+
+// ChatUpdateRepositoryMockNotifyChatUpdateParams contains parameters of the ChatUpdateRepository.NotifyChatUpdate
+type ChatUpdateRepositoryMockNotifyChatUpdateParams struct {
+	ctx    context.Context
+	params model.CreateChatUpdateParams
+}
+
+// ChatUpdateRepositoryMockNotifyChatUpdateParamPtrs contains pointers to parameters of the ChatUpdateRepository.NotifyChatUpdate
+type ChatUpdateRepositoryMockNotifyChatUpdateParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateChatUpdateParams
+}
+
+// ChatUpdateRepositoryMockNotifyChatUpdateResults contains results of the ChatUpdateRepository.NotifyChatUpdate
+type ChatUpdateRepositoryMockNotifyChatUpdateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) Optional() *mChatUpdateRepositoryMockNotifyChatUpdate {
+	mmNotifyChatUpdate.optional = true
+	return mmNotifyChatUpdate
+}
+
+// Expect sets up expected params for ChatUpdateRepository.NotifyChatUpdate
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) Expect(ctx context.Context, params model.CreateChatUpdateParams) *mChatUpdateRepositoryMockNotifyChatUpdate {
+	if mmNotifyChatUpdate.mock.funcNotifyChatUpdate != nil {
+		mmNotifyChatUpdate.mock.t.Fatalf("ChatUpdateRepositoryMock.NotifyChatUpdate mock is already set by Set")
+	}
+
+	if mmNotifyChatUpdate.defaultExpectation == nil {
+		mmNotifyChatUpdate.defaultExpectation = &ChatUpdateRepositoryMockNotifyChatUpdateExpectation{}
+	}
+
+	if mmNotifyChatUpdate.defaultExpectation.paramPtrs != nil {
+		mmNotifyChatUpdate.mock.t.Fatalf("ChatUpdateRepositoryMock.NotifyChatUpdate mock is already set by ExpectParams functions")
+	}
+
+	mmNotifyChatUpdate.defaultExpectation.params = &ChatUpdateRepositoryMockNotifyChatUpdateParams{ctx, params}
+	for _, e := range mmNotifyChatUpdate.expectations {
+		if minimock.Equal(e.params, mmNotifyChatUpdate.defaultExpectation.params) {
+			mmNotifyChatUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNotifyChatUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmNotifyChatUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatUpdateRepository.NotifyChatUpdate
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) ExpectCtxParam1(ctx context.Context) *mChatUpdateRepositoryMockNotifyChatUpdate {
+	if mmNotifyChatUpdate.mock.funcNotifyChatUpdate != nil {
+		mmNotifyChatUpdate.mock.t.Fatalf("ChatUpdateRepositoryMock.NotifyChatUpdate mock is already set by Set")
+	}
+
+	if mmNotifyChatUpdate.defaultExpectation == nil {
+		mmNotifyChatUpdate.defaultExpectation = &ChatUpdateRepositoryMockNotifyChatUpdateExpectation{}
+	}
+
+	if mmNotifyChatUpdate.defaultExpectation.params != nil {
+		mmNotifyChatUpdate.mock.t.Fatalf("ChatUpdateRepositoryMock.NotifyChatUpdate mock is already set by Expect")
+	}
+
+	if mmNotifyChatUpdate.defaultExpectation.paramPtrs == nil {
+		mmNotifyChatUpdate.defaultExpectation.paramPtrs = &ChatUpdateRepositoryMockNotifyChatUpdateParamPtrs{}
+	}
+	mmNotifyChatUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmNotifyChatUpdate
+}
+
+// ExpectParamsParam2 sets up expected param params for ChatUpdateRepository.NotifyChatUpdate
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) ExpectParamsParam2(params model.CreateChatUpdateParams) *mChatUpdateRepositoryMockNotifyChatUpdate {
+	if mmNotifyChatUpdate.mock.funcNotifyChatUpdate != nil {
+		mmNotifyChatUpdate.mock.t.Fatalf("ChatUpdateRepositoryMock.NotifyChatUpdate mock is already set by Set")
+	}
+
+	if mmNotifyChatUpdate.defaultExpectation == nil {
+		mmNotifyChatUpdate.defaultExpectation = &ChatUpdateRepositoryMockNotifyChatUpdateExpectation{}
+	}
+
+	if mmNotifyChatUpdate.defaultExpectation.params != nil {
+		mmNotifyChatUpdate.mock.t.Fatalf("ChatUpdateRepositoryMock.NotifyChatUpdate mock is already set by Expect")
+	}
+
+	if mmNotifyChatUpdate.defaultExpectation.paramPtrs == nil {
+		mmNotifyChatUpdate.defaultExpectation.paramPtrs = &ChatUpdateRepositoryMockNotifyChatUpdateParamPtrs{}
+	}
+	mmNotifyChatUpdate.defaultExpectation.paramPtrs.params = &params
+
+	return mmNotifyChatUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatUpdateRepository.NotifyChatUpdate
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) Inspect(f func(ctx context.Context, params model.CreateChatUpdateParams)) *mChatUpdateRepositoryMockNotifyChatUpdate {
+	if mmNotifyChatUpdate.mock.inspectFuncNotifyChatUpdate != nil {
+		mmNotifyChatUpdate.mock.t.Fatalf("Inspect function is already set for ChatUpdateRepositoryMock.NotifyChatUpdate")
+	}
+
+	mmNotifyChatUpdate.mock.inspectFuncNotifyChatUpdate = f
+
+	return mmNotifyChatUpdate
+}
+
+// Return sets up results that will be returned by ChatUpdateRepository.NotifyChatUpdate
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) Return(err error) *ChatUpdateRepositoryMock {
+	if mmNotifyChatUpdate.mock.funcNotifyChatUpdate != nil {
+		mmNotifyChatUpdate.mock.t.Fatalf("ChatUpdateRepositoryMock.NotifyChatUpdate mock is already set by Set")
+	}
+
+	if mmNotifyChatUpdate.defaultExpectation == nil {
+		mmNotifyChatUpdate.defaultExpectation = &ChatUpdateRepositoryMockNotifyChatUpdateExpectation{mock: mmNotifyChatUpdate.mock}
+	}
+	mmNotifyChatUpdate.defaultExpectation.results = &ChatUpdateRepositoryMockNotifyChatUpdateResults{err}
+	return mmNotifyChatUpdate.mock
+}
+
+// Set uses given function f to mock the ChatUpdateRepository.NotifyChatUpdate method
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) Set(f func(ctx context.Context, params model.CreateChatUpdateParams) (err error)) *ChatUpdateRepositoryMock {
+	if mmNotifyChatUpdate.defaultExpectation != nil {
+		mmNotifyChatUpdate.mock.t.Fatalf("Default expectation is already set for the ChatUpdateRepository.NotifyChatUpdate method")
+	}
+
+	if len(mmNotifyChatUpdate.expectations) > 0 {
+		mmNotifyChatUpdate.mock.t.Fatalf("Some expectations are already set for the ChatUpdateRepository.NotifyChatUpdate method")
+	}
+
+	mmNotifyChatUpdate.mock.funcNotifyChatUpdate = f
+	return mmNotifyChatUpdate.mock
+}
+
+// When sets expectation for the ChatUpdateRepository.NotifyChatUpdate which will trigger the result defined by the following
+// Then helper
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) When(ctx context.Context, params model.CreateChatUpdateParams) *ChatUpdateRepositoryMockNotifyChatUpdateExpectation {
+	if mmNotifyChatUpdate.mock.funcNotifyChatUpdate != nil {
+		mmNotifyChatUpdate.mock.t.Fatalf("ChatUpdateRepositoryMock.NotifyChatUpdate mock is already set by Set")
+	}
+
+	expectation := &ChatUpdateRepositoryMockNotifyChatUpdateExpectation{
+		mock:   mmNotifyChatUpdate.mock,
+		params: &ChatUpdateRepositoryMockNotifyChatUpdateParams{ctx, params},
+	}
+	mmNotifyChatUpdate.expectations = append(mmNotifyChatUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatUpdateRepository.NotifyChatUpdate return parameters for the expectation previously defined by the When method
+func (e *ChatUpdateRepositoryMockNotifyChatUpdateExpectation) Then(err error) *ChatUpdateRepositoryMock {
+	e.results = &ChatUpdateRepositoryMockNotifyChatUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatUpdateRepository.NotifyChatUpdate should be invoked
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) Times(n uint64) *mChatUpdateRepositoryMockNotifyChatUpdate {
+	if n == 0 {
+		mmNotifyChatUpdate.mock.t.Fatalf("Times of ChatUpdateRepositoryMock.NotifyChatUpdate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmNotifyChatUpdate.expectedInvocations, n)
+	return mmNotifyChatUpdate
+}
+
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) invocationsDone() bool {
+	if len(mmNotifyChatUpdate.expectations) == 0 && mmNotifyChatUpdate.defaultExpectation == nil && mmNotifyChatUpdate.mock.funcNotifyChatUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmNotifyChatUpdate.mock.afterNotifyChatUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmNotifyChatUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// NotifyChatUpdate implements repository.ChatUpdateRepository
+func (mmNotifyChatUpdate *ChatUpdateRepositoryMock) NotifyChatUpdate(ctx context.Context, params model.CreateChatUpdateParams) (err error) {
+	mm_atomic.AddUint64(&mmNotifyChatUpdate.beforeNotifyChatUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmNotifyChatUpdate.afterNotifyChatUpdateCounter, 1)
+
+	if mmNotifyChatUpdate.inspectFuncNotifyChatUpdate != nil {
+		mmNotifyChatUpdate.inspectFuncNotifyChatUpdate(ctx, params)
+	}
+
+	mm_params := ChatUpdateRepositoryMockNotifyChatUpdateParams{ctx, params}
+
+	// Record call args
+	mmNotifyChatUpdate.NotifyChatUpdateMock.mutex.Lock()
+	mmNotifyChatUpdate.NotifyChatUpdateMock.callArgs = append(mmNotifyChatUpdate.NotifyChatUpdateMock.callArgs, &mm_params)
+	mmNotifyChatUpdate.NotifyChatUpdateMock.mutex.Unlock()
+
+	for _, e := range mmNotifyChatUpdate.NotifyChatUpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmNotifyChatUpdate.NotifyChatUpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNotifyChatUpdate.NotifyChatUpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmNotifyChatUpdate.NotifyChatUpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmNotifyChatUpdate.NotifyChatUpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatUpdateRepositoryMockNotifyChatUpdateParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmNotifyChatUpdate.t.Errorf("ChatUpdateRepositoryMock.NotifyChatUpdate got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmNotifyChatUpdate.t.Errorf("ChatUpdateRepositoryMock.NotifyChatUpdate got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNotifyChatUpdate.t.Errorf("ChatUpdateRepositoryMock.NotifyChatUpdate got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmNotifyChatUpdate.NotifyChatUpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmNotifyChatUpdate.t.Fatal("No results are set for the ChatUpdateRepositoryMock.NotifyChatUpdate")
+		}
+		return (*mm_results).err
+	}
+	if mmNotifyChatUpdate.funcNotifyChatUpdate != nil {
+		return mmNotifyChatUpdate.funcNotifyChatUpdate(ctx, params)
+	}
+	mmNotifyChatUpdate.t.Fatalf("Unexpected call to ChatUpdateRepositoryMock.NotifyChatUpdate. %v %v", ctx, params)
+	return
+}
+
+// NotifyChatUpdateAfterCounter returns a count of finished ChatUpdateRepositoryMock.NotifyChatUpdate invocations
+func (mmNotifyChatUpdate *ChatUpdateRepositoryMock) NotifyChatUpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNotifyChatUpdate.afterNotifyChatUpdateCounter)
+}
+
+// NotifyChatUpdateBeforeCounter returns a count of ChatUpdateRepositoryMock.NotifyChatUpdate invocations
+func (mmNotifyChatUpdate *ChatUpdateRepositoryMock) NotifyChatUpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNotifyChatUpdate.beforeNotifyChatUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatUpdateRepositoryMock.NotifyChatUpdate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmNotifyChatUpdate *mChatUpdateRepositoryMockNotifyChatUpdate) Calls() []*ChatUpdateRepositoryMockNotifyChatUpdateParams {
+	mmNotifyChatUpdate.mutex.RLock()
+
+	argCopy := make([]*ChatUpdateRepositoryMockNotifyChatUpdateParams, len(mmNotifyChatUpdate.callArgs))
+	copy(argCopy, mmNotifyChatUpdate.callArgs)
+
+	mmNotifyChatUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockNotifyChatUpdateDone returns true if the count of the NotifyChatUpdate invocations corresponds
+// the number of defined expectations
+func (m *ChatUpdateRepositoryMock) MinimockNotifyChatUpdateDone() bool {
+	if m.NotifyChatUpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.NotifyChatUpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.NotifyChatUpdateMock.invocationsDone()
+}
+
+// MinimockNotifyChatUpdateInspect logs each unmet expectation
+func (m *ChatUpdateRepositoryMock) MinimockNotifyChatUpdateInspect() {
+	for _, e := range m.NotifyChatUpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatUpdateRepositoryMock.NotifyChatUpdate with params: %#v", *e.params)
+		}
+	}
+
+	afterNotifyChatUpdateCounter := mm_atomic.LoadUint64(&m.afterNotifyChatUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NotifyChatUpdateMock.defaultExpectation != nil && afterNotifyChatUpdateCounter < 1 {
+		if m.NotifyChatUpdateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatUpdateRepositoryMock.NotifyChatUpdate")
+		} else {
+			m.t.Errorf("Expected call to ChatUpdateRepositoryMock.NotifyChatUpdate with params: %#v", *m.NotifyChatUpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNotifyChatUpdate != nil && afterNotifyChatUpdateCounter < 1 {
+		m.t.Error("Expected call to ChatUpdateRepositoryMock.NotifyChatUpdate")
+	}
+
+	if !m.NotifyChatUpdateMock.invocationsDone() && afterNotifyChatUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatUpdateRepositoryMock.NotifyChatUpdate but found %d calls",
+			mm_atomic.LoadUint64(&m.NotifyChatUpdateMock.expectedInvocations), afterNotifyChatUpdateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ChatUpdateRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockNotifyChatUpdateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ChatUpdateRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ChatUpdateRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockNotifyChatUpdateDone()
+}
@@ -0,0 +1,1725 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.LogPartitionRepository -o log_partition_repository_minimock.go -n LogPartitionRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// LogPartitionRepositoryMock implements repository.LogPartitionRepository
+type LogPartitionRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateAPILogPartition          func(ctx context.Context, from time.Time, to time.Time) (err error)
+	inspectFuncCreateAPILogPartition   func(ctx context.Context, from time.Time, to time.Time)
+	afterCreateAPILogPartitionCounter  uint64
+	beforeCreateAPILogPartitionCounter uint64
+	CreateAPILogPartitionMock          mLogPartitionRepositoryMockCreateAPILogPartition
+
+	funcDropAPILogPartition          func(ctx context.Context, name string) (err error)
+	inspectFuncDropAPILogPartition   func(ctx context.Context, name string)
+	afterDropAPILogPartitionCounter  uint64
+	beforeDropAPILogPartitionCounter uint64
+	DropAPILogPartitionMock          mLogPartitionRepositoryMockDropAPILogPartition
+
+	funcExportAPILogPartition          func(ctx context.Context, name string, fn func(entry model.AuditLogEntry) error) (err error)
+	inspectFuncExportAPILogPartition   func(ctx context.Context, name string, fn func(entry model.AuditLogEntry) error)
+	afterExportAPILogPartitionCounter  uint64
+	beforeExportAPILogPartitionCounter uint64
+	ExportAPILogPartitionMock          mLogPartitionRepositoryMockExportAPILogPartition
+
+	funcListAPILogPartitions          func(ctx context.Context) (partitions []model.AuditLogPartition, err error)
+	inspectFuncListAPILogPartitions   func(ctx context.Context)
+	afterListAPILogPartitionsCounter  uint64
+	beforeListAPILogPartitionsCounter uint64
+	ListAPILogPartitionsMock          mLogPartitionRepositoryMockListAPILogPartitions
+
+	funcLockAPILogMaintenance          func(ctx context.Context) (locked bool, err error)
+	inspectFuncLockAPILogMaintenance   func(ctx context.Context)
+	afterLockAPILogMaintenanceCounter  uint64
+	beforeLockAPILogMaintenanceCounter uint64
+	LockAPILogMaintenanceMock          mLogPartitionRepositoryMockLockAPILogMaintenance
+}
+
+// NewLogPartitionRepositoryMock returns a mock for repository.LogPartitionRepository
+func NewLogPartitionRepositoryMock(t minimock.Tester) *LogPartitionRepositoryMock {
+	m := &LogPartitionRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateAPILogPartitionMock = mLogPartitionRepositoryMockCreateAPILogPartition{mock: m}
+	m.CreateAPILogPartitionMock.callArgs = []*LogPartitionRepositoryMockCreateAPILogPartitionParams{}
+
+	m.DropAPILogPartitionMock = mLogPartitionRepositoryMockDropAPILogPartition{mock: m}
+	m.DropAPILogPartitionMock.callArgs = []*LogPartitionRepositoryMockDropAPILogPartitionParams{}
+
+	m.ExportAPILogPartitionMock = mLogPartitionRepositoryMockExportAPILogPartition{mock: m}
+	m.ExportAPILogPartitionMock.callArgs = []*LogPartitionRepositoryMockExportAPILogPartitionParams{}
+
+	m.ListAPILogPartitionsMock = mLogPartitionRepositoryMockListAPILogPartitions{mock: m}
+	m.ListAPILogPartitionsMock.callArgs = []*LogPartitionRepositoryMockListAPILogPartitionsParams{}
+
+	m.LockAPILogMaintenanceMock = mLogPartitionRepositoryMockLockAPILogMaintenance{mock: m}
+	m.LockAPILogMaintenanceMock.callArgs = []*LogPartitionRepositoryMockLockAPILogMaintenanceParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mLogPartitionRepositoryMockCreateAPILogPartition struct {
+	optional           bool
+	mock               *LogPartitionRepositoryMock
+	defaultExpectation *LogPartitionRepositoryMockCreateAPILogPartitionExpectation
+	expectations       []*LogPartitionRepositoryMockCreateAPILogPartitionExpectation
+
+	callArgs []*LogPartitionRepositoryMockCreateAPILogPartitionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogPartitionRepositoryMockCreateAPILogPartitionExpectation specifies expectation struct of the LogPartitionRepository.CreateAPILogPartition
+type LogPartitionRepositoryMockCreateAPILogPartitionExpectation struct {
+	mock      *LogPartitionRepositoryMock
+	params    *LogPartitionRepositoryMockCreateAPILogPartitionParams
+	paramPtrs *LogPartitionRepositoryMockCreateAPILogPartitionParamPtrs
+	results   *LogPartitionRepositoryMockCreateAPILogPartitionResults
+	Counter   uint64
+}
+
+// LogPartitionRepositoryMockCreateAPILogPartitionParams contains parameters of the LogPartitionRepository.CreateAPILogPartition
+type LogPartitionRepositoryMockCreateAPILogPartitionParams struct {
+	ctx  context.Context
+	from time.Time
+	to   time.Time
+}
+
+// LogPartitionRepositoryMockCreateAPILogPartitionParamPtrs contains pointers to parameters of the LogPartitionRepository.CreateAPILogPartition
+type LogPartitionRepositoryMockCreateAPILogPartitionParamPtrs struct {
+	ctx  *context.Context
+	from *time.Time
+	to   *time.Time
+}
+
+// LogPartitionRepositoryMockCreateAPILogPartitionResults contains results of the LogPartitionRepository.CreateAPILogPartition
+type LogPartitionRepositoryMockCreateAPILogPartitionResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) Optional() *mLogPartitionRepositoryMockCreateAPILogPartition {
+	mmCreateAPILogPartition.optional = true
+	return mmCreateAPILogPartition
+}
+
+// Expect sets up expected params for LogPartitionRepository.CreateAPILogPartition
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) Expect(ctx context.Context, from time.Time, to time.Time) *mLogPartitionRepositoryMockCreateAPILogPartition {
+	if mmCreateAPILogPartition.mock.funcCreateAPILogPartition != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.CreateAPILogPartition mock is already set by Set")
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation == nil {
+		mmCreateAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockCreateAPILogPartitionExpectation{}
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation.paramPtrs != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.CreateAPILogPartition mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAPILogPartition.defaultExpectation.params = &LogPartitionRepositoryMockCreateAPILogPartitionParams{ctx, from, to}
+	for _, e := range mmCreateAPILogPartition.expectations {
+		if minimock.Equal(e.params, mmCreateAPILogPartition.defaultExpectation.params) {
+			mmCreateAPILogPartition.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAPILogPartition.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAPILogPartition
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogPartitionRepository.CreateAPILogPartition
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) ExpectCtxParam1(ctx context.Context) *mLogPartitionRepositoryMockCreateAPILogPartition {
+	if mmCreateAPILogPartition.mock.funcCreateAPILogPartition != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.CreateAPILogPartition mock is already set by Set")
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation == nil {
+		mmCreateAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockCreateAPILogPartitionExpectation{}
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation.params != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.CreateAPILogPartition mock is already set by Expect")
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation.paramPtrs == nil {
+		mmCreateAPILogPartition.defaultExpectation.paramPtrs = &LogPartitionRepositoryMockCreateAPILogPartitionParamPtrs{}
+	}
+	mmCreateAPILogPartition.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateAPILogPartition
+}
+
+// ExpectFromParam2 sets up expected param from for LogPartitionRepository.CreateAPILogPartition
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) ExpectFromParam2(from time.Time) *mLogPartitionRepositoryMockCreateAPILogPartition {
+	if mmCreateAPILogPartition.mock.funcCreateAPILogPartition != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.CreateAPILogPartition mock is already set by Set")
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation == nil {
+		mmCreateAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockCreateAPILogPartitionExpectation{}
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation.params != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.CreateAPILogPartition mock is already set by Expect")
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation.paramPtrs == nil {
+		mmCreateAPILogPartition.defaultExpectation.paramPtrs = &LogPartitionRepositoryMockCreateAPILogPartitionParamPtrs{}
+	}
+	mmCreateAPILogPartition.defaultExpectation.paramPtrs.from = &from
+
+	return mmCreateAPILogPartition
+}
+
+// ExpectToParam3 sets up expected param to for LogPartitionRepository.CreateAPILogPartition
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) ExpectToParam3(to time.Time) *mLogPartitionRepositoryMockCreateAPILogPartition {
+	if mmCreateAPILogPartition.mock.funcCreateAPILogPartition != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.CreateAPILogPartition mock is already set by Set")
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation == nil {
+		mmCreateAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockCreateAPILogPartitionExpectation{}
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation.params != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.CreateAPILogPartition mock is already set by Expect")
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation.paramPtrs == nil {
+		mmCreateAPILogPartition.defaultExpectation.paramPtrs = &LogPartitionRepositoryMockCreateAPILogPartitionParamPtrs{}
+	}
+	mmCreateAPILogPartition.defaultExpectation.paramPtrs.to = &to
+
+	return mmCreateAPILogPartition
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogPartitionRepository.CreateAPILogPartition
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) Inspect(f func(ctx context.Context, from time.Time, to time.Time)) *mLogPartitionRepositoryMockCreateAPILogPartition {
+	if mmCreateAPILogPartition.mock.inspectFuncCreateAPILogPartition != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("Inspect function is already set for LogPartitionRepositoryMock.CreateAPILogPartition")
+	}
+
+	mmCreateAPILogPartition.mock.inspectFuncCreateAPILogPartition = f
+
+	return mmCreateAPILogPartition
+}
+
+// Return sets up results that will be returned by LogPartitionRepository.CreateAPILogPartition
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) Return(err error) *LogPartitionRepositoryMock {
+	if mmCreateAPILogPartition.mock.funcCreateAPILogPartition != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.CreateAPILogPartition mock is already set by Set")
+	}
+
+	if mmCreateAPILogPartition.defaultExpectation == nil {
+		mmCreateAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockCreateAPILogPartitionExpectation{mock: mmCreateAPILogPartition.mock}
+	}
+	mmCreateAPILogPartition.defaultExpectation.results = &LogPartitionRepositoryMockCreateAPILogPartitionResults{err}
+	return mmCreateAPILogPartition.mock
+}
+
+// Set uses given function f to mock the LogPartitionRepository.CreateAPILogPartition method
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) Set(f func(ctx context.Context, from time.Time, to time.Time) (err error)) *LogPartitionRepositoryMock {
+	if mmCreateAPILogPartition.defaultExpectation != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("Default expectation is already set for the LogPartitionRepository.CreateAPILogPartition method")
+	}
+
+	if len(mmCreateAPILogPartition.expectations) > 0 {
+		mmCreateAPILogPartition.mock.t.Fatalf("Some expectations are already set for the LogPartitionRepository.CreateAPILogPartition method")
+	}
+
+	mmCreateAPILogPartition.mock.funcCreateAPILogPartition = f
+	return mmCreateAPILogPartition.mock
+}
+
+// When sets expectation for the LogPartitionRepository.CreateAPILogPartition which will trigger the result defined by the following
+// Then helper
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) When(ctx context.Context, from time.Time, to time.Time) *LogPartitionRepositoryMockCreateAPILogPartitionExpectation {
+	if mmCreateAPILogPartition.mock.funcCreateAPILogPartition != nil {
+		mmCreateAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.CreateAPILogPartition mock is already set by Set")
+	}
+
+	expectation := &LogPartitionRepositoryMockCreateAPILogPartitionExpectation{
+		mock:   mmCreateAPILogPartition.mock,
+		params: &LogPartitionRepositoryMockCreateAPILogPartitionParams{ctx, from, to},
+	}
+	mmCreateAPILogPartition.expectations = append(mmCreateAPILogPartition.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogPartitionRepository.CreateAPILogPartition return parameters for the expectation previously defined by the When method
+func (e *LogPartitionRepositoryMockCreateAPILogPartitionExpectation) Then(err error) *LogPartitionRepositoryMock {
+	e.results = &LogPartitionRepositoryMockCreateAPILogPartitionResults{err}
+	return e.mock
+}
+
+// Times sets number of times LogPartitionRepository.CreateAPILogPartition should be invoked
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) Times(n uint64) *mLogPartitionRepositoryMockCreateAPILogPartition {
+	if n == 0 {
+		mmCreateAPILogPartition.mock.t.Fatalf("Times of LogPartitionRepositoryMock.CreateAPILogPartition mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAPILogPartition.expectedInvocations, n)
+	return mmCreateAPILogPartition
+}
+
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) invocationsDone() bool {
+	if len(mmCreateAPILogPartition.expectations) == 0 && mmCreateAPILogPartition.defaultExpectation == nil && mmCreateAPILogPartition.mock.funcCreateAPILogPartition == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAPILogPartition.mock.afterCreateAPILogPartitionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAPILogPartition.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAPILogPartition implements repository.LogPartitionRepository
+func (mmCreateAPILogPartition *LogPartitionRepositoryMock) CreateAPILogPartition(ctx context.Context, from time.Time, to time.Time) (err error) {
+	mm_atomic.AddUint64(&mmCreateAPILogPartition.beforeCreateAPILogPartitionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAPILogPartition.afterCreateAPILogPartitionCounter, 1)
+
+	if mmCreateAPILogPartition.inspectFuncCreateAPILogPartition != nil {
+		mmCreateAPILogPartition.inspectFuncCreateAPILogPartition(ctx, from, to)
+	}
+
+	mm_params := LogPartitionRepositoryMockCreateAPILogPartitionParams{ctx, from, to}
+
+	// Record call args
+	mmCreateAPILogPartition.CreateAPILogPartitionMock.mutex.Lock()
+	mmCreateAPILogPartition.CreateAPILogPartitionMock.callArgs = append(mmCreateAPILogPartition.CreateAPILogPartitionMock.callArgs, &mm_params)
+	mmCreateAPILogPartition.CreateAPILogPartitionMock.mutex.Unlock()
+
+	for _, e := range mmCreateAPILogPartition.CreateAPILogPartitionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAPILogPartition.CreateAPILogPartitionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAPILogPartition.CreateAPILogPartitionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAPILogPartition.CreateAPILogPartitionMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAPILogPartition.CreateAPILogPartitionMock.defaultExpectation.paramPtrs
+
+		mm_got := LogPartitionRepositoryMockCreateAPILogPartitionParams{ctx, from, to}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAPILogPartition.t.Errorf("LogPartitionRepositoryMock.CreateAPILogPartition got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.from != nil && !minimock.Equal(*mm_want_ptrs.from, mm_got.from) {
+				mmCreateAPILogPartition.t.Errorf("LogPartitionRepositoryMock.CreateAPILogPartition got unexpected parameter from, want: %#v, got: %#v%s\n", *mm_want_ptrs.from, mm_got.from, minimock.Diff(*mm_want_ptrs.from, mm_got.from))
+			}
+
+			if mm_want_ptrs.to != nil && !minimock.Equal(*mm_want_ptrs.to, mm_got.to) {
+				mmCreateAPILogPartition.t.Errorf("LogPartitionRepositoryMock.CreateAPILogPartition got unexpected parameter to, want: %#v, got: %#v%s\n", *mm_want_ptrs.to, mm_got.to, minimock.Diff(*mm_want_ptrs.to, mm_got.to))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAPILogPartition.t.Errorf("LogPartitionRepositoryMock.CreateAPILogPartition got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAPILogPartition.CreateAPILogPartitionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAPILogPartition.t.Fatal("No results are set for the LogPartitionRepositoryMock.CreateAPILogPartition")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAPILogPartition.funcCreateAPILogPartition != nil {
+		return mmCreateAPILogPartition.funcCreateAPILogPartition(ctx, from, to)
+	}
+	mmCreateAPILogPartition.t.Fatalf("Unexpected call to LogPartitionRepositoryMock.CreateAPILogPartition. %v %v %v", ctx, from, to)
+	return
+}
+
+// CreateAPILogPartitionAfterCounter returns a count of finished LogPartitionRepositoryMock.CreateAPILogPartition invocations
+func (mmCreateAPILogPartition *LogPartitionRepositoryMock) CreateAPILogPartitionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPILogPartition.afterCreateAPILogPartitionCounter)
+}
+
+// CreateAPILogPartitionBeforeCounter returns a count of LogPartitionRepositoryMock.CreateAPILogPartition invocations
+func (mmCreateAPILogPartition *LogPartitionRepositoryMock) CreateAPILogPartitionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPILogPartition.beforeCreateAPILogPartitionCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogPartitionRepositoryMock.CreateAPILogPartition.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAPILogPartition *mLogPartitionRepositoryMockCreateAPILogPartition) Calls() []*LogPartitionRepositoryMockCreateAPILogPartitionParams {
+	mmCreateAPILogPartition.mutex.RLock()
+
+	argCopy := make([]*LogPartitionRepositoryMockCreateAPILogPartitionParams, len(mmCreateAPILogPartition.callArgs))
+	copy(argCopy, mmCreateAPILogPartition.callArgs)
+
+	mmCreateAPILogPartition.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAPILogPartitionDone returns true if the count of the CreateAPILogPartition invocations corresponds
+// the number of defined expectations
+func (m *LogPartitionRepositoryMock) MinimockCreateAPILogPartitionDone() bool {
+	if m.CreateAPILogPartitionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAPILogPartitionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAPILogPartitionMock.invocationsDone()
+}
+
+// MinimockCreateAPILogPartitionInspect logs each unmet expectation
+func (m *LogPartitionRepositoryMock) MinimockCreateAPILogPartitionInspect() {
+	for _, e := range m.CreateAPILogPartitionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogPartitionRepositoryMock.CreateAPILogPartition with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateAPILogPartitionCounter := mm_atomic.LoadUint64(&m.afterCreateAPILogPartitionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAPILogPartitionMock.defaultExpectation != nil && afterCreateAPILogPartitionCounter < 1 {
+		if m.CreateAPILogPartitionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogPartitionRepositoryMock.CreateAPILogPartition")
+		} else {
+			m.t.Errorf("Expected call to LogPartitionRepositoryMock.CreateAPILogPartition with params: %#v", *m.CreateAPILogPartitionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAPILogPartition != nil && afterCreateAPILogPartitionCounter < 1 {
+		m.t.Error("Expected call to LogPartitionRepositoryMock.CreateAPILogPartition")
+	}
+
+	if !m.CreateAPILogPartitionMock.invocationsDone() && afterCreateAPILogPartitionCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogPartitionRepositoryMock.CreateAPILogPartition but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAPILogPartitionMock.expectedInvocations), afterCreateAPILogPartitionCounter)
+	}
+}
+
+type mLogPartitionRepositoryMockDropAPILogPartition struct {
+	optional           bool
+	mock               *LogPartitionRepositoryMock
+	defaultExpectation *LogPartitionRepositoryMockDropAPILogPartitionExpectation
+	expectations       []*LogPartitionRepositoryMockDropAPILogPartitionExpectation
+
+	callArgs []*LogPartitionRepositoryMockDropAPILogPartitionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogPartitionRepositoryMockDropAPILogPartitionExpectation specifies expectation struct of the LogPartitionRepository.DropAPILogPartition
+type LogPartitionRepositoryMockDropAPILogPartitionExpectation struct {
+	mock      *LogPartitionRepositoryMock
+	params    *LogPartitionRepositoryMockDropAPILogPartitionParams
+	paramPtrs *LogPartitionRepositoryMockDropAPILogPartitionParamPtrs
+	results   *LogPartitionRepositoryMockDropAPILogPartitionResults
+	Counter   uint64
+}
+
+// LogPartitionRepositoryMockDropAPILogPartitionParams contains parameters of the LogPartitionRepository.DropAPILogPartition
+type LogPartitionRepositoryMockDropAPILogPartitionParams struct {
+	ctx  context.Context
+	name string
+}
+
+// LogPartitionRepositoryMockDropAPILogPartitionParamPtrs contains pointers to parameters of the LogPartitionRepository.DropAPILogPartition
+type LogPartitionRepositoryMockDropAPILogPartitionParamPtrs struct {
+	ctx  *context.Context
+	name *string
+}
+
+// LogPartitionRepositoryMockDropAPILogPartitionResults contains results of the LogPartitionRepository.DropAPILogPartition
+type LogPartitionRepositoryMockDropAPILogPartitionResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) Optional() *mLogPartitionRepositoryMockDropAPILogPartition {
+	mmDropAPILogPartition.optional = true
+	return mmDropAPILogPartition
+}
+
+// Expect sets up expected params for LogPartitionRepository.DropAPILogPartition
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) Expect(ctx context.Context, name string) *mLogPartitionRepositoryMockDropAPILogPartition {
+	if mmDropAPILogPartition.mock.funcDropAPILogPartition != nil {
+		mmDropAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.DropAPILogPartition mock is already set by Set")
+	}
+
+	if mmDropAPILogPartition.defaultExpectation == nil {
+		mmDropAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockDropAPILogPartitionExpectation{}
+	}
+
+	if mmDropAPILogPartition.defaultExpectation.paramPtrs != nil {
+		mmDropAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.DropAPILogPartition mock is already set by ExpectParams functions")
+	}
+
+	mmDropAPILogPartition.defaultExpectation.params = &LogPartitionRepositoryMockDropAPILogPartitionParams{ctx, name}
+	for _, e := range mmDropAPILogPartition.expectations {
+		if minimock.Equal(e.params, mmDropAPILogPartition.defaultExpectation.params) {
+			mmDropAPILogPartition.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDropAPILogPartition.defaultExpectation.params)
+		}
+	}
+
+	return mmDropAPILogPartition
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogPartitionRepository.DropAPILogPartition
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) ExpectCtxParam1(ctx context.Context) *mLogPartitionRepositoryMockDropAPILogPartition {
+	if mmDropAPILogPartition.mock.funcDropAPILogPartition != nil {
+		mmDropAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.DropAPILogPartition mock is already set by Set")
+	}
+
+	if mmDropAPILogPartition.defaultExpectation == nil {
+		mmDropAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockDropAPILogPartitionExpectation{}
+	}
+
+	if mmDropAPILogPartition.defaultExpectation.params != nil {
+		mmDropAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.DropAPILogPartition mock is already set by Expect")
+	}
+
+	if mmDropAPILogPartition.defaultExpectation.paramPtrs == nil {
+		mmDropAPILogPartition.defaultExpectation.paramPtrs = &LogPartitionRepositoryMockDropAPILogPartitionParamPtrs{}
+	}
+	mmDropAPILogPartition.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDropAPILogPartition
+}
+
+// ExpectNameParam2 sets up expected param name for LogPartitionRepository.DropAPILogPartition
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) ExpectNameParam2(name string) *mLogPartitionRepositoryMockDropAPILogPartition {
+	if mmDropAPILogPartition.mock.funcDropAPILogPartition != nil {
+		mmDropAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.DropAPILogPartition mock is already set by Set")
+	}
+
+	if mmDropAPILogPartition.defaultExpectation == nil {
+		mmDropAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockDropAPILogPartitionExpectation{}
+	}
+
+	if mmDropAPILogPartition.defaultExpectation.params != nil {
+		mmDropAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.DropAPILogPartition mock is already set by Expect")
+	}
+
+	if mmDropAPILogPartition.defaultExpectation.paramPtrs == nil {
+		mmDropAPILogPartition.defaultExpectation.paramPtrs = &LogPartitionRepositoryMockDropAPILogPartitionParamPtrs{}
+	}
+	mmDropAPILogPartition.defaultExpectation.paramPtrs.name = &name
+
+	return mmDropAPILogPartition
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogPartitionRepository.DropAPILogPartition
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) Inspect(f func(ctx context.Context, name string)) *mLogPartitionRepositoryMockDropAPILogPartition {
+	if mmDropAPILogPartition.mock.inspectFuncDropAPILogPartition != nil {
+		mmDropAPILogPartition.mock.t.Fatalf("Inspect function is already set for LogPartitionRepositoryMock.DropAPILogPartition")
+	}
+
+	mmDropAPILogPartition.mock.inspectFuncDropAPILogPartition = f
+
+	return mmDropAPILogPartition
+}
+
+// Return sets up results that will be returned by LogPartitionRepository.DropAPILogPartition
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) Return(err error) *LogPartitionRepositoryMock {
+	if mmDropAPILogPartition.mock.funcDropAPILogPartition != nil {
+		mmDropAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.DropAPILogPartition mock is already set by Set")
+	}
+
+	if mmDropAPILogPartition.defaultExpectation == nil {
+		mmDropAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockDropAPILogPartitionExpectation{mock: mmDropAPILogPartition.mock}
+	}
+	mmDropAPILogPartition.defaultExpectation.results = &LogPartitionRepositoryMockDropAPILogPartitionResults{err}
+	return mmDropAPILogPartition.mock
+}
+
+// Set uses given function f to mock the LogPartitionRepository.DropAPILogPartition method
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) Set(f func(ctx context.Context, name string) (err error)) *LogPartitionRepositoryMock {
+	if mmDropAPILogPartition.defaultExpectation != nil {
+		mmDropAPILogPartition.mock.t.Fatalf("Default expectation is already set for the LogPartitionRepository.DropAPILogPartition method")
+	}
+
+	if len(mmDropAPILogPartition.expectations) > 0 {
+		mmDropAPILogPartition.mock.t.Fatalf("Some expectations are already set for the LogPartitionRepository.DropAPILogPartition method")
+	}
+
+	mmDropAPILogPartition.mock.funcDropAPILogPartition = f
+	return mmDropAPILogPartition.mock
+}
+
+// When sets expectation for the LogPartitionRepository.DropAPILogPartition which will trigger the result defined by the following
+// Then helper
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) When(ctx context.Context, name string) *LogPartitionRepositoryMockDropAPILogPartitionExpectation {
+	if mmDropAPILogPartition.mock.funcDropAPILogPartition != nil {
+		mmDropAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.DropAPILogPartition mock is already set by Set")
+	}
+
+	expectation := &LogPartitionRepositoryMockDropAPILogPartitionExpectation{
+		mock:   mmDropAPILogPartition.mock,
+		params: &LogPartitionRepositoryMockDropAPILogPartitionParams{ctx, name},
+	}
+	mmDropAPILogPartition.expectations = append(mmDropAPILogPartition.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogPartitionRepository.DropAPILogPartition return parameters for the expectation previously defined by the When method
+func (e *LogPartitionRepositoryMockDropAPILogPartitionExpectation) Then(err error) *LogPartitionRepositoryMock {
+	e.results = &LogPartitionRepositoryMockDropAPILogPartitionResults{err}
+	return e.mock
+}
+
+// Times sets number of times LogPartitionRepository.DropAPILogPartition should be invoked
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) Times(n uint64) *mLogPartitionRepositoryMockDropAPILogPartition {
+	if n == 0 {
+		mmDropAPILogPartition.mock.t.Fatalf("Times of LogPartitionRepositoryMock.DropAPILogPartition mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDropAPILogPartition.expectedInvocations, n)
+	return mmDropAPILogPartition
+}
+
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) invocationsDone() bool {
+	if len(mmDropAPILogPartition.expectations) == 0 && mmDropAPILogPartition.defaultExpectation == nil && mmDropAPILogPartition.mock.funcDropAPILogPartition == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDropAPILogPartition.mock.afterDropAPILogPartitionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDropAPILogPartition.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DropAPILogPartition implements repository.LogPartitionRepository
+func (mmDropAPILogPartition *LogPartitionRepositoryMock) DropAPILogPartition(ctx context.Context, name string) (err error) {
+	mm_atomic.AddUint64(&mmDropAPILogPartition.beforeDropAPILogPartitionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDropAPILogPartition.afterDropAPILogPartitionCounter, 1)
+
+	if mmDropAPILogPartition.inspectFuncDropAPILogPartition != nil {
+		mmDropAPILogPartition.inspectFuncDropAPILogPartition(ctx, name)
+	}
+
+	mm_params := LogPartitionRepositoryMockDropAPILogPartitionParams{ctx, name}
+
+	// Record call args
+	mmDropAPILogPartition.DropAPILogPartitionMock.mutex.Lock()
+	mmDropAPILogPartition.DropAPILogPartitionMock.callArgs = append(mmDropAPILogPartition.DropAPILogPartitionMock.callArgs, &mm_params)
+	mmDropAPILogPartition.DropAPILogPartitionMock.mutex.Unlock()
+
+	for _, e := range mmDropAPILogPartition.DropAPILogPartitionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDropAPILogPartition.DropAPILogPartitionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDropAPILogPartition.DropAPILogPartitionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDropAPILogPartition.DropAPILogPartitionMock.defaultExpectation.params
+		mm_want_ptrs := mmDropAPILogPartition.DropAPILogPartitionMock.defaultExpectation.paramPtrs
+
+		mm_got := LogPartitionRepositoryMockDropAPILogPartitionParams{ctx, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDropAPILogPartition.t.Errorf("LogPartitionRepositoryMock.DropAPILogPartition got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDropAPILogPartition.t.Errorf("LogPartitionRepositoryMock.DropAPILogPartition got unexpected parameter name, want: %#v, got: %#v%s\n", *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDropAPILogPartition.t.Errorf("LogPartitionRepositoryMock.DropAPILogPartition got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDropAPILogPartition.DropAPILogPartitionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDropAPILogPartition.t.Fatal("No results are set for the LogPartitionRepositoryMock.DropAPILogPartition")
+		}
+		return (*mm_results).err
+	}
+	if mmDropAPILogPartition.funcDropAPILogPartition != nil {
+		return mmDropAPILogPartition.funcDropAPILogPartition(ctx, name)
+	}
+	mmDropAPILogPartition.t.Fatalf("Unexpected call to LogPartitionRepositoryMock.DropAPILogPartition. %v %v", ctx, name)
+	return
+}
+
+// DropAPILogPartitionAfterCounter returns a count of finished LogPartitionRepositoryMock.DropAPILogPartition invocations
+func (mmDropAPILogPartition *LogPartitionRepositoryMock) DropAPILogPartitionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDropAPILogPartition.afterDropAPILogPartitionCounter)
+}
+
+// DropAPILogPartitionBeforeCounter returns a count of LogPartitionRepositoryMock.DropAPILogPartition invocations
+func (mmDropAPILogPartition *LogPartitionRepositoryMock) DropAPILogPartitionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDropAPILogPartition.beforeDropAPILogPartitionCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogPartitionRepositoryMock.DropAPILogPartition.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDropAPILogPartition *mLogPartitionRepositoryMockDropAPILogPartition) Calls() []*LogPartitionRepositoryMockDropAPILogPartitionParams {
+	mmDropAPILogPartition.mutex.RLock()
+
+	argCopy := make([]*LogPartitionRepositoryMockDropAPILogPartitionParams, len(mmDropAPILogPartition.callArgs))
+	copy(argCopy, mmDropAPILogPartition.callArgs)
+
+	mmDropAPILogPartition.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDropAPILogPartitionDone returns true if the count of the DropAPILogPartition invocations corresponds
+// the number of defined expectations
+func (m *LogPartitionRepositoryMock) MinimockDropAPILogPartitionDone() bool {
+	if m.DropAPILogPartitionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DropAPILogPartitionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DropAPILogPartitionMock.invocationsDone()
+}
+
+// MinimockDropAPILogPartitionInspect logs each unmet expectation
+func (m *LogPartitionRepositoryMock) MinimockDropAPILogPartitionInspect() {
+	for _, e := range m.DropAPILogPartitionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogPartitionRepositoryMock.DropAPILogPartition with params: %#v", *e.params)
+		}
+	}
+
+	afterDropAPILogPartitionCounter := mm_atomic.LoadUint64(&m.afterDropAPILogPartitionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DropAPILogPartitionMock.defaultExpectation != nil && afterDropAPILogPartitionCounter < 1 {
+		if m.DropAPILogPartitionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogPartitionRepositoryMock.DropAPILogPartition")
+		} else {
+			m.t.Errorf("Expected call to LogPartitionRepositoryMock.DropAPILogPartition with params: %#v", *m.DropAPILogPartitionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDropAPILogPartition != nil && afterDropAPILogPartitionCounter < 1 {
+		m.t.Error("Expected call to LogPartitionRepositoryMock.DropAPILogPartition")
+	}
+
+	if !m.DropAPILogPartitionMock.invocationsDone() && afterDropAPILogPartitionCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogPartitionRepositoryMock.DropAPILogPartition but found %d calls",
+			mm_atomic.LoadUint64(&m.DropAPILogPartitionMock.expectedInvocations), afterDropAPILogPartitionCounter)
+	}
+}
+
+type mLogPartitionRepositoryMockExportAPILogPartition struct {
+	optional           bool
+	mock               *LogPartitionRepositoryMock
+	defaultExpectation *LogPartitionRepositoryMockExportAPILogPartitionExpectation
+	expectations       []*LogPartitionRepositoryMockExportAPILogPartitionExpectation
+
+	callArgs []*LogPartitionRepositoryMockExportAPILogPartitionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogPartitionRepositoryMockExportAPILogPartitionExpectation specifies expectation struct of the LogPartitionRepository.ExportAPILogPartition
+type LogPartitionRepositoryMockExportAPILogPartitionExpectation struct {
+	mock      *LogPartitionRepositoryMock
+	params    *LogPartitionRepositoryMockExportAPILogPartitionParams
+	paramPtrs *LogPartitionRepositoryMockExportAPILogPartitionParamPtrs
+	results   *LogPartitionRepositoryMockExportAPILogPartitionResults
+	Counter   uint64
+}
+
+// LogPartitionRepositoryMockExportAPILogPartitionParams contains parameters of the LogPartitionRepository.ExportAPILogPartition
+type LogPartitionRepositoryMockExportAPILogPartitionParams struct {
+	ctx  context.Context
+	name string
+	fn   func(entry model.AuditLogEntry) error
+}
+
+// LogPartitionRepositoryMockExportAPILogPartitionParamPtrs contains pointers to parameters of the LogPartitionRepository.ExportAPILogPartition
+type LogPartitionRepositoryMockExportAPILogPartitionParamPtrs struct {
+	ctx  *context.Context
+	name *string
+	fn   *func(entry model.AuditLogEntry) error
+}
+
+// LogPartitionRepositoryMockExportAPILogPartitionResults contains results of the LogPartitionRepository.ExportAPILogPartition
+type LogPartitionRepositoryMockExportAPILogPartitionResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) Optional() *mLogPartitionRepositoryMockExportAPILogPartition {
+	mmExportAPILogPartition.optional = true
+	return mmExportAPILogPartition
+}
+
+// Expect sets up expected params for LogPartitionRepository.ExportAPILogPartition
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) Expect(ctx context.Context, name string, fn func(entry model.AuditLogEntry) error) *mLogPartitionRepositoryMockExportAPILogPartition {
+	if mmExportAPILogPartition.mock.funcExportAPILogPartition != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.ExportAPILogPartition mock is already set by Set")
+	}
+
+	if mmExportAPILogPartition.defaultExpectation == nil {
+		mmExportAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockExportAPILogPartitionExpectation{}
+	}
+
+	if mmExportAPILogPartition.defaultExpectation.paramPtrs != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.ExportAPILogPartition mock is already set by ExpectParams functions")
+	}
+
+	mmExportAPILogPartition.defaultExpectation.params = &LogPartitionRepositoryMockExportAPILogPartitionParams{ctx, name, fn}
+	for _, e := range mmExportAPILogPartition.expectations {
+		if minimock.Equal(e.params, mmExportAPILogPartition.defaultExpectation.params) {
+			mmExportAPILogPartition.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportAPILogPartition.defaultExpectation.params)
+		}
+	}
+
+	return mmExportAPILogPartition
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogPartitionRepository.ExportAPILogPartition
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) ExpectCtxParam1(ctx context.Context) *mLogPartitionRepositoryMockExportAPILogPartition {
+	if mmExportAPILogPartition.mock.funcExportAPILogPartition != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.ExportAPILogPartition mock is already set by Set")
+	}
+
+	if mmExportAPILogPartition.defaultExpectation == nil {
+		mmExportAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockExportAPILogPartitionExpectation{}
+	}
+
+	if mmExportAPILogPartition.defaultExpectation.params != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.ExportAPILogPartition mock is already set by Expect")
+	}
+
+	if mmExportAPILogPartition.defaultExpectation.paramPtrs == nil {
+		mmExportAPILogPartition.defaultExpectation.paramPtrs = &LogPartitionRepositoryMockExportAPILogPartitionParamPtrs{}
+	}
+	mmExportAPILogPartition.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmExportAPILogPartition
+}
+
+// ExpectNameParam2 sets up expected param name for LogPartitionRepository.ExportAPILogPartition
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) ExpectNameParam2(name string) *mLogPartitionRepositoryMockExportAPILogPartition {
+	if mmExportAPILogPartition.mock.funcExportAPILogPartition != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.ExportAPILogPartition mock is already set by Set")
+	}
+
+	if mmExportAPILogPartition.defaultExpectation == nil {
+		mmExportAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockExportAPILogPartitionExpectation{}
+	}
+
+	if mmExportAPILogPartition.defaultExpectation.params != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.ExportAPILogPartition mock is already set by Expect")
+	}
+
+	if mmExportAPILogPartition.defaultExpectation.paramPtrs == nil {
+		mmExportAPILogPartition.defaultExpectation.paramPtrs = &LogPartitionRepositoryMockExportAPILogPartitionParamPtrs{}
+	}
+	mmExportAPILogPartition.defaultExpectation.paramPtrs.name = &name
+
+	return mmExportAPILogPartition
+}
+
+// ExpectFnParam3 sets up expected param fn for LogPartitionRepository.ExportAPILogPartition
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) ExpectFnParam3(fn func(entry model.AuditLogEntry) error) *mLogPartitionRepositoryMockExportAPILogPartition {
+	if mmExportAPILogPartition.mock.funcExportAPILogPartition != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.ExportAPILogPartition mock is already set by Set")
+	}
+
+	if mmExportAPILogPartition.defaultExpectation == nil {
+		mmExportAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockExportAPILogPartitionExpectation{}
+	}
+
+	if mmExportAPILogPartition.defaultExpectation.params != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.ExportAPILogPartition mock is already set by Expect")
+	}
+
+	if mmExportAPILogPartition.defaultExpectation.paramPtrs == nil {
+		mmExportAPILogPartition.defaultExpectation.paramPtrs = &LogPartitionRepositoryMockExportAPILogPartitionParamPtrs{}
+	}
+	mmExportAPILogPartition.defaultExpectation.paramPtrs.fn = &fn
+
+	return mmExportAPILogPartition
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogPartitionRepository.ExportAPILogPartition
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) Inspect(f func(ctx context.Context, name string, fn func(entry model.AuditLogEntry) error)) *mLogPartitionRepositoryMockExportAPILogPartition {
+	if mmExportAPILogPartition.mock.inspectFuncExportAPILogPartition != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("Inspect function is already set for LogPartitionRepositoryMock.ExportAPILogPartition")
+	}
+
+	mmExportAPILogPartition.mock.inspectFuncExportAPILogPartition = f
+
+	return mmExportAPILogPartition
+}
+
+// Return sets up results that will be returned by LogPartitionRepository.ExportAPILogPartition
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) Return(err error) *LogPartitionRepositoryMock {
+	if mmExportAPILogPartition.mock.funcExportAPILogPartition != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.ExportAPILogPartition mock is already set by Set")
+	}
+
+	if mmExportAPILogPartition.defaultExpectation == nil {
+		mmExportAPILogPartition.defaultExpectation = &LogPartitionRepositoryMockExportAPILogPartitionExpectation{mock: mmExportAPILogPartition.mock}
+	}
+	mmExportAPILogPartition.defaultExpectation.results = &LogPartitionRepositoryMockExportAPILogPartitionResults{err}
+	return mmExportAPILogPartition.mock
+}
+
+// Set uses given function f to mock the LogPartitionRepository.ExportAPILogPartition method
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) Set(f func(ctx context.Context, name string, fn func(entry model.AuditLogEntry) error) (err error)) *LogPartitionRepositoryMock {
+	if mmExportAPILogPartition.defaultExpectation != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("Default expectation is already set for the LogPartitionRepository.ExportAPILogPartition method")
+	}
+
+	if len(mmExportAPILogPartition.expectations) > 0 {
+		mmExportAPILogPartition.mock.t.Fatalf("Some expectations are already set for the LogPartitionRepository.ExportAPILogPartition method")
+	}
+
+	mmExportAPILogPartition.mock.funcExportAPILogPartition = f
+	return mmExportAPILogPartition.mock
+}
+
+// When sets expectation for the LogPartitionRepository.ExportAPILogPartition which will trigger the result defined by the following
+// Then helper
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) When(ctx context.Context, name string, fn func(entry model.AuditLogEntry) error) *LogPartitionRepositoryMockExportAPILogPartitionExpectation {
+	if mmExportAPILogPartition.mock.funcExportAPILogPartition != nil {
+		mmExportAPILogPartition.mock.t.Fatalf("LogPartitionRepositoryMock.ExportAPILogPartition mock is already set by Set")
+	}
+
+	expectation := &LogPartitionRepositoryMockExportAPILogPartitionExpectation{
+		mock:   mmExportAPILogPartition.mock,
+		params: &LogPartitionRepositoryMockExportAPILogPartitionParams{ctx, name, fn},
+	}
+	mmExportAPILogPartition.expectations = append(mmExportAPILogPartition.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogPartitionRepository.ExportAPILogPartition return parameters for the expectation previously defined by the When method
+func (e *LogPartitionRepositoryMockExportAPILogPartitionExpectation) Then(err error) *LogPartitionRepositoryMock {
+	e.results = &LogPartitionRepositoryMockExportAPILogPartitionResults{err}
+	return e.mock
+}
+
+// Times sets number of times LogPartitionRepository.ExportAPILogPartition should be invoked
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) Times(n uint64) *mLogPartitionRepositoryMockExportAPILogPartition {
+	if n == 0 {
+		mmExportAPILogPartition.mock.t.Fatalf("Times of LogPartitionRepositoryMock.ExportAPILogPartition mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportAPILogPartition.expectedInvocations, n)
+	return mmExportAPILogPartition
+}
+
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) invocationsDone() bool {
+	if len(mmExportAPILogPartition.expectations) == 0 && mmExportAPILogPartition.defaultExpectation == nil && mmExportAPILogPartition.mock.funcExportAPILogPartition == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportAPILogPartition.mock.afterExportAPILogPartitionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportAPILogPartition.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportAPILogPartition implements repository.LogPartitionRepository
+func (mmExportAPILogPartition *LogPartitionRepositoryMock) ExportAPILogPartition(ctx context.Context, name string, fn func(entry model.AuditLogEntry) error) (err error) {
+	mm_atomic.AddUint64(&mmExportAPILogPartition.beforeExportAPILogPartitionCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportAPILogPartition.afterExportAPILogPartitionCounter, 1)
+
+	if mmExportAPILogPartition.inspectFuncExportAPILogPartition != nil {
+		mmExportAPILogPartition.inspectFuncExportAPILogPartition(ctx, name, fn)
+	}
+
+	mm_params := LogPartitionRepositoryMockExportAPILogPartitionParams{ctx, name, fn}
+
+	// Record call args
+	mmExportAPILogPartition.ExportAPILogPartitionMock.mutex.Lock()
+	mmExportAPILogPartition.ExportAPILogPartitionMock.callArgs = append(mmExportAPILogPartition.ExportAPILogPartitionMock.callArgs, &mm_params)
+	mmExportAPILogPartition.ExportAPILogPartitionMock.mutex.Unlock()
+
+	for _, e := range mmExportAPILogPartition.ExportAPILogPartitionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmExportAPILogPartition.ExportAPILogPartitionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportAPILogPartition.ExportAPILogPartitionMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportAPILogPartition.ExportAPILogPartitionMock.defaultExpectation.params
+		mm_want_ptrs := mmExportAPILogPartition.ExportAPILogPartitionMock.defaultExpectation.paramPtrs
+
+		mm_got := LogPartitionRepositoryMockExportAPILogPartitionParams{ctx, name, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportAPILogPartition.t.Errorf("LogPartitionRepositoryMock.ExportAPILogPartition got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmExportAPILogPartition.t.Errorf("LogPartitionRepositoryMock.ExportAPILogPartition got unexpected parameter name, want: %#v, got: %#v%s\n", *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmExportAPILogPartition.t.Errorf("LogPartitionRepositoryMock.ExportAPILogPartition got unexpected parameter fn, want: %#v, got: %#v%s\n", *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportAPILogPartition.t.Errorf("LogPartitionRepositoryMock.ExportAPILogPartition got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportAPILogPartition.ExportAPILogPartitionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportAPILogPartition.t.Fatal("No results are set for the LogPartitionRepositoryMock.ExportAPILogPartition")
+		}
+		return (*mm_results).err
+	}
+	if mmExportAPILogPartition.funcExportAPILogPartition != nil {
+		return mmExportAPILogPartition.funcExportAPILogPartition(ctx, name, fn)
+	}
+	mmExportAPILogPartition.t.Fatalf("Unexpected call to LogPartitionRepositoryMock.ExportAPILogPartition. %v %v %v", ctx, name, fn)
+	return
+}
+
+// ExportAPILogPartitionAfterCounter returns a count of finished LogPartitionRepositoryMock.ExportAPILogPartition invocations
+func (mmExportAPILogPartition *LogPartitionRepositoryMock) ExportAPILogPartitionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportAPILogPartition.afterExportAPILogPartitionCounter)
+}
+
+// ExportAPILogPartitionBeforeCounter returns a count of LogPartitionRepositoryMock.ExportAPILogPartition invocations
+func (mmExportAPILogPartition *LogPartitionRepositoryMock) ExportAPILogPartitionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportAPILogPartition.beforeExportAPILogPartitionCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogPartitionRepositoryMock.ExportAPILogPartition.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportAPILogPartition *mLogPartitionRepositoryMockExportAPILogPartition) Calls() []*LogPartitionRepositoryMockExportAPILogPartitionParams {
+	mmExportAPILogPartition.mutex.RLock()
+
+	argCopy := make([]*LogPartitionRepositoryMockExportAPILogPartitionParams, len(mmExportAPILogPartition.callArgs))
+	copy(argCopy, mmExportAPILogPartition.callArgs)
+
+	mmExportAPILogPartition.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportAPILogPartitionDone returns true if the count of the ExportAPILogPartition invocations corresponds
+// the number of defined expectations
+func (m *LogPartitionRepositoryMock) MinimockExportAPILogPartitionDone() bool {
+	if m.ExportAPILogPartitionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportAPILogPartitionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportAPILogPartitionMock.invocationsDone()
+}
+
+// MinimockExportAPILogPartitionInspect logs each unmet expectation
+func (m *LogPartitionRepositoryMock) MinimockExportAPILogPartitionInspect() {
+	for _, e := range m.ExportAPILogPartitionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogPartitionRepositoryMock.ExportAPILogPartition with params: %#v", *e.params)
+		}
+	}
+
+	afterExportAPILogPartitionCounter := mm_atomic.LoadUint64(&m.afterExportAPILogPartitionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportAPILogPartitionMock.defaultExpectation != nil && afterExportAPILogPartitionCounter < 1 {
+		if m.ExportAPILogPartitionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogPartitionRepositoryMock.ExportAPILogPartition")
+		} else {
+			m.t.Errorf("Expected call to LogPartitionRepositoryMock.ExportAPILogPartition with params: %#v", *m.ExportAPILogPartitionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportAPILogPartition != nil && afterExportAPILogPartitionCounter < 1 {
+		m.t.Error("Expected call to LogPartitionRepositoryMock.ExportAPILogPartition")
+	}
+
+	if !m.ExportAPILogPartitionMock.invocationsDone() && afterExportAPILogPartitionCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogPartitionRepositoryMock.ExportAPILogPartition but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportAPILogPartitionMock.expectedInvocations), afterExportAPILogPartitionCounter)
+	}
+}
+
+type mLogPartitionRepositoryMockListAPILogPartitions struct {
+	optional           bool
+	mock               *LogPartitionRepositoryMock
+	defaultExpectation *LogPartitionRepositoryMockListAPILogPartitionsExpectation
+	expectations       []*LogPartitionRepositoryMockListAPILogPartitionsExpectation
+
+	callArgs []*LogPartitionRepositoryMockListAPILogPartitionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogPartitionRepositoryMockListAPILogPartitionsExpectation specifies expectation struct of the LogPartitionRepository.ListAPILogPartitions
+type LogPartitionRepositoryMockListAPILogPartitionsExpectation struct {
+	mock      *LogPartitionRepositoryMock
+	params    *LogPartitionRepositoryMockListAPILogPartitionsParams
+	paramPtrs *LogPartitionRepositoryMockListAPILogPartitionsParamPtrs
+	results   *LogPartitionRepositoryMockListAPILogPartitionsResults
+	Counter   uint64
+}
+
+// LogPartitionRepositoryMockListAPILogPartitionsParams contains parameters of the LogPartitionRepository.ListAPILogPartitions
+type LogPartitionRepositoryMockListAPILogPartitionsParams struct {
+	ctx context.Context
+}
+
+// LogPartitionRepositoryMockListAPILogPartitionsParamPtrs contains pointers to parameters of the LogPartitionRepository.ListAPILogPartitions
+type LogPartitionRepositoryMockListAPILogPartitionsParamPtrs struct {
+	ctx *context.Context
+}
+
+// LogPartitionRepositoryMockListAPILogPartitionsResults contains results of the LogPartitionRepository.ListAPILogPartitions
+type LogPartitionRepositoryMockListAPILogPartitionsResults struct {
+	partitions []model.AuditLogPartition
+	err        error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAPILogPartitions *mLogPartitionRepositoryMockListAPILogPartitions) Optional() *mLogPartitionRepositoryMockListAPILogPartitions {
+	mmListAPILogPartitions.optional = true
+	return mmListAPILogPartitions
+}
+
+// Expect sets up expected params for LogPartitionRepository.ListAPILogPartitions
+func (mmListAPILogPartitions *mLogPartitionRepositoryMockListAPILogPartitions) Expect(ctx context.Context) *mLogPartitionRepositoryMockListAPILogPartitions {
+	if mmListAPILogPartitions.mock.funcListAPILogPartitions != nil {
+		mmListAPILogPartitions.mock.t.Fatalf("LogPartitionRepositoryMock.ListAPILogPartitions mock is already set by Set")
+	}
+
+	if mmListAPILogPartitions.defaultExpectation == nil {
+		mmListAPILogPartitions.defaultExpectation = &LogPartitionRepositoryMockListAPILogPartitionsExpectation{}
+	}
+
+	if mmListAPILogPartitions.defaultExpectation.paramPtrs != nil {
+		mmListAPILogPartitions.mock.t.Fatalf("LogPartitionRepositoryMock.ListAPILogPartitions mock is already set by ExpectParams functions")
+	}
+
+	mmListAPILogPartitions.defaultExpectation.params = &LogPartitionRepositoryMockListAPILogPartitionsParams{ctx}
+	for _, e := range mmListAPILogPartitions.expectations {
+		if minimock.Equal(e.params, mmListAPILogPartitions.defaultExpectation.params) {
+			mmListAPILogPartitions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAPILogPartitions.defaultExpectation.params)
+		}
+	}
+
+	return mmListAPILogPartitions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogPartitionRepository.ListAPILogPartitions
+func (mmListAPILogPartitions *mLogPartitionRepositoryMockListAPILogPartitions) ExpectCtxParam1(ctx context.Context) *mLogPartitionRepositoryMockListAPILogPartitions {
+	if mmListAPILogPartitions.mock.funcListAPILogPartitions != nil {
+		mmListAPILogPartitions.mock.t.Fatalf("LogPartitionRepositoryMock.ListAPILogPartitions mock is already set by Set")
+	}
+
+	if mmListAPILogPartitions.defaultExpectation == nil {
+		mmListAPILogPartitions.defaultExpectation = &LogPartitionRepositoryMockListAPILogPartitionsExpectation{}
+	}
+
+	if mmListAPILogPartitions.defaultExpectation.params != nil {
+		mmListAPILogPartitions.mock.t.Fatalf("LogPartitionRepositoryMock.ListAPILogPartitions mock is already set by Expect")
+	}
+
+	if mmListAPILogPartitions.defaultExpectation.paramPtrs == nil {
+		mmListAPILogPartitions.defaultExpectation.paramPtrs = &LogPartitionRepositoryMockListAPILogPartitionsParamPtrs{}
+	}
+	mmListAPILogPartitions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAPILogPartitions
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogPartitionRepository.ListAPILogPartitions
+func (mmListAPILogPartitions *mLogPartitionRepositoryMockListAPILogPartitions) Inspect(f func(ctx context.Context)) *mLogPartitionRepositoryMockListAPILogPartitions {
+	if mmListAPILogPartitions.mock.inspectFuncListAPILogPartitions != nil {
+		mmListAPILogPartitions.mock.t.Fatalf("Inspect function is already set for LogPartitionRepositoryMock.ListAPILogPartitions")
+	}
+
+	mmListAPILogPartitions.mock.inspectFuncListAPILogPartitions = f
+
+	return mmListAPILogPartitions
+}
+
+// Return sets up results that will be returned by LogPartitionRepository.ListAPILogPartitions
+func (mmListAPILogPartitions *mLogPartitionRepositoryMockListAPILogPartitions) Return(partitions []model.AuditLogPartition, err error) *LogPartitionRepositoryMock {
+	if mmListAPILogPartitions.mock.funcListAPILogPartitions != nil {
+		mmListAPILogPartitions.mock.t.Fatalf("LogPartitionRepositoryMock.ListAPILogPartitions mock is already set by Set")
+	}
+
+	if mmListAPILogPartitions.defaultExpectation == nil {
+		mmListAPILogPartitions.defaultExpectation = &LogPartitionRepositoryMockListAPILogPartitionsExpectation{mock: mmListAPILogPartitions.mock}
+	}
+	mmListAPILogPartitions.defaultExpectation.results = &LogPartitionRepositoryMockListAPILogPartitionsResults{partitions, err}
+	return mmListAPILogPartitions.mock
+}
+
+// Set uses given function f to mock the LogPartitionRepository.ListAPILogPartitions method
+func (mmListAPILogPartitions *mLogPartitionRepositoryMockListAPILogPartitions) Set(f func(ctx context.Context) (partitions []model.AuditLogPartition, err error)) *LogPartitionRepositoryMock {
+	if mmListAPILogPartitions.defaultExpectation != nil {
+		mmListAPILogPartitions.mock.t.Fatalf("Default expectation is already set for the LogPartitionRepository.ListAPILogPartitions method")
+	}
+
+	if len(mmListAPILogPartitions.expectations) > 0 {
+		mmListAPILogPartitions.mock.t.Fatalf("Some expectations are already set for the LogPartitionRepository.ListAPILogPartitions method")
+	}
+
+	mmListAPILogPartitions.mock.funcListAPILogPartitions = f
+	return mmListAPILogPartitions.mock
+}
+
+// When sets expectation for the LogPartitionRepository.ListAPILogPartitions which will trigger the result defined by the following
+// Then helper
+func (mmListAPILogPartitions *mLogPartitionRepositoryMockListAPILogPartitions) When(ctx context.Context) *LogPartitionRepositoryMockListAPILogPartitionsExpectation {
+	if mmListAPILogPartitions.mock.funcListAPILogPartitions != nil {
+		mmListAPILogPartitions.mock.t.Fatalf("LogPartitionRepositoryMock.ListAPILogPartitions mock is already set by Set")
+	}
+
+	expectation := &LogPartitionRepositoryMockListAPILogPartitionsExpectation{
+		mock:   mmListAPILogPartitions.mock,
+		params: &LogPartitionRepositoryMockListAPILogPartitionsParams{ctx},
+	}
+	mmListAPILogPartitions.expectations = append(mmListAPILogPartitions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogPartitionRepository.ListAPILogPartitions return parameters for the expectation previously defined by the When method
+func (e *LogPartitionRepositoryMockListAPILogPartitionsExpectation) Then(partitions []model.AuditLogPartition, err error) *LogPartitionRepositoryMock {
+	e.results = &LogPartitionRepositoryMockListAPILogPartitionsResults{partitions, err}
+	return e.mock
+}
+
+// Times sets number of times LogPartitionRepository.ListAPILogPartitions should be invoked
+func (mmListAPILogPartitions *mLogPartitionRepositoryMockListAPILogPartitions) Times(n uint64) *mLogPartitionRepositoryMockListAPILogPartitions {
+	if n == 0 {
+		mmListAPILogPartitions.mock.t.Fatalf("Times of LogPartitionRepositoryMock.ListAPILogPartitions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAPILogPartitions.expectedInvocations, n)
+	return mmListAPILogPartitions
+}
+
+func (mmListAPILogPartitions *mLogPartitionRepositoryMockListAPILogPartitions) invocationsDone() bool {
+	if len(mmListAPILogPartitions.expectations) == 0 && mmListAPILogPartitions.defaultExpectation == nil && mmListAPILogPartitions.mock.funcListAPILogPartitions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAPILogPartitions.mock.afterListAPILogPartitionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAPILogPartitions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAPILogPartitions implements repository.LogPartitionRepository
+func (mmListAPILogPartitions *LogPartitionRepositoryMock) ListAPILogPartitions(ctx context.Context) (partitions []model.AuditLogPartition, err error) {
+	mm_atomic.AddUint64(&mmListAPILogPartitions.beforeListAPILogPartitionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAPILogPartitions.afterListAPILogPartitionsCounter, 1)
+
+	if mmListAPILogPartitions.inspectFuncListAPILogPartitions != nil {
+		mmListAPILogPartitions.inspectFuncListAPILogPartitions(ctx)
+	}
+
+	mm_params := LogPartitionRepositoryMockListAPILogPartitionsParams{ctx}
+
+	// Record call args
+	mmListAPILogPartitions.ListAPILogPartitionsMock.mutex.Lock()
+	mmListAPILogPartitions.ListAPILogPartitionsMock.callArgs = append(mmListAPILogPartitions.ListAPILogPartitionsMock.callArgs, &mm_params)
+	mmListAPILogPartitions.ListAPILogPartitionsMock.mutex.Unlock()
+
+	for _, e := range mmListAPILogPartitions.ListAPILogPartitionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.partitions, e.results.err
+		}
+	}
+
+	if mmListAPILogPartitions.ListAPILogPartitionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAPILogPartitions.ListAPILogPartitionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAPILogPartitions.ListAPILogPartitionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAPILogPartitions.ListAPILogPartitionsMock.defaultExpectation.paramPtrs
+
+		mm_got := LogPartitionRepositoryMockListAPILogPartitionsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAPILogPartitions.t.Errorf("LogPartitionRepositoryMock.ListAPILogPartitions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAPILogPartitions.t.Errorf("LogPartitionRepositoryMock.ListAPILogPartitions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAPILogPartitions.ListAPILogPartitionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAPILogPartitions.t.Fatal("No results are set for the LogPartitionRepositoryMock.ListAPILogPartitions")
+		}
+		return (*mm_results).partitions, (*mm_results).err
+	}
+	if mmListAPILogPartitions.funcListAPILogPartitions != nil {
+		return mmListAPILogPartitions.funcListAPILogPartitions(ctx)
+	}
+	mmListAPILogPartitions.t.Fatalf("Unexpected call to LogPartitionRepositoryMock.ListAPILogPartitions. %v", ctx)
+	return
+}
+
+// ListAPILogPartitionsAfterCounter returns a count of finished LogPartitionRepositoryMock.ListAPILogPartitions invocations
+func (mmListAPILogPartitions *LogPartitionRepositoryMock) ListAPILogPartitionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAPILogPartitions.afterListAPILogPartitionsCounter)
+}
+
+// ListAPILogPartitionsBeforeCounter returns a count of LogPartitionRepositoryMock.ListAPILogPartitions invocations
+func (mmListAPILogPartitions *LogPartitionRepositoryMock) ListAPILogPartitionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAPILogPartitions.beforeListAPILogPartitionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogPartitionRepositoryMock.ListAPILogPartitions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAPILogPartitions *mLogPartitionRepositoryMockListAPILogPartitions) Calls() []*LogPartitionRepositoryMockListAPILogPartitionsParams {
+	mmListAPILogPartitions.mutex.RLock()
+
+	argCopy := make([]*LogPartitionRepositoryMockListAPILogPartitionsParams, len(mmListAPILogPartitions.callArgs))
+	copy(argCopy, mmListAPILogPartitions.callArgs)
+
+	mmListAPILogPartitions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAPILogPartitionsDone returns true if the count of the ListAPILogPartitions invocations corresponds
+// the number of defined expectations
+func (m *LogPartitionRepositoryMock) MinimockListAPILogPartitionsDone() bool {
+	if m.ListAPILogPartitionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAPILogPartitionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAPILogPartitionsMock.invocationsDone()
+}
+
+// MinimockListAPILogPartitionsInspect logs each unmet expectation
+func (m *LogPartitionRepositoryMock) MinimockListAPILogPartitionsInspect() {
+	for _, e := range m.ListAPILogPartitionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogPartitionRepositoryMock.ListAPILogPartitions with params: %#v", *e.params)
+		}
+	}
+
+	afterListAPILogPartitionsCounter := mm_atomic.LoadUint64(&m.afterListAPILogPartitionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAPILogPartitionsMock.defaultExpectation != nil && afterListAPILogPartitionsCounter < 1 {
+		if m.ListAPILogPartitionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogPartitionRepositoryMock.ListAPILogPartitions")
+		} else {
+			m.t.Errorf("Expected call to LogPartitionRepositoryMock.ListAPILogPartitions with params: %#v", *m.ListAPILogPartitionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAPILogPartitions != nil && afterListAPILogPartitionsCounter < 1 {
+		m.t.Error("Expected call to LogPartitionRepositoryMock.ListAPILogPartitions")
+	}
+
+	if !m.ListAPILogPartitionsMock.invocationsDone() && afterListAPILogPartitionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogPartitionRepositoryMock.ListAPILogPartitions but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAPILogPartitionsMock.expectedInvocations), afterListAPILogPartitionsCounter)
+	}
+}
+
+type mLogPartitionRepositoryMockLockAPILogMaintenance struct {
+	optional           bool
+	mock               *LogPartitionRepositoryMock
+	defaultExpectation *LogPartitionRepositoryMockLockAPILogMaintenanceExpectation
+	expectations       []*LogPartitionRepositoryMockLockAPILogMaintenanceExpectation
+
+	callArgs []*LogPartitionRepositoryMockLockAPILogMaintenanceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogPartitionRepositoryMockLockAPILogMaintenanceExpectation specifies expectation struct of the LogPartitionRepository.LockAPILogMaintenance
+type LogPartitionRepositoryMockLockAPILogMaintenanceExpectation struct {
+	mock      *LogPartitionRepositoryMock
+	params    *LogPartitionRepositoryMockLockAPILogMaintenanceParams
+	paramPtrs *LogPartitionRepositoryMockLockAPILogMaintenanceParamPtrs
+	results   *LogPartitionRepositoryMockLockAPILogMaintenanceResults
+	Counter   uint64
+}
+
+// LogPartitionRepositoryMockLockAPILogMaintenanceParams contains parameters of the LogPartitionRepository.LockAPILogMaintenance
+type LogPartitionRepositoryMockLockAPILogMaintenanceParams struct {
+	ctx context.Context
+}
+
+// LogPartitionRepositoryMockLockAPILogMaintenanceParamPtrs contains pointers to parameters of the LogPartitionRepository.LockAPILogMaintenance
+type LogPartitionRepositoryMockLockAPILogMaintenanceParamPtrs struct {
+	ctx *context.Context
+}
+
+// LogPartitionRepositoryMockLockAPILogMaintenanceResults contains results of the LogPartitionRepository.LockAPILogMaintenance
+type LogPartitionRepositoryMockLockAPILogMaintenanceResults struct {
+	locked bool
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockAPILogMaintenance *mLogPartitionRepositoryMockLockAPILogMaintenance) Optional() *mLogPartitionRepositoryMockLockAPILogMaintenance {
+	mmLockAPILogMaintenance.optional = true
+	return mmLockAPILogMaintenance
+}
+
+// Expect sets up expected params for LogPartitionRepository.LockAPILogMaintenance
+func (mmLockAPILogMaintenance *mLogPartitionRepositoryMockLockAPILogMaintenance) Expect(ctx context.Context) *mLogPartitionRepositoryMockLockAPILogMaintenance {
+	if mmLockAPILogMaintenance.mock.funcLockAPILogMaintenance != nil {
+		mmLockAPILogMaintenance.mock.t.Fatalf("LogPartitionRepositoryMock.LockAPILogMaintenance mock is already set by Set")
+	}
+
+	if mmLockAPILogMaintenance.defaultExpectation == nil {
+		mmLockAPILogMaintenance.defaultExpectation = &LogPartitionRepositoryMockLockAPILogMaintenanceExpectation{}
+	}
+
+	if mmLockAPILogMaintenance.defaultExpectation.paramPtrs != nil {
+		mmLockAPILogMaintenance.mock.t.Fatalf("LogPartitionRepositoryMock.LockAPILogMaintenance mock is already set by ExpectParams functions")
+	}
+
+	mmLockAPILogMaintenance.defaultExpectation.params = &LogPartitionRepositoryMockLockAPILogMaintenanceParams{ctx}
+	for _, e := range mmLockAPILogMaintenance.expectations {
+		if minimock.Equal(e.params, mmLockAPILogMaintenance.defaultExpectation.params) {
+			mmLockAPILogMaintenance.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockAPILogMaintenance.defaultExpectation.params)
+		}
+	}
+
+	return mmLockAPILogMaintenance
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogPartitionRepository.LockAPILogMaintenance
+func (mmLockAPILogMaintenance *mLogPartitionRepositoryMockLockAPILogMaintenance) ExpectCtxParam1(ctx context.Context) *mLogPartitionRepositoryMockLockAPILogMaintenance {
+	if mmLockAPILogMaintenance.mock.funcLockAPILogMaintenance != nil {
+		mmLockAPILogMaintenance.mock.t.Fatalf("LogPartitionRepositoryMock.LockAPILogMaintenance mock is already set by Set")
+	}
+
+	if mmLockAPILogMaintenance.defaultExpectation == nil {
+		mmLockAPILogMaintenance.defaultExpectation = &LogPartitionRepositoryMockLockAPILogMaintenanceExpectation{}
+	}
+
+	if mmLockAPILogMaintenance.defaultExpectation.params != nil {
+		mmLockAPILogMaintenance.mock.t.Fatalf("LogPartitionRepositoryMock.LockAPILogMaintenance mock is already set by Expect")
+	}
+
+	if mmLockAPILogMaintenance.defaultExpectation.paramPtrs == nil {
+		mmLockAPILogMaintenance.defaultExpectation.paramPtrs = &LogPartitionRepositoryMockLockAPILogMaintenanceParamPtrs{}
+	}
+	mmLockAPILogMaintenance.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLockAPILogMaintenance
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogPartitionRepository.LockAPILogMaintenance
+func (mmLockAPILogMaintenance *mLogPartitionRepositoryMockLockAPILogMaintenance) Inspect(f func(ctx context.Context)) *mLogPartitionRepositoryMockLockAPILogMaintenance {
+	if mmLockAPILogMaintenance.mock.inspectFuncLockAPILogMaintenance != nil {
+		mmLockAPILogMaintenance.mock.t.Fatalf("Inspect function is already set for LogPartitionRepositoryMock.LockAPILogMaintenance")
+	}
+
+	mmLockAPILogMaintenance.mock.inspectFuncLockAPILogMaintenance = f
+
+	return mmLockAPILogMaintenance
+}
+
+// Return sets up results that will be returned by LogPartitionRepository.LockAPILogMaintenance
+func (mmLockAPILogMaintenance *mLogPartitionRepositoryMockLockAPILogMaintenance) Return(locked bool, err error) *LogPartitionRepositoryMock {
+	if mmLockAPILogMaintenance.mock.funcLockAPILogMaintenance != nil {
+		mmLockAPILogMaintenance.mock.t.Fatalf("LogPartitionRepositoryMock.LockAPILogMaintenance mock is already set by Set")
+	}
+
+	if mmLockAPILogMaintenance.defaultExpectation == nil {
+		mmLockAPILogMaintenance.defaultExpectation = &LogPartitionRepositoryMockLockAPILogMaintenanceExpectation{mock: mmLockAPILogMaintenance.mock}
+	}
+	mmLockAPILogMaintenance.defaultExpectation.results = &LogPartitionRepositoryMockLockAPILogMaintenanceResults{locked, err}
+	return mmLockAPILogMaintenance.mock
+}
+
+// Set uses given function f to mock the LogPartitionRepository.LockAPILogMaintenance method
+func (mmLockAPILogMaintenance *mLogPartitionRepositoryMockLockAPILogMaintenance) Set(f func(ctx context.Context) (locked bool, err error)) *LogPartitionRepositoryMock {
+	if mmLockAPILogMaintenance.defaultExpectation != nil {
+		mmLockAPILogMaintenance.mock.t.Fatalf("Default expectation is already set for the LogPartitionRepository.LockAPILogMaintenance method")
+	}
+
+	if len(mmLockAPILogMaintenance.expectations) > 0 {
+		mmLockAPILogMaintenance.mock.t.Fatalf("Some expectations are already set for the LogPartitionRepository.LockAPILogMaintenance method")
+	}
+
+	mmLockAPILogMaintenance.mock.funcLockAPILogMaintenance = f
+	return mmLockAPILogMaintenance.mock
+}
+
+// When sets expectation for the LogPartitionRepository.LockAPILogMaintenance which will trigger the result defined by the following
+// Then helper
+func (mmLockAPILogMaintenance *mLogPartitionRepositoryMockLockAPILogMaintenance) When(ctx context.Context) *LogPartitionRepositoryMockLockAPILogMaintenanceExpectation {
+	if mmLockAPILogMaintenance.mock.funcLockAPILogMaintenance != nil {
+		mmLockAPILogMaintenance.mock.t.Fatalf("LogPartitionRepositoryMock.LockAPILogMaintenance mock is already set by Set")
+	}
+
+	expectation := &LogPartitionRepositoryMockLockAPILogMaintenanceExpectation{
+		mock:   mmLockAPILogMaintenance.mock,
+		params: &LogPartitionRepositoryMockLockAPILogMaintenanceParams{ctx},
+	}
+	mmLockAPILogMaintenance.expectations = append(mmLockAPILogMaintenance.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogPartitionRepository.LockAPILogMaintenance return parameters for the expectation previously defined by the When method
+func (e *LogPartitionRepositoryMockLockAPILogMaintenanceExpectation) Then(locked bool, err error) *LogPartitionRepositoryMock {
+	e.results = &LogPartitionRepositoryMockLockAPILogMaintenanceResults{locked, err}
+	return e.mock
+}
+
+// Times sets number of times LogPartitionRepository.LockAPILogMaintenance should be invoked
+func (mmLockAPILogMaintenance *mLogPartitionRepositoryMockLockAPILogMaintenance) Times(n uint64) *mLogPartitionRepositoryMockLockAPILogMaintenance {
+	if n == 0 {
+		mmLockAPILogMaintenance.mock.t.Fatalf("Times of LogPartitionRepositoryMock.LockAPILogMaintenance mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockAPILogMaintenance.expectedInvocations, n)
+	return mmLockAPILogMaintenance
+}
+
+func (mmLockAPILogMaintenance *mLogPartitionRepositoryMockLockAPILogMaintenance) invocationsDone() bool {
+	if len(mmLockAPILogMaintenance.expectations) == 0 && mmLockAPILogMaintenance.defaultExpectation == nil && mmLockAPILogMaintenance.mock.funcLockAPILogMaintenance == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockAPILogMaintenance.mock.afterLockAPILogMaintenanceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockAPILogMaintenance.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockAPILogMaintenance implements repository.LogPartitionRepository
+func (mmLockAPILogMaintenance *LogPartitionRepositoryMock) LockAPILogMaintenance(ctx context.Context) (locked bool, err error) {
+	mm_atomic.AddUint64(&mmLockAPILogMaintenance.beforeLockAPILogMaintenanceCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockAPILogMaintenance.afterLockAPILogMaintenanceCounter, 1)
+
+	if mmLockAPILogMaintenance.inspectFuncLockAPILogMaintenance != nil {
+		mmLockAPILogMaintenance.inspectFuncLockAPILogMaintenance(ctx)
+	}
+
+	mm_params := LogPartitionRepositoryMockLockAPILogMaintenanceParams{ctx}
+
+	// Record call args
+	mmLockAPILogMaintenance.LockAPILogMaintenanceMock.mutex.Lock()
+	mmLockAPILogMaintenance.LockAPILogMaintenanceMock.callArgs = append(mmLockAPILogMaintenance.LockAPILogMaintenanceMock.callArgs, &mm_params)
+	mmLockAPILogMaintenance.LockAPILogMaintenanceMock.mutex.Unlock()
+
+	for _, e := range mmLockAPILogMaintenance.LockAPILogMaintenanceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.locked, e.results.err
+		}
+	}
+
+	if mmLockAPILogMaintenance.LockAPILogMaintenanceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockAPILogMaintenance.LockAPILogMaintenanceMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockAPILogMaintenance.LockAPILogMaintenanceMock.defaultExpectation.params
+		mm_want_ptrs := mmLockAPILogMaintenance.LockAPILogMaintenanceMock.defaultExpectation.paramPtrs
+
+		mm_got := LogPartitionRepositoryMockLockAPILogMaintenanceParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockAPILogMaintenance.t.Errorf("LogPartitionRepositoryMock.LockAPILogMaintenance got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockAPILogMaintenance.t.Errorf("LogPartitionRepositoryMock.LockAPILogMaintenance got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockAPILogMaintenance.LockAPILogMaintenanceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockAPILogMaintenance.t.Fatal("No results are set for the LogPartitionRepositoryMock.LockAPILogMaintenance")
+		}
+		return (*mm_results).locked, (*mm_results).err
+	}
+	if mmLockAPILogMaintenance.funcLockAPILogMaintenance != nil {
+		return mmLockAPILogMaintenance.funcLockAPILogMaintenance(ctx)
+	}
+	mmLockAPILogMaintenance.t.Fatalf("Unexpected call to LogPartitionRepositoryMock.LockAPILogMaintenance. %v", ctx)
+	return
+}
+
+// LockAPILogMaintenanceAfterCounter returns a count of finished LogPartitionRepositoryMock.LockAPILogMaintenance invocations
+func (mmLockAPILogMaintenance *LogPartitionRepositoryMock) LockAPILogMaintenanceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockAPILogMaintenance.afterLockAPILogMaintenanceCounter)
+}
+
+// LockAPILogMaintenanceBeforeCounter returns a count of LogPartitionRepositoryMock.LockAPILogMaintenance invocations
+func (mmLockAPILogMaintenance *LogPartitionRepositoryMock) LockAPILogMaintenanceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockAPILogMaintenance.beforeLockAPILogMaintenanceCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogPartitionRepositoryMock.LockAPILogMaintenance.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockAPILogMaintenance *mLogPartitionRepositoryMockLockAPILogMaintenance) Calls() []*LogPartitionRepositoryMockLockAPILogMaintenanceParams {
+	mmLockAPILogMaintenance.mutex.RLock()
+
+	argCopy := make([]*LogPartitionRepositoryMockLockAPILogMaintenanceParams, len(mmLockAPILogMaintenance.callArgs))
+	copy(argCopy, mmLockAPILogMaintenance.callArgs)
+
+	mmLockAPILogMaintenance.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockAPILogMaintenanceDone returns true if the count of the LockAPILogMaintenance invocations corresponds
+// the number of defined expectations
+func (m *LogPartitionRepositoryMock) MinimockLockAPILogMaintenanceDone() bool {
+	if m.LockAPILogMaintenanceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockAPILogMaintenanceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockAPILogMaintenanceMock.invocationsDone()
+}
+
+// MinimockLockAPILogMaintenanceInspect logs each unmet expectation
+func (m *LogPartitionRepositoryMock) MinimockLockAPILogMaintenanceInspect() {
+	for _, e := range m.LockAPILogMaintenanceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogPartitionRepositoryMock.LockAPILogMaintenance with params: %#v", *e.params)
+		}
+	}
+
+	afterLockAPILogMaintenanceCounter := mm_atomic.LoadUint64(&m.afterLockAPILogMaintenanceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockAPILogMaintenanceMock.defaultExpectation != nil && afterLockAPILogMaintenanceCounter < 1 {
+		if m.LockAPILogMaintenanceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogPartitionRepositoryMock.LockAPILogMaintenance")
+		} else {
+			m.t.Errorf("Expected call to LogPartitionRepositoryMock.LockAPILogMaintenance with params: %#v", *m.LockAPILogMaintenanceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockAPILogMaintenance != nil && afterLockAPILogMaintenanceCounter < 1 {
+		m.t.Error("Expected call to LogPartitionRepositoryMock.LockAPILogMaintenance")
+	}
+
+	if !m.LockAPILogMaintenanceMock.invocationsDone() && afterLockAPILogMaintenanceCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogPartitionRepositoryMock.LockAPILogMaintenance but found %d calls",
+			mm_atomic.LoadUint64(&m.LockAPILogMaintenanceMock.expectedInvocations), afterLockAPILogMaintenanceCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LogPartitionRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateAPILogPartitionInspect()
+
+			m.MinimockDropAPILogPartitionInspect()
+
+			m.MinimockExportAPILogPartitionInspect()
+
+			m.MinimockListAPILogPartitionsInspect()
+
+			m.MinimockLockAPILogMaintenanceInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LogPartitionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LogPartitionRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateAPILogPartitionDone() &&
+		m.MinimockDropAPILogPartitionDone() &&
+		m.MinimockExportAPILogPartitionDone() &&
+		m.MinimockListAPILogPartitionsDone() &&
+		m.MinimockLockAPILogMaintenanceDone()
+}
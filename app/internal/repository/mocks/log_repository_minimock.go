@@ -24,6 +24,12 @@ type LogRepositoryMock struct {
 	afterCreateAPILogCounter  uint64
 	beforeCreateAPILogCounter uint64
 	CreateAPILogMock          mLogRepositoryMockCreateAPILog
+
+	funcListAPILogs          func(ctx context.Context, params model.ListAuditLogParams) (logs []model.AuditLogEntry, err error)
+	inspectFuncListAPILogs   func(ctx context.Context, params model.ListAuditLogParams)
+	afterListAPILogsCounter  uint64
+	beforeListAPILogsCounter uint64
+	ListAPILogsMock          mLogRepositoryMockListAPILogs
 }
 
 // NewLogRepositoryMock returns a mock for repository.LogRepository
@@ -37,6 +43,9 @@ func NewLogRepositoryMock(t minimock.Tester) *LogRepositoryMock {
 	m.CreateAPILogMock = mLogRepositoryMockCreateAPILog{mock: m}
 	m.CreateAPILogMock.callArgs = []*LogRepositoryMockCreateAPILogParams{}
 
+	m.ListAPILogsMock = mLogRepositoryMockListAPILogs{mock: m}
+	m.ListAPILogsMock.callArgs = []*LogRepositoryMockListAPILogsParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -362,11 +371,334 @@ func (m *LogRepositoryMock) MinimockCreateAPILogInspect() {
 	}
 }
 
+type mLogRepositoryMockListAPILogs struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockListAPILogsExpectation
+	expectations       []*LogRepositoryMockListAPILogsExpectation
+
+	callArgs []*LogRepositoryMockListAPILogsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogRepositoryMockListAPILogsExpectation specifies expectation struct of the LogRepository.ListAPILogs
+type LogRepositoryMockListAPILogsExpectation struct {
+	mock      *LogRepositoryMock
+	params    *LogRepositoryMockListAPILogsParams
+	paramPtrs *LogRepositoryMockListAPILogsParamPtrs
+	results   *LogRepositoryMockListAPILogsResults
+	Counter   uint64
+}
+
+// LogRepositoryMockListAPILogsParams contains parameters of the LogRepository.ListAPILogs
+type LogRepositoryMockListAPILogsParams struct {
+	ctx    context.Context
+	params model.ListAuditLogParams
+}
+
+// LogRepositoryMockListAPILogsParamPtrs contains pointers to parameters of the LogRepository.ListAPILogs
+type LogRepositoryMockListAPILogsParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListAuditLogParams
+}
+
+// LogRepositoryMockListAPILogsResults contains results of the LogRepository.ListAPILogs
+type LogRepositoryMockListAPILogsResults struct {
+	logs []model.AuditLogEntry
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) Optional() *mLogRepositoryMockListAPILogs {
+	mmListAPILogs.optional = true
+	return mmListAPILogs
+}
+
+// Expect sets up expected params for LogRepository.ListAPILogs
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) Expect(ctx context.Context, params model.ListAuditLogParams) *mLogRepositoryMockListAPILogs {
+	if mmListAPILogs.mock.funcListAPILogs != nil {
+		mmListAPILogs.mock.t.Fatalf("LogRepositoryMock.ListAPILogs mock is already set by Set")
+	}
+
+	if mmListAPILogs.defaultExpectation == nil {
+		mmListAPILogs.defaultExpectation = &LogRepositoryMockListAPILogsExpectation{}
+	}
+
+	if mmListAPILogs.defaultExpectation.paramPtrs != nil {
+		mmListAPILogs.mock.t.Fatalf("LogRepositoryMock.ListAPILogs mock is already set by ExpectParams functions")
+	}
+
+	mmListAPILogs.defaultExpectation.params = &LogRepositoryMockListAPILogsParams{ctx, params}
+	for _, e := range mmListAPILogs.expectations {
+		if minimock.Equal(e.params, mmListAPILogs.defaultExpectation.params) {
+			mmListAPILogs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAPILogs.defaultExpectation.params)
+		}
+	}
+
+	return mmListAPILogs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.ListAPILogs
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockListAPILogs {
+	if mmListAPILogs.mock.funcListAPILogs != nil {
+		mmListAPILogs.mock.t.Fatalf("LogRepositoryMock.ListAPILogs mock is already set by Set")
+	}
+
+	if mmListAPILogs.defaultExpectation == nil {
+		mmListAPILogs.defaultExpectation = &LogRepositoryMockListAPILogsExpectation{}
+	}
+
+	if mmListAPILogs.defaultExpectation.params != nil {
+		mmListAPILogs.mock.t.Fatalf("LogRepositoryMock.ListAPILogs mock is already set by Expect")
+	}
+
+	if mmListAPILogs.defaultExpectation.paramPtrs == nil {
+		mmListAPILogs.defaultExpectation.paramPtrs = &LogRepositoryMockListAPILogsParamPtrs{}
+	}
+	mmListAPILogs.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAPILogs
+}
+
+// ExpectParamsParam2 sets up expected param params for LogRepository.ListAPILogs
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) ExpectParamsParam2(params model.ListAuditLogParams) *mLogRepositoryMockListAPILogs {
+	if mmListAPILogs.mock.funcListAPILogs != nil {
+		mmListAPILogs.mock.t.Fatalf("LogRepositoryMock.ListAPILogs mock is already set by Set")
+	}
+
+	if mmListAPILogs.defaultExpectation == nil {
+		mmListAPILogs.defaultExpectation = &LogRepositoryMockListAPILogsExpectation{}
+	}
+
+	if mmListAPILogs.defaultExpectation.params != nil {
+		mmListAPILogs.mock.t.Fatalf("LogRepositoryMock.ListAPILogs mock is already set by Expect")
+	}
+
+	if mmListAPILogs.defaultExpectation.paramPtrs == nil {
+		mmListAPILogs.defaultExpectation.paramPtrs = &LogRepositoryMockListAPILogsParamPtrs{}
+	}
+	mmListAPILogs.defaultExpectation.paramPtrs.params = &params
+
+	return mmListAPILogs
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.ListAPILogs
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) Inspect(f func(ctx context.Context, params model.ListAuditLogParams)) *mLogRepositoryMockListAPILogs {
+	if mmListAPILogs.mock.inspectFuncListAPILogs != nil {
+		mmListAPILogs.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.ListAPILogs")
+	}
+
+	mmListAPILogs.mock.inspectFuncListAPILogs = f
+
+	return mmListAPILogs
+}
+
+// Return sets up results that will be returned by LogRepository.ListAPILogs
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) Return(logs []model.AuditLogEntry, err error) *LogRepositoryMock {
+	if mmListAPILogs.mock.funcListAPILogs != nil {
+		mmListAPILogs.mock.t.Fatalf("LogRepositoryMock.ListAPILogs mock is already set by Set")
+	}
+
+	if mmListAPILogs.defaultExpectation == nil {
+		mmListAPILogs.defaultExpectation = &LogRepositoryMockListAPILogsExpectation{mock: mmListAPILogs.mock}
+	}
+	mmListAPILogs.defaultExpectation.results = &LogRepositoryMockListAPILogsResults{logs, err}
+	return mmListAPILogs.mock
+}
+
+// Set uses given function f to mock the LogRepository.ListAPILogs method
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) Set(f func(ctx context.Context, params model.ListAuditLogParams) (logs []model.AuditLogEntry, err error)) *LogRepositoryMock {
+	if mmListAPILogs.defaultExpectation != nil {
+		mmListAPILogs.mock.t.Fatalf("Default expectation is already set for the LogRepository.ListAPILogs method")
+	}
+
+	if len(mmListAPILogs.expectations) > 0 {
+		mmListAPILogs.mock.t.Fatalf("Some expectations are already set for the LogRepository.ListAPILogs method")
+	}
+
+	mmListAPILogs.mock.funcListAPILogs = f
+	return mmListAPILogs.mock
+}
+
+// When sets expectation for the LogRepository.ListAPILogs which will trigger the result defined by the following
+// Then helper
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) When(ctx context.Context, params model.ListAuditLogParams) *LogRepositoryMockListAPILogsExpectation {
+	if mmListAPILogs.mock.funcListAPILogs != nil {
+		mmListAPILogs.mock.t.Fatalf("LogRepositoryMock.ListAPILogs mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockListAPILogsExpectation{
+		mock:   mmListAPILogs.mock,
+		params: &LogRepositoryMockListAPILogsParams{ctx, params},
+	}
+	mmListAPILogs.expectations = append(mmListAPILogs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.ListAPILogs return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockListAPILogsExpectation) Then(logs []model.AuditLogEntry, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockListAPILogsResults{logs, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.ListAPILogs should be invoked
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) Times(n uint64) *mLogRepositoryMockListAPILogs {
+	if n == 0 {
+		mmListAPILogs.mock.t.Fatalf("Times of LogRepositoryMock.ListAPILogs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAPILogs.expectedInvocations, n)
+	return mmListAPILogs
+}
+
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) invocationsDone() bool {
+	if len(mmListAPILogs.expectations) == 0 && mmListAPILogs.defaultExpectation == nil && mmListAPILogs.mock.funcListAPILogs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAPILogs.mock.afterListAPILogsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAPILogs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAPILogs implements repository.LogRepository
+func (mmListAPILogs *LogRepositoryMock) ListAPILogs(ctx context.Context, params model.ListAuditLogParams) (logs []model.AuditLogEntry, err error) {
+	mm_atomic.AddUint64(&mmListAPILogs.beforeListAPILogsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAPILogs.afterListAPILogsCounter, 1)
+
+	if mmListAPILogs.inspectFuncListAPILogs != nil {
+		mmListAPILogs.inspectFuncListAPILogs(ctx, params)
+	}
+
+	mm_params := LogRepositoryMockListAPILogsParams{ctx, params}
+
+	// Record call args
+	mmListAPILogs.ListAPILogsMock.mutex.Lock()
+	mmListAPILogs.ListAPILogsMock.callArgs = append(mmListAPILogs.ListAPILogsMock.callArgs, &mm_params)
+	mmListAPILogs.ListAPILogsMock.mutex.Unlock()
+
+	for _, e := range mmListAPILogs.ListAPILogsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.logs, e.results.err
+		}
+	}
+
+	if mmListAPILogs.ListAPILogsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAPILogs.ListAPILogsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAPILogs.ListAPILogsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAPILogs.ListAPILogsMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockListAPILogsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAPILogs.t.Errorf("LogRepositoryMock.ListAPILogs got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListAPILogs.t.Errorf("LogRepositoryMock.ListAPILogs got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAPILogs.t.Errorf("LogRepositoryMock.ListAPILogs got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAPILogs.ListAPILogsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAPILogs.t.Fatal("No results are set for the LogRepositoryMock.ListAPILogs")
+		}
+		return (*mm_results).logs, (*mm_results).err
+	}
+	if mmListAPILogs.funcListAPILogs != nil {
+		return mmListAPILogs.funcListAPILogs(ctx, params)
+	}
+	mmListAPILogs.t.Fatalf("Unexpected call to LogRepositoryMock.ListAPILogs. %v %v", ctx, params)
+	return
+}
+
+// ListAPILogsAfterCounter returns a count of finished LogRepositoryMock.ListAPILogs invocations
+func (mmListAPILogs *LogRepositoryMock) ListAPILogsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAPILogs.afterListAPILogsCounter)
+}
+
+// ListAPILogsBeforeCounter returns a count of LogRepositoryMock.ListAPILogs invocations
+func (mmListAPILogs *LogRepositoryMock) ListAPILogsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAPILogs.beforeListAPILogsCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.ListAPILogs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAPILogs *mLogRepositoryMockListAPILogs) Calls() []*LogRepositoryMockListAPILogsParams {
+	mmListAPILogs.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockListAPILogsParams, len(mmListAPILogs.callArgs))
+	copy(argCopy, mmListAPILogs.callArgs)
+
+	mmListAPILogs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAPILogsDone returns true if the count of the ListAPILogs invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockListAPILogsDone() bool {
+	if m.ListAPILogsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAPILogsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAPILogsMock.invocationsDone()
+}
+
+// MinimockListAPILogsInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockListAPILogsInspect() {
+	for _, e := range m.ListAPILogsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListAPILogs with params: %#v", *e.params)
+		}
+	}
+
+	afterListAPILogsCounter := mm_atomic.LoadUint64(&m.afterListAPILogsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAPILogsMock.defaultExpectation != nil && afterListAPILogsCounter < 1 {
+		if m.ListAPILogsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogRepositoryMock.ListAPILogs")
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListAPILogs with params: %#v", *m.ListAPILogsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAPILogs != nil && afterListAPILogsCounter < 1 {
+		m.t.Error("Expected call to LogRepositoryMock.ListAPILogs")
+	}
+
+	if !m.ListAPILogsMock.invocationsDone() && afterListAPILogsCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.ListAPILogs but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAPILogsMock.expectedInvocations), afterListAPILogsCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *LogRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockCreateAPILogInspect()
+
+			m.MinimockListAPILogsInspect()
 		}
 	})
 }
@@ -390,5 +722,6 @@ func (m *LogRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *LogRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockCreateAPILogDone()
+		m.MinimockCreateAPILogDone() &&
+		m.MinimockListAPILogsDone()
 }
@@ -0,0 +1,728 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.MentionRepository -o mention_repository_minimock.go -n MentionRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// MentionRepositoryMock implements repository.MentionRepository
+type MentionRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateMentions          func(ctx context.Context, params model.CreateMentionsParams) (users []model.MentionedUser, err error)
+	inspectFuncCreateMentions   func(ctx context.Context, params model.CreateMentionsParams)
+	afterCreateMentionsCounter  uint64
+	beforeCreateMentionsCounter uint64
+	CreateMentionsMock          mMentionRepositoryMockCreateMentions
+
+	funcListMentions          func(ctx context.Context, params model.ListMentionsParams) (mentions []model.Mention, err error)
+	inspectFuncListMentions   func(ctx context.Context, params model.ListMentionsParams)
+	afterListMentionsCounter  uint64
+	beforeListMentionsCounter uint64
+	ListMentionsMock          mMentionRepositoryMockListMentions
+}
+
+// NewMentionRepositoryMock returns a mock for repository.MentionRepository
+func NewMentionRepositoryMock(t minimock.Tester) *MentionRepositoryMock {
+	m := &MentionRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMentionsMock = mMentionRepositoryMockCreateMentions{mock: m}
+	m.CreateMentionsMock.callArgs = []*MentionRepositoryMockCreateMentionsParams{}
+
+	m.ListMentionsMock = mMentionRepositoryMockListMentions{mock: m}
+	m.ListMentionsMock.callArgs = []*MentionRepositoryMockListMentionsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMentionRepositoryMockCreateMentions struct {
+	optional           bool
+	mock               *MentionRepositoryMock
+	defaultExpectation *MentionRepositoryMockCreateMentionsExpectation
+	expectations       []*MentionRepositoryMockCreateMentionsExpectation
+
+	callArgs []*MentionRepositoryMockCreateMentionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// MentionRepositoryMockCreateMentionsExpectation specifies expectation struct of the MentionRepository.CreateMentions
+type MentionRepositoryMockCreateMentionsExpectation struct {
+	mock      *MentionRepositoryMock
+	params    *MentionRepositoryMockCreateMentionsParams
+	paramPtrs *MentionRepositoryMockCreateMentionsParamPtrs
+	results   *MentionRepositoryMockCreateMentionsResults
+	Counter   uint64
+}
+
+// MentionRepositoryMockCreateMentionsParams contains parameters of the MentionRepository.CreateMentions
+type MentionRepositoryMockCreateMentionsParams struct {
+	ctx    context.Context
+	params model.CreateMentionsParams
+}
+
+// MentionRepositoryMockCreateMentionsParamPtrs contains pointers to parameters of the MentionRepository.CreateMentions
+type MentionRepositoryMockCreateMentionsParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateMentionsParams
+}
+
+// MentionRepositoryMockCreateMentionsResults contains results of the MentionRepository.CreateMentions
+type MentionRepositoryMockCreateMentionsResults struct {
+	users []model.MentionedUser
+	err   error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) Optional() *mMentionRepositoryMockCreateMentions {
+	mmCreateMentions.optional = true
+	return mmCreateMentions
+}
+
+// Expect sets up expected params for MentionRepository.CreateMentions
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) Expect(ctx context.Context, params model.CreateMentionsParams) *mMentionRepositoryMockCreateMentions {
+	if mmCreateMentions.mock.funcCreateMentions != nil {
+		mmCreateMentions.mock.t.Fatalf("MentionRepositoryMock.CreateMentions mock is already set by Set")
+	}
+
+	if mmCreateMentions.defaultExpectation == nil {
+		mmCreateMentions.defaultExpectation = &MentionRepositoryMockCreateMentionsExpectation{}
+	}
+
+	if mmCreateMentions.defaultExpectation.paramPtrs != nil {
+		mmCreateMentions.mock.t.Fatalf("MentionRepositoryMock.CreateMentions mock is already set by ExpectParams functions")
+	}
+
+	mmCreateMentions.defaultExpectation.params = &MentionRepositoryMockCreateMentionsParams{ctx, params}
+	for _, e := range mmCreateMentions.expectations {
+		if minimock.Equal(e.params, mmCreateMentions.defaultExpectation.params) {
+			mmCreateMentions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateMentions.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateMentions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MentionRepository.CreateMentions
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) ExpectCtxParam1(ctx context.Context) *mMentionRepositoryMockCreateMentions {
+	if mmCreateMentions.mock.funcCreateMentions != nil {
+		mmCreateMentions.mock.t.Fatalf("MentionRepositoryMock.CreateMentions mock is already set by Set")
+	}
+
+	if mmCreateMentions.defaultExpectation == nil {
+		mmCreateMentions.defaultExpectation = &MentionRepositoryMockCreateMentionsExpectation{}
+	}
+
+	if mmCreateMentions.defaultExpectation.params != nil {
+		mmCreateMentions.mock.t.Fatalf("MentionRepositoryMock.CreateMentions mock is already set by Expect")
+	}
+
+	if mmCreateMentions.defaultExpectation.paramPtrs == nil {
+		mmCreateMentions.defaultExpectation.paramPtrs = &MentionRepositoryMockCreateMentionsParamPtrs{}
+	}
+	mmCreateMentions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateMentions
+}
+
+// ExpectParamsParam2 sets up expected param params for MentionRepository.CreateMentions
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) ExpectParamsParam2(params model.CreateMentionsParams) *mMentionRepositoryMockCreateMentions {
+	if mmCreateMentions.mock.funcCreateMentions != nil {
+		mmCreateMentions.mock.t.Fatalf("MentionRepositoryMock.CreateMentions mock is already set by Set")
+	}
+
+	if mmCreateMentions.defaultExpectation == nil {
+		mmCreateMentions.defaultExpectation = &MentionRepositoryMockCreateMentionsExpectation{}
+	}
+
+	if mmCreateMentions.defaultExpectation.params != nil {
+		mmCreateMentions.mock.t.Fatalf("MentionRepositoryMock.CreateMentions mock is already set by Expect")
+	}
+
+	if mmCreateMentions.defaultExpectation.paramPtrs == nil {
+		mmCreateMentions.defaultExpectation.paramPtrs = &MentionRepositoryMockCreateMentionsParamPtrs{}
+	}
+	mmCreateMentions.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateMentions
+}
+
+// Inspect accepts an inspector function that has same arguments as the MentionRepository.CreateMentions
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) Inspect(f func(ctx context.Context, params model.CreateMentionsParams)) *mMentionRepositoryMockCreateMentions {
+	if mmCreateMentions.mock.inspectFuncCreateMentions != nil {
+		mmCreateMentions.mock.t.Fatalf("Inspect function is already set for MentionRepositoryMock.CreateMentions")
+	}
+
+	mmCreateMentions.mock.inspectFuncCreateMentions = f
+
+	return mmCreateMentions
+}
+
+// Return sets up results that will be returned by MentionRepository.CreateMentions
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) Return(users []model.MentionedUser, err error) *MentionRepositoryMock {
+	if mmCreateMentions.mock.funcCreateMentions != nil {
+		mmCreateMentions.mock.t.Fatalf("MentionRepositoryMock.CreateMentions mock is already set by Set")
+	}
+
+	if mmCreateMentions.defaultExpectation == nil {
+		mmCreateMentions.defaultExpectation = &MentionRepositoryMockCreateMentionsExpectation{mock: mmCreateMentions.mock}
+	}
+	mmCreateMentions.defaultExpectation.results = &MentionRepositoryMockCreateMentionsResults{users, err}
+	return mmCreateMentions.mock
+}
+
+// Set uses given function f to mock the MentionRepository.CreateMentions method
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) Set(f func(ctx context.Context, params model.CreateMentionsParams) (users []model.MentionedUser, err error)) *MentionRepositoryMock {
+	if mmCreateMentions.defaultExpectation != nil {
+		mmCreateMentions.mock.t.Fatalf("Default expectation is already set for the MentionRepository.CreateMentions method")
+	}
+
+	if len(mmCreateMentions.expectations) > 0 {
+		mmCreateMentions.mock.t.Fatalf("Some expectations are already set for the MentionRepository.CreateMentions method")
+	}
+
+	mmCreateMentions.mock.funcCreateMentions = f
+	return mmCreateMentions.mock
+}
+
+// When sets expectation for the MentionRepository.CreateMentions which will trigger the result defined by the following
+// Then helper
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) When(ctx context.Context, params model.CreateMentionsParams) *MentionRepositoryMockCreateMentionsExpectation {
+	if mmCreateMentions.mock.funcCreateMentions != nil {
+		mmCreateMentions.mock.t.Fatalf("MentionRepositoryMock.CreateMentions mock is already set by Set")
+	}
+
+	expectation := &MentionRepositoryMockCreateMentionsExpectation{
+		mock:   mmCreateMentions.mock,
+		params: &MentionRepositoryMockCreateMentionsParams{ctx, params},
+	}
+	mmCreateMentions.expectations = append(mmCreateMentions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MentionRepository.CreateMentions return parameters for the expectation previously defined by the When method
+func (e *MentionRepositoryMockCreateMentionsExpectation) Then(users []model.MentionedUser, err error) *MentionRepositoryMock {
+	e.results = &MentionRepositoryMockCreateMentionsResults{users, err}
+	return e.mock
+}
+
+// Times sets number of times MentionRepository.CreateMentions should be invoked
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) Times(n uint64) *mMentionRepositoryMockCreateMentions {
+	if n == 0 {
+		mmCreateMentions.mock.t.Fatalf("Times of MentionRepositoryMock.CreateMentions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateMentions.expectedInvocations, n)
+	return mmCreateMentions
+}
+
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) invocationsDone() bool {
+	if len(mmCreateMentions.expectations) == 0 && mmCreateMentions.defaultExpectation == nil && mmCreateMentions.mock.funcCreateMentions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateMentions.mock.afterCreateMentionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateMentions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateMentions implements repository.MentionRepository
+func (mmCreateMentions *MentionRepositoryMock) CreateMentions(ctx context.Context, params model.CreateMentionsParams) (users []model.MentionedUser, err error) {
+	mm_atomic.AddUint64(&mmCreateMentions.beforeCreateMentionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateMentions.afterCreateMentionsCounter, 1)
+
+	if mmCreateMentions.inspectFuncCreateMentions != nil {
+		mmCreateMentions.inspectFuncCreateMentions(ctx, params)
+	}
+
+	mm_params := MentionRepositoryMockCreateMentionsParams{ctx, params}
+
+	// Record call args
+	mmCreateMentions.CreateMentionsMock.mutex.Lock()
+	mmCreateMentions.CreateMentionsMock.callArgs = append(mmCreateMentions.CreateMentionsMock.callArgs, &mm_params)
+	mmCreateMentions.CreateMentionsMock.mutex.Unlock()
+
+	for _, e := range mmCreateMentions.CreateMentionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.users, e.results.err
+		}
+	}
+
+	if mmCreateMentions.CreateMentionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateMentions.CreateMentionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateMentions.CreateMentionsMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateMentions.CreateMentionsMock.defaultExpectation.paramPtrs
+
+		mm_got := MentionRepositoryMockCreateMentionsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateMentions.t.Errorf("MentionRepositoryMock.CreateMentions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateMentions.t.Errorf("MentionRepositoryMock.CreateMentions got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateMentions.t.Errorf("MentionRepositoryMock.CreateMentions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateMentions.CreateMentionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateMentions.t.Fatal("No results are set for the MentionRepositoryMock.CreateMentions")
+		}
+		return (*mm_results).users, (*mm_results).err
+	}
+	if mmCreateMentions.funcCreateMentions != nil {
+		return mmCreateMentions.funcCreateMentions(ctx, params)
+	}
+	mmCreateMentions.t.Fatalf("Unexpected call to MentionRepositoryMock.CreateMentions. %v %v", ctx, params)
+	return
+}
+
+// CreateMentionsAfterCounter returns a count of finished MentionRepositoryMock.CreateMentions invocations
+func (mmCreateMentions *MentionRepositoryMock) CreateMentionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateMentions.afterCreateMentionsCounter)
+}
+
+// CreateMentionsBeforeCounter returns a count of MentionRepositoryMock.CreateMentions invocations
+func (mmCreateMentions *MentionRepositoryMock) CreateMentionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateMentions.beforeCreateMentionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MentionRepositoryMock.CreateMentions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateMentions *mMentionRepositoryMockCreateMentions) Calls() []*MentionRepositoryMockCreateMentionsParams {
+	mmCreateMentions.mutex.RLock()
+
+	argCopy := make([]*MentionRepositoryMockCreateMentionsParams, len(mmCreateMentions.callArgs))
+	copy(argCopy, mmCreateMentions.callArgs)
+
+	mmCreateMentions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateMentionsDone returns true if the count of the CreateMentions invocations corresponds
+// the number of defined expectations
+func (m *MentionRepositoryMock) MinimockCreateMentionsDone() bool {
+	if m.CreateMentionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMentionsMock.invocationsDone()
+}
+
+// MinimockCreateMentionsInspect logs each unmet expectation
+func (m *MentionRepositoryMock) MinimockCreateMentionsInspect() {
+	for _, e := range m.CreateMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MentionRepositoryMock.CreateMentions with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateMentionsCounter := mm_atomic.LoadUint64(&m.afterCreateMentionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMentionsMock.defaultExpectation != nil && afterCreateMentionsCounter < 1 {
+		if m.CreateMentionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MentionRepositoryMock.CreateMentions")
+		} else {
+			m.t.Errorf("Expected call to MentionRepositoryMock.CreateMentions with params: %#v", *m.CreateMentionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateMentions != nil && afterCreateMentionsCounter < 1 {
+		m.t.Error("Expected call to MentionRepositoryMock.CreateMentions")
+	}
+
+	if !m.CreateMentionsMock.invocationsDone() && afterCreateMentionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MentionRepositoryMock.CreateMentions but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMentionsMock.expectedInvocations), afterCreateMentionsCounter)
+	}
+}
+
+type mMentionRepositoryMockListMentions struct {
+	optional           bool
+	mock               *MentionRepositoryMock
+	defaultExpectation *MentionRepositoryMockListMentionsExpectation
+	expectations       []*MentionRepositoryMockListMentionsExpectation
+
+	callArgs []*MentionRepositoryMockListMentionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// MentionRepositoryMockListMentionsExpectation specifies expectation struct of the MentionRepository.ListMentions
+type MentionRepositoryMockListMentionsExpectation struct {
+	mock      *MentionRepositoryMock
+	params    *MentionRepositoryMockListMentionsParams
+	paramPtrs *MentionRepositoryMockListMentionsParamPtrs
+	results   *MentionRepositoryMockListMentionsResults
+	Counter   uint64
+}
+
+// MentionRepositoryMockListMentionsParams contains parameters of the MentionRepository.ListMentions
+type MentionRepositoryMockListMentionsParams struct {
+	ctx    context.Context
+	params model.ListMentionsParams
+}
+
+// MentionRepositoryMockListMentionsParamPtrs contains pointers to parameters of the MentionRepository.ListMentions
+type MentionRepositoryMockListMentionsParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListMentionsParams
+}
+
+// MentionRepositoryMockListMentionsResults contains results of the MentionRepository.ListMentions
+type MentionRepositoryMockListMentionsResults struct {
+	mentions []model.Mention
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMentions *mMentionRepositoryMockListMentions) Optional() *mMentionRepositoryMockListMentions {
+	mmListMentions.optional = true
+	return mmListMentions
+}
+
+// Expect sets up expected params for MentionRepository.ListMentions
+func (mmListMentions *mMentionRepositoryMockListMentions) Expect(ctx context.Context, params model.ListMentionsParams) *mMentionRepositoryMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("MentionRepositoryMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &MentionRepositoryMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs != nil {
+		mmListMentions.mock.t.Fatalf("MentionRepositoryMock.ListMentions mock is already set by ExpectParams functions")
+	}
+
+	mmListMentions.defaultExpectation.params = &MentionRepositoryMockListMentionsParams{ctx, params}
+	for _, e := range mmListMentions.expectations {
+		if minimock.Equal(e.params, mmListMentions.defaultExpectation.params) {
+			mmListMentions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMentions.defaultExpectation.params)
+		}
+	}
+
+	return mmListMentions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MentionRepository.ListMentions
+func (mmListMentions *mMentionRepositoryMockListMentions) ExpectCtxParam1(ctx context.Context) *mMentionRepositoryMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("MentionRepositoryMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &MentionRepositoryMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("MentionRepositoryMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &MentionRepositoryMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListMentions
+}
+
+// ExpectParamsParam2 sets up expected param params for MentionRepository.ListMentions
+func (mmListMentions *mMentionRepositoryMockListMentions) ExpectParamsParam2(params model.ListMentionsParams) *mMentionRepositoryMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("MentionRepositoryMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &MentionRepositoryMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("MentionRepositoryMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &MentionRepositoryMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.params = &params
+
+	return mmListMentions
+}
+
+// Inspect accepts an inspector function that has same arguments as the MentionRepository.ListMentions
+func (mmListMentions *mMentionRepositoryMockListMentions) Inspect(f func(ctx context.Context, params model.ListMentionsParams)) *mMentionRepositoryMockListMentions {
+	if mmListMentions.mock.inspectFuncListMentions != nil {
+		mmListMentions.mock.t.Fatalf("Inspect function is already set for MentionRepositoryMock.ListMentions")
+	}
+
+	mmListMentions.mock.inspectFuncListMentions = f
+
+	return mmListMentions
+}
+
+// Return sets up results that will be returned by MentionRepository.ListMentions
+func (mmListMentions *mMentionRepositoryMockListMentions) Return(mentions []model.Mention, err error) *MentionRepositoryMock {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("MentionRepositoryMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &MentionRepositoryMockListMentionsExpectation{mock: mmListMentions.mock}
+	}
+	mmListMentions.defaultExpectation.results = &MentionRepositoryMockListMentionsResults{mentions, err}
+	return mmListMentions.mock
+}
+
+// Set uses given function f to mock the MentionRepository.ListMentions method
+func (mmListMentions *mMentionRepositoryMockListMentions) Set(f func(ctx context.Context, params model.ListMentionsParams) (mentions []model.Mention, err error)) *MentionRepositoryMock {
+	if mmListMentions.defaultExpectation != nil {
+		mmListMentions.mock.t.Fatalf("Default expectation is already set for the MentionRepository.ListMentions method")
+	}
+
+	if len(mmListMentions.expectations) > 0 {
+		mmListMentions.mock.t.Fatalf("Some expectations are already set for the MentionRepository.ListMentions method")
+	}
+
+	mmListMentions.mock.funcListMentions = f
+	return mmListMentions.mock
+}
+
+// When sets expectation for the MentionRepository.ListMentions which will trigger the result defined by the following
+// Then helper
+func (mmListMentions *mMentionRepositoryMockListMentions) When(ctx context.Context, params model.ListMentionsParams) *MentionRepositoryMockListMentionsExpectation {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("MentionRepositoryMock.ListMentions mock is already set by Set")
+	}
+
+	expectation := &MentionRepositoryMockListMentionsExpectation{
+		mock:   mmListMentions.mock,
+		params: &MentionRepositoryMockListMentionsParams{ctx, params},
+	}
+	mmListMentions.expectations = append(mmListMentions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MentionRepository.ListMentions return parameters for the expectation previously defined by the When method
+func (e *MentionRepositoryMockListMentionsExpectation) Then(mentions []model.Mention, err error) *MentionRepositoryMock {
+	e.results = &MentionRepositoryMockListMentionsResults{mentions, err}
+	return e.mock
+}
+
+// Times sets number of times MentionRepository.ListMentions should be invoked
+func (mmListMentions *mMentionRepositoryMockListMentions) Times(n uint64) *mMentionRepositoryMockListMentions {
+	if n == 0 {
+		mmListMentions.mock.t.Fatalf("Times of MentionRepositoryMock.ListMentions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMentions.expectedInvocations, n)
+	return mmListMentions
+}
+
+func (mmListMentions *mMentionRepositoryMockListMentions) invocationsDone() bool {
+	if len(mmListMentions.expectations) == 0 && mmListMentions.defaultExpectation == nil && mmListMentions.mock.funcListMentions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMentions.mock.afterListMentionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMentions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMentions implements repository.MentionRepository
+func (mmListMentions *MentionRepositoryMock) ListMentions(ctx context.Context, params model.ListMentionsParams) (mentions []model.Mention, err error) {
+	mm_atomic.AddUint64(&mmListMentions.beforeListMentionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMentions.afterListMentionsCounter, 1)
+
+	if mmListMentions.inspectFuncListMentions != nil {
+		mmListMentions.inspectFuncListMentions(ctx, params)
+	}
+
+	mm_params := MentionRepositoryMockListMentionsParams{ctx, params}
+
+	// Record call args
+	mmListMentions.ListMentionsMock.mutex.Lock()
+	mmListMentions.ListMentionsMock.callArgs = append(mmListMentions.ListMentionsMock.callArgs, &mm_params)
+	mmListMentions.ListMentionsMock.mutex.Unlock()
+
+	for _, e := range mmListMentions.ListMentionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mentions, e.results.err
+		}
+	}
+
+	if mmListMentions.ListMentionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMentions.ListMentionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMentions.ListMentionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListMentions.ListMentionsMock.defaultExpectation.paramPtrs
+
+		mm_got := MentionRepositoryMockListMentionsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMentions.t.Errorf("MentionRepositoryMock.ListMentions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListMentions.t.Errorf("MentionRepositoryMock.ListMentions got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMentions.t.Errorf("MentionRepositoryMock.ListMentions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMentions.ListMentionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMentions.t.Fatal("No results are set for the MentionRepositoryMock.ListMentions")
+		}
+		return (*mm_results).mentions, (*mm_results).err
+	}
+	if mmListMentions.funcListMentions != nil {
+		return mmListMentions.funcListMentions(ctx, params)
+	}
+	mmListMentions.t.Fatalf("Unexpected call to MentionRepositoryMock.ListMentions. %v %v", ctx, params)
+	return
+}
+
+// ListMentionsAfterCounter returns a count of finished MentionRepositoryMock.ListMentions invocations
+func (mmListMentions *MentionRepositoryMock) ListMentionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentions.afterListMentionsCounter)
+}
+
+// ListMentionsBeforeCounter returns a count of MentionRepositoryMock.ListMentions invocations
+func (mmListMentions *MentionRepositoryMock) ListMentionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentions.beforeListMentionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MentionRepositoryMock.ListMentions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMentions *mMentionRepositoryMockListMentions) Calls() []*MentionRepositoryMockListMentionsParams {
+	mmListMentions.mutex.RLock()
+
+	argCopy := make([]*MentionRepositoryMockListMentionsParams, len(mmListMentions.callArgs))
+	copy(argCopy, mmListMentions.callArgs)
+
+	mmListMentions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMentionsDone returns true if the count of the ListMentions invocations corresponds
+// the number of defined expectations
+func (m *MentionRepositoryMock) MinimockListMentionsDone() bool {
+	if m.ListMentionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMentionsMock.invocationsDone()
+}
+
+// MinimockListMentionsInspect logs each unmet expectation
+func (m *MentionRepositoryMock) MinimockListMentionsInspect() {
+	for _, e := range m.ListMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MentionRepositoryMock.ListMentions with params: %#v", *e.params)
+		}
+	}
+
+	afterListMentionsCounter := mm_atomic.LoadUint64(&m.afterListMentionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMentionsMock.defaultExpectation != nil && afterListMentionsCounter < 1 {
+		if m.ListMentionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MentionRepositoryMock.ListMentions")
+		} else {
+			m.t.Errorf("Expected call to MentionRepositoryMock.ListMentions with params: %#v", *m.ListMentionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMentions != nil && afterListMentionsCounter < 1 {
+		m.t.Error("Expected call to MentionRepositoryMock.ListMentions")
+	}
+
+	if !m.ListMentionsMock.invocationsDone() && afterListMentionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MentionRepositoryMock.ListMentions but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMentionsMock.expectedInvocations), afterListMentionsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MentionRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateMentionsInspect()
+
+			m.MinimockListMentionsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MentionRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MentionRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateMentionsDone() &&
+		m.MinimockListMentionsDone()
+}
@@ -0,0 +1,2750 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.NotificationRepository -o notification_repository_minimock.go -n NotificationRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// NotificationRepositoryMock implements repository.NotificationRepository
+type NotificationRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaimDueRecipients          func(ctx context.Context, params model.ClaimDueRecipientsParams) (recipients []model.NotificationRecipient, err error)
+	inspectFuncClaimDueRecipients   func(ctx context.Context, params model.ClaimDueRecipientsParams)
+	afterClaimDueRecipientsCounter  uint64
+	beforeClaimDueRecipientsCounter uint64
+	ClaimDueRecipientsMock          mNotificationRepositoryMockClaimDueRecipients
+
+	funcCreateNotifications          func(ctx context.Context, event model.Event) (err error)
+	inspectFuncCreateNotifications   func(ctx context.Context, event model.Event)
+	afterCreateNotificationsCounter  uint64
+	beforeCreateNotificationsCounter uint64
+	CreateNotificationsMock          mNotificationRepositoryMockCreateNotifications
+
+	funcDeleteFinishedNotifications          func(ctx context.Context, before time.Time) (deleted int64, err error)
+	inspectFuncDeleteFinishedNotifications   func(ctx context.Context, before time.Time)
+	afterDeleteFinishedNotificationsCounter  uint64
+	beforeDeleteFinishedNotificationsCounter uint64
+	DeleteFinishedNotificationsMock          mNotificationRepositoryMockDeleteFinishedNotifications
+
+	funcFinishNotifications          func(ctx context.Context, params model.FinishNotificationsParams) (err error)
+	inspectFuncFinishNotifications   func(ctx context.Context, params model.FinishNotificationsParams)
+	afterFinishNotificationsCounter  uint64
+	beforeFinishNotificationsCounter uint64
+	FinishNotificationsMock          mNotificationRepositoryMockFinishNotifications
+
+	funcListPendingNotifications          func(ctx context.Context, userID int64, limit int) (notifications []model.Notification, err error)
+	inspectFuncListPendingNotifications   func(ctx context.Context, userID int64, limit int)
+	afterListPendingNotificationsCounter  uint64
+	beforeListPendingNotificationsCounter uint64
+	ListPendingNotificationsMock          mNotificationRepositoryMockListPendingNotifications
+
+	funcRecordNotificationsFailure          func(ctx context.Context, params model.RecordNotificationsFailureParams) (err error)
+	inspectFuncRecordNotificationsFailure   func(ctx context.Context, params model.RecordNotificationsFailureParams)
+	afterRecordNotificationsFailureCounter  uint64
+	beforeRecordNotificationsFailureCounter uint64
+	RecordNotificationsFailureMock          mNotificationRepositoryMockRecordNotificationsFailure
+
+	funcReleaseRecipient          func(ctx context.Context, userID int64) (err error)
+	inspectFuncReleaseRecipient   func(ctx context.Context, userID int64)
+	afterReleaseRecipientCounter  uint64
+	beforeReleaseRecipientCounter uint64
+	ReleaseRecipientMock          mNotificationRepositoryMockReleaseRecipient
+
+	funcUpdateRecipient          func(ctx context.Context, params model.UpdateRecipientParams) (err error)
+	inspectFuncUpdateRecipient   func(ctx context.Context, params model.UpdateRecipientParams)
+	afterUpdateRecipientCounter  uint64
+	beforeUpdateRecipientCounter uint64
+	UpdateRecipientMock          mNotificationRepositoryMockUpdateRecipient
+}
+
+// NewNotificationRepositoryMock returns a mock for repository.NotificationRepository
+func NewNotificationRepositoryMock(t minimock.Tester) *NotificationRepositoryMock {
+	m := &NotificationRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimDueRecipientsMock = mNotificationRepositoryMockClaimDueRecipients{mock: m}
+	m.ClaimDueRecipientsMock.callArgs = []*NotificationRepositoryMockClaimDueRecipientsParams{}
+
+	m.CreateNotificationsMock = mNotificationRepositoryMockCreateNotifications{mock: m}
+	m.CreateNotificationsMock.callArgs = []*NotificationRepositoryMockCreateNotificationsParams{}
+
+	m.DeleteFinishedNotificationsMock = mNotificationRepositoryMockDeleteFinishedNotifications{mock: m}
+	m.DeleteFinishedNotificationsMock.callArgs = []*NotificationRepositoryMockDeleteFinishedNotificationsParams{}
+
+	m.FinishNotificationsMock = mNotificationRepositoryMockFinishNotifications{mock: m}
+	m.FinishNotificationsMock.callArgs = []*NotificationRepositoryMockFinishNotificationsParams{}
+
+	m.ListPendingNotificationsMock = mNotificationRepositoryMockListPendingNotifications{mock: m}
+	m.ListPendingNotificationsMock.callArgs = []*NotificationRepositoryMockListPendingNotificationsParams{}
+
+	m.RecordNotificationsFailureMock = mNotificationRepositoryMockRecordNotificationsFailure{mock: m}
+	m.RecordNotificationsFailureMock.callArgs = []*NotificationRepositoryMockRecordNotificationsFailureParams{}
+
+	m.ReleaseRecipientMock = mNotificationRepositoryMockReleaseRecipient{mock: m}
+	m.ReleaseRecipientMock.callArgs = []*NotificationRepositoryMockReleaseRecipientParams{}
+
+	m.UpdateRecipientMock = mNotificationRepositoryMockUpdateRecipient{mock: m}
+	m.UpdateRecipientMock.callArgs = []*NotificationRepositoryMockUpdateRecipientParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mNotificationRepositoryMockClaimDueRecipients struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockClaimDueRecipientsExpectation
+	expectations       []*NotificationRepositoryMockClaimDueRecipientsExpectation
+
+	callArgs []*NotificationRepositoryMockClaimDueRecipientsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// NotificationRepositoryMockClaimDueRecipientsExpectation specifies expectation struct of the NotificationRepository.ClaimDueRecipients
+type NotificationRepositoryMockClaimDueRecipientsExpectation struct {
+	mock      *NotificationRepositoryMock
+	params    *NotificationRepositoryMockClaimDueRecipientsParams
+	paramPtrs *NotificationRepositoryMockClaimDueRecipientsParamPtrs
+	results   *NotificationRepositoryMockClaimDueRecipientsResults
+	Counter   uint64
+}
+
+// NotificationRepositoryMockClaimDueRecipientsParams contains parameters of the NotificationRepository.ClaimDueRecipients
+type NotificationRepositoryMockClaimDueRecipientsParams struct {
+	ctx    context.Context
+	params model.ClaimDueRecipientsParams
+}
+
+// NotificationRepositoryMockClaimDueRecipientsParamPtrs contains pointers to parameters of the NotificationRepository.ClaimDueRecipients
+type NotificationRepositoryMockClaimDueRecipientsParamPtrs struct {
+	ctx    *context.Context
+	params *model.ClaimDueRecipientsParams
+}
+
+// NotificationRepositoryMockClaimDueRecipientsResults contains results of the NotificationRepository.ClaimDueRecipients
+type NotificationRepositoryMockClaimDueRecipientsResults struct {
+	recipients []model.NotificationRecipient
+	err        error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) Optional() *mNotificationRepositoryMockClaimDueRecipients {
+	mmClaimDueRecipients.optional = true
+	return mmClaimDueRecipients
+}
+
+// Expect sets up expected params for NotificationRepository.ClaimDueRecipients
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) Expect(ctx context.Context, params model.ClaimDueRecipientsParams) *mNotificationRepositoryMockClaimDueRecipients {
+	if mmClaimDueRecipients.mock.funcClaimDueRecipients != nil {
+		mmClaimDueRecipients.mock.t.Fatalf("NotificationRepositoryMock.ClaimDueRecipients mock is already set by Set")
+	}
+
+	if mmClaimDueRecipients.defaultExpectation == nil {
+		mmClaimDueRecipients.defaultExpectation = &NotificationRepositoryMockClaimDueRecipientsExpectation{}
+	}
+
+	if mmClaimDueRecipients.defaultExpectation.paramPtrs != nil {
+		mmClaimDueRecipients.mock.t.Fatalf("NotificationRepositoryMock.ClaimDueRecipients mock is already set by ExpectParams functions")
+	}
+
+	mmClaimDueRecipients.defaultExpectation.params = &NotificationRepositoryMockClaimDueRecipientsParams{ctx, params}
+	for _, e := range mmClaimDueRecipients.expectations {
+		if minimock.Equal(e.params, mmClaimDueRecipients.defaultExpectation.params) {
+			mmClaimDueRecipients.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimDueRecipients.defaultExpectation.params)
+		}
+	}
+
+	return mmClaimDueRecipients
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.ClaimDueRecipients
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockClaimDueRecipients {
+	if mmClaimDueRecipients.mock.funcClaimDueRecipients != nil {
+		mmClaimDueRecipients.mock.t.Fatalf("NotificationRepositoryMock.ClaimDueRecipients mock is already set by Set")
+	}
+
+	if mmClaimDueRecipients.defaultExpectation == nil {
+		mmClaimDueRecipients.defaultExpectation = &NotificationRepositoryMockClaimDueRecipientsExpectation{}
+	}
+
+	if mmClaimDueRecipients.defaultExpectation.params != nil {
+		mmClaimDueRecipients.mock.t.Fatalf("NotificationRepositoryMock.ClaimDueRecipients mock is already set by Expect")
+	}
+
+	if mmClaimDueRecipients.defaultExpectation.paramPtrs == nil {
+		mmClaimDueRecipients.defaultExpectation.paramPtrs = &NotificationRepositoryMockClaimDueRecipientsParamPtrs{}
+	}
+	mmClaimDueRecipients.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmClaimDueRecipients
+}
+
+// ExpectParamsParam2 sets up expected param params for NotificationRepository.ClaimDueRecipients
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) ExpectParamsParam2(params model.ClaimDueRecipientsParams) *mNotificationRepositoryMockClaimDueRecipients {
+	if mmClaimDueRecipients.mock.funcClaimDueRecipients != nil {
+		mmClaimDueRecipients.mock.t.Fatalf("NotificationRepositoryMock.ClaimDueRecipients mock is already set by Set")
+	}
+
+	if mmClaimDueRecipients.defaultExpectation == nil {
+		mmClaimDueRecipients.defaultExpectation = &NotificationRepositoryMockClaimDueRecipientsExpectation{}
+	}
+
+	if mmClaimDueRecipients.defaultExpectation.params != nil {
+		mmClaimDueRecipients.mock.t.Fatalf("NotificationRepositoryMock.ClaimDueRecipients mock is already set by Expect")
+	}
+
+	if mmClaimDueRecipients.defaultExpectation.paramPtrs == nil {
+		mmClaimDueRecipients.defaultExpectation.paramPtrs = &NotificationRepositoryMockClaimDueRecipientsParamPtrs{}
+	}
+	mmClaimDueRecipients.defaultExpectation.paramPtrs.params = &params
+
+	return mmClaimDueRecipients
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.ClaimDueRecipients
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) Inspect(f func(ctx context.Context, params model.ClaimDueRecipientsParams)) *mNotificationRepositoryMockClaimDueRecipients {
+	if mmClaimDueRecipients.mock.inspectFuncClaimDueRecipients != nil {
+		mmClaimDueRecipients.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.ClaimDueRecipients")
+	}
+
+	mmClaimDueRecipients.mock.inspectFuncClaimDueRecipients = f
+
+	return mmClaimDueRecipients
+}
+
+// Return sets up results that will be returned by NotificationRepository.ClaimDueRecipients
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) Return(recipients []model.NotificationRecipient, err error) *NotificationRepositoryMock {
+	if mmClaimDueRecipients.mock.funcClaimDueRecipients != nil {
+		mmClaimDueRecipients.mock.t.Fatalf("NotificationRepositoryMock.ClaimDueRecipients mock is already set by Set")
+	}
+
+	if mmClaimDueRecipients.defaultExpectation == nil {
+		mmClaimDueRecipients.defaultExpectation = &NotificationRepositoryMockClaimDueRecipientsExpectation{mock: mmClaimDueRecipients.mock}
+	}
+	mmClaimDueRecipients.defaultExpectation.results = &NotificationRepositoryMockClaimDueRecipientsResults{recipients, err}
+	return mmClaimDueRecipients.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.ClaimDueRecipients method
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) Set(f func(ctx context.Context, params model.ClaimDueRecipientsParams) (recipients []model.NotificationRecipient, err error)) *NotificationRepositoryMock {
+	if mmClaimDueRecipients.defaultExpectation != nil {
+		mmClaimDueRecipients.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.ClaimDueRecipients method")
+	}
+
+	if len(mmClaimDueRecipients.expectations) > 0 {
+		mmClaimDueRecipients.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.ClaimDueRecipients method")
+	}
+
+	mmClaimDueRecipients.mock.funcClaimDueRecipients = f
+	return mmClaimDueRecipients.mock
+}
+
+// When sets expectation for the NotificationRepository.ClaimDueRecipients which will trigger the result defined by the following
+// Then helper
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) When(ctx context.Context, params model.ClaimDueRecipientsParams) *NotificationRepositoryMockClaimDueRecipientsExpectation {
+	if mmClaimDueRecipients.mock.funcClaimDueRecipients != nil {
+		mmClaimDueRecipients.mock.t.Fatalf("NotificationRepositoryMock.ClaimDueRecipients mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockClaimDueRecipientsExpectation{
+		mock:   mmClaimDueRecipients.mock,
+		params: &NotificationRepositoryMockClaimDueRecipientsParams{ctx, params},
+	}
+	mmClaimDueRecipients.expectations = append(mmClaimDueRecipients.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.ClaimDueRecipients return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockClaimDueRecipientsExpectation) Then(recipients []model.NotificationRecipient, err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockClaimDueRecipientsResults{recipients, err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.ClaimDueRecipients should be invoked
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) Times(n uint64) *mNotificationRepositoryMockClaimDueRecipients {
+	if n == 0 {
+		mmClaimDueRecipients.mock.t.Fatalf("Times of NotificationRepositoryMock.ClaimDueRecipients mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimDueRecipients.expectedInvocations, n)
+	return mmClaimDueRecipients
+}
+
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) invocationsDone() bool {
+	if len(mmClaimDueRecipients.expectations) == 0 && mmClaimDueRecipients.defaultExpectation == nil && mmClaimDueRecipients.mock.funcClaimDueRecipients == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimDueRecipients.mock.afterClaimDueRecipientsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimDueRecipients.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimDueRecipients implements repository.NotificationRepository
+func (mmClaimDueRecipients *NotificationRepositoryMock) ClaimDueRecipients(ctx context.Context, params model.ClaimDueRecipientsParams) (recipients []model.NotificationRecipient, err error) {
+	mm_atomic.AddUint64(&mmClaimDueRecipients.beforeClaimDueRecipientsCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimDueRecipients.afterClaimDueRecipientsCounter, 1)
+
+	if mmClaimDueRecipients.inspectFuncClaimDueRecipients != nil {
+		mmClaimDueRecipients.inspectFuncClaimDueRecipients(ctx, params)
+	}
+
+	mm_params := NotificationRepositoryMockClaimDueRecipientsParams{ctx, params}
+
+	// Record call args
+	mmClaimDueRecipients.ClaimDueRecipientsMock.mutex.Lock()
+	mmClaimDueRecipients.ClaimDueRecipientsMock.callArgs = append(mmClaimDueRecipients.ClaimDueRecipientsMock.callArgs, &mm_params)
+	mmClaimDueRecipients.ClaimDueRecipientsMock.mutex.Unlock()
+
+	for _, e := range mmClaimDueRecipients.ClaimDueRecipientsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.recipients, e.results.err
+		}
+	}
+
+	if mmClaimDueRecipients.ClaimDueRecipientsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimDueRecipients.ClaimDueRecipientsMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimDueRecipients.ClaimDueRecipientsMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimDueRecipients.ClaimDueRecipientsMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockClaimDueRecipientsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimDueRecipients.t.Errorf("NotificationRepositoryMock.ClaimDueRecipients got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmClaimDueRecipients.t.Errorf("NotificationRepositoryMock.ClaimDueRecipients got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimDueRecipients.t.Errorf("NotificationRepositoryMock.ClaimDueRecipients got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimDueRecipients.ClaimDueRecipientsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimDueRecipients.t.Fatal("No results are set for the NotificationRepositoryMock.ClaimDueRecipients")
+		}
+		return (*mm_results).recipients, (*mm_results).err
+	}
+	if mmClaimDueRecipients.funcClaimDueRecipients != nil {
+		return mmClaimDueRecipients.funcClaimDueRecipients(ctx, params)
+	}
+	mmClaimDueRecipients.t.Fatalf("Unexpected call to NotificationRepositoryMock.ClaimDueRecipients. %v %v", ctx, params)
+	return
+}
+
+// ClaimDueRecipientsAfterCounter returns a count of finished NotificationRepositoryMock.ClaimDueRecipients invocations
+func (mmClaimDueRecipients *NotificationRepositoryMock) ClaimDueRecipientsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimDueRecipients.afterClaimDueRecipientsCounter)
+}
+
+// ClaimDueRecipientsBeforeCounter returns a count of NotificationRepositoryMock.ClaimDueRecipients invocations
+func (mmClaimDueRecipients *NotificationRepositoryMock) ClaimDueRecipientsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimDueRecipients.beforeClaimDueRecipientsCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.ClaimDueRecipients.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimDueRecipients *mNotificationRepositoryMockClaimDueRecipients) Calls() []*NotificationRepositoryMockClaimDueRecipientsParams {
+	mmClaimDueRecipients.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockClaimDueRecipientsParams, len(mmClaimDueRecipients.callArgs))
+	copy(argCopy, mmClaimDueRecipients.callArgs)
+
+	mmClaimDueRecipients.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimDueRecipientsDone returns true if the count of the ClaimDueRecipients invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockClaimDueRecipientsDone() bool {
+	if m.ClaimDueRecipientsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimDueRecipientsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimDueRecipientsMock.invocationsDone()
+}
+
+// MinimockClaimDueRecipientsInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockClaimDueRecipientsInspect() {
+	for _, e := range m.ClaimDueRecipientsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.ClaimDueRecipients with params: %#v", *e.params)
+		}
+	}
+
+	afterClaimDueRecipientsCounter := mm_atomic.LoadUint64(&m.afterClaimDueRecipientsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimDueRecipientsMock.defaultExpectation != nil && afterClaimDueRecipientsCounter < 1 {
+		if m.ClaimDueRecipientsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to NotificationRepositoryMock.ClaimDueRecipients")
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.ClaimDueRecipients with params: %#v", *m.ClaimDueRecipientsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimDueRecipients != nil && afterClaimDueRecipientsCounter < 1 {
+		m.t.Error("Expected call to NotificationRepositoryMock.ClaimDueRecipients")
+	}
+
+	if !m.ClaimDueRecipientsMock.invocationsDone() && afterClaimDueRecipientsCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.ClaimDueRecipients but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimDueRecipientsMock.expectedInvocations), afterClaimDueRecipientsCounter)
+	}
+}
+
+type mNotificationRepositoryMockCreateNotifications struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockCreateNotificationsExpectation
+	expectations       []*NotificationRepositoryMockCreateNotificationsExpectation
+
+	callArgs []*NotificationRepositoryMockCreateNotificationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// NotificationRepositoryMockCreateNotificationsExpectation specifies expectation struct of the NotificationRepository.CreateNotifications
+type NotificationRepositoryMockCreateNotificationsExpectation struct {
+	mock      *NotificationRepositoryMock
+	params    *NotificationRepositoryMockCreateNotificationsParams
+	paramPtrs *NotificationRepositoryMockCreateNotificationsParamPtrs
+	results   *NotificationRepositoryMockCreateNotificationsResults
+	Counter   uint64
+}
+
+// NotificationRepositoryMockCreateNotificationsParams contains parameters of the NotificationRepository.CreateNotifications
+type NotificationRepositoryMockCreateNotificationsParams struct {
+	ctx   context.Context
+	event model.Event
+}
+
+// NotificationRepositoryMockCreateNotificationsParamPtrs contains pointers to parameters of the NotificationRepository.CreateNotifications
+type NotificationRepositoryMockCreateNotificationsParamPtrs struct {
+	ctx   *context.Context
+	event *model.Event
+}
+
+// NotificationRepositoryMockCreateNotificationsResults contains results of the NotificationRepository.CreateNotifications
+type NotificationRepositoryMockCreateNotificationsResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) Optional() *mNotificationRepositoryMockCreateNotifications {
+	mmCreateNotifications.optional = true
+	return mmCreateNotifications
+}
+
+// Expect sets up expected params for NotificationRepository.CreateNotifications
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) Expect(ctx context.Context, event model.Event) *mNotificationRepositoryMockCreateNotifications {
+	if mmCreateNotifications.mock.funcCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("NotificationRepositoryMock.CreateNotifications mock is already set by Set")
+	}
+
+	if mmCreateNotifications.defaultExpectation == nil {
+		mmCreateNotifications.defaultExpectation = &NotificationRepositoryMockCreateNotificationsExpectation{}
+	}
+
+	if mmCreateNotifications.defaultExpectation.paramPtrs != nil {
+		mmCreateNotifications.mock.t.Fatalf("NotificationRepositoryMock.CreateNotifications mock is already set by ExpectParams functions")
+	}
+
+	mmCreateNotifications.defaultExpectation.params = &NotificationRepositoryMockCreateNotificationsParams{ctx, event}
+	for _, e := range mmCreateNotifications.expectations {
+		if minimock.Equal(e.params, mmCreateNotifications.defaultExpectation.params) {
+			mmCreateNotifications.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateNotifications.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateNotifications
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.CreateNotifications
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockCreateNotifications {
+	if mmCreateNotifications.mock.funcCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("NotificationRepositoryMock.CreateNotifications mock is already set by Set")
+	}
+
+	if mmCreateNotifications.defaultExpectation == nil {
+		mmCreateNotifications.defaultExpectation = &NotificationRepositoryMockCreateNotificationsExpectation{}
+	}
+
+	if mmCreateNotifications.defaultExpectation.params != nil {
+		mmCreateNotifications.mock.t.Fatalf("NotificationRepositoryMock.CreateNotifications mock is already set by Expect")
+	}
+
+	if mmCreateNotifications.defaultExpectation.paramPtrs == nil {
+		mmCreateNotifications.defaultExpectation.paramPtrs = &NotificationRepositoryMockCreateNotificationsParamPtrs{}
+	}
+	mmCreateNotifications.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateNotifications
+}
+
+// ExpectEventParam2 sets up expected param event for NotificationRepository.CreateNotifications
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) ExpectEventParam2(event model.Event) *mNotificationRepositoryMockCreateNotifications {
+	if mmCreateNotifications.mock.funcCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("NotificationRepositoryMock.CreateNotifications mock is already set by Set")
+	}
+
+	if mmCreateNotifications.defaultExpectation == nil {
+		mmCreateNotifications.defaultExpectation = &NotificationRepositoryMockCreateNotificationsExpectation{}
+	}
+
+	if mmCreateNotifications.defaultExpectation.params != nil {
+		mmCreateNotifications.mock.t.Fatalf("NotificationRepositoryMock.CreateNotifications mock is already set by Expect")
+	}
+
+	if mmCreateNotifications.defaultExpectation.paramPtrs == nil {
+		mmCreateNotifications.defaultExpectation.paramPtrs = &NotificationRepositoryMockCreateNotificationsParamPtrs{}
+	}
+	mmCreateNotifications.defaultExpectation.paramPtrs.event = &event
+
+	return mmCreateNotifications
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.CreateNotifications
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) Inspect(f func(ctx context.Context, event model.Event)) *mNotificationRepositoryMockCreateNotifications {
+	if mmCreateNotifications.mock.inspectFuncCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.CreateNotifications")
+	}
+
+	mmCreateNotifications.mock.inspectFuncCreateNotifications = f
+
+	return mmCreateNotifications
+}
+
+// Return sets up results that will be returned by NotificationRepository.CreateNotifications
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) Return(err error) *NotificationRepositoryMock {
+	if mmCreateNotifications.mock.funcCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("NotificationRepositoryMock.CreateNotifications mock is already set by Set")
+	}
+
+	if mmCreateNotifications.defaultExpectation == nil {
+		mmCreateNotifications.defaultExpectation = &NotificationRepositoryMockCreateNotificationsExpectation{mock: mmCreateNotifications.mock}
+	}
+	mmCreateNotifications.defaultExpectation.results = &NotificationRepositoryMockCreateNotificationsResults{err}
+	return mmCreateNotifications.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.CreateNotifications method
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) Set(f func(ctx context.Context, event model.Event) (err error)) *NotificationRepositoryMock {
+	if mmCreateNotifications.defaultExpectation != nil {
+		mmCreateNotifications.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.CreateNotifications method")
+	}
+
+	if len(mmCreateNotifications.expectations) > 0 {
+		mmCreateNotifications.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.CreateNotifications method")
+	}
+
+	mmCreateNotifications.mock.funcCreateNotifications = f
+	return mmCreateNotifications.mock
+}
+
+// When sets expectation for the NotificationRepository.CreateNotifications which will trigger the result defined by the following
+// Then helper
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) When(ctx context.Context, event model.Event) *NotificationRepositoryMockCreateNotificationsExpectation {
+	if mmCreateNotifications.mock.funcCreateNotifications != nil {
+		mmCreateNotifications.mock.t.Fatalf("NotificationRepositoryMock.CreateNotifications mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockCreateNotificationsExpectation{
+		mock:   mmCreateNotifications.mock,
+		params: &NotificationRepositoryMockCreateNotificationsParams{ctx, event},
+	}
+	mmCreateNotifications.expectations = append(mmCreateNotifications.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.CreateNotifications return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockCreateNotificationsExpectation) Then(err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockCreateNotificationsResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.CreateNotifications should be invoked
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) Times(n uint64) *mNotificationRepositoryMockCreateNotifications {
+	if n == 0 {
+		mmCreateNotifications.mock.t.Fatalf("Times of NotificationRepositoryMock.CreateNotifications mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateNotifications.expectedInvocations, n)
+	return mmCreateNotifications
+}
+
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) invocationsDone() bool {
+	if len(mmCreateNotifications.expectations) == 0 && mmCreateNotifications.defaultExpectation == nil && mmCreateNotifications.mock.funcCreateNotifications == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateNotifications.mock.afterCreateNotificationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateNotifications.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateNotifications implements repository.NotificationRepository
+func (mmCreateNotifications *NotificationRepositoryMock) CreateNotifications(ctx context.Context, event model.Event) (err error) {
+	mm_atomic.AddUint64(&mmCreateNotifications.beforeCreateNotificationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateNotifications.afterCreateNotificationsCounter, 1)
+
+	if mmCreateNotifications.inspectFuncCreateNotifications != nil {
+		mmCreateNotifications.inspectFuncCreateNotifications(ctx, event)
+	}
+
+	mm_params := NotificationRepositoryMockCreateNotificationsParams{ctx, event}
+
+	// Record call args
+	mmCreateNotifications.CreateNotificationsMock.mutex.Lock()
+	mmCreateNotifications.CreateNotificationsMock.callArgs = append(mmCreateNotifications.CreateNotificationsMock.callArgs, &mm_params)
+	mmCreateNotifications.CreateNotificationsMock.mutex.Unlock()
+
+	for _, e := range mmCreateNotifications.CreateNotificationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateNotifications.CreateNotificationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateNotifications.CreateNotificationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateNotifications.CreateNotificationsMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateNotifications.CreateNotificationsMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockCreateNotificationsParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateNotifications.t.Errorf("NotificationRepositoryMock.CreateNotifications got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmCreateNotifications.t.Errorf("NotificationRepositoryMock.CreateNotifications got unexpected parameter event, want: %#v, got: %#v%s\n", *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateNotifications.t.Errorf("NotificationRepositoryMock.CreateNotifications got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateNotifications.CreateNotificationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateNotifications.t.Fatal("No results are set for the NotificationRepositoryMock.CreateNotifications")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateNotifications.funcCreateNotifications != nil {
+		return mmCreateNotifications.funcCreateNotifications(ctx, event)
+	}
+	mmCreateNotifications.t.Fatalf("Unexpected call to NotificationRepositoryMock.CreateNotifications. %v %v", ctx, event)
+	return
+}
+
+// CreateNotificationsAfterCounter returns a count of finished NotificationRepositoryMock.CreateNotifications invocations
+func (mmCreateNotifications *NotificationRepositoryMock) CreateNotificationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateNotifications.afterCreateNotificationsCounter)
+}
+
+// CreateNotificationsBeforeCounter returns a count of NotificationRepositoryMock.CreateNotifications invocations
+func (mmCreateNotifications *NotificationRepositoryMock) CreateNotificationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateNotifications.beforeCreateNotificationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.CreateNotifications.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateNotifications *mNotificationRepositoryMockCreateNotifications) Calls() []*NotificationRepositoryMockCreateNotificationsParams {
+	mmCreateNotifications.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockCreateNotificationsParams, len(mmCreateNotifications.callArgs))
+	copy(argCopy, mmCreateNotifications.callArgs)
+
+	mmCreateNotifications.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateNotificationsDone returns true if the count of the CreateNotifications invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockCreateNotificationsDone() bool {
+	if m.CreateNotificationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateNotificationsMock.invocationsDone()
+}
+
+// MinimockCreateNotificationsInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockCreateNotificationsInspect() {
+	for _, e := range m.CreateNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.CreateNotifications with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateNotificationsCounter := mm_atomic.LoadUint64(&m.afterCreateNotificationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateNotificationsMock.defaultExpectation != nil && afterCreateNotificationsCounter < 1 {
+		if m.CreateNotificationsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to NotificationRepositoryMock.CreateNotifications")
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.CreateNotifications with params: %#v", *m.CreateNotificationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateNotifications != nil && afterCreateNotificationsCounter < 1 {
+		m.t.Error("Expected call to NotificationRepositoryMock.CreateNotifications")
+	}
+
+	if !m.CreateNotificationsMock.invocationsDone() && afterCreateNotificationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.CreateNotifications but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateNotificationsMock.expectedInvocations), afterCreateNotificationsCounter)
+	}
+}
+
+type mNotificationRepositoryMockDeleteFinishedNotifications struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockDeleteFinishedNotificationsExpectation
+	expectations       []*NotificationRepositoryMockDeleteFinishedNotificationsExpectation
+
+	callArgs []*NotificationRepositoryMockDeleteFinishedNotificationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// NotificationRepositoryMockDeleteFinishedNotificationsExpectation specifies expectation struct of the NotificationRepository.DeleteFinishedNotifications
+type NotificationRepositoryMockDeleteFinishedNotificationsExpectation struct {
+	mock      *NotificationRepositoryMock
+	params    *NotificationRepositoryMockDeleteFinishedNotificationsParams
+	paramPtrs *NotificationRepositoryMockDeleteFinishedNotificationsParamPtrs
+	results   *NotificationRepositoryMockDeleteFinishedNotificationsResults
+	Counter   uint64
+}
+
+// NotificationRepositoryMockDeleteFinishedNotificationsParams contains parameters of the NotificationRepository.DeleteFinishedNotifications
+type NotificationRepositoryMockDeleteFinishedNotificationsParams struct {
+	ctx    context.Context
+	before time.Time
+}
+
+// NotificationRepositoryMockDeleteFinishedNotificationsParamPtrs contains pointers to parameters of the NotificationRepository.DeleteFinishedNotifications
+type NotificationRepositoryMockDeleteFinishedNotificationsParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+}
+
+// NotificationRepositoryMockDeleteFinishedNotificationsResults contains results of the NotificationRepository.DeleteFinishedNotifications
+type NotificationRepositoryMockDeleteFinishedNotificationsResults struct {
+	deleted int64
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) Optional() *mNotificationRepositoryMockDeleteFinishedNotifications {
+	mmDeleteFinishedNotifications.optional = true
+	return mmDeleteFinishedNotifications
+}
+
+// Expect sets up expected params for NotificationRepository.DeleteFinishedNotifications
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) Expect(ctx context.Context, before time.Time) *mNotificationRepositoryMockDeleteFinishedNotifications {
+	if mmDeleteFinishedNotifications.mock.funcDeleteFinishedNotifications != nil {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("NotificationRepositoryMock.DeleteFinishedNotifications mock is already set by Set")
+	}
+
+	if mmDeleteFinishedNotifications.defaultExpectation == nil {
+		mmDeleteFinishedNotifications.defaultExpectation = &NotificationRepositoryMockDeleteFinishedNotificationsExpectation{}
+	}
+
+	if mmDeleteFinishedNotifications.defaultExpectation.paramPtrs != nil {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("NotificationRepositoryMock.DeleteFinishedNotifications mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteFinishedNotifications.defaultExpectation.params = &NotificationRepositoryMockDeleteFinishedNotificationsParams{ctx, before}
+	for _, e := range mmDeleteFinishedNotifications.expectations {
+		if minimock.Equal(e.params, mmDeleteFinishedNotifications.defaultExpectation.params) {
+			mmDeleteFinishedNotifications.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteFinishedNotifications.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteFinishedNotifications
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.DeleteFinishedNotifications
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockDeleteFinishedNotifications {
+	if mmDeleteFinishedNotifications.mock.funcDeleteFinishedNotifications != nil {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("NotificationRepositoryMock.DeleteFinishedNotifications mock is already set by Set")
+	}
+
+	if mmDeleteFinishedNotifications.defaultExpectation == nil {
+		mmDeleteFinishedNotifications.defaultExpectation = &NotificationRepositoryMockDeleteFinishedNotificationsExpectation{}
+	}
+
+	if mmDeleteFinishedNotifications.defaultExpectation.params != nil {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("NotificationRepositoryMock.DeleteFinishedNotifications mock is already set by Expect")
+	}
+
+	if mmDeleteFinishedNotifications.defaultExpectation.paramPtrs == nil {
+		mmDeleteFinishedNotifications.defaultExpectation.paramPtrs = &NotificationRepositoryMockDeleteFinishedNotificationsParamPtrs{}
+	}
+	mmDeleteFinishedNotifications.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteFinishedNotifications
+}
+
+// ExpectBeforeParam2 sets up expected param before for NotificationRepository.DeleteFinishedNotifications
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) ExpectBeforeParam2(before time.Time) *mNotificationRepositoryMockDeleteFinishedNotifications {
+	if mmDeleteFinishedNotifications.mock.funcDeleteFinishedNotifications != nil {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("NotificationRepositoryMock.DeleteFinishedNotifications mock is already set by Set")
+	}
+
+	if mmDeleteFinishedNotifications.defaultExpectation == nil {
+		mmDeleteFinishedNotifications.defaultExpectation = &NotificationRepositoryMockDeleteFinishedNotificationsExpectation{}
+	}
+
+	if mmDeleteFinishedNotifications.defaultExpectation.params != nil {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("NotificationRepositoryMock.DeleteFinishedNotifications mock is already set by Expect")
+	}
+
+	if mmDeleteFinishedNotifications.defaultExpectation.paramPtrs == nil {
+		mmDeleteFinishedNotifications.defaultExpectation.paramPtrs = &NotificationRepositoryMockDeleteFinishedNotificationsParamPtrs{}
+	}
+	mmDeleteFinishedNotifications.defaultExpectation.paramPtrs.before = &before
+
+	return mmDeleteFinishedNotifications
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.DeleteFinishedNotifications
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) Inspect(f func(ctx context.Context, before time.Time)) *mNotificationRepositoryMockDeleteFinishedNotifications {
+	if mmDeleteFinishedNotifications.mock.inspectFuncDeleteFinishedNotifications != nil {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.DeleteFinishedNotifications")
+	}
+
+	mmDeleteFinishedNotifications.mock.inspectFuncDeleteFinishedNotifications = f
+
+	return mmDeleteFinishedNotifications
+}
+
+// Return sets up results that will be returned by NotificationRepository.DeleteFinishedNotifications
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) Return(deleted int64, err error) *NotificationRepositoryMock {
+	if mmDeleteFinishedNotifications.mock.funcDeleteFinishedNotifications != nil {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("NotificationRepositoryMock.DeleteFinishedNotifications mock is already set by Set")
+	}
+
+	if mmDeleteFinishedNotifications.defaultExpectation == nil {
+		mmDeleteFinishedNotifications.defaultExpectation = &NotificationRepositoryMockDeleteFinishedNotificationsExpectation{mock: mmDeleteFinishedNotifications.mock}
+	}
+	mmDeleteFinishedNotifications.defaultExpectation.results = &NotificationRepositoryMockDeleteFinishedNotificationsResults{deleted, err}
+	return mmDeleteFinishedNotifications.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.DeleteFinishedNotifications method
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) Set(f func(ctx context.Context, before time.Time) (deleted int64, err error)) *NotificationRepositoryMock {
+	if mmDeleteFinishedNotifications.defaultExpectation != nil {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.DeleteFinishedNotifications method")
+	}
+
+	if len(mmDeleteFinishedNotifications.expectations) > 0 {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.DeleteFinishedNotifications method")
+	}
+
+	mmDeleteFinishedNotifications.mock.funcDeleteFinishedNotifications = f
+	return mmDeleteFinishedNotifications.mock
+}
+
+// When sets expectation for the NotificationRepository.DeleteFinishedNotifications which will trigger the result defined by the following
+// Then helper
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) When(ctx context.Context, before time.Time) *NotificationRepositoryMockDeleteFinishedNotificationsExpectation {
+	if mmDeleteFinishedNotifications.mock.funcDeleteFinishedNotifications != nil {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("NotificationRepositoryMock.DeleteFinishedNotifications mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockDeleteFinishedNotificationsExpectation{
+		mock:   mmDeleteFinishedNotifications.mock,
+		params: &NotificationRepositoryMockDeleteFinishedNotificationsParams{ctx, before},
+	}
+	mmDeleteFinishedNotifications.expectations = append(mmDeleteFinishedNotifications.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.DeleteFinishedNotifications return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockDeleteFinishedNotificationsExpectation) Then(deleted int64, err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockDeleteFinishedNotificationsResults{deleted, err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.DeleteFinishedNotifications should be invoked
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) Times(n uint64) *mNotificationRepositoryMockDeleteFinishedNotifications {
+	if n == 0 {
+		mmDeleteFinishedNotifications.mock.t.Fatalf("Times of NotificationRepositoryMock.DeleteFinishedNotifications mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteFinishedNotifications.expectedInvocations, n)
+	return mmDeleteFinishedNotifications
+}
+
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) invocationsDone() bool {
+	if len(mmDeleteFinishedNotifications.expectations) == 0 && mmDeleteFinishedNotifications.defaultExpectation == nil && mmDeleteFinishedNotifications.mock.funcDeleteFinishedNotifications == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteFinishedNotifications.mock.afterDeleteFinishedNotificationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteFinishedNotifications.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteFinishedNotifications implements repository.NotificationRepository
+func (mmDeleteFinishedNotifications *NotificationRepositoryMock) DeleteFinishedNotifications(ctx context.Context, before time.Time) (deleted int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteFinishedNotifications.beforeDeleteFinishedNotificationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteFinishedNotifications.afterDeleteFinishedNotificationsCounter, 1)
+
+	if mmDeleteFinishedNotifications.inspectFuncDeleteFinishedNotifications != nil {
+		mmDeleteFinishedNotifications.inspectFuncDeleteFinishedNotifications(ctx, before)
+	}
+
+	mm_params := NotificationRepositoryMockDeleteFinishedNotificationsParams{ctx, before}
+
+	// Record call args
+	mmDeleteFinishedNotifications.DeleteFinishedNotificationsMock.mutex.Lock()
+	mmDeleteFinishedNotifications.DeleteFinishedNotificationsMock.callArgs = append(mmDeleteFinishedNotifications.DeleteFinishedNotificationsMock.callArgs, &mm_params)
+	mmDeleteFinishedNotifications.DeleteFinishedNotificationsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteFinishedNotifications.DeleteFinishedNotificationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.deleted, e.results.err
+		}
+	}
+
+	if mmDeleteFinishedNotifications.DeleteFinishedNotificationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteFinishedNotifications.DeleteFinishedNotificationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteFinishedNotifications.DeleteFinishedNotificationsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteFinishedNotifications.DeleteFinishedNotificationsMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockDeleteFinishedNotificationsParams{ctx, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteFinishedNotifications.t.Errorf("NotificationRepositoryMock.DeleteFinishedNotifications got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmDeleteFinishedNotifications.t.Errorf("NotificationRepositoryMock.DeleteFinishedNotifications got unexpected parameter before, want: %#v, got: %#v%s\n", *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteFinishedNotifications.t.Errorf("NotificationRepositoryMock.DeleteFinishedNotifications got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteFinishedNotifications.DeleteFinishedNotificationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteFinishedNotifications.t.Fatal("No results are set for the NotificationRepositoryMock.DeleteFinishedNotifications")
+		}
+		return (*mm_results).deleted, (*mm_results).err
+	}
+	if mmDeleteFinishedNotifications.funcDeleteFinishedNotifications != nil {
+		return mmDeleteFinishedNotifications.funcDeleteFinishedNotifications(ctx, before)
+	}
+	mmDeleteFinishedNotifications.t.Fatalf("Unexpected call to NotificationRepositoryMock.DeleteFinishedNotifications. %v %v", ctx, before)
+	return
+}
+
+// DeleteFinishedNotificationsAfterCounter returns a count of finished NotificationRepositoryMock.DeleteFinishedNotifications invocations
+func (mmDeleteFinishedNotifications *NotificationRepositoryMock) DeleteFinishedNotificationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFinishedNotifications.afterDeleteFinishedNotificationsCounter)
+}
+
+// DeleteFinishedNotificationsBeforeCounter returns a count of NotificationRepositoryMock.DeleteFinishedNotifications invocations
+func (mmDeleteFinishedNotifications *NotificationRepositoryMock) DeleteFinishedNotificationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFinishedNotifications.beforeDeleteFinishedNotificationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.DeleteFinishedNotifications.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteFinishedNotifications *mNotificationRepositoryMockDeleteFinishedNotifications) Calls() []*NotificationRepositoryMockDeleteFinishedNotificationsParams {
+	mmDeleteFinishedNotifications.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockDeleteFinishedNotificationsParams, len(mmDeleteFinishedNotifications.callArgs))
+	copy(argCopy, mmDeleteFinishedNotifications.callArgs)
+
+	mmDeleteFinishedNotifications.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteFinishedNotificationsDone returns true if the count of the DeleteFinishedNotifications invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockDeleteFinishedNotificationsDone() bool {
+	if m.DeleteFinishedNotificationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteFinishedNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteFinishedNotificationsMock.invocationsDone()
+}
+
+// MinimockDeleteFinishedNotificationsInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockDeleteFinishedNotificationsInspect() {
+	for _, e := range m.DeleteFinishedNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.DeleteFinishedNotifications with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteFinishedNotificationsCounter := mm_atomic.LoadUint64(&m.afterDeleteFinishedNotificationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteFinishedNotificationsMock.defaultExpectation != nil && afterDeleteFinishedNotificationsCounter < 1 {
+		if m.DeleteFinishedNotificationsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to NotificationRepositoryMock.DeleteFinishedNotifications")
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.DeleteFinishedNotifications with params: %#v", *m.DeleteFinishedNotificationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteFinishedNotifications != nil && afterDeleteFinishedNotificationsCounter < 1 {
+		m.t.Error("Expected call to NotificationRepositoryMock.DeleteFinishedNotifications")
+	}
+
+	if !m.DeleteFinishedNotificationsMock.invocationsDone() && afterDeleteFinishedNotificationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.DeleteFinishedNotifications but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteFinishedNotificationsMock.expectedInvocations), afterDeleteFinishedNotificationsCounter)
+	}
+}
+
+type mNotificationRepositoryMockFinishNotifications struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockFinishNotificationsExpectation
+	expectations       []*NotificationRepositoryMockFinishNotificationsExpectation
+
+	callArgs []*NotificationRepositoryMockFinishNotificationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// NotificationRepositoryMockFinishNotificationsExpectation specifies expectation struct of the NotificationRepository.FinishNotifications
+type NotificationRepositoryMockFinishNotificationsExpectation struct {
+	mock      *NotificationRepositoryMock
+	params    *NotificationRepositoryMockFinishNotificationsParams
+	paramPtrs *NotificationRepositoryMockFinishNotificationsParamPtrs
+	results   *NotificationRepositoryMockFinishNotificationsResults
+	Counter   uint64
+}
+
+// NotificationRepositoryMockFinishNotificationsParams contains parameters of the NotificationRepository.FinishNotifications
+type NotificationRepositoryMockFinishNotificationsParams struct {
+	ctx    context.Context
+	params model.FinishNotificationsParams
+}
+
+// NotificationRepositoryMockFinishNotificationsParamPtrs contains pointers to parameters of the NotificationRepository.FinishNotifications
+type NotificationRepositoryMockFinishNotificationsParamPtrs struct {
+	ctx    *context.Context
+	params *model.FinishNotificationsParams
+}
+
+// NotificationRepositoryMockFinishNotificationsResults contains results of the NotificationRepository.FinishNotifications
+type NotificationRepositoryMockFinishNotificationsResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) Optional() *mNotificationRepositoryMockFinishNotifications {
+	mmFinishNotifications.optional = true
+	return mmFinishNotifications
+}
+
+// Expect sets up expected params for NotificationRepository.FinishNotifications
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) Expect(ctx context.Context, params model.FinishNotificationsParams) *mNotificationRepositoryMockFinishNotifications {
+	if mmFinishNotifications.mock.funcFinishNotifications != nil {
+		mmFinishNotifications.mock.t.Fatalf("NotificationRepositoryMock.FinishNotifications mock is already set by Set")
+	}
+
+	if mmFinishNotifications.defaultExpectation == nil {
+		mmFinishNotifications.defaultExpectation = &NotificationRepositoryMockFinishNotificationsExpectation{}
+	}
+
+	if mmFinishNotifications.defaultExpectation.paramPtrs != nil {
+		mmFinishNotifications.mock.t.Fatalf("NotificationRepositoryMock.FinishNotifications mock is already set by ExpectParams functions")
+	}
+
+	mmFinishNotifications.defaultExpectation.params = &NotificationRepositoryMockFinishNotificationsParams{ctx, params}
+	for _, e := range mmFinishNotifications.expectations {
+		if minimock.Equal(e.params, mmFinishNotifications.defaultExpectation.params) {
+			mmFinishNotifications.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFinishNotifications.defaultExpectation.params)
+		}
+	}
+
+	return mmFinishNotifications
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.FinishNotifications
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockFinishNotifications {
+	if mmFinishNotifications.mock.funcFinishNotifications != nil {
+		mmFinishNotifications.mock.t.Fatalf("NotificationRepositoryMock.FinishNotifications mock is already set by Set")
+	}
+
+	if mmFinishNotifications.defaultExpectation == nil {
+		mmFinishNotifications.defaultExpectation = &NotificationRepositoryMockFinishNotificationsExpectation{}
+	}
+
+	if mmFinishNotifications.defaultExpectation.params != nil {
+		mmFinishNotifications.mock.t.Fatalf("NotificationRepositoryMock.FinishNotifications mock is already set by Expect")
+	}
+
+	if mmFinishNotifications.defaultExpectation.paramPtrs == nil {
+		mmFinishNotifications.defaultExpectation.paramPtrs = &NotificationRepositoryMockFinishNotificationsParamPtrs{}
+	}
+	mmFinishNotifications.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmFinishNotifications
+}
+
+// ExpectParamsParam2 sets up expected param params for NotificationRepository.FinishNotifications
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) ExpectParamsParam2(params model.FinishNotificationsParams) *mNotificationRepositoryMockFinishNotifications {
+	if mmFinishNotifications.mock.funcFinishNotifications != nil {
+		mmFinishNotifications.mock.t.Fatalf("NotificationRepositoryMock.FinishNotifications mock is already set by Set")
+	}
+
+	if mmFinishNotifications.defaultExpectation == nil {
+		mmFinishNotifications.defaultExpectation = &NotificationRepositoryMockFinishNotificationsExpectation{}
+	}
+
+	if mmFinishNotifications.defaultExpectation.params != nil {
+		mmFinishNotifications.mock.t.Fatalf("NotificationRepositoryMock.FinishNotifications mock is already set by Expect")
+	}
+
+	if mmFinishNotifications.defaultExpectation.paramPtrs == nil {
+		mmFinishNotifications.defaultExpectation.paramPtrs = &NotificationRepositoryMockFinishNotificationsParamPtrs{}
+	}
+	mmFinishNotifications.defaultExpectation.paramPtrs.params = &params
+
+	return mmFinishNotifications
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.FinishNotifications
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) Inspect(f func(ctx context.Context, params model.FinishNotificationsParams)) *mNotificationRepositoryMockFinishNotifications {
+	if mmFinishNotifications.mock.inspectFuncFinishNotifications != nil {
+		mmFinishNotifications.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.FinishNotifications")
+	}
+
+	mmFinishNotifications.mock.inspectFuncFinishNotifications = f
+
+	return mmFinishNotifications
+}
+
+// Return sets up results that will be returned by NotificationRepository.FinishNotifications
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) Return(err error) *NotificationRepositoryMock {
+	if mmFinishNotifications.mock.funcFinishNotifications != nil {
+		mmFinishNotifications.mock.t.Fatalf("NotificationRepositoryMock.FinishNotifications mock is already set by Set")
+	}
+
+	if mmFinishNotifications.defaultExpectation == nil {
+		mmFinishNotifications.defaultExpectation = &NotificationRepositoryMockFinishNotificationsExpectation{mock: mmFinishNotifications.mock}
+	}
+	mmFinishNotifications.defaultExpectation.results = &NotificationRepositoryMockFinishNotificationsResults{err}
+	return mmFinishNotifications.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.FinishNotifications method
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) Set(f func(ctx context.Context, params model.FinishNotificationsParams) (err error)) *NotificationRepositoryMock {
+	if mmFinishNotifications.defaultExpectation != nil {
+		mmFinishNotifications.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.FinishNotifications method")
+	}
+
+	if len(mmFinishNotifications.expectations) > 0 {
+		mmFinishNotifications.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.FinishNotifications method")
+	}
+
+	mmFinishNotifications.mock.funcFinishNotifications = f
+	return mmFinishNotifications.mock
+}
+
+// When sets expectation for the NotificationRepository.FinishNotifications which will trigger the result defined by the following
+// Then helper
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) When(ctx context.Context, params model.FinishNotificationsParams) *NotificationRepositoryMockFinishNotificationsExpectation {
+	if mmFinishNotifications.mock.funcFinishNotifications != nil {
+		mmFinishNotifications.mock.t.Fatalf("NotificationRepositoryMock.FinishNotifications mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockFinishNotificationsExpectation{
+		mock:   mmFinishNotifications.mock,
+		params: &NotificationRepositoryMockFinishNotificationsParams{ctx, params},
+	}
+	mmFinishNotifications.expectations = append(mmFinishNotifications.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.FinishNotifications return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockFinishNotificationsExpectation) Then(err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockFinishNotificationsResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.FinishNotifications should be invoked
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) Times(n uint64) *mNotificationRepositoryMockFinishNotifications {
+	if n == 0 {
+		mmFinishNotifications.mock.t.Fatalf("Times of NotificationRepositoryMock.FinishNotifications mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFinishNotifications.expectedInvocations, n)
+	return mmFinishNotifications
+}
+
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) invocationsDone() bool {
+	if len(mmFinishNotifications.expectations) == 0 && mmFinishNotifications.defaultExpectation == nil && mmFinishNotifications.mock.funcFinishNotifications == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFinishNotifications.mock.afterFinishNotificationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFinishNotifications.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FinishNotifications implements repository.NotificationRepository
+func (mmFinishNotifications *NotificationRepositoryMock) FinishNotifications(ctx context.Context, params model.FinishNotificationsParams) (err error) {
+	mm_atomic.AddUint64(&mmFinishNotifications.beforeFinishNotificationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmFinishNotifications.afterFinishNotificationsCounter, 1)
+
+	if mmFinishNotifications.inspectFuncFinishNotifications != nil {
+		mmFinishNotifications.inspectFuncFinishNotifications(ctx, params)
+	}
+
+	mm_params := NotificationRepositoryMockFinishNotificationsParams{ctx, params}
+
+	// Record call args
+	mmFinishNotifications.FinishNotificationsMock.mutex.Lock()
+	mmFinishNotifications.FinishNotificationsMock.callArgs = append(mmFinishNotifications.FinishNotificationsMock.callArgs, &mm_params)
+	mmFinishNotifications.FinishNotificationsMock.mutex.Unlock()
+
+	for _, e := range mmFinishNotifications.FinishNotificationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFinishNotifications.FinishNotificationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFinishNotifications.FinishNotificationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmFinishNotifications.FinishNotificationsMock.defaultExpectation.params
+		mm_want_ptrs := mmFinishNotifications.FinishNotificationsMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockFinishNotificationsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFinishNotifications.t.Errorf("NotificationRepositoryMock.FinishNotifications got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmFinishNotifications.t.Errorf("NotificationRepositoryMock.FinishNotifications got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFinishNotifications.t.Errorf("NotificationRepositoryMock.FinishNotifications got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFinishNotifications.FinishNotificationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFinishNotifications.t.Fatal("No results are set for the NotificationRepositoryMock.FinishNotifications")
+		}
+		return (*mm_results).err
+	}
+	if mmFinishNotifications.funcFinishNotifications != nil {
+		return mmFinishNotifications.funcFinishNotifications(ctx, params)
+	}
+	mmFinishNotifications.t.Fatalf("Unexpected call to NotificationRepositoryMock.FinishNotifications. %v %v", ctx, params)
+	return
+}
+
+// FinishNotificationsAfterCounter returns a count of finished NotificationRepositoryMock.FinishNotifications invocations
+func (mmFinishNotifications *NotificationRepositoryMock) FinishNotificationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishNotifications.afterFinishNotificationsCounter)
+}
+
+// FinishNotificationsBeforeCounter returns a count of NotificationRepositoryMock.FinishNotifications invocations
+func (mmFinishNotifications *NotificationRepositoryMock) FinishNotificationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishNotifications.beforeFinishNotificationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.FinishNotifications.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFinishNotifications *mNotificationRepositoryMockFinishNotifications) Calls() []*NotificationRepositoryMockFinishNotificationsParams {
+	mmFinishNotifications.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockFinishNotificationsParams, len(mmFinishNotifications.callArgs))
+	copy(argCopy, mmFinishNotifications.callArgs)
+
+	mmFinishNotifications.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFinishNotificationsDone returns true if the count of the FinishNotifications invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockFinishNotificationsDone() bool {
+	if m.FinishNotificationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FinishNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FinishNotificationsMock.invocationsDone()
+}
+
+// MinimockFinishNotificationsInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockFinishNotificationsInspect() {
+	for _, e := range m.FinishNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.FinishNotifications with params: %#v", *e.params)
+		}
+	}
+
+	afterFinishNotificationsCounter := mm_atomic.LoadUint64(&m.afterFinishNotificationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FinishNotificationsMock.defaultExpectation != nil && afterFinishNotificationsCounter < 1 {
+		if m.FinishNotificationsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to NotificationRepositoryMock.FinishNotifications")
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.FinishNotifications with params: %#v", *m.FinishNotificationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFinishNotifications != nil && afterFinishNotificationsCounter < 1 {
+		m.t.Error("Expected call to NotificationRepositoryMock.FinishNotifications")
+	}
+
+	if !m.FinishNotificationsMock.invocationsDone() && afterFinishNotificationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.FinishNotifications but found %d calls",
+			mm_atomic.LoadUint64(&m.FinishNotificationsMock.expectedInvocations), afterFinishNotificationsCounter)
+	}
+}
+
+type mNotificationRepositoryMockListPendingNotifications struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockListPendingNotificationsExpectation
+	expectations       []*NotificationRepositoryMockListPendingNotificationsExpectation
+
+	callArgs []*NotificationRepositoryMockListPendingNotificationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// NotificationRepositoryMockListPendingNotificationsExpectation specifies expectation struct of the NotificationRepository.ListPendingNotifications
+type NotificationRepositoryMockListPendingNotificationsExpectation struct {
+	mock      *NotificationRepositoryMock
+	params    *NotificationRepositoryMockListPendingNotificationsParams
+	paramPtrs *NotificationRepositoryMockListPendingNotificationsParamPtrs
+	results   *NotificationRepositoryMockListPendingNotificationsResults
+	Counter   uint64
+}
+
+// NotificationRepositoryMockListPendingNotificationsParams contains parameters of the NotificationRepository.ListPendingNotifications
+type NotificationRepositoryMockListPendingNotificationsParams struct {
+	ctx    context.Context
+	userID int64
+	limit  int
+}
+
+// NotificationRepositoryMockListPendingNotificationsParamPtrs contains pointers to parameters of the NotificationRepository.ListPendingNotifications
+type NotificationRepositoryMockListPendingNotificationsParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	limit  *int
+}
+
+// NotificationRepositoryMockListPendingNotificationsResults contains results of the NotificationRepository.ListPendingNotifications
+type NotificationRepositoryMockListPendingNotificationsResults struct {
+	notifications []model.Notification
+	err           error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) Optional() *mNotificationRepositoryMockListPendingNotifications {
+	mmListPendingNotifications.optional = true
+	return mmListPendingNotifications
+}
+
+// Expect sets up expected params for NotificationRepository.ListPendingNotifications
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) Expect(ctx context.Context, userID int64, limit int) *mNotificationRepositoryMockListPendingNotifications {
+	if mmListPendingNotifications.mock.funcListPendingNotifications != nil {
+		mmListPendingNotifications.mock.t.Fatalf("NotificationRepositoryMock.ListPendingNotifications mock is already set by Set")
+	}
+
+	if mmListPendingNotifications.defaultExpectation == nil {
+		mmListPendingNotifications.defaultExpectation = &NotificationRepositoryMockListPendingNotificationsExpectation{}
+	}
+
+	if mmListPendingNotifications.defaultExpectation.paramPtrs != nil {
+		mmListPendingNotifications.mock.t.Fatalf("NotificationRepositoryMock.ListPendingNotifications mock is already set by ExpectParams functions")
+	}
+
+	mmListPendingNotifications.defaultExpectation.params = &NotificationRepositoryMockListPendingNotificationsParams{ctx, userID, limit}
+	for _, e := range mmListPendingNotifications.expectations {
+		if minimock.Equal(e.params, mmListPendingNotifications.defaultExpectation.params) {
+			mmListPendingNotifications.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPendingNotifications.defaultExpectation.params)
+		}
+	}
+
+	return mmListPendingNotifications
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.ListPendingNotifications
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockListPendingNotifications {
+	if mmListPendingNotifications.mock.funcListPendingNotifications != nil {
+		mmListPendingNotifications.mock.t.Fatalf("NotificationRepositoryMock.ListPendingNotifications mock is already set by Set")
+	}
+
+	if mmListPendingNotifications.defaultExpectation == nil {
+		mmListPendingNotifications.defaultExpectation = &NotificationRepositoryMockListPendingNotificationsExpectation{}
+	}
+
+	if mmListPendingNotifications.defaultExpectation.params != nil {
+		mmListPendingNotifications.mock.t.Fatalf("NotificationRepositoryMock.ListPendingNotifications mock is already set by Expect")
+	}
+
+	if mmListPendingNotifications.defaultExpectation.paramPtrs == nil {
+		mmListPendingNotifications.defaultExpectation.paramPtrs = &NotificationRepositoryMockListPendingNotificationsParamPtrs{}
+	}
+	mmListPendingNotifications.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPendingNotifications
+}
+
+// ExpectUserIDParam2 sets up expected param userID for NotificationRepository.ListPendingNotifications
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) ExpectUserIDParam2(userID int64) *mNotificationRepositoryMockListPendingNotifications {
+	if mmListPendingNotifications.mock.funcListPendingNotifications != nil {
+		mmListPendingNotifications.mock.t.Fatalf("NotificationRepositoryMock.ListPendingNotifications mock is already set by Set")
+	}
+
+	if mmListPendingNotifications.defaultExpectation == nil {
+		mmListPendingNotifications.defaultExpectation = &NotificationRepositoryMockListPendingNotificationsExpectation{}
+	}
+
+	if mmListPendingNotifications.defaultExpectation.params != nil {
+		mmListPendingNotifications.mock.t.Fatalf("NotificationRepositoryMock.ListPendingNotifications mock is already set by Expect")
+	}
+
+	if mmListPendingNotifications.defaultExpectation.paramPtrs == nil {
+		mmListPendingNotifications.defaultExpectation.paramPtrs = &NotificationRepositoryMockListPendingNotificationsParamPtrs{}
+	}
+	mmListPendingNotifications.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmListPendingNotifications
+}
+
+// ExpectLimitParam3 sets up expected param limit for NotificationRepository.ListPendingNotifications
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) ExpectLimitParam3(limit int) *mNotificationRepositoryMockListPendingNotifications {
+	if mmListPendingNotifications.mock.funcListPendingNotifications != nil {
+		mmListPendingNotifications.mock.t.Fatalf("NotificationRepositoryMock.ListPendingNotifications mock is already set by Set")
+	}
+
+	if mmListPendingNotifications.defaultExpectation == nil {
+		mmListPendingNotifications.defaultExpectation = &NotificationRepositoryMockListPendingNotificationsExpectation{}
+	}
+
+	if mmListPendingNotifications.defaultExpectation.params != nil {
+		mmListPendingNotifications.mock.t.Fatalf("NotificationRepositoryMock.ListPendingNotifications mock is already set by Expect")
+	}
+
+	if mmListPendingNotifications.defaultExpectation.paramPtrs == nil {
+		mmListPendingNotifications.defaultExpectation.paramPtrs = &NotificationRepositoryMockListPendingNotificationsParamPtrs{}
+	}
+	mmListPendingNotifications.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmListPendingNotifications
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.ListPendingNotifications
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) Inspect(f func(ctx context.Context, userID int64, limit int)) *mNotificationRepositoryMockListPendingNotifications {
+	if mmListPendingNotifications.mock.inspectFuncListPendingNotifications != nil {
+		mmListPendingNotifications.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.ListPendingNotifications")
+	}
+
+	mmListPendingNotifications.mock.inspectFuncListPendingNotifications = f
+
+	return mmListPendingNotifications
+}
+
+// Return sets up results that will be returned by NotificationRepository.ListPendingNotifications
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) Return(notifications []model.Notification, err error) *NotificationRepositoryMock {
+	if mmListPendingNotifications.mock.funcListPendingNotifications != nil {
+		mmListPendingNotifications.mock.t.Fatalf("NotificationRepositoryMock.ListPendingNotifications mock is already set by Set")
+	}
+
+	if mmListPendingNotifications.defaultExpectation == nil {
+		mmListPendingNotifications.defaultExpectation = &NotificationRepositoryMockListPendingNotificationsExpectation{mock: mmListPendingNotifications.mock}
+	}
+	mmListPendingNotifications.defaultExpectation.results = &NotificationRepositoryMockListPendingNotificationsResults{notifications, err}
+	return mmListPendingNotifications.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.ListPendingNotifications method
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) Set(f func(ctx context.Context, userID int64, limit int) (notifications []model.Notification, err error)) *NotificationRepositoryMock {
+	if mmListPendingNotifications.defaultExpectation != nil {
+		mmListPendingNotifications.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.ListPendingNotifications method")
+	}
+
+	if len(mmListPendingNotifications.expectations) > 0 {
+		mmListPendingNotifications.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.ListPendingNotifications method")
+	}
+
+	mmListPendingNotifications.mock.funcListPendingNotifications = f
+	return mmListPendingNotifications.mock
+}
+
+// When sets expectation for the NotificationRepository.ListPendingNotifications which will trigger the result defined by the following
+// Then helper
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) When(ctx context.Context, userID int64, limit int) *NotificationRepositoryMockListPendingNotificationsExpectation {
+	if mmListPendingNotifications.mock.funcListPendingNotifications != nil {
+		mmListPendingNotifications.mock.t.Fatalf("NotificationRepositoryMock.ListPendingNotifications mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockListPendingNotificationsExpectation{
+		mock:   mmListPendingNotifications.mock,
+		params: &NotificationRepositoryMockListPendingNotificationsParams{ctx, userID, limit},
+	}
+	mmListPendingNotifications.expectations = append(mmListPendingNotifications.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.ListPendingNotifications return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockListPendingNotificationsExpectation) Then(notifications []model.Notification, err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockListPendingNotificationsResults{notifications, err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.ListPendingNotifications should be invoked
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) Times(n uint64) *mNotificationRepositoryMockListPendingNotifications {
+	if n == 0 {
+		mmListPendingNotifications.mock.t.Fatalf("Times of NotificationRepositoryMock.ListPendingNotifications mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPendingNotifications.expectedInvocations, n)
+	return mmListPendingNotifications
+}
+
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) invocationsDone() bool {
+	if len(mmListPendingNotifications.expectations) == 0 && mmListPendingNotifications.defaultExpectation == nil && mmListPendingNotifications.mock.funcListPendingNotifications == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPendingNotifications.mock.afterListPendingNotificationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPendingNotifications.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPendingNotifications implements repository.NotificationRepository
+func (mmListPendingNotifications *NotificationRepositoryMock) ListPendingNotifications(ctx context.Context, userID int64, limit int) (notifications []model.Notification, err error) {
+	mm_atomic.AddUint64(&mmListPendingNotifications.beforeListPendingNotificationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPendingNotifications.afterListPendingNotificationsCounter, 1)
+
+	if mmListPendingNotifications.inspectFuncListPendingNotifications != nil {
+		mmListPendingNotifications.inspectFuncListPendingNotifications(ctx, userID, limit)
+	}
+
+	mm_params := NotificationRepositoryMockListPendingNotificationsParams{ctx, userID, limit}
+
+	// Record call args
+	mmListPendingNotifications.ListPendingNotificationsMock.mutex.Lock()
+	mmListPendingNotifications.ListPendingNotificationsMock.callArgs = append(mmListPendingNotifications.ListPendingNotificationsMock.callArgs, &mm_params)
+	mmListPendingNotifications.ListPendingNotificationsMock.mutex.Unlock()
+
+	for _, e := range mmListPendingNotifications.ListPendingNotificationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.notifications, e.results.err
+		}
+	}
+
+	if mmListPendingNotifications.ListPendingNotificationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPendingNotifications.ListPendingNotificationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPendingNotifications.ListPendingNotificationsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPendingNotifications.ListPendingNotificationsMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockListPendingNotificationsParams{ctx, userID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPendingNotifications.t.Errorf("NotificationRepositoryMock.ListPendingNotifications got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListPendingNotifications.t.Errorf("NotificationRepositoryMock.ListPendingNotifications got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListPendingNotifications.t.Errorf("NotificationRepositoryMock.ListPendingNotifications got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPendingNotifications.t.Errorf("NotificationRepositoryMock.ListPendingNotifications got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPendingNotifications.ListPendingNotificationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPendingNotifications.t.Fatal("No results are set for the NotificationRepositoryMock.ListPendingNotifications")
+		}
+		return (*mm_results).notifications, (*mm_results).err
+	}
+	if mmListPendingNotifications.funcListPendingNotifications != nil {
+		return mmListPendingNotifications.funcListPendingNotifications(ctx, userID, limit)
+	}
+	mmListPendingNotifications.t.Fatalf("Unexpected call to NotificationRepositoryMock.ListPendingNotifications. %v %v %v", ctx, userID, limit)
+	return
+}
+
+// ListPendingNotificationsAfterCounter returns a count of finished NotificationRepositoryMock.ListPendingNotifications invocations
+func (mmListPendingNotifications *NotificationRepositoryMock) ListPendingNotificationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingNotifications.afterListPendingNotificationsCounter)
+}
+
+// ListPendingNotificationsBeforeCounter returns a count of NotificationRepositoryMock.ListPendingNotifications invocations
+func (mmListPendingNotifications *NotificationRepositoryMock) ListPendingNotificationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingNotifications.beforeListPendingNotificationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.ListPendingNotifications.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPendingNotifications *mNotificationRepositoryMockListPendingNotifications) Calls() []*NotificationRepositoryMockListPendingNotificationsParams {
+	mmListPendingNotifications.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockListPendingNotificationsParams, len(mmListPendingNotifications.callArgs))
+	copy(argCopy, mmListPendingNotifications.callArgs)
+
+	mmListPendingNotifications.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPendingNotificationsDone returns true if the count of the ListPendingNotifications invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockListPendingNotificationsDone() bool {
+	if m.ListPendingNotificationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPendingNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPendingNotificationsMock.invocationsDone()
+}
+
+// MinimockListPendingNotificationsInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockListPendingNotificationsInspect() {
+	for _, e := range m.ListPendingNotificationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.ListPendingNotifications with params: %#v", *e.params)
+		}
+	}
+
+	afterListPendingNotificationsCounter := mm_atomic.LoadUint64(&m.afterListPendingNotificationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPendingNotificationsMock.defaultExpectation != nil && afterListPendingNotificationsCounter < 1 {
+		if m.ListPendingNotificationsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to NotificationRepositoryMock.ListPendingNotifications")
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.ListPendingNotifications with params: %#v", *m.ListPendingNotificationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPendingNotifications != nil && afterListPendingNotificationsCounter < 1 {
+		m.t.Error("Expected call to NotificationRepositoryMock.ListPendingNotifications")
+	}
+
+	if !m.ListPendingNotificationsMock.invocationsDone() && afterListPendingNotificationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.ListPendingNotifications but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPendingNotificationsMock.expectedInvocations), afterListPendingNotificationsCounter)
+	}
+}
+
+type mNotificationRepositoryMockRecordNotificationsFailure struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockRecordNotificationsFailureExpectation
+	expectations       []*NotificationRepositoryMockRecordNotificationsFailureExpectation
+
+	callArgs []*NotificationRepositoryMockRecordNotificationsFailureParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// NotificationRepositoryMockRecordNotificationsFailureExpectation specifies expectation struct of the NotificationRepository.RecordNotificationsFailure
+type NotificationRepositoryMockRecordNotificationsFailureExpectation struct {
+	mock      *NotificationRepositoryMock
+	params    *NotificationRepositoryMockRecordNotificationsFailureParams
+	paramPtrs *NotificationRepositoryMockRecordNotificationsFailureParamPtrs
+	results   *NotificationRepositoryMockRecordNotificationsFailureResults
+	Counter   uint64
+}
+
+// NotificationRepositoryMockRecordNotificationsFailureParams contains parameters of the NotificationRepository.RecordNotificationsFailure
+type NotificationRepositoryMockRecordNotificationsFailureParams struct {
+	ctx    context.Context
+	params model.RecordNotificationsFailureParams
+}
+
+// NotificationRepositoryMockRecordNotificationsFailureParamPtrs contains pointers to parameters of the NotificationRepository.RecordNotificationsFailure
+type NotificationRepositoryMockRecordNotificationsFailureParamPtrs struct {
+	ctx    *context.Context
+	params *model.RecordNotificationsFailureParams
+}
+
+// NotificationRepositoryMockRecordNotificationsFailureResults contains results of the NotificationRepository.RecordNotificationsFailure
+type NotificationRepositoryMockRecordNotificationsFailureResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) Optional() *mNotificationRepositoryMockRecordNotificationsFailure {
+	mmRecordNotificationsFailure.optional = true
+	return mmRecordNotificationsFailure
+}
+
+// Expect sets up expected params for NotificationRepository.RecordNotificationsFailure
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) Expect(ctx context.Context, params model.RecordNotificationsFailureParams) *mNotificationRepositoryMockRecordNotificationsFailure {
+	if mmRecordNotificationsFailure.mock.funcRecordNotificationsFailure != nil {
+		mmRecordNotificationsFailure.mock.t.Fatalf("NotificationRepositoryMock.RecordNotificationsFailure mock is already set by Set")
+	}
+
+	if mmRecordNotificationsFailure.defaultExpectation == nil {
+		mmRecordNotificationsFailure.defaultExpectation = &NotificationRepositoryMockRecordNotificationsFailureExpectation{}
+	}
+
+	if mmRecordNotificationsFailure.defaultExpectation.paramPtrs != nil {
+		mmRecordNotificationsFailure.mock.t.Fatalf("NotificationRepositoryMock.RecordNotificationsFailure mock is already set by ExpectParams functions")
+	}
+
+	mmRecordNotificationsFailure.defaultExpectation.params = &NotificationRepositoryMockRecordNotificationsFailureParams{ctx, params}
+	for _, e := range mmRecordNotificationsFailure.expectations {
+		if minimock.Equal(e.params, mmRecordNotificationsFailure.defaultExpectation.params) {
+			mmRecordNotificationsFailure.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordNotificationsFailure.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordNotificationsFailure
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.RecordNotificationsFailure
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockRecordNotificationsFailure {
+	if mmRecordNotificationsFailure.mock.funcRecordNotificationsFailure != nil {
+		mmRecordNotificationsFailure.mock.t.Fatalf("NotificationRepositoryMock.RecordNotificationsFailure mock is already set by Set")
+	}
+
+	if mmRecordNotificationsFailure.defaultExpectation == nil {
+		mmRecordNotificationsFailure.defaultExpectation = &NotificationRepositoryMockRecordNotificationsFailureExpectation{}
+	}
+
+	if mmRecordNotificationsFailure.defaultExpectation.params != nil {
+		mmRecordNotificationsFailure.mock.t.Fatalf("NotificationRepositoryMock.RecordNotificationsFailure mock is already set by Expect")
+	}
+
+	if mmRecordNotificationsFailure.defaultExpectation.paramPtrs == nil {
+		mmRecordNotificationsFailure.defaultExpectation.paramPtrs = &NotificationRepositoryMockRecordNotificationsFailureParamPtrs{}
+	}
+	mmRecordNotificationsFailure.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRecordNotificationsFailure
+}
+
+// ExpectParamsParam2 sets up expected param params for NotificationRepository.RecordNotificationsFailure
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) ExpectParamsParam2(params model.RecordNotificationsFailureParams) *mNotificationRepositoryMockRecordNotificationsFailure {
+	if mmRecordNotificationsFailure.mock.funcRecordNotificationsFailure != nil {
+		mmRecordNotificationsFailure.mock.t.Fatalf("NotificationRepositoryMock.RecordNotificationsFailure mock is already set by Set")
+	}
+
+	if mmRecordNotificationsFailure.defaultExpectation == nil {
+		mmRecordNotificationsFailure.defaultExpectation = &NotificationRepositoryMockRecordNotificationsFailureExpectation{}
+	}
+
+	if mmRecordNotificationsFailure.defaultExpectation.params != nil {
+		mmRecordNotificationsFailure.mock.t.Fatalf("NotificationRepositoryMock.RecordNotificationsFailure mock is already set by Expect")
+	}
+
+	if mmRecordNotificationsFailure.defaultExpectation.paramPtrs == nil {
+		mmRecordNotificationsFailure.defaultExpectation.paramPtrs = &NotificationRepositoryMockRecordNotificationsFailureParamPtrs{}
+	}
+	mmRecordNotificationsFailure.defaultExpectation.paramPtrs.params = &params
+
+	return mmRecordNotificationsFailure
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.RecordNotificationsFailure
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) Inspect(f func(ctx context.Context, params model.RecordNotificationsFailureParams)) *mNotificationRepositoryMockRecordNotificationsFailure {
+	if mmRecordNotificationsFailure.mock.inspectFuncRecordNotificationsFailure != nil {
+		mmRecordNotificationsFailure.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.RecordNotificationsFailure")
+	}
+
+	mmRecordNotificationsFailure.mock.inspectFuncRecordNotificationsFailure = f
+
+	return mmRecordNotificationsFailure
+}
+
+// Return sets up results that will be returned by NotificationRepository.RecordNotificationsFailure
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) Return(err error) *NotificationRepositoryMock {
+	if mmRecordNotificationsFailure.mock.funcRecordNotificationsFailure != nil {
+		mmRecordNotificationsFailure.mock.t.Fatalf("NotificationRepositoryMock.RecordNotificationsFailure mock is already set by Set")
+	}
+
+	if mmRecordNotificationsFailure.defaultExpectation == nil {
+		mmRecordNotificationsFailure.defaultExpectation = &NotificationRepositoryMockRecordNotificationsFailureExpectation{mock: mmRecordNotificationsFailure.mock}
+	}
+	mmRecordNotificationsFailure.defaultExpectation.results = &NotificationRepositoryMockRecordNotificationsFailureResults{err}
+	return mmRecordNotificationsFailure.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.RecordNotificationsFailure method
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) Set(f func(ctx context.Context, params model.RecordNotificationsFailureParams) (err error)) *NotificationRepositoryMock {
+	if mmRecordNotificationsFailure.defaultExpectation != nil {
+		mmRecordNotificationsFailure.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.RecordNotificationsFailure method")
+	}
+
+	if len(mmRecordNotificationsFailure.expectations) > 0 {
+		mmRecordNotificationsFailure.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.RecordNotificationsFailure method")
+	}
+
+	mmRecordNotificationsFailure.mock.funcRecordNotificationsFailure = f
+	return mmRecordNotificationsFailure.mock
+}
+
+// When sets expectation for the NotificationRepository.RecordNotificationsFailure which will trigger the result defined by the following
+// Then helper
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) When(ctx context.Context, params model.RecordNotificationsFailureParams) *NotificationRepositoryMockRecordNotificationsFailureExpectation {
+	if mmRecordNotificationsFailure.mock.funcRecordNotificationsFailure != nil {
+		mmRecordNotificationsFailure.mock.t.Fatalf("NotificationRepositoryMock.RecordNotificationsFailure mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockRecordNotificationsFailureExpectation{
+		mock:   mmRecordNotificationsFailure.mock,
+		params: &NotificationRepositoryMockRecordNotificationsFailureParams{ctx, params},
+	}
+	mmRecordNotificationsFailure.expectations = append(mmRecordNotificationsFailure.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.RecordNotificationsFailure return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockRecordNotificationsFailureExpectation) Then(err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockRecordNotificationsFailureResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.RecordNotificationsFailure should be invoked
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) Times(n uint64) *mNotificationRepositoryMockRecordNotificationsFailure {
+	if n == 0 {
+		mmRecordNotificationsFailure.mock.t.Fatalf("Times of NotificationRepositoryMock.RecordNotificationsFailure mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordNotificationsFailure.expectedInvocations, n)
+	return mmRecordNotificationsFailure
+}
+
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) invocationsDone() bool {
+	if len(mmRecordNotificationsFailure.expectations) == 0 && mmRecordNotificationsFailure.defaultExpectation == nil && mmRecordNotificationsFailure.mock.funcRecordNotificationsFailure == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordNotificationsFailure.mock.afterRecordNotificationsFailureCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordNotificationsFailure.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordNotificationsFailure implements repository.NotificationRepository
+func (mmRecordNotificationsFailure *NotificationRepositoryMock) RecordNotificationsFailure(ctx context.Context, params model.RecordNotificationsFailureParams) (err error) {
+	mm_atomic.AddUint64(&mmRecordNotificationsFailure.beforeRecordNotificationsFailureCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordNotificationsFailure.afterRecordNotificationsFailureCounter, 1)
+
+	if mmRecordNotificationsFailure.inspectFuncRecordNotificationsFailure != nil {
+		mmRecordNotificationsFailure.inspectFuncRecordNotificationsFailure(ctx, params)
+	}
+
+	mm_params := NotificationRepositoryMockRecordNotificationsFailureParams{ctx, params}
+
+	// Record call args
+	mmRecordNotificationsFailure.RecordNotificationsFailureMock.mutex.Lock()
+	mmRecordNotificationsFailure.RecordNotificationsFailureMock.callArgs = append(mmRecordNotificationsFailure.RecordNotificationsFailureMock.callArgs, &mm_params)
+	mmRecordNotificationsFailure.RecordNotificationsFailureMock.mutex.Unlock()
+
+	for _, e := range mmRecordNotificationsFailure.RecordNotificationsFailureMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecordNotificationsFailure.RecordNotificationsFailureMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordNotificationsFailure.RecordNotificationsFailureMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordNotificationsFailure.RecordNotificationsFailureMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordNotificationsFailure.RecordNotificationsFailureMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockRecordNotificationsFailureParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordNotificationsFailure.t.Errorf("NotificationRepositoryMock.RecordNotificationsFailure got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmRecordNotificationsFailure.t.Errorf("NotificationRepositoryMock.RecordNotificationsFailure got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordNotificationsFailure.t.Errorf("NotificationRepositoryMock.RecordNotificationsFailure got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordNotificationsFailure.RecordNotificationsFailureMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordNotificationsFailure.t.Fatal("No results are set for the NotificationRepositoryMock.RecordNotificationsFailure")
+		}
+		return (*mm_results).err
+	}
+	if mmRecordNotificationsFailure.funcRecordNotificationsFailure != nil {
+		return mmRecordNotificationsFailure.funcRecordNotificationsFailure(ctx, params)
+	}
+	mmRecordNotificationsFailure.t.Fatalf("Unexpected call to NotificationRepositoryMock.RecordNotificationsFailure. %v %v", ctx, params)
+	return
+}
+
+// RecordNotificationsFailureAfterCounter returns a count of finished NotificationRepositoryMock.RecordNotificationsFailure invocations
+func (mmRecordNotificationsFailure *NotificationRepositoryMock) RecordNotificationsFailureAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordNotificationsFailure.afterRecordNotificationsFailureCounter)
+}
+
+// RecordNotificationsFailureBeforeCounter returns a count of NotificationRepositoryMock.RecordNotificationsFailure invocations
+func (mmRecordNotificationsFailure *NotificationRepositoryMock) RecordNotificationsFailureBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordNotificationsFailure.beforeRecordNotificationsFailureCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.RecordNotificationsFailure.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordNotificationsFailure *mNotificationRepositoryMockRecordNotificationsFailure) Calls() []*NotificationRepositoryMockRecordNotificationsFailureParams {
+	mmRecordNotificationsFailure.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockRecordNotificationsFailureParams, len(mmRecordNotificationsFailure.callArgs))
+	copy(argCopy, mmRecordNotificationsFailure.callArgs)
+
+	mmRecordNotificationsFailure.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordNotificationsFailureDone returns true if the count of the RecordNotificationsFailure invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockRecordNotificationsFailureDone() bool {
+	if m.RecordNotificationsFailureMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordNotificationsFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordNotificationsFailureMock.invocationsDone()
+}
+
+// MinimockRecordNotificationsFailureInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockRecordNotificationsFailureInspect() {
+	for _, e := range m.RecordNotificationsFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.RecordNotificationsFailure with params: %#v", *e.params)
+		}
+	}
+
+	afterRecordNotificationsFailureCounter := mm_atomic.LoadUint64(&m.afterRecordNotificationsFailureCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordNotificationsFailureMock.defaultExpectation != nil && afterRecordNotificationsFailureCounter < 1 {
+		if m.RecordNotificationsFailureMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to NotificationRepositoryMock.RecordNotificationsFailure")
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.RecordNotificationsFailure with params: %#v", *m.RecordNotificationsFailureMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordNotificationsFailure != nil && afterRecordNotificationsFailureCounter < 1 {
+		m.t.Error("Expected call to NotificationRepositoryMock.RecordNotificationsFailure")
+	}
+
+	if !m.RecordNotificationsFailureMock.invocationsDone() && afterRecordNotificationsFailureCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.RecordNotificationsFailure but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordNotificationsFailureMock.expectedInvocations), afterRecordNotificationsFailureCounter)
+	}
+}
+
+type mNotificationRepositoryMockReleaseRecipient struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockReleaseRecipientExpectation
+	expectations       []*NotificationRepositoryMockReleaseRecipientExpectation
+
+	callArgs []*NotificationRepositoryMockReleaseRecipientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// NotificationRepositoryMockReleaseRecipientExpectation specifies expectation struct of the NotificationRepository.ReleaseRecipient
+type NotificationRepositoryMockReleaseRecipientExpectation struct {
+	mock      *NotificationRepositoryMock
+	params    *NotificationRepositoryMockReleaseRecipientParams
+	paramPtrs *NotificationRepositoryMockReleaseRecipientParamPtrs
+	results   *NotificationRepositoryMockReleaseRecipientResults
+	Counter   uint64
+}
+
+// NotificationRepositoryMockReleaseRecipientParams contains parameters of the NotificationRepository.ReleaseRecipient
+type NotificationRepositoryMockReleaseRecipientParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// NotificationRepositoryMockReleaseRecipientParamPtrs contains pointers to parameters of the NotificationRepository.ReleaseRecipient
+type NotificationRepositoryMockReleaseRecipientParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// NotificationRepositoryMockReleaseRecipientResults contains results of the NotificationRepository.ReleaseRecipient
+type NotificationRepositoryMockReleaseRecipientResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) Optional() *mNotificationRepositoryMockReleaseRecipient {
+	mmReleaseRecipient.optional = true
+	return mmReleaseRecipient
+}
+
+// Expect sets up expected params for NotificationRepository.ReleaseRecipient
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) Expect(ctx context.Context, userID int64) *mNotificationRepositoryMockReleaseRecipient {
+	if mmReleaseRecipient.mock.funcReleaseRecipient != nil {
+		mmReleaseRecipient.mock.t.Fatalf("NotificationRepositoryMock.ReleaseRecipient mock is already set by Set")
+	}
+
+	if mmReleaseRecipient.defaultExpectation == nil {
+		mmReleaseRecipient.defaultExpectation = &NotificationRepositoryMockReleaseRecipientExpectation{}
+	}
+
+	if mmReleaseRecipient.defaultExpectation.paramPtrs != nil {
+		mmReleaseRecipient.mock.t.Fatalf("NotificationRepositoryMock.ReleaseRecipient mock is already set by ExpectParams functions")
+	}
+
+	mmReleaseRecipient.defaultExpectation.params = &NotificationRepositoryMockReleaseRecipientParams{ctx, userID}
+	for _, e := range mmReleaseRecipient.expectations {
+		if minimock.Equal(e.params, mmReleaseRecipient.defaultExpectation.params) {
+			mmReleaseRecipient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReleaseRecipient.defaultExpectation.params)
+		}
+	}
+
+	return mmReleaseRecipient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.ReleaseRecipient
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockReleaseRecipient {
+	if mmReleaseRecipient.mock.funcReleaseRecipient != nil {
+		mmReleaseRecipient.mock.t.Fatalf("NotificationRepositoryMock.ReleaseRecipient mock is already set by Set")
+	}
+
+	if mmReleaseRecipient.defaultExpectation == nil {
+		mmReleaseRecipient.defaultExpectation = &NotificationRepositoryMockReleaseRecipientExpectation{}
+	}
+
+	if mmReleaseRecipient.defaultExpectation.params != nil {
+		mmReleaseRecipient.mock.t.Fatalf("NotificationRepositoryMock.ReleaseRecipient mock is already set by Expect")
+	}
+
+	if mmReleaseRecipient.defaultExpectation.paramPtrs == nil {
+		mmReleaseRecipient.defaultExpectation.paramPtrs = &NotificationRepositoryMockReleaseRecipientParamPtrs{}
+	}
+	mmReleaseRecipient.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmReleaseRecipient
+}
+
+// ExpectUserIDParam2 sets up expected param userID for NotificationRepository.ReleaseRecipient
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) ExpectUserIDParam2(userID int64) *mNotificationRepositoryMockReleaseRecipient {
+	if mmReleaseRecipient.mock.funcReleaseRecipient != nil {
+		mmReleaseRecipient.mock.t.Fatalf("NotificationRepositoryMock.ReleaseRecipient mock is already set by Set")
+	}
+
+	if mmReleaseRecipient.defaultExpectation == nil {
+		mmReleaseRecipient.defaultExpectation = &NotificationRepositoryMockReleaseRecipientExpectation{}
+	}
+
+	if mmReleaseRecipient.defaultExpectation.params != nil {
+		mmReleaseRecipient.mock.t.Fatalf("NotificationRepositoryMock.ReleaseRecipient mock is already set by Expect")
+	}
+
+	if mmReleaseRecipient.defaultExpectation.paramPtrs == nil {
+		mmReleaseRecipient.defaultExpectation.paramPtrs = &NotificationRepositoryMockReleaseRecipientParamPtrs{}
+	}
+	mmReleaseRecipient.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmReleaseRecipient
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.ReleaseRecipient
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) Inspect(f func(ctx context.Context, userID int64)) *mNotificationRepositoryMockReleaseRecipient {
+	if mmReleaseRecipient.mock.inspectFuncReleaseRecipient != nil {
+		mmReleaseRecipient.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.ReleaseRecipient")
+	}
+
+	mmReleaseRecipient.mock.inspectFuncReleaseRecipient = f
+
+	return mmReleaseRecipient
+}
+
+// Return sets up results that will be returned by NotificationRepository.ReleaseRecipient
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) Return(err error) *NotificationRepositoryMock {
+	if mmReleaseRecipient.mock.funcReleaseRecipient != nil {
+		mmReleaseRecipient.mock.t.Fatalf("NotificationRepositoryMock.ReleaseRecipient mock is already set by Set")
+	}
+
+	if mmReleaseRecipient.defaultExpectation == nil {
+		mmReleaseRecipient.defaultExpectation = &NotificationRepositoryMockReleaseRecipientExpectation{mock: mmReleaseRecipient.mock}
+	}
+	mmReleaseRecipient.defaultExpectation.results = &NotificationRepositoryMockReleaseRecipientResults{err}
+	return mmReleaseRecipient.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.ReleaseRecipient method
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) Set(f func(ctx context.Context, userID int64) (err error)) *NotificationRepositoryMock {
+	if mmReleaseRecipient.defaultExpectation != nil {
+		mmReleaseRecipient.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.ReleaseRecipient method")
+	}
+
+	if len(mmReleaseRecipient.expectations) > 0 {
+		mmReleaseRecipient.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.ReleaseRecipient method")
+	}
+
+	mmReleaseRecipient.mock.funcReleaseRecipient = f
+	return mmReleaseRecipient.mock
+}
+
+// When sets expectation for the NotificationRepository.ReleaseRecipient which will trigger the result defined by the following
+// Then helper
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) When(ctx context.Context, userID int64) *NotificationRepositoryMockReleaseRecipientExpectation {
+	if mmReleaseRecipient.mock.funcReleaseRecipient != nil {
+		mmReleaseRecipient.mock.t.Fatalf("NotificationRepositoryMock.ReleaseRecipient mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockReleaseRecipientExpectation{
+		mock:   mmReleaseRecipient.mock,
+		params: &NotificationRepositoryMockReleaseRecipientParams{ctx, userID},
+	}
+	mmReleaseRecipient.expectations = append(mmReleaseRecipient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.ReleaseRecipient return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockReleaseRecipientExpectation) Then(err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockReleaseRecipientResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.ReleaseRecipient should be invoked
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) Times(n uint64) *mNotificationRepositoryMockReleaseRecipient {
+	if n == 0 {
+		mmReleaseRecipient.mock.t.Fatalf("Times of NotificationRepositoryMock.ReleaseRecipient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReleaseRecipient.expectedInvocations, n)
+	return mmReleaseRecipient
+}
+
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) invocationsDone() bool {
+	if len(mmReleaseRecipient.expectations) == 0 && mmReleaseRecipient.defaultExpectation == nil && mmReleaseRecipient.mock.funcReleaseRecipient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReleaseRecipient.mock.afterReleaseRecipientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReleaseRecipient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReleaseRecipient implements repository.NotificationRepository
+func (mmReleaseRecipient *NotificationRepositoryMock) ReleaseRecipient(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmReleaseRecipient.beforeReleaseRecipientCounter, 1)
+	defer mm_atomic.AddUint64(&mmReleaseRecipient.afterReleaseRecipientCounter, 1)
+
+	if mmReleaseRecipient.inspectFuncReleaseRecipient != nil {
+		mmReleaseRecipient.inspectFuncReleaseRecipient(ctx, userID)
+	}
+
+	mm_params := NotificationRepositoryMockReleaseRecipientParams{ctx, userID}
+
+	// Record call args
+	mmReleaseRecipient.ReleaseRecipientMock.mutex.Lock()
+	mmReleaseRecipient.ReleaseRecipientMock.callArgs = append(mmReleaseRecipient.ReleaseRecipientMock.callArgs, &mm_params)
+	mmReleaseRecipient.ReleaseRecipientMock.mutex.Unlock()
+
+	for _, e := range mmReleaseRecipient.ReleaseRecipientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReleaseRecipient.ReleaseRecipientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReleaseRecipient.ReleaseRecipientMock.defaultExpectation.Counter, 1)
+		mm_want := mmReleaseRecipient.ReleaseRecipientMock.defaultExpectation.params
+		mm_want_ptrs := mmReleaseRecipient.ReleaseRecipientMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockReleaseRecipientParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReleaseRecipient.t.Errorf("NotificationRepositoryMock.ReleaseRecipient got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReleaseRecipient.t.Errorf("NotificationRepositoryMock.ReleaseRecipient got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReleaseRecipient.t.Errorf("NotificationRepositoryMock.ReleaseRecipient got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReleaseRecipient.ReleaseRecipientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReleaseRecipient.t.Fatal("No results are set for the NotificationRepositoryMock.ReleaseRecipient")
+		}
+		return (*mm_results).err
+	}
+	if mmReleaseRecipient.funcReleaseRecipient != nil {
+		return mmReleaseRecipient.funcReleaseRecipient(ctx, userID)
+	}
+	mmReleaseRecipient.t.Fatalf("Unexpected call to NotificationRepositoryMock.ReleaseRecipient. %v %v", ctx, userID)
+	return
+}
+
+// ReleaseRecipientAfterCounter returns a count of finished NotificationRepositoryMock.ReleaseRecipient invocations
+func (mmReleaseRecipient *NotificationRepositoryMock) ReleaseRecipientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseRecipient.afterReleaseRecipientCounter)
+}
+
+// ReleaseRecipientBeforeCounter returns a count of NotificationRepositoryMock.ReleaseRecipient invocations
+func (mmReleaseRecipient *NotificationRepositoryMock) ReleaseRecipientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseRecipient.beforeReleaseRecipientCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.ReleaseRecipient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReleaseRecipient *mNotificationRepositoryMockReleaseRecipient) Calls() []*NotificationRepositoryMockReleaseRecipientParams {
+	mmReleaseRecipient.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockReleaseRecipientParams, len(mmReleaseRecipient.callArgs))
+	copy(argCopy, mmReleaseRecipient.callArgs)
+
+	mmReleaseRecipient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseRecipientDone returns true if the count of the ReleaseRecipient invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockReleaseRecipientDone() bool {
+	if m.ReleaseRecipientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReleaseRecipientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReleaseRecipientMock.invocationsDone()
+}
+
+// MinimockReleaseRecipientInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockReleaseRecipientInspect() {
+	for _, e := range m.ReleaseRecipientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.ReleaseRecipient with params: %#v", *e.params)
+		}
+	}
+
+	afterReleaseRecipientCounter := mm_atomic.LoadUint64(&m.afterReleaseRecipientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseRecipientMock.defaultExpectation != nil && afterReleaseRecipientCounter < 1 {
+		if m.ReleaseRecipientMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to NotificationRepositoryMock.ReleaseRecipient")
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.ReleaseRecipient with params: %#v", *m.ReleaseRecipientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseRecipient != nil && afterReleaseRecipientCounter < 1 {
+		m.t.Error("Expected call to NotificationRepositoryMock.ReleaseRecipient")
+	}
+
+	if !m.ReleaseRecipientMock.invocationsDone() && afterReleaseRecipientCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.ReleaseRecipient but found %d calls",
+			mm_atomic.LoadUint64(&m.ReleaseRecipientMock.expectedInvocations), afterReleaseRecipientCounter)
+	}
+}
+
+type mNotificationRepositoryMockUpdateRecipient struct {
+	optional           bool
+	mock               *NotificationRepositoryMock
+	defaultExpectation *NotificationRepositoryMockUpdateRecipientExpectation
+	expectations       []*NotificationRepositoryMockUpdateRecipientExpectation
+
+	callArgs []*NotificationRepositoryMockUpdateRecipientParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// NotificationRepositoryMockUpdateRecipientExpectation specifies expectation struct of the NotificationRepository.UpdateRecipient
+type NotificationRepositoryMockUpdateRecipientExpectation struct {
+	mock      *NotificationRepositoryMock
+	params    *NotificationRepositoryMockUpdateRecipientParams
+	paramPtrs *NotificationRepositoryMockUpdateRecipientParamPtrs
+	results   *NotificationRepositoryMockUpdateRecipientResults
+	Counter   uint64
+}
+
+// NotificationRepositoryMockUpdateRecipientParams contains parameters of the NotificationRepository.UpdateRecipient
+type NotificationRepositoryMockUpdateRecipientParams struct {
+	ctx    context.Context
+	params model.UpdateRecipientParams
+}
+
+// NotificationRepositoryMockUpdateRecipientParamPtrs contains pointers to parameters of the NotificationRepository.UpdateRecipient
+type NotificationRepositoryMockUpdateRecipientParamPtrs struct {
+	ctx    *context.Context
+	params *model.UpdateRecipientParams
+}
+
+// NotificationRepositoryMockUpdateRecipientResults contains results of the NotificationRepository.UpdateRecipient
+type NotificationRepositoryMockUpdateRecipientResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) Optional() *mNotificationRepositoryMockUpdateRecipient {
+	mmUpdateRecipient.optional = true
+	return mmUpdateRecipient
+}
+
+// Expect sets up expected params for NotificationRepository.UpdateRecipient
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) Expect(ctx context.Context, params model.UpdateRecipientParams) *mNotificationRepositoryMockUpdateRecipient {
+	if mmUpdateRecipient.mock.funcUpdateRecipient != nil {
+		mmUpdateRecipient.mock.t.Fatalf("NotificationRepositoryMock.UpdateRecipient mock is already set by Set")
+	}
+
+	if mmUpdateRecipient.defaultExpectation == nil {
+		mmUpdateRecipient.defaultExpectation = &NotificationRepositoryMockUpdateRecipientExpectation{}
+	}
+
+	if mmUpdateRecipient.defaultExpectation.paramPtrs != nil {
+		mmUpdateRecipient.mock.t.Fatalf("NotificationRepositoryMock.UpdateRecipient mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateRecipient.defaultExpectation.params = &NotificationRepositoryMockUpdateRecipientParams{ctx, params}
+	for _, e := range mmUpdateRecipient.expectations {
+		if minimock.Equal(e.params, mmUpdateRecipient.defaultExpectation.params) {
+			mmUpdateRecipient.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateRecipient.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateRecipient
+}
+
+// ExpectCtxParam1 sets up expected param ctx for NotificationRepository.UpdateRecipient
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) ExpectCtxParam1(ctx context.Context) *mNotificationRepositoryMockUpdateRecipient {
+	if mmUpdateRecipient.mock.funcUpdateRecipient != nil {
+		mmUpdateRecipient.mock.t.Fatalf("NotificationRepositoryMock.UpdateRecipient mock is already set by Set")
+	}
+
+	if mmUpdateRecipient.defaultExpectation == nil {
+		mmUpdateRecipient.defaultExpectation = &NotificationRepositoryMockUpdateRecipientExpectation{}
+	}
+
+	if mmUpdateRecipient.defaultExpectation.params != nil {
+		mmUpdateRecipient.mock.t.Fatalf("NotificationRepositoryMock.UpdateRecipient mock is already set by Expect")
+	}
+
+	if mmUpdateRecipient.defaultExpectation.paramPtrs == nil {
+		mmUpdateRecipient.defaultExpectation.paramPtrs = &NotificationRepositoryMockUpdateRecipientParamPtrs{}
+	}
+	mmUpdateRecipient.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateRecipient
+}
+
+// ExpectParamsParam2 sets up expected param params for NotificationRepository.UpdateRecipient
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) ExpectParamsParam2(params model.UpdateRecipientParams) *mNotificationRepositoryMockUpdateRecipient {
+	if mmUpdateRecipient.mock.funcUpdateRecipient != nil {
+		mmUpdateRecipient.mock.t.Fatalf("NotificationRepositoryMock.UpdateRecipient mock is already set by Set")
+	}
+
+	if mmUpdateRecipient.defaultExpectation == nil {
+		mmUpdateRecipient.defaultExpectation = &NotificationRepositoryMockUpdateRecipientExpectation{}
+	}
+
+	if mmUpdateRecipient.defaultExpectation.params != nil {
+		mmUpdateRecipient.mock.t.Fatalf("NotificationRepositoryMock.UpdateRecipient mock is already set by Expect")
+	}
+
+	if mmUpdateRecipient.defaultExpectation.paramPtrs == nil {
+		mmUpdateRecipient.defaultExpectation.paramPtrs = &NotificationRepositoryMockUpdateRecipientParamPtrs{}
+	}
+	mmUpdateRecipient.defaultExpectation.paramPtrs.params = &params
+
+	return mmUpdateRecipient
+}
+
+// Inspect accepts an inspector function that has same arguments as the NotificationRepository.UpdateRecipient
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) Inspect(f func(ctx context.Context, params model.UpdateRecipientParams)) *mNotificationRepositoryMockUpdateRecipient {
+	if mmUpdateRecipient.mock.inspectFuncUpdateRecipient != nil {
+		mmUpdateRecipient.mock.t.Fatalf("Inspect function is already set for NotificationRepositoryMock.UpdateRecipient")
+	}
+
+	mmUpdateRecipient.mock.inspectFuncUpdateRecipient = f
+
+	return mmUpdateRecipient
+}
+
+// Return sets up results that will be returned by NotificationRepository.UpdateRecipient
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) Return(err error) *NotificationRepositoryMock {
+	if mmUpdateRecipient.mock.funcUpdateRecipient != nil {
+		mmUpdateRecipient.mock.t.Fatalf("NotificationRepositoryMock.UpdateRecipient mock is already set by Set")
+	}
+
+	if mmUpdateRecipient.defaultExpectation == nil {
+		mmUpdateRecipient.defaultExpectation = &NotificationRepositoryMockUpdateRecipientExpectation{mock: mmUpdateRecipient.mock}
+	}
+	mmUpdateRecipient.defaultExpectation.results = &NotificationRepositoryMockUpdateRecipientResults{err}
+	return mmUpdateRecipient.mock
+}
+
+// Set uses given function f to mock the NotificationRepository.UpdateRecipient method
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) Set(f func(ctx context.Context, params model.UpdateRecipientParams) (err error)) *NotificationRepositoryMock {
+	if mmUpdateRecipient.defaultExpectation != nil {
+		mmUpdateRecipient.mock.t.Fatalf("Default expectation is already set for the NotificationRepository.UpdateRecipient method")
+	}
+
+	if len(mmUpdateRecipient.expectations) > 0 {
+		mmUpdateRecipient.mock.t.Fatalf("Some expectations are already set for the NotificationRepository.UpdateRecipient method")
+	}
+
+	mmUpdateRecipient.mock.funcUpdateRecipient = f
+	return mmUpdateRecipient.mock
+}
+
+// When sets expectation for the NotificationRepository.UpdateRecipient which will trigger the result defined by the following
+// Then helper
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) When(ctx context.Context, params model.UpdateRecipientParams) *NotificationRepositoryMockUpdateRecipientExpectation {
+	if mmUpdateRecipient.mock.funcUpdateRecipient != nil {
+		mmUpdateRecipient.mock.t.Fatalf("NotificationRepositoryMock.UpdateRecipient mock is already set by Set")
+	}
+
+	expectation := &NotificationRepositoryMockUpdateRecipientExpectation{
+		mock:   mmUpdateRecipient.mock,
+		params: &NotificationRepositoryMockUpdateRecipientParams{ctx, params},
+	}
+	mmUpdateRecipient.expectations = append(mmUpdateRecipient.expectations, expectation)
+	return expectation
+}
+
+// Then sets up NotificationRepository.UpdateRecipient return parameters for the expectation previously defined by the When method
+func (e *NotificationRepositoryMockUpdateRecipientExpectation) Then(err error) *NotificationRepositoryMock {
+	e.results = &NotificationRepositoryMockUpdateRecipientResults{err}
+	return e.mock
+}
+
+// Times sets number of times NotificationRepository.UpdateRecipient should be invoked
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) Times(n uint64) *mNotificationRepositoryMockUpdateRecipient {
+	if n == 0 {
+		mmUpdateRecipient.mock.t.Fatalf("Times of NotificationRepositoryMock.UpdateRecipient mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateRecipient.expectedInvocations, n)
+	return mmUpdateRecipient
+}
+
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) invocationsDone() bool {
+	if len(mmUpdateRecipient.expectations) == 0 && mmUpdateRecipient.defaultExpectation == nil && mmUpdateRecipient.mock.funcUpdateRecipient == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateRecipient.mock.afterUpdateRecipientCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateRecipient.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateRecipient implements repository.NotificationRepository
+func (mmUpdateRecipient *NotificationRepositoryMock) UpdateRecipient(ctx context.Context, params model.UpdateRecipientParams) (err error) {
+	mm_atomic.AddUint64(&mmUpdateRecipient.beforeUpdateRecipientCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateRecipient.afterUpdateRecipientCounter, 1)
+
+	if mmUpdateRecipient.inspectFuncUpdateRecipient != nil {
+		mmUpdateRecipient.inspectFuncUpdateRecipient(ctx, params)
+	}
+
+	mm_params := NotificationRepositoryMockUpdateRecipientParams{ctx, params}
+
+	// Record call args
+	mmUpdateRecipient.UpdateRecipientMock.mutex.Lock()
+	mmUpdateRecipient.UpdateRecipientMock.callArgs = append(mmUpdateRecipient.UpdateRecipientMock.callArgs, &mm_params)
+	mmUpdateRecipient.UpdateRecipientMock.mutex.Unlock()
+
+	for _, e := range mmUpdateRecipient.UpdateRecipientMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateRecipient.UpdateRecipientMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateRecipient.UpdateRecipientMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateRecipient.UpdateRecipientMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateRecipient.UpdateRecipientMock.defaultExpectation.paramPtrs
+
+		mm_got := NotificationRepositoryMockUpdateRecipientParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateRecipient.t.Errorf("NotificationRepositoryMock.UpdateRecipient got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUpdateRecipient.t.Errorf("NotificationRepositoryMock.UpdateRecipient got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateRecipient.t.Errorf("NotificationRepositoryMock.UpdateRecipient got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateRecipient.UpdateRecipientMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateRecipient.t.Fatal("No results are set for the NotificationRepositoryMock.UpdateRecipient")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateRecipient.funcUpdateRecipient != nil {
+		return mmUpdateRecipient.funcUpdateRecipient(ctx, params)
+	}
+	mmUpdateRecipient.t.Fatalf("Unexpected call to NotificationRepositoryMock.UpdateRecipient. %v %v", ctx, params)
+	return
+}
+
+// UpdateRecipientAfterCounter returns a count of finished NotificationRepositoryMock.UpdateRecipient invocations
+func (mmUpdateRecipient *NotificationRepositoryMock) UpdateRecipientAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRecipient.afterUpdateRecipientCounter)
+}
+
+// UpdateRecipientBeforeCounter returns a count of NotificationRepositoryMock.UpdateRecipient invocations
+func (mmUpdateRecipient *NotificationRepositoryMock) UpdateRecipientBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRecipient.beforeUpdateRecipientCounter)
+}
+
+// Calls returns a list of arguments used in each call to NotificationRepositoryMock.UpdateRecipient.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateRecipient *mNotificationRepositoryMockUpdateRecipient) Calls() []*NotificationRepositoryMockUpdateRecipientParams {
+	mmUpdateRecipient.mutex.RLock()
+
+	argCopy := make([]*NotificationRepositoryMockUpdateRecipientParams, len(mmUpdateRecipient.callArgs))
+	copy(argCopy, mmUpdateRecipient.callArgs)
+
+	mmUpdateRecipient.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateRecipientDone returns true if the count of the UpdateRecipient invocations corresponds
+// the number of defined expectations
+func (m *NotificationRepositoryMock) MinimockUpdateRecipientDone() bool {
+	if m.UpdateRecipientMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateRecipientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateRecipientMock.invocationsDone()
+}
+
+// MinimockUpdateRecipientInspect logs each unmet expectation
+func (m *NotificationRepositoryMock) MinimockUpdateRecipientInspect() {
+	for _, e := range m.UpdateRecipientMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.UpdateRecipient with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateRecipientCounter := mm_atomic.LoadUint64(&m.afterUpdateRecipientCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateRecipientMock.defaultExpectation != nil && afterUpdateRecipientCounter < 1 {
+		if m.UpdateRecipientMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to NotificationRepositoryMock.UpdateRecipient")
+		} else {
+			m.t.Errorf("Expected call to NotificationRepositoryMock.UpdateRecipient with params: %#v", *m.UpdateRecipientMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateRecipient != nil && afterUpdateRecipientCounter < 1 {
+		m.t.Error("Expected call to NotificationRepositoryMock.UpdateRecipient")
+	}
+
+	if !m.UpdateRecipientMock.invocationsDone() && afterUpdateRecipientCounter > 0 {
+		m.t.Errorf("Expected %d calls to NotificationRepositoryMock.UpdateRecipient but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateRecipientMock.expectedInvocations), afterUpdateRecipientCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *NotificationRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimDueRecipientsInspect()
+
+			m.MinimockCreateNotificationsInspect()
+
+			m.MinimockDeleteFinishedNotificationsInspect()
+
+			m.MinimockFinishNotificationsInspect()
+
+			m.MinimockListPendingNotificationsInspect()
+
+			m.MinimockRecordNotificationsFailureInspect()
+
+			m.MinimockReleaseRecipientInspect()
+
+			m.MinimockUpdateRecipientInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *NotificationRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *NotificationRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimDueRecipientsDone() &&
+		m.MinimockCreateNotificationsDone() &&
+		m.MinimockDeleteFinishedNotificationsDone() &&
+		m.MinimockFinishNotificationsDone() &&
+		m.MinimockListPendingNotificationsDone() &&
+		m.MinimockRecordNotificationsFailureDone() &&
+		m.MinimockReleaseRecipientDone() &&
+		m.MinimockUpdateRecipientDone()
+}
@@ -0,0 +1,2142 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.OutboxRepository -o outbox_repository_minimock.go -n OutboxRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// OutboxRepositoryMock implements repository.OutboxRepository
+type OutboxRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateEvent          func(ctx context.Context, params model.CreateEventParams) (err error)
+	inspectFuncCreateEvent   func(ctx context.Context, params model.CreateEventParams)
+	afterCreateEventCounter  uint64
+	beforeCreateEventCounter uint64
+	CreateEventMock          mOutboxRepositoryMockCreateEvent
+
+	funcDeletePublishedEvents          func(ctx context.Context, before time.Time) (deleted int64, err error)
+	inspectFuncDeletePublishedEvents   func(ctx context.Context, before time.Time)
+	afterDeletePublishedEventsCounter  uint64
+	beforeDeletePublishedEventsCounter uint64
+	DeletePublishedEventsMock          mOutboxRepositoryMockDeletePublishedEvents
+
+	funcListPendingEvents          func(ctx context.Context, limit int) (events []model.Event, err error)
+	inspectFuncListPendingEvents   func(ctx context.Context, limit int)
+	afterListPendingEventsCounter  uint64
+	beforeListPendingEventsCounter uint64
+	ListPendingEventsMock          mOutboxRepositoryMockListPendingEvents
+
+	funcLockRelay          func(ctx context.Context, holder string, now time.Time, until time.Time) (locked bool, err error)
+	inspectFuncLockRelay   func(ctx context.Context, holder string, now time.Time, until time.Time)
+	afterLockRelayCounter  uint64
+	beforeLockRelayCounter uint64
+	LockRelayMock          mOutboxRepositoryMockLockRelay
+
+	funcMarkEventFailed          func(ctx context.Context, id int64, reason string) (err error)
+	inspectFuncMarkEventFailed   func(ctx context.Context, id int64, reason string)
+	afterMarkEventFailedCounter  uint64
+	beforeMarkEventFailedCounter uint64
+	MarkEventFailedMock          mOutboxRepositoryMockMarkEventFailed
+
+	funcMarkEventsPublished          func(ctx context.Context, ids []int64) (err error)
+	inspectFuncMarkEventsPublished   func(ctx context.Context, ids []int64)
+	afterMarkEventsPublishedCounter  uint64
+	beforeMarkEventsPublishedCounter uint64
+	MarkEventsPublishedMock          mOutboxRepositoryMockMarkEventsPublished
+}
+
+// NewOutboxRepositoryMock returns a mock for repository.OutboxRepository
+func NewOutboxRepositoryMock(t minimock.Tester) *OutboxRepositoryMock {
+	m := &OutboxRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateEventMock = mOutboxRepositoryMockCreateEvent{mock: m}
+	m.CreateEventMock.callArgs = []*OutboxRepositoryMockCreateEventParams{}
+
+	m.DeletePublishedEventsMock = mOutboxRepositoryMockDeletePublishedEvents{mock: m}
+	m.DeletePublishedEventsMock.callArgs = []*OutboxRepositoryMockDeletePublishedEventsParams{}
+
+	m.ListPendingEventsMock = mOutboxRepositoryMockListPendingEvents{mock: m}
+	m.ListPendingEventsMock.callArgs = []*OutboxRepositoryMockListPendingEventsParams{}
+
+	m.LockRelayMock = mOutboxRepositoryMockLockRelay{mock: m}
+	m.LockRelayMock.callArgs = []*OutboxRepositoryMockLockRelayParams{}
+
+	m.MarkEventFailedMock = mOutboxRepositoryMockMarkEventFailed{mock: m}
+	m.MarkEventFailedMock.callArgs = []*OutboxRepositoryMockMarkEventFailedParams{}
+
+	m.MarkEventsPublishedMock = mOutboxRepositoryMockMarkEventsPublished{mock: m}
+	m.MarkEventsPublishedMock.callArgs = []*OutboxRepositoryMockMarkEventsPublishedParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxRepositoryMockCreateEvent struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockCreateEventExpectation
+	expectations       []*OutboxRepositoryMockCreateEventExpectation
+
+	callArgs []*OutboxRepositoryMockCreateEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OutboxRepositoryMockCreateEventExpectation specifies expectation struct of the OutboxRepository.CreateEvent
+type OutboxRepositoryMockCreateEventExpectation struct {
+	mock      *OutboxRepositoryMock
+	params    *OutboxRepositoryMockCreateEventParams
+	paramPtrs *OutboxRepositoryMockCreateEventParamPtrs
+	results   *OutboxRepositoryMockCreateEventResults
+	Counter   uint64
+}
+
+// OutboxRepositoryMockCreateEventParams contains parameters of the OutboxRepository.CreateEvent
+type OutboxRepositoryMockCreateEventParams struct {
+	ctx    context.Context
+	params model.CreateEventParams
+}
+
+// OutboxRepositoryMockCreateEventParamPtrs contains pointers to parameters of the OutboxRepository.CreateEvent
+type OutboxRepositoryMockCreateEventParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateEventParams
+}
+
+// OutboxRepositoryMockCreateEventResults contains results of the OutboxRepository.CreateEvent
+type OutboxRepositoryMockCreateEventResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) Optional() *mOutboxRepositoryMockCreateEvent {
+	mmCreateEvent.optional = true
+	return mmCreateEvent
+}
+
+// Expect sets up expected params for OutboxRepository.CreateEvent
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) Expect(ctx context.Context, params model.CreateEventParams) *mOutboxRepositoryMockCreateEvent {
+	if mmCreateEvent.mock.funcCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateEvent mock is already set by Set")
+	}
+
+	if mmCreateEvent.defaultExpectation == nil {
+		mmCreateEvent.defaultExpectation = &OutboxRepositoryMockCreateEventExpectation{}
+	}
+
+	if mmCreateEvent.defaultExpectation.paramPtrs != nil {
+		mmCreateEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateEvent mock is already set by ExpectParams functions")
+	}
+
+	mmCreateEvent.defaultExpectation.params = &OutboxRepositoryMockCreateEventParams{ctx, params}
+	for _, e := range mmCreateEvent.expectations {
+		if minimock.Equal(e.params, mmCreateEvent.defaultExpectation.params) {
+			mmCreateEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.CreateEvent
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockCreateEvent {
+	if mmCreateEvent.mock.funcCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateEvent mock is already set by Set")
+	}
+
+	if mmCreateEvent.defaultExpectation == nil {
+		mmCreateEvent.defaultExpectation = &OutboxRepositoryMockCreateEventExpectation{}
+	}
+
+	if mmCreateEvent.defaultExpectation.params != nil {
+		mmCreateEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateEvent mock is already set by Expect")
+	}
+
+	if mmCreateEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockCreateEventParamPtrs{}
+	}
+	mmCreateEvent.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateEvent
+}
+
+// ExpectParamsParam2 sets up expected param params for OutboxRepository.CreateEvent
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) ExpectParamsParam2(params model.CreateEventParams) *mOutboxRepositoryMockCreateEvent {
+	if mmCreateEvent.mock.funcCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateEvent mock is already set by Set")
+	}
+
+	if mmCreateEvent.defaultExpectation == nil {
+		mmCreateEvent.defaultExpectation = &OutboxRepositoryMockCreateEventExpectation{}
+	}
+
+	if mmCreateEvent.defaultExpectation.params != nil {
+		mmCreateEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateEvent mock is already set by Expect")
+	}
+
+	if mmCreateEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockCreateEventParamPtrs{}
+	}
+	mmCreateEvent.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.CreateEvent
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) Inspect(f func(ctx context.Context, params model.CreateEventParams)) *mOutboxRepositoryMockCreateEvent {
+	if mmCreateEvent.mock.inspectFuncCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.CreateEvent")
+	}
+
+	mmCreateEvent.mock.inspectFuncCreateEvent = f
+
+	return mmCreateEvent
+}
+
+// Return sets up results that will be returned by OutboxRepository.CreateEvent
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) Return(err error) *OutboxRepositoryMock {
+	if mmCreateEvent.mock.funcCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateEvent mock is already set by Set")
+	}
+
+	if mmCreateEvent.defaultExpectation == nil {
+		mmCreateEvent.defaultExpectation = &OutboxRepositoryMockCreateEventExpectation{mock: mmCreateEvent.mock}
+	}
+	mmCreateEvent.defaultExpectation.results = &OutboxRepositoryMockCreateEventResults{err}
+	return mmCreateEvent.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.CreateEvent method
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) Set(f func(ctx context.Context, params model.CreateEventParams) (err error)) *OutboxRepositoryMock {
+	if mmCreateEvent.defaultExpectation != nil {
+		mmCreateEvent.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.CreateEvent method")
+	}
+
+	if len(mmCreateEvent.expectations) > 0 {
+		mmCreateEvent.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.CreateEvent method")
+	}
+
+	mmCreateEvent.mock.funcCreateEvent = f
+	return mmCreateEvent.mock
+}
+
+// When sets expectation for the OutboxRepository.CreateEvent which will trigger the result defined by the following
+// Then helper
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) When(ctx context.Context, params model.CreateEventParams) *OutboxRepositoryMockCreateEventExpectation {
+	if mmCreateEvent.mock.funcCreateEvent != nil {
+		mmCreateEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateEvent mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockCreateEventExpectation{
+		mock:   mmCreateEvent.mock,
+		params: &OutboxRepositoryMockCreateEventParams{ctx, params},
+	}
+	mmCreateEvent.expectations = append(mmCreateEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.CreateEvent return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockCreateEventExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockCreateEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.CreateEvent should be invoked
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) Times(n uint64) *mOutboxRepositoryMockCreateEvent {
+	if n == 0 {
+		mmCreateEvent.mock.t.Fatalf("Times of OutboxRepositoryMock.CreateEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateEvent.expectedInvocations, n)
+	return mmCreateEvent
+}
+
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) invocationsDone() bool {
+	if len(mmCreateEvent.expectations) == 0 && mmCreateEvent.defaultExpectation == nil && mmCreateEvent.mock.funcCreateEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateEvent.mock.afterCreateEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateEvent implements repository.OutboxRepository
+func (mmCreateEvent *OutboxRepositoryMock) CreateEvent(ctx context.Context, params model.CreateEventParams) (err error) {
+	mm_atomic.AddUint64(&mmCreateEvent.beforeCreateEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateEvent.afterCreateEventCounter, 1)
+
+	if mmCreateEvent.inspectFuncCreateEvent != nil {
+		mmCreateEvent.inspectFuncCreateEvent(ctx, params)
+	}
+
+	mm_params := OutboxRepositoryMockCreateEventParams{ctx, params}
+
+	// Record call args
+	mmCreateEvent.CreateEventMock.mutex.Lock()
+	mmCreateEvent.CreateEventMock.callArgs = append(mmCreateEvent.CreateEventMock.callArgs, &mm_params)
+	mmCreateEvent.CreateEventMock.mutex.Unlock()
+
+	for _, e := range mmCreateEvent.CreateEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateEvent.CreateEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateEvent.CreateEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateEvent.CreateEventMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateEvent.CreateEventMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockCreateEventParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateEvent.t.Errorf("OutboxRepositoryMock.CreateEvent got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateEvent.t.Errorf("OutboxRepositoryMock.CreateEvent got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateEvent.t.Errorf("OutboxRepositoryMock.CreateEvent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateEvent.CreateEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateEvent.t.Fatal("No results are set for the OutboxRepositoryMock.CreateEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateEvent.funcCreateEvent != nil {
+		return mmCreateEvent.funcCreateEvent(ctx, params)
+	}
+	mmCreateEvent.t.Fatalf("Unexpected call to OutboxRepositoryMock.CreateEvent. %v %v", ctx, params)
+	return
+}
+
+// CreateEventAfterCounter returns a count of finished OutboxRepositoryMock.CreateEvent invocations
+func (mmCreateEvent *OutboxRepositoryMock) CreateEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateEvent.afterCreateEventCounter)
+}
+
+// CreateEventBeforeCounter returns a count of OutboxRepositoryMock.CreateEvent invocations
+func (mmCreateEvent *OutboxRepositoryMock) CreateEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateEvent.beforeCreateEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.CreateEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateEvent *mOutboxRepositoryMockCreateEvent) Calls() []*OutboxRepositoryMockCreateEventParams {
+	mmCreateEvent.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockCreateEventParams, len(mmCreateEvent.callArgs))
+	copy(argCopy, mmCreateEvent.callArgs)
+
+	mmCreateEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateEventDone returns true if the count of the CreateEvent invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockCreateEventDone() bool {
+	if m.CreateEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateEventMock.invocationsDone()
+}
+
+// MinimockCreateEventInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockCreateEventInspect() {
+	for _, e := range m.CreateEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.CreateEvent with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateEventCounter := mm_atomic.LoadUint64(&m.afterCreateEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateEventMock.defaultExpectation != nil && afterCreateEventCounter < 1 {
+		if m.CreateEventMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OutboxRepositoryMock.CreateEvent")
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.CreateEvent with params: %#v", *m.CreateEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateEvent != nil && afterCreateEventCounter < 1 {
+		m.t.Error("Expected call to OutboxRepositoryMock.CreateEvent")
+	}
+
+	if !m.CreateEventMock.invocationsDone() && afterCreateEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.CreateEvent but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateEventMock.expectedInvocations), afterCreateEventCounter)
+	}
+}
+
+type mOutboxRepositoryMockDeletePublishedEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockDeletePublishedEventsExpectation
+	expectations       []*OutboxRepositoryMockDeletePublishedEventsExpectation
+
+	callArgs []*OutboxRepositoryMockDeletePublishedEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OutboxRepositoryMockDeletePublishedEventsExpectation specifies expectation struct of the OutboxRepository.DeletePublishedEvents
+type OutboxRepositoryMockDeletePublishedEventsExpectation struct {
+	mock      *OutboxRepositoryMock
+	params    *OutboxRepositoryMockDeletePublishedEventsParams
+	paramPtrs *OutboxRepositoryMockDeletePublishedEventsParamPtrs
+	results   *OutboxRepositoryMockDeletePublishedEventsResults
+	Counter   uint64
+}
+
+// OutboxRepositoryMockDeletePublishedEventsParams contains parameters of the OutboxRepository.DeletePublishedEvents
+type OutboxRepositoryMockDeletePublishedEventsParams struct {
+	ctx    context.Context
+	before time.Time
+}
+
+// OutboxRepositoryMockDeletePublishedEventsParamPtrs contains pointers to parameters of the OutboxRepository.DeletePublishedEvents
+type OutboxRepositoryMockDeletePublishedEventsParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+}
+
+// OutboxRepositoryMockDeletePublishedEventsResults contains results of the OutboxRepository.DeletePublishedEvents
+type OutboxRepositoryMockDeletePublishedEventsResults struct {
+	deleted int64
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) Optional() *mOutboxRepositoryMockDeletePublishedEvents {
+	mmDeletePublishedEvents.optional = true
+	return mmDeletePublishedEvents
+}
+
+// Expect sets up expected params for OutboxRepository.DeletePublishedEvents
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) Expect(ctx context.Context, before time.Time) *mOutboxRepositoryMockDeletePublishedEvents {
+	if mmDeletePublishedEvents.mock.funcDeletePublishedEvents != nil {
+		mmDeletePublishedEvents.mock.t.Fatalf("OutboxRepositoryMock.DeletePublishedEvents mock is already set by Set")
+	}
+
+	if mmDeletePublishedEvents.defaultExpectation == nil {
+		mmDeletePublishedEvents.defaultExpectation = &OutboxRepositoryMockDeletePublishedEventsExpectation{}
+	}
+
+	if mmDeletePublishedEvents.defaultExpectation.paramPtrs != nil {
+		mmDeletePublishedEvents.mock.t.Fatalf("OutboxRepositoryMock.DeletePublishedEvents mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePublishedEvents.defaultExpectation.params = &OutboxRepositoryMockDeletePublishedEventsParams{ctx, before}
+	for _, e := range mmDeletePublishedEvents.expectations {
+		if minimock.Equal(e.params, mmDeletePublishedEvents.defaultExpectation.params) {
+			mmDeletePublishedEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePublishedEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePublishedEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.DeletePublishedEvents
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockDeletePublishedEvents {
+	if mmDeletePublishedEvents.mock.funcDeletePublishedEvents != nil {
+		mmDeletePublishedEvents.mock.t.Fatalf("OutboxRepositoryMock.DeletePublishedEvents mock is already set by Set")
+	}
+
+	if mmDeletePublishedEvents.defaultExpectation == nil {
+		mmDeletePublishedEvents.defaultExpectation = &OutboxRepositoryMockDeletePublishedEventsExpectation{}
+	}
+
+	if mmDeletePublishedEvents.defaultExpectation.params != nil {
+		mmDeletePublishedEvents.mock.t.Fatalf("OutboxRepositoryMock.DeletePublishedEvents mock is already set by Expect")
+	}
+
+	if mmDeletePublishedEvents.defaultExpectation.paramPtrs == nil {
+		mmDeletePublishedEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockDeletePublishedEventsParamPtrs{}
+	}
+	mmDeletePublishedEvents.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeletePublishedEvents
+}
+
+// ExpectBeforeParam2 sets up expected param before for OutboxRepository.DeletePublishedEvents
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) ExpectBeforeParam2(before time.Time) *mOutboxRepositoryMockDeletePublishedEvents {
+	if mmDeletePublishedEvents.mock.funcDeletePublishedEvents != nil {
+		mmDeletePublishedEvents.mock.t.Fatalf("OutboxRepositoryMock.DeletePublishedEvents mock is already set by Set")
+	}
+
+	if mmDeletePublishedEvents.defaultExpectation == nil {
+		mmDeletePublishedEvents.defaultExpectation = &OutboxRepositoryMockDeletePublishedEventsExpectation{}
+	}
+
+	if mmDeletePublishedEvents.defaultExpectation.params != nil {
+		mmDeletePublishedEvents.mock.t.Fatalf("OutboxRepositoryMock.DeletePublishedEvents mock is already set by Expect")
+	}
+
+	if mmDeletePublishedEvents.defaultExpectation.paramPtrs == nil {
+		mmDeletePublishedEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockDeletePublishedEventsParamPtrs{}
+	}
+	mmDeletePublishedEvents.defaultExpectation.paramPtrs.before = &before
+
+	return mmDeletePublishedEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.DeletePublishedEvents
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) Inspect(f func(ctx context.Context, before time.Time)) *mOutboxRepositoryMockDeletePublishedEvents {
+	if mmDeletePublishedEvents.mock.inspectFuncDeletePublishedEvents != nil {
+		mmDeletePublishedEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.DeletePublishedEvents")
+	}
+
+	mmDeletePublishedEvents.mock.inspectFuncDeletePublishedEvents = f
+
+	return mmDeletePublishedEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.DeletePublishedEvents
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) Return(deleted int64, err error) *OutboxRepositoryMock {
+	if mmDeletePublishedEvents.mock.funcDeletePublishedEvents != nil {
+		mmDeletePublishedEvents.mock.t.Fatalf("OutboxRepositoryMock.DeletePublishedEvents mock is already set by Set")
+	}
+
+	if mmDeletePublishedEvents.defaultExpectation == nil {
+		mmDeletePublishedEvents.defaultExpectation = &OutboxRepositoryMockDeletePublishedEventsExpectation{mock: mmDeletePublishedEvents.mock}
+	}
+	mmDeletePublishedEvents.defaultExpectation.results = &OutboxRepositoryMockDeletePublishedEventsResults{deleted, err}
+	return mmDeletePublishedEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.DeletePublishedEvents method
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) Set(f func(ctx context.Context, before time.Time) (deleted int64, err error)) *OutboxRepositoryMock {
+	if mmDeletePublishedEvents.defaultExpectation != nil {
+		mmDeletePublishedEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.DeletePublishedEvents method")
+	}
+
+	if len(mmDeletePublishedEvents.expectations) > 0 {
+		mmDeletePublishedEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.DeletePublishedEvents method")
+	}
+
+	mmDeletePublishedEvents.mock.funcDeletePublishedEvents = f
+	return mmDeletePublishedEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.DeletePublishedEvents which will trigger the result defined by the following
+// Then helper
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) When(ctx context.Context, before time.Time) *OutboxRepositoryMockDeletePublishedEventsExpectation {
+	if mmDeletePublishedEvents.mock.funcDeletePublishedEvents != nil {
+		mmDeletePublishedEvents.mock.t.Fatalf("OutboxRepositoryMock.DeletePublishedEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockDeletePublishedEventsExpectation{
+		mock:   mmDeletePublishedEvents.mock,
+		params: &OutboxRepositoryMockDeletePublishedEventsParams{ctx, before},
+	}
+	mmDeletePublishedEvents.expectations = append(mmDeletePublishedEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.DeletePublishedEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockDeletePublishedEventsExpectation) Then(deleted int64, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockDeletePublishedEventsResults{deleted, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.DeletePublishedEvents should be invoked
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) Times(n uint64) *mOutboxRepositoryMockDeletePublishedEvents {
+	if n == 0 {
+		mmDeletePublishedEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.DeletePublishedEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePublishedEvents.expectedInvocations, n)
+	return mmDeletePublishedEvents
+}
+
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) invocationsDone() bool {
+	if len(mmDeletePublishedEvents.expectations) == 0 && mmDeletePublishedEvents.defaultExpectation == nil && mmDeletePublishedEvents.mock.funcDeletePublishedEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePublishedEvents.mock.afterDeletePublishedEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePublishedEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePublishedEvents implements repository.OutboxRepository
+func (mmDeletePublishedEvents *OutboxRepositoryMock) DeletePublishedEvents(ctx context.Context, before time.Time) (deleted int64, err error) {
+	mm_atomic.AddUint64(&mmDeletePublishedEvents.beforeDeletePublishedEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePublishedEvents.afterDeletePublishedEventsCounter, 1)
+
+	if mmDeletePublishedEvents.inspectFuncDeletePublishedEvents != nil {
+		mmDeletePublishedEvents.inspectFuncDeletePublishedEvents(ctx, before)
+	}
+
+	mm_params := OutboxRepositoryMockDeletePublishedEventsParams{ctx, before}
+
+	// Record call args
+	mmDeletePublishedEvents.DeletePublishedEventsMock.mutex.Lock()
+	mmDeletePublishedEvents.DeletePublishedEventsMock.callArgs = append(mmDeletePublishedEvents.DeletePublishedEventsMock.callArgs, &mm_params)
+	mmDeletePublishedEvents.DeletePublishedEventsMock.mutex.Unlock()
+
+	for _, e := range mmDeletePublishedEvents.DeletePublishedEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.deleted, e.results.err
+		}
+	}
+
+	if mmDeletePublishedEvents.DeletePublishedEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePublishedEvents.DeletePublishedEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePublishedEvents.DeletePublishedEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePublishedEvents.DeletePublishedEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockDeletePublishedEventsParams{ctx, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePublishedEvents.t.Errorf("OutboxRepositoryMock.DeletePublishedEvents got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmDeletePublishedEvents.t.Errorf("OutboxRepositoryMock.DeletePublishedEvents got unexpected parameter before, want: %#v, got: %#v%s\n", *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePublishedEvents.t.Errorf("OutboxRepositoryMock.DeletePublishedEvents got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePublishedEvents.DeletePublishedEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePublishedEvents.t.Fatal("No results are set for the OutboxRepositoryMock.DeletePublishedEvents")
+		}
+		return (*mm_results).deleted, (*mm_results).err
+	}
+	if mmDeletePublishedEvents.funcDeletePublishedEvents != nil {
+		return mmDeletePublishedEvents.funcDeletePublishedEvents(ctx, before)
+	}
+	mmDeletePublishedEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.DeletePublishedEvents. %v %v", ctx, before)
+	return
+}
+
+// DeletePublishedEventsAfterCounter returns a count of finished OutboxRepositoryMock.DeletePublishedEvents invocations
+func (mmDeletePublishedEvents *OutboxRepositoryMock) DeletePublishedEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePublishedEvents.afterDeletePublishedEventsCounter)
+}
+
+// DeletePublishedEventsBeforeCounter returns a count of OutboxRepositoryMock.DeletePublishedEvents invocations
+func (mmDeletePublishedEvents *OutboxRepositoryMock) DeletePublishedEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePublishedEvents.beforeDeletePublishedEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.DeletePublishedEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePublishedEvents *mOutboxRepositoryMockDeletePublishedEvents) Calls() []*OutboxRepositoryMockDeletePublishedEventsParams {
+	mmDeletePublishedEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockDeletePublishedEventsParams, len(mmDeletePublishedEvents.callArgs))
+	copy(argCopy, mmDeletePublishedEvents.callArgs)
+
+	mmDeletePublishedEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePublishedEventsDone returns true if the count of the DeletePublishedEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockDeletePublishedEventsDone() bool {
+	if m.DeletePublishedEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePublishedEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePublishedEventsMock.invocationsDone()
+}
+
+// MinimockDeletePublishedEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockDeletePublishedEventsInspect() {
+	for _, e := range m.DeletePublishedEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.DeletePublishedEvents with params: %#v", *e.params)
+		}
+	}
+
+	afterDeletePublishedEventsCounter := mm_atomic.LoadUint64(&m.afterDeletePublishedEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePublishedEventsMock.defaultExpectation != nil && afterDeletePublishedEventsCounter < 1 {
+		if m.DeletePublishedEventsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OutboxRepositoryMock.DeletePublishedEvents")
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.DeletePublishedEvents with params: %#v", *m.DeletePublishedEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePublishedEvents != nil && afterDeletePublishedEventsCounter < 1 {
+		m.t.Error("Expected call to OutboxRepositoryMock.DeletePublishedEvents")
+	}
+
+	if !m.DeletePublishedEventsMock.invocationsDone() && afterDeletePublishedEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.DeletePublishedEvents but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePublishedEventsMock.expectedInvocations), afterDeletePublishedEventsCounter)
+	}
+}
+
+type mOutboxRepositoryMockListPendingEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockListPendingEventsExpectation
+	expectations       []*OutboxRepositoryMockListPendingEventsExpectation
+
+	callArgs []*OutboxRepositoryMockListPendingEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OutboxRepositoryMockListPendingEventsExpectation specifies expectation struct of the OutboxRepository.ListPendingEvents
+type OutboxRepositoryMockListPendingEventsExpectation struct {
+	mock      *OutboxRepositoryMock
+	params    *OutboxRepositoryMockListPendingEventsParams
+	paramPtrs *OutboxRepositoryMockListPendingEventsParamPtrs
+	results   *OutboxRepositoryMockListPendingEventsResults
+	Counter   uint64
+}
+
+// OutboxRepositoryMockListPendingEventsParams contains parameters of the OutboxRepository.ListPendingEvents
+type OutboxRepositoryMockListPendingEventsParams struct {
+	ctx   context.Context
+	limit int
+}
+
+// OutboxRepositoryMockListPendingEventsParamPtrs contains pointers to parameters of the OutboxRepository.ListPendingEvents
+type OutboxRepositoryMockListPendingEventsParamPtrs struct {
+	ctx   *context.Context
+	limit *int
+}
+
+// OutboxRepositoryMockListPendingEventsResults contains results of the OutboxRepository.ListPendingEvents
+type OutboxRepositoryMockListPendingEventsResults struct {
+	events []model.Event
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) Optional() *mOutboxRepositoryMockListPendingEvents {
+	mmListPendingEvents.optional = true
+	return mmListPendingEvents
+}
+
+// Expect sets up expected params for OutboxRepository.ListPendingEvents
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) Expect(ctx context.Context, limit int) *mOutboxRepositoryMockListPendingEvents {
+	if mmListPendingEvents.mock.funcListPendingEvents != nil {
+		mmListPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingEvents mock is already set by Set")
+	}
+
+	if mmListPendingEvents.defaultExpectation == nil {
+		mmListPendingEvents.defaultExpectation = &OutboxRepositoryMockListPendingEventsExpectation{}
+	}
+
+	if mmListPendingEvents.defaultExpectation.paramPtrs != nil {
+		mmListPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingEvents mock is already set by ExpectParams functions")
+	}
+
+	mmListPendingEvents.defaultExpectation.params = &OutboxRepositoryMockListPendingEventsParams{ctx, limit}
+	for _, e := range mmListPendingEvents.expectations {
+		if minimock.Equal(e.params, mmListPendingEvents.defaultExpectation.params) {
+			mmListPendingEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPendingEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmListPendingEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.ListPendingEvents
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockListPendingEvents {
+	if mmListPendingEvents.mock.funcListPendingEvents != nil {
+		mmListPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingEvents mock is already set by Set")
+	}
+
+	if mmListPendingEvents.defaultExpectation == nil {
+		mmListPendingEvents.defaultExpectation = &OutboxRepositoryMockListPendingEventsExpectation{}
+	}
+
+	if mmListPendingEvents.defaultExpectation.params != nil {
+		mmListPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingEvents mock is already set by Expect")
+	}
+
+	if mmListPendingEvents.defaultExpectation.paramPtrs == nil {
+		mmListPendingEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockListPendingEventsParamPtrs{}
+	}
+	mmListPendingEvents.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPendingEvents
+}
+
+// ExpectLimitParam2 sets up expected param limit for OutboxRepository.ListPendingEvents
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) ExpectLimitParam2(limit int) *mOutboxRepositoryMockListPendingEvents {
+	if mmListPendingEvents.mock.funcListPendingEvents != nil {
+		mmListPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingEvents mock is already set by Set")
+	}
+
+	if mmListPendingEvents.defaultExpectation == nil {
+		mmListPendingEvents.defaultExpectation = &OutboxRepositoryMockListPendingEventsExpectation{}
+	}
+
+	if mmListPendingEvents.defaultExpectation.params != nil {
+		mmListPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingEvents mock is already set by Expect")
+	}
+
+	if mmListPendingEvents.defaultExpectation.paramPtrs == nil {
+		mmListPendingEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockListPendingEventsParamPtrs{}
+	}
+	mmListPendingEvents.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmListPendingEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.ListPendingEvents
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) Inspect(f func(ctx context.Context, limit int)) *mOutboxRepositoryMockListPendingEvents {
+	if mmListPendingEvents.mock.inspectFuncListPendingEvents != nil {
+		mmListPendingEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.ListPendingEvents")
+	}
+
+	mmListPendingEvents.mock.inspectFuncListPendingEvents = f
+
+	return mmListPendingEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.ListPendingEvents
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) Return(events []model.Event, err error) *OutboxRepositoryMock {
+	if mmListPendingEvents.mock.funcListPendingEvents != nil {
+		mmListPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingEvents mock is already set by Set")
+	}
+
+	if mmListPendingEvents.defaultExpectation == nil {
+		mmListPendingEvents.defaultExpectation = &OutboxRepositoryMockListPendingEventsExpectation{mock: mmListPendingEvents.mock}
+	}
+	mmListPendingEvents.defaultExpectation.results = &OutboxRepositoryMockListPendingEventsResults{events, err}
+	return mmListPendingEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.ListPendingEvents method
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) Set(f func(ctx context.Context, limit int) (events []model.Event, err error)) *OutboxRepositoryMock {
+	if mmListPendingEvents.defaultExpectation != nil {
+		mmListPendingEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.ListPendingEvents method")
+	}
+
+	if len(mmListPendingEvents.expectations) > 0 {
+		mmListPendingEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.ListPendingEvents method")
+	}
+
+	mmListPendingEvents.mock.funcListPendingEvents = f
+	return mmListPendingEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.ListPendingEvents which will trigger the result defined by the following
+// Then helper
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) When(ctx context.Context, limit int) *OutboxRepositoryMockListPendingEventsExpectation {
+	if mmListPendingEvents.mock.funcListPendingEvents != nil {
+		mmListPendingEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockListPendingEventsExpectation{
+		mock:   mmListPendingEvents.mock,
+		params: &OutboxRepositoryMockListPendingEventsParams{ctx, limit},
+	}
+	mmListPendingEvents.expectations = append(mmListPendingEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.ListPendingEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockListPendingEventsExpectation) Then(events []model.Event, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockListPendingEventsResults{events, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.ListPendingEvents should be invoked
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) Times(n uint64) *mOutboxRepositoryMockListPendingEvents {
+	if n == 0 {
+		mmListPendingEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.ListPendingEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPendingEvents.expectedInvocations, n)
+	return mmListPendingEvents
+}
+
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) invocationsDone() bool {
+	if len(mmListPendingEvents.expectations) == 0 && mmListPendingEvents.defaultExpectation == nil && mmListPendingEvents.mock.funcListPendingEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPendingEvents.mock.afterListPendingEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPendingEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPendingEvents implements repository.OutboxRepository
+func (mmListPendingEvents *OutboxRepositoryMock) ListPendingEvents(ctx context.Context, limit int) (events []model.Event, err error) {
+	mm_atomic.AddUint64(&mmListPendingEvents.beforeListPendingEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPendingEvents.afterListPendingEventsCounter, 1)
+
+	if mmListPendingEvents.inspectFuncListPendingEvents != nil {
+		mmListPendingEvents.inspectFuncListPendingEvents(ctx, limit)
+	}
+
+	mm_params := OutboxRepositoryMockListPendingEventsParams{ctx, limit}
+
+	// Record call args
+	mmListPendingEvents.ListPendingEventsMock.mutex.Lock()
+	mmListPendingEvents.ListPendingEventsMock.callArgs = append(mmListPendingEvents.ListPendingEventsMock.callArgs, &mm_params)
+	mmListPendingEvents.ListPendingEventsMock.mutex.Unlock()
+
+	for _, e := range mmListPendingEvents.ListPendingEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.events, e.results.err
+		}
+	}
+
+	if mmListPendingEvents.ListPendingEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPendingEvents.ListPendingEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPendingEvents.ListPendingEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPendingEvents.ListPendingEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockListPendingEventsParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPendingEvents.t.Errorf("OutboxRepositoryMock.ListPendingEvents got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListPendingEvents.t.Errorf("OutboxRepositoryMock.ListPendingEvents got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPendingEvents.t.Errorf("OutboxRepositoryMock.ListPendingEvents got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPendingEvents.ListPendingEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPendingEvents.t.Fatal("No results are set for the OutboxRepositoryMock.ListPendingEvents")
+		}
+		return (*mm_results).events, (*mm_results).err
+	}
+	if mmListPendingEvents.funcListPendingEvents != nil {
+		return mmListPendingEvents.funcListPendingEvents(ctx, limit)
+	}
+	mmListPendingEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.ListPendingEvents. %v %v", ctx, limit)
+	return
+}
+
+// ListPendingEventsAfterCounter returns a count of finished OutboxRepositoryMock.ListPendingEvents invocations
+func (mmListPendingEvents *OutboxRepositoryMock) ListPendingEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingEvents.afterListPendingEventsCounter)
+}
+
+// ListPendingEventsBeforeCounter returns a count of OutboxRepositoryMock.ListPendingEvents invocations
+func (mmListPendingEvents *OutboxRepositoryMock) ListPendingEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingEvents.beforeListPendingEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.ListPendingEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPendingEvents *mOutboxRepositoryMockListPendingEvents) Calls() []*OutboxRepositoryMockListPendingEventsParams {
+	mmListPendingEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockListPendingEventsParams, len(mmListPendingEvents.callArgs))
+	copy(argCopy, mmListPendingEvents.callArgs)
+
+	mmListPendingEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPendingEventsDone returns true if the count of the ListPendingEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockListPendingEventsDone() bool {
+	if m.ListPendingEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPendingEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPendingEventsMock.invocationsDone()
+}
+
+// MinimockListPendingEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockListPendingEventsInspect() {
+	for _, e := range m.ListPendingEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ListPendingEvents with params: %#v", *e.params)
+		}
+	}
+
+	afterListPendingEventsCounter := mm_atomic.LoadUint64(&m.afterListPendingEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPendingEventsMock.defaultExpectation != nil && afterListPendingEventsCounter < 1 {
+		if m.ListPendingEventsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OutboxRepositoryMock.ListPendingEvents")
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ListPendingEvents with params: %#v", *m.ListPendingEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPendingEvents != nil && afterListPendingEventsCounter < 1 {
+		m.t.Error("Expected call to OutboxRepositoryMock.ListPendingEvents")
+	}
+
+	if !m.ListPendingEventsMock.invocationsDone() && afterListPendingEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.ListPendingEvents but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPendingEventsMock.expectedInvocations), afterListPendingEventsCounter)
+	}
+}
+
+type mOutboxRepositoryMockLockRelay struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockLockRelayExpectation
+	expectations       []*OutboxRepositoryMockLockRelayExpectation
+
+	callArgs []*OutboxRepositoryMockLockRelayParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OutboxRepositoryMockLockRelayExpectation specifies expectation struct of the OutboxRepository.LockRelay
+type OutboxRepositoryMockLockRelayExpectation struct {
+	mock      *OutboxRepositoryMock
+	params    *OutboxRepositoryMockLockRelayParams
+	paramPtrs *OutboxRepositoryMockLockRelayParamPtrs
+	results   *OutboxRepositoryMockLockRelayResults
+	Counter   uint64
+}
+
+// OutboxRepositoryMockLockRelayParams contains parameters of the OutboxRepository.LockRelay
+type OutboxRepositoryMockLockRelayParams struct {
+	ctx    context.Context
+	holder string
+	now    time.Time
+	until  time.Time
+}
+
+// OutboxRepositoryMockLockRelayParamPtrs contains pointers to parameters of the OutboxRepository.LockRelay
+type OutboxRepositoryMockLockRelayParamPtrs struct {
+	ctx    *context.Context
+	holder *string
+	now    *time.Time
+	until  *time.Time
+}
+
+// OutboxRepositoryMockLockRelayResults contains results of the OutboxRepository.LockRelay
+type OutboxRepositoryMockLockRelayResults struct {
+	locked bool
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) Optional() *mOutboxRepositoryMockLockRelay {
+	mmLockRelay.optional = true
+	return mmLockRelay
+}
+
+// Expect sets up expected params for OutboxRepository.LockRelay
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) Expect(ctx context.Context, holder string, now time.Time, until time.Time) *mOutboxRepositoryMockLockRelay {
+	if mmLockRelay.mock.funcLockRelay != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Set")
+	}
+
+	if mmLockRelay.defaultExpectation == nil {
+		mmLockRelay.defaultExpectation = &OutboxRepositoryMockLockRelayExpectation{}
+	}
+
+	if mmLockRelay.defaultExpectation.paramPtrs != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by ExpectParams functions")
+	}
+
+	mmLockRelay.defaultExpectation.params = &OutboxRepositoryMockLockRelayParams{ctx, holder, now, until}
+	for _, e := range mmLockRelay.expectations {
+		if minimock.Equal(e.params, mmLockRelay.defaultExpectation.params) {
+			mmLockRelay.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockRelay.defaultExpectation.params)
+		}
+	}
+
+	return mmLockRelay
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.LockRelay
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockLockRelay {
+	if mmLockRelay.mock.funcLockRelay != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Set")
+	}
+
+	if mmLockRelay.defaultExpectation == nil {
+		mmLockRelay.defaultExpectation = &OutboxRepositoryMockLockRelayExpectation{}
+	}
+
+	if mmLockRelay.defaultExpectation.params != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Expect")
+	}
+
+	if mmLockRelay.defaultExpectation.paramPtrs == nil {
+		mmLockRelay.defaultExpectation.paramPtrs = &OutboxRepositoryMockLockRelayParamPtrs{}
+	}
+	mmLockRelay.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLockRelay
+}
+
+// ExpectHolderParam2 sets up expected param holder for OutboxRepository.LockRelay
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) ExpectHolderParam2(holder string) *mOutboxRepositoryMockLockRelay {
+	if mmLockRelay.mock.funcLockRelay != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Set")
+	}
+
+	if mmLockRelay.defaultExpectation == nil {
+		mmLockRelay.defaultExpectation = &OutboxRepositoryMockLockRelayExpectation{}
+	}
+
+	if mmLockRelay.defaultExpectation.params != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Expect")
+	}
+
+	if mmLockRelay.defaultExpectation.paramPtrs == nil {
+		mmLockRelay.defaultExpectation.paramPtrs = &OutboxRepositoryMockLockRelayParamPtrs{}
+	}
+	mmLockRelay.defaultExpectation.paramPtrs.holder = &holder
+
+	return mmLockRelay
+}
+
+// ExpectNowParam3 sets up expected param now for OutboxRepository.LockRelay
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) ExpectNowParam3(now time.Time) *mOutboxRepositoryMockLockRelay {
+	if mmLockRelay.mock.funcLockRelay != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Set")
+	}
+
+	if mmLockRelay.defaultExpectation == nil {
+		mmLockRelay.defaultExpectation = &OutboxRepositoryMockLockRelayExpectation{}
+	}
+
+	if mmLockRelay.defaultExpectation.params != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Expect")
+	}
+
+	if mmLockRelay.defaultExpectation.paramPtrs == nil {
+		mmLockRelay.defaultExpectation.paramPtrs = &OutboxRepositoryMockLockRelayParamPtrs{}
+	}
+	mmLockRelay.defaultExpectation.paramPtrs.now = &now
+
+	return mmLockRelay
+}
+
+// ExpectUntilParam4 sets up expected param until for OutboxRepository.LockRelay
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) ExpectUntilParam4(until time.Time) *mOutboxRepositoryMockLockRelay {
+	if mmLockRelay.mock.funcLockRelay != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Set")
+	}
+
+	if mmLockRelay.defaultExpectation == nil {
+		mmLockRelay.defaultExpectation = &OutboxRepositoryMockLockRelayExpectation{}
+	}
+
+	if mmLockRelay.defaultExpectation.params != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Expect")
+	}
+
+	if mmLockRelay.defaultExpectation.paramPtrs == nil {
+		mmLockRelay.defaultExpectation.paramPtrs = &OutboxRepositoryMockLockRelayParamPtrs{}
+	}
+	mmLockRelay.defaultExpectation.paramPtrs.until = &until
+
+	return mmLockRelay
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.LockRelay
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) Inspect(f func(ctx context.Context, holder string, now time.Time, until time.Time)) *mOutboxRepositoryMockLockRelay {
+	if mmLockRelay.mock.inspectFuncLockRelay != nil {
+		mmLockRelay.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.LockRelay")
+	}
+
+	mmLockRelay.mock.inspectFuncLockRelay = f
+
+	return mmLockRelay
+}
+
+// Return sets up results that will be returned by OutboxRepository.LockRelay
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) Return(locked bool, err error) *OutboxRepositoryMock {
+	if mmLockRelay.mock.funcLockRelay != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Set")
+	}
+
+	if mmLockRelay.defaultExpectation == nil {
+		mmLockRelay.defaultExpectation = &OutboxRepositoryMockLockRelayExpectation{mock: mmLockRelay.mock}
+	}
+	mmLockRelay.defaultExpectation.results = &OutboxRepositoryMockLockRelayResults{locked, err}
+	return mmLockRelay.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.LockRelay method
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) Set(f func(ctx context.Context, holder string, now time.Time, until time.Time) (locked bool, err error)) *OutboxRepositoryMock {
+	if mmLockRelay.defaultExpectation != nil {
+		mmLockRelay.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.LockRelay method")
+	}
+
+	if len(mmLockRelay.expectations) > 0 {
+		mmLockRelay.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.LockRelay method")
+	}
+
+	mmLockRelay.mock.funcLockRelay = f
+	return mmLockRelay.mock
+}
+
+// When sets expectation for the OutboxRepository.LockRelay which will trigger the result defined by the following
+// Then helper
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) When(ctx context.Context, holder string, now time.Time, until time.Time) *OutboxRepositoryMockLockRelayExpectation {
+	if mmLockRelay.mock.funcLockRelay != nil {
+		mmLockRelay.mock.t.Fatalf("OutboxRepositoryMock.LockRelay mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockLockRelayExpectation{
+		mock:   mmLockRelay.mock,
+		params: &OutboxRepositoryMockLockRelayParams{ctx, holder, now, until},
+	}
+	mmLockRelay.expectations = append(mmLockRelay.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.LockRelay return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockLockRelayExpectation) Then(locked bool, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockLockRelayResults{locked, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.LockRelay should be invoked
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) Times(n uint64) *mOutboxRepositoryMockLockRelay {
+	if n == 0 {
+		mmLockRelay.mock.t.Fatalf("Times of OutboxRepositoryMock.LockRelay mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockRelay.expectedInvocations, n)
+	return mmLockRelay
+}
+
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) invocationsDone() bool {
+	if len(mmLockRelay.expectations) == 0 && mmLockRelay.defaultExpectation == nil && mmLockRelay.mock.funcLockRelay == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockRelay.mock.afterLockRelayCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockRelay.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockRelay implements repository.OutboxRepository
+func (mmLockRelay *OutboxRepositoryMock) LockRelay(ctx context.Context, holder string, now time.Time, until time.Time) (locked bool, err error) {
+	mm_atomic.AddUint64(&mmLockRelay.beforeLockRelayCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockRelay.afterLockRelayCounter, 1)
+
+	if mmLockRelay.inspectFuncLockRelay != nil {
+		mmLockRelay.inspectFuncLockRelay(ctx, holder, now, until)
+	}
+
+	mm_params := OutboxRepositoryMockLockRelayParams{ctx, holder, now, until}
+
+	// Record call args
+	mmLockRelay.LockRelayMock.mutex.Lock()
+	mmLockRelay.LockRelayMock.callArgs = append(mmLockRelay.LockRelayMock.callArgs, &mm_params)
+	mmLockRelay.LockRelayMock.mutex.Unlock()
+
+	for _, e := range mmLockRelay.LockRelayMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.locked, e.results.err
+		}
+	}
+
+	if mmLockRelay.LockRelayMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockRelay.LockRelayMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockRelay.LockRelayMock.defaultExpectation.params
+		mm_want_ptrs := mmLockRelay.LockRelayMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockLockRelayParams{ctx, holder, now, until}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockRelay.t.Errorf("OutboxRepositoryMock.LockRelay got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.holder != nil && !minimock.Equal(*mm_want_ptrs.holder, mm_got.holder) {
+				mmLockRelay.t.Errorf("OutboxRepositoryMock.LockRelay got unexpected parameter holder, want: %#v, got: %#v%s\n", *mm_want_ptrs.holder, mm_got.holder, minimock.Diff(*mm_want_ptrs.holder, mm_got.holder))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmLockRelay.t.Errorf("OutboxRepositoryMock.LockRelay got unexpected parameter now, want: %#v, got: %#v%s\n", *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+			if mm_want_ptrs.until != nil && !minimock.Equal(*mm_want_ptrs.until, mm_got.until) {
+				mmLockRelay.t.Errorf("OutboxRepositoryMock.LockRelay got unexpected parameter until, want: %#v, got: %#v%s\n", *mm_want_ptrs.until, mm_got.until, minimock.Diff(*mm_want_ptrs.until, mm_got.until))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockRelay.t.Errorf("OutboxRepositoryMock.LockRelay got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockRelay.LockRelayMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockRelay.t.Fatal("No results are set for the OutboxRepositoryMock.LockRelay")
+		}
+		return (*mm_results).locked, (*mm_results).err
+	}
+	if mmLockRelay.funcLockRelay != nil {
+		return mmLockRelay.funcLockRelay(ctx, holder, now, until)
+	}
+	mmLockRelay.t.Fatalf("Unexpected call to OutboxRepositoryMock.LockRelay. %v %v %v %v", ctx, holder, now, until)
+	return
+}
+
+// LockRelayAfterCounter returns a count of finished OutboxRepositoryMock.LockRelay invocations
+func (mmLockRelay *OutboxRepositoryMock) LockRelayAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockRelay.afterLockRelayCounter)
+}
+
+// LockRelayBeforeCounter returns a count of OutboxRepositoryMock.LockRelay invocations
+func (mmLockRelay *OutboxRepositoryMock) LockRelayBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockRelay.beforeLockRelayCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.LockRelay.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockRelay *mOutboxRepositoryMockLockRelay) Calls() []*OutboxRepositoryMockLockRelayParams {
+	mmLockRelay.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockLockRelayParams, len(mmLockRelay.callArgs))
+	copy(argCopy, mmLockRelay.callArgs)
+
+	mmLockRelay.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockRelayDone returns true if the count of the LockRelay invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockLockRelayDone() bool {
+	if m.LockRelayMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockRelayMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockRelayMock.invocationsDone()
+}
+
+// MinimockLockRelayInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockLockRelayInspect() {
+	for _, e := range m.LockRelayMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.LockRelay with params: %#v", *e.params)
+		}
+	}
+
+	afterLockRelayCounter := mm_atomic.LoadUint64(&m.afterLockRelayCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockRelayMock.defaultExpectation != nil && afterLockRelayCounter < 1 {
+		if m.LockRelayMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OutboxRepositoryMock.LockRelay")
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.LockRelay with params: %#v", *m.LockRelayMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockRelay != nil && afterLockRelayCounter < 1 {
+		m.t.Error("Expected call to OutboxRepositoryMock.LockRelay")
+	}
+
+	if !m.LockRelayMock.invocationsDone() && afterLockRelayCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.LockRelay but found %d calls",
+			mm_atomic.LoadUint64(&m.LockRelayMock.expectedInvocations), afterLockRelayCounter)
+	}
+}
+
+type mOutboxRepositoryMockMarkEventFailed struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockMarkEventFailedExpectation
+	expectations       []*OutboxRepositoryMockMarkEventFailedExpectation
+
+	callArgs []*OutboxRepositoryMockMarkEventFailedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OutboxRepositoryMockMarkEventFailedExpectation specifies expectation struct of the OutboxRepository.MarkEventFailed
+type OutboxRepositoryMockMarkEventFailedExpectation struct {
+	mock      *OutboxRepositoryMock
+	params    *OutboxRepositoryMockMarkEventFailedParams
+	paramPtrs *OutboxRepositoryMockMarkEventFailedParamPtrs
+	results   *OutboxRepositoryMockMarkEventFailedResults
+	Counter   uint64
+}
+
+// OutboxRepositoryMockMarkEventFailedParams contains parameters of the OutboxRepository.MarkEventFailed
+type OutboxRepositoryMockMarkEventFailedParams struct {
+	ctx    context.Context
+	id     int64
+	reason string
+}
+
+// OutboxRepositoryMockMarkEventFailedParamPtrs contains pointers to parameters of the OutboxRepository.MarkEventFailed
+type OutboxRepositoryMockMarkEventFailedParamPtrs struct {
+	ctx    *context.Context
+	id     *int64
+	reason *string
+}
+
+// OutboxRepositoryMockMarkEventFailedResults contains results of the OutboxRepository.MarkEventFailed
+type OutboxRepositoryMockMarkEventFailedResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) Optional() *mOutboxRepositoryMockMarkEventFailed {
+	mmMarkEventFailed.optional = true
+	return mmMarkEventFailed
+}
+
+// Expect sets up expected params for OutboxRepository.MarkEventFailed
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) Expect(ctx context.Context, id int64, reason string) *mOutboxRepositoryMockMarkEventFailed {
+	if mmMarkEventFailed.mock.funcMarkEventFailed != nil {
+		mmMarkEventFailed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventFailed mock is already set by Set")
+	}
+
+	if mmMarkEventFailed.defaultExpectation == nil {
+		mmMarkEventFailed.defaultExpectation = &OutboxRepositoryMockMarkEventFailedExpectation{}
+	}
+
+	if mmMarkEventFailed.defaultExpectation.paramPtrs != nil {
+		mmMarkEventFailed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventFailed mock is already set by ExpectParams functions")
+	}
+
+	mmMarkEventFailed.defaultExpectation.params = &OutboxRepositoryMockMarkEventFailedParams{ctx, id, reason}
+	for _, e := range mmMarkEventFailed.expectations {
+		if minimock.Equal(e.params, mmMarkEventFailed.defaultExpectation.params) {
+			mmMarkEventFailed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkEventFailed.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkEventFailed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.MarkEventFailed
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockMarkEventFailed {
+	if mmMarkEventFailed.mock.funcMarkEventFailed != nil {
+		mmMarkEventFailed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventFailed mock is already set by Set")
+	}
+
+	if mmMarkEventFailed.defaultExpectation == nil {
+		mmMarkEventFailed.defaultExpectation = &OutboxRepositoryMockMarkEventFailedExpectation{}
+	}
+
+	if mmMarkEventFailed.defaultExpectation.params != nil {
+		mmMarkEventFailed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventFailed mock is already set by Expect")
+	}
+
+	if mmMarkEventFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkEventFailed.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkEventFailedParamPtrs{}
+	}
+	mmMarkEventFailed.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmMarkEventFailed
+}
+
+// ExpectIdParam2 sets up expected param id for OutboxRepository.MarkEventFailed
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) ExpectIdParam2(id int64) *mOutboxRepositoryMockMarkEventFailed {
+	if mmMarkEventFailed.mock.funcMarkEventFailed != nil {
+		mmMarkEventFailed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventFailed mock is already set by Set")
+	}
+
+	if mmMarkEventFailed.defaultExpectation == nil {
+		mmMarkEventFailed.defaultExpectation = &OutboxRepositoryMockMarkEventFailedExpectation{}
+	}
+
+	if mmMarkEventFailed.defaultExpectation.params != nil {
+		mmMarkEventFailed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventFailed mock is already set by Expect")
+	}
+
+	if mmMarkEventFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkEventFailed.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkEventFailedParamPtrs{}
+	}
+	mmMarkEventFailed.defaultExpectation.paramPtrs.id = &id
+
+	return mmMarkEventFailed
+}
+
+// ExpectReasonParam3 sets up expected param reason for OutboxRepository.MarkEventFailed
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) ExpectReasonParam3(reason string) *mOutboxRepositoryMockMarkEventFailed {
+	if mmMarkEventFailed.mock.funcMarkEventFailed != nil {
+		mmMarkEventFailed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventFailed mock is already set by Set")
+	}
+
+	if mmMarkEventFailed.defaultExpectation == nil {
+		mmMarkEventFailed.defaultExpectation = &OutboxRepositoryMockMarkEventFailedExpectation{}
+	}
+
+	if mmMarkEventFailed.defaultExpectation.params != nil {
+		mmMarkEventFailed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventFailed mock is already set by Expect")
+	}
+
+	if mmMarkEventFailed.defaultExpectation.paramPtrs == nil {
+		mmMarkEventFailed.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkEventFailedParamPtrs{}
+	}
+	mmMarkEventFailed.defaultExpectation.paramPtrs.reason = &reason
+
+	return mmMarkEventFailed
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.MarkEventFailed
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) Inspect(f func(ctx context.Context, id int64, reason string)) *mOutboxRepositoryMockMarkEventFailed {
+	if mmMarkEventFailed.mock.inspectFuncMarkEventFailed != nil {
+		mmMarkEventFailed.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.MarkEventFailed")
+	}
+
+	mmMarkEventFailed.mock.inspectFuncMarkEventFailed = f
+
+	return mmMarkEventFailed
+}
+
+// Return sets up results that will be returned by OutboxRepository.MarkEventFailed
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) Return(err error) *OutboxRepositoryMock {
+	if mmMarkEventFailed.mock.funcMarkEventFailed != nil {
+		mmMarkEventFailed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventFailed mock is already set by Set")
+	}
+
+	if mmMarkEventFailed.defaultExpectation == nil {
+		mmMarkEventFailed.defaultExpectation = &OutboxRepositoryMockMarkEventFailedExpectation{mock: mmMarkEventFailed.mock}
+	}
+	mmMarkEventFailed.defaultExpectation.results = &OutboxRepositoryMockMarkEventFailedResults{err}
+	return mmMarkEventFailed.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.MarkEventFailed method
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) Set(f func(ctx context.Context, id int64, reason string) (err error)) *OutboxRepositoryMock {
+	if mmMarkEventFailed.defaultExpectation != nil {
+		mmMarkEventFailed.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.MarkEventFailed method")
+	}
+
+	if len(mmMarkEventFailed.expectations) > 0 {
+		mmMarkEventFailed.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.MarkEventFailed method")
+	}
+
+	mmMarkEventFailed.mock.funcMarkEventFailed = f
+	return mmMarkEventFailed.mock
+}
+
+// When sets expectation for the OutboxRepository.MarkEventFailed which will trigger the result defined by the following
+// Then helper
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) When(ctx context.Context, id int64, reason string) *OutboxRepositoryMockMarkEventFailedExpectation {
+	if mmMarkEventFailed.mock.funcMarkEventFailed != nil {
+		mmMarkEventFailed.mock.t.Fatalf("OutboxRepositoryMock.MarkEventFailed mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockMarkEventFailedExpectation{
+		mock:   mmMarkEventFailed.mock,
+		params: &OutboxRepositoryMockMarkEventFailedParams{ctx, id, reason},
+	}
+	mmMarkEventFailed.expectations = append(mmMarkEventFailed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.MarkEventFailed return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockMarkEventFailedExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockMarkEventFailedResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.MarkEventFailed should be invoked
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) Times(n uint64) *mOutboxRepositoryMockMarkEventFailed {
+	if n == 0 {
+		mmMarkEventFailed.mock.t.Fatalf("Times of OutboxRepositoryMock.MarkEventFailed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkEventFailed.expectedInvocations, n)
+	return mmMarkEventFailed
+}
+
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) invocationsDone() bool {
+	if len(mmMarkEventFailed.expectations) == 0 && mmMarkEventFailed.defaultExpectation == nil && mmMarkEventFailed.mock.funcMarkEventFailed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkEventFailed.mock.afterMarkEventFailedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkEventFailed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkEventFailed implements repository.OutboxRepository
+func (mmMarkEventFailed *OutboxRepositoryMock) MarkEventFailed(ctx context.Context, id int64, reason string) (err error) {
+	mm_atomic.AddUint64(&mmMarkEventFailed.beforeMarkEventFailedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkEventFailed.afterMarkEventFailedCounter, 1)
+
+	if mmMarkEventFailed.inspectFuncMarkEventFailed != nil {
+		mmMarkEventFailed.inspectFuncMarkEventFailed(ctx, id, reason)
+	}
+
+	mm_params := OutboxRepositoryMockMarkEventFailedParams{ctx, id, reason}
+
+	// Record call args
+	mmMarkEventFailed.MarkEventFailedMock.mutex.Lock()
+	mmMarkEventFailed.MarkEventFailedMock.callArgs = append(mmMarkEventFailed.MarkEventFailedMock.callArgs, &mm_params)
+	mmMarkEventFailed.MarkEventFailedMock.mutex.Unlock()
+
+	for _, e := range mmMarkEventFailed.MarkEventFailedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkEventFailed.MarkEventFailedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkEventFailed.MarkEventFailedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkEventFailed.MarkEventFailedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkEventFailed.MarkEventFailedMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockMarkEventFailedParams{ctx, id, reason}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkEventFailed.t.Errorf("OutboxRepositoryMock.MarkEventFailed got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkEventFailed.t.Errorf("OutboxRepositoryMock.MarkEventFailed got unexpected parameter id, want: %#v, got: %#v%s\n", *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmMarkEventFailed.t.Errorf("OutboxRepositoryMock.MarkEventFailed got unexpected parameter reason, want: %#v, got: %#v%s\n", *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkEventFailed.t.Errorf("OutboxRepositoryMock.MarkEventFailed got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkEventFailed.MarkEventFailedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkEventFailed.t.Fatal("No results are set for the OutboxRepositoryMock.MarkEventFailed")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkEventFailed.funcMarkEventFailed != nil {
+		return mmMarkEventFailed.funcMarkEventFailed(ctx, id, reason)
+	}
+	mmMarkEventFailed.t.Fatalf("Unexpected call to OutboxRepositoryMock.MarkEventFailed. %v %v %v", ctx, id, reason)
+	return
+}
+
+// MarkEventFailedAfterCounter returns a count of finished OutboxRepositoryMock.MarkEventFailed invocations
+func (mmMarkEventFailed *OutboxRepositoryMock) MarkEventFailedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEventFailed.afterMarkEventFailedCounter)
+}
+
+// MarkEventFailedBeforeCounter returns a count of OutboxRepositoryMock.MarkEventFailed invocations
+func (mmMarkEventFailed *OutboxRepositoryMock) MarkEventFailedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEventFailed.beforeMarkEventFailedCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.MarkEventFailed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkEventFailed *mOutboxRepositoryMockMarkEventFailed) Calls() []*OutboxRepositoryMockMarkEventFailedParams {
+	mmMarkEventFailed.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockMarkEventFailedParams, len(mmMarkEventFailed.callArgs))
+	copy(argCopy, mmMarkEventFailed.callArgs)
+
+	mmMarkEventFailed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkEventFailedDone returns true if the count of the MarkEventFailed invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockMarkEventFailedDone() bool {
+	if m.MarkEventFailedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkEventFailedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkEventFailedMock.invocationsDone()
+}
+
+// MinimockMarkEventFailedInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockMarkEventFailedInspect() {
+	for _, e := range m.MarkEventFailedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkEventFailed with params: %#v", *e.params)
+		}
+	}
+
+	afterMarkEventFailedCounter := mm_atomic.LoadUint64(&m.afterMarkEventFailedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkEventFailedMock.defaultExpectation != nil && afterMarkEventFailedCounter < 1 {
+		if m.MarkEventFailedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OutboxRepositoryMock.MarkEventFailed")
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkEventFailed with params: %#v", *m.MarkEventFailedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkEventFailed != nil && afterMarkEventFailedCounter < 1 {
+		m.t.Error("Expected call to OutboxRepositoryMock.MarkEventFailed")
+	}
+
+	if !m.MarkEventFailedMock.invocationsDone() && afterMarkEventFailedCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.MarkEventFailed but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkEventFailedMock.expectedInvocations), afterMarkEventFailedCounter)
+	}
+}
+
+type mOutboxRepositoryMockMarkEventsPublished struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockMarkEventsPublishedExpectation
+	expectations       []*OutboxRepositoryMockMarkEventsPublishedExpectation
+
+	callArgs []*OutboxRepositoryMockMarkEventsPublishedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OutboxRepositoryMockMarkEventsPublishedExpectation specifies expectation struct of the OutboxRepository.MarkEventsPublished
+type OutboxRepositoryMockMarkEventsPublishedExpectation struct {
+	mock      *OutboxRepositoryMock
+	params    *OutboxRepositoryMockMarkEventsPublishedParams
+	paramPtrs *OutboxRepositoryMockMarkEventsPublishedParamPtrs
+	results   *OutboxRepositoryMockMarkEventsPublishedResults
+	Counter   uint64
+}
+
+// OutboxRepositoryMockMarkEventsPublishedParams contains parameters of the OutboxRepository.MarkEventsPublished
+type OutboxRepositoryMockMarkEventsPublishedParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// OutboxRepositoryMockMarkEventsPublishedParamPtrs contains pointers to parameters of the OutboxRepository.MarkEventsPublished
+type OutboxRepositoryMockMarkEventsPublishedParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// OutboxRepositoryMockMarkEventsPublishedResults contains results of the OutboxRepository.MarkEventsPublished
+type OutboxRepositoryMockMarkEventsPublishedResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) Optional() *mOutboxRepositoryMockMarkEventsPublished {
+	mmMarkEventsPublished.optional = true
+	return mmMarkEventsPublished
+}
+
+// Expect sets up expected params for OutboxRepository.MarkEventsPublished
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) Expect(ctx context.Context, ids []int64) *mOutboxRepositoryMockMarkEventsPublished {
+	if mmMarkEventsPublished.mock.funcMarkEventsPublished != nil {
+		mmMarkEventsPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsPublished mock is already set by Set")
+	}
+
+	if mmMarkEventsPublished.defaultExpectation == nil {
+		mmMarkEventsPublished.defaultExpectation = &OutboxRepositoryMockMarkEventsPublishedExpectation{}
+	}
+
+	if mmMarkEventsPublished.defaultExpectation.paramPtrs != nil {
+		mmMarkEventsPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsPublished mock is already set by ExpectParams functions")
+	}
+
+	mmMarkEventsPublished.defaultExpectation.params = &OutboxRepositoryMockMarkEventsPublishedParams{ctx, ids}
+	for _, e := range mmMarkEventsPublished.expectations {
+		if minimock.Equal(e.params, mmMarkEventsPublished.defaultExpectation.params) {
+			mmMarkEventsPublished.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkEventsPublished.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkEventsPublished
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.MarkEventsPublished
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockMarkEventsPublished {
+	if mmMarkEventsPublished.mock.funcMarkEventsPublished != nil {
+		mmMarkEventsPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsPublished mock is already set by Set")
+	}
+
+	if mmMarkEventsPublished.defaultExpectation == nil {
+		mmMarkEventsPublished.defaultExpectation = &OutboxRepositoryMockMarkEventsPublishedExpectation{}
+	}
+
+	if mmMarkEventsPublished.defaultExpectation.params != nil {
+		mmMarkEventsPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsPublished mock is already set by Expect")
+	}
+
+	if mmMarkEventsPublished.defaultExpectation.paramPtrs == nil {
+		mmMarkEventsPublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkEventsPublishedParamPtrs{}
+	}
+	mmMarkEventsPublished.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmMarkEventsPublished
+}
+
+// ExpectIdsParam2 sets up expected param ids for OutboxRepository.MarkEventsPublished
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) ExpectIdsParam2(ids []int64) *mOutboxRepositoryMockMarkEventsPublished {
+	if mmMarkEventsPublished.mock.funcMarkEventsPublished != nil {
+		mmMarkEventsPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsPublished mock is already set by Set")
+	}
+
+	if mmMarkEventsPublished.defaultExpectation == nil {
+		mmMarkEventsPublished.defaultExpectation = &OutboxRepositoryMockMarkEventsPublishedExpectation{}
+	}
+
+	if mmMarkEventsPublished.defaultExpectation.params != nil {
+		mmMarkEventsPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsPublished mock is already set by Expect")
+	}
+
+	if mmMarkEventsPublished.defaultExpectation.paramPtrs == nil {
+		mmMarkEventsPublished.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkEventsPublishedParamPtrs{}
+	}
+	mmMarkEventsPublished.defaultExpectation.paramPtrs.ids = &ids
+
+	return mmMarkEventsPublished
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.MarkEventsPublished
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) Inspect(f func(ctx context.Context, ids []int64)) *mOutboxRepositoryMockMarkEventsPublished {
+	if mmMarkEventsPublished.mock.inspectFuncMarkEventsPublished != nil {
+		mmMarkEventsPublished.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.MarkEventsPublished")
+	}
+
+	mmMarkEventsPublished.mock.inspectFuncMarkEventsPublished = f
+
+	return mmMarkEventsPublished
+}
+
+// Return sets up results that will be returned by OutboxRepository.MarkEventsPublished
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) Return(err error) *OutboxRepositoryMock {
+	if mmMarkEventsPublished.mock.funcMarkEventsPublished != nil {
+		mmMarkEventsPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsPublished mock is already set by Set")
+	}
+
+	if mmMarkEventsPublished.defaultExpectation == nil {
+		mmMarkEventsPublished.defaultExpectation = &OutboxRepositoryMockMarkEventsPublishedExpectation{mock: mmMarkEventsPublished.mock}
+	}
+	mmMarkEventsPublished.defaultExpectation.results = &OutboxRepositoryMockMarkEventsPublishedResults{err}
+	return mmMarkEventsPublished.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.MarkEventsPublished method
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) Set(f func(ctx context.Context, ids []int64) (err error)) *OutboxRepositoryMock {
+	if mmMarkEventsPublished.defaultExpectation != nil {
+		mmMarkEventsPublished.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.MarkEventsPublished method")
+	}
+
+	if len(mmMarkEventsPublished.expectations) > 0 {
+		mmMarkEventsPublished.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.MarkEventsPublished method")
+	}
+
+	mmMarkEventsPublished.mock.funcMarkEventsPublished = f
+	return mmMarkEventsPublished.mock
+}
+
+// When sets expectation for the OutboxRepository.MarkEventsPublished which will trigger the result defined by the following
+// Then helper
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) When(ctx context.Context, ids []int64) *OutboxRepositoryMockMarkEventsPublishedExpectation {
+	if mmMarkEventsPublished.mock.funcMarkEventsPublished != nil {
+		mmMarkEventsPublished.mock.t.Fatalf("OutboxRepositoryMock.MarkEventsPublished mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockMarkEventsPublishedExpectation{
+		mock:   mmMarkEventsPublished.mock,
+		params: &OutboxRepositoryMockMarkEventsPublishedParams{ctx, ids},
+	}
+	mmMarkEventsPublished.expectations = append(mmMarkEventsPublished.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.MarkEventsPublished return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockMarkEventsPublishedExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockMarkEventsPublishedResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.MarkEventsPublished should be invoked
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) Times(n uint64) *mOutboxRepositoryMockMarkEventsPublished {
+	if n == 0 {
+		mmMarkEventsPublished.mock.t.Fatalf("Times of OutboxRepositoryMock.MarkEventsPublished mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkEventsPublished.expectedInvocations, n)
+	return mmMarkEventsPublished
+}
+
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) invocationsDone() bool {
+	if len(mmMarkEventsPublished.expectations) == 0 && mmMarkEventsPublished.defaultExpectation == nil && mmMarkEventsPublished.mock.funcMarkEventsPublished == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkEventsPublished.mock.afterMarkEventsPublishedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkEventsPublished.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkEventsPublished implements repository.OutboxRepository
+func (mmMarkEventsPublished *OutboxRepositoryMock) MarkEventsPublished(ctx context.Context, ids []int64) (err error) {
+	mm_atomic.AddUint64(&mmMarkEventsPublished.beforeMarkEventsPublishedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkEventsPublished.afterMarkEventsPublishedCounter, 1)
+
+	if mmMarkEventsPublished.inspectFuncMarkEventsPublished != nil {
+		mmMarkEventsPublished.inspectFuncMarkEventsPublished(ctx, ids)
+	}
+
+	mm_params := OutboxRepositoryMockMarkEventsPublishedParams{ctx, ids}
+
+	// Record call args
+	mmMarkEventsPublished.MarkEventsPublishedMock.mutex.Lock()
+	mmMarkEventsPublished.MarkEventsPublishedMock.callArgs = append(mmMarkEventsPublished.MarkEventsPublishedMock.callArgs, &mm_params)
+	mmMarkEventsPublished.MarkEventsPublishedMock.mutex.Unlock()
+
+	for _, e := range mmMarkEventsPublished.MarkEventsPublishedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkEventsPublished.MarkEventsPublishedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkEventsPublished.MarkEventsPublishedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkEventsPublished.MarkEventsPublishedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkEventsPublished.MarkEventsPublishedMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockMarkEventsPublishedParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkEventsPublished.t.Errorf("OutboxRepositoryMock.MarkEventsPublished got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmMarkEventsPublished.t.Errorf("OutboxRepositoryMock.MarkEventsPublished got unexpected parameter ids, want: %#v, got: %#v%s\n", *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkEventsPublished.t.Errorf("OutboxRepositoryMock.MarkEventsPublished got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkEventsPublished.MarkEventsPublishedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkEventsPublished.t.Fatal("No results are set for the OutboxRepositoryMock.MarkEventsPublished")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkEventsPublished.funcMarkEventsPublished != nil {
+		return mmMarkEventsPublished.funcMarkEventsPublished(ctx, ids)
+	}
+	mmMarkEventsPublished.t.Fatalf("Unexpected call to OutboxRepositoryMock.MarkEventsPublished. %v %v", ctx, ids)
+	return
+}
+
+// MarkEventsPublishedAfterCounter returns a count of finished OutboxRepositoryMock.MarkEventsPublished invocations
+func (mmMarkEventsPublished *OutboxRepositoryMock) MarkEventsPublishedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEventsPublished.afterMarkEventsPublishedCounter)
+}
+
+// MarkEventsPublishedBeforeCounter returns a count of OutboxRepositoryMock.MarkEventsPublished invocations
+func (mmMarkEventsPublished *OutboxRepositoryMock) MarkEventsPublishedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEventsPublished.beforeMarkEventsPublishedCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.MarkEventsPublished.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkEventsPublished *mOutboxRepositoryMockMarkEventsPublished) Calls() []*OutboxRepositoryMockMarkEventsPublishedParams {
+	mmMarkEventsPublished.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockMarkEventsPublishedParams, len(mmMarkEventsPublished.callArgs))
+	copy(argCopy, mmMarkEventsPublished.callArgs)
+
+	mmMarkEventsPublished.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkEventsPublishedDone returns true if the count of the MarkEventsPublished invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockMarkEventsPublishedDone() bool {
+	if m.MarkEventsPublishedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkEventsPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkEventsPublishedMock.invocationsDone()
+}
+
+// MinimockMarkEventsPublishedInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockMarkEventsPublishedInspect() {
+	for _, e := range m.MarkEventsPublishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkEventsPublished with params: %#v", *e.params)
+		}
+	}
+
+	afterMarkEventsPublishedCounter := mm_atomic.LoadUint64(&m.afterMarkEventsPublishedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkEventsPublishedMock.defaultExpectation != nil && afterMarkEventsPublishedCounter < 1 {
+		if m.MarkEventsPublishedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OutboxRepositoryMock.MarkEventsPublished")
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkEventsPublished with params: %#v", *m.MarkEventsPublishedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkEventsPublished != nil && afterMarkEventsPublishedCounter < 1 {
+		m.t.Error("Expected call to OutboxRepositoryMock.MarkEventsPublished")
+	}
+
+	if !m.MarkEventsPublishedMock.invocationsDone() && afterMarkEventsPublishedCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.MarkEventsPublished but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkEventsPublishedMock.expectedInvocations), afterMarkEventsPublishedCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateEventInspect()
+
+			m.MinimockDeletePublishedEventsInspect()
+
+			m.MinimockListPendingEventsInspect()
+
+			m.MinimockLockRelayInspect()
+
+			m.MinimockMarkEventFailedInspect()
+
+			m.MinimockMarkEventsPublishedInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateEventDone() &&
+		m.MinimockDeletePublishedEventsDone() &&
+		m.MinimockListPendingEventsDone() &&
+		m.MinimockLockRelayDone() &&
+		m.MinimockMarkEventFailedDone() &&
+		m.MinimockMarkEventsPublishedDone()
+}
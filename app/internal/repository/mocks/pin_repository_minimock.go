@@ -0,0 +1,1725 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.PinRepository -o pin_repository_minimock.go -n PinRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PinRepositoryMock implements repository.PinRepository
+type PinRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetPinPolicy          func(ctx context.Context, params model.GetPinPolicyParams) (policy model.PinPolicy, err error)
+	inspectFuncGetPinPolicy   func(ctx context.Context, params model.GetPinPolicyParams)
+	afterGetPinPolicyCounter  uint64
+	beforeGetPinPolicyCounter uint64
+	GetPinPolicyMock          mPinRepositoryMockGetPinPolicy
+
+	funcListPinnedMessages          func(ctx context.Context, params model.ListPinnedMessagesParams) (pinned []model.PinnedMessage, err error)
+	inspectFuncListPinnedMessages   func(ctx context.Context, params model.ListPinnedMessagesParams)
+	afterListPinnedMessagesCounter  uint64
+	beforeListPinnedMessagesCounter uint64
+	ListPinnedMessagesMock          mPinRepositoryMockListPinnedMessages
+
+	funcPinMessage          func(ctx context.Context, params model.PinMessageParams) (pinned model.PinnedMessage, err error)
+	inspectFuncPinMessage   func(ctx context.Context, params model.PinMessageParams)
+	afterPinMessageCounter  uint64
+	beforePinMessageCounter uint64
+	PinMessageMock          mPinRepositoryMockPinMessage
+
+	funcSetPinPolicy          func(ctx context.Context, params model.SetPinPolicyParams) (err error)
+	inspectFuncSetPinPolicy   func(ctx context.Context, params model.SetPinPolicyParams)
+	afterSetPinPolicyCounter  uint64
+	beforeSetPinPolicyCounter uint64
+	SetPinPolicyMock          mPinRepositoryMockSetPinPolicy
+
+	funcUnpinMessage          func(ctx context.Context, params model.UnpinMessageParams) (err error)
+	inspectFuncUnpinMessage   func(ctx context.Context, params model.UnpinMessageParams)
+	afterUnpinMessageCounter  uint64
+	beforeUnpinMessageCounter uint64
+	UnpinMessageMock          mPinRepositoryMockUnpinMessage
+}
+
+// NewPinRepositoryMock returns a mock for repository.PinRepository
+func NewPinRepositoryMock(t minimock.Tester) *PinRepositoryMock {
+	m := &PinRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetPinPolicyMock = mPinRepositoryMockGetPinPolicy{mock: m}
+	m.GetPinPolicyMock.callArgs = []*PinRepositoryMockGetPinPolicyParams{}
+
+	m.ListPinnedMessagesMock = mPinRepositoryMockListPinnedMessages{mock: m}
+	m.ListPinnedMessagesMock.callArgs = []*PinRepositoryMockListPinnedMessagesParams{}
+
+	m.PinMessageMock = mPinRepositoryMockPinMessage{mock: m}
+	m.PinMessageMock.callArgs = []*PinRepositoryMockPinMessageParams{}
+
+	m.SetPinPolicyMock = mPinRepositoryMockSetPinPolicy{mock: m}
+	m.SetPinPolicyMock.callArgs = []*PinRepositoryMockSetPinPolicyParams{}
+
+	m.UnpinMessageMock = mPinRepositoryMockUnpinMessage{mock: m}
+	m.UnpinMessageMock.callArgs = []*PinRepositoryMockUnpinMessageParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPinRepositoryMockGetPinPolicy struct {
+	optional           bool
+	mock               *PinRepositoryMock
+	defaultExpectation *PinRepositoryMockGetPinPolicyExpectation
+	expectations       []*PinRepositoryMockGetPinPolicyExpectation
+
+	callArgs []*PinRepositoryMockGetPinPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PinRepositoryMockGetPinPolicyExpectation specifies expectation struct of the PinRepository.GetPinPolicy
+type PinRepositoryMockGetPinPolicyExpectation struct {
+	mock      *PinRepositoryMock
+	params    *PinRepositoryMockGetPinPolicyParams
+	paramPtrs *PinRepositoryMockGetPinPolicyParamPtrs
+	results   *PinRepositoryMockGetPinPolicyResults
+	Counter   uint64
+}
+
+// PinRepositoryMockGetPinPolicyParams contains parameters of the PinRepository.GetPinPolicy
+type PinRepositoryMockGetPinPolicyParams struct {
+	ctx    context.Context
+	params model.GetPinPolicyParams
+}
+
+// PinRepositoryMockGetPinPolicyParamPtrs contains pointers to parameters of the PinRepository.GetPinPolicy
+type PinRepositoryMockGetPinPolicyParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetPinPolicyParams
+}
+
+// PinRepositoryMockGetPinPolicyResults contains results of the PinRepository.GetPinPolicy
+type PinRepositoryMockGetPinPolicyResults struct {
+	policy model.PinPolicy
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) Optional() *mPinRepositoryMockGetPinPolicy {
+	mmGetPinPolicy.optional = true
+	return mmGetPinPolicy
+}
+
+// Expect sets up expected params for PinRepository.GetPinPolicy
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) Expect(ctx context.Context, params model.GetPinPolicyParams) *mPinRepositoryMockGetPinPolicy {
+	if mmGetPinPolicy.mock.funcGetPinPolicy != nil {
+		mmGetPinPolicy.mock.t.Fatalf("PinRepositoryMock.GetPinPolicy mock is already set by Set")
+	}
+
+	if mmGetPinPolicy.defaultExpectation == nil {
+		mmGetPinPolicy.defaultExpectation = &PinRepositoryMockGetPinPolicyExpectation{}
+	}
+
+	if mmGetPinPolicy.defaultExpectation.paramPtrs != nil {
+		mmGetPinPolicy.mock.t.Fatalf("PinRepositoryMock.GetPinPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmGetPinPolicy.defaultExpectation.params = &PinRepositoryMockGetPinPolicyParams{ctx, params}
+	for _, e := range mmGetPinPolicy.expectations {
+		if minimock.Equal(e.params, mmGetPinPolicy.defaultExpectation.params) {
+			mmGetPinPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPinPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPinPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PinRepository.GetPinPolicy
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) ExpectCtxParam1(ctx context.Context) *mPinRepositoryMockGetPinPolicy {
+	if mmGetPinPolicy.mock.funcGetPinPolicy != nil {
+		mmGetPinPolicy.mock.t.Fatalf("PinRepositoryMock.GetPinPolicy mock is already set by Set")
+	}
+
+	if mmGetPinPolicy.defaultExpectation == nil {
+		mmGetPinPolicy.defaultExpectation = &PinRepositoryMockGetPinPolicyExpectation{}
+	}
+
+	if mmGetPinPolicy.defaultExpectation.params != nil {
+		mmGetPinPolicy.mock.t.Fatalf("PinRepositoryMock.GetPinPolicy mock is already set by Expect")
+	}
+
+	if mmGetPinPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetPinPolicy.defaultExpectation.paramPtrs = &PinRepositoryMockGetPinPolicyParamPtrs{}
+	}
+	mmGetPinPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetPinPolicy
+}
+
+// ExpectParamsParam2 sets up expected param params for PinRepository.GetPinPolicy
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) ExpectParamsParam2(params model.GetPinPolicyParams) *mPinRepositoryMockGetPinPolicy {
+	if mmGetPinPolicy.mock.funcGetPinPolicy != nil {
+		mmGetPinPolicy.mock.t.Fatalf("PinRepositoryMock.GetPinPolicy mock is already set by Set")
+	}
+
+	if mmGetPinPolicy.defaultExpectation == nil {
+		mmGetPinPolicy.defaultExpectation = &PinRepositoryMockGetPinPolicyExpectation{}
+	}
+
+	if mmGetPinPolicy.defaultExpectation.params != nil {
+		mmGetPinPolicy.mock.t.Fatalf("PinRepositoryMock.GetPinPolicy mock is already set by Expect")
+	}
+
+	if mmGetPinPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetPinPolicy.defaultExpectation.paramPtrs = &PinRepositoryMockGetPinPolicyParamPtrs{}
+	}
+	mmGetPinPolicy.defaultExpectation.paramPtrs.params = &params
+
+	return mmGetPinPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PinRepository.GetPinPolicy
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) Inspect(f func(ctx context.Context, params model.GetPinPolicyParams)) *mPinRepositoryMockGetPinPolicy {
+	if mmGetPinPolicy.mock.inspectFuncGetPinPolicy != nil {
+		mmGetPinPolicy.mock.t.Fatalf("Inspect function is already set for PinRepositoryMock.GetPinPolicy")
+	}
+
+	mmGetPinPolicy.mock.inspectFuncGetPinPolicy = f
+
+	return mmGetPinPolicy
+}
+
+// Return sets up results that will be returned by PinRepository.GetPinPolicy
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) Return(policy model.PinPolicy, err error) *PinRepositoryMock {
+	if mmGetPinPolicy.mock.funcGetPinPolicy != nil {
+		mmGetPinPolicy.mock.t.Fatalf("PinRepositoryMock.GetPinPolicy mock is already set by Set")
+	}
+
+	if mmGetPinPolicy.defaultExpectation == nil {
+		mmGetPinPolicy.defaultExpectation = &PinRepositoryMockGetPinPolicyExpectation{mock: mmGetPinPolicy.mock}
+	}
+	mmGetPinPolicy.defaultExpectation.results = &PinRepositoryMockGetPinPolicyResults{policy, err}
+	return mmGetPinPolicy.mock
+}
+
+// Set uses given function f to mock the PinRepository.GetPinPolicy method
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) Set(f func(ctx context.Context, params model.GetPinPolicyParams) (policy model.PinPolicy, err error)) *PinRepositoryMock {
+	if mmGetPinPolicy.defaultExpectation != nil {
+		mmGetPinPolicy.mock.t.Fatalf("Default expectation is already set for the PinRepository.GetPinPolicy method")
+	}
+
+	if len(mmGetPinPolicy.expectations) > 0 {
+		mmGetPinPolicy.mock.t.Fatalf("Some expectations are already set for the PinRepository.GetPinPolicy method")
+	}
+
+	mmGetPinPolicy.mock.funcGetPinPolicy = f
+	return mmGetPinPolicy.mock
+}
+
+// When sets expectation for the PinRepository.GetPinPolicy which will trigger the result defined by the following
+// Then helper
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) When(ctx context.Context, params model.GetPinPolicyParams) *PinRepositoryMockGetPinPolicyExpectation {
+	if mmGetPinPolicy.mock.funcGetPinPolicy != nil {
+		mmGetPinPolicy.mock.t.Fatalf("PinRepositoryMock.GetPinPolicy mock is already set by Set")
+	}
+
+	expectation := &PinRepositoryMockGetPinPolicyExpectation{
+		mock:   mmGetPinPolicy.mock,
+		params: &PinRepositoryMockGetPinPolicyParams{ctx, params},
+	}
+	mmGetPinPolicy.expectations = append(mmGetPinPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PinRepository.GetPinPolicy return parameters for the expectation previously defined by the When method
+func (e *PinRepositoryMockGetPinPolicyExpectation) Then(policy model.PinPolicy, err error) *PinRepositoryMock {
+	e.results = &PinRepositoryMockGetPinPolicyResults{policy, err}
+	return e.mock
+}
+
+// Times sets number of times PinRepository.GetPinPolicy should be invoked
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) Times(n uint64) *mPinRepositoryMockGetPinPolicy {
+	if n == 0 {
+		mmGetPinPolicy.mock.t.Fatalf("Times of PinRepositoryMock.GetPinPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPinPolicy.expectedInvocations, n)
+	return mmGetPinPolicy
+}
+
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) invocationsDone() bool {
+	if len(mmGetPinPolicy.expectations) == 0 && mmGetPinPolicy.defaultExpectation == nil && mmGetPinPolicy.mock.funcGetPinPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPinPolicy.mock.afterGetPinPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPinPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPinPolicy implements repository.PinRepository
+func (mmGetPinPolicy *PinRepositoryMock) GetPinPolicy(ctx context.Context, params model.GetPinPolicyParams) (policy model.PinPolicy, err error) {
+	mm_atomic.AddUint64(&mmGetPinPolicy.beforeGetPinPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPinPolicy.afterGetPinPolicyCounter, 1)
+
+	if mmGetPinPolicy.inspectFuncGetPinPolicy != nil {
+		mmGetPinPolicy.inspectFuncGetPinPolicy(ctx, params)
+	}
+
+	mm_params := PinRepositoryMockGetPinPolicyParams{ctx, params}
+
+	// Record call args
+	mmGetPinPolicy.GetPinPolicyMock.mutex.Lock()
+	mmGetPinPolicy.GetPinPolicyMock.callArgs = append(mmGetPinPolicy.GetPinPolicyMock.callArgs, &mm_params)
+	mmGetPinPolicy.GetPinPolicyMock.mutex.Unlock()
+
+	for _, e := range mmGetPinPolicy.GetPinPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.policy, e.results.err
+		}
+	}
+
+	if mmGetPinPolicy.GetPinPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPinPolicy.GetPinPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPinPolicy.GetPinPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPinPolicy.GetPinPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PinRepositoryMockGetPinPolicyParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPinPolicy.t.Errorf("PinRepositoryMock.GetPinPolicy got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmGetPinPolicy.t.Errorf("PinRepositoryMock.GetPinPolicy got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPinPolicy.t.Errorf("PinRepositoryMock.GetPinPolicy got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPinPolicy.GetPinPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPinPolicy.t.Fatal("No results are set for the PinRepositoryMock.GetPinPolicy")
+		}
+		return (*mm_results).policy, (*mm_results).err
+	}
+	if mmGetPinPolicy.funcGetPinPolicy != nil {
+		return mmGetPinPolicy.funcGetPinPolicy(ctx, params)
+	}
+	mmGetPinPolicy.t.Fatalf("Unexpected call to PinRepositoryMock.GetPinPolicy. %v %v", ctx, params)
+	return
+}
+
+// GetPinPolicyAfterCounter returns a count of finished PinRepositoryMock.GetPinPolicy invocations
+func (mmGetPinPolicy *PinRepositoryMock) GetPinPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPinPolicy.afterGetPinPolicyCounter)
+}
+
+// GetPinPolicyBeforeCounter returns a count of PinRepositoryMock.GetPinPolicy invocations
+func (mmGetPinPolicy *PinRepositoryMock) GetPinPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPinPolicy.beforeGetPinPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PinRepositoryMock.GetPinPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPinPolicy *mPinRepositoryMockGetPinPolicy) Calls() []*PinRepositoryMockGetPinPolicyParams {
+	mmGetPinPolicy.mutex.RLock()
+
+	argCopy := make([]*PinRepositoryMockGetPinPolicyParams, len(mmGetPinPolicy.callArgs))
+	copy(argCopy, mmGetPinPolicy.callArgs)
+
+	mmGetPinPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPinPolicyDone returns true if the count of the GetPinPolicy invocations corresponds
+// the number of defined expectations
+func (m *PinRepositoryMock) MinimockGetPinPolicyDone() bool {
+	if m.GetPinPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPinPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPinPolicyMock.invocationsDone()
+}
+
+// MinimockGetPinPolicyInspect logs each unmet expectation
+func (m *PinRepositoryMock) MinimockGetPinPolicyInspect() {
+	for _, e := range m.GetPinPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PinRepositoryMock.GetPinPolicy with params: %#v", *e.params)
+		}
+	}
+
+	afterGetPinPolicyCounter := mm_atomic.LoadUint64(&m.afterGetPinPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPinPolicyMock.defaultExpectation != nil && afterGetPinPolicyCounter < 1 {
+		if m.GetPinPolicyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PinRepositoryMock.GetPinPolicy")
+		} else {
+			m.t.Errorf("Expected call to PinRepositoryMock.GetPinPolicy with params: %#v", *m.GetPinPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPinPolicy != nil && afterGetPinPolicyCounter < 1 {
+		m.t.Error("Expected call to PinRepositoryMock.GetPinPolicy")
+	}
+
+	if !m.GetPinPolicyMock.invocationsDone() && afterGetPinPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PinRepositoryMock.GetPinPolicy but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPinPolicyMock.expectedInvocations), afterGetPinPolicyCounter)
+	}
+}
+
+type mPinRepositoryMockListPinnedMessages struct {
+	optional           bool
+	mock               *PinRepositoryMock
+	defaultExpectation *PinRepositoryMockListPinnedMessagesExpectation
+	expectations       []*PinRepositoryMockListPinnedMessagesExpectation
+
+	callArgs []*PinRepositoryMockListPinnedMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PinRepositoryMockListPinnedMessagesExpectation specifies expectation struct of the PinRepository.ListPinnedMessages
+type PinRepositoryMockListPinnedMessagesExpectation struct {
+	mock      *PinRepositoryMock
+	params    *PinRepositoryMockListPinnedMessagesParams
+	paramPtrs *PinRepositoryMockListPinnedMessagesParamPtrs
+	results   *PinRepositoryMockListPinnedMessagesResults
+	Counter   uint64
+}
+
+// PinRepositoryMockListPinnedMessagesParams contains parameters of the PinRepository.ListPinnedMessages
+type PinRepositoryMockListPinnedMessagesParams struct {
+	ctx    context.Context
+	params model.ListPinnedMessagesParams
+}
+
+// PinRepositoryMockListPinnedMessagesParamPtrs contains pointers to parameters of the PinRepository.ListPinnedMessages
+type PinRepositoryMockListPinnedMessagesParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListPinnedMessagesParams
+}
+
+// PinRepositoryMockListPinnedMessagesResults contains results of the PinRepository.ListPinnedMessages
+type PinRepositoryMockListPinnedMessagesResults struct {
+	pinned []model.PinnedMessage
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) Optional() *mPinRepositoryMockListPinnedMessages {
+	mmListPinnedMessages.optional = true
+	return mmListPinnedMessages
+}
+
+// Expect sets up expected params for PinRepository.ListPinnedMessages
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) Expect(ctx context.Context, params model.ListPinnedMessagesParams) *mPinRepositoryMockListPinnedMessages {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinRepositoryMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &PinRepositoryMockListPinnedMessagesExpectation{}
+	}
+
+	if mmListPinnedMessages.defaultExpectation.paramPtrs != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinRepositoryMock.ListPinnedMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListPinnedMessages.defaultExpectation.params = &PinRepositoryMockListPinnedMessagesParams{ctx, params}
+	for _, e := range mmListPinnedMessages.expectations {
+		if minimock.Equal(e.params, mmListPinnedMessages.defaultExpectation.params) {
+			mmListPinnedMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPinnedMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListPinnedMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PinRepository.ListPinnedMessages
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) ExpectCtxParam1(ctx context.Context) *mPinRepositoryMockListPinnedMessages {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinRepositoryMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &PinRepositoryMockListPinnedMessagesExpectation{}
+	}
+
+	if mmListPinnedMessages.defaultExpectation.params != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinRepositoryMock.ListPinnedMessages mock is already set by Expect")
+	}
+
+	if mmListPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmListPinnedMessages.defaultExpectation.paramPtrs = &PinRepositoryMockListPinnedMessagesParamPtrs{}
+	}
+	mmListPinnedMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPinnedMessages
+}
+
+// ExpectParamsParam2 sets up expected param params for PinRepository.ListPinnedMessages
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) ExpectParamsParam2(params model.ListPinnedMessagesParams) *mPinRepositoryMockListPinnedMessages {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinRepositoryMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &PinRepositoryMockListPinnedMessagesExpectation{}
+	}
+
+	if mmListPinnedMessages.defaultExpectation.params != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinRepositoryMock.ListPinnedMessages mock is already set by Expect")
+	}
+
+	if mmListPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmListPinnedMessages.defaultExpectation.paramPtrs = &PinRepositoryMockListPinnedMessagesParamPtrs{}
+	}
+	mmListPinnedMessages.defaultExpectation.paramPtrs.params = &params
+
+	return mmListPinnedMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the PinRepository.ListPinnedMessages
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) Inspect(f func(ctx context.Context, params model.ListPinnedMessagesParams)) *mPinRepositoryMockListPinnedMessages {
+	if mmListPinnedMessages.mock.inspectFuncListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("Inspect function is already set for PinRepositoryMock.ListPinnedMessages")
+	}
+
+	mmListPinnedMessages.mock.inspectFuncListPinnedMessages = f
+
+	return mmListPinnedMessages
+}
+
+// Return sets up results that will be returned by PinRepository.ListPinnedMessages
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) Return(pinned []model.PinnedMessage, err error) *PinRepositoryMock {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinRepositoryMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &PinRepositoryMockListPinnedMessagesExpectation{mock: mmListPinnedMessages.mock}
+	}
+	mmListPinnedMessages.defaultExpectation.results = &PinRepositoryMockListPinnedMessagesResults{pinned, err}
+	return mmListPinnedMessages.mock
+}
+
+// Set uses given function f to mock the PinRepository.ListPinnedMessages method
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) Set(f func(ctx context.Context, params model.ListPinnedMessagesParams) (pinned []model.PinnedMessage, err error)) *PinRepositoryMock {
+	if mmListPinnedMessages.defaultExpectation != nil {
+		mmListPinnedMessages.mock.t.Fatalf("Default expectation is already set for the PinRepository.ListPinnedMessages method")
+	}
+
+	if len(mmListPinnedMessages.expectations) > 0 {
+		mmListPinnedMessages.mock.t.Fatalf("Some expectations are already set for the PinRepository.ListPinnedMessages method")
+	}
+
+	mmListPinnedMessages.mock.funcListPinnedMessages = f
+	return mmListPinnedMessages.mock
+}
+
+// When sets expectation for the PinRepository.ListPinnedMessages which will trigger the result defined by the following
+// Then helper
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) When(ctx context.Context, params model.ListPinnedMessagesParams) *PinRepositoryMockListPinnedMessagesExpectation {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinRepositoryMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	expectation := &PinRepositoryMockListPinnedMessagesExpectation{
+		mock:   mmListPinnedMessages.mock,
+		params: &PinRepositoryMockListPinnedMessagesParams{ctx, params},
+	}
+	mmListPinnedMessages.expectations = append(mmListPinnedMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PinRepository.ListPinnedMessages return parameters for the expectation previously defined by the When method
+func (e *PinRepositoryMockListPinnedMessagesExpectation) Then(pinned []model.PinnedMessage, err error) *PinRepositoryMock {
+	e.results = &PinRepositoryMockListPinnedMessagesResults{pinned, err}
+	return e.mock
+}
+
+// Times sets number of times PinRepository.ListPinnedMessages should be invoked
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) Times(n uint64) *mPinRepositoryMockListPinnedMessages {
+	if n == 0 {
+		mmListPinnedMessages.mock.t.Fatalf("Times of PinRepositoryMock.ListPinnedMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPinnedMessages.expectedInvocations, n)
+	return mmListPinnedMessages
+}
+
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) invocationsDone() bool {
+	if len(mmListPinnedMessages.expectations) == 0 && mmListPinnedMessages.defaultExpectation == nil && mmListPinnedMessages.mock.funcListPinnedMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPinnedMessages.mock.afterListPinnedMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPinnedMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPinnedMessages implements repository.PinRepository
+func (mmListPinnedMessages *PinRepositoryMock) ListPinnedMessages(ctx context.Context, params model.ListPinnedMessagesParams) (pinned []model.PinnedMessage, err error) {
+	mm_atomic.AddUint64(&mmListPinnedMessages.beforeListPinnedMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPinnedMessages.afterListPinnedMessagesCounter, 1)
+
+	if mmListPinnedMessages.inspectFuncListPinnedMessages != nil {
+		mmListPinnedMessages.inspectFuncListPinnedMessages(ctx, params)
+	}
+
+	mm_params := PinRepositoryMockListPinnedMessagesParams{ctx, params}
+
+	// Record call args
+	mmListPinnedMessages.ListPinnedMessagesMock.mutex.Lock()
+	mmListPinnedMessages.ListPinnedMessagesMock.callArgs = append(mmListPinnedMessages.ListPinnedMessagesMock.callArgs, &mm_params)
+	mmListPinnedMessages.ListPinnedMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListPinnedMessages.ListPinnedMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pinned, e.results.err
+		}
+	}
+
+	if mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := PinRepositoryMockListPinnedMessagesParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPinnedMessages.t.Errorf("PinRepositoryMock.ListPinnedMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListPinnedMessages.t.Errorf("PinRepositoryMock.ListPinnedMessages got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPinnedMessages.t.Errorf("PinRepositoryMock.ListPinnedMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPinnedMessages.t.Fatal("No results are set for the PinRepositoryMock.ListPinnedMessages")
+		}
+		return (*mm_results).pinned, (*mm_results).err
+	}
+	if mmListPinnedMessages.funcListPinnedMessages != nil {
+		return mmListPinnedMessages.funcListPinnedMessages(ctx, params)
+	}
+	mmListPinnedMessages.t.Fatalf("Unexpected call to PinRepositoryMock.ListPinnedMessages. %v %v", ctx, params)
+	return
+}
+
+// ListPinnedMessagesAfterCounter returns a count of finished PinRepositoryMock.ListPinnedMessages invocations
+func (mmListPinnedMessages *PinRepositoryMock) ListPinnedMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinnedMessages.afterListPinnedMessagesCounter)
+}
+
+// ListPinnedMessagesBeforeCounter returns a count of PinRepositoryMock.ListPinnedMessages invocations
+func (mmListPinnedMessages *PinRepositoryMock) ListPinnedMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinnedMessages.beforeListPinnedMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to PinRepositoryMock.ListPinnedMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPinnedMessages *mPinRepositoryMockListPinnedMessages) Calls() []*PinRepositoryMockListPinnedMessagesParams {
+	mmListPinnedMessages.mutex.RLock()
+
+	argCopy := make([]*PinRepositoryMockListPinnedMessagesParams, len(mmListPinnedMessages.callArgs))
+	copy(argCopy, mmListPinnedMessages.callArgs)
+
+	mmListPinnedMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPinnedMessagesDone returns true if the count of the ListPinnedMessages invocations corresponds
+// the number of defined expectations
+func (m *PinRepositoryMock) MinimockListPinnedMessagesDone() bool {
+	if m.ListPinnedMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPinnedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPinnedMessagesMock.invocationsDone()
+}
+
+// MinimockListPinnedMessagesInspect logs each unmet expectation
+func (m *PinRepositoryMock) MinimockListPinnedMessagesInspect() {
+	for _, e := range m.ListPinnedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PinRepositoryMock.ListPinnedMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterListPinnedMessagesCounter := mm_atomic.LoadUint64(&m.afterListPinnedMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPinnedMessagesMock.defaultExpectation != nil && afterListPinnedMessagesCounter < 1 {
+		if m.ListPinnedMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PinRepositoryMock.ListPinnedMessages")
+		} else {
+			m.t.Errorf("Expected call to PinRepositoryMock.ListPinnedMessages with params: %#v", *m.ListPinnedMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPinnedMessages != nil && afterListPinnedMessagesCounter < 1 {
+		m.t.Error("Expected call to PinRepositoryMock.ListPinnedMessages")
+	}
+
+	if !m.ListPinnedMessagesMock.invocationsDone() && afterListPinnedMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to PinRepositoryMock.ListPinnedMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPinnedMessagesMock.expectedInvocations), afterListPinnedMessagesCounter)
+	}
+}
+
+type mPinRepositoryMockPinMessage struct {
+	optional           bool
+	mock               *PinRepositoryMock
+	defaultExpectation *PinRepositoryMockPinMessageExpectation
+	expectations       []*PinRepositoryMockPinMessageExpectation
+
+	callArgs []*PinRepositoryMockPinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PinRepositoryMockPinMessageExpectation specifies expectation struct of the PinRepository.PinMessage
+type PinRepositoryMockPinMessageExpectation struct {
+	mock      *PinRepositoryMock
+	params    *PinRepositoryMockPinMessageParams
+	paramPtrs *PinRepositoryMockPinMessageParamPtrs
+	results   *PinRepositoryMockPinMessageResults
+	Counter   uint64
+}
+
+// PinRepositoryMockPinMessageParams contains parameters of the PinRepository.PinMessage
+type PinRepositoryMockPinMessageParams struct {
+	ctx    context.Context
+	params model.PinMessageParams
+}
+
+// PinRepositoryMockPinMessageParamPtrs contains pointers to parameters of the PinRepository.PinMessage
+type PinRepositoryMockPinMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.PinMessageParams
+}
+
+// PinRepositoryMockPinMessageResults contains results of the PinRepository.PinMessage
+type PinRepositoryMockPinMessageResults struct {
+	pinned model.PinnedMessage
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPinMessage *mPinRepositoryMockPinMessage) Optional() *mPinRepositoryMockPinMessage {
+	mmPinMessage.optional = true
+	return mmPinMessage
+}
+
+// Expect sets up expected params for PinRepository.PinMessage
+func (mmPinMessage *mPinRepositoryMockPinMessage) Expect(ctx context.Context, params model.PinMessageParams) *mPinRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("PinRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &PinRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs != nil {
+		mmPinMessage.mock.t.Fatalf("PinRepositoryMock.PinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPinMessage.defaultExpectation.params = &PinRepositoryMockPinMessageParams{ctx, params}
+	for _, e := range mmPinMessage.expectations {
+		if minimock.Equal(e.params, mmPinMessage.defaultExpectation.params) {
+			mmPinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PinRepository.PinMessage
+func (mmPinMessage *mPinRepositoryMockPinMessage) ExpectCtxParam1(ctx context.Context) *mPinRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("PinRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &PinRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("PinRepositoryMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &PinRepositoryMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPinMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for PinRepository.PinMessage
+func (mmPinMessage *mPinRepositoryMockPinMessage) ExpectParamsParam2(params model.PinMessageParams) *mPinRepositoryMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("PinRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &PinRepositoryMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("PinRepositoryMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &PinRepositoryMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmPinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the PinRepository.PinMessage
+func (mmPinMessage *mPinRepositoryMockPinMessage) Inspect(f func(ctx context.Context, params model.PinMessageParams)) *mPinRepositoryMockPinMessage {
+	if mmPinMessage.mock.inspectFuncPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("Inspect function is already set for PinRepositoryMock.PinMessage")
+	}
+
+	mmPinMessage.mock.inspectFuncPinMessage = f
+
+	return mmPinMessage
+}
+
+// Return sets up results that will be returned by PinRepository.PinMessage
+func (mmPinMessage *mPinRepositoryMockPinMessage) Return(pinned model.PinnedMessage, err error) *PinRepositoryMock {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("PinRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &PinRepositoryMockPinMessageExpectation{mock: mmPinMessage.mock}
+	}
+	mmPinMessage.defaultExpectation.results = &PinRepositoryMockPinMessageResults{pinned, err}
+	return mmPinMessage.mock
+}
+
+// Set uses given function f to mock the PinRepository.PinMessage method
+func (mmPinMessage *mPinRepositoryMockPinMessage) Set(f func(ctx context.Context, params model.PinMessageParams) (pinned model.PinnedMessage, err error)) *PinRepositoryMock {
+	if mmPinMessage.defaultExpectation != nil {
+		mmPinMessage.mock.t.Fatalf("Default expectation is already set for the PinRepository.PinMessage method")
+	}
+
+	if len(mmPinMessage.expectations) > 0 {
+		mmPinMessage.mock.t.Fatalf("Some expectations are already set for the PinRepository.PinMessage method")
+	}
+
+	mmPinMessage.mock.funcPinMessage = f
+	return mmPinMessage.mock
+}
+
+// When sets expectation for the PinRepository.PinMessage which will trigger the result defined by the following
+// Then helper
+func (mmPinMessage *mPinRepositoryMockPinMessage) When(ctx context.Context, params model.PinMessageParams) *PinRepositoryMockPinMessageExpectation {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("PinRepositoryMock.PinMessage mock is already set by Set")
+	}
+
+	expectation := &PinRepositoryMockPinMessageExpectation{
+		mock:   mmPinMessage.mock,
+		params: &PinRepositoryMockPinMessageParams{ctx, params},
+	}
+	mmPinMessage.expectations = append(mmPinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PinRepository.PinMessage return parameters for the expectation previously defined by the When method
+func (e *PinRepositoryMockPinMessageExpectation) Then(pinned model.PinnedMessage, err error) *PinRepositoryMock {
+	e.results = &PinRepositoryMockPinMessageResults{pinned, err}
+	return e.mock
+}
+
+// Times sets number of times PinRepository.PinMessage should be invoked
+func (mmPinMessage *mPinRepositoryMockPinMessage) Times(n uint64) *mPinRepositoryMockPinMessage {
+	if n == 0 {
+		mmPinMessage.mock.t.Fatalf("Times of PinRepositoryMock.PinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPinMessage.expectedInvocations, n)
+	return mmPinMessage
+}
+
+func (mmPinMessage *mPinRepositoryMockPinMessage) invocationsDone() bool {
+	if len(mmPinMessage.expectations) == 0 && mmPinMessage.defaultExpectation == nil && mmPinMessage.mock.funcPinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPinMessage.mock.afterPinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PinMessage implements repository.PinRepository
+func (mmPinMessage *PinRepositoryMock) PinMessage(ctx context.Context, params model.PinMessageParams) (pinned model.PinnedMessage, err error) {
+	mm_atomic.AddUint64(&mmPinMessage.beforePinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPinMessage.afterPinMessageCounter, 1)
+
+	if mmPinMessage.inspectFuncPinMessage != nil {
+		mmPinMessage.inspectFuncPinMessage(ctx, params)
+	}
+
+	mm_params := PinRepositoryMockPinMessageParams{ctx, params}
+
+	// Record call args
+	mmPinMessage.PinMessageMock.mutex.Lock()
+	mmPinMessage.PinMessageMock.callArgs = append(mmPinMessage.PinMessageMock.callArgs, &mm_params)
+	mmPinMessage.PinMessageMock.mutex.Unlock()
+
+	for _, e := range mmPinMessage.PinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pinned, e.results.err
+		}
+	}
+
+	if mmPinMessage.PinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPinMessage.PinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPinMessage.PinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPinMessage.PinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := PinRepositoryMockPinMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPinMessage.t.Errorf("PinRepositoryMock.PinMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmPinMessage.t.Errorf("PinRepositoryMock.PinMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPinMessage.t.Errorf("PinRepositoryMock.PinMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPinMessage.PinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPinMessage.t.Fatal("No results are set for the PinRepositoryMock.PinMessage")
+		}
+		return (*mm_results).pinned, (*mm_results).err
+	}
+	if mmPinMessage.funcPinMessage != nil {
+		return mmPinMessage.funcPinMessage(ctx, params)
+	}
+	mmPinMessage.t.Fatalf("Unexpected call to PinRepositoryMock.PinMessage. %v %v", ctx, params)
+	return
+}
+
+// PinMessageAfterCounter returns a count of finished PinRepositoryMock.PinMessage invocations
+func (mmPinMessage *PinRepositoryMock) PinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.afterPinMessageCounter)
+}
+
+// PinMessageBeforeCounter returns a count of PinRepositoryMock.PinMessage invocations
+func (mmPinMessage *PinRepositoryMock) PinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.beforePinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to PinRepositoryMock.PinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPinMessage *mPinRepositoryMockPinMessage) Calls() []*PinRepositoryMockPinMessageParams {
+	mmPinMessage.mutex.RLock()
+
+	argCopy := make([]*PinRepositoryMockPinMessageParams, len(mmPinMessage.callArgs))
+	copy(argCopy, mmPinMessage.callArgs)
+
+	mmPinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPinMessageDone returns true if the count of the PinMessage invocations corresponds
+// the number of defined expectations
+func (m *PinRepositoryMock) MinimockPinMessageDone() bool {
+	if m.PinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PinMessageMock.invocationsDone()
+}
+
+// MinimockPinMessageInspect logs each unmet expectation
+func (m *PinRepositoryMock) MinimockPinMessageInspect() {
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PinRepositoryMock.PinMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterPinMessageCounter := mm_atomic.LoadUint64(&m.afterPinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PinMessageMock.defaultExpectation != nil && afterPinMessageCounter < 1 {
+		if m.PinMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PinRepositoryMock.PinMessage")
+		} else {
+			m.t.Errorf("Expected call to PinRepositoryMock.PinMessage with params: %#v", *m.PinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPinMessage != nil && afterPinMessageCounter < 1 {
+		m.t.Error("Expected call to PinRepositoryMock.PinMessage")
+	}
+
+	if !m.PinMessageMock.invocationsDone() && afterPinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to PinRepositoryMock.PinMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.PinMessageMock.expectedInvocations), afterPinMessageCounter)
+	}
+}
+
+type mPinRepositoryMockSetPinPolicy struct {
+	optional           bool
+	mock               *PinRepositoryMock
+	defaultExpectation *PinRepositoryMockSetPinPolicyExpectation
+	expectations       []*PinRepositoryMockSetPinPolicyExpectation
+
+	callArgs []*PinRepositoryMockSetPinPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PinRepositoryMockSetPinPolicyExpectation specifies expectation struct of the PinRepository.SetPinPolicy
+type PinRepositoryMockSetPinPolicyExpectation struct {
+	mock      *PinRepositoryMock
+	params    *PinRepositoryMockSetPinPolicyParams
+	paramPtrs *PinRepositoryMockSetPinPolicyParamPtrs
+	results   *PinRepositoryMockSetPinPolicyResults
+	Counter   uint64
+}
+
+// PinRepositoryMockSetPinPolicyParams contains parameters of the PinRepository.SetPinPolicy
+type PinRepositoryMockSetPinPolicyParams struct {
+	ctx    context.Context
+	params model.SetPinPolicyParams
+}
+
+// PinRepositoryMockSetPinPolicyParamPtrs contains pointers to parameters of the PinRepository.SetPinPolicy
+type PinRepositoryMockSetPinPolicyParamPtrs struct {
+	ctx    *context.Context
+	params *model.SetPinPolicyParams
+}
+
+// PinRepositoryMockSetPinPolicyResults contains results of the PinRepository.SetPinPolicy
+type PinRepositoryMockSetPinPolicyResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) Optional() *mPinRepositoryMockSetPinPolicy {
+	mmSetPinPolicy.optional = true
+	return mmSetPinPolicy
+}
+
+// Expect sets up expected params for PinRepository.SetPinPolicy
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) Expect(ctx context.Context, params model.SetPinPolicyParams) *mPinRepositoryMockSetPinPolicy {
+	if mmSetPinPolicy.mock.funcSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinRepositoryMock.SetPinPolicy mock is already set by Set")
+	}
+
+	if mmSetPinPolicy.defaultExpectation == nil {
+		mmSetPinPolicy.defaultExpectation = &PinRepositoryMockSetPinPolicyExpectation{}
+	}
+
+	if mmSetPinPolicy.defaultExpectation.paramPtrs != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinRepositoryMock.SetPinPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmSetPinPolicy.defaultExpectation.params = &PinRepositoryMockSetPinPolicyParams{ctx, params}
+	for _, e := range mmSetPinPolicy.expectations {
+		if minimock.Equal(e.params, mmSetPinPolicy.defaultExpectation.params) {
+			mmSetPinPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPinPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmSetPinPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PinRepository.SetPinPolicy
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) ExpectCtxParam1(ctx context.Context) *mPinRepositoryMockSetPinPolicy {
+	if mmSetPinPolicy.mock.funcSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinRepositoryMock.SetPinPolicy mock is already set by Set")
+	}
+
+	if mmSetPinPolicy.defaultExpectation == nil {
+		mmSetPinPolicy.defaultExpectation = &PinRepositoryMockSetPinPolicyExpectation{}
+	}
+
+	if mmSetPinPolicy.defaultExpectation.params != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinRepositoryMock.SetPinPolicy mock is already set by Expect")
+	}
+
+	if mmSetPinPolicy.defaultExpectation.paramPtrs == nil {
+		mmSetPinPolicy.defaultExpectation.paramPtrs = &PinRepositoryMockSetPinPolicyParamPtrs{}
+	}
+	mmSetPinPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetPinPolicy
+}
+
+// ExpectParamsParam2 sets up expected param params for PinRepository.SetPinPolicy
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) ExpectParamsParam2(params model.SetPinPolicyParams) *mPinRepositoryMockSetPinPolicy {
+	if mmSetPinPolicy.mock.funcSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinRepositoryMock.SetPinPolicy mock is already set by Set")
+	}
+
+	if mmSetPinPolicy.defaultExpectation == nil {
+		mmSetPinPolicy.defaultExpectation = &PinRepositoryMockSetPinPolicyExpectation{}
+	}
+
+	if mmSetPinPolicy.defaultExpectation.params != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinRepositoryMock.SetPinPolicy mock is already set by Expect")
+	}
+
+	if mmSetPinPolicy.defaultExpectation.paramPtrs == nil {
+		mmSetPinPolicy.defaultExpectation.paramPtrs = &PinRepositoryMockSetPinPolicyParamPtrs{}
+	}
+	mmSetPinPolicy.defaultExpectation.paramPtrs.params = &params
+
+	return mmSetPinPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PinRepository.SetPinPolicy
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) Inspect(f func(ctx context.Context, params model.SetPinPolicyParams)) *mPinRepositoryMockSetPinPolicy {
+	if mmSetPinPolicy.mock.inspectFuncSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("Inspect function is already set for PinRepositoryMock.SetPinPolicy")
+	}
+
+	mmSetPinPolicy.mock.inspectFuncSetPinPolicy = f
+
+	return mmSetPinPolicy
+}
+
+// Return sets up results that will be returned by PinRepository.SetPinPolicy
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) Return(err error) *PinRepositoryMock {
+	if mmSetPinPolicy.mock.funcSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinRepositoryMock.SetPinPolicy mock is already set by Set")
+	}
+
+	if mmSetPinPolicy.defaultExpectation == nil {
+		mmSetPinPolicy.defaultExpectation = &PinRepositoryMockSetPinPolicyExpectation{mock: mmSetPinPolicy.mock}
+	}
+	mmSetPinPolicy.defaultExpectation.results = &PinRepositoryMockSetPinPolicyResults{err}
+	return mmSetPinPolicy.mock
+}
+
+// Set uses given function f to mock the PinRepository.SetPinPolicy method
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) Set(f func(ctx context.Context, params model.SetPinPolicyParams) (err error)) *PinRepositoryMock {
+	if mmSetPinPolicy.defaultExpectation != nil {
+		mmSetPinPolicy.mock.t.Fatalf("Default expectation is already set for the PinRepository.SetPinPolicy method")
+	}
+
+	if len(mmSetPinPolicy.expectations) > 0 {
+		mmSetPinPolicy.mock.t.Fatalf("Some expectations are already set for the PinRepository.SetPinPolicy method")
+	}
+
+	mmSetPinPolicy.mock.funcSetPinPolicy = f
+	return mmSetPinPolicy.mock
+}
+
+// When sets expectation for the PinRepository.SetPinPolicy which will trigger the result defined by the following
+// Then helper
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) When(ctx context.Context, params model.SetPinPolicyParams) *PinRepositoryMockSetPinPolicyExpectation {
+	if mmSetPinPolicy.mock.funcSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinRepositoryMock.SetPinPolicy mock is already set by Set")
+	}
+
+	expectation := &PinRepositoryMockSetPinPolicyExpectation{
+		mock:   mmSetPinPolicy.mock,
+		params: &PinRepositoryMockSetPinPolicyParams{ctx, params},
+	}
+	mmSetPinPolicy.expectations = append(mmSetPinPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PinRepository.SetPinPolicy return parameters for the expectation previously defined by the When method
+func (e *PinRepositoryMockSetPinPolicyExpectation) Then(err error) *PinRepositoryMock {
+	e.results = &PinRepositoryMockSetPinPolicyResults{err}
+	return e.mock
+}
+
+// Times sets number of times PinRepository.SetPinPolicy should be invoked
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) Times(n uint64) *mPinRepositoryMockSetPinPolicy {
+	if n == 0 {
+		mmSetPinPolicy.mock.t.Fatalf("Times of PinRepositoryMock.SetPinPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetPinPolicy.expectedInvocations, n)
+	return mmSetPinPolicy
+}
+
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) invocationsDone() bool {
+	if len(mmSetPinPolicy.expectations) == 0 && mmSetPinPolicy.defaultExpectation == nil && mmSetPinPolicy.mock.funcSetPinPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPinPolicy.mock.afterSetPinPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPinPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetPinPolicy implements repository.PinRepository
+func (mmSetPinPolicy *PinRepositoryMock) SetPinPolicy(ctx context.Context, params model.SetPinPolicyParams) (err error) {
+	mm_atomic.AddUint64(&mmSetPinPolicy.beforeSetPinPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPinPolicy.afterSetPinPolicyCounter, 1)
+
+	if mmSetPinPolicy.inspectFuncSetPinPolicy != nil {
+		mmSetPinPolicy.inspectFuncSetPinPolicy(ctx, params)
+	}
+
+	mm_params := PinRepositoryMockSetPinPolicyParams{ctx, params}
+
+	// Record call args
+	mmSetPinPolicy.SetPinPolicyMock.mutex.Lock()
+	mmSetPinPolicy.SetPinPolicyMock.callArgs = append(mmSetPinPolicy.SetPinPolicyMock.callArgs, &mm_params)
+	mmSetPinPolicy.SetPinPolicyMock.mutex.Unlock()
+
+	for _, e := range mmSetPinPolicy.SetPinPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetPinPolicy.SetPinPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPinPolicy.SetPinPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPinPolicy.SetPinPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPinPolicy.SetPinPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PinRepositoryMockSetPinPolicyParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetPinPolicy.t.Errorf("PinRepositoryMock.SetPinPolicy got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmSetPinPolicy.t.Errorf("PinRepositoryMock.SetPinPolicy got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetPinPolicy.t.Errorf("PinRepositoryMock.SetPinPolicy got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetPinPolicy.SetPinPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetPinPolicy.t.Fatal("No results are set for the PinRepositoryMock.SetPinPolicy")
+		}
+		return (*mm_results).err
+	}
+	if mmSetPinPolicy.funcSetPinPolicy != nil {
+		return mmSetPinPolicy.funcSetPinPolicy(ctx, params)
+	}
+	mmSetPinPolicy.t.Fatalf("Unexpected call to PinRepositoryMock.SetPinPolicy. %v %v", ctx, params)
+	return
+}
+
+// SetPinPolicyAfterCounter returns a count of finished PinRepositoryMock.SetPinPolicy invocations
+func (mmSetPinPolicy *PinRepositoryMock) SetPinPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPinPolicy.afterSetPinPolicyCounter)
+}
+
+// SetPinPolicyBeforeCounter returns a count of PinRepositoryMock.SetPinPolicy invocations
+func (mmSetPinPolicy *PinRepositoryMock) SetPinPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPinPolicy.beforeSetPinPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PinRepositoryMock.SetPinPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetPinPolicy *mPinRepositoryMockSetPinPolicy) Calls() []*PinRepositoryMockSetPinPolicyParams {
+	mmSetPinPolicy.mutex.RLock()
+
+	argCopy := make([]*PinRepositoryMockSetPinPolicyParams, len(mmSetPinPolicy.callArgs))
+	copy(argCopy, mmSetPinPolicy.callArgs)
+
+	mmSetPinPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetPinPolicyDone returns true if the count of the SetPinPolicy invocations corresponds
+// the number of defined expectations
+func (m *PinRepositoryMock) MinimockSetPinPolicyDone() bool {
+	if m.SetPinPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetPinPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetPinPolicyMock.invocationsDone()
+}
+
+// MinimockSetPinPolicyInspect logs each unmet expectation
+func (m *PinRepositoryMock) MinimockSetPinPolicyInspect() {
+	for _, e := range m.SetPinPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PinRepositoryMock.SetPinPolicy with params: %#v", *e.params)
+		}
+	}
+
+	afterSetPinPolicyCounter := mm_atomic.LoadUint64(&m.afterSetPinPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetPinPolicyMock.defaultExpectation != nil && afterSetPinPolicyCounter < 1 {
+		if m.SetPinPolicyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PinRepositoryMock.SetPinPolicy")
+		} else {
+			m.t.Errorf("Expected call to PinRepositoryMock.SetPinPolicy with params: %#v", *m.SetPinPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetPinPolicy != nil && afterSetPinPolicyCounter < 1 {
+		m.t.Error("Expected call to PinRepositoryMock.SetPinPolicy")
+	}
+
+	if !m.SetPinPolicyMock.invocationsDone() && afterSetPinPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PinRepositoryMock.SetPinPolicy but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPinPolicyMock.expectedInvocations), afterSetPinPolicyCounter)
+	}
+}
+
+type mPinRepositoryMockUnpinMessage struct {
+	optional           bool
+	mock               *PinRepositoryMock
+	defaultExpectation *PinRepositoryMockUnpinMessageExpectation
+	expectations       []*PinRepositoryMockUnpinMessageExpectation
+
+	callArgs []*PinRepositoryMockUnpinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PinRepositoryMockUnpinMessageExpectation specifies expectation struct of the PinRepository.UnpinMessage
+type PinRepositoryMockUnpinMessageExpectation struct {
+	mock      *PinRepositoryMock
+	params    *PinRepositoryMockUnpinMessageParams
+	paramPtrs *PinRepositoryMockUnpinMessageParamPtrs
+	results   *PinRepositoryMockUnpinMessageResults
+	Counter   uint64
+}
+
+// PinRepositoryMockUnpinMessageParams contains parameters of the PinRepository.UnpinMessage
+type PinRepositoryMockUnpinMessageParams struct {
+	ctx    context.Context
+	params model.UnpinMessageParams
+}
+
+// PinRepositoryMockUnpinMessageParamPtrs contains pointers to parameters of the PinRepository.UnpinMessage
+type PinRepositoryMockUnpinMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.UnpinMessageParams
+}
+
+// PinRepositoryMockUnpinMessageResults contains results of the PinRepository.UnpinMessage
+type PinRepositoryMockUnpinMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) Optional() *mPinRepositoryMockUnpinMessage {
+	mmUnpinMessage.optional = true
+	return mmUnpinMessage
+}
+
+// Expect sets up expected params for PinRepository.UnpinMessage
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) Expect(ctx context.Context, params model.UnpinMessageParams) *mPinRepositoryMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinRepositoryMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &PinRepositoryMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinRepositoryMock.UnpinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmUnpinMessage.defaultExpectation.params = &PinRepositoryMockUnpinMessageParams{ctx, params}
+	for _, e := range mmUnpinMessage.expectations {
+		if minimock.Equal(e.params, mmUnpinMessage.defaultExpectation.params) {
+			mmUnpinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnpinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmUnpinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PinRepository.UnpinMessage
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) ExpectCtxParam1(ctx context.Context) *mPinRepositoryMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinRepositoryMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &PinRepositoryMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinRepositoryMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &PinRepositoryMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUnpinMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for PinRepository.UnpinMessage
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) ExpectParamsParam2(params model.UnpinMessageParams) *mPinRepositoryMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinRepositoryMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &PinRepositoryMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinRepositoryMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &PinRepositoryMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmUnpinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the PinRepository.UnpinMessage
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) Inspect(f func(ctx context.Context, params model.UnpinMessageParams)) *mPinRepositoryMockUnpinMessage {
+	if mmUnpinMessage.mock.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("Inspect function is already set for PinRepositoryMock.UnpinMessage")
+	}
+
+	mmUnpinMessage.mock.inspectFuncUnpinMessage = f
+
+	return mmUnpinMessage
+}
+
+// Return sets up results that will be returned by PinRepository.UnpinMessage
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) Return(err error) *PinRepositoryMock {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinRepositoryMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &PinRepositoryMockUnpinMessageExpectation{mock: mmUnpinMessage.mock}
+	}
+	mmUnpinMessage.defaultExpectation.results = &PinRepositoryMockUnpinMessageResults{err}
+	return mmUnpinMessage.mock
+}
+
+// Set uses given function f to mock the PinRepository.UnpinMessage method
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) Set(f func(ctx context.Context, params model.UnpinMessageParams) (err error)) *PinRepositoryMock {
+	if mmUnpinMessage.defaultExpectation != nil {
+		mmUnpinMessage.mock.t.Fatalf("Default expectation is already set for the PinRepository.UnpinMessage method")
+	}
+
+	if len(mmUnpinMessage.expectations) > 0 {
+		mmUnpinMessage.mock.t.Fatalf("Some expectations are already set for the PinRepository.UnpinMessage method")
+	}
+
+	mmUnpinMessage.mock.funcUnpinMessage = f
+	return mmUnpinMessage.mock
+}
+
+// When sets expectation for the PinRepository.UnpinMessage which will trigger the result defined by the following
+// Then helper
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) When(ctx context.Context, params model.UnpinMessageParams) *PinRepositoryMockUnpinMessageExpectation {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinRepositoryMock.UnpinMessage mock is already set by Set")
+	}
+
+	expectation := &PinRepositoryMockUnpinMessageExpectation{
+		mock:   mmUnpinMessage.mock,
+		params: &PinRepositoryMockUnpinMessageParams{ctx, params},
+	}
+	mmUnpinMessage.expectations = append(mmUnpinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PinRepository.UnpinMessage return parameters for the expectation previously defined by the When method
+func (e *PinRepositoryMockUnpinMessageExpectation) Then(err error) *PinRepositoryMock {
+	e.results = &PinRepositoryMockUnpinMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times PinRepository.UnpinMessage should be invoked
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) Times(n uint64) *mPinRepositoryMockUnpinMessage {
+	if n == 0 {
+		mmUnpinMessage.mock.t.Fatalf("Times of PinRepositoryMock.UnpinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUnpinMessage.expectedInvocations, n)
+	return mmUnpinMessage
+}
+
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) invocationsDone() bool {
+	if len(mmUnpinMessage.expectations) == 0 && mmUnpinMessage.defaultExpectation == nil && mmUnpinMessage.mock.funcUnpinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.mock.afterUnpinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UnpinMessage implements repository.PinRepository
+func (mmUnpinMessage *PinRepositoryMock) UnpinMessage(ctx context.Context, params model.UnpinMessageParams) (err error) {
+	mm_atomic.AddUint64(&mmUnpinMessage.beforeUnpinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnpinMessage.afterUnpinMessageCounter, 1)
+
+	if mmUnpinMessage.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.inspectFuncUnpinMessage(ctx, params)
+	}
+
+	mm_params := PinRepositoryMockUnpinMessageParams{ctx, params}
+
+	// Record call args
+	mmUnpinMessage.UnpinMessageMock.mutex.Lock()
+	mmUnpinMessage.UnpinMessageMock.callArgs = append(mmUnpinMessage.UnpinMessageMock.callArgs, &mm_params)
+	mmUnpinMessage.UnpinMessageMock.mutex.Unlock()
+
+	for _, e := range mmUnpinMessage.UnpinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUnpinMessage.UnpinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnpinMessage.UnpinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnpinMessage.UnpinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmUnpinMessage.UnpinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := PinRepositoryMockUnpinMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUnpinMessage.t.Errorf("PinRepositoryMock.UnpinMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUnpinMessage.t.Errorf("PinRepositoryMock.UnpinMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnpinMessage.t.Errorf("PinRepositoryMock.UnpinMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnpinMessage.UnpinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnpinMessage.t.Fatal("No results are set for the PinRepositoryMock.UnpinMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmUnpinMessage.funcUnpinMessage != nil {
+		return mmUnpinMessage.funcUnpinMessage(ctx, params)
+	}
+	mmUnpinMessage.t.Fatalf("Unexpected call to PinRepositoryMock.UnpinMessage. %v %v", ctx, params)
+	return
+}
+
+// UnpinMessageAfterCounter returns a count of finished PinRepositoryMock.UnpinMessage invocations
+func (mmUnpinMessage *PinRepositoryMock) UnpinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.afterUnpinMessageCounter)
+}
+
+// UnpinMessageBeforeCounter returns a count of PinRepositoryMock.UnpinMessage invocations
+func (mmUnpinMessage *PinRepositoryMock) UnpinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.beforeUnpinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to PinRepositoryMock.UnpinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnpinMessage *mPinRepositoryMockUnpinMessage) Calls() []*PinRepositoryMockUnpinMessageParams {
+	mmUnpinMessage.mutex.RLock()
+
+	argCopy := make([]*PinRepositoryMockUnpinMessageParams, len(mmUnpinMessage.callArgs))
+	copy(argCopy, mmUnpinMessage.callArgs)
+
+	mmUnpinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnpinMessageDone returns true if the count of the UnpinMessage invocations corresponds
+// the number of defined expectations
+func (m *PinRepositoryMock) MinimockUnpinMessageDone() bool {
+	if m.UnpinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UnpinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UnpinMessageMock.invocationsDone()
+}
+
+// MinimockUnpinMessageInspect logs each unmet expectation
+func (m *PinRepositoryMock) MinimockUnpinMessageInspect() {
+	for _, e := range m.UnpinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PinRepositoryMock.UnpinMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterUnpinMessageCounter := mm_atomic.LoadUint64(&m.afterUnpinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnpinMessageMock.defaultExpectation != nil && afterUnpinMessageCounter < 1 {
+		if m.UnpinMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PinRepositoryMock.UnpinMessage")
+		} else {
+			m.t.Errorf("Expected call to PinRepositoryMock.UnpinMessage with params: %#v", *m.UnpinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnpinMessage != nil && afterUnpinMessageCounter < 1 {
+		m.t.Error("Expected call to PinRepositoryMock.UnpinMessage")
+	}
+
+	if !m.UnpinMessageMock.invocationsDone() && afterUnpinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to PinRepositoryMock.UnpinMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.UnpinMessageMock.expectedInvocations), afterUnpinMessageCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PinRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetPinPolicyInspect()
+
+			m.MinimockListPinnedMessagesInspect()
+
+			m.MinimockPinMessageInspect()
+
+			m.MinimockSetPinPolicyInspect()
+
+			m.MinimockUnpinMessageInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PinRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PinRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetPinPolicyDone() &&
+		m.MinimockListPinnedMessagesDone() &&
+		m.MinimockPinMessageDone() &&
+		m.MinimockSetPinPolicyDone() &&
+		m.MinimockUnpinMessageDone()
+}
@@ -0,0 +1,1392 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.PollRepository -o poll_repository_minimock.go -n PollRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PollRepositoryMock implements repository.PollRepository
+type PollRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClosePoll          func(ctx context.Context, params model.ClosePollParams) (err error)
+	inspectFuncClosePoll   func(ctx context.Context, params model.ClosePollParams)
+	afterClosePollCounter  uint64
+	beforeClosePollCounter uint64
+	ClosePollMock          mPollRepositoryMockClosePoll
+
+	funcCreatePoll          func(ctx context.Context, params model.CreatePollParams) (pollID int64, err error)
+	inspectFuncCreatePoll   func(ctx context.Context, params model.CreatePollParams)
+	afterCreatePollCounter  uint64
+	beforeCreatePollCounter uint64
+	CreatePollMock          mPollRepositoryMockCreatePoll
+
+	funcGetPoll          func(ctx context.Context, params model.GetPollParams) (poll model.Poll, err error)
+	inspectFuncGetPoll   func(ctx context.Context, params model.GetPollParams)
+	afterGetPollCounter  uint64
+	beforeGetPollCounter uint64
+	GetPollMock          mPollRepositoryMockGetPoll
+
+	funcVote          func(ctx context.Context, params model.VoteParams) (err error)
+	inspectFuncVote   func(ctx context.Context, params model.VoteParams)
+	afterVoteCounter  uint64
+	beforeVoteCounter uint64
+	VoteMock          mPollRepositoryMockVote
+}
+
+// NewPollRepositoryMock returns a mock for repository.PollRepository
+func NewPollRepositoryMock(t minimock.Tester) *PollRepositoryMock {
+	m := &PollRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClosePollMock = mPollRepositoryMockClosePoll{mock: m}
+	m.ClosePollMock.callArgs = []*PollRepositoryMockClosePollParams{}
+
+	m.CreatePollMock = mPollRepositoryMockCreatePoll{mock: m}
+	m.CreatePollMock.callArgs = []*PollRepositoryMockCreatePollParams{}
+
+	m.GetPollMock = mPollRepositoryMockGetPoll{mock: m}
+	m.GetPollMock.callArgs = []*PollRepositoryMockGetPollParams{}
+
+	m.VoteMock = mPollRepositoryMockVote{mock: m}
+	m.VoteMock.callArgs = []*PollRepositoryMockVoteParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPollRepositoryMockClosePoll struct {
+	optional           bool
+	mock               *PollRepositoryMock
+	defaultExpectation *PollRepositoryMockClosePollExpectation
+	expectations       []*PollRepositoryMockClosePollExpectation
+
+	callArgs []*PollRepositoryMockClosePollParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PollRepositoryMockClosePollExpectation specifies expectation struct of the PollRepository.ClosePoll
+type PollRepositoryMockClosePollExpectation struct {
+	mock      *PollRepositoryMock
+	params    *PollRepositoryMockClosePollParams
+	paramPtrs *PollRepositoryMockClosePollParamPtrs
+	results   *PollRepositoryMockClosePollResults
+	Counter   uint64
+}
+
+// PollRepositoryMockClosePollParams contains parameters of the PollRepository.ClosePoll
+type PollRepositoryMockClosePollParams struct {
+	ctx    context.Context
+	params model.ClosePollParams
+}
+
+// PollRepositoryMockClosePollParamPtrs contains pointers to parameters of the PollRepository.ClosePoll
+type PollRepositoryMockClosePollParamPtrs struct {
+	ctx    *context.Context
+	params *model.ClosePollParams
+}
+
+// PollRepositoryMockClosePollResults contains results of the PollRepository.ClosePoll
+type PollRepositoryMockClosePollResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClosePoll *mPollRepositoryMockClosePoll) Optional() *mPollRepositoryMockClosePoll {
+	mmClosePoll.optional = true
+	return mmClosePoll
+}
+
+// Expect sets up expected params for PollRepository.ClosePoll
+func (mmClosePoll *mPollRepositoryMockClosePoll) Expect(ctx context.Context, params model.ClosePollParams) *mPollRepositoryMockClosePoll {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("PollRepositoryMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &PollRepositoryMockClosePollExpectation{}
+	}
+
+	if mmClosePoll.defaultExpectation.paramPtrs != nil {
+		mmClosePoll.mock.t.Fatalf("PollRepositoryMock.ClosePoll mock is already set by ExpectParams functions")
+	}
+
+	mmClosePoll.defaultExpectation.params = &PollRepositoryMockClosePollParams{ctx, params}
+	for _, e := range mmClosePoll.expectations {
+		if minimock.Equal(e.params, mmClosePoll.defaultExpectation.params) {
+			mmClosePoll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClosePoll.defaultExpectation.params)
+		}
+	}
+
+	return mmClosePoll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PollRepository.ClosePoll
+func (mmClosePoll *mPollRepositoryMockClosePoll) ExpectCtxParam1(ctx context.Context) *mPollRepositoryMockClosePoll {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("PollRepositoryMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &PollRepositoryMockClosePollExpectation{}
+	}
+
+	if mmClosePoll.defaultExpectation.params != nil {
+		mmClosePoll.mock.t.Fatalf("PollRepositoryMock.ClosePoll mock is already set by Expect")
+	}
+
+	if mmClosePoll.defaultExpectation.paramPtrs == nil {
+		mmClosePoll.defaultExpectation.paramPtrs = &PollRepositoryMockClosePollParamPtrs{}
+	}
+	mmClosePoll.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmClosePoll
+}
+
+// ExpectParamsParam2 sets up expected param params for PollRepository.ClosePoll
+func (mmClosePoll *mPollRepositoryMockClosePoll) ExpectParamsParam2(params model.ClosePollParams) *mPollRepositoryMockClosePoll {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("PollRepositoryMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &PollRepositoryMockClosePollExpectation{}
+	}
+
+	if mmClosePoll.defaultExpectation.params != nil {
+		mmClosePoll.mock.t.Fatalf("PollRepositoryMock.ClosePoll mock is already set by Expect")
+	}
+
+	if mmClosePoll.defaultExpectation.paramPtrs == nil {
+		mmClosePoll.defaultExpectation.paramPtrs = &PollRepositoryMockClosePollParamPtrs{}
+	}
+	mmClosePoll.defaultExpectation.paramPtrs.params = &params
+
+	return mmClosePoll
+}
+
+// Inspect accepts an inspector function that has same arguments as the PollRepository.ClosePoll
+func (mmClosePoll *mPollRepositoryMockClosePoll) Inspect(f func(ctx context.Context, params model.ClosePollParams)) *mPollRepositoryMockClosePoll {
+	if mmClosePoll.mock.inspectFuncClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("Inspect function is already set for PollRepositoryMock.ClosePoll")
+	}
+
+	mmClosePoll.mock.inspectFuncClosePoll = f
+
+	return mmClosePoll
+}
+
+// Return sets up results that will be returned by PollRepository.ClosePoll
+func (mmClosePoll *mPollRepositoryMockClosePoll) Return(err error) *PollRepositoryMock {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("PollRepositoryMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &PollRepositoryMockClosePollExpectation{mock: mmClosePoll.mock}
+	}
+	mmClosePoll.defaultExpectation.results = &PollRepositoryMockClosePollResults{err}
+	return mmClosePoll.mock
+}
+
+// Set uses given function f to mock the PollRepository.ClosePoll method
+func (mmClosePoll *mPollRepositoryMockClosePoll) Set(f func(ctx context.Context, params model.ClosePollParams) (err error)) *PollRepositoryMock {
+	if mmClosePoll.defaultExpectation != nil {
+		mmClosePoll.mock.t.Fatalf("Default expectation is already set for the PollRepository.ClosePoll method")
+	}
+
+	if len(mmClosePoll.expectations) > 0 {
+		mmClosePoll.mock.t.Fatalf("Some expectations are already set for the PollRepository.ClosePoll method")
+	}
+
+	mmClosePoll.mock.funcClosePoll = f
+	return mmClosePoll.mock
+}
+
+// When sets expectation for the PollRepository.ClosePoll which will trigger the result defined by the following
+// Then helper
+func (mmClosePoll *mPollRepositoryMockClosePoll) When(ctx context.Context, params model.ClosePollParams) *PollRepositoryMockClosePollExpectation {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("PollRepositoryMock.ClosePoll mock is already set by Set")
+	}
+
+	expectation := &PollRepositoryMockClosePollExpectation{
+		mock:   mmClosePoll.mock,
+		params: &PollRepositoryMockClosePollParams{ctx, params},
+	}
+	mmClosePoll.expectations = append(mmClosePoll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PollRepository.ClosePoll return parameters for the expectation previously defined by the When method
+func (e *PollRepositoryMockClosePollExpectation) Then(err error) *PollRepositoryMock {
+	e.results = &PollRepositoryMockClosePollResults{err}
+	return e.mock
+}
+
+// Times sets number of times PollRepository.ClosePoll should be invoked
+func (mmClosePoll *mPollRepositoryMockClosePoll) Times(n uint64) *mPollRepositoryMockClosePoll {
+	if n == 0 {
+		mmClosePoll.mock.t.Fatalf("Times of PollRepositoryMock.ClosePoll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClosePoll.expectedInvocations, n)
+	return mmClosePoll
+}
+
+func (mmClosePoll *mPollRepositoryMockClosePoll) invocationsDone() bool {
+	if len(mmClosePoll.expectations) == 0 && mmClosePoll.defaultExpectation == nil && mmClosePoll.mock.funcClosePoll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClosePoll.mock.afterClosePollCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClosePoll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClosePoll implements repository.PollRepository
+func (mmClosePoll *PollRepositoryMock) ClosePoll(ctx context.Context, params model.ClosePollParams) (err error) {
+	mm_atomic.AddUint64(&mmClosePoll.beforeClosePollCounter, 1)
+	defer mm_atomic.AddUint64(&mmClosePoll.afterClosePollCounter, 1)
+
+	if mmClosePoll.inspectFuncClosePoll != nil {
+		mmClosePoll.inspectFuncClosePoll(ctx, params)
+	}
+
+	mm_params := PollRepositoryMockClosePollParams{ctx, params}
+
+	// Record call args
+	mmClosePoll.ClosePollMock.mutex.Lock()
+	mmClosePoll.ClosePollMock.callArgs = append(mmClosePoll.ClosePollMock.callArgs, &mm_params)
+	mmClosePoll.ClosePollMock.mutex.Unlock()
+
+	for _, e := range mmClosePoll.ClosePollMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmClosePoll.ClosePollMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClosePoll.ClosePollMock.defaultExpectation.Counter, 1)
+		mm_want := mmClosePoll.ClosePollMock.defaultExpectation.params
+		mm_want_ptrs := mmClosePoll.ClosePollMock.defaultExpectation.paramPtrs
+
+		mm_got := PollRepositoryMockClosePollParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClosePoll.t.Errorf("PollRepositoryMock.ClosePoll got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmClosePoll.t.Errorf("PollRepositoryMock.ClosePoll got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClosePoll.t.Errorf("PollRepositoryMock.ClosePoll got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClosePoll.ClosePollMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClosePoll.t.Fatal("No results are set for the PollRepositoryMock.ClosePoll")
+		}
+		return (*mm_results).err
+	}
+	if mmClosePoll.funcClosePoll != nil {
+		return mmClosePoll.funcClosePoll(ctx, params)
+	}
+	mmClosePoll.t.Fatalf("Unexpected call to PollRepositoryMock.ClosePoll. %v %v", ctx, params)
+	return
+}
+
+// ClosePollAfterCounter returns a count of finished PollRepositoryMock.ClosePoll invocations
+func (mmClosePoll *PollRepositoryMock) ClosePollAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClosePoll.afterClosePollCounter)
+}
+
+// ClosePollBeforeCounter returns a count of PollRepositoryMock.ClosePoll invocations
+func (mmClosePoll *PollRepositoryMock) ClosePollBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClosePoll.beforeClosePollCounter)
+}
+
+// Calls returns a list of arguments used in each call to PollRepositoryMock.ClosePoll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClosePoll *mPollRepositoryMockClosePoll) Calls() []*PollRepositoryMockClosePollParams {
+	mmClosePoll.mutex.RLock()
+
+	argCopy := make([]*PollRepositoryMockClosePollParams, len(mmClosePoll.callArgs))
+	copy(argCopy, mmClosePoll.callArgs)
+
+	mmClosePoll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClosePollDone returns true if the count of the ClosePoll invocations corresponds
+// the number of defined expectations
+func (m *PollRepositoryMock) MinimockClosePollDone() bool {
+	if m.ClosePollMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClosePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClosePollMock.invocationsDone()
+}
+
+// MinimockClosePollInspect logs each unmet expectation
+func (m *PollRepositoryMock) MinimockClosePollInspect() {
+	for _, e := range m.ClosePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PollRepositoryMock.ClosePoll with params: %#v", *e.params)
+		}
+	}
+
+	afterClosePollCounter := mm_atomic.LoadUint64(&m.afterClosePollCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClosePollMock.defaultExpectation != nil && afterClosePollCounter < 1 {
+		if m.ClosePollMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PollRepositoryMock.ClosePoll")
+		} else {
+			m.t.Errorf("Expected call to PollRepositoryMock.ClosePoll with params: %#v", *m.ClosePollMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClosePoll != nil && afterClosePollCounter < 1 {
+		m.t.Error("Expected call to PollRepositoryMock.ClosePoll")
+	}
+
+	if !m.ClosePollMock.invocationsDone() && afterClosePollCounter > 0 {
+		m.t.Errorf("Expected %d calls to PollRepositoryMock.ClosePoll but found %d calls",
+			mm_atomic.LoadUint64(&m.ClosePollMock.expectedInvocations), afterClosePollCounter)
+	}
+}
+
+type mPollRepositoryMockCreatePoll struct {
+	optional           bool
+	mock               *PollRepositoryMock
+	defaultExpectation *PollRepositoryMockCreatePollExpectation
+	expectations       []*PollRepositoryMockCreatePollExpectation
+
+	callArgs []*PollRepositoryMockCreatePollParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PollRepositoryMockCreatePollExpectation specifies expectation struct of the PollRepository.CreatePoll
+type PollRepositoryMockCreatePollExpectation struct {
+	mock      *PollRepositoryMock
+	params    *PollRepositoryMockCreatePollParams
+	paramPtrs *PollRepositoryMockCreatePollParamPtrs
+	results   *PollRepositoryMockCreatePollResults
+	Counter   uint64
+}
+
+// PollRepositoryMockCreatePollParams contains parameters of the PollRepository.CreatePoll
+type PollRepositoryMockCreatePollParams struct {
+	ctx    context.Context
+	params model.CreatePollParams
+}
+
+// PollRepositoryMockCreatePollParamPtrs contains pointers to parameters of the PollRepository.CreatePoll
+type PollRepositoryMockCreatePollParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreatePollParams
+}
+
+// PollRepositoryMockCreatePollResults contains results of the PollRepository.CreatePoll
+type PollRepositoryMockCreatePollResults struct {
+	pollID int64
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) Optional() *mPollRepositoryMockCreatePoll {
+	mmCreatePoll.optional = true
+	return mmCreatePoll
+}
+
+// Expect sets up expected params for PollRepository.CreatePoll
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) Expect(ctx context.Context, params model.CreatePollParams) *mPollRepositoryMockCreatePoll {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("PollRepositoryMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &PollRepositoryMockCreatePollExpectation{}
+	}
+
+	if mmCreatePoll.defaultExpectation.paramPtrs != nil {
+		mmCreatePoll.mock.t.Fatalf("PollRepositoryMock.CreatePoll mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePoll.defaultExpectation.params = &PollRepositoryMockCreatePollParams{ctx, params}
+	for _, e := range mmCreatePoll.expectations {
+		if minimock.Equal(e.params, mmCreatePoll.defaultExpectation.params) {
+			mmCreatePoll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePoll.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePoll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PollRepository.CreatePoll
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) ExpectCtxParam1(ctx context.Context) *mPollRepositoryMockCreatePoll {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("PollRepositoryMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &PollRepositoryMockCreatePollExpectation{}
+	}
+
+	if mmCreatePoll.defaultExpectation.params != nil {
+		mmCreatePoll.mock.t.Fatalf("PollRepositoryMock.CreatePoll mock is already set by Expect")
+	}
+
+	if mmCreatePoll.defaultExpectation.paramPtrs == nil {
+		mmCreatePoll.defaultExpectation.paramPtrs = &PollRepositoryMockCreatePollParamPtrs{}
+	}
+	mmCreatePoll.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreatePoll
+}
+
+// ExpectParamsParam2 sets up expected param params for PollRepository.CreatePoll
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) ExpectParamsParam2(params model.CreatePollParams) *mPollRepositoryMockCreatePoll {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("PollRepositoryMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &PollRepositoryMockCreatePollExpectation{}
+	}
+
+	if mmCreatePoll.defaultExpectation.params != nil {
+		mmCreatePoll.mock.t.Fatalf("PollRepositoryMock.CreatePoll mock is already set by Expect")
+	}
+
+	if mmCreatePoll.defaultExpectation.paramPtrs == nil {
+		mmCreatePoll.defaultExpectation.paramPtrs = &PollRepositoryMockCreatePollParamPtrs{}
+	}
+	mmCreatePoll.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreatePoll
+}
+
+// Inspect accepts an inspector function that has same arguments as the PollRepository.CreatePoll
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) Inspect(f func(ctx context.Context, params model.CreatePollParams)) *mPollRepositoryMockCreatePoll {
+	if mmCreatePoll.mock.inspectFuncCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("Inspect function is already set for PollRepositoryMock.CreatePoll")
+	}
+
+	mmCreatePoll.mock.inspectFuncCreatePoll = f
+
+	return mmCreatePoll
+}
+
+// Return sets up results that will be returned by PollRepository.CreatePoll
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) Return(pollID int64, err error) *PollRepositoryMock {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("PollRepositoryMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &PollRepositoryMockCreatePollExpectation{mock: mmCreatePoll.mock}
+	}
+	mmCreatePoll.defaultExpectation.results = &PollRepositoryMockCreatePollResults{pollID, err}
+	return mmCreatePoll.mock
+}
+
+// Set uses given function f to mock the PollRepository.CreatePoll method
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) Set(f func(ctx context.Context, params model.CreatePollParams) (pollID int64, err error)) *PollRepositoryMock {
+	if mmCreatePoll.defaultExpectation != nil {
+		mmCreatePoll.mock.t.Fatalf("Default expectation is already set for the PollRepository.CreatePoll method")
+	}
+
+	if len(mmCreatePoll.expectations) > 0 {
+		mmCreatePoll.mock.t.Fatalf("Some expectations are already set for the PollRepository.CreatePoll method")
+	}
+
+	mmCreatePoll.mock.funcCreatePoll = f
+	return mmCreatePoll.mock
+}
+
+// When sets expectation for the PollRepository.CreatePoll which will trigger the result defined by the following
+// Then helper
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) When(ctx context.Context, params model.CreatePollParams) *PollRepositoryMockCreatePollExpectation {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("PollRepositoryMock.CreatePoll mock is already set by Set")
+	}
+
+	expectation := &PollRepositoryMockCreatePollExpectation{
+		mock:   mmCreatePoll.mock,
+		params: &PollRepositoryMockCreatePollParams{ctx, params},
+	}
+	mmCreatePoll.expectations = append(mmCreatePoll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PollRepository.CreatePoll return parameters for the expectation previously defined by the When method
+func (e *PollRepositoryMockCreatePollExpectation) Then(pollID int64, err error) *PollRepositoryMock {
+	e.results = &PollRepositoryMockCreatePollResults{pollID, err}
+	return e.mock
+}
+
+// Times sets number of times PollRepository.CreatePoll should be invoked
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) Times(n uint64) *mPollRepositoryMockCreatePoll {
+	if n == 0 {
+		mmCreatePoll.mock.t.Fatalf("Times of PollRepositoryMock.CreatePoll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePoll.expectedInvocations, n)
+	return mmCreatePoll
+}
+
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) invocationsDone() bool {
+	if len(mmCreatePoll.expectations) == 0 && mmCreatePoll.defaultExpectation == nil && mmCreatePoll.mock.funcCreatePoll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePoll.mock.afterCreatePollCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePoll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePoll implements repository.PollRepository
+func (mmCreatePoll *PollRepositoryMock) CreatePoll(ctx context.Context, params model.CreatePollParams) (pollID int64, err error) {
+	mm_atomic.AddUint64(&mmCreatePoll.beforeCreatePollCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePoll.afterCreatePollCounter, 1)
+
+	if mmCreatePoll.inspectFuncCreatePoll != nil {
+		mmCreatePoll.inspectFuncCreatePoll(ctx, params)
+	}
+
+	mm_params := PollRepositoryMockCreatePollParams{ctx, params}
+
+	// Record call args
+	mmCreatePoll.CreatePollMock.mutex.Lock()
+	mmCreatePoll.CreatePollMock.callArgs = append(mmCreatePoll.CreatePollMock.callArgs, &mm_params)
+	mmCreatePoll.CreatePollMock.mutex.Unlock()
+
+	for _, e := range mmCreatePoll.CreatePollMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pollID, e.results.err
+		}
+	}
+
+	if mmCreatePoll.CreatePollMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePoll.CreatePollMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePoll.CreatePollMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePoll.CreatePollMock.defaultExpectation.paramPtrs
+
+		mm_got := PollRepositoryMockCreatePollParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePoll.t.Errorf("PollRepositoryMock.CreatePoll got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreatePoll.t.Errorf("PollRepositoryMock.CreatePoll got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePoll.t.Errorf("PollRepositoryMock.CreatePoll got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePoll.CreatePollMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePoll.t.Fatal("No results are set for the PollRepositoryMock.CreatePoll")
+		}
+		return (*mm_results).pollID, (*mm_results).err
+	}
+	if mmCreatePoll.funcCreatePoll != nil {
+		return mmCreatePoll.funcCreatePoll(ctx, params)
+	}
+	mmCreatePoll.t.Fatalf("Unexpected call to PollRepositoryMock.CreatePoll. %v %v", ctx, params)
+	return
+}
+
+// CreatePollAfterCounter returns a count of finished PollRepositoryMock.CreatePoll invocations
+func (mmCreatePoll *PollRepositoryMock) CreatePollAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePoll.afterCreatePollCounter)
+}
+
+// CreatePollBeforeCounter returns a count of PollRepositoryMock.CreatePoll invocations
+func (mmCreatePoll *PollRepositoryMock) CreatePollBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePoll.beforeCreatePollCounter)
+}
+
+// Calls returns a list of arguments used in each call to PollRepositoryMock.CreatePoll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePoll *mPollRepositoryMockCreatePoll) Calls() []*PollRepositoryMockCreatePollParams {
+	mmCreatePoll.mutex.RLock()
+
+	argCopy := make([]*PollRepositoryMockCreatePollParams, len(mmCreatePoll.callArgs))
+	copy(argCopy, mmCreatePoll.callArgs)
+
+	mmCreatePoll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePollDone returns true if the count of the CreatePoll invocations corresponds
+// the number of defined expectations
+func (m *PollRepositoryMock) MinimockCreatePollDone() bool {
+	if m.CreatePollMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePollMock.invocationsDone()
+}
+
+// MinimockCreatePollInspect logs each unmet expectation
+func (m *PollRepositoryMock) MinimockCreatePollInspect() {
+	for _, e := range m.CreatePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PollRepositoryMock.CreatePoll with params: %#v", *e.params)
+		}
+	}
+
+	afterCreatePollCounter := mm_atomic.LoadUint64(&m.afterCreatePollCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePollMock.defaultExpectation != nil && afterCreatePollCounter < 1 {
+		if m.CreatePollMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PollRepositoryMock.CreatePoll")
+		} else {
+			m.t.Errorf("Expected call to PollRepositoryMock.CreatePoll with params: %#v", *m.CreatePollMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePoll != nil && afterCreatePollCounter < 1 {
+		m.t.Error("Expected call to PollRepositoryMock.CreatePoll")
+	}
+
+	if !m.CreatePollMock.invocationsDone() && afterCreatePollCounter > 0 {
+		m.t.Errorf("Expected %d calls to PollRepositoryMock.CreatePoll but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePollMock.expectedInvocations), afterCreatePollCounter)
+	}
+}
+
+type mPollRepositoryMockGetPoll struct {
+	optional           bool
+	mock               *PollRepositoryMock
+	defaultExpectation *PollRepositoryMockGetPollExpectation
+	expectations       []*PollRepositoryMockGetPollExpectation
+
+	callArgs []*PollRepositoryMockGetPollParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PollRepositoryMockGetPollExpectation specifies expectation struct of the PollRepository.GetPoll
+type PollRepositoryMockGetPollExpectation struct {
+	mock      *PollRepositoryMock
+	params    *PollRepositoryMockGetPollParams
+	paramPtrs *PollRepositoryMockGetPollParamPtrs
+	results   *PollRepositoryMockGetPollResults
+	Counter   uint64
+}
+
+// PollRepositoryMockGetPollParams contains parameters of the PollRepository.GetPoll
+type PollRepositoryMockGetPollParams struct {
+	ctx    context.Context
+	params model.GetPollParams
+}
+
+// PollRepositoryMockGetPollParamPtrs contains pointers to parameters of the PollRepository.GetPoll
+type PollRepositoryMockGetPollParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetPollParams
+}
+
+// PollRepositoryMockGetPollResults contains results of the PollRepository.GetPoll
+type PollRepositoryMockGetPollResults struct {
+	poll model.Poll
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPoll *mPollRepositoryMockGetPoll) Optional() *mPollRepositoryMockGetPoll {
+	mmGetPoll.optional = true
+	return mmGetPoll
+}
+
+// Expect sets up expected params for PollRepository.GetPoll
+func (mmGetPoll *mPollRepositoryMockGetPoll) Expect(ctx context.Context, params model.GetPollParams) *mPollRepositoryMockGetPoll {
+	if mmGetPoll.mock.funcGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("PollRepositoryMock.GetPoll mock is already set by Set")
+	}
+
+	if mmGetPoll.defaultExpectation == nil {
+		mmGetPoll.defaultExpectation = &PollRepositoryMockGetPollExpectation{}
+	}
+
+	if mmGetPoll.defaultExpectation.paramPtrs != nil {
+		mmGetPoll.mock.t.Fatalf("PollRepositoryMock.GetPoll mock is already set by ExpectParams functions")
+	}
+
+	mmGetPoll.defaultExpectation.params = &PollRepositoryMockGetPollParams{ctx, params}
+	for _, e := range mmGetPoll.expectations {
+		if minimock.Equal(e.params, mmGetPoll.defaultExpectation.params) {
+			mmGetPoll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPoll.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPoll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PollRepository.GetPoll
+func (mmGetPoll *mPollRepositoryMockGetPoll) ExpectCtxParam1(ctx context.Context) *mPollRepositoryMockGetPoll {
+	if mmGetPoll.mock.funcGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("PollRepositoryMock.GetPoll mock is already set by Set")
+	}
+
+	if mmGetPoll.defaultExpectation == nil {
+		mmGetPoll.defaultExpectation = &PollRepositoryMockGetPollExpectation{}
+	}
+
+	if mmGetPoll.defaultExpectation.params != nil {
+		mmGetPoll.mock.t.Fatalf("PollRepositoryMock.GetPoll mock is already set by Expect")
+	}
+
+	if mmGetPoll.defaultExpectation.paramPtrs == nil {
+		mmGetPoll.defaultExpectation.paramPtrs = &PollRepositoryMockGetPollParamPtrs{}
+	}
+	mmGetPoll.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetPoll
+}
+
+// ExpectParamsParam2 sets up expected param params for PollRepository.GetPoll
+func (mmGetPoll *mPollRepositoryMockGetPoll) ExpectParamsParam2(params model.GetPollParams) *mPollRepositoryMockGetPoll {
+	if mmGetPoll.mock.funcGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("PollRepositoryMock.GetPoll mock is already set by Set")
+	}
+
+	if mmGetPoll.defaultExpectation == nil {
+		mmGetPoll.defaultExpectation = &PollRepositoryMockGetPollExpectation{}
+	}
+
+	if mmGetPoll.defaultExpectation.params != nil {
+		mmGetPoll.mock.t.Fatalf("PollRepositoryMock.GetPoll mock is already set by Expect")
+	}
+
+	if mmGetPoll.defaultExpectation.paramPtrs == nil {
+		mmGetPoll.defaultExpectation.paramPtrs = &PollRepositoryMockGetPollParamPtrs{}
+	}
+	mmGetPoll.defaultExpectation.paramPtrs.params = &params
+
+	return mmGetPoll
+}
+
+// Inspect accepts an inspector function that has same arguments as the PollRepository.GetPoll
+func (mmGetPoll *mPollRepositoryMockGetPoll) Inspect(f func(ctx context.Context, params model.GetPollParams)) *mPollRepositoryMockGetPoll {
+	if mmGetPoll.mock.inspectFuncGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("Inspect function is already set for PollRepositoryMock.GetPoll")
+	}
+
+	mmGetPoll.mock.inspectFuncGetPoll = f
+
+	return mmGetPoll
+}
+
+// Return sets up results that will be returned by PollRepository.GetPoll
+func (mmGetPoll *mPollRepositoryMockGetPoll) Return(poll model.Poll, err error) *PollRepositoryMock {
+	if mmGetPoll.mock.funcGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("PollRepositoryMock.GetPoll mock is already set by Set")
+	}
+
+	if mmGetPoll.defaultExpectation == nil {
+		mmGetPoll.defaultExpectation = &PollRepositoryMockGetPollExpectation{mock: mmGetPoll.mock}
+	}
+	mmGetPoll.defaultExpectation.results = &PollRepositoryMockGetPollResults{poll, err}
+	return mmGetPoll.mock
+}
+
+// Set uses given function f to mock the PollRepository.GetPoll method
+func (mmGetPoll *mPollRepositoryMockGetPoll) Set(f func(ctx context.Context, params model.GetPollParams) (poll model.Poll, err error)) *PollRepositoryMock {
+	if mmGetPoll.defaultExpectation != nil {
+		mmGetPoll.mock.t.Fatalf("Default expectation is already set for the PollRepository.GetPoll method")
+	}
+
+	if len(mmGetPoll.expectations) > 0 {
+		mmGetPoll.mock.t.Fatalf("Some expectations are already set for the PollRepository.GetPoll method")
+	}
+
+	mmGetPoll.mock.funcGetPoll = f
+	return mmGetPoll.mock
+}
+
+// When sets expectation for the PollRepository.GetPoll which will trigger the result defined by the following
+// Then helper
+func (mmGetPoll *mPollRepositoryMockGetPoll) When(ctx context.Context, params model.GetPollParams) *PollRepositoryMockGetPollExpectation {
+	if mmGetPoll.mock.funcGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("PollRepositoryMock.GetPoll mock is already set by Set")
+	}
+
+	expectation := &PollRepositoryMockGetPollExpectation{
+		mock:   mmGetPoll.mock,
+		params: &PollRepositoryMockGetPollParams{ctx, params},
+	}
+	mmGetPoll.expectations = append(mmGetPoll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PollRepository.GetPoll return parameters for the expectation previously defined by the When method
+func (e *PollRepositoryMockGetPollExpectation) Then(poll model.Poll, err error) *PollRepositoryMock {
+	e.results = &PollRepositoryMockGetPollResults{poll, err}
+	return e.mock
+}
+
+// Times sets number of times PollRepository.GetPoll should be invoked
+func (mmGetPoll *mPollRepositoryMockGetPoll) Times(n uint64) *mPollRepositoryMockGetPoll {
+	if n == 0 {
+		mmGetPoll.mock.t.Fatalf("Times of PollRepositoryMock.GetPoll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPoll.expectedInvocations, n)
+	return mmGetPoll
+}
+
+func (mmGetPoll *mPollRepositoryMockGetPoll) invocationsDone() bool {
+	if len(mmGetPoll.expectations) == 0 && mmGetPoll.defaultExpectation == nil && mmGetPoll.mock.funcGetPoll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPoll.mock.afterGetPollCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPoll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPoll implements repository.PollRepository
+func (mmGetPoll *PollRepositoryMock) GetPoll(ctx context.Context, params model.GetPollParams) (poll model.Poll, err error) {
+	mm_atomic.AddUint64(&mmGetPoll.beforeGetPollCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPoll.afterGetPollCounter, 1)
+
+	if mmGetPoll.inspectFuncGetPoll != nil {
+		mmGetPoll.inspectFuncGetPoll(ctx, params)
+	}
+
+	mm_params := PollRepositoryMockGetPollParams{ctx, params}
+
+	// Record call args
+	mmGetPoll.GetPollMock.mutex.Lock()
+	mmGetPoll.GetPollMock.callArgs = append(mmGetPoll.GetPollMock.callArgs, &mm_params)
+	mmGetPoll.GetPollMock.mutex.Unlock()
+
+	for _, e := range mmGetPoll.GetPollMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.poll, e.results.err
+		}
+	}
+
+	if mmGetPoll.GetPollMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPoll.GetPollMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPoll.GetPollMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPoll.GetPollMock.defaultExpectation.paramPtrs
+
+		mm_got := PollRepositoryMockGetPollParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPoll.t.Errorf("PollRepositoryMock.GetPoll got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmGetPoll.t.Errorf("PollRepositoryMock.GetPoll got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPoll.t.Errorf("PollRepositoryMock.GetPoll got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPoll.GetPollMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPoll.t.Fatal("No results are set for the PollRepositoryMock.GetPoll")
+		}
+		return (*mm_results).poll, (*mm_results).err
+	}
+	if mmGetPoll.funcGetPoll != nil {
+		return mmGetPoll.funcGetPoll(ctx, params)
+	}
+	mmGetPoll.t.Fatalf("Unexpected call to PollRepositoryMock.GetPoll. %v %v", ctx, params)
+	return
+}
+
+// GetPollAfterCounter returns a count of finished PollRepositoryMock.GetPoll invocations
+func (mmGetPoll *PollRepositoryMock) GetPollAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPoll.afterGetPollCounter)
+}
+
+// GetPollBeforeCounter returns a count of PollRepositoryMock.GetPoll invocations
+func (mmGetPoll *PollRepositoryMock) GetPollBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPoll.beforeGetPollCounter)
+}
+
+// Calls returns a list of arguments used in each call to PollRepositoryMock.GetPoll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPoll *mPollRepositoryMockGetPoll) Calls() []*PollRepositoryMockGetPollParams {
+	mmGetPoll.mutex.RLock()
+
+	argCopy := make([]*PollRepositoryMockGetPollParams, len(mmGetPoll.callArgs))
+	copy(argCopy, mmGetPoll.callArgs)
+
+	mmGetPoll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPollDone returns true if the count of the GetPoll invocations corresponds
+// the number of defined expectations
+func (m *PollRepositoryMock) MinimockGetPollDone() bool {
+	if m.GetPollMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPollMock.invocationsDone()
+}
+
+// MinimockGetPollInspect logs each unmet expectation
+func (m *PollRepositoryMock) MinimockGetPollInspect() {
+	for _, e := range m.GetPollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PollRepositoryMock.GetPoll with params: %#v", *e.params)
+		}
+	}
+
+	afterGetPollCounter := mm_atomic.LoadUint64(&m.afterGetPollCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPollMock.defaultExpectation != nil && afterGetPollCounter < 1 {
+		if m.GetPollMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PollRepositoryMock.GetPoll")
+		} else {
+			m.t.Errorf("Expected call to PollRepositoryMock.GetPoll with params: %#v", *m.GetPollMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPoll != nil && afterGetPollCounter < 1 {
+		m.t.Error("Expected call to PollRepositoryMock.GetPoll")
+	}
+
+	if !m.GetPollMock.invocationsDone() && afterGetPollCounter > 0 {
+		m.t.Errorf("Expected %d calls to PollRepositoryMock.GetPoll but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPollMock.expectedInvocations), afterGetPollCounter)
+	}
+}
+
+type mPollRepositoryMockVote struct {
+	optional           bool
+	mock               *PollRepositoryMock
+	defaultExpectation *PollRepositoryMockVoteExpectation
+	expectations       []*PollRepositoryMockVoteExpectation
+
+	callArgs []*PollRepositoryMockVoteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PollRepositoryMockVoteExpectation specifies expectation struct of the PollRepository.Vote
+type PollRepositoryMockVoteExpectation struct {
+	mock      *PollRepositoryMock
+	params    *PollRepositoryMockVoteParams
+	paramPtrs *PollRepositoryMockVoteParamPtrs
+	results   *PollRepositoryMockVoteResults
+	Counter   uint64
+}
+
+// PollRepositoryMockVoteParams contains parameters of the PollRepository.Vote
+type PollRepositoryMockVoteParams struct {
+	ctx    context.Context
+	params model.VoteParams
+}
+
+// PollRepositoryMockVoteParamPtrs contains pointers to parameters of the PollRepository.Vote
+type PollRepositoryMockVoteParamPtrs struct {
+	ctx    *context.Context
+	params *model.VoteParams
+}
+
+// PollRepositoryMockVoteResults contains results of the PollRepository.Vote
+type PollRepositoryMockVoteResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVote *mPollRepositoryMockVote) Optional() *mPollRepositoryMockVote {
+	mmVote.optional = true
+	return mmVote
+}
+
+// Expect sets up expected params for PollRepository.Vote
+func (mmVote *mPollRepositoryMockVote) Expect(ctx context.Context, params model.VoteParams) *mPollRepositoryMockVote {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("PollRepositoryMock.Vote mock is already set by Set")
+	}
+
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &PollRepositoryMockVoteExpectation{}
+	}
+
+	if mmVote.defaultExpectation.paramPtrs != nil {
+		mmVote.mock.t.Fatalf("PollRepositoryMock.Vote mock is already set by ExpectParams functions")
+	}
+
+	mmVote.defaultExpectation.params = &PollRepositoryMockVoteParams{ctx, params}
+	for _, e := range mmVote.expectations {
+		if minimock.Equal(e.params, mmVote.defaultExpectation.params) {
+			mmVote.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVote.defaultExpectation.params)
+		}
+	}
+
+	return mmVote
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PollRepository.Vote
+func (mmVote *mPollRepositoryMockVote) ExpectCtxParam1(ctx context.Context) *mPollRepositoryMockVote {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("PollRepositoryMock.Vote mock is already set by Set")
+	}
+
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &PollRepositoryMockVoteExpectation{}
+	}
+
+	if mmVote.defaultExpectation.params != nil {
+		mmVote.mock.t.Fatalf("PollRepositoryMock.Vote mock is already set by Expect")
+	}
+
+	if mmVote.defaultExpectation.paramPtrs == nil {
+		mmVote.defaultExpectation.paramPtrs = &PollRepositoryMockVoteParamPtrs{}
+	}
+	mmVote.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmVote
+}
+
+// ExpectParamsParam2 sets up expected param params for PollRepository.Vote
+func (mmVote *mPollRepositoryMockVote) ExpectParamsParam2(params model.VoteParams) *mPollRepositoryMockVote {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("PollRepositoryMock.Vote mock is already set by Set")
+	}
+
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &PollRepositoryMockVoteExpectation{}
+	}
+
+	if mmVote.defaultExpectation.params != nil {
+		mmVote.mock.t.Fatalf("PollRepositoryMock.Vote mock is already set by Expect")
+	}
+
+	if mmVote.defaultExpectation.paramPtrs == nil {
+		mmVote.defaultExpectation.paramPtrs = &PollRepositoryMockVoteParamPtrs{}
+	}
+	mmVote.defaultExpectation.paramPtrs.params = &params
+
+	return mmVote
+}
+
+// Inspect accepts an inspector function that has same arguments as the PollRepository.Vote
+func (mmVote *mPollRepositoryMockVote) Inspect(f func(ctx context.Context, params model.VoteParams)) *mPollRepositoryMockVote {
+	if mmVote.mock.inspectFuncVote != nil {
+		mmVote.mock.t.Fatalf("Inspect function is already set for PollRepositoryMock.Vote")
+	}
+
+	mmVote.mock.inspectFuncVote = f
+
+	return mmVote
+}
+
+// Return sets up results that will be returned by PollRepository.Vote
+func (mmVote *mPollRepositoryMockVote) Return(err error) *PollRepositoryMock {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("PollRepositoryMock.Vote mock is already set by Set")
+	}
+
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &PollRepositoryMockVoteExpectation{mock: mmVote.mock}
+	}
+	mmVote.defaultExpectation.results = &PollRepositoryMockVoteResults{err}
+	return mmVote.mock
+}
+
+// Set uses given function f to mock the PollRepository.Vote method
+func (mmVote *mPollRepositoryMockVote) Set(f func(ctx context.Context, params model.VoteParams) (err error)) *PollRepositoryMock {
+	if mmVote.defaultExpectation != nil {
+		mmVote.mock.t.Fatalf("Default expectation is already set for the PollRepository.Vote method")
+	}
+
+	if len(mmVote.expectations) > 0 {
+		mmVote.mock.t.Fatalf("Some expectations are already set for the PollRepository.Vote method")
+	}
+
+	mmVote.mock.funcVote = f
+	return mmVote.mock
+}
+
+// When sets expectation for the PollRepository.Vote which will trigger the result defined by the following
+// Then helper
+func (mmVote *mPollRepositoryMockVote) When(ctx context.Context, params model.VoteParams) *PollRepositoryMockVoteExpectation {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("PollRepositoryMock.Vote mock is already set by Set")
+	}
+
+	expectation := &PollRepositoryMockVoteExpectation{
+		mock:   mmVote.mock,
+		params: &PollRepositoryMockVoteParams{ctx, params},
+	}
+	mmVote.expectations = append(mmVote.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PollRepository.Vote return parameters for the expectation previously defined by the When method
+func (e *PollRepositoryMockVoteExpectation) Then(err error) *PollRepositoryMock {
+	e.results = &PollRepositoryMockVoteResults{err}
+	return e.mock
+}
+
+// Times sets number of times PollRepository.Vote should be invoked
+func (mmVote *mPollRepositoryMockVote) Times(n uint64) *mPollRepositoryMockVote {
+	if n == 0 {
+		mmVote.mock.t.Fatalf("Times of PollRepositoryMock.Vote mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVote.expectedInvocations, n)
+	return mmVote
+}
+
+func (mmVote *mPollRepositoryMockVote) invocationsDone() bool {
+	if len(mmVote.expectations) == 0 && mmVote.defaultExpectation == nil && mmVote.mock.funcVote == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVote.mock.afterVoteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVote.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Vote implements repository.PollRepository
+func (mmVote *PollRepositoryMock) Vote(ctx context.Context, params model.VoteParams) (err error) {
+	mm_atomic.AddUint64(&mmVote.beforeVoteCounter, 1)
+	defer mm_atomic.AddUint64(&mmVote.afterVoteCounter, 1)
+
+	if mmVote.inspectFuncVote != nil {
+		mmVote.inspectFuncVote(ctx, params)
+	}
+
+	mm_params := PollRepositoryMockVoteParams{ctx, params}
+
+	// Record call args
+	mmVote.VoteMock.mutex.Lock()
+	mmVote.VoteMock.callArgs = append(mmVote.VoteMock.callArgs, &mm_params)
+	mmVote.VoteMock.mutex.Unlock()
+
+	for _, e := range mmVote.VoteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmVote.VoteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVote.VoteMock.defaultExpectation.Counter, 1)
+		mm_want := mmVote.VoteMock.defaultExpectation.params
+		mm_want_ptrs := mmVote.VoteMock.defaultExpectation.paramPtrs
+
+		mm_got := PollRepositoryMockVoteParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVote.t.Errorf("PollRepositoryMock.Vote got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmVote.t.Errorf("PollRepositoryMock.Vote got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVote.t.Errorf("PollRepositoryMock.Vote got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVote.VoteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVote.t.Fatal("No results are set for the PollRepositoryMock.Vote")
+		}
+		return (*mm_results).err
+	}
+	if mmVote.funcVote != nil {
+		return mmVote.funcVote(ctx, params)
+	}
+	mmVote.t.Fatalf("Unexpected call to PollRepositoryMock.Vote. %v %v", ctx, params)
+	return
+}
+
+// VoteAfterCounter returns a count of finished PollRepositoryMock.Vote invocations
+func (mmVote *PollRepositoryMock) VoteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVote.afterVoteCounter)
+}
+
+// VoteBeforeCounter returns a count of PollRepositoryMock.Vote invocations
+func (mmVote *PollRepositoryMock) VoteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVote.beforeVoteCounter)
+}
+
+// Calls returns a list of arguments used in each call to PollRepositoryMock.Vote.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVote *mPollRepositoryMockVote) Calls() []*PollRepositoryMockVoteParams {
+	mmVote.mutex.RLock()
+
+	argCopy := make([]*PollRepositoryMockVoteParams, len(mmVote.callArgs))
+	copy(argCopy, mmVote.callArgs)
+
+	mmVote.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVoteDone returns true if the count of the Vote invocations corresponds
+// the number of defined expectations
+func (m *PollRepositoryMock) MinimockVoteDone() bool {
+	if m.VoteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VoteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VoteMock.invocationsDone()
+}
+
+// MinimockVoteInspect logs each unmet expectation
+func (m *PollRepositoryMock) MinimockVoteInspect() {
+	for _, e := range m.VoteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PollRepositoryMock.Vote with params: %#v", *e.params)
+		}
+	}
+
+	afterVoteCounter := mm_atomic.LoadUint64(&m.afterVoteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VoteMock.defaultExpectation != nil && afterVoteCounter < 1 {
+		if m.VoteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PollRepositoryMock.Vote")
+		} else {
+			m.t.Errorf("Expected call to PollRepositoryMock.Vote with params: %#v", *m.VoteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVote != nil && afterVoteCounter < 1 {
+		m.t.Error("Expected call to PollRepositoryMock.Vote")
+	}
+
+	if !m.VoteMock.invocationsDone() && afterVoteCounter > 0 {
+		m.t.Errorf("Expected %d calls to PollRepositoryMock.Vote but found %d calls",
+			mm_atomic.LoadUint64(&m.VoteMock.expectedInvocations), afterVoteCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PollRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClosePollInspect()
+
+			m.MinimockCreatePollInspect()
+
+			m.MinimockGetPollInspect()
+
+			m.MinimockVoteInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PollRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PollRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClosePollDone() &&
+		m.MinimockCreatePollDone() &&
+		m.MinimockGetPollDone() &&
+		m.MinimockVoteDone()
+}
@@ -0,0 +1,1727 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.PresenceRepository -o presence_repository_minimock.go -n PresenceRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PresenceRepositoryMock implements repository.PresenceRepository
+type PresenceRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeletePresenceSession          func(ctx context.Context, params model.DeletePresenceSessionParams) (err error)
+	inspectFuncDeletePresenceSession   func(ctx context.Context, params model.DeletePresenceSessionParams)
+	afterDeletePresenceSessionCounter  uint64
+	beforeDeletePresenceSessionCounter uint64
+	DeletePresenceSessionMock          mPresenceRepositoryMockDeletePresenceSession
+
+	funcDeleteStalePresenceSessions          func(ctx context.Context, before time.Time) (deleted int64, err error)
+	inspectFuncDeleteStalePresenceSessions   func(ctx context.Context, before time.Time)
+	afterDeleteStalePresenceSessionsCounter  uint64
+	beforeDeleteStalePresenceSessionsCounter uint64
+	DeleteStalePresenceSessionsMock          mPresenceRepositoryMockDeleteStalePresenceSessions
+
+	funcListPresence          func(ctx context.Context, params model.ListPresenceParams) (presences []model.Presence, err error)
+	inspectFuncListPresence   func(ctx context.Context, params model.ListPresenceParams)
+	afterListPresenceCounter  uint64
+	beforeListPresenceCounter uint64
+	ListPresenceMock          mPresenceRepositoryMockListPresence
+
+	funcRefreshPresence          func(ctx context.Context, params model.RefreshPresenceParams) (changes []model.PresenceChange, err error)
+	inspectFuncRefreshPresence   func(ctx context.Context, params model.RefreshPresenceParams)
+	afterRefreshPresenceCounter  uint64
+	beforeRefreshPresenceCounter uint64
+	RefreshPresenceMock          mPresenceRepositoryMockRefreshPresence
+
+	funcTouchPresence          func(ctx context.Context, params model.TouchPresenceParams) (touched int64, err error)
+	inspectFuncTouchPresence   func(ctx context.Context, params model.TouchPresenceParams)
+	afterTouchPresenceCounter  uint64
+	beforeTouchPresenceCounter uint64
+	TouchPresenceMock          mPresenceRepositoryMockTouchPresence
+}
+
+// NewPresenceRepositoryMock returns a mock for repository.PresenceRepository
+func NewPresenceRepositoryMock(t minimock.Tester) *PresenceRepositoryMock {
+	m := &PresenceRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeletePresenceSessionMock = mPresenceRepositoryMockDeletePresenceSession{mock: m}
+	m.DeletePresenceSessionMock.callArgs = []*PresenceRepositoryMockDeletePresenceSessionParams{}
+
+	m.DeleteStalePresenceSessionsMock = mPresenceRepositoryMockDeleteStalePresenceSessions{mock: m}
+	m.DeleteStalePresenceSessionsMock.callArgs = []*PresenceRepositoryMockDeleteStalePresenceSessionsParams{}
+
+	m.ListPresenceMock = mPresenceRepositoryMockListPresence{mock: m}
+	m.ListPresenceMock.callArgs = []*PresenceRepositoryMockListPresenceParams{}
+
+	m.RefreshPresenceMock = mPresenceRepositoryMockRefreshPresence{mock: m}
+	m.RefreshPresenceMock.callArgs = []*PresenceRepositoryMockRefreshPresenceParams{}
+
+	m.TouchPresenceMock = mPresenceRepositoryMockTouchPresence{mock: m}
+	m.TouchPresenceMock.callArgs = []*PresenceRepositoryMockTouchPresenceParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPresenceRepositoryMockDeletePresenceSession struct {
+	optional           bool
+	mock               *PresenceRepositoryMock
+	defaultExpectation *PresenceRepositoryMockDeletePresenceSessionExpectation
+	expectations       []*PresenceRepositoryMockDeletePresenceSessionExpectation
+
+	callArgs []*PresenceRepositoryMockDeletePresenceSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PresenceRepositoryMockDeletePresenceSessionExpectation specifies expectation struct of the PresenceRepository.DeletePresenceSession
+type PresenceRepositoryMockDeletePresenceSessionExpectation struct {
+	mock      *PresenceRepositoryMock
+	params    *PresenceRepositoryMockDeletePresenceSessionParams
+	paramPtrs *PresenceRepositoryMockDeletePresenceSessionParamPtrs
+	results   *PresenceRepositoryMockDeletePresenceSessionResults
+	Counter   uint64
+}
+
+// PresenceRepositoryMockDeletePresenceSessionParams contains parameters of the PresenceRepository.DeletePresenceSession
+type PresenceRepositoryMockDeletePresenceSessionParams struct {
+	ctx    context.Context
+	params model.DeletePresenceSessionParams
+}
+
+// PresenceRepositoryMockDeletePresenceSessionParamPtrs contains pointers to parameters of the PresenceRepository.DeletePresenceSession
+type PresenceRepositoryMockDeletePresenceSessionParamPtrs struct {
+	ctx    *context.Context
+	params *model.DeletePresenceSessionParams
+}
+
+// PresenceRepositoryMockDeletePresenceSessionResults contains results of the PresenceRepository.DeletePresenceSession
+type PresenceRepositoryMockDeletePresenceSessionResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) Optional() *mPresenceRepositoryMockDeletePresenceSession {
+	mmDeletePresenceSession.optional = true
+	return mmDeletePresenceSession
+}
+
+// Expect sets up expected params for PresenceRepository.DeletePresenceSession
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) Expect(ctx context.Context, params model.DeletePresenceSessionParams) *mPresenceRepositoryMockDeletePresenceSession {
+	if mmDeletePresenceSession.mock.funcDeletePresenceSession != nil {
+		mmDeletePresenceSession.mock.t.Fatalf("PresenceRepositoryMock.DeletePresenceSession mock is already set by Set")
+	}
+
+	if mmDeletePresenceSession.defaultExpectation == nil {
+		mmDeletePresenceSession.defaultExpectation = &PresenceRepositoryMockDeletePresenceSessionExpectation{}
+	}
+
+	if mmDeletePresenceSession.defaultExpectation.paramPtrs != nil {
+		mmDeletePresenceSession.mock.t.Fatalf("PresenceRepositoryMock.DeletePresenceSession mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePresenceSession.defaultExpectation.params = &PresenceRepositoryMockDeletePresenceSessionParams{ctx, params}
+	for _, e := range mmDeletePresenceSession.expectations {
+		if minimock.Equal(e.params, mmDeletePresenceSession.defaultExpectation.params) {
+			mmDeletePresenceSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePresenceSession.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePresenceSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PresenceRepository.DeletePresenceSession
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) ExpectCtxParam1(ctx context.Context) *mPresenceRepositoryMockDeletePresenceSession {
+	if mmDeletePresenceSession.mock.funcDeletePresenceSession != nil {
+		mmDeletePresenceSession.mock.t.Fatalf("PresenceRepositoryMock.DeletePresenceSession mock is already set by Set")
+	}
+
+	if mmDeletePresenceSession.defaultExpectation == nil {
+		mmDeletePresenceSession.defaultExpectation = &PresenceRepositoryMockDeletePresenceSessionExpectation{}
+	}
+
+	if mmDeletePresenceSession.defaultExpectation.params != nil {
+		mmDeletePresenceSession.mock.t.Fatalf("PresenceRepositoryMock.DeletePresenceSession mock is already set by Expect")
+	}
+
+	if mmDeletePresenceSession.defaultExpectation.paramPtrs == nil {
+		mmDeletePresenceSession.defaultExpectation.paramPtrs = &PresenceRepositoryMockDeletePresenceSessionParamPtrs{}
+	}
+	mmDeletePresenceSession.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeletePresenceSession
+}
+
+// ExpectParamsParam2 sets up expected param params for PresenceRepository.DeletePresenceSession
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) ExpectParamsParam2(params model.DeletePresenceSessionParams) *mPresenceRepositoryMockDeletePresenceSession {
+	if mmDeletePresenceSession.mock.funcDeletePresenceSession != nil {
+		mmDeletePresenceSession.mock.t.Fatalf("PresenceRepositoryMock.DeletePresenceSession mock is already set by Set")
+	}
+
+	if mmDeletePresenceSession.defaultExpectation == nil {
+		mmDeletePresenceSession.defaultExpectation = &PresenceRepositoryMockDeletePresenceSessionExpectation{}
+	}
+
+	if mmDeletePresenceSession.defaultExpectation.params != nil {
+		mmDeletePresenceSession.mock.t.Fatalf("PresenceRepositoryMock.DeletePresenceSession mock is already set by Expect")
+	}
+
+	if mmDeletePresenceSession.defaultExpectation.paramPtrs == nil {
+		mmDeletePresenceSession.defaultExpectation.paramPtrs = &PresenceRepositoryMockDeletePresenceSessionParamPtrs{}
+	}
+	mmDeletePresenceSession.defaultExpectation.paramPtrs.params = &params
+
+	return mmDeletePresenceSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the PresenceRepository.DeletePresenceSession
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) Inspect(f func(ctx context.Context, params model.DeletePresenceSessionParams)) *mPresenceRepositoryMockDeletePresenceSession {
+	if mmDeletePresenceSession.mock.inspectFuncDeletePresenceSession != nil {
+		mmDeletePresenceSession.mock.t.Fatalf("Inspect function is already set for PresenceRepositoryMock.DeletePresenceSession")
+	}
+
+	mmDeletePresenceSession.mock.inspectFuncDeletePresenceSession = f
+
+	return mmDeletePresenceSession
+}
+
+// Return sets up results that will be returned by PresenceRepository.DeletePresenceSession
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) Return(err error) *PresenceRepositoryMock {
+	if mmDeletePresenceSession.mock.funcDeletePresenceSession != nil {
+		mmDeletePresenceSession.mock.t.Fatalf("PresenceRepositoryMock.DeletePresenceSession mock is already set by Set")
+	}
+
+	if mmDeletePresenceSession.defaultExpectation == nil {
+		mmDeletePresenceSession.defaultExpectation = &PresenceRepositoryMockDeletePresenceSessionExpectation{mock: mmDeletePresenceSession.mock}
+	}
+	mmDeletePresenceSession.defaultExpectation.results = &PresenceRepositoryMockDeletePresenceSessionResults{err}
+	return mmDeletePresenceSession.mock
+}
+
+// Set uses given function f to mock the PresenceRepository.DeletePresenceSession method
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) Set(f func(ctx context.Context, params model.DeletePresenceSessionParams) (err error)) *PresenceRepositoryMock {
+	if mmDeletePresenceSession.defaultExpectation != nil {
+		mmDeletePresenceSession.mock.t.Fatalf("Default expectation is already set for the PresenceRepository.DeletePresenceSession method")
+	}
+
+	if len(mmDeletePresenceSession.expectations) > 0 {
+		mmDeletePresenceSession.mock.t.Fatalf("Some expectations are already set for the PresenceRepository.DeletePresenceSession method")
+	}
+
+	mmDeletePresenceSession.mock.funcDeletePresenceSession = f
+	return mmDeletePresenceSession.mock
+}
+
+// When sets expectation for the PresenceRepository.DeletePresenceSession which will trigger the result defined by the following
+// Then helper
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) When(ctx context.Context, params model.DeletePresenceSessionParams) *PresenceRepositoryMockDeletePresenceSessionExpectation {
+	if mmDeletePresenceSession.mock.funcDeletePresenceSession != nil {
+		mmDeletePresenceSession.mock.t.Fatalf("PresenceRepositoryMock.DeletePresenceSession mock is already set by Set")
+	}
+
+	expectation := &PresenceRepositoryMockDeletePresenceSessionExpectation{
+		mock:   mmDeletePresenceSession.mock,
+		params: &PresenceRepositoryMockDeletePresenceSessionParams{ctx, params},
+	}
+	mmDeletePresenceSession.expectations = append(mmDeletePresenceSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PresenceRepository.DeletePresenceSession return parameters for the expectation previously defined by the When method
+func (e *PresenceRepositoryMockDeletePresenceSessionExpectation) Then(err error) *PresenceRepositoryMock {
+	e.results = &PresenceRepositoryMockDeletePresenceSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times PresenceRepository.DeletePresenceSession should be invoked
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) Times(n uint64) *mPresenceRepositoryMockDeletePresenceSession {
+	if n == 0 {
+		mmDeletePresenceSession.mock.t.Fatalf("Times of PresenceRepositoryMock.DeletePresenceSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePresenceSession.expectedInvocations, n)
+	return mmDeletePresenceSession
+}
+
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) invocationsDone() bool {
+	if len(mmDeletePresenceSession.expectations) == 0 && mmDeletePresenceSession.defaultExpectation == nil && mmDeletePresenceSession.mock.funcDeletePresenceSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePresenceSession.mock.afterDeletePresenceSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePresenceSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePresenceSession implements repository.PresenceRepository
+func (mmDeletePresenceSession *PresenceRepositoryMock) DeletePresenceSession(ctx context.Context, params model.DeletePresenceSessionParams) (err error) {
+	mm_atomic.AddUint64(&mmDeletePresenceSession.beforeDeletePresenceSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePresenceSession.afterDeletePresenceSessionCounter, 1)
+
+	if mmDeletePresenceSession.inspectFuncDeletePresenceSession != nil {
+		mmDeletePresenceSession.inspectFuncDeletePresenceSession(ctx, params)
+	}
+
+	mm_params := PresenceRepositoryMockDeletePresenceSessionParams{ctx, params}
+
+	// Record call args
+	mmDeletePresenceSession.DeletePresenceSessionMock.mutex.Lock()
+	mmDeletePresenceSession.DeletePresenceSessionMock.callArgs = append(mmDeletePresenceSession.DeletePresenceSessionMock.callArgs, &mm_params)
+	mmDeletePresenceSession.DeletePresenceSessionMock.mutex.Unlock()
+
+	for _, e := range mmDeletePresenceSession.DeletePresenceSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePresenceSession.DeletePresenceSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePresenceSession.DeletePresenceSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePresenceSession.DeletePresenceSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePresenceSession.DeletePresenceSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := PresenceRepositoryMockDeletePresenceSessionParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePresenceSession.t.Errorf("PresenceRepositoryMock.DeletePresenceSession got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmDeletePresenceSession.t.Errorf("PresenceRepositoryMock.DeletePresenceSession got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePresenceSession.t.Errorf("PresenceRepositoryMock.DeletePresenceSession got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePresenceSession.DeletePresenceSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePresenceSession.t.Fatal("No results are set for the PresenceRepositoryMock.DeletePresenceSession")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePresenceSession.funcDeletePresenceSession != nil {
+		return mmDeletePresenceSession.funcDeletePresenceSession(ctx, params)
+	}
+	mmDeletePresenceSession.t.Fatalf("Unexpected call to PresenceRepositoryMock.DeletePresenceSession. %v %v", ctx, params)
+	return
+}
+
+// DeletePresenceSessionAfterCounter returns a count of finished PresenceRepositoryMock.DeletePresenceSession invocations
+func (mmDeletePresenceSession *PresenceRepositoryMock) DeletePresenceSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePresenceSession.afterDeletePresenceSessionCounter)
+}
+
+// DeletePresenceSessionBeforeCounter returns a count of PresenceRepositoryMock.DeletePresenceSession invocations
+func (mmDeletePresenceSession *PresenceRepositoryMock) DeletePresenceSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePresenceSession.beforeDeletePresenceSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to PresenceRepositoryMock.DeletePresenceSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePresenceSession *mPresenceRepositoryMockDeletePresenceSession) Calls() []*PresenceRepositoryMockDeletePresenceSessionParams {
+	mmDeletePresenceSession.mutex.RLock()
+
+	argCopy := make([]*PresenceRepositoryMockDeletePresenceSessionParams, len(mmDeletePresenceSession.callArgs))
+	copy(argCopy, mmDeletePresenceSession.callArgs)
+
+	mmDeletePresenceSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePresenceSessionDone returns true if the count of the DeletePresenceSession invocations corresponds
+// the number of defined expectations
+func (m *PresenceRepositoryMock) MinimockDeletePresenceSessionDone() bool {
+	if m.DeletePresenceSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePresenceSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePresenceSessionMock.invocationsDone()
+}
+
+// MinimockDeletePresenceSessionInspect logs each unmet expectation
+func (m *PresenceRepositoryMock) MinimockDeletePresenceSessionInspect() {
+	for _, e := range m.DeletePresenceSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.DeletePresenceSession with params: %#v", *e.params)
+		}
+	}
+
+	afterDeletePresenceSessionCounter := mm_atomic.LoadUint64(&m.afterDeletePresenceSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePresenceSessionMock.defaultExpectation != nil && afterDeletePresenceSessionCounter < 1 {
+		if m.DeletePresenceSessionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PresenceRepositoryMock.DeletePresenceSession")
+		} else {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.DeletePresenceSession with params: %#v", *m.DeletePresenceSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePresenceSession != nil && afterDeletePresenceSessionCounter < 1 {
+		m.t.Error("Expected call to PresenceRepositoryMock.DeletePresenceSession")
+	}
+
+	if !m.DeletePresenceSessionMock.invocationsDone() && afterDeletePresenceSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to PresenceRepositoryMock.DeletePresenceSession but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePresenceSessionMock.expectedInvocations), afterDeletePresenceSessionCounter)
+	}
+}
+
+type mPresenceRepositoryMockDeleteStalePresenceSessions struct {
+	optional           bool
+	mock               *PresenceRepositoryMock
+	defaultExpectation *PresenceRepositoryMockDeleteStalePresenceSessionsExpectation
+	expectations       []*PresenceRepositoryMockDeleteStalePresenceSessionsExpectation
+
+	callArgs []*PresenceRepositoryMockDeleteStalePresenceSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PresenceRepositoryMockDeleteStalePresenceSessionsExpectation specifies expectation struct of the PresenceRepository.DeleteStalePresenceSessions
+type PresenceRepositoryMockDeleteStalePresenceSessionsExpectation struct {
+	mock      *PresenceRepositoryMock
+	params    *PresenceRepositoryMockDeleteStalePresenceSessionsParams
+	paramPtrs *PresenceRepositoryMockDeleteStalePresenceSessionsParamPtrs
+	results   *PresenceRepositoryMockDeleteStalePresenceSessionsResults
+	Counter   uint64
+}
+
+// PresenceRepositoryMockDeleteStalePresenceSessionsParams contains parameters of the PresenceRepository.DeleteStalePresenceSessions
+type PresenceRepositoryMockDeleteStalePresenceSessionsParams struct {
+	ctx    context.Context
+	before time.Time
+}
+
+// PresenceRepositoryMockDeleteStalePresenceSessionsParamPtrs contains pointers to parameters of the PresenceRepository.DeleteStalePresenceSessions
+type PresenceRepositoryMockDeleteStalePresenceSessionsParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+}
+
+// PresenceRepositoryMockDeleteStalePresenceSessionsResults contains results of the PresenceRepository.DeleteStalePresenceSessions
+type PresenceRepositoryMockDeleteStalePresenceSessionsResults struct {
+	deleted int64
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) Optional() *mPresenceRepositoryMockDeleteStalePresenceSessions {
+	mmDeleteStalePresenceSessions.optional = true
+	return mmDeleteStalePresenceSessions
+}
+
+// Expect sets up expected params for PresenceRepository.DeleteStalePresenceSessions
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) Expect(ctx context.Context, before time.Time) *mPresenceRepositoryMockDeleteStalePresenceSessions {
+	if mmDeleteStalePresenceSessions.mock.funcDeleteStalePresenceSessions != nil {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("PresenceRepositoryMock.DeleteStalePresenceSessions mock is already set by Set")
+	}
+
+	if mmDeleteStalePresenceSessions.defaultExpectation == nil {
+		mmDeleteStalePresenceSessions.defaultExpectation = &PresenceRepositoryMockDeleteStalePresenceSessionsExpectation{}
+	}
+
+	if mmDeleteStalePresenceSessions.defaultExpectation.paramPtrs != nil {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("PresenceRepositoryMock.DeleteStalePresenceSessions mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteStalePresenceSessions.defaultExpectation.params = &PresenceRepositoryMockDeleteStalePresenceSessionsParams{ctx, before}
+	for _, e := range mmDeleteStalePresenceSessions.expectations {
+		if minimock.Equal(e.params, mmDeleteStalePresenceSessions.defaultExpectation.params) {
+			mmDeleteStalePresenceSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteStalePresenceSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteStalePresenceSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PresenceRepository.DeleteStalePresenceSessions
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) ExpectCtxParam1(ctx context.Context) *mPresenceRepositoryMockDeleteStalePresenceSessions {
+	if mmDeleteStalePresenceSessions.mock.funcDeleteStalePresenceSessions != nil {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("PresenceRepositoryMock.DeleteStalePresenceSessions mock is already set by Set")
+	}
+
+	if mmDeleteStalePresenceSessions.defaultExpectation == nil {
+		mmDeleteStalePresenceSessions.defaultExpectation = &PresenceRepositoryMockDeleteStalePresenceSessionsExpectation{}
+	}
+
+	if mmDeleteStalePresenceSessions.defaultExpectation.params != nil {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("PresenceRepositoryMock.DeleteStalePresenceSessions mock is already set by Expect")
+	}
+
+	if mmDeleteStalePresenceSessions.defaultExpectation.paramPtrs == nil {
+		mmDeleteStalePresenceSessions.defaultExpectation.paramPtrs = &PresenceRepositoryMockDeleteStalePresenceSessionsParamPtrs{}
+	}
+	mmDeleteStalePresenceSessions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteStalePresenceSessions
+}
+
+// ExpectBeforeParam2 sets up expected param before for PresenceRepository.DeleteStalePresenceSessions
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) ExpectBeforeParam2(before time.Time) *mPresenceRepositoryMockDeleteStalePresenceSessions {
+	if mmDeleteStalePresenceSessions.mock.funcDeleteStalePresenceSessions != nil {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("PresenceRepositoryMock.DeleteStalePresenceSessions mock is already set by Set")
+	}
+
+	if mmDeleteStalePresenceSessions.defaultExpectation == nil {
+		mmDeleteStalePresenceSessions.defaultExpectation = &PresenceRepositoryMockDeleteStalePresenceSessionsExpectation{}
+	}
+
+	if mmDeleteStalePresenceSessions.defaultExpectation.params != nil {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("PresenceRepositoryMock.DeleteStalePresenceSessions mock is already set by Expect")
+	}
+
+	if mmDeleteStalePresenceSessions.defaultExpectation.paramPtrs == nil {
+		mmDeleteStalePresenceSessions.defaultExpectation.paramPtrs = &PresenceRepositoryMockDeleteStalePresenceSessionsParamPtrs{}
+	}
+	mmDeleteStalePresenceSessions.defaultExpectation.paramPtrs.before = &before
+
+	return mmDeleteStalePresenceSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the PresenceRepository.DeleteStalePresenceSessions
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) Inspect(f func(ctx context.Context, before time.Time)) *mPresenceRepositoryMockDeleteStalePresenceSessions {
+	if mmDeleteStalePresenceSessions.mock.inspectFuncDeleteStalePresenceSessions != nil {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("Inspect function is already set for PresenceRepositoryMock.DeleteStalePresenceSessions")
+	}
+
+	mmDeleteStalePresenceSessions.mock.inspectFuncDeleteStalePresenceSessions = f
+
+	return mmDeleteStalePresenceSessions
+}
+
+// Return sets up results that will be returned by PresenceRepository.DeleteStalePresenceSessions
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) Return(deleted int64, err error) *PresenceRepositoryMock {
+	if mmDeleteStalePresenceSessions.mock.funcDeleteStalePresenceSessions != nil {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("PresenceRepositoryMock.DeleteStalePresenceSessions mock is already set by Set")
+	}
+
+	if mmDeleteStalePresenceSessions.defaultExpectation == nil {
+		mmDeleteStalePresenceSessions.defaultExpectation = &PresenceRepositoryMockDeleteStalePresenceSessionsExpectation{mock: mmDeleteStalePresenceSessions.mock}
+	}
+	mmDeleteStalePresenceSessions.defaultExpectation.results = &PresenceRepositoryMockDeleteStalePresenceSessionsResults{deleted, err}
+	return mmDeleteStalePresenceSessions.mock
+}
+
+// Set uses given function f to mock the PresenceRepository.DeleteStalePresenceSessions method
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) Set(f func(ctx context.Context, before time.Time) (deleted int64, err error)) *PresenceRepositoryMock {
+	if mmDeleteStalePresenceSessions.defaultExpectation != nil {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("Default expectation is already set for the PresenceRepository.DeleteStalePresenceSessions method")
+	}
+
+	if len(mmDeleteStalePresenceSessions.expectations) > 0 {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("Some expectations are already set for the PresenceRepository.DeleteStalePresenceSessions method")
+	}
+
+	mmDeleteStalePresenceSessions.mock.funcDeleteStalePresenceSessions = f
+	return mmDeleteStalePresenceSessions.mock
+}
+
+// When sets expectation for the PresenceRepository.DeleteStalePresenceSessions which will trigger the result defined by the following
+// Then helper
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) When(ctx context.Context, before time.Time) *PresenceRepositoryMockDeleteStalePresenceSessionsExpectation {
+	if mmDeleteStalePresenceSessions.mock.funcDeleteStalePresenceSessions != nil {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("PresenceRepositoryMock.DeleteStalePresenceSessions mock is already set by Set")
+	}
+
+	expectation := &PresenceRepositoryMockDeleteStalePresenceSessionsExpectation{
+		mock:   mmDeleteStalePresenceSessions.mock,
+		params: &PresenceRepositoryMockDeleteStalePresenceSessionsParams{ctx, before},
+	}
+	mmDeleteStalePresenceSessions.expectations = append(mmDeleteStalePresenceSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PresenceRepository.DeleteStalePresenceSessions return parameters for the expectation previously defined by the When method
+func (e *PresenceRepositoryMockDeleteStalePresenceSessionsExpectation) Then(deleted int64, err error) *PresenceRepositoryMock {
+	e.results = &PresenceRepositoryMockDeleteStalePresenceSessionsResults{deleted, err}
+	return e.mock
+}
+
+// Times sets number of times PresenceRepository.DeleteStalePresenceSessions should be invoked
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) Times(n uint64) *mPresenceRepositoryMockDeleteStalePresenceSessions {
+	if n == 0 {
+		mmDeleteStalePresenceSessions.mock.t.Fatalf("Times of PresenceRepositoryMock.DeleteStalePresenceSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteStalePresenceSessions.expectedInvocations, n)
+	return mmDeleteStalePresenceSessions
+}
+
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) invocationsDone() bool {
+	if len(mmDeleteStalePresenceSessions.expectations) == 0 && mmDeleteStalePresenceSessions.defaultExpectation == nil && mmDeleteStalePresenceSessions.mock.funcDeleteStalePresenceSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteStalePresenceSessions.mock.afterDeleteStalePresenceSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteStalePresenceSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteStalePresenceSessions implements repository.PresenceRepository
+func (mmDeleteStalePresenceSessions *PresenceRepositoryMock) DeleteStalePresenceSessions(ctx context.Context, before time.Time) (deleted int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteStalePresenceSessions.beforeDeleteStalePresenceSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteStalePresenceSessions.afterDeleteStalePresenceSessionsCounter, 1)
+
+	if mmDeleteStalePresenceSessions.inspectFuncDeleteStalePresenceSessions != nil {
+		mmDeleteStalePresenceSessions.inspectFuncDeleteStalePresenceSessions(ctx, before)
+	}
+
+	mm_params := PresenceRepositoryMockDeleteStalePresenceSessionsParams{ctx, before}
+
+	// Record call args
+	mmDeleteStalePresenceSessions.DeleteStalePresenceSessionsMock.mutex.Lock()
+	mmDeleteStalePresenceSessions.DeleteStalePresenceSessionsMock.callArgs = append(mmDeleteStalePresenceSessions.DeleteStalePresenceSessionsMock.callArgs, &mm_params)
+	mmDeleteStalePresenceSessions.DeleteStalePresenceSessionsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteStalePresenceSessions.DeleteStalePresenceSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.deleted, e.results.err
+		}
+	}
+
+	if mmDeleteStalePresenceSessions.DeleteStalePresenceSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteStalePresenceSessions.DeleteStalePresenceSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteStalePresenceSessions.DeleteStalePresenceSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteStalePresenceSessions.DeleteStalePresenceSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := PresenceRepositoryMockDeleteStalePresenceSessionsParams{ctx, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteStalePresenceSessions.t.Errorf("PresenceRepositoryMock.DeleteStalePresenceSessions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmDeleteStalePresenceSessions.t.Errorf("PresenceRepositoryMock.DeleteStalePresenceSessions got unexpected parameter before, want: %#v, got: %#v%s\n", *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteStalePresenceSessions.t.Errorf("PresenceRepositoryMock.DeleteStalePresenceSessions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteStalePresenceSessions.DeleteStalePresenceSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteStalePresenceSessions.t.Fatal("No results are set for the PresenceRepositoryMock.DeleteStalePresenceSessions")
+		}
+		return (*mm_results).deleted, (*mm_results).err
+	}
+	if mmDeleteStalePresenceSessions.funcDeleteStalePresenceSessions != nil {
+		return mmDeleteStalePresenceSessions.funcDeleteStalePresenceSessions(ctx, before)
+	}
+	mmDeleteStalePresenceSessions.t.Fatalf("Unexpected call to PresenceRepositoryMock.DeleteStalePresenceSessions. %v %v", ctx, before)
+	return
+}
+
+// DeleteStalePresenceSessionsAfterCounter returns a count of finished PresenceRepositoryMock.DeleteStalePresenceSessions invocations
+func (mmDeleteStalePresenceSessions *PresenceRepositoryMock) DeleteStalePresenceSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStalePresenceSessions.afterDeleteStalePresenceSessionsCounter)
+}
+
+// DeleteStalePresenceSessionsBeforeCounter returns a count of PresenceRepositoryMock.DeleteStalePresenceSessions invocations
+func (mmDeleteStalePresenceSessions *PresenceRepositoryMock) DeleteStalePresenceSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStalePresenceSessions.beforeDeleteStalePresenceSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PresenceRepositoryMock.DeleteStalePresenceSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteStalePresenceSessions *mPresenceRepositoryMockDeleteStalePresenceSessions) Calls() []*PresenceRepositoryMockDeleteStalePresenceSessionsParams {
+	mmDeleteStalePresenceSessions.mutex.RLock()
+
+	argCopy := make([]*PresenceRepositoryMockDeleteStalePresenceSessionsParams, len(mmDeleteStalePresenceSessions.callArgs))
+	copy(argCopy, mmDeleteStalePresenceSessions.callArgs)
+
+	mmDeleteStalePresenceSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteStalePresenceSessionsDone returns true if the count of the DeleteStalePresenceSessions invocations corresponds
+// the number of defined expectations
+func (m *PresenceRepositoryMock) MinimockDeleteStalePresenceSessionsDone() bool {
+	if m.DeleteStalePresenceSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteStalePresenceSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteStalePresenceSessionsMock.invocationsDone()
+}
+
+// MinimockDeleteStalePresenceSessionsInspect logs each unmet expectation
+func (m *PresenceRepositoryMock) MinimockDeleteStalePresenceSessionsInspect() {
+	for _, e := range m.DeleteStalePresenceSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.DeleteStalePresenceSessions with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteStalePresenceSessionsCounter := mm_atomic.LoadUint64(&m.afterDeleteStalePresenceSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteStalePresenceSessionsMock.defaultExpectation != nil && afterDeleteStalePresenceSessionsCounter < 1 {
+		if m.DeleteStalePresenceSessionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PresenceRepositoryMock.DeleteStalePresenceSessions")
+		} else {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.DeleteStalePresenceSessions with params: %#v", *m.DeleteStalePresenceSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteStalePresenceSessions != nil && afterDeleteStalePresenceSessionsCounter < 1 {
+		m.t.Error("Expected call to PresenceRepositoryMock.DeleteStalePresenceSessions")
+	}
+
+	if !m.DeleteStalePresenceSessionsMock.invocationsDone() && afterDeleteStalePresenceSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PresenceRepositoryMock.DeleteStalePresenceSessions but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteStalePresenceSessionsMock.expectedInvocations), afterDeleteStalePresenceSessionsCounter)
+	}
+}
+
+type mPresenceRepositoryMockListPresence struct {
+	optional           bool
+	mock               *PresenceRepositoryMock
+	defaultExpectation *PresenceRepositoryMockListPresenceExpectation
+	expectations       []*PresenceRepositoryMockListPresenceExpectation
+
+	callArgs []*PresenceRepositoryMockListPresenceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PresenceRepositoryMockListPresenceExpectation specifies expectation struct of the PresenceRepository.ListPresence
+type PresenceRepositoryMockListPresenceExpectation struct {
+	mock      *PresenceRepositoryMock
+	params    *PresenceRepositoryMockListPresenceParams
+	paramPtrs *PresenceRepositoryMockListPresenceParamPtrs
+	results   *PresenceRepositoryMockListPresenceResults
+	Counter   uint64
+}
+
+// PresenceRepositoryMockListPresenceParams contains parameters of the PresenceRepository.ListPresence
+type PresenceRepositoryMockListPresenceParams struct {
+	ctx    context.Context
+	params model.ListPresenceParams
+}
+
+// PresenceRepositoryMockListPresenceParamPtrs contains pointers to parameters of the PresenceRepository.ListPresence
+type PresenceRepositoryMockListPresenceParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListPresenceParams
+}
+
+// PresenceRepositoryMockListPresenceResults contains results of the PresenceRepository.ListPresence
+type PresenceRepositoryMockListPresenceResults struct {
+	presences []model.Presence
+	err       error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPresence *mPresenceRepositoryMockListPresence) Optional() *mPresenceRepositoryMockListPresence {
+	mmListPresence.optional = true
+	return mmListPresence
+}
+
+// Expect sets up expected params for PresenceRepository.ListPresence
+func (mmListPresence *mPresenceRepositoryMockListPresence) Expect(ctx context.Context, params model.ListPresenceParams) *mPresenceRepositoryMockListPresence {
+	if mmListPresence.mock.funcListPresence != nil {
+		mmListPresence.mock.t.Fatalf("PresenceRepositoryMock.ListPresence mock is already set by Set")
+	}
+
+	if mmListPresence.defaultExpectation == nil {
+		mmListPresence.defaultExpectation = &PresenceRepositoryMockListPresenceExpectation{}
+	}
+
+	if mmListPresence.defaultExpectation.paramPtrs != nil {
+		mmListPresence.mock.t.Fatalf("PresenceRepositoryMock.ListPresence mock is already set by ExpectParams functions")
+	}
+
+	mmListPresence.defaultExpectation.params = &PresenceRepositoryMockListPresenceParams{ctx, params}
+	for _, e := range mmListPresence.expectations {
+		if minimock.Equal(e.params, mmListPresence.defaultExpectation.params) {
+			mmListPresence.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPresence.defaultExpectation.params)
+		}
+	}
+
+	return mmListPresence
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PresenceRepository.ListPresence
+func (mmListPresence *mPresenceRepositoryMockListPresence) ExpectCtxParam1(ctx context.Context) *mPresenceRepositoryMockListPresence {
+	if mmListPresence.mock.funcListPresence != nil {
+		mmListPresence.mock.t.Fatalf("PresenceRepositoryMock.ListPresence mock is already set by Set")
+	}
+
+	if mmListPresence.defaultExpectation == nil {
+		mmListPresence.defaultExpectation = &PresenceRepositoryMockListPresenceExpectation{}
+	}
+
+	if mmListPresence.defaultExpectation.params != nil {
+		mmListPresence.mock.t.Fatalf("PresenceRepositoryMock.ListPresence mock is already set by Expect")
+	}
+
+	if mmListPresence.defaultExpectation.paramPtrs == nil {
+		mmListPresence.defaultExpectation.paramPtrs = &PresenceRepositoryMockListPresenceParamPtrs{}
+	}
+	mmListPresence.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPresence
+}
+
+// ExpectParamsParam2 sets up expected param params for PresenceRepository.ListPresence
+func (mmListPresence *mPresenceRepositoryMockListPresence) ExpectParamsParam2(params model.ListPresenceParams) *mPresenceRepositoryMockListPresence {
+	if mmListPresence.mock.funcListPresence != nil {
+		mmListPresence.mock.t.Fatalf("PresenceRepositoryMock.ListPresence mock is already set by Set")
+	}
+
+	if mmListPresence.defaultExpectation == nil {
+		mmListPresence.defaultExpectation = &PresenceRepositoryMockListPresenceExpectation{}
+	}
+
+	if mmListPresence.defaultExpectation.params != nil {
+		mmListPresence.mock.t.Fatalf("PresenceRepositoryMock.ListPresence mock is already set by Expect")
+	}
+
+	if mmListPresence.defaultExpectation.paramPtrs == nil {
+		mmListPresence.defaultExpectation.paramPtrs = &PresenceRepositoryMockListPresenceParamPtrs{}
+	}
+	mmListPresence.defaultExpectation.paramPtrs.params = &params
+
+	return mmListPresence
+}
+
+// Inspect accepts an inspector function that has same arguments as the PresenceRepository.ListPresence
+func (mmListPresence *mPresenceRepositoryMockListPresence) Inspect(f func(ctx context.Context, params model.ListPresenceParams)) *mPresenceRepositoryMockListPresence {
+	if mmListPresence.mock.inspectFuncListPresence != nil {
+		mmListPresence.mock.t.Fatalf("Inspect function is already set for PresenceRepositoryMock.ListPresence")
+	}
+
+	mmListPresence.mock.inspectFuncListPresence = f
+
+	return mmListPresence
+}
+
+// Return sets up results that will be returned by PresenceRepository.ListPresence
+func (mmListPresence *mPresenceRepositoryMockListPresence) Return(presences []model.Presence, err error) *PresenceRepositoryMock {
+	if mmListPresence.mock.funcListPresence != nil {
+		mmListPresence.mock.t.Fatalf("PresenceRepositoryMock.ListPresence mock is already set by Set")
+	}
+
+	if mmListPresence.defaultExpectation == nil {
+		mmListPresence.defaultExpectation = &PresenceRepositoryMockListPresenceExpectation{mock: mmListPresence.mock}
+	}
+	mmListPresence.defaultExpectation.results = &PresenceRepositoryMockListPresenceResults{presences, err}
+	return mmListPresence.mock
+}
+
+// Set uses given function f to mock the PresenceRepository.ListPresence method
+func (mmListPresence *mPresenceRepositoryMockListPresence) Set(f func(ctx context.Context, params model.ListPresenceParams) (presences []model.Presence, err error)) *PresenceRepositoryMock {
+	if mmListPresence.defaultExpectation != nil {
+		mmListPresence.mock.t.Fatalf("Default expectation is already set for the PresenceRepository.ListPresence method")
+	}
+
+	if len(mmListPresence.expectations) > 0 {
+		mmListPresence.mock.t.Fatalf("Some expectations are already set for the PresenceRepository.ListPresence method")
+	}
+
+	mmListPresence.mock.funcListPresence = f
+	return mmListPresence.mock
+}
+
+// When sets expectation for the PresenceRepository.ListPresence which will trigger the result defined by the following
+// Then helper
+func (mmListPresence *mPresenceRepositoryMockListPresence) When(ctx context.Context, params model.ListPresenceParams) *PresenceRepositoryMockListPresenceExpectation {
+	if mmListPresence.mock.funcListPresence != nil {
+		mmListPresence.mock.t.Fatalf("PresenceRepositoryMock.ListPresence mock is already set by Set")
+	}
+
+	expectation := &PresenceRepositoryMockListPresenceExpectation{
+		mock:   mmListPresence.mock,
+		params: &PresenceRepositoryMockListPresenceParams{ctx, params},
+	}
+	mmListPresence.expectations = append(mmListPresence.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PresenceRepository.ListPresence return parameters for the expectation previously defined by the When method
+func (e *PresenceRepositoryMockListPresenceExpectation) Then(presences []model.Presence, err error) *PresenceRepositoryMock {
+	e.results = &PresenceRepositoryMockListPresenceResults{presences, err}
+	return e.mock
+}
+
+// Times sets number of times PresenceRepository.ListPresence should be invoked
+func (mmListPresence *mPresenceRepositoryMockListPresence) Times(n uint64) *mPresenceRepositoryMockListPresence {
+	if n == 0 {
+		mmListPresence.mock.t.Fatalf("Times of PresenceRepositoryMock.ListPresence mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPresence.expectedInvocations, n)
+	return mmListPresence
+}
+
+func (mmListPresence *mPresenceRepositoryMockListPresence) invocationsDone() bool {
+	if len(mmListPresence.expectations) == 0 && mmListPresence.defaultExpectation == nil && mmListPresence.mock.funcListPresence == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPresence.mock.afterListPresenceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPresence.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPresence implements repository.PresenceRepository
+func (mmListPresence *PresenceRepositoryMock) ListPresence(ctx context.Context, params model.ListPresenceParams) (presences []model.Presence, err error) {
+	mm_atomic.AddUint64(&mmListPresence.beforeListPresenceCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPresence.afterListPresenceCounter, 1)
+
+	if mmListPresence.inspectFuncListPresence != nil {
+		mmListPresence.inspectFuncListPresence(ctx, params)
+	}
+
+	mm_params := PresenceRepositoryMockListPresenceParams{ctx, params}
+
+	// Record call args
+	mmListPresence.ListPresenceMock.mutex.Lock()
+	mmListPresence.ListPresenceMock.callArgs = append(mmListPresence.ListPresenceMock.callArgs, &mm_params)
+	mmListPresence.ListPresenceMock.mutex.Unlock()
+
+	for _, e := range mmListPresence.ListPresenceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.presences, e.results.err
+		}
+	}
+
+	if mmListPresence.ListPresenceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPresence.ListPresenceMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPresence.ListPresenceMock.defaultExpectation.params
+		mm_want_ptrs := mmListPresence.ListPresenceMock.defaultExpectation.paramPtrs
+
+		mm_got := PresenceRepositoryMockListPresenceParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPresence.t.Errorf("PresenceRepositoryMock.ListPresence got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListPresence.t.Errorf("PresenceRepositoryMock.ListPresence got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPresence.t.Errorf("PresenceRepositoryMock.ListPresence got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPresence.ListPresenceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPresence.t.Fatal("No results are set for the PresenceRepositoryMock.ListPresence")
+		}
+		return (*mm_results).presences, (*mm_results).err
+	}
+	if mmListPresence.funcListPresence != nil {
+		return mmListPresence.funcListPresence(ctx, params)
+	}
+	mmListPresence.t.Fatalf("Unexpected call to PresenceRepositoryMock.ListPresence. %v %v", ctx, params)
+	return
+}
+
+// ListPresenceAfterCounter returns a count of finished PresenceRepositoryMock.ListPresence invocations
+func (mmListPresence *PresenceRepositoryMock) ListPresenceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPresence.afterListPresenceCounter)
+}
+
+// ListPresenceBeforeCounter returns a count of PresenceRepositoryMock.ListPresence invocations
+func (mmListPresence *PresenceRepositoryMock) ListPresenceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPresence.beforeListPresenceCounter)
+}
+
+// Calls returns a list of arguments used in each call to PresenceRepositoryMock.ListPresence.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPresence *mPresenceRepositoryMockListPresence) Calls() []*PresenceRepositoryMockListPresenceParams {
+	mmListPresence.mutex.RLock()
+
+	argCopy := make([]*PresenceRepositoryMockListPresenceParams, len(mmListPresence.callArgs))
+	copy(argCopy, mmListPresence.callArgs)
+
+	mmListPresence.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPresenceDone returns true if the count of the ListPresence invocations corresponds
+// the number of defined expectations
+func (m *PresenceRepositoryMock) MinimockListPresenceDone() bool {
+	if m.ListPresenceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPresenceMock.invocationsDone()
+}
+
+// MinimockListPresenceInspect logs each unmet expectation
+func (m *PresenceRepositoryMock) MinimockListPresenceInspect() {
+	for _, e := range m.ListPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.ListPresence with params: %#v", *e.params)
+		}
+	}
+
+	afterListPresenceCounter := mm_atomic.LoadUint64(&m.afterListPresenceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPresenceMock.defaultExpectation != nil && afterListPresenceCounter < 1 {
+		if m.ListPresenceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PresenceRepositoryMock.ListPresence")
+		} else {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.ListPresence with params: %#v", *m.ListPresenceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPresence != nil && afterListPresenceCounter < 1 {
+		m.t.Error("Expected call to PresenceRepositoryMock.ListPresence")
+	}
+
+	if !m.ListPresenceMock.invocationsDone() && afterListPresenceCounter > 0 {
+		m.t.Errorf("Expected %d calls to PresenceRepositoryMock.ListPresence but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPresenceMock.expectedInvocations), afterListPresenceCounter)
+	}
+}
+
+type mPresenceRepositoryMockRefreshPresence struct {
+	optional           bool
+	mock               *PresenceRepositoryMock
+	defaultExpectation *PresenceRepositoryMockRefreshPresenceExpectation
+	expectations       []*PresenceRepositoryMockRefreshPresenceExpectation
+
+	callArgs []*PresenceRepositoryMockRefreshPresenceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PresenceRepositoryMockRefreshPresenceExpectation specifies expectation struct of the PresenceRepository.RefreshPresence
+type PresenceRepositoryMockRefreshPresenceExpectation struct {
+	mock      *PresenceRepositoryMock
+	params    *PresenceRepositoryMockRefreshPresenceParams
+	paramPtrs *PresenceRepositoryMockRefreshPresenceParamPtrs
+	results   *PresenceRepositoryMockRefreshPresenceResults
+	Counter   uint64
+}
+
+// PresenceRepositoryMockRefreshPresenceParams contains parameters of the PresenceRepository.RefreshPresence
+type PresenceRepositoryMockRefreshPresenceParams struct {
+	ctx    context.Context
+	params model.RefreshPresenceParams
+}
+
+// PresenceRepositoryMockRefreshPresenceParamPtrs contains pointers to parameters of the PresenceRepository.RefreshPresence
+type PresenceRepositoryMockRefreshPresenceParamPtrs struct {
+	ctx    *context.Context
+	params *model.RefreshPresenceParams
+}
+
+// PresenceRepositoryMockRefreshPresenceResults contains results of the PresenceRepository.RefreshPresence
+type PresenceRepositoryMockRefreshPresenceResults struct {
+	changes []model.PresenceChange
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) Optional() *mPresenceRepositoryMockRefreshPresence {
+	mmRefreshPresence.optional = true
+	return mmRefreshPresence
+}
+
+// Expect sets up expected params for PresenceRepository.RefreshPresence
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) Expect(ctx context.Context, params model.RefreshPresenceParams) *mPresenceRepositoryMockRefreshPresence {
+	if mmRefreshPresence.mock.funcRefreshPresence != nil {
+		mmRefreshPresence.mock.t.Fatalf("PresenceRepositoryMock.RefreshPresence mock is already set by Set")
+	}
+
+	if mmRefreshPresence.defaultExpectation == nil {
+		mmRefreshPresence.defaultExpectation = &PresenceRepositoryMockRefreshPresenceExpectation{}
+	}
+
+	if mmRefreshPresence.defaultExpectation.paramPtrs != nil {
+		mmRefreshPresence.mock.t.Fatalf("PresenceRepositoryMock.RefreshPresence mock is already set by ExpectParams functions")
+	}
+
+	mmRefreshPresence.defaultExpectation.params = &PresenceRepositoryMockRefreshPresenceParams{ctx, params}
+	for _, e := range mmRefreshPresence.expectations {
+		if minimock.Equal(e.params, mmRefreshPresence.defaultExpectation.params) {
+			mmRefreshPresence.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefreshPresence.defaultExpectation.params)
+		}
+	}
+
+	return mmRefreshPresence
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PresenceRepository.RefreshPresence
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) ExpectCtxParam1(ctx context.Context) *mPresenceRepositoryMockRefreshPresence {
+	if mmRefreshPresence.mock.funcRefreshPresence != nil {
+		mmRefreshPresence.mock.t.Fatalf("PresenceRepositoryMock.RefreshPresence mock is already set by Set")
+	}
+
+	if mmRefreshPresence.defaultExpectation == nil {
+		mmRefreshPresence.defaultExpectation = &PresenceRepositoryMockRefreshPresenceExpectation{}
+	}
+
+	if mmRefreshPresence.defaultExpectation.params != nil {
+		mmRefreshPresence.mock.t.Fatalf("PresenceRepositoryMock.RefreshPresence mock is already set by Expect")
+	}
+
+	if mmRefreshPresence.defaultExpectation.paramPtrs == nil {
+		mmRefreshPresence.defaultExpectation.paramPtrs = &PresenceRepositoryMockRefreshPresenceParamPtrs{}
+	}
+	mmRefreshPresence.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRefreshPresence
+}
+
+// ExpectParamsParam2 sets up expected param params for PresenceRepository.RefreshPresence
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) ExpectParamsParam2(params model.RefreshPresenceParams) *mPresenceRepositoryMockRefreshPresence {
+	if mmRefreshPresence.mock.funcRefreshPresence != nil {
+		mmRefreshPresence.mock.t.Fatalf("PresenceRepositoryMock.RefreshPresence mock is already set by Set")
+	}
+
+	if mmRefreshPresence.defaultExpectation == nil {
+		mmRefreshPresence.defaultExpectation = &PresenceRepositoryMockRefreshPresenceExpectation{}
+	}
+
+	if mmRefreshPresence.defaultExpectation.params != nil {
+		mmRefreshPresence.mock.t.Fatalf("PresenceRepositoryMock.RefreshPresence mock is already set by Expect")
+	}
+
+	if mmRefreshPresence.defaultExpectation.paramPtrs == nil {
+		mmRefreshPresence.defaultExpectation.paramPtrs = &PresenceRepositoryMockRefreshPresenceParamPtrs{}
+	}
+	mmRefreshPresence.defaultExpectation.paramPtrs.params = &params
+
+	return mmRefreshPresence
+}
+
+// Inspect accepts an inspector function that has same arguments as the PresenceRepository.RefreshPresence
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) Inspect(f func(ctx context.Context, params model.RefreshPresenceParams)) *mPresenceRepositoryMockRefreshPresence {
+	if mmRefreshPresence.mock.inspectFuncRefreshPresence != nil {
+		mmRefreshPresence.mock.t.Fatalf("Inspect function is already set for PresenceRepositoryMock.RefreshPresence")
+	}
+
+	mmRefreshPresence.mock.inspectFuncRefreshPresence = f
+
+	return mmRefreshPresence
+}
+
+// Return sets up results that will be returned by PresenceRepository.RefreshPresence
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) Return(changes []model.PresenceChange, err error) *PresenceRepositoryMock {
+	if mmRefreshPresence.mock.funcRefreshPresence != nil {
+		mmRefreshPresence.mock.t.Fatalf("PresenceRepositoryMock.RefreshPresence mock is already set by Set")
+	}
+
+	if mmRefreshPresence.defaultExpectation == nil {
+		mmRefreshPresence.defaultExpectation = &PresenceRepositoryMockRefreshPresenceExpectation{mock: mmRefreshPresence.mock}
+	}
+	mmRefreshPresence.defaultExpectation.results = &PresenceRepositoryMockRefreshPresenceResults{changes, err}
+	return mmRefreshPresence.mock
+}
+
+// Set uses given function f to mock the PresenceRepository.RefreshPresence method
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) Set(f func(ctx context.Context, params model.RefreshPresenceParams) (changes []model.PresenceChange, err error)) *PresenceRepositoryMock {
+	if mmRefreshPresence.defaultExpectation != nil {
+		mmRefreshPresence.mock.t.Fatalf("Default expectation is already set for the PresenceRepository.RefreshPresence method")
+	}
+
+	if len(mmRefreshPresence.expectations) > 0 {
+		mmRefreshPresence.mock.t.Fatalf("Some expectations are already set for the PresenceRepository.RefreshPresence method")
+	}
+
+	mmRefreshPresence.mock.funcRefreshPresence = f
+	return mmRefreshPresence.mock
+}
+
+// When sets expectation for the PresenceRepository.RefreshPresence which will trigger the result defined by the following
+// Then helper
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) When(ctx context.Context, params model.RefreshPresenceParams) *PresenceRepositoryMockRefreshPresenceExpectation {
+	if mmRefreshPresence.mock.funcRefreshPresence != nil {
+		mmRefreshPresence.mock.t.Fatalf("PresenceRepositoryMock.RefreshPresence mock is already set by Set")
+	}
+
+	expectation := &PresenceRepositoryMockRefreshPresenceExpectation{
+		mock:   mmRefreshPresence.mock,
+		params: &PresenceRepositoryMockRefreshPresenceParams{ctx, params},
+	}
+	mmRefreshPresence.expectations = append(mmRefreshPresence.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PresenceRepository.RefreshPresence return parameters for the expectation previously defined by the When method
+func (e *PresenceRepositoryMockRefreshPresenceExpectation) Then(changes []model.PresenceChange, err error) *PresenceRepositoryMock {
+	e.results = &PresenceRepositoryMockRefreshPresenceResults{changes, err}
+	return e.mock
+}
+
+// Times sets number of times PresenceRepository.RefreshPresence should be invoked
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) Times(n uint64) *mPresenceRepositoryMockRefreshPresence {
+	if n == 0 {
+		mmRefreshPresence.mock.t.Fatalf("Times of PresenceRepositoryMock.RefreshPresence mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRefreshPresence.expectedInvocations, n)
+	return mmRefreshPresence
+}
+
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) invocationsDone() bool {
+	if len(mmRefreshPresence.expectations) == 0 && mmRefreshPresence.defaultExpectation == nil && mmRefreshPresence.mock.funcRefreshPresence == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRefreshPresence.mock.afterRefreshPresenceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRefreshPresence.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RefreshPresence implements repository.PresenceRepository
+func (mmRefreshPresence *PresenceRepositoryMock) RefreshPresence(ctx context.Context, params model.RefreshPresenceParams) (changes []model.PresenceChange, err error) {
+	mm_atomic.AddUint64(&mmRefreshPresence.beforeRefreshPresenceCounter, 1)
+	defer mm_atomic.AddUint64(&mmRefreshPresence.afterRefreshPresenceCounter, 1)
+
+	if mmRefreshPresence.inspectFuncRefreshPresence != nil {
+		mmRefreshPresence.inspectFuncRefreshPresence(ctx, params)
+	}
+
+	mm_params := PresenceRepositoryMockRefreshPresenceParams{ctx, params}
+
+	// Record call args
+	mmRefreshPresence.RefreshPresenceMock.mutex.Lock()
+	mmRefreshPresence.RefreshPresenceMock.callArgs = append(mmRefreshPresence.RefreshPresenceMock.callArgs, &mm_params)
+	mmRefreshPresence.RefreshPresenceMock.mutex.Unlock()
+
+	for _, e := range mmRefreshPresence.RefreshPresenceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.changes, e.results.err
+		}
+	}
+
+	if mmRefreshPresence.RefreshPresenceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRefreshPresence.RefreshPresenceMock.defaultExpectation.Counter, 1)
+		mm_want := mmRefreshPresence.RefreshPresenceMock.defaultExpectation.params
+		mm_want_ptrs := mmRefreshPresence.RefreshPresenceMock.defaultExpectation.paramPtrs
+
+		mm_got := PresenceRepositoryMockRefreshPresenceParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRefreshPresence.t.Errorf("PresenceRepositoryMock.RefreshPresence got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmRefreshPresence.t.Errorf("PresenceRepositoryMock.RefreshPresence got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRefreshPresence.t.Errorf("PresenceRepositoryMock.RefreshPresence got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRefreshPresence.RefreshPresenceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRefreshPresence.t.Fatal("No results are set for the PresenceRepositoryMock.RefreshPresence")
+		}
+		return (*mm_results).changes, (*mm_results).err
+	}
+	if mmRefreshPresence.funcRefreshPresence != nil {
+		return mmRefreshPresence.funcRefreshPresence(ctx, params)
+	}
+	mmRefreshPresence.t.Fatalf("Unexpected call to PresenceRepositoryMock.RefreshPresence. %v %v", ctx, params)
+	return
+}
+
+// RefreshPresenceAfterCounter returns a count of finished PresenceRepositoryMock.RefreshPresence invocations
+func (mmRefreshPresence *PresenceRepositoryMock) RefreshPresenceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshPresence.afterRefreshPresenceCounter)
+}
+
+// RefreshPresenceBeforeCounter returns a count of PresenceRepositoryMock.RefreshPresence invocations
+func (mmRefreshPresence *PresenceRepositoryMock) RefreshPresenceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshPresence.beforeRefreshPresenceCounter)
+}
+
+// Calls returns a list of arguments used in each call to PresenceRepositoryMock.RefreshPresence.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRefreshPresence *mPresenceRepositoryMockRefreshPresence) Calls() []*PresenceRepositoryMockRefreshPresenceParams {
+	mmRefreshPresence.mutex.RLock()
+
+	argCopy := make([]*PresenceRepositoryMockRefreshPresenceParams, len(mmRefreshPresence.callArgs))
+	copy(argCopy, mmRefreshPresence.callArgs)
+
+	mmRefreshPresence.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRefreshPresenceDone returns true if the count of the RefreshPresence invocations corresponds
+// the number of defined expectations
+func (m *PresenceRepositoryMock) MinimockRefreshPresenceDone() bool {
+	if m.RefreshPresenceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RefreshPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RefreshPresenceMock.invocationsDone()
+}
+
+// MinimockRefreshPresenceInspect logs each unmet expectation
+func (m *PresenceRepositoryMock) MinimockRefreshPresenceInspect() {
+	for _, e := range m.RefreshPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.RefreshPresence with params: %#v", *e.params)
+		}
+	}
+
+	afterRefreshPresenceCounter := mm_atomic.LoadUint64(&m.afterRefreshPresenceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RefreshPresenceMock.defaultExpectation != nil && afterRefreshPresenceCounter < 1 {
+		if m.RefreshPresenceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PresenceRepositoryMock.RefreshPresence")
+		} else {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.RefreshPresence with params: %#v", *m.RefreshPresenceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRefreshPresence != nil && afterRefreshPresenceCounter < 1 {
+		m.t.Error("Expected call to PresenceRepositoryMock.RefreshPresence")
+	}
+
+	if !m.RefreshPresenceMock.invocationsDone() && afterRefreshPresenceCounter > 0 {
+		m.t.Errorf("Expected %d calls to PresenceRepositoryMock.RefreshPresence but found %d calls",
+			mm_atomic.LoadUint64(&m.RefreshPresenceMock.expectedInvocations), afterRefreshPresenceCounter)
+	}
+}
+
+type mPresenceRepositoryMockTouchPresence struct {
+	optional           bool
+	mock               *PresenceRepositoryMock
+	defaultExpectation *PresenceRepositoryMockTouchPresenceExpectation
+	expectations       []*PresenceRepositoryMockTouchPresenceExpectation
+
+	callArgs []*PresenceRepositoryMockTouchPresenceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PresenceRepositoryMockTouchPresenceExpectation specifies expectation struct of the PresenceRepository.TouchPresence
+type PresenceRepositoryMockTouchPresenceExpectation struct {
+	mock      *PresenceRepositoryMock
+	params    *PresenceRepositoryMockTouchPresenceParams
+	paramPtrs *PresenceRepositoryMockTouchPresenceParamPtrs
+	results   *PresenceRepositoryMockTouchPresenceResults
+	Counter   uint64
+}
+
+// PresenceRepositoryMockTouchPresenceParams contains parameters of the PresenceRepository.TouchPresence
+type PresenceRepositoryMockTouchPresenceParams struct {
+	ctx    context.Context
+	params model.TouchPresenceParams
+}
+
+// PresenceRepositoryMockTouchPresenceParamPtrs contains pointers to parameters of the PresenceRepository.TouchPresence
+type PresenceRepositoryMockTouchPresenceParamPtrs struct {
+	ctx    *context.Context
+	params *model.TouchPresenceParams
+}
+
+// PresenceRepositoryMockTouchPresenceResults contains results of the PresenceRepository.TouchPresence
+type PresenceRepositoryMockTouchPresenceResults struct {
+	touched int64
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) Optional() *mPresenceRepositoryMockTouchPresence {
+	mmTouchPresence.optional = true
+	return mmTouchPresence
+}
+
+// Expect sets up expected params for PresenceRepository.TouchPresence
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) Expect(ctx context.Context, params model.TouchPresenceParams) *mPresenceRepositoryMockTouchPresence {
+	if mmTouchPresence.mock.funcTouchPresence != nil {
+		mmTouchPresence.mock.t.Fatalf("PresenceRepositoryMock.TouchPresence mock is already set by Set")
+	}
+
+	if mmTouchPresence.defaultExpectation == nil {
+		mmTouchPresence.defaultExpectation = &PresenceRepositoryMockTouchPresenceExpectation{}
+	}
+
+	if mmTouchPresence.defaultExpectation.paramPtrs != nil {
+		mmTouchPresence.mock.t.Fatalf("PresenceRepositoryMock.TouchPresence mock is already set by ExpectParams functions")
+	}
+
+	mmTouchPresence.defaultExpectation.params = &PresenceRepositoryMockTouchPresenceParams{ctx, params}
+	for _, e := range mmTouchPresence.expectations {
+		if minimock.Equal(e.params, mmTouchPresence.defaultExpectation.params) {
+			mmTouchPresence.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTouchPresence.defaultExpectation.params)
+		}
+	}
+
+	return mmTouchPresence
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PresenceRepository.TouchPresence
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) ExpectCtxParam1(ctx context.Context) *mPresenceRepositoryMockTouchPresence {
+	if mmTouchPresence.mock.funcTouchPresence != nil {
+		mmTouchPresence.mock.t.Fatalf("PresenceRepositoryMock.TouchPresence mock is already set by Set")
+	}
+
+	if mmTouchPresence.defaultExpectation == nil {
+		mmTouchPresence.defaultExpectation = &PresenceRepositoryMockTouchPresenceExpectation{}
+	}
+
+	if mmTouchPresence.defaultExpectation.params != nil {
+		mmTouchPresence.mock.t.Fatalf("PresenceRepositoryMock.TouchPresence mock is already set by Expect")
+	}
+
+	if mmTouchPresence.defaultExpectation.paramPtrs == nil {
+		mmTouchPresence.defaultExpectation.paramPtrs = &PresenceRepositoryMockTouchPresenceParamPtrs{}
+	}
+	mmTouchPresence.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmTouchPresence
+}
+
+// ExpectParamsParam2 sets up expected param params for PresenceRepository.TouchPresence
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) ExpectParamsParam2(params model.TouchPresenceParams) *mPresenceRepositoryMockTouchPresence {
+	if mmTouchPresence.mock.funcTouchPresence != nil {
+		mmTouchPresence.mock.t.Fatalf("PresenceRepositoryMock.TouchPresence mock is already set by Set")
+	}
+
+	if mmTouchPresence.defaultExpectation == nil {
+		mmTouchPresence.defaultExpectation = &PresenceRepositoryMockTouchPresenceExpectation{}
+	}
+
+	if mmTouchPresence.defaultExpectation.params != nil {
+		mmTouchPresence.mock.t.Fatalf("PresenceRepositoryMock.TouchPresence mock is already set by Expect")
+	}
+
+	if mmTouchPresence.defaultExpectation.paramPtrs == nil {
+		mmTouchPresence.defaultExpectation.paramPtrs = &PresenceRepositoryMockTouchPresenceParamPtrs{}
+	}
+	mmTouchPresence.defaultExpectation.paramPtrs.params = &params
+
+	return mmTouchPresence
+}
+
+// Inspect accepts an inspector function that has same arguments as the PresenceRepository.TouchPresence
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) Inspect(f func(ctx context.Context, params model.TouchPresenceParams)) *mPresenceRepositoryMockTouchPresence {
+	if mmTouchPresence.mock.inspectFuncTouchPresence != nil {
+		mmTouchPresence.mock.t.Fatalf("Inspect function is already set for PresenceRepositoryMock.TouchPresence")
+	}
+
+	mmTouchPresence.mock.inspectFuncTouchPresence = f
+
+	return mmTouchPresence
+}
+
+// Return sets up results that will be returned by PresenceRepository.TouchPresence
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) Return(touched int64, err error) *PresenceRepositoryMock {
+	if mmTouchPresence.mock.funcTouchPresence != nil {
+		mmTouchPresence.mock.t.Fatalf("PresenceRepositoryMock.TouchPresence mock is already set by Set")
+	}
+
+	if mmTouchPresence.defaultExpectation == nil {
+		mmTouchPresence.defaultExpectation = &PresenceRepositoryMockTouchPresenceExpectation{mock: mmTouchPresence.mock}
+	}
+	mmTouchPresence.defaultExpectation.results = &PresenceRepositoryMockTouchPresenceResults{touched, err}
+	return mmTouchPresence.mock
+}
+
+// Set uses given function f to mock the PresenceRepository.TouchPresence method
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) Set(f func(ctx context.Context, params model.TouchPresenceParams) (touched int64, err error)) *PresenceRepositoryMock {
+	if mmTouchPresence.defaultExpectation != nil {
+		mmTouchPresence.mock.t.Fatalf("Default expectation is already set for the PresenceRepository.TouchPresence method")
+	}
+
+	if len(mmTouchPresence.expectations) > 0 {
+		mmTouchPresence.mock.t.Fatalf("Some expectations are already set for the PresenceRepository.TouchPresence method")
+	}
+
+	mmTouchPresence.mock.funcTouchPresence = f
+	return mmTouchPresence.mock
+}
+
+// When sets expectation for the PresenceRepository.TouchPresence which will trigger the result defined by the following
+// Then helper
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) When(ctx context.Context, params model.TouchPresenceParams) *PresenceRepositoryMockTouchPresenceExpectation {
+	if mmTouchPresence.mock.funcTouchPresence != nil {
+		mmTouchPresence.mock.t.Fatalf("PresenceRepositoryMock.TouchPresence mock is already set by Set")
+	}
+
+	expectation := &PresenceRepositoryMockTouchPresenceExpectation{
+		mock:   mmTouchPresence.mock,
+		params: &PresenceRepositoryMockTouchPresenceParams{ctx, params},
+	}
+	mmTouchPresence.expectations = append(mmTouchPresence.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PresenceRepository.TouchPresence return parameters for the expectation previously defined by the When method
+func (e *PresenceRepositoryMockTouchPresenceExpectation) Then(touched int64, err error) *PresenceRepositoryMock {
+	e.results = &PresenceRepositoryMockTouchPresenceResults{touched, err}
+	return e.mock
+}
+
+// Times sets number of times PresenceRepository.TouchPresence should be invoked
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) Times(n uint64) *mPresenceRepositoryMockTouchPresence {
+	if n == 0 {
+		mmTouchPresence.mock.t.Fatalf("Times of PresenceRepositoryMock.TouchPresence mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTouchPresence.expectedInvocations, n)
+	return mmTouchPresence
+}
+
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) invocationsDone() bool {
+	if len(mmTouchPresence.expectations) == 0 && mmTouchPresence.defaultExpectation == nil && mmTouchPresence.mock.funcTouchPresence == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTouchPresence.mock.afterTouchPresenceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTouchPresence.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TouchPresence implements repository.PresenceRepository
+func (mmTouchPresence *PresenceRepositoryMock) TouchPresence(ctx context.Context, params model.TouchPresenceParams) (touched int64, err error) {
+	mm_atomic.AddUint64(&mmTouchPresence.beforeTouchPresenceCounter, 1)
+	defer mm_atomic.AddUint64(&mmTouchPresence.afterTouchPresenceCounter, 1)
+
+	if mmTouchPresence.inspectFuncTouchPresence != nil {
+		mmTouchPresence.inspectFuncTouchPresence(ctx, params)
+	}
+
+	mm_params := PresenceRepositoryMockTouchPresenceParams{ctx, params}
+
+	// Record call args
+	mmTouchPresence.TouchPresenceMock.mutex.Lock()
+	mmTouchPresence.TouchPresenceMock.callArgs = append(mmTouchPresence.TouchPresenceMock.callArgs, &mm_params)
+	mmTouchPresence.TouchPresenceMock.mutex.Unlock()
+
+	for _, e := range mmTouchPresence.TouchPresenceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.touched, e.results.err
+		}
+	}
+
+	if mmTouchPresence.TouchPresenceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTouchPresence.TouchPresenceMock.defaultExpectation.Counter, 1)
+		mm_want := mmTouchPresence.TouchPresenceMock.defaultExpectation.params
+		mm_want_ptrs := mmTouchPresence.TouchPresenceMock.defaultExpectation.paramPtrs
+
+		mm_got := PresenceRepositoryMockTouchPresenceParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTouchPresence.t.Errorf("PresenceRepositoryMock.TouchPresence got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmTouchPresence.t.Errorf("PresenceRepositoryMock.TouchPresence got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTouchPresence.t.Errorf("PresenceRepositoryMock.TouchPresence got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTouchPresence.TouchPresenceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTouchPresence.t.Fatal("No results are set for the PresenceRepositoryMock.TouchPresence")
+		}
+		return (*mm_results).touched, (*mm_results).err
+	}
+	if mmTouchPresence.funcTouchPresence != nil {
+		return mmTouchPresence.funcTouchPresence(ctx, params)
+	}
+	mmTouchPresence.t.Fatalf("Unexpected call to PresenceRepositoryMock.TouchPresence. %v %v", ctx, params)
+	return
+}
+
+// TouchPresenceAfterCounter returns a count of finished PresenceRepositoryMock.TouchPresence invocations
+func (mmTouchPresence *PresenceRepositoryMock) TouchPresenceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchPresence.afterTouchPresenceCounter)
+}
+
+// TouchPresenceBeforeCounter returns a count of PresenceRepositoryMock.TouchPresence invocations
+func (mmTouchPresence *PresenceRepositoryMock) TouchPresenceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTouchPresence.beforeTouchPresenceCounter)
+}
+
+// Calls returns a list of arguments used in each call to PresenceRepositoryMock.TouchPresence.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTouchPresence *mPresenceRepositoryMockTouchPresence) Calls() []*PresenceRepositoryMockTouchPresenceParams {
+	mmTouchPresence.mutex.RLock()
+
+	argCopy := make([]*PresenceRepositoryMockTouchPresenceParams, len(mmTouchPresence.callArgs))
+	copy(argCopy, mmTouchPresence.callArgs)
+
+	mmTouchPresence.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTouchPresenceDone returns true if the count of the TouchPresence invocations corresponds
+// the number of defined expectations
+func (m *PresenceRepositoryMock) MinimockTouchPresenceDone() bool {
+	if m.TouchPresenceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TouchPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TouchPresenceMock.invocationsDone()
+}
+
+// MinimockTouchPresenceInspect logs each unmet expectation
+func (m *PresenceRepositoryMock) MinimockTouchPresenceInspect() {
+	for _, e := range m.TouchPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.TouchPresence with params: %#v", *e.params)
+		}
+	}
+
+	afterTouchPresenceCounter := mm_atomic.LoadUint64(&m.afterTouchPresenceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TouchPresenceMock.defaultExpectation != nil && afterTouchPresenceCounter < 1 {
+		if m.TouchPresenceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PresenceRepositoryMock.TouchPresence")
+		} else {
+			m.t.Errorf("Expected call to PresenceRepositoryMock.TouchPresence with params: %#v", *m.TouchPresenceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTouchPresence != nil && afterTouchPresenceCounter < 1 {
+		m.t.Error("Expected call to PresenceRepositoryMock.TouchPresence")
+	}
+
+	if !m.TouchPresenceMock.invocationsDone() && afterTouchPresenceCounter > 0 {
+		m.t.Errorf("Expected %d calls to PresenceRepositoryMock.TouchPresence but found %d calls",
+			mm_atomic.LoadUint64(&m.TouchPresenceMock.expectedInvocations), afterTouchPresenceCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PresenceRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeletePresenceSessionInspect()
+
+			m.MinimockDeleteStalePresenceSessionsInspect()
+
+			m.MinimockListPresenceInspect()
+
+			m.MinimockRefreshPresenceInspect()
+
+			m.MinimockTouchPresenceInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PresenceRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PresenceRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeletePresenceSessionDone() &&
+		m.MinimockDeleteStalePresenceSessionsDone() &&
+		m.MinimockListPresenceDone() &&
+		m.MinimockRefreshPresenceDone() &&
+		m.MinimockTouchPresenceDone()
+}
@@ -0,0 +1,2447 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.ScheduledMessageRepository -o scheduled_message_repository_minimock.go -n ScheduledMessageRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ScheduledMessageRepositoryMock implements repository.ScheduledMessageRepository
+type ScheduledMessageRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCancelScheduledMessage          func(ctx context.Context, params model.CancelScheduledMessageParams) (err error)
+	inspectFuncCancelScheduledMessage   func(ctx context.Context, params model.CancelScheduledMessageParams)
+	afterCancelScheduledMessageCounter  uint64
+	beforeCancelScheduledMessageCounter uint64
+	CancelScheduledMessageMock          mScheduledMessageRepositoryMockCancelScheduledMessage
+
+	funcCreateScheduledMessage          func(ctx context.Context, params model.ScheduleMessageParams) (scheduledMessageID int64, err error)
+	inspectFuncCreateScheduledMessage   func(ctx context.Context, params model.ScheduleMessageParams)
+	afterCreateScheduledMessageCounter  uint64
+	beforeCreateScheduledMessageCounter uint64
+	CreateScheduledMessageMock          mScheduledMessageRepositoryMockCreateScheduledMessage
+
+	funcDeleteFinishedScheduledMessages          func(ctx context.Context, before time.Time) (deleted int64, err error)
+	inspectFuncDeleteFinishedScheduledMessages   func(ctx context.Context, before time.Time)
+	afterDeleteFinishedScheduledMessagesCounter  uint64
+	beforeDeleteFinishedScheduledMessagesCounter uint64
+	DeleteFinishedScheduledMessagesMock          mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages
+
+	funcListDueScheduledMessages          func(ctx context.Context, now time.Time, limit int) (messages []model.ScheduledMessage, err error)
+	inspectFuncListDueScheduledMessages   func(ctx context.Context, now time.Time, limit int)
+	afterListDueScheduledMessagesCounter  uint64
+	beforeListDueScheduledMessagesCounter uint64
+	ListDueScheduledMessagesMock          mScheduledMessageRepositoryMockListDueScheduledMessages
+
+	funcListScheduledMessages          func(ctx context.Context, params model.ListScheduledMessagesParams) (messages []model.ScheduledMessage, err error)
+	inspectFuncListScheduledMessages   func(ctx context.Context, params model.ListScheduledMessagesParams)
+	afterListScheduledMessagesCounter  uint64
+	beforeListScheduledMessagesCounter uint64
+	ListScheduledMessagesMock          mScheduledMessageRepositoryMockListScheduledMessages
+
+	funcMarkScheduledMessageSent          func(ctx context.Context, scheduledMessageID int64, sentAt time.Time) (err error)
+	inspectFuncMarkScheduledMessageSent   func(ctx context.Context, scheduledMessageID int64, sentAt time.Time)
+	afterMarkScheduledMessageSentCounter  uint64
+	beforeMarkScheduledMessageSentCounter uint64
+	MarkScheduledMessageSentMock          mScheduledMessageRepositoryMockMarkScheduledMessageSent
+
+	funcRecordScheduledMessageFailure          func(ctx context.Context, params model.RecordScheduledMessageFailureParams) (err error)
+	inspectFuncRecordScheduledMessageFailure   func(ctx context.Context, params model.RecordScheduledMessageFailureParams)
+	afterRecordScheduledMessageFailureCounter  uint64
+	beforeRecordScheduledMessageFailureCounter uint64
+	RecordScheduledMessageFailureMock          mScheduledMessageRepositoryMockRecordScheduledMessageFailure
+}
+
+// NewScheduledMessageRepositoryMock returns a mock for repository.ScheduledMessageRepository
+func NewScheduledMessageRepositoryMock(t minimock.Tester) *ScheduledMessageRepositoryMock {
+	m := &ScheduledMessageRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CancelScheduledMessageMock = mScheduledMessageRepositoryMockCancelScheduledMessage{mock: m}
+	m.CancelScheduledMessageMock.callArgs = []*ScheduledMessageRepositoryMockCancelScheduledMessageParams{}
+
+	m.CreateScheduledMessageMock = mScheduledMessageRepositoryMockCreateScheduledMessage{mock: m}
+	m.CreateScheduledMessageMock.callArgs = []*ScheduledMessageRepositoryMockCreateScheduledMessageParams{}
+
+	m.DeleteFinishedScheduledMessagesMock = mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages{mock: m}
+	m.DeleteFinishedScheduledMessagesMock.callArgs = []*ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams{}
+
+	m.ListDueScheduledMessagesMock = mScheduledMessageRepositoryMockListDueScheduledMessages{mock: m}
+	m.ListDueScheduledMessagesMock.callArgs = []*ScheduledMessageRepositoryMockListDueScheduledMessagesParams{}
+
+	m.ListScheduledMessagesMock = mScheduledMessageRepositoryMockListScheduledMessages{mock: m}
+	m.ListScheduledMessagesMock.callArgs = []*ScheduledMessageRepositoryMockListScheduledMessagesParams{}
+
+	m.MarkScheduledMessageSentMock = mScheduledMessageRepositoryMockMarkScheduledMessageSent{mock: m}
+	m.MarkScheduledMessageSentMock.callArgs = []*ScheduledMessageRepositoryMockMarkScheduledMessageSentParams{}
+
+	m.RecordScheduledMessageFailureMock = mScheduledMessageRepositoryMockRecordScheduledMessageFailure{mock: m}
+	m.RecordScheduledMessageFailureMock.callArgs = []*ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mScheduledMessageRepositoryMockCancelScheduledMessage struct {
+	optional           bool
+	mock               *ScheduledMessageRepositoryMock
+	defaultExpectation *ScheduledMessageRepositoryMockCancelScheduledMessageExpectation
+	expectations       []*ScheduledMessageRepositoryMockCancelScheduledMessageExpectation
+
+	callArgs []*ScheduledMessageRepositoryMockCancelScheduledMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ScheduledMessageRepositoryMockCancelScheduledMessageExpectation specifies expectation struct of the ScheduledMessageRepository.CancelScheduledMessage
+type ScheduledMessageRepositoryMockCancelScheduledMessageExpectation struct {
+	mock      *ScheduledMessageRepositoryMock
+	params    *ScheduledMessageRepositoryMockCancelScheduledMessageParams
+	paramPtrs *ScheduledMessageRepositoryMockCancelScheduledMessageParamPtrs
+	results   *ScheduledMessageRepositoryMockCancelScheduledMessageResults
+	Counter   uint64
+}
+
+// ScheduledMessageRepositoryMockCancelScheduledMessageParams contains parameters of the ScheduledMessageRepository.CancelScheduledMessage
+type ScheduledMessageRepositoryMockCancelScheduledMessageParams struct {
+	ctx    context.Context
+	params model.CancelScheduledMessageParams
+}
+
+// ScheduledMessageRepositoryMockCancelScheduledMessageParamPtrs contains pointers to parameters of the ScheduledMessageRepository.CancelScheduledMessage
+type ScheduledMessageRepositoryMockCancelScheduledMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.CancelScheduledMessageParams
+}
+
+// ScheduledMessageRepositoryMockCancelScheduledMessageResults contains results of the ScheduledMessageRepository.CancelScheduledMessage
+type ScheduledMessageRepositoryMockCancelScheduledMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) Optional() *mScheduledMessageRepositoryMockCancelScheduledMessage {
+	mmCancelScheduledMessage.optional = true
+	return mmCancelScheduledMessage
+}
+
+// Expect sets up expected params for ScheduledMessageRepository.CancelScheduledMessage
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) Expect(ctx context.Context, params model.CancelScheduledMessageParams) *mScheduledMessageRepositoryMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ScheduledMessageRepositoryMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CancelScheduledMessage mock is already set by ExpectParams functions")
+	}
+
+	mmCancelScheduledMessage.defaultExpectation.params = &ScheduledMessageRepositoryMockCancelScheduledMessageParams{ctx, params}
+	for _, e := range mmCancelScheduledMessage.expectations {
+		if minimock.Equal(e.params, mmCancelScheduledMessage.defaultExpectation.params) {
+			mmCancelScheduledMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancelScheduledMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmCancelScheduledMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledMessageRepository.CancelScheduledMessage
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) ExpectCtxParam1(ctx context.Context) *mScheduledMessageRepositoryMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ScheduledMessageRepositoryMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.params != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CancelScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduledMessage.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockCancelScheduledMessageParamPtrs{}
+	}
+	mmCancelScheduledMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCancelScheduledMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for ScheduledMessageRepository.CancelScheduledMessage
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) ExpectParamsParam2(params model.CancelScheduledMessageParams) *mScheduledMessageRepositoryMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ScheduledMessageRepositoryMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.params != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CancelScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduledMessage.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockCancelScheduledMessageParamPtrs{}
+	}
+	mmCancelScheduledMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmCancelScheduledMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledMessageRepository.CancelScheduledMessage
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) Inspect(f func(ctx context.Context, params model.CancelScheduledMessageParams)) *mScheduledMessageRepositoryMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.inspectFuncCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("Inspect function is already set for ScheduledMessageRepositoryMock.CancelScheduledMessage")
+	}
+
+	mmCancelScheduledMessage.mock.inspectFuncCancelScheduledMessage = f
+
+	return mmCancelScheduledMessage
+}
+
+// Return sets up results that will be returned by ScheduledMessageRepository.CancelScheduledMessage
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) Return(err error) *ScheduledMessageRepositoryMock {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ScheduledMessageRepositoryMockCancelScheduledMessageExpectation{mock: mmCancelScheduledMessage.mock}
+	}
+	mmCancelScheduledMessage.defaultExpectation.results = &ScheduledMessageRepositoryMockCancelScheduledMessageResults{err}
+	return mmCancelScheduledMessage.mock
+}
+
+// Set uses given function f to mock the ScheduledMessageRepository.CancelScheduledMessage method
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) Set(f func(ctx context.Context, params model.CancelScheduledMessageParams) (err error)) *ScheduledMessageRepositoryMock {
+	if mmCancelScheduledMessage.defaultExpectation != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("Default expectation is already set for the ScheduledMessageRepository.CancelScheduledMessage method")
+	}
+
+	if len(mmCancelScheduledMessage.expectations) > 0 {
+		mmCancelScheduledMessage.mock.t.Fatalf("Some expectations are already set for the ScheduledMessageRepository.CancelScheduledMessage method")
+	}
+
+	mmCancelScheduledMessage.mock.funcCancelScheduledMessage = f
+	return mmCancelScheduledMessage.mock
+}
+
+// When sets expectation for the ScheduledMessageRepository.CancelScheduledMessage which will trigger the result defined by the following
+// Then helper
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) When(ctx context.Context, params model.CancelScheduledMessageParams) *ScheduledMessageRepositoryMockCancelScheduledMessageExpectation {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	expectation := &ScheduledMessageRepositoryMockCancelScheduledMessageExpectation{
+		mock:   mmCancelScheduledMessage.mock,
+		params: &ScheduledMessageRepositoryMockCancelScheduledMessageParams{ctx, params},
+	}
+	mmCancelScheduledMessage.expectations = append(mmCancelScheduledMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledMessageRepository.CancelScheduledMessage return parameters for the expectation previously defined by the When method
+func (e *ScheduledMessageRepositoryMockCancelScheduledMessageExpectation) Then(err error) *ScheduledMessageRepositoryMock {
+	e.results = &ScheduledMessageRepositoryMockCancelScheduledMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledMessageRepository.CancelScheduledMessage should be invoked
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) Times(n uint64) *mScheduledMessageRepositoryMockCancelScheduledMessage {
+	if n == 0 {
+		mmCancelScheduledMessage.mock.t.Fatalf("Times of ScheduledMessageRepositoryMock.CancelScheduledMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancelScheduledMessage.expectedInvocations, n)
+	return mmCancelScheduledMessage
+}
+
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) invocationsDone() bool {
+	if len(mmCancelScheduledMessage.expectations) == 0 && mmCancelScheduledMessage.defaultExpectation == nil && mmCancelScheduledMessage.mock.funcCancelScheduledMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancelScheduledMessage.mock.afterCancelScheduledMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancelScheduledMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancelScheduledMessage implements repository.ScheduledMessageRepository
+func (mmCancelScheduledMessage *ScheduledMessageRepositoryMock) CancelScheduledMessage(ctx context.Context, params model.CancelScheduledMessageParams) (err error) {
+	mm_atomic.AddUint64(&mmCancelScheduledMessage.beforeCancelScheduledMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancelScheduledMessage.afterCancelScheduledMessageCounter, 1)
+
+	if mmCancelScheduledMessage.inspectFuncCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.inspectFuncCancelScheduledMessage(ctx, params)
+	}
+
+	mm_params := ScheduledMessageRepositoryMockCancelScheduledMessageParams{ctx, params}
+
+	// Record call args
+	mmCancelScheduledMessage.CancelScheduledMessageMock.mutex.Lock()
+	mmCancelScheduledMessage.CancelScheduledMessageMock.callArgs = append(mmCancelScheduledMessage.CancelScheduledMessageMock.callArgs, &mm_params)
+	mmCancelScheduledMessage.CancelScheduledMessageMock.mutex.Unlock()
+
+	for _, e := range mmCancelScheduledMessage.CancelScheduledMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledMessageRepositoryMockCancelScheduledMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancelScheduledMessage.t.Errorf("ScheduledMessageRepositoryMock.CancelScheduledMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCancelScheduledMessage.t.Errorf("ScheduledMessageRepositoryMock.CancelScheduledMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancelScheduledMessage.t.Errorf("ScheduledMessageRepositoryMock.CancelScheduledMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancelScheduledMessage.t.Fatal("No results are set for the ScheduledMessageRepositoryMock.CancelScheduledMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmCancelScheduledMessage.funcCancelScheduledMessage != nil {
+		return mmCancelScheduledMessage.funcCancelScheduledMessage(ctx, params)
+	}
+	mmCancelScheduledMessage.t.Fatalf("Unexpected call to ScheduledMessageRepositoryMock.CancelScheduledMessage. %v %v", ctx, params)
+	return
+}
+
+// CancelScheduledMessageAfterCounter returns a count of finished ScheduledMessageRepositoryMock.CancelScheduledMessage invocations
+func (mmCancelScheduledMessage *ScheduledMessageRepositoryMock) CancelScheduledMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelScheduledMessage.afterCancelScheduledMessageCounter)
+}
+
+// CancelScheduledMessageBeforeCounter returns a count of ScheduledMessageRepositoryMock.CancelScheduledMessage invocations
+func (mmCancelScheduledMessage *ScheduledMessageRepositoryMock) CancelScheduledMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelScheduledMessage.beforeCancelScheduledMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledMessageRepositoryMock.CancelScheduledMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancelScheduledMessage *mScheduledMessageRepositoryMockCancelScheduledMessage) Calls() []*ScheduledMessageRepositoryMockCancelScheduledMessageParams {
+	mmCancelScheduledMessage.mutex.RLock()
+
+	argCopy := make([]*ScheduledMessageRepositoryMockCancelScheduledMessageParams, len(mmCancelScheduledMessage.callArgs))
+	copy(argCopy, mmCancelScheduledMessage.callArgs)
+
+	mmCancelScheduledMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelScheduledMessageDone returns true if the count of the CancelScheduledMessage invocations corresponds
+// the number of defined expectations
+func (m *ScheduledMessageRepositoryMock) MinimockCancelScheduledMessageDone() bool {
+	if m.CancelScheduledMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelScheduledMessageMock.invocationsDone()
+}
+
+// MinimockCancelScheduledMessageInspect logs each unmet expectation
+func (m *ScheduledMessageRepositoryMock) MinimockCancelScheduledMessageInspect() {
+	for _, e := range m.CancelScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.CancelScheduledMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterCancelScheduledMessageCounter := mm_atomic.LoadUint64(&m.afterCancelScheduledMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelScheduledMessageMock.defaultExpectation != nil && afterCancelScheduledMessageCounter < 1 {
+		if m.CancelScheduledMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ScheduledMessageRepositoryMock.CancelScheduledMessage")
+		} else {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.CancelScheduledMessage with params: %#v", *m.CancelScheduledMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancelScheduledMessage != nil && afterCancelScheduledMessageCounter < 1 {
+		m.t.Error("Expected call to ScheduledMessageRepositoryMock.CancelScheduledMessage")
+	}
+
+	if !m.CancelScheduledMessageMock.invocationsDone() && afterCancelScheduledMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledMessageRepositoryMock.CancelScheduledMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelScheduledMessageMock.expectedInvocations), afterCancelScheduledMessageCounter)
+	}
+}
+
+type mScheduledMessageRepositoryMockCreateScheduledMessage struct {
+	optional           bool
+	mock               *ScheduledMessageRepositoryMock
+	defaultExpectation *ScheduledMessageRepositoryMockCreateScheduledMessageExpectation
+	expectations       []*ScheduledMessageRepositoryMockCreateScheduledMessageExpectation
+
+	callArgs []*ScheduledMessageRepositoryMockCreateScheduledMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ScheduledMessageRepositoryMockCreateScheduledMessageExpectation specifies expectation struct of the ScheduledMessageRepository.CreateScheduledMessage
+type ScheduledMessageRepositoryMockCreateScheduledMessageExpectation struct {
+	mock      *ScheduledMessageRepositoryMock
+	params    *ScheduledMessageRepositoryMockCreateScheduledMessageParams
+	paramPtrs *ScheduledMessageRepositoryMockCreateScheduledMessageParamPtrs
+	results   *ScheduledMessageRepositoryMockCreateScheduledMessageResults
+	Counter   uint64
+}
+
+// ScheduledMessageRepositoryMockCreateScheduledMessageParams contains parameters of the ScheduledMessageRepository.CreateScheduledMessage
+type ScheduledMessageRepositoryMockCreateScheduledMessageParams struct {
+	ctx    context.Context
+	params model.ScheduleMessageParams
+}
+
+// ScheduledMessageRepositoryMockCreateScheduledMessageParamPtrs contains pointers to parameters of the ScheduledMessageRepository.CreateScheduledMessage
+type ScheduledMessageRepositoryMockCreateScheduledMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.ScheduleMessageParams
+}
+
+// ScheduledMessageRepositoryMockCreateScheduledMessageResults contains results of the ScheduledMessageRepository.CreateScheduledMessage
+type ScheduledMessageRepositoryMockCreateScheduledMessageResults struct {
+	scheduledMessageID int64
+	err                error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) Optional() *mScheduledMessageRepositoryMockCreateScheduledMessage {
+	mmCreateScheduledMessage.optional = true
+	return mmCreateScheduledMessage
+}
+
+// Expect sets up expected params for ScheduledMessageRepository.CreateScheduledMessage
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) Expect(ctx context.Context, params model.ScheduleMessageParams) *mScheduledMessageRepositoryMockCreateScheduledMessage {
+	if mmCreateScheduledMessage.mock.funcCreateScheduledMessage != nil {
+		mmCreateScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CreateScheduledMessage mock is already set by Set")
+	}
+
+	if mmCreateScheduledMessage.defaultExpectation == nil {
+		mmCreateScheduledMessage.defaultExpectation = &ScheduledMessageRepositoryMockCreateScheduledMessageExpectation{}
+	}
+
+	if mmCreateScheduledMessage.defaultExpectation.paramPtrs != nil {
+		mmCreateScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CreateScheduledMessage mock is already set by ExpectParams functions")
+	}
+
+	mmCreateScheduledMessage.defaultExpectation.params = &ScheduledMessageRepositoryMockCreateScheduledMessageParams{ctx, params}
+	for _, e := range mmCreateScheduledMessage.expectations {
+		if minimock.Equal(e.params, mmCreateScheduledMessage.defaultExpectation.params) {
+			mmCreateScheduledMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateScheduledMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateScheduledMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledMessageRepository.CreateScheduledMessage
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) ExpectCtxParam1(ctx context.Context) *mScheduledMessageRepositoryMockCreateScheduledMessage {
+	if mmCreateScheduledMessage.mock.funcCreateScheduledMessage != nil {
+		mmCreateScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CreateScheduledMessage mock is already set by Set")
+	}
+
+	if mmCreateScheduledMessage.defaultExpectation == nil {
+		mmCreateScheduledMessage.defaultExpectation = &ScheduledMessageRepositoryMockCreateScheduledMessageExpectation{}
+	}
+
+	if mmCreateScheduledMessage.defaultExpectation.params != nil {
+		mmCreateScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CreateScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCreateScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCreateScheduledMessage.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockCreateScheduledMessageParamPtrs{}
+	}
+	mmCreateScheduledMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateScheduledMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for ScheduledMessageRepository.CreateScheduledMessage
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) ExpectParamsParam2(params model.ScheduleMessageParams) *mScheduledMessageRepositoryMockCreateScheduledMessage {
+	if mmCreateScheduledMessage.mock.funcCreateScheduledMessage != nil {
+		mmCreateScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CreateScheduledMessage mock is already set by Set")
+	}
+
+	if mmCreateScheduledMessage.defaultExpectation == nil {
+		mmCreateScheduledMessage.defaultExpectation = &ScheduledMessageRepositoryMockCreateScheduledMessageExpectation{}
+	}
+
+	if mmCreateScheduledMessage.defaultExpectation.params != nil {
+		mmCreateScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CreateScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCreateScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCreateScheduledMessage.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockCreateScheduledMessageParamPtrs{}
+	}
+	mmCreateScheduledMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateScheduledMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledMessageRepository.CreateScheduledMessage
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) Inspect(f func(ctx context.Context, params model.ScheduleMessageParams)) *mScheduledMessageRepositoryMockCreateScheduledMessage {
+	if mmCreateScheduledMessage.mock.inspectFuncCreateScheduledMessage != nil {
+		mmCreateScheduledMessage.mock.t.Fatalf("Inspect function is already set for ScheduledMessageRepositoryMock.CreateScheduledMessage")
+	}
+
+	mmCreateScheduledMessage.mock.inspectFuncCreateScheduledMessage = f
+
+	return mmCreateScheduledMessage
+}
+
+// Return sets up results that will be returned by ScheduledMessageRepository.CreateScheduledMessage
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) Return(scheduledMessageID int64, err error) *ScheduledMessageRepositoryMock {
+	if mmCreateScheduledMessage.mock.funcCreateScheduledMessage != nil {
+		mmCreateScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CreateScheduledMessage mock is already set by Set")
+	}
+
+	if mmCreateScheduledMessage.defaultExpectation == nil {
+		mmCreateScheduledMessage.defaultExpectation = &ScheduledMessageRepositoryMockCreateScheduledMessageExpectation{mock: mmCreateScheduledMessage.mock}
+	}
+	mmCreateScheduledMessage.defaultExpectation.results = &ScheduledMessageRepositoryMockCreateScheduledMessageResults{scheduledMessageID, err}
+	return mmCreateScheduledMessage.mock
+}
+
+// Set uses given function f to mock the ScheduledMessageRepository.CreateScheduledMessage method
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) Set(f func(ctx context.Context, params model.ScheduleMessageParams) (scheduledMessageID int64, err error)) *ScheduledMessageRepositoryMock {
+	if mmCreateScheduledMessage.defaultExpectation != nil {
+		mmCreateScheduledMessage.mock.t.Fatalf("Default expectation is already set for the ScheduledMessageRepository.CreateScheduledMessage method")
+	}
+
+	if len(mmCreateScheduledMessage.expectations) > 0 {
+		mmCreateScheduledMessage.mock.t.Fatalf("Some expectations are already set for the ScheduledMessageRepository.CreateScheduledMessage method")
+	}
+
+	mmCreateScheduledMessage.mock.funcCreateScheduledMessage = f
+	return mmCreateScheduledMessage.mock
+}
+
+// When sets expectation for the ScheduledMessageRepository.CreateScheduledMessage which will trigger the result defined by the following
+// Then helper
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) When(ctx context.Context, params model.ScheduleMessageParams) *ScheduledMessageRepositoryMockCreateScheduledMessageExpectation {
+	if mmCreateScheduledMessage.mock.funcCreateScheduledMessage != nil {
+		mmCreateScheduledMessage.mock.t.Fatalf("ScheduledMessageRepositoryMock.CreateScheduledMessage mock is already set by Set")
+	}
+
+	expectation := &ScheduledMessageRepositoryMockCreateScheduledMessageExpectation{
+		mock:   mmCreateScheduledMessage.mock,
+		params: &ScheduledMessageRepositoryMockCreateScheduledMessageParams{ctx, params},
+	}
+	mmCreateScheduledMessage.expectations = append(mmCreateScheduledMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledMessageRepository.CreateScheduledMessage return parameters for the expectation previously defined by the When method
+func (e *ScheduledMessageRepositoryMockCreateScheduledMessageExpectation) Then(scheduledMessageID int64, err error) *ScheduledMessageRepositoryMock {
+	e.results = &ScheduledMessageRepositoryMockCreateScheduledMessageResults{scheduledMessageID, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledMessageRepository.CreateScheduledMessage should be invoked
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) Times(n uint64) *mScheduledMessageRepositoryMockCreateScheduledMessage {
+	if n == 0 {
+		mmCreateScheduledMessage.mock.t.Fatalf("Times of ScheduledMessageRepositoryMock.CreateScheduledMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateScheduledMessage.expectedInvocations, n)
+	return mmCreateScheduledMessage
+}
+
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) invocationsDone() bool {
+	if len(mmCreateScheduledMessage.expectations) == 0 && mmCreateScheduledMessage.defaultExpectation == nil && mmCreateScheduledMessage.mock.funcCreateScheduledMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateScheduledMessage.mock.afterCreateScheduledMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateScheduledMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateScheduledMessage implements repository.ScheduledMessageRepository
+func (mmCreateScheduledMessage *ScheduledMessageRepositoryMock) CreateScheduledMessage(ctx context.Context, params model.ScheduleMessageParams) (scheduledMessageID int64, err error) {
+	mm_atomic.AddUint64(&mmCreateScheduledMessage.beforeCreateScheduledMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateScheduledMessage.afterCreateScheduledMessageCounter, 1)
+
+	if mmCreateScheduledMessage.inspectFuncCreateScheduledMessage != nil {
+		mmCreateScheduledMessage.inspectFuncCreateScheduledMessage(ctx, params)
+	}
+
+	mm_params := ScheduledMessageRepositoryMockCreateScheduledMessageParams{ctx, params}
+
+	// Record call args
+	mmCreateScheduledMessage.CreateScheduledMessageMock.mutex.Lock()
+	mmCreateScheduledMessage.CreateScheduledMessageMock.callArgs = append(mmCreateScheduledMessage.CreateScheduledMessageMock.callArgs, &mm_params)
+	mmCreateScheduledMessage.CreateScheduledMessageMock.mutex.Unlock()
+
+	for _, e := range mmCreateScheduledMessage.CreateScheduledMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.scheduledMessageID, e.results.err
+		}
+	}
+
+	if mmCreateScheduledMessage.CreateScheduledMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateScheduledMessage.CreateScheduledMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateScheduledMessage.CreateScheduledMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateScheduledMessage.CreateScheduledMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledMessageRepositoryMockCreateScheduledMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateScheduledMessage.t.Errorf("ScheduledMessageRepositoryMock.CreateScheduledMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateScheduledMessage.t.Errorf("ScheduledMessageRepositoryMock.CreateScheduledMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateScheduledMessage.t.Errorf("ScheduledMessageRepositoryMock.CreateScheduledMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateScheduledMessage.CreateScheduledMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateScheduledMessage.t.Fatal("No results are set for the ScheduledMessageRepositoryMock.CreateScheduledMessage")
+		}
+		return (*mm_results).scheduledMessageID, (*mm_results).err
+	}
+	if mmCreateScheduledMessage.funcCreateScheduledMessage != nil {
+		return mmCreateScheduledMessage.funcCreateScheduledMessage(ctx, params)
+	}
+	mmCreateScheduledMessage.t.Fatalf("Unexpected call to ScheduledMessageRepositoryMock.CreateScheduledMessage. %v %v", ctx, params)
+	return
+}
+
+// CreateScheduledMessageAfterCounter returns a count of finished ScheduledMessageRepositoryMock.CreateScheduledMessage invocations
+func (mmCreateScheduledMessage *ScheduledMessageRepositoryMock) CreateScheduledMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateScheduledMessage.afterCreateScheduledMessageCounter)
+}
+
+// CreateScheduledMessageBeforeCounter returns a count of ScheduledMessageRepositoryMock.CreateScheduledMessage invocations
+func (mmCreateScheduledMessage *ScheduledMessageRepositoryMock) CreateScheduledMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateScheduledMessage.beforeCreateScheduledMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledMessageRepositoryMock.CreateScheduledMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateScheduledMessage *mScheduledMessageRepositoryMockCreateScheduledMessage) Calls() []*ScheduledMessageRepositoryMockCreateScheduledMessageParams {
+	mmCreateScheduledMessage.mutex.RLock()
+
+	argCopy := make([]*ScheduledMessageRepositoryMockCreateScheduledMessageParams, len(mmCreateScheduledMessage.callArgs))
+	copy(argCopy, mmCreateScheduledMessage.callArgs)
+
+	mmCreateScheduledMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateScheduledMessageDone returns true if the count of the CreateScheduledMessage invocations corresponds
+// the number of defined expectations
+func (m *ScheduledMessageRepositoryMock) MinimockCreateScheduledMessageDone() bool {
+	if m.CreateScheduledMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateScheduledMessageMock.invocationsDone()
+}
+
+// MinimockCreateScheduledMessageInspect logs each unmet expectation
+func (m *ScheduledMessageRepositoryMock) MinimockCreateScheduledMessageInspect() {
+	for _, e := range m.CreateScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.CreateScheduledMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateScheduledMessageCounter := mm_atomic.LoadUint64(&m.afterCreateScheduledMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateScheduledMessageMock.defaultExpectation != nil && afterCreateScheduledMessageCounter < 1 {
+		if m.CreateScheduledMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ScheduledMessageRepositoryMock.CreateScheduledMessage")
+		} else {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.CreateScheduledMessage with params: %#v", *m.CreateScheduledMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateScheduledMessage != nil && afterCreateScheduledMessageCounter < 1 {
+		m.t.Error("Expected call to ScheduledMessageRepositoryMock.CreateScheduledMessage")
+	}
+
+	if !m.CreateScheduledMessageMock.invocationsDone() && afterCreateScheduledMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledMessageRepositoryMock.CreateScheduledMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateScheduledMessageMock.expectedInvocations), afterCreateScheduledMessageCounter)
+	}
+}
+
+type mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages struct {
+	optional           bool
+	mock               *ScheduledMessageRepositoryMock
+	defaultExpectation *ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation
+	expectations       []*ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation
+
+	callArgs []*ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation specifies expectation struct of the ScheduledMessageRepository.DeleteFinishedScheduledMessages
+type ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation struct {
+	mock      *ScheduledMessageRepositoryMock
+	params    *ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams
+	paramPtrs *ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParamPtrs
+	results   *ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesResults
+	Counter   uint64
+}
+
+// ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams contains parameters of the ScheduledMessageRepository.DeleteFinishedScheduledMessages
+type ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams struct {
+	ctx    context.Context
+	before time.Time
+}
+
+// ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParamPtrs contains pointers to parameters of the ScheduledMessageRepository.DeleteFinishedScheduledMessages
+type ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+}
+
+// ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesResults contains results of the ScheduledMessageRepository.DeleteFinishedScheduledMessages
+type ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesResults struct {
+	deleted int64
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) Optional() *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages {
+	mmDeleteFinishedScheduledMessages.optional = true
+	return mmDeleteFinishedScheduledMessages
+}
+
+// Expect sets up expected params for ScheduledMessageRepository.DeleteFinishedScheduledMessages
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) Expect(ctx context.Context, before time.Time) *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages {
+	if mmDeleteFinishedScheduledMessages.mock.funcDeleteFinishedScheduledMessages != nil {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages mock is already set by Set")
+	}
+
+	if mmDeleteFinishedScheduledMessages.defaultExpectation == nil {
+		mmDeleteFinishedScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation{}
+	}
+
+	if mmDeleteFinishedScheduledMessages.defaultExpectation.paramPtrs != nil {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteFinishedScheduledMessages.defaultExpectation.params = &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams{ctx, before}
+	for _, e := range mmDeleteFinishedScheduledMessages.expectations {
+		if minimock.Equal(e.params, mmDeleteFinishedScheduledMessages.defaultExpectation.params) {
+			mmDeleteFinishedScheduledMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteFinishedScheduledMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteFinishedScheduledMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledMessageRepository.DeleteFinishedScheduledMessages
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) ExpectCtxParam1(ctx context.Context) *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages {
+	if mmDeleteFinishedScheduledMessages.mock.funcDeleteFinishedScheduledMessages != nil {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages mock is already set by Set")
+	}
+
+	if mmDeleteFinishedScheduledMessages.defaultExpectation == nil {
+		mmDeleteFinishedScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation{}
+	}
+
+	if mmDeleteFinishedScheduledMessages.defaultExpectation.params != nil {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages mock is already set by Expect")
+	}
+
+	if mmDeleteFinishedScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmDeleteFinishedScheduledMessages.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParamPtrs{}
+	}
+	mmDeleteFinishedScheduledMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteFinishedScheduledMessages
+}
+
+// ExpectBeforeParam2 sets up expected param before for ScheduledMessageRepository.DeleteFinishedScheduledMessages
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) ExpectBeforeParam2(before time.Time) *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages {
+	if mmDeleteFinishedScheduledMessages.mock.funcDeleteFinishedScheduledMessages != nil {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages mock is already set by Set")
+	}
+
+	if mmDeleteFinishedScheduledMessages.defaultExpectation == nil {
+		mmDeleteFinishedScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation{}
+	}
+
+	if mmDeleteFinishedScheduledMessages.defaultExpectation.params != nil {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages mock is already set by Expect")
+	}
+
+	if mmDeleteFinishedScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmDeleteFinishedScheduledMessages.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParamPtrs{}
+	}
+	mmDeleteFinishedScheduledMessages.defaultExpectation.paramPtrs.before = &before
+
+	return mmDeleteFinishedScheduledMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledMessageRepository.DeleteFinishedScheduledMessages
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) Inspect(f func(ctx context.Context, before time.Time)) *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages {
+	if mmDeleteFinishedScheduledMessages.mock.inspectFuncDeleteFinishedScheduledMessages != nil {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("Inspect function is already set for ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages")
+	}
+
+	mmDeleteFinishedScheduledMessages.mock.inspectFuncDeleteFinishedScheduledMessages = f
+
+	return mmDeleteFinishedScheduledMessages
+}
+
+// Return sets up results that will be returned by ScheduledMessageRepository.DeleteFinishedScheduledMessages
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) Return(deleted int64, err error) *ScheduledMessageRepositoryMock {
+	if mmDeleteFinishedScheduledMessages.mock.funcDeleteFinishedScheduledMessages != nil {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages mock is already set by Set")
+	}
+
+	if mmDeleteFinishedScheduledMessages.defaultExpectation == nil {
+		mmDeleteFinishedScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation{mock: mmDeleteFinishedScheduledMessages.mock}
+	}
+	mmDeleteFinishedScheduledMessages.defaultExpectation.results = &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesResults{deleted, err}
+	return mmDeleteFinishedScheduledMessages.mock
+}
+
+// Set uses given function f to mock the ScheduledMessageRepository.DeleteFinishedScheduledMessages method
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) Set(f func(ctx context.Context, before time.Time) (deleted int64, err error)) *ScheduledMessageRepositoryMock {
+	if mmDeleteFinishedScheduledMessages.defaultExpectation != nil {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("Default expectation is already set for the ScheduledMessageRepository.DeleteFinishedScheduledMessages method")
+	}
+
+	if len(mmDeleteFinishedScheduledMessages.expectations) > 0 {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("Some expectations are already set for the ScheduledMessageRepository.DeleteFinishedScheduledMessages method")
+	}
+
+	mmDeleteFinishedScheduledMessages.mock.funcDeleteFinishedScheduledMessages = f
+	return mmDeleteFinishedScheduledMessages.mock
+}
+
+// When sets expectation for the ScheduledMessageRepository.DeleteFinishedScheduledMessages which will trigger the result defined by the following
+// Then helper
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) When(ctx context.Context, before time.Time) *ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation {
+	if mmDeleteFinishedScheduledMessages.mock.funcDeleteFinishedScheduledMessages != nil {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages mock is already set by Set")
+	}
+
+	expectation := &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation{
+		mock:   mmDeleteFinishedScheduledMessages.mock,
+		params: &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams{ctx, before},
+	}
+	mmDeleteFinishedScheduledMessages.expectations = append(mmDeleteFinishedScheduledMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledMessageRepository.DeleteFinishedScheduledMessages return parameters for the expectation previously defined by the When method
+func (e *ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesExpectation) Then(deleted int64, err error) *ScheduledMessageRepositoryMock {
+	e.results = &ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesResults{deleted, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledMessageRepository.DeleteFinishedScheduledMessages should be invoked
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) Times(n uint64) *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages {
+	if n == 0 {
+		mmDeleteFinishedScheduledMessages.mock.t.Fatalf("Times of ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteFinishedScheduledMessages.expectedInvocations, n)
+	return mmDeleteFinishedScheduledMessages
+}
+
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) invocationsDone() bool {
+	if len(mmDeleteFinishedScheduledMessages.expectations) == 0 && mmDeleteFinishedScheduledMessages.defaultExpectation == nil && mmDeleteFinishedScheduledMessages.mock.funcDeleteFinishedScheduledMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteFinishedScheduledMessages.mock.afterDeleteFinishedScheduledMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteFinishedScheduledMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteFinishedScheduledMessages implements repository.ScheduledMessageRepository
+func (mmDeleteFinishedScheduledMessages *ScheduledMessageRepositoryMock) DeleteFinishedScheduledMessages(ctx context.Context, before time.Time) (deleted int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteFinishedScheduledMessages.beforeDeleteFinishedScheduledMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteFinishedScheduledMessages.afterDeleteFinishedScheduledMessagesCounter, 1)
+
+	if mmDeleteFinishedScheduledMessages.inspectFuncDeleteFinishedScheduledMessages != nil {
+		mmDeleteFinishedScheduledMessages.inspectFuncDeleteFinishedScheduledMessages(ctx, before)
+	}
+
+	mm_params := ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams{ctx, before}
+
+	// Record call args
+	mmDeleteFinishedScheduledMessages.DeleteFinishedScheduledMessagesMock.mutex.Lock()
+	mmDeleteFinishedScheduledMessages.DeleteFinishedScheduledMessagesMock.callArgs = append(mmDeleteFinishedScheduledMessages.DeleteFinishedScheduledMessagesMock.callArgs, &mm_params)
+	mmDeleteFinishedScheduledMessages.DeleteFinishedScheduledMessagesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteFinishedScheduledMessages.DeleteFinishedScheduledMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.deleted, e.results.err
+		}
+	}
+
+	if mmDeleteFinishedScheduledMessages.DeleteFinishedScheduledMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteFinishedScheduledMessages.DeleteFinishedScheduledMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteFinishedScheduledMessages.DeleteFinishedScheduledMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteFinishedScheduledMessages.DeleteFinishedScheduledMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams{ctx, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteFinishedScheduledMessages.t.Errorf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmDeleteFinishedScheduledMessages.t.Errorf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages got unexpected parameter before, want: %#v, got: %#v%s\n", *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteFinishedScheduledMessages.t.Errorf("ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteFinishedScheduledMessages.DeleteFinishedScheduledMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteFinishedScheduledMessages.t.Fatal("No results are set for the ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages")
+		}
+		return (*mm_results).deleted, (*mm_results).err
+	}
+	if mmDeleteFinishedScheduledMessages.funcDeleteFinishedScheduledMessages != nil {
+		return mmDeleteFinishedScheduledMessages.funcDeleteFinishedScheduledMessages(ctx, before)
+	}
+	mmDeleteFinishedScheduledMessages.t.Fatalf("Unexpected call to ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages. %v %v", ctx, before)
+	return
+}
+
+// DeleteFinishedScheduledMessagesAfterCounter returns a count of finished ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages invocations
+func (mmDeleteFinishedScheduledMessages *ScheduledMessageRepositoryMock) DeleteFinishedScheduledMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFinishedScheduledMessages.afterDeleteFinishedScheduledMessagesCounter)
+}
+
+// DeleteFinishedScheduledMessagesBeforeCounter returns a count of ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages invocations
+func (mmDeleteFinishedScheduledMessages *ScheduledMessageRepositoryMock) DeleteFinishedScheduledMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFinishedScheduledMessages.beforeDeleteFinishedScheduledMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteFinishedScheduledMessages *mScheduledMessageRepositoryMockDeleteFinishedScheduledMessages) Calls() []*ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams {
+	mmDeleteFinishedScheduledMessages.mutex.RLock()
+
+	argCopy := make([]*ScheduledMessageRepositoryMockDeleteFinishedScheduledMessagesParams, len(mmDeleteFinishedScheduledMessages.callArgs))
+	copy(argCopy, mmDeleteFinishedScheduledMessages.callArgs)
+
+	mmDeleteFinishedScheduledMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteFinishedScheduledMessagesDone returns true if the count of the DeleteFinishedScheduledMessages invocations corresponds
+// the number of defined expectations
+func (m *ScheduledMessageRepositoryMock) MinimockDeleteFinishedScheduledMessagesDone() bool {
+	if m.DeleteFinishedScheduledMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteFinishedScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteFinishedScheduledMessagesMock.invocationsDone()
+}
+
+// MinimockDeleteFinishedScheduledMessagesInspect logs each unmet expectation
+func (m *ScheduledMessageRepositoryMock) MinimockDeleteFinishedScheduledMessagesInspect() {
+	for _, e := range m.DeleteFinishedScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteFinishedScheduledMessagesCounter := mm_atomic.LoadUint64(&m.afterDeleteFinishedScheduledMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteFinishedScheduledMessagesMock.defaultExpectation != nil && afterDeleteFinishedScheduledMessagesCounter < 1 {
+		if m.DeleteFinishedScheduledMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages")
+		} else {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages with params: %#v", *m.DeleteFinishedScheduledMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteFinishedScheduledMessages != nil && afterDeleteFinishedScheduledMessagesCounter < 1 {
+		m.t.Error("Expected call to ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages")
+	}
+
+	if !m.DeleteFinishedScheduledMessagesMock.invocationsDone() && afterDeleteFinishedScheduledMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledMessageRepositoryMock.DeleteFinishedScheduledMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteFinishedScheduledMessagesMock.expectedInvocations), afterDeleteFinishedScheduledMessagesCounter)
+	}
+}
+
+type mScheduledMessageRepositoryMockListDueScheduledMessages struct {
+	optional           bool
+	mock               *ScheduledMessageRepositoryMock
+	defaultExpectation *ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation
+	expectations       []*ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation
+
+	callArgs []*ScheduledMessageRepositoryMockListDueScheduledMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation specifies expectation struct of the ScheduledMessageRepository.ListDueScheduledMessages
+type ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation struct {
+	mock      *ScheduledMessageRepositoryMock
+	params    *ScheduledMessageRepositoryMockListDueScheduledMessagesParams
+	paramPtrs *ScheduledMessageRepositoryMockListDueScheduledMessagesParamPtrs
+	results   *ScheduledMessageRepositoryMockListDueScheduledMessagesResults
+	Counter   uint64
+}
+
+// ScheduledMessageRepositoryMockListDueScheduledMessagesParams contains parameters of the ScheduledMessageRepository.ListDueScheduledMessages
+type ScheduledMessageRepositoryMockListDueScheduledMessagesParams struct {
+	ctx   context.Context
+	now   time.Time
+	limit int
+}
+
+// ScheduledMessageRepositoryMockListDueScheduledMessagesParamPtrs contains pointers to parameters of the ScheduledMessageRepository.ListDueScheduledMessages
+type ScheduledMessageRepositoryMockListDueScheduledMessagesParamPtrs struct {
+	ctx   *context.Context
+	now   *time.Time
+	limit *int
+}
+
+// ScheduledMessageRepositoryMockListDueScheduledMessagesResults contains results of the ScheduledMessageRepository.ListDueScheduledMessages
+type ScheduledMessageRepositoryMockListDueScheduledMessagesResults struct {
+	messages []model.ScheduledMessage
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) Optional() *mScheduledMessageRepositoryMockListDueScheduledMessages {
+	mmListDueScheduledMessages.optional = true
+	return mmListDueScheduledMessages
+}
+
+// Expect sets up expected params for ScheduledMessageRepository.ListDueScheduledMessages
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) Expect(ctx context.Context, now time.Time, limit int) *mScheduledMessageRepositoryMockListDueScheduledMessages {
+	if mmListDueScheduledMessages.mock.funcListDueScheduledMessages != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListDueScheduledMessages mock is already set by Set")
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation == nil {
+		mmListDueScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation{}
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation.paramPtrs != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListDueScheduledMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListDueScheduledMessages.defaultExpectation.params = &ScheduledMessageRepositoryMockListDueScheduledMessagesParams{ctx, now, limit}
+	for _, e := range mmListDueScheduledMessages.expectations {
+		if minimock.Equal(e.params, mmListDueScheduledMessages.defaultExpectation.params) {
+			mmListDueScheduledMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDueScheduledMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListDueScheduledMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledMessageRepository.ListDueScheduledMessages
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) ExpectCtxParam1(ctx context.Context) *mScheduledMessageRepositoryMockListDueScheduledMessages {
+	if mmListDueScheduledMessages.mock.funcListDueScheduledMessages != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListDueScheduledMessages mock is already set by Set")
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation == nil {
+		mmListDueScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation{}
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation.params != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListDueScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListDueScheduledMessages.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockListDueScheduledMessagesParamPtrs{}
+	}
+	mmListDueScheduledMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListDueScheduledMessages
+}
+
+// ExpectNowParam2 sets up expected param now for ScheduledMessageRepository.ListDueScheduledMessages
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) ExpectNowParam2(now time.Time) *mScheduledMessageRepositoryMockListDueScheduledMessages {
+	if mmListDueScheduledMessages.mock.funcListDueScheduledMessages != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListDueScheduledMessages mock is already set by Set")
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation == nil {
+		mmListDueScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation{}
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation.params != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListDueScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListDueScheduledMessages.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockListDueScheduledMessagesParamPtrs{}
+	}
+	mmListDueScheduledMessages.defaultExpectation.paramPtrs.now = &now
+
+	return mmListDueScheduledMessages
+}
+
+// ExpectLimitParam3 sets up expected param limit for ScheduledMessageRepository.ListDueScheduledMessages
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) ExpectLimitParam3(limit int) *mScheduledMessageRepositoryMockListDueScheduledMessages {
+	if mmListDueScheduledMessages.mock.funcListDueScheduledMessages != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListDueScheduledMessages mock is already set by Set")
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation == nil {
+		mmListDueScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation{}
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation.params != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListDueScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListDueScheduledMessages.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockListDueScheduledMessagesParamPtrs{}
+	}
+	mmListDueScheduledMessages.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmListDueScheduledMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledMessageRepository.ListDueScheduledMessages
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) Inspect(f func(ctx context.Context, now time.Time, limit int)) *mScheduledMessageRepositoryMockListDueScheduledMessages {
+	if mmListDueScheduledMessages.mock.inspectFuncListDueScheduledMessages != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("Inspect function is already set for ScheduledMessageRepositoryMock.ListDueScheduledMessages")
+	}
+
+	mmListDueScheduledMessages.mock.inspectFuncListDueScheduledMessages = f
+
+	return mmListDueScheduledMessages
+}
+
+// Return sets up results that will be returned by ScheduledMessageRepository.ListDueScheduledMessages
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) Return(messages []model.ScheduledMessage, err error) *ScheduledMessageRepositoryMock {
+	if mmListDueScheduledMessages.mock.funcListDueScheduledMessages != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListDueScheduledMessages mock is already set by Set")
+	}
+
+	if mmListDueScheduledMessages.defaultExpectation == nil {
+		mmListDueScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation{mock: mmListDueScheduledMessages.mock}
+	}
+	mmListDueScheduledMessages.defaultExpectation.results = &ScheduledMessageRepositoryMockListDueScheduledMessagesResults{messages, err}
+	return mmListDueScheduledMessages.mock
+}
+
+// Set uses given function f to mock the ScheduledMessageRepository.ListDueScheduledMessages method
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) Set(f func(ctx context.Context, now time.Time, limit int) (messages []model.ScheduledMessage, err error)) *ScheduledMessageRepositoryMock {
+	if mmListDueScheduledMessages.defaultExpectation != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("Default expectation is already set for the ScheduledMessageRepository.ListDueScheduledMessages method")
+	}
+
+	if len(mmListDueScheduledMessages.expectations) > 0 {
+		mmListDueScheduledMessages.mock.t.Fatalf("Some expectations are already set for the ScheduledMessageRepository.ListDueScheduledMessages method")
+	}
+
+	mmListDueScheduledMessages.mock.funcListDueScheduledMessages = f
+	return mmListDueScheduledMessages.mock
+}
+
+// When sets expectation for the ScheduledMessageRepository.ListDueScheduledMessages which will trigger the result defined by the following
+// Then helper
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) When(ctx context.Context, now time.Time, limit int) *ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation {
+	if mmListDueScheduledMessages.mock.funcListDueScheduledMessages != nil {
+		mmListDueScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListDueScheduledMessages mock is already set by Set")
+	}
+
+	expectation := &ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation{
+		mock:   mmListDueScheduledMessages.mock,
+		params: &ScheduledMessageRepositoryMockListDueScheduledMessagesParams{ctx, now, limit},
+	}
+	mmListDueScheduledMessages.expectations = append(mmListDueScheduledMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledMessageRepository.ListDueScheduledMessages return parameters for the expectation previously defined by the When method
+func (e *ScheduledMessageRepositoryMockListDueScheduledMessagesExpectation) Then(messages []model.ScheduledMessage, err error) *ScheduledMessageRepositoryMock {
+	e.results = &ScheduledMessageRepositoryMockListDueScheduledMessagesResults{messages, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledMessageRepository.ListDueScheduledMessages should be invoked
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) Times(n uint64) *mScheduledMessageRepositoryMockListDueScheduledMessages {
+	if n == 0 {
+		mmListDueScheduledMessages.mock.t.Fatalf("Times of ScheduledMessageRepositoryMock.ListDueScheduledMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListDueScheduledMessages.expectedInvocations, n)
+	return mmListDueScheduledMessages
+}
+
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) invocationsDone() bool {
+	if len(mmListDueScheduledMessages.expectations) == 0 && mmListDueScheduledMessages.defaultExpectation == nil && mmListDueScheduledMessages.mock.funcListDueScheduledMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListDueScheduledMessages.mock.afterListDueScheduledMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListDueScheduledMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListDueScheduledMessages implements repository.ScheduledMessageRepository
+func (mmListDueScheduledMessages *ScheduledMessageRepositoryMock) ListDueScheduledMessages(ctx context.Context, now time.Time, limit int) (messages []model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmListDueScheduledMessages.beforeListDueScheduledMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDueScheduledMessages.afterListDueScheduledMessagesCounter, 1)
+
+	if mmListDueScheduledMessages.inspectFuncListDueScheduledMessages != nil {
+		mmListDueScheduledMessages.inspectFuncListDueScheduledMessages(ctx, now, limit)
+	}
+
+	mm_params := ScheduledMessageRepositoryMockListDueScheduledMessagesParams{ctx, now, limit}
+
+	// Record call args
+	mmListDueScheduledMessages.ListDueScheduledMessagesMock.mutex.Lock()
+	mmListDueScheduledMessages.ListDueScheduledMessagesMock.callArgs = append(mmListDueScheduledMessages.ListDueScheduledMessagesMock.callArgs, &mm_params)
+	mmListDueScheduledMessages.ListDueScheduledMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListDueScheduledMessages.ListDueScheduledMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.messages, e.results.err
+		}
+	}
+
+	if mmListDueScheduledMessages.ListDueScheduledMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDueScheduledMessages.ListDueScheduledMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDueScheduledMessages.ListDueScheduledMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListDueScheduledMessages.ListDueScheduledMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledMessageRepositoryMockListDueScheduledMessagesParams{ctx, now, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDueScheduledMessages.t.Errorf("ScheduledMessageRepositoryMock.ListDueScheduledMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmListDueScheduledMessages.t.Errorf("ScheduledMessageRepositoryMock.ListDueScheduledMessages got unexpected parameter now, want: %#v, got: %#v%s\n", *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListDueScheduledMessages.t.Errorf("ScheduledMessageRepositoryMock.ListDueScheduledMessages got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDueScheduledMessages.t.Errorf("ScheduledMessageRepositoryMock.ListDueScheduledMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDueScheduledMessages.ListDueScheduledMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDueScheduledMessages.t.Fatal("No results are set for the ScheduledMessageRepositoryMock.ListDueScheduledMessages")
+		}
+		return (*mm_results).messages, (*mm_results).err
+	}
+	if mmListDueScheduledMessages.funcListDueScheduledMessages != nil {
+		return mmListDueScheduledMessages.funcListDueScheduledMessages(ctx, now, limit)
+	}
+	mmListDueScheduledMessages.t.Fatalf("Unexpected call to ScheduledMessageRepositoryMock.ListDueScheduledMessages. %v %v %v", ctx, now, limit)
+	return
+}
+
+// ListDueScheduledMessagesAfterCounter returns a count of finished ScheduledMessageRepositoryMock.ListDueScheduledMessages invocations
+func (mmListDueScheduledMessages *ScheduledMessageRepositoryMock) ListDueScheduledMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDueScheduledMessages.afterListDueScheduledMessagesCounter)
+}
+
+// ListDueScheduledMessagesBeforeCounter returns a count of ScheduledMessageRepositoryMock.ListDueScheduledMessages invocations
+func (mmListDueScheduledMessages *ScheduledMessageRepositoryMock) ListDueScheduledMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDueScheduledMessages.beforeListDueScheduledMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledMessageRepositoryMock.ListDueScheduledMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDueScheduledMessages *mScheduledMessageRepositoryMockListDueScheduledMessages) Calls() []*ScheduledMessageRepositoryMockListDueScheduledMessagesParams {
+	mmListDueScheduledMessages.mutex.RLock()
+
+	argCopy := make([]*ScheduledMessageRepositoryMockListDueScheduledMessagesParams, len(mmListDueScheduledMessages.callArgs))
+	copy(argCopy, mmListDueScheduledMessages.callArgs)
+
+	mmListDueScheduledMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDueScheduledMessagesDone returns true if the count of the ListDueScheduledMessages invocations corresponds
+// the number of defined expectations
+func (m *ScheduledMessageRepositoryMock) MinimockListDueScheduledMessagesDone() bool {
+	if m.ListDueScheduledMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListDueScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListDueScheduledMessagesMock.invocationsDone()
+}
+
+// MinimockListDueScheduledMessagesInspect logs each unmet expectation
+func (m *ScheduledMessageRepositoryMock) MinimockListDueScheduledMessagesInspect() {
+	for _, e := range m.ListDueScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.ListDueScheduledMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterListDueScheduledMessagesCounter := mm_atomic.LoadUint64(&m.afterListDueScheduledMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDueScheduledMessagesMock.defaultExpectation != nil && afterListDueScheduledMessagesCounter < 1 {
+		if m.ListDueScheduledMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ScheduledMessageRepositoryMock.ListDueScheduledMessages")
+		} else {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.ListDueScheduledMessages with params: %#v", *m.ListDueScheduledMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDueScheduledMessages != nil && afterListDueScheduledMessagesCounter < 1 {
+		m.t.Error("Expected call to ScheduledMessageRepositoryMock.ListDueScheduledMessages")
+	}
+
+	if !m.ListDueScheduledMessagesMock.invocationsDone() && afterListDueScheduledMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledMessageRepositoryMock.ListDueScheduledMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.ListDueScheduledMessagesMock.expectedInvocations), afterListDueScheduledMessagesCounter)
+	}
+}
+
+type mScheduledMessageRepositoryMockListScheduledMessages struct {
+	optional           bool
+	mock               *ScheduledMessageRepositoryMock
+	defaultExpectation *ScheduledMessageRepositoryMockListScheduledMessagesExpectation
+	expectations       []*ScheduledMessageRepositoryMockListScheduledMessagesExpectation
+
+	callArgs []*ScheduledMessageRepositoryMockListScheduledMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ScheduledMessageRepositoryMockListScheduledMessagesExpectation specifies expectation struct of the ScheduledMessageRepository.ListScheduledMessages
+type ScheduledMessageRepositoryMockListScheduledMessagesExpectation struct {
+	mock      *ScheduledMessageRepositoryMock
+	params    *ScheduledMessageRepositoryMockListScheduledMessagesParams
+	paramPtrs *ScheduledMessageRepositoryMockListScheduledMessagesParamPtrs
+	results   *ScheduledMessageRepositoryMockListScheduledMessagesResults
+	Counter   uint64
+}
+
+// ScheduledMessageRepositoryMockListScheduledMessagesParams contains parameters of the ScheduledMessageRepository.ListScheduledMessages
+type ScheduledMessageRepositoryMockListScheduledMessagesParams struct {
+	ctx    context.Context
+	params model.ListScheduledMessagesParams
+}
+
+// ScheduledMessageRepositoryMockListScheduledMessagesParamPtrs contains pointers to parameters of the ScheduledMessageRepository.ListScheduledMessages
+type ScheduledMessageRepositoryMockListScheduledMessagesParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListScheduledMessagesParams
+}
+
+// ScheduledMessageRepositoryMockListScheduledMessagesResults contains results of the ScheduledMessageRepository.ListScheduledMessages
+type ScheduledMessageRepositoryMockListScheduledMessagesResults struct {
+	messages []model.ScheduledMessage
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) Optional() *mScheduledMessageRepositoryMockListScheduledMessages {
+	mmListScheduledMessages.optional = true
+	return mmListScheduledMessages
+}
+
+// Expect sets up expected params for ScheduledMessageRepository.ListScheduledMessages
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) Expect(ctx context.Context, params model.ListScheduledMessagesParams) *mScheduledMessageRepositoryMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListScheduledMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListScheduledMessages.defaultExpectation.params = &ScheduledMessageRepositoryMockListScheduledMessagesParams{ctx, params}
+	for _, e := range mmListScheduledMessages.expectations {
+		if minimock.Equal(e.params, mmListScheduledMessages.defaultExpectation.params) {
+			mmListScheduledMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListScheduledMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListScheduledMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledMessageRepository.ListScheduledMessages
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) ExpectCtxParam1(ctx context.Context) *mScheduledMessageRepositoryMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.params != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListScheduledMessages.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockListScheduledMessagesParamPtrs{}
+	}
+	mmListScheduledMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListScheduledMessages
+}
+
+// ExpectParamsParam2 sets up expected param params for ScheduledMessageRepository.ListScheduledMessages
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) ExpectParamsParam2(params model.ListScheduledMessagesParams) *mScheduledMessageRepositoryMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.params != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListScheduledMessages.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockListScheduledMessagesParamPtrs{}
+	}
+	mmListScheduledMessages.defaultExpectation.paramPtrs.params = &params
+
+	return mmListScheduledMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledMessageRepository.ListScheduledMessages
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) Inspect(f func(ctx context.Context, params model.ListScheduledMessagesParams)) *mScheduledMessageRepositoryMockListScheduledMessages {
+	if mmListScheduledMessages.mock.inspectFuncListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("Inspect function is already set for ScheduledMessageRepositoryMock.ListScheduledMessages")
+	}
+
+	mmListScheduledMessages.mock.inspectFuncListScheduledMessages = f
+
+	return mmListScheduledMessages
+}
+
+// Return sets up results that will be returned by ScheduledMessageRepository.ListScheduledMessages
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) Return(messages []model.ScheduledMessage, err error) *ScheduledMessageRepositoryMock {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ScheduledMessageRepositoryMockListScheduledMessagesExpectation{mock: mmListScheduledMessages.mock}
+	}
+	mmListScheduledMessages.defaultExpectation.results = &ScheduledMessageRepositoryMockListScheduledMessagesResults{messages, err}
+	return mmListScheduledMessages.mock
+}
+
+// Set uses given function f to mock the ScheduledMessageRepository.ListScheduledMessages method
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) Set(f func(ctx context.Context, params model.ListScheduledMessagesParams) (messages []model.ScheduledMessage, err error)) *ScheduledMessageRepositoryMock {
+	if mmListScheduledMessages.defaultExpectation != nil {
+		mmListScheduledMessages.mock.t.Fatalf("Default expectation is already set for the ScheduledMessageRepository.ListScheduledMessages method")
+	}
+
+	if len(mmListScheduledMessages.expectations) > 0 {
+		mmListScheduledMessages.mock.t.Fatalf("Some expectations are already set for the ScheduledMessageRepository.ListScheduledMessages method")
+	}
+
+	mmListScheduledMessages.mock.funcListScheduledMessages = f
+	return mmListScheduledMessages.mock
+}
+
+// When sets expectation for the ScheduledMessageRepository.ListScheduledMessages which will trigger the result defined by the following
+// Then helper
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) When(ctx context.Context, params model.ListScheduledMessagesParams) *ScheduledMessageRepositoryMockListScheduledMessagesExpectation {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduledMessageRepositoryMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	expectation := &ScheduledMessageRepositoryMockListScheduledMessagesExpectation{
+		mock:   mmListScheduledMessages.mock,
+		params: &ScheduledMessageRepositoryMockListScheduledMessagesParams{ctx, params},
+	}
+	mmListScheduledMessages.expectations = append(mmListScheduledMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledMessageRepository.ListScheduledMessages return parameters for the expectation previously defined by the When method
+func (e *ScheduledMessageRepositoryMockListScheduledMessagesExpectation) Then(messages []model.ScheduledMessage, err error) *ScheduledMessageRepositoryMock {
+	e.results = &ScheduledMessageRepositoryMockListScheduledMessagesResults{messages, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledMessageRepository.ListScheduledMessages should be invoked
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) Times(n uint64) *mScheduledMessageRepositoryMockListScheduledMessages {
+	if n == 0 {
+		mmListScheduledMessages.mock.t.Fatalf("Times of ScheduledMessageRepositoryMock.ListScheduledMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListScheduledMessages.expectedInvocations, n)
+	return mmListScheduledMessages
+}
+
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) invocationsDone() bool {
+	if len(mmListScheduledMessages.expectations) == 0 && mmListScheduledMessages.defaultExpectation == nil && mmListScheduledMessages.mock.funcListScheduledMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListScheduledMessages.mock.afterListScheduledMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListScheduledMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListScheduledMessages implements repository.ScheduledMessageRepository
+func (mmListScheduledMessages *ScheduledMessageRepositoryMock) ListScheduledMessages(ctx context.Context, params model.ListScheduledMessagesParams) (messages []model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmListScheduledMessages.beforeListScheduledMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListScheduledMessages.afterListScheduledMessagesCounter, 1)
+
+	if mmListScheduledMessages.inspectFuncListScheduledMessages != nil {
+		mmListScheduledMessages.inspectFuncListScheduledMessages(ctx, params)
+	}
+
+	mm_params := ScheduledMessageRepositoryMockListScheduledMessagesParams{ctx, params}
+
+	// Record call args
+	mmListScheduledMessages.ListScheduledMessagesMock.mutex.Lock()
+	mmListScheduledMessages.ListScheduledMessagesMock.callArgs = append(mmListScheduledMessages.ListScheduledMessagesMock.callArgs, &mm_params)
+	mmListScheduledMessages.ListScheduledMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListScheduledMessages.ListScheduledMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.messages, e.results.err
+		}
+	}
+
+	if mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledMessageRepositoryMockListScheduledMessagesParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListScheduledMessages.t.Errorf("ScheduledMessageRepositoryMock.ListScheduledMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListScheduledMessages.t.Errorf("ScheduledMessageRepositoryMock.ListScheduledMessages got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListScheduledMessages.t.Errorf("ScheduledMessageRepositoryMock.ListScheduledMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListScheduledMessages.t.Fatal("No results are set for the ScheduledMessageRepositoryMock.ListScheduledMessages")
+		}
+		return (*mm_results).messages, (*mm_results).err
+	}
+	if mmListScheduledMessages.funcListScheduledMessages != nil {
+		return mmListScheduledMessages.funcListScheduledMessages(ctx, params)
+	}
+	mmListScheduledMessages.t.Fatalf("Unexpected call to ScheduledMessageRepositoryMock.ListScheduledMessages. %v %v", ctx, params)
+	return
+}
+
+// ListScheduledMessagesAfterCounter returns a count of finished ScheduledMessageRepositoryMock.ListScheduledMessages invocations
+func (mmListScheduledMessages *ScheduledMessageRepositoryMock) ListScheduledMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduledMessages.afterListScheduledMessagesCounter)
+}
+
+// ListScheduledMessagesBeforeCounter returns a count of ScheduledMessageRepositoryMock.ListScheduledMessages invocations
+func (mmListScheduledMessages *ScheduledMessageRepositoryMock) ListScheduledMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduledMessages.beforeListScheduledMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledMessageRepositoryMock.ListScheduledMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListScheduledMessages *mScheduledMessageRepositoryMockListScheduledMessages) Calls() []*ScheduledMessageRepositoryMockListScheduledMessagesParams {
+	mmListScheduledMessages.mutex.RLock()
+
+	argCopy := make([]*ScheduledMessageRepositoryMockListScheduledMessagesParams, len(mmListScheduledMessages.callArgs))
+	copy(argCopy, mmListScheduledMessages.callArgs)
+
+	mmListScheduledMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListScheduledMessagesDone returns true if the count of the ListScheduledMessages invocations corresponds
+// the number of defined expectations
+func (m *ScheduledMessageRepositoryMock) MinimockListScheduledMessagesDone() bool {
+	if m.ListScheduledMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListScheduledMessagesMock.invocationsDone()
+}
+
+// MinimockListScheduledMessagesInspect logs each unmet expectation
+func (m *ScheduledMessageRepositoryMock) MinimockListScheduledMessagesInspect() {
+	for _, e := range m.ListScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.ListScheduledMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterListScheduledMessagesCounter := mm_atomic.LoadUint64(&m.afterListScheduledMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListScheduledMessagesMock.defaultExpectation != nil && afterListScheduledMessagesCounter < 1 {
+		if m.ListScheduledMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ScheduledMessageRepositoryMock.ListScheduledMessages")
+		} else {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.ListScheduledMessages with params: %#v", *m.ListScheduledMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListScheduledMessages != nil && afterListScheduledMessagesCounter < 1 {
+		m.t.Error("Expected call to ScheduledMessageRepositoryMock.ListScheduledMessages")
+	}
+
+	if !m.ListScheduledMessagesMock.invocationsDone() && afterListScheduledMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledMessageRepositoryMock.ListScheduledMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.ListScheduledMessagesMock.expectedInvocations), afterListScheduledMessagesCounter)
+	}
+}
+
+type mScheduledMessageRepositoryMockMarkScheduledMessageSent struct {
+	optional           bool
+	mock               *ScheduledMessageRepositoryMock
+	defaultExpectation *ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation
+	expectations       []*ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation
+
+	callArgs []*ScheduledMessageRepositoryMockMarkScheduledMessageSentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation specifies expectation struct of the ScheduledMessageRepository.MarkScheduledMessageSent
+type ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation struct {
+	mock      *ScheduledMessageRepositoryMock
+	params    *ScheduledMessageRepositoryMockMarkScheduledMessageSentParams
+	paramPtrs *ScheduledMessageRepositoryMockMarkScheduledMessageSentParamPtrs
+	results   *ScheduledMessageRepositoryMockMarkScheduledMessageSentResults
+	Counter   uint64
+}
+
+// ScheduledMessageRepositoryMockMarkScheduledMessageSentParams contains parameters of the ScheduledMessageRepository.MarkScheduledMessageSent
+type ScheduledMessageRepositoryMockMarkScheduledMessageSentParams struct {
+	ctx                context.Context
+	scheduledMessageID int64
+	sentAt             time.Time
+}
+
+// ScheduledMessageRepositoryMockMarkScheduledMessageSentParamPtrs contains pointers to parameters of the ScheduledMessageRepository.MarkScheduledMessageSent
+type ScheduledMessageRepositoryMockMarkScheduledMessageSentParamPtrs struct {
+	ctx                *context.Context
+	scheduledMessageID *int64
+	sentAt             *time.Time
+}
+
+// ScheduledMessageRepositoryMockMarkScheduledMessageSentResults contains results of the ScheduledMessageRepository.MarkScheduledMessageSent
+type ScheduledMessageRepositoryMockMarkScheduledMessageSentResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) Optional() *mScheduledMessageRepositoryMockMarkScheduledMessageSent {
+	mmMarkScheduledMessageSent.optional = true
+	return mmMarkScheduledMessageSent
+}
+
+// Expect sets up expected params for ScheduledMessageRepository.MarkScheduledMessageSent
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) Expect(ctx context.Context, scheduledMessageID int64, sentAt time.Time) *mScheduledMessageRepositoryMockMarkScheduledMessageSent {
+	if mmMarkScheduledMessageSent.mock.funcMarkScheduledMessageSent != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock is already set by Set")
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation == nil {
+		mmMarkScheduledMessageSent.defaultExpectation = &ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation{}
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation.paramPtrs != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock is already set by ExpectParams functions")
+	}
+
+	mmMarkScheduledMessageSent.defaultExpectation.params = &ScheduledMessageRepositoryMockMarkScheduledMessageSentParams{ctx, scheduledMessageID, sentAt}
+	for _, e := range mmMarkScheduledMessageSent.expectations {
+		if minimock.Equal(e.params, mmMarkScheduledMessageSent.defaultExpectation.params) {
+			mmMarkScheduledMessageSent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkScheduledMessageSent.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkScheduledMessageSent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledMessageRepository.MarkScheduledMessageSent
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) ExpectCtxParam1(ctx context.Context) *mScheduledMessageRepositoryMockMarkScheduledMessageSent {
+	if mmMarkScheduledMessageSent.mock.funcMarkScheduledMessageSent != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock is already set by Set")
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation == nil {
+		mmMarkScheduledMessageSent.defaultExpectation = &ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation{}
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation.params != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock is already set by Expect")
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation.paramPtrs == nil {
+		mmMarkScheduledMessageSent.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockMarkScheduledMessageSentParamPtrs{}
+	}
+	mmMarkScheduledMessageSent.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmMarkScheduledMessageSent
+}
+
+// ExpectScheduledMessageIDParam2 sets up expected param scheduledMessageID for ScheduledMessageRepository.MarkScheduledMessageSent
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) ExpectScheduledMessageIDParam2(scheduledMessageID int64) *mScheduledMessageRepositoryMockMarkScheduledMessageSent {
+	if mmMarkScheduledMessageSent.mock.funcMarkScheduledMessageSent != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock is already set by Set")
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation == nil {
+		mmMarkScheduledMessageSent.defaultExpectation = &ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation{}
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation.params != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock is already set by Expect")
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation.paramPtrs == nil {
+		mmMarkScheduledMessageSent.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockMarkScheduledMessageSentParamPtrs{}
+	}
+	mmMarkScheduledMessageSent.defaultExpectation.paramPtrs.scheduledMessageID = &scheduledMessageID
+
+	return mmMarkScheduledMessageSent
+}
+
+// ExpectSentAtParam3 sets up expected param sentAt for ScheduledMessageRepository.MarkScheduledMessageSent
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) ExpectSentAtParam3(sentAt time.Time) *mScheduledMessageRepositoryMockMarkScheduledMessageSent {
+	if mmMarkScheduledMessageSent.mock.funcMarkScheduledMessageSent != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock is already set by Set")
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation == nil {
+		mmMarkScheduledMessageSent.defaultExpectation = &ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation{}
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation.params != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock is already set by Expect")
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation.paramPtrs == nil {
+		mmMarkScheduledMessageSent.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockMarkScheduledMessageSentParamPtrs{}
+	}
+	mmMarkScheduledMessageSent.defaultExpectation.paramPtrs.sentAt = &sentAt
+
+	return mmMarkScheduledMessageSent
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledMessageRepository.MarkScheduledMessageSent
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) Inspect(f func(ctx context.Context, scheduledMessageID int64, sentAt time.Time)) *mScheduledMessageRepositoryMockMarkScheduledMessageSent {
+	if mmMarkScheduledMessageSent.mock.inspectFuncMarkScheduledMessageSent != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("Inspect function is already set for ScheduledMessageRepositoryMock.MarkScheduledMessageSent")
+	}
+
+	mmMarkScheduledMessageSent.mock.inspectFuncMarkScheduledMessageSent = f
+
+	return mmMarkScheduledMessageSent
+}
+
+// Return sets up results that will be returned by ScheduledMessageRepository.MarkScheduledMessageSent
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) Return(err error) *ScheduledMessageRepositoryMock {
+	if mmMarkScheduledMessageSent.mock.funcMarkScheduledMessageSent != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock is already set by Set")
+	}
+
+	if mmMarkScheduledMessageSent.defaultExpectation == nil {
+		mmMarkScheduledMessageSent.defaultExpectation = &ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation{mock: mmMarkScheduledMessageSent.mock}
+	}
+	mmMarkScheduledMessageSent.defaultExpectation.results = &ScheduledMessageRepositoryMockMarkScheduledMessageSentResults{err}
+	return mmMarkScheduledMessageSent.mock
+}
+
+// Set uses given function f to mock the ScheduledMessageRepository.MarkScheduledMessageSent method
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) Set(f func(ctx context.Context, scheduledMessageID int64, sentAt time.Time) (err error)) *ScheduledMessageRepositoryMock {
+	if mmMarkScheduledMessageSent.defaultExpectation != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("Default expectation is already set for the ScheduledMessageRepository.MarkScheduledMessageSent method")
+	}
+
+	if len(mmMarkScheduledMessageSent.expectations) > 0 {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("Some expectations are already set for the ScheduledMessageRepository.MarkScheduledMessageSent method")
+	}
+
+	mmMarkScheduledMessageSent.mock.funcMarkScheduledMessageSent = f
+	return mmMarkScheduledMessageSent.mock
+}
+
+// When sets expectation for the ScheduledMessageRepository.MarkScheduledMessageSent which will trigger the result defined by the following
+// Then helper
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) When(ctx context.Context, scheduledMessageID int64, sentAt time.Time) *ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation {
+	if mmMarkScheduledMessageSent.mock.funcMarkScheduledMessageSent != nil {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock is already set by Set")
+	}
+
+	expectation := &ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation{
+		mock:   mmMarkScheduledMessageSent.mock,
+		params: &ScheduledMessageRepositoryMockMarkScheduledMessageSentParams{ctx, scheduledMessageID, sentAt},
+	}
+	mmMarkScheduledMessageSent.expectations = append(mmMarkScheduledMessageSent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledMessageRepository.MarkScheduledMessageSent return parameters for the expectation previously defined by the When method
+func (e *ScheduledMessageRepositoryMockMarkScheduledMessageSentExpectation) Then(err error) *ScheduledMessageRepositoryMock {
+	e.results = &ScheduledMessageRepositoryMockMarkScheduledMessageSentResults{err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledMessageRepository.MarkScheduledMessageSent should be invoked
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) Times(n uint64) *mScheduledMessageRepositoryMockMarkScheduledMessageSent {
+	if n == 0 {
+		mmMarkScheduledMessageSent.mock.t.Fatalf("Times of ScheduledMessageRepositoryMock.MarkScheduledMessageSent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkScheduledMessageSent.expectedInvocations, n)
+	return mmMarkScheduledMessageSent
+}
+
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) invocationsDone() bool {
+	if len(mmMarkScheduledMessageSent.expectations) == 0 && mmMarkScheduledMessageSent.defaultExpectation == nil && mmMarkScheduledMessageSent.mock.funcMarkScheduledMessageSent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkScheduledMessageSent.mock.afterMarkScheduledMessageSentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkScheduledMessageSent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkScheduledMessageSent implements repository.ScheduledMessageRepository
+func (mmMarkScheduledMessageSent *ScheduledMessageRepositoryMock) MarkScheduledMessageSent(ctx context.Context, scheduledMessageID int64, sentAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkScheduledMessageSent.beforeMarkScheduledMessageSentCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkScheduledMessageSent.afterMarkScheduledMessageSentCounter, 1)
+
+	if mmMarkScheduledMessageSent.inspectFuncMarkScheduledMessageSent != nil {
+		mmMarkScheduledMessageSent.inspectFuncMarkScheduledMessageSent(ctx, scheduledMessageID, sentAt)
+	}
+
+	mm_params := ScheduledMessageRepositoryMockMarkScheduledMessageSentParams{ctx, scheduledMessageID, sentAt}
+
+	// Record call args
+	mmMarkScheduledMessageSent.MarkScheduledMessageSentMock.mutex.Lock()
+	mmMarkScheduledMessageSent.MarkScheduledMessageSentMock.callArgs = append(mmMarkScheduledMessageSent.MarkScheduledMessageSentMock.callArgs, &mm_params)
+	mmMarkScheduledMessageSent.MarkScheduledMessageSentMock.mutex.Unlock()
+
+	for _, e := range mmMarkScheduledMessageSent.MarkScheduledMessageSentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkScheduledMessageSent.MarkScheduledMessageSentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkScheduledMessageSent.MarkScheduledMessageSentMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkScheduledMessageSent.MarkScheduledMessageSentMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkScheduledMessageSent.MarkScheduledMessageSentMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledMessageRepositoryMockMarkScheduledMessageSentParams{ctx, scheduledMessageID, sentAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkScheduledMessageSent.t.Errorf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.scheduledMessageID != nil && !minimock.Equal(*mm_want_ptrs.scheduledMessageID, mm_got.scheduledMessageID) {
+				mmMarkScheduledMessageSent.t.Errorf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent got unexpected parameter scheduledMessageID, want: %#v, got: %#v%s\n", *mm_want_ptrs.scheduledMessageID, mm_got.scheduledMessageID, minimock.Diff(*mm_want_ptrs.scheduledMessageID, mm_got.scheduledMessageID))
+			}
+
+			if mm_want_ptrs.sentAt != nil && !minimock.Equal(*mm_want_ptrs.sentAt, mm_got.sentAt) {
+				mmMarkScheduledMessageSent.t.Errorf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent got unexpected parameter sentAt, want: %#v, got: %#v%s\n", *mm_want_ptrs.sentAt, mm_got.sentAt, minimock.Diff(*mm_want_ptrs.sentAt, mm_got.sentAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkScheduledMessageSent.t.Errorf("ScheduledMessageRepositoryMock.MarkScheduledMessageSent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkScheduledMessageSent.MarkScheduledMessageSentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkScheduledMessageSent.t.Fatal("No results are set for the ScheduledMessageRepositoryMock.MarkScheduledMessageSent")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkScheduledMessageSent.funcMarkScheduledMessageSent != nil {
+		return mmMarkScheduledMessageSent.funcMarkScheduledMessageSent(ctx, scheduledMessageID, sentAt)
+	}
+	mmMarkScheduledMessageSent.t.Fatalf("Unexpected call to ScheduledMessageRepositoryMock.MarkScheduledMessageSent. %v %v %v", ctx, scheduledMessageID, sentAt)
+	return
+}
+
+// MarkScheduledMessageSentAfterCounter returns a count of finished ScheduledMessageRepositoryMock.MarkScheduledMessageSent invocations
+func (mmMarkScheduledMessageSent *ScheduledMessageRepositoryMock) MarkScheduledMessageSentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkScheduledMessageSent.afterMarkScheduledMessageSentCounter)
+}
+
+// MarkScheduledMessageSentBeforeCounter returns a count of ScheduledMessageRepositoryMock.MarkScheduledMessageSent invocations
+func (mmMarkScheduledMessageSent *ScheduledMessageRepositoryMock) MarkScheduledMessageSentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkScheduledMessageSent.beforeMarkScheduledMessageSentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledMessageRepositoryMock.MarkScheduledMessageSent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkScheduledMessageSent *mScheduledMessageRepositoryMockMarkScheduledMessageSent) Calls() []*ScheduledMessageRepositoryMockMarkScheduledMessageSentParams {
+	mmMarkScheduledMessageSent.mutex.RLock()
+
+	argCopy := make([]*ScheduledMessageRepositoryMockMarkScheduledMessageSentParams, len(mmMarkScheduledMessageSent.callArgs))
+	copy(argCopy, mmMarkScheduledMessageSent.callArgs)
+
+	mmMarkScheduledMessageSent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkScheduledMessageSentDone returns true if the count of the MarkScheduledMessageSent invocations corresponds
+// the number of defined expectations
+func (m *ScheduledMessageRepositoryMock) MinimockMarkScheduledMessageSentDone() bool {
+	if m.MarkScheduledMessageSentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkScheduledMessageSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkScheduledMessageSentMock.invocationsDone()
+}
+
+// MinimockMarkScheduledMessageSentInspect logs each unmet expectation
+func (m *ScheduledMessageRepositoryMock) MinimockMarkScheduledMessageSentInspect() {
+	for _, e := range m.MarkScheduledMessageSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.MarkScheduledMessageSent with params: %#v", *e.params)
+		}
+	}
+
+	afterMarkScheduledMessageSentCounter := mm_atomic.LoadUint64(&m.afterMarkScheduledMessageSentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkScheduledMessageSentMock.defaultExpectation != nil && afterMarkScheduledMessageSentCounter < 1 {
+		if m.MarkScheduledMessageSentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ScheduledMessageRepositoryMock.MarkScheduledMessageSent")
+		} else {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.MarkScheduledMessageSent with params: %#v", *m.MarkScheduledMessageSentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkScheduledMessageSent != nil && afterMarkScheduledMessageSentCounter < 1 {
+		m.t.Error("Expected call to ScheduledMessageRepositoryMock.MarkScheduledMessageSent")
+	}
+
+	if !m.MarkScheduledMessageSentMock.invocationsDone() && afterMarkScheduledMessageSentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledMessageRepositoryMock.MarkScheduledMessageSent but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkScheduledMessageSentMock.expectedInvocations), afterMarkScheduledMessageSentCounter)
+	}
+}
+
+type mScheduledMessageRepositoryMockRecordScheduledMessageFailure struct {
+	optional           bool
+	mock               *ScheduledMessageRepositoryMock
+	defaultExpectation *ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation
+	expectations       []*ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation
+
+	callArgs []*ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation specifies expectation struct of the ScheduledMessageRepository.RecordScheduledMessageFailure
+type ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation struct {
+	mock      *ScheduledMessageRepositoryMock
+	params    *ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams
+	paramPtrs *ScheduledMessageRepositoryMockRecordScheduledMessageFailureParamPtrs
+	results   *ScheduledMessageRepositoryMockRecordScheduledMessageFailureResults
+	Counter   uint64
+}
+
+// ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams contains parameters of the ScheduledMessageRepository.RecordScheduledMessageFailure
+type ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams struct {
+	ctx    context.Context
+	params model.RecordScheduledMessageFailureParams
+}
+
+// ScheduledMessageRepositoryMockRecordScheduledMessageFailureParamPtrs contains pointers to parameters of the ScheduledMessageRepository.RecordScheduledMessageFailure
+type ScheduledMessageRepositoryMockRecordScheduledMessageFailureParamPtrs struct {
+	ctx    *context.Context
+	params *model.RecordScheduledMessageFailureParams
+}
+
+// ScheduledMessageRepositoryMockRecordScheduledMessageFailureResults contains results of the ScheduledMessageRepository.RecordScheduledMessageFailure
+type ScheduledMessageRepositoryMockRecordScheduledMessageFailureResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) Optional() *mScheduledMessageRepositoryMockRecordScheduledMessageFailure {
+	mmRecordScheduledMessageFailure.optional = true
+	return mmRecordScheduledMessageFailure
+}
+
+// Expect sets up expected params for ScheduledMessageRepository.RecordScheduledMessageFailure
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) Expect(ctx context.Context, params model.RecordScheduledMessageFailureParams) *mScheduledMessageRepositoryMockRecordScheduledMessageFailure {
+	if mmRecordScheduledMessageFailure.mock.funcRecordScheduledMessageFailure != nil {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure mock is already set by Set")
+	}
+
+	if mmRecordScheduledMessageFailure.defaultExpectation == nil {
+		mmRecordScheduledMessageFailure.defaultExpectation = &ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation{}
+	}
+
+	if mmRecordScheduledMessageFailure.defaultExpectation.paramPtrs != nil {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure mock is already set by ExpectParams functions")
+	}
+
+	mmRecordScheduledMessageFailure.defaultExpectation.params = &ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams{ctx, params}
+	for _, e := range mmRecordScheduledMessageFailure.expectations {
+		if minimock.Equal(e.params, mmRecordScheduledMessageFailure.defaultExpectation.params) {
+			mmRecordScheduledMessageFailure.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordScheduledMessageFailure.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordScheduledMessageFailure
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledMessageRepository.RecordScheduledMessageFailure
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) ExpectCtxParam1(ctx context.Context) *mScheduledMessageRepositoryMockRecordScheduledMessageFailure {
+	if mmRecordScheduledMessageFailure.mock.funcRecordScheduledMessageFailure != nil {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure mock is already set by Set")
+	}
+
+	if mmRecordScheduledMessageFailure.defaultExpectation == nil {
+		mmRecordScheduledMessageFailure.defaultExpectation = &ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation{}
+	}
+
+	if mmRecordScheduledMessageFailure.defaultExpectation.params != nil {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure mock is already set by Expect")
+	}
+
+	if mmRecordScheduledMessageFailure.defaultExpectation.paramPtrs == nil {
+		mmRecordScheduledMessageFailure.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockRecordScheduledMessageFailureParamPtrs{}
+	}
+	mmRecordScheduledMessageFailure.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRecordScheduledMessageFailure
+}
+
+// ExpectParamsParam2 sets up expected param params for ScheduledMessageRepository.RecordScheduledMessageFailure
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) ExpectParamsParam2(params model.RecordScheduledMessageFailureParams) *mScheduledMessageRepositoryMockRecordScheduledMessageFailure {
+	if mmRecordScheduledMessageFailure.mock.funcRecordScheduledMessageFailure != nil {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure mock is already set by Set")
+	}
+
+	if mmRecordScheduledMessageFailure.defaultExpectation == nil {
+		mmRecordScheduledMessageFailure.defaultExpectation = &ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation{}
+	}
+
+	if mmRecordScheduledMessageFailure.defaultExpectation.params != nil {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure mock is already set by Expect")
+	}
+
+	if mmRecordScheduledMessageFailure.defaultExpectation.paramPtrs == nil {
+		mmRecordScheduledMessageFailure.defaultExpectation.paramPtrs = &ScheduledMessageRepositoryMockRecordScheduledMessageFailureParamPtrs{}
+	}
+	mmRecordScheduledMessageFailure.defaultExpectation.paramPtrs.params = &params
+
+	return mmRecordScheduledMessageFailure
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledMessageRepository.RecordScheduledMessageFailure
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) Inspect(f func(ctx context.Context, params model.RecordScheduledMessageFailureParams)) *mScheduledMessageRepositoryMockRecordScheduledMessageFailure {
+	if mmRecordScheduledMessageFailure.mock.inspectFuncRecordScheduledMessageFailure != nil {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("Inspect function is already set for ScheduledMessageRepositoryMock.RecordScheduledMessageFailure")
+	}
+
+	mmRecordScheduledMessageFailure.mock.inspectFuncRecordScheduledMessageFailure = f
+
+	return mmRecordScheduledMessageFailure
+}
+
+// Return sets up results that will be returned by ScheduledMessageRepository.RecordScheduledMessageFailure
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) Return(err error) *ScheduledMessageRepositoryMock {
+	if mmRecordScheduledMessageFailure.mock.funcRecordScheduledMessageFailure != nil {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure mock is already set by Set")
+	}
+
+	if mmRecordScheduledMessageFailure.defaultExpectation == nil {
+		mmRecordScheduledMessageFailure.defaultExpectation = &ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation{mock: mmRecordScheduledMessageFailure.mock}
+	}
+	mmRecordScheduledMessageFailure.defaultExpectation.results = &ScheduledMessageRepositoryMockRecordScheduledMessageFailureResults{err}
+	return mmRecordScheduledMessageFailure.mock
+}
+
+// Set uses given function f to mock the ScheduledMessageRepository.RecordScheduledMessageFailure method
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) Set(f func(ctx context.Context, params model.RecordScheduledMessageFailureParams) (err error)) *ScheduledMessageRepositoryMock {
+	if mmRecordScheduledMessageFailure.defaultExpectation != nil {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("Default expectation is already set for the ScheduledMessageRepository.RecordScheduledMessageFailure method")
+	}
+
+	if len(mmRecordScheduledMessageFailure.expectations) > 0 {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("Some expectations are already set for the ScheduledMessageRepository.RecordScheduledMessageFailure method")
+	}
+
+	mmRecordScheduledMessageFailure.mock.funcRecordScheduledMessageFailure = f
+	return mmRecordScheduledMessageFailure.mock
+}
+
+// When sets expectation for the ScheduledMessageRepository.RecordScheduledMessageFailure which will trigger the result defined by the following
+// Then helper
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) When(ctx context.Context, params model.RecordScheduledMessageFailureParams) *ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation {
+	if mmRecordScheduledMessageFailure.mock.funcRecordScheduledMessageFailure != nil {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure mock is already set by Set")
+	}
+
+	expectation := &ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation{
+		mock:   mmRecordScheduledMessageFailure.mock,
+		params: &ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams{ctx, params},
+	}
+	mmRecordScheduledMessageFailure.expectations = append(mmRecordScheduledMessageFailure.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledMessageRepository.RecordScheduledMessageFailure return parameters for the expectation previously defined by the When method
+func (e *ScheduledMessageRepositoryMockRecordScheduledMessageFailureExpectation) Then(err error) *ScheduledMessageRepositoryMock {
+	e.results = &ScheduledMessageRepositoryMockRecordScheduledMessageFailureResults{err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledMessageRepository.RecordScheduledMessageFailure should be invoked
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) Times(n uint64) *mScheduledMessageRepositoryMockRecordScheduledMessageFailure {
+	if n == 0 {
+		mmRecordScheduledMessageFailure.mock.t.Fatalf("Times of ScheduledMessageRepositoryMock.RecordScheduledMessageFailure mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordScheduledMessageFailure.expectedInvocations, n)
+	return mmRecordScheduledMessageFailure
+}
+
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) invocationsDone() bool {
+	if len(mmRecordScheduledMessageFailure.expectations) == 0 && mmRecordScheduledMessageFailure.defaultExpectation == nil && mmRecordScheduledMessageFailure.mock.funcRecordScheduledMessageFailure == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordScheduledMessageFailure.mock.afterRecordScheduledMessageFailureCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordScheduledMessageFailure.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordScheduledMessageFailure implements repository.ScheduledMessageRepository
+func (mmRecordScheduledMessageFailure *ScheduledMessageRepositoryMock) RecordScheduledMessageFailure(ctx context.Context, params model.RecordScheduledMessageFailureParams) (err error) {
+	mm_atomic.AddUint64(&mmRecordScheduledMessageFailure.beforeRecordScheduledMessageFailureCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordScheduledMessageFailure.afterRecordScheduledMessageFailureCounter, 1)
+
+	if mmRecordScheduledMessageFailure.inspectFuncRecordScheduledMessageFailure != nil {
+		mmRecordScheduledMessageFailure.inspectFuncRecordScheduledMessageFailure(ctx, params)
+	}
+
+	mm_params := ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams{ctx, params}
+
+	// Record call args
+	mmRecordScheduledMessageFailure.RecordScheduledMessageFailureMock.mutex.Lock()
+	mmRecordScheduledMessageFailure.RecordScheduledMessageFailureMock.callArgs = append(mmRecordScheduledMessageFailure.RecordScheduledMessageFailureMock.callArgs, &mm_params)
+	mmRecordScheduledMessageFailure.RecordScheduledMessageFailureMock.mutex.Unlock()
+
+	for _, e := range mmRecordScheduledMessageFailure.RecordScheduledMessageFailureMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecordScheduledMessageFailure.RecordScheduledMessageFailureMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordScheduledMessageFailure.RecordScheduledMessageFailureMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordScheduledMessageFailure.RecordScheduledMessageFailureMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordScheduledMessageFailure.RecordScheduledMessageFailureMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordScheduledMessageFailure.t.Errorf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmRecordScheduledMessageFailure.t.Errorf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordScheduledMessageFailure.t.Errorf("ScheduledMessageRepositoryMock.RecordScheduledMessageFailure got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordScheduledMessageFailure.RecordScheduledMessageFailureMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordScheduledMessageFailure.t.Fatal("No results are set for the ScheduledMessageRepositoryMock.RecordScheduledMessageFailure")
+		}
+		return (*mm_results).err
+	}
+	if mmRecordScheduledMessageFailure.funcRecordScheduledMessageFailure != nil {
+		return mmRecordScheduledMessageFailure.funcRecordScheduledMessageFailure(ctx, params)
+	}
+	mmRecordScheduledMessageFailure.t.Fatalf("Unexpected call to ScheduledMessageRepositoryMock.RecordScheduledMessageFailure. %v %v", ctx, params)
+	return
+}
+
+// RecordScheduledMessageFailureAfterCounter returns a count of finished ScheduledMessageRepositoryMock.RecordScheduledMessageFailure invocations
+func (mmRecordScheduledMessageFailure *ScheduledMessageRepositoryMock) RecordScheduledMessageFailureAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordScheduledMessageFailure.afterRecordScheduledMessageFailureCounter)
+}
+
+// RecordScheduledMessageFailureBeforeCounter returns a count of ScheduledMessageRepositoryMock.RecordScheduledMessageFailure invocations
+func (mmRecordScheduledMessageFailure *ScheduledMessageRepositoryMock) RecordScheduledMessageFailureBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordScheduledMessageFailure.beforeRecordScheduledMessageFailureCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledMessageRepositoryMock.RecordScheduledMessageFailure.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordScheduledMessageFailure *mScheduledMessageRepositoryMockRecordScheduledMessageFailure) Calls() []*ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams {
+	mmRecordScheduledMessageFailure.mutex.RLock()
+
+	argCopy := make([]*ScheduledMessageRepositoryMockRecordScheduledMessageFailureParams, len(mmRecordScheduledMessageFailure.callArgs))
+	copy(argCopy, mmRecordScheduledMessageFailure.callArgs)
+
+	mmRecordScheduledMessageFailure.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordScheduledMessageFailureDone returns true if the count of the RecordScheduledMessageFailure invocations corresponds
+// the number of defined expectations
+func (m *ScheduledMessageRepositoryMock) MinimockRecordScheduledMessageFailureDone() bool {
+	if m.RecordScheduledMessageFailureMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordScheduledMessageFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordScheduledMessageFailureMock.invocationsDone()
+}
+
+// MinimockRecordScheduledMessageFailureInspect logs each unmet expectation
+func (m *ScheduledMessageRepositoryMock) MinimockRecordScheduledMessageFailureInspect() {
+	for _, e := range m.RecordScheduledMessageFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.RecordScheduledMessageFailure with params: %#v", *e.params)
+		}
+	}
+
+	afterRecordScheduledMessageFailureCounter := mm_atomic.LoadUint64(&m.afterRecordScheduledMessageFailureCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordScheduledMessageFailureMock.defaultExpectation != nil && afterRecordScheduledMessageFailureCounter < 1 {
+		if m.RecordScheduledMessageFailureMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ScheduledMessageRepositoryMock.RecordScheduledMessageFailure")
+		} else {
+			m.t.Errorf("Expected call to ScheduledMessageRepositoryMock.RecordScheduledMessageFailure with params: %#v", *m.RecordScheduledMessageFailureMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordScheduledMessageFailure != nil && afterRecordScheduledMessageFailureCounter < 1 {
+		m.t.Error("Expected call to ScheduledMessageRepositoryMock.RecordScheduledMessageFailure")
+	}
+
+	if !m.RecordScheduledMessageFailureMock.invocationsDone() && afterRecordScheduledMessageFailureCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledMessageRepositoryMock.RecordScheduledMessageFailure but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordScheduledMessageFailureMock.expectedInvocations), afterRecordScheduledMessageFailureCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ScheduledMessageRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCancelScheduledMessageInspect()
+
+			m.MinimockCreateScheduledMessageInspect()
+
+			m.MinimockDeleteFinishedScheduledMessagesInspect()
+
+			m.MinimockListDueScheduledMessagesInspect()
+
+			m.MinimockListScheduledMessagesInspect()
+
+			m.MinimockMarkScheduledMessageSentInspect()
+
+			m.MinimockRecordScheduledMessageFailureInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ScheduledMessageRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ScheduledMessageRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCancelScheduledMessageDone() &&
+		m.MinimockCreateScheduledMessageDone() &&
+		m.MinimockDeleteFinishedScheduledMessagesDone() &&
+		m.MinimockListDueScheduledMessagesDone() &&
+		m.MinimockListScheduledMessagesDone() &&
+		m.MinimockMarkScheduledMessageSentDone() &&
+		m.MinimockRecordScheduledMessageFailureDone()
+}
@@ -0,0 +1,1061 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.UserRepository -o user_repository_minimock.go -n UserRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// UserRepositoryMock implements repository.UserRepository
+type UserRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetUser          func(ctx context.Context, params model.GetUserParams) (user model.User, err error)
+	inspectFuncGetUser   func(ctx context.Context, params model.GetUserParams)
+	afterGetUserCounter  uint64
+	beforeGetUserCounter uint64
+	GetUserMock          mUserRepositoryMockGetUser
+
+	funcSearchUsers          func(ctx context.Context, params model.SearchUsersParams) (users []model.User, err error)
+	inspectFuncSearchUsers   func(ctx context.Context, params model.SearchUsersParams)
+	afterSearchUsersCounter  uint64
+	beforeSearchUsersCounter uint64
+	SearchUsersMock          mUserRepositoryMockSearchUsers
+
+	funcUpdateProfile          func(ctx context.Context, params model.UpdateProfileParams) (user model.User, err error)
+	inspectFuncUpdateProfile   func(ctx context.Context, params model.UpdateProfileParams)
+	afterUpdateProfileCounter  uint64
+	beforeUpdateProfileCounter uint64
+	UpdateProfileMock          mUserRepositoryMockUpdateProfile
+}
+
+// NewUserRepositoryMock returns a mock for repository.UserRepository
+func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
+	m := &UserRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetUserMock = mUserRepositoryMockGetUser{mock: m}
+	m.GetUserMock.callArgs = []*UserRepositoryMockGetUserParams{}
+
+	m.SearchUsersMock = mUserRepositoryMockSearchUsers{mock: m}
+	m.SearchUsersMock.callArgs = []*UserRepositoryMockSearchUsersParams{}
+
+	m.UpdateProfileMock = mUserRepositoryMockUpdateProfile{mock: m}
+	m.UpdateProfileMock.callArgs = []*UserRepositoryMockUpdateProfileParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUserRepositoryMockGetUser struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetUserExpectation
+	expectations       []*UserRepositoryMockGetUserExpectation
+
+	callArgs []*UserRepositoryMockGetUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockGetUserExpectation specifies expectation struct of the UserRepository.GetUser
+type UserRepositoryMockGetUserExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockGetUserParams
+	paramPtrs *UserRepositoryMockGetUserParamPtrs
+	results   *UserRepositoryMockGetUserResults
+	Counter   uint64
+}
+
+// UserRepositoryMockGetUserParams contains parameters of the UserRepository.GetUser
+type UserRepositoryMockGetUserParams struct {
+	ctx    context.Context
+	params model.GetUserParams
+}
+
+// UserRepositoryMockGetUserParamPtrs contains pointers to parameters of the UserRepository.GetUser
+type UserRepositoryMockGetUserParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetUserParams
+}
+
+// UserRepositoryMockGetUserResults contains results of the UserRepository.GetUser
+type UserRepositoryMockGetUserResults struct {
+	user model.User
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUser *mUserRepositoryMockGetUser) Optional() *mUserRepositoryMockGetUser {
+	mmGetUser.optional = true
+	return mmGetUser
+}
+
+// Expect sets up expected params for UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) Expect(ctx context.Context, params model.GetUserParams) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by ExpectParams functions")
+	}
+
+	mmGetUser.defaultExpectation.params = &UserRepositoryMockGetUserParams{ctx, params}
+	for _, e := range mmGetUser.expectations {
+		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
+			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetUser
+}
+
+// ExpectParamsParam2 sets up expected param params for UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) ExpectParamsParam2(params model.GetUserParams) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.params = &params
+
+	return mmGetUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) Inspect(f func(ctx context.Context, params model.GetUserParams)) *mUserRepositoryMockGetUser {
+	if mmGetUser.mock.inspectFuncGetUser != nil {
+		mmGetUser.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUser")
+	}
+
+	mmGetUser.mock.inspectFuncGetUser = f
+
+	return mmGetUser
+}
+
+// Return sets up results that will be returned by UserRepository.GetUser
+func (mmGetUser *mUserRepositoryMockGetUser) Return(user model.User, err error) *UserRepositoryMock {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserRepositoryMockGetUserExpectation{mock: mmGetUser.mock}
+	}
+	mmGetUser.defaultExpectation.results = &UserRepositoryMockGetUserResults{user, err}
+	return mmGetUser.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetUser method
+func (mmGetUser *mUserRepositoryMockGetUser) Set(f func(ctx context.Context, params model.GetUserParams) (user model.User, err error)) *UserRepositoryMock {
+	if mmGetUser.defaultExpectation != nil {
+		mmGetUser.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUser method")
+	}
+
+	if len(mmGetUser.expectations) > 0 {
+		mmGetUser.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUser method")
+	}
+
+	mmGetUser.mock.funcGetUser = f
+	return mmGetUser.mock
+}
+
+// When sets expectation for the UserRepository.GetUser which will trigger the result defined by the following
+// Then helper
+func (mmGetUser *mUserRepositoryMockGetUser) When(ctx context.Context, params model.GetUserParams) *UserRepositoryMockGetUserExpectation {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserRepositoryMock.GetUser mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetUserExpectation{
+		mock:   mmGetUser.mock,
+		params: &UserRepositoryMockGetUserParams{ctx, params},
+	}
+	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetUser return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetUserExpectation) Then(user model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetUserResults{user, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetUser should be invoked
+func (mmGetUser *mUserRepositoryMockGetUser) Times(n uint64) *mUserRepositoryMockGetUser {
+	if n == 0 {
+		mmGetUser.mock.t.Fatalf("Times of UserRepositoryMock.GetUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
+	return mmGetUser
+}
+
+func (mmGetUser *mUserRepositoryMockGetUser) invocationsDone() bool {
+	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUser implements repository.UserRepository
+func (mmGetUser *UserRepositoryMock) GetUser(ctx context.Context, params model.GetUserParams) (user model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+
+	if mmGetUser.inspectFuncGetUser != nil {
+		mmGetUser.inspectFuncGetUser(ctx, params)
+	}
+
+	mm_params := UserRepositoryMockGetUserParams{ctx, params}
+
+	// Record call args
+	mmGetUser.GetUserMock.mutex.Lock()
+	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
+	mmGetUser.GetUserMock.mutex.Unlock()
+
+	for _, e := range mmGetUser.GetUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.user, e.results.err
+		}
+	}
+
+	if mmGetUser.GetUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetUserParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUser.t.Errorf("UserRepositoryMock.GetUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUser.t.Fatal("No results are set for the UserRepositoryMock.GetUser")
+		}
+		return (*mm_results).user, (*mm_results).err
+	}
+	if mmGetUser.funcGetUser != nil {
+		return mmGetUser.funcGetUser(ctx, params)
+	}
+	mmGetUser.t.Fatalf("Unexpected call to UserRepositoryMock.GetUser. %v %v", ctx, params)
+	return
+}
+
+// GetUserAfterCounter returns a count of finished UserRepositoryMock.GetUser invocations
+func (mmGetUser *UserRepositoryMock) GetUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+}
+
+// GetUserBeforeCounter returns a count of UserRepositoryMock.GetUser invocations
+func (mmGetUser *UserRepositoryMock) GetUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUser *mUserRepositoryMockGetUser) Calls() []*UserRepositoryMockGetUserParams {
+	mmGetUser.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetUserParams, len(mmGetUser.callArgs))
+	copy(argCopy, mmGetUser.callArgs)
+
+	mmGetUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetUserDone() bool {
+	if m.GetUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserMock.invocationsDone()
+}
+
+// MinimockGetUserInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetUserInspect() {
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUser with params: %#v", *e.params)
+		}
+	}
+
+	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
+		if m.GetUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.GetUser")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUser with params: %#v", *m.GetUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUser != nil && afterGetUserCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.GetUser")
+	}
+
+	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUser but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), afterGetUserCounter)
+	}
+}
+
+type mUserRepositoryMockSearchUsers struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockSearchUsersExpectation
+	expectations       []*UserRepositoryMockSearchUsersExpectation
+
+	callArgs []*UserRepositoryMockSearchUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockSearchUsersExpectation specifies expectation struct of the UserRepository.SearchUsers
+type UserRepositoryMockSearchUsersExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockSearchUsersParams
+	paramPtrs *UserRepositoryMockSearchUsersParamPtrs
+	results   *UserRepositoryMockSearchUsersResults
+	Counter   uint64
+}
+
+// UserRepositoryMockSearchUsersParams contains parameters of the UserRepository.SearchUsers
+type UserRepositoryMockSearchUsersParams struct {
+	ctx    context.Context
+	params model.SearchUsersParams
+}
+
+// UserRepositoryMockSearchUsersParamPtrs contains pointers to parameters of the UserRepository.SearchUsers
+type UserRepositoryMockSearchUsersParamPtrs struct {
+	ctx    *context.Context
+	params *model.SearchUsersParams
+}
+
+// UserRepositoryMockSearchUsersResults contains results of the UserRepository.SearchUsers
+type UserRepositoryMockSearchUsersResults struct {
+	users []model.User
+	err   error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) Optional() *mUserRepositoryMockSearchUsers {
+	mmSearchUsers.optional = true
+	return mmSearchUsers
+}
+
+// Expect sets up expected params for UserRepository.SearchUsers
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) Expect(ctx context.Context, params model.SearchUsersParams) *mUserRepositoryMockSearchUsers {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserRepositoryMock.SearchUsers mock is already set by Set")
+	}
+
+	if mmSearchUsers.defaultExpectation == nil {
+		mmSearchUsers.defaultExpectation = &UserRepositoryMockSearchUsersExpectation{}
+	}
+
+	if mmSearchUsers.defaultExpectation.paramPtrs != nil {
+		mmSearchUsers.mock.t.Fatalf("UserRepositoryMock.SearchUsers mock is already set by ExpectParams functions")
+	}
+
+	mmSearchUsers.defaultExpectation.params = &UserRepositoryMockSearchUsersParams{ctx, params}
+	for _, e := range mmSearchUsers.expectations {
+		if minimock.Equal(e.params, mmSearchUsers.defaultExpectation.params) {
+			mmSearchUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.SearchUsers
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockSearchUsers {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserRepositoryMock.SearchUsers mock is already set by Set")
+	}
+
+	if mmSearchUsers.defaultExpectation == nil {
+		mmSearchUsers.defaultExpectation = &UserRepositoryMockSearchUsersExpectation{}
+	}
+
+	if mmSearchUsers.defaultExpectation.params != nil {
+		mmSearchUsers.mock.t.Fatalf("UserRepositoryMock.SearchUsers mock is already set by Expect")
+	}
+
+	if mmSearchUsers.defaultExpectation.paramPtrs == nil {
+		mmSearchUsers.defaultExpectation.paramPtrs = &UserRepositoryMockSearchUsersParamPtrs{}
+	}
+	mmSearchUsers.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSearchUsers
+}
+
+// ExpectParamsParam2 sets up expected param params for UserRepository.SearchUsers
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) ExpectParamsParam2(params model.SearchUsersParams) *mUserRepositoryMockSearchUsers {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserRepositoryMock.SearchUsers mock is already set by Set")
+	}
+
+	if mmSearchUsers.defaultExpectation == nil {
+		mmSearchUsers.defaultExpectation = &UserRepositoryMockSearchUsersExpectation{}
+	}
+
+	if mmSearchUsers.defaultExpectation.params != nil {
+		mmSearchUsers.mock.t.Fatalf("UserRepositoryMock.SearchUsers mock is already set by Expect")
+	}
+
+	if mmSearchUsers.defaultExpectation.paramPtrs == nil {
+		mmSearchUsers.defaultExpectation.paramPtrs = &UserRepositoryMockSearchUsersParamPtrs{}
+	}
+	mmSearchUsers.defaultExpectation.paramPtrs.params = &params
+
+	return mmSearchUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.SearchUsers
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) Inspect(f func(ctx context.Context, params model.SearchUsersParams)) *mUserRepositoryMockSearchUsers {
+	if mmSearchUsers.mock.inspectFuncSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.SearchUsers")
+	}
+
+	mmSearchUsers.mock.inspectFuncSearchUsers = f
+
+	return mmSearchUsers
+}
+
+// Return sets up results that will be returned by UserRepository.SearchUsers
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) Return(users []model.User, err error) *UserRepositoryMock {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserRepositoryMock.SearchUsers mock is already set by Set")
+	}
+
+	if mmSearchUsers.defaultExpectation == nil {
+		mmSearchUsers.defaultExpectation = &UserRepositoryMockSearchUsersExpectation{mock: mmSearchUsers.mock}
+	}
+	mmSearchUsers.defaultExpectation.results = &UserRepositoryMockSearchUsersResults{users, err}
+	return mmSearchUsers.mock
+}
+
+// Set uses given function f to mock the UserRepository.SearchUsers method
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) Set(f func(ctx context.Context, params model.SearchUsersParams) (users []model.User, err error)) *UserRepositoryMock {
+	if mmSearchUsers.defaultExpectation != nil {
+		mmSearchUsers.mock.t.Fatalf("Default expectation is already set for the UserRepository.SearchUsers method")
+	}
+
+	if len(mmSearchUsers.expectations) > 0 {
+		mmSearchUsers.mock.t.Fatalf("Some expectations are already set for the UserRepository.SearchUsers method")
+	}
+
+	mmSearchUsers.mock.funcSearchUsers = f
+	return mmSearchUsers.mock
+}
+
+// When sets expectation for the UserRepository.SearchUsers which will trigger the result defined by the following
+// Then helper
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) When(ctx context.Context, params model.SearchUsersParams) *UserRepositoryMockSearchUsersExpectation {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserRepositoryMock.SearchUsers mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockSearchUsersExpectation{
+		mock:   mmSearchUsers.mock,
+		params: &UserRepositoryMockSearchUsersParams{ctx, params},
+	}
+	mmSearchUsers.expectations = append(mmSearchUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.SearchUsers return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockSearchUsersExpectation) Then(users []model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockSearchUsersResults{users, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.SearchUsers should be invoked
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) Times(n uint64) *mUserRepositoryMockSearchUsers {
+	if n == 0 {
+		mmSearchUsers.mock.t.Fatalf("Times of UserRepositoryMock.SearchUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchUsers.expectedInvocations, n)
+	return mmSearchUsers
+}
+
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) invocationsDone() bool {
+	if len(mmSearchUsers.expectations) == 0 && mmSearchUsers.defaultExpectation == nil && mmSearchUsers.mock.funcSearchUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchUsers.mock.afterSearchUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchUsers implements repository.UserRepository
+func (mmSearchUsers *UserRepositoryMock) SearchUsers(ctx context.Context, params model.SearchUsersParams) (users []model.User, err error) {
+	mm_atomic.AddUint64(&mmSearchUsers.beforeSearchUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchUsers.afterSearchUsersCounter, 1)
+
+	if mmSearchUsers.inspectFuncSearchUsers != nil {
+		mmSearchUsers.inspectFuncSearchUsers(ctx, params)
+	}
+
+	mm_params := UserRepositoryMockSearchUsersParams{ctx, params}
+
+	// Record call args
+	mmSearchUsers.SearchUsersMock.mutex.Lock()
+	mmSearchUsers.SearchUsersMock.callArgs = append(mmSearchUsers.SearchUsersMock.callArgs, &mm_params)
+	mmSearchUsers.SearchUsersMock.mutex.Unlock()
+
+	for _, e := range mmSearchUsers.SearchUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.users, e.results.err
+		}
+	}
+
+	if mmSearchUsers.SearchUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchUsers.SearchUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchUsers.SearchUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchUsers.SearchUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockSearchUsersParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchUsers.t.Errorf("UserRepositoryMock.SearchUsers got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmSearchUsers.t.Errorf("UserRepositoryMock.SearchUsers got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchUsers.t.Errorf("UserRepositoryMock.SearchUsers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchUsers.SearchUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchUsers.t.Fatal("No results are set for the UserRepositoryMock.SearchUsers")
+		}
+		return (*mm_results).users, (*mm_results).err
+	}
+	if mmSearchUsers.funcSearchUsers != nil {
+		return mmSearchUsers.funcSearchUsers(ctx, params)
+	}
+	mmSearchUsers.t.Fatalf("Unexpected call to UserRepositoryMock.SearchUsers. %v %v", ctx, params)
+	return
+}
+
+// SearchUsersAfterCounter returns a count of finished UserRepositoryMock.SearchUsers invocations
+func (mmSearchUsers *UserRepositoryMock) SearchUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchUsers.afterSearchUsersCounter)
+}
+
+// SearchUsersBeforeCounter returns a count of UserRepositoryMock.SearchUsers invocations
+func (mmSearchUsers *UserRepositoryMock) SearchUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchUsers.beforeSearchUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.SearchUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchUsers *mUserRepositoryMockSearchUsers) Calls() []*UserRepositoryMockSearchUsersParams {
+	mmSearchUsers.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockSearchUsersParams, len(mmSearchUsers.callArgs))
+	copy(argCopy, mmSearchUsers.callArgs)
+
+	mmSearchUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchUsersDone returns true if the count of the SearchUsers invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockSearchUsersDone() bool {
+	if m.SearchUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchUsersMock.invocationsDone()
+}
+
+// MinimockSearchUsersInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockSearchUsersInspect() {
+	for _, e := range m.SearchUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.SearchUsers with params: %#v", *e.params)
+		}
+	}
+
+	afterSearchUsersCounter := mm_atomic.LoadUint64(&m.afterSearchUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchUsersMock.defaultExpectation != nil && afterSearchUsersCounter < 1 {
+		if m.SearchUsersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.SearchUsers")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.SearchUsers with params: %#v", *m.SearchUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchUsers != nil && afterSearchUsersCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.SearchUsers")
+	}
+
+	if !m.SearchUsersMock.invocationsDone() && afterSearchUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.SearchUsers but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchUsersMock.expectedInvocations), afterSearchUsersCounter)
+	}
+}
+
+type mUserRepositoryMockUpdateProfile struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdateProfileExpectation
+	expectations       []*UserRepositoryMockUpdateProfileExpectation
+
+	callArgs []*UserRepositoryMockUpdateProfileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockUpdateProfileExpectation specifies expectation struct of the UserRepository.UpdateProfile
+type UserRepositoryMockUpdateProfileExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockUpdateProfileParams
+	paramPtrs *UserRepositoryMockUpdateProfileParamPtrs
+	results   *UserRepositoryMockUpdateProfileResults
+	Counter   uint64
+}
+
+// UserRepositoryMockUpdateProfileParams contains parameters of the UserRepository.UpdateProfile
+type UserRepositoryMockUpdateProfileParams struct {
+	ctx    context.Context
+	params model.UpdateProfileParams
+}
+
+// UserRepositoryMockUpdateProfileParamPtrs contains pointers to parameters of the UserRepository.UpdateProfile
+type UserRepositoryMockUpdateProfileParamPtrs struct {
+	ctx    *context.Context
+	params *model.UpdateProfileParams
+}
+
+// UserRepositoryMockUpdateProfileResults contains results of the UserRepository.UpdateProfile
+type UserRepositoryMockUpdateProfileResults struct {
+	user model.User
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) Optional() *mUserRepositoryMockUpdateProfile {
+	mmUpdateProfile.optional = true
+	return mmUpdateProfile
+}
+
+// Expect sets up expected params for UserRepository.UpdateProfile
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) Expect(ctx context.Context, params model.UpdateProfileParams) *mUserRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserRepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &UserRepositoryMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserRepositoryMock.UpdateProfile mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateProfile.defaultExpectation.params = &UserRepositoryMockUpdateProfileParams{ctx, params}
+	for _, e := range mmUpdateProfile.expectations {
+		if minimock.Equal(e.params, mmUpdateProfile.defaultExpectation.params) {
+			mmUpdateProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateProfile.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateProfile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdateProfile
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserRepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &UserRepositoryMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserRepositoryMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateProfile
+}
+
+// ExpectParamsParam2 sets up expected param params for UserRepository.UpdateProfile
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) ExpectParamsParam2(params model.UpdateProfileParams) *mUserRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserRepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &UserRepositoryMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserRepositoryMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.params = &params
+
+	return mmUpdateProfile
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdateProfile
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) Inspect(f func(ctx context.Context, params model.UpdateProfileParams)) *mUserRepositoryMockUpdateProfile {
+	if mmUpdateProfile.mock.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdateProfile")
+	}
+
+	mmUpdateProfile.mock.inspectFuncUpdateProfile = f
+
+	return mmUpdateProfile
+}
+
+// Return sets up results that will be returned by UserRepository.UpdateProfile
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) Return(user model.User, err error) *UserRepositoryMock {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserRepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &UserRepositoryMockUpdateProfileExpectation{mock: mmUpdateProfile.mock}
+	}
+	mmUpdateProfile.defaultExpectation.results = &UserRepositoryMockUpdateProfileResults{user, err}
+	return mmUpdateProfile.mock
+}
+
+// Set uses given function f to mock the UserRepository.UpdateProfile method
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) Set(f func(ctx context.Context, params model.UpdateProfileParams) (user model.User, err error)) *UserRepositoryMock {
+	if mmUpdateProfile.defaultExpectation != nil {
+		mmUpdateProfile.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdateProfile method")
+	}
+
+	if len(mmUpdateProfile.expectations) > 0 {
+		mmUpdateProfile.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdateProfile method")
+	}
+
+	mmUpdateProfile.mock.funcUpdateProfile = f
+	return mmUpdateProfile.mock
+}
+
+// When sets expectation for the UserRepository.UpdateProfile which will trigger the result defined by the following
+// Then helper
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) When(ctx context.Context, params model.UpdateProfileParams) *UserRepositoryMockUpdateProfileExpectation {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserRepositoryMock.UpdateProfile mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockUpdateProfileExpectation{
+		mock:   mmUpdateProfile.mock,
+		params: &UserRepositoryMockUpdateProfileParams{ctx, params},
+	}
+	mmUpdateProfile.expectations = append(mmUpdateProfile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.UpdateProfile return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdateProfileExpectation) Then(user model.User, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdateProfileResults{user, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.UpdateProfile should be invoked
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) Times(n uint64) *mUserRepositoryMockUpdateProfile {
+	if n == 0 {
+		mmUpdateProfile.mock.t.Fatalf("Times of UserRepositoryMock.UpdateProfile mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateProfile.expectedInvocations, n)
+	return mmUpdateProfile
+}
+
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) invocationsDone() bool {
+	if len(mmUpdateProfile.expectations) == 0 && mmUpdateProfile.defaultExpectation == nil && mmUpdateProfile.mock.funcUpdateProfile == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.mock.afterUpdateProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateProfile implements repository.UserRepository
+func (mmUpdateProfile *UserRepositoryMock) UpdateProfile(ctx context.Context, params model.UpdateProfileParams) (user model.User, err error) {
+	mm_atomic.AddUint64(&mmUpdateProfile.beforeUpdateProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateProfile.afterUpdateProfileCounter, 1)
+
+	if mmUpdateProfile.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.inspectFuncUpdateProfile(ctx, params)
+	}
+
+	mm_params := UserRepositoryMockUpdateProfileParams{ctx, params}
+
+	// Record call args
+	mmUpdateProfile.UpdateProfileMock.mutex.Lock()
+	mmUpdateProfile.UpdateProfileMock.callArgs = append(mmUpdateProfile.UpdateProfileMock.callArgs, &mm_params)
+	mmUpdateProfile.UpdateProfileMock.mutex.Unlock()
+
+	for _, e := range mmUpdateProfile.UpdateProfileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.user, e.results.err
+		}
+	}
+
+	if mmUpdateProfile.UpdateProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateProfile.UpdateProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateProfile.UpdateProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateProfile.UpdateProfileMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockUpdateProfileParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateProfile.t.Errorf("UserRepositoryMock.UpdateProfile got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUpdateProfile.t.Errorf("UserRepositoryMock.UpdateProfile got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateProfile.t.Errorf("UserRepositoryMock.UpdateProfile got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateProfile.UpdateProfileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateProfile.t.Fatal("No results are set for the UserRepositoryMock.UpdateProfile")
+		}
+		return (*mm_results).user, (*mm_results).err
+	}
+	if mmUpdateProfile.funcUpdateProfile != nil {
+		return mmUpdateProfile.funcUpdateProfile(ctx, params)
+	}
+	mmUpdateProfile.t.Fatalf("Unexpected call to UserRepositoryMock.UpdateProfile. %v %v", ctx, params)
+	return
+}
+
+// UpdateProfileAfterCounter returns a count of finished UserRepositoryMock.UpdateProfile invocations
+func (mmUpdateProfile *UserRepositoryMock) UpdateProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.afterUpdateProfileCounter)
+}
+
+// UpdateProfileBeforeCounter returns a count of UserRepositoryMock.UpdateProfile invocations
+func (mmUpdateProfile *UserRepositoryMock) UpdateProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.beforeUpdateProfileCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdateProfile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateProfile *mUserRepositoryMockUpdateProfile) Calls() []*UserRepositoryMockUpdateProfileParams {
+	mmUpdateProfile.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockUpdateProfileParams, len(mmUpdateProfile.callArgs))
+	copy(argCopy, mmUpdateProfile.callArgs)
+
+	mmUpdateProfile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateProfileDone returns true if the count of the UpdateProfile invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockUpdateProfileDone() bool {
+	if m.UpdateProfileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateProfileMock.invocationsDone()
+}
+
+// MinimockUpdateProfileInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdateProfileInspect() {
+	for _, e := range m.UpdateProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateProfile with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateProfileCounter := mm_atomic.LoadUint64(&m.afterUpdateProfileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateProfileMock.defaultExpectation != nil && afterUpdateProfileCounter < 1 {
+		if m.UpdateProfileMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.UpdateProfile")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateProfile with params: %#v", *m.UpdateProfileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateProfile != nil && afterUpdateProfileCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.UpdateProfile")
+	}
+
+	if !m.UpdateProfileMock.invocationsDone() && afterUpdateProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdateProfile but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateProfileMock.expectedInvocations), afterUpdateProfileCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetUserInspect()
+
+			m.MinimockSearchUsersInspect()
+
+			m.MinimockUpdateProfileInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UserRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetUserDone() &&
+		m.MinimockSearchUsersDone() &&
+		m.MinimockUpdateProfileDone()
+}
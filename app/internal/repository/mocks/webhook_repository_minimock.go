@@ -0,0 +1,4443 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/repository.WebhookRepository -o webhook_repository_minimock.go -n WebhookRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// WebhookRepositoryMock implements repository.WebhookRepository
+type WebhookRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaimDueDeliveries          func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) (deliveries []model.WebhookDelivery, err error)
+	inspectFuncClaimDueDeliveries   func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)
+	afterClaimDueDeliveriesCounter  uint64
+	beforeClaimDueDeliveriesCounter uint64
+	ClaimDueDeliveriesMock          mWebhookRepositoryMockClaimDueDeliveries
+
+	funcCreateDeliveries          func(ctx context.Context, event model.Event) (err error)
+	inspectFuncCreateDeliveries   func(ctx context.Context, event model.Event)
+	afterCreateDeliveriesCounter  uint64
+	beforeCreateDeliveriesCounter uint64
+	CreateDeliveriesMock          mWebhookRepositoryMockCreateDeliveries
+
+	funcCreateDeliveryAttempt          func(ctx context.Context, params model.CreateWebhookAttemptParams) (err error)
+	inspectFuncCreateDeliveryAttempt   func(ctx context.Context, params model.CreateWebhookAttemptParams)
+	afterCreateDeliveryAttemptCounter  uint64
+	beforeCreateDeliveryAttemptCounter uint64
+	CreateDeliveryAttemptMock          mWebhookRepositoryMockCreateDeliveryAttempt
+
+	funcCreateIncomingWebhook          func(ctx context.Context, params model.CreateIncomingWebhookParams) (incomingWebhookID int64, err error)
+	inspectFuncCreateIncomingWebhook   func(ctx context.Context, params model.CreateIncomingWebhookParams)
+	afterCreateIncomingWebhookCounter  uint64
+	beforeCreateIncomingWebhookCounter uint64
+	CreateIncomingWebhookMock          mWebhookRepositoryMockCreateIncomingWebhook
+
+	funcCreateWebhook          func(ctx context.Context, params model.CreateWebhookParams) (webhookID int64, err error)
+	inspectFuncCreateWebhook   func(ctx context.Context, params model.CreateWebhookParams)
+	afterCreateWebhookCounter  uint64
+	beforeCreateWebhookCounter uint64
+	CreateWebhookMock          mWebhookRepositoryMockCreateWebhook
+
+	funcDeleteFinishedDeliveries          func(ctx context.Context, before time.Time) (deleted int64, err error)
+	inspectFuncDeleteFinishedDeliveries   func(ctx context.Context, before time.Time)
+	afterDeleteFinishedDeliveriesCounter  uint64
+	beforeDeleteFinishedDeliveriesCounter uint64
+	DeleteFinishedDeliveriesMock          mWebhookRepositoryMockDeleteFinishedDeliveries
+
+	funcDeleteIncomingWebhook          func(ctx context.Context, params model.DeleteIncomingWebhookParams) (err error)
+	inspectFuncDeleteIncomingWebhook   func(ctx context.Context, params model.DeleteIncomingWebhookParams)
+	afterDeleteIncomingWebhookCounter  uint64
+	beforeDeleteIncomingWebhookCounter uint64
+	DeleteIncomingWebhookMock          mWebhookRepositoryMockDeleteIncomingWebhook
+
+	funcDeleteWebhook          func(ctx context.Context, params model.DeleteWebhookParams) (err error)
+	inspectFuncDeleteWebhook   func(ctx context.Context, params model.DeleteWebhookParams)
+	afterDeleteWebhookCounter  uint64
+	beforeDeleteWebhookCounter uint64
+	DeleteWebhookMock          mWebhookRepositoryMockDeleteWebhook
+
+	funcGetIncomingWebhookByTokenHash          func(ctx context.Context, tokenHash string) (webhook model.IncomingWebhook, err error)
+	inspectFuncGetIncomingWebhookByTokenHash   func(ctx context.Context, tokenHash string)
+	afterGetIncomingWebhookByTokenHashCounter  uint64
+	beforeGetIncomingWebhookByTokenHashCounter uint64
+	GetIncomingWebhookByTokenHashMock          mWebhookRepositoryMockGetIncomingWebhookByTokenHash
+
+	funcListIncomingWebhooks          func(ctx context.Context, params model.ListIncomingWebhooksParams) (webhooks []model.IncomingWebhook, err error)
+	inspectFuncListIncomingWebhooks   func(ctx context.Context, params model.ListIncomingWebhooksParams)
+	afterListIncomingWebhooksCounter  uint64
+	beforeListIncomingWebhooksCounter uint64
+	ListIncomingWebhooksMock          mWebhookRepositoryMockListIncomingWebhooks
+
+	funcListWebhooks          func(ctx context.Context, params model.ListWebhooksParams) (webhooks []model.Webhook, err error)
+	inspectFuncListWebhooks   func(ctx context.Context, params model.ListWebhooksParams)
+	afterListWebhooksCounter  uint64
+	beforeListWebhooksCounter uint64
+	ListWebhooksMock          mWebhookRepositoryMockListWebhooks
+
+	funcRecordWebhookResult          func(ctx context.Context, params model.RecordWebhookResultParams) (disabled bool, err error)
+	inspectFuncRecordWebhookResult   func(ctx context.Context, params model.RecordWebhookResultParams)
+	afterRecordWebhookResultCounter  uint64
+	beforeRecordWebhookResultCounter uint64
+	RecordWebhookResultMock          mWebhookRepositoryMockRecordWebhookResult
+
+	funcUpdateDelivery          func(ctx context.Context, params model.UpdateWebhookDeliveryParams) (err error)
+	inspectFuncUpdateDelivery   func(ctx context.Context, params model.UpdateWebhookDeliveryParams)
+	afterUpdateDeliveryCounter  uint64
+	beforeUpdateDeliveryCounter uint64
+	UpdateDeliveryMock          mWebhookRepositoryMockUpdateDelivery
+}
+
+// NewWebhookRepositoryMock returns a mock for repository.WebhookRepository
+func NewWebhookRepositoryMock(t minimock.Tester) *WebhookRepositoryMock {
+	m := &WebhookRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimDueDeliveriesMock = mWebhookRepositoryMockClaimDueDeliveries{mock: m}
+	m.ClaimDueDeliveriesMock.callArgs = []*WebhookRepositoryMockClaimDueDeliveriesParams{}
+
+	m.CreateDeliveriesMock = mWebhookRepositoryMockCreateDeliveries{mock: m}
+	m.CreateDeliveriesMock.callArgs = []*WebhookRepositoryMockCreateDeliveriesParams{}
+
+	m.CreateDeliveryAttemptMock = mWebhookRepositoryMockCreateDeliveryAttempt{mock: m}
+	m.CreateDeliveryAttemptMock.callArgs = []*WebhookRepositoryMockCreateDeliveryAttemptParams{}
+
+	m.CreateIncomingWebhookMock = mWebhookRepositoryMockCreateIncomingWebhook{mock: m}
+	m.CreateIncomingWebhookMock.callArgs = []*WebhookRepositoryMockCreateIncomingWebhookParams{}
+
+	m.CreateWebhookMock = mWebhookRepositoryMockCreateWebhook{mock: m}
+	m.CreateWebhookMock.callArgs = []*WebhookRepositoryMockCreateWebhookParams{}
+
+	m.DeleteFinishedDeliveriesMock = mWebhookRepositoryMockDeleteFinishedDeliveries{mock: m}
+	m.DeleteFinishedDeliveriesMock.callArgs = []*WebhookRepositoryMockDeleteFinishedDeliveriesParams{}
+
+	m.DeleteIncomingWebhookMock = mWebhookRepositoryMockDeleteIncomingWebhook{mock: m}
+	m.DeleteIncomingWebhookMock.callArgs = []*WebhookRepositoryMockDeleteIncomingWebhookParams{}
+
+	m.DeleteWebhookMock = mWebhookRepositoryMockDeleteWebhook{mock: m}
+	m.DeleteWebhookMock.callArgs = []*WebhookRepositoryMockDeleteWebhookParams{}
+
+	m.GetIncomingWebhookByTokenHashMock = mWebhookRepositoryMockGetIncomingWebhookByTokenHash{mock: m}
+	m.GetIncomingWebhookByTokenHashMock.callArgs = []*WebhookRepositoryMockGetIncomingWebhookByTokenHashParams{}
+
+	m.ListIncomingWebhooksMock = mWebhookRepositoryMockListIncomingWebhooks{mock: m}
+	m.ListIncomingWebhooksMock.callArgs = []*WebhookRepositoryMockListIncomingWebhooksParams{}
+
+	m.ListWebhooksMock = mWebhookRepositoryMockListWebhooks{mock: m}
+	m.ListWebhooksMock.callArgs = []*WebhookRepositoryMockListWebhooksParams{}
+
+	m.RecordWebhookResultMock = mWebhookRepositoryMockRecordWebhookResult{mock: m}
+	m.RecordWebhookResultMock.callArgs = []*WebhookRepositoryMockRecordWebhookResultParams{}
+
+	m.UpdateDeliveryMock = mWebhookRepositoryMockUpdateDelivery{mock: m}
+	m.UpdateDeliveryMock.callArgs = []*WebhookRepositoryMockUpdateDeliveryParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mWebhookRepositoryMockClaimDueDeliveries struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockClaimDueDeliveriesExpectation
+	expectations       []*WebhookRepositoryMockClaimDueDeliveriesExpectation
+
+	callArgs []*WebhookRepositoryMockClaimDueDeliveriesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockClaimDueDeliveriesExpectation specifies expectation struct of the WebhookRepository.ClaimDueDeliveries
+type WebhookRepositoryMockClaimDueDeliveriesExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockClaimDueDeliveriesParams
+	paramPtrs *WebhookRepositoryMockClaimDueDeliveriesParamPtrs
+	results   *WebhookRepositoryMockClaimDueDeliveriesResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockClaimDueDeliveriesParams contains parameters of the WebhookRepository.ClaimDueDeliveries
+type WebhookRepositoryMockClaimDueDeliveriesParams struct {
+	ctx        context.Context
+	now        time.Time
+	leaseUntil time.Time
+	limit      int
+}
+
+// WebhookRepositoryMockClaimDueDeliveriesParamPtrs contains pointers to parameters of the WebhookRepository.ClaimDueDeliveries
+type WebhookRepositoryMockClaimDueDeliveriesParamPtrs struct {
+	ctx        *context.Context
+	now        *time.Time
+	leaseUntil *time.Time
+	limit      *int
+}
+
+// WebhookRepositoryMockClaimDueDeliveriesResults contains results of the WebhookRepository.ClaimDueDeliveries
+type WebhookRepositoryMockClaimDueDeliveriesResults struct {
+	deliveries []model.WebhookDelivery
+	err        error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) Optional() *mWebhookRepositoryMockClaimDueDeliveries {
+	mmClaimDueDeliveries.optional = true
+	return mmClaimDueDeliveries
+}
+
+// Expect sets up expected params for WebhookRepository.ClaimDueDeliveries
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) Expect(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) *mWebhookRepositoryMockClaimDueDeliveries {
+	if mmClaimDueDeliveries.mock.funcClaimDueDeliveries != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Set")
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation == nil {
+		mmClaimDueDeliveries.defaultExpectation = &WebhookRepositoryMockClaimDueDeliveriesExpectation{}
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation.paramPtrs != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by ExpectParams functions")
+	}
+
+	mmClaimDueDeliveries.defaultExpectation.params = &WebhookRepositoryMockClaimDueDeliveriesParams{ctx, now, leaseUntil, limit}
+	for _, e := range mmClaimDueDeliveries.expectations {
+		if minimock.Equal(e.params, mmClaimDueDeliveries.defaultExpectation.params) {
+			mmClaimDueDeliveries.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimDueDeliveries.defaultExpectation.params)
+		}
+	}
+
+	return mmClaimDueDeliveries
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.ClaimDueDeliveries
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockClaimDueDeliveries {
+	if mmClaimDueDeliveries.mock.funcClaimDueDeliveries != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Set")
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation == nil {
+		mmClaimDueDeliveries.defaultExpectation = &WebhookRepositoryMockClaimDueDeliveriesExpectation{}
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation.params != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Expect")
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation.paramPtrs == nil {
+		mmClaimDueDeliveries.defaultExpectation.paramPtrs = &WebhookRepositoryMockClaimDueDeliveriesParamPtrs{}
+	}
+	mmClaimDueDeliveries.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmClaimDueDeliveries
+}
+
+// ExpectNowParam2 sets up expected param now for WebhookRepository.ClaimDueDeliveries
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) ExpectNowParam2(now time.Time) *mWebhookRepositoryMockClaimDueDeliveries {
+	if mmClaimDueDeliveries.mock.funcClaimDueDeliveries != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Set")
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation == nil {
+		mmClaimDueDeliveries.defaultExpectation = &WebhookRepositoryMockClaimDueDeliveriesExpectation{}
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation.params != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Expect")
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation.paramPtrs == nil {
+		mmClaimDueDeliveries.defaultExpectation.paramPtrs = &WebhookRepositoryMockClaimDueDeliveriesParamPtrs{}
+	}
+	mmClaimDueDeliveries.defaultExpectation.paramPtrs.now = &now
+
+	return mmClaimDueDeliveries
+}
+
+// ExpectLeaseUntilParam3 sets up expected param leaseUntil for WebhookRepository.ClaimDueDeliveries
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) ExpectLeaseUntilParam3(leaseUntil time.Time) *mWebhookRepositoryMockClaimDueDeliveries {
+	if mmClaimDueDeliveries.mock.funcClaimDueDeliveries != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Set")
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation == nil {
+		mmClaimDueDeliveries.defaultExpectation = &WebhookRepositoryMockClaimDueDeliveriesExpectation{}
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation.params != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Expect")
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation.paramPtrs == nil {
+		mmClaimDueDeliveries.defaultExpectation.paramPtrs = &WebhookRepositoryMockClaimDueDeliveriesParamPtrs{}
+	}
+	mmClaimDueDeliveries.defaultExpectation.paramPtrs.leaseUntil = &leaseUntil
+
+	return mmClaimDueDeliveries
+}
+
+// ExpectLimitParam4 sets up expected param limit for WebhookRepository.ClaimDueDeliveries
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) ExpectLimitParam4(limit int) *mWebhookRepositoryMockClaimDueDeliveries {
+	if mmClaimDueDeliveries.mock.funcClaimDueDeliveries != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Set")
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation == nil {
+		mmClaimDueDeliveries.defaultExpectation = &WebhookRepositoryMockClaimDueDeliveriesExpectation{}
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation.params != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Expect")
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation.paramPtrs == nil {
+		mmClaimDueDeliveries.defaultExpectation.paramPtrs = &WebhookRepositoryMockClaimDueDeliveriesParamPtrs{}
+	}
+	mmClaimDueDeliveries.defaultExpectation.paramPtrs.limit = &limit
+
+	return mmClaimDueDeliveries
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.ClaimDueDeliveries
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) Inspect(f func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)) *mWebhookRepositoryMockClaimDueDeliveries {
+	if mmClaimDueDeliveries.mock.inspectFuncClaimDueDeliveries != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.ClaimDueDeliveries")
+	}
+
+	mmClaimDueDeliveries.mock.inspectFuncClaimDueDeliveries = f
+
+	return mmClaimDueDeliveries
+}
+
+// Return sets up results that will be returned by WebhookRepository.ClaimDueDeliveries
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) Return(deliveries []model.WebhookDelivery, err error) *WebhookRepositoryMock {
+	if mmClaimDueDeliveries.mock.funcClaimDueDeliveries != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Set")
+	}
+
+	if mmClaimDueDeliveries.defaultExpectation == nil {
+		mmClaimDueDeliveries.defaultExpectation = &WebhookRepositoryMockClaimDueDeliveriesExpectation{mock: mmClaimDueDeliveries.mock}
+	}
+	mmClaimDueDeliveries.defaultExpectation.results = &WebhookRepositoryMockClaimDueDeliveriesResults{deliveries, err}
+	return mmClaimDueDeliveries.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.ClaimDueDeliveries method
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) Set(f func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) (deliveries []model.WebhookDelivery, err error)) *WebhookRepositoryMock {
+	if mmClaimDueDeliveries.defaultExpectation != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.ClaimDueDeliveries method")
+	}
+
+	if len(mmClaimDueDeliveries.expectations) > 0 {
+		mmClaimDueDeliveries.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.ClaimDueDeliveries method")
+	}
+
+	mmClaimDueDeliveries.mock.funcClaimDueDeliveries = f
+	return mmClaimDueDeliveries.mock
+}
+
+// When sets expectation for the WebhookRepository.ClaimDueDeliveries which will trigger the result defined by the following
+// Then helper
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) When(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) *WebhookRepositoryMockClaimDueDeliveriesExpectation {
+	if mmClaimDueDeliveries.mock.funcClaimDueDeliveries != nil {
+		mmClaimDueDeliveries.mock.t.Fatalf("WebhookRepositoryMock.ClaimDueDeliveries mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockClaimDueDeliveriesExpectation{
+		mock:   mmClaimDueDeliveries.mock,
+		params: &WebhookRepositoryMockClaimDueDeliveriesParams{ctx, now, leaseUntil, limit},
+	}
+	mmClaimDueDeliveries.expectations = append(mmClaimDueDeliveries.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.ClaimDueDeliveries return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockClaimDueDeliveriesExpectation) Then(deliveries []model.WebhookDelivery, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockClaimDueDeliveriesResults{deliveries, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.ClaimDueDeliveries should be invoked
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) Times(n uint64) *mWebhookRepositoryMockClaimDueDeliveries {
+	if n == 0 {
+		mmClaimDueDeliveries.mock.t.Fatalf("Times of WebhookRepositoryMock.ClaimDueDeliveries mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimDueDeliveries.expectedInvocations, n)
+	return mmClaimDueDeliveries
+}
+
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) invocationsDone() bool {
+	if len(mmClaimDueDeliveries.expectations) == 0 && mmClaimDueDeliveries.defaultExpectation == nil && mmClaimDueDeliveries.mock.funcClaimDueDeliveries == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimDueDeliveries.mock.afterClaimDueDeliveriesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimDueDeliveries.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimDueDeliveries implements repository.WebhookRepository
+func (mmClaimDueDeliveries *WebhookRepositoryMock) ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) (deliveries []model.WebhookDelivery, err error) {
+	mm_atomic.AddUint64(&mmClaimDueDeliveries.beforeClaimDueDeliveriesCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimDueDeliveries.afterClaimDueDeliveriesCounter, 1)
+
+	if mmClaimDueDeliveries.inspectFuncClaimDueDeliveries != nil {
+		mmClaimDueDeliveries.inspectFuncClaimDueDeliveries(ctx, now, leaseUntil, limit)
+	}
+
+	mm_params := WebhookRepositoryMockClaimDueDeliveriesParams{ctx, now, leaseUntil, limit}
+
+	// Record call args
+	mmClaimDueDeliveries.ClaimDueDeliveriesMock.mutex.Lock()
+	mmClaimDueDeliveries.ClaimDueDeliveriesMock.callArgs = append(mmClaimDueDeliveries.ClaimDueDeliveriesMock.callArgs, &mm_params)
+	mmClaimDueDeliveries.ClaimDueDeliveriesMock.mutex.Unlock()
+
+	for _, e := range mmClaimDueDeliveries.ClaimDueDeliveriesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.deliveries, e.results.err
+		}
+	}
+
+	if mmClaimDueDeliveries.ClaimDueDeliveriesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimDueDeliveries.ClaimDueDeliveriesMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimDueDeliveries.ClaimDueDeliveriesMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimDueDeliveries.ClaimDueDeliveriesMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockClaimDueDeliveriesParams{ctx, now, leaseUntil, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimDueDeliveries.t.Errorf("WebhookRepositoryMock.ClaimDueDeliveries got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmClaimDueDeliveries.t.Errorf("WebhookRepositoryMock.ClaimDueDeliveries got unexpected parameter now, want: %#v, got: %#v%s\n", *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+			if mm_want_ptrs.leaseUntil != nil && !minimock.Equal(*mm_want_ptrs.leaseUntil, mm_got.leaseUntil) {
+				mmClaimDueDeliveries.t.Errorf("WebhookRepositoryMock.ClaimDueDeliveries got unexpected parameter leaseUntil, want: %#v, got: %#v%s\n", *mm_want_ptrs.leaseUntil, mm_got.leaseUntil, minimock.Diff(*mm_want_ptrs.leaseUntil, mm_got.leaseUntil))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmClaimDueDeliveries.t.Errorf("WebhookRepositoryMock.ClaimDueDeliveries got unexpected parameter limit, want: %#v, got: %#v%s\n", *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimDueDeliveries.t.Errorf("WebhookRepositoryMock.ClaimDueDeliveries got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimDueDeliveries.ClaimDueDeliveriesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimDueDeliveries.t.Fatal("No results are set for the WebhookRepositoryMock.ClaimDueDeliveries")
+		}
+		return (*mm_results).deliveries, (*mm_results).err
+	}
+	if mmClaimDueDeliveries.funcClaimDueDeliveries != nil {
+		return mmClaimDueDeliveries.funcClaimDueDeliveries(ctx, now, leaseUntil, limit)
+	}
+	mmClaimDueDeliveries.t.Fatalf("Unexpected call to WebhookRepositoryMock.ClaimDueDeliveries. %v %v %v %v", ctx, now, leaseUntil, limit)
+	return
+}
+
+// ClaimDueDeliveriesAfterCounter returns a count of finished WebhookRepositoryMock.ClaimDueDeliveries invocations
+func (mmClaimDueDeliveries *WebhookRepositoryMock) ClaimDueDeliveriesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimDueDeliveries.afterClaimDueDeliveriesCounter)
+}
+
+// ClaimDueDeliveriesBeforeCounter returns a count of WebhookRepositoryMock.ClaimDueDeliveries invocations
+func (mmClaimDueDeliveries *WebhookRepositoryMock) ClaimDueDeliveriesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimDueDeliveries.beforeClaimDueDeliveriesCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.ClaimDueDeliveries.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimDueDeliveries *mWebhookRepositoryMockClaimDueDeliveries) Calls() []*WebhookRepositoryMockClaimDueDeliveriesParams {
+	mmClaimDueDeliveries.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockClaimDueDeliveriesParams, len(mmClaimDueDeliveries.callArgs))
+	copy(argCopy, mmClaimDueDeliveries.callArgs)
+
+	mmClaimDueDeliveries.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimDueDeliveriesDone returns true if the count of the ClaimDueDeliveries invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockClaimDueDeliveriesDone() bool {
+	if m.ClaimDueDeliveriesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimDueDeliveriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimDueDeliveriesMock.invocationsDone()
+}
+
+// MinimockClaimDueDeliveriesInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockClaimDueDeliveriesInspect() {
+	for _, e := range m.ClaimDueDeliveriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ClaimDueDeliveries with params: %#v", *e.params)
+		}
+	}
+
+	afterClaimDueDeliveriesCounter := mm_atomic.LoadUint64(&m.afterClaimDueDeliveriesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimDueDeliveriesMock.defaultExpectation != nil && afterClaimDueDeliveriesCounter < 1 {
+		if m.ClaimDueDeliveriesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.ClaimDueDeliveries")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ClaimDueDeliveries with params: %#v", *m.ClaimDueDeliveriesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimDueDeliveries != nil && afterClaimDueDeliveriesCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.ClaimDueDeliveries")
+	}
+
+	if !m.ClaimDueDeliveriesMock.invocationsDone() && afterClaimDueDeliveriesCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.ClaimDueDeliveries but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimDueDeliveriesMock.expectedInvocations), afterClaimDueDeliveriesCounter)
+	}
+}
+
+type mWebhookRepositoryMockCreateDeliveries struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockCreateDeliveriesExpectation
+	expectations       []*WebhookRepositoryMockCreateDeliveriesExpectation
+
+	callArgs []*WebhookRepositoryMockCreateDeliveriesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockCreateDeliveriesExpectation specifies expectation struct of the WebhookRepository.CreateDeliveries
+type WebhookRepositoryMockCreateDeliveriesExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockCreateDeliveriesParams
+	paramPtrs *WebhookRepositoryMockCreateDeliveriesParamPtrs
+	results   *WebhookRepositoryMockCreateDeliveriesResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockCreateDeliveriesParams contains parameters of the WebhookRepository.CreateDeliveries
+type WebhookRepositoryMockCreateDeliveriesParams struct {
+	ctx   context.Context
+	event model.Event
+}
+
+// WebhookRepositoryMockCreateDeliveriesParamPtrs contains pointers to parameters of the WebhookRepository.CreateDeliveries
+type WebhookRepositoryMockCreateDeliveriesParamPtrs struct {
+	ctx   *context.Context
+	event *model.Event
+}
+
+// WebhookRepositoryMockCreateDeliveriesResults contains results of the WebhookRepository.CreateDeliveries
+type WebhookRepositoryMockCreateDeliveriesResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Optional() *mWebhookRepositoryMockCreateDeliveries {
+	mmCreateDeliveries.optional = true
+	return mmCreateDeliveries
+}
+
+// Expect sets up expected params for WebhookRepository.CreateDeliveries
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Expect(ctx context.Context, event model.Event) *mWebhookRepositoryMockCreateDeliveries {
+	if mmCreateDeliveries.mock.funcCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Set")
+	}
+
+	if mmCreateDeliveries.defaultExpectation == nil {
+		mmCreateDeliveries.defaultExpectation = &WebhookRepositoryMockCreateDeliveriesExpectation{}
+	}
+
+	if mmCreateDeliveries.defaultExpectation.paramPtrs != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by ExpectParams functions")
+	}
+
+	mmCreateDeliveries.defaultExpectation.params = &WebhookRepositoryMockCreateDeliveriesParams{ctx, event}
+	for _, e := range mmCreateDeliveries.expectations {
+		if minimock.Equal(e.params, mmCreateDeliveries.defaultExpectation.params) {
+			mmCreateDeliveries.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateDeliveries.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateDeliveries
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.CreateDeliveries
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockCreateDeliveries {
+	if mmCreateDeliveries.mock.funcCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Set")
+	}
+
+	if mmCreateDeliveries.defaultExpectation == nil {
+		mmCreateDeliveries.defaultExpectation = &WebhookRepositoryMockCreateDeliveriesExpectation{}
+	}
+
+	if mmCreateDeliveries.defaultExpectation.params != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Expect")
+	}
+
+	if mmCreateDeliveries.defaultExpectation.paramPtrs == nil {
+		mmCreateDeliveries.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateDeliveriesParamPtrs{}
+	}
+	mmCreateDeliveries.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateDeliveries
+}
+
+// ExpectEventParam2 sets up expected param event for WebhookRepository.CreateDeliveries
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) ExpectEventParam2(event model.Event) *mWebhookRepositoryMockCreateDeliveries {
+	if mmCreateDeliveries.mock.funcCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Set")
+	}
+
+	if mmCreateDeliveries.defaultExpectation == nil {
+		mmCreateDeliveries.defaultExpectation = &WebhookRepositoryMockCreateDeliveriesExpectation{}
+	}
+
+	if mmCreateDeliveries.defaultExpectation.params != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Expect")
+	}
+
+	if mmCreateDeliveries.defaultExpectation.paramPtrs == nil {
+		mmCreateDeliveries.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateDeliveriesParamPtrs{}
+	}
+	mmCreateDeliveries.defaultExpectation.paramPtrs.event = &event
+
+	return mmCreateDeliveries
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.CreateDeliveries
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Inspect(f func(ctx context.Context, event model.Event)) *mWebhookRepositoryMockCreateDeliveries {
+	if mmCreateDeliveries.mock.inspectFuncCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.CreateDeliveries")
+	}
+
+	mmCreateDeliveries.mock.inspectFuncCreateDeliveries = f
+
+	return mmCreateDeliveries
+}
+
+// Return sets up results that will be returned by WebhookRepository.CreateDeliveries
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Return(err error) *WebhookRepositoryMock {
+	if mmCreateDeliveries.mock.funcCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Set")
+	}
+
+	if mmCreateDeliveries.defaultExpectation == nil {
+		mmCreateDeliveries.defaultExpectation = &WebhookRepositoryMockCreateDeliveriesExpectation{mock: mmCreateDeliveries.mock}
+	}
+	mmCreateDeliveries.defaultExpectation.results = &WebhookRepositoryMockCreateDeliveriesResults{err}
+	return mmCreateDeliveries.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.CreateDeliveries method
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Set(f func(ctx context.Context, event model.Event) (err error)) *WebhookRepositoryMock {
+	if mmCreateDeliveries.defaultExpectation != nil {
+		mmCreateDeliveries.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.CreateDeliveries method")
+	}
+
+	if len(mmCreateDeliveries.expectations) > 0 {
+		mmCreateDeliveries.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.CreateDeliveries method")
+	}
+
+	mmCreateDeliveries.mock.funcCreateDeliveries = f
+	return mmCreateDeliveries.mock
+}
+
+// When sets expectation for the WebhookRepository.CreateDeliveries which will trigger the result defined by the following
+// Then helper
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) When(ctx context.Context, event model.Event) *WebhookRepositoryMockCreateDeliveriesExpectation {
+	if mmCreateDeliveries.mock.funcCreateDeliveries != nil {
+		mmCreateDeliveries.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveries mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockCreateDeliveriesExpectation{
+		mock:   mmCreateDeliveries.mock,
+		params: &WebhookRepositoryMockCreateDeliveriesParams{ctx, event},
+	}
+	mmCreateDeliveries.expectations = append(mmCreateDeliveries.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.CreateDeliveries return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockCreateDeliveriesExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockCreateDeliveriesResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.CreateDeliveries should be invoked
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Times(n uint64) *mWebhookRepositoryMockCreateDeliveries {
+	if n == 0 {
+		mmCreateDeliveries.mock.t.Fatalf("Times of WebhookRepositoryMock.CreateDeliveries mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateDeliveries.expectedInvocations, n)
+	return mmCreateDeliveries
+}
+
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) invocationsDone() bool {
+	if len(mmCreateDeliveries.expectations) == 0 && mmCreateDeliveries.defaultExpectation == nil && mmCreateDeliveries.mock.funcCreateDeliveries == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateDeliveries.mock.afterCreateDeliveriesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateDeliveries.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateDeliveries implements repository.WebhookRepository
+func (mmCreateDeliveries *WebhookRepositoryMock) CreateDeliveries(ctx context.Context, event model.Event) (err error) {
+	mm_atomic.AddUint64(&mmCreateDeliveries.beforeCreateDeliveriesCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateDeliveries.afterCreateDeliveriesCounter, 1)
+
+	if mmCreateDeliveries.inspectFuncCreateDeliveries != nil {
+		mmCreateDeliveries.inspectFuncCreateDeliveries(ctx, event)
+	}
+
+	mm_params := WebhookRepositoryMockCreateDeliveriesParams{ctx, event}
+
+	// Record call args
+	mmCreateDeliveries.CreateDeliveriesMock.mutex.Lock()
+	mmCreateDeliveries.CreateDeliveriesMock.callArgs = append(mmCreateDeliveries.CreateDeliveriesMock.callArgs, &mm_params)
+	mmCreateDeliveries.CreateDeliveriesMock.mutex.Unlock()
+
+	for _, e := range mmCreateDeliveries.CreateDeliveriesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockCreateDeliveriesParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateDeliveries.t.Errorf("WebhookRepositoryMock.CreateDeliveries got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmCreateDeliveries.t.Errorf("WebhookRepositoryMock.CreateDeliveries got unexpected parameter event, want: %#v, got: %#v%s\n", *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateDeliveries.t.Errorf("WebhookRepositoryMock.CreateDeliveries got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateDeliveries.CreateDeliveriesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateDeliveries.t.Fatal("No results are set for the WebhookRepositoryMock.CreateDeliveries")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateDeliveries.funcCreateDeliveries != nil {
+		return mmCreateDeliveries.funcCreateDeliveries(ctx, event)
+	}
+	mmCreateDeliveries.t.Fatalf("Unexpected call to WebhookRepositoryMock.CreateDeliveries. %v %v", ctx, event)
+	return
+}
+
+// CreateDeliveriesAfterCounter returns a count of finished WebhookRepositoryMock.CreateDeliveries invocations
+func (mmCreateDeliveries *WebhookRepositoryMock) CreateDeliveriesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateDeliveries.afterCreateDeliveriesCounter)
+}
+
+// CreateDeliveriesBeforeCounter returns a count of WebhookRepositoryMock.CreateDeliveries invocations
+func (mmCreateDeliveries *WebhookRepositoryMock) CreateDeliveriesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateDeliveries.beforeCreateDeliveriesCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.CreateDeliveries.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateDeliveries *mWebhookRepositoryMockCreateDeliveries) Calls() []*WebhookRepositoryMockCreateDeliveriesParams {
+	mmCreateDeliveries.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockCreateDeliveriesParams, len(mmCreateDeliveries.callArgs))
+	copy(argCopy, mmCreateDeliveries.callArgs)
+
+	mmCreateDeliveries.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDeliveriesDone returns true if the count of the CreateDeliveries invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockCreateDeliveriesDone() bool {
+	if m.CreateDeliveriesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateDeliveriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateDeliveriesMock.invocationsDone()
+}
+
+// MinimockCreateDeliveriesInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockCreateDeliveriesInspect() {
+	for _, e := range m.CreateDeliveriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateDeliveries with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateDeliveriesCounter := mm_atomic.LoadUint64(&m.afterCreateDeliveriesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateDeliveriesMock.defaultExpectation != nil && afterCreateDeliveriesCounter < 1 {
+		if m.CreateDeliveriesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.CreateDeliveries")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateDeliveries with params: %#v", *m.CreateDeliveriesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateDeliveries != nil && afterCreateDeliveriesCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.CreateDeliveries")
+	}
+
+	if !m.CreateDeliveriesMock.invocationsDone() && afterCreateDeliveriesCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.CreateDeliveries but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateDeliveriesMock.expectedInvocations), afterCreateDeliveriesCounter)
+	}
+}
+
+type mWebhookRepositoryMockCreateDeliveryAttempt struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockCreateDeliveryAttemptExpectation
+	expectations       []*WebhookRepositoryMockCreateDeliveryAttemptExpectation
+
+	callArgs []*WebhookRepositoryMockCreateDeliveryAttemptParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockCreateDeliveryAttemptExpectation specifies expectation struct of the WebhookRepository.CreateDeliveryAttempt
+type WebhookRepositoryMockCreateDeliveryAttemptExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockCreateDeliveryAttemptParams
+	paramPtrs *WebhookRepositoryMockCreateDeliveryAttemptParamPtrs
+	results   *WebhookRepositoryMockCreateDeliveryAttemptResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockCreateDeliveryAttemptParams contains parameters of the WebhookRepository.CreateDeliveryAttempt
+type WebhookRepositoryMockCreateDeliveryAttemptParams struct {
+	ctx    context.Context
+	params model.CreateWebhookAttemptParams
+}
+
+// WebhookRepositoryMockCreateDeliveryAttemptParamPtrs contains pointers to parameters of the WebhookRepository.CreateDeliveryAttempt
+type WebhookRepositoryMockCreateDeliveryAttemptParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateWebhookAttemptParams
+}
+
+// WebhookRepositoryMockCreateDeliveryAttemptResults contains results of the WebhookRepository.CreateDeliveryAttempt
+type WebhookRepositoryMockCreateDeliveryAttemptResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) Optional() *mWebhookRepositoryMockCreateDeliveryAttempt {
+	mmCreateDeliveryAttempt.optional = true
+	return mmCreateDeliveryAttempt
+}
+
+// Expect sets up expected params for WebhookRepository.CreateDeliveryAttempt
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) Expect(ctx context.Context, params model.CreateWebhookAttemptParams) *mWebhookRepositoryMockCreateDeliveryAttempt {
+	if mmCreateDeliveryAttempt.mock.funcCreateDeliveryAttempt != nil {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveryAttempt mock is already set by Set")
+	}
+
+	if mmCreateDeliveryAttempt.defaultExpectation == nil {
+		mmCreateDeliveryAttempt.defaultExpectation = &WebhookRepositoryMockCreateDeliveryAttemptExpectation{}
+	}
+
+	if mmCreateDeliveryAttempt.defaultExpectation.paramPtrs != nil {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveryAttempt mock is already set by ExpectParams functions")
+	}
+
+	mmCreateDeliveryAttempt.defaultExpectation.params = &WebhookRepositoryMockCreateDeliveryAttemptParams{ctx, params}
+	for _, e := range mmCreateDeliveryAttempt.expectations {
+		if minimock.Equal(e.params, mmCreateDeliveryAttempt.defaultExpectation.params) {
+			mmCreateDeliveryAttempt.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateDeliveryAttempt.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateDeliveryAttempt
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.CreateDeliveryAttempt
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockCreateDeliveryAttempt {
+	if mmCreateDeliveryAttempt.mock.funcCreateDeliveryAttempt != nil {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveryAttempt mock is already set by Set")
+	}
+
+	if mmCreateDeliveryAttempt.defaultExpectation == nil {
+		mmCreateDeliveryAttempt.defaultExpectation = &WebhookRepositoryMockCreateDeliveryAttemptExpectation{}
+	}
+
+	if mmCreateDeliveryAttempt.defaultExpectation.params != nil {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveryAttempt mock is already set by Expect")
+	}
+
+	if mmCreateDeliveryAttempt.defaultExpectation.paramPtrs == nil {
+		mmCreateDeliveryAttempt.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateDeliveryAttemptParamPtrs{}
+	}
+	mmCreateDeliveryAttempt.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateDeliveryAttempt
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookRepository.CreateDeliveryAttempt
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) ExpectParamsParam2(params model.CreateWebhookAttemptParams) *mWebhookRepositoryMockCreateDeliveryAttempt {
+	if mmCreateDeliveryAttempt.mock.funcCreateDeliveryAttempt != nil {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveryAttempt mock is already set by Set")
+	}
+
+	if mmCreateDeliveryAttempt.defaultExpectation == nil {
+		mmCreateDeliveryAttempt.defaultExpectation = &WebhookRepositoryMockCreateDeliveryAttemptExpectation{}
+	}
+
+	if mmCreateDeliveryAttempt.defaultExpectation.params != nil {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveryAttempt mock is already set by Expect")
+	}
+
+	if mmCreateDeliveryAttempt.defaultExpectation.paramPtrs == nil {
+		mmCreateDeliveryAttempt.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateDeliveryAttemptParamPtrs{}
+	}
+	mmCreateDeliveryAttempt.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateDeliveryAttempt
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.CreateDeliveryAttempt
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) Inspect(f func(ctx context.Context, params model.CreateWebhookAttemptParams)) *mWebhookRepositoryMockCreateDeliveryAttempt {
+	if mmCreateDeliveryAttempt.mock.inspectFuncCreateDeliveryAttempt != nil {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.CreateDeliveryAttempt")
+	}
+
+	mmCreateDeliveryAttempt.mock.inspectFuncCreateDeliveryAttempt = f
+
+	return mmCreateDeliveryAttempt
+}
+
+// Return sets up results that will be returned by WebhookRepository.CreateDeliveryAttempt
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) Return(err error) *WebhookRepositoryMock {
+	if mmCreateDeliveryAttempt.mock.funcCreateDeliveryAttempt != nil {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveryAttempt mock is already set by Set")
+	}
+
+	if mmCreateDeliveryAttempt.defaultExpectation == nil {
+		mmCreateDeliveryAttempt.defaultExpectation = &WebhookRepositoryMockCreateDeliveryAttemptExpectation{mock: mmCreateDeliveryAttempt.mock}
+	}
+	mmCreateDeliveryAttempt.defaultExpectation.results = &WebhookRepositoryMockCreateDeliveryAttemptResults{err}
+	return mmCreateDeliveryAttempt.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.CreateDeliveryAttempt method
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) Set(f func(ctx context.Context, params model.CreateWebhookAttemptParams) (err error)) *WebhookRepositoryMock {
+	if mmCreateDeliveryAttempt.defaultExpectation != nil {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.CreateDeliveryAttempt method")
+	}
+
+	if len(mmCreateDeliveryAttempt.expectations) > 0 {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.CreateDeliveryAttempt method")
+	}
+
+	mmCreateDeliveryAttempt.mock.funcCreateDeliveryAttempt = f
+	return mmCreateDeliveryAttempt.mock
+}
+
+// When sets expectation for the WebhookRepository.CreateDeliveryAttempt which will trigger the result defined by the following
+// Then helper
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) When(ctx context.Context, params model.CreateWebhookAttemptParams) *WebhookRepositoryMockCreateDeliveryAttemptExpectation {
+	if mmCreateDeliveryAttempt.mock.funcCreateDeliveryAttempt != nil {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("WebhookRepositoryMock.CreateDeliveryAttempt mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockCreateDeliveryAttemptExpectation{
+		mock:   mmCreateDeliveryAttempt.mock,
+		params: &WebhookRepositoryMockCreateDeliveryAttemptParams{ctx, params},
+	}
+	mmCreateDeliveryAttempt.expectations = append(mmCreateDeliveryAttempt.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.CreateDeliveryAttempt return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockCreateDeliveryAttemptExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockCreateDeliveryAttemptResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.CreateDeliveryAttempt should be invoked
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) Times(n uint64) *mWebhookRepositoryMockCreateDeliveryAttempt {
+	if n == 0 {
+		mmCreateDeliveryAttempt.mock.t.Fatalf("Times of WebhookRepositoryMock.CreateDeliveryAttempt mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateDeliveryAttempt.expectedInvocations, n)
+	return mmCreateDeliveryAttempt
+}
+
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) invocationsDone() bool {
+	if len(mmCreateDeliveryAttempt.expectations) == 0 && mmCreateDeliveryAttempt.defaultExpectation == nil && mmCreateDeliveryAttempt.mock.funcCreateDeliveryAttempt == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateDeliveryAttempt.mock.afterCreateDeliveryAttemptCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateDeliveryAttempt.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateDeliveryAttempt implements repository.WebhookRepository
+func (mmCreateDeliveryAttempt *WebhookRepositoryMock) CreateDeliveryAttempt(ctx context.Context, params model.CreateWebhookAttemptParams) (err error) {
+	mm_atomic.AddUint64(&mmCreateDeliveryAttempt.beforeCreateDeliveryAttemptCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateDeliveryAttempt.afterCreateDeliveryAttemptCounter, 1)
+
+	if mmCreateDeliveryAttempt.inspectFuncCreateDeliveryAttempt != nil {
+		mmCreateDeliveryAttempt.inspectFuncCreateDeliveryAttempt(ctx, params)
+	}
+
+	mm_params := WebhookRepositoryMockCreateDeliveryAttemptParams{ctx, params}
+
+	// Record call args
+	mmCreateDeliveryAttempt.CreateDeliveryAttemptMock.mutex.Lock()
+	mmCreateDeliveryAttempt.CreateDeliveryAttemptMock.callArgs = append(mmCreateDeliveryAttempt.CreateDeliveryAttemptMock.callArgs, &mm_params)
+	mmCreateDeliveryAttempt.CreateDeliveryAttemptMock.mutex.Unlock()
+
+	for _, e := range mmCreateDeliveryAttempt.CreateDeliveryAttemptMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateDeliveryAttempt.CreateDeliveryAttemptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateDeliveryAttempt.CreateDeliveryAttemptMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateDeliveryAttempt.CreateDeliveryAttemptMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateDeliveryAttempt.CreateDeliveryAttemptMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockCreateDeliveryAttemptParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateDeliveryAttempt.t.Errorf("WebhookRepositoryMock.CreateDeliveryAttempt got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateDeliveryAttempt.t.Errorf("WebhookRepositoryMock.CreateDeliveryAttempt got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateDeliveryAttempt.t.Errorf("WebhookRepositoryMock.CreateDeliveryAttempt got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateDeliveryAttempt.CreateDeliveryAttemptMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateDeliveryAttempt.t.Fatal("No results are set for the WebhookRepositoryMock.CreateDeliveryAttempt")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateDeliveryAttempt.funcCreateDeliveryAttempt != nil {
+		return mmCreateDeliveryAttempt.funcCreateDeliveryAttempt(ctx, params)
+	}
+	mmCreateDeliveryAttempt.t.Fatalf("Unexpected call to WebhookRepositoryMock.CreateDeliveryAttempt. %v %v", ctx, params)
+	return
+}
+
+// CreateDeliveryAttemptAfterCounter returns a count of finished WebhookRepositoryMock.CreateDeliveryAttempt invocations
+func (mmCreateDeliveryAttempt *WebhookRepositoryMock) CreateDeliveryAttemptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateDeliveryAttempt.afterCreateDeliveryAttemptCounter)
+}
+
+// CreateDeliveryAttemptBeforeCounter returns a count of WebhookRepositoryMock.CreateDeliveryAttempt invocations
+func (mmCreateDeliveryAttempt *WebhookRepositoryMock) CreateDeliveryAttemptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateDeliveryAttempt.beforeCreateDeliveryAttemptCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.CreateDeliveryAttempt.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateDeliveryAttempt *mWebhookRepositoryMockCreateDeliveryAttempt) Calls() []*WebhookRepositoryMockCreateDeliveryAttemptParams {
+	mmCreateDeliveryAttempt.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockCreateDeliveryAttemptParams, len(mmCreateDeliveryAttempt.callArgs))
+	copy(argCopy, mmCreateDeliveryAttempt.callArgs)
+
+	mmCreateDeliveryAttempt.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDeliveryAttemptDone returns true if the count of the CreateDeliveryAttempt invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockCreateDeliveryAttemptDone() bool {
+	if m.CreateDeliveryAttemptMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateDeliveryAttemptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateDeliveryAttemptMock.invocationsDone()
+}
+
+// MinimockCreateDeliveryAttemptInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockCreateDeliveryAttemptInspect() {
+	for _, e := range m.CreateDeliveryAttemptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateDeliveryAttempt with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateDeliveryAttemptCounter := mm_atomic.LoadUint64(&m.afterCreateDeliveryAttemptCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateDeliveryAttemptMock.defaultExpectation != nil && afterCreateDeliveryAttemptCounter < 1 {
+		if m.CreateDeliveryAttemptMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.CreateDeliveryAttempt")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateDeliveryAttempt with params: %#v", *m.CreateDeliveryAttemptMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateDeliveryAttempt != nil && afterCreateDeliveryAttemptCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.CreateDeliveryAttempt")
+	}
+
+	if !m.CreateDeliveryAttemptMock.invocationsDone() && afterCreateDeliveryAttemptCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.CreateDeliveryAttempt but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateDeliveryAttemptMock.expectedInvocations), afterCreateDeliveryAttemptCounter)
+	}
+}
+
+type mWebhookRepositoryMockCreateIncomingWebhook struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockCreateIncomingWebhookExpectation
+	expectations       []*WebhookRepositoryMockCreateIncomingWebhookExpectation
+
+	callArgs []*WebhookRepositoryMockCreateIncomingWebhookParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockCreateIncomingWebhookExpectation specifies expectation struct of the WebhookRepository.CreateIncomingWebhook
+type WebhookRepositoryMockCreateIncomingWebhookExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockCreateIncomingWebhookParams
+	paramPtrs *WebhookRepositoryMockCreateIncomingWebhookParamPtrs
+	results   *WebhookRepositoryMockCreateIncomingWebhookResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockCreateIncomingWebhookParams contains parameters of the WebhookRepository.CreateIncomingWebhook
+type WebhookRepositoryMockCreateIncomingWebhookParams struct {
+	ctx    context.Context
+	params model.CreateIncomingWebhookParams
+}
+
+// WebhookRepositoryMockCreateIncomingWebhookParamPtrs contains pointers to parameters of the WebhookRepository.CreateIncomingWebhook
+type WebhookRepositoryMockCreateIncomingWebhookParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateIncomingWebhookParams
+}
+
+// WebhookRepositoryMockCreateIncomingWebhookResults contains results of the WebhookRepository.CreateIncomingWebhook
+type WebhookRepositoryMockCreateIncomingWebhookResults struct {
+	incomingWebhookID int64
+	err               error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) Optional() *mWebhookRepositoryMockCreateIncomingWebhook {
+	mmCreateIncomingWebhook.optional = true
+	return mmCreateIncomingWebhook
+}
+
+// Expect sets up expected params for WebhookRepository.CreateIncomingWebhook
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) Expect(ctx context.Context, params model.CreateIncomingWebhookParams) *mWebhookRepositoryMockCreateIncomingWebhook {
+	if mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateIncomingWebhook mock is already set by Set")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation == nil {
+		mmCreateIncomingWebhook.defaultExpectation = &WebhookRepositoryMockCreateIncomingWebhookExpectation{}
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation.paramPtrs != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateIncomingWebhook mock is already set by ExpectParams functions")
+	}
+
+	mmCreateIncomingWebhook.defaultExpectation.params = &WebhookRepositoryMockCreateIncomingWebhookParams{ctx, params}
+	for _, e := range mmCreateIncomingWebhook.expectations {
+		if minimock.Equal(e.params, mmCreateIncomingWebhook.defaultExpectation.params) {
+			mmCreateIncomingWebhook.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateIncomingWebhook.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateIncomingWebhook
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.CreateIncomingWebhook
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockCreateIncomingWebhook {
+	if mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateIncomingWebhook mock is already set by Set")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation == nil {
+		mmCreateIncomingWebhook.defaultExpectation = &WebhookRepositoryMockCreateIncomingWebhookExpectation{}
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation.params != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateIncomingWebhook mock is already set by Expect")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation.paramPtrs == nil {
+		mmCreateIncomingWebhook.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateIncomingWebhookParamPtrs{}
+	}
+	mmCreateIncomingWebhook.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateIncomingWebhook
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookRepository.CreateIncomingWebhook
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) ExpectParamsParam2(params model.CreateIncomingWebhookParams) *mWebhookRepositoryMockCreateIncomingWebhook {
+	if mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateIncomingWebhook mock is already set by Set")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation == nil {
+		mmCreateIncomingWebhook.defaultExpectation = &WebhookRepositoryMockCreateIncomingWebhookExpectation{}
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation.params != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateIncomingWebhook mock is already set by Expect")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation.paramPtrs == nil {
+		mmCreateIncomingWebhook.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateIncomingWebhookParamPtrs{}
+	}
+	mmCreateIncomingWebhook.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateIncomingWebhook
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.CreateIncomingWebhook
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) Inspect(f func(ctx context.Context, params model.CreateIncomingWebhookParams)) *mWebhookRepositoryMockCreateIncomingWebhook {
+	if mmCreateIncomingWebhook.mock.inspectFuncCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.CreateIncomingWebhook")
+	}
+
+	mmCreateIncomingWebhook.mock.inspectFuncCreateIncomingWebhook = f
+
+	return mmCreateIncomingWebhook
+}
+
+// Return sets up results that will be returned by WebhookRepository.CreateIncomingWebhook
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) Return(incomingWebhookID int64, err error) *WebhookRepositoryMock {
+	if mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateIncomingWebhook mock is already set by Set")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation == nil {
+		mmCreateIncomingWebhook.defaultExpectation = &WebhookRepositoryMockCreateIncomingWebhookExpectation{mock: mmCreateIncomingWebhook.mock}
+	}
+	mmCreateIncomingWebhook.defaultExpectation.results = &WebhookRepositoryMockCreateIncomingWebhookResults{incomingWebhookID, err}
+	return mmCreateIncomingWebhook.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.CreateIncomingWebhook method
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) Set(f func(ctx context.Context, params model.CreateIncomingWebhookParams) (incomingWebhookID int64, err error)) *WebhookRepositoryMock {
+	if mmCreateIncomingWebhook.defaultExpectation != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.CreateIncomingWebhook method")
+	}
+
+	if len(mmCreateIncomingWebhook.expectations) > 0 {
+		mmCreateIncomingWebhook.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.CreateIncomingWebhook method")
+	}
+
+	mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook = f
+	return mmCreateIncomingWebhook.mock
+}
+
+// When sets expectation for the WebhookRepository.CreateIncomingWebhook which will trigger the result defined by the following
+// Then helper
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) When(ctx context.Context, params model.CreateIncomingWebhookParams) *WebhookRepositoryMockCreateIncomingWebhookExpectation {
+	if mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateIncomingWebhook mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockCreateIncomingWebhookExpectation{
+		mock:   mmCreateIncomingWebhook.mock,
+		params: &WebhookRepositoryMockCreateIncomingWebhookParams{ctx, params},
+	}
+	mmCreateIncomingWebhook.expectations = append(mmCreateIncomingWebhook.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.CreateIncomingWebhook return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockCreateIncomingWebhookExpectation) Then(incomingWebhookID int64, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockCreateIncomingWebhookResults{incomingWebhookID, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.CreateIncomingWebhook should be invoked
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) Times(n uint64) *mWebhookRepositoryMockCreateIncomingWebhook {
+	if n == 0 {
+		mmCreateIncomingWebhook.mock.t.Fatalf("Times of WebhookRepositoryMock.CreateIncomingWebhook mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateIncomingWebhook.expectedInvocations, n)
+	return mmCreateIncomingWebhook
+}
+
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) invocationsDone() bool {
+	if len(mmCreateIncomingWebhook.expectations) == 0 && mmCreateIncomingWebhook.defaultExpectation == nil && mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateIncomingWebhook.mock.afterCreateIncomingWebhookCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateIncomingWebhook.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateIncomingWebhook implements repository.WebhookRepository
+func (mmCreateIncomingWebhook *WebhookRepositoryMock) CreateIncomingWebhook(ctx context.Context, params model.CreateIncomingWebhookParams) (incomingWebhookID int64, err error) {
+	mm_atomic.AddUint64(&mmCreateIncomingWebhook.beforeCreateIncomingWebhookCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateIncomingWebhook.afterCreateIncomingWebhookCounter, 1)
+
+	if mmCreateIncomingWebhook.inspectFuncCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.inspectFuncCreateIncomingWebhook(ctx, params)
+	}
+
+	mm_params := WebhookRepositoryMockCreateIncomingWebhookParams{ctx, params}
+
+	// Record call args
+	mmCreateIncomingWebhook.CreateIncomingWebhookMock.mutex.Lock()
+	mmCreateIncomingWebhook.CreateIncomingWebhookMock.callArgs = append(mmCreateIncomingWebhook.CreateIncomingWebhookMock.callArgs, &mm_params)
+	mmCreateIncomingWebhook.CreateIncomingWebhookMock.mutex.Unlock()
+
+	for _, e := range mmCreateIncomingWebhook.CreateIncomingWebhookMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.incomingWebhookID, e.results.err
+		}
+	}
+
+	if mmCreateIncomingWebhook.CreateIncomingWebhookMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateIncomingWebhook.CreateIncomingWebhookMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateIncomingWebhook.CreateIncomingWebhookMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateIncomingWebhook.CreateIncomingWebhookMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockCreateIncomingWebhookParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateIncomingWebhook.t.Errorf("WebhookRepositoryMock.CreateIncomingWebhook got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateIncomingWebhook.t.Errorf("WebhookRepositoryMock.CreateIncomingWebhook got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateIncomingWebhook.t.Errorf("WebhookRepositoryMock.CreateIncomingWebhook got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateIncomingWebhook.CreateIncomingWebhookMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateIncomingWebhook.t.Fatal("No results are set for the WebhookRepositoryMock.CreateIncomingWebhook")
+		}
+		return (*mm_results).incomingWebhookID, (*mm_results).err
+	}
+	if mmCreateIncomingWebhook.funcCreateIncomingWebhook != nil {
+		return mmCreateIncomingWebhook.funcCreateIncomingWebhook(ctx, params)
+	}
+	mmCreateIncomingWebhook.t.Fatalf("Unexpected call to WebhookRepositoryMock.CreateIncomingWebhook. %v %v", ctx, params)
+	return
+}
+
+// CreateIncomingWebhookAfterCounter returns a count of finished WebhookRepositoryMock.CreateIncomingWebhook invocations
+func (mmCreateIncomingWebhook *WebhookRepositoryMock) CreateIncomingWebhookAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateIncomingWebhook.afterCreateIncomingWebhookCounter)
+}
+
+// CreateIncomingWebhookBeforeCounter returns a count of WebhookRepositoryMock.CreateIncomingWebhook invocations
+func (mmCreateIncomingWebhook *WebhookRepositoryMock) CreateIncomingWebhookBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateIncomingWebhook.beforeCreateIncomingWebhookCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.CreateIncomingWebhook.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateIncomingWebhook *mWebhookRepositoryMockCreateIncomingWebhook) Calls() []*WebhookRepositoryMockCreateIncomingWebhookParams {
+	mmCreateIncomingWebhook.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockCreateIncomingWebhookParams, len(mmCreateIncomingWebhook.callArgs))
+	copy(argCopy, mmCreateIncomingWebhook.callArgs)
+
+	mmCreateIncomingWebhook.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateIncomingWebhookDone returns true if the count of the CreateIncomingWebhook invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockCreateIncomingWebhookDone() bool {
+	if m.CreateIncomingWebhookMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateIncomingWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateIncomingWebhookMock.invocationsDone()
+}
+
+// MinimockCreateIncomingWebhookInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockCreateIncomingWebhookInspect() {
+	for _, e := range m.CreateIncomingWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateIncomingWebhook with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateIncomingWebhookCounter := mm_atomic.LoadUint64(&m.afterCreateIncomingWebhookCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateIncomingWebhookMock.defaultExpectation != nil && afterCreateIncomingWebhookCounter < 1 {
+		if m.CreateIncomingWebhookMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.CreateIncomingWebhook")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateIncomingWebhook with params: %#v", *m.CreateIncomingWebhookMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateIncomingWebhook != nil && afterCreateIncomingWebhookCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.CreateIncomingWebhook")
+	}
+
+	if !m.CreateIncomingWebhookMock.invocationsDone() && afterCreateIncomingWebhookCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.CreateIncomingWebhook but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateIncomingWebhookMock.expectedInvocations), afterCreateIncomingWebhookCounter)
+	}
+}
+
+type mWebhookRepositoryMockCreateWebhook struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockCreateWebhookExpectation
+	expectations       []*WebhookRepositoryMockCreateWebhookExpectation
+
+	callArgs []*WebhookRepositoryMockCreateWebhookParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockCreateWebhookExpectation specifies expectation struct of the WebhookRepository.CreateWebhook
+type WebhookRepositoryMockCreateWebhookExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockCreateWebhookParams
+	paramPtrs *WebhookRepositoryMockCreateWebhookParamPtrs
+	results   *WebhookRepositoryMockCreateWebhookResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockCreateWebhookParams contains parameters of the WebhookRepository.CreateWebhook
+type WebhookRepositoryMockCreateWebhookParams struct {
+	ctx    context.Context
+	params model.CreateWebhookParams
+}
+
+// WebhookRepositoryMockCreateWebhookParamPtrs contains pointers to parameters of the WebhookRepository.CreateWebhook
+type WebhookRepositoryMockCreateWebhookParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateWebhookParams
+}
+
+// WebhookRepositoryMockCreateWebhookResults contains results of the WebhookRepository.CreateWebhook
+type WebhookRepositoryMockCreateWebhookResults struct {
+	webhookID int64
+	err       error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) Optional() *mWebhookRepositoryMockCreateWebhook {
+	mmCreateWebhook.optional = true
+	return mmCreateWebhook
+}
+
+// Expect sets up expected params for WebhookRepository.CreateWebhook
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) Expect(ctx context.Context, params model.CreateWebhookParams) *mWebhookRepositoryMockCreateWebhook {
+	if mmCreateWebhook.mock.funcCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateWebhook mock is already set by Set")
+	}
+
+	if mmCreateWebhook.defaultExpectation == nil {
+		mmCreateWebhook.defaultExpectation = &WebhookRepositoryMockCreateWebhookExpectation{}
+	}
+
+	if mmCreateWebhook.defaultExpectation.paramPtrs != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateWebhook mock is already set by ExpectParams functions")
+	}
+
+	mmCreateWebhook.defaultExpectation.params = &WebhookRepositoryMockCreateWebhookParams{ctx, params}
+	for _, e := range mmCreateWebhook.expectations {
+		if minimock.Equal(e.params, mmCreateWebhook.defaultExpectation.params) {
+			mmCreateWebhook.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateWebhook.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateWebhook
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.CreateWebhook
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockCreateWebhook {
+	if mmCreateWebhook.mock.funcCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateWebhook mock is already set by Set")
+	}
+
+	if mmCreateWebhook.defaultExpectation == nil {
+		mmCreateWebhook.defaultExpectation = &WebhookRepositoryMockCreateWebhookExpectation{}
+	}
+
+	if mmCreateWebhook.defaultExpectation.params != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateWebhook mock is already set by Expect")
+	}
+
+	if mmCreateWebhook.defaultExpectation.paramPtrs == nil {
+		mmCreateWebhook.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateWebhookParamPtrs{}
+	}
+	mmCreateWebhook.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateWebhook
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookRepository.CreateWebhook
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) ExpectParamsParam2(params model.CreateWebhookParams) *mWebhookRepositoryMockCreateWebhook {
+	if mmCreateWebhook.mock.funcCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateWebhook mock is already set by Set")
+	}
+
+	if mmCreateWebhook.defaultExpectation == nil {
+		mmCreateWebhook.defaultExpectation = &WebhookRepositoryMockCreateWebhookExpectation{}
+	}
+
+	if mmCreateWebhook.defaultExpectation.params != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateWebhook mock is already set by Expect")
+	}
+
+	if mmCreateWebhook.defaultExpectation.paramPtrs == nil {
+		mmCreateWebhook.defaultExpectation.paramPtrs = &WebhookRepositoryMockCreateWebhookParamPtrs{}
+	}
+	mmCreateWebhook.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateWebhook
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.CreateWebhook
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) Inspect(f func(ctx context.Context, params model.CreateWebhookParams)) *mWebhookRepositoryMockCreateWebhook {
+	if mmCreateWebhook.mock.inspectFuncCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.CreateWebhook")
+	}
+
+	mmCreateWebhook.mock.inspectFuncCreateWebhook = f
+
+	return mmCreateWebhook
+}
+
+// Return sets up results that will be returned by WebhookRepository.CreateWebhook
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) Return(webhookID int64, err error) *WebhookRepositoryMock {
+	if mmCreateWebhook.mock.funcCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateWebhook mock is already set by Set")
+	}
+
+	if mmCreateWebhook.defaultExpectation == nil {
+		mmCreateWebhook.defaultExpectation = &WebhookRepositoryMockCreateWebhookExpectation{mock: mmCreateWebhook.mock}
+	}
+	mmCreateWebhook.defaultExpectation.results = &WebhookRepositoryMockCreateWebhookResults{webhookID, err}
+	return mmCreateWebhook.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.CreateWebhook method
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) Set(f func(ctx context.Context, params model.CreateWebhookParams) (webhookID int64, err error)) *WebhookRepositoryMock {
+	if mmCreateWebhook.defaultExpectation != nil {
+		mmCreateWebhook.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.CreateWebhook method")
+	}
+
+	if len(mmCreateWebhook.expectations) > 0 {
+		mmCreateWebhook.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.CreateWebhook method")
+	}
+
+	mmCreateWebhook.mock.funcCreateWebhook = f
+	return mmCreateWebhook.mock
+}
+
+// When sets expectation for the WebhookRepository.CreateWebhook which will trigger the result defined by the following
+// Then helper
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) When(ctx context.Context, params model.CreateWebhookParams) *WebhookRepositoryMockCreateWebhookExpectation {
+	if mmCreateWebhook.mock.funcCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookRepositoryMock.CreateWebhook mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockCreateWebhookExpectation{
+		mock:   mmCreateWebhook.mock,
+		params: &WebhookRepositoryMockCreateWebhookParams{ctx, params},
+	}
+	mmCreateWebhook.expectations = append(mmCreateWebhook.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.CreateWebhook return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockCreateWebhookExpectation) Then(webhookID int64, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockCreateWebhookResults{webhookID, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.CreateWebhook should be invoked
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) Times(n uint64) *mWebhookRepositoryMockCreateWebhook {
+	if n == 0 {
+		mmCreateWebhook.mock.t.Fatalf("Times of WebhookRepositoryMock.CreateWebhook mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateWebhook.expectedInvocations, n)
+	return mmCreateWebhook
+}
+
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) invocationsDone() bool {
+	if len(mmCreateWebhook.expectations) == 0 && mmCreateWebhook.defaultExpectation == nil && mmCreateWebhook.mock.funcCreateWebhook == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateWebhook.mock.afterCreateWebhookCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateWebhook.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateWebhook implements repository.WebhookRepository
+func (mmCreateWebhook *WebhookRepositoryMock) CreateWebhook(ctx context.Context, params model.CreateWebhookParams) (webhookID int64, err error) {
+	mm_atomic.AddUint64(&mmCreateWebhook.beforeCreateWebhookCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateWebhook.afterCreateWebhookCounter, 1)
+
+	if mmCreateWebhook.inspectFuncCreateWebhook != nil {
+		mmCreateWebhook.inspectFuncCreateWebhook(ctx, params)
+	}
+
+	mm_params := WebhookRepositoryMockCreateWebhookParams{ctx, params}
+
+	// Record call args
+	mmCreateWebhook.CreateWebhookMock.mutex.Lock()
+	mmCreateWebhook.CreateWebhookMock.callArgs = append(mmCreateWebhook.CreateWebhookMock.callArgs, &mm_params)
+	mmCreateWebhook.CreateWebhookMock.mutex.Unlock()
+
+	for _, e := range mmCreateWebhook.CreateWebhookMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.webhookID, e.results.err
+		}
+	}
+
+	if mmCreateWebhook.CreateWebhookMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateWebhook.CreateWebhookMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateWebhook.CreateWebhookMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateWebhook.CreateWebhookMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockCreateWebhookParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateWebhook.t.Errorf("WebhookRepositoryMock.CreateWebhook got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateWebhook.t.Errorf("WebhookRepositoryMock.CreateWebhook got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateWebhook.t.Errorf("WebhookRepositoryMock.CreateWebhook got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateWebhook.CreateWebhookMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateWebhook.t.Fatal("No results are set for the WebhookRepositoryMock.CreateWebhook")
+		}
+		return (*mm_results).webhookID, (*mm_results).err
+	}
+	if mmCreateWebhook.funcCreateWebhook != nil {
+		return mmCreateWebhook.funcCreateWebhook(ctx, params)
+	}
+	mmCreateWebhook.t.Fatalf("Unexpected call to WebhookRepositoryMock.CreateWebhook. %v %v", ctx, params)
+	return
+}
+
+// CreateWebhookAfterCounter returns a count of finished WebhookRepositoryMock.CreateWebhook invocations
+func (mmCreateWebhook *WebhookRepositoryMock) CreateWebhookAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateWebhook.afterCreateWebhookCounter)
+}
+
+// CreateWebhookBeforeCounter returns a count of WebhookRepositoryMock.CreateWebhook invocations
+func (mmCreateWebhook *WebhookRepositoryMock) CreateWebhookBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateWebhook.beforeCreateWebhookCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.CreateWebhook.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateWebhook *mWebhookRepositoryMockCreateWebhook) Calls() []*WebhookRepositoryMockCreateWebhookParams {
+	mmCreateWebhook.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockCreateWebhookParams, len(mmCreateWebhook.callArgs))
+	copy(argCopy, mmCreateWebhook.callArgs)
+
+	mmCreateWebhook.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateWebhookDone returns true if the count of the CreateWebhook invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockCreateWebhookDone() bool {
+	if m.CreateWebhookMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateWebhookMock.invocationsDone()
+}
+
+// MinimockCreateWebhookInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockCreateWebhookInspect() {
+	for _, e := range m.CreateWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateWebhook with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateWebhookCounter := mm_atomic.LoadUint64(&m.afterCreateWebhookCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateWebhookMock.defaultExpectation != nil && afterCreateWebhookCounter < 1 {
+		if m.CreateWebhookMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.CreateWebhook")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.CreateWebhook with params: %#v", *m.CreateWebhookMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateWebhook != nil && afterCreateWebhookCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.CreateWebhook")
+	}
+
+	if !m.CreateWebhookMock.invocationsDone() && afterCreateWebhookCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.CreateWebhook but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateWebhookMock.expectedInvocations), afterCreateWebhookCounter)
+	}
+}
+
+type mWebhookRepositoryMockDeleteFinishedDeliveries struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockDeleteFinishedDeliveriesExpectation
+	expectations       []*WebhookRepositoryMockDeleteFinishedDeliveriesExpectation
+
+	callArgs []*WebhookRepositoryMockDeleteFinishedDeliveriesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockDeleteFinishedDeliveriesExpectation specifies expectation struct of the WebhookRepository.DeleteFinishedDeliveries
+type WebhookRepositoryMockDeleteFinishedDeliveriesExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockDeleteFinishedDeliveriesParams
+	paramPtrs *WebhookRepositoryMockDeleteFinishedDeliveriesParamPtrs
+	results   *WebhookRepositoryMockDeleteFinishedDeliveriesResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockDeleteFinishedDeliveriesParams contains parameters of the WebhookRepository.DeleteFinishedDeliveries
+type WebhookRepositoryMockDeleteFinishedDeliveriesParams struct {
+	ctx    context.Context
+	before time.Time
+}
+
+// WebhookRepositoryMockDeleteFinishedDeliveriesParamPtrs contains pointers to parameters of the WebhookRepository.DeleteFinishedDeliveries
+type WebhookRepositoryMockDeleteFinishedDeliveriesParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+}
+
+// WebhookRepositoryMockDeleteFinishedDeliveriesResults contains results of the WebhookRepository.DeleteFinishedDeliveries
+type WebhookRepositoryMockDeleteFinishedDeliveriesResults struct {
+	deleted int64
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) Optional() *mWebhookRepositoryMockDeleteFinishedDeliveries {
+	mmDeleteFinishedDeliveries.optional = true
+	return mmDeleteFinishedDeliveries
+}
+
+// Expect sets up expected params for WebhookRepository.DeleteFinishedDeliveries
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) Expect(ctx context.Context, before time.Time) *mWebhookRepositoryMockDeleteFinishedDeliveries {
+	if mmDeleteFinishedDeliveries.mock.funcDeleteFinishedDeliveries != nil {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("WebhookRepositoryMock.DeleteFinishedDeliveries mock is already set by Set")
+	}
+
+	if mmDeleteFinishedDeliveries.defaultExpectation == nil {
+		mmDeleteFinishedDeliveries.defaultExpectation = &WebhookRepositoryMockDeleteFinishedDeliveriesExpectation{}
+	}
+
+	if mmDeleteFinishedDeliveries.defaultExpectation.paramPtrs != nil {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("WebhookRepositoryMock.DeleteFinishedDeliveries mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteFinishedDeliveries.defaultExpectation.params = &WebhookRepositoryMockDeleteFinishedDeliveriesParams{ctx, before}
+	for _, e := range mmDeleteFinishedDeliveries.expectations {
+		if minimock.Equal(e.params, mmDeleteFinishedDeliveries.defaultExpectation.params) {
+			mmDeleteFinishedDeliveries.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteFinishedDeliveries.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteFinishedDeliveries
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.DeleteFinishedDeliveries
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockDeleteFinishedDeliveries {
+	if mmDeleteFinishedDeliveries.mock.funcDeleteFinishedDeliveries != nil {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("WebhookRepositoryMock.DeleteFinishedDeliveries mock is already set by Set")
+	}
+
+	if mmDeleteFinishedDeliveries.defaultExpectation == nil {
+		mmDeleteFinishedDeliveries.defaultExpectation = &WebhookRepositoryMockDeleteFinishedDeliveriesExpectation{}
+	}
+
+	if mmDeleteFinishedDeliveries.defaultExpectation.params != nil {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("WebhookRepositoryMock.DeleteFinishedDeliveries mock is already set by Expect")
+	}
+
+	if mmDeleteFinishedDeliveries.defaultExpectation.paramPtrs == nil {
+		mmDeleteFinishedDeliveries.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeleteFinishedDeliveriesParamPtrs{}
+	}
+	mmDeleteFinishedDeliveries.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteFinishedDeliveries
+}
+
+// ExpectBeforeParam2 sets up expected param before for WebhookRepository.DeleteFinishedDeliveries
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) ExpectBeforeParam2(before time.Time) *mWebhookRepositoryMockDeleteFinishedDeliveries {
+	if mmDeleteFinishedDeliveries.mock.funcDeleteFinishedDeliveries != nil {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("WebhookRepositoryMock.DeleteFinishedDeliveries mock is already set by Set")
+	}
+
+	if mmDeleteFinishedDeliveries.defaultExpectation == nil {
+		mmDeleteFinishedDeliveries.defaultExpectation = &WebhookRepositoryMockDeleteFinishedDeliveriesExpectation{}
+	}
+
+	if mmDeleteFinishedDeliveries.defaultExpectation.params != nil {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("WebhookRepositoryMock.DeleteFinishedDeliveries mock is already set by Expect")
+	}
+
+	if mmDeleteFinishedDeliveries.defaultExpectation.paramPtrs == nil {
+		mmDeleteFinishedDeliveries.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeleteFinishedDeliveriesParamPtrs{}
+	}
+	mmDeleteFinishedDeliveries.defaultExpectation.paramPtrs.before = &before
+
+	return mmDeleteFinishedDeliveries
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.DeleteFinishedDeliveries
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) Inspect(f func(ctx context.Context, before time.Time)) *mWebhookRepositoryMockDeleteFinishedDeliveries {
+	if mmDeleteFinishedDeliveries.mock.inspectFuncDeleteFinishedDeliveries != nil {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.DeleteFinishedDeliveries")
+	}
+
+	mmDeleteFinishedDeliveries.mock.inspectFuncDeleteFinishedDeliveries = f
+
+	return mmDeleteFinishedDeliveries
+}
+
+// Return sets up results that will be returned by WebhookRepository.DeleteFinishedDeliveries
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) Return(deleted int64, err error) *WebhookRepositoryMock {
+	if mmDeleteFinishedDeliveries.mock.funcDeleteFinishedDeliveries != nil {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("WebhookRepositoryMock.DeleteFinishedDeliveries mock is already set by Set")
+	}
+
+	if mmDeleteFinishedDeliveries.defaultExpectation == nil {
+		mmDeleteFinishedDeliveries.defaultExpectation = &WebhookRepositoryMockDeleteFinishedDeliveriesExpectation{mock: mmDeleteFinishedDeliveries.mock}
+	}
+	mmDeleteFinishedDeliveries.defaultExpectation.results = &WebhookRepositoryMockDeleteFinishedDeliveriesResults{deleted, err}
+	return mmDeleteFinishedDeliveries.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.DeleteFinishedDeliveries method
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) Set(f func(ctx context.Context, before time.Time) (deleted int64, err error)) *WebhookRepositoryMock {
+	if mmDeleteFinishedDeliveries.defaultExpectation != nil {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.DeleteFinishedDeliveries method")
+	}
+
+	if len(mmDeleteFinishedDeliveries.expectations) > 0 {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.DeleteFinishedDeliveries method")
+	}
+
+	mmDeleteFinishedDeliveries.mock.funcDeleteFinishedDeliveries = f
+	return mmDeleteFinishedDeliveries.mock
+}
+
+// When sets expectation for the WebhookRepository.DeleteFinishedDeliveries which will trigger the result defined by the following
+// Then helper
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) When(ctx context.Context, before time.Time) *WebhookRepositoryMockDeleteFinishedDeliveriesExpectation {
+	if mmDeleteFinishedDeliveries.mock.funcDeleteFinishedDeliveries != nil {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("WebhookRepositoryMock.DeleteFinishedDeliveries mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockDeleteFinishedDeliveriesExpectation{
+		mock:   mmDeleteFinishedDeliveries.mock,
+		params: &WebhookRepositoryMockDeleteFinishedDeliveriesParams{ctx, before},
+	}
+	mmDeleteFinishedDeliveries.expectations = append(mmDeleteFinishedDeliveries.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.DeleteFinishedDeliveries return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockDeleteFinishedDeliveriesExpectation) Then(deleted int64, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockDeleteFinishedDeliveriesResults{deleted, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.DeleteFinishedDeliveries should be invoked
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) Times(n uint64) *mWebhookRepositoryMockDeleteFinishedDeliveries {
+	if n == 0 {
+		mmDeleteFinishedDeliveries.mock.t.Fatalf("Times of WebhookRepositoryMock.DeleteFinishedDeliveries mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteFinishedDeliveries.expectedInvocations, n)
+	return mmDeleteFinishedDeliveries
+}
+
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) invocationsDone() bool {
+	if len(mmDeleteFinishedDeliveries.expectations) == 0 && mmDeleteFinishedDeliveries.defaultExpectation == nil && mmDeleteFinishedDeliveries.mock.funcDeleteFinishedDeliveries == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteFinishedDeliveries.mock.afterDeleteFinishedDeliveriesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteFinishedDeliveries.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteFinishedDeliveries implements repository.WebhookRepository
+func (mmDeleteFinishedDeliveries *WebhookRepositoryMock) DeleteFinishedDeliveries(ctx context.Context, before time.Time) (deleted int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteFinishedDeliveries.beforeDeleteFinishedDeliveriesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteFinishedDeliveries.afterDeleteFinishedDeliveriesCounter, 1)
+
+	if mmDeleteFinishedDeliveries.inspectFuncDeleteFinishedDeliveries != nil {
+		mmDeleteFinishedDeliveries.inspectFuncDeleteFinishedDeliveries(ctx, before)
+	}
+
+	mm_params := WebhookRepositoryMockDeleteFinishedDeliveriesParams{ctx, before}
+
+	// Record call args
+	mmDeleteFinishedDeliveries.DeleteFinishedDeliveriesMock.mutex.Lock()
+	mmDeleteFinishedDeliveries.DeleteFinishedDeliveriesMock.callArgs = append(mmDeleteFinishedDeliveries.DeleteFinishedDeliveriesMock.callArgs, &mm_params)
+	mmDeleteFinishedDeliveries.DeleteFinishedDeliveriesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteFinishedDeliveries.DeleteFinishedDeliveriesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.deleted, e.results.err
+		}
+	}
+
+	if mmDeleteFinishedDeliveries.DeleteFinishedDeliveriesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteFinishedDeliveries.DeleteFinishedDeliveriesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteFinishedDeliveries.DeleteFinishedDeliveriesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteFinishedDeliveries.DeleteFinishedDeliveriesMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockDeleteFinishedDeliveriesParams{ctx, before}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteFinishedDeliveries.t.Errorf("WebhookRepositoryMock.DeleteFinishedDeliveries got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmDeleteFinishedDeliveries.t.Errorf("WebhookRepositoryMock.DeleteFinishedDeliveries got unexpected parameter before, want: %#v, got: %#v%s\n", *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteFinishedDeliveries.t.Errorf("WebhookRepositoryMock.DeleteFinishedDeliveries got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteFinishedDeliveries.DeleteFinishedDeliveriesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteFinishedDeliveries.t.Fatal("No results are set for the WebhookRepositoryMock.DeleteFinishedDeliveries")
+		}
+		return (*mm_results).deleted, (*mm_results).err
+	}
+	if mmDeleteFinishedDeliveries.funcDeleteFinishedDeliveries != nil {
+		return mmDeleteFinishedDeliveries.funcDeleteFinishedDeliveries(ctx, before)
+	}
+	mmDeleteFinishedDeliveries.t.Fatalf("Unexpected call to WebhookRepositoryMock.DeleteFinishedDeliveries. %v %v", ctx, before)
+	return
+}
+
+// DeleteFinishedDeliveriesAfterCounter returns a count of finished WebhookRepositoryMock.DeleteFinishedDeliveries invocations
+func (mmDeleteFinishedDeliveries *WebhookRepositoryMock) DeleteFinishedDeliveriesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFinishedDeliveries.afterDeleteFinishedDeliveriesCounter)
+}
+
+// DeleteFinishedDeliveriesBeforeCounter returns a count of WebhookRepositoryMock.DeleteFinishedDeliveries invocations
+func (mmDeleteFinishedDeliveries *WebhookRepositoryMock) DeleteFinishedDeliveriesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFinishedDeliveries.beforeDeleteFinishedDeliveriesCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.DeleteFinishedDeliveries.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteFinishedDeliveries *mWebhookRepositoryMockDeleteFinishedDeliveries) Calls() []*WebhookRepositoryMockDeleteFinishedDeliveriesParams {
+	mmDeleteFinishedDeliveries.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockDeleteFinishedDeliveriesParams, len(mmDeleteFinishedDeliveries.callArgs))
+	copy(argCopy, mmDeleteFinishedDeliveries.callArgs)
+
+	mmDeleteFinishedDeliveries.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteFinishedDeliveriesDone returns true if the count of the DeleteFinishedDeliveries invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockDeleteFinishedDeliveriesDone() bool {
+	if m.DeleteFinishedDeliveriesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteFinishedDeliveriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteFinishedDeliveriesMock.invocationsDone()
+}
+
+// MinimockDeleteFinishedDeliveriesInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockDeleteFinishedDeliveriesInspect() {
+	for _, e := range m.DeleteFinishedDeliveriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.DeleteFinishedDeliveries with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteFinishedDeliveriesCounter := mm_atomic.LoadUint64(&m.afterDeleteFinishedDeliveriesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteFinishedDeliveriesMock.defaultExpectation != nil && afterDeleteFinishedDeliveriesCounter < 1 {
+		if m.DeleteFinishedDeliveriesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.DeleteFinishedDeliveries")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.DeleteFinishedDeliveries with params: %#v", *m.DeleteFinishedDeliveriesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteFinishedDeliveries != nil && afterDeleteFinishedDeliveriesCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.DeleteFinishedDeliveries")
+	}
+
+	if !m.DeleteFinishedDeliveriesMock.invocationsDone() && afterDeleteFinishedDeliveriesCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.DeleteFinishedDeliveries but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteFinishedDeliveriesMock.expectedInvocations), afterDeleteFinishedDeliveriesCounter)
+	}
+}
+
+type mWebhookRepositoryMockDeleteIncomingWebhook struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockDeleteIncomingWebhookExpectation
+	expectations       []*WebhookRepositoryMockDeleteIncomingWebhookExpectation
+
+	callArgs []*WebhookRepositoryMockDeleteIncomingWebhookParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockDeleteIncomingWebhookExpectation specifies expectation struct of the WebhookRepository.DeleteIncomingWebhook
+type WebhookRepositoryMockDeleteIncomingWebhookExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockDeleteIncomingWebhookParams
+	paramPtrs *WebhookRepositoryMockDeleteIncomingWebhookParamPtrs
+	results   *WebhookRepositoryMockDeleteIncomingWebhookResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockDeleteIncomingWebhookParams contains parameters of the WebhookRepository.DeleteIncomingWebhook
+type WebhookRepositoryMockDeleteIncomingWebhookParams struct {
+	ctx    context.Context
+	params model.DeleteIncomingWebhookParams
+}
+
+// WebhookRepositoryMockDeleteIncomingWebhookParamPtrs contains pointers to parameters of the WebhookRepository.DeleteIncomingWebhook
+type WebhookRepositoryMockDeleteIncomingWebhookParamPtrs struct {
+	ctx    *context.Context
+	params *model.DeleteIncomingWebhookParams
+}
+
+// WebhookRepositoryMockDeleteIncomingWebhookResults contains results of the WebhookRepository.DeleteIncomingWebhook
+type WebhookRepositoryMockDeleteIncomingWebhookResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) Optional() *mWebhookRepositoryMockDeleteIncomingWebhook {
+	mmDeleteIncomingWebhook.optional = true
+	return mmDeleteIncomingWebhook
+}
+
+// Expect sets up expected params for WebhookRepository.DeleteIncomingWebhook
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) Expect(ctx context.Context, params model.DeleteIncomingWebhookParams) *mWebhookRepositoryMockDeleteIncomingWebhook {
+	if mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteIncomingWebhook mock is already set by Set")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation == nil {
+		mmDeleteIncomingWebhook.defaultExpectation = &WebhookRepositoryMockDeleteIncomingWebhookExpectation{}
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation.paramPtrs != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteIncomingWebhook mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteIncomingWebhook.defaultExpectation.params = &WebhookRepositoryMockDeleteIncomingWebhookParams{ctx, params}
+	for _, e := range mmDeleteIncomingWebhook.expectations {
+		if minimock.Equal(e.params, mmDeleteIncomingWebhook.defaultExpectation.params) {
+			mmDeleteIncomingWebhook.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteIncomingWebhook.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteIncomingWebhook
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.DeleteIncomingWebhook
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockDeleteIncomingWebhook {
+	if mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteIncomingWebhook mock is already set by Set")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation == nil {
+		mmDeleteIncomingWebhook.defaultExpectation = &WebhookRepositoryMockDeleteIncomingWebhookExpectation{}
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation.params != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteIncomingWebhook mock is already set by Expect")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation.paramPtrs == nil {
+		mmDeleteIncomingWebhook.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeleteIncomingWebhookParamPtrs{}
+	}
+	mmDeleteIncomingWebhook.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteIncomingWebhook
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookRepository.DeleteIncomingWebhook
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) ExpectParamsParam2(params model.DeleteIncomingWebhookParams) *mWebhookRepositoryMockDeleteIncomingWebhook {
+	if mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteIncomingWebhook mock is already set by Set")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation == nil {
+		mmDeleteIncomingWebhook.defaultExpectation = &WebhookRepositoryMockDeleteIncomingWebhookExpectation{}
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation.params != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteIncomingWebhook mock is already set by Expect")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation.paramPtrs == nil {
+		mmDeleteIncomingWebhook.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeleteIncomingWebhookParamPtrs{}
+	}
+	mmDeleteIncomingWebhook.defaultExpectation.paramPtrs.params = &params
+
+	return mmDeleteIncomingWebhook
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.DeleteIncomingWebhook
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) Inspect(f func(ctx context.Context, params model.DeleteIncomingWebhookParams)) *mWebhookRepositoryMockDeleteIncomingWebhook {
+	if mmDeleteIncomingWebhook.mock.inspectFuncDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.DeleteIncomingWebhook")
+	}
+
+	mmDeleteIncomingWebhook.mock.inspectFuncDeleteIncomingWebhook = f
+
+	return mmDeleteIncomingWebhook
+}
+
+// Return sets up results that will be returned by WebhookRepository.DeleteIncomingWebhook
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) Return(err error) *WebhookRepositoryMock {
+	if mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteIncomingWebhook mock is already set by Set")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation == nil {
+		mmDeleteIncomingWebhook.defaultExpectation = &WebhookRepositoryMockDeleteIncomingWebhookExpectation{mock: mmDeleteIncomingWebhook.mock}
+	}
+	mmDeleteIncomingWebhook.defaultExpectation.results = &WebhookRepositoryMockDeleteIncomingWebhookResults{err}
+	return mmDeleteIncomingWebhook.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.DeleteIncomingWebhook method
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) Set(f func(ctx context.Context, params model.DeleteIncomingWebhookParams) (err error)) *WebhookRepositoryMock {
+	if mmDeleteIncomingWebhook.defaultExpectation != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.DeleteIncomingWebhook method")
+	}
+
+	if len(mmDeleteIncomingWebhook.expectations) > 0 {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.DeleteIncomingWebhook method")
+	}
+
+	mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook = f
+	return mmDeleteIncomingWebhook.mock
+}
+
+// When sets expectation for the WebhookRepository.DeleteIncomingWebhook which will trigger the result defined by the following
+// Then helper
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) When(ctx context.Context, params model.DeleteIncomingWebhookParams) *WebhookRepositoryMockDeleteIncomingWebhookExpectation {
+	if mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteIncomingWebhook mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockDeleteIncomingWebhookExpectation{
+		mock:   mmDeleteIncomingWebhook.mock,
+		params: &WebhookRepositoryMockDeleteIncomingWebhookParams{ctx, params},
+	}
+	mmDeleteIncomingWebhook.expectations = append(mmDeleteIncomingWebhook.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.DeleteIncomingWebhook return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockDeleteIncomingWebhookExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockDeleteIncomingWebhookResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.DeleteIncomingWebhook should be invoked
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) Times(n uint64) *mWebhookRepositoryMockDeleteIncomingWebhook {
+	if n == 0 {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("Times of WebhookRepositoryMock.DeleteIncomingWebhook mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteIncomingWebhook.expectedInvocations, n)
+	return mmDeleteIncomingWebhook
+}
+
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) invocationsDone() bool {
+	if len(mmDeleteIncomingWebhook.expectations) == 0 && mmDeleteIncomingWebhook.defaultExpectation == nil && mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteIncomingWebhook.mock.afterDeleteIncomingWebhookCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteIncomingWebhook.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteIncomingWebhook implements repository.WebhookRepository
+func (mmDeleteIncomingWebhook *WebhookRepositoryMock) DeleteIncomingWebhook(ctx context.Context, params model.DeleteIncomingWebhookParams) (err error) {
+	mm_atomic.AddUint64(&mmDeleteIncomingWebhook.beforeDeleteIncomingWebhookCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteIncomingWebhook.afterDeleteIncomingWebhookCounter, 1)
+
+	if mmDeleteIncomingWebhook.inspectFuncDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.inspectFuncDeleteIncomingWebhook(ctx, params)
+	}
+
+	mm_params := WebhookRepositoryMockDeleteIncomingWebhookParams{ctx, params}
+
+	// Record call args
+	mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.mutex.Lock()
+	mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.callArgs = append(mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.callArgs, &mm_params)
+	mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.mutex.Unlock()
+
+	for _, e := range mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockDeleteIncomingWebhookParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteIncomingWebhook.t.Errorf("WebhookRepositoryMock.DeleteIncomingWebhook got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmDeleteIncomingWebhook.t.Errorf("WebhookRepositoryMock.DeleteIncomingWebhook got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteIncomingWebhook.t.Errorf("WebhookRepositoryMock.DeleteIncomingWebhook got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteIncomingWebhook.t.Fatal("No results are set for the WebhookRepositoryMock.DeleteIncomingWebhook")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteIncomingWebhook.funcDeleteIncomingWebhook != nil {
+		return mmDeleteIncomingWebhook.funcDeleteIncomingWebhook(ctx, params)
+	}
+	mmDeleteIncomingWebhook.t.Fatalf("Unexpected call to WebhookRepositoryMock.DeleteIncomingWebhook. %v %v", ctx, params)
+	return
+}
+
+// DeleteIncomingWebhookAfterCounter returns a count of finished WebhookRepositoryMock.DeleteIncomingWebhook invocations
+func (mmDeleteIncomingWebhook *WebhookRepositoryMock) DeleteIncomingWebhookAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteIncomingWebhook.afterDeleteIncomingWebhookCounter)
+}
+
+// DeleteIncomingWebhookBeforeCounter returns a count of WebhookRepositoryMock.DeleteIncomingWebhook invocations
+func (mmDeleteIncomingWebhook *WebhookRepositoryMock) DeleteIncomingWebhookBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteIncomingWebhook.beforeDeleteIncomingWebhookCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.DeleteIncomingWebhook.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteIncomingWebhook *mWebhookRepositoryMockDeleteIncomingWebhook) Calls() []*WebhookRepositoryMockDeleteIncomingWebhookParams {
+	mmDeleteIncomingWebhook.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockDeleteIncomingWebhookParams, len(mmDeleteIncomingWebhook.callArgs))
+	copy(argCopy, mmDeleteIncomingWebhook.callArgs)
+
+	mmDeleteIncomingWebhook.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteIncomingWebhookDone returns true if the count of the DeleteIncomingWebhook invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockDeleteIncomingWebhookDone() bool {
+	if m.DeleteIncomingWebhookMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteIncomingWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteIncomingWebhookMock.invocationsDone()
+}
+
+// MinimockDeleteIncomingWebhookInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockDeleteIncomingWebhookInspect() {
+	for _, e := range m.DeleteIncomingWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.DeleteIncomingWebhook with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteIncomingWebhookCounter := mm_atomic.LoadUint64(&m.afterDeleteIncomingWebhookCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteIncomingWebhookMock.defaultExpectation != nil && afterDeleteIncomingWebhookCounter < 1 {
+		if m.DeleteIncomingWebhookMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.DeleteIncomingWebhook")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.DeleteIncomingWebhook with params: %#v", *m.DeleteIncomingWebhookMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteIncomingWebhook != nil && afterDeleteIncomingWebhookCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.DeleteIncomingWebhook")
+	}
+
+	if !m.DeleteIncomingWebhookMock.invocationsDone() && afterDeleteIncomingWebhookCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.DeleteIncomingWebhook but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteIncomingWebhookMock.expectedInvocations), afterDeleteIncomingWebhookCounter)
+	}
+}
+
+type mWebhookRepositoryMockDeleteWebhook struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockDeleteWebhookExpectation
+	expectations       []*WebhookRepositoryMockDeleteWebhookExpectation
+
+	callArgs []*WebhookRepositoryMockDeleteWebhookParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockDeleteWebhookExpectation specifies expectation struct of the WebhookRepository.DeleteWebhook
+type WebhookRepositoryMockDeleteWebhookExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockDeleteWebhookParams
+	paramPtrs *WebhookRepositoryMockDeleteWebhookParamPtrs
+	results   *WebhookRepositoryMockDeleteWebhookResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockDeleteWebhookParams contains parameters of the WebhookRepository.DeleteWebhook
+type WebhookRepositoryMockDeleteWebhookParams struct {
+	ctx    context.Context
+	params model.DeleteWebhookParams
+}
+
+// WebhookRepositoryMockDeleteWebhookParamPtrs contains pointers to parameters of the WebhookRepository.DeleteWebhook
+type WebhookRepositoryMockDeleteWebhookParamPtrs struct {
+	ctx    *context.Context
+	params *model.DeleteWebhookParams
+}
+
+// WebhookRepositoryMockDeleteWebhookResults contains results of the WebhookRepository.DeleteWebhook
+type WebhookRepositoryMockDeleteWebhookResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) Optional() *mWebhookRepositoryMockDeleteWebhook {
+	mmDeleteWebhook.optional = true
+	return mmDeleteWebhook
+}
+
+// Expect sets up expected params for WebhookRepository.DeleteWebhook
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) Expect(ctx context.Context, params model.DeleteWebhookParams) *mWebhookRepositoryMockDeleteWebhook {
+	if mmDeleteWebhook.mock.funcDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteWebhook mock is already set by Set")
+	}
+
+	if mmDeleteWebhook.defaultExpectation == nil {
+		mmDeleteWebhook.defaultExpectation = &WebhookRepositoryMockDeleteWebhookExpectation{}
+	}
+
+	if mmDeleteWebhook.defaultExpectation.paramPtrs != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteWebhook mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteWebhook.defaultExpectation.params = &WebhookRepositoryMockDeleteWebhookParams{ctx, params}
+	for _, e := range mmDeleteWebhook.expectations {
+		if minimock.Equal(e.params, mmDeleteWebhook.defaultExpectation.params) {
+			mmDeleteWebhook.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteWebhook.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteWebhook
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.DeleteWebhook
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockDeleteWebhook {
+	if mmDeleteWebhook.mock.funcDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteWebhook mock is already set by Set")
+	}
+
+	if mmDeleteWebhook.defaultExpectation == nil {
+		mmDeleteWebhook.defaultExpectation = &WebhookRepositoryMockDeleteWebhookExpectation{}
+	}
+
+	if mmDeleteWebhook.defaultExpectation.params != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteWebhook mock is already set by Expect")
+	}
+
+	if mmDeleteWebhook.defaultExpectation.paramPtrs == nil {
+		mmDeleteWebhook.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeleteWebhookParamPtrs{}
+	}
+	mmDeleteWebhook.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteWebhook
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookRepository.DeleteWebhook
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) ExpectParamsParam2(params model.DeleteWebhookParams) *mWebhookRepositoryMockDeleteWebhook {
+	if mmDeleteWebhook.mock.funcDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteWebhook mock is already set by Set")
+	}
+
+	if mmDeleteWebhook.defaultExpectation == nil {
+		mmDeleteWebhook.defaultExpectation = &WebhookRepositoryMockDeleteWebhookExpectation{}
+	}
+
+	if mmDeleteWebhook.defaultExpectation.params != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteWebhook mock is already set by Expect")
+	}
+
+	if mmDeleteWebhook.defaultExpectation.paramPtrs == nil {
+		mmDeleteWebhook.defaultExpectation.paramPtrs = &WebhookRepositoryMockDeleteWebhookParamPtrs{}
+	}
+	mmDeleteWebhook.defaultExpectation.paramPtrs.params = &params
+
+	return mmDeleteWebhook
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.DeleteWebhook
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) Inspect(f func(ctx context.Context, params model.DeleteWebhookParams)) *mWebhookRepositoryMockDeleteWebhook {
+	if mmDeleteWebhook.mock.inspectFuncDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.DeleteWebhook")
+	}
+
+	mmDeleteWebhook.mock.inspectFuncDeleteWebhook = f
+
+	return mmDeleteWebhook
+}
+
+// Return sets up results that will be returned by WebhookRepository.DeleteWebhook
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) Return(err error) *WebhookRepositoryMock {
+	if mmDeleteWebhook.mock.funcDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteWebhook mock is already set by Set")
+	}
+
+	if mmDeleteWebhook.defaultExpectation == nil {
+		mmDeleteWebhook.defaultExpectation = &WebhookRepositoryMockDeleteWebhookExpectation{mock: mmDeleteWebhook.mock}
+	}
+	mmDeleteWebhook.defaultExpectation.results = &WebhookRepositoryMockDeleteWebhookResults{err}
+	return mmDeleteWebhook.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.DeleteWebhook method
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) Set(f func(ctx context.Context, params model.DeleteWebhookParams) (err error)) *WebhookRepositoryMock {
+	if mmDeleteWebhook.defaultExpectation != nil {
+		mmDeleteWebhook.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.DeleteWebhook method")
+	}
+
+	if len(mmDeleteWebhook.expectations) > 0 {
+		mmDeleteWebhook.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.DeleteWebhook method")
+	}
+
+	mmDeleteWebhook.mock.funcDeleteWebhook = f
+	return mmDeleteWebhook.mock
+}
+
+// When sets expectation for the WebhookRepository.DeleteWebhook which will trigger the result defined by the following
+// Then helper
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) When(ctx context.Context, params model.DeleteWebhookParams) *WebhookRepositoryMockDeleteWebhookExpectation {
+	if mmDeleteWebhook.mock.funcDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookRepositoryMock.DeleteWebhook mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockDeleteWebhookExpectation{
+		mock:   mmDeleteWebhook.mock,
+		params: &WebhookRepositoryMockDeleteWebhookParams{ctx, params},
+	}
+	mmDeleteWebhook.expectations = append(mmDeleteWebhook.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.DeleteWebhook return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockDeleteWebhookExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockDeleteWebhookResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.DeleteWebhook should be invoked
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) Times(n uint64) *mWebhookRepositoryMockDeleteWebhook {
+	if n == 0 {
+		mmDeleteWebhook.mock.t.Fatalf("Times of WebhookRepositoryMock.DeleteWebhook mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteWebhook.expectedInvocations, n)
+	return mmDeleteWebhook
+}
+
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) invocationsDone() bool {
+	if len(mmDeleteWebhook.expectations) == 0 && mmDeleteWebhook.defaultExpectation == nil && mmDeleteWebhook.mock.funcDeleteWebhook == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteWebhook.mock.afterDeleteWebhookCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteWebhook.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteWebhook implements repository.WebhookRepository
+func (mmDeleteWebhook *WebhookRepositoryMock) DeleteWebhook(ctx context.Context, params model.DeleteWebhookParams) (err error) {
+	mm_atomic.AddUint64(&mmDeleteWebhook.beforeDeleteWebhookCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteWebhook.afterDeleteWebhookCounter, 1)
+
+	if mmDeleteWebhook.inspectFuncDeleteWebhook != nil {
+		mmDeleteWebhook.inspectFuncDeleteWebhook(ctx, params)
+	}
+
+	mm_params := WebhookRepositoryMockDeleteWebhookParams{ctx, params}
+
+	// Record call args
+	mmDeleteWebhook.DeleteWebhookMock.mutex.Lock()
+	mmDeleteWebhook.DeleteWebhookMock.callArgs = append(mmDeleteWebhook.DeleteWebhookMock.callArgs, &mm_params)
+	mmDeleteWebhook.DeleteWebhookMock.mutex.Unlock()
+
+	for _, e := range mmDeleteWebhook.DeleteWebhookMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteWebhook.DeleteWebhookMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteWebhook.DeleteWebhookMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteWebhook.DeleteWebhookMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteWebhook.DeleteWebhookMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockDeleteWebhookParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteWebhook.t.Errorf("WebhookRepositoryMock.DeleteWebhook got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmDeleteWebhook.t.Errorf("WebhookRepositoryMock.DeleteWebhook got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteWebhook.t.Errorf("WebhookRepositoryMock.DeleteWebhook got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteWebhook.DeleteWebhookMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteWebhook.t.Fatal("No results are set for the WebhookRepositoryMock.DeleteWebhook")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteWebhook.funcDeleteWebhook != nil {
+		return mmDeleteWebhook.funcDeleteWebhook(ctx, params)
+	}
+	mmDeleteWebhook.t.Fatalf("Unexpected call to WebhookRepositoryMock.DeleteWebhook. %v %v", ctx, params)
+	return
+}
+
+// DeleteWebhookAfterCounter returns a count of finished WebhookRepositoryMock.DeleteWebhook invocations
+func (mmDeleteWebhook *WebhookRepositoryMock) DeleteWebhookAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteWebhook.afterDeleteWebhookCounter)
+}
+
+// DeleteWebhookBeforeCounter returns a count of WebhookRepositoryMock.DeleteWebhook invocations
+func (mmDeleteWebhook *WebhookRepositoryMock) DeleteWebhookBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteWebhook.beforeDeleteWebhookCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.DeleteWebhook.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteWebhook *mWebhookRepositoryMockDeleteWebhook) Calls() []*WebhookRepositoryMockDeleteWebhookParams {
+	mmDeleteWebhook.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockDeleteWebhookParams, len(mmDeleteWebhook.callArgs))
+	copy(argCopy, mmDeleteWebhook.callArgs)
+
+	mmDeleteWebhook.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteWebhookDone returns true if the count of the DeleteWebhook invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockDeleteWebhookDone() bool {
+	if m.DeleteWebhookMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteWebhookMock.invocationsDone()
+}
+
+// MinimockDeleteWebhookInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockDeleteWebhookInspect() {
+	for _, e := range m.DeleteWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.DeleteWebhook with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteWebhookCounter := mm_atomic.LoadUint64(&m.afterDeleteWebhookCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteWebhookMock.defaultExpectation != nil && afterDeleteWebhookCounter < 1 {
+		if m.DeleteWebhookMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.DeleteWebhook")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.DeleteWebhook with params: %#v", *m.DeleteWebhookMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteWebhook != nil && afterDeleteWebhookCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.DeleteWebhook")
+	}
+
+	if !m.DeleteWebhookMock.invocationsDone() && afterDeleteWebhookCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.DeleteWebhook but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteWebhookMock.expectedInvocations), afterDeleteWebhookCounter)
+	}
+}
+
+type mWebhookRepositoryMockGetIncomingWebhookByTokenHash struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation
+	expectations       []*WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation
+
+	callArgs []*WebhookRepositoryMockGetIncomingWebhookByTokenHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation specifies expectation struct of the WebhookRepository.GetIncomingWebhookByTokenHash
+type WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockGetIncomingWebhookByTokenHashParams
+	paramPtrs *WebhookRepositoryMockGetIncomingWebhookByTokenHashParamPtrs
+	results   *WebhookRepositoryMockGetIncomingWebhookByTokenHashResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockGetIncomingWebhookByTokenHashParams contains parameters of the WebhookRepository.GetIncomingWebhookByTokenHash
+type WebhookRepositoryMockGetIncomingWebhookByTokenHashParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// WebhookRepositoryMockGetIncomingWebhookByTokenHashParamPtrs contains pointers to parameters of the WebhookRepository.GetIncomingWebhookByTokenHash
+type WebhookRepositoryMockGetIncomingWebhookByTokenHashParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// WebhookRepositoryMockGetIncomingWebhookByTokenHashResults contains results of the WebhookRepository.GetIncomingWebhookByTokenHash
+type WebhookRepositoryMockGetIncomingWebhookByTokenHashResults struct {
+	webhook model.IncomingWebhook
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) Optional() *mWebhookRepositoryMockGetIncomingWebhookByTokenHash {
+	mmGetIncomingWebhookByTokenHash.optional = true
+	return mmGetIncomingWebhookByTokenHash
+}
+
+// Expect sets up expected params for WebhookRepository.GetIncomingWebhookByTokenHash
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) Expect(ctx context.Context, tokenHash string) *mWebhookRepositoryMockGetIncomingWebhookByTokenHash {
+	if mmGetIncomingWebhookByTokenHash.mock.funcGetIncomingWebhookByTokenHash != nil {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash mock is already set by Set")
+	}
+
+	if mmGetIncomingWebhookByTokenHash.defaultExpectation == nil {
+		mmGetIncomingWebhookByTokenHash.defaultExpectation = &WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation{}
+	}
+
+	if mmGetIncomingWebhookByTokenHash.defaultExpectation.paramPtrs != nil {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash mock is already set by ExpectParams functions")
+	}
+
+	mmGetIncomingWebhookByTokenHash.defaultExpectation.params = &WebhookRepositoryMockGetIncomingWebhookByTokenHashParams{ctx, tokenHash}
+	for _, e := range mmGetIncomingWebhookByTokenHash.expectations {
+		if minimock.Equal(e.params, mmGetIncomingWebhookByTokenHash.defaultExpectation.params) {
+			mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetIncomingWebhookByTokenHash.defaultExpectation.params)
+		}
+	}
+
+	return mmGetIncomingWebhookByTokenHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.GetIncomingWebhookByTokenHash
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockGetIncomingWebhookByTokenHash {
+	if mmGetIncomingWebhookByTokenHash.mock.funcGetIncomingWebhookByTokenHash != nil {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash mock is already set by Set")
+	}
+
+	if mmGetIncomingWebhookByTokenHash.defaultExpectation == nil {
+		mmGetIncomingWebhookByTokenHash.defaultExpectation = &WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation{}
+	}
+
+	if mmGetIncomingWebhookByTokenHash.defaultExpectation.params != nil {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash mock is already set by Expect")
+	}
+
+	if mmGetIncomingWebhookByTokenHash.defaultExpectation.paramPtrs == nil {
+		mmGetIncomingWebhookByTokenHash.defaultExpectation.paramPtrs = &WebhookRepositoryMockGetIncomingWebhookByTokenHashParamPtrs{}
+	}
+	mmGetIncomingWebhookByTokenHash.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetIncomingWebhookByTokenHash
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for WebhookRepository.GetIncomingWebhookByTokenHash
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) ExpectTokenHashParam2(tokenHash string) *mWebhookRepositoryMockGetIncomingWebhookByTokenHash {
+	if mmGetIncomingWebhookByTokenHash.mock.funcGetIncomingWebhookByTokenHash != nil {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash mock is already set by Set")
+	}
+
+	if mmGetIncomingWebhookByTokenHash.defaultExpectation == nil {
+		mmGetIncomingWebhookByTokenHash.defaultExpectation = &WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation{}
+	}
+
+	if mmGetIncomingWebhookByTokenHash.defaultExpectation.params != nil {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash mock is already set by Expect")
+	}
+
+	if mmGetIncomingWebhookByTokenHash.defaultExpectation.paramPtrs == nil {
+		mmGetIncomingWebhookByTokenHash.defaultExpectation.paramPtrs = &WebhookRepositoryMockGetIncomingWebhookByTokenHashParamPtrs{}
+	}
+	mmGetIncomingWebhookByTokenHash.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+
+	return mmGetIncomingWebhookByTokenHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.GetIncomingWebhookByTokenHash
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) Inspect(f func(ctx context.Context, tokenHash string)) *mWebhookRepositoryMockGetIncomingWebhookByTokenHash {
+	if mmGetIncomingWebhookByTokenHash.mock.inspectFuncGetIncomingWebhookByTokenHash != nil {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.GetIncomingWebhookByTokenHash")
+	}
+
+	mmGetIncomingWebhookByTokenHash.mock.inspectFuncGetIncomingWebhookByTokenHash = f
+
+	return mmGetIncomingWebhookByTokenHash
+}
+
+// Return sets up results that will be returned by WebhookRepository.GetIncomingWebhookByTokenHash
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) Return(webhook model.IncomingWebhook, err error) *WebhookRepositoryMock {
+	if mmGetIncomingWebhookByTokenHash.mock.funcGetIncomingWebhookByTokenHash != nil {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash mock is already set by Set")
+	}
+
+	if mmGetIncomingWebhookByTokenHash.defaultExpectation == nil {
+		mmGetIncomingWebhookByTokenHash.defaultExpectation = &WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation{mock: mmGetIncomingWebhookByTokenHash.mock}
+	}
+	mmGetIncomingWebhookByTokenHash.defaultExpectation.results = &WebhookRepositoryMockGetIncomingWebhookByTokenHashResults{webhook, err}
+	return mmGetIncomingWebhookByTokenHash.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.GetIncomingWebhookByTokenHash method
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) Set(f func(ctx context.Context, tokenHash string) (webhook model.IncomingWebhook, err error)) *WebhookRepositoryMock {
+	if mmGetIncomingWebhookByTokenHash.defaultExpectation != nil {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.GetIncomingWebhookByTokenHash method")
+	}
+
+	if len(mmGetIncomingWebhookByTokenHash.expectations) > 0 {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.GetIncomingWebhookByTokenHash method")
+	}
+
+	mmGetIncomingWebhookByTokenHash.mock.funcGetIncomingWebhookByTokenHash = f
+	return mmGetIncomingWebhookByTokenHash.mock
+}
+
+// When sets expectation for the WebhookRepository.GetIncomingWebhookByTokenHash which will trigger the result defined by the following
+// Then helper
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) When(ctx context.Context, tokenHash string) *WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation {
+	if mmGetIncomingWebhookByTokenHash.mock.funcGetIncomingWebhookByTokenHash != nil {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation{
+		mock:   mmGetIncomingWebhookByTokenHash.mock,
+		params: &WebhookRepositoryMockGetIncomingWebhookByTokenHashParams{ctx, tokenHash},
+	}
+	mmGetIncomingWebhookByTokenHash.expectations = append(mmGetIncomingWebhookByTokenHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.GetIncomingWebhookByTokenHash return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockGetIncomingWebhookByTokenHashExpectation) Then(webhook model.IncomingWebhook, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockGetIncomingWebhookByTokenHashResults{webhook, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.GetIncomingWebhookByTokenHash should be invoked
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) Times(n uint64) *mWebhookRepositoryMockGetIncomingWebhookByTokenHash {
+	if n == 0 {
+		mmGetIncomingWebhookByTokenHash.mock.t.Fatalf("Times of WebhookRepositoryMock.GetIncomingWebhookByTokenHash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetIncomingWebhookByTokenHash.expectedInvocations, n)
+	return mmGetIncomingWebhookByTokenHash
+}
+
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) invocationsDone() bool {
+	if len(mmGetIncomingWebhookByTokenHash.expectations) == 0 && mmGetIncomingWebhookByTokenHash.defaultExpectation == nil && mmGetIncomingWebhookByTokenHash.mock.funcGetIncomingWebhookByTokenHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetIncomingWebhookByTokenHash.mock.afterGetIncomingWebhookByTokenHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetIncomingWebhookByTokenHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetIncomingWebhookByTokenHash implements repository.WebhookRepository
+func (mmGetIncomingWebhookByTokenHash *WebhookRepositoryMock) GetIncomingWebhookByTokenHash(ctx context.Context, tokenHash string) (webhook model.IncomingWebhook, err error) {
+	mm_atomic.AddUint64(&mmGetIncomingWebhookByTokenHash.beforeGetIncomingWebhookByTokenHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetIncomingWebhookByTokenHash.afterGetIncomingWebhookByTokenHashCounter, 1)
+
+	if mmGetIncomingWebhookByTokenHash.inspectFuncGetIncomingWebhookByTokenHash != nil {
+		mmGetIncomingWebhookByTokenHash.inspectFuncGetIncomingWebhookByTokenHash(ctx, tokenHash)
+	}
+
+	mm_params := WebhookRepositoryMockGetIncomingWebhookByTokenHashParams{ctx, tokenHash}
+
+	// Record call args
+	mmGetIncomingWebhookByTokenHash.GetIncomingWebhookByTokenHashMock.mutex.Lock()
+	mmGetIncomingWebhookByTokenHash.GetIncomingWebhookByTokenHashMock.callArgs = append(mmGetIncomingWebhookByTokenHash.GetIncomingWebhookByTokenHashMock.callArgs, &mm_params)
+	mmGetIncomingWebhookByTokenHash.GetIncomingWebhookByTokenHashMock.mutex.Unlock()
+
+	for _, e := range mmGetIncomingWebhookByTokenHash.GetIncomingWebhookByTokenHashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.webhook, e.results.err
+		}
+	}
+
+	if mmGetIncomingWebhookByTokenHash.GetIncomingWebhookByTokenHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetIncomingWebhookByTokenHash.GetIncomingWebhookByTokenHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetIncomingWebhookByTokenHash.GetIncomingWebhookByTokenHashMock.defaultExpectation.params
+		mm_want_ptrs := mmGetIncomingWebhookByTokenHash.GetIncomingWebhookByTokenHashMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockGetIncomingWebhookByTokenHashParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetIncomingWebhookByTokenHash.t.Errorf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmGetIncomingWebhookByTokenHash.t.Errorf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash got unexpected parameter tokenHash, want: %#v, got: %#v%s\n", *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetIncomingWebhookByTokenHash.t.Errorf("WebhookRepositoryMock.GetIncomingWebhookByTokenHash got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetIncomingWebhookByTokenHash.GetIncomingWebhookByTokenHashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetIncomingWebhookByTokenHash.t.Fatal("No results are set for the WebhookRepositoryMock.GetIncomingWebhookByTokenHash")
+		}
+		return (*mm_results).webhook, (*mm_results).err
+	}
+	if mmGetIncomingWebhookByTokenHash.funcGetIncomingWebhookByTokenHash != nil {
+		return mmGetIncomingWebhookByTokenHash.funcGetIncomingWebhookByTokenHash(ctx, tokenHash)
+	}
+	mmGetIncomingWebhookByTokenHash.t.Fatalf("Unexpected call to WebhookRepositoryMock.GetIncomingWebhookByTokenHash. %v %v", ctx, tokenHash)
+	return
+}
+
+// GetIncomingWebhookByTokenHashAfterCounter returns a count of finished WebhookRepositoryMock.GetIncomingWebhookByTokenHash invocations
+func (mmGetIncomingWebhookByTokenHash *WebhookRepositoryMock) GetIncomingWebhookByTokenHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetIncomingWebhookByTokenHash.afterGetIncomingWebhookByTokenHashCounter)
+}
+
+// GetIncomingWebhookByTokenHashBeforeCounter returns a count of WebhookRepositoryMock.GetIncomingWebhookByTokenHash invocations
+func (mmGetIncomingWebhookByTokenHash *WebhookRepositoryMock) GetIncomingWebhookByTokenHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetIncomingWebhookByTokenHash.beforeGetIncomingWebhookByTokenHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.GetIncomingWebhookByTokenHash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetIncomingWebhookByTokenHash *mWebhookRepositoryMockGetIncomingWebhookByTokenHash) Calls() []*WebhookRepositoryMockGetIncomingWebhookByTokenHashParams {
+	mmGetIncomingWebhookByTokenHash.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockGetIncomingWebhookByTokenHashParams, len(mmGetIncomingWebhookByTokenHash.callArgs))
+	copy(argCopy, mmGetIncomingWebhookByTokenHash.callArgs)
+
+	mmGetIncomingWebhookByTokenHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetIncomingWebhookByTokenHashDone returns true if the count of the GetIncomingWebhookByTokenHash invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockGetIncomingWebhookByTokenHashDone() bool {
+	if m.GetIncomingWebhookByTokenHashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetIncomingWebhookByTokenHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetIncomingWebhookByTokenHashMock.invocationsDone()
+}
+
+// MinimockGetIncomingWebhookByTokenHashInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockGetIncomingWebhookByTokenHashInspect() {
+	for _, e := range m.GetIncomingWebhookByTokenHashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.GetIncomingWebhookByTokenHash with params: %#v", *e.params)
+		}
+	}
+
+	afterGetIncomingWebhookByTokenHashCounter := mm_atomic.LoadUint64(&m.afterGetIncomingWebhookByTokenHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetIncomingWebhookByTokenHashMock.defaultExpectation != nil && afterGetIncomingWebhookByTokenHashCounter < 1 {
+		if m.GetIncomingWebhookByTokenHashMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.GetIncomingWebhookByTokenHash")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.GetIncomingWebhookByTokenHash with params: %#v", *m.GetIncomingWebhookByTokenHashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetIncomingWebhookByTokenHash != nil && afterGetIncomingWebhookByTokenHashCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.GetIncomingWebhookByTokenHash")
+	}
+
+	if !m.GetIncomingWebhookByTokenHashMock.invocationsDone() && afterGetIncomingWebhookByTokenHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.GetIncomingWebhookByTokenHash but found %d calls",
+			mm_atomic.LoadUint64(&m.GetIncomingWebhookByTokenHashMock.expectedInvocations), afterGetIncomingWebhookByTokenHashCounter)
+	}
+}
+
+type mWebhookRepositoryMockListIncomingWebhooks struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockListIncomingWebhooksExpectation
+	expectations       []*WebhookRepositoryMockListIncomingWebhooksExpectation
+
+	callArgs []*WebhookRepositoryMockListIncomingWebhooksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockListIncomingWebhooksExpectation specifies expectation struct of the WebhookRepository.ListIncomingWebhooks
+type WebhookRepositoryMockListIncomingWebhooksExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockListIncomingWebhooksParams
+	paramPtrs *WebhookRepositoryMockListIncomingWebhooksParamPtrs
+	results   *WebhookRepositoryMockListIncomingWebhooksResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockListIncomingWebhooksParams contains parameters of the WebhookRepository.ListIncomingWebhooks
+type WebhookRepositoryMockListIncomingWebhooksParams struct {
+	ctx    context.Context
+	params model.ListIncomingWebhooksParams
+}
+
+// WebhookRepositoryMockListIncomingWebhooksParamPtrs contains pointers to parameters of the WebhookRepository.ListIncomingWebhooks
+type WebhookRepositoryMockListIncomingWebhooksParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListIncomingWebhooksParams
+}
+
+// WebhookRepositoryMockListIncomingWebhooksResults contains results of the WebhookRepository.ListIncomingWebhooks
+type WebhookRepositoryMockListIncomingWebhooksResults struct {
+	webhooks []model.IncomingWebhook
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) Optional() *mWebhookRepositoryMockListIncomingWebhooks {
+	mmListIncomingWebhooks.optional = true
+	return mmListIncomingWebhooks
+}
+
+// Expect sets up expected params for WebhookRepository.ListIncomingWebhooks
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) Expect(ctx context.Context, params model.ListIncomingWebhooksParams) *mWebhookRepositoryMockListIncomingWebhooks {
+	if mmListIncomingWebhooks.mock.funcListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListIncomingWebhooks mock is already set by Set")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation == nil {
+		mmListIncomingWebhooks.defaultExpectation = &WebhookRepositoryMockListIncomingWebhooksExpectation{}
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation.paramPtrs != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListIncomingWebhooks mock is already set by ExpectParams functions")
+	}
+
+	mmListIncomingWebhooks.defaultExpectation.params = &WebhookRepositoryMockListIncomingWebhooksParams{ctx, params}
+	for _, e := range mmListIncomingWebhooks.expectations {
+		if minimock.Equal(e.params, mmListIncomingWebhooks.defaultExpectation.params) {
+			mmListIncomingWebhooks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListIncomingWebhooks.defaultExpectation.params)
+		}
+	}
+
+	return mmListIncomingWebhooks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.ListIncomingWebhooks
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockListIncomingWebhooks {
+	if mmListIncomingWebhooks.mock.funcListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListIncomingWebhooks mock is already set by Set")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation == nil {
+		mmListIncomingWebhooks.defaultExpectation = &WebhookRepositoryMockListIncomingWebhooksExpectation{}
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation.params != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListIncomingWebhooks mock is already set by Expect")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation.paramPtrs == nil {
+		mmListIncomingWebhooks.defaultExpectation.paramPtrs = &WebhookRepositoryMockListIncomingWebhooksParamPtrs{}
+	}
+	mmListIncomingWebhooks.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListIncomingWebhooks
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookRepository.ListIncomingWebhooks
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) ExpectParamsParam2(params model.ListIncomingWebhooksParams) *mWebhookRepositoryMockListIncomingWebhooks {
+	if mmListIncomingWebhooks.mock.funcListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListIncomingWebhooks mock is already set by Set")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation == nil {
+		mmListIncomingWebhooks.defaultExpectation = &WebhookRepositoryMockListIncomingWebhooksExpectation{}
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation.params != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListIncomingWebhooks mock is already set by Expect")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation.paramPtrs == nil {
+		mmListIncomingWebhooks.defaultExpectation.paramPtrs = &WebhookRepositoryMockListIncomingWebhooksParamPtrs{}
+	}
+	mmListIncomingWebhooks.defaultExpectation.paramPtrs.params = &params
+
+	return mmListIncomingWebhooks
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.ListIncomingWebhooks
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) Inspect(f func(ctx context.Context, params model.ListIncomingWebhooksParams)) *mWebhookRepositoryMockListIncomingWebhooks {
+	if mmListIncomingWebhooks.mock.inspectFuncListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.ListIncomingWebhooks")
+	}
+
+	mmListIncomingWebhooks.mock.inspectFuncListIncomingWebhooks = f
+
+	return mmListIncomingWebhooks
+}
+
+// Return sets up results that will be returned by WebhookRepository.ListIncomingWebhooks
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) Return(webhooks []model.IncomingWebhook, err error) *WebhookRepositoryMock {
+	if mmListIncomingWebhooks.mock.funcListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListIncomingWebhooks mock is already set by Set")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation == nil {
+		mmListIncomingWebhooks.defaultExpectation = &WebhookRepositoryMockListIncomingWebhooksExpectation{mock: mmListIncomingWebhooks.mock}
+	}
+	mmListIncomingWebhooks.defaultExpectation.results = &WebhookRepositoryMockListIncomingWebhooksResults{webhooks, err}
+	return mmListIncomingWebhooks.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.ListIncomingWebhooks method
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) Set(f func(ctx context.Context, params model.ListIncomingWebhooksParams) (webhooks []model.IncomingWebhook, err error)) *WebhookRepositoryMock {
+	if mmListIncomingWebhooks.defaultExpectation != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.ListIncomingWebhooks method")
+	}
+
+	if len(mmListIncomingWebhooks.expectations) > 0 {
+		mmListIncomingWebhooks.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.ListIncomingWebhooks method")
+	}
+
+	mmListIncomingWebhooks.mock.funcListIncomingWebhooks = f
+	return mmListIncomingWebhooks.mock
+}
+
+// When sets expectation for the WebhookRepository.ListIncomingWebhooks which will trigger the result defined by the following
+// Then helper
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) When(ctx context.Context, params model.ListIncomingWebhooksParams) *WebhookRepositoryMockListIncomingWebhooksExpectation {
+	if mmListIncomingWebhooks.mock.funcListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListIncomingWebhooks mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockListIncomingWebhooksExpectation{
+		mock:   mmListIncomingWebhooks.mock,
+		params: &WebhookRepositoryMockListIncomingWebhooksParams{ctx, params},
+	}
+	mmListIncomingWebhooks.expectations = append(mmListIncomingWebhooks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.ListIncomingWebhooks return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockListIncomingWebhooksExpectation) Then(webhooks []model.IncomingWebhook, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockListIncomingWebhooksResults{webhooks, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.ListIncomingWebhooks should be invoked
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) Times(n uint64) *mWebhookRepositoryMockListIncomingWebhooks {
+	if n == 0 {
+		mmListIncomingWebhooks.mock.t.Fatalf("Times of WebhookRepositoryMock.ListIncomingWebhooks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListIncomingWebhooks.expectedInvocations, n)
+	return mmListIncomingWebhooks
+}
+
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) invocationsDone() bool {
+	if len(mmListIncomingWebhooks.expectations) == 0 && mmListIncomingWebhooks.defaultExpectation == nil && mmListIncomingWebhooks.mock.funcListIncomingWebhooks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListIncomingWebhooks.mock.afterListIncomingWebhooksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListIncomingWebhooks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListIncomingWebhooks implements repository.WebhookRepository
+func (mmListIncomingWebhooks *WebhookRepositoryMock) ListIncomingWebhooks(ctx context.Context, params model.ListIncomingWebhooksParams) (webhooks []model.IncomingWebhook, err error) {
+	mm_atomic.AddUint64(&mmListIncomingWebhooks.beforeListIncomingWebhooksCounter, 1)
+	defer mm_atomic.AddUint64(&mmListIncomingWebhooks.afterListIncomingWebhooksCounter, 1)
+
+	if mmListIncomingWebhooks.inspectFuncListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.inspectFuncListIncomingWebhooks(ctx, params)
+	}
+
+	mm_params := WebhookRepositoryMockListIncomingWebhooksParams{ctx, params}
+
+	// Record call args
+	mmListIncomingWebhooks.ListIncomingWebhooksMock.mutex.Lock()
+	mmListIncomingWebhooks.ListIncomingWebhooksMock.callArgs = append(mmListIncomingWebhooks.ListIncomingWebhooksMock.callArgs, &mm_params)
+	mmListIncomingWebhooks.ListIncomingWebhooksMock.mutex.Unlock()
+
+	for _, e := range mmListIncomingWebhooks.ListIncomingWebhooksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.webhooks, e.results.err
+		}
+	}
+
+	if mmListIncomingWebhooks.ListIncomingWebhooksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListIncomingWebhooks.ListIncomingWebhooksMock.defaultExpectation.Counter, 1)
+		mm_want := mmListIncomingWebhooks.ListIncomingWebhooksMock.defaultExpectation.params
+		mm_want_ptrs := mmListIncomingWebhooks.ListIncomingWebhooksMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockListIncomingWebhooksParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListIncomingWebhooks.t.Errorf("WebhookRepositoryMock.ListIncomingWebhooks got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListIncomingWebhooks.t.Errorf("WebhookRepositoryMock.ListIncomingWebhooks got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListIncomingWebhooks.t.Errorf("WebhookRepositoryMock.ListIncomingWebhooks got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListIncomingWebhooks.ListIncomingWebhooksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListIncomingWebhooks.t.Fatal("No results are set for the WebhookRepositoryMock.ListIncomingWebhooks")
+		}
+		return (*mm_results).webhooks, (*mm_results).err
+	}
+	if mmListIncomingWebhooks.funcListIncomingWebhooks != nil {
+		return mmListIncomingWebhooks.funcListIncomingWebhooks(ctx, params)
+	}
+	mmListIncomingWebhooks.t.Fatalf("Unexpected call to WebhookRepositoryMock.ListIncomingWebhooks. %v %v", ctx, params)
+	return
+}
+
+// ListIncomingWebhooksAfterCounter returns a count of finished WebhookRepositoryMock.ListIncomingWebhooks invocations
+func (mmListIncomingWebhooks *WebhookRepositoryMock) ListIncomingWebhooksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListIncomingWebhooks.afterListIncomingWebhooksCounter)
+}
+
+// ListIncomingWebhooksBeforeCounter returns a count of WebhookRepositoryMock.ListIncomingWebhooks invocations
+func (mmListIncomingWebhooks *WebhookRepositoryMock) ListIncomingWebhooksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListIncomingWebhooks.beforeListIncomingWebhooksCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.ListIncomingWebhooks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListIncomingWebhooks *mWebhookRepositoryMockListIncomingWebhooks) Calls() []*WebhookRepositoryMockListIncomingWebhooksParams {
+	mmListIncomingWebhooks.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockListIncomingWebhooksParams, len(mmListIncomingWebhooks.callArgs))
+	copy(argCopy, mmListIncomingWebhooks.callArgs)
+
+	mmListIncomingWebhooks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListIncomingWebhooksDone returns true if the count of the ListIncomingWebhooks invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockListIncomingWebhooksDone() bool {
+	if m.ListIncomingWebhooksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListIncomingWebhooksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListIncomingWebhooksMock.invocationsDone()
+}
+
+// MinimockListIncomingWebhooksInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockListIncomingWebhooksInspect() {
+	for _, e := range m.ListIncomingWebhooksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListIncomingWebhooks with params: %#v", *e.params)
+		}
+	}
+
+	afterListIncomingWebhooksCounter := mm_atomic.LoadUint64(&m.afterListIncomingWebhooksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListIncomingWebhooksMock.defaultExpectation != nil && afterListIncomingWebhooksCounter < 1 {
+		if m.ListIncomingWebhooksMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.ListIncomingWebhooks")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListIncomingWebhooks with params: %#v", *m.ListIncomingWebhooksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListIncomingWebhooks != nil && afterListIncomingWebhooksCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.ListIncomingWebhooks")
+	}
+
+	if !m.ListIncomingWebhooksMock.invocationsDone() && afterListIncomingWebhooksCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.ListIncomingWebhooks but found %d calls",
+			mm_atomic.LoadUint64(&m.ListIncomingWebhooksMock.expectedInvocations), afterListIncomingWebhooksCounter)
+	}
+}
+
+type mWebhookRepositoryMockListWebhooks struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockListWebhooksExpectation
+	expectations       []*WebhookRepositoryMockListWebhooksExpectation
+
+	callArgs []*WebhookRepositoryMockListWebhooksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockListWebhooksExpectation specifies expectation struct of the WebhookRepository.ListWebhooks
+type WebhookRepositoryMockListWebhooksExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockListWebhooksParams
+	paramPtrs *WebhookRepositoryMockListWebhooksParamPtrs
+	results   *WebhookRepositoryMockListWebhooksResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockListWebhooksParams contains parameters of the WebhookRepository.ListWebhooks
+type WebhookRepositoryMockListWebhooksParams struct {
+	ctx    context.Context
+	params model.ListWebhooksParams
+}
+
+// WebhookRepositoryMockListWebhooksParamPtrs contains pointers to parameters of the WebhookRepository.ListWebhooks
+type WebhookRepositoryMockListWebhooksParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListWebhooksParams
+}
+
+// WebhookRepositoryMockListWebhooksResults contains results of the WebhookRepository.ListWebhooks
+type WebhookRepositoryMockListWebhooksResults struct {
+	webhooks []model.Webhook
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) Optional() *mWebhookRepositoryMockListWebhooks {
+	mmListWebhooks.optional = true
+	return mmListWebhooks
+}
+
+// Expect sets up expected params for WebhookRepository.ListWebhooks
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) Expect(ctx context.Context, params model.ListWebhooksParams) *mWebhookRepositoryMockListWebhooks {
+	if mmListWebhooks.mock.funcListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListWebhooks mock is already set by Set")
+	}
+
+	if mmListWebhooks.defaultExpectation == nil {
+		mmListWebhooks.defaultExpectation = &WebhookRepositoryMockListWebhooksExpectation{}
+	}
+
+	if mmListWebhooks.defaultExpectation.paramPtrs != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListWebhooks mock is already set by ExpectParams functions")
+	}
+
+	mmListWebhooks.defaultExpectation.params = &WebhookRepositoryMockListWebhooksParams{ctx, params}
+	for _, e := range mmListWebhooks.expectations {
+		if minimock.Equal(e.params, mmListWebhooks.defaultExpectation.params) {
+			mmListWebhooks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListWebhooks.defaultExpectation.params)
+		}
+	}
+
+	return mmListWebhooks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.ListWebhooks
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockListWebhooks {
+	if mmListWebhooks.mock.funcListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListWebhooks mock is already set by Set")
+	}
+
+	if mmListWebhooks.defaultExpectation == nil {
+		mmListWebhooks.defaultExpectation = &WebhookRepositoryMockListWebhooksExpectation{}
+	}
+
+	if mmListWebhooks.defaultExpectation.params != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListWebhooks mock is already set by Expect")
+	}
+
+	if mmListWebhooks.defaultExpectation.paramPtrs == nil {
+		mmListWebhooks.defaultExpectation.paramPtrs = &WebhookRepositoryMockListWebhooksParamPtrs{}
+	}
+	mmListWebhooks.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListWebhooks
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookRepository.ListWebhooks
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) ExpectParamsParam2(params model.ListWebhooksParams) *mWebhookRepositoryMockListWebhooks {
+	if mmListWebhooks.mock.funcListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListWebhooks mock is already set by Set")
+	}
+
+	if mmListWebhooks.defaultExpectation == nil {
+		mmListWebhooks.defaultExpectation = &WebhookRepositoryMockListWebhooksExpectation{}
+	}
+
+	if mmListWebhooks.defaultExpectation.params != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListWebhooks mock is already set by Expect")
+	}
+
+	if mmListWebhooks.defaultExpectation.paramPtrs == nil {
+		mmListWebhooks.defaultExpectation.paramPtrs = &WebhookRepositoryMockListWebhooksParamPtrs{}
+	}
+	mmListWebhooks.defaultExpectation.paramPtrs.params = &params
+
+	return mmListWebhooks
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.ListWebhooks
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) Inspect(f func(ctx context.Context, params model.ListWebhooksParams)) *mWebhookRepositoryMockListWebhooks {
+	if mmListWebhooks.mock.inspectFuncListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.ListWebhooks")
+	}
+
+	mmListWebhooks.mock.inspectFuncListWebhooks = f
+
+	return mmListWebhooks
+}
+
+// Return sets up results that will be returned by WebhookRepository.ListWebhooks
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) Return(webhooks []model.Webhook, err error) *WebhookRepositoryMock {
+	if mmListWebhooks.mock.funcListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListWebhooks mock is already set by Set")
+	}
+
+	if mmListWebhooks.defaultExpectation == nil {
+		mmListWebhooks.defaultExpectation = &WebhookRepositoryMockListWebhooksExpectation{mock: mmListWebhooks.mock}
+	}
+	mmListWebhooks.defaultExpectation.results = &WebhookRepositoryMockListWebhooksResults{webhooks, err}
+	return mmListWebhooks.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.ListWebhooks method
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) Set(f func(ctx context.Context, params model.ListWebhooksParams) (webhooks []model.Webhook, err error)) *WebhookRepositoryMock {
+	if mmListWebhooks.defaultExpectation != nil {
+		mmListWebhooks.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.ListWebhooks method")
+	}
+
+	if len(mmListWebhooks.expectations) > 0 {
+		mmListWebhooks.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.ListWebhooks method")
+	}
+
+	mmListWebhooks.mock.funcListWebhooks = f
+	return mmListWebhooks.mock
+}
+
+// When sets expectation for the WebhookRepository.ListWebhooks which will trigger the result defined by the following
+// Then helper
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) When(ctx context.Context, params model.ListWebhooksParams) *WebhookRepositoryMockListWebhooksExpectation {
+	if mmListWebhooks.mock.funcListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookRepositoryMock.ListWebhooks mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockListWebhooksExpectation{
+		mock:   mmListWebhooks.mock,
+		params: &WebhookRepositoryMockListWebhooksParams{ctx, params},
+	}
+	mmListWebhooks.expectations = append(mmListWebhooks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.ListWebhooks return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockListWebhooksExpectation) Then(webhooks []model.Webhook, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockListWebhooksResults{webhooks, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.ListWebhooks should be invoked
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) Times(n uint64) *mWebhookRepositoryMockListWebhooks {
+	if n == 0 {
+		mmListWebhooks.mock.t.Fatalf("Times of WebhookRepositoryMock.ListWebhooks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListWebhooks.expectedInvocations, n)
+	return mmListWebhooks
+}
+
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) invocationsDone() bool {
+	if len(mmListWebhooks.expectations) == 0 && mmListWebhooks.defaultExpectation == nil && mmListWebhooks.mock.funcListWebhooks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListWebhooks.mock.afterListWebhooksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListWebhooks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListWebhooks implements repository.WebhookRepository
+func (mmListWebhooks *WebhookRepositoryMock) ListWebhooks(ctx context.Context, params model.ListWebhooksParams) (webhooks []model.Webhook, err error) {
+	mm_atomic.AddUint64(&mmListWebhooks.beforeListWebhooksCounter, 1)
+	defer mm_atomic.AddUint64(&mmListWebhooks.afterListWebhooksCounter, 1)
+
+	if mmListWebhooks.inspectFuncListWebhooks != nil {
+		mmListWebhooks.inspectFuncListWebhooks(ctx, params)
+	}
+
+	mm_params := WebhookRepositoryMockListWebhooksParams{ctx, params}
+
+	// Record call args
+	mmListWebhooks.ListWebhooksMock.mutex.Lock()
+	mmListWebhooks.ListWebhooksMock.callArgs = append(mmListWebhooks.ListWebhooksMock.callArgs, &mm_params)
+	mmListWebhooks.ListWebhooksMock.mutex.Unlock()
+
+	for _, e := range mmListWebhooks.ListWebhooksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.webhooks, e.results.err
+		}
+	}
+
+	if mmListWebhooks.ListWebhooksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListWebhooks.ListWebhooksMock.defaultExpectation.Counter, 1)
+		mm_want := mmListWebhooks.ListWebhooksMock.defaultExpectation.params
+		mm_want_ptrs := mmListWebhooks.ListWebhooksMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockListWebhooksParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListWebhooks.t.Errorf("WebhookRepositoryMock.ListWebhooks got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListWebhooks.t.Errorf("WebhookRepositoryMock.ListWebhooks got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListWebhooks.t.Errorf("WebhookRepositoryMock.ListWebhooks got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListWebhooks.ListWebhooksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListWebhooks.t.Fatal("No results are set for the WebhookRepositoryMock.ListWebhooks")
+		}
+		return (*mm_results).webhooks, (*mm_results).err
+	}
+	if mmListWebhooks.funcListWebhooks != nil {
+		return mmListWebhooks.funcListWebhooks(ctx, params)
+	}
+	mmListWebhooks.t.Fatalf("Unexpected call to WebhookRepositoryMock.ListWebhooks. %v %v", ctx, params)
+	return
+}
+
+// ListWebhooksAfterCounter returns a count of finished WebhookRepositoryMock.ListWebhooks invocations
+func (mmListWebhooks *WebhookRepositoryMock) ListWebhooksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListWebhooks.afterListWebhooksCounter)
+}
+
+// ListWebhooksBeforeCounter returns a count of WebhookRepositoryMock.ListWebhooks invocations
+func (mmListWebhooks *WebhookRepositoryMock) ListWebhooksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListWebhooks.beforeListWebhooksCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.ListWebhooks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListWebhooks *mWebhookRepositoryMockListWebhooks) Calls() []*WebhookRepositoryMockListWebhooksParams {
+	mmListWebhooks.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockListWebhooksParams, len(mmListWebhooks.callArgs))
+	copy(argCopy, mmListWebhooks.callArgs)
+
+	mmListWebhooks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListWebhooksDone returns true if the count of the ListWebhooks invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockListWebhooksDone() bool {
+	if m.ListWebhooksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListWebhooksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListWebhooksMock.invocationsDone()
+}
+
+// MinimockListWebhooksInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockListWebhooksInspect() {
+	for _, e := range m.ListWebhooksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListWebhooks with params: %#v", *e.params)
+		}
+	}
+
+	afterListWebhooksCounter := mm_atomic.LoadUint64(&m.afterListWebhooksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListWebhooksMock.defaultExpectation != nil && afterListWebhooksCounter < 1 {
+		if m.ListWebhooksMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.ListWebhooks")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.ListWebhooks with params: %#v", *m.ListWebhooksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListWebhooks != nil && afterListWebhooksCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.ListWebhooks")
+	}
+
+	if !m.ListWebhooksMock.invocationsDone() && afterListWebhooksCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.ListWebhooks but found %d calls",
+			mm_atomic.LoadUint64(&m.ListWebhooksMock.expectedInvocations), afterListWebhooksCounter)
+	}
+}
+
+type mWebhookRepositoryMockRecordWebhookResult struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockRecordWebhookResultExpectation
+	expectations       []*WebhookRepositoryMockRecordWebhookResultExpectation
+
+	callArgs []*WebhookRepositoryMockRecordWebhookResultParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockRecordWebhookResultExpectation specifies expectation struct of the WebhookRepository.RecordWebhookResult
+type WebhookRepositoryMockRecordWebhookResultExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockRecordWebhookResultParams
+	paramPtrs *WebhookRepositoryMockRecordWebhookResultParamPtrs
+	results   *WebhookRepositoryMockRecordWebhookResultResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockRecordWebhookResultParams contains parameters of the WebhookRepository.RecordWebhookResult
+type WebhookRepositoryMockRecordWebhookResultParams struct {
+	ctx    context.Context
+	params model.RecordWebhookResultParams
+}
+
+// WebhookRepositoryMockRecordWebhookResultParamPtrs contains pointers to parameters of the WebhookRepository.RecordWebhookResult
+type WebhookRepositoryMockRecordWebhookResultParamPtrs struct {
+	ctx    *context.Context
+	params *model.RecordWebhookResultParams
+}
+
+// WebhookRepositoryMockRecordWebhookResultResults contains results of the WebhookRepository.RecordWebhookResult
+type WebhookRepositoryMockRecordWebhookResultResults struct {
+	disabled bool
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) Optional() *mWebhookRepositoryMockRecordWebhookResult {
+	mmRecordWebhookResult.optional = true
+	return mmRecordWebhookResult
+}
+
+// Expect sets up expected params for WebhookRepository.RecordWebhookResult
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) Expect(ctx context.Context, params model.RecordWebhookResultParams) *mWebhookRepositoryMockRecordWebhookResult {
+	if mmRecordWebhookResult.mock.funcRecordWebhookResult != nil {
+		mmRecordWebhookResult.mock.t.Fatalf("WebhookRepositoryMock.RecordWebhookResult mock is already set by Set")
+	}
+
+	if mmRecordWebhookResult.defaultExpectation == nil {
+		mmRecordWebhookResult.defaultExpectation = &WebhookRepositoryMockRecordWebhookResultExpectation{}
+	}
+
+	if mmRecordWebhookResult.defaultExpectation.paramPtrs != nil {
+		mmRecordWebhookResult.mock.t.Fatalf("WebhookRepositoryMock.RecordWebhookResult mock is already set by ExpectParams functions")
+	}
+
+	mmRecordWebhookResult.defaultExpectation.params = &WebhookRepositoryMockRecordWebhookResultParams{ctx, params}
+	for _, e := range mmRecordWebhookResult.expectations {
+		if minimock.Equal(e.params, mmRecordWebhookResult.defaultExpectation.params) {
+			mmRecordWebhookResult.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordWebhookResult.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordWebhookResult
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.RecordWebhookResult
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockRecordWebhookResult {
+	if mmRecordWebhookResult.mock.funcRecordWebhookResult != nil {
+		mmRecordWebhookResult.mock.t.Fatalf("WebhookRepositoryMock.RecordWebhookResult mock is already set by Set")
+	}
+
+	if mmRecordWebhookResult.defaultExpectation == nil {
+		mmRecordWebhookResult.defaultExpectation = &WebhookRepositoryMockRecordWebhookResultExpectation{}
+	}
+
+	if mmRecordWebhookResult.defaultExpectation.params != nil {
+		mmRecordWebhookResult.mock.t.Fatalf("WebhookRepositoryMock.RecordWebhookResult mock is already set by Expect")
+	}
+
+	if mmRecordWebhookResult.defaultExpectation.paramPtrs == nil {
+		mmRecordWebhookResult.defaultExpectation.paramPtrs = &WebhookRepositoryMockRecordWebhookResultParamPtrs{}
+	}
+	mmRecordWebhookResult.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRecordWebhookResult
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookRepository.RecordWebhookResult
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) ExpectParamsParam2(params model.RecordWebhookResultParams) *mWebhookRepositoryMockRecordWebhookResult {
+	if mmRecordWebhookResult.mock.funcRecordWebhookResult != nil {
+		mmRecordWebhookResult.mock.t.Fatalf("WebhookRepositoryMock.RecordWebhookResult mock is already set by Set")
+	}
+
+	if mmRecordWebhookResult.defaultExpectation == nil {
+		mmRecordWebhookResult.defaultExpectation = &WebhookRepositoryMockRecordWebhookResultExpectation{}
+	}
+
+	if mmRecordWebhookResult.defaultExpectation.params != nil {
+		mmRecordWebhookResult.mock.t.Fatalf("WebhookRepositoryMock.RecordWebhookResult mock is already set by Expect")
+	}
+
+	if mmRecordWebhookResult.defaultExpectation.paramPtrs == nil {
+		mmRecordWebhookResult.defaultExpectation.paramPtrs = &WebhookRepositoryMockRecordWebhookResultParamPtrs{}
+	}
+	mmRecordWebhookResult.defaultExpectation.paramPtrs.params = &params
+
+	return mmRecordWebhookResult
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.RecordWebhookResult
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) Inspect(f func(ctx context.Context, params model.RecordWebhookResultParams)) *mWebhookRepositoryMockRecordWebhookResult {
+	if mmRecordWebhookResult.mock.inspectFuncRecordWebhookResult != nil {
+		mmRecordWebhookResult.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.RecordWebhookResult")
+	}
+
+	mmRecordWebhookResult.mock.inspectFuncRecordWebhookResult = f
+
+	return mmRecordWebhookResult
+}
+
+// Return sets up results that will be returned by WebhookRepository.RecordWebhookResult
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) Return(disabled bool, err error) *WebhookRepositoryMock {
+	if mmRecordWebhookResult.mock.funcRecordWebhookResult != nil {
+		mmRecordWebhookResult.mock.t.Fatalf("WebhookRepositoryMock.RecordWebhookResult mock is already set by Set")
+	}
+
+	if mmRecordWebhookResult.defaultExpectation == nil {
+		mmRecordWebhookResult.defaultExpectation = &WebhookRepositoryMockRecordWebhookResultExpectation{mock: mmRecordWebhookResult.mock}
+	}
+	mmRecordWebhookResult.defaultExpectation.results = &WebhookRepositoryMockRecordWebhookResultResults{disabled, err}
+	return mmRecordWebhookResult.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.RecordWebhookResult method
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) Set(f func(ctx context.Context, params model.RecordWebhookResultParams) (disabled bool, err error)) *WebhookRepositoryMock {
+	if mmRecordWebhookResult.defaultExpectation != nil {
+		mmRecordWebhookResult.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.RecordWebhookResult method")
+	}
+
+	if len(mmRecordWebhookResult.expectations) > 0 {
+		mmRecordWebhookResult.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.RecordWebhookResult method")
+	}
+
+	mmRecordWebhookResult.mock.funcRecordWebhookResult = f
+	return mmRecordWebhookResult.mock
+}
+
+// When sets expectation for the WebhookRepository.RecordWebhookResult which will trigger the result defined by the following
+// Then helper
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) When(ctx context.Context, params model.RecordWebhookResultParams) *WebhookRepositoryMockRecordWebhookResultExpectation {
+	if mmRecordWebhookResult.mock.funcRecordWebhookResult != nil {
+		mmRecordWebhookResult.mock.t.Fatalf("WebhookRepositoryMock.RecordWebhookResult mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockRecordWebhookResultExpectation{
+		mock:   mmRecordWebhookResult.mock,
+		params: &WebhookRepositoryMockRecordWebhookResultParams{ctx, params},
+	}
+	mmRecordWebhookResult.expectations = append(mmRecordWebhookResult.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.RecordWebhookResult return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockRecordWebhookResultExpectation) Then(disabled bool, err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockRecordWebhookResultResults{disabled, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.RecordWebhookResult should be invoked
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) Times(n uint64) *mWebhookRepositoryMockRecordWebhookResult {
+	if n == 0 {
+		mmRecordWebhookResult.mock.t.Fatalf("Times of WebhookRepositoryMock.RecordWebhookResult mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordWebhookResult.expectedInvocations, n)
+	return mmRecordWebhookResult
+}
+
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) invocationsDone() bool {
+	if len(mmRecordWebhookResult.expectations) == 0 && mmRecordWebhookResult.defaultExpectation == nil && mmRecordWebhookResult.mock.funcRecordWebhookResult == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordWebhookResult.mock.afterRecordWebhookResultCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordWebhookResult.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordWebhookResult implements repository.WebhookRepository
+func (mmRecordWebhookResult *WebhookRepositoryMock) RecordWebhookResult(ctx context.Context, params model.RecordWebhookResultParams) (disabled bool, err error) {
+	mm_atomic.AddUint64(&mmRecordWebhookResult.beforeRecordWebhookResultCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordWebhookResult.afterRecordWebhookResultCounter, 1)
+
+	if mmRecordWebhookResult.inspectFuncRecordWebhookResult != nil {
+		mmRecordWebhookResult.inspectFuncRecordWebhookResult(ctx, params)
+	}
+
+	mm_params := WebhookRepositoryMockRecordWebhookResultParams{ctx, params}
+
+	// Record call args
+	mmRecordWebhookResult.RecordWebhookResultMock.mutex.Lock()
+	mmRecordWebhookResult.RecordWebhookResultMock.callArgs = append(mmRecordWebhookResult.RecordWebhookResultMock.callArgs, &mm_params)
+	mmRecordWebhookResult.RecordWebhookResultMock.mutex.Unlock()
+
+	for _, e := range mmRecordWebhookResult.RecordWebhookResultMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.disabled, e.results.err
+		}
+	}
+
+	if mmRecordWebhookResult.RecordWebhookResultMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordWebhookResult.RecordWebhookResultMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordWebhookResult.RecordWebhookResultMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordWebhookResult.RecordWebhookResultMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockRecordWebhookResultParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordWebhookResult.t.Errorf("WebhookRepositoryMock.RecordWebhookResult got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmRecordWebhookResult.t.Errorf("WebhookRepositoryMock.RecordWebhookResult got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordWebhookResult.t.Errorf("WebhookRepositoryMock.RecordWebhookResult got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordWebhookResult.RecordWebhookResultMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordWebhookResult.t.Fatal("No results are set for the WebhookRepositoryMock.RecordWebhookResult")
+		}
+		return (*mm_results).disabled, (*mm_results).err
+	}
+	if mmRecordWebhookResult.funcRecordWebhookResult != nil {
+		return mmRecordWebhookResult.funcRecordWebhookResult(ctx, params)
+	}
+	mmRecordWebhookResult.t.Fatalf("Unexpected call to WebhookRepositoryMock.RecordWebhookResult. %v %v", ctx, params)
+	return
+}
+
+// RecordWebhookResultAfterCounter returns a count of finished WebhookRepositoryMock.RecordWebhookResult invocations
+func (mmRecordWebhookResult *WebhookRepositoryMock) RecordWebhookResultAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordWebhookResult.afterRecordWebhookResultCounter)
+}
+
+// RecordWebhookResultBeforeCounter returns a count of WebhookRepositoryMock.RecordWebhookResult invocations
+func (mmRecordWebhookResult *WebhookRepositoryMock) RecordWebhookResultBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordWebhookResult.beforeRecordWebhookResultCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.RecordWebhookResult.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordWebhookResult *mWebhookRepositoryMockRecordWebhookResult) Calls() []*WebhookRepositoryMockRecordWebhookResultParams {
+	mmRecordWebhookResult.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockRecordWebhookResultParams, len(mmRecordWebhookResult.callArgs))
+	copy(argCopy, mmRecordWebhookResult.callArgs)
+
+	mmRecordWebhookResult.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordWebhookResultDone returns true if the count of the RecordWebhookResult invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockRecordWebhookResultDone() bool {
+	if m.RecordWebhookResultMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordWebhookResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordWebhookResultMock.invocationsDone()
+}
+
+// MinimockRecordWebhookResultInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockRecordWebhookResultInspect() {
+	for _, e := range m.RecordWebhookResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.RecordWebhookResult with params: %#v", *e.params)
+		}
+	}
+
+	afterRecordWebhookResultCounter := mm_atomic.LoadUint64(&m.afterRecordWebhookResultCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordWebhookResultMock.defaultExpectation != nil && afterRecordWebhookResultCounter < 1 {
+		if m.RecordWebhookResultMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.RecordWebhookResult")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.RecordWebhookResult with params: %#v", *m.RecordWebhookResultMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordWebhookResult != nil && afterRecordWebhookResultCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.RecordWebhookResult")
+	}
+
+	if !m.RecordWebhookResultMock.invocationsDone() && afterRecordWebhookResultCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.RecordWebhookResult but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordWebhookResultMock.expectedInvocations), afterRecordWebhookResultCounter)
+	}
+}
+
+type mWebhookRepositoryMockUpdateDelivery struct {
+	optional           bool
+	mock               *WebhookRepositoryMock
+	defaultExpectation *WebhookRepositoryMockUpdateDeliveryExpectation
+	expectations       []*WebhookRepositoryMockUpdateDeliveryExpectation
+
+	callArgs []*WebhookRepositoryMockUpdateDeliveryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookRepositoryMockUpdateDeliveryExpectation specifies expectation struct of the WebhookRepository.UpdateDelivery
+type WebhookRepositoryMockUpdateDeliveryExpectation struct {
+	mock      *WebhookRepositoryMock
+	params    *WebhookRepositoryMockUpdateDeliveryParams
+	paramPtrs *WebhookRepositoryMockUpdateDeliveryParamPtrs
+	results   *WebhookRepositoryMockUpdateDeliveryResults
+	Counter   uint64
+}
+
+// WebhookRepositoryMockUpdateDeliveryParams contains parameters of the WebhookRepository.UpdateDelivery
+type WebhookRepositoryMockUpdateDeliveryParams struct {
+	ctx    context.Context
+	params model.UpdateWebhookDeliveryParams
+}
+
+// WebhookRepositoryMockUpdateDeliveryParamPtrs contains pointers to parameters of the WebhookRepository.UpdateDelivery
+type WebhookRepositoryMockUpdateDeliveryParamPtrs struct {
+	ctx    *context.Context
+	params *model.UpdateWebhookDeliveryParams
+}
+
+// WebhookRepositoryMockUpdateDeliveryResults contains results of the WebhookRepository.UpdateDelivery
+type WebhookRepositoryMockUpdateDeliveryResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) Optional() *mWebhookRepositoryMockUpdateDelivery {
+	mmUpdateDelivery.optional = true
+	return mmUpdateDelivery
+}
+
+// Expect sets up expected params for WebhookRepository.UpdateDelivery
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) Expect(ctx context.Context, params model.UpdateWebhookDeliveryParams) *mWebhookRepositoryMockUpdateDelivery {
+	if mmUpdateDelivery.mock.funcUpdateDelivery != nil {
+		mmUpdateDelivery.mock.t.Fatalf("WebhookRepositoryMock.UpdateDelivery mock is already set by Set")
+	}
+
+	if mmUpdateDelivery.defaultExpectation == nil {
+		mmUpdateDelivery.defaultExpectation = &WebhookRepositoryMockUpdateDeliveryExpectation{}
+	}
+
+	if mmUpdateDelivery.defaultExpectation.paramPtrs != nil {
+		mmUpdateDelivery.mock.t.Fatalf("WebhookRepositoryMock.UpdateDelivery mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateDelivery.defaultExpectation.params = &WebhookRepositoryMockUpdateDeliveryParams{ctx, params}
+	for _, e := range mmUpdateDelivery.expectations {
+		if minimock.Equal(e.params, mmUpdateDelivery.defaultExpectation.params) {
+			mmUpdateDelivery.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateDelivery.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateDelivery
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookRepository.UpdateDelivery
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) ExpectCtxParam1(ctx context.Context) *mWebhookRepositoryMockUpdateDelivery {
+	if mmUpdateDelivery.mock.funcUpdateDelivery != nil {
+		mmUpdateDelivery.mock.t.Fatalf("WebhookRepositoryMock.UpdateDelivery mock is already set by Set")
+	}
+
+	if mmUpdateDelivery.defaultExpectation == nil {
+		mmUpdateDelivery.defaultExpectation = &WebhookRepositoryMockUpdateDeliveryExpectation{}
+	}
+
+	if mmUpdateDelivery.defaultExpectation.params != nil {
+		mmUpdateDelivery.mock.t.Fatalf("WebhookRepositoryMock.UpdateDelivery mock is already set by Expect")
+	}
+
+	if mmUpdateDelivery.defaultExpectation.paramPtrs == nil {
+		mmUpdateDelivery.defaultExpectation.paramPtrs = &WebhookRepositoryMockUpdateDeliveryParamPtrs{}
+	}
+	mmUpdateDelivery.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateDelivery
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookRepository.UpdateDelivery
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) ExpectParamsParam2(params model.UpdateWebhookDeliveryParams) *mWebhookRepositoryMockUpdateDelivery {
+	if mmUpdateDelivery.mock.funcUpdateDelivery != nil {
+		mmUpdateDelivery.mock.t.Fatalf("WebhookRepositoryMock.UpdateDelivery mock is already set by Set")
+	}
+
+	if mmUpdateDelivery.defaultExpectation == nil {
+		mmUpdateDelivery.defaultExpectation = &WebhookRepositoryMockUpdateDeliveryExpectation{}
+	}
+
+	if mmUpdateDelivery.defaultExpectation.params != nil {
+		mmUpdateDelivery.mock.t.Fatalf("WebhookRepositoryMock.UpdateDelivery mock is already set by Expect")
+	}
+
+	if mmUpdateDelivery.defaultExpectation.paramPtrs == nil {
+		mmUpdateDelivery.defaultExpectation.paramPtrs = &WebhookRepositoryMockUpdateDeliveryParamPtrs{}
+	}
+	mmUpdateDelivery.defaultExpectation.paramPtrs.params = &params
+
+	return mmUpdateDelivery
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookRepository.UpdateDelivery
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) Inspect(f func(ctx context.Context, params model.UpdateWebhookDeliveryParams)) *mWebhookRepositoryMockUpdateDelivery {
+	if mmUpdateDelivery.mock.inspectFuncUpdateDelivery != nil {
+		mmUpdateDelivery.mock.t.Fatalf("Inspect function is already set for WebhookRepositoryMock.UpdateDelivery")
+	}
+
+	mmUpdateDelivery.mock.inspectFuncUpdateDelivery = f
+
+	return mmUpdateDelivery
+}
+
+// Return sets up results that will be returned by WebhookRepository.UpdateDelivery
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) Return(err error) *WebhookRepositoryMock {
+	if mmUpdateDelivery.mock.funcUpdateDelivery != nil {
+		mmUpdateDelivery.mock.t.Fatalf("WebhookRepositoryMock.UpdateDelivery mock is already set by Set")
+	}
+
+	if mmUpdateDelivery.defaultExpectation == nil {
+		mmUpdateDelivery.defaultExpectation = &WebhookRepositoryMockUpdateDeliveryExpectation{mock: mmUpdateDelivery.mock}
+	}
+	mmUpdateDelivery.defaultExpectation.results = &WebhookRepositoryMockUpdateDeliveryResults{err}
+	return mmUpdateDelivery.mock
+}
+
+// Set uses given function f to mock the WebhookRepository.UpdateDelivery method
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) Set(f func(ctx context.Context, params model.UpdateWebhookDeliveryParams) (err error)) *WebhookRepositoryMock {
+	if mmUpdateDelivery.defaultExpectation != nil {
+		mmUpdateDelivery.mock.t.Fatalf("Default expectation is already set for the WebhookRepository.UpdateDelivery method")
+	}
+
+	if len(mmUpdateDelivery.expectations) > 0 {
+		mmUpdateDelivery.mock.t.Fatalf("Some expectations are already set for the WebhookRepository.UpdateDelivery method")
+	}
+
+	mmUpdateDelivery.mock.funcUpdateDelivery = f
+	return mmUpdateDelivery.mock
+}
+
+// When sets expectation for the WebhookRepository.UpdateDelivery which will trigger the result defined by the following
+// Then helper
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) When(ctx context.Context, params model.UpdateWebhookDeliveryParams) *WebhookRepositoryMockUpdateDeliveryExpectation {
+	if mmUpdateDelivery.mock.funcUpdateDelivery != nil {
+		mmUpdateDelivery.mock.t.Fatalf("WebhookRepositoryMock.UpdateDelivery mock is already set by Set")
+	}
+
+	expectation := &WebhookRepositoryMockUpdateDeliveryExpectation{
+		mock:   mmUpdateDelivery.mock,
+		params: &WebhookRepositoryMockUpdateDeliveryParams{ctx, params},
+	}
+	mmUpdateDelivery.expectations = append(mmUpdateDelivery.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookRepository.UpdateDelivery return parameters for the expectation previously defined by the When method
+func (e *WebhookRepositoryMockUpdateDeliveryExpectation) Then(err error) *WebhookRepositoryMock {
+	e.results = &WebhookRepositoryMockUpdateDeliveryResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookRepository.UpdateDelivery should be invoked
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) Times(n uint64) *mWebhookRepositoryMockUpdateDelivery {
+	if n == 0 {
+		mmUpdateDelivery.mock.t.Fatalf("Times of WebhookRepositoryMock.UpdateDelivery mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateDelivery.expectedInvocations, n)
+	return mmUpdateDelivery
+}
+
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) invocationsDone() bool {
+	if len(mmUpdateDelivery.expectations) == 0 && mmUpdateDelivery.defaultExpectation == nil && mmUpdateDelivery.mock.funcUpdateDelivery == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateDelivery.mock.afterUpdateDeliveryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateDelivery.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateDelivery implements repository.WebhookRepository
+func (mmUpdateDelivery *WebhookRepositoryMock) UpdateDelivery(ctx context.Context, params model.UpdateWebhookDeliveryParams) (err error) {
+	mm_atomic.AddUint64(&mmUpdateDelivery.beforeUpdateDeliveryCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateDelivery.afterUpdateDeliveryCounter, 1)
+
+	if mmUpdateDelivery.inspectFuncUpdateDelivery != nil {
+		mmUpdateDelivery.inspectFuncUpdateDelivery(ctx, params)
+	}
+
+	mm_params := WebhookRepositoryMockUpdateDeliveryParams{ctx, params}
+
+	// Record call args
+	mmUpdateDelivery.UpdateDeliveryMock.mutex.Lock()
+	mmUpdateDelivery.UpdateDeliveryMock.callArgs = append(mmUpdateDelivery.UpdateDeliveryMock.callArgs, &mm_params)
+	mmUpdateDelivery.UpdateDeliveryMock.mutex.Unlock()
+
+	for _, e := range mmUpdateDelivery.UpdateDeliveryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateDelivery.UpdateDeliveryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateDelivery.UpdateDeliveryMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateDelivery.UpdateDeliveryMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateDelivery.UpdateDeliveryMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookRepositoryMockUpdateDeliveryParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateDelivery.t.Errorf("WebhookRepositoryMock.UpdateDelivery got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUpdateDelivery.t.Errorf("WebhookRepositoryMock.UpdateDelivery got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateDelivery.t.Errorf("WebhookRepositoryMock.UpdateDelivery got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateDelivery.UpdateDeliveryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateDelivery.t.Fatal("No results are set for the WebhookRepositoryMock.UpdateDelivery")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateDelivery.funcUpdateDelivery != nil {
+		return mmUpdateDelivery.funcUpdateDelivery(ctx, params)
+	}
+	mmUpdateDelivery.t.Fatalf("Unexpected call to WebhookRepositoryMock.UpdateDelivery. %v %v", ctx, params)
+	return
+}
+
+// UpdateDeliveryAfterCounter returns a count of finished WebhookRepositoryMock.UpdateDelivery invocations
+func (mmUpdateDelivery *WebhookRepositoryMock) UpdateDeliveryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateDelivery.afterUpdateDeliveryCounter)
+}
+
+// UpdateDeliveryBeforeCounter returns a count of WebhookRepositoryMock.UpdateDelivery invocations
+func (mmUpdateDelivery *WebhookRepositoryMock) UpdateDeliveryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateDelivery.beforeUpdateDeliveryCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookRepositoryMock.UpdateDelivery.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateDelivery *mWebhookRepositoryMockUpdateDelivery) Calls() []*WebhookRepositoryMockUpdateDeliveryParams {
+	mmUpdateDelivery.mutex.RLock()
+
+	argCopy := make([]*WebhookRepositoryMockUpdateDeliveryParams, len(mmUpdateDelivery.callArgs))
+	copy(argCopy, mmUpdateDelivery.callArgs)
+
+	mmUpdateDelivery.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDeliveryDone returns true if the count of the UpdateDelivery invocations corresponds
+// the number of defined expectations
+func (m *WebhookRepositoryMock) MinimockUpdateDeliveryDone() bool {
+	if m.UpdateDeliveryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateDeliveryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateDeliveryMock.invocationsDone()
+}
+
+// MinimockUpdateDeliveryInspect logs each unmet expectation
+func (m *WebhookRepositoryMock) MinimockUpdateDeliveryInspect() {
+	for _, e := range m.UpdateDeliveryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.UpdateDelivery with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateDeliveryCounter := mm_atomic.LoadUint64(&m.afterUpdateDeliveryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateDeliveryMock.defaultExpectation != nil && afterUpdateDeliveryCounter < 1 {
+		if m.UpdateDeliveryMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookRepositoryMock.UpdateDelivery")
+		} else {
+			m.t.Errorf("Expected call to WebhookRepositoryMock.UpdateDelivery with params: %#v", *m.UpdateDeliveryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateDelivery != nil && afterUpdateDeliveryCounter < 1 {
+		m.t.Error("Expected call to WebhookRepositoryMock.UpdateDelivery")
+	}
+
+	if !m.UpdateDeliveryMock.invocationsDone() && afterUpdateDeliveryCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookRepositoryMock.UpdateDelivery but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateDeliveryMock.expectedInvocations), afterUpdateDeliveryCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *WebhookRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimDueDeliveriesInspect()
+
+			m.MinimockCreateDeliveriesInspect()
+
+			m.MinimockCreateDeliveryAttemptInspect()
+
+			m.MinimockCreateIncomingWebhookInspect()
+
+			m.MinimockCreateWebhookInspect()
+
+			m.MinimockDeleteFinishedDeliveriesInspect()
+
+			m.MinimockDeleteIncomingWebhookInspect()
+
+			m.MinimockDeleteWebhookInspect()
+
+			m.MinimockGetIncomingWebhookByTokenHashInspect()
+
+			m.MinimockListIncomingWebhooksInspect()
+
+			m.MinimockListWebhooksInspect()
+
+			m.MinimockRecordWebhookResultInspect()
+
+			m.MinimockUpdateDeliveryInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *WebhookRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *WebhookRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimDueDeliveriesDone() &&
+		m.MinimockCreateDeliveriesDone() &&
+		m.MinimockCreateDeliveryAttemptDone() &&
+		m.MinimockCreateIncomingWebhookDone() &&
+		m.MinimockCreateWebhookDone() &&
+		m.MinimockDeleteFinishedDeliveriesDone() &&
+		m.MinimockDeleteIncomingWebhookDone() &&
+		m.MinimockDeleteWebhookDone() &&
+		m.MinimockGetIncomingWebhookByTokenHashDone() &&
+		m.MinimockListIncomingWebhooksDone() &&
+		m.MinimockListWebhooksDone() &&
+		m.MinimockRecordWebhookResultDone() &&
+		m.MinimockUpdateDeliveryDone()
+}
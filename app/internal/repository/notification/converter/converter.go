@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/notification/model"
+)
+
+// ConvertNotificationRecipientsFromRepoToService converts stored recipients from the repository layer
+// to the service layer format.
+func ConvertNotificationRecipientsFromRepoToService(
+	recipients []modelRepo.NotificationRecipient,
+) []model.NotificationRecipient {
+	result := make([]model.NotificationRecipient, len(recipients))
+	for i, recipient := range recipients {
+		result[i] = model.NotificationRecipient{
+			UserID:      recipient.UserID,
+			Email:       recipient.Email,
+			DisplayName: recipient.DisplayName,
+			Online:      recipient.Online,
+		}
+	}
+
+	return result
+}
+
+// ConvertNotificationsFromRepoToService converts stored notifications from the repository layer
+// to the service layer format.
+func ConvertNotificationsFromRepoToService(notifications []modelRepo.Notification) []model.Notification {
+	result := make([]model.Notification, len(notifications))
+	for i, notification := range notifications {
+		result[i] = model.Notification{
+			ID:        notification.ID,
+			EventType: notification.EventType,
+			ChatID:    notification.ChatID,
+			Payload:   notification.Payload,
+			Attempts:  notification.Attempts,
+			CreatedAt: notification.CreatedAt,
+		}
+	}
+
+	return result
+}
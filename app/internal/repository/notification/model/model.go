@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// NotificationRecipient represents a stored recipient joined with its user.
+type NotificationRecipient struct {
+	UserID      int64  `db:"user_id"`
+	Email       string `db:"email"`
+	DisplayName string `db:"display_name"`
+	Online      bool   `db:"online"`
+}
+
+// Notification represents a stored notification.
+type Notification struct {
+	ID        int64     `db:"id"`
+	EventType string    `db:"event_type"`
+	ChatID    int64     `db:"chat_id"`
+	Payload   []byte    `db:"payload"`
+	Attempts  int       `db:"attempts"`
+	CreatedAt time.Time `db:"created_at"`
+}
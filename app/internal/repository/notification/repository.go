@@ -0,0 +1,186 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/notification/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/notification/model"
+)
+
+type notificationPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of notificationPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.NotificationRepository {
+	return &notificationPGRepo{
+		db: db,
+	}
+}
+
+// CreateNotifications stores the notifications of the event for the offline participants it concerns.
+func (p *notificationPGRepo) CreateNotifications(ctx context.Context, event model.Event) (err error) {
+	logger.FromContext(ctx).Debug("notificationPGRepo.CreateNotifications", slog.Int64("event_id", event.ID))
+
+	q := db.Query{
+		Name:     "notificationPGRepo.CreateNotifications",
+		QueryRaw: queryCreateNotifications,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, event.ID, event.Type, event.ChatID, event.Payload)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create notifications(eventID: %d)", event.ID)
+	}
+
+	return nil
+}
+
+// ClaimDueRecipients leases and returns the recipients due a digest, the longest waiting first.
+func (p *notificationPGRepo) ClaimDueRecipients(
+	ctx context.Context,
+	params model.ClaimDueRecipientsParams,
+) (recipients []model.NotificationRecipient, err error) {
+	logger.FromContext(ctx).Debug("notificationPGRepo.ClaimDueRecipients", slog.Int("limit", params.Limit))
+
+	q := db.Query{
+		Name:     "notificationPGRepo.ClaimDueRecipients",
+		QueryRaw: queryClaimDueRecipients,
+	}
+
+	var recipientsRepo []modelRepo.NotificationRecipient
+
+	err = p.db.DB().ScanAllContext(
+		ctx,
+		&recipientsRepo,
+		q,
+		params.CreatedBefore,
+		params.DigestBefore,
+		params.Now,
+		params.LeaseUntil,
+		params.Limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot claim due notification recipients")
+	}
+
+	return converter.ConvertNotificationRecipientsFromRepoToService(recipientsRepo), nil
+}
+
+// ListPendingNotifications returns the pending notifications of the user, oldest first.
+func (p *notificationPGRepo) ListPendingNotifications(
+	ctx context.Context,
+	userID int64,
+	limit int,
+) (notifications []model.Notification, err error) {
+	logger.FromContext(ctx).Debug("notificationPGRepo.ListPendingNotifications", slog.Int64("user_id", userID))
+
+	q := db.Query{
+		Name:     "notificationPGRepo.ListPendingNotifications",
+		QueryRaw: queryListPendingNotifications,
+	}
+
+	var notificationsRepo []modelRepo.Notification
+
+	err = p.db.DB().ScanAllContext(ctx, &notificationsRepo, q, userID, limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot list pending notifications(userID: %d)", userID)
+	}
+
+	return converter.ConvertNotificationsFromRepoToService(notificationsRepo), nil
+}
+
+// FinishNotifications sets the final status of the notifications.
+func (p *notificationPGRepo) FinishNotifications(ctx context.Context, params model.FinishNotificationsParams) (err error) {
+	logger.FromContext(ctx).Debug("notificationPGRepo.FinishNotifications", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "notificationPGRepo.FinishNotifications",
+		QueryRaw: queryFinishNotifications,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, params.IDs, params.Status)
+	if err != nil {
+		return errors.Wrap(err, "Cannot finish notifications")
+	}
+
+	return nil
+}
+
+// RecordNotificationsFailure counts a failed attempt of the notifications.
+func (p *notificationPGRepo) RecordNotificationsFailure(
+	ctx context.Context,
+	params model.RecordNotificationsFailureParams,
+) (err error) {
+	logger.FromContext(ctx).Debug("notificationPGRepo.RecordNotificationsFailure", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "notificationPGRepo.RecordNotificationsFailure",
+		QueryRaw: queryRecordNotificationsFailure,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, params.IDs, params.MaxAttempts)
+	if err != nil {
+		return errors.Wrap(err, "Cannot record notifications failure")
+	}
+
+	return nil
+}
+
+// UpdateRecipient updates the time of the last digest of the recipient and releases its lease.
+func (p *notificationPGRepo) UpdateRecipient(ctx context.Context, params model.UpdateRecipientParams) (err error) {
+	logger.FromContext(ctx).Debug("notificationPGRepo.UpdateRecipient", slog.Int64("user_id", params.UserID))
+
+	q := db.Query{
+		Name:     "notificationPGRepo.UpdateRecipient",
+		QueryRaw: queryUpdateRecipient,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, params.UserID, params.LastDigestAt)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot update notification recipient(userID: %d)", params.UserID)
+	}
+
+	return nil
+}
+
+// ReleaseRecipient releases the lease of the recipient without a digest.
+func (p *notificationPGRepo) ReleaseRecipient(ctx context.Context, userID int64) (err error) {
+	logger.FromContext(ctx).Debug("notificationPGRepo.ReleaseRecipient", slog.Int64("user_id", userID))
+
+	q := db.Query{
+		Name:     "notificationPGRepo.ReleaseRecipient",
+		QueryRaw: queryReleaseRecipient,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, userID)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot release notification recipient(userID: %d)", userID)
+	}
+
+	return nil
+}
+
+// DeleteFinishedNotifications deletes the notifications finished before the given time.
+func (p *notificationPGRepo) DeleteFinishedNotifications(ctx context.Context, before time.Time) (deleted int64, err error) {
+	logger.FromContext(ctx).Debug("notificationPGRepo.DeleteFinishedNotifications", slog.Time("before", before))
+
+	q := db.Query{
+		Name:     "notificationPGRepo.DeleteFinishedNotifications",
+		QueryRaw: queryDeleteFinishedNotifications,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "Cannot delete finished notifications")
+	}
+
+	return tag.RowsAffected(), nil
+}
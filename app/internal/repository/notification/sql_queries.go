@@ -0,0 +1,120 @@
+package notification
+
+const (
+	// queryCreateNotifications notifies the offline participants of the chat, other than the sender and
+	// the participants blocking them. A mention.created event notifies the mentioned participant unless
+	// their notification level is none, even if they muted the chat. A message.sent event notifies the
+	// participants with the notification level all who did not mute the chat, except the mentioned ones
+	// who are notified of the mention instead.
+	queryCreateNotifications = `
+		WITH recipients AS (
+			SELECT u.id
+			FROM chats.chat_participants p
+			JOIN chats.users u ON u.id = p.user_id
+			WHERE p.chat_id = $3
+				AND u.presence_status = 'offline'
+				AND u.email <> $4::jsonb ->> 'from'
+				AND NOT EXISTS (
+					SELECT 1
+					FROM chats.user_blocks b
+					JOIN chats.users sender ON sender.id = b.blocked_id
+					WHERE b.blocker_id = u.id
+						AND sender.email = $4::jsonb ->> 'from'
+				)
+				AND CASE $2::varchar
+					WHEN 'mention.created' THEN
+						u.email = $4::jsonb ->> 'email'
+						AND p.notification_level <> 'none'
+					ELSE
+						p.notification_level = 'all'
+						AND (p.muted_until IS NULL OR p.muted_until <= now())
+						AND NOT EXISTS (
+							SELECT 1
+							FROM chats.mentions m
+							WHERE m.message_id = ($4::jsonb ->> 'message_id')::bigint
+								AND m.user_id = u.id
+						)
+				END
+		), created AS (
+			INSERT INTO chats.notifications
+				(user_id, event_id, event_type, chat_id, payload)
+			SELECT id, $1::bigint, $2::varchar, $3::integer, $4::jsonb
+			FROM recipients
+			ON CONFLICT (event_id, user_id) DO NOTHING
+			RETURNING user_id
+		)
+		INSERT INTO chats.notification_recipients (user_id)
+		SELECT DISTINCT user_id
+		FROM created
+		ON CONFLICT (user_id) DO NOTHING;
+	`
+
+	// queryClaimDueRecipients leases the recipients with a notification older than the digest delay,
+	// no digest within the rate limit and no lease, the longest waiting first.
+	queryClaimDueRecipients = `
+		WITH due AS (
+			SELECT r.user_id
+			FROM chats.notification_recipients r
+			WHERE (r.last_digest_at IS NULL OR r.last_digest_at <= $2)
+				AND r.leased_until <= $3
+				AND EXISTS (
+					SELECT 1
+					FROM chats.notifications n
+					WHERE n.user_id = r.user_id
+						AND n.status = 'pending'
+						AND n.created_at <= $1
+				)
+			ORDER BY r.last_digest_at NULLS FIRST, r.user_id
+			LIMIT $5
+			FOR UPDATE OF r SKIP LOCKED
+		)
+		UPDATE chats.notification_recipients r
+		SET leased_until = $4
+		FROM due, chats.users u
+		WHERE r.user_id = due.user_id
+			AND u.id = r.user_id
+		RETURNING r.user_id, u.email, u.display_name, u.presence_status <> 'offline' AS online;
+	`
+
+	queryListPendingNotifications = `
+		SELECT id, event_type, chat_id, payload, attempts, created_at
+		FROM chats.notifications
+		WHERE user_id = $1
+			AND status = 'pending'
+		ORDER BY id
+		LIMIT $2;
+	`
+
+	queryFinishNotifications = `
+		UPDATE chats.notifications
+		SET status = $2,
+			finished_at = now()
+		WHERE id = ANY($1);
+	`
+
+	queryRecordNotificationsFailure = `
+		UPDATE chats.notifications
+		SET attempts = attempts + 1,
+			status = CASE WHEN attempts + 1 >= $2 THEN 'failed' ELSE status END,
+			finished_at = CASE WHEN attempts + 1 >= $2 THEN now() ELSE finished_at END
+		WHERE id = ANY($1);
+	`
+
+	queryUpdateRecipient = `
+		UPDATE chats.notification_recipients
+		SET last_digest_at = $2,
+			leased_until = '-infinity'
+		WHERE user_id = $1;
+	`
+
+	queryReleaseRecipient = `
+		UPDATE chats.notification_recipients
+		SET leased_until = '-infinity'
+		WHERE user_id = $1;
+	`
+
+	queryDeleteFinishedNotifications = `
+		DELETE FROM chats.notifications
+		WHERE finished_at < $1;
+	`
+)
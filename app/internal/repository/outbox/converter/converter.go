@@ -0,0 +1,41 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/outbox/model"
+)
+
+// ConvertCreateEventParamsFromServiceToRepo converts CreateEventParams from the service layer
+// to the repository layer format, encoding the payload as JSON.
+func ConvertCreateEventParamsFromServiceToRepo(params model.CreateEventParams) (modelRepo.CreateEventParams, error) {
+	payload, err := json.Marshal(params.Payload)
+	if err != nil {
+		return modelRepo.CreateEventParams{}, err
+	}
+
+	return modelRepo.CreateEventParams{
+		Type:    params.Type,
+		ChatID:  params.ChatID,
+		Payload: string(payload),
+	}, nil
+}
+
+// ConvertEventsFromRepoToService converts stored events from the repository layer
+// to the service layer format.
+func ConvertEventsFromRepoToService(events []modelRepo.Event) []model.Event {
+	result := make([]model.Event, len(events))
+	for i, event := range events {
+		result[i] = model.Event{
+			ID:        event.ID,
+			Type:      event.Type,
+			ChatID:    event.ChatID,
+			Payload:   event.Payload,
+			CreatedAt: event.CreatedAt,
+			Attempts:  event.Attempts,
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+)
+
+// CreateEventParams holds the data of a chat domain event stored in the outbox.
+type CreateEventParams struct {
+	Type    string `db:"event_type"`
+	ChatID  int64  `db:"chat_id"`
+	Payload string `db:"payload"`
+}
+
+// Event represents a chat domain event stored in the outbox.
+type Event struct {
+	ID        int64     `db:"id"`
+	Type      string    `db:"event_type"`
+	ChatID    int64     `db:"chat_id"`
+	Payload   []byte    `db:"payload"`
+	CreatedAt time.Time `db:"created_at"`
+	Attempts  int       `db:"attempts"`
+}
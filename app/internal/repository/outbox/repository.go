@@ -0,0 +1,139 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/outbox/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/outbox/model"
+)
+
+type outboxPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of outboxPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.OutboxRepository {
+	return &outboxPGRepo{
+		db: db,
+	}
+}
+
+// CreateEvent stores a chat domain event in the outbox.
+func (p *outboxPGRepo) CreateEvent(ctx context.Context, params model.CreateEventParams) (err error) {
+	logger.FromContext(ctx).Debug("outboxPGRepo.CreateEvent",
+		slog.String("type", params.Type),
+		slog.Int64("chat_id", params.ChatID),
+	)
+
+	paramsRepo, err := converter.ConvertCreateEventParamsFromServiceToRepo(params)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot encode event(type: %s)", params.Type)
+	}
+
+	q := db.Query{
+		Name:     "outboxPGRepo.CreateEvent",
+		QueryRaw: queryCreateEvent,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.Type, paramsRepo.ChatID, paramsRepo.Payload)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create event(type: %s, chatID: %d)", paramsRepo.Type, paramsRepo.ChatID)
+	}
+
+	return nil
+}
+
+// LockRelay takes or renews the relay lease for the holder until the given time.
+func (p *outboxPGRepo) LockRelay(ctx context.Context, holder string, now, until time.Time) (locked bool, err error) {
+	logger.FromContext(ctx).Debug("outboxPGRepo.LockRelay", slog.String("holder", holder))
+
+	q := db.Query{
+		Name:     "outboxPGRepo.LockRelay",
+		QueryRaw: queryLockRelay,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, holder, now, until)
+	if err != nil {
+		return false, errors.Wrap(err, "Cannot take outbox relay lease")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListPendingEvents returns the oldest events not published yet.
+func (p *outboxPGRepo) ListPendingEvents(ctx context.Context, limit int) (events []model.Event, err error) {
+	logger.FromContext(ctx).Debug("outboxPGRepo.ListPendingEvents", slog.Int("limit", limit))
+
+	q := db.Query{
+		Name:     "outboxPGRepo.ListPendingEvents",
+		QueryRaw: queryListPendingEvents,
+	}
+
+	var eventsRepo []modelRepo.Event
+
+	err = p.db.DB().ScanAllContext(ctx, &eventsRepo, q, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list pending events")
+	}
+
+	return converter.ConvertEventsFromRepoToService(eventsRepo), nil
+}
+
+// MarkEventsPublished marks the events as published.
+func (p *outboxPGRepo) MarkEventsPublished(ctx context.Context, ids []int64) (err error) {
+	logger.FromContext(ctx).Debug("outboxPGRepo.MarkEventsPublished", slog.Int("count", len(ids)))
+
+	q := db.Query{
+		Name:     "outboxPGRepo.MarkEventsPublished",
+		QueryRaw: queryMarkEventsPublished,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, ids)
+	if err != nil {
+		return errors.Wrap(err, "Cannot mark events as published")
+	}
+
+	return nil
+}
+
+// MarkEventFailed increments the attempts of the event and records the reason of the failure.
+func (p *outboxPGRepo) MarkEventFailed(ctx context.Context, id int64, reason string) (err error) {
+	logger.FromContext(ctx).Debug("outboxPGRepo.MarkEventFailed", slog.Int64("id", id))
+
+	q := db.Query{
+		Name:     "outboxPGRepo.MarkEventFailed",
+		QueryRaw: queryMarkEventFailed,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, id, reason)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot mark event as failed(id: %d)", id)
+	}
+
+	return nil
+}
+
+// DeletePublishedEvents deletes the events published before the given time.
+func (p *outboxPGRepo) DeletePublishedEvents(ctx context.Context, before time.Time) (deleted int64, err error) {
+	logger.FromContext(ctx).Debug("outboxPGRepo.DeletePublishedEvents", slog.Time("before", before))
+
+	q := db.Query{
+		Name:     "outboxPGRepo.DeletePublishedEvents",
+		QueryRaw: queryDeletePublishedEvents,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "Cannot delete published events")
+	}
+
+	return tag.RowsAffected(), nil
+}
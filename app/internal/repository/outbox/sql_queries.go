@@ -0,0 +1,51 @@
+package outbox
+
+const (
+	// queryCreateEvent serializes the writers of the events of a chat until they commit,
+	// so that the IDs of the events of a chat follow their commit order.
+	queryCreateEvent = `
+		WITH chat_lock AS (
+			SELECT pg_advisory_xact_lock(hashtext('chats.outbox'), $2::integer)
+		)
+		INSERT INTO chats.outbox
+			(event_type, chat_id, payload)
+		SELECT $1, $2, $3::jsonb
+		FROM chat_lock;
+	`
+
+	// queryLockRelay takes or renews the lease of the relay, so that a single instance
+	// relays the events at a time.
+	queryLockRelay = `
+		UPDATE chats.outbox_relay
+		SET holder = $1,
+			locked_until = $3
+		WHERE holder = $1
+			OR locked_until <= $2;
+	`
+
+	queryListPendingEvents = `
+		SELECT id, event_type, chat_id, payload, created_at, attempts
+		FROM chats.outbox
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1;
+	`
+
+	queryMarkEventsPublished = `
+		UPDATE chats.outbox
+		SET published_at = now()
+		WHERE id = ANY($1);
+	`
+
+	queryMarkEventFailed = `
+		UPDATE chats.outbox
+		SET attempts = attempts + 1,
+			last_error = $2
+		WHERE id = $1;
+	`
+
+	queryDeletePublishedEvents = `
+		DELETE FROM chats.outbox
+		WHERE published_at < $1;
+	`
+)
@@ -0,0 +1,29 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/pin/model"
+)
+
+// ConvertPinPolicyFromRepoToService converts a stored pin policy from the repository layer
+// to the service layer format.
+func ConvertPinPolicyFromRepoToService(policy modelRepo.PinPolicy) model.PinPolicy {
+	return model.PinPolicy{
+		Policy:      policy.Policy,
+		Participant: policy.Participant,
+	}
+}
+
+// ConvertPinnedMessageFromRepoToService converts a stored pin from the repository layer to the service layer format.
+func ConvertPinnedMessageFromRepoToService(pinned modelRepo.PinnedMessage) model.PinnedMessage {
+	return model.PinnedMessage{
+		ChatID:    pinned.ChatID,
+		MessageID: pinned.MessageID,
+		From:      pinned.From,
+		Text:      pinned.Text,
+		Type:      pinned.Type,
+		SentAt:    pinned.SentAt,
+		PinnedBy:  pinned.PinnedBy,
+		PinnedAt:  pinned.PinnedAt,
+	}
+}
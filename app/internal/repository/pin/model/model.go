@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// PinPolicy represents the stored pin policy of a chat and whether a user participates in it.
+type PinPolicy struct {
+	Policy      string `db:"policy"`
+	Participant bool   `db:"participant"`
+}
+
+// PinnedMessage represents a stored pin joined with its message.
+type PinnedMessage struct {
+	ChatID    int64     `db:"chat_id"`
+	MessageID int64     `db:"message_id"`
+	From      string    `db:"sender"`
+	Text      string    `db:"message_text"`
+	Type      string    `db:"message_type"`
+	SentAt    time.Time `db:"sent_at"`
+	PinnedBy  string    `db:"pinned_by"`
+	PinnedAt  time.Time `db:"pinned_at"`
+}
@@ -0,0 +1,154 @@
+package pin
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/pin/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/pin/model"
+)
+
+// uniqueViolation is the PostgreSQL error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+type pinPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of pinPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.PinRepository {
+	return &pinPGRepo{
+		db: db,
+	}
+}
+
+// GetPinPolicy returns the pin policy of the chat and whether the user participates in it.
+func (p *pinPGRepo) GetPinPolicy(ctx context.Context, params model.GetPinPolicyParams) (policy model.PinPolicy, err error) {
+	logger.FromContext(ctx).Debug("pinPGRepo.GetPinPolicy", slog.Int64("chat_id", params.ChatID))
+
+	q := db.Query{
+		Name:     "pinPGRepo.GetPinPolicy",
+		QueryRaw: queryGetPinPolicy,
+	}
+
+	var policyRepo modelRepo.PinPolicy
+
+	err = p.db.DB().ScanOneContext(ctx, &policyRepo, q, params.ChatID, params.From)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.PinPolicy{}, errors.Wrapf(model.ErrNotFound, "chat(chatID: %d)", params.ChatID)
+		}
+
+		return model.PinPolicy{}, errors.Wrapf(err, "Cannot get pin policy(chatID: %d)", params.ChatID)
+	}
+
+	return converter.ConvertPinPolicyFromRepoToService(policyRepo), nil
+}
+
+// SetPinPolicy updates the pin policy of the chat.
+func (p *pinPGRepo) SetPinPolicy(ctx context.Context, params model.SetPinPolicyParams) (err error) {
+	logger.FromContext(ctx).Debug("pinPGRepo.SetPinPolicy", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "pinPGRepo.SetPinPolicy",
+		QueryRaw: querySetPinPolicy,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, params.ChatID, params.Policy)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot set pin policy(chatID: %d)", params.ChatID)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(model.ErrNotFound, "chat(chatID: %d)", params.ChatID)
+	}
+
+	return nil
+}
+
+// PinMessage pins a message of the chat on behalf of params.From and returns the pin.
+func (p *pinPGRepo) PinMessage(ctx context.Context, params model.PinMessageParams) (pinned model.PinnedMessage, err error) {
+	logger.FromContext(ctx).Debug("pinPGRepo.PinMessage", slog.Int64("message_id", params.MessageID))
+
+	q := db.Query{
+		Name:     "pinPGRepo.PinMessage",
+		QueryRaw: queryPinMessage,
+	}
+
+	var pinnedRepo modelRepo.PinnedMessage
+
+	err = p.db.DB().ScanOneContext(ctx, &pinnedRepo, q, params.ChatID, params.MessageID, params.From)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.PinnedMessage{}, errors.Wrapf(model.ErrNotFound,
+				"message(chatID: %d, messageID: %d)", params.ChatID, params.MessageID)
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return model.PinnedMessage{}, errors.Wrapf(model.ErrAlreadyExists,
+				"pin(chatID: %d, messageID: %d)", params.ChatID, params.MessageID)
+		}
+
+		return model.PinnedMessage{}, errors.Wrapf(err,
+			"Cannot pin message(chatID: %d, messageID: %d)", params.ChatID, params.MessageID)
+	}
+
+	return converter.ConvertPinnedMessageFromRepoToService(pinnedRepo), nil
+}
+
+// UnpinMessage removes the pin of a message of the chat.
+func (p *pinPGRepo) UnpinMessage(ctx context.Context, params model.UnpinMessageParams) (err error) {
+	logger.FromContext(ctx).Debug("pinPGRepo.UnpinMessage", slog.Int64("message_id", params.MessageID))
+
+	q := db.Query{
+		Name:     "pinPGRepo.UnpinMessage",
+		QueryRaw: queryUnpinMessage,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, params.ChatID, params.MessageID)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot unpin message(chatID: %d, messageID: %d)", params.ChatID, params.MessageID)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(model.ErrNotFound, "pin(chatID: %d, messageID: %d)", params.ChatID, params.MessageID)
+	}
+
+	return nil
+}
+
+// ListPinnedMessages returns the pinned messages of the chat, most recently pinned first.
+func (p *pinPGRepo) ListPinnedMessages(
+	ctx context.Context,
+	params model.ListPinnedMessagesParams,
+) (pinned []model.PinnedMessage, err error) {
+	logger.FromContext(ctx).Debug("pinPGRepo.ListPinnedMessages", slog.Int64("chat_id", params.ChatID))
+
+	q := db.Query{
+		Name:     "pinPGRepo.ListPinnedMessages",
+		QueryRaw: queryListPinnedMessages,
+	}
+
+	var pinnedRepo []modelRepo.PinnedMessage
+
+	err = p.db.DB().ScanAllContext(ctx, &pinnedRepo, q, params.ChatID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot list pinned messages(chatID: %d)", params.ChatID)
+	}
+
+	pinned = make([]model.PinnedMessage, len(pinnedRepo))
+	for i, pin := range pinnedRepo {
+		pinned[i] = converter.ConvertPinnedMessageFromRepoToService(pin)
+	}
+
+	return pinned, nil
+}
@@ -0,0 +1,52 @@
+package pin
+
+const (
+	queryGetPinPolicy = `
+		SELECT c.pin_policy AS policy,
+			EXISTS (
+				SELECT 1
+				FROM chats.chat_participants cp
+				JOIN chats.users u ON u.id = cp.user_id
+				WHERE cp.chat_id = c.id AND u.email = $2
+			) AS participant
+		FROM chats.chat c
+		WHERE c.id = $1;
+	`
+
+	querySetPinPolicy = `
+		UPDATE chats.chat
+		SET pin_policy = $2
+		WHERE id = $1;
+	`
+
+	// queryPinMessage pins the message only if it belongs to the chat. Pinning it again violates
+	// the primary key of the pinned messages.
+	queryPinMessage = `
+		WITH pin AS (
+			INSERT INTO chats.pinned_messages
+				(chat_id, message_id, pinned_by)
+			SELECT m.chat_id, m.id, $3
+			FROM chats.messages m
+			WHERE m.id = $2 AND m.chat_id = $1
+			RETURNING chat_id, message_id, pinned_by, pinned_at
+		)
+		SELECT pin.chat_id, pin.message_id, m.sender, m.message_text, m.message_type, m.sent_at,
+			pin.pinned_by, pin.pinned_at
+		FROM pin
+		JOIN chats.messages m ON m.id = pin.message_id;
+	`
+
+	queryUnpinMessage = `
+		DELETE FROM chats.pinned_messages
+		WHERE chat_id = $1 AND message_id = $2;
+	`
+
+	queryListPinnedMessages = `
+		SELECT p.chat_id, p.message_id, m.sender, m.message_text, m.message_type, m.sent_at,
+			p.pinned_by, p.pinned_at
+		FROM chats.pinned_messages p
+		JOIN chats.messages m ON m.id = p.message_id
+		WHERE p.chat_id = $1
+		ORDER BY p.pinned_at DESC, p.message_id DESC;
+	`
+)
@@ -0,0 +1,53 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/poll/model"
+)
+
+// ConvertCreatePollParamsFromServiceToRepo converts CreatePollParams from the service layer
+// to the repository layer format.
+func ConvertCreatePollParamsFromServiceToRepo(params model.CreatePollParams) modelRepo.CreatePollParams {
+	paramsRepo := modelRepo.CreatePollParams{
+		ChatID:      params.ChatID,
+		MessageID:   params.MessageID,
+		CreatedBy:   params.From,
+		Question:    params.Question,
+		Options:     params.Options,
+		MultiChoice: params.MultiChoice,
+	}
+
+	if !params.ClosesAt.IsZero() {
+		closesAt := params.ClosesAt
+		paramsRepo.ClosesAt = &closesAt
+	}
+
+	return paramsRepo
+}
+
+// ConvertPollFromRepoToService converts a stored poll from the repository layer to the service layer format.
+func ConvertPollFromRepoToService(poll modelRepo.Poll) model.Poll {
+	options := make([]model.PollOption, len(poll.OptionIDs))
+	for i, id := range poll.OptionIDs {
+		options[i] = model.PollOption{
+			ID:    id,
+			Text:  poll.OptionTexts[i],
+			Votes: poll.OptionVotes[i],
+		}
+	}
+
+	return model.Poll{
+		ID:                   poll.ID,
+		ChatID:               poll.ChatID,
+		MessageID:            poll.MessageID,
+		CreatedBy:            poll.CreatedBy,
+		CreatedByDisplayName: poll.CreatedByDisplayName,
+		Question:             poll.Question,
+		MultiChoice:          poll.MultiChoice,
+		Options:              options,
+		Voters:               poll.Voters,
+		ClosesAt:             poll.ClosesAt,
+		ClosedAt:             poll.ClosedAt,
+		CreatedAt:            poll.CreatedAt,
+	}
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// CreatePollParams holds the data of a poll to create.
+type CreatePollParams struct {
+	ChatID      int64      `db:"chat_id"`
+	MessageID   int64      `db:"message_id"`
+	CreatedBy   string     `db:"created_by"`
+	Question    string     `db:"question"`
+	Options     []string   `db:"options"`
+	MultiChoice bool       `db:"multi_choice"`
+	ClosesAt    *time.Time `db:"closes_at"`
+}
+
+// Poll represents a stored poll with its options and their votes as parallel arrays.
+type Poll struct {
+	ID                   int64      `db:"id"`
+	ChatID               int64      `db:"chat_id"`
+	MessageID            int64      `db:"message_id"`
+	CreatedBy            string     `db:"created_by"`
+	CreatedByDisplayName string     `db:"created_by_display_name"`
+	Question             string     `db:"question"`
+	MultiChoice          bool       `db:"multi_choice"`
+	ClosesAt             *time.Time `db:"closes_at"`
+	ClosedAt             *time.Time `db:"closed_at"`
+
+	CreatedAt   time.Time `db:"created_at"`
+	Voters      int64     `db:"voters"`
+	OptionIDs   []int64   `db:"option_ids"`
+	OptionTexts []string  `db:"option_texts"`
+	OptionVotes []int64   `db:"option_votes"`
+}
@@ -0,0 +1,131 @@
+package poll
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/poll/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/poll/model"
+)
+
+// uniqueViolation is the PostgreSQL error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+type pollPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of pollPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.PollRepository {
+	return &pollPGRepo{
+		db: db,
+	}
+}
+
+// CreatePoll creates a poll with its options and returns its ID.
+func (p *pollPGRepo) CreatePoll(ctx context.Context, params model.CreatePollParams) (pollID int64, err error) {
+	logger.FromContext(ctx).Debug("pollPGRepo.CreatePoll", slog.Int64("chat_id", params.ChatID))
+
+	paramsRepo := converter.ConvertCreatePollParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "pollPGRepo.CreatePoll",
+		QueryRaw: queryCreatePoll,
+	}
+
+	err = p.db.DB().ScanOneContext(
+		ctx,
+		&pollID,
+		q,
+		paramsRepo.ChatID,
+		paramsRepo.MessageID,
+		paramsRepo.CreatedBy,
+		paramsRepo.Question,
+		paramsRepo.MultiChoice,
+		paramsRepo.ClosesAt,
+		paramsRepo.Options,
+	)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot create poll(chatID: %d)", params.ChatID)
+	}
+
+	return pollID, nil
+}
+
+// GetPoll returns the poll with its results.
+func (p *pollPGRepo) GetPoll(ctx context.Context, params model.GetPollParams) (poll model.Poll, err error) {
+	logger.FromContext(ctx).Debug("pollPGRepo.GetPoll", slog.Int64("poll_id", params.PollID))
+
+	q := db.Query{
+		Name:     "pollPGRepo.GetPoll",
+		QueryRaw: queryGetPoll,
+	}
+
+	var pollRepo modelRepo.Poll
+
+	err = p.db.DB().ScanOneContext(ctx, &pollRepo, q, params.PollID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Poll{}, errors.Wrapf(model.ErrNotFound, "poll(pollID: %d)", params.PollID)
+		}
+
+		return model.Poll{}, errors.Wrapf(err, "Cannot get poll(pollID: %d)", params.PollID)
+	}
+
+	return converter.ConvertPollFromRepoToService(pollRepo), nil
+}
+
+// Vote stores the ballot of a participant of the chat of the poll.
+func (p *pollPGRepo) Vote(ctx context.Context, params model.VoteParams) (err error) {
+	logger.FromContext(ctx).Debug("pollPGRepo.Vote", slog.Int64("poll_id", params.PollID))
+
+	q := db.Query{
+		Name:     "pollPGRepo.Vote",
+		QueryRaw: queryVote,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, params.PollID, params.From, params.OptionIDs)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return errors.Wrapf(model.ErrAlreadyExists, "ballot(pollID: %d)", params.PollID)
+		}
+
+		return errors.Wrapf(err, "Cannot vote(pollID: %d)", params.PollID)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(model.ErrPermissionDenied, "not a participant of the chat of poll(pollID: %d)", params.PollID)
+	}
+
+	return nil
+}
+
+// ClosePoll closes the poll at params.ClosedAt, unless it is already closed.
+func (p *pollPGRepo) ClosePoll(ctx context.Context, params model.ClosePollParams) (err error) {
+	logger.FromContext(ctx).Debug("pollPGRepo.ClosePoll", slog.Int64("poll_id", params.PollID))
+
+	q := db.Query{
+		Name:     "pollPGRepo.ClosePoll",
+		QueryRaw: queryClosePoll,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, params.PollID, params.ClosedAt)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot close poll(pollID: %d)", params.PollID)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(model.ErrPollClosed, "poll(pollID: %d)", params.PollID)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package poll
+
+const (
+	// queryCreatePoll creates the poll with its options numbered in the given order.
+	queryCreatePoll = `
+		WITH poll AS (
+			INSERT INTO chats.polls
+				(chat_id, message_id, created_by, question, multi_choice, closes_at)
+			VALUES
+				($1, $2, $3, $4, $5, $6)
+			RETURNING id
+		), options AS (
+			INSERT INTO chats.poll_options
+				(poll_id, position, option_text)
+			SELECT poll.id, o.position, o.option_text
+			FROM poll, unnest($7::text[]) WITH ORDINALITY AS o(option_text, position)
+		)
+		SELECT id FROM poll;
+	`
+
+	queryGetPoll = `
+		SELECT p.id, p.chat_id, p.message_id, p.created_by, p.question, p.multi_choice,
+			p.closes_at, p.closed_at, p.created_at,
+			COALESCE(
+				(SELECT u.display_name FROM chats.users u WHERE u.email = p.created_by), ''
+			) AS created_by_display_name,
+			(SELECT count(*) FROM chats.poll_ballots b WHERE b.poll_id = p.id) AS voters,
+			array(
+				SELECT o.id::bigint FROM chats.poll_options o
+				WHERE o.poll_id = p.id ORDER BY o.position
+			) AS option_ids,
+			array(
+				SELECT o.option_text FROM chats.poll_options o
+				WHERE o.poll_id = p.id ORDER BY o.position
+			) AS option_texts,
+			array(
+				SELECT (SELECT count(*) FROM chats.poll_ballot_options bo WHERE bo.option_id = o.id)
+				FROM chats.poll_options o
+				WHERE o.poll_id = p.id ORDER BY o.position
+			) AS option_votes
+		FROM chats.polls p
+		WHERE p.id = $1;
+	`
+
+	// queryVote casts the ballot only if the voter participates in the chat of the poll.
+	// A second ballot of the same voter violates the primary key of the ballots.
+	queryVote = `
+		WITH ballot AS (
+			INSERT INTO chats.poll_ballots
+				(poll_id, voter)
+			SELECT p.id, $2
+			FROM chats.polls p
+			WHERE p.id = $1
+				AND EXISTS (
+					SELECT 1
+					FROM chats.chat_participants cp
+					JOIN chats.users u ON u.id = cp.user_id
+					WHERE cp.chat_id = p.chat_id AND u.email = $2
+				)
+			RETURNING poll_id, voter
+		)
+		INSERT INTO chats.poll_ballot_options
+			(poll_id, voter, option_id)
+		SELECT ballot.poll_id, ballot.voter, unnest($3::integer[])
+		FROM ballot;
+	`
+
+	queryClosePoll = `
+		UPDATE chats.polls
+		SET closed_at = $2
+		WHERE id = $1 AND closed_at IS NULL;
+	`
+)
@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/presence/model"
+)
+
+// ConvertPresenceFromRepoToService converts a Presence from the repository layer to the service layer format.
+func ConvertPresenceFromRepoToService(presence modelRepo.Presence) model.Presence {
+	return model.Presence{
+		Email:       presence.Email,
+		DisplayName: presence.DisplayName,
+		Status:      presence.Status,
+		LastSeenAt:  presence.LastSeenAt,
+	}
+}
+
+// ConvertPresenceChangeFromRepoToService converts a PresenceChange from the repository layer
+// to the service layer format.
+func ConvertPresenceChangeFromRepoToService(change modelRepo.PresenceChange) model.PresenceChange {
+	return model.PresenceChange{
+		Presence: model.Presence{
+			Email:       change.Email,
+			DisplayName: change.DisplayName,
+			Status:      change.Status,
+			LastSeenAt:  change.LastSeenAt,
+		},
+		ChatIDs: change.ChatIDs,
+	}
+}
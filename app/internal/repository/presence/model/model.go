@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// Presence represents the presence status of a user computed from their sessions.
+type Presence struct {
+	Email       string     `db:"email"`
+	DisplayName string     `db:"display_name"`
+	Status      string     `db:"status"`
+	LastSeenAt  *time.Time `db:"last_seen_at"`
+}
+
+// PresenceChange represents a new presence status of a user with the chats the user participates in.
+type PresenceChange struct {
+	Email       string     `db:"email"`
+	DisplayName string     `db:"display_name"`
+	Status      string     `db:"status"`
+	LastSeenAt  *time.Time `db:"last_seen_at"`
+	ChatIDs     []int64    `db:"chat_ids"`
+}
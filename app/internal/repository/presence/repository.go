@@ -0,0 +1,144 @@
+package presence
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/presence/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/presence/model"
+)
+
+type presencePGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of presencePGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.PresenceRepository {
+	return &presencePGRepo{
+		db: db,
+	}
+}
+
+// TouchPresence stores the sessions of the users on the instance as seen at params.SeenAt,
+// along with their last-seen timestamps, and returns the number of known users touched.
+func (p *presencePGRepo) TouchPresence(ctx context.Context, params model.TouchPresenceParams) (touched int64, err error) {
+	logger.FromContext(ctx).Debug("presencePGRepo.TouchPresence",
+		slog.String("instance_id", params.InstanceID),
+		slog.Int("users", len(params.Emails)),
+	)
+
+	q := db.Query{
+		Name:     "presencePGRepo.TouchPresence",
+		QueryRaw: queryTouchPresence,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, params.InstanceID, params.Emails, params.Away, params.SeenAt)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot touch presence(instanceID: %s)", params.InstanceID)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// DeletePresenceSession deletes the session of the user on the instance.
+func (p *presencePGRepo) DeletePresenceSession(ctx context.Context, params model.DeletePresenceSessionParams) (err error) {
+	logger.FromContext(ctx).Debug("presencePGRepo.DeletePresenceSession",
+		slog.String("instance_id", params.InstanceID),
+	)
+
+	q := db.Query{
+		Name:     "presencePGRepo.DeletePresenceSession",
+		QueryRaw: queryDeletePresenceSession,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, params.InstanceID, params.Email)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot delete presence session(instanceID: %s)", params.InstanceID)
+	}
+
+	return nil
+}
+
+// DeleteStalePresenceSessions deletes the sessions not seen after before and returns their number.
+func (p *presencePGRepo) DeleteStalePresenceSessions(ctx context.Context, before time.Time) (deleted int64, err error) {
+	logger.FromContext(ctx).Debug("presencePGRepo.DeleteStalePresenceSessions", slog.Time("before", before))
+
+	q := db.Query{
+		Name:     "presencePGRepo.DeleteStalePresenceSessions",
+		QueryRaw: queryDeleteStalePresenceSessions,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "Cannot delete stale presence sessions")
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// RefreshPresence recomputes the presence statuses of the users from their sessions and returns
+// the ones that changed. A change is returned once, even if several instances refresh concurrently.
+func (p *presencePGRepo) RefreshPresence(
+	ctx context.Context,
+	params model.RefreshPresenceParams,
+) (changes []model.PresenceChange, err error) {
+	logger.FromContext(ctx).Debug("presencePGRepo.RefreshPresence", slog.Int("users", len(params.Emails)))
+
+	q := db.Query{
+		Name:     "presencePGRepo.RefreshPresence",
+		QueryRaw: queryRefreshPresence,
+	}
+
+	emails := params.Emails
+	if emails == nil {
+		emails = []string{}
+	}
+
+	var changesRepo []modelRepo.PresenceChange
+
+	err = p.db.DB().ScanAllContext(ctx, &changesRepo, q, params.Since, emails)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot refresh presence")
+	}
+
+	changes = make([]model.PresenceChange, len(changesRepo))
+	for i, change := range changesRepo {
+		changes[i] = converter.ConvertPresenceChangeFromRepoToService(change)
+	}
+
+	return changes, nil
+}
+
+// ListPresence returns the presence of the known users among params.Emails, ordered by email.
+func (p *presencePGRepo) ListPresence(
+	ctx context.Context,
+	params model.ListPresenceParams,
+) (presences []model.Presence, err error) {
+	logger.FromContext(ctx).Debug("presencePGRepo.ListPresence", slog.Int("users", len(params.Emails)))
+
+	q := db.Query{
+		Name:     "presencePGRepo.ListPresence",
+		QueryRaw: queryListPresence,
+	}
+
+	var presencesRepo []modelRepo.Presence
+
+	err = p.db.DB().ScanAllContext(ctx, &presencesRepo, q, params.Since, params.Emails)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list presence")
+	}
+
+	presences = make([]model.Presence, len(presencesRepo))
+	for i, presence := range presencesRepo {
+		presences[i] = converter.ConvertPresenceFromRepoToService(presence)
+	}
+
+	return presences, nil
+}
@@ -0,0 +1,84 @@
+package presence
+
+const (
+	queryTouchPresence = `
+		WITH touched AS (
+			UPDATE chats.users
+			SET last_seen_at = $4
+			WHERE email = ANY($2)
+			RETURNING id
+		)
+		INSERT INTO chats.presence_sessions (user_id, instance_id, away, seen_at)
+		SELECT id, $1, $3, $4
+		FROM touched
+		ON CONFLICT (user_id, instance_id) DO UPDATE
+		SET away = EXCLUDED.away,
+			seen_at = EXCLUDED.seen_at;
+	`
+
+	queryDeletePresenceSession = `
+		DELETE FROM chats.presence_sessions s
+		USING chats.users u
+		WHERE s.user_id = u.id
+			AND s.instance_id = $1
+			AND u.email = $2;
+	`
+
+	queryDeleteStalePresenceSessions = `
+		DELETE FROM chats.presence_sessions
+		WHERE seen_at <= $1;
+	`
+
+	queryRefreshPresence = `
+		WITH computed AS (
+			SELECT
+				u.id,
+				CASE
+					WHEN bool_or(NOT s.away) THEN 'online'
+					WHEN count(s.user_id) > 0 THEN 'away'
+					ELSE 'offline'
+				END AS status
+			FROM chats.users u
+			LEFT JOIN chats.presence_sessions s ON s.user_id = u.id AND s.seen_at > $1
+			WHERE (cardinality($2::text[]) = 0 OR u.email = ANY($2))
+				AND (u.presence_status <> 'offline' OR s.user_id IS NOT NULL)
+			GROUP BY u.id
+		), changed AS (
+			UPDATE chats.users u
+			SET presence_status = c.status
+			FROM computed c
+			WHERE u.id = c.id
+				AND u.presence_status <> c.status
+			RETURNING u.id, u.email, u.display_name, u.presence_status, u.last_seen_at
+		)
+		SELECT
+			c.email,
+			c.display_name,
+			c.presence_status AS status,
+			c.last_seen_at,
+			array(
+				SELECT cp.chat_id::bigint
+				FROM chats.chat_participants cp
+				WHERE cp.user_id = c.id
+				ORDER BY cp.chat_id
+			) AS chat_ids
+		FROM changed c;
+	`
+
+	queryListPresence = `
+		SELECT
+			u.email,
+			u.display_name,
+			CASE
+				WHEN bool_or(NOT s.away) THEN 'online'
+				WHEN count(s.user_id) > 0 THEN 'away'
+				ELSE 'offline'
+			END AS status,
+			u.last_seen_at
+		FROM chats.users u
+		LEFT JOIN chats.presence_sessions s ON s.user_id = u.id AND s.seen_at > $1
+		WHERE u.email = ANY($2)
+		GROUP BY u.id
+		ORDER BY u.email;
+	`
+)
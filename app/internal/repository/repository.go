@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Prrromanssss/chat-server/internal/model"
 )
@@ -27,11 +28,320 @@ type ChatRepository interface {
 	// DeleteChat removes a chat identified by its chat ID.
 	DeleteChat(ctx context.Context, params model.DeleteChatParams) (err error)
 
-	// SendMessage sends a message with the specified parameters.
-	SendMessage(ctx context.Context, params model.SendMessageParams) (err error)
+	// SendMessage sends a message with the specified parameters and returns the ID of the message.
+	SendMessage(ctx context.Context, params model.SendMessageParams) (resp model.SendMessageResponse, err error)
+
+	// ListMessages returns at most params.Limit messages of the chat older than params.BeforeID, if set,
+	// newest first, without the messages of the users blocked by params.From.
+	ListMessages(ctx context.Context, params model.ListMessagesParams) (messages []model.Message, err error)
+
+	// UpdateChatSettings updates the settings of the chat for the participant and returns the settings,
+	// or returns model.ErrNotFound if the user does not participate in the chat.
+	UpdateChatSettings(
+		ctx context.Context,
+		params model.UpdateChatSettingsParams,
+	) (settings model.ChatSettings, err error)
+
+	// ListChats returns the chats of the participant with their settings, pinned first, then newest first.
+	ListChats(ctx context.Context, params model.ListChatsParams) (chats []model.UserChat, err error)
+
+	// IsParticipant reports whether the user participates in the chat.
+	IsParticipant(ctx context.Context, chatID int64, email string) (participant bool, err error)
 }
 
 type LogRepository interface {
 	// CreateAPILog creates log in database of every api action and returns any error..
 	CreateAPILog(ctx context.Context, params model.CreateAPILogParams) (err error)
+
+	// ListAPILogs returns at most params.PageSize logs matching the filters, newest first,
+	// starting after params.Cursor.
+	ListAPILogs(ctx context.Context, params model.ListAuditLogParams) (logs []model.AuditLogEntry, err error)
+}
+
+// LogPartitionRepository defines methods for maintaining the daily partitions of the audit log.
+type LogPartitionRepository interface {
+	// LockAPILogMaintenance takes the maintenance lock for the current transaction
+	// and returns false if another instance holds it.
+	LockAPILogMaintenance(ctx context.Context) (locked bool, err error)
+
+	// ListAPILogPartitions returns the partitions of the audit log.
+	ListAPILogPartitions(ctx context.Context) (partitions []model.AuditLogPartition, err error)
+
+	// CreateAPILogPartition creates the partition holding the entries created in [from, to).
+	CreateAPILogPartition(ctx context.Context, from, to time.Time) (err error)
+
+	// ExportAPILogPartition calls fn for every entry of the partition, oldest first.
+	ExportAPILogPartition(ctx context.Context, name string, fn func(entry model.AuditLogEntry) error) (err error)
+
+	// DropAPILogPartition drops the partition with all its entries.
+	DropAPILogPartition(ctx context.Context, name string) (err error)
+}
+
+// OutboxRepository defines methods for storing chat domain events and relaying them.
+type OutboxRepository interface {
+	// CreateEvent stores the event in the transaction of the context, so that it is published
+	// if and only if the transaction commits.
+	CreateEvent(ctx context.Context, params model.CreateEventParams) (err error)
+
+	// LockRelay takes or renews the relay lease for the holder until the given time and returns false
+	// if another holder has it at now.
+	LockRelay(ctx context.Context, holder string, now, until time.Time) (locked bool, err error)
+
+	// ListPendingEvents returns at most limit events not published yet, oldest first.
+	ListPendingEvents(ctx context.Context, limit int) (events []model.Event, err error)
+
+	// MarkEventsPublished marks the events as published.
+	MarkEventsPublished(ctx context.Context, ids []int64) (err error)
+
+	// MarkEventFailed records a failed attempt to publish the event.
+	MarkEventFailed(ctx context.Context, id int64, reason string) (err error)
+
+	// DeletePublishedEvents deletes the events published before the given time
+	// and returns the number of deleted events.
+	DeletePublishedEvents(ctx context.Context, before time.Time) (deleted int64, err error)
+}
+
+// WebhookRepository defines methods for managing outgoing webhooks and their deliveries.
+type WebhookRepository interface {
+	// CreateWebhook creates a webhook and returns its ID.
+	CreateWebhook(ctx context.Context, params model.CreateWebhookParams) (webhookID int64, err error)
+
+	// ListWebhooks returns the webhooks matching the filter ordered by ID.
+	ListWebhooks(ctx context.Context, params model.ListWebhooksParams) (webhooks []model.Webhook, err error)
+
+	// DeleteWebhook removes a webhook with its deliveries, or returns model.ErrNotFound.
+	DeleteWebhook(ctx context.Context, params model.DeleteWebhookParams) (err error)
+
+	// CreateDeliveries schedules the delivery of the event to every enabled webhook subscribed to it.
+	// Scheduling an event twice creates no duplicate deliveries.
+	CreateDeliveries(ctx context.Context, event model.Event) (err error)
+
+	// ClaimDueDeliveries returns at most limit pending deliveries due at now, postponed to leaseUntil,
+	// so that other instances skip them until the outcome of their attempt is recorded.
+	ClaimDueDeliveries(
+		ctx context.Context,
+		now time.Time,
+		leaseUntil time.Time,
+		limit int,
+	) (deliveries []model.WebhookDelivery, err error)
+
+	// CreateDeliveryAttempt records an attempt to deliver an event.
+	CreateDeliveryAttempt(ctx context.Context, params model.CreateWebhookAttemptParams) (err error)
+
+	// UpdateDelivery updates the state of a delivery after an attempt.
+	UpdateDelivery(ctx context.Context, params model.UpdateWebhookDeliveryParams) (err error)
+
+	// RecordWebhookResult updates the consecutive failures of the webhook and reports
+	// whether the webhook has just been disabled.
+	RecordWebhookResult(ctx context.Context, params model.RecordWebhookResultParams) (disabled bool, err error)
+
+	// DeleteFinishedDeliveries deletes the deliveries finished before the given time with their attempts.
+	DeleteFinishedDeliveries(ctx context.Context, before time.Time) (deleted int64, err error)
+
+	// CreateIncomingWebhook creates an incoming webhook and returns its ID.
+	CreateIncomingWebhook(
+		ctx context.Context,
+		params model.CreateIncomingWebhookParams,
+	) (incomingWebhookID int64, err error)
+
+	// ListIncomingWebhooks returns the incoming webhooks matching the filter ordered by ID.
+	ListIncomingWebhooks(
+		ctx context.Context,
+		params model.ListIncomingWebhooksParams,
+	) (webhooks []model.IncomingWebhook, err error)
+
+	// DeleteIncomingWebhook removes an incoming webhook, or returns model.ErrNotFound.
+	DeleteIncomingWebhook(ctx context.Context, params model.DeleteIncomingWebhookParams) (err error)
+
+	// GetIncomingWebhookByTokenHash returns the incoming webhook whose token has the given hash,
+	// or model.ErrNotFound.
+	GetIncomingWebhookByTokenHash(ctx context.Context, tokenHash string) (webhook model.IncomingWebhook, err error)
+}
+
+// BotRepository defines methods for managing the bot users and their slash commands.
+type BotRepository interface {
+	// CreateBot creates a bot user with its commands and returns its ID, or returns model.ErrAlreadyExists
+	// if the name or one of the commands is taken.
+	CreateBot(ctx context.Context, params model.CreateBotParams) (botID int64, err error)
+
+	// ListBots returns the bots with their commands ordered by ID.
+	ListBots(ctx context.Context) (bots []model.Bot, err error)
+
+	// DeleteBot removes a bot with its commands, or returns model.ErrNotFound.
+	DeleteBot(ctx context.Context, params model.DeleteBotParams) (err error)
+
+	// GetBotByTokenHash returns the bot whose API token has the given hash, or model.ErrNotFound.
+	GetBotByTokenHash(ctx context.Context, tokenHash string) (bot model.Bot, err error)
+
+	// GetBotByCommand returns the bot handling the command, or model.ErrNotFound.
+	GetBotByCommand(ctx context.Context, command string) (bot model.Bot, err error)
+
+	// ListBotCommands returns the commands of all bots ordered by name.
+	ListBotCommands(ctx context.Context) (commands []model.BotCommand, err error)
+}
+
+// PollRepository defines methods for managing the polls of the chats and their ballots.
+type PollRepository interface {
+	// CreatePoll creates a poll with its options and returns its ID.
+	CreatePoll(ctx context.Context, params model.CreatePollParams) (pollID int64, err error)
+
+	// GetPoll returns the poll with the votes of its options, or model.ErrNotFound.
+	GetPoll(ctx context.Context, params model.GetPollParams) (poll model.Poll, err error)
+
+	// Vote stores the ballot of the voter, or returns model.ErrPermissionDenied if the voter does not
+	// participate in the chat of the poll and model.ErrAlreadyExists if the voter has already voted.
+	Vote(ctx context.Context, params model.VoteParams) (err error)
+
+	// ClosePoll closes the poll, or returns model.ErrPollClosed if it is already closed.
+	ClosePoll(ctx context.Context, params model.ClosePollParams) (err error)
+}
+
+// ChatUpdateRepository defines methods for broadcasting the live updates of the chats.
+type ChatUpdateRepository interface {
+	// NotifyChatUpdate broadcasts the update to the subscribers of its chat on every instance
+	// once the transaction of the context commits, or immediately outside of a transaction.
+	NotifyChatUpdate(ctx context.Context, params model.CreateChatUpdateParams) (err error)
+}
+
+// PresenceRepository defines methods for tracking the presence of the users across the instances.
+type PresenceRepository interface {
+	// TouchPresence stores the sessions of the users on the instance as seen, along with their last-seen
+	// timestamps, and returns the number of users touched. The unknown users are skipped.
+	TouchPresence(ctx context.Context, params model.TouchPresenceParams) (touched int64, err error)
+
+	// DeletePresenceSession deletes the session of the user on the instance.
+	DeletePresenceSession(ctx context.Context, params model.DeletePresenceSessionParams) (err error)
+
+	// DeleteStalePresenceSessions deletes the sessions not seen after before and returns their number.
+	DeleteStalePresenceSessions(ctx context.Context, before time.Time) (deleted int64, err error)
+
+	// RefreshPresence recomputes the presence statuses of the users and returns the ones that changed.
+	RefreshPresence(ctx context.Context, params model.RefreshPresenceParams) (changes []model.PresenceChange, err error)
+
+	// ListPresence returns the presence of the known users among the emails.
+	ListPresence(ctx context.Context, params model.ListPresenceParams) (presences []model.Presence, err error)
+}
+
+// UserRepository defines methods for managing the profiles of the users and searching the directory.
+type UserRepository interface {
+	// GetUser returns the user with the email, or model.ErrNotFound.
+	GetUser(ctx context.Context, params model.GetUserParams) (user model.User, err error)
+
+	// UpdateProfile updates the profile of the user and returns the user, or model.ErrNotFound.
+	UpdateProfile(ctx context.Context, params model.UpdateProfileParams) (user model.User, err error)
+
+	// SearchUsers returns the users whose email or display name starts with the query.
+	SearchUsers(ctx context.Context, params model.SearchUsersParams) (users []model.User, err error)
+}
+
+// BlockRepository defines methods for managing the users blocked by other users.
+type BlockRepository interface {
+	// BlockUser stores the block of the user by another one, or returns model.ErrNotFound if either user
+	// is unknown. Blocking a user twice keeps the first block.
+	BlockUser(ctx context.Context, params model.BlockUserParams) (err error)
+
+	// UnblockUser removes the block of the user, if any.
+	UnblockUser(ctx context.Context, params model.UnblockUserParams) (err error)
+
+	// ListBlocked returns the users blocked by the user, most recently blocked first.
+	ListBlocked(ctx context.Context, params model.ListBlockedParams) (users []model.BlockedUser, err error)
+
+	// BlockedBetween reports whether either user blocks the other one.
+	BlockedBetween(ctx context.Context, first, second string) (blocked bool, err error)
+}
+
+// MentionRepository defines methods for managing the mentions of the participants in the messages.
+type MentionRepository interface {
+	// CreateMentions stores the mentions of the participants of the chat, other than the sender, whose email
+	// or display name is mentioned in the message, and returns them. The participants blocking the sender
+	// are not mentioned.
+	CreateMentions(ctx context.Context, params model.CreateMentionsParams) (users []model.MentionedUser, err error)
+
+	// ListMentions returns at most params.Limit mentions of the user older than params.BeforeID, if set,
+	// newest first.
+	ListMentions(ctx context.Context, params model.ListMentionsParams) (mentions []model.Mention, err error)
+}
+
+// NotificationRepository defines methods for managing the notifications of the offline users.
+type NotificationRepository interface {
+	// CreateNotifications stores the notifications of a message.sent or mention.created event for
+	// the offline participants it concerns. Storing an event twice creates no duplicate notifications.
+	CreateNotifications(ctx context.Context, event model.Event) (err error)
+
+	// ClaimDueRecipients leases until params.LeaseUntil and returns at most params.Limit recipients due
+	// a digest at params.Now, skipping the ones leased or locked by other instances.
+	ClaimDueRecipients(
+		ctx context.Context,
+		params model.ClaimDueRecipientsParams,
+	) (recipients []model.NotificationRecipient, err error)
+
+	// ListPendingNotifications returns at most limit pending notifications of the user, oldest first.
+	ListPendingNotifications(ctx context.Context, userID int64, limit int) (notifications []model.Notification, err error)
+
+	// FinishNotifications sets the final status of the notifications.
+	FinishNotifications(ctx context.Context, params model.FinishNotificationsParams) (err error)
+
+	// RecordNotificationsFailure counts a failed attempt of the notifications, failing the ones
+	// reaching the maximum number of attempts.
+	RecordNotificationsFailure(ctx context.Context, params model.RecordNotificationsFailureParams) (err error)
+
+	// UpdateRecipient updates the time of the last digest of the recipient and releases its lease.
+	UpdateRecipient(ctx context.Context, params model.UpdateRecipientParams) (err error)
+
+	// ReleaseRecipient releases the lease of the recipient without a digest, leaving the time of its last digest.
+	ReleaseRecipient(ctx context.Context, userID int64) (err error)
+
+	// DeleteFinishedNotifications deletes the notifications finished before the given time.
+	DeleteFinishedNotifications(ctx context.Context, before time.Time) (deleted int64, err error)
+}
+
+// PinRepository defines methods for managing the pinned messages of the chats and who may pin them.
+type PinRepository interface {
+	// GetPinPolicy returns the pin policy of the chat and whether the user participates in it,
+	// or model.ErrNotFound for an unknown chat.
+	GetPinPolicy(ctx context.Context, params model.GetPinPolicyParams) (policy model.PinPolicy, err error)
+
+	// SetPinPolicy updates the pin policy of the chat, or returns model.ErrNotFound for an unknown chat.
+	SetPinPolicy(ctx context.Context, params model.SetPinPolicyParams) (err error)
+
+	// PinMessage pins a message of the chat and returns the pin, or returns model.ErrNotFound if the message
+	// is not a message of the chat and model.ErrAlreadyExists if it is already pinned.
+	PinMessage(ctx context.Context, params model.PinMessageParams) (pinned model.PinnedMessage, err error)
+
+	// UnpinMessage removes the pin of a message of the chat, or returns model.ErrNotFound if it is not pinned.
+	UnpinMessage(ctx context.Context, params model.UnpinMessageParams) (err error)
+
+	// ListPinnedMessages returns the pinned messages of the chat, most recently pinned first.
+	ListPinnedMessages(ctx context.Context, params model.ListPinnedMessagesParams) (pinned []model.PinnedMessage, err error)
+}
+
+// ScheduledMessageRepository defines methods for managing the messages scheduled to be sent later.
+type ScheduledMessageRepository interface {
+	// CreateScheduledMessage stores a message to send at params.SendAt and returns its ID.
+	CreateScheduledMessage(ctx context.Context, params model.ScheduleMessageParams) (scheduledMessageID int64, err error)
+
+	// ListScheduledMessages returns the pending scheduled messages of the sender matching the filter,
+	// the soonest first.
+	ListScheduledMessages(
+		ctx context.Context,
+		params model.ListScheduledMessagesParams,
+	) (messages []model.ScheduledMessage, err error)
+
+	// CancelScheduledMessage cancels a pending scheduled message of the sender, or returns model.ErrNotFound
+	// if there is none, e.g. because it was already sent.
+	CancelScheduledMessage(ctx context.Context, params model.CancelScheduledMessageParams) (err error)
+
+	// ListDueScheduledMessages locks and returns at most limit pending scheduled messages due at now,
+	// skipping the ones locked by other instances.
+	ListDueScheduledMessages(ctx context.Context, now time.Time, limit int) (messages []model.ScheduledMessage, err error)
+
+	// MarkScheduledMessageSent marks the scheduled message sent at sentAt.
+	MarkScheduledMessageSent(ctx context.Context, scheduledMessageID int64, sentAt time.Time) (err error)
+
+	// RecordScheduledMessageFailure counts a failed attempt to send the scheduled message, failing it
+	// at the maximum number of attempts.
+	RecordScheduledMessageFailure(ctx context.Context, params model.RecordScheduledMessageFailureParams) (err error)
+
+	// DeleteFinishedScheduledMessages deletes the scheduled messages finished before the given time.
+	DeleteFinishedScheduledMessages(ctx context.Context, before time.Time) (deleted int64, err error)
 }
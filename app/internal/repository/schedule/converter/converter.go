@@ -0,0 +1,25 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/schedule/model"
+)
+
+// ConvertScheduledMessagesFromRepoToService converts stored scheduled messages from the repository layer
+// to the service layer format.
+func ConvertScheduledMessagesFromRepoToService(messages []modelRepo.ScheduledMessage) []model.ScheduledMessage {
+	result := make([]model.ScheduledMessage, len(messages))
+	for i, message := range messages {
+		result[i] = model.ScheduledMessage{
+			ID:        message.ID,
+			ChatID:    message.ChatID,
+			From:      message.From,
+			Text:      message.Text,
+			SendAt:    message.SendAt,
+			Attempts:  message.Attempts,
+			CreatedAt: message.CreatedAt,
+		}
+	}
+
+	return result
+}
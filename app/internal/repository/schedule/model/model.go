@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// ScheduledMessage represents a stored scheduled message.
+type ScheduledMessage struct {
+	ID        int64     `db:"id"`
+	ChatID    int64     `db:"chat_id"`
+	From      string    `db:"sender"`
+	Text      string    `db:"message_text"`
+	SendAt    time.Time `db:"send_at"`
+	Attempts  int       `db:"attempts"`
+	CreatedAt time.Time `db:"created_at"`
+}
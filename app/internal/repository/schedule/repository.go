@@ -0,0 +1,187 @@
+package schedule
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/schedule/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/schedule/model"
+)
+
+type schedulePGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of schedulePGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.ScheduledMessageRepository {
+	return &schedulePGRepo{
+		db: db,
+	}
+}
+
+// CreateScheduledMessage stores a message to send at params.SendAt and returns its ID.
+func (p *schedulePGRepo) CreateScheduledMessage(
+	ctx context.Context,
+	params model.ScheduleMessageParams,
+) (scheduledMessageID int64, err error) {
+	logger.FromContext(ctx).Debug("schedulePGRepo.CreateScheduledMessage", slog.Int64("chat_id", params.ChatID))
+
+	q := db.Query{
+		Name:     "schedulePGRepo.CreateScheduledMessage",
+		QueryRaw: queryCreateScheduledMessage,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &scheduledMessageID, q, params.ChatID, params.From, params.Text, params.SendAt)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot create scheduled message(chatID: %d)", params.ChatID)
+	}
+
+	return scheduledMessageID, nil
+}
+
+// ListScheduledMessages returns the pending scheduled messages of the sender, the soonest first.
+func (p *schedulePGRepo) ListScheduledMessages(
+	ctx context.Context,
+	params model.ListScheduledMessagesParams,
+) (messages []model.ScheduledMessage, err error) {
+	logger.FromContext(ctx).Debug("schedulePGRepo.ListScheduledMessages", slog.Int64("chat_id", params.ChatID))
+
+	q := db.Query{
+		Name:     "schedulePGRepo.ListScheduledMessages",
+		QueryRaw: queryListScheduledMessages,
+	}
+
+	var messagesRepo []modelRepo.ScheduledMessage
+
+	err = p.db.DB().ScanAllContext(ctx, &messagesRepo, q, params.From, params.ChatID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list scheduled messages")
+	}
+
+	return converter.ConvertScheduledMessagesFromRepoToService(messagesRepo), nil
+}
+
+// CancelScheduledMessage cancels a pending scheduled message of the sender.
+func (p *schedulePGRepo) CancelScheduledMessage(
+	ctx context.Context,
+	params model.CancelScheduledMessageParams,
+) (err error) {
+	logger.FromContext(ctx).Debug("schedulePGRepo.CancelScheduledMessage",
+		slog.Int64("scheduled_message_id", params.ScheduledMessageID))
+
+	q := db.Query{
+		Name:     "schedulePGRepo.CancelScheduledMessage",
+		QueryRaw: queryCancelScheduledMessage,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, params.ScheduledMessageID, params.From, params.CancelledAt)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot cancel scheduled message(scheduledMessageID: %d)", params.ScheduledMessageID)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(model.ErrNotFound, "pending scheduled message(scheduledMessageID: %d)",
+			params.ScheduledMessageID)
+	}
+
+	return nil
+}
+
+// ListDueScheduledMessages locks and returns the pending scheduled messages due at now, oldest first.
+func (p *schedulePGRepo) ListDueScheduledMessages(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) (messages []model.ScheduledMessage, err error) {
+	logger.FromContext(ctx).Debug("schedulePGRepo.ListDueScheduledMessages", slog.Int("limit", limit))
+
+	q := db.Query{
+		Name:     "schedulePGRepo.ListDueScheduledMessages",
+		QueryRaw: queryListDueScheduledMessages,
+	}
+
+	var messagesRepo []modelRepo.ScheduledMessage
+
+	err = p.db.DB().ScanAllContext(ctx, &messagesRepo, q, now, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list due scheduled messages")
+	}
+
+	return converter.ConvertScheduledMessagesFromRepoToService(messagesRepo), nil
+}
+
+// MarkScheduledMessageSent marks the scheduled message sent at sentAt.
+func (p *schedulePGRepo) MarkScheduledMessageSent(
+	ctx context.Context,
+	scheduledMessageID int64,
+	sentAt time.Time,
+) (err error) {
+	logger.FromContext(ctx).Debug("schedulePGRepo.MarkScheduledMessageSent",
+		slog.Int64("scheduled_message_id", scheduledMessageID))
+
+	q := db.Query{
+		Name:     "schedulePGRepo.MarkScheduledMessageSent",
+		QueryRaw: queryMarkScheduledMessageSent,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, scheduledMessageID, sentAt)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot mark scheduled message sent(scheduledMessageID: %d)", scheduledMessageID)
+	}
+
+	return nil
+}
+
+// RecordScheduledMessageFailure records a failed attempt to send the scheduled message.
+func (p *schedulePGRepo) RecordScheduledMessageFailure(
+	ctx context.Context,
+	params model.RecordScheduledMessageFailureParams,
+) (err error) {
+	logger.FromContext(ctx).Debug("schedulePGRepo.RecordScheduledMessageFailure",
+		slog.Int64("scheduled_message_id", params.ScheduledMessageID))
+
+	q := db.Query{
+		Name:     "schedulePGRepo.RecordScheduledMessageFailure",
+		QueryRaw: queryRecordScheduledMessageFailure,
+	}
+
+	_, err = p.db.DB().ExecContext(
+		ctx,
+		q,
+		params.ScheduledMessageID,
+		params.Error,
+		params.NextAttemptAt,
+		params.MaxAttempts,
+		params.FailedAt,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot record scheduled message failure(scheduledMessageID: %d)",
+			params.ScheduledMessageID)
+	}
+
+	return nil
+}
+
+// DeleteFinishedScheduledMessages deletes the scheduled messages finished before the given time.
+func (p *schedulePGRepo) DeleteFinishedScheduledMessages(ctx context.Context, before time.Time) (deleted int64, err error) {
+	logger.FromContext(ctx).Debug("schedulePGRepo.DeleteFinishedScheduledMessages", slog.Time("before", before))
+
+	q := db.Query{
+		Name:     "schedulePGRepo.DeleteFinishedScheduledMessages",
+		QueryRaw: queryDeleteFinishedScheduledMessages,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "Cannot delete finished scheduled messages")
+	}
+
+	return tag.RowsAffected(), nil
+}
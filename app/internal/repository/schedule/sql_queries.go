@@ -0,0 +1,65 @@
+package schedule
+
+const (
+	queryCreateScheduledMessage = `
+		INSERT INTO chats.scheduled_messages
+			(chat_id, sender, message_text, send_at, next_attempt_at)
+		VALUES
+			($1, $2, $3, $4, $4)
+		RETURNING id;
+	`
+
+	queryListScheduledMessages = `
+		SELECT id, chat_id, sender, message_text, send_at, attempts, created_at
+		FROM chats.scheduled_messages
+		WHERE sender = $1
+			AND status = 'pending'
+			AND ($2::bigint = 0 OR chat_id = $2)
+		ORDER BY send_at, id;
+	`
+
+	// queryCancelScheduledMessage waits for the scheduler sending the message, if any,
+	// and cancels it only if it is still pending.
+	queryCancelScheduledMessage = `
+		UPDATE chats.scheduled_messages
+		SET status = 'cancelled',
+			finished_at = $3
+		WHERE id = $1
+			AND sender = $2
+			AND status = 'pending';
+	`
+
+	queryListDueScheduledMessages = `
+		SELECT id, chat_id, sender, message_text, send_at, attempts, created_at
+		FROM chats.scheduled_messages
+		WHERE status = 'pending'
+			AND next_attempt_at <= $1
+		ORDER BY next_attempt_at, id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED;
+	`
+
+	queryMarkScheduledMessageSent = `
+		UPDATE chats.scheduled_messages
+		SET status = 'sent',
+			attempts = attempts + 1,
+			finished_at = $2
+		WHERE id = $1;
+	`
+
+	queryRecordScheduledMessageFailure = `
+		UPDATE chats.scheduled_messages
+		SET attempts = attempts + 1,
+			last_error = $2,
+			next_attempt_at = $3,
+			status = CASE WHEN attempts + 1 >= $4 THEN 'failed' ELSE status END,
+			finished_at = CASE WHEN attempts + 1 >= $4 THEN $5::timestamp ELSE finished_at END
+		WHERE id = $1
+			AND status = 'pending';
+	`
+
+	queryDeleteFinishedScheduledMessages = `
+		DELETE FROM chats.scheduled_messages
+		WHERE finished_at < $1;
+	`
+)
@@ -0,0 +1,52 @@
+package converter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+// ConvertCreateChatUpdateParamsFromServiceToRepo encodes the update created at the given time,
+// with its expiration time if ephemeral, as the JSON payload of a notification.
+func ConvertCreateChatUpdateParamsFromServiceToRepo(
+	params model.CreateChatUpdateParams,
+	createdAt time.Time,
+) (string, error) {
+	payload, err := json.Marshal(params.Payload)
+	if err != nil {
+		return "", err
+	}
+
+	update := model.ChatUpdate{
+		ChatID:    params.ChatID,
+		Type:      params.Type,
+		Sender:    params.Sender,
+		Payload:   payload,
+		CreatedAt: createdAt,
+	}
+
+	if params.TTL > 0 {
+		expiresAt := createdAt.Add(params.TTL)
+		update.ExpiresAt = &expiresAt
+	}
+
+	notification, err := json.Marshal(update)
+	if err != nil {
+		return "", err
+	}
+
+	return string(notification), nil
+}
+
+// ConvertNotificationFromRepoToService decodes the JSON payload of a notification.
+func ConvertNotificationFromRepoToService(payload string) (model.ChatUpdate, error) {
+	var update model.ChatUpdate
+
+	err := json.Unmarshal([]byte(payload), &update)
+	if err != nil {
+		return model.ChatUpdate{}, err
+	}
+
+	return update, nil
+}
@@ -0,0 +1,56 @@
+package update
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/update/converter"
+)
+
+// Channel is the PostgreSQL notification channel of the live updates of the chats.
+const Channel = "chat_updates"
+
+type updatePGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of updatePGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.ChatUpdateRepository {
+	return &updatePGRepo{
+		db: db,
+	}
+}
+
+// NotifyChatUpdate notifies the listeners of the channel of the update. PostgreSQL delivers
+// the notifications of a transaction when it commits and drops them when it rolls back,
+// the ones sent outside of a transaction immediately.
+func (p *updatePGRepo) NotifyChatUpdate(ctx context.Context, params model.CreateChatUpdateParams) (err error) {
+	logger.FromContext(ctx).Debug("updatePGRepo.NotifyChatUpdate",
+		slog.String("type", params.Type),
+		slog.Int64("chat_id", params.ChatID),
+	)
+
+	payload, err := converter.ConvertCreateChatUpdateParamsFromServiceToRepo(params, time.Now().UTC())
+	if err != nil {
+		return errors.Wrapf(err, "Cannot encode chat update(type: %s)", params.Type)
+	}
+
+	q := db.Query{
+		Name:     "updatePGRepo.NotifyChatUpdate",
+		QueryRaw: queryNotifyChatUpdate,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, Channel, payload)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot notify chat update(type: %s, chatID: %d)", params.Type, params.ChatID)
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package update
+
+const (
+	queryNotifyChatUpdate = `
+		SELECT pg_notify($1, $2);
+	`
+)
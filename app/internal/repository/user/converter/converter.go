@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/user/model"
+)
+
+// likeEscaper escapes the wildcards of the LIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ConvertUserFromRepoToService converts a stored user from the repository layer to the service layer format.
+func ConvertUserFromRepoToService(user modelRepo.User) model.User {
+	resp := model.User{
+		ID:          user.ID,
+		Email:       user.Email,
+		DisplayName: user.DisplayName,
+		AvatarURL:   user.AvatarURL,
+		StatusText:  user.StatusText,
+	}
+
+	if user.CreatedAt != nil {
+		resp.CreatedAt = *user.CreatedAt
+	}
+
+	return resp
+}
+
+// ConvertSearchQueryFromServiceToRepo converts the query of a search to the lower-cased prefix
+// matched by the repository, with the LIKE wildcards escaped.
+func ConvertSearchQueryFromServiceToRepo(query string) string {
+	return likeEscaper.Replace(strings.ToLower(query))
+}
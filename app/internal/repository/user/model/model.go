@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// User represents a stored user with their profile.
+type User struct {
+	ID          int64      `db:"id"`
+	Email       string     `db:"email"`
+	DisplayName string     `db:"display_name"`
+	AvatarURL   string     `db:"avatar_url"`
+	StatusText  string     `db:"status_text"`
+	CreatedAt   *time.Time `db:"created_at"`
+}
@@ -0,0 +1,112 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/user/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/user/model"
+)
+
+type userPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of userPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.UserRepository {
+	return &userPGRepo{
+		db: db,
+	}
+}
+
+// GetUser returns the user with the email.
+func (p *userPGRepo) GetUser(ctx context.Context, params model.GetUserParams) (user model.User, err error) {
+	logger.FromContext(ctx).Debug("userPGRepo.GetUser")
+
+	q := db.Query{
+		Name:     "userPGRepo.GetUser",
+		QueryRaw: queryGetUser,
+	}
+
+	var userRepo modelRepo.User
+
+	err = p.db.DB().ScanOneContext(ctx, &userRepo, q, params.Email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.User{}, errors.Wrap(model.ErrNotFound, "user")
+		}
+
+		return model.User{}, errors.Wrap(err, "Cannot get user")
+	}
+
+	return converter.ConvertUserFromRepoToService(userRepo), nil
+}
+
+// UpdateProfile updates the non-nil fields of the profile of the user and returns the user.
+func (p *userPGRepo) UpdateProfile(ctx context.Context, params model.UpdateProfileParams) (user model.User, err error) {
+	logger.FromContext(ctx).Debug("userPGRepo.UpdateProfile")
+
+	q := db.Query{
+		Name:     "userPGRepo.UpdateProfile",
+		QueryRaw: queryUpdateProfile,
+	}
+
+	var userRepo modelRepo.User
+
+	err = p.db.DB().ScanOneContext(
+		ctx,
+		&userRepo,
+		q,
+		params.Email,
+		params.DisplayName,
+		params.AvatarURL,
+		params.StatusText,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.User{}, errors.Wrap(model.ErrNotFound, "user")
+		}
+
+		return model.User{}, errors.Wrap(err, "Cannot update profile")
+	}
+
+	return converter.ConvertUserFromRepoToService(userRepo), nil
+}
+
+// SearchUsers returns the users whose email or display name starts with the query, ignoring the case,
+// ordered by email.
+func (p *userPGRepo) SearchUsers(ctx context.Context, params model.SearchUsersParams) (users []model.User, err error) {
+	logger.FromContext(ctx).Debug("userPGRepo.SearchUsers", slog.Int64("limit", params.Limit))
+
+	q := db.Query{
+		Name:     "userPGRepo.SearchUsers",
+		QueryRaw: querySearchUsers,
+	}
+
+	var usersRepo []modelRepo.User
+
+	err = p.db.DB().ScanAllContext(
+		ctx,
+		&usersRepo,
+		q,
+		converter.ConvertSearchQueryFromServiceToRepo(params.Query),
+		params.Limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot search users")
+	}
+
+	users = make([]model.User, len(usersRepo))
+	for i, user := range usersRepo {
+		users[i] = converter.ConvertUserFromRepoToService(user)
+	}
+
+	return users, nil
+}
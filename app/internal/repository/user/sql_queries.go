@@ -0,0 +1,28 @@
+package user
+
+const (
+	queryGetUser = `
+		SELECT id, email, display_name, avatar_url, status_text, created_at
+		FROM chats.users
+		WHERE email = $1;
+	`
+
+	queryUpdateProfile = `
+		UPDATE chats.users
+		SET display_name = COALESCE($2, display_name),
+			avatar_url = COALESCE($3, avatar_url),
+			status_text = COALESCE($4, status_text)
+		WHERE email = $1
+		RETURNING id, email, display_name, avatar_url, status_text, created_at;
+	`
+
+	// querySearchUsers matches the escaped prefix case-insensitively, using the prefix indexes of the users.
+	querySearchUsers = `
+		SELECT id, email, display_name, avatar_url, status_text, created_at
+		FROM chats.users
+		WHERE lower(email) LIKE $1 || '%'
+			OR lower(display_name) LIKE $1 || '%'
+		ORDER BY email
+		LIMIT $2;
+	`
+)
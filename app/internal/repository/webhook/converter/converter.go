@@ -0,0 +1,87 @@
+package converter
+
+import (
+	"database/sql"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/webhook/model"
+)
+
+// ConvertCreateWebhookParamsFromServiceToRepo converts CreateWebhookParams from the service layer
+// to the repository layer format, storing a zero chat ID as NULL.
+func ConvertCreateWebhookParamsFromServiceToRepo(params model.CreateWebhookParams) modelRepo.CreateWebhookParams {
+	eventTypes := params.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	return modelRepo.CreateWebhookParams{
+		URL:        params.URL,
+		ChatID:     sql.NullInt64{Int64: params.ChatID, Valid: params.ChatID != 0},
+		EventTypes: eventTypes,
+		Secret:     params.Secret,
+	}
+}
+
+// ConvertWebhooksFromRepoToService converts stored webhooks from the repository layer
+// to the service layer format.
+func ConvertWebhooksFromRepoToService(webhooks []modelRepo.Webhook) []model.Webhook {
+	result := make([]model.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		result[i] = model.Webhook{
+			ID:                  webhook.ID,
+			URL:                 webhook.URL,
+			ChatID:              webhook.ChatID.Int64,
+			EventTypes:          webhook.EventTypes,
+			Disabled:            webhook.DisabledAt != nil,
+			ConsecutiveFailures: webhook.ConsecutiveFailures,
+			CreatedAt:           webhook.CreatedAt,
+		}
+	}
+
+	return result
+}
+
+// ConvertIncomingWebhookFromRepoToService converts a stored incoming webhook from the repository layer
+// to the service layer format.
+func ConvertIncomingWebhookFromRepoToService(webhook modelRepo.IncomingWebhook) model.IncomingWebhook {
+	return model.IncomingWebhook{
+		ID:        webhook.ID,
+		ChatID:    webhook.ChatID,
+		Name:      webhook.Name,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+// ConvertIncomingWebhooksFromRepoToService converts stored incoming webhooks from the repository layer
+// to the service layer format.
+func ConvertIncomingWebhooksFromRepoToService(webhooks []modelRepo.IncomingWebhook) []model.IncomingWebhook {
+	result := make([]model.IncomingWebhook, len(webhooks))
+	for i, webhook := range webhooks {
+		result[i] = ConvertIncomingWebhookFromRepoToService(webhook)
+	}
+
+	return result
+}
+
+// ConvertWebhookDeliveriesFromRepoToService converts pending deliveries from the repository layer
+// to the service layer format.
+func ConvertWebhookDeliveriesFromRepoToService(deliveries []modelRepo.WebhookDelivery) []model.WebhookDelivery {
+	result := make([]model.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		result[i] = model.WebhookDelivery{
+			ID:             delivery.ID,
+			WebhookID:      delivery.WebhookID,
+			URL:            delivery.URL,
+			Secret:         delivery.Secret,
+			EventID:        delivery.EventID,
+			EventType:      delivery.EventType,
+			ChatID:         delivery.ChatID,
+			Payload:        delivery.Payload,
+			EventCreatedAt: delivery.EventCreatedAt,
+			Attempts:       delivery.Attempts,
+		}
+	}
+
+	return result
+}
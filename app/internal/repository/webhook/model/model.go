@@ -0,0 +1,47 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// CreateWebhookParams holds the data of a webhook to create.
+type CreateWebhookParams struct {
+	URL        string        `db:"url"`
+	ChatID     sql.NullInt64 `db:"chat_id"`
+	EventTypes []string      `db:"event_types"`
+	Secret     string        `db:"secret"`
+}
+
+// Webhook represents a stored webhook.
+type Webhook struct {
+	ID                  int64         `db:"id"`
+	URL                 string        `db:"url"`
+	ChatID              sql.NullInt64 `db:"chat_id"`
+	EventTypes          []string      `db:"event_types"`
+	DisabledAt          *time.Time    `db:"disabled_at"`
+	ConsecutiveFailures int           `db:"consecutive_failures"`
+	CreatedAt           time.Time     `db:"created_at"`
+}
+
+// IncomingWebhook represents a stored incoming webhook.
+type IncomingWebhook struct {
+	ID        int64     `db:"id"`
+	ChatID    int64     `db:"chat_id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// WebhookDelivery represents a pending delivery joined with its webhook.
+type WebhookDelivery struct {
+	ID             int64     `db:"id"`
+	WebhookID      int64     `db:"webhook_id"`
+	URL            string    `db:"url"`
+	Secret         string    `db:"secret"`
+	EventID        int64     `db:"event_id"`
+	EventType      string    `db:"event_type"`
+	ChatID         int64     `db:"chat_id"`
+	Payload        []byte    `db:"payload"`
+	EventCreatedAt time.Time `db:"event_created_at"`
+	Attempts       int       `db:"attempts"`
+}
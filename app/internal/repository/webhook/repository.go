@@ -0,0 +1,312 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/repository/webhook/converter"
+	modelRepo "github.com/Prrromanssss/chat-server/internal/repository/webhook/model"
+)
+
+type webhookPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of webhookPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.WebhookRepository {
+	return &webhookPGRepo{
+		db: db,
+	}
+}
+
+// CreateWebhook creates a webhook and returns its ID.
+func (p *webhookPGRepo) CreateWebhook(ctx context.Context, params model.CreateWebhookParams) (webhookID int64, err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.CreateWebhook", slog.Int64("chat_id", params.ChatID))
+
+	paramsRepo := converter.ConvertCreateWebhookParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "webhookPGRepo.CreateWebhook",
+		QueryRaw: queryCreateWebhook,
+	}
+
+	err = p.db.DB().ScanOneContext(
+		ctx,
+		&webhookID,
+		q,
+		paramsRepo.URL,
+		paramsRepo.ChatID,
+		paramsRepo.EventTypes,
+		paramsRepo.Secret,
+	)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot create webhook(chatID: %d)", params.ChatID)
+	}
+
+	return webhookID, nil
+}
+
+// ListWebhooks returns the webhooks of the chat, or all webhooks for a zero chat ID.
+func (p *webhookPGRepo) ListWebhooks(
+	ctx context.Context,
+	params model.ListWebhooksParams,
+) (webhooks []model.Webhook, err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.ListWebhooks", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.ListWebhooks",
+		QueryRaw: queryListWebhooks,
+	}
+
+	var webhooksRepo []modelRepo.Webhook
+
+	err = p.db.DB().ScanAllContext(ctx, &webhooksRepo, q, params.ChatID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot list webhooks(chatID: %d)", params.ChatID)
+	}
+
+	return converter.ConvertWebhooksFromRepoToService(webhooksRepo), nil
+}
+
+// DeleteWebhook removes a webhook, its deliveries are removed in cascade.
+func (p *webhookPGRepo) DeleteWebhook(ctx context.Context, params model.DeleteWebhookParams) (err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.DeleteWebhook", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.DeleteWebhook",
+		QueryRaw: queryDeleteWebhook,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, params.WebhookID)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot delete webhook(webhookID: %d)", params.WebhookID)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(model.ErrNotFound, "webhook(webhookID: %d)", params.WebhookID)
+	}
+
+	return nil
+}
+
+// CreateDeliveries schedules the delivery of the event to the webhooks subscribed to it.
+func (p *webhookPGRepo) CreateDeliveries(ctx context.Context, event model.Event) (err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.CreateDeliveries", slog.Int64("event_id", event.ID))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.CreateDeliveries",
+		QueryRaw: queryCreateDeliveries,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, event.ID, event.Type, event.ChatID, event.Payload, event.CreatedAt)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create webhook deliveries(eventID: %d)", event.ID)
+	}
+
+	return nil
+}
+
+// ClaimDueDeliveries postpones the oldest pending deliveries due at now to leaseUntil and returns them.
+func (p *webhookPGRepo) ClaimDueDeliveries(
+	ctx context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	limit int,
+) (deliveries []model.WebhookDelivery, err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.ClaimDueDeliveries", slog.Int("limit", limit))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.ClaimDueDeliveries",
+		QueryRaw: queryClaimDueDeliveries,
+	}
+
+	var deliveriesRepo []modelRepo.WebhookDelivery
+
+	err = p.db.DB().ScanAllContext(ctx, &deliveriesRepo, q, now, leaseUntil, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot claim due webhook deliveries")
+	}
+
+	return converter.ConvertWebhookDeliveriesFromRepoToService(deliveriesRepo), nil
+}
+
+// CreateDeliveryAttempt records an attempt to deliver an event, storing a zero status code as NULL.
+func (p *webhookPGRepo) CreateDeliveryAttempt(ctx context.Context, params model.CreateWebhookAttemptParams) (err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.CreateDeliveryAttempt", slog.Int64("delivery_id", params.DeliveryID))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.CreateDeliveryAttempt",
+		QueryRaw: queryCreateDeliveryAttempt,
+	}
+
+	var statusCode *int
+	if params.StatusCode != 0 {
+		statusCode = &params.StatusCode
+	}
+
+	var reason *string
+	if params.Error != "" {
+		reason = &params.Error
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, params.DeliveryID, statusCode, reason, params.Duration.Microseconds())
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create webhook delivery attempt(deliveryID: %d)", params.DeliveryID)
+	}
+
+	return nil
+}
+
+// UpdateDelivery updates the status, the attempts and the next attempt time of a delivery.
+func (p *webhookPGRepo) UpdateDelivery(ctx context.Context, params model.UpdateWebhookDeliveryParams) (err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.UpdateDelivery", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.UpdateDelivery",
+		QueryRaw: queryUpdateDelivery,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, params.DeliveryID, params.Status, params.Attempts, params.NextAttemptAt)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot update webhook delivery(deliveryID: %d)", params.DeliveryID)
+	}
+
+	return nil
+}
+
+// RecordWebhookResult updates the consecutive failures of the webhook, disabling it at the threshold.
+func (p *webhookPGRepo) RecordWebhookResult(
+	ctx context.Context,
+	params model.RecordWebhookResultParams,
+) (disabled bool, err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.RecordWebhookResult", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.RecordWebhookResult",
+		QueryRaw: queryRecordWebhookResult,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &disabled, q, params.WebhookID, params.Success, params.DisableAfter)
+	if err != nil {
+		return false, errors.Wrapf(err, "Cannot record webhook result(webhookID: %d)", params.WebhookID)
+	}
+
+	return disabled, nil
+}
+
+// DeleteFinishedDeliveries deletes the deliveries finished before the given time.
+func (p *webhookPGRepo) DeleteFinishedDeliveries(ctx context.Context, before time.Time) (deleted int64, err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.DeleteFinishedDeliveries", slog.Time("before", before))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.DeleteFinishedDeliveries",
+		QueryRaw: queryDeleteFinishedDeliveries,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "Cannot delete finished webhook deliveries")
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// CreateIncomingWebhook creates an incoming webhook and returns its ID.
+func (p *webhookPGRepo) CreateIncomingWebhook(
+	ctx context.Context,
+	params model.CreateIncomingWebhookParams,
+) (incomingWebhookID int64, err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.CreateIncomingWebhook", slog.Int64("chat_id", params.ChatID))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.CreateIncomingWebhook",
+		QueryRaw: queryCreateIncomingWebhook,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &incomingWebhookID, q, params.ChatID, params.Name, params.TokenHash)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot create incoming webhook(chatID: %d)", params.ChatID)
+	}
+
+	return incomingWebhookID, nil
+}
+
+// ListIncomingWebhooks returns the incoming webhooks of the chat, or all of them for a zero chat ID.
+func (p *webhookPGRepo) ListIncomingWebhooks(
+	ctx context.Context,
+	params model.ListIncomingWebhooksParams,
+) (webhooks []model.IncomingWebhook, err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.ListIncomingWebhooks", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.ListIncomingWebhooks",
+		QueryRaw: queryListIncomingWebhooks,
+	}
+
+	var webhooksRepo []modelRepo.IncomingWebhook
+
+	err = p.db.DB().ScanAllContext(ctx, &webhooksRepo, q, params.ChatID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot list incoming webhooks(chatID: %d)", params.ChatID)
+	}
+
+	return converter.ConvertIncomingWebhooksFromRepoToService(webhooksRepo), nil
+}
+
+// DeleteIncomingWebhook removes an incoming webhook, revoking its token.
+func (p *webhookPGRepo) DeleteIncomingWebhook(
+	ctx context.Context,
+	params model.DeleteIncomingWebhookParams,
+) (err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.DeleteIncomingWebhook", slog.Any("params", params))
+
+	q := db.Query{
+		Name:     "webhookPGRepo.DeleteIncomingWebhook",
+		QueryRaw: queryDeleteIncomingWebhook,
+	}
+
+	tag, err := p.db.DB().ExecContext(ctx, q, params.IncomingWebhookID)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot delete incoming webhook(incomingWebhookID: %d)", params.IncomingWebhookID)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(model.ErrNotFound, "incoming webhook(incomingWebhookID: %d)", params.IncomingWebhookID)
+	}
+
+	return nil
+}
+
+// GetIncomingWebhookByTokenHash returns the incoming webhook whose token has the given hash.
+func (p *webhookPGRepo) GetIncomingWebhookByTokenHash(
+	ctx context.Context,
+	tokenHash string,
+) (webhook model.IncomingWebhook, err error) {
+	logger.FromContext(ctx).Debug("webhookPGRepo.GetIncomingWebhookByTokenHash")
+
+	q := db.Query{
+		Name:     "webhookPGRepo.GetIncomingWebhookByTokenHash",
+		QueryRaw: queryGetIncomingWebhookByTokenHash,
+	}
+
+	var webhookRepo modelRepo.IncomingWebhook
+
+	err = p.db.DB().ScanOneContext(ctx, &webhookRepo, q, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.IncomingWebhook{}, errors.Wrap(model.ErrNotFound, "incoming webhook")
+		}
+
+		return model.IncomingWebhook{}, errors.Wrap(err, "Cannot get incoming webhook")
+	}
+
+	return converter.ConvertIncomingWebhookFromRepoToService(webhookRepo), nil
+}
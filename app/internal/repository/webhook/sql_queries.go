@@ -0,0 +1,119 @@
+package webhook
+
+const (
+	queryCreateWebhook = `
+		INSERT INTO chats.webhooks
+			(url, chat_id, event_types, secret)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING id;
+	`
+
+	queryListWebhooks = `
+		SELECT id, url, chat_id, event_types, disabled_at, consecutive_failures, created_at
+		FROM chats.webhooks
+		WHERE $1 = 0 OR chat_id = $1
+		ORDER BY id;
+	`
+
+	queryDeleteWebhook = `
+		DELETE FROM chats.webhooks
+		WHERE id = $1;
+	`
+
+	// queryCreateDeliveries fans the event out to the enabled webhooks of its chat or of all chats
+	// subscribed to its type.
+	queryCreateDeliveries = `
+		INSERT INTO chats.webhook_deliveries
+			(webhook_id, event_id, event_type, chat_id, payload, event_created_at)
+		SELECT id, $1::bigint, $2::varchar, $3::integer, $4::jsonb, $5::timestamp
+		FROM chats.webhooks
+		WHERE disabled_at IS NULL
+			AND (chat_id IS NULL OR chat_id = $3)
+			AND (cardinality(event_types) = 0 OR $2 = ANY(event_types))
+		ON CONFLICT (webhook_id, event_id) DO NOTHING;
+	`
+
+	// queryClaimDueDeliveries postpones the due deliveries to the end of the lease of the instance
+	// sending them, so that no other instance sends them meanwhile.
+	queryClaimDueDeliveries = `
+		WITH due AS (
+			SELECT d.id
+			FROM chats.webhook_deliveries d
+			JOIN chats.webhooks w ON w.id = d.webhook_id
+			WHERE d.status = 'pending'
+				AND d.next_attempt_at <= $1
+				AND w.disabled_at IS NULL
+			ORDER BY d.next_attempt_at, d.id
+			LIMIT $3
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		UPDATE chats.webhook_deliveries d
+		SET next_attempt_at = $2
+		FROM due, chats.webhooks w
+		WHERE d.id = due.id
+			AND w.id = d.webhook_id
+		RETURNING d.id, d.webhook_id, w.url, w.secret, d.event_id, d.event_type, d.chat_id,
+			d.payload, d.event_created_at, d.attempts;
+	`
+
+	queryCreateDeliveryAttempt = `
+		INSERT INTO chats.webhook_delivery_attempts
+			(delivery_id, status_code, error, duration_us)
+		VALUES
+			($1, $2, $3, $4);
+	`
+
+	queryUpdateDelivery = `
+		UPDATE chats.webhook_deliveries
+		SET status = $2,
+			attempts = $3,
+			next_attempt_at = $4,
+			finished_at = CASE WHEN $2 = 'pending' THEN NULL ELSE now() END
+		WHERE id = $1;
+	`
+
+	// queryRecordWebhookResult resets the failures of the webhook on success, and counts them
+	// on failure up to the threshold disabling the webhook.
+	queryRecordWebhookResult = `
+		UPDATE chats.webhooks
+		SET consecutive_failures = CASE WHEN $2 THEN 0 ELSE consecutive_failures + 1 END,
+			disabled_at = CASE
+				WHEN NOT $2 AND disabled_at IS NULL AND consecutive_failures + 1 >= $3 THEN now()
+				ELSE disabled_at
+			END
+		WHERE id = $1
+		RETURNING NOT $2 AND consecutive_failures = $3;
+	`
+
+	queryDeleteFinishedDeliveries = `
+		DELETE FROM chats.webhook_deliveries
+		WHERE finished_at < $1;
+	`
+
+	queryCreateIncomingWebhook = `
+		INSERT INTO chats.incoming_webhooks
+			(chat_id, name, token_hash)
+		VALUES
+			($1, $2, $3)
+		RETURNING id;
+	`
+
+	queryListIncomingWebhooks = `
+		SELECT id, chat_id, name, created_at
+		FROM chats.incoming_webhooks
+		WHERE $1 = 0 OR chat_id = $1
+		ORDER BY id;
+	`
+
+	queryDeleteIncomingWebhook = `
+		DELETE FROM chats.incoming_webhooks
+		WHERE id = $1;
+	`
+
+	queryGetIncomingWebhookByTokenHash = `
+		SELECT id, chat_id, name, created_at
+		FROM chats.incoming_webhooks
+		WHERE token_hash = $1;
+	`
+)
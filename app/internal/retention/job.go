@@ -0,0 +1,207 @@
+package retention
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+)
+
+const (
+	day = 24 * time.Hour
+
+	// archiveExtension is the extension of the gzipped JSON Lines files partitions are archived to.
+	archiveExtension = ".jsonl.gz"
+)
+
+// archivedEntry is a line of an archive file.
+type archivedEntry struct {
+	ID           int64           `json:"id"`
+	ActionType   string          `json:"action_type"`
+	RequestData  json.RawMessage `json:"request_data"`
+	ResponseData json.RawMessage `json:"response_data,omitempty"`
+	Caller       string          `json:"caller,omitempty"`
+	Peer         string          `json:"peer,omitempty"`
+	RequestID    string          `json:"request_id,omitempty"`
+	StatusCode   string          `json:"status_code"`
+	Duration     time.Duration   `json:"duration_ns"`
+	Timestamp    time.Time       `json:"timestamp"`
+}
+
+// Job maintains the daily partitions of the audit log: it creates the partitions of the coming days
+// and archives and drops the partitions past the retention period.
+type Job struct {
+	partitionRepository repository.LogPartitionRepository
+	txManager           db.TxManager
+	cfg                 config.AuditLogRetention
+}
+
+// NewJob creates a new instance of Job with the provided repository, transaction manager and retention settings.
+func NewJob(
+	partitionRepository repository.LogPartitionRepository,
+	txManager db.TxManager,
+	cfg config.AuditLogRetention,
+) *Job {
+	return &Job{
+		partitionRepository: partitionRepository,
+		txManager:           txManager,
+		cfg:                 cfg,
+	}
+}
+
+// Run maintains the partitions immediately and then periodically until the context is cancelled.
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.RunOnce(ctx, time.Now()); err != nil {
+			slog.Error("audit log maintenance failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce maintains the partitions as of now. It does nothing if another instance is maintaining them.
+func (j *Job) RunOnce(ctx context.Context, now time.Time) error {
+	return j.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		locked, err := j.partitionRepository.LockAPILogMaintenance(ctx)
+		if err != nil || !locked {
+			return err
+		}
+
+		partitions, err := j.partitionRepository.ListAPILogPartitions(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = j.createPartitions(ctx, partitions, now)
+		if err != nil {
+			return err
+		}
+
+		return j.expirePartitions(ctx, partitions, now)
+	})
+}
+
+// createPartitions creates the missing daily partitions from today up to PremakeDays ahead.
+func (j *Job) createPartitions(ctx context.Context, partitions []model.AuditLogPartition, now time.Time) error {
+	today := now.UTC().Truncate(day)
+	end := today.Add(time.Duration(j.cfg.PremakeDays+1) * day)
+
+	from := today
+	if len(partitions) > 0 {
+		if latest := partitions[len(partitions)-1].UpperBound; latest.After(from) {
+			from = latest
+		}
+	}
+
+	for ; from.Before(end); from = from.Add(day) {
+		err := j.partitionRepository.CreateAPILogPartition(ctx, from, from.Add(day))
+		if err != nil {
+			return err
+		}
+
+		slog.Info("audit log partition created", slog.Time("from", from))
+	}
+
+	return nil
+}
+
+// expirePartitions archives, if enabled, and drops the partitions whose entries are all past the retention period.
+func (j *Job) expirePartitions(ctx context.Context, partitions []model.AuditLogPartition, now time.Time) error {
+	if j.cfg.Period <= 0 {
+		return nil
+	}
+
+	cutoff := now.UTC().Add(-j.cfg.Period)
+
+	for _, partition := range partitions {
+		if partition.UpperBound.After(cutoff) {
+			continue
+		}
+
+		if j.cfg.ArchiveDir != "" {
+			err := j.archivePartition(ctx, partition.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := j.partitionRepository.DropAPILogPartition(ctx, partition.Name)
+		if err != nil {
+			return err
+		}
+
+		slog.Info("audit log partition dropped", slog.String("partition", partition.Name))
+	}
+
+	return nil
+}
+
+// archivePartition writes the entries of the partition to a gzipped JSON Lines file named after it.
+// The file is written under a temporary name first, so that only complete archives are left behind.
+func (j *Job) archivePartition(ctx context.Context, name string) (err error) {
+	err = os.MkdirAll(j.cfg.ArchiveDir, 0o750)
+	if err != nil {
+		return errors.Wrap(err, "Cannot create audit log archive directory")
+	}
+
+	path := filepath.Join(j.cfg.ArchiveDir, name+archiveExtension)
+
+	file, err := os.CreateTemp(j.cfg.ArchiveDir, name+"-*.tmp")
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create archive of partition(name: %s)", name)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	gz := gzip.NewWriter(file)
+	encoder := json.NewEncoder(gz)
+
+	err = j.partitionRepository.ExportAPILogPartition(ctx, name, func(entry model.AuditLogEntry) error {
+		return encoder.Encode(archivedEntry(entry))
+	})
+	if err != nil {
+		return err
+	}
+
+	err = gz.Close()
+	if err != nil {
+		return errors.Wrapf(err, "Cannot write archive of partition(name: %s)", name)
+	}
+
+	err = file.Close()
+	if err != nil {
+		return errors.Wrapf(err, "Cannot write archive of partition(name: %s)", name)
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot write archive of partition(name: %s)", name)
+	}
+
+	slog.Info("audit log partition archived", slog.String("partition", name), slog.String("path", path))
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	"github.com/Prrromanssss/chat-server/internal/retention"
+)
+
+const day = 24 * time.Hour
+
+func txManagerMock(mc *minimock.Controller) db.TxManager {
+	mock := dbMocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	return mock
+}
+
+func TestRunOnce(t *testing.T) {
+	t.Parallel()
+
+	type partitionRepositoryMockFunc func(mc *minimock.Controller) repository.LogPartitionRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		now   = time.Date(2024, time.August, 10, 15, 30, 0, 0, time.UTC)
+		today = time.Date(2024, time.August, 10, 0, 0, 0, 0, time.UTC)
+
+		ErrRepository = errors.New("partition repository error")
+
+		partitions = []model.AuditLogPartition{
+			{Name: "api_chat_log_legacy", UpperBound: today.Add(-3 * day)},
+			{Name: "api_chat_log_p20240807", UpperBound: today.Add(-2 * day)},
+			{Name: "api_chat_log_p20240808", UpperBound: today.Add(-day)},
+			{Name: "api_chat_log_p20240809", UpperBound: today},
+			{Name: "api_chat_log_p20240810", UpperBound: today.Add(day)},
+		}
+	)
+
+	tests := []struct {
+		name                    string
+		cfg                     config.AuditLogRetention
+		err                     error
+		partitionRepositoryMock partitionRepositoryMockFunc
+	}{
+		{
+			name: "creates coming partitions and drops expired ones",
+			cfg:  config.AuditLogRetention{Period: 2 * day, PremakeDays: 2},
+			partitionRepositoryMock: func(mc *minimock.Controller) repository.LogPartitionRepository {
+				mock := repositoryMocks.NewLogPartitionRepositoryMock(mc)
+				mock.LockAPILogMaintenanceMock.Return(true, nil)
+				mock.ListAPILogPartitionsMock.Return(partitions, nil)
+				mock.CreateAPILogPartitionMock.When(minimock.AnyContext, today.Add(day), today.Add(2*day)).Then(nil)
+				mock.CreateAPILogPartitionMock.When(minimock.AnyContext, today.Add(2*day), today.Add(3*day)).Then(nil)
+				mock.DropAPILogPartitionMock.When(minimock.AnyContext, "api_chat_log_legacy").Then(nil)
+				mock.DropAPILogPartitionMock.When(minimock.AnyContext, "api_chat_log_p20240807").Then(nil)
+
+				return mock
+			},
+		},
+		{
+			name: "keeps partitions forever without retention period",
+			cfg:  config.AuditLogRetention{PremakeDays: 0},
+			partitionRepositoryMock: func(mc *minimock.Controller) repository.LogPartitionRepository {
+				mock := repositoryMocks.NewLogPartitionRepositoryMock(mc)
+				mock.LockAPILogMaintenanceMock.Return(true, nil)
+				mock.ListAPILogPartitionsMock.Return(partitions, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "creates today partition on empty table",
+			cfg:  config.AuditLogRetention{Period: day},
+			partitionRepositoryMock: func(mc *minimock.Controller) repository.LogPartitionRepository {
+				mock := repositoryMocks.NewLogPartitionRepositoryMock(mc)
+				mock.LockAPILogMaintenanceMock.Return(true, nil)
+				mock.ListAPILogPartitionsMock.Return(nil, nil)
+				mock.CreateAPILogPartitionMock.Expect(minimock.AnyContext, today, today.Add(day)).Return(nil)
+
+				return mock
+			},
+		},
+		{
+			name: "skips when another instance holds the lock",
+			cfg:  config.AuditLogRetention{Period: day},
+			partitionRepositoryMock: func(mc *minimock.Controller) repository.LogPartitionRepository {
+				mock := repositoryMocks.NewLogPartitionRepositoryMock(mc)
+				mock.LockAPILogMaintenanceMock.Return(false, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "partition repository error",
+			cfg:  config.AuditLogRetention{Period: day},
+			err:  ErrRepository,
+			partitionRepositoryMock: func(mc *minimock.Controller) repository.LogPartitionRepository {
+				mock := repositoryMocks.NewLogPartitionRepositoryMock(mc)
+				mock.LockAPILogMaintenanceMock.Return(true, nil)
+				mock.ListAPILogPartitionsMock.Return(nil, ErrRepository)
+
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			job := retention.NewJob(tt.partitionRepositoryMock(mc), txManagerMock(mc), tt.cfg)
+
+			err := job.RunOnce(ctx, now)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestRunOnceArchives(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+		dir = t.TempDir()
+
+		now       = time.Date(2024, time.August, 10, 15, 30, 0, 0, time.UTC)
+		partition = model.AuditLogPartition{Name: "api_chat_log_p20240801", UpperBound: now.Add(-7 * day)}
+
+		entries = []model.AuditLogEntry{
+			{ID: 1, ActionType: "Create", RequestData: []byte(`{"Emails":["a***@example.com"]}`), ResponseData: []byte(`{"chat_id":1}`)},
+			{ID: 2, ActionType: "Delete", RequestData: []byte(`{"chat_id":1}`)},
+		}
+	)
+
+	partitionRepositoryMock := repositoryMocks.NewLogPartitionRepositoryMock(mc)
+	partitionRepositoryMock.LockAPILogMaintenanceMock.Return(true, nil)
+	partitionRepositoryMock.ListAPILogPartitionsMock.Return([]model.AuditLogPartition{
+		partition,
+		{Name: "api_chat_log_p20240820", UpperBound: now.Add(10 * day)},
+	}, nil)
+	partitionRepositoryMock.ExportAPILogPartitionMock.Set(
+		func(_ context.Context, name string, fn func(entry model.AuditLogEntry) error) error {
+			require.Equal(t, partition.Name, name)
+
+			for _, entry := range entries {
+				if err := fn(entry); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+	partitionRepositoryMock.DropAPILogPartitionMock.Expect(minimock.AnyContext, partition.Name).Return(nil)
+
+	job := retention.NewJob(partitionRepositoryMock, txManagerMock(mc), config.AuditLogRetention{
+		Period:     3 * day,
+		ArchiveDir: dir,
+	})
+
+	require.NoError(t, job.RunOnce(ctx, now))
+
+	file, err := os.Open(filepath.Join(dir, partition.Name+".jsonl.gz"))
+	require.NoError(t, err)
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	require.NoError(t, err)
+
+	var lines []map[string]interface{}
+
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		line := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, lines, 2)
+	require.Equal(t, "Create", lines[0]["action_type"])
+	require.Equal(t, map[string]interface{}{"chat_id": float64(1)}, lines[0]["response_data"])
+	require.NotContains(t, lines[1], "response_data")
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
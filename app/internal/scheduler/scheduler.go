@@ -0,0 +1,160 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+)
+
+// Scheduler sends the due scheduled messages through the chat service, as if their senders sent them.
+// Each message is locked, posted and marked sent in a single transaction, which the chat service joins,
+// so a message is sent exactly once: either everything commits or nothing does. The command the message
+// starts with, if any, runs once the transaction commits, so that its side effects are not repeated
+// by a retry. Several instances share the due messages, skipping the ones locked by each other. A message
+// failing to send is retried after RetryInterval until MaxAttempts.
+type Scheduler struct {
+	scheduledMessageRepository repository.ScheduledMessageRepository
+	txManager                  db.TxManager
+	chatService                service.ChatService
+	cfg                        config.Scheduler
+}
+
+// NewScheduler creates a new instance of Scheduler with the provided repository, transaction manager,
+// chat service and settings.
+func NewScheduler(
+	scheduledMessageRepository repository.ScheduledMessageRepository,
+	txManager db.TxManager,
+	chatService service.ChatService,
+	cfg config.Scheduler,
+) *Scheduler {
+	return &Scheduler{
+		scheduledMessageRepository: scheduledMessageRepository,
+		txManager:                  txManager,
+		chatService:                chatService,
+		cfg:                        cfg,
+	}
+}
+
+// Run sends the due messages immediately and then periodically until the context is cancelled.
+// A full batch is followed by the next one without waiting, to catch up with a backlog.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		processed, err := s.RunOnce(ctx, time.Now())
+		if err != nil {
+			slog.Error("scheduled messages delivery failed", slog.String("error", err.Error()))
+		}
+
+		if err == nil && processed == s.cfg.BatchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce sends at most a batch of messages due at now and deletes the messages finished before
+// the retention period. It returns the number of messages processed, whether sent or not.
+func (s *Scheduler) RunOnce(ctx context.Context, now time.Time) (processed int, err error) {
+	now = now.UTC()
+
+	for processed < s.cfg.BatchSize && ctx.Err() == nil {
+		found, err := s.sendNext(ctx, now)
+		if err != nil {
+			return processed, err
+		}
+
+		if !found {
+			break
+		}
+
+		processed++
+	}
+
+	if s.cfg.Retention > 0 {
+		_, err = s.scheduledMessageRepository.DeleteFinishedScheduledMessages(ctx, now.Add(-s.cfg.Retention))
+		if err != nil {
+			return processed, err
+		}
+	}
+
+	return processed, nil
+}
+
+// sendNext sends the next due message and reports whether there was one. A failure to send the message
+// is recorded against it rather than returned.
+func (s *Scheduler) sendNext(ctx context.Context, now time.Time) (found bool, err error) {
+	var (
+		message model.ScheduledMessage
+		params  model.SendMessageParams
+		sendErr error
+	)
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		messages, txErr := s.scheduledMessageRepository.ListDueScheduledMessages(ctx, now, 1)
+		if txErr != nil {
+			return txErr
+		}
+
+		if len(messages) == 0 {
+			return nil
+		}
+
+		message = messages[0]
+		found = true
+
+		params = model.SendMessageParams{
+			ChatID: message.ChatID,
+			From:   message.From,
+			Text:   message.Text,
+			SentAt: now,
+		}
+
+		sendErr = s.chatService.PostMessage(ctx, params)
+		if sendErr != nil {
+			return sendErr
+		}
+
+		return s.scheduledMessageRepository.MarkScheduledMessageSent(ctx, message.ID, now)
+	})
+	if sendErr == nil {
+		if err == nil && found {
+			s.chatService.RunCommand(ctx, params)
+		}
+
+		return found, err
+	}
+
+	slog.Warn("scheduled message not sent",
+		slog.Int64("scheduled_message_id", message.ID),
+		slog.Int64("chat_id", message.ChatID),
+		slog.String("error", sendErr.Error()),
+	)
+
+	err = s.scheduledMessageRepository.RecordScheduledMessageFailure(ctx, model.RecordScheduledMessageFailureParams{
+		ScheduledMessageID: message.ID,
+		Error:              sendErr.Error(),
+		FailedAt:           now,
+		NextAttemptAt:      now.Add(s.cfg.RetryInterval),
+		MaxAttempts:        s.cfg.MaxAttempts,
+	})
+	if err != nil {
+		return true, errors.Wrapf(err, "Cannot record failure of scheduled message(scheduledMessageID: %d)", message.ID)
+	}
+
+	return true, nil
+}
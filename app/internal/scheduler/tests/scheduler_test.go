@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	"github.com/Prrromanssss/chat-server/internal/scheduler"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	serviceMocks "github.com/Prrromanssss/chat-server/internal/service/mocks"
+)
+
+func TestSchedulerRunOnce(t *testing.T) {
+	t.Parallel()
+
+	type (
+		scheduledMessageRepositoryMockFunc func(mc *minimock.Controller) repository.ScheduledMessageRepository
+		chatServiceMockFunc                func(mc *minimock.Controller) service.ChatService
+	)
+
+	var (
+		ctx = context.Background()
+		now = time.Date(2024, time.October, 20, 13, 0, 0, 0, time.UTC)
+		cfg = config.Scheduler{
+			BatchSize:     10,
+			RetryInterval: 30 * time.Second,
+			MaxAttempts:   5,
+			Retention:     time.Hour,
+		}
+
+		ErrChatService = errors.New("chat service error")
+
+		message = model.ScheduledMessage{
+			ID:     3,
+			ChatID: 7,
+			From:   "alice@example.com",
+			Text:   "Standup in 5 minutes",
+			SendAt: now.Add(-time.Second),
+		}
+
+		sendParams = model.SendMessageParams{
+			ChatID: message.ChatID,
+			From:   message.From,
+			Text:   message.Text,
+			SentAt: now,
+		}
+
+		// due returns the repository handing out the given messages one at a time, then none.
+		due = func(
+			messages []model.ScheduledMessage,
+			expect func(mock *repositoryMocks.ScheduledMessageRepositoryMock),
+		) scheduledMessageRepositoryMockFunc {
+			return func(mc *minimock.Controller) repository.ScheduledMessageRepository {
+				mock := repositoryMocks.NewScheduledMessageRepositoryMock(mc)
+
+				calls := 0
+				mock.ListDueScheduledMessagesMock.Set(func(_ context.Context, at time.Time, limit int) (
+					[]model.ScheduledMessage, error,
+				) {
+					require.Equal(t, now, at)
+					require.Equal(t, 1, limit)
+
+					calls++
+					if calls > len(messages) {
+						return nil, nil
+					}
+
+					return messages[calls-1 : calls], nil
+				})
+				mock.DeleteFinishedScheduledMessagesMock.Expect(minimock.AnyContext, now.Add(-cfg.Retention)).
+					Return(0, nil)
+
+				expect(mock)
+
+				return mock
+			}
+		}
+	)
+
+	tests := []struct {
+		name                           string
+		processed                      int
+		scheduledMessageRepositoryMock scheduledMessageRepositoryMockFunc
+		chatServiceMock                chatServiceMockFunc
+	}{
+		{
+			name:      "nothing due",
+			processed: 0,
+			scheduledMessageRepositoryMock: due(nil, func(*repositoryMocks.ScheduledMessageRepositoryMock) {
+			}),
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name:      "due message sent and marked sent",
+			processed: 1,
+			scheduledMessageRepositoryMock: due(
+				[]model.ScheduledMessage{message},
+				func(mock *repositoryMocks.ScheduledMessageRepositoryMock) {
+					mock.MarkScheduledMessageSentMock.Expect(minimock.AnyContext, message.ID, now).Return(nil)
+				},
+			),
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.PostMessageMock.Expect(minimock.AnyContext, sendParams).Return(nil)
+				mock.RunCommandMock.Expect(minimock.AnyContext, sendParams).Return()
+
+				return mock
+			},
+		},
+		{
+			name:      "failure recorded for a retry",
+			processed: 1,
+			scheduledMessageRepositoryMock: due(
+				[]model.ScheduledMessage{message},
+				func(mock *repositoryMocks.ScheduledMessageRepositoryMock) {
+					mock.RecordScheduledMessageFailureMock.Expect(
+						minimock.AnyContext,
+						model.RecordScheduledMessageFailureParams{
+							ScheduledMessageID: message.ID,
+							Error:              ErrChatService.Error(),
+							FailedAt:           now,
+							NextAttemptAt:      now.Add(cfg.RetryInterval),
+							MaxAttempts:        cfg.MaxAttempts,
+						},
+					).Return(nil)
+				},
+			),
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.PostMessageMock.Expect(minimock.AnyContext, sendParams).Return(ErrChatService)
+
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			s := scheduler.NewScheduler(tt.scheduledMessageRepositoryMock(mc), txManagerMock, tt.chatServiceMock(mc), cfg)
+
+			processed, err := s.RunOnce(ctx, now)
+			require.NoError(t, err)
+			require.Equal(t, tt.processed, processed)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
+)
+
+// Bounds of the number of entries returned in a page.
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+type auditService struct {
+	logRepository repository.LogRepository
+}
+
+// NewService creates a new instance of auditService with the provided LogRepository.
+func NewService(logRepository repository.LogRepository) service.AuditService {
+	return &auditService{
+		logRepository: logRepository,
+	}
+}
+
+// ListAuditLog returns a page of the audit log. One more entry than requested is fetched
+// to tell whether a next page exists without counting the matching entries.
+func (s *auditService) ListAuditLog(
+	ctx context.Context,
+	params model.ListAuditLogParams,
+) (resp model.ListAuditLogResponse, err error) {
+	logger.FromContext(ctx).Debug("auditService.ListAuditLog", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "auditService.ListAuditLog")
+	defer func() { tracing.End(span, err) }()
+
+	pageSize := params.PageSize
+	switch {
+	case pageSize <= 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	params.PageSize = pageSize + 1
+
+	entries, err := s.logRepository.ListAPILogs(ctx, params)
+	if err != nil {
+		return model.ListAuditLogResponse{}, err
+	}
+
+	resp.Entries = entries
+
+	if len(entries) > pageSize {
+		resp.Entries = entries[:pageSize]
+
+		last := resp.Entries[pageSize-1]
+		resp.NextCursor = &model.AuditLogCursor{
+			Timestamp: last.Timestamp,
+			ID:        last.ID,
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	auditService "github.com/Prrromanssss/chat-server/internal/service/audit"
+)
+
+func TestListAuditLog(t *testing.T) {
+	t.Parallel()
+
+	type logRepositoryMockFunc func(mc *minimock.Controller) repository.LogRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		chatID = gofakeit.Int64()
+		now    = time.Now().UTC()
+
+		ErrLogRepository = errors.New("log repository error")
+
+		entries = []model.AuditLogEntry{
+			{ID: 3, ActionType: "Delete", RequestData: []byte(`{"chat_id":1}`), Timestamp: now},
+			{ID: 2, ActionType: "Delete", RequestData: []byte(`{"chat_id":1}`), Timestamp: now.Add(-time.Second)},
+			{ID: 1, ActionType: "Delete", RequestData: []byte(`{"chat_id":1}`), Timestamp: now.Add(-time.Minute)},
+		}
+	)
+
+	tests := []struct {
+		name              string
+		req               model.ListAuditLogParams
+		want              model.ListAuditLogResponse
+		err               error
+		logRepositoryMock logRepositoryMockFunc
+	}{
+		{
+			name: "next page exists",
+			req:  model.ListAuditLogParams{ChatID: chatID, PageSize: 2},
+			want: model.ListAuditLogResponse{
+				Entries:    entries[:2],
+				NextCursor: &model.AuditLogCursor{Timestamp: entries[1].Timestamp, ID: entries[1].ID},
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.ListAPILogsMock.Expect(minimock.AnyContext, model.ListAuditLogParams{ChatID: chatID, PageSize: 3}).
+					Return(entries, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "last page with default page size",
+			req:  model.ListAuditLogParams{ChatID: chatID},
+			want: model.ListAuditLogResponse{Entries: entries},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.ListAPILogsMock.Expect(minimock.AnyContext, model.ListAuditLogParams{
+					ChatID:   chatID,
+					PageSize: auditService.DefaultPageSize + 1,
+				}).Return(entries, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "page size is capped",
+			req:  model.ListAuditLogParams{PageSize: 10000},
+			want: model.ListAuditLogResponse{Entries: []model.AuditLogEntry{}},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.ListAPILogsMock.Expect(minimock.AnyContext, model.ListAuditLogParams{
+					PageSize: auditService.MaxPageSize + 1,
+				}).Return([]model.AuditLogEntry{}, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "log repository error",
+			req:  model.ListAuditLogParams{PageSize: 2},
+			err:  ErrLogRepository,
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.ListAPILogsMock.Expect(minimock.AnyContext, model.ListAuditLogParams{PageSize: 3}).
+					Return(nil, ErrLogRepository)
+
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := auditService.NewService(tt.logRepositoryMock(mc))
+
+			resp, err := service.ListAuditLog(ctx, tt.req)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
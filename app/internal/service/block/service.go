@@ -0,0 +1,53 @@
+package block
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+)
+
+type blockService struct {
+	blockRepository repository.BlockRepository
+}
+
+// NewService creates a new instance of blockService with the provided BlockRepository.
+func NewService(blockRepository repository.BlockRepository) service.BlockService {
+	return &blockService{
+		blockRepository: blockRepository,
+	}
+}
+
+// BlockUser blocks the user. The block applies to the direct chats, the history and the live updates,
+// the chats shared with the blocked user are left as they are.
+func (s *blockService) BlockUser(ctx context.Context, params model.BlockUserParams) (err error) {
+	logger.FromContext(ctx).Debug("blockService.BlockUser", slog.Any("params", params))
+
+	if params.From == params.Email {
+		return errors.Wrap(model.ErrInvalidArgument, "users cannot block themselves")
+	}
+
+	return s.blockRepository.BlockUser(ctx, params)
+}
+
+// UnblockUser lifts the block of the user, if any.
+func (s *blockService) UnblockUser(ctx context.Context, params model.UnblockUserParams) (err error) {
+	logger.FromContext(ctx).Debug("blockService.UnblockUser", slog.Any("params", params))
+
+	return s.blockRepository.UnblockUser(ctx, params)
+}
+
+// ListBlocked returns the users blocked by the user.
+func (s *blockService) ListBlocked(
+	ctx context.Context,
+	params model.ListBlockedParams,
+) (users []model.BlockedUser, err error) {
+	logger.FromContext(ctx).Debug("blockService.ListBlocked", slog.Any("params", params))
+
+	return s.blockRepository.ListBlocked(ctx, params)
+}
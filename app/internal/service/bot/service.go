@@ -0,0 +1,96 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	"github.com/Prrromanssss/chat-server/internal/token"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
+)
+
+const (
+	// tokenSize is the number of random bytes of an API token.
+	tokenSize = 32
+	// secretSize is the number of random bytes of the signing secret of the commands.
+	secretSize = 32
+)
+
+type botService struct {
+	botRepository repository.BotRepository
+}
+
+// NewService creates a new instance of botService with the provided BotRepository.
+func NewService(botRepository repository.BotRepository) service.BotService {
+	return &botService{
+		botRepository: botRepository,
+	}
+}
+
+// CreateBot generates the API token and the signing secret of the bot and creates it, storing only
+// the token hash.
+func (s *botService) CreateBot(
+	ctx context.Context,
+	params model.CreateBotParams,
+) (resp model.CreateBotResponse, err error) {
+	logger.FromContext(ctx).Debug("botService.CreateBot", slog.String("name", params.Name))
+
+	ctx, span := tracing.Start(ctx, "botService.CreateBot")
+	defer func() { tracing.End(span, err) }()
+
+	apiToken, err := token.Generate(tokenSize)
+	if err != nil {
+		return model.CreateBotResponse{}, errors.Wrap(err, "Cannot generate bot token")
+	}
+
+	params.TokenHash = token.Hash(apiToken)
+
+	params.SigningSecret, err = token.Generate(secretSize)
+	if err != nil {
+		return model.CreateBotResponse{}, errors.Wrap(err, "Cannot generate bot signing secret")
+	}
+
+	botID, err := s.botRepository.CreateBot(ctx, params)
+	if err != nil {
+		return model.CreateBotResponse{}, err
+	}
+
+	return model.CreateBotResponse{
+		BotID:         botID,
+		Token:         apiToken,
+		SigningSecret: params.SigningSecret,
+	}, nil
+}
+
+// ListBots returns the bots with their commands.
+func (s *botService) ListBots(ctx context.Context) (bots []model.Bot, err error) {
+	logger.FromContext(ctx).Debug("botService.ListBots")
+
+	ctx, span := tracing.Start(ctx, "botService.ListBots")
+	defer func() { tracing.End(span, err) }()
+
+	return s.botRepository.ListBots(ctx)
+}
+
+// DeleteBot removes a bot and revokes its token.
+func (s *botService) DeleteBot(ctx context.Context, params model.DeleteBotParams) (err error) {
+	logger.FromContext(ctx).Debug("botService.DeleteBot", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "botService.DeleteBot")
+	defer func() { tracing.End(span, err) }()
+
+	return s.botRepository.DeleteBot(ctx, params)
+}
+
+// Authenticate returns the bot owning the API token.
+func (s *botService) Authenticate(ctx context.Context, apiToken string) (bot model.Bot, err error) {
+	ctx, span := tracing.Start(ctx, "botService.Authenticate")
+	defer func() { tracing.End(span, err) }()
+
+	return s.botRepository.GetBotByTokenHash(ctx, token.Hash(apiToken))
+}
@@ -2,43 +2,86 @@ package chat
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/Prrromanssss/platform_common/pkg/db"
-	"github.com/gofiber/fiber/v2/log"
 	"github.com/pkg/errors"
 
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/metric"
 	"github.com/Prrromanssss/chat-server/internal/model"
 	"github.com/Prrromanssss/chat-server/internal/repository"
 	"github.com/Prrromanssss/chat-server/internal/service"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
+	"github.com/Prrromanssss/chat-server/internal/userresolver"
 )
 
 type chatService struct {
-	chatRepository repository.ChatRepository
-	logRepository  repository.LogRepository
-	txManager      db.TxManager
+	chatRepository    repository.ChatRepository
+	outboxRepository  repository.OutboxRepository
+	updateRepository  repository.ChatUpdateRepository
+	blockRepository   repository.BlockRepository
+	mentionRepository repository.MentionRepository
+	txManager         db.TxManager
+	userResolver      userresolver.UserResolver
+	commandService    service.CommandService
 }
 
-// NewService creates a new instance of chatService with the provided ChatRepository and TxManager.
+// NewService creates a new instance of chatService with the provided ChatRepository,
+// OutboxRepository, ChatUpdateRepository broadcasting the messages, BlockRepository, MentionRepository,
+// TxManager, the UserResolver checking the participants of the new chats and the CommandService running
+// the slash commands, if any.
 func NewService(
 	chatRepository repository.ChatRepository,
-	logRepository repository.LogRepository,
+	outboxRepository repository.OutboxRepository,
+	updateRepository repository.ChatUpdateRepository,
+	blockRepository repository.BlockRepository,
+	mentionRepository repository.MentionRepository,
 	txManager db.TxManager,
+	userResolver userresolver.UserResolver,
+	commandService service.CommandService,
 ) service.ChatService {
 	return &chatService{
-		chatRepository: chatRepository,
-		logRepository:  logRepository,
-		txManager:      txManager,
+		chatRepository:    chatRepository,
+		outboxRepository:  outboxRepository,
+		updateRepository:  updateRepository,
+		blockRepository:   blockRepository,
+		mentionRepository: mentionRepository,
+		txManager:         txManager,
+		userResolver:      userResolver,
+		commandService:    commandService,
 	}
 }
 
-// CreateChat handles the creation of a new chat and links participants to it within a transaction.
-// It also logs the request and response data for auditing purposes.
+// CreateChat handles the creation of a new chat and links participants to it within a transaction,
+// in which the chat.created event is stored in the outbox. The participants must be known to the
+// UserResolver, otherwise model.ErrNotFound is returned. A direct chat between users blocking
+// one another is refused with model.ErrPermissionDenied.
 func (s *chatService) CreateChat(
 	ctx context.Context,
 	params model.CreateChatParams,
 ) (resp model.CreateChatResponse, err error) {
-	log.Infof("chatService.CreateChat")
+	logger.FromContext(ctx).Debug("chatService.CreateChat")
+
+	ctx, span := tracing.Start(ctx, "chatService.CreateChat")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.userResolver.Resolve(ctx, params.Emails)
+	if err != nil {
+		return model.CreateChatResponse{}, err
+	}
+
+	if len(params.Emails) == model.DirectChatParticipants {
+		blocked, err := s.blockRepository.BlockedBetween(ctx, params.Emails[0], params.Emails[1])
+		if err != nil {
+			return model.CreateChatResponse{}, err
+		}
+
+		if blocked {
+			return model.CreateChatResponse{}, errors.Wrap(model.ErrPermissionDenied, "participants block one another")
+		}
+	}
 
 	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var txErr error
@@ -48,7 +91,7 @@ func (s *chatService) CreateChat(
 			return txErr
 		}
 
-		fmt.Printf("ChatID: %d\n", resp.ChatID)
+		logger.FromContext(ctx).Debug("chat created", slog.Int64("chat_id", resp.ChatID))
 
 		// Create users for the chat and get their IDs
 		usersResp, txErr := s.chatRepository.CreateUsersForChat(ctx, model.CreateUsersForChatParams(params))
@@ -56,7 +99,7 @@ func (s *chatService) CreateChat(
 			return txErr
 		}
 
-		fmt.Printf("Users: %v", usersResp.UserIDs)
+		logger.FromContext(ctx).Debug("users created for chat", slog.Any("user_ids", usersResp.UserIDs))
 
 		// Link the created users to the new chat
 		txErr = s.chatRepository.LinkParticipantsToChat(ctx, model.LinkParticipantsToChatParams{
@@ -67,28 +110,28 @@ func (s *chatService) CreateChat(
 			return txErr
 		}
 
-		txErr = s.logRepository.CreateAPILog(ctx, model.CreateAPILogParams{
-			Method:       "Create",
-			RequestData:  params,
-			ResponseData: resp,
+		return s.outboxRepository.CreateEvent(ctx, model.CreateEventParams{
+			Type:    model.EventTypeChatCreated,
+			ChatID:  resp.ChatID,
+			Payload: model.ChatCreatedEvent{ChatID: resp.ChatID, Emails: params.Emails},
 		})
-		if txErr != nil {
-			return txErr
-		}
-
-		return nil
 	})
 	if err != nil {
 		return model.CreateChatResponse{}, errors.Wrapf(err, "Transaction failed")
 	}
 
+	metric.IncChatsCreated()
+
 	return resp, nil
 }
 
-// DeleteChat handles the deletion of a chat and unlinks participants from it within a transaction.
-// It also logs the request data for auditing purposes.
+// DeleteChat handles the deletion of a chat and unlinks participants from it within a transaction,
+// in which the chat.deleted event is stored in the outbox.
 func (s *chatService) DeleteChat(ctx context.Context, params model.DeleteChatParams) (err error) {
-	log.Infof("chatService.DeleteChat, params: %v", params)
+	logger.FromContext(ctx).Debug("chatService.DeleteChat", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "chatService.DeleteChat")
+	defer func() { tracing.End(span, err) }()
 
 	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		txErr := s.chatRepository.UnlinkParticipantsFromChat(ctx, model.UnlinkParticipantsFromChatParams(params))
@@ -101,15 +144,11 @@ func (s *chatService) DeleteChat(ctx context.Context, params model.DeleteChatPar
 			return txErr
 		}
 
-		txErr = s.logRepository.CreateAPILog(ctx, model.CreateAPILogParams{
-			Method:      "Delete",
-			RequestData: params,
+		return s.outboxRepository.CreateEvent(ctx, model.CreateEventParams{
+			Type:    model.EventTypeChatDeleted,
+			ChatID:  params.ChatID,
+			Payload: model.ChatDeletedEvent{ChatID: params.ChatID},
 		})
-		if txErr != nil {
-			return txErr
-		}
-
-		return nil
 	})
 	if err != nil {
 		err = errors.Wrapf(err, "Transaction failed")
@@ -119,31 +158,249 @@ func (s *chatService) DeleteChat(ctx context.Context, params model.DeleteChatPar
 	return nil
 }
 
-// SendMessage handles sending a message within a transaction.
-// It also logs the request data for auditing purposes.
+// SendMessage handles sending a message within a transaction,
+// in which the message.sent event is stored in the outbox.
+// A message starting with a slash command is followed by the reply of the command. The message is sent
+// whatever the outcome of the command, whose failures are only logged.
 func (s *chatService) SendMessage(ctx context.Context, params model.SendMessageParams) (err error) {
-	log.Infof("chatService.SendMessage, params: %v", params)
+	logger.FromContext(ctx).Debug("chatService.SendMessage", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "chatService.SendMessage")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.sendMessage(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	s.RunCommand(ctx, params)
+
+	return nil
+}
+
+// PostMessage sends a message like SendMessage, joining the transaction of the context if any,
+// without running its command.
+func (s *chatService) PostMessage(ctx context.Context, params model.SendMessageParams) (err error) {
+	logger.FromContext(ctx).Debug("chatService.PostMessage", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "chatService.PostMessage")
+	defer func() { tracing.End(span, err) }()
+
+	return s.sendMessage(ctx, params)
+}
+
+// RunCommand runs the slash command the sent message starts with, if any. Its failures are only logged.
+func (s *chatService) RunCommand(ctx context.Context, params model.SendMessageParams) {
+	cmd, ok := model.ParseCommand(params.Text)
+	if !ok || s.commandService == nil {
+		return
+	}
+
+	cmd.ChatID = params.ChatID
+	cmd.From = params.From
+
+	s.runCommand(ctx, cmd)
+}
+
+// runCommand dispatches the command and sends its reply to the chat. The replies are not parsed
+// for commands, so bots cannot trigger each other endlessly.
+func (s *chatService) runCommand(ctx context.Context, cmd model.Command) {
+	reply, err := s.commandService.Dispatch(ctx, cmd)
+	if err != nil {
+		logger.FromContext(ctx).Error("command dispatch failed",
+			slog.String("command", cmd.Name),
+			slog.String("error", err.Error()),
+		)
 
+		return
+	}
+
+	if reply.Text == "" {
+		return
+	}
+
+	err = s.sendMessage(ctx, model.SendMessageParams{
+		ChatID: cmd.ChatID,
+		From:   reply.From,
+		Text:   reply.Text,
+		SentAt: time.Now().UTC(),
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("command reply failed",
+			slog.String("command", cmd.Name),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// sendMessage stores the message with its message.sent event in a transaction, in which the message
+// is broadcast to the subscribers of the chat and the participants it mentions are notified.
+func (s *chatService) sendMessage(ctx context.Context, params model.SendMessageParams) (err error) {
 	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		txErr := s.chatRepository.SendMessage(ctx, params)
+		resp, txErr := s.chatRepository.SendMessage(ctx, params)
 		if txErr != nil {
 			return txErr
 		}
 
-		txErr = s.logRepository.CreateAPILog(ctx, model.CreateAPILogParams{
-			Method:      "SendMessage",
-			RequestData: params,
+		event := model.MessageSentEvent{
+			MessageID: resp.MessageID,
+			ChatID:    params.ChatID,
+			From:      params.From,
+			Text:      params.Text,
+			SentAt:    params.SentAt,
+		}
+
+		txErr = s.outboxRepository.CreateEvent(ctx, model.CreateEventParams{
+			Type:    model.EventTypeMessageSent,
+			ChatID:  params.ChatID,
+			Payload: event,
 		})
 		if txErr != nil {
 			return txErr
 		}
 
-		return nil
+		txErr = s.createMentions(ctx, event)
+		if txErr != nil {
+			return txErr
+		}
+
+		update := event
+		update.Text, update.Truncated = model.TruncateUpdateText(event.Text)
+
+		return s.updateRepository.NotifyChatUpdate(ctx, model.CreateChatUpdateParams{
+			ChatID:  params.ChatID,
+			Type:    model.UpdateTypeMessageSent,
+			Sender:  params.From,
+			Payload: update,
+		})
 	})
 	if err != nil {
 		err = errors.Wrapf(err, "Transaction failed")
 		return
 	}
 
+	metric.IncMessagesSent()
+
+	return nil
+}
+
+// createMentions stores the mentions of the participants named in the message and emits the mention.created
+// event to each of them, even if they muted the chat, unless their notification level of the chat is none.
+func (s *chatService) createMentions(ctx context.Context, message model.MessageSentEvent) error {
+	mentions := model.ParseMentions(message.Text)
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	users, err := s.mentionRepository.CreateMentions(ctx, model.CreateMentionsParams{
+		MessageID: message.MessageID,
+		ChatID:    message.ChatID,
+		From:      message.From,
+		Mentions:  mentions,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		if user.NotificationLevel == model.NotificationLevelNone {
+			continue
+		}
+
+		err = s.outboxRepository.CreateEvent(ctx, model.CreateEventParams{
+			Type:   model.EventTypeMentionCreated,
+			ChatID: message.ChatID,
+			Payload: model.MentionCreatedEvent{
+				MentionID: user.MentionID,
+				MessageID: message.MessageID,
+				ChatID:    message.ChatID,
+				From:      message.From,
+				Email:     user.Email,
+				Text:      message.Text,
+				SentAt:    message.SentAt,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// ListMessages returns a page of the history of the chat to a participant, at most ListMessagesDefaultLimit
+// messages unless another limit is set.
+func (s *chatService) ListMessages(
+	ctx context.Context,
+	params model.ListMessagesParams,
+) (messages []model.Message, err error) {
+	logger.FromContext(ctx).Debug("chatService.ListMessages", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "chatService.ListMessages")
+	defer func() { tracing.End(span, err) }()
+
+	if params.From == "" {
+		return nil, errors.Wrap(model.ErrInvalidArgument, "from is required")
+	}
+
+	participant, err := s.chatRepository.IsParticipant(ctx, params.ChatID, params.From)
+	if err != nil {
+		return nil, err
+	}
+
+	if !participant {
+		return nil, errors.Wrapf(model.ErrPermissionDenied, "not a participant of chat(chatID: %d)", params.ChatID)
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = model.ListMessagesDefaultLimit
+	}
+
+	return s.chatRepository.ListMessages(ctx, params)
+}
+
+// UpdateChatSettings updates the settings of the chat for the participant. A mute ending before now
+// removes the mute.
+func (s *chatService) UpdateChatSettings(
+	ctx context.Context,
+	params model.UpdateChatSettingsParams,
+) (settings model.ChatSettings, err error) {
+	logger.FromContext(ctx).Debug("chatService.UpdateChatSettings", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "chatService.UpdateChatSettings")
+	defer func() { tracing.End(span, err) }()
+
+	if params.MutedUntil != nil && !params.MutedUntil.After(time.Now()) {
+		params.MutedUntil = &time.Time{}
+	}
+
+	return s.chatRepository.UpdateChatSettings(ctx, params)
+}
+
+// ListChats returns the chats of the participant with their settings, pinned first.
+func (s *chatService) ListChats(ctx context.Context, params model.ListChatsParams) (chats []model.UserChat, err error) {
+	logger.FromContext(ctx).Debug("chatService.ListChats", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "chatService.ListChats")
+	defer func() { tracing.End(span, err) }()
+
+	return s.chatRepository.ListChats(ctx, params)
+}
+
+// ListMentions returns a page of the mentions of the user, at most ListMentionsDefaultLimit mentions
+// unless another limit is set.
+func (s *chatService) ListMentions(
+	ctx context.Context,
+	params model.ListMentionsParams,
+) (mentions []model.Mention, err error) {
+	logger.FromContext(ctx).Debug("chatService.ListMentions", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "chatService.ListMentions")
+	defer func() { tracing.End(span, err) }()
+
+	if params.Limit <= 0 {
+		params.Limit = model.ListMentionsDefaultLimit
+	}
+
+	return s.mentionRepository.ListMentions(ctx, params)
+}
@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/Prrromanssss/platform_common/pkg/db"
 	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
@@ -11,19 +12,22 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/Prrromanssss/chat-server/config"
 	"github.com/Prrromanssss/chat-server/internal/model"
 	"github.com/Prrromanssss/chat-server/internal/repository"
 	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
 	chatService "github.com/Prrromanssss/chat-server/internal/service/chat"
+	"github.com/Prrromanssss/chat-server/internal/userresolver"
+	"github.com/Prrromanssss/chat-server/internal/userresolver/userresolvertest"
 )
 
 func TestCreateChat(t *testing.T) {
 	t.Parallel()
 
 	type (
-		chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
-		logRepositoryMockFunc  func(mc *minimock.Controller) repository.LogRepository
-		txManagerMockFunc      func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
+		chatRepositoryMockFunc   func(mc *minimock.Controller) repository.ChatRepository
+		outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+		txManagerMockFunc        func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
 	)
 
 	type args struct {
@@ -42,8 +46,8 @@ func TestCreateChat(t *testing.T) {
 		email2  = gofakeit.Email()
 		userIDs = []int64{id1, id2}
 
-		ErrUserRepository = errors.New("user repository error")
-		ErrLogRepository  = errors.New("log repository error")
+		ErrUserRepository   = errors.New("user repository error")
+		ErrOutboxRepository = errors.New("outbox repository error")
 
 		req = model.CreateChatParams{
 			Emails: []string{email1, email2},
@@ -57,21 +61,23 @@ func TestCreateChat(t *testing.T) {
 			UserIDs: userIDs,
 		}
 
-		logApiReq = model.CreateAPILogParams{
-			Method:       "Create",
-			RequestData:  req,
-			ResponseData: resp,
+		event = model.CreateEventParams{
+			Type:    model.EventTypeChatCreated,
+			ChatID:  chatID,
+			Payload: model.ChatCreatedEvent{ChatID: chatID, Emails: req.Emails},
 		}
 	)
 
 	tests := []struct {
-		name               string
-		args               args
-		want               model.CreateChatResponse
-		err                error
-		chatRepositoryMock chatRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
-		txManagerMock      txManagerMockFunc
+		name                 string
+		args                 args
+		want                 model.CreateChatResponse
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+		txManagerMock        txManagerMockFunc
+		userResolver         userresolver.UserResolver
+		blocked              bool
 	}{
 		{
 			name: "success case",
@@ -83,19 +89,19 @@ func TestCreateChat(t *testing.T) {
 			err:  nil,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.CreateChatMock.Expect(ctx).Return(resp, nil)
-				mock.CreateUsersForChatMock.Expect(ctx, model.CreateUsersForChatParams(req)).
+				mock.CreateChatMock.Expect(minimock.AnyContext).Return(resp, nil)
+				mock.CreateUsersForChatMock.Expect(minimock.AnyContext, model.CreateUsersForChatParams(req)).
 					Return(usersResp, nil)
-				mock.LinkParticipantsToChatMock.Expect(ctx, model.LinkParticipantsToChatParams{
+				mock.LinkParticipantsToChatMock.Expect(minimock.AnyContext, model.LinkParticipantsToChatParams{
 					ChatID:  chatID,
 					UserIDs: userIDs,
 				}).Return(nil)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateEventMock.Expect(minimock.AnyContext, event).Return(nil)
 
 				return mock
 			},
@@ -118,14 +124,12 @@ func TestCreateChat(t *testing.T) {
 			err:  ErrUserRepository,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.CreateChatMock.Expect(ctx).Return(model.CreateChatResponse{}, ErrUserRepository)
+				mock.CreateChatMock.Expect(minimock.AnyContext).Return(model.CreateChatResponse{}, ErrUserRepository)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-
-				return mock
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repositoryMocks.NewOutboxRepositoryMock(mc)
 			},
 			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
 				mock := dbMocks.NewTxManagerMock(mc)
@@ -146,16 +150,14 @@ func TestCreateChat(t *testing.T) {
 			err:  ErrUserRepository,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.CreateChatMock.Expect(ctx).Return(resp, nil)
-				mock.CreateUsersForChatMock.Expect(ctx, model.CreateUsersForChatParams(req)).
+				mock.CreateChatMock.Expect(minimock.AnyContext).Return(resp, nil)
+				mock.CreateUsersForChatMock.Expect(minimock.AnyContext, model.CreateUsersForChatParams(req)).
 					Return(model.CreateUsersForChatResponse{}, ErrUserRepository)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-
-				return mock
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repositoryMocks.NewOutboxRepositoryMock(mc)
 			},
 			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
 				mock := dbMocks.NewTxManagerMock(mc)
@@ -176,20 +178,18 @@ func TestCreateChat(t *testing.T) {
 			err:  ErrUserRepository,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.CreateChatMock.Expect(ctx).Return(resp, nil)
-				mock.CreateUsersForChatMock.Expect(ctx, model.CreateUsersForChatParams(req)).
+				mock.CreateChatMock.Expect(minimock.AnyContext).Return(resp, nil)
+				mock.CreateUsersForChatMock.Expect(minimock.AnyContext, model.CreateUsersForChatParams(req)).
 					Return(usersResp, nil)
-				mock.LinkParticipantsToChatMock.Expect(ctx, model.LinkParticipantsToChatParams{
+				mock.LinkParticipantsToChatMock.Expect(minimock.AnyContext, model.LinkParticipantsToChatParams{
 					ChatID:  chatID,
 					UserIDs: userIDs,
 				}).Return(ErrUserRepository)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-
-				return mock
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repositoryMocks.NewOutboxRepositoryMock(mc)
 			},
 			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
 				mock := dbMocks.NewTxManagerMock(mc)
@@ -201,28 +201,28 @@ func TestCreateChat(t *testing.T) {
 			},
 		},
 		{
-			name: "log repository error",
+			name: "outbox repository error",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
 			want: model.CreateChatResponse{},
-			err:  ErrLogRepository,
+			err:  ErrOutboxRepository,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.CreateChatMock.Expect(ctx).Return(resp, nil)
-				mock.CreateUsersForChatMock.Expect(ctx, model.CreateUsersForChatParams(req)).
+				mock.CreateChatMock.Expect(minimock.AnyContext).Return(resp, nil)
+				mock.CreateUsersForChatMock.Expect(minimock.AnyContext, model.CreateUsersForChatParams(req)).
 					Return(usersResp, nil)
-				mock.LinkParticipantsToChatMock.Expect(ctx, model.LinkParticipantsToChatParams{
+				mock.LinkParticipantsToChatMock.Expect(minimock.AnyContext, model.LinkParticipantsToChatParams{
 					ChatID:  chatID,
 					UserIDs: userIDs,
 				}).Return(nil)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(ErrLogRepository)
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateEventMock.Expect(minimock.AnyContext, event).Return(ErrOutboxRepository)
 
 				return mock
 			},
@@ -235,6 +235,47 @@ func TestCreateChat(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "unknown participant",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.CreateChatResponse{},
+			err:  model.ErrNotFound,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repositoryMocks.NewChatRepositoryMock(mc)
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repositoryMocks.NewOutboxRepositoryMock(mc)
+			},
+			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
+				return dbMocks.NewTxManagerMock(mc)
+			},
+			userResolver: userresolver.NewGRPCResolver(
+				userresolvertest.NewUserServer(email1).Client(),
+				config.AuthUsers{Timeout: time.Second, CacheTTL: time.Minute, NegativeCacheTTL: time.Minute},
+			),
+		},
+		{
+			name: "direct chat between users blocking one another",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.CreateChatResponse{},
+			err:  model.ErrPermissionDenied,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				return repositoryMocks.NewChatRepositoryMock(mc)
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repositoryMocks.NewOutboxRepositoryMock(mc)
+			},
+			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
+				return dbMocks.NewTxManagerMock(mc)
+			},
+			blocked: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -242,7 +283,6 @@ func TestCreateChat(t *testing.T) {
 			t.Parallel()
 
 			chatRepositoryMock := tt.chatRepositoryMock(mc)
-			logRepositoryMock := tt.logRepositoryMock(mc)
 			txManagerMock := tt.txManagerMock(func(ctx context.Context) error {
 				resp, txErr := chatRepositoryMock.CreateChat(ctx)
 				if txErr != nil {
@@ -262,19 +302,27 @@ func TestCreateChat(t *testing.T) {
 					return txErr
 				}
 
-				txErr = logRepositoryMock.CreateAPILog(ctx, model.CreateAPILogParams{
-					Method:       "Create",
-					RequestData:  req,
-					ResponseData: resp,
-				})
-				if txErr != nil {
-					return txErr
-				}
-
 				return nil
 			}, mc)
 
-			service := chatService.NewService(chatRepositoryMock, logRepositoryMock, txManagerMock)
+			userResolver := tt.userResolver
+			if userResolver == nil {
+				userResolver = userresolver.NewLocalResolver()
+			}
+
+			blockRepositoryMock := repositoryMocks.NewBlockRepositoryMock(mc)
+			blockRepositoryMock.BlockedBetweenMock.Optional().Return(tt.blocked, nil)
+
+			service := chatService.NewService(
+				chatRepositoryMock,
+				tt.outboxRepositoryMock(mc),
+				nil,
+				blockRepositoryMock,
+				nil,
+				txManagerMock,
+				userResolver,
+				nil,
+			)
 
 			resp, err := service.CreateChat(tt.args.ctx, tt.args.req)
 			require.ErrorIs(t, err, tt.err)
@@ -21,9 +21,9 @@ func TestDeleteChat(t *testing.T) {
 	t.Parallel()
 
 	type (
-		chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
-		logRepositoryMockFunc  func(mc *minimock.Controller) repository.LogRepository
-		txManagerMockFunc      func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
+		chatRepositoryMockFunc   func(mc *minimock.Controller) repository.ChatRepository
+		outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+		txManagerMockFunc        func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
 	)
 
 	type args struct {
@@ -37,26 +37,27 @@ func TestDeleteChat(t *testing.T) {
 
 		id = gofakeit.Int64()
 
-		ErrUserRepository = errors.New("user repository error")
-		ErrLogRepository  = errors.New("log repository error")
+		ErrUserRepository   = errors.New("user repository error")
+		ErrOutboxRepository = errors.New("outbox repository error")
 
 		req = model.DeleteChatParams{
 			ChatID: id,
 		}
 
-		logApiReq = model.CreateAPILogParams{
-			Method:      "Delete",
-			RequestData: req,
+		event = model.CreateEventParams{
+			Type:    model.EventTypeChatDeleted,
+			ChatID:  id,
+			Payload: model.ChatDeletedEvent{ChatID: id},
 		}
 	)
 
 	tests := []struct {
-		name               string
-		args               args
-		err                error
-		chatRepositoryMock chatRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
-		txManagerMock      txManagerMockFunc
+		name                 string
+		args                 args
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+		txManagerMock        txManagerMockFunc
 	}{
 		{
 			name: "success case",
@@ -67,15 +68,15 @@ func TestDeleteChat(t *testing.T) {
 			err: nil,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.UnlinkParticipantsFromChatMock.Expect(ctx, model.UnlinkParticipantsFromChatParams(req)).
+				mock.UnlinkParticipantsFromChatMock.Expect(minimock.AnyContext, model.UnlinkParticipantsFromChatParams(req)).
 					Return(nil)
-				mock.DeleteChatMock.Expect(ctx, req).Return(nil)
+				mock.DeleteChatMock.Expect(minimock.AnyContext, req).Return(nil)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateEventMock.Expect(minimock.AnyContext, event).Return(nil)
 
 				return mock
 			},
@@ -97,16 +98,14 @@ func TestDeleteChat(t *testing.T) {
 			err: ErrUserRepository,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.UnlinkParticipantsFromChatMock.Expect(ctx, model.UnlinkParticipantsFromChatParams(req)).
+				mock.UnlinkParticipantsFromChatMock.Expect(minimock.AnyContext, model.UnlinkParticipantsFromChatParams(req)).
 					Return(nil)
-				mock.DeleteChatMock.Expect(ctx, req).Return(ErrUserRepository)
+				mock.DeleteChatMock.Expect(minimock.AnyContext, req).Return(ErrUserRepository)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-
-				return mock
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repositoryMocks.NewOutboxRepositoryMock(mc)
 			},
 			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
 				mock := dbMocks.NewTxManagerMock(mc)
@@ -126,15 +125,13 @@ func TestDeleteChat(t *testing.T) {
 			err: ErrUserRepository,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.UnlinkParticipantsFromChatMock.Expect(ctx, model.UnlinkParticipantsFromChatParams(req)).
+				mock.UnlinkParticipantsFromChatMock.Expect(minimock.AnyContext, model.UnlinkParticipantsFromChatParams(req)).
 					Return(ErrUserRepository)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-
-				return mock
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repositoryMocks.NewOutboxRepositoryMock(mc)
 			},
 			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
 				mock := dbMocks.NewTxManagerMock(mc)
@@ -146,23 +143,23 @@ func TestDeleteChat(t *testing.T) {
 			},
 		},
 		{
-			name: "log repository error",
+			name: "outbox repository error",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			err: ErrLogRepository,
+			err: ErrOutboxRepository,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.UnlinkParticipantsFromChatMock.Expect(ctx, model.UnlinkParticipantsFromChatParams(req)).
+				mock.UnlinkParticipantsFromChatMock.Expect(minimock.AnyContext, model.UnlinkParticipantsFromChatParams(req)).
 					Return(nil)
-				mock.DeleteChatMock.Expect(ctx, req).Return(nil)
+				mock.DeleteChatMock.Expect(minimock.AnyContext, req).Return(nil)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(ErrLogRepository)
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateEventMock.Expect(minimock.AnyContext, event).Return(ErrOutboxRepository)
 
 				return mock
 			},
@@ -182,25 +179,16 @@ func TestDeleteChat(t *testing.T) {
 			t.Parallel()
 
 			chatRepositoryMock := tt.chatRepositoryMock(mc)
-			logRepositoryMock := tt.logRepositoryMock(mc)
 			txManagerMock := tt.txManagerMock(func(ctx context.Context) error {
 				txErr := chatRepositoryMock.DeleteChat(ctx, req)
 				if txErr != nil {
 					return txErr
 				}
 
-				txErr = logRepositoryMock.CreateAPILog(ctx, model.CreateAPILogParams{
-					Method:      "Delete",
-					RequestData: req,
-				})
-				if txErr != nil {
-					return txErr
-				}
-
 				return nil
 			}, mc)
 
-			service := chatService.NewService(chatRepositoryMock, logRepositoryMock, txManagerMock)
+			service := chatService.NewService(chatRepositoryMock, tt.outboxRepositoryMock(mc), nil, nil, nil, txManagerMock, nil, nil)
 
 			err := service.DeleteChat(tt.args.ctx, tt.args.req)
 			require.ErrorIs(t, err, tt.err)
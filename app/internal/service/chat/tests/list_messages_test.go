@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	chatService "github.com/Prrromanssss/chat-server/internal/service/chat"
+)
+
+func TestListMessages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		chatID   = int64(7)
+		reader   = "alice@example.com"
+		outsider = "eve@example.com"
+
+		messages = []model.Message{{ID: 2, ChatID: chatID, From: "bob@example.com", Text: "hi"}}
+	)
+
+	tests := []struct {
+		name     string
+		from     string
+		expected []model.Message
+		err      error
+	}{
+		{
+			name:     "participant reads the history",
+			from:     reader,
+			expected: messages,
+		},
+		{
+			name: "non-participant is denied",
+			from: outsider,
+			err:  model.ErrPermissionDenied,
+		},
+		{
+			name: "anonymous reader is rejected",
+			err:  model.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			chatRepositoryMock := repositoryMocks.NewChatRepositoryMock(mc)
+			chatRepositoryMock.IsParticipantMock.Optional().Set(func(_ context.Context, _ int64, email string) (bool, error) {
+				return email == reader, nil
+			})
+			chatRepositoryMock.ListMessagesMock.Optional().Expect(minimock.AnyContext, model.ListMessagesParams{
+				ChatID: chatID,
+				From:   reader,
+				Limit:  model.ListMessagesDefaultLimit,
+			}).Return(messages, nil)
+
+			service := chatService.NewService(chatRepositoryMock, nil, nil, nil, nil, nil, nil, nil)
+
+			got, err := service.ListMessages(context.Background(), model.ListMessagesParams{ChatID: chatID, From: tt.from})
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	chatService "github.com/Prrromanssss/chat-server/internal/service/chat"
+	serviceMocks "github.com/Prrromanssss/chat-server/internal/service/mocks"
+)
+
+func TestSendMessageCommand(t *testing.T) {
+	t.Parallel()
+
+	type commandServiceMockFunc func(mc *minimock.Controller) service.CommandService
+
+	var (
+		ctx = context.Background()
+
+		chatID = gofakeit.Int64()
+		from   = gofakeit.Email()
+
+		req = model.SendMessageParams{
+			ChatID: chatID,
+			From:   from,
+			Text:   `/poll "Lunch?" Pizza Sushi`,
+			SentAt: gofakeit.Date(),
+		}
+
+		cmd = model.Command{
+			ChatID: chatID,
+			From:   from,
+			Name:   "poll",
+			Args:   []string{"Lunch?", "Pizza", "Sushi"},
+		}
+	)
+
+	tests := []struct {
+		name               string
+		text               string
+		sent               []string
+		commandServiceMock commandServiceMockFunc
+	}{
+		{
+			name: "reply sent after the command",
+			text: req.Text,
+			sent: []string{from, "system"},
+			commandServiceMock: func(mc *minimock.Controller) service.CommandService {
+				mock := serviceMocks.NewCommandServiceMock(mc)
+				mock.DispatchMock.Expect(minimock.AnyContext, cmd).
+					Return(model.CommandReply{From: "system", Text: "Poll by " + from}, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "empty reply",
+			text: req.Text,
+			sent: []string{from},
+			commandServiceMock: func(mc *minimock.Controller) service.CommandService {
+				mock := serviceMocks.NewCommandServiceMock(mc)
+				mock.DispatchMock.Expect(minimock.AnyContext, cmd).Return(model.CommandReply{}, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "dispatch error does not fail the message",
+			text: req.Text,
+			sent: []string{from},
+			commandServiceMock: func(mc *minimock.Controller) service.CommandService {
+				mock := serviceMocks.NewCommandServiceMock(mc)
+				mock.DispatchMock.Return(model.CommandReply{}, errors.New("bot repository error"))
+
+				return mock
+			},
+		},
+		{
+			name: "plain message",
+			text: "no /command here",
+			sent: []string{from},
+			commandServiceMock: func(mc *minimock.Controller) service.CommandService {
+				return serviceMocks.NewCommandServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			var sent []string
+
+			chatRepositoryMock := repositoryMocks.NewChatRepositoryMock(mc)
+			chatRepositoryMock.SendMessageMock.Set(
+				func(_ context.Context, params model.SendMessageParams) (model.SendMessageResponse, error) {
+					sent = append(sent, params.From)
+					return model.SendMessageResponse{MessageID: int64(len(sent))}, nil
+				},
+			)
+
+			outboxRepositoryMock := repositoryMocks.NewOutboxRepositoryMock(mc)
+			outboxRepositoryMock.CreateEventMock.Return(nil)
+
+			updateRepositoryMock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+			updateRepositoryMock.NotifyChatUpdateMock.Return(nil)
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			service := chatService.NewService(
+				chatRepositoryMock,
+				outboxRepositoryMock,
+				updateRepositoryMock,
+				nil,
+				nil,
+				txManagerMock,
+				nil,
+				tt.commandServiceMock(mc),
+			)
+
+			params := req
+			params.Text = tt.text
+
+			err := service.SendMessage(ctx, params)
+			require.NoError(t, err)
+			require.Equal(t, tt.sent, sent)
+		})
+	}
+}
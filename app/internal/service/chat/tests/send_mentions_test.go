@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	chatService "github.com/Prrromanssss/chat-server/internal/service/chat"
+)
+
+func TestSendMessageMentions(t *testing.T) {
+	t.Parallel()
+
+	const (
+		chatID    = 7
+		messageID = 42
+		from      = "alice@example.com"
+	)
+
+	tests := []struct {
+		name      string
+		text      string
+		mentions  []string
+		mentioned []model.MentionedUser
+		notified  []string
+	}{
+		{
+			name: "message without mentions",
+			text: "mail me at alice@example.com",
+		},
+		{
+			name:     "mentions by email and display name",
+			text:     "@Bob, ask @carol@example.com. Thanks @bob!",
+			mentions: []string{"bob", "carol@example.com"},
+			mentioned: []model.MentionedUser{
+				{MentionID: 1, Email: "bob@example.com", NotificationLevel: model.NotificationLevelAll},
+				{MentionID: 2, Email: "carol@example.com", NotificationLevel: model.NotificationLevelMentions},
+			},
+			notified: []string{"bob@example.com", "carol@example.com"},
+		},
+		{
+			name:     "participants not notified at the none level",
+			text:     "hey @dave and @erin",
+			mentions: []string{"dave", "erin"},
+			mentioned: []model.MentionedUser{
+				{MentionID: 3, Email: "dave@example.com", NotificationLevel: model.NotificationLevelNone},
+				{MentionID: 4, Email: "erin@example.com", NotificationLevel: model.NotificationLevelMentions},
+			},
+			notified: []string{"erin@example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			chatRepositoryMock := repositoryMocks.NewChatRepositoryMock(mc)
+			chatRepositoryMock.SendMessageMock.Return(model.SendMessageResponse{MessageID: messageID}, nil)
+
+			var notified []string
+
+			outboxRepositoryMock := repositoryMocks.NewOutboxRepositoryMock(mc)
+			outboxRepositoryMock.CreateEventMock.Set(func(_ context.Context, params model.CreateEventParams) error {
+				if event, ok := params.Payload.(model.MentionCreatedEvent); ok {
+					require.Equal(t, model.EventTypeMentionCreated, params.Type)
+					require.Equal(t, from, event.From)
+					notified = append(notified, event.Email)
+				}
+
+				return nil
+			})
+
+			updateRepositoryMock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+			updateRepositoryMock.NotifyChatUpdateMock.Return(nil)
+
+			mentionRepositoryMock := repositoryMocks.NewMentionRepositoryMock(mc)
+			if tt.mentions != nil {
+				mentionRepositoryMock.CreateMentionsMock.Expect(minimock.AnyContext, model.CreateMentionsParams{
+					MessageID: messageID,
+					ChatID:    chatID,
+					From:      from,
+					Mentions:  tt.mentions,
+				}).Return(tt.mentioned, nil)
+			}
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			service := chatService.NewService(
+				chatRepositoryMock,
+				outboxRepositoryMock,
+				updateRepositoryMock,
+				nil,
+				mentionRepositoryMock,
+				txManagerMock,
+				nil,
+				nil,
+			)
+
+			err := service.SendMessage(context.Background(), model.SendMessageParams{
+				ChatID: chatID,
+				From:   from,
+				Text:   tt.text,
+				SentAt: time.Now(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.notified, notified)
+		})
+	}
+}
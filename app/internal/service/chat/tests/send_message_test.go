@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/Prrromanssss/platform_common/pkg/db"
@@ -14,6 +15,7 @@ import (
 	"github.com/Prrromanssss/chat-server/internal/model"
 	"github.com/Prrromanssss/chat-server/internal/repository"
 	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	updateConverter "github.com/Prrromanssss/chat-server/internal/repository/update/converter"
 	chatService "github.com/Prrromanssss/chat-server/internal/service/chat"
 )
 
@@ -21,9 +23,10 @@ func TestSendMessage(t *testing.T) {
 	t.Parallel()
 
 	type (
-		chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
-		logRepositoryMockFunc  func(mc *minimock.Controller) repository.LogRepository
-		txManagerMockFunc      func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
+		chatRepositoryMockFunc   func(mc *minimock.Controller) repository.ChatRepository
+		outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+		updateRepositoryMockFunc func(mc *minimock.Controller) repository.ChatUpdateRepository
+		txManagerMockFunc        func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
 	)
 
 	type args struct {
@@ -35,32 +38,72 @@ func TestSendMessage(t *testing.T) {
 		ctx = context.Background()
 		mc  = minimock.NewController(t)
 
-		from   = gofakeit.Name()
-		text   = gofakeit.StreetName()
-		sendAt = gofakeit.Date()
+		chatID    = gofakeit.Int64()
+		messageID = gofakeit.Int64()
+		from      = gofakeit.Name()
+		text      = gofakeit.StreetName()
+		sendAt    = gofakeit.Date()
 
-		ErrUserRepository = errors.New("user repository error")
-		ErrLogRepository  = errors.New("log repository error")
+		ErrUserRepository   = errors.New("user repository error")
+		ErrOutboxRepository = errors.New("outbox repository error")
 
 		req = model.SendMessageParams{
+			ChatID: chatID,
 			From:   from,
 			Text:   text,
 			SentAt: sendAt,
 		}
 
-		logApiReq = model.CreateAPILogParams{
-			Method:      "SendMessage",
-			RequestData: req,
+		resp = model.SendMessageResponse{
+			MessageID: messageID,
+		}
+
+		sent = model.MessageSentEvent{
+			MessageID: messageID,
+			ChatID:    chatID,
+			From:      from,
+			Text:      text,
+			SentAt:    sendAt,
+		}
+
+		event = model.CreateEventParams{
+			Type:    model.EventTypeMessageSent,
+			ChatID:  chatID,
+			Payload: sent,
+		}
+
+		update = model.CreateChatUpdateParams{
+			ChatID:  chatID,
+			Type:    model.UpdateTypeMessageSent,
+			Sender:  from,
+			Payload: sent,
+		}
+
+		// Every character of the long message takes 6 bytes once encoded to JSON.
+		longReq = model.SendMessageParams{
+			ChatID: chatID,
+			From:   from,
+			Text:   strings.Repeat("<", 64<<10),
+			SentAt: sendAt,
+		}
+
+		longSent = model.MessageSentEvent{
+			MessageID: messageID,
+			ChatID:    chatID,
+			From:      from,
+			Text:      longReq.Text,
+			SentAt:    sendAt,
 		}
 	)
 
 	tests := []struct {
-		name               string
-		args               args
-		err                error
-		chatRepositoryMock chatRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
-		txManagerMock      txManagerMockFunc
+		name                 string
+		args                 args
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+		updateRepositoryMock updateRepositoryMockFunc
+		txManagerMock        txManagerMockFunc
 	}{
 		{
 			name: "success case",
@@ -71,13 +114,68 @@ func TestSendMessage(t *testing.T) {
 			err: nil,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.SendMessageMock.Expect(ctx, req).Return(nil)
+				mock.SendMessageMock.Expect(minimock.AnyContext, req).Return(resp, nil)
+
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateEventMock.Expect(minimock.AnyContext, event).Return(nil)
+
+				return mock
+			},
+			updateRepositoryMock: func(mc *minimock.Controller) repository.ChatUpdateRepository {
+				mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+				mock.NotifyChatUpdateMock.Expect(minimock.AnyContext, update).Return(nil)
+
+				return mock
+			},
+			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
+				mock := dbMocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+
+				return mock
+			},
+		},
+		{
+			name: "long message truncated in the live update",
+			args: args{
+				ctx: ctx,
+				req: longReq,
+			},
+			err: nil,
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repositoryMocks.NewChatRepositoryMock(mc)
+				mock.SendMessageMock.Expect(minimock.AnyContext, longReq).Return(resp, nil)
+
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateEventMock.Expect(minimock.AnyContext, model.CreateEventParams{
+					Type:    model.EventTypeMessageSent,
+					ChatID:  chatID,
+					Payload: longSent,
+				}).Return(nil)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
+			updateRepositoryMock: func(mc *minimock.Controller) repository.ChatUpdateRepository {
+				mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+				mock.NotifyChatUpdateMock.Set(func(_ context.Context, params model.CreateChatUpdateParams) error {
+					truncated := longSent
+					truncated.Text = longReq.Text[:model.MaxUpdateTextLength]
+					truncated.Truncated = true
+					require.Equal(t, truncated, params.Payload)
+
+					notification, err := updateConverter.ConvertCreateChatUpdateParamsFromServiceToRepo(params, sendAt)
+					require.NoError(t, err)
+					require.Less(t, len(notification), 8000)
+
+					return nil
+				})
 
 				return mock
 			},
@@ -99,14 +197,15 @@ func TestSendMessage(t *testing.T) {
 			err: ErrUserRepository,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.SendMessageMock.Expect(ctx, req).Return(ErrUserRepository)
+				mock.SendMessageMock.Expect(minimock.AnyContext, req).Return(model.SendMessageResponse{}, ErrUserRepository)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-
-				return mock
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				return repositoryMocks.NewOutboxRepositoryMock(mc)
+			},
+			updateRepositoryMock: func(mc *minimock.Controller) repository.ChatUpdateRepository {
+				return repositoryMocks.NewChatUpdateRepositoryMock(mc)
 			},
 			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
 				mock := dbMocks.NewTxManagerMock(mc)
@@ -118,24 +217,27 @@ func TestSendMessage(t *testing.T) {
 			},
 		},
 		{
-			name: "log repository error",
+			name: "outbox repository error",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			err: ErrLogRepository,
+			err: ErrOutboxRepository,
 			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
 				mock := repositoryMocks.NewChatRepositoryMock(mc)
-				mock.SendMessageMock.Expect(ctx, req).Return(nil)
+				mock.SendMessageMock.Expect(minimock.AnyContext, req).Return(resp, nil)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(ErrLogRepository)
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateEventMock.Expect(minimock.AnyContext, event).Return(ErrOutboxRepository)
 
 				return mock
 			},
+			updateRepositoryMock: func(mc *minimock.Controller) repository.ChatUpdateRepository {
+				return repositoryMocks.NewChatUpdateRepositoryMock(mc)
+			},
 			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
 				mock := dbMocks.NewTxManagerMock(mc)
 				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
@@ -152,17 +254,8 @@ func TestSendMessage(t *testing.T) {
 			t.Parallel()
 
 			chatRepositoryMock := tt.chatRepositoryMock(mc)
-			logRepositoryMock := tt.logRepositoryMock(mc)
 			txManagerMock := tt.txManagerMock(func(ctx context.Context) error {
-				txErr := chatRepositoryMock.SendMessage(ctx, req)
-				if txErr != nil {
-					return txErr
-				}
-
-				txErr = logRepositoryMock.CreateAPILog(ctx, model.CreateAPILogParams{
-					Method:      "SendMessage",
-					RequestData: req,
-				})
+				_, txErr := chatRepositoryMock.SendMessage(ctx, req)
 				if txErr != nil {
 					return txErr
 				}
@@ -170,7 +263,16 @@ func TestSendMessage(t *testing.T) {
 				return nil
 			}, mc)
 
-			service := chatService.NewService(chatRepositoryMock, logRepositoryMock, txManagerMock)
+			service := chatService.NewService(
+				chatRepositoryMock,
+				tt.outboxRepositoryMock(mc),
+				tt.updateRepositoryMock(mc),
+				nil,
+				nil,
+				txManagerMock,
+				nil,
+				nil,
+			)
 
 			err := service.SendMessage(tt.args.ctx, tt.args.req)
 			require.ErrorIs(t, err, tt.err)
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	chatService "github.com/Prrromanssss/chat-server/internal/service/chat"
+)
+
+func TestUpdateChatSettings(t *testing.T) {
+	t.Parallel()
+
+	var (
+		chatID     = int64(7)
+		from       = "alice@example.com"
+		mentions   = model.NotificationLevelMentions
+		mutedUntil = time.Now().Add(time.Hour)
+		past       = time.Now().Add(-time.Hour)
+	)
+
+	tests := []struct {
+		name       string
+		mutedUntil *time.Time
+		want       *time.Time
+		err        error
+	}{
+		{
+			name: "mute left unchanged",
+		},
+		{
+			name:       "chat muted until a future time",
+			mutedUntil: &mutedUntil,
+			want:       &mutedUntil,
+		},
+		{
+			name:       "mute in the past unmutes the chat",
+			mutedUntil: &past,
+			want:       &time.Time{},
+		},
+		{
+			name: "user not participating in the chat",
+			err:  model.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			var got model.UpdateChatSettingsParams
+
+			chatRepositoryMock := repositoryMocks.NewChatRepositoryMock(mc)
+			chatRepositoryMock.UpdateChatSettingsMock.Set(
+				func(_ context.Context, params model.UpdateChatSettingsParams) (model.ChatSettings, error) {
+					got = params
+					return model.ChatSettings{ChatID: params.ChatID}, tt.err
+				},
+			)
+
+			service := chatService.NewService(chatRepositoryMock, nil, nil, nil, nil, nil, nil, nil)
+
+			_, err := service.UpdateChatSettings(context.Background(), model.UpdateChatSettingsParams{
+				ChatID:            chatID,
+				From:              from,
+				MutedUntil:        tt.mutedUntil,
+				NotificationLevel: &mentions,
+			})
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.want, got.MutedUntil)
+			require.Equal(t, &mentions, got.NotificationLevel)
+		})
+	}
+}
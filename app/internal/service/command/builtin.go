@@ -0,0 +1,93 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+)
+
+// helpHandler returns the /help command, listing the in-process and the bot commands.
+func (s *commandService) helpHandler() Handler {
+	return Handler{
+		Name:        "help",
+		Usage:       "/help",
+		Description: "Show the available commands",
+		Handle: func(ctx context.Context, _ model.Command) (string, error) {
+			handlers := make([]Handler, 0, len(s.handlers))
+			for _, handler := range s.handlers {
+				handlers = append(handlers, handler)
+			}
+
+			sort.Slice(handlers, func(i, j int) bool { return handlers[i].Name < handlers[j].Name })
+
+			var b strings.Builder
+
+			b.WriteString("Available commands:")
+
+			for _, handler := range handlers {
+				b.WriteString("\n" + handler.Usage + " - " + handler.Description)
+			}
+
+			botCommands, err := s.botRepository.ListBotCommands(ctx)
+			if err != nil {
+				return "", errors.New("bot commands are unavailable")
+			}
+
+			for _, command := range botCommands {
+				if _, ok := s.handlers[command.Name]; ok {
+					continue
+				}
+
+				b.WriteString("\n/" + command.Name)
+				if command.Description != "" {
+					b.WriteString(" - " + command.Description)
+				}
+			}
+
+			return b.String(), nil
+		},
+	}
+}
+
+// pollHandler returns the /poll command, posting a single choice poll to the chat. The poll is the reply,
+// so the handler replies no text.
+func (s *commandService) pollHandler() Handler {
+	return Handler{
+		Name:        "poll",
+		Usage:       `/poll "question" "option" "option"...`,
+		Description: "Ask the chat a question",
+		Handle: func(ctx context.Context, cmd model.Command) (string, error) {
+			if len(cmd.Args) == 0 {
+				return "", errors.Errorf(`usage: /poll "question" "option" "option"... with %d to %d options`,
+					model.PollMinOptions, model.PollMaxOptions)
+			}
+
+			params := model.CreatePollParams{
+				ChatID:   cmd.ChatID,
+				From:     cmd.From,
+				Question: cmd.Args[0],
+				Options:  cmd.Args[1:],
+			}
+
+			err := params.Validate()
+			if err != nil {
+				return "", errors.Errorf(`usage: /poll "question" "option" "option"...: %s`, err)
+			}
+
+			_, err = s.pollService.CreatePoll(ctx, params)
+			if err != nil {
+				logger.FromContext(ctx).Error("poll command failed", slog.String("error", err.Error()))
+
+				return "", errors.New("the poll could not be created, try again later")
+			}
+
+			return "", nil
+		},
+	}
+}
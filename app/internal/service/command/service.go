@@ -0,0 +1,201 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
+	"github.com/Prrromanssss/chat-server/internal/webhook"
+)
+
+// Sender is the name the replies of the in-process handlers are sent under.
+const Sender = "system"
+
+// maxReplySize is the maximum size in bytes of the response of a bot to a command.
+const maxReplySize = 64 << 10
+
+// HandlerFunc runs a command and returns the text of the reply. The message of an error is posted
+// back as the reply, so it should tell the sender how to use the command.
+type HandlerFunc func(ctx context.Context, cmd model.Command) (string, error)
+
+// Handler is a command handled in-process. In-process handlers take precedence over the bots.
+type Handler struct {
+	Name        string
+	Usage       string
+	Description string
+	Handle      HandlerFunc
+}
+
+// botRequest is the JSON body of a command POSTed to a bot.
+type botRequest struct {
+	Command string   `json:"command"`
+	Args    []string `json:"args"`
+	ChatID  int64    `json:"chat_id"`
+	From    string   `json:"from"`
+}
+
+// botReply is the JSON body of the response of a bot, no reply is posted if it is empty.
+type botReply struct {
+	Text string `json:"text"`
+}
+
+type commandService struct {
+	chatRepository repository.ChatRepository
+	botRepository  repository.BotRepository
+	pollService    service.PollService
+	client         *http.Client
+	handlers       map[string]Handler
+}
+
+// NewService creates a new instance of commandService dispatching the commands to the built-in /help
+// and /poll, which creates polls with the PollService, to the provided in-process handlers and to the bots
+// handling them that participate in the chat, given cfg.CommandTimeout to reply.
+func NewService(
+	chatRepository repository.ChatRepository,
+	botRepository repository.BotRepository,
+	pollService service.PollService,
+	cfg config.Bots,
+	handlers ...Handler,
+) service.CommandService {
+	s := &commandService{
+		chatRepository: chatRepository,
+		botRepository:  botRepository,
+		pollService:    pollService,
+		client:         &http.Client{Timeout: cfg.CommandTimeout},
+		handlers:       make(map[string]Handler),
+	}
+
+	for _, handler := range append([]Handler{s.helpHandler(), s.pollHandler()}, handlers...) {
+		s.handlers[handler.Name] = handler
+	}
+
+	return s
+}
+
+// Dispatch runs the command with its in-process handler or the bot handling it. A command of a bot
+// that is not a participant of the chat is unknown there, so that bots only see the chats they are in.
+func (s *commandService) Dispatch(ctx context.Context, cmd model.Command) (reply model.CommandReply, err error) {
+	logger.FromContext(ctx).Debug("commandService.Dispatch", slog.Any("cmd", cmd))
+
+	ctx, span := tracing.Start(ctx, "commandService.Dispatch")
+	defer func() { tracing.End(span, err) }()
+
+	if handler, ok := s.handlers[cmd.Name]; ok {
+		text, err := handler.Handle(ctx, cmd)
+		if err != nil {
+			return model.CommandReply{From: Sender, Text: "/" + cmd.Name + ": " + err.Error()}, nil
+		}
+
+		return model.CommandReply{From: Sender, Text: text}, nil
+	}
+
+	unknown := model.CommandReply{
+		From: Sender,
+		Text: "Unknown command /" + cmd.Name + ". Send /help for the list of commands.",
+	}
+
+	bot, err := s.botRepository.GetBotByCommand(ctx, cmd.Name)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return unknown, nil
+		}
+
+		return model.CommandReply{}, err
+	}
+
+	isParticipant, err := s.chatRepository.IsParticipant(ctx, cmd.ChatID, bot.Name)
+	if err != nil {
+		return model.CommandReply{}, err
+	}
+
+	if !isParticipant {
+		return unknown, nil
+	}
+
+	text, err := s.callBot(ctx, bot, cmd)
+	if err != nil {
+		logger.FromContext(ctx).Warn("bot command failed",
+			slog.Int64("bot_id", bot.ID),
+			slog.String("command", cmd.Name),
+			slog.String("error", err.Error()),
+		)
+
+		return model.CommandReply{From: Sender, Text: "Command /" + cmd.Name + " failed, try again later."}, nil
+	}
+
+	return model.CommandReply{From: bot.Name, Text: text}, nil
+}
+
+// callBot POSTs the command to the webhook of the bot, signed like the webhook deliveries with the signing
+// secret of the bot as the key, and returns the text of the reply.
+func (s *commandService) callBot(ctx context.Context, bot model.Bot, cmd model.Command) (string, error) {
+	if bot.WebhookURL == "" {
+		return "", errors.New("bot has no webhook")
+	}
+
+	if bot.SigningSecret == "" {
+		return "", errors.New("bot has no signing secret")
+	}
+
+	body, err := json.Marshal(botRequest{
+		Command: cmd.Name,
+		Args:    cmd.Args,
+		ChatID:  cmd.ChatID,
+		From:    cmd.From,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot encode command")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, bot.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot create request")
+	}
+
+	timestamp := time.Now().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(bot.SigningSecret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return "", errors.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxReplySize))
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot read reply")
+	}
+
+	if len(bytes.TrimSpace(respBody)) == 0 {
+		return "", nil
+	}
+
+	var reply botReply
+
+	err = json.Unmarshal(respBody, &reply)
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot decode reply")
+	}
+
+	return reply.Text, nil
+}
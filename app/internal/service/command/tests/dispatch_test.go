@@ -0,0 +1,264 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	commandService "github.com/Prrromanssss/chat-server/internal/service/command"
+	serviceMocks "github.com/Prrromanssss/chat-server/internal/service/mocks"
+	"github.com/Prrromanssss/chat-server/internal/webhook"
+)
+
+func TestDispatch(t *testing.T) {
+	t.Parallel()
+
+	type chatRepositoryMockFunc func(mc *minimock.Controller) repository.ChatRepository
+	type botRepositoryMockFunc func(mc *minimock.Controller, url string) repository.BotRepository
+	type pollServiceMockFunc func(mc *minimock.Controller) service.PollService
+
+	const signingSecret = "signing-secret"
+
+	var (
+		ctx = context.Background()
+		cfg = config.Bots{CommandTimeout: time.Second}
+
+		ErrChatRepository = errors.New("chat repository error")
+		ErrBotRepository  = errors.New("bot repository error")
+		ErrPollService    = errors.New("poll service error")
+
+		bot = func(url string) model.Bot {
+			return model.Bot{ID: 1, Name: "deployer", WebhookURL: url, SigningSecret: signingSecret}
+		}
+
+		participant = func(participant bool, err error) chatRepositoryMockFunc {
+			return func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repositoryMocks.NewChatRepositoryMock(mc)
+				mock.IsParticipantMock.Expect(minimock.AnyContext, 7, "deployer").Return(participant, err)
+
+				return mock
+			}
+		}
+	)
+
+	tests := []struct {
+		name           string
+		cmd            model.Command
+		botStatus      int
+		expected       model.CommandReply
+		err            error
+		chatRepository chatRepositoryMockFunc
+		botRepository  botRepositoryMockFunc
+		pollService    pollServiceMockFunc
+	}{
+		{
+			name: "help lists the built-in and the bot commands",
+			cmd:  model.Command{Name: "help"},
+			expected: model.CommandReply{
+				From: commandService.Sender,
+				Text: "Available commands:\n" +
+					"/help - Show the available commands\n" +
+					`/poll "question" "option" "option"... - Ask the chat a question` + "\n" +
+					"/deploy - Deploy a service",
+			},
+			botRepository: func(mc *minimock.Controller, _ string) repository.BotRepository {
+				mock := repositoryMocks.NewBotRepositoryMock(mc)
+				mock.ListBotCommandsMock.Return([]model.BotCommand{{Name: "deploy", Description: "Deploy a service"}}, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "poll is posted without a reply",
+			cmd: model.Command{
+				ChatID: 7,
+				Name:   "poll",
+				From:   "alice@example.com",
+				Args:   []string{"Lunch?", "Pizza", "Sushi"},
+			},
+			expected: model.CommandReply{From: commandService.Sender},
+			botRepository: func(mc *minimock.Controller, _ string) repository.BotRepository {
+				return repositoryMocks.NewBotRepositoryMock(mc)
+			},
+			pollService: func(mc *minimock.Controller) service.PollService {
+				mock := serviceMocks.NewPollServiceMock(mc)
+				mock.CreatePollMock.Expect(minimock.AnyContext, model.CreatePollParams{
+					ChatID:   7,
+					From:     "alice@example.com",
+					Question: "Lunch?",
+					Options:  []string{"Pizza", "Sushi"},
+				}).Return(model.CreatePollResponse{PollID: 1, MessageID: 2}, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "poll usage",
+			cmd:  model.Command{Name: "poll", Args: []string{"Lunch?"}},
+			expected: model.CommandReply{
+				From: commandService.Sender,
+				Text: `/poll: usage: /poll "question" "option" "option"...: a poll has 2 to 10 options`,
+			},
+			botRepository: func(mc *minimock.Controller, _ string) repository.BotRepository {
+				return repositoryMocks.NewBotRepositoryMock(mc)
+			},
+		},
+		{
+			name: "poll failure",
+			cmd:  model.Command{Name: "poll", Args: []string{"Lunch?", "Pizza", "Sushi"}},
+			expected: model.CommandReply{
+				From: commandService.Sender,
+				Text: "/poll: the poll could not be created, try again later",
+			},
+			botRepository: func(mc *minimock.Controller, _ string) repository.BotRepository {
+				return repositoryMocks.NewBotRepositoryMock(mc)
+			},
+			pollService: func(mc *minimock.Controller) service.PollService {
+				mock := serviceMocks.NewPollServiceMock(mc)
+				mock.CreatePollMock.Return(model.CreatePollResponse{}, ErrPollService)
+
+				return mock
+			},
+		},
+		{
+			name:           "bot command",
+			cmd:            model.Command{ChatID: 7, From: "alice@example.com", Name: "deploy", Args: []string{"api"}},
+			botStatus:      http.StatusOK,
+			expected:       model.CommandReply{From: "deployer", Text: "Deploying api"},
+			chatRepository: participant(true, nil),
+			botRepository: func(mc *minimock.Controller, url string) repository.BotRepository {
+				mock := repositoryMocks.NewBotRepositoryMock(mc)
+				mock.GetBotByCommandMock.Expect(minimock.AnyContext, "deploy").Return(bot(url), nil)
+
+				return mock
+			},
+		},
+		{
+			name:           "bot failure",
+			cmd:            model.Command{ChatID: 7, Name: "deploy", Args: []string{"api"}},
+			botStatus:      http.StatusInternalServerError,
+			expected:       model.CommandReply{From: commandService.Sender, Text: "Command /deploy failed, try again later."},
+			chatRepository: participant(true, nil),
+			botRepository: func(mc *minimock.Controller, url string) repository.BotRepository {
+				mock := repositoryMocks.NewBotRepositoryMock(mc)
+				mock.GetBotByCommandMock.Return(bot(url), nil)
+
+				return mock
+			},
+		},
+		{
+			name: "unknown command",
+			cmd:  model.Command{Name: "deploy"},
+			expected: model.CommandReply{
+				From: commandService.Sender,
+				Text: "Unknown command /deploy. Send /help for the list of commands.",
+			},
+			botRepository: func(mc *minimock.Controller, _ string) repository.BotRepository {
+				mock := repositoryMocks.NewBotRepositoryMock(mc)
+				mock.GetBotByCommandMock.Return(model.Bot{}, errors.Wrap(model.ErrNotFound, "bot"))
+
+				return mock
+			},
+		},
+		{
+			name: "bot not in the chat",
+			cmd:  model.Command{ChatID: 7, Name: "deploy", Args: []string{"api"}},
+			expected: model.CommandReply{
+				From: commandService.Sender,
+				Text: "Unknown command /deploy. Send /help for the list of commands.",
+			},
+			chatRepository: participant(false, nil),
+			botRepository: func(mc *minimock.Controller, url string) repository.BotRepository {
+				mock := repositoryMocks.NewBotRepositoryMock(mc)
+				mock.GetBotByCommandMock.Return(bot(url), nil)
+
+				return mock
+			},
+		},
+		{
+			name:           "chat repository error",
+			cmd:            model.Command{ChatID: 7, Name: "deploy", Args: []string{"api"}},
+			err:            ErrChatRepository,
+			chatRepository: participant(false, ErrChatRepository),
+			botRepository: func(mc *minimock.Controller, url string) repository.BotRepository {
+				mock := repositoryMocks.NewBotRepositoryMock(mc)
+				mock.GetBotByCommandMock.Return(bot(url), nil)
+
+				return mock
+			},
+		},
+		{
+			name: "bot repository error",
+			cmd:  model.Command{Name: "deploy"},
+			err:  ErrBotRepository,
+			botRepository: func(mc *minimock.Controller, _ string) repository.BotRepository {
+				mock := repositoryMocks.NewBotRepositoryMock(mc)
+				mock.GetBotByCommandMock.Return(model.Bot{}, ErrBotRepository)
+
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				timestamp, err := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+				require.NoError(t, err)
+				require.Equal(t, webhook.Sign(signingSecret, timestamp, body), r.Header.Get(webhook.HeaderSignature))
+
+				var req struct {
+					Command string   `json:"command"`
+					Args    []string `json:"args"`
+					ChatID  int64    `json:"chat_id"`
+					From    string   `json:"from"`
+				}
+
+				require.NoError(t, json.Unmarshal(body, &req))
+				require.Equal(t, tt.cmd.Name, req.Command)
+				require.Equal(t, tt.cmd.ChatID, req.ChatID)
+
+				w.WriteHeader(tt.botStatus)
+				_, _ = w.Write([]byte(`{"text": "Deploying ` + req.Args[0] + `"}`))
+			}))
+			defer server.Close()
+
+			pollService := serviceMocks.NewPollServiceMock(mc)
+			if tt.pollService != nil {
+				pollService = tt.pollService(mc).(*serviceMocks.PollServiceMock)
+			}
+
+			chatRepository := repositoryMocks.NewChatRepositoryMock(mc)
+			if tt.chatRepository != nil {
+				chatRepository = tt.chatRepository(mc).(*repositoryMocks.ChatRepositoryMock)
+			}
+
+			service := commandService.NewService(chatRepository, tt.botRepository(mc, server.URL), pollService, cfg)
+
+			reply, err := service.Dispatch(ctx, tt.cmd)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.expected, reply)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package ephemeral
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
+)
+
+// throttleKey identifies the events throttled together: the events of a type sent by a participant to a chat.
+type throttleKey struct {
+	chatID    int64
+	from      string
+	eventType string
+}
+
+type ephemeralService struct {
+	chatRepository   repository.ChatRepository
+	updateRepository repository.ChatUpdateRepository
+	userRepository   repository.UserRepository
+	cfg              config.Ephemeral
+
+	mu        sync.Mutex
+	lastSent  map[throttleKey]time.Time
+	lastPrune time.Time
+}
+
+// NewService creates a new instance of ephemeralService checking the senders against the participants
+// with the provided ChatRepository, broadcasting the events with the provided ChatUpdateRepository,
+// throttled and expiring as configured, and naming the senders with the provided UserRepository.
+func NewService(
+	chatRepository repository.ChatRepository,
+	updateRepository repository.ChatUpdateRepository,
+	userRepository repository.UserRepository,
+	cfg config.Ephemeral,
+) service.EphemeralService {
+	return &ephemeralService{
+		chatRepository:   chatRepository,
+		updateRepository: updateRepository,
+		userRepository:   userRepository,
+		cfg:              cfg,
+		lastSent:         make(map[throttleKey]time.Time),
+	}
+}
+
+// Publish broadcasts the event of a participant of the chat unless they have sent one of its type
+// to the chat within the throttle interval. The events are throttled by each instance on its own,
+// before the sender is checked, so that a throttled event costs no query.
+func (s *ephemeralService) Publish(ctx context.Context, event model.EphemeralEvent) (published bool, err error) {
+	logger.FromContext(ctx).Debug("ephemeralService.Publish",
+		slog.String("type", event.Type),
+		slog.Int64("chat_id", event.ChatID),
+	)
+
+	ctx, span := tracing.Start(ctx, "ephemeralService.Publish")
+	defer func() { tracing.End(span, err) }()
+
+	return s.publish(ctx, event, nil)
+}
+
+// SendTypingEvent broadcasts the typing indicator of the participant with their display name, expiring
+// after the configured TTL. The participants unknown to the directory are named by their email only.
+// The display name is looked up only for the events passing the throttle.
+func (s *ephemeralService) SendTypingEvent(ctx context.Context, params model.SendTypingEventParams) (err error) {
+	logger.FromContext(ctx).Debug("ephemeralService.SendTypingEvent", slog.Int64("chat_id", params.ChatID))
+
+	ctx, span := tracing.Start(ctx, "ephemeralService.SendTypingEvent")
+	defer func() { tracing.End(span, err) }()
+
+	event := model.EphemeralEvent{
+		ChatID: params.ChatID,
+		Type:   model.UpdateTypeTyping,
+		From:   params.From,
+		TTL:    s.cfg.TypingTTL,
+	}
+
+	_, err = s.publish(ctx, event, func(ctx context.Context) (interface{}, error) {
+		typing := model.TypingEvent{From: params.From}
+
+		user, err := s.userRepository.GetUser(ctx, model.GetUserParams{Email: params.From})
+		switch {
+		case err == nil:
+			typing.DisplayName = user.DisplayName
+		case !errors.Is(err, model.ErrNotFound):
+			return nil, err
+		}
+
+		return typing, nil
+	})
+
+	return err
+}
+
+// publish broadcasts the event if it passes the throttle and its sender is a participant of the chat.
+// The payload of the event is built with payload, if provided, once both checks pass.
+func (s *ephemeralService) publish(
+	ctx context.Context,
+	event model.EphemeralEvent,
+	payload func(ctx context.Context) (interface{}, error),
+) (bool, error) {
+	key := throttleKey{chatID: event.ChatID, from: event.From, eventType: event.Type}
+	if !s.allow(key, time.Now()) {
+		return false, nil
+	}
+
+	participant, err := s.chatRepository.IsParticipant(ctx, event.ChatID, event.From)
+	if err != nil {
+		return false, err
+	}
+
+	if !participant {
+		return false, errors.Wrapf(model.ErrPermissionDenied, "not a participant of chat(chatID: %d)", event.ChatID)
+	}
+
+	if payload != nil {
+		event.Payload, err = payload(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = s.updateRepository.NotifyChatUpdate(ctx, model.CreateChatUpdateParams{
+		ChatID:  event.ChatID,
+		Type:    event.Type,
+		Sender:  event.From,
+		Payload: event.Payload,
+		TTL:     event.TTL,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// allow records the event sent at now and reports whether it passes the throttle. The records older
+// than the throttle interval are pruned at most once per interval.
+func (s *ephemeralService) allow(key throttleKey, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastPrune) >= s.cfg.Throttle {
+		for k, sentAt := range s.lastSent {
+			if now.Sub(sentAt) >= s.cfg.Throttle {
+				delete(s.lastSent, k)
+			}
+		}
+
+		s.lastPrune = now
+	}
+
+	if sentAt, ok := s.lastSent[key]; ok && now.Sub(sentAt) < s.cfg.Throttle {
+		return false
+	}
+
+	s.lastSent[key] = now
+
+	return true
+}
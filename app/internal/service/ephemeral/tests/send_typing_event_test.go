@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	ephemeralService "github.com/Prrromanssss/chat-server/internal/service/ephemeral"
+)
+
+func TestSendTypingEvent(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		cfg = config.Ephemeral{Throttle: time.Hour, TypingTTL: 5 * time.Second}
+
+		ErrUpdateRepository = errors.New("update repository error")
+
+		alice    = model.SendTypingEventParams{ChatID: 7, From: "alice@example.com"}
+		bob      = model.SendTypingEventParams{ChatID: 7, From: "bob@example.com"}
+		outsider = model.SendTypingEventParams{ChatID: 7, From: "eve@example.com"}
+
+		update = func(params model.SendTypingEventParams, displayName string) model.CreateChatUpdateParams {
+			return model.CreateChatUpdateParams{
+				ChatID:  params.ChatID,
+				Type:    model.UpdateTypeTyping,
+				Sender:  params.From,
+				Payload: model.TypingEvent{From: params.From, DisplayName: displayName},
+				TTL:     cfg.TypingTTL,
+			}
+		}
+
+		users = func(mc *minimock.Controller) *repositoryMocks.UserRepositoryMock {
+			mock := repositoryMocks.NewUserRepositoryMock(mc)
+			mock.GetUserMock.Optional().Set(func(_ context.Context, params model.GetUserParams) (model.User, error) {
+				if params.Email == alice.From {
+					return model.User{Email: alice.From, DisplayName: "Alice"}, nil
+				}
+
+				return model.User{}, model.ErrNotFound
+			})
+
+			return mock
+		}
+
+		participants = func(mc *minimock.Controller) *repositoryMocks.ChatRepositoryMock {
+			mock := repositoryMocks.NewChatRepositoryMock(mc)
+			mock.IsParticipantMock.Set(func(_ context.Context, chatID int64, email string) (bool, error) {
+				return chatID == 7 && email != outsider.From, nil
+			})
+
+			return mock
+		}
+	)
+
+	t.Run("events are throttled per participant", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+		mock.NotifyChatUpdateMock.When(minimock.AnyContext, update(alice, "Alice")).Then(nil)
+		mock.NotifyChatUpdateMock.When(minimock.AnyContext, update(bob, "")).Then(nil)
+
+		service := ephemeralService.NewService(participants(mc), mock, users(mc), cfg)
+
+		require.NoError(t, service.SendTypingEvent(ctx, alice))
+		require.NoError(t, service.SendTypingEvent(ctx, alice))
+		require.NoError(t, service.SendTypingEvent(ctx, bob))
+
+		require.Len(t, mock.NotifyChatUpdateMock.Calls(), 2)
+	})
+
+	t.Run("throttled events are not looked up", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		chatRepositoryMock := participants(mc)
+		userRepositoryMock := users(mc)
+
+		mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+		mock.NotifyChatUpdateMock.Return(nil)
+
+		service := ephemeralService.NewService(chatRepositoryMock, mock, userRepositoryMock, cfg)
+
+		require.NoError(t, service.SendTypingEvent(ctx, alice))
+		require.NoError(t, service.SendTypingEvent(ctx, alice))
+
+		require.Len(t, chatRepositoryMock.IsParticipantMock.Calls(), 1)
+		require.Len(t, userRepositoryMock.GetUserMock.Calls(), 1)
+		require.Len(t, mock.NotifyChatUpdateMock.Calls(), 1)
+	})
+
+	t.Run("events of another type are not throttled", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+		mock.NotifyChatUpdateMock.Return(nil)
+
+		service := ephemeralService.NewService(participants(mc), mock, users(mc), cfg)
+
+		require.NoError(t, service.SendTypingEvent(ctx, alice))
+
+		published, err := service.Publish(ctx, model.EphemeralEvent{ChatID: 7, Type: "reading", From: alice.From})
+		require.NoError(t, err)
+		require.True(t, published)
+	})
+
+	t.Run("events of a non-participant are denied", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+
+		service := ephemeralService.NewService(participants(mc), mock, users(mc), cfg)
+
+		require.ErrorIs(t, service.SendTypingEvent(ctx, outsider), model.ErrPermissionDenied)
+	})
+
+	t.Run("update repository error", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+		mock.NotifyChatUpdateMock.Return(ErrUpdateRepository)
+
+		service := ephemeralService.NewService(participants(mc), mock, users(mc), cfg)
+
+		require.ErrorIs(t, service.SendTypingEvent(ctx, alice), ErrUpdateRepository)
+	})
+}
@@ -2,3 +2,15 @@ package service
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i ChatService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AuditService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i WebhookService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i BotService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i CommandService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PollService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i SubscriptionService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i EphemeralService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PresenceService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i UserService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i BlockService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i PinService -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i ScheduleService -o ./mocks/ -s "_minimock.go"
@@ -0,0 +1,395 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.AuditService -o audit_service_minimock.go -n AuditServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditServiceMock implements service.AuditService
+type AuditServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListAuditLog          func(ctx context.Context, params model.ListAuditLogParams) (resp model.ListAuditLogResponse, err error)
+	inspectFuncListAuditLog   func(ctx context.Context, params model.ListAuditLogParams)
+	afterListAuditLogCounter  uint64
+	beforeListAuditLogCounter uint64
+	ListAuditLogMock          mAuditServiceMockListAuditLog
+}
+
+// NewAuditServiceMock returns a mock for service.AuditService
+func NewAuditServiceMock(t minimock.Tester) *AuditServiceMock {
+	m := &AuditServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListAuditLogMock = mAuditServiceMockListAuditLog{mock: m}
+	m.ListAuditLogMock.callArgs = []*AuditServiceMockListAuditLogParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditServiceMockListAuditLog struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockListAuditLogExpectation
+	expectations       []*AuditServiceMockListAuditLogExpectation
+
+	callArgs []*AuditServiceMockListAuditLogParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditServiceMockListAuditLogExpectation specifies expectation struct of the AuditService.ListAuditLog
+type AuditServiceMockListAuditLogExpectation struct {
+	mock      *AuditServiceMock
+	params    *AuditServiceMockListAuditLogParams
+	paramPtrs *AuditServiceMockListAuditLogParamPtrs
+	results   *AuditServiceMockListAuditLogResults
+	Counter   uint64
+}
+
+// AuditServiceMockListAuditLogParams contains parameters of the AuditService.ListAuditLog
+type AuditServiceMockListAuditLogParams struct {
+	ctx    context.Context
+	params model.ListAuditLogParams
+}
+
+// AuditServiceMockListAuditLogParamPtrs contains pointers to parameters of the AuditService.ListAuditLog
+type AuditServiceMockListAuditLogParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListAuditLogParams
+}
+
+// AuditServiceMockListAuditLogResults contains results of the AuditService.ListAuditLog
+type AuditServiceMockListAuditLogResults struct {
+	resp model.ListAuditLogResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditLog *mAuditServiceMockListAuditLog) Optional() *mAuditServiceMockListAuditLog {
+	mmListAuditLog.optional = true
+	return mmListAuditLog
+}
+
+// Expect sets up expected params for AuditService.ListAuditLog
+func (mmListAuditLog *mAuditServiceMockListAuditLog) Expect(ctx context.Context, params model.ListAuditLogParams) *mAuditServiceMockListAuditLog {
+	if mmListAuditLog.mock.funcListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("AuditServiceMock.ListAuditLog mock is already set by Set")
+	}
+
+	if mmListAuditLog.defaultExpectation == nil {
+		mmListAuditLog.defaultExpectation = &AuditServiceMockListAuditLogExpectation{}
+	}
+
+	if mmListAuditLog.defaultExpectation.paramPtrs != nil {
+		mmListAuditLog.mock.t.Fatalf("AuditServiceMock.ListAuditLog mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditLog.defaultExpectation.params = &AuditServiceMockListAuditLogParams{ctx, params}
+	for _, e := range mmListAuditLog.expectations {
+		if minimock.Equal(e.params, mmListAuditLog.defaultExpectation.params) {
+			mmListAuditLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditLog.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditLog
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.ListAuditLog
+func (mmListAuditLog *mAuditServiceMockListAuditLog) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockListAuditLog {
+	if mmListAuditLog.mock.funcListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("AuditServiceMock.ListAuditLog mock is already set by Set")
+	}
+
+	if mmListAuditLog.defaultExpectation == nil {
+		mmListAuditLog.defaultExpectation = &AuditServiceMockListAuditLogExpectation{}
+	}
+
+	if mmListAuditLog.defaultExpectation.params != nil {
+		mmListAuditLog.mock.t.Fatalf("AuditServiceMock.ListAuditLog mock is already set by Expect")
+	}
+
+	if mmListAuditLog.defaultExpectation.paramPtrs == nil {
+		mmListAuditLog.defaultExpectation.paramPtrs = &AuditServiceMockListAuditLogParamPtrs{}
+	}
+	mmListAuditLog.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAuditLog
+}
+
+// ExpectParamsParam2 sets up expected param params for AuditService.ListAuditLog
+func (mmListAuditLog *mAuditServiceMockListAuditLog) ExpectParamsParam2(params model.ListAuditLogParams) *mAuditServiceMockListAuditLog {
+	if mmListAuditLog.mock.funcListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("AuditServiceMock.ListAuditLog mock is already set by Set")
+	}
+
+	if mmListAuditLog.defaultExpectation == nil {
+		mmListAuditLog.defaultExpectation = &AuditServiceMockListAuditLogExpectation{}
+	}
+
+	if mmListAuditLog.defaultExpectation.params != nil {
+		mmListAuditLog.mock.t.Fatalf("AuditServiceMock.ListAuditLog mock is already set by Expect")
+	}
+
+	if mmListAuditLog.defaultExpectation.paramPtrs == nil {
+		mmListAuditLog.defaultExpectation.paramPtrs = &AuditServiceMockListAuditLogParamPtrs{}
+	}
+	mmListAuditLog.defaultExpectation.paramPtrs.params = &params
+
+	return mmListAuditLog
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.ListAuditLog
+func (mmListAuditLog *mAuditServiceMockListAuditLog) Inspect(f func(ctx context.Context, params model.ListAuditLogParams)) *mAuditServiceMockListAuditLog {
+	if mmListAuditLog.mock.inspectFuncListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.ListAuditLog")
+	}
+
+	mmListAuditLog.mock.inspectFuncListAuditLog = f
+
+	return mmListAuditLog
+}
+
+// Return sets up results that will be returned by AuditService.ListAuditLog
+func (mmListAuditLog *mAuditServiceMockListAuditLog) Return(resp model.ListAuditLogResponse, err error) *AuditServiceMock {
+	if mmListAuditLog.mock.funcListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("AuditServiceMock.ListAuditLog mock is already set by Set")
+	}
+
+	if mmListAuditLog.defaultExpectation == nil {
+		mmListAuditLog.defaultExpectation = &AuditServiceMockListAuditLogExpectation{mock: mmListAuditLog.mock}
+	}
+	mmListAuditLog.defaultExpectation.results = &AuditServiceMockListAuditLogResults{resp, err}
+	return mmListAuditLog.mock
+}
+
+// Set uses given function f to mock the AuditService.ListAuditLog method
+func (mmListAuditLog *mAuditServiceMockListAuditLog) Set(f func(ctx context.Context, params model.ListAuditLogParams) (resp model.ListAuditLogResponse, err error)) *AuditServiceMock {
+	if mmListAuditLog.defaultExpectation != nil {
+		mmListAuditLog.mock.t.Fatalf("Default expectation is already set for the AuditService.ListAuditLog method")
+	}
+
+	if len(mmListAuditLog.expectations) > 0 {
+		mmListAuditLog.mock.t.Fatalf("Some expectations are already set for the AuditService.ListAuditLog method")
+	}
+
+	mmListAuditLog.mock.funcListAuditLog = f
+	return mmListAuditLog.mock
+}
+
+// When sets expectation for the AuditService.ListAuditLog which will trigger the result defined by the following
+// Then helper
+func (mmListAuditLog *mAuditServiceMockListAuditLog) When(ctx context.Context, params model.ListAuditLogParams) *AuditServiceMockListAuditLogExpectation {
+	if mmListAuditLog.mock.funcListAuditLog != nil {
+		mmListAuditLog.mock.t.Fatalf("AuditServiceMock.ListAuditLog mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockListAuditLogExpectation{
+		mock:   mmListAuditLog.mock,
+		params: &AuditServiceMockListAuditLogParams{ctx, params},
+	}
+	mmListAuditLog.expectations = append(mmListAuditLog.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.ListAuditLog return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockListAuditLogExpectation) Then(resp model.ListAuditLogResponse, err error) *AuditServiceMock {
+	e.results = &AuditServiceMockListAuditLogResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.ListAuditLog should be invoked
+func (mmListAuditLog *mAuditServiceMockListAuditLog) Times(n uint64) *mAuditServiceMockListAuditLog {
+	if n == 0 {
+		mmListAuditLog.mock.t.Fatalf("Times of AuditServiceMock.ListAuditLog mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditLog.expectedInvocations, n)
+	return mmListAuditLog
+}
+
+func (mmListAuditLog *mAuditServiceMockListAuditLog) invocationsDone() bool {
+	if len(mmListAuditLog.expectations) == 0 && mmListAuditLog.defaultExpectation == nil && mmListAuditLog.mock.funcListAuditLog == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditLog.mock.afterListAuditLogCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditLog.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditLog implements service.AuditService
+func (mmListAuditLog *AuditServiceMock) ListAuditLog(ctx context.Context, params model.ListAuditLogParams) (resp model.ListAuditLogResponse, err error) {
+	mm_atomic.AddUint64(&mmListAuditLog.beforeListAuditLogCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditLog.afterListAuditLogCounter, 1)
+
+	if mmListAuditLog.inspectFuncListAuditLog != nil {
+		mmListAuditLog.inspectFuncListAuditLog(ctx, params)
+	}
+
+	mm_params := AuditServiceMockListAuditLogParams{ctx, params}
+
+	// Record call args
+	mmListAuditLog.ListAuditLogMock.mutex.Lock()
+	mmListAuditLog.ListAuditLogMock.callArgs = append(mmListAuditLog.ListAuditLogMock.callArgs, &mm_params)
+	mmListAuditLog.ListAuditLogMock.mutex.Unlock()
+
+	for _, e := range mmListAuditLog.ListAuditLogMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListAuditLog.ListAuditLogMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditLog.ListAuditLogMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditLog.ListAuditLogMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditLog.ListAuditLogMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockListAuditLogParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditLog.t.Errorf("AuditServiceMock.ListAuditLog got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListAuditLog.t.Errorf("AuditServiceMock.ListAuditLog got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditLog.t.Errorf("AuditServiceMock.ListAuditLog got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditLog.ListAuditLogMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditLog.t.Fatal("No results are set for the AuditServiceMock.ListAuditLog")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListAuditLog.funcListAuditLog != nil {
+		return mmListAuditLog.funcListAuditLog(ctx, params)
+	}
+	mmListAuditLog.t.Fatalf("Unexpected call to AuditServiceMock.ListAuditLog. %v %v", ctx, params)
+	return
+}
+
+// ListAuditLogAfterCounter returns a count of finished AuditServiceMock.ListAuditLog invocations
+func (mmListAuditLog *AuditServiceMock) ListAuditLogAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditLog.afterListAuditLogCounter)
+}
+
+// ListAuditLogBeforeCounter returns a count of AuditServiceMock.ListAuditLog invocations
+func (mmListAuditLog *AuditServiceMock) ListAuditLogBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditLog.beforeListAuditLogCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.ListAuditLog.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditLog *mAuditServiceMockListAuditLog) Calls() []*AuditServiceMockListAuditLogParams {
+	mmListAuditLog.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockListAuditLogParams, len(mmListAuditLog.callArgs))
+	copy(argCopy, mmListAuditLog.callArgs)
+
+	mmListAuditLog.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditLogDone returns true if the count of the ListAuditLog invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockListAuditLogDone() bool {
+	if m.ListAuditLogMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditLogMock.invocationsDone()
+}
+
+// MinimockListAuditLogInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockListAuditLogInspect() {
+	for _, e := range m.ListAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.ListAuditLog with params: %#v", *e.params)
+		}
+	}
+
+	afterListAuditLogCounter := mm_atomic.LoadUint64(&m.afterListAuditLogCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditLogMock.defaultExpectation != nil && afterListAuditLogCounter < 1 {
+		if m.ListAuditLogMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditServiceMock.ListAuditLog")
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.ListAuditLog with params: %#v", *m.ListAuditLogMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditLog != nil && afterListAuditLogCounter < 1 {
+		m.t.Error("Expected call to AuditServiceMock.ListAuditLog")
+	}
+
+	if !m.ListAuditLogMock.invocationsDone() && afterListAuditLogCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.ListAuditLog but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditLogMock.expectedInvocations), afterListAuditLogCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListAuditLogInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListAuditLogDone()
+}
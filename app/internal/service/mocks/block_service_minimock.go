@@ -0,0 +1,1059 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.BlockService -o block_service_minimock.go -n BlockServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// BlockServiceMock implements service.BlockService
+type BlockServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcBlockUser          func(ctx context.Context, params model.BlockUserParams) (err error)
+	inspectFuncBlockUser   func(ctx context.Context, params model.BlockUserParams)
+	afterBlockUserCounter  uint64
+	beforeBlockUserCounter uint64
+	BlockUserMock          mBlockServiceMockBlockUser
+
+	funcListBlocked          func(ctx context.Context, params model.ListBlockedParams) (users []model.BlockedUser, err error)
+	inspectFuncListBlocked   func(ctx context.Context, params model.ListBlockedParams)
+	afterListBlockedCounter  uint64
+	beforeListBlockedCounter uint64
+	ListBlockedMock          mBlockServiceMockListBlocked
+
+	funcUnblockUser          func(ctx context.Context, params model.UnblockUserParams) (err error)
+	inspectFuncUnblockUser   func(ctx context.Context, params model.UnblockUserParams)
+	afterUnblockUserCounter  uint64
+	beforeUnblockUserCounter uint64
+	UnblockUserMock          mBlockServiceMockUnblockUser
+}
+
+// NewBlockServiceMock returns a mock for service.BlockService
+func NewBlockServiceMock(t minimock.Tester) *BlockServiceMock {
+	m := &BlockServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.BlockUserMock = mBlockServiceMockBlockUser{mock: m}
+	m.BlockUserMock.callArgs = []*BlockServiceMockBlockUserParams{}
+
+	m.ListBlockedMock = mBlockServiceMockListBlocked{mock: m}
+	m.ListBlockedMock.callArgs = []*BlockServiceMockListBlockedParams{}
+
+	m.UnblockUserMock = mBlockServiceMockUnblockUser{mock: m}
+	m.UnblockUserMock.callArgs = []*BlockServiceMockUnblockUserParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mBlockServiceMockBlockUser struct {
+	optional           bool
+	mock               *BlockServiceMock
+	defaultExpectation *BlockServiceMockBlockUserExpectation
+	expectations       []*BlockServiceMockBlockUserExpectation
+
+	callArgs []*BlockServiceMockBlockUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BlockServiceMockBlockUserExpectation specifies expectation struct of the BlockService.BlockUser
+type BlockServiceMockBlockUserExpectation struct {
+	mock      *BlockServiceMock
+	params    *BlockServiceMockBlockUserParams
+	paramPtrs *BlockServiceMockBlockUserParamPtrs
+	results   *BlockServiceMockBlockUserResults
+	Counter   uint64
+}
+
+// BlockServiceMockBlockUserParams contains parameters of the BlockService.BlockUser
+type BlockServiceMockBlockUserParams struct {
+	ctx    context.Context
+	params model.BlockUserParams
+}
+
+// BlockServiceMockBlockUserParamPtrs contains pointers to parameters of the BlockService.BlockUser
+type BlockServiceMockBlockUserParamPtrs struct {
+	ctx    *context.Context
+	params *model.BlockUserParams
+}
+
+// BlockServiceMockBlockUserResults contains results of the BlockService.BlockUser
+type BlockServiceMockBlockUserResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBlockUser *mBlockServiceMockBlockUser) Optional() *mBlockServiceMockBlockUser {
+	mmBlockUser.optional = true
+	return mmBlockUser
+}
+
+// Expect sets up expected params for BlockService.BlockUser
+func (mmBlockUser *mBlockServiceMockBlockUser) Expect(ctx context.Context, params model.BlockUserParams) *mBlockServiceMockBlockUser {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("BlockServiceMock.BlockUser mock is already set by Set")
+	}
+
+	if mmBlockUser.defaultExpectation == nil {
+		mmBlockUser.defaultExpectation = &BlockServiceMockBlockUserExpectation{}
+	}
+
+	if mmBlockUser.defaultExpectation.paramPtrs != nil {
+		mmBlockUser.mock.t.Fatalf("BlockServiceMock.BlockUser mock is already set by ExpectParams functions")
+	}
+
+	mmBlockUser.defaultExpectation.params = &BlockServiceMockBlockUserParams{ctx, params}
+	for _, e := range mmBlockUser.expectations {
+		if minimock.Equal(e.params, mmBlockUser.defaultExpectation.params) {
+			mmBlockUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBlockUser.defaultExpectation.params)
+		}
+	}
+
+	return mmBlockUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlockService.BlockUser
+func (mmBlockUser *mBlockServiceMockBlockUser) ExpectCtxParam1(ctx context.Context) *mBlockServiceMockBlockUser {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("BlockServiceMock.BlockUser mock is already set by Set")
+	}
+
+	if mmBlockUser.defaultExpectation == nil {
+		mmBlockUser.defaultExpectation = &BlockServiceMockBlockUserExpectation{}
+	}
+
+	if mmBlockUser.defaultExpectation.params != nil {
+		mmBlockUser.mock.t.Fatalf("BlockServiceMock.BlockUser mock is already set by Expect")
+	}
+
+	if mmBlockUser.defaultExpectation.paramPtrs == nil {
+		mmBlockUser.defaultExpectation.paramPtrs = &BlockServiceMockBlockUserParamPtrs{}
+	}
+	mmBlockUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmBlockUser
+}
+
+// ExpectParamsParam2 sets up expected param params for BlockService.BlockUser
+func (mmBlockUser *mBlockServiceMockBlockUser) ExpectParamsParam2(params model.BlockUserParams) *mBlockServiceMockBlockUser {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("BlockServiceMock.BlockUser mock is already set by Set")
+	}
+
+	if mmBlockUser.defaultExpectation == nil {
+		mmBlockUser.defaultExpectation = &BlockServiceMockBlockUserExpectation{}
+	}
+
+	if mmBlockUser.defaultExpectation.params != nil {
+		mmBlockUser.mock.t.Fatalf("BlockServiceMock.BlockUser mock is already set by Expect")
+	}
+
+	if mmBlockUser.defaultExpectation.paramPtrs == nil {
+		mmBlockUser.defaultExpectation.paramPtrs = &BlockServiceMockBlockUserParamPtrs{}
+	}
+	mmBlockUser.defaultExpectation.paramPtrs.params = &params
+
+	return mmBlockUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlockService.BlockUser
+func (mmBlockUser *mBlockServiceMockBlockUser) Inspect(f func(ctx context.Context, params model.BlockUserParams)) *mBlockServiceMockBlockUser {
+	if mmBlockUser.mock.inspectFuncBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("Inspect function is already set for BlockServiceMock.BlockUser")
+	}
+
+	mmBlockUser.mock.inspectFuncBlockUser = f
+
+	return mmBlockUser
+}
+
+// Return sets up results that will be returned by BlockService.BlockUser
+func (mmBlockUser *mBlockServiceMockBlockUser) Return(err error) *BlockServiceMock {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("BlockServiceMock.BlockUser mock is already set by Set")
+	}
+
+	if mmBlockUser.defaultExpectation == nil {
+		mmBlockUser.defaultExpectation = &BlockServiceMockBlockUserExpectation{mock: mmBlockUser.mock}
+	}
+	mmBlockUser.defaultExpectation.results = &BlockServiceMockBlockUserResults{err}
+	return mmBlockUser.mock
+}
+
+// Set uses given function f to mock the BlockService.BlockUser method
+func (mmBlockUser *mBlockServiceMockBlockUser) Set(f func(ctx context.Context, params model.BlockUserParams) (err error)) *BlockServiceMock {
+	if mmBlockUser.defaultExpectation != nil {
+		mmBlockUser.mock.t.Fatalf("Default expectation is already set for the BlockService.BlockUser method")
+	}
+
+	if len(mmBlockUser.expectations) > 0 {
+		mmBlockUser.mock.t.Fatalf("Some expectations are already set for the BlockService.BlockUser method")
+	}
+
+	mmBlockUser.mock.funcBlockUser = f
+	return mmBlockUser.mock
+}
+
+// When sets expectation for the BlockService.BlockUser which will trigger the result defined by the following
+// Then helper
+func (mmBlockUser *mBlockServiceMockBlockUser) When(ctx context.Context, params model.BlockUserParams) *BlockServiceMockBlockUserExpectation {
+	if mmBlockUser.mock.funcBlockUser != nil {
+		mmBlockUser.mock.t.Fatalf("BlockServiceMock.BlockUser mock is already set by Set")
+	}
+
+	expectation := &BlockServiceMockBlockUserExpectation{
+		mock:   mmBlockUser.mock,
+		params: &BlockServiceMockBlockUserParams{ctx, params},
+	}
+	mmBlockUser.expectations = append(mmBlockUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlockService.BlockUser return parameters for the expectation previously defined by the When method
+func (e *BlockServiceMockBlockUserExpectation) Then(err error) *BlockServiceMock {
+	e.results = &BlockServiceMockBlockUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times BlockService.BlockUser should be invoked
+func (mmBlockUser *mBlockServiceMockBlockUser) Times(n uint64) *mBlockServiceMockBlockUser {
+	if n == 0 {
+		mmBlockUser.mock.t.Fatalf("Times of BlockServiceMock.BlockUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBlockUser.expectedInvocations, n)
+	return mmBlockUser
+}
+
+func (mmBlockUser *mBlockServiceMockBlockUser) invocationsDone() bool {
+	if len(mmBlockUser.expectations) == 0 && mmBlockUser.defaultExpectation == nil && mmBlockUser.mock.funcBlockUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBlockUser.mock.afterBlockUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBlockUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BlockUser implements service.BlockService
+func (mmBlockUser *BlockServiceMock) BlockUser(ctx context.Context, params model.BlockUserParams) (err error) {
+	mm_atomic.AddUint64(&mmBlockUser.beforeBlockUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmBlockUser.afterBlockUserCounter, 1)
+
+	if mmBlockUser.inspectFuncBlockUser != nil {
+		mmBlockUser.inspectFuncBlockUser(ctx, params)
+	}
+
+	mm_params := BlockServiceMockBlockUserParams{ctx, params}
+
+	// Record call args
+	mmBlockUser.BlockUserMock.mutex.Lock()
+	mmBlockUser.BlockUserMock.callArgs = append(mmBlockUser.BlockUserMock.callArgs, &mm_params)
+	mmBlockUser.BlockUserMock.mutex.Unlock()
+
+	for _, e := range mmBlockUser.BlockUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmBlockUser.BlockUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBlockUser.BlockUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmBlockUser.BlockUserMock.defaultExpectation.params
+		mm_want_ptrs := mmBlockUser.BlockUserMock.defaultExpectation.paramPtrs
+
+		mm_got := BlockServiceMockBlockUserParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBlockUser.t.Errorf("BlockServiceMock.BlockUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmBlockUser.t.Errorf("BlockServiceMock.BlockUser got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBlockUser.t.Errorf("BlockServiceMock.BlockUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBlockUser.BlockUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBlockUser.t.Fatal("No results are set for the BlockServiceMock.BlockUser")
+		}
+		return (*mm_results).err
+	}
+	if mmBlockUser.funcBlockUser != nil {
+		return mmBlockUser.funcBlockUser(ctx, params)
+	}
+	mmBlockUser.t.Fatalf("Unexpected call to BlockServiceMock.BlockUser. %v %v", ctx, params)
+	return
+}
+
+// BlockUserAfterCounter returns a count of finished BlockServiceMock.BlockUser invocations
+func (mmBlockUser *BlockServiceMock) BlockUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlockUser.afterBlockUserCounter)
+}
+
+// BlockUserBeforeCounter returns a count of BlockServiceMock.BlockUser invocations
+func (mmBlockUser *BlockServiceMock) BlockUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBlockUser.beforeBlockUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlockServiceMock.BlockUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBlockUser *mBlockServiceMockBlockUser) Calls() []*BlockServiceMockBlockUserParams {
+	mmBlockUser.mutex.RLock()
+
+	argCopy := make([]*BlockServiceMockBlockUserParams, len(mmBlockUser.callArgs))
+	copy(argCopy, mmBlockUser.callArgs)
+
+	mmBlockUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBlockUserDone returns true if the count of the BlockUser invocations corresponds
+// the number of defined expectations
+func (m *BlockServiceMock) MinimockBlockUserDone() bool {
+	if m.BlockUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BlockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BlockUserMock.invocationsDone()
+}
+
+// MinimockBlockUserInspect logs each unmet expectation
+func (m *BlockServiceMock) MinimockBlockUserInspect() {
+	for _, e := range m.BlockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlockServiceMock.BlockUser with params: %#v", *e.params)
+		}
+	}
+
+	afterBlockUserCounter := mm_atomic.LoadUint64(&m.afterBlockUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BlockUserMock.defaultExpectation != nil && afterBlockUserCounter < 1 {
+		if m.BlockUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BlockServiceMock.BlockUser")
+		} else {
+			m.t.Errorf("Expected call to BlockServiceMock.BlockUser with params: %#v", *m.BlockUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBlockUser != nil && afterBlockUserCounter < 1 {
+		m.t.Error("Expected call to BlockServiceMock.BlockUser")
+	}
+
+	if !m.BlockUserMock.invocationsDone() && afterBlockUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlockServiceMock.BlockUser but found %d calls",
+			mm_atomic.LoadUint64(&m.BlockUserMock.expectedInvocations), afterBlockUserCounter)
+	}
+}
+
+type mBlockServiceMockListBlocked struct {
+	optional           bool
+	mock               *BlockServiceMock
+	defaultExpectation *BlockServiceMockListBlockedExpectation
+	expectations       []*BlockServiceMockListBlockedExpectation
+
+	callArgs []*BlockServiceMockListBlockedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BlockServiceMockListBlockedExpectation specifies expectation struct of the BlockService.ListBlocked
+type BlockServiceMockListBlockedExpectation struct {
+	mock      *BlockServiceMock
+	params    *BlockServiceMockListBlockedParams
+	paramPtrs *BlockServiceMockListBlockedParamPtrs
+	results   *BlockServiceMockListBlockedResults
+	Counter   uint64
+}
+
+// BlockServiceMockListBlockedParams contains parameters of the BlockService.ListBlocked
+type BlockServiceMockListBlockedParams struct {
+	ctx    context.Context
+	params model.ListBlockedParams
+}
+
+// BlockServiceMockListBlockedParamPtrs contains pointers to parameters of the BlockService.ListBlocked
+type BlockServiceMockListBlockedParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListBlockedParams
+}
+
+// BlockServiceMockListBlockedResults contains results of the BlockService.ListBlocked
+type BlockServiceMockListBlockedResults struct {
+	users []model.BlockedUser
+	err   error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListBlocked *mBlockServiceMockListBlocked) Optional() *mBlockServiceMockListBlocked {
+	mmListBlocked.optional = true
+	return mmListBlocked
+}
+
+// Expect sets up expected params for BlockService.ListBlocked
+func (mmListBlocked *mBlockServiceMockListBlocked) Expect(ctx context.Context, params model.ListBlockedParams) *mBlockServiceMockListBlocked {
+	if mmListBlocked.mock.funcListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("BlockServiceMock.ListBlocked mock is already set by Set")
+	}
+
+	if mmListBlocked.defaultExpectation == nil {
+		mmListBlocked.defaultExpectation = &BlockServiceMockListBlockedExpectation{}
+	}
+
+	if mmListBlocked.defaultExpectation.paramPtrs != nil {
+		mmListBlocked.mock.t.Fatalf("BlockServiceMock.ListBlocked mock is already set by ExpectParams functions")
+	}
+
+	mmListBlocked.defaultExpectation.params = &BlockServiceMockListBlockedParams{ctx, params}
+	for _, e := range mmListBlocked.expectations {
+		if minimock.Equal(e.params, mmListBlocked.defaultExpectation.params) {
+			mmListBlocked.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListBlocked.defaultExpectation.params)
+		}
+	}
+
+	return mmListBlocked
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlockService.ListBlocked
+func (mmListBlocked *mBlockServiceMockListBlocked) ExpectCtxParam1(ctx context.Context) *mBlockServiceMockListBlocked {
+	if mmListBlocked.mock.funcListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("BlockServiceMock.ListBlocked mock is already set by Set")
+	}
+
+	if mmListBlocked.defaultExpectation == nil {
+		mmListBlocked.defaultExpectation = &BlockServiceMockListBlockedExpectation{}
+	}
+
+	if mmListBlocked.defaultExpectation.params != nil {
+		mmListBlocked.mock.t.Fatalf("BlockServiceMock.ListBlocked mock is already set by Expect")
+	}
+
+	if mmListBlocked.defaultExpectation.paramPtrs == nil {
+		mmListBlocked.defaultExpectation.paramPtrs = &BlockServiceMockListBlockedParamPtrs{}
+	}
+	mmListBlocked.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListBlocked
+}
+
+// ExpectParamsParam2 sets up expected param params for BlockService.ListBlocked
+func (mmListBlocked *mBlockServiceMockListBlocked) ExpectParamsParam2(params model.ListBlockedParams) *mBlockServiceMockListBlocked {
+	if mmListBlocked.mock.funcListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("BlockServiceMock.ListBlocked mock is already set by Set")
+	}
+
+	if mmListBlocked.defaultExpectation == nil {
+		mmListBlocked.defaultExpectation = &BlockServiceMockListBlockedExpectation{}
+	}
+
+	if mmListBlocked.defaultExpectation.params != nil {
+		mmListBlocked.mock.t.Fatalf("BlockServiceMock.ListBlocked mock is already set by Expect")
+	}
+
+	if mmListBlocked.defaultExpectation.paramPtrs == nil {
+		mmListBlocked.defaultExpectation.paramPtrs = &BlockServiceMockListBlockedParamPtrs{}
+	}
+	mmListBlocked.defaultExpectation.paramPtrs.params = &params
+
+	return mmListBlocked
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlockService.ListBlocked
+func (mmListBlocked *mBlockServiceMockListBlocked) Inspect(f func(ctx context.Context, params model.ListBlockedParams)) *mBlockServiceMockListBlocked {
+	if mmListBlocked.mock.inspectFuncListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("Inspect function is already set for BlockServiceMock.ListBlocked")
+	}
+
+	mmListBlocked.mock.inspectFuncListBlocked = f
+
+	return mmListBlocked
+}
+
+// Return sets up results that will be returned by BlockService.ListBlocked
+func (mmListBlocked *mBlockServiceMockListBlocked) Return(users []model.BlockedUser, err error) *BlockServiceMock {
+	if mmListBlocked.mock.funcListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("BlockServiceMock.ListBlocked mock is already set by Set")
+	}
+
+	if mmListBlocked.defaultExpectation == nil {
+		mmListBlocked.defaultExpectation = &BlockServiceMockListBlockedExpectation{mock: mmListBlocked.mock}
+	}
+	mmListBlocked.defaultExpectation.results = &BlockServiceMockListBlockedResults{users, err}
+	return mmListBlocked.mock
+}
+
+// Set uses given function f to mock the BlockService.ListBlocked method
+func (mmListBlocked *mBlockServiceMockListBlocked) Set(f func(ctx context.Context, params model.ListBlockedParams) (users []model.BlockedUser, err error)) *BlockServiceMock {
+	if mmListBlocked.defaultExpectation != nil {
+		mmListBlocked.mock.t.Fatalf("Default expectation is already set for the BlockService.ListBlocked method")
+	}
+
+	if len(mmListBlocked.expectations) > 0 {
+		mmListBlocked.mock.t.Fatalf("Some expectations are already set for the BlockService.ListBlocked method")
+	}
+
+	mmListBlocked.mock.funcListBlocked = f
+	return mmListBlocked.mock
+}
+
+// When sets expectation for the BlockService.ListBlocked which will trigger the result defined by the following
+// Then helper
+func (mmListBlocked *mBlockServiceMockListBlocked) When(ctx context.Context, params model.ListBlockedParams) *BlockServiceMockListBlockedExpectation {
+	if mmListBlocked.mock.funcListBlocked != nil {
+		mmListBlocked.mock.t.Fatalf("BlockServiceMock.ListBlocked mock is already set by Set")
+	}
+
+	expectation := &BlockServiceMockListBlockedExpectation{
+		mock:   mmListBlocked.mock,
+		params: &BlockServiceMockListBlockedParams{ctx, params},
+	}
+	mmListBlocked.expectations = append(mmListBlocked.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlockService.ListBlocked return parameters for the expectation previously defined by the When method
+func (e *BlockServiceMockListBlockedExpectation) Then(users []model.BlockedUser, err error) *BlockServiceMock {
+	e.results = &BlockServiceMockListBlockedResults{users, err}
+	return e.mock
+}
+
+// Times sets number of times BlockService.ListBlocked should be invoked
+func (mmListBlocked *mBlockServiceMockListBlocked) Times(n uint64) *mBlockServiceMockListBlocked {
+	if n == 0 {
+		mmListBlocked.mock.t.Fatalf("Times of BlockServiceMock.ListBlocked mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListBlocked.expectedInvocations, n)
+	return mmListBlocked
+}
+
+func (mmListBlocked *mBlockServiceMockListBlocked) invocationsDone() bool {
+	if len(mmListBlocked.expectations) == 0 && mmListBlocked.defaultExpectation == nil && mmListBlocked.mock.funcListBlocked == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListBlocked.mock.afterListBlockedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListBlocked.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListBlocked implements service.BlockService
+func (mmListBlocked *BlockServiceMock) ListBlocked(ctx context.Context, params model.ListBlockedParams) (users []model.BlockedUser, err error) {
+	mm_atomic.AddUint64(&mmListBlocked.beforeListBlockedCounter, 1)
+	defer mm_atomic.AddUint64(&mmListBlocked.afterListBlockedCounter, 1)
+
+	if mmListBlocked.inspectFuncListBlocked != nil {
+		mmListBlocked.inspectFuncListBlocked(ctx, params)
+	}
+
+	mm_params := BlockServiceMockListBlockedParams{ctx, params}
+
+	// Record call args
+	mmListBlocked.ListBlockedMock.mutex.Lock()
+	mmListBlocked.ListBlockedMock.callArgs = append(mmListBlocked.ListBlockedMock.callArgs, &mm_params)
+	mmListBlocked.ListBlockedMock.mutex.Unlock()
+
+	for _, e := range mmListBlocked.ListBlockedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.users, e.results.err
+		}
+	}
+
+	if mmListBlocked.ListBlockedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListBlocked.ListBlockedMock.defaultExpectation.Counter, 1)
+		mm_want := mmListBlocked.ListBlockedMock.defaultExpectation.params
+		mm_want_ptrs := mmListBlocked.ListBlockedMock.defaultExpectation.paramPtrs
+
+		mm_got := BlockServiceMockListBlockedParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListBlocked.t.Errorf("BlockServiceMock.ListBlocked got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListBlocked.t.Errorf("BlockServiceMock.ListBlocked got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListBlocked.t.Errorf("BlockServiceMock.ListBlocked got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListBlocked.ListBlockedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListBlocked.t.Fatal("No results are set for the BlockServiceMock.ListBlocked")
+		}
+		return (*mm_results).users, (*mm_results).err
+	}
+	if mmListBlocked.funcListBlocked != nil {
+		return mmListBlocked.funcListBlocked(ctx, params)
+	}
+	mmListBlocked.t.Fatalf("Unexpected call to BlockServiceMock.ListBlocked. %v %v", ctx, params)
+	return
+}
+
+// ListBlockedAfterCounter returns a count of finished BlockServiceMock.ListBlocked invocations
+func (mmListBlocked *BlockServiceMock) ListBlockedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBlocked.afterListBlockedCounter)
+}
+
+// ListBlockedBeforeCounter returns a count of BlockServiceMock.ListBlocked invocations
+func (mmListBlocked *BlockServiceMock) ListBlockedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBlocked.beforeListBlockedCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlockServiceMock.ListBlocked.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListBlocked *mBlockServiceMockListBlocked) Calls() []*BlockServiceMockListBlockedParams {
+	mmListBlocked.mutex.RLock()
+
+	argCopy := make([]*BlockServiceMockListBlockedParams, len(mmListBlocked.callArgs))
+	copy(argCopy, mmListBlocked.callArgs)
+
+	mmListBlocked.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListBlockedDone returns true if the count of the ListBlocked invocations corresponds
+// the number of defined expectations
+func (m *BlockServiceMock) MinimockListBlockedDone() bool {
+	if m.ListBlockedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListBlockedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListBlockedMock.invocationsDone()
+}
+
+// MinimockListBlockedInspect logs each unmet expectation
+func (m *BlockServiceMock) MinimockListBlockedInspect() {
+	for _, e := range m.ListBlockedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlockServiceMock.ListBlocked with params: %#v", *e.params)
+		}
+	}
+
+	afterListBlockedCounter := mm_atomic.LoadUint64(&m.afterListBlockedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListBlockedMock.defaultExpectation != nil && afterListBlockedCounter < 1 {
+		if m.ListBlockedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BlockServiceMock.ListBlocked")
+		} else {
+			m.t.Errorf("Expected call to BlockServiceMock.ListBlocked with params: %#v", *m.ListBlockedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListBlocked != nil && afterListBlockedCounter < 1 {
+		m.t.Error("Expected call to BlockServiceMock.ListBlocked")
+	}
+
+	if !m.ListBlockedMock.invocationsDone() && afterListBlockedCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlockServiceMock.ListBlocked but found %d calls",
+			mm_atomic.LoadUint64(&m.ListBlockedMock.expectedInvocations), afterListBlockedCounter)
+	}
+}
+
+type mBlockServiceMockUnblockUser struct {
+	optional           bool
+	mock               *BlockServiceMock
+	defaultExpectation *BlockServiceMockUnblockUserExpectation
+	expectations       []*BlockServiceMockUnblockUserExpectation
+
+	callArgs []*BlockServiceMockUnblockUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BlockServiceMockUnblockUserExpectation specifies expectation struct of the BlockService.UnblockUser
+type BlockServiceMockUnblockUserExpectation struct {
+	mock      *BlockServiceMock
+	params    *BlockServiceMockUnblockUserParams
+	paramPtrs *BlockServiceMockUnblockUserParamPtrs
+	results   *BlockServiceMockUnblockUserResults
+	Counter   uint64
+}
+
+// BlockServiceMockUnblockUserParams contains parameters of the BlockService.UnblockUser
+type BlockServiceMockUnblockUserParams struct {
+	ctx    context.Context
+	params model.UnblockUserParams
+}
+
+// BlockServiceMockUnblockUserParamPtrs contains pointers to parameters of the BlockService.UnblockUser
+type BlockServiceMockUnblockUserParamPtrs struct {
+	ctx    *context.Context
+	params *model.UnblockUserParams
+}
+
+// BlockServiceMockUnblockUserResults contains results of the BlockService.UnblockUser
+type BlockServiceMockUnblockUserResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnblockUser *mBlockServiceMockUnblockUser) Optional() *mBlockServiceMockUnblockUser {
+	mmUnblockUser.optional = true
+	return mmUnblockUser
+}
+
+// Expect sets up expected params for BlockService.UnblockUser
+func (mmUnblockUser *mBlockServiceMockUnblockUser) Expect(ctx context.Context, params model.UnblockUserParams) *mBlockServiceMockUnblockUser {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockServiceMock.UnblockUser mock is already set by Set")
+	}
+
+	if mmUnblockUser.defaultExpectation == nil {
+		mmUnblockUser.defaultExpectation = &BlockServiceMockUnblockUserExpectation{}
+	}
+
+	if mmUnblockUser.defaultExpectation.paramPtrs != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockServiceMock.UnblockUser mock is already set by ExpectParams functions")
+	}
+
+	mmUnblockUser.defaultExpectation.params = &BlockServiceMockUnblockUserParams{ctx, params}
+	for _, e := range mmUnblockUser.expectations {
+		if minimock.Equal(e.params, mmUnblockUser.defaultExpectation.params) {
+			mmUnblockUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnblockUser.defaultExpectation.params)
+		}
+	}
+
+	return mmUnblockUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BlockService.UnblockUser
+func (mmUnblockUser *mBlockServiceMockUnblockUser) ExpectCtxParam1(ctx context.Context) *mBlockServiceMockUnblockUser {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockServiceMock.UnblockUser mock is already set by Set")
+	}
+
+	if mmUnblockUser.defaultExpectation == nil {
+		mmUnblockUser.defaultExpectation = &BlockServiceMockUnblockUserExpectation{}
+	}
+
+	if mmUnblockUser.defaultExpectation.params != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockServiceMock.UnblockUser mock is already set by Expect")
+	}
+
+	if mmUnblockUser.defaultExpectation.paramPtrs == nil {
+		mmUnblockUser.defaultExpectation.paramPtrs = &BlockServiceMockUnblockUserParamPtrs{}
+	}
+	mmUnblockUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUnblockUser
+}
+
+// ExpectParamsParam2 sets up expected param params for BlockService.UnblockUser
+func (mmUnblockUser *mBlockServiceMockUnblockUser) ExpectParamsParam2(params model.UnblockUserParams) *mBlockServiceMockUnblockUser {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockServiceMock.UnblockUser mock is already set by Set")
+	}
+
+	if mmUnblockUser.defaultExpectation == nil {
+		mmUnblockUser.defaultExpectation = &BlockServiceMockUnblockUserExpectation{}
+	}
+
+	if mmUnblockUser.defaultExpectation.params != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockServiceMock.UnblockUser mock is already set by Expect")
+	}
+
+	if mmUnblockUser.defaultExpectation.paramPtrs == nil {
+		mmUnblockUser.defaultExpectation.paramPtrs = &BlockServiceMockUnblockUserParamPtrs{}
+	}
+	mmUnblockUser.defaultExpectation.paramPtrs.params = &params
+
+	return mmUnblockUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the BlockService.UnblockUser
+func (mmUnblockUser *mBlockServiceMockUnblockUser) Inspect(f func(ctx context.Context, params model.UnblockUserParams)) *mBlockServiceMockUnblockUser {
+	if mmUnblockUser.mock.inspectFuncUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("Inspect function is already set for BlockServiceMock.UnblockUser")
+	}
+
+	mmUnblockUser.mock.inspectFuncUnblockUser = f
+
+	return mmUnblockUser
+}
+
+// Return sets up results that will be returned by BlockService.UnblockUser
+func (mmUnblockUser *mBlockServiceMockUnblockUser) Return(err error) *BlockServiceMock {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockServiceMock.UnblockUser mock is already set by Set")
+	}
+
+	if mmUnblockUser.defaultExpectation == nil {
+		mmUnblockUser.defaultExpectation = &BlockServiceMockUnblockUserExpectation{mock: mmUnblockUser.mock}
+	}
+	mmUnblockUser.defaultExpectation.results = &BlockServiceMockUnblockUserResults{err}
+	return mmUnblockUser.mock
+}
+
+// Set uses given function f to mock the BlockService.UnblockUser method
+func (mmUnblockUser *mBlockServiceMockUnblockUser) Set(f func(ctx context.Context, params model.UnblockUserParams) (err error)) *BlockServiceMock {
+	if mmUnblockUser.defaultExpectation != nil {
+		mmUnblockUser.mock.t.Fatalf("Default expectation is already set for the BlockService.UnblockUser method")
+	}
+
+	if len(mmUnblockUser.expectations) > 0 {
+		mmUnblockUser.mock.t.Fatalf("Some expectations are already set for the BlockService.UnblockUser method")
+	}
+
+	mmUnblockUser.mock.funcUnblockUser = f
+	return mmUnblockUser.mock
+}
+
+// When sets expectation for the BlockService.UnblockUser which will trigger the result defined by the following
+// Then helper
+func (mmUnblockUser *mBlockServiceMockUnblockUser) When(ctx context.Context, params model.UnblockUserParams) *BlockServiceMockUnblockUserExpectation {
+	if mmUnblockUser.mock.funcUnblockUser != nil {
+		mmUnblockUser.mock.t.Fatalf("BlockServiceMock.UnblockUser mock is already set by Set")
+	}
+
+	expectation := &BlockServiceMockUnblockUserExpectation{
+		mock:   mmUnblockUser.mock,
+		params: &BlockServiceMockUnblockUserParams{ctx, params},
+	}
+	mmUnblockUser.expectations = append(mmUnblockUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BlockService.UnblockUser return parameters for the expectation previously defined by the When method
+func (e *BlockServiceMockUnblockUserExpectation) Then(err error) *BlockServiceMock {
+	e.results = &BlockServiceMockUnblockUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times BlockService.UnblockUser should be invoked
+func (mmUnblockUser *mBlockServiceMockUnblockUser) Times(n uint64) *mBlockServiceMockUnblockUser {
+	if n == 0 {
+		mmUnblockUser.mock.t.Fatalf("Times of BlockServiceMock.UnblockUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUnblockUser.expectedInvocations, n)
+	return mmUnblockUser
+}
+
+func (mmUnblockUser *mBlockServiceMockUnblockUser) invocationsDone() bool {
+	if len(mmUnblockUser.expectations) == 0 && mmUnblockUser.defaultExpectation == nil && mmUnblockUser.mock.funcUnblockUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUnblockUser.mock.afterUnblockUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnblockUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UnblockUser implements service.BlockService
+func (mmUnblockUser *BlockServiceMock) UnblockUser(ctx context.Context, params model.UnblockUserParams) (err error) {
+	mm_atomic.AddUint64(&mmUnblockUser.beforeUnblockUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnblockUser.afterUnblockUserCounter, 1)
+
+	if mmUnblockUser.inspectFuncUnblockUser != nil {
+		mmUnblockUser.inspectFuncUnblockUser(ctx, params)
+	}
+
+	mm_params := BlockServiceMockUnblockUserParams{ctx, params}
+
+	// Record call args
+	mmUnblockUser.UnblockUserMock.mutex.Lock()
+	mmUnblockUser.UnblockUserMock.callArgs = append(mmUnblockUser.UnblockUserMock.callArgs, &mm_params)
+	mmUnblockUser.UnblockUserMock.mutex.Unlock()
+
+	for _, e := range mmUnblockUser.UnblockUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUnblockUser.UnblockUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnblockUser.UnblockUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnblockUser.UnblockUserMock.defaultExpectation.params
+		mm_want_ptrs := mmUnblockUser.UnblockUserMock.defaultExpectation.paramPtrs
+
+		mm_got := BlockServiceMockUnblockUserParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUnblockUser.t.Errorf("BlockServiceMock.UnblockUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUnblockUser.t.Errorf("BlockServiceMock.UnblockUser got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnblockUser.t.Errorf("BlockServiceMock.UnblockUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnblockUser.UnblockUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnblockUser.t.Fatal("No results are set for the BlockServiceMock.UnblockUser")
+		}
+		return (*mm_results).err
+	}
+	if mmUnblockUser.funcUnblockUser != nil {
+		return mmUnblockUser.funcUnblockUser(ctx, params)
+	}
+	mmUnblockUser.t.Fatalf("Unexpected call to BlockServiceMock.UnblockUser. %v %v", ctx, params)
+	return
+}
+
+// UnblockUserAfterCounter returns a count of finished BlockServiceMock.UnblockUser invocations
+func (mmUnblockUser *BlockServiceMock) UnblockUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnblockUser.afterUnblockUserCounter)
+}
+
+// UnblockUserBeforeCounter returns a count of BlockServiceMock.UnblockUser invocations
+func (mmUnblockUser *BlockServiceMock) UnblockUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnblockUser.beforeUnblockUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to BlockServiceMock.UnblockUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnblockUser *mBlockServiceMockUnblockUser) Calls() []*BlockServiceMockUnblockUserParams {
+	mmUnblockUser.mutex.RLock()
+
+	argCopy := make([]*BlockServiceMockUnblockUserParams, len(mmUnblockUser.callArgs))
+	copy(argCopy, mmUnblockUser.callArgs)
+
+	mmUnblockUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnblockUserDone returns true if the count of the UnblockUser invocations corresponds
+// the number of defined expectations
+func (m *BlockServiceMock) MinimockUnblockUserDone() bool {
+	if m.UnblockUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UnblockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UnblockUserMock.invocationsDone()
+}
+
+// MinimockUnblockUserInspect logs each unmet expectation
+func (m *BlockServiceMock) MinimockUnblockUserInspect() {
+	for _, e := range m.UnblockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BlockServiceMock.UnblockUser with params: %#v", *e.params)
+		}
+	}
+
+	afterUnblockUserCounter := mm_atomic.LoadUint64(&m.afterUnblockUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnblockUserMock.defaultExpectation != nil && afterUnblockUserCounter < 1 {
+		if m.UnblockUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BlockServiceMock.UnblockUser")
+		} else {
+			m.t.Errorf("Expected call to BlockServiceMock.UnblockUser with params: %#v", *m.UnblockUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnblockUser != nil && afterUnblockUserCounter < 1 {
+		m.t.Error("Expected call to BlockServiceMock.UnblockUser")
+	}
+
+	if !m.UnblockUserMock.invocationsDone() && afterUnblockUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to BlockServiceMock.UnblockUser but found %d calls",
+			mm_atomic.LoadUint64(&m.UnblockUserMock.expectedInvocations), afterUnblockUserCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BlockServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockBlockUserInspect()
+
+			m.MinimockListBlockedInspect()
+
+			m.MinimockUnblockUserInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BlockServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *BlockServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockBlockUserDone() &&
+		m.MinimockListBlockedDone() &&
+		m.MinimockUnblockUserDone()
+}
@@ -0,0 +1,1365 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.BotService -o bot_service_minimock.go -n BotServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// BotServiceMock implements service.BotService
+type BotServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAuthenticate          func(ctx context.Context, token string) (bot model.Bot, err error)
+	inspectFuncAuthenticate   func(ctx context.Context, token string)
+	afterAuthenticateCounter  uint64
+	beforeAuthenticateCounter uint64
+	AuthenticateMock          mBotServiceMockAuthenticate
+
+	funcCreateBot          func(ctx context.Context, params model.CreateBotParams) (resp model.CreateBotResponse, err error)
+	inspectFuncCreateBot   func(ctx context.Context, params model.CreateBotParams)
+	afterCreateBotCounter  uint64
+	beforeCreateBotCounter uint64
+	CreateBotMock          mBotServiceMockCreateBot
+
+	funcDeleteBot          func(ctx context.Context, params model.DeleteBotParams) (err error)
+	inspectFuncDeleteBot   func(ctx context.Context, params model.DeleteBotParams)
+	afterDeleteBotCounter  uint64
+	beforeDeleteBotCounter uint64
+	DeleteBotMock          mBotServiceMockDeleteBot
+
+	funcListBots          func(ctx context.Context) (bots []model.Bot, err error)
+	inspectFuncListBots   func(ctx context.Context)
+	afterListBotsCounter  uint64
+	beforeListBotsCounter uint64
+	ListBotsMock          mBotServiceMockListBots
+}
+
+// NewBotServiceMock returns a mock for service.BotService
+func NewBotServiceMock(t minimock.Tester) *BotServiceMock {
+	m := &BotServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AuthenticateMock = mBotServiceMockAuthenticate{mock: m}
+	m.AuthenticateMock.callArgs = []*BotServiceMockAuthenticateParams{}
+
+	m.CreateBotMock = mBotServiceMockCreateBot{mock: m}
+	m.CreateBotMock.callArgs = []*BotServiceMockCreateBotParams{}
+
+	m.DeleteBotMock = mBotServiceMockDeleteBot{mock: m}
+	m.DeleteBotMock.callArgs = []*BotServiceMockDeleteBotParams{}
+
+	m.ListBotsMock = mBotServiceMockListBots{mock: m}
+	m.ListBotsMock.callArgs = []*BotServiceMockListBotsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mBotServiceMockAuthenticate struct {
+	optional           bool
+	mock               *BotServiceMock
+	defaultExpectation *BotServiceMockAuthenticateExpectation
+	expectations       []*BotServiceMockAuthenticateExpectation
+
+	callArgs []*BotServiceMockAuthenticateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BotServiceMockAuthenticateExpectation specifies expectation struct of the BotService.Authenticate
+type BotServiceMockAuthenticateExpectation struct {
+	mock      *BotServiceMock
+	params    *BotServiceMockAuthenticateParams
+	paramPtrs *BotServiceMockAuthenticateParamPtrs
+	results   *BotServiceMockAuthenticateResults
+	Counter   uint64
+}
+
+// BotServiceMockAuthenticateParams contains parameters of the BotService.Authenticate
+type BotServiceMockAuthenticateParams struct {
+	ctx   context.Context
+	token string
+}
+
+// BotServiceMockAuthenticateParamPtrs contains pointers to parameters of the BotService.Authenticate
+type BotServiceMockAuthenticateParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// BotServiceMockAuthenticateResults contains results of the BotService.Authenticate
+type BotServiceMockAuthenticateResults struct {
+	bot model.Bot
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAuthenticate *mBotServiceMockAuthenticate) Optional() *mBotServiceMockAuthenticate {
+	mmAuthenticate.optional = true
+	return mmAuthenticate
+}
+
+// Expect sets up expected params for BotService.Authenticate
+func (mmAuthenticate *mBotServiceMockAuthenticate) Expect(ctx context.Context, token string) *mBotServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &BotServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by ExpectParams functions")
+	}
+
+	mmAuthenticate.defaultExpectation.params = &BotServiceMockAuthenticateParams{ctx, token}
+	for _, e := range mmAuthenticate.expectations {
+		if minimock.Equal(e.params, mmAuthenticate.defaultExpectation.params) {
+			mmAuthenticate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticate.defaultExpectation.params)
+		}
+	}
+
+	return mmAuthenticate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotService.Authenticate
+func (mmAuthenticate *mBotServiceMockAuthenticate) ExpectCtxParam1(ctx context.Context) *mBotServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &BotServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &BotServiceMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAuthenticate
+}
+
+// ExpectTokenParam2 sets up expected param token for BotService.Authenticate
+func (mmAuthenticate *mBotServiceMockAuthenticate) ExpectTokenParam2(token string) *mBotServiceMockAuthenticate {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &BotServiceMockAuthenticateExpectation{}
+	}
+
+	if mmAuthenticate.defaultExpectation.params != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Expect")
+	}
+
+	if mmAuthenticate.defaultExpectation.paramPtrs == nil {
+		mmAuthenticate.defaultExpectation.paramPtrs = &BotServiceMockAuthenticateParamPtrs{}
+	}
+	mmAuthenticate.defaultExpectation.paramPtrs.token = &token
+
+	return mmAuthenticate
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotService.Authenticate
+func (mmAuthenticate *mBotServiceMockAuthenticate) Inspect(f func(ctx context.Context, token string)) *mBotServiceMockAuthenticate {
+	if mmAuthenticate.mock.inspectFuncAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("Inspect function is already set for BotServiceMock.Authenticate")
+	}
+
+	mmAuthenticate.mock.inspectFuncAuthenticate = f
+
+	return mmAuthenticate
+}
+
+// Return sets up results that will be returned by BotService.Authenticate
+func (mmAuthenticate *mBotServiceMockAuthenticate) Return(bot model.Bot, err error) *BotServiceMock {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Set")
+	}
+
+	if mmAuthenticate.defaultExpectation == nil {
+		mmAuthenticate.defaultExpectation = &BotServiceMockAuthenticateExpectation{mock: mmAuthenticate.mock}
+	}
+	mmAuthenticate.defaultExpectation.results = &BotServiceMockAuthenticateResults{bot, err}
+	return mmAuthenticate.mock
+}
+
+// Set uses given function f to mock the BotService.Authenticate method
+func (mmAuthenticate *mBotServiceMockAuthenticate) Set(f func(ctx context.Context, token string) (bot model.Bot, err error)) *BotServiceMock {
+	if mmAuthenticate.defaultExpectation != nil {
+		mmAuthenticate.mock.t.Fatalf("Default expectation is already set for the BotService.Authenticate method")
+	}
+
+	if len(mmAuthenticate.expectations) > 0 {
+		mmAuthenticate.mock.t.Fatalf("Some expectations are already set for the BotService.Authenticate method")
+	}
+
+	mmAuthenticate.mock.funcAuthenticate = f
+	return mmAuthenticate.mock
+}
+
+// When sets expectation for the BotService.Authenticate which will trigger the result defined by the following
+// Then helper
+func (mmAuthenticate *mBotServiceMockAuthenticate) When(ctx context.Context, token string) *BotServiceMockAuthenticateExpectation {
+	if mmAuthenticate.mock.funcAuthenticate != nil {
+		mmAuthenticate.mock.t.Fatalf("BotServiceMock.Authenticate mock is already set by Set")
+	}
+
+	expectation := &BotServiceMockAuthenticateExpectation{
+		mock:   mmAuthenticate.mock,
+		params: &BotServiceMockAuthenticateParams{ctx, token},
+	}
+	mmAuthenticate.expectations = append(mmAuthenticate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotService.Authenticate return parameters for the expectation previously defined by the When method
+func (e *BotServiceMockAuthenticateExpectation) Then(bot model.Bot, err error) *BotServiceMock {
+	e.results = &BotServiceMockAuthenticateResults{bot, err}
+	return e.mock
+}
+
+// Times sets number of times BotService.Authenticate should be invoked
+func (mmAuthenticate *mBotServiceMockAuthenticate) Times(n uint64) *mBotServiceMockAuthenticate {
+	if n == 0 {
+		mmAuthenticate.mock.t.Fatalf("Times of BotServiceMock.Authenticate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAuthenticate.expectedInvocations, n)
+	return mmAuthenticate
+}
+
+func (mmAuthenticate *mBotServiceMockAuthenticate) invocationsDone() bool {
+	if len(mmAuthenticate.expectations) == 0 && mmAuthenticate.defaultExpectation == nil && mmAuthenticate.mock.funcAuthenticate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticate.mock.afterAuthenticateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Authenticate implements service.BotService
+func (mmAuthenticate *BotServiceMock) Authenticate(ctx context.Context, token string) (bot model.Bot, err error) {
+	mm_atomic.AddUint64(&mmAuthenticate.beforeAuthenticateCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticate.afterAuthenticateCounter, 1)
+
+	if mmAuthenticate.inspectFuncAuthenticate != nil {
+		mmAuthenticate.inspectFuncAuthenticate(ctx, token)
+	}
+
+	mm_params := BotServiceMockAuthenticateParams{ctx, token}
+
+	// Record call args
+	mmAuthenticate.AuthenticateMock.mutex.Lock()
+	mmAuthenticate.AuthenticateMock.callArgs = append(mmAuthenticate.AuthenticateMock.callArgs, &mm_params)
+	mmAuthenticate.AuthenticateMock.mutex.Unlock()
+
+	for _, e := range mmAuthenticate.AuthenticateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.bot, e.results.err
+		}
+	}
+
+	if mmAuthenticate.AuthenticateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticate.AuthenticateMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticate.AuthenticateMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticate.AuthenticateMock.defaultExpectation.paramPtrs
+
+		mm_got := BotServiceMockAuthenticateParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAuthenticate.t.Errorf("BotServiceMock.Authenticate got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmAuthenticate.t.Errorf("BotServiceMock.Authenticate got unexpected parameter token, want: %#v, got: %#v%s\n", *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAuthenticate.t.Errorf("BotServiceMock.Authenticate got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAuthenticate.AuthenticateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAuthenticate.t.Fatal("No results are set for the BotServiceMock.Authenticate")
+		}
+		return (*mm_results).bot, (*mm_results).err
+	}
+	if mmAuthenticate.funcAuthenticate != nil {
+		return mmAuthenticate.funcAuthenticate(ctx, token)
+	}
+	mmAuthenticate.t.Fatalf("Unexpected call to BotServiceMock.Authenticate. %v %v", ctx, token)
+	return
+}
+
+// AuthenticateAfterCounter returns a count of finished BotServiceMock.Authenticate invocations
+func (mmAuthenticate *BotServiceMock) AuthenticateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.afterAuthenticateCounter)
+}
+
+// AuthenticateBeforeCounter returns a count of BotServiceMock.Authenticate invocations
+func (mmAuthenticate *BotServiceMock) AuthenticateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticate.beforeAuthenticateCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotServiceMock.Authenticate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAuthenticate *mBotServiceMockAuthenticate) Calls() []*BotServiceMockAuthenticateParams {
+	mmAuthenticate.mutex.RLock()
+
+	argCopy := make([]*BotServiceMockAuthenticateParams, len(mmAuthenticate.callArgs))
+	copy(argCopy, mmAuthenticate.callArgs)
+
+	mmAuthenticate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAuthenticateDone returns true if the count of the Authenticate invocations corresponds
+// the number of defined expectations
+func (m *BotServiceMock) MinimockAuthenticateDone() bool {
+	if m.AuthenticateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AuthenticateMock.invocationsDone()
+}
+
+// MinimockAuthenticateInspect logs each unmet expectation
+func (m *BotServiceMock) MinimockAuthenticateInspect() {
+	for _, e := range m.AuthenticateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotServiceMock.Authenticate with params: %#v", *e.params)
+		}
+	}
+
+	afterAuthenticateCounter := mm_atomic.LoadUint64(&m.afterAuthenticateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AuthenticateMock.defaultExpectation != nil && afterAuthenticateCounter < 1 {
+		if m.AuthenticateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BotServiceMock.Authenticate")
+		} else {
+			m.t.Errorf("Expected call to BotServiceMock.Authenticate with params: %#v", *m.AuthenticateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAuthenticate != nil && afterAuthenticateCounter < 1 {
+		m.t.Error("Expected call to BotServiceMock.Authenticate")
+	}
+
+	if !m.AuthenticateMock.invocationsDone() && afterAuthenticateCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotServiceMock.Authenticate but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateMock.expectedInvocations), afterAuthenticateCounter)
+	}
+}
+
+type mBotServiceMockCreateBot struct {
+	optional           bool
+	mock               *BotServiceMock
+	defaultExpectation *BotServiceMockCreateBotExpectation
+	expectations       []*BotServiceMockCreateBotExpectation
+
+	callArgs []*BotServiceMockCreateBotParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BotServiceMockCreateBotExpectation specifies expectation struct of the BotService.CreateBot
+type BotServiceMockCreateBotExpectation struct {
+	mock      *BotServiceMock
+	params    *BotServiceMockCreateBotParams
+	paramPtrs *BotServiceMockCreateBotParamPtrs
+	results   *BotServiceMockCreateBotResults
+	Counter   uint64
+}
+
+// BotServiceMockCreateBotParams contains parameters of the BotService.CreateBot
+type BotServiceMockCreateBotParams struct {
+	ctx    context.Context
+	params model.CreateBotParams
+}
+
+// BotServiceMockCreateBotParamPtrs contains pointers to parameters of the BotService.CreateBot
+type BotServiceMockCreateBotParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateBotParams
+}
+
+// BotServiceMockCreateBotResults contains results of the BotService.CreateBot
+type BotServiceMockCreateBotResults struct {
+	resp model.CreateBotResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateBot *mBotServiceMockCreateBot) Optional() *mBotServiceMockCreateBot {
+	mmCreateBot.optional = true
+	return mmCreateBot
+}
+
+// Expect sets up expected params for BotService.CreateBot
+func (mmCreateBot *mBotServiceMockCreateBot) Expect(ctx context.Context, params model.CreateBotParams) *mBotServiceMockCreateBot {
+	if mmCreateBot.mock.funcCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("BotServiceMock.CreateBot mock is already set by Set")
+	}
+
+	if mmCreateBot.defaultExpectation == nil {
+		mmCreateBot.defaultExpectation = &BotServiceMockCreateBotExpectation{}
+	}
+
+	if mmCreateBot.defaultExpectation.paramPtrs != nil {
+		mmCreateBot.mock.t.Fatalf("BotServiceMock.CreateBot mock is already set by ExpectParams functions")
+	}
+
+	mmCreateBot.defaultExpectation.params = &BotServiceMockCreateBotParams{ctx, params}
+	for _, e := range mmCreateBot.expectations {
+		if minimock.Equal(e.params, mmCreateBot.defaultExpectation.params) {
+			mmCreateBot.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateBot.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateBot
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotService.CreateBot
+func (mmCreateBot *mBotServiceMockCreateBot) ExpectCtxParam1(ctx context.Context) *mBotServiceMockCreateBot {
+	if mmCreateBot.mock.funcCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("BotServiceMock.CreateBot mock is already set by Set")
+	}
+
+	if mmCreateBot.defaultExpectation == nil {
+		mmCreateBot.defaultExpectation = &BotServiceMockCreateBotExpectation{}
+	}
+
+	if mmCreateBot.defaultExpectation.params != nil {
+		mmCreateBot.mock.t.Fatalf("BotServiceMock.CreateBot mock is already set by Expect")
+	}
+
+	if mmCreateBot.defaultExpectation.paramPtrs == nil {
+		mmCreateBot.defaultExpectation.paramPtrs = &BotServiceMockCreateBotParamPtrs{}
+	}
+	mmCreateBot.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateBot
+}
+
+// ExpectParamsParam2 sets up expected param params for BotService.CreateBot
+func (mmCreateBot *mBotServiceMockCreateBot) ExpectParamsParam2(params model.CreateBotParams) *mBotServiceMockCreateBot {
+	if mmCreateBot.mock.funcCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("BotServiceMock.CreateBot mock is already set by Set")
+	}
+
+	if mmCreateBot.defaultExpectation == nil {
+		mmCreateBot.defaultExpectation = &BotServiceMockCreateBotExpectation{}
+	}
+
+	if mmCreateBot.defaultExpectation.params != nil {
+		mmCreateBot.mock.t.Fatalf("BotServiceMock.CreateBot mock is already set by Expect")
+	}
+
+	if mmCreateBot.defaultExpectation.paramPtrs == nil {
+		mmCreateBot.defaultExpectation.paramPtrs = &BotServiceMockCreateBotParamPtrs{}
+	}
+	mmCreateBot.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateBot
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotService.CreateBot
+func (mmCreateBot *mBotServiceMockCreateBot) Inspect(f func(ctx context.Context, params model.CreateBotParams)) *mBotServiceMockCreateBot {
+	if mmCreateBot.mock.inspectFuncCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("Inspect function is already set for BotServiceMock.CreateBot")
+	}
+
+	mmCreateBot.mock.inspectFuncCreateBot = f
+
+	return mmCreateBot
+}
+
+// Return sets up results that will be returned by BotService.CreateBot
+func (mmCreateBot *mBotServiceMockCreateBot) Return(resp model.CreateBotResponse, err error) *BotServiceMock {
+	if mmCreateBot.mock.funcCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("BotServiceMock.CreateBot mock is already set by Set")
+	}
+
+	if mmCreateBot.defaultExpectation == nil {
+		mmCreateBot.defaultExpectation = &BotServiceMockCreateBotExpectation{mock: mmCreateBot.mock}
+	}
+	mmCreateBot.defaultExpectation.results = &BotServiceMockCreateBotResults{resp, err}
+	return mmCreateBot.mock
+}
+
+// Set uses given function f to mock the BotService.CreateBot method
+func (mmCreateBot *mBotServiceMockCreateBot) Set(f func(ctx context.Context, params model.CreateBotParams) (resp model.CreateBotResponse, err error)) *BotServiceMock {
+	if mmCreateBot.defaultExpectation != nil {
+		mmCreateBot.mock.t.Fatalf("Default expectation is already set for the BotService.CreateBot method")
+	}
+
+	if len(mmCreateBot.expectations) > 0 {
+		mmCreateBot.mock.t.Fatalf("Some expectations are already set for the BotService.CreateBot method")
+	}
+
+	mmCreateBot.mock.funcCreateBot = f
+	return mmCreateBot.mock
+}
+
+// When sets expectation for the BotService.CreateBot which will trigger the result defined by the following
+// Then helper
+func (mmCreateBot *mBotServiceMockCreateBot) When(ctx context.Context, params model.CreateBotParams) *BotServiceMockCreateBotExpectation {
+	if mmCreateBot.mock.funcCreateBot != nil {
+		mmCreateBot.mock.t.Fatalf("BotServiceMock.CreateBot mock is already set by Set")
+	}
+
+	expectation := &BotServiceMockCreateBotExpectation{
+		mock:   mmCreateBot.mock,
+		params: &BotServiceMockCreateBotParams{ctx, params},
+	}
+	mmCreateBot.expectations = append(mmCreateBot.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotService.CreateBot return parameters for the expectation previously defined by the When method
+func (e *BotServiceMockCreateBotExpectation) Then(resp model.CreateBotResponse, err error) *BotServiceMock {
+	e.results = &BotServiceMockCreateBotResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times BotService.CreateBot should be invoked
+func (mmCreateBot *mBotServiceMockCreateBot) Times(n uint64) *mBotServiceMockCreateBot {
+	if n == 0 {
+		mmCreateBot.mock.t.Fatalf("Times of BotServiceMock.CreateBot mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateBot.expectedInvocations, n)
+	return mmCreateBot
+}
+
+func (mmCreateBot *mBotServiceMockCreateBot) invocationsDone() bool {
+	if len(mmCreateBot.expectations) == 0 && mmCreateBot.defaultExpectation == nil && mmCreateBot.mock.funcCreateBot == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateBot.mock.afterCreateBotCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateBot.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateBot implements service.BotService
+func (mmCreateBot *BotServiceMock) CreateBot(ctx context.Context, params model.CreateBotParams) (resp model.CreateBotResponse, err error) {
+	mm_atomic.AddUint64(&mmCreateBot.beforeCreateBotCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateBot.afterCreateBotCounter, 1)
+
+	if mmCreateBot.inspectFuncCreateBot != nil {
+		mmCreateBot.inspectFuncCreateBot(ctx, params)
+	}
+
+	mm_params := BotServiceMockCreateBotParams{ctx, params}
+
+	// Record call args
+	mmCreateBot.CreateBotMock.mutex.Lock()
+	mmCreateBot.CreateBotMock.callArgs = append(mmCreateBot.CreateBotMock.callArgs, &mm_params)
+	mmCreateBot.CreateBotMock.mutex.Unlock()
+
+	for _, e := range mmCreateBot.CreateBotMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmCreateBot.CreateBotMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateBot.CreateBotMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateBot.CreateBotMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateBot.CreateBotMock.defaultExpectation.paramPtrs
+
+		mm_got := BotServiceMockCreateBotParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateBot.t.Errorf("BotServiceMock.CreateBot got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateBot.t.Errorf("BotServiceMock.CreateBot got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateBot.t.Errorf("BotServiceMock.CreateBot got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateBot.CreateBotMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateBot.t.Fatal("No results are set for the BotServiceMock.CreateBot")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmCreateBot.funcCreateBot != nil {
+		return mmCreateBot.funcCreateBot(ctx, params)
+	}
+	mmCreateBot.t.Fatalf("Unexpected call to BotServiceMock.CreateBot. %v %v", ctx, params)
+	return
+}
+
+// CreateBotAfterCounter returns a count of finished BotServiceMock.CreateBot invocations
+func (mmCreateBot *BotServiceMock) CreateBotAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateBot.afterCreateBotCounter)
+}
+
+// CreateBotBeforeCounter returns a count of BotServiceMock.CreateBot invocations
+func (mmCreateBot *BotServiceMock) CreateBotBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateBot.beforeCreateBotCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotServiceMock.CreateBot.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateBot *mBotServiceMockCreateBot) Calls() []*BotServiceMockCreateBotParams {
+	mmCreateBot.mutex.RLock()
+
+	argCopy := make([]*BotServiceMockCreateBotParams, len(mmCreateBot.callArgs))
+	copy(argCopy, mmCreateBot.callArgs)
+
+	mmCreateBot.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateBotDone returns true if the count of the CreateBot invocations corresponds
+// the number of defined expectations
+func (m *BotServiceMock) MinimockCreateBotDone() bool {
+	if m.CreateBotMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateBotMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateBotMock.invocationsDone()
+}
+
+// MinimockCreateBotInspect logs each unmet expectation
+func (m *BotServiceMock) MinimockCreateBotInspect() {
+	for _, e := range m.CreateBotMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotServiceMock.CreateBot with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateBotCounter := mm_atomic.LoadUint64(&m.afterCreateBotCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateBotMock.defaultExpectation != nil && afterCreateBotCounter < 1 {
+		if m.CreateBotMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BotServiceMock.CreateBot")
+		} else {
+			m.t.Errorf("Expected call to BotServiceMock.CreateBot with params: %#v", *m.CreateBotMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateBot != nil && afterCreateBotCounter < 1 {
+		m.t.Error("Expected call to BotServiceMock.CreateBot")
+	}
+
+	if !m.CreateBotMock.invocationsDone() && afterCreateBotCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotServiceMock.CreateBot but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateBotMock.expectedInvocations), afterCreateBotCounter)
+	}
+}
+
+type mBotServiceMockDeleteBot struct {
+	optional           bool
+	mock               *BotServiceMock
+	defaultExpectation *BotServiceMockDeleteBotExpectation
+	expectations       []*BotServiceMockDeleteBotExpectation
+
+	callArgs []*BotServiceMockDeleteBotParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BotServiceMockDeleteBotExpectation specifies expectation struct of the BotService.DeleteBot
+type BotServiceMockDeleteBotExpectation struct {
+	mock      *BotServiceMock
+	params    *BotServiceMockDeleteBotParams
+	paramPtrs *BotServiceMockDeleteBotParamPtrs
+	results   *BotServiceMockDeleteBotResults
+	Counter   uint64
+}
+
+// BotServiceMockDeleteBotParams contains parameters of the BotService.DeleteBot
+type BotServiceMockDeleteBotParams struct {
+	ctx    context.Context
+	params model.DeleteBotParams
+}
+
+// BotServiceMockDeleteBotParamPtrs contains pointers to parameters of the BotService.DeleteBot
+type BotServiceMockDeleteBotParamPtrs struct {
+	ctx    *context.Context
+	params *model.DeleteBotParams
+}
+
+// BotServiceMockDeleteBotResults contains results of the BotService.DeleteBot
+type BotServiceMockDeleteBotResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteBot *mBotServiceMockDeleteBot) Optional() *mBotServiceMockDeleteBot {
+	mmDeleteBot.optional = true
+	return mmDeleteBot
+}
+
+// Expect sets up expected params for BotService.DeleteBot
+func (mmDeleteBot *mBotServiceMockDeleteBot) Expect(ctx context.Context, params model.DeleteBotParams) *mBotServiceMockDeleteBot {
+	if mmDeleteBot.mock.funcDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("BotServiceMock.DeleteBot mock is already set by Set")
+	}
+
+	if mmDeleteBot.defaultExpectation == nil {
+		mmDeleteBot.defaultExpectation = &BotServiceMockDeleteBotExpectation{}
+	}
+
+	if mmDeleteBot.defaultExpectation.paramPtrs != nil {
+		mmDeleteBot.mock.t.Fatalf("BotServiceMock.DeleteBot mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteBot.defaultExpectation.params = &BotServiceMockDeleteBotParams{ctx, params}
+	for _, e := range mmDeleteBot.expectations {
+		if minimock.Equal(e.params, mmDeleteBot.defaultExpectation.params) {
+			mmDeleteBot.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteBot.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteBot
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotService.DeleteBot
+func (mmDeleteBot *mBotServiceMockDeleteBot) ExpectCtxParam1(ctx context.Context) *mBotServiceMockDeleteBot {
+	if mmDeleteBot.mock.funcDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("BotServiceMock.DeleteBot mock is already set by Set")
+	}
+
+	if mmDeleteBot.defaultExpectation == nil {
+		mmDeleteBot.defaultExpectation = &BotServiceMockDeleteBotExpectation{}
+	}
+
+	if mmDeleteBot.defaultExpectation.params != nil {
+		mmDeleteBot.mock.t.Fatalf("BotServiceMock.DeleteBot mock is already set by Expect")
+	}
+
+	if mmDeleteBot.defaultExpectation.paramPtrs == nil {
+		mmDeleteBot.defaultExpectation.paramPtrs = &BotServiceMockDeleteBotParamPtrs{}
+	}
+	mmDeleteBot.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteBot
+}
+
+// ExpectParamsParam2 sets up expected param params for BotService.DeleteBot
+func (mmDeleteBot *mBotServiceMockDeleteBot) ExpectParamsParam2(params model.DeleteBotParams) *mBotServiceMockDeleteBot {
+	if mmDeleteBot.mock.funcDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("BotServiceMock.DeleteBot mock is already set by Set")
+	}
+
+	if mmDeleteBot.defaultExpectation == nil {
+		mmDeleteBot.defaultExpectation = &BotServiceMockDeleteBotExpectation{}
+	}
+
+	if mmDeleteBot.defaultExpectation.params != nil {
+		mmDeleteBot.mock.t.Fatalf("BotServiceMock.DeleteBot mock is already set by Expect")
+	}
+
+	if mmDeleteBot.defaultExpectation.paramPtrs == nil {
+		mmDeleteBot.defaultExpectation.paramPtrs = &BotServiceMockDeleteBotParamPtrs{}
+	}
+	mmDeleteBot.defaultExpectation.paramPtrs.params = &params
+
+	return mmDeleteBot
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotService.DeleteBot
+func (mmDeleteBot *mBotServiceMockDeleteBot) Inspect(f func(ctx context.Context, params model.DeleteBotParams)) *mBotServiceMockDeleteBot {
+	if mmDeleteBot.mock.inspectFuncDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("Inspect function is already set for BotServiceMock.DeleteBot")
+	}
+
+	mmDeleteBot.mock.inspectFuncDeleteBot = f
+
+	return mmDeleteBot
+}
+
+// Return sets up results that will be returned by BotService.DeleteBot
+func (mmDeleteBot *mBotServiceMockDeleteBot) Return(err error) *BotServiceMock {
+	if mmDeleteBot.mock.funcDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("BotServiceMock.DeleteBot mock is already set by Set")
+	}
+
+	if mmDeleteBot.defaultExpectation == nil {
+		mmDeleteBot.defaultExpectation = &BotServiceMockDeleteBotExpectation{mock: mmDeleteBot.mock}
+	}
+	mmDeleteBot.defaultExpectation.results = &BotServiceMockDeleteBotResults{err}
+	return mmDeleteBot.mock
+}
+
+// Set uses given function f to mock the BotService.DeleteBot method
+func (mmDeleteBot *mBotServiceMockDeleteBot) Set(f func(ctx context.Context, params model.DeleteBotParams) (err error)) *BotServiceMock {
+	if mmDeleteBot.defaultExpectation != nil {
+		mmDeleteBot.mock.t.Fatalf("Default expectation is already set for the BotService.DeleteBot method")
+	}
+
+	if len(mmDeleteBot.expectations) > 0 {
+		mmDeleteBot.mock.t.Fatalf("Some expectations are already set for the BotService.DeleteBot method")
+	}
+
+	mmDeleteBot.mock.funcDeleteBot = f
+	return mmDeleteBot.mock
+}
+
+// When sets expectation for the BotService.DeleteBot which will trigger the result defined by the following
+// Then helper
+func (mmDeleteBot *mBotServiceMockDeleteBot) When(ctx context.Context, params model.DeleteBotParams) *BotServiceMockDeleteBotExpectation {
+	if mmDeleteBot.mock.funcDeleteBot != nil {
+		mmDeleteBot.mock.t.Fatalf("BotServiceMock.DeleteBot mock is already set by Set")
+	}
+
+	expectation := &BotServiceMockDeleteBotExpectation{
+		mock:   mmDeleteBot.mock,
+		params: &BotServiceMockDeleteBotParams{ctx, params},
+	}
+	mmDeleteBot.expectations = append(mmDeleteBot.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotService.DeleteBot return parameters for the expectation previously defined by the When method
+func (e *BotServiceMockDeleteBotExpectation) Then(err error) *BotServiceMock {
+	e.results = &BotServiceMockDeleteBotResults{err}
+	return e.mock
+}
+
+// Times sets number of times BotService.DeleteBot should be invoked
+func (mmDeleteBot *mBotServiceMockDeleteBot) Times(n uint64) *mBotServiceMockDeleteBot {
+	if n == 0 {
+		mmDeleteBot.mock.t.Fatalf("Times of BotServiceMock.DeleteBot mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteBot.expectedInvocations, n)
+	return mmDeleteBot
+}
+
+func (mmDeleteBot *mBotServiceMockDeleteBot) invocationsDone() bool {
+	if len(mmDeleteBot.expectations) == 0 && mmDeleteBot.defaultExpectation == nil && mmDeleteBot.mock.funcDeleteBot == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteBot.mock.afterDeleteBotCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteBot.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteBot implements service.BotService
+func (mmDeleteBot *BotServiceMock) DeleteBot(ctx context.Context, params model.DeleteBotParams) (err error) {
+	mm_atomic.AddUint64(&mmDeleteBot.beforeDeleteBotCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteBot.afterDeleteBotCounter, 1)
+
+	if mmDeleteBot.inspectFuncDeleteBot != nil {
+		mmDeleteBot.inspectFuncDeleteBot(ctx, params)
+	}
+
+	mm_params := BotServiceMockDeleteBotParams{ctx, params}
+
+	// Record call args
+	mmDeleteBot.DeleteBotMock.mutex.Lock()
+	mmDeleteBot.DeleteBotMock.callArgs = append(mmDeleteBot.DeleteBotMock.callArgs, &mm_params)
+	mmDeleteBot.DeleteBotMock.mutex.Unlock()
+
+	for _, e := range mmDeleteBot.DeleteBotMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteBot.DeleteBotMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteBot.DeleteBotMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteBot.DeleteBotMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteBot.DeleteBotMock.defaultExpectation.paramPtrs
+
+		mm_got := BotServiceMockDeleteBotParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteBot.t.Errorf("BotServiceMock.DeleteBot got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmDeleteBot.t.Errorf("BotServiceMock.DeleteBot got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteBot.t.Errorf("BotServiceMock.DeleteBot got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteBot.DeleteBotMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteBot.t.Fatal("No results are set for the BotServiceMock.DeleteBot")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteBot.funcDeleteBot != nil {
+		return mmDeleteBot.funcDeleteBot(ctx, params)
+	}
+	mmDeleteBot.t.Fatalf("Unexpected call to BotServiceMock.DeleteBot. %v %v", ctx, params)
+	return
+}
+
+// DeleteBotAfterCounter returns a count of finished BotServiceMock.DeleteBot invocations
+func (mmDeleteBot *BotServiceMock) DeleteBotAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteBot.afterDeleteBotCounter)
+}
+
+// DeleteBotBeforeCounter returns a count of BotServiceMock.DeleteBot invocations
+func (mmDeleteBot *BotServiceMock) DeleteBotBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteBot.beforeDeleteBotCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotServiceMock.DeleteBot.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteBot *mBotServiceMockDeleteBot) Calls() []*BotServiceMockDeleteBotParams {
+	mmDeleteBot.mutex.RLock()
+
+	argCopy := make([]*BotServiceMockDeleteBotParams, len(mmDeleteBot.callArgs))
+	copy(argCopy, mmDeleteBot.callArgs)
+
+	mmDeleteBot.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteBotDone returns true if the count of the DeleteBot invocations corresponds
+// the number of defined expectations
+func (m *BotServiceMock) MinimockDeleteBotDone() bool {
+	if m.DeleteBotMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteBotMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteBotMock.invocationsDone()
+}
+
+// MinimockDeleteBotInspect logs each unmet expectation
+func (m *BotServiceMock) MinimockDeleteBotInspect() {
+	for _, e := range m.DeleteBotMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotServiceMock.DeleteBot with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteBotCounter := mm_atomic.LoadUint64(&m.afterDeleteBotCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteBotMock.defaultExpectation != nil && afterDeleteBotCounter < 1 {
+		if m.DeleteBotMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BotServiceMock.DeleteBot")
+		} else {
+			m.t.Errorf("Expected call to BotServiceMock.DeleteBot with params: %#v", *m.DeleteBotMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteBot != nil && afterDeleteBotCounter < 1 {
+		m.t.Error("Expected call to BotServiceMock.DeleteBot")
+	}
+
+	if !m.DeleteBotMock.invocationsDone() && afterDeleteBotCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotServiceMock.DeleteBot but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteBotMock.expectedInvocations), afterDeleteBotCounter)
+	}
+}
+
+type mBotServiceMockListBots struct {
+	optional           bool
+	mock               *BotServiceMock
+	defaultExpectation *BotServiceMockListBotsExpectation
+	expectations       []*BotServiceMockListBotsExpectation
+
+	callArgs []*BotServiceMockListBotsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// BotServiceMockListBotsExpectation specifies expectation struct of the BotService.ListBots
+type BotServiceMockListBotsExpectation struct {
+	mock      *BotServiceMock
+	params    *BotServiceMockListBotsParams
+	paramPtrs *BotServiceMockListBotsParamPtrs
+	results   *BotServiceMockListBotsResults
+	Counter   uint64
+}
+
+// BotServiceMockListBotsParams contains parameters of the BotService.ListBots
+type BotServiceMockListBotsParams struct {
+	ctx context.Context
+}
+
+// BotServiceMockListBotsParamPtrs contains pointers to parameters of the BotService.ListBots
+type BotServiceMockListBotsParamPtrs struct {
+	ctx *context.Context
+}
+
+// BotServiceMockListBotsResults contains results of the BotService.ListBots
+type BotServiceMockListBotsResults struct {
+	bots []model.Bot
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListBots *mBotServiceMockListBots) Optional() *mBotServiceMockListBots {
+	mmListBots.optional = true
+	return mmListBots
+}
+
+// Expect sets up expected params for BotService.ListBots
+func (mmListBots *mBotServiceMockListBots) Expect(ctx context.Context) *mBotServiceMockListBots {
+	if mmListBots.mock.funcListBots != nil {
+		mmListBots.mock.t.Fatalf("BotServiceMock.ListBots mock is already set by Set")
+	}
+
+	if mmListBots.defaultExpectation == nil {
+		mmListBots.defaultExpectation = &BotServiceMockListBotsExpectation{}
+	}
+
+	if mmListBots.defaultExpectation.paramPtrs != nil {
+		mmListBots.mock.t.Fatalf("BotServiceMock.ListBots mock is already set by ExpectParams functions")
+	}
+
+	mmListBots.defaultExpectation.params = &BotServiceMockListBotsParams{ctx}
+	for _, e := range mmListBots.expectations {
+		if minimock.Equal(e.params, mmListBots.defaultExpectation.params) {
+			mmListBots.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListBots.defaultExpectation.params)
+		}
+	}
+
+	return mmListBots
+}
+
+// ExpectCtxParam1 sets up expected param ctx for BotService.ListBots
+func (mmListBots *mBotServiceMockListBots) ExpectCtxParam1(ctx context.Context) *mBotServiceMockListBots {
+	if mmListBots.mock.funcListBots != nil {
+		mmListBots.mock.t.Fatalf("BotServiceMock.ListBots mock is already set by Set")
+	}
+
+	if mmListBots.defaultExpectation == nil {
+		mmListBots.defaultExpectation = &BotServiceMockListBotsExpectation{}
+	}
+
+	if mmListBots.defaultExpectation.params != nil {
+		mmListBots.mock.t.Fatalf("BotServiceMock.ListBots mock is already set by Expect")
+	}
+
+	if mmListBots.defaultExpectation.paramPtrs == nil {
+		mmListBots.defaultExpectation.paramPtrs = &BotServiceMockListBotsParamPtrs{}
+	}
+	mmListBots.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListBots
+}
+
+// Inspect accepts an inspector function that has same arguments as the BotService.ListBots
+func (mmListBots *mBotServiceMockListBots) Inspect(f func(ctx context.Context)) *mBotServiceMockListBots {
+	if mmListBots.mock.inspectFuncListBots != nil {
+		mmListBots.mock.t.Fatalf("Inspect function is already set for BotServiceMock.ListBots")
+	}
+
+	mmListBots.mock.inspectFuncListBots = f
+
+	return mmListBots
+}
+
+// Return sets up results that will be returned by BotService.ListBots
+func (mmListBots *mBotServiceMockListBots) Return(bots []model.Bot, err error) *BotServiceMock {
+	if mmListBots.mock.funcListBots != nil {
+		mmListBots.mock.t.Fatalf("BotServiceMock.ListBots mock is already set by Set")
+	}
+
+	if mmListBots.defaultExpectation == nil {
+		mmListBots.defaultExpectation = &BotServiceMockListBotsExpectation{mock: mmListBots.mock}
+	}
+	mmListBots.defaultExpectation.results = &BotServiceMockListBotsResults{bots, err}
+	return mmListBots.mock
+}
+
+// Set uses given function f to mock the BotService.ListBots method
+func (mmListBots *mBotServiceMockListBots) Set(f func(ctx context.Context) (bots []model.Bot, err error)) *BotServiceMock {
+	if mmListBots.defaultExpectation != nil {
+		mmListBots.mock.t.Fatalf("Default expectation is already set for the BotService.ListBots method")
+	}
+
+	if len(mmListBots.expectations) > 0 {
+		mmListBots.mock.t.Fatalf("Some expectations are already set for the BotService.ListBots method")
+	}
+
+	mmListBots.mock.funcListBots = f
+	return mmListBots.mock
+}
+
+// When sets expectation for the BotService.ListBots which will trigger the result defined by the following
+// Then helper
+func (mmListBots *mBotServiceMockListBots) When(ctx context.Context) *BotServiceMockListBotsExpectation {
+	if mmListBots.mock.funcListBots != nil {
+		mmListBots.mock.t.Fatalf("BotServiceMock.ListBots mock is already set by Set")
+	}
+
+	expectation := &BotServiceMockListBotsExpectation{
+		mock:   mmListBots.mock,
+		params: &BotServiceMockListBotsParams{ctx},
+	}
+	mmListBots.expectations = append(mmListBots.expectations, expectation)
+	return expectation
+}
+
+// Then sets up BotService.ListBots return parameters for the expectation previously defined by the When method
+func (e *BotServiceMockListBotsExpectation) Then(bots []model.Bot, err error) *BotServiceMock {
+	e.results = &BotServiceMockListBotsResults{bots, err}
+	return e.mock
+}
+
+// Times sets number of times BotService.ListBots should be invoked
+func (mmListBots *mBotServiceMockListBots) Times(n uint64) *mBotServiceMockListBots {
+	if n == 0 {
+		mmListBots.mock.t.Fatalf("Times of BotServiceMock.ListBots mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListBots.expectedInvocations, n)
+	return mmListBots
+}
+
+func (mmListBots *mBotServiceMockListBots) invocationsDone() bool {
+	if len(mmListBots.expectations) == 0 && mmListBots.defaultExpectation == nil && mmListBots.mock.funcListBots == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListBots.mock.afterListBotsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListBots.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListBots implements service.BotService
+func (mmListBots *BotServiceMock) ListBots(ctx context.Context) (bots []model.Bot, err error) {
+	mm_atomic.AddUint64(&mmListBots.beforeListBotsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListBots.afterListBotsCounter, 1)
+
+	if mmListBots.inspectFuncListBots != nil {
+		mmListBots.inspectFuncListBots(ctx)
+	}
+
+	mm_params := BotServiceMockListBotsParams{ctx}
+
+	// Record call args
+	mmListBots.ListBotsMock.mutex.Lock()
+	mmListBots.ListBotsMock.callArgs = append(mmListBots.ListBotsMock.callArgs, &mm_params)
+	mmListBots.ListBotsMock.mutex.Unlock()
+
+	for _, e := range mmListBots.ListBotsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.bots, e.results.err
+		}
+	}
+
+	if mmListBots.ListBotsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListBots.ListBotsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListBots.ListBotsMock.defaultExpectation.params
+		mm_want_ptrs := mmListBots.ListBotsMock.defaultExpectation.paramPtrs
+
+		mm_got := BotServiceMockListBotsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListBots.t.Errorf("BotServiceMock.ListBots got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListBots.t.Errorf("BotServiceMock.ListBots got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListBots.ListBotsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListBots.t.Fatal("No results are set for the BotServiceMock.ListBots")
+		}
+		return (*mm_results).bots, (*mm_results).err
+	}
+	if mmListBots.funcListBots != nil {
+		return mmListBots.funcListBots(ctx)
+	}
+	mmListBots.t.Fatalf("Unexpected call to BotServiceMock.ListBots. %v", ctx)
+	return
+}
+
+// ListBotsAfterCounter returns a count of finished BotServiceMock.ListBots invocations
+func (mmListBots *BotServiceMock) ListBotsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBots.afterListBotsCounter)
+}
+
+// ListBotsBeforeCounter returns a count of BotServiceMock.ListBots invocations
+func (mmListBots *BotServiceMock) ListBotsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBots.beforeListBotsCounter)
+}
+
+// Calls returns a list of arguments used in each call to BotServiceMock.ListBots.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListBots *mBotServiceMockListBots) Calls() []*BotServiceMockListBotsParams {
+	mmListBots.mutex.RLock()
+
+	argCopy := make([]*BotServiceMockListBotsParams, len(mmListBots.callArgs))
+	copy(argCopy, mmListBots.callArgs)
+
+	mmListBots.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListBotsDone returns true if the count of the ListBots invocations corresponds
+// the number of defined expectations
+func (m *BotServiceMock) MinimockListBotsDone() bool {
+	if m.ListBotsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListBotsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListBotsMock.invocationsDone()
+}
+
+// MinimockListBotsInspect logs each unmet expectation
+func (m *BotServiceMock) MinimockListBotsInspect() {
+	for _, e := range m.ListBotsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to BotServiceMock.ListBots with params: %#v", *e.params)
+		}
+	}
+
+	afterListBotsCounter := mm_atomic.LoadUint64(&m.afterListBotsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListBotsMock.defaultExpectation != nil && afterListBotsCounter < 1 {
+		if m.ListBotsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to BotServiceMock.ListBots")
+		} else {
+			m.t.Errorf("Expected call to BotServiceMock.ListBots with params: %#v", *m.ListBotsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListBots != nil && afterListBotsCounter < 1 {
+		m.t.Error("Expected call to BotServiceMock.ListBots")
+	}
+
+	if !m.ListBotsMock.invocationsDone() && afterListBotsCounter > 0 {
+		m.t.Errorf("Expected %d calls to BotServiceMock.ListBots but found %d calls",
+			mm_atomic.LoadUint64(&m.ListBotsMock.expectedInvocations), afterListBotsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *BotServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAuthenticateInspect()
+
+			m.MinimockCreateBotInspect()
+
+			m.MinimockDeleteBotInspect()
+
+			m.MinimockListBotsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *BotServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *BotServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAuthenticateDone() &&
+		m.MinimockCreateBotDone() &&
+		m.MinimockDeleteBotDone() &&
+		m.MinimockListBotsDone()
+}
@@ -31,11 +31,47 @@ type ChatServiceMock struct {
 	beforeDeleteChatCounter uint64
 	DeleteChatMock          mChatServiceMockDeleteChat
 
+	funcListChats          func(ctx context.Context, params model.ListChatsParams) (chats []model.UserChat, err error)
+	inspectFuncListChats   func(ctx context.Context, params model.ListChatsParams)
+	afterListChatsCounter  uint64
+	beforeListChatsCounter uint64
+	ListChatsMock          mChatServiceMockListChats
+
+	funcListMentions          func(ctx context.Context, params model.ListMentionsParams) (mentions []model.Mention, err error)
+	inspectFuncListMentions   func(ctx context.Context, params model.ListMentionsParams)
+	afterListMentionsCounter  uint64
+	beforeListMentionsCounter uint64
+	ListMentionsMock          mChatServiceMockListMentions
+
+	funcListMessages          func(ctx context.Context, params model.ListMessagesParams) (messages []model.Message, err error)
+	inspectFuncListMessages   func(ctx context.Context, params model.ListMessagesParams)
+	afterListMessagesCounter  uint64
+	beforeListMessagesCounter uint64
+	ListMessagesMock          mChatServiceMockListMessages
+
+	funcPostMessage          func(ctx context.Context, params model.SendMessageParams) (err error)
+	inspectFuncPostMessage   func(ctx context.Context, params model.SendMessageParams)
+	afterPostMessageCounter  uint64
+	beforePostMessageCounter uint64
+	PostMessageMock          mChatServiceMockPostMessage
+
+	funcRunCommand          func(ctx context.Context, params model.SendMessageParams)
+	inspectFuncRunCommand   func(ctx context.Context, params model.SendMessageParams)
+	afterRunCommandCounter  uint64
+	beforeRunCommandCounter uint64
+	RunCommandMock          mChatServiceMockRunCommand
+
 	funcSendMessage          func(ctx context.Context, params model.SendMessageParams) (err error)
 	inspectFuncSendMessage   func(ctx context.Context, params model.SendMessageParams)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mChatServiceMockSendMessage
+
+	funcUpdateChatSettings          func(ctx context.Context, params model.UpdateChatSettingsParams) (settings model.ChatSettings, err error)
+	inspectFuncUpdateChatSettings   func(ctx context.Context, params model.UpdateChatSettingsParams)
+	afterUpdateChatSettingsCounter  uint64
+	beforeUpdateChatSettingsCounter uint64
+	UpdateChatSettingsMock          mChatServiceMockUpdateChatSettings
 }
 
 // NewChatServiceMock returns a mock for service.ChatService
@@ -52,9 +88,27 @@ func NewChatServiceMock(t minimock.Tester) *ChatServiceMock {
 	m.DeleteChatMock = mChatServiceMockDeleteChat{mock: m}
 	m.DeleteChatMock.callArgs = []*ChatServiceMockDeleteChatParams{}
 
+	m.ListChatsMock = mChatServiceMockListChats{mock: m}
+	m.ListChatsMock.callArgs = []*ChatServiceMockListChatsParams{}
+
+	m.ListMentionsMock = mChatServiceMockListMentions{mock: m}
+	m.ListMentionsMock.callArgs = []*ChatServiceMockListMentionsParams{}
+
+	m.ListMessagesMock = mChatServiceMockListMessages{mock: m}
+	m.ListMessagesMock.callArgs = []*ChatServiceMockListMessagesParams{}
+
+	m.PostMessageMock = mChatServiceMockPostMessage{mock: m}
+	m.PostMessageMock.callArgs = []*ChatServiceMockPostMessageParams{}
+
+	m.RunCommandMock = mChatServiceMockRunCommand{mock: m}
+	m.RunCommandMock.callArgs = []*ChatServiceMockRunCommandParams{}
+
 	m.SendMessageMock = mChatServiceMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatServiceMockSendMessageParams{}
 
+	m.UpdateChatSettingsMock = mChatServiceMockUpdateChatSettings{mock: m}
+	m.UpdateChatSettingsMock.callArgs = []*ChatServiceMockUpdateChatSettingsParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -701,42 +755,43 @@ func (m *ChatServiceMock) MinimockDeleteChatInspect() {
 	}
 }
 
-type mChatServiceMockSendMessage struct {
+type mChatServiceMockListChats struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockSendMessageExpectation
-	expectations       []*ChatServiceMockSendMessageExpectation
+	defaultExpectation *ChatServiceMockListChatsExpectation
+	expectations       []*ChatServiceMockListChatsExpectation
 
-	callArgs []*ChatServiceMockSendMessageParams
+	callArgs []*ChatServiceMockListChatsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
-type ChatServiceMockSendMessageExpectation struct {
+// ChatServiceMockListChatsExpectation specifies expectation struct of the ChatService.ListChats
+type ChatServiceMockListChatsExpectation struct {
 	mock      *ChatServiceMock
-	params    *ChatServiceMockSendMessageParams
-	paramPtrs *ChatServiceMockSendMessageParamPtrs
-	results   *ChatServiceMockSendMessageResults
+	params    *ChatServiceMockListChatsParams
+	paramPtrs *ChatServiceMockListChatsParamPtrs
+	results   *ChatServiceMockListChatsResults
 	Counter   uint64
 }
 
-// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParams struct {
+// ChatServiceMockListChatsParams contains parameters of the ChatService.ListChats
+type ChatServiceMockListChatsParams struct {
 	ctx    context.Context
-	params model.SendMessageParams
+	params model.ListChatsParams
 }
 
-// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParamPtrs struct {
+// ChatServiceMockListChatsParamPtrs contains pointers to parameters of the ChatService.ListChats
+type ChatServiceMockListChatsParamPtrs struct {
 	ctx    *context.Context
-	params *model.SendMessageParams
+	params *model.ListChatsParams
 }
 
-// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
-type ChatServiceMockSendMessageResults struct {
-	err error
+// ChatServiceMockListChatsResults contains results of the ChatService.ListChats
+type ChatServiceMockListChatsResults struct {
+	chats []model.UserChat
+	err   error
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -744,292 +799,2199 @@ type ChatServiceMockSendMessageResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
-	mmSendMessage.optional = true
-	return mmSendMessage
+func (mmListChats *mChatServiceMockListChats) Optional() *mChatServiceMockListChats {
+	mmListChats.optional = true
+	return mmListChats
 }
 
-// Expect sets up expected params for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, params model.SendMessageParams) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Expect sets up expected params for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Expect(ctx context.Context, params model.ListChatsParams) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	if mmListChats.defaultExpectation.paramPtrs != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by ExpectParams functions")
 	}
 
-	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, params}
-	for _, e := range mmSendMessage.expectations {
-		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
-			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+	mmListChats.defaultExpectation.params = &ChatServiceMockListChatsParams{ctx, params}
+	for _, e := range mmListChats.expectations {
+		if minimock.Equal(e.params, mmListChats.defaultExpectation.params) {
+			mmListChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChats.defaultExpectation.params)
 		}
 	}
 
-	return mmSendMessage
+	return mmListChats
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatServiceMockListChatsParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListChats.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmSendMessage
+	return mmListChats
 }
 
-// ExpectParamsParam2 sets up expected param params for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectParamsParam2(params model.SendMessageParams) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectParamsParam2 sets up expected param params for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) ExpectParamsParam2(params model.ListChatsParams) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatServiceMockListChatsParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.params = &params
+	mmListChats.defaultExpectation.paramPtrs.params = &params
 
-	return mmSendMessage
+	return mmListChats
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, params model.SendMessageParams)) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.inspectFuncSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Inspect(f func(ctx context.Context, params model.ListChatsParams)) *mChatServiceMockListChats {
+	if mmListChats.mock.inspectFuncListChats != nil {
+		mmListChats.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListChats")
 	}
 
-	mmSendMessage.mock.inspectFuncSendMessage = f
+	mmListChats.mock.inspectFuncListChats = f
 
-	return mmSendMessage
+	return mmListChats
 }
 
-// Return sets up results that will be returned by ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Return(err error) *ChatServiceMock {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Return sets up results that will be returned by ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Return(chats []model.UserChat, err error) *ChatServiceMock {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{mock: mmListChats.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{err}
-	return mmSendMessage.mock
+	mmListChats.defaultExpectation.results = &ChatServiceMockListChatsResults{chats, err}
+	return mmListChats.mock
 }
 
-// Set uses given function f to mock the ChatService.SendMessage method
-func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, params model.SendMessageParams) (err error)) *ChatServiceMock {
-	if mmSendMessage.defaultExpectation != nil {
-		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+// Set uses given function f to mock the ChatService.ListChats method
+func (mmListChats *mChatServiceMockListChats) Set(f func(ctx context.Context, params model.ListChatsParams) (chats []model.UserChat, err error)) *ChatServiceMock {
+	if mmListChats.defaultExpectation != nil {
+		mmListChats.mock.t.Fatalf("Default expectation is already set for the ChatService.ListChats method")
 	}
 
-	if len(mmSendMessage.expectations) > 0 {
-		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	if len(mmListChats.expectations) > 0 {
+		mmListChats.mock.t.Fatalf("Some expectations are already set for the ChatService.ListChats method")
 	}
 
-	mmSendMessage.mock.funcSendMessage = f
-	return mmSendMessage.mock
+	mmListChats.mock.funcListChats = f
+	return mmListChats.mock
 }
 
-// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// When sets expectation for the ChatService.ListChats which will trigger the result defined by the following
 // Then helper
-func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, params model.SendMessageParams) *ChatServiceMockSendMessageExpectation {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+func (mmListChats *mChatServiceMockListChats) When(ctx context.Context, params model.ListChatsParams) *ChatServiceMockListChatsExpectation {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockSendMessageExpectation{
-		mock:   mmSendMessage.mock,
-		params: &ChatServiceMockSendMessageParams{ctx, params},
+	expectation := &ChatServiceMockListChatsExpectation{
+		mock:   mmListChats.mock,
+		params: &ChatServiceMockListChatsParams{ctx, params},
 	}
-	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	mmListChats.expectations = append(mmListChats.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockSendMessageExpectation) Then(err error) *ChatServiceMock {
-	e.results = &ChatServiceMockSendMessageResults{err}
+// Then sets up ChatService.ListChats return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListChatsExpectation) Then(chats []model.UserChat, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListChatsResults{chats, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.SendMessage should be invoked
-func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+// Times sets number of times ChatService.ListChats should be invoked
+func (mmListChats *mChatServiceMockListChats) Times(n uint64) *mChatServiceMockListChats {
 	if n == 0 {
-		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+		mmListChats.mock.t.Fatalf("Times of ChatServiceMock.ListChats mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
-	return mmSendMessage
+	mm_atomic.StoreUint64(&mmListChats.expectedInvocations, n)
+	return mmListChats
 }
 
-func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
-	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+func (mmListChats *mChatServiceMockListChats) invocationsDone() bool {
+	if len(mmListChats.expectations) == 0 && mmListChats.defaultExpectation == nil && mmListChats.mock.funcListChats == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmListChats.mock.afterListChatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChats.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SendMessage implements service.ChatService
-func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, params model.SendMessageParams) (err error) {
-	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
-	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+// ListChats implements service.ChatService
+func (mmListChats *ChatServiceMock) ListChats(ctx context.Context, params model.ListChatsParams) (chats []model.UserChat, err error) {
+	mm_atomic.AddUint64(&mmListChats.beforeListChatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChats.afterListChatsCounter, 1)
 
-	if mmSendMessage.inspectFuncSendMessage != nil {
-		mmSendMessage.inspectFuncSendMessage(ctx, params)
+	if mmListChats.inspectFuncListChats != nil {
+		mmListChats.inspectFuncListChats(ctx, params)
 	}
 
-	mm_params := ChatServiceMockSendMessageParams{ctx, params}
+	mm_params := ChatServiceMockListChatsParams{ctx, params}
 
 	// Record call args
-	mmSendMessage.SendMessageMock.mutex.Lock()
-	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
-	mmSendMessage.SendMessageMock.mutex.Unlock()
+	mmListChats.ListChatsMock.mutex.Lock()
+	mmListChats.ListChatsMock.callArgs = append(mmListChats.ListChatsMock.callArgs, &mm_params)
+	mmListChats.ListChatsMock.mutex.Unlock()
 
-	for _, e := range mmSendMessage.SendMessageMock.expectations {
+	for _, e := range mmListChats.ListChatsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.chats, e.results.err
 		}
 	}
 
-	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
-		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
-		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+	if mmListChats.ListChatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChats.ListChatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChats.ListChatsMock.defaultExpectation.params
+		mm_want_ptrs := mmListChats.ListChatsMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockSendMessageParams{ctx, params}
+		mm_got := ChatServiceMockListChatsParams{ctx, params}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+				mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		mm_results := mmListChats.ListChatsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+			mmListChats.t.Fatal("No results are set for the ChatServiceMock.ListChats")
 		}
-		return (*mm_results).err
+		return (*mm_results).chats, (*mm_results).err
 	}
-	if mmSendMessage.funcSendMessage != nil {
-		return mmSendMessage.funcSendMessage(ctx, params)
+	if mmListChats.funcListChats != nil {
+		return mmListChats.funcListChats(ctx, params)
 	}
-	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, params)
+	mmListChats.t.Fatalf("Unexpected call to ChatServiceMock.ListChats. %v %v", ctx, params)
 	return
 }
 
-// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+// ListChatsAfterCounter returns a count of finished ChatServiceMock.ListChats invocations
+func (mmListChats *ChatServiceMock) ListChatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.afterListChatsCounter)
 }
 
-// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+// ListChatsBeforeCounter returns a count of ChatServiceMock.ListChats invocations
+func (mmListChats *ChatServiceMock) ListChatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.beforeListChatsCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListChats.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
-	mmSendMessage.mutex.RLock()
+func (mmListChats *mChatServiceMockListChats) Calls() []*ChatServiceMockListChatsParams {
+	mmListChats.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
-	copy(argCopy, mmSendMessage.callArgs)
+	argCopy := make([]*ChatServiceMockListChatsParams, len(mmListChats.callArgs))
+	copy(argCopy, mmListChats.callArgs)
 
-	mmSendMessage.mutex.RUnlock()
+	mmListChats.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// MinimockListChatsDone returns true if the count of the ListChats invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockSendMessageDone() bool {
-	if m.SendMessageMock.optional {
+func (m *ChatServiceMock) MinimockListChatsDone() bool {
+	if m.ListChatsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SendMessageMock.expectations {
+	for _, e := range m.ListChatsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SendMessageMock.invocationsDone()
+	return m.ListChatsMock.invocationsDone()
 }
 
-// MinimockSendMessageInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockSendMessageInspect() {
-	for _, e := range m.SendMessageMock.expectations {
+// MinimockListChatsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListChatsInspect() {
+	for _, e := range m.ListChatsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage with params: %#v", *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.ListChats with params: %#v", *e.params)
 		}
 	}
 
-	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	afterListChatsCounter := mm_atomic.LoadUint64(&m.afterListChatsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
-		if m.SendMessageMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to ChatServiceMock.SendMessage")
+	if m.ListChatsMock.defaultExpectation != nil && afterListChatsCounter < 1 {
+		if m.ListChatsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ListChats")
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage with params: %#v", *m.SendMessageMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.ListChats with params: %#v", *m.ListChatsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
-		m.t.Error("Expected call to ChatServiceMock.SendMessage")
+	if m.funcListChats != nil && afterListChatsCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ListChats")
 	}
 
-	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage but found %d calls",
-			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), afterSendMessageCounter)
+	if !m.ListChatsMock.invocationsDone() && afterListChatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListChats but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChatsMock.expectedInvocations), afterListChatsCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *ChatServiceMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateChatInspect()
+type mChatServiceMockListMentions struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListMentionsExpectation
+	expectations       []*ChatServiceMockListMentionsExpectation
 
-			m.MinimockDeleteChatInspect()
+	callArgs []*ChatServiceMockListMentionsParams
+	mutex    sync.RWMutex
 
-			m.MinimockSendMessageInspect()
+	expectedInvocations uint64
+}
+
+// ChatServiceMockListMentionsExpectation specifies expectation struct of the ChatService.ListMentions
+type ChatServiceMockListMentionsExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockListMentionsParams
+	paramPtrs *ChatServiceMockListMentionsParamPtrs
+	results   *ChatServiceMockListMentionsResults
+	Counter   uint64
+}
+
+// ChatServiceMockListMentionsParams contains parameters of the ChatService.ListMentions
+type ChatServiceMockListMentionsParams struct {
+	ctx    context.Context
+	params model.ListMentionsParams
+}
+
+// ChatServiceMockListMentionsParamPtrs contains pointers to parameters of the ChatService.ListMentions
+type ChatServiceMockListMentionsParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListMentionsParams
+}
+
+// ChatServiceMockListMentionsResults contains results of the ChatService.ListMentions
+type ChatServiceMockListMentionsResults struct {
+	mentions []model.Mention
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMentions *mChatServiceMockListMentions) Optional() *mChatServiceMockListMentions {
+	mmListMentions.optional = true
+	return mmListMentions
+}
+
+// Expect sets up expected params for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) Expect(ctx context.Context, params model.ListMentionsParams) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by ExpectParams functions")
+	}
+
+	mmListMentions.defaultExpectation.params = &ChatServiceMockListMentionsParams{ctx, params}
+	for _, e := range mmListMentions.expectations {
+		if minimock.Equal(e.params, mmListMentions.defaultExpectation.params) {
+			mmListMentions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMentions.defaultExpectation.params)
+		}
+	}
+
+	return mmListMentions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &ChatServiceMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListMentions
+}
+
+// ExpectParamsParam2 sets up expected param params for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) ExpectParamsParam2(params model.ListMentionsParams) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &ChatServiceMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.params = &params
+
+	return mmListMentions
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) Inspect(f func(ctx context.Context, params model.ListMentionsParams)) *mChatServiceMockListMentions {
+	if mmListMentions.mock.inspectFuncListMentions != nil {
+		mmListMentions.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListMentions")
+	}
+
+	mmListMentions.mock.inspectFuncListMentions = f
+
+	return mmListMentions
+}
+
+// Return sets up results that will be returned by ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) Return(mentions []model.Mention, err error) *ChatServiceMock {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{mock: mmListMentions.mock}
+	}
+	mmListMentions.defaultExpectation.results = &ChatServiceMockListMentionsResults{mentions, err}
+	return mmListMentions.mock
+}
+
+// Set uses given function f to mock the ChatService.ListMentions method
+func (mmListMentions *mChatServiceMockListMentions) Set(f func(ctx context.Context, params model.ListMentionsParams) (mentions []model.Mention, err error)) *ChatServiceMock {
+	if mmListMentions.defaultExpectation != nil {
+		mmListMentions.mock.t.Fatalf("Default expectation is already set for the ChatService.ListMentions method")
+	}
+
+	if len(mmListMentions.expectations) > 0 {
+		mmListMentions.mock.t.Fatalf("Some expectations are already set for the ChatService.ListMentions method")
+	}
+
+	mmListMentions.mock.funcListMentions = f
+	return mmListMentions.mock
+}
+
+// When sets expectation for the ChatService.ListMentions which will trigger the result defined by the following
+// Then helper
+func (mmListMentions *mChatServiceMockListMentions) When(ctx context.Context, params model.ListMentionsParams) *ChatServiceMockListMentionsExpectation {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListMentionsExpectation{
+		mock:   mmListMentions.mock,
+		params: &ChatServiceMockListMentionsParams{ctx, params},
+	}
+	mmListMentions.expectations = append(mmListMentions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListMentions return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListMentionsExpectation) Then(mentions []model.Mention, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListMentionsResults{mentions, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListMentions should be invoked
+func (mmListMentions *mChatServiceMockListMentions) Times(n uint64) *mChatServiceMockListMentions {
+	if n == 0 {
+		mmListMentions.mock.t.Fatalf("Times of ChatServiceMock.ListMentions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMentions.expectedInvocations, n)
+	return mmListMentions
+}
+
+func (mmListMentions *mChatServiceMockListMentions) invocationsDone() bool {
+	if len(mmListMentions.expectations) == 0 && mmListMentions.defaultExpectation == nil && mmListMentions.mock.funcListMentions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMentions.mock.afterListMentionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMentions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMentions implements service.ChatService
+func (mmListMentions *ChatServiceMock) ListMentions(ctx context.Context, params model.ListMentionsParams) (mentions []model.Mention, err error) {
+	mm_atomic.AddUint64(&mmListMentions.beforeListMentionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMentions.afterListMentionsCounter, 1)
+
+	if mmListMentions.inspectFuncListMentions != nil {
+		mmListMentions.inspectFuncListMentions(ctx, params)
+	}
+
+	mm_params := ChatServiceMockListMentionsParams{ctx, params}
+
+	// Record call args
+	mmListMentions.ListMentionsMock.mutex.Lock()
+	mmListMentions.ListMentionsMock.callArgs = append(mmListMentions.ListMentionsMock.callArgs, &mm_params)
+	mmListMentions.ListMentionsMock.mutex.Unlock()
+
+	for _, e := range mmListMentions.ListMentionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mentions, e.results.err
+		}
+	}
+
+	if mmListMentions.ListMentionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMentions.ListMentionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMentions.ListMentionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListMentions.ListMentionsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListMentionsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMentions.ListMentionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMentions.t.Fatal("No results are set for the ChatServiceMock.ListMentions")
+		}
+		return (*mm_results).mentions, (*mm_results).err
+	}
+	if mmListMentions.funcListMentions != nil {
+		return mmListMentions.funcListMentions(ctx, params)
+	}
+	mmListMentions.t.Fatalf("Unexpected call to ChatServiceMock.ListMentions. %v %v", ctx, params)
+	return
+}
+
+// ListMentionsAfterCounter returns a count of finished ChatServiceMock.ListMentions invocations
+func (mmListMentions *ChatServiceMock) ListMentionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentions.afterListMentionsCounter)
+}
+
+// ListMentionsBeforeCounter returns a count of ChatServiceMock.ListMentions invocations
+func (mmListMentions *ChatServiceMock) ListMentionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentions.beforeListMentionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListMentions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMentions *mChatServiceMockListMentions) Calls() []*ChatServiceMockListMentionsParams {
+	mmListMentions.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListMentionsParams, len(mmListMentions.callArgs))
+	copy(argCopy, mmListMentions.callArgs)
+
+	mmListMentions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMentionsDone returns true if the count of the ListMentions invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListMentionsDone() bool {
+	if m.ListMentionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMentionsMock.invocationsDone()
+}
+
+// MinimockListMentionsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListMentionsInspect() {
+	for _, e := range m.ListMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMentions with params: %#v", *e.params)
+		}
+	}
+
+	afterListMentionsCounter := mm_atomic.LoadUint64(&m.afterListMentionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMentionsMock.defaultExpectation != nil && afterListMentionsCounter < 1 {
+		if m.ListMentionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ListMentions")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMentions with params: %#v", *m.ListMentionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMentions != nil && afterListMentionsCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ListMentions")
+	}
+
+	if !m.ListMentionsMock.invocationsDone() && afterListMentionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListMentions but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMentionsMock.expectedInvocations), afterListMentionsCounter)
+	}
+}
+
+type mChatServiceMockListMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListMessagesExpectation
+	expectations       []*ChatServiceMockListMessagesExpectation
+
+	callArgs []*ChatServiceMockListMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockListMessagesExpectation specifies expectation struct of the ChatService.ListMessages
+type ChatServiceMockListMessagesExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockListMessagesParams
+	paramPtrs *ChatServiceMockListMessagesParamPtrs
+	results   *ChatServiceMockListMessagesResults
+	Counter   uint64
+}
+
+// ChatServiceMockListMessagesParams contains parameters of the ChatService.ListMessages
+type ChatServiceMockListMessagesParams struct {
+	ctx    context.Context
+	params model.ListMessagesParams
+}
+
+// ChatServiceMockListMessagesParamPtrs contains pointers to parameters of the ChatService.ListMessages
+type ChatServiceMockListMessagesParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListMessagesParams
+}
+
+// ChatServiceMockListMessagesResults contains results of the ChatService.ListMessages
+type ChatServiceMockListMessagesResults struct {
+	messages []model.Message
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessages *mChatServiceMockListMessages) Optional() *mChatServiceMockListMessages {
+	mmListMessages.optional = true
+	return mmListMessages
+}
+
+// Expect sets up expected params for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Expect(ctx context.Context, params model.ListMessagesParams) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListMessages.defaultExpectation.params = &ChatServiceMockListMessagesParams{ctx, params}
+	for _, e := range mmListMessages.expectations {
+		if minimock.Equal(e.params, mmListMessages.defaultExpectation.params) {
+			mmListMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListMessages
+}
+
+// ExpectParamsParam2 sets up expected param params for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectParamsParam2(params model.ListMessagesParams) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.params = &params
+
+	return mmListMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Inspect(f func(ctx context.Context, params model.ListMessagesParams)) *mChatServiceMockListMessages {
+	if mmListMessages.mock.inspectFuncListMessages != nil {
+		mmListMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListMessages")
+	}
+
+	mmListMessages.mock.inspectFuncListMessages = f
+
+	return mmListMessages
+}
+
+// Return sets up results that will be returned by ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Return(messages []model.Message, err error) *ChatServiceMock {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{mock: mmListMessages.mock}
+	}
+	mmListMessages.defaultExpectation.results = &ChatServiceMockListMessagesResults{messages, err}
+	return mmListMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.ListMessages method
+func (mmListMessages *mChatServiceMockListMessages) Set(f func(ctx context.Context, params model.ListMessagesParams) (messages []model.Message, err error)) *ChatServiceMock {
+	if mmListMessages.defaultExpectation != nil {
+		mmListMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.ListMessages method")
+	}
+
+	if len(mmListMessages.expectations) > 0 {
+		mmListMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.ListMessages method")
+	}
+
+	mmListMessages.mock.funcListMessages = f
+	return mmListMessages.mock
+}
+
+// When sets expectation for the ChatService.ListMessages which will trigger the result defined by the following
+// Then helper
+func (mmListMessages *mChatServiceMockListMessages) When(ctx context.Context, params model.ListMessagesParams) *ChatServiceMockListMessagesExpectation {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListMessagesExpectation{
+		mock:   mmListMessages.mock,
+		params: &ChatServiceMockListMessagesParams{ctx, params},
+	}
+	mmListMessages.expectations = append(mmListMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListMessagesExpectation) Then(messages []model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListMessagesResults{messages, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListMessages should be invoked
+func (mmListMessages *mChatServiceMockListMessages) Times(n uint64) *mChatServiceMockListMessages {
+	if n == 0 {
+		mmListMessages.mock.t.Fatalf("Times of ChatServiceMock.ListMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessages.expectedInvocations, n)
+	return mmListMessages
+}
+
+func (mmListMessages *mChatServiceMockListMessages) invocationsDone() bool {
+	if len(mmListMessages.expectations) == 0 && mmListMessages.defaultExpectation == nil && mmListMessages.mock.funcListMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessages.mock.afterListMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessages implements service.ChatService
+func (mmListMessages *ChatServiceMock) ListMessages(ctx context.Context, params model.ListMessagesParams) (messages []model.Message, err error) {
+	mm_atomic.AddUint64(&mmListMessages.beforeListMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessages.afterListMessagesCounter, 1)
+
+	if mmListMessages.inspectFuncListMessages != nil {
+		mmListMessages.inspectFuncListMessages(ctx, params)
+	}
+
+	mm_params := ChatServiceMockListMessagesParams{ctx, params}
+
+	// Record call args
+	mmListMessages.ListMessagesMock.mutex.Lock()
+	mmListMessages.ListMessagesMock.callArgs = append(mmListMessages.ListMessagesMock.callArgs, &mm_params)
+	mmListMessages.ListMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListMessages.ListMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.messages, e.results.err
+		}
+	}
+
+	if mmListMessages.ListMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessages.ListMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessages.ListMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessages.ListMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListMessagesParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessages.ListMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessages.t.Fatal("No results are set for the ChatServiceMock.ListMessages")
+		}
+		return (*mm_results).messages, (*mm_results).err
+	}
+	if mmListMessages.funcListMessages != nil {
+		return mmListMessages.funcListMessages(ctx, params)
+	}
+	mmListMessages.t.Fatalf("Unexpected call to ChatServiceMock.ListMessages. %v %v", ctx, params)
+	return
+}
+
+// ListMessagesAfterCounter returns a count of finished ChatServiceMock.ListMessages invocations
+func (mmListMessages *ChatServiceMock) ListMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.afterListMessagesCounter)
+}
+
+// ListMessagesBeforeCounter returns a count of ChatServiceMock.ListMessages invocations
+func (mmListMessages *ChatServiceMock) ListMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.beforeListMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessages *mChatServiceMockListMessages) Calls() []*ChatServiceMockListMessagesParams {
+	mmListMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListMessagesParams, len(mmListMessages.callArgs))
+	copy(argCopy, mmListMessages.callArgs)
+
+	mmListMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessagesDone returns true if the count of the ListMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListMessagesDone() bool {
+	if m.ListMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessagesMock.invocationsDone()
+}
+
+// MinimockListMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListMessagesInspect() {
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterListMessagesCounter := mm_atomic.LoadUint64(&m.afterListMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessagesMock.defaultExpectation != nil && afterListMessagesCounter < 1 {
+		if m.ListMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.ListMessages")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages with params: %#v", *m.ListMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessages != nil && afterListMessagesCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.ListMessages")
+	}
+
+	if !m.ListMessagesMock.invocationsDone() && afterListMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessagesMock.expectedInvocations), afterListMessagesCounter)
+	}
+}
+
+type mChatServiceMockPostMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockPostMessageExpectation
+	expectations       []*ChatServiceMockPostMessageExpectation
+
+	callArgs []*ChatServiceMockPostMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockPostMessageExpectation specifies expectation struct of the ChatService.PostMessage
+type ChatServiceMockPostMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockPostMessageParams
+	paramPtrs *ChatServiceMockPostMessageParamPtrs
+	results   *ChatServiceMockPostMessageResults
+	Counter   uint64
+}
+
+// ChatServiceMockPostMessageParams contains parameters of the ChatService.PostMessage
+type ChatServiceMockPostMessageParams struct {
+	ctx    context.Context
+	params model.SendMessageParams
+}
+
+// ChatServiceMockPostMessageParamPtrs contains pointers to parameters of the ChatService.PostMessage
+type ChatServiceMockPostMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.SendMessageParams
+}
+
+// ChatServiceMockPostMessageResults contains results of the ChatService.PostMessage
+type ChatServiceMockPostMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPostMessage *mChatServiceMockPostMessage) Optional() *mChatServiceMockPostMessage {
+	mmPostMessage.optional = true
+	return mmPostMessage
+}
+
+// Expect sets up expected params for ChatService.PostMessage
+func (mmPostMessage *mChatServiceMockPostMessage) Expect(ctx context.Context, params model.SendMessageParams) *mChatServiceMockPostMessage {
+	if mmPostMessage.mock.funcPostMessage != nil {
+		mmPostMessage.mock.t.Fatalf("ChatServiceMock.PostMessage mock is already set by Set")
+	}
+
+	if mmPostMessage.defaultExpectation == nil {
+		mmPostMessage.defaultExpectation = &ChatServiceMockPostMessageExpectation{}
+	}
+
+	if mmPostMessage.defaultExpectation.paramPtrs != nil {
+		mmPostMessage.mock.t.Fatalf("ChatServiceMock.PostMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPostMessage.defaultExpectation.params = &ChatServiceMockPostMessageParams{ctx, params}
+	for _, e := range mmPostMessage.expectations {
+		if minimock.Equal(e.params, mmPostMessage.defaultExpectation.params) {
+			mmPostMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPostMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPostMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.PostMessage
+func (mmPostMessage *mChatServiceMockPostMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockPostMessage {
+	if mmPostMessage.mock.funcPostMessage != nil {
+		mmPostMessage.mock.t.Fatalf("ChatServiceMock.PostMessage mock is already set by Set")
+	}
+
+	if mmPostMessage.defaultExpectation == nil {
+		mmPostMessage.defaultExpectation = &ChatServiceMockPostMessageExpectation{}
+	}
+
+	if mmPostMessage.defaultExpectation.params != nil {
+		mmPostMessage.mock.t.Fatalf("ChatServiceMock.PostMessage mock is already set by Expect")
+	}
+
+	if mmPostMessage.defaultExpectation.paramPtrs == nil {
+		mmPostMessage.defaultExpectation.paramPtrs = &ChatServiceMockPostMessageParamPtrs{}
+	}
+	mmPostMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPostMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for ChatService.PostMessage
+func (mmPostMessage *mChatServiceMockPostMessage) ExpectParamsParam2(params model.SendMessageParams) *mChatServiceMockPostMessage {
+	if mmPostMessage.mock.funcPostMessage != nil {
+		mmPostMessage.mock.t.Fatalf("ChatServiceMock.PostMessage mock is already set by Set")
+	}
+
+	if mmPostMessage.defaultExpectation == nil {
+		mmPostMessage.defaultExpectation = &ChatServiceMockPostMessageExpectation{}
+	}
+
+	if mmPostMessage.defaultExpectation.params != nil {
+		mmPostMessage.mock.t.Fatalf("ChatServiceMock.PostMessage mock is already set by Expect")
+	}
+
+	if mmPostMessage.defaultExpectation.paramPtrs == nil {
+		mmPostMessage.defaultExpectation.paramPtrs = &ChatServiceMockPostMessageParamPtrs{}
+	}
+	mmPostMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmPostMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.PostMessage
+func (mmPostMessage *mChatServiceMockPostMessage) Inspect(f func(ctx context.Context, params model.SendMessageParams)) *mChatServiceMockPostMessage {
+	if mmPostMessage.mock.inspectFuncPostMessage != nil {
+		mmPostMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.PostMessage")
+	}
+
+	mmPostMessage.mock.inspectFuncPostMessage = f
+
+	return mmPostMessage
+}
+
+// Return sets up results that will be returned by ChatService.PostMessage
+func (mmPostMessage *mChatServiceMockPostMessage) Return(err error) *ChatServiceMock {
+	if mmPostMessage.mock.funcPostMessage != nil {
+		mmPostMessage.mock.t.Fatalf("ChatServiceMock.PostMessage mock is already set by Set")
+	}
+
+	if mmPostMessage.defaultExpectation == nil {
+		mmPostMessage.defaultExpectation = &ChatServiceMockPostMessageExpectation{mock: mmPostMessage.mock}
+	}
+	mmPostMessage.defaultExpectation.results = &ChatServiceMockPostMessageResults{err}
+	return mmPostMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.PostMessage method
+func (mmPostMessage *mChatServiceMockPostMessage) Set(f func(ctx context.Context, params model.SendMessageParams) (err error)) *ChatServiceMock {
+	if mmPostMessage.defaultExpectation != nil {
+		mmPostMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.PostMessage method")
+	}
+
+	if len(mmPostMessage.expectations) > 0 {
+		mmPostMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.PostMessage method")
+	}
+
+	mmPostMessage.mock.funcPostMessage = f
+	return mmPostMessage.mock
+}
+
+// When sets expectation for the ChatService.PostMessage which will trigger the result defined by the following
+// Then helper
+func (mmPostMessage *mChatServiceMockPostMessage) When(ctx context.Context, params model.SendMessageParams) *ChatServiceMockPostMessageExpectation {
+	if mmPostMessage.mock.funcPostMessage != nil {
+		mmPostMessage.mock.t.Fatalf("ChatServiceMock.PostMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockPostMessageExpectation{
+		mock:   mmPostMessage.mock,
+		params: &ChatServiceMockPostMessageParams{ctx, params},
+	}
+	mmPostMessage.expectations = append(mmPostMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.PostMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockPostMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockPostMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.PostMessage should be invoked
+func (mmPostMessage *mChatServiceMockPostMessage) Times(n uint64) *mChatServiceMockPostMessage {
+	if n == 0 {
+		mmPostMessage.mock.t.Fatalf("Times of ChatServiceMock.PostMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPostMessage.expectedInvocations, n)
+	return mmPostMessage
+}
+
+func (mmPostMessage *mChatServiceMockPostMessage) invocationsDone() bool {
+	if len(mmPostMessage.expectations) == 0 && mmPostMessage.defaultExpectation == nil && mmPostMessage.mock.funcPostMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPostMessage.mock.afterPostMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPostMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PostMessage implements service.ChatService
+func (mmPostMessage *ChatServiceMock) PostMessage(ctx context.Context, params model.SendMessageParams) (err error) {
+	mm_atomic.AddUint64(&mmPostMessage.beforePostMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPostMessage.afterPostMessageCounter, 1)
+
+	if mmPostMessage.inspectFuncPostMessage != nil {
+		mmPostMessage.inspectFuncPostMessage(ctx, params)
+	}
+
+	mm_params := ChatServiceMockPostMessageParams{ctx, params}
+
+	// Record call args
+	mmPostMessage.PostMessageMock.mutex.Lock()
+	mmPostMessage.PostMessageMock.callArgs = append(mmPostMessage.PostMessageMock.callArgs, &mm_params)
+	mmPostMessage.PostMessageMock.mutex.Unlock()
+
+	for _, e := range mmPostMessage.PostMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPostMessage.PostMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPostMessage.PostMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPostMessage.PostMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPostMessage.PostMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockPostMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPostMessage.t.Errorf("ChatServiceMock.PostMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmPostMessage.t.Errorf("ChatServiceMock.PostMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPostMessage.t.Errorf("ChatServiceMock.PostMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPostMessage.PostMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPostMessage.t.Fatal("No results are set for the ChatServiceMock.PostMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmPostMessage.funcPostMessage != nil {
+		return mmPostMessage.funcPostMessage(ctx, params)
+	}
+	mmPostMessage.t.Fatalf("Unexpected call to ChatServiceMock.PostMessage. %v %v", ctx, params)
+	return
+}
+
+// PostMessageAfterCounter returns a count of finished ChatServiceMock.PostMessage invocations
+func (mmPostMessage *ChatServiceMock) PostMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPostMessage.afterPostMessageCounter)
+}
+
+// PostMessageBeforeCounter returns a count of ChatServiceMock.PostMessage invocations
+func (mmPostMessage *ChatServiceMock) PostMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPostMessage.beforePostMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.PostMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPostMessage *mChatServiceMockPostMessage) Calls() []*ChatServiceMockPostMessageParams {
+	mmPostMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockPostMessageParams, len(mmPostMessage.callArgs))
+	copy(argCopy, mmPostMessage.callArgs)
+
+	mmPostMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPostMessageDone returns true if the count of the PostMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockPostMessageDone() bool {
+	if m.PostMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PostMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PostMessageMock.invocationsDone()
+}
+
+// MinimockPostMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockPostMessageInspect() {
+	for _, e := range m.PostMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.PostMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterPostMessageCounter := mm_atomic.LoadUint64(&m.afterPostMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PostMessageMock.defaultExpectation != nil && afterPostMessageCounter < 1 {
+		if m.PostMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.PostMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.PostMessage with params: %#v", *m.PostMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPostMessage != nil && afterPostMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.PostMessage")
+	}
+
+	if !m.PostMessageMock.invocationsDone() && afterPostMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.PostMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.PostMessageMock.expectedInvocations), afterPostMessageCounter)
+	}
+}
+
+type mChatServiceMockRunCommand struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRunCommandExpectation
+	expectations       []*ChatServiceMockRunCommandExpectation
+
+	callArgs []*ChatServiceMockRunCommandParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockRunCommandExpectation specifies expectation struct of the ChatService.RunCommand
+type ChatServiceMockRunCommandExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockRunCommandParams
+	paramPtrs *ChatServiceMockRunCommandParamPtrs
+
+	Counter uint64
+}
+
+// ChatServiceMockRunCommandParams contains parameters of the ChatService.RunCommand
+type ChatServiceMockRunCommandParams struct {
+	ctx    context.Context
+	params model.SendMessageParams
+}
+
+// ChatServiceMockRunCommandParamPtrs contains pointers to parameters of the ChatService.RunCommand
+type ChatServiceMockRunCommandParamPtrs struct {
+	ctx    *context.Context
+	params *model.SendMessageParams
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRunCommand *mChatServiceMockRunCommand) Optional() *mChatServiceMockRunCommand {
+	mmRunCommand.optional = true
+	return mmRunCommand
+}
+
+// Expect sets up expected params for ChatService.RunCommand
+func (mmRunCommand *mChatServiceMockRunCommand) Expect(ctx context.Context, params model.SendMessageParams) *mChatServiceMockRunCommand {
+	if mmRunCommand.mock.funcRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Set")
+	}
+
+	if mmRunCommand.defaultExpectation == nil {
+		mmRunCommand.defaultExpectation = &ChatServiceMockRunCommandExpectation{}
+	}
+
+	if mmRunCommand.defaultExpectation.paramPtrs != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by ExpectParams functions")
+	}
+
+	mmRunCommand.defaultExpectation.params = &ChatServiceMockRunCommandParams{ctx, params}
+	for _, e := range mmRunCommand.expectations {
+		if minimock.Equal(e.params, mmRunCommand.defaultExpectation.params) {
+			mmRunCommand.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRunCommand.defaultExpectation.params)
+		}
+	}
+
+	return mmRunCommand
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RunCommand
+func (mmRunCommand *mChatServiceMockRunCommand) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRunCommand {
+	if mmRunCommand.mock.funcRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Set")
+	}
+
+	if mmRunCommand.defaultExpectation == nil {
+		mmRunCommand.defaultExpectation = &ChatServiceMockRunCommandExpectation{}
+	}
+
+	if mmRunCommand.defaultExpectation.params != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Expect")
+	}
+
+	if mmRunCommand.defaultExpectation.paramPtrs == nil {
+		mmRunCommand.defaultExpectation.paramPtrs = &ChatServiceMockRunCommandParamPtrs{}
+	}
+	mmRunCommand.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRunCommand
+}
+
+// ExpectParamsParam2 sets up expected param params for ChatService.RunCommand
+func (mmRunCommand *mChatServiceMockRunCommand) ExpectParamsParam2(params model.SendMessageParams) *mChatServiceMockRunCommand {
+	if mmRunCommand.mock.funcRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Set")
+	}
+
+	if mmRunCommand.defaultExpectation == nil {
+		mmRunCommand.defaultExpectation = &ChatServiceMockRunCommandExpectation{}
+	}
+
+	if mmRunCommand.defaultExpectation.params != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Expect")
+	}
+
+	if mmRunCommand.defaultExpectation.paramPtrs == nil {
+		mmRunCommand.defaultExpectation.paramPtrs = &ChatServiceMockRunCommandParamPtrs{}
+	}
+	mmRunCommand.defaultExpectation.paramPtrs.params = &params
+
+	return mmRunCommand
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RunCommand
+func (mmRunCommand *mChatServiceMockRunCommand) Inspect(f func(ctx context.Context, params model.SendMessageParams)) *mChatServiceMockRunCommand {
+	if mmRunCommand.mock.inspectFuncRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RunCommand")
+	}
+
+	mmRunCommand.mock.inspectFuncRunCommand = f
+
+	return mmRunCommand
+}
+
+// Return sets up results that will be returned by ChatService.RunCommand
+func (mmRunCommand *mChatServiceMockRunCommand) Return() *ChatServiceMock {
+	if mmRunCommand.mock.funcRunCommand != nil {
+		mmRunCommand.mock.t.Fatalf("ChatServiceMock.RunCommand mock is already set by Set")
+	}
+
+	if mmRunCommand.defaultExpectation == nil {
+		mmRunCommand.defaultExpectation = &ChatServiceMockRunCommandExpectation{mock: mmRunCommand.mock}
+	}
+
+	return mmRunCommand.mock
+}
+
+// Set uses given function f to mock the ChatService.RunCommand method
+func (mmRunCommand *mChatServiceMockRunCommand) Set(f func(ctx context.Context, params model.SendMessageParams)) *ChatServiceMock {
+	if mmRunCommand.defaultExpectation != nil {
+		mmRunCommand.mock.t.Fatalf("Default expectation is already set for the ChatService.RunCommand method")
+	}
+
+	if len(mmRunCommand.expectations) > 0 {
+		mmRunCommand.mock.t.Fatalf("Some expectations are already set for the ChatService.RunCommand method")
+	}
+
+	mmRunCommand.mock.funcRunCommand = f
+	return mmRunCommand.mock
+}
+
+// Times sets number of times ChatService.RunCommand should be invoked
+func (mmRunCommand *mChatServiceMockRunCommand) Times(n uint64) *mChatServiceMockRunCommand {
+	if n == 0 {
+		mmRunCommand.mock.t.Fatalf("Times of ChatServiceMock.RunCommand mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRunCommand.expectedInvocations, n)
+	return mmRunCommand
+}
+
+func (mmRunCommand *mChatServiceMockRunCommand) invocationsDone() bool {
+	if len(mmRunCommand.expectations) == 0 && mmRunCommand.defaultExpectation == nil && mmRunCommand.mock.funcRunCommand == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRunCommand.mock.afterRunCommandCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRunCommand.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RunCommand implements service.ChatService
+func (mmRunCommand *ChatServiceMock) RunCommand(ctx context.Context, params model.SendMessageParams) {
+	mm_atomic.AddUint64(&mmRunCommand.beforeRunCommandCounter, 1)
+	defer mm_atomic.AddUint64(&mmRunCommand.afterRunCommandCounter, 1)
+
+	if mmRunCommand.inspectFuncRunCommand != nil {
+		mmRunCommand.inspectFuncRunCommand(ctx, params)
+	}
+
+	mm_params := ChatServiceMockRunCommandParams{ctx, params}
+
+	// Record call args
+	mmRunCommand.RunCommandMock.mutex.Lock()
+	mmRunCommand.RunCommandMock.callArgs = append(mmRunCommand.RunCommandMock.callArgs, &mm_params)
+	mmRunCommand.RunCommandMock.mutex.Unlock()
+
+	for _, e := range mmRunCommand.RunCommandMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmRunCommand.RunCommandMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRunCommand.RunCommandMock.defaultExpectation.Counter, 1)
+		mm_want := mmRunCommand.RunCommandMock.defaultExpectation.params
+		mm_want_ptrs := mmRunCommand.RunCommandMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRunCommandParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRunCommand.t.Errorf("ChatServiceMock.RunCommand got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmRunCommand.t.Errorf("ChatServiceMock.RunCommand got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRunCommand.t.Errorf("ChatServiceMock.RunCommand got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmRunCommand.funcRunCommand != nil {
+		mmRunCommand.funcRunCommand(ctx, params)
+		return
+	}
+	mmRunCommand.t.Fatalf("Unexpected call to ChatServiceMock.RunCommand. %v %v", ctx, params)
+
+}
+
+// RunCommandAfterCounter returns a count of finished ChatServiceMock.RunCommand invocations
+func (mmRunCommand *ChatServiceMock) RunCommandAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunCommand.afterRunCommandCounter)
+}
+
+// RunCommandBeforeCounter returns a count of ChatServiceMock.RunCommand invocations
+func (mmRunCommand *ChatServiceMock) RunCommandBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunCommand.beforeRunCommandCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RunCommand.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRunCommand *mChatServiceMockRunCommand) Calls() []*ChatServiceMockRunCommandParams {
+	mmRunCommand.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRunCommandParams, len(mmRunCommand.callArgs))
+	copy(argCopy, mmRunCommand.callArgs)
+
+	mmRunCommand.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRunCommandDone returns true if the count of the RunCommand invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRunCommandDone() bool {
+	if m.RunCommandMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RunCommandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RunCommandMock.invocationsDone()
+}
+
+// MinimockRunCommandInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRunCommandInspect() {
+	for _, e := range m.RunCommandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RunCommand with params: %#v", *e.params)
+		}
+	}
+
+	afterRunCommandCounter := mm_atomic.LoadUint64(&m.afterRunCommandCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RunCommandMock.defaultExpectation != nil && afterRunCommandCounter < 1 {
+		if m.RunCommandMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.RunCommand")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RunCommand with params: %#v", *m.RunCommandMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRunCommand != nil && afterRunCommandCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.RunCommand")
+	}
+
+	if !m.RunCommandMock.invocationsDone() && afterRunCommandCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RunCommand but found %d calls",
+			mm_atomic.LoadUint64(&m.RunCommandMock.expectedInvocations), afterRunCommandCounter)
+	}
+}
+
+type mChatServiceMockSendMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSendMessageExpectation
+	expectations       []*ChatServiceMockSendMessageExpectation
+
+	callArgs []*ChatServiceMockSendMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
+type ChatServiceMockSendMessageExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockSendMessageParams
+	paramPtrs *ChatServiceMockSendMessageParamPtrs
+	results   *ChatServiceMockSendMessageResults
+	Counter   uint64
+}
+
+// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParams struct {
+	ctx    context.Context
+	params model.SendMessageParams
+}
+
+// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.SendMessageParams
+}
+
+// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
+type ChatServiceMockSendMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
+	mmSendMessage.optional = true
+	return mmSendMessage
+}
+
+// Expect sets up expected params for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, params model.SendMessageParams) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, params}
+	for _, e := range mmSendMessage.expectations {
+		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
+			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSendMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectParamsParam2(params model.SendMessageParams) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmSendMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, params model.SendMessageParams)) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.inspectFuncSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+	}
+
+	mmSendMessage.mock.inspectFuncSendMessage = f
+
+	return mmSendMessage
+}
+
+// Return sets up results that will be returned by ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Return(err error) *ChatServiceMock {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	}
+	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{err}
+	return mmSendMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.SendMessage method
+func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, params model.SendMessageParams) (err error)) *ChatServiceMock {
+	if mmSendMessage.defaultExpectation != nil {
+		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+	}
+
+	if len(mmSendMessage.expectations) > 0 {
+		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	}
+
+	mmSendMessage.mock.funcSendMessage = f
+	return mmSendMessage.mock
+}
+
+// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// Then helper
+func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, params model.SendMessageParams) *ChatServiceMockSendMessageExpectation {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSendMessageExpectation{
+		mock:   mmSendMessage.mock,
+		params: &ChatServiceMockSendMessageParams{ctx, params},
+	}
+	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSendMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSendMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SendMessage should be invoked
+func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+	if n == 0 {
+		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
+	return mmSendMessage
+}
+
+func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
+	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendMessage implements service.ChatService
+func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, params model.SendMessageParams) (err error) {
+	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+
+	if mmSendMessage.inspectFuncSendMessage != nil {
+		mmSendMessage.inspectFuncSendMessage(ctx, params)
+	}
+
+	mm_params := ChatServiceMockSendMessageParams{ctx, params}
+
+	// Record call args
+	mmSendMessage.SendMessageMock.mutex.Lock()
+	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
+	mmSendMessage.SendMessageMock.mutex.Unlock()
+
+	for _, e := range mmSendMessage.SendMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSendMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmSendMessage.funcSendMessage != nil {
+		return mmSendMessage.funcSendMessage(ctx, params)
+	}
+	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, params)
+	return
+}
+
+// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+}
+
+// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
+	mmSendMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
+	copy(argCopy, mmSendMessage.callArgs)
+
+	mmSendMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSendMessageDone() bool {
+	if m.SendMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMessageMock.invocationsDone()
+}
+
+// MinimockSendMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSendMessageInspect() {
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
+		if m.SendMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.SendMessage")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage with params: %#v", *m.SendMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.SendMessage")
+	}
+
+	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), afterSendMessageCounter)
+	}
+}
+
+type mChatServiceMockUpdateChatSettings struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockUpdateChatSettingsExpectation
+	expectations       []*ChatServiceMockUpdateChatSettingsExpectation
+
+	callArgs []*ChatServiceMockUpdateChatSettingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ChatServiceMockUpdateChatSettingsExpectation specifies expectation struct of the ChatService.UpdateChatSettings
+type ChatServiceMockUpdateChatSettingsExpectation struct {
+	mock      *ChatServiceMock
+	params    *ChatServiceMockUpdateChatSettingsParams
+	paramPtrs *ChatServiceMockUpdateChatSettingsParamPtrs
+	results   *ChatServiceMockUpdateChatSettingsResults
+	Counter   uint64
+}
+
+// ChatServiceMockUpdateChatSettingsParams contains parameters of the ChatService.UpdateChatSettings
+type ChatServiceMockUpdateChatSettingsParams struct {
+	ctx    context.Context
+	params model.UpdateChatSettingsParams
+}
+
+// ChatServiceMockUpdateChatSettingsParamPtrs contains pointers to parameters of the ChatService.UpdateChatSettings
+type ChatServiceMockUpdateChatSettingsParamPtrs struct {
+	ctx    *context.Context
+	params *model.UpdateChatSettingsParams
+}
+
+// ChatServiceMockUpdateChatSettingsResults contains results of the ChatService.UpdateChatSettings
+type ChatServiceMockUpdateChatSettingsResults struct {
+	settings model.ChatSettings
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) Optional() *mChatServiceMockUpdateChatSettings {
+	mmUpdateChatSettings.optional = true
+	return mmUpdateChatSettings
+}
+
+// Expect sets up expected params for ChatService.UpdateChatSettings
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) Expect(ctx context.Context, params model.UpdateChatSettingsParams) *mChatServiceMockUpdateChatSettings {
+	if mmUpdateChatSettings.mock.funcUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatServiceMock.UpdateChatSettings mock is already set by Set")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation == nil {
+		mmUpdateChatSettings.defaultExpectation = &ChatServiceMockUpdateChatSettingsExpectation{}
+	}
+
+	if mmUpdateChatSettings.defaultExpectation.paramPtrs != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatServiceMock.UpdateChatSettings mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateChatSettings.defaultExpectation.params = &ChatServiceMockUpdateChatSettingsParams{ctx, params}
+	for _, e := range mmUpdateChatSettings.expectations {
+		if minimock.Equal(e.params, mmUpdateChatSettings.defaultExpectation.params) {
+			mmUpdateChatSettings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateChatSettings.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateChatSettings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.UpdateChatSettings
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) ExpectCtxParam1(ctx context.Context) *mChatServiceMockUpdateChatSettings {
+	if mmUpdateChatSettings.mock.funcUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatServiceMock.UpdateChatSettings mock is already set by Set")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation == nil {
+		mmUpdateChatSettings.defaultExpectation = &ChatServiceMockUpdateChatSettingsExpectation{}
+	}
+
+	if mmUpdateChatSettings.defaultExpectation.params != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatServiceMock.UpdateChatSettings mock is already set by Expect")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation.paramPtrs == nil {
+		mmUpdateChatSettings.defaultExpectation.paramPtrs = &ChatServiceMockUpdateChatSettingsParamPtrs{}
+	}
+	mmUpdateChatSettings.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateChatSettings
+}
+
+// ExpectParamsParam2 sets up expected param params for ChatService.UpdateChatSettings
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) ExpectParamsParam2(params model.UpdateChatSettingsParams) *mChatServiceMockUpdateChatSettings {
+	if mmUpdateChatSettings.mock.funcUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatServiceMock.UpdateChatSettings mock is already set by Set")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation == nil {
+		mmUpdateChatSettings.defaultExpectation = &ChatServiceMockUpdateChatSettingsExpectation{}
+	}
+
+	if mmUpdateChatSettings.defaultExpectation.params != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatServiceMock.UpdateChatSettings mock is already set by Expect")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation.paramPtrs == nil {
+		mmUpdateChatSettings.defaultExpectation.paramPtrs = &ChatServiceMockUpdateChatSettingsParamPtrs{}
+	}
+	mmUpdateChatSettings.defaultExpectation.paramPtrs.params = &params
+
+	return mmUpdateChatSettings
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.UpdateChatSettings
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) Inspect(f func(ctx context.Context, params model.UpdateChatSettingsParams)) *mChatServiceMockUpdateChatSettings {
+	if mmUpdateChatSettings.mock.inspectFuncUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.UpdateChatSettings")
+	}
+
+	mmUpdateChatSettings.mock.inspectFuncUpdateChatSettings = f
+
+	return mmUpdateChatSettings
+}
+
+// Return sets up results that will be returned by ChatService.UpdateChatSettings
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) Return(settings model.ChatSettings, err error) *ChatServiceMock {
+	if mmUpdateChatSettings.mock.funcUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatServiceMock.UpdateChatSettings mock is already set by Set")
+	}
+
+	if mmUpdateChatSettings.defaultExpectation == nil {
+		mmUpdateChatSettings.defaultExpectation = &ChatServiceMockUpdateChatSettingsExpectation{mock: mmUpdateChatSettings.mock}
+	}
+	mmUpdateChatSettings.defaultExpectation.results = &ChatServiceMockUpdateChatSettingsResults{settings, err}
+	return mmUpdateChatSettings.mock
+}
+
+// Set uses given function f to mock the ChatService.UpdateChatSettings method
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) Set(f func(ctx context.Context, params model.UpdateChatSettingsParams) (settings model.ChatSettings, err error)) *ChatServiceMock {
+	if mmUpdateChatSettings.defaultExpectation != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("Default expectation is already set for the ChatService.UpdateChatSettings method")
+	}
+
+	if len(mmUpdateChatSettings.expectations) > 0 {
+		mmUpdateChatSettings.mock.t.Fatalf("Some expectations are already set for the ChatService.UpdateChatSettings method")
+	}
+
+	mmUpdateChatSettings.mock.funcUpdateChatSettings = f
+	return mmUpdateChatSettings.mock
+}
+
+// When sets expectation for the ChatService.UpdateChatSettings which will trigger the result defined by the following
+// Then helper
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) When(ctx context.Context, params model.UpdateChatSettingsParams) *ChatServiceMockUpdateChatSettingsExpectation {
+	if mmUpdateChatSettings.mock.funcUpdateChatSettings != nil {
+		mmUpdateChatSettings.mock.t.Fatalf("ChatServiceMock.UpdateChatSettings mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockUpdateChatSettingsExpectation{
+		mock:   mmUpdateChatSettings.mock,
+		params: &ChatServiceMockUpdateChatSettingsParams{ctx, params},
+	}
+	mmUpdateChatSettings.expectations = append(mmUpdateChatSettings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.UpdateChatSettings return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockUpdateChatSettingsExpectation) Then(settings model.ChatSettings, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockUpdateChatSettingsResults{settings, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.UpdateChatSettings should be invoked
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) Times(n uint64) *mChatServiceMockUpdateChatSettings {
+	if n == 0 {
+		mmUpdateChatSettings.mock.t.Fatalf("Times of ChatServiceMock.UpdateChatSettings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateChatSettings.expectedInvocations, n)
+	return mmUpdateChatSettings
+}
+
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) invocationsDone() bool {
+	if len(mmUpdateChatSettings.expectations) == 0 && mmUpdateChatSettings.defaultExpectation == nil && mmUpdateChatSettings.mock.funcUpdateChatSettings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateChatSettings.mock.afterUpdateChatSettingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateChatSettings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateChatSettings implements service.ChatService
+func (mmUpdateChatSettings *ChatServiceMock) UpdateChatSettings(ctx context.Context, params model.UpdateChatSettingsParams) (settings model.ChatSettings, err error) {
+	mm_atomic.AddUint64(&mmUpdateChatSettings.beforeUpdateChatSettingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateChatSettings.afterUpdateChatSettingsCounter, 1)
+
+	if mmUpdateChatSettings.inspectFuncUpdateChatSettings != nil {
+		mmUpdateChatSettings.inspectFuncUpdateChatSettings(ctx, params)
+	}
+
+	mm_params := ChatServiceMockUpdateChatSettingsParams{ctx, params}
+
+	// Record call args
+	mmUpdateChatSettings.UpdateChatSettingsMock.mutex.Lock()
+	mmUpdateChatSettings.UpdateChatSettingsMock.callArgs = append(mmUpdateChatSettings.UpdateChatSettingsMock.callArgs, &mm_params)
+	mmUpdateChatSettings.UpdateChatSettingsMock.mutex.Unlock()
+
+	for _, e := range mmUpdateChatSettings.UpdateChatSettingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.settings, e.results.err
+		}
+	}
+
+	if mmUpdateChatSettings.UpdateChatSettingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateChatSettings.UpdateChatSettingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateChatSettings.UpdateChatSettingsMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateChatSettings.UpdateChatSettingsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockUpdateChatSettingsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateChatSettings.t.Errorf("ChatServiceMock.UpdateChatSettings got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUpdateChatSettings.t.Errorf("ChatServiceMock.UpdateChatSettings got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateChatSettings.t.Errorf("ChatServiceMock.UpdateChatSettings got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateChatSettings.UpdateChatSettingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateChatSettings.t.Fatal("No results are set for the ChatServiceMock.UpdateChatSettings")
+		}
+		return (*mm_results).settings, (*mm_results).err
+	}
+	if mmUpdateChatSettings.funcUpdateChatSettings != nil {
+		return mmUpdateChatSettings.funcUpdateChatSettings(ctx, params)
+	}
+	mmUpdateChatSettings.t.Fatalf("Unexpected call to ChatServiceMock.UpdateChatSettings. %v %v", ctx, params)
+	return
+}
+
+// UpdateChatSettingsAfterCounter returns a count of finished ChatServiceMock.UpdateChatSettings invocations
+func (mmUpdateChatSettings *ChatServiceMock) UpdateChatSettingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateChatSettings.afterUpdateChatSettingsCounter)
+}
+
+// UpdateChatSettingsBeforeCounter returns a count of ChatServiceMock.UpdateChatSettings invocations
+func (mmUpdateChatSettings *ChatServiceMock) UpdateChatSettingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateChatSettings.beforeUpdateChatSettingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.UpdateChatSettings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateChatSettings *mChatServiceMockUpdateChatSettings) Calls() []*ChatServiceMockUpdateChatSettingsParams {
+	mmUpdateChatSettings.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockUpdateChatSettingsParams, len(mmUpdateChatSettings.callArgs))
+	copy(argCopy, mmUpdateChatSettings.callArgs)
+
+	mmUpdateChatSettings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateChatSettingsDone returns true if the count of the UpdateChatSettings invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockUpdateChatSettingsDone() bool {
+	if m.UpdateChatSettingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateChatSettingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateChatSettingsMock.invocationsDone()
+}
+
+// MinimockUpdateChatSettingsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockUpdateChatSettingsInspect() {
+	for _, e := range m.UpdateChatSettingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.UpdateChatSettings with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateChatSettingsCounter := mm_atomic.LoadUint64(&m.afterUpdateChatSettingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateChatSettingsMock.defaultExpectation != nil && afterUpdateChatSettingsCounter < 1 {
+		if m.UpdateChatSettingsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ChatServiceMock.UpdateChatSettings")
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.UpdateChatSettings with params: %#v", *m.UpdateChatSettingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateChatSettings != nil && afterUpdateChatSettingsCounter < 1 {
+		m.t.Error("Expected call to ChatServiceMock.UpdateChatSettings")
+	}
+
+	if !m.UpdateChatSettingsMock.invocationsDone() && afterUpdateChatSettingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.UpdateChatSettings but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateChatSettingsMock.expectedInvocations), afterUpdateChatSettingsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ChatServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateChatInspect()
+
+			m.MinimockDeleteChatInspect()
+
+			m.MinimockListChatsInspect()
+
+			m.MinimockListMentionsInspect()
+
+			m.MinimockListMessagesInspect()
+
+			m.MinimockPostMessageInspect()
+
+			m.MinimockRunCommandInspect()
+
+			m.MinimockSendMessageInspect()
+
+			m.MinimockUpdateChatSettingsInspect()
 		}
 	})
 }
@@ -1055,5 +3017,11 @@ func (m *ChatServiceMock) minimockDone() bool {
 	return done &&
 		m.MinimockCreateChatDone() &&
 		m.MinimockDeleteChatDone() &&
-		m.MinimockSendMessageDone()
+		m.MinimockListChatsDone() &&
+		m.MinimockListMentionsDone() &&
+		m.MinimockListMessagesDone() &&
+		m.MinimockPostMessageDone() &&
+		m.MinimockRunCommandDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockUpdateChatSettingsDone()
 }
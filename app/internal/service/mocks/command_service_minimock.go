@@ -0,0 +1,395 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.CommandService -o command_service_minimock.go -n CommandServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// CommandServiceMock implements service.CommandService
+type CommandServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDispatch          func(ctx context.Context, cmd model.Command) (reply model.CommandReply, err error)
+	inspectFuncDispatch   func(ctx context.Context, cmd model.Command)
+	afterDispatchCounter  uint64
+	beforeDispatchCounter uint64
+	DispatchMock          mCommandServiceMockDispatch
+}
+
+// NewCommandServiceMock returns a mock for service.CommandService
+func NewCommandServiceMock(t minimock.Tester) *CommandServiceMock {
+	m := &CommandServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DispatchMock = mCommandServiceMockDispatch{mock: m}
+	m.DispatchMock.callArgs = []*CommandServiceMockDispatchParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCommandServiceMockDispatch struct {
+	optional           bool
+	mock               *CommandServiceMock
+	defaultExpectation *CommandServiceMockDispatchExpectation
+	expectations       []*CommandServiceMockDispatchExpectation
+
+	callArgs []*CommandServiceMockDispatchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// CommandServiceMockDispatchExpectation specifies expectation struct of the CommandService.Dispatch
+type CommandServiceMockDispatchExpectation struct {
+	mock      *CommandServiceMock
+	params    *CommandServiceMockDispatchParams
+	paramPtrs *CommandServiceMockDispatchParamPtrs
+	results   *CommandServiceMockDispatchResults
+	Counter   uint64
+}
+
+// CommandServiceMockDispatchParams contains parameters of the CommandService.Dispatch
+type CommandServiceMockDispatchParams struct {
+	ctx context.Context
+	cmd model.Command
+}
+
+// CommandServiceMockDispatchParamPtrs contains pointers to parameters of the CommandService.Dispatch
+type CommandServiceMockDispatchParamPtrs struct {
+	ctx *context.Context
+	cmd *model.Command
+}
+
+// CommandServiceMockDispatchResults contains results of the CommandService.Dispatch
+type CommandServiceMockDispatchResults struct {
+	reply model.CommandReply
+	err   error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDispatch *mCommandServiceMockDispatch) Optional() *mCommandServiceMockDispatch {
+	mmDispatch.optional = true
+	return mmDispatch
+}
+
+// Expect sets up expected params for CommandService.Dispatch
+func (mmDispatch *mCommandServiceMockDispatch) Expect(ctx context.Context, cmd model.Command) *mCommandServiceMockDispatch {
+	if mmDispatch.mock.funcDispatch != nil {
+		mmDispatch.mock.t.Fatalf("CommandServiceMock.Dispatch mock is already set by Set")
+	}
+
+	if mmDispatch.defaultExpectation == nil {
+		mmDispatch.defaultExpectation = &CommandServiceMockDispatchExpectation{}
+	}
+
+	if mmDispatch.defaultExpectation.paramPtrs != nil {
+		mmDispatch.mock.t.Fatalf("CommandServiceMock.Dispatch mock is already set by ExpectParams functions")
+	}
+
+	mmDispatch.defaultExpectation.params = &CommandServiceMockDispatchParams{ctx, cmd}
+	for _, e := range mmDispatch.expectations {
+		if minimock.Equal(e.params, mmDispatch.defaultExpectation.params) {
+			mmDispatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDispatch.defaultExpectation.params)
+		}
+	}
+
+	return mmDispatch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CommandService.Dispatch
+func (mmDispatch *mCommandServiceMockDispatch) ExpectCtxParam1(ctx context.Context) *mCommandServiceMockDispatch {
+	if mmDispatch.mock.funcDispatch != nil {
+		mmDispatch.mock.t.Fatalf("CommandServiceMock.Dispatch mock is already set by Set")
+	}
+
+	if mmDispatch.defaultExpectation == nil {
+		mmDispatch.defaultExpectation = &CommandServiceMockDispatchExpectation{}
+	}
+
+	if mmDispatch.defaultExpectation.params != nil {
+		mmDispatch.mock.t.Fatalf("CommandServiceMock.Dispatch mock is already set by Expect")
+	}
+
+	if mmDispatch.defaultExpectation.paramPtrs == nil {
+		mmDispatch.defaultExpectation.paramPtrs = &CommandServiceMockDispatchParamPtrs{}
+	}
+	mmDispatch.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDispatch
+}
+
+// ExpectCmdParam2 sets up expected param cmd for CommandService.Dispatch
+func (mmDispatch *mCommandServiceMockDispatch) ExpectCmdParam2(cmd model.Command) *mCommandServiceMockDispatch {
+	if mmDispatch.mock.funcDispatch != nil {
+		mmDispatch.mock.t.Fatalf("CommandServiceMock.Dispatch mock is already set by Set")
+	}
+
+	if mmDispatch.defaultExpectation == nil {
+		mmDispatch.defaultExpectation = &CommandServiceMockDispatchExpectation{}
+	}
+
+	if mmDispatch.defaultExpectation.params != nil {
+		mmDispatch.mock.t.Fatalf("CommandServiceMock.Dispatch mock is already set by Expect")
+	}
+
+	if mmDispatch.defaultExpectation.paramPtrs == nil {
+		mmDispatch.defaultExpectation.paramPtrs = &CommandServiceMockDispatchParamPtrs{}
+	}
+	mmDispatch.defaultExpectation.paramPtrs.cmd = &cmd
+
+	return mmDispatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the CommandService.Dispatch
+func (mmDispatch *mCommandServiceMockDispatch) Inspect(f func(ctx context.Context, cmd model.Command)) *mCommandServiceMockDispatch {
+	if mmDispatch.mock.inspectFuncDispatch != nil {
+		mmDispatch.mock.t.Fatalf("Inspect function is already set for CommandServiceMock.Dispatch")
+	}
+
+	mmDispatch.mock.inspectFuncDispatch = f
+
+	return mmDispatch
+}
+
+// Return sets up results that will be returned by CommandService.Dispatch
+func (mmDispatch *mCommandServiceMockDispatch) Return(reply model.CommandReply, err error) *CommandServiceMock {
+	if mmDispatch.mock.funcDispatch != nil {
+		mmDispatch.mock.t.Fatalf("CommandServiceMock.Dispatch mock is already set by Set")
+	}
+
+	if mmDispatch.defaultExpectation == nil {
+		mmDispatch.defaultExpectation = &CommandServiceMockDispatchExpectation{mock: mmDispatch.mock}
+	}
+	mmDispatch.defaultExpectation.results = &CommandServiceMockDispatchResults{reply, err}
+	return mmDispatch.mock
+}
+
+// Set uses given function f to mock the CommandService.Dispatch method
+func (mmDispatch *mCommandServiceMockDispatch) Set(f func(ctx context.Context, cmd model.Command) (reply model.CommandReply, err error)) *CommandServiceMock {
+	if mmDispatch.defaultExpectation != nil {
+		mmDispatch.mock.t.Fatalf("Default expectation is already set for the CommandService.Dispatch method")
+	}
+
+	if len(mmDispatch.expectations) > 0 {
+		mmDispatch.mock.t.Fatalf("Some expectations are already set for the CommandService.Dispatch method")
+	}
+
+	mmDispatch.mock.funcDispatch = f
+	return mmDispatch.mock
+}
+
+// When sets expectation for the CommandService.Dispatch which will trigger the result defined by the following
+// Then helper
+func (mmDispatch *mCommandServiceMockDispatch) When(ctx context.Context, cmd model.Command) *CommandServiceMockDispatchExpectation {
+	if mmDispatch.mock.funcDispatch != nil {
+		mmDispatch.mock.t.Fatalf("CommandServiceMock.Dispatch mock is already set by Set")
+	}
+
+	expectation := &CommandServiceMockDispatchExpectation{
+		mock:   mmDispatch.mock,
+		params: &CommandServiceMockDispatchParams{ctx, cmd},
+	}
+	mmDispatch.expectations = append(mmDispatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CommandService.Dispatch return parameters for the expectation previously defined by the When method
+func (e *CommandServiceMockDispatchExpectation) Then(reply model.CommandReply, err error) *CommandServiceMock {
+	e.results = &CommandServiceMockDispatchResults{reply, err}
+	return e.mock
+}
+
+// Times sets number of times CommandService.Dispatch should be invoked
+func (mmDispatch *mCommandServiceMockDispatch) Times(n uint64) *mCommandServiceMockDispatch {
+	if n == 0 {
+		mmDispatch.mock.t.Fatalf("Times of CommandServiceMock.Dispatch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDispatch.expectedInvocations, n)
+	return mmDispatch
+}
+
+func (mmDispatch *mCommandServiceMockDispatch) invocationsDone() bool {
+	if len(mmDispatch.expectations) == 0 && mmDispatch.defaultExpectation == nil && mmDispatch.mock.funcDispatch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDispatch.mock.afterDispatchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDispatch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Dispatch implements service.CommandService
+func (mmDispatch *CommandServiceMock) Dispatch(ctx context.Context, cmd model.Command) (reply model.CommandReply, err error) {
+	mm_atomic.AddUint64(&mmDispatch.beforeDispatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmDispatch.afterDispatchCounter, 1)
+
+	if mmDispatch.inspectFuncDispatch != nil {
+		mmDispatch.inspectFuncDispatch(ctx, cmd)
+	}
+
+	mm_params := CommandServiceMockDispatchParams{ctx, cmd}
+
+	// Record call args
+	mmDispatch.DispatchMock.mutex.Lock()
+	mmDispatch.DispatchMock.callArgs = append(mmDispatch.DispatchMock.callArgs, &mm_params)
+	mmDispatch.DispatchMock.mutex.Unlock()
+
+	for _, e := range mmDispatch.DispatchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.reply, e.results.err
+		}
+	}
+
+	if mmDispatch.DispatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDispatch.DispatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmDispatch.DispatchMock.defaultExpectation.params
+		mm_want_ptrs := mmDispatch.DispatchMock.defaultExpectation.paramPtrs
+
+		mm_got := CommandServiceMockDispatchParams{ctx, cmd}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDispatch.t.Errorf("CommandServiceMock.Dispatch got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cmd != nil && !minimock.Equal(*mm_want_ptrs.cmd, mm_got.cmd) {
+				mmDispatch.t.Errorf("CommandServiceMock.Dispatch got unexpected parameter cmd, want: %#v, got: %#v%s\n", *mm_want_ptrs.cmd, mm_got.cmd, minimock.Diff(*mm_want_ptrs.cmd, mm_got.cmd))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDispatch.t.Errorf("CommandServiceMock.Dispatch got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDispatch.DispatchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDispatch.t.Fatal("No results are set for the CommandServiceMock.Dispatch")
+		}
+		return (*mm_results).reply, (*mm_results).err
+	}
+	if mmDispatch.funcDispatch != nil {
+		return mmDispatch.funcDispatch(ctx, cmd)
+	}
+	mmDispatch.t.Fatalf("Unexpected call to CommandServiceMock.Dispatch. %v %v", ctx, cmd)
+	return
+}
+
+// DispatchAfterCounter returns a count of finished CommandServiceMock.Dispatch invocations
+func (mmDispatch *CommandServiceMock) DispatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDispatch.afterDispatchCounter)
+}
+
+// DispatchBeforeCounter returns a count of CommandServiceMock.Dispatch invocations
+func (mmDispatch *CommandServiceMock) DispatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDispatch.beforeDispatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to CommandServiceMock.Dispatch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDispatch *mCommandServiceMockDispatch) Calls() []*CommandServiceMockDispatchParams {
+	mmDispatch.mutex.RLock()
+
+	argCopy := make([]*CommandServiceMockDispatchParams, len(mmDispatch.callArgs))
+	copy(argCopy, mmDispatch.callArgs)
+
+	mmDispatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDispatchDone returns true if the count of the Dispatch invocations corresponds
+// the number of defined expectations
+func (m *CommandServiceMock) MinimockDispatchDone() bool {
+	if m.DispatchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DispatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DispatchMock.invocationsDone()
+}
+
+// MinimockDispatchInspect logs each unmet expectation
+func (m *CommandServiceMock) MinimockDispatchInspect() {
+	for _, e := range m.DispatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CommandServiceMock.Dispatch with params: %#v", *e.params)
+		}
+	}
+
+	afterDispatchCounter := mm_atomic.LoadUint64(&m.afterDispatchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DispatchMock.defaultExpectation != nil && afterDispatchCounter < 1 {
+		if m.DispatchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CommandServiceMock.Dispatch")
+		} else {
+			m.t.Errorf("Expected call to CommandServiceMock.Dispatch with params: %#v", *m.DispatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDispatch != nil && afterDispatchCounter < 1 {
+		m.t.Error("Expected call to CommandServiceMock.Dispatch")
+	}
+
+	if !m.DispatchMock.invocationsDone() && afterDispatchCounter > 0 {
+		m.t.Errorf("Expected %d calls to CommandServiceMock.Dispatch but found %d calls",
+			mm_atomic.LoadUint64(&m.DispatchMock.expectedInvocations), afterDispatchCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CommandServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDispatchInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CommandServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CommandServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDispatchDone()
+}
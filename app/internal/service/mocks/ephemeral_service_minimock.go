@@ -0,0 +1,727 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.EphemeralService -o ephemeral_service_minimock.go -n EphemeralServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// EphemeralServiceMock implements service.EphemeralService
+type EphemeralServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcPublish          func(ctx context.Context, event model.EphemeralEvent) (published bool, err error)
+	inspectFuncPublish   func(ctx context.Context, event model.EphemeralEvent)
+	afterPublishCounter  uint64
+	beforePublishCounter uint64
+	PublishMock          mEphemeralServiceMockPublish
+
+	funcSendTypingEvent          func(ctx context.Context, params model.SendTypingEventParams) (err error)
+	inspectFuncSendTypingEvent   func(ctx context.Context, params model.SendTypingEventParams)
+	afterSendTypingEventCounter  uint64
+	beforeSendTypingEventCounter uint64
+	SendTypingEventMock          mEphemeralServiceMockSendTypingEvent
+}
+
+// NewEphemeralServiceMock returns a mock for service.EphemeralService
+func NewEphemeralServiceMock(t minimock.Tester) *EphemeralServiceMock {
+	m := &EphemeralServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PublishMock = mEphemeralServiceMockPublish{mock: m}
+	m.PublishMock.callArgs = []*EphemeralServiceMockPublishParams{}
+
+	m.SendTypingEventMock = mEphemeralServiceMockSendTypingEvent{mock: m}
+	m.SendTypingEventMock.callArgs = []*EphemeralServiceMockSendTypingEventParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEphemeralServiceMockPublish struct {
+	optional           bool
+	mock               *EphemeralServiceMock
+	defaultExpectation *EphemeralServiceMockPublishExpectation
+	expectations       []*EphemeralServiceMockPublishExpectation
+
+	callArgs []*EphemeralServiceMockPublishParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// EphemeralServiceMockPublishExpectation specifies expectation struct of the EphemeralService.Publish
+type EphemeralServiceMockPublishExpectation struct {
+	mock      *EphemeralServiceMock
+	params    *EphemeralServiceMockPublishParams
+	paramPtrs *EphemeralServiceMockPublishParamPtrs
+	results   *EphemeralServiceMockPublishResults
+	Counter   uint64
+}
+
+// EphemeralServiceMockPublishParams contains parameters of the EphemeralService.Publish
+type EphemeralServiceMockPublishParams struct {
+	ctx   context.Context
+	event model.EphemeralEvent
+}
+
+// EphemeralServiceMockPublishParamPtrs contains pointers to parameters of the EphemeralService.Publish
+type EphemeralServiceMockPublishParamPtrs struct {
+	ctx   *context.Context
+	event *model.EphemeralEvent
+}
+
+// EphemeralServiceMockPublishResults contains results of the EphemeralService.Publish
+type EphemeralServiceMockPublishResults struct {
+	published bool
+	err       error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublish *mEphemeralServiceMockPublish) Optional() *mEphemeralServiceMockPublish {
+	mmPublish.optional = true
+	return mmPublish
+}
+
+// Expect sets up expected params for EphemeralService.Publish
+func (mmPublish *mEphemeralServiceMockPublish) Expect(ctx context.Context, event model.EphemeralEvent) *mEphemeralServiceMockPublish {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("EphemeralServiceMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &EphemeralServiceMockPublishExpectation{}
+	}
+
+	if mmPublish.defaultExpectation.paramPtrs != nil {
+		mmPublish.mock.t.Fatalf("EphemeralServiceMock.Publish mock is already set by ExpectParams functions")
+	}
+
+	mmPublish.defaultExpectation.params = &EphemeralServiceMockPublishParams{ctx, event}
+	for _, e := range mmPublish.expectations {
+		if minimock.Equal(e.params, mmPublish.defaultExpectation.params) {
+			mmPublish.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublish.defaultExpectation.params)
+		}
+	}
+
+	return mmPublish
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EphemeralService.Publish
+func (mmPublish *mEphemeralServiceMockPublish) ExpectCtxParam1(ctx context.Context) *mEphemeralServiceMockPublish {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("EphemeralServiceMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &EphemeralServiceMockPublishExpectation{}
+	}
+
+	if mmPublish.defaultExpectation.params != nil {
+		mmPublish.mock.t.Fatalf("EphemeralServiceMock.Publish mock is already set by Expect")
+	}
+
+	if mmPublish.defaultExpectation.paramPtrs == nil {
+		mmPublish.defaultExpectation.paramPtrs = &EphemeralServiceMockPublishParamPtrs{}
+	}
+	mmPublish.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPublish
+}
+
+// ExpectEventParam2 sets up expected param event for EphemeralService.Publish
+func (mmPublish *mEphemeralServiceMockPublish) ExpectEventParam2(event model.EphemeralEvent) *mEphemeralServiceMockPublish {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("EphemeralServiceMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &EphemeralServiceMockPublishExpectation{}
+	}
+
+	if mmPublish.defaultExpectation.params != nil {
+		mmPublish.mock.t.Fatalf("EphemeralServiceMock.Publish mock is already set by Expect")
+	}
+
+	if mmPublish.defaultExpectation.paramPtrs == nil {
+		mmPublish.defaultExpectation.paramPtrs = &EphemeralServiceMockPublishParamPtrs{}
+	}
+	mmPublish.defaultExpectation.paramPtrs.event = &event
+
+	return mmPublish
+}
+
+// Inspect accepts an inspector function that has same arguments as the EphemeralService.Publish
+func (mmPublish *mEphemeralServiceMockPublish) Inspect(f func(ctx context.Context, event model.EphemeralEvent)) *mEphemeralServiceMockPublish {
+	if mmPublish.mock.inspectFuncPublish != nil {
+		mmPublish.mock.t.Fatalf("Inspect function is already set for EphemeralServiceMock.Publish")
+	}
+
+	mmPublish.mock.inspectFuncPublish = f
+
+	return mmPublish
+}
+
+// Return sets up results that will be returned by EphemeralService.Publish
+func (mmPublish *mEphemeralServiceMockPublish) Return(published bool, err error) *EphemeralServiceMock {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("EphemeralServiceMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &EphemeralServiceMockPublishExpectation{mock: mmPublish.mock}
+	}
+	mmPublish.defaultExpectation.results = &EphemeralServiceMockPublishResults{published, err}
+	return mmPublish.mock
+}
+
+// Set uses given function f to mock the EphemeralService.Publish method
+func (mmPublish *mEphemeralServiceMockPublish) Set(f func(ctx context.Context, event model.EphemeralEvent) (published bool, err error)) *EphemeralServiceMock {
+	if mmPublish.defaultExpectation != nil {
+		mmPublish.mock.t.Fatalf("Default expectation is already set for the EphemeralService.Publish method")
+	}
+
+	if len(mmPublish.expectations) > 0 {
+		mmPublish.mock.t.Fatalf("Some expectations are already set for the EphemeralService.Publish method")
+	}
+
+	mmPublish.mock.funcPublish = f
+	return mmPublish.mock
+}
+
+// When sets expectation for the EphemeralService.Publish which will trigger the result defined by the following
+// Then helper
+func (mmPublish *mEphemeralServiceMockPublish) When(ctx context.Context, event model.EphemeralEvent) *EphemeralServiceMockPublishExpectation {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("EphemeralServiceMock.Publish mock is already set by Set")
+	}
+
+	expectation := &EphemeralServiceMockPublishExpectation{
+		mock:   mmPublish.mock,
+		params: &EphemeralServiceMockPublishParams{ctx, event},
+	}
+	mmPublish.expectations = append(mmPublish.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EphemeralService.Publish return parameters for the expectation previously defined by the When method
+func (e *EphemeralServiceMockPublishExpectation) Then(published bool, err error) *EphemeralServiceMock {
+	e.results = &EphemeralServiceMockPublishResults{published, err}
+	return e.mock
+}
+
+// Times sets number of times EphemeralService.Publish should be invoked
+func (mmPublish *mEphemeralServiceMockPublish) Times(n uint64) *mEphemeralServiceMockPublish {
+	if n == 0 {
+		mmPublish.mock.t.Fatalf("Times of EphemeralServiceMock.Publish mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublish.expectedInvocations, n)
+	return mmPublish
+}
+
+func (mmPublish *mEphemeralServiceMockPublish) invocationsDone() bool {
+	if len(mmPublish.expectations) == 0 && mmPublish.defaultExpectation == nil && mmPublish.mock.funcPublish == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublish.mock.afterPublishCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublish.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Publish implements service.EphemeralService
+func (mmPublish *EphemeralServiceMock) Publish(ctx context.Context, event model.EphemeralEvent) (published bool, err error) {
+	mm_atomic.AddUint64(&mmPublish.beforePublishCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublish.afterPublishCounter, 1)
+
+	if mmPublish.inspectFuncPublish != nil {
+		mmPublish.inspectFuncPublish(ctx, event)
+	}
+
+	mm_params := EphemeralServiceMockPublishParams{ctx, event}
+
+	// Record call args
+	mmPublish.PublishMock.mutex.Lock()
+	mmPublish.PublishMock.callArgs = append(mmPublish.PublishMock.callArgs, &mm_params)
+	mmPublish.PublishMock.mutex.Unlock()
+
+	for _, e := range mmPublish.PublishMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.published, e.results.err
+		}
+	}
+
+	if mmPublish.PublishMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublish.PublishMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublish.PublishMock.defaultExpectation.params
+		mm_want_ptrs := mmPublish.PublishMock.defaultExpectation.paramPtrs
+
+		mm_got := EphemeralServiceMockPublishParams{ctx, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPublish.t.Errorf("EphemeralServiceMock.Publish got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmPublish.t.Errorf("EphemeralServiceMock.Publish got unexpected parameter event, want: %#v, got: %#v%s\n", *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPublish.t.Errorf("EphemeralServiceMock.Publish got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPublish.PublishMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPublish.t.Fatal("No results are set for the EphemeralServiceMock.Publish")
+		}
+		return (*mm_results).published, (*mm_results).err
+	}
+	if mmPublish.funcPublish != nil {
+		return mmPublish.funcPublish(ctx, event)
+	}
+	mmPublish.t.Fatalf("Unexpected call to EphemeralServiceMock.Publish. %v %v", ctx, event)
+	return
+}
+
+// PublishAfterCounter returns a count of finished EphemeralServiceMock.Publish invocations
+func (mmPublish *EphemeralServiceMock) PublishAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublish.afterPublishCounter)
+}
+
+// PublishBeforeCounter returns a count of EphemeralServiceMock.Publish invocations
+func (mmPublish *EphemeralServiceMock) PublishBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublish.beforePublishCounter)
+}
+
+// Calls returns a list of arguments used in each call to EphemeralServiceMock.Publish.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPublish *mEphemeralServiceMockPublish) Calls() []*EphemeralServiceMockPublishParams {
+	mmPublish.mutex.RLock()
+
+	argCopy := make([]*EphemeralServiceMockPublishParams, len(mmPublish.callArgs))
+	copy(argCopy, mmPublish.callArgs)
+
+	mmPublish.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPublishDone returns true if the count of the Publish invocations corresponds
+// the number of defined expectations
+func (m *EphemeralServiceMock) MinimockPublishDone() bool {
+	if m.PublishMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublishMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublishMock.invocationsDone()
+}
+
+// MinimockPublishInspect logs each unmet expectation
+func (m *EphemeralServiceMock) MinimockPublishInspect() {
+	for _, e := range m.PublishMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EphemeralServiceMock.Publish with params: %#v", *e.params)
+		}
+	}
+
+	afterPublishCounter := mm_atomic.LoadUint64(&m.afterPublishCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublishMock.defaultExpectation != nil && afterPublishCounter < 1 {
+		if m.PublishMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to EphemeralServiceMock.Publish")
+		} else {
+			m.t.Errorf("Expected call to EphemeralServiceMock.Publish with params: %#v", *m.PublishMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublish != nil && afterPublishCounter < 1 {
+		m.t.Error("Expected call to EphemeralServiceMock.Publish")
+	}
+
+	if !m.PublishMock.invocationsDone() && afterPublishCounter > 0 {
+		m.t.Errorf("Expected %d calls to EphemeralServiceMock.Publish but found %d calls",
+			mm_atomic.LoadUint64(&m.PublishMock.expectedInvocations), afterPublishCounter)
+	}
+}
+
+type mEphemeralServiceMockSendTypingEvent struct {
+	optional           bool
+	mock               *EphemeralServiceMock
+	defaultExpectation *EphemeralServiceMockSendTypingEventExpectation
+	expectations       []*EphemeralServiceMockSendTypingEventExpectation
+
+	callArgs []*EphemeralServiceMockSendTypingEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// EphemeralServiceMockSendTypingEventExpectation specifies expectation struct of the EphemeralService.SendTypingEvent
+type EphemeralServiceMockSendTypingEventExpectation struct {
+	mock      *EphemeralServiceMock
+	params    *EphemeralServiceMockSendTypingEventParams
+	paramPtrs *EphemeralServiceMockSendTypingEventParamPtrs
+	results   *EphemeralServiceMockSendTypingEventResults
+	Counter   uint64
+}
+
+// EphemeralServiceMockSendTypingEventParams contains parameters of the EphemeralService.SendTypingEvent
+type EphemeralServiceMockSendTypingEventParams struct {
+	ctx    context.Context
+	params model.SendTypingEventParams
+}
+
+// EphemeralServiceMockSendTypingEventParamPtrs contains pointers to parameters of the EphemeralService.SendTypingEvent
+type EphemeralServiceMockSendTypingEventParamPtrs struct {
+	ctx    *context.Context
+	params *model.SendTypingEventParams
+}
+
+// EphemeralServiceMockSendTypingEventResults contains results of the EphemeralService.SendTypingEvent
+type EphemeralServiceMockSendTypingEventResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) Optional() *mEphemeralServiceMockSendTypingEvent {
+	mmSendTypingEvent.optional = true
+	return mmSendTypingEvent
+}
+
+// Expect sets up expected params for EphemeralService.SendTypingEvent
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) Expect(ctx context.Context, params model.SendTypingEventParams) *mEphemeralServiceMockSendTypingEvent {
+	if mmSendTypingEvent.mock.funcSendTypingEvent != nil {
+		mmSendTypingEvent.mock.t.Fatalf("EphemeralServiceMock.SendTypingEvent mock is already set by Set")
+	}
+
+	if mmSendTypingEvent.defaultExpectation == nil {
+		mmSendTypingEvent.defaultExpectation = &EphemeralServiceMockSendTypingEventExpectation{}
+	}
+
+	if mmSendTypingEvent.defaultExpectation.paramPtrs != nil {
+		mmSendTypingEvent.mock.t.Fatalf("EphemeralServiceMock.SendTypingEvent mock is already set by ExpectParams functions")
+	}
+
+	mmSendTypingEvent.defaultExpectation.params = &EphemeralServiceMockSendTypingEventParams{ctx, params}
+	for _, e := range mmSendTypingEvent.expectations {
+		if minimock.Equal(e.params, mmSendTypingEvent.defaultExpectation.params) {
+			mmSendTypingEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendTypingEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmSendTypingEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EphemeralService.SendTypingEvent
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) ExpectCtxParam1(ctx context.Context) *mEphemeralServiceMockSendTypingEvent {
+	if mmSendTypingEvent.mock.funcSendTypingEvent != nil {
+		mmSendTypingEvent.mock.t.Fatalf("EphemeralServiceMock.SendTypingEvent mock is already set by Set")
+	}
+
+	if mmSendTypingEvent.defaultExpectation == nil {
+		mmSendTypingEvent.defaultExpectation = &EphemeralServiceMockSendTypingEventExpectation{}
+	}
+
+	if mmSendTypingEvent.defaultExpectation.params != nil {
+		mmSendTypingEvent.mock.t.Fatalf("EphemeralServiceMock.SendTypingEvent mock is already set by Expect")
+	}
+
+	if mmSendTypingEvent.defaultExpectation.paramPtrs == nil {
+		mmSendTypingEvent.defaultExpectation.paramPtrs = &EphemeralServiceMockSendTypingEventParamPtrs{}
+	}
+	mmSendTypingEvent.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSendTypingEvent
+}
+
+// ExpectParamsParam2 sets up expected param params for EphemeralService.SendTypingEvent
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) ExpectParamsParam2(params model.SendTypingEventParams) *mEphemeralServiceMockSendTypingEvent {
+	if mmSendTypingEvent.mock.funcSendTypingEvent != nil {
+		mmSendTypingEvent.mock.t.Fatalf("EphemeralServiceMock.SendTypingEvent mock is already set by Set")
+	}
+
+	if mmSendTypingEvent.defaultExpectation == nil {
+		mmSendTypingEvent.defaultExpectation = &EphemeralServiceMockSendTypingEventExpectation{}
+	}
+
+	if mmSendTypingEvent.defaultExpectation.params != nil {
+		mmSendTypingEvent.mock.t.Fatalf("EphemeralServiceMock.SendTypingEvent mock is already set by Expect")
+	}
+
+	if mmSendTypingEvent.defaultExpectation.paramPtrs == nil {
+		mmSendTypingEvent.defaultExpectation.paramPtrs = &EphemeralServiceMockSendTypingEventParamPtrs{}
+	}
+	mmSendTypingEvent.defaultExpectation.paramPtrs.params = &params
+
+	return mmSendTypingEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the EphemeralService.SendTypingEvent
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) Inspect(f func(ctx context.Context, params model.SendTypingEventParams)) *mEphemeralServiceMockSendTypingEvent {
+	if mmSendTypingEvent.mock.inspectFuncSendTypingEvent != nil {
+		mmSendTypingEvent.mock.t.Fatalf("Inspect function is already set for EphemeralServiceMock.SendTypingEvent")
+	}
+
+	mmSendTypingEvent.mock.inspectFuncSendTypingEvent = f
+
+	return mmSendTypingEvent
+}
+
+// Return sets up results that will be returned by EphemeralService.SendTypingEvent
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) Return(err error) *EphemeralServiceMock {
+	if mmSendTypingEvent.mock.funcSendTypingEvent != nil {
+		mmSendTypingEvent.mock.t.Fatalf("EphemeralServiceMock.SendTypingEvent mock is already set by Set")
+	}
+
+	if mmSendTypingEvent.defaultExpectation == nil {
+		mmSendTypingEvent.defaultExpectation = &EphemeralServiceMockSendTypingEventExpectation{mock: mmSendTypingEvent.mock}
+	}
+	mmSendTypingEvent.defaultExpectation.results = &EphemeralServiceMockSendTypingEventResults{err}
+	return mmSendTypingEvent.mock
+}
+
+// Set uses given function f to mock the EphemeralService.SendTypingEvent method
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) Set(f func(ctx context.Context, params model.SendTypingEventParams) (err error)) *EphemeralServiceMock {
+	if mmSendTypingEvent.defaultExpectation != nil {
+		mmSendTypingEvent.mock.t.Fatalf("Default expectation is already set for the EphemeralService.SendTypingEvent method")
+	}
+
+	if len(mmSendTypingEvent.expectations) > 0 {
+		mmSendTypingEvent.mock.t.Fatalf("Some expectations are already set for the EphemeralService.SendTypingEvent method")
+	}
+
+	mmSendTypingEvent.mock.funcSendTypingEvent = f
+	return mmSendTypingEvent.mock
+}
+
+// When sets expectation for the EphemeralService.SendTypingEvent which will trigger the result defined by the following
+// Then helper
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) When(ctx context.Context, params model.SendTypingEventParams) *EphemeralServiceMockSendTypingEventExpectation {
+	if mmSendTypingEvent.mock.funcSendTypingEvent != nil {
+		mmSendTypingEvent.mock.t.Fatalf("EphemeralServiceMock.SendTypingEvent mock is already set by Set")
+	}
+
+	expectation := &EphemeralServiceMockSendTypingEventExpectation{
+		mock:   mmSendTypingEvent.mock,
+		params: &EphemeralServiceMockSendTypingEventParams{ctx, params},
+	}
+	mmSendTypingEvent.expectations = append(mmSendTypingEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EphemeralService.SendTypingEvent return parameters for the expectation previously defined by the When method
+func (e *EphemeralServiceMockSendTypingEventExpectation) Then(err error) *EphemeralServiceMock {
+	e.results = &EphemeralServiceMockSendTypingEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times EphemeralService.SendTypingEvent should be invoked
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) Times(n uint64) *mEphemeralServiceMockSendTypingEvent {
+	if n == 0 {
+		mmSendTypingEvent.mock.t.Fatalf("Times of EphemeralServiceMock.SendTypingEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendTypingEvent.expectedInvocations, n)
+	return mmSendTypingEvent
+}
+
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) invocationsDone() bool {
+	if len(mmSendTypingEvent.expectations) == 0 && mmSendTypingEvent.defaultExpectation == nil && mmSendTypingEvent.mock.funcSendTypingEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendTypingEvent.mock.afterSendTypingEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendTypingEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendTypingEvent implements service.EphemeralService
+func (mmSendTypingEvent *EphemeralServiceMock) SendTypingEvent(ctx context.Context, params model.SendTypingEventParams) (err error) {
+	mm_atomic.AddUint64(&mmSendTypingEvent.beforeSendTypingEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendTypingEvent.afterSendTypingEventCounter, 1)
+
+	if mmSendTypingEvent.inspectFuncSendTypingEvent != nil {
+		mmSendTypingEvent.inspectFuncSendTypingEvent(ctx, params)
+	}
+
+	mm_params := EphemeralServiceMockSendTypingEventParams{ctx, params}
+
+	// Record call args
+	mmSendTypingEvent.SendTypingEventMock.mutex.Lock()
+	mmSendTypingEvent.SendTypingEventMock.callArgs = append(mmSendTypingEvent.SendTypingEventMock.callArgs, &mm_params)
+	mmSendTypingEvent.SendTypingEventMock.mutex.Unlock()
+
+	for _, e := range mmSendTypingEvent.SendTypingEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSendTypingEvent.SendTypingEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendTypingEvent.SendTypingEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendTypingEvent.SendTypingEventMock.defaultExpectation.params
+		mm_want_ptrs := mmSendTypingEvent.SendTypingEventMock.defaultExpectation.paramPtrs
+
+		mm_got := EphemeralServiceMockSendTypingEventParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendTypingEvent.t.Errorf("EphemeralServiceMock.SendTypingEvent got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmSendTypingEvent.t.Errorf("EphemeralServiceMock.SendTypingEvent got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendTypingEvent.t.Errorf("EphemeralServiceMock.SendTypingEvent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendTypingEvent.SendTypingEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendTypingEvent.t.Fatal("No results are set for the EphemeralServiceMock.SendTypingEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmSendTypingEvent.funcSendTypingEvent != nil {
+		return mmSendTypingEvent.funcSendTypingEvent(ctx, params)
+	}
+	mmSendTypingEvent.t.Fatalf("Unexpected call to EphemeralServiceMock.SendTypingEvent. %v %v", ctx, params)
+	return
+}
+
+// SendTypingEventAfterCounter returns a count of finished EphemeralServiceMock.SendTypingEvent invocations
+func (mmSendTypingEvent *EphemeralServiceMock) SendTypingEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendTypingEvent.afterSendTypingEventCounter)
+}
+
+// SendTypingEventBeforeCounter returns a count of EphemeralServiceMock.SendTypingEvent invocations
+func (mmSendTypingEvent *EphemeralServiceMock) SendTypingEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendTypingEvent.beforeSendTypingEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to EphemeralServiceMock.SendTypingEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendTypingEvent *mEphemeralServiceMockSendTypingEvent) Calls() []*EphemeralServiceMockSendTypingEventParams {
+	mmSendTypingEvent.mutex.RLock()
+
+	argCopy := make([]*EphemeralServiceMockSendTypingEventParams, len(mmSendTypingEvent.callArgs))
+	copy(argCopy, mmSendTypingEvent.callArgs)
+
+	mmSendTypingEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendTypingEventDone returns true if the count of the SendTypingEvent invocations corresponds
+// the number of defined expectations
+func (m *EphemeralServiceMock) MinimockSendTypingEventDone() bool {
+	if m.SendTypingEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendTypingEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendTypingEventMock.invocationsDone()
+}
+
+// MinimockSendTypingEventInspect logs each unmet expectation
+func (m *EphemeralServiceMock) MinimockSendTypingEventInspect() {
+	for _, e := range m.SendTypingEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EphemeralServiceMock.SendTypingEvent with params: %#v", *e.params)
+		}
+	}
+
+	afterSendTypingEventCounter := mm_atomic.LoadUint64(&m.afterSendTypingEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendTypingEventMock.defaultExpectation != nil && afterSendTypingEventCounter < 1 {
+		if m.SendTypingEventMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to EphemeralServiceMock.SendTypingEvent")
+		} else {
+			m.t.Errorf("Expected call to EphemeralServiceMock.SendTypingEvent with params: %#v", *m.SendTypingEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendTypingEvent != nil && afterSendTypingEventCounter < 1 {
+		m.t.Error("Expected call to EphemeralServiceMock.SendTypingEvent")
+	}
+
+	if !m.SendTypingEventMock.invocationsDone() && afterSendTypingEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to EphemeralServiceMock.SendTypingEvent but found %d calls",
+			mm_atomic.LoadUint64(&m.SendTypingEventMock.expectedInvocations), afterSendTypingEventCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EphemeralServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockPublishInspect()
+
+			m.MinimockSendTypingEventInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EphemeralServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EphemeralServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPublishDone() &&
+		m.MinimockSendTypingEventDone()
+}
@@ -0,0 +1,1392 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.PinService -o pin_service_minimock.go -n PinServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PinServiceMock implements service.PinService
+type PinServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListPinnedMessages          func(ctx context.Context, params model.ListPinnedMessagesParams) (pinned []model.PinnedMessage, err error)
+	inspectFuncListPinnedMessages   func(ctx context.Context, params model.ListPinnedMessagesParams)
+	afterListPinnedMessagesCounter  uint64
+	beforeListPinnedMessagesCounter uint64
+	ListPinnedMessagesMock          mPinServiceMockListPinnedMessages
+
+	funcPinMessage          func(ctx context.Context, params model.PinMessageParams) (pinned model.PinnedMessage, err error)
+	inspectFuncPinMessage   func(ctx context.Context, params model.PinMessageParams)
+	afterPinMessageCounter  uint64
+	beforePinMessageCounter uint64
+	PinMessageMock          mPinServiceMockPinMessage
+
+	funcSetPinPolicy          func(ctx context.Context, params model.SetPinPolicyParams) (err error)
+	inspectFuncSetPinPolicy   func(ctx context.Context, params model.SetPinPolicyParams)
+	afterSetPinPolicyCounter  uint64
+	beforeSetPinPolicyCounter uint64
+	SetPinPolicyMock          mPinServiceMockSetPinPolicy
+
+	funcUnpinMessage          func(ctx context.Context, params model.UnpinMessageParams) (err error)
+	inspectFuncUnpinMessage   func(ctx context.Context, params model.UnpinMessageParams)
+	afterUnpinMessageCounter  uint64
+	beforeUnpinMessageCounter uint64
+	UnpinMessageMock          mPinServiceMockUnpinMessage
+}
+
+// NewPinServiceMock returns a mock for service.PinService
+func NewPinServiceMock(t minimock.Tester) *PinServiceMock {
+	m := &PinServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListPinnedMessagesMock = mPinServiceMockListPinnedMessages{mock: m}
+	m.ListPinnedMessagesMock.callArgs = []*PinServiceMockListPinnedMessagesParams{}
+
+	m.PinMessageMock = mPinServiceMockPinMessage{mock: m}
+	m.PinMessageMock.callArgs = []*PinServiceMockPinMessageParams{}
+
+	m.SetPinPolicyMock = mPinServiceMockSetPinPolicy{mock: m}
+	m.SetPinPolicyMock.callArgs = []*PinServiceMockSetPinPolicyParams{}
+
+	m.UnpinMessageMock = mPinServiceMockUnpinMessage{mock: m}
+	m.UnpinMessageMock.callArgs = []*PinServiceMockUnpinMessageParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPinServiceMockListPinnedMessages struct {
+	optional           bool
+	mock               *PinServiceMock
+	defaultExpectation *PinServiceMockListPinnedMessagesExpectation
+	expectations       []*PinServiceMockListPinnedMessagesExpectation
+
+	callArgs []*PinServiceMockListPinnedMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PinServiceMockListPinnedMessagesExpectation specifies expectation struct of the PinService.ListPinnedMessages
+type PinServiceMockListPinnedMessagesExpectation struct {
+	mock      *PinServiceMock
+	params    *PinServiceMockListPinnedMessagesParams
+	paramPtrs *PinServiceMockListPinnedMessagesParamPtrs
+	results   *PinServiceMockListPinnedMessagesResults
+	Counter   uint64
+}
+
+// PinServiceMockListPinnedMessagesParams contains parameters of the PinService.ListPinnedMessages
+type PinServiceMockListPinnedMessagesParams struct {
+	ctx    context.Context
+	params model.ListPinnedMessagesParams
+}
+
+// PinServiceMockListPinnedMessagesParamPtrs contains pointers to parameters of the PinService.ListPinnedMessages
+type PinServiceMockListPinnedMessagesParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListPinnedMessagesParams
+}
+
+// PinServiceMockListPinnedMessagesResults contains results of the PinService.ListPinnedMessages
+type PinServiceMockListPinnedMessagesResults struct {
+	pinned []model.PinnedMessage
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) Optional() *mPinServiceMockListPinnedMessages {
+	mmListPinnedMessages.optional = true
+	return mmListPinnedMessages
+}
+
+// Expect sets up expected params for PinService.ListPinnedMessages
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) Expect(ctx context.Context, params model.ListPinnedMessagesParams) *mPinServiceMockListPinnedMessages {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &PinServiceMockListPinnedMessagesExpectation{}
+	}
+
+	if mmListPinnedMessages.defaultExpectation.paramPtrs != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinServiceMock.ListPinnedMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListPinnedMessages.defaultExpectation.params = &PinServiceMockListPinnedMessagesParams{ctx, params}
+	for _, e := range mmListPinnedMessages.expectations {
+		if minimock.Equal(e.params, mmListPinnedMessages.defaultExpectation.params) {
+			mmListPinnedMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPinnedMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListPinnedMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PinService.ListPinnedMessages
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) ExpectCtxParam1(ctx context.Context) *mPinServiceMockListPinnedMessages {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &PinServiceMockListPinnedMessagesExpectation{}
+	}
+
+	if mmListPinnedMessages.defaultExpectation.params != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinServiceMock.ListPinnedMessages mock is already set by Expect")
+	}
+
+	if mmListPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmListPinnedMessages.defaultExpectation.paramPtrs = &PinServiceMockListPinnedMessagesParamPtrs{}
+	}
+	mmListPinnedMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPinnedMessages
+}
+
+// ExpectParamsParam2 sets up expected param params for PinService.ListPinnedMessages
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) ExpectParamsParam2(params model.ListPinnedMessagesParams) *mPinServiceMockListPinnedMessages {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &PinServiceMockListPinnedMessagesExpectation{}
+	}
+
+	if mmListPinnedMessages.defaultExpectation.params != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinServiceMock.ListPinnedMessages mock is already set by Expect")
+	}
+
+	if mmListPinnedMessages.defaultExpectation.paramPtrs == nil {
+		mmListPinnedMessages.defaultExpectation.paramPtrs = &PinServiceMockListPinnedMessagesParamPtrs{}
+	}
+	mmListPinnedMessages.defaultExpectation.paramPtrs.params = &params
+
+	return mmListPinnedMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the PinService.ListPinnedMessages
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) Inspect(f func(ctx context.Context, params model.ListPinnedMessagesParams)) *mPinServiceMockListPinnedMessages {
+	if mmListPinnedMessages.mock.inspectFuncListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("Inspect function is already set for PinServiceMock.ListPinnedMessages")
+	}
+
+	mmListPinnedMessages.mock.inspectFuncListPinnedMessages = f
+
+	return mmListPinnedMessages
+}
+
+// Return sets up results that will be returned by PinService.ListPinnedMessages
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) Return(pinned []model.PinnedMessage, err error) *PinServiceMock {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	if mmListPinnedMessages.defaultExpectation == nil {
+		mmListPinnedMessages.defaultExpectation = &PinServiceMockListPinnedMessagesExpectation{mock: mmListPinnedMessages.mock}
+	}
+	mmListPinnedMessages.defaultExpectation.results = &PinServiceMockListPinnedMessagesResults{pinned, err}
+	return mmListPinnedMessages.mock
+}
+
+// Set uses given function f to mock the PinService.ListPinnedMessages method
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) Set(f func(ctx context.Context, params model.ListPinnedMessagesParams) (pinned []model.PinnedMessage, err error)) *PinServiceMock {
+	if mmListPinnedMessages.defaultExpectation != nil {
+		mmListPinnedMessages.mock.t.Fatalf("Default expectation is already set for the PinService.ListPinnedMessages method")
+	}
+
+	if len(mmListPinnedMessages.expectations) > 0 {
+		mmListPinnedMessages.mock.t.Fatalf("Some expectations are already set for the PinService.ListPinnedMessages method")
+	}
+
+	mmListPinnedMessages.mock.funcListPinnedMessages = f
+	return mmListPinnedMessages.mock
+}
+
+// When sets expectation for the PinService.ListPinnedMessages which will trigger the result defined by the following
+// Then helper
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) When(ctx context.Context, params model.ListPinnedMessagesParams) *PinServiceMockListPinnedMessagesExpectation {
+	if mmListPinnedMessages.mock.funcListPinnedMessages != nil {
+		mmListPinnedMessages.mock.t.Fatalf("PinServiceMock.ListPinnedMessages mock is already set by Set")
+	}
+
+	expectation := &PinServiceMockListPinnedMessagesExpectation{
+		mock:   mmListPinnedMessages.mock,
+		params: &PinServiceMockListPinnedMessagesParams{ctx, params},
+	}
+	mmListPinnedMessages.expectations = append(mmListPinnedMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PinService.ListPinnedMessages return parameters for the expectation previously defined by the When method
+func (e *PinServiceMockListPinnedMessagesExpectation) Then(pinned []model.PinnedMessage, err error) *PinServiceMock {
+	e.results = &PinServiceMockListPinnedMessagesResults{pinned, err}
+	return e.mock
+}
+
+// Times sets number of times PinService.ListPinnedMessages should be invoked
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) Times(n uint64) *mPinServiceMockListPinnedMessages {
+	if n == 0 {
+		mmListPinnedMessages.mock.t.Fatalf("Times of PinServiceMock.ListPinnedMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPinnedMessages.expectedInvocations, n)
+	return mmListPinnedMessages
+}
+
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) invocationsDone() bool {
+	if len(mmListPinnedMessages.expectations) == 0 && mmListPinnedMessages.defaultExpectation == nil && mmListPinnedMessages.mock.funcListPinnedMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPinnedMessages.mock.afterListPinnedMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPinnedMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPinnedMessages implements service.PinService
+func (mmListPinnedMessages *PinServiceMock) ListPinnedMessages(ctx context.Context, params model.ListPinnedMessagesParams) (pinned []model.PinnedMessage, err error) {
+	mm_atomic.AddUint64(&mmListPinnedMessages.beforeListPinnedMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPinnedMessages.afterListPinnedMessagesCounter, 1)
+
+	if mmListPinnedMessages.inspectFuncListPinnedMessages != nil {
+		mmListPinnedMessages.inspectFuncListPinnedMessages(ctx, params)
+	}
+
+	mm_params := PinServiceMockListPinnedMessagesParams{ctx, params}
+
+	// Record call args
+	mmListPinnedMessages.ListPinnedMessagesMock.mutex.Lock()
+	mmListPinnedMessages.ListPinnedMessagesMock.callArgs = append(mmListPinnedMessages.ListPinnedMessagesMock.callArgs, &mm_params)
+	mmListPinnedMessages.ListPinnedMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListPinnedMessages.ListPinnedMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pinned, e.results.err
+		}
+	}
+
+	if mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := PinServiceMockListPinnedMessagesParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPinnedMessages.t.Errorf("PinServiceMock.ListPinnedMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListPinnedMessages.t.Errorf("PinServiceMock.ListPinnedMessages got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPinnedMessages.t.Errorf("PinServiceMock.ListPinnedMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPinnedMessages.ListPinnedMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPinnedMessages.t.Fatal("No results are set for the PinServiceMock.ListPinnedMessages")
+		}
+		return (*mm_results).pinned, (*mm_results).err
+	}
+	if mmListPinnedMessages.funcListPinnedMessages != nil {
+		return mmListPinnedMessages.funcListPinnedMessages(ctx, params)
+	}
+	mmListPinnedMessages.t.Fatalf("Unexpected call to PinServiceMock.ListPinnedMessages. %v %v", ctx, params)
+	return
+}
+
+// ListPinnedMessagesAfterCounter returns a count of finished PinServiceMock.ListPinnedMessages invocations
+func (mmListPinnedMessages *PinServiceMock) ListPinnedMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinnedMessages.afterListPinnedMessagesCounter)
+}
+
+// ListPinnedMessagesBeforeCounter returns a count of PinServiceMock.ListPinnedMessages invocations
+func (mmListPinnedMessages *PinServiceMock) ListPinnedMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinnedMessages.beforeListPinnedMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to PinServiceMock.ListPinnedMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPinnedMessages *mPinServiceMockListPinnedMessages) Calls() []*PinServiceMockListPinnedMessagesParams {
+	mmListPinnedMessages.mutex.RLock()
+
+	argCopy := make([]*PinServiceMockListPinnedMessagesParams, len(mmListPinnedMessages.callArgs))
+	copy(argCopy, mmListPinnedMessages.callArgs)
+
+	mmListPinnedMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPinnedMessagesDone returns true if the count of the ListPinnedMessages invocations corresponds
+// the number of defined expectations
+func (m *PinServiceMock) MinimockListPinnedMessagesDone() bool {
+	if m.ListPinnedMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPinnedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPinnedMessagesMock.invocationsDone()
+}
+
+// MinimockListPinnedMessagesInspect logs each unmet expectation
+func (m *PinServiceMock) MinimockListPinnedMessagesInspect() {
+	for _, e := range m.ListPinnedMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PinServiceMock.ListPinnedMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterListPinnedMessagesCounter := mm_atomic.LoadUint64(&m.afterListPinnedMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPinnedMessagesMock.defaultExpectation != nil && afterListPinnedMessagesCounter < 1 {
+		if m.ListPinnedMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PinServiceMock.ListPinnedMessages")
+		} else {
+			m.t.Errorf("Expected call to PinServiceMock.ListPinnedMessages with params: %#v", *m.ListPinnedMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPinnedMessages != nil && afterListPinnedMessagesCounter < 1 {
+		m.t.Error("Expected call to PinServiceMock.ListPinnedMessages")
+	}
+
+	if !m.ListPinnedMessagesMock.invocationsDone() && afterListPinnedMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to PinServiceMock.ListPinnedMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPinnedMessagesMock.expectedInvocations), afterListPinnedMessagesCounter)
+	}
+}
+
+type mPinServiceMockPinMessage struct {
+	optional           bool
+	mock               *PinServiceMock
+	defaultExpectation *PinServiceMockPinMessageExpectation
+	expectations       []*PinServiceMockPinMessageExpectation
+
+	callArgs []*PinServiceMockPinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PinServiceMockPinMessageExpectation specifies expectation struct of the PinService.PinMessage
+type PinServiceMockPinMessageExpectation struct {
+	mock      *PinServiceMock
+	params    *PinServiceMockPinMessageParams
+	paramPtrs *PinServiceMockPinMessageParamPtrs
+	results   *PinServiceMockPinMessageResults
+	Counter   uint64
+}
+
+// PinServiceMockPinMessageParams contains parameters of the PinService.PinMessage
+type PinServiceMockPinMessageParams struct {
+	ctx    context.Context
+	params model.PinMessageParams
+}
+
+// PinServiceMockPinMessageParamPtrs contains pointers to parameters of the PinService.PinMessage
+type PinServiceMockPinMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.PinMessageParams
+}
+
+// PinServiceMockPinMessageResults contains results of the PinService.PinMessage
+type PinServiceMockPinMessageResults struct {
+	pinned model.PinnedMessage
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPinMessage *mPinServiceMockPinMessage) Optional() *mPinServiceMockPinMessage {
+	mmPinMessage.optional = true
+	return mmPinMessage
+}
+
+// Expect sets up expected params for PinService.PinMessage
+func (mmPinMessage *mPinServiceMockPinMessage) Expect(ctx context.Context, params model.PinMessageParams) *mPinServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("PinServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &PinServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs != nil {
+		mmPinMessage.mock.t.Fatalf("PinServiceMock.PinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPinMessage.defaultExpectation.params = &PinServiceMockPinMessageParams{ctx, params}
+	for _, e := range mmPinMessage.expectations {
+		if minimock.Equal(e.params, mmPinMessage.defaultExpectation.params) {
+			mmPinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PinService.PinMessage
+func (mmPinMessage *mPinServiceMockPinMessage) ExpectCtxParam1(ctx context.Context) *mPinServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("PinServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &PinServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("PinServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &PinServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPinMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for PinService.PinMessage
+func (mmPinMessage *mPinServiceMockPinMessage) ExpectParamsParam2(params model.PinMessageParams) *mPinServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("PinServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &PinServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("PinServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &PinServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmPinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the PinService.PinMessage
+func (mmPinMessage *mPinServiceMockPinMessage) Inspect(f func(ctx context.Context, params model.PinMessageParams)) *mPinServiceMockPinMessage {
+	if mmPinMessage.mock.inspectFuncPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("Inspect function is already set for PinServiceMock.PinMessage")
+	}
+
+	mmPinMessage.mock.inspectFuncPinMessage = f
+
+	return mmPinMessage
+}
+
+// Return sets up results that will be returned by PinService.PinMessage
+func (mmPinMessage *mPinServiceMockPinMessage) Return(pinned model.PinnedMessage, err error) *PinServiceMock {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("PinServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &PinServiceMockPinMessageExpectation{mock: mmPinMessage.mock}
+	}
+	mmPinMessage.defaultExpectation.results = &PinServiceMockPinMessageResults{pinned, err}
+	return mmPinMessage.mock
+}
+
+// Set uses given function f to mock the PinService.PinMessage method
+func (mmPinMessage *mPinServiceMockPinMessage) Set(f func(ctx context.Context, params model.PinMessageParams) (pinned model.PinnedMessage, err error)) *PinServiceMock {
+	if mmPinMessage.defaultExpectation != nil {
+		mmPinMessage.mock.t.Fatalf("Default expectation is already set for the PinService.PinMessage method")
+	}
+
+	if len(mmPinMessage.expectations) > 0 {
+		mmPinMessage.mock.t.Fatalf("Some expectations are already set for the PinService.PinMessage method")
+	}
+
+	mmPinMessage.mock.funcPinMessage = f
+	return mmPinMessage.mock
+}
+
+// When sets expectation for the PinService.PinMessage which will trigger the result defined by the following
+// Then helper
+func (mmPinMessage *mPinServiceMockPinMessage) When(ctx context.Context, params model.PinMessageParams) *PinServiceMockPinMessageExpectation {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("PinServiceMock.PinMessage mock is already set by Set")
+	}
+
+	expectation := &PinServiceMockPinMessageExpectation{
+		mock:   mmPinMessage.mock,
+		params: &PinServiceMockPinMessageParams{ctx, params},
+	}
+	mmPinMessage.expectations = append(mmPinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PinService.PinMessage return parameters for the expectation previously defined by the When method
+func (e *PinServiceMockPinMessageExpectation) Then(pinned model.PinnedMessage, err error) *PinServiceMock {
+	e.results = &PinServiceMockPinMessageResults{pinned, err}
+	return e.mock
+}
+
+// Times sets number of times PinService.PinMessage should be invoked
+func (mmPinMessage *mPinServiceMockPinMessage) Times(n uint64) *mPinServiceMockPinMessage {
+	if n == 0 {
+		mmPinMessage.mock.t.Fatalf("Times of PinServiceMock.PinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPinMessage.expectedInvocations, n)
+	return mmPinMessage
+}
+
+func (mmPinMessage *mPinServiceMockPinMessage) invocationsDone() bool {
+	if len(mmPinMessage.expectations) == 0 && mmPinMessage.defaultExpectation == nil && mmPinMessage.mock.funcPinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPinMessage.mock.afterPinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PinMessage implements service.PinService
+func (mmPinMessage *PinServiceMock) PinMessage(ctx context.Context, params model.PinMessageParams) (pinned model.PinnedMessage, err error) {
+	mm_atomic.AddUint64(&mmPinMessage.beforePinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPinMessage.afterPinMessageCounter, 1)
+
+	if mmPinMessage.inspectFuncPinMessage != nil {
+		mmPinMessage.inspectFuncPinMessage(ctx, params)
+	}
+
+	mm_params := PinServiceMockPinMessageParams{ctx, params}
+
+	// Record call args
+	mmPinMessage.PinMessageMock.mutex.Lock()
+	mmPinMessage.PinMessageMock.callArgs = append(mmPinMessage.PinMessageMock.callArgs, &mm_params)
+	mmPinMessage.PinMessageMock.mutex.Unlock()
+
+	for _, e := range mmPinMessage.PinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pinned, e.results.err
+		}
+	}
+
+	if mmPinMessage.PinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPinMessage.PinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPinMessage.PinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPinMessage.PinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := PinServiceMockPinMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPinMessage.t.Errorf("PinServiceMock.PinMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmPinMessage.t.Errorf("PinServiceMock.PinMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPinMessage.t.Errorf("PinServiceMock.PinMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPinMessage.PinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPinMessage.t.Fatal("No results are set for the PinServiceMock.PinMessage")
+		}
+		return (*mm_results).pinned, (*mm_results).err
+	}
+	if mmPinMessage.funcPinMessage != nil {
+		return mmPinMessage.funcPinMessage(ctx, params)
+	}
+	mmPinMessage.t.Fatalf("Unexpected call to PinServiceMock.PinMessage. %v %v", ctx, params)
+	return
+}
+
+// PinMessageAfterCounter returns a count of finished PinServiceMock.PinMessage invocations
+func (mmPinMessage *PinServiceMock) PinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.afterPinMessageCounter)
+}
+
+// PinMessageBeforeCounter returns a count of PinServiceMock.PinMessage invocations
+func (mmPinMessage *PinServiceMock) PinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.beforePinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to PinServiceMock.PinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPinMessage *mPinServiceMockPinMessage) Calls() []*PinServiceMockPinMessageParams {
+	mmPinMessage.mutex.RLock()
+
+	argCopy := make([]*PinServiceMockPinMessageParams, len(mmPinMessage.callArgs))
+	copy(argCopy, mmPinMessage.callArgs)
+
+	mmPinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPinMessageDone returns true if the count of the PinMessage invocations corresponds
+// the number of defined expectations
+func (m *PinServiceMock) MinimockPinMessageDone() bool {
+	if m.PinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PinMessageMock.invocationsDone()
+}
+
+// MinimockPinMessageInspect logs each unmet expectation
+func (m *PinServiceMock) MinimockPinMessageInspect() {
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PinServiceMock.PinMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterPinMessageCounter := mm_atomic.LoadUint64(&m.afterPinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PinMessageMock.defaultExpectation != nil && afterPinMessageCounter < 1 {
+		if m.PinMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PinServiceMock.PinMessage")
+		} else {
+			m.t.Errorf("Expected call to PinServiceMock.PinMessage with params: %#v", *m.PinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPinMessage != nil && afterPinMessageCounter < 1 {
+		m.t.Error("Expected call to PinServiceMock.PinMessage")
+	}
+
+	if !m.PinMessageMock.invocationsDone() && afterPinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to PinServiceMock.PinMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.PinMessageMock.expectedInvocations), afterPinMessageCounter)
+	}
+}
+
+type mPinServiceMockSetPinPolicy struct {
+	optional           bool
+	mock               *PinServiceMock
+	defaultExpectation *PinServiceMockSetPinPolicyExpectation
+	expectations       []*PinServiceMockSetPinPolicyExpectation
+
+	callArgs []*PinServiceMockSetPinPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PinServiceMockSetPinPolicyExpectation specifies expectation struct of the PinService.SetPinPolicy
+type PinServiceMockSetPinPolicyExpectation struct {
+	mock      *PinServiceMock
+	params    *PinServiceMockSetPinPolicyParams
+	paramPtrs *PinServiceMockSetPinPolicyParamPtrs
+	results   *PinServiceMockSetPinPolicyResults
+	Counter   uint64
+}
+
+// PinServiceMockSetPinPolicyParams contains parameters of the PinService.SetPinPolicy
+type PinServiceMockSetPinPolicyParams struct {
+	ctx    context.Context
+	params model.SetPinPolicyParams
+}
+
+// PinServiceMockSetPinPolicyParamPtrs contains pointers to parameters of the PinService.SetPinPolicy
+type PinServiceMockSetPinPolicyParamPtrs struct {
+	ctx    *context.Context
+	params *model.SetPinPolicyParams
+}
+
+// PinServiceMockSetPinPolicyResults contains results of the PinService.SetPinPolicy
+type PinServiceMockSetPinPolicyResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) Optional() *mPinServiceMockSetPinPolicy {
+	mmSetPinPolicy.optional = true
+	return mmSetPinPolicy
+}
+
+// Expect sets up expected params for PinService.SetPinPolicy
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) Expect(ctx context.Context, params model.SetPinPolicyParams) *mPinServiceMockSetPinPolicy {
+	if mmSetPinPolicy.mock.funcSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinServiceMock.SetPinPolicy mock is already set by Set")
+	}
+
+	if mmSetPinPolicy.defaultExpectation == nil {
+		mmSetPinPolicy.defaultExpectation = &PinServiceMockSetPinPolicyExpectation{}
+	}
+
+	if mmSetPinPolicy.defaultExpectation.paramPtrs != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinServiceMock.SetPinPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmSetPinPolicy.defaultExpectation.params = &PinServiceMockSetPinPolicyParams{ctx, params}
+	for _, e := range mmSetPinPolicy.expectations {
+		if minimock.Equal(e.params, mmSetPinPolicy.defaultExpectation.params) {
+			mmSetPinPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPinPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmSetPinPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PinService.SetPinPolicy
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) ExpectCtxParam1(ctx context.Context) *mPinServiceMockSetPinPolicy {
+	if mmSetPinPolicy.mock.funcSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinServiceMock.SetPinPolicy mock is already set by Set")
+	}
+
+	if mmSetPinPolicy.defaultExpectation == nil {
+		mmSetPinPolicy.defaultExpectation = &PinServiceMockSetPinPolicyExpectation{}
+	}
+
+	if mmSetPinPolicy.defaultExpectation.params != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinServiceMock.SetPinPolicy mock is already set by Expect")
+	}
+
+	if mmSetPinPolicy.defaultExpectation.paramPtrs == nil {
+		mmSetPinPolicy.defaultExpectation.paramPtrs = &PinServiceMockSetPinPolicyParamPtrs{}
+	}
+	mmSetPinPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetPinPolicy
+}
+
+// ExpectParamsParam2 sets up expected param params for PinService.SetPinPolicy
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) ExpectParamsParam2(params model.SetPinPolicyParams) *mPinServiceMockSetPinPolicy {
+	if mmSetPinPolicy.mock.funcSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinServiceMock.SetPinPolicy mock is already set by Set")
+	}
+
+	if mmSetPinPolicy.defaultExpectation == nil {
+		mmSetPinPolicy.defaultExpectation = &PinServiceMockSetPinPolicyExpectation{}
+	}
+
+	if mmSetPinPolicy.defaultExpectation.params != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinServiceMock.SetPinPolicy mock is already set by Expect")
+	}
+
+	if mmSetPinPolicy.defaultExpectation.paramPtrs == nil {
+		mmSetPinPolicy.defaultExpectation.paramPtrs = &PinServiceMockSetPinPolicyParamPtrs{}
+	}
+	mmSetPinPolicy.defaultExpectation.paramPtrs.params = &params
+
+	return mmSetPinPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PinService.SetPinPolicy
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) Inspect(f func(ctx context.Context, params model.SetPinPolicyParams)) *mPinServiceMockSetPinPolicy {
+	if mmSetPinPolicy.mock.inspectFuncSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("Inspect function is already set for PinServiceMock.SetPinPolicy")
+	}
+
+	mmSetPinPolicy.mock.inspectFuncSetPinPolicy = f
+
+	return mmSetPinPolicy
+}
+
+// Return sets up results that will be returned by PinService.SetPinPolicy
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) Return(err error) *PinServiceMock {
+	if mmSetPinPolicy.mock.funcSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinServiceMock.SetPinPolicy mock is already set by Set")
+	}
+
+	if mmSetPinPolicy.defaultExpectation == nil {
+		mmSetPinPolicy.defaultExpectation = &PinServiceMockSetPinPolicyExpectation{mock: mmSetPinPolicy.mock}
+	}
+	mmSetPinPolicy.defaultExpectation.results = &PinServiceMockSetPinPolicyResults{err}
+	return mmSetPinPolicy.mock
+}
+
+// Set uses given function f to mock the PinService.SetPinPolicy method
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) Set(f func(ctx context.Context, params model.SetPinPolicyParams) (err error)) *PinServiceMock {
+	if mmSetPinPolicy.defaultExpectation != nil {
+		mmSetPinPolicy.mock.t.Fatalf("Default expectation is already set for the PinService.SetPinPolicy method")
+	}
+
+	if len(mmSetPinPolicy.expectations) > 0 {
+		mmSetPinPolicy.mock.t.Fatalf("Some expectations are already set for the PinService.SetPinPolicy method")
+	}
+
+	mmSetPinPolicy.mock.funcSetPinPolicy = f
+	return mmSetPinPolicy.mock
+}
+
+// When sets expectation for the PinService.SetPinPolicy which will trigger the result defined by the following
+// Then helper
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) When(ctx context.Context, params model.SetPinPolicyParams) *PinServiceMockSetPinPolicyExpectation {
+	if mmSetPinPolicy.mock.funcSetPinPolicy != nil {
+		mmSetPinPolicy.mock.t.Fatalf("PinServiceMock.SetPinPolicy mock is already set by Set")
+	}
+
+	expectation := &PinServiceMockSetPinPolicyExpectation{
+		mock:   mmSetPinPolicy.mock,
+		params: &PinServiceMockSetPinPolicyParams{ctx, params},
+	}
+	mmSetPinPolicy.expectations = append(mmSetPinPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PinService.SetPinPolicy return parameters for the expectation previously defined by the When method
+func (e *PinServiceMockSetPinPolicyExpectation) Then(err error) *PinServiceMock {
+	e.results = &PinServiceMockSetPinPolicyResults{err}
+	return e.mock
+}
+
+// Times sets number of times PinService.SetPinPolicy should be invoked
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) Times(n uint64) *mPinServiceMockSetPinPolicy {
+	if n == 0 {
+		mmSetPinPolicy.mock.t.Fatalf("Times of PinServiceMock.SetPinPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetPinPolicy.expectedInvocations, n)
+	return mmSetPinPolicy
+}
+
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) invocationsDone() bool {
+	if len(mmSetPinPolicy.expectations) == 0 && mmSetPinPolicy.defaultExpectation == nil && mmSetPinPolicy.mock.funcSetPinPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPinPolicy.mock.afterSetPinPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPinPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetPinPolicy implements service.PinService
+func (mmSetPinPolicy *PinServiceMock) SetPinPolicy(ctx context.Context, params model.SetPinPolicyParams) (err error) {
+	mm_atomic.AddUint64(&mmSetPinPolicy.beforeSetPinPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPinPolicy.afterSetPinPolicyCounter, 1)
+
+	if mmSetPinPolicy.inspectFuncSetPinPolicy != nil {
+		mmSetPinPolicy.inspectFuncSetPinPolicy(ctx, params)
+	}
+
+	mm_params := PinServiceMockSetPinPolicyParams{ctx, params}
+
+	// Record call args
+	mmSetPinPolicy.SetPinPolicyMock.mutex.Lock()
+	mmSetPinPolicy.SetPinPolicyMock.callArgs = append(mmSetPinPolicy.SetPinPolicyMock.callArgs, &mm_params)
+	mmSetPinPolicy.SetPinPolicyMock.mutex.Unlock()
+
+	for _, e := range mmSetPinPolicy.SetPinPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetPinPolicy.SetPinPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPinPolicy.SetPinPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPinPolicy.SetPinPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPinPolicy.SetPinPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PinServiceMockSetPinPolicyParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetPinPolicy.t.Errorf("PinServiceMock.SetPinPolicy got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmSetPinPolicy.t.Errorf("PinServiceMock.SetPinPolicy got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetPinPolicy.t.Errorf("PinServiceMock.SetPinPolicy got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetPinPolicy.SetPinPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetPinPolicy.t.Fatal("No results are set for the PinServiceMock.SetPinPolicy")
+		}
+		return (*mm_results).err
+	}
+	if mmSetPinPolicy.funcSetPinPolicy != nil {
+		return mmSetPinPolicy.funcSetPinPolicy(ctx, params)
+	}
+	mmSetPinPolicy.t.Fatalf("Unexpected call to PinServiceMock.SetPinPolicy. %v %v", ctx, params)
+	return
+}
+
+// SetPinPolicyAfterCounter returns a count of finished PinServiceMock.SetPinPolicy invocations
+func (mmSetPinPolicy *PinServiceMock) SetPinPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPinPolicy.afterSetPinPolicyCounter)
+}
+
+// SetPinPolicyBeforeCounter returns a count of PinServiceMock.SetPinPolicy invocations
+func (mmSetPinPolicy *PinServiceMock) SetPinPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPinPolicy.beforeSetPinPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PinServiceMock.SetPinPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetPinPolicy *mPinServiceMockSetPinPolicy) Calls() []*PinServiceMockSetPinPolicyParams {
+	mmSetPinPolicy.mutex.RLock()
+
+	argCopy := make([]*PinServiceMockSetPinPolicyParams, len(mmSetPinPolicy.callArgs))
+	copy(argCopy, mmSetPinPolicy.callArgs)
+
+	mmSetPinPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetPinPolicyDone returns true if the count of the SetPinPolicy invocations corresponds
+// the number of defined expectations
+func (m *PinServiceMock) MinimockSetPinPolicyDone() bool {
+	if m.SetPinPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetPinPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetPinPolicyMock.invocationsDone()
+}
+
+// MinimockSetPinPolicyInspect logs each unmet expectation
+func (m *PinServiceMock) MinimockSetPinPolicyInspect() {
+	for _, e := range m.SetPinPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PinServiceMock.SetPinPolicy with params: %#v", *e.params)
+		}
+	}
+
+	afterSetPinPolicyCounter := mm_atomic.LoadUint64(&m.afterSetPinPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetPinPolicyMock.defaultExpectation != nil && afterSetPinPolicyCounter < 1 {
+		if m.SetPinPolicyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PinServiceMock.SetPinPolicy")
+		} else {
+			m.t.Errorf("Expected call to PinServiceMock.SetPinPolicy with params: %#v", *m.SetPinPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetPinPolicy != nil && afterSetPinPolicyCounter < 1 {
+		m.t.Error("Expected call to PinServiceMock.SetPinPolicy")
+	}
+
+	if !m.SetPinPolicyMock.invocationsDone() && afterSetPinPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PinServiceMock.SetPinPolicy but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPinPolicyMock.expectedInvocations), afterSetPinPolicyCounter)
+	}
+}
+
+type mPinServiceMockUnpinMessage struct {
+	optional           bool
+	mock               *PinServiceMock
+	defaultExpectation *PinServiceMockUnpinMessageExpectation
+	expectations       []*PinServiceMockUnpinMessageExpectation
+
+	callArgs []*PinServiceMockUnpinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PinServiceMockUnpinMessageExpectation specifies expectation struct of the PinService.UnpinMessage
+type PinServiceMockUnpinMessageExpectation struct {
+	mock      *PinServiceMock
+	params    *PinServiceMockUnpinMessageParams
+	paramPtrs *PinServiceMockUnpinMessageParamPtrs
+	results   *PinServiceMockUnpinMessageResults
+	Counter   uint64
+}
+
+// PinServiceMockUnpinMessageParams contains parameters of the PinService.UnpinMessage
+type PinServiceMockUnpinMessageParams struct {
+	ctx    context.Context
+	params model.UnpinMessageParams
+}
+
+// PinServiceMockUnpinMessageParamPtrs contains pointers to parameters of the PinService.UnpinMessage
+type PinServiceMockUnpinMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.UnpinMessageParams
+}
+
+// PinServiceMockUnpinMessageResults contains results of the PinService.UnpinMessage
+type PinServiceMockUnpinMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) Optional() *mPinServiceMockUnpinMessage {
+	mmUnpinMessage.optional = true
+	return mmUnpinMessage
+}
+
+// Expect sets up expected params for PinService.UnpinMessage
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) Expect(ctx context.Context, params model.UnpinMessageParams) *mPinServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &PinServiceMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinServiceMock.UnpinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmUnpinMessage.defaultExpectation.params = &PinServiceMockUnpinMessageParams{ctx, params}
+	for _, e := range mmUnpinMessage.expectations {
+		if minimock.Equal(e.params, mmUnpinMessage.defaultExpectation.params) {
+			mmUnpinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnpinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmUnpinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PinService.UnpinMessage
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) ExpectCtxParam1(ctx context.Context) *mPinServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &PinServiceMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinServiceMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &PinServiceMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUnpinMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for PinService.UnpinMessage
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) ExpectParamsParam2(params model.UnpinMessageParams) *mPinServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &PinServiceMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinServiceMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &PinServiceMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmUnpinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the PinService.UnpinMessage
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) Inspect(f func(ctx context.Context, params model.UnpinMessageParams)) *mPinServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("Inspect function is already set for PinServiceMock.UnpinMessage")
+	}
+
+	mmUnpinMessage.mock.inspectFuncUnpinMessage = f
+
+	return mmUnpinMessage
+}
+
+// Return sets up results that will be returned by PinService.UnpinMessage
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) Return(err error) *PinServiceMock {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &PinServiceMockUnpinMessageExpectation{mock: mmUnpinMessage.mock}
+	}
+	mmUnpinMessage.defaultExpectation.results = &PinServiceMockUnpinMessageResults{err}
+	return mmUnpinMessage.mock
+}
+
+// Set uses given function f to mock the PinService.UnpinMessage method
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) Set(f func(ctx context.Context, params model.UnpinMessageParams) (err error)) *PinServiceMock {
+	if mmUnpinMessage.defaultExpectation != nil {
+		mmUnpinMessage.mock.t.Fatalf("Default expectation is already set for the PinService.UnpinMessage method")
+	}
+
+	if len(mmUnpinMessage.expectations) > 0 {
+		mmUnpinMessage.mock.t.Fatalf("Some expectations are already set for the PinService.UnpinMessage method")
+	}
+
+	mmUnpinMessage.mock.funcUnpinMessage = f
+	return mmUnpinMessage.mock
+}
+
+// When sets expectation for the PinService.UnpinMessage which will trigger the result defined by the following
+// Then helper
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) When(ctx context.Context, params model.UnpinMessageParams) *PinServiceMockUnpinMessageExpectation {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("PinServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	expectation := &PinServiceMockUnpinMessageExpectation{
+		mock:   mmUnpinMessage.mock,
+		params: &PinServiceMockUnpinMessageParams{ctx, params},
+	}
+	mmUnpinMessage.expectations = append(mmUnpinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PinService.UnpinMessage return parameters for the expectation previously defined by the When method
+func (e *PinServiceMockUnpinMessageExpectation) Then(err error) *PinServiceMock {
+	e.results = &PinServiceMockUnpinMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times PinService.UnpinMessage should be invoked
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) Times(n uint64) *mPinServiceMockUnpinMessage {
+	if n == 0 {
+		mmUnpinMessage.mock.t.Fatalf("Times of PinServiceMock.UnpinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUnpinMessage.expectedInvocations, n)
+	return mmUnpinMessage
+}
+
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) invocationsDone() bool {
+	if len(mmUnpinMessage.expectations) == 0 && mmUnpinMessage.defaultExpectation == nil && mmUnpinMessage.mock.funcUnpinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.mock.afterUnpinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UnpinMessage implements service.PinService
+func (mmUnpinMessage *PinServiceMock) UnpinMessage(ctx context.Context, params model.UnpinMessageParams) (err error) {
+	mm_atomic.AddUint64(&mmUnpinMessage.beforeUnpinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnpinMessage.afterUnpinMessageCounter, 1)
+
+	if mmUnpinMessage.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.inspectFuncUnpinMessage(ctx, params)
+	}
+
+	mm_params := PinServiceMockUnpinMessageParams{ctx, params}
+
+	// Record call args
+	mmUnpinMessage.UnpinMessageMock.mutex.Lock()
+	mmUnpinMessage.UnpinMessageMock.callArgs = append(mmUnpinMessage.UnpinMessageMock.callArgs, &mm_params)
+	mmUnpinMessage.UnpinMessageMock.mutex.Unlock()
+
+	for _, e := range mmUnpinMessage.UnpinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUnpinMessage.UnpinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnpinMessage.UnpinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnpinMessage.UnpinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmUnpinMessage.UnpinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := PinServiceMockUnpinMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUnpinMessage.t.Errorf("PinServiceMock.UnpinMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUnpinMessage.t.Errorf("PinServiceMock.UnpinMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnpinMessage.t.Errorf("PinServiceMock.UnpinMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnpinMessage.UnpinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnpinMessage.t.Fatal("No results are set for the PinServiceMock.UnpinMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmUnpinMessage.funcUnpinMessage != nil {
+		return mmUnpinMessage.funcUnpinMessage(ctx, params)
+	}
+	mmUnpinMessage.t.Fatalf("Unexpected call to PinServiceMock.UnpinMessage. %v %v", ctx, params)
+	return
+}
+
+// UnpinMessageAfterCounter returns a count of finished PinServiceMock.UnpinMessage invocations
+func (mmUnpinMessage *PinServiceMock) UnpinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.afterUnpinMessageCounter)
+}
+
+// UnpinMessageBeforeCounter returns a count of PinServiceMock.UnpinMessage invocations
+func (mmUnpinMessage *PinServiceMock) UnpinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.beforeUnpinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to PinServiceMock.UnpinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnpinMessage *mPinServiceMockUnpinMessage) Calls() []*PinServiceMockUnpinMessageParams {
+	mmUnpinMessage.mutex.RLock()
+
+	argCopy := make([]*PinServiceMockUnpinMessageParams, len(mmUnpinMessage.callArgs))
+	copy(argCopy, mmUnpinMessage.callArgs)
+
+	mmUnpinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnpinMessageDone returns true if the count of the UnpinMessage invocations corresponds
+// the number of defined expectations
+func (m *PinServiceMock) MinimockUnpinMessageDone() bool {
+	if m.UnpinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UnpinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UnpinMessageMock.invocationsDone()
+}
+
+// MinimockUnpinMessageInspect logs each unmet expectation
+func (m *PinServiceMock) MinimockUnpinMessageInspect() {
+	for _, e := range m.UnpinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PinServiceMock.UnpinMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterUnpinMessageCounter := mm_atomic.LoadUint64(&m.afterUnpinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnpinMessageMock.defaultExpectation != nil && afterUnpinMessageCounter < 1 {
+		if m.UnpinMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PinServiceMock.UnpinMessage")
+		} else {
+			m.t.Errorf("Expected call to PinServiceMock.UnpinMessage with params: %#v", *m.UnpinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnpinMessage != nil && afterUnpinMessageCounter < 1 {
+		m.t.Error("Expected call to PinServiceMock.UnpinMessage")
+	}
+
+	if !m.UnpinMessageMock.invocationsDone() && afterUnpinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to PinServiceMock.UnpinMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.UnpinMessageMock.expectedInvocations), afterUnpinMessageCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PinServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListPinnedMessagesInspect()
+
+			m.MinimockPinMessageInspect()
+
+			m.MinimockSetPinPolicyInspect()
+
+			m.MinimockUnpinMessageInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PinServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PinServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListPinnedMessagesDone() &&
+		m.MinimockPinMessageDone() &&
+		m.MinimockSetPinPolicyDone() &&
+		m.MinimockUnpinMessageDone()
+}
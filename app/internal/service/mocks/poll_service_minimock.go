@@ -0,0 +1,1392 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.PollService -o poll_service_minimock.go -n PollServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PollServiceMock implements service.PollService
+type PollServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClosePoll          func(ctx context.Context, params model.ClosePollParams) (err error)
+	inspectFuncClosePoll   func(ctx context.Context, params model.ClosePollParams)
+	afterClosePollCounter  uint64
+	beforeClosePollCounter uint64
+	ClosePollMock          mPollServiceMockClosePoll
+
+	funcCreatePoll          func(ctx context.Context, params model.CreatePollParams) (resp model.CreatePollResponse, err error)
+	inspectFuncCreatePoll   func(ctx context.Context, params model.CreatePollParams)
+	afterCreatePollCounter  uint64
+	beforeCreatePollCounter uint64
+	CreatePollMock          mPollServiceMockCreatePoll
+
+	funcGetPoll          func(ctx context.Context, params model.GetPollParams) (poll model.Poll, err error)
+	inspectFuncGetPoll   func(ctx context.Context, params model.GetPollParams)
+	afterGetPollCounter  uint64
+	beforeGetPollCounter uint64
+	GetPollMock          mPollServiceMockGetPoll
+
+	funcVote          func(ctx context.Context, params model.VoteParams) (err error)
+	inspectFuncVote   func(ctx context.Context, params model.VoteParams)
+	afterVoteCounter  uint64
+	beforeVoteCounter uint64
+	VoteMock          mPollServiceMockVote
+}
+
+// NewPollServiceMock returns a mock for service.PollService
+func NewPollServiceMock(t minimock.Tester) *PollServiceMock {
+	m := &PollServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClosePollMock = mPollServiceMockClosePoll{mock: m}
+	m.ClosePollMock.callArgs = []*PollServiceMockClosePollParams{}
+
+	m.CreatePollMock = mPollServiceMockCreatePoll{mock: m}
+	m.CreatePollMock.callArgs = []*PollServiceMockCreatePollParams{}
+
+	m.GetPollMock = mPollServiceMockGetPoll{mock: m}
+	m.GetPollMock.callArgs = []*PollServiceMockGetPollParams{}
+
+	m.VoteMock = mPollServiceMockVote{mock: m}
+	m.VoteMock.callArgs = []*PollServiceMockVoteParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPollServiceMockClosePoll struct {
+	optional           bool
+	mock               *PollServiceMock
+	defaultExpectation *PollServiceMockClosePollExpectation
+	expectations       []*PollServiceMockClosePollExpectation
+
+	callArgs []*PollServiceMockClosePollParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PollServiceMockClosePollExpectation specifies expectation struct of the PollService.ClosePoll
+type PollServiceMockClosePollExpectation struct {
+	mock      *PollServiceMock
+	params    *PollServiceMockClosePollParams
+	paramPtrs *PollServiceMockClosePollParamPtrs
+	results   *PollServiceMockClosePollResults
+	Counter   uint64
+}
+
+// PollServiceMockClosePollParams contains parameters of the PollService.ClosePoll
+type PollServiceMockClosePollParams struct {
+	ctx    context.Context
+	params model.ClosePollParams
+}
+
+// PollServiceMockClosePollParamPtrs contains pointers to parameters of the PollService.ClosePoll
+type PollServiceMockClosePollParamPtrs struct {
+	ctx    *context.Context
+	params *model.ClosePollParams
+}
+
+// PollServiceMockClosePollResults contains results of the PollService.ClosePoll
+type PollServiceMockClosePollResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClosePoll *mPollServiceMockClosePoll) Optional() *mPollServiceMockClosePoll {
+	mmClosePoll.optional = true
+	return mmClosePoll
+}
+
+// Expect sets up expected params for PollService.ClosePoll
+func (mmClosePoll *mPollServiceMockClosePoll) Expect(ctx context.Context, params model.ClosePollParams) *mPollServiceMockClosePoll {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("PollServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &PollServiceMockClosePollExpectation{}
+	}
+
+	if mmClosePoll.defaultExpectation.paramPtrs != nil {
+		mmClosePoll.mock.t.Fatalf("PollServiceMock.ClosePoll mock is already set by ExpectParams functions")
+	}
+
+	mmClosePoll.defaultExpectation.params = &PollServiceMockClosePollParams{ctx, params}
+	for _, e := range mmClosePoll.expectations {
+		if minimock.Equal(e.params, mmClosePoll.defaultExpectation.params) {
+			mmClosePoll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClosePoll.defaultExpectation.params)
+		}
+	}
+
+	return mmClosePoll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PollService.ClosePoll
+func (mmClosePoll *mPollServiceMockClosePoll) ExpectCtxParam1(ctx context.Context) *mPollServiceMockClosePoll {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("PollServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &PollServiceMockClosePollExpectation{}
+	}
+
+	if mmClosePoll.defaultExpectation.params != nil {
+		mmClosePoll.mock.t.Fatalf("PollServiceMock.ClosePoll mock is already set by Expect")
+	}
+
+	if mmClosePoll.defaultExpectation.paramPtrs == nil {
+		mmClosePoll.defaultExpectation.paramPtrs = &PollServiceMockClosePollParamPtrs{}
+	}
+	mmClosePoll.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmClosePoll
+}
+
+// ExpectParamsParam2 sets up expected param params for PollService.ClosePoll
+func (mmClosePoll *mPollServiceMockClosePoll) ExpectParamsParam2(params model.ClosePollParams) *mPollServiceMockClosePoll {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("PollServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &PollServiceMockClosePollExpectation{}
+	}
+
+	if mmClosePoll.defaultExpectation.params != nil {
+		mmClosePoll.mock.t.Fatalf("PollServiceMock.ClosePoll mock is already set by Expect")
+	}
+
+	if mmClosePoll.defaultExpectation.paramPtrs == nil {
+		mmClosePoll.defaultExpectation.paramPtrs = &PollServiceMockClosePollParamPtrs{}
+	}
+	mmClosePoll.defaultExpectation.paramPtrs.params = &params
+
+	return mmClosePoll
+}
+
+// Inspect accepts an inspector function that has same arguments as the PollService.ClosePoll
+func (mmClosePoll *mPollServiceMockClosePoll) Inspect(f func(ctx context.Context, params model.ClosePollParams)) *mPollServiceMockClosePoll {
+	if mmClosePoll.mock.inspectFuncClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("Inspect function is already set for PollServiceMock.ClosePoll")
+	}
+
+	mmClosePoll.mock.inspectFuncClosePoll = f
+
+	return mmClosePoll
+}
+
+// Return sets up results that will be returned by PollService.ClosePoll
+func (mmClosePoll *mPollServiceMockClosePoll) Return(err error) *PollServiceMock {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("PollServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	if mmClosePoll.defaultExpectation == nil {
+		mmClosePoll.defaultExpectation = &PollServiceMockClosePollExpectation{mock: mmClosePoll.mock}
+	}
+	mmClosePoll.defaultExpectation.results = &PollServiceMockClosePollResults{err}
+	return mmClosePoll.mock
+}
+
+// Set uses given function f to mock the PollService.ClosePoll method
+func (mmClosePoll *mPollServiceMockClosePoll) Set(f func(ctx context.Context, params model.ClosePollParams) (err error)) *PollServiceMock {
+	if mmClosePoll.defaultExpectation != nil {
+		mmClosePoll.mock.t.Fatalf("Default expectation is already set for the PollService.ClosePoll method")
+	}
+
+	if len(mmClosePoll.expectations) > 0 {
+		mmClosePoll.mock.t.Fatalf("Some expectations are already set for the PollService.ClosePoll method")
+	}
+
+	mmClosePoll.mock.funcClosePoll = f
+	return mmClosePoll.mock
+}
+
+// When sets expectation for the PollService.ClosePoll which will trigger the result defined by the following
+// Then helper
+func (mmClosePoll *mPollServiceMockClosePoll) When(ctx context.Context, params model.ClosePollParams) *PollServiceMockClosePollExpectation {
+	if mmClosePoll.mock.funcClosePoll != nil {
+		mmClosePoll.mock.t.Fatalf("PollServiceMock.ClosePoll mock is already set by Set")
+	}
+
+	expectation := &PollServiceMockClosePollExpectation{
+		mock:   mmClosePoll.mock,
+		params: &PollServiceMockClosePollParams{ctx, params},
+	}
+	mmClosePoll.expectations = append(mmClosePoll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PollService.ClosePoll return parameters for the expectation previously defined by the When method
+func (e *PollServiceMockClosePollExpectation) Then(err error) *PollServiceMock {
+	e.results = &PollServiceMockClosePollResults{err}
+	return e.mock
+}
+
+// Times sets number of times PollService.ClosePoll should be invoked
+func (mmClosePoll *mPollServiceMockClosePoll) Times(n uint64) *mPollServiceMockClosePoll {
+	if n == 0 {
+		mmClosePoll.mock.t.Fatalf("Times of PollServiceMock.ClosePoll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClosePoll.expectedInvocations, n)
+	return mmClosePoll
+}
+
+func (mmClosePoll *mPollServiceMockClosePoll) invocationsDone() bool {
+	if len(mmClosePoll.expectations) == 0 && mmClosePoll.defaultExpectation == nil && mmClosePoll.mock.funcClosePoll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClosePoll.mock.afterClosePollCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClosePoll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClosePoll implements service.PollService
+func (mmClosePoll *PollServiceMock) ClosePoll(ctx context.Context, params model.ClosePollParams) (err error) {
+	mm_atomic.AddUint64(&mmClosePoll.beforeClosePollCounter, 1)
+	defer mm_atomic.AddUint64(&mmClosePoll.afterClosePollCounter, 1)
+
+	if mmClosePoll.inspectFuncClosePoll != nil {
+		mmClosePoll.inspectFuncClosePoll(ctx, params)
+	}
+
+	mm_params := PollServiceMockClosePollParams{ctx, params}
+
+	// Record call args
+	mmClosePoll.ClosePollMock.mutex.Lock()
+	mmClosePoll.ClosePollMock.callArgs = append(mmClosePoll.ClosePollMock.callArgs, &mm_params)
+	mmClosePoll.ClosePollMock.mutex.Unlock()
+
+	for _, e := range mmClosePoll.ClosePollMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmClosePoll.ClosePollMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClosePoll.ClosePollMock.defaultExpectation.Counter, 1)
+		mm_want := mmClosePoll.ClosePollMock.defaultExpectation.params
+		mm_want_ptrs := mmClosePoll.ClosePollMock.defaultExpectation.paramPtrs
+
+		mm_got := PollServiceMockClosePollParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClosePoll.t.Errorf("PollServiceMock.ClosePoll got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmClosePoll.t.Errorf("PollServiceMock.ClosePoll got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClosePoll.t.Errorf("PollServiceMock.ClosePoll got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClosePoll.ClosePollMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClosePoll.t.Fatal("No results are set for the PollServiceMock.ClosePoll")
+		}
+		return (*mm_results).err
+	}
+	if mmClosePoll.funcClosePoll != nil {
+		return mmClosePoll.funcClosePoll(ctx, params)
+	}
+	mmClosePoll.t.Fatalf("Unexpected call to PollServiceMock.ClosePoll. %v %v", ctx, params)
+	return
+}
+
+// ClosePollAfterCounter returns a count of finished PollServiceMock.ClosePoll invocations
+func (mmClosePoll *PollServiceMock) ClosePollAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClosePoll.afterClosePollCounter)
+}
+
+// ClosePollBeforeCounter returns a count of PollServiceMock.ClosePoll invocations
+func (mmClosePoll *PollServiceMock) ClosePollBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClosePoll.beforeClosePollCounter)
+}
+
+// Calls returns a list of arguments used in each call to PollServiceMock.ClosePoll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClosePoll *mPollServiceMockClosePoll) Calls() []*PollServiceMockClosePollParams {
+	mmClosePoll.mutex.RLock()
+
+	argCopy := make([]*PollServiceMockClosePollParams, len(mmClosePoll.callArgs))
+	copy(argCopy, mmClosePoll.callArgs)
+
+	mmClosePoll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClosePollDone returns true if the count of the ClosePoll invocations corresponds
+// the number of defined expectations
+func (m *PollServiceMock) MinimockClosePollDone() bool {
+	if m.ClosePollMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClosePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClosePollMock.invocationsDone()
+}
+
+// MinimockClosePollInspect logs each unmet expectation
+func (m *PollServiceMock) MinimockClosePollInspect() {
+	for _, e := range m.ClosePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PollServiceMock.ClosePoll with params: %#v", *e.params)
+		}
+	}
+
+	afterClosePollCounter := mm_atomic.LoadUint64(&m.afterClosePollCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClosePollMock.defaultExpectation != nil && afterClosePollCounter < 1 {
+		if m.ClosePollMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PollServiceMock.ClosePoll")
+		} else {
+			m.t.Errorf("Expected call to PollServiceMock.ClosePoll with params: %#v", *m.ClosePollMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClosePoll != nil && afterClosePollCounter < 1 {
+		m.t.Error("Expected call to PollServiceMock.ClosePoll")
+	}
+
+	if !m.ClosePollMock.invocationsDone() && afterClosePollCounter > 0 {
+		m.t.Errorf("Expected %d calls to PollServiceMock.ClosePoll but found %d calls",
+			mm_atomic.LoadUint64(&m.ClosePollMock.expectedInvocations), afterClosePollCounter)
+	}
+}
+
+type mPollServiceMockCreatePoll struct {
+	optional           bool
+	mock               *PollServiceMock
+	defaultExpectation *PollServiceMockCreatePollExpectation
+	expectations       []*PollServiceMockCreatePollExpectation
+
+	callArgs []*PollServiceMockCreatePollParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PollServiceMockCreatePollExpectation specifies expectation struct of the PollService.CreatePoll
+type PollServiceMockCreatePollExpectation struct {
+	mock      *PollServiceMock
+	params    *PollServiceMockCreatePollParams
+	paramPtrs *PollServiceMockCreatePollParamPtrs
+	results   *PollServiceMockCreatePollResults
+	Counter   uint64
+}
+
+// PollServiceMockCreatePollParams contains parameters of the PollService.CreatePoll
+type PollServiceMockCreatePollParams struct {
+	ctx    context.Context
+	params model.CreatePollParams
+}
+
+// PollServiceMockCreatePollParamPtrs contains pointers to parameters of the PollService.CreatePoll
+type PollServiceMockCreatePollParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreatePollParams
+}
+
+// PollServiceMockCreatePollResults contains results of the PollService.CreatePoll
+type PollServiceMockCreatePollResults struct {
+	resp model.CreatePollResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePoll *mPollServiceMockCreatePoll) Optional() *mPollServiceMockCreatePoll {
+	mmCreatePoll.optional = true
+	return mmCreatePoll
+}
+
+// Expect sets up expected params for PollService.CreatePoll
+func (mmCreatePoll *mPollServiceMockCreatePoll) Expect(ctx context.Context, params model.CreatePollParams) *mPollServiceMockCreatePoll {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("PollServiceMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &PollServiceMockCreatePollExpectation{}
+	}
+
+	if mmCreatePoll.defaultExpectation.paramPtrs != nil {
+		mmCreatePoll.mock.t.Fatalf("PollServiceMock.CreatePoll mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePoll.defaultExpectation.params = &PollServiceMockCreatePollParams{ctx, params}
+	for _, e := range mmCreatePoll.expectations {
+		if minimock.Equal(e.params, mmCreatePoll.defaultExpectation.params) {
+			mmCreatePoll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePoll.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePoll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PollService.CreatePoll
+func (mmCreatePoll *mPollServiceMockCreatePoll) ExpectCtxParam1(ctx context.Context) *mPollServiceMockCreatePoll {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("PollServiceMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &PollServiceMockCreatePollExpectation{}
+	}
+
+	if mmCreatePoll.defaultExpectation.params != nil {
+		mmCreatePoll.mock.t.Fatalf("PollServiceMock.CreatePoll mock is already set by Expect")
+	}
+
+	if mmCreatePoll.defaultExpectation.paramPtrs == nil {
+		mmCreatePoll.defaultExpectation.paramPtrs = &PollServiceMockCreatePollParamPtrs{}
+	}
+	mmCreatePoll.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreatePoll
+}
+
+// ExpectParamsParam2 sets up expected param params for PollService.CreatePoll
+func (mmCreatePoll *mPollServiceMockCreatePoll) ExpectParamsParam2(params model.CreatePollParams) *mPollServiceMockCreatePoll {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("PollServiceMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &PollServiceMockCreatePollExpectation{}
+	}
+
+	if mmCreatePoll.defaultExpectation.params != nil {
+		mmCreatePoll.mock.t.Fatalf("PollServiceMock.CreatePoll mock is already set by Expect")
+	}
+
+	if mmCreatePoll.defaultExpectation.paramPtrs == nil {
+		mmCreatePoll.defaultExpectation.paramPtrs = &PollServiceMockCreatePollParamPtrs{}
+	}
+	mmCreatePoll.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreatePoll
+}
+
+// Inspect accepts an inspector function that has same arguments as the PollService.CreatePoll
+func (mmCreatePoll *mPollServiceMockCreatePoll) Inspect(f func(ctx context.Context, params model.CreatePollParams)) *mPollServiceMockCreatePoll {
+	if mmCreatePoll.mock.inspectFuncCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("Inspect function is already set for PollServiceMock.CreatePoll")
+	}
+
+	mmCreatePoll.mock.inspectFuncCreatePoll = f
+
+	return mmCreatePoll
+}
+
+// Return sets up results that will be returned by PollService.CreatePoll
+func (mmCreatePoll *mPollServiceMockCreatePoll) Return(resp model.CreatePollResponse, err error) *PollServiceMock {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("PollServiceMock.CreatePoll mock is already set by Set")
+	}
+
+	if mmCreatePoll.defaultExpectation == nil {
+		mmCreatePoll.defaultExpectation = &PollServiceMockCreatePollExpectation{mock: mmCreatePoll.mock}
+	}
+	mmCreatePoll.defaultExpectation.results = &PollServiceMockCreatePollResults{resp, err}
+	return mmCreatePoll.mock
+}
+
+// Set uses given function f to mock the PollService.CreatePoll method
+func (mmCreatePoll *mPollServiceMockCreatePoll) Set(f func(ctx context.Context, params model.CreatePollParams) (resp model.CreatePollResponse, err error)) *PollServiceMock {
+	if mmCreatePoll.defaultExpectation != nil {
+		mmCreatePoll.mock.t.Fatalf("Default expectation is already set for the PollService.CreatePoll method")
+	}
+
+	if len(mmCreatePoll.expectations) > 0 {
+		mmCreatePoll.mock.t.Fatalf("Some expectations are already set for the PollService.CreatePoll method")
+	}
+
+	mmCreatePoll.mock.funcCreatePoll = f
+	return mmCreatePoll.mock
+}
+
+// When sets expectation for the PollService.CreatePoll which will trigger the result defined by the following
+// Then helper
+func (mmCreatePoll *mPollServiceMockCreatePoll) When(ctx context.Context, params model.CreatePollParams) *PollServiceMockCreatePollExpectation {
+	if mmCreatePoll.mock.funcCreatePoll != nil {
+		mmCreatePoll.mock.t.Fatalf("PollServiceMock.CreatePoll mock is already set by Set")
+	}
+
+	expectation := &PollServiceMockCreatePollExpectation{
+		mock:   mmCreatePoll.mock,
+		params: &PollServiceMockCreatePollParams{ctx, params},
+	}
+	mmCreatePoll.expectations = append(mmCreatePoll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PollService.CreatePoll return parameters for the expectation previously defined by the When method
+func (e *PollServiceMockCreatePollExpectation) Then(resp model.CreatePollResponse, err error) *PollServiceMock {
+	e.results = &PollServiceMockCreatePollResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times PollService.CreatePoll should be invoked
+func (mmCreatePoll *mPollServiceMockCreatePoll) Times(n uint64) *mPollServiceMockCreatePoll {
+	if n == 0 {
+		mmCreatePoll.mock.t.Fatalf("Times of PollServiceMock.CreatePoll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePoll.expectedInvocations, n)
+	return mmCreatePoll
+}
+
+func (mmCreatePoll *mPollServiceMockCreatePoll) invocationsDone() bool {
+	if len(mmCreatePoll.expectations) == 0 && mmCreatePoll.defaultExpectation == nil && mmCreatePoll.mock.funcCreatePoll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePoll.mock.afterCreatePollCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePoll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePoll implements service.PollService
+func (mmCreatePoll *PollServiceMock) CreatePoll(ctx context.Context, params model.CreatePollParams) (resp model.CreatePollResponse, err error) {
+	mm_atomic.AddUint64(&mmCreatePoll.beforeCreatePollCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePoll.afterCreatePollCounter, 1)
+
+	if mmCreatePoll.inspectFuncCreatePoll != nil {
+		mmCreatePoll.inspectFuncCreatePoll(ctx, params)
+	}
+
+	mm_params := PollServiceMockCreatePollParams{ctx, params}
+
+	// Record call args
+	mmCreatePoll.CreatePollMock.mutex.Lock()
+	mmCreatePoll.CreatePollMock.callArgs = append(mmCreatePoll.CreatePollMock.callArgs, &mm_params)
+	mmCreatePoll.CreatePollMock.mutex.Unlock()
+
+	for _, e := range mmCreatePoll.CreatePollMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmCreatePoll.CreatePollMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePoll.CreatePollMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePoll.CreatePollMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePoll.CreatePollMock.defaultExpectation.paramPtrs
+
+		mm_got := PollServiceMockCreatePollParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePoll.t.Errorf("PollServiceMock.CreatePoll got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreatePoll.t.Errorf("PollServiceMock.CreatePoll got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePoll.t.Errorf("PollServiceMock.CreatePoll got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePoll.CreatePollMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePoll.t.Fatal("No results are set for the PollServiceMock.CreatePoll")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmCreatePoll.funcCreatePoll != nil {
+		return mmCreatePoll.funcCreatePoll(ctx, params)
+	}
+	mmCreatePoll.t.Fatalf("Unexpected call to PollServiceMock.CreatePoll. %v %v", ctx, params)
+	return
+}
+
+// CreatePollAfterCounter returns a count of finished PollServiceMock.CreatePoll invocations
+func (mmCreatePoll *PollServiceMock) CreatePollAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePoll.afterCreatePollCounter)
+}
+
+// CreatePollBeforeCounter returns a count of PollServiceMock.CreatePoll invocations
+func (mmCreatePoll *PollServiceMock) CreatePollBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePoll.beforeCreatePollCounter)
+}
+
+// Calls returns a list of arguments used in each call to PollServiceMock.CreatePoll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePoll *mPollServiceMockCreatePoll) Calls() []*PollServiceMockCreatePollParams {
+	mmCreatePoll.mutex.RLock()
+
+	argCopy := make([]*PollServiceMockCreatePollParams, len(mmCreatePoll.callArgs))
+	copy(argCopy, mmCreatePoll.callArgs)
+
+	mmCreatePoll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePollDone returns true if the count of the CreatePoll invocations corresponds
+// the number of defined expectations
+func (m *PollServiceMock) MinimockCreatePollDone() bool {
+	if m.CreatePollMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePollMock.invocationsDone()
+}
+
+// MinimockCreatePollInspect logs each unmet expectation
+func (m *PollServiceMock) MinimockCreatePollInspect() {
+	for _, e := range m.CreatePollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PollServiceMock.CreatePoll with params: %#v", *e.params)
+		}
+	}
+
+	afterCreatePollCounter := mm_atomic.LoadUint64(&m.afterCreatePollCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePollMock.defaultExpectation != nil && afterCreatePollCounter < 1 {
+		if m.CreatePollMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PollServiceMock.CreatePoll")
+		} else {
+			m.t.Errorf("Expected call to PollServiceMock.CreatePoll with params: %#v", *m.CreatePollMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePoll != nil && afterCreatePollCounter < 1 {
+		m.t.Error("Expected call to PollServiceMock.CreatePoll")
+	}
+
+	if !m.CreatePollMock.invocationsDone() && afterCreatePollCounter > 0 {
+		m.t.Errorf("Expected %d calls to PollServiceMock.CreatePoll but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePollMock.expectedInvocations), afterCreatePollCounter)
+	}
+}
+
+type mPollServiceMockGetPoll struct {
+	optional           bool
+	mock               *PollServiceMock
+	defaultExpectation *PollServiceMockGetPollExpectation
+	expectations       []*PollServiceMockGetPollExpectation
+
+	callArgs []*PollServiceMockGetPollParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PollServiceMockGetPollExpectation specifies expectation struct of the PollService.GetPoll
+type PollServiceMockGetPollExpectation struct {
+	mock      *PollServiceMock
+	params    *PollServiceMockGetPollParams
+	paramPtrs *PollServiceMockGetPollParamPtrs
+	results   *PollServiceMockGetPollResults
+	Counter   uint64
+}
+
+// PollServiceMockGetPollParams contains parameters of the PollService.GetPoll
+type PollServiceMockGetPollParams struct {
+	ctx    context.Context
+	params model.GetPollParams
+}
+
+// PollServiceMockGetPollParamPtrs contains pointers to parameters of the PollService.GetPoll
+type PollServiceMockGetPollParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetPollParams
+}
+
+// PollServiceMockGetPollResults contains results of the PollService.GetPoll
+type PollServiceMockGetPollResults struct {
+	poll model.Poll
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPoll *mPollServiceMockGetPoll) Optional() *mPollServiceMockGetPoll {
+	mmGetPoll.optional = true
+	return mmGetPoll
+}
+
+// Expect sets up expected params for PollService.GetPoll
+func (mmGetPoll *mPollServiceMockGetPoll) Expect(ctx context.Context, params model.GetPollParams) *mPollServiceMockGetPoll {
+	if mmGetPoll.mock.funcGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("PollServiceMock.GetPoll mock is already set by Set")
+	}
+
+	if mmGetPoll.defaultExpectation == nil {
+		mmGetPoll.defaultExpectation = &PollServiceMockGetPollExpectation{}
+	}
+
+	if mmGetPoll.defaultExpectation.paramPtrs != nil {
+		mmGetPoll.mock.t.Fatalf("PollServiceMock.GetPoll mock is already set by ExpectParams functions")
+	}
+
+	mmGetPoll.defaultExpectation.params = &PollServiceMockGetPollParams{ctx, params}
+	for _, e := range mmGetPoll.expectations {
+		if minimock.Equal(e.params, mmGetPoll.defaultExpectation.params) {
+			mmGetPoll.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPoll.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPoll
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PollService.GetPoll
+func (mmGetPoll *mPollServiceMockGetPoll) ExpectCtxParam1(ctx context.Context) *mPollServiceMockGetPoll {
+	if mmGetPoll.mock.funcGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("PollServiceMock.GetPoll mock is already set by Set")
+	}
+
+	if mmGetPoll.defaultExpectation == nil {
+		mmGetPoll.defaultExpectation = &PollServiceMockGetPollExpectation{}
+	}
+
+	if mmGetPoll.defaultExpectation.params != nil {
+		mmGetPoll.mock.t.Fatalf("PollServiceMock.GetPoll mock is already set by Expect")
+	}
+
+	if mmGetPoll.defaultExpectation.paramPtrs == nil {
+		mmGetPoll.defaultExpectation.paramPtrs = &PollServiceMockGetPollParamPtrs{}
+	}
+	mmGetPoll.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetPoll
+}
+
+// ExpectParamsParam2 sets up expected param params for PollService.GetPoll
+func (mmGetPoll *mPollServiceMockGetPoll) ExpectParamsParam2(params model.GetPollParams) *mPollServiceMockGetPoll {
+	if mmGetPoll.mock.funcGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("PollServiceMock.GetPoll mock is already set by Set")
+	}
+
+	if mmGetPoll.defaultExpectation == nil {
+		mmGetPoll.defaultExpectation = &PollServiceMockGetPollExpectation{}
+	}
+
+	if mmGetPoll.defaultExpectation.params != nil {
+		mmGetPoll.mock.t.Fatalf("PollServiceMock.GetPoll mock is already set by Expect")
+	}
+
+	if mmGetPoll.defaultExpectation.paramPtrs == nil {
+		mmGetPoll.defaultExpectation.paramPtrs = &PollServiceMockGetPollParamPtrs{}
+	}
+	mmGetPoll.defaultExpectation.paramPtrs.params = &params
+
+	return mmGetPoll
+}
+
+// Inspect accepts an inspector function that has same arguments as the PollService.GetPoll
+func (mmGetPoll *mPollServiceMockGetPoll) Inspect(f func(ctx context.Context, params model.GetPollParams)) *mPollServiceMockGetPoll {
+	if mmGetPoll.mock.inspectFuncGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("Inspect function is already set for PollServiceMock.GetPoll")
+	}
+
+	mmGetPoll.mock.inspectFuncGetPoll = f
+
+	return mmGetPoll
+}
+
+// Return sets up results that will be returned by PollService.GetPoll
+func (mmGetPoll *mPollServiceMockGetPoll) Return(poll model.Poll, err error) *PollServiceMock {
+	if mmGetPoll.mock.funcGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("PollServiceMock.GetPoll mock is already set by Set")
+	}
+
+	if mmGetPoll.defaultExpectation == nil {
+		mmGetPoll.defaultExpectation = &PollServiceMockGetPollExpectation{mock: mmGetPoll.mock}
+	}
+	mmGetPoll.defaultExpectation.results = &PollServiceMockGetPollResults{poll, err}
+	return mmGetPoll.mock
+}
+
+// Set uses given function f to mock the PollService.GetPoll method
+func (mmGetPoll *mPollServiceMockGetPoll) Set(f func(ctx context.Context, params model.GetPollParams) (poll model.Poll, err error)) *PollServiceMock {
+	if mmGetPoll.defaultExpectation != nil {
+		mmGetPoll.mock.t.Fatalf("Default expectation is already set for the PollService.GetPoll method")
+	}
+
+	if len(mmGetPoll.expectations) > 0 {
+		mmGetPoll.mock.t.Fatalf("Some expectations are already set for the PollService.GetPoll method")
+	}
+
+	mmGetPoll.mock.funcGetPoll = f
+	return mmGetPoll.mock
+}
+
+// When sets expectation for the PollService.GetPoll which will trigger the result defined by the following
+// Then helper
+func (mmGetPoll *mPollServiceMockGetPoll) When(ctx context.Context, params model.GetPollParams) *PollServiceMockGetPollExpectation {
+	if mmGetPoll.mock.funcGetPoll != nil {
+		mmGetPoll.mock.t.Fatalf("PollServiceMock.GetPoll mock is already set by Set")
+	}
+
+	expectation := &PollServiceMockGetPollExpectation{
+		mock:   mmGetPoll.mock,
+		params: &PollServiceMockGetPollParams{ctx, params},
+	}
+	mmGetPoll.expectations = append(mmGetPoll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PollService.GetPoll return parameters for the expectation previously defined by the When method
+func (e *PollServiceMockGetPollExpectation) Then(poll model.Poll, err error) *PollServiceMock {
+	e.results = &PollServiceMockGetPollResults{poll, err}
+	return e.mock
+}
+
+// Times sets number of times PollService.GetPoll should be invoked
+func (mmGetPoll *mPollServiceMockGetPoll) Times(n uint64) *mPollServiceMockGetPoll {
+	if n == 0 {
+		mmGetPoll.mock.t.Fatalf("Times of PollServiceMock.GetPoll mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPoll.expectedInvocations, n)
+	return mmGetPoll
+}
+
+func (mmGetPoll *mPollServiceMockGetPoll) invocationsDone() bool {
+	if len(mmGetPoll.expectations) == 0 && mmGetPoll.defaultExpectation == nil && mmGetPoll.mock.funcGetPoll == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPoll.mock.afterGetPollCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPoll.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPoll implements service.PollService
+func (mmGetPoll *PollServiceMock) GetPoll(ctx context.Context, params model.GetPollParams) (poll model.Poll, err error) {
+	mm_atomic.AddUint64(&mmGetPoll.beforeGetPollCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPoll.afterGetPollCounter, 1)
+
+	if mmGetPoll.inspectFuncGetPoll != nil {
+		mmGetPoll.inspectFuncGetPoll(ctx, params)
+	}
+
+	mm_params := PollServiceMockGetPollParams{ctx, params}
+
+	// Record call args
+	mmGetPoll.GetPollMock.mutex.Lock()
+	mmGetPoll.GetPollMock.callArgs = append(mmGetPoll.GetPollMock.callArgs, &mm_params)
+	mmGetPoll.GetPollMock.mutex.Unlock()
+
+	for _, e := range mmGetPoll.GetPollMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.poll, e.results.err
+		}
+	}
+
+	if mmGetPoll.GetPollMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPoll.GetPollMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPoll.GetPollMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPoll.GetPollMock.defaultExpectation.paramPtrs
+
+		mm_got := PollServiceMockGetPollParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPoll.t.Errorf("PollServiceMock.GetPoll got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmGetPoll.t.Errorf("PollServiceMock.GetPoll got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPoll.t.Errorf("PollServiceMock.GetPoll got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPoll.GetPollMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPoll.t.Fatal("No results are set for the PollServiceMock.GetPoll")
+		}
+		return (*mm_results).poll, (*mm_results).err
+	}
+	if mmGetPoll.funcGetPoll != nil {
+		return mmGetPoll.funcGetPoll(ctx, params)
+	}
+	mmGetPoll.t.Fatalf("Unexpected call to PollServiceMock.GetPoll. %v %v", ctx, params)
+	return
+}
+
+// GetPollAfterCounter returns a count of finished PollServiceMock.GetPoll invocations
+func (mmGetPoll *PollServiceMock) GetPollAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPoll.afterGetPollCounter)
+}
+
+// GetPollBeforeCounter returns a count of PollServiceMock.GetPoll invocations
+func (mmGetPoll *PollServiceMock) GetPollBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPoll.beforeGetPollCounter)
+}
+
+// Calls returns a list of arguments used in each call to PollServiceMock.GetPoll.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPoll *mPollServiceMockGetPoll) Calls() []*PollServiceMockGetPollParams {
+	mmGetPoll.mutex.RLock()
+
+	argCopy := make([]*PollServiceMockGetPollParams, len(mmGetPoll.callArgs))
+	copy(argCopy, mmGetPoll.callArgs)
+
+	mmGetPoll.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPollDone returns true if the count of the GetPoll invocations corresponds
+// the number of defined expectations
+func (m *PollServiceMock) MinimockGetPollDone() bool {
+	if m.GetPollMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPollMock.invocationsDone()
+}
+
+// MinimockGetPollInspect logs each unmet expectation
+func (m *PollServiceMock) MinimockGetPollInspect() {
+	for _, e := range m.GetPollMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PollServiceMock.GetPoll with params: %#v", *e.params)
+		}
+	}
+
+	afterGetPollCounter := mm_atomic.LoadUint64(&m.afterGetPollCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPollMock.defaultExpectation != nil && afterGetPollCounter < 1 {
+		if m.GetPollMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PollServiceMock.GetPoll")
+		} else {
+			m.t.Errorf("Expected call to PollServiceMock.GetPoll with params: %#v", *m.GetPollMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPoll != nil && afterGetPollCounter < 1 {
+		m.t.Error("Expected call to PollServiceMock.GetPoll")
+	}
+
+	if !m.GetPollMock.invocationsDone() && afterGetPollCounter > 0 {
+		m.t.Errorf("Expected %d calls to PollServiceMock.GetPoll but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPollMock.expectedInvocations), afterGetPollCounter)
+	}
+}
+
+type mPollServiceMockVote struct {
+	optional           bool
+	mock               *PollServiceMock
+	defaultExpectation *PollServiceMockVoteExpectation
+	expectations       []*PollServiceMockVoteExpectation
+
+	callArgs []*PollServiceMockVoteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PollServiceMockVoteExpectation specifies expectation struct of the PollService.Vote
+type PollServiceMockVoteExpectation struct {
+	mock      *PollServiceMock
+	params    *PollServiceMockVoteParams
+	paramPtrs *PollServiceMockVoteParamPtrs
+	results   *PollServiceMockVoteResults
+	Counter   uint64
+}
+
+// PollServiceMockVoteParams contains parameters of the PollService.Vote
+type PollServiceMockVoteParams struct {
+	ctx    context.Context
+	params model.VoteParams
+}
+
+// PollServiceMockVoteParamPtrs contains pointers to parameters of the PollService.Vote
+type PollServiceMockVoteParamPtrs struct {
+	ctx    *context.Context
+	params *model.VoteParams
+}
+
+// PollServiceMockVoteResults contains results of the PollService.Vote
+type PollServiceMockVoteResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVote *mPollServiceMockVote) Optional() *mPollServiceMockVote {
+	mmVote.optional = true
+	return mmVote
+}
+
+// Expect sets up expected params for PollService.Vote
+func (mmVote *mPollServiceMockVote) Expect(ctx context.Context, params model.VoteParams) *mPollServiceMockVote {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("PollServiceMock.Vote mock is already set by Set")
+	}
+
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &PollServiceMockVoteExpectation{}
+	}
+
+	if mmVote.defaultExpectation.paramPtrs != nil {
+		mmVote.mock.t.Fatalf("PollServiceMock.Vote mock is already set by ExpectParams functions")
+	}
+
+	mmVote.defaultExpectation.params = &PollServiceMockVoteParams{ctx, params}
+	for _, e := range mmVote.expectations {
+		if minimock.Equal(e.params, mmVote.defaultExpectation.params) {
+			mmVote.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVote.defaultExpectation.params)
+		}
+	}
+
+	return mmVote
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PollService.Vote
+func (mmVote *mPollServiceMockVote) ExpectCtxParam1(ctx context.Context) *mPollServiceMockVote {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("PollServiceMock.Vote mock is already set by Set")
+	}
+
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &PollServiceMockVoteExpectation{}
+	}
+
+	if mmVote.defaultExpectation.params != nil {
+		mmVote.mock.t.Fatalf("PollServiceMock.Vote mock is already set by Expect")
+	}
+
+	if mmVote.defaultExpectation.paramPtrs == nil {
+		mmVote.defaultExpectation.paramPtrs = &PollServiceMockVoteParamPtrs{}
+	}
+	mmVote.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmVote
+}
+
+// ExpectParamsParam2 sets up expected param params for PollService.Vote
+func (mmVote *mPollServiceMockVote) ExpectParamsParam2(params model.VoteParams) *mPollServiceMockVote {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("PollServiceMock.Vote mock is already set by Set")
+	}
+
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &PollServiceMockVoteExpectation{}
+	}
+
+	if mmVote.defaultExpectation.params != nil {
+		mmVote.mock.t.Fatalf("PollServiceMock.Vote mock is already set by Expect")
+	}
+
+	if mmVote.defaultExpectation.paramPtrs == nil {
+		mmVote.defaultExpectation.paramPtrs = &PollServiceMockVoteParamPtrs{}
+	}
+	mmVote.defaultExpectation.paramPtrs.params = &params
+
+	return mmVote
+}
+
+// Inspect accepts an inspector function that has same arguments as the PollService.Vote
+func (mmVote *mPollServiceMockVote) Inspect(f func(ctx context.Context, params model.VoteParams)) *mPollServiceMockVote {
+	if mmVote.mock.inspectFuncVote != nil {
+		mmVote.mock.t.Fatalf("Inspect function is already set for PollServiceMock.Vote")
+	}
+
+	mmVote.mock.inspectFuncVote = f
+
+	return mmVote
+}
+
+// Return sets up results that will be returned by PollService.Vote
+func (mmVote *mPollServiceMockVote) Return(err error) *PollServiceMock {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("PollServiceMock.Vote mock is already set by Set")
+	}
+
+	if mmVote.defaultExpectation == nil {
+		mmVote.defaultExpectation = &PollServiceMockVoteExpectation{mock: mmVote.mock}
+	}
+	mmVote.defaultExpectation.results = &PollServiceMockVoteResults{err}
+	return mmVote.mock
+}
+
+// Set uses given function f to mock the PollService.Vote method
+func (mmVote *mPollServiceMockVote) Set(f func(ctx context.Context, params model.VoteParams) (err error)) *PollServiceMock {
+	if mmVote.defaultExpectation != nil {
+		mmVote.mock.t.Fatalf("Default expectation is already set for the PollService.Vote method")
+	}
+
+	if len(mmVote.expectations) > 0 {
+		mmVote.mock.t.Fatalf("Some expectations are already set for the PollService.Vote method")
+	}
+
+	mmVote.mock.funcVote = f
+	return mmVote.mock
+}
+
+// When sets expectation for the PollService.Vote which will trigger the result defined by the following
+// Then helper
+func (mmVote *mPollServiceMockVote) When(ctx context.Context, params model.VoteParams) *PollServiceMockVoteExpectation {
+	if mmVote.mock.funcVote != nil {
+		mmVote.mock.t.Fatalf("PollServiceMock.Vote mock is already set by Set")
+	}
+
+	expectation := &PollServiceMockVoteExpectation{
+		mock:   mmVote.mock,
+		params: &PollServiceMockVoteParams{ctx, params},
+	}
+	mmVote.expectations = append(mmVote.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PollService.Vote return parameters for the expectation previously defined by the When method
+func (e *PollServiceMockVoteExpectation) Then(err error) *PollServiceMock {
+	e.results = &PollServiceMockVoteResults{err}
+	return e.mock
+}
+
+// Times sets number of times PollService.Vote should be invoked
+func (mmVote *mPollServiceMockVote) Times(n uint64) *mPollServiceMockVote {
+	if n == 0 {
+		mmVote.mock.t.Fatalf("Times of PollServiceMock.Vote mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVote.expectedInvocations, n)
+	return mmVote
+}
+
+func (mmVote *mPollServiceMockVote) invocationsDone() bool {
+	if len(mmVote.expectations) == 0 && mmVote.defaultExpectation == nil && mmVote.mock.funcVote == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVote.mock.afterVoteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVote.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Vote implements service.PollService
+func (mmVote *PollServiceMock) Vote(ctx context.Context, params model.VoteParams) (err error) {
+	mm_atomic.AddUint64(&mmVote.beforeVoteCounter, 1)
+	defer mm_atomic.AddUint64(&mmVote.afterVoteCounter, 1)
+
+	if mmVote.inspectFuncVote != nil {
+		mmVote.inspectFuncVote(ctx, params)
+	}
+
+	mm_params := PollServiceMockVoteParams{ctx, params}
+
+	// Record call args
+	mmVote.VoteMock.mutex.Lock()
+	mmVote.VoteMock.callArgs = append(mmVote.VoteMock.callArgs, &mm_params)
+	mmVote.VoteMock.mutex.Unlock()
+
+	for _, e := range mmVote.VoteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmVote.VoteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVote.VoteMock.defaultExpectation.Counter, 1)
+		mm_want := mmVote.VoteMock.defaultExpectation.params
+		mm_want_ptrs := mmVote.VoteMock.defaultExpectation.paramPtrs
+
+		mm_got := PollServiceMockVoteParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmVote.t.Errorf("PollServiceMock.Vote got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmVote.t.Errorf("PollServiceMock.Vote got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVote.t.Errorf("PollServiceMock.Vote got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVote.VoteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVote.t.Fatal("No results are set for the PollServiceMock.Vote")
+		}
+		return (*mm_results).err
+	}
+	if mmVote.funcVote != nil {
+		return mmVote.funcVote(ctx, params)
+	}
+	mmVote.t.Fatalf("Unexpected call to PollServiceMock.Vote. %v %v", ctx, params)
+	return
+}
+
+// VoteAfterCounter returns a count of finished PollServiceMock.Vote invocations
+func (mmVote *PollServiceMock) VoteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVote.afterVoteCounter)
+}
+
+// VoteBeforeCounter returns a count of PollServiceMock.Vote invocations
+func (mmVote *PollServiceMock) VoteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVote.beforeVoteCounter)
+}
+
+// Calls returns a list of arguments used in each call to PollServiceMock.Vote.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVote *mPollServiceMockVote) Calls() []*PollServiceMockVoteParams {
+	mmVote.mutex.RLock()
+
+	argCopy := make([]*PollServiceMockVoteParams, len(mmVote.callArgs))
+	copy(argCopy, mmVote.callArgs)
+
+	mmVote.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVoteDone returns true if the count of the Vote invocations corresponds
+// the number of defined expectations
+func (m *PollServiceMock) MinimockVoteDone() bool {
+	if m.VoteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VoteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VoteMock.invocationsDone()
+}
+
+// MinimockVoteInspect logs each unmet expectation
+func (m *PollServiceMock) MinimockVoteInspect() {
+	for _, e := range m.VoteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PollServiceMock.Vote with params: %#v", *e.params)
+		}
+	}
+
+	afterVoteCounter := mm_atomic.LoadUint64(&m.afterVoteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VoteMock.defaultExpectation != nil && afterVoteCounter < 1 {
+		if m.VoteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PollServiceMock.Vote")
+		} else {
+			m.t.Errorf("Expected call to PollServiceMock.Vote with params: %#v", *m.VoteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVote != nil && afterVoteCounter < 1 {
+		m.t.Error("Expected call to PollServiceMock.Vote")
+	}
+
+	if !m.VoteMock.invocationsDone() && afterVoteCounter > 0 {
+		m.t.Errorf("Expected %d calls to PollServiceMock.Vote but found %d calls",
+			mm_atomic.LoadUint64(&m.VoteMock.expectedInvocations), afterVoteCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PollServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClosePollInspect()
+
+			m.MinimockCreatePollInspect()
+
+			m.MinimockGetPollInspect()
+
+			m.MinimockVoteInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PollServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PollServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClosePollDone() &&
+		m.MinimockCreatePollDone() &&
+		m.MinimockGetPollDone() &&
+		m.MinimockVoteDone()
+}
@@ -0,0 +1,1059 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.PresenceService -o presence_service_minimock.go -n PresenceServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PresenceServiceMock implements service.PresenceService
+type PresenceServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcConnect          func(ctx context.Context, params model.ConnectParams) (err error)
+	inspectFuncConnect   func(ctx context.Context, params model.ConnectParams)
+	afterConnectCounter  uint64
+	beforeConnectCounter uint64
+	ConnectMock          mPresenceServiceMockConnect
+
+	funcGetPresence          func(ctx context.Context, params model.GetPresenceParams) (presences []model.Presence, err error)
+	inspectFuncGetPresence   func(ctx context.Context, params model.GetPresenceParams)
+	afterGetPresenceCounter  uint64
+	beforeGetPresenceCounter uint64
+	GetPresenceMock          mPresenceServiceMockGetPresence
+
+	funcHeartbeat          func(ctx context.Context, params model.HeartbeatParams) (err error)
+	inspectFuncHeartbeat   func(ctx context.Context, params model.HeartbeatParams)
+	afterHeartbeatCounter  uint64
+	beforeHeartbeatCounter uint64
+	HeartbeatMock          mPresenceServiceMockHeartbeat
+}
+
+// NewPresenceServiceMock returns a mock for service.PresenceService
+func NewPresenceServiceMock(t minimock.Tester) *PresenceServiceMock {
+	m := &PresenceServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ConnectMock = mPresenceServiceMockConnect{mock: m}
+	m.ConnectMock.callArgs = []*PresenceServiceMockConnectParams{}
+
+	m.GetPresenceMock = mPresenceServiceMockGetPresence{mock: m}
+	m.GetPresenceMock.callArgs = []*PresenceServiceMockGetPresenceParams{}
+
+	m.HeartbeatMock = mPresenceServiceMockHeartbeat{mock: m}
+	m.HeartbeatMock.callArgs = []*PresenceServiceMockHeartbeatParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPresenceServiceMockConnect struct {
+	optional           bool
+	mock               *PresenceServiceMock
+	defaultExpectation *PresenceServiceMockConnectExpectation
+	expectations       []*PresenceServiceMockConnectExpectation
+
+	callArgs []*PresenceServiceMockConnectParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PresenceServiceMockConnectExpectation specifies expectation struct of the PresenceService.Connect
+type PresenceServiceMockConnectExpectation struct {
+	mock      *PresenceServiceMock
+	params    *PresenceServiceMockConnectParams
+	paramPtrs *PresenceServiceMockConnectParamPtrs
+	results   *PresenceServiceMockConnectResults
+	Counter   uint64
+}
+
+// PresenceServiceMockConnectParams contains parameters of the PresenceService.Connect
+type PresenceServiceMockConnectParams struct {
+	ctx    context.Context
+	params model.ConnectParams
+}
+
+// PresenceServiceMockConnectParamPtrs contains pointers to parameters of the PresenceService.Connect
+type PresenceServiceMockConnectParamPtrs struct {
+	ctx    *context.Context
+	params *model.ConnectParams
+}
+
+// PresenceServiceMockConnectResults contains results of the PresenceService.Connect
+type PresenceServiceMockConnectResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConnect *mPresenceServiceMockConnect) Optional() *mPresenceServiceMockConnect {
+	mmConnect.optional = true
+	return mmConnect
+}
+
+// Expect sets up expected params for PresenceService.Connect
+func (mmConnect *mPresenceServiceMockConnect) Expect(ctx context.Context, params model.ConnectParams) *mPresenceServiceMockConnect {
+	if mmConnect.mock.funcConnect != nil {
+		mmConnect.mock.t.Fatalf("PresenceServiceMock.Connect mock is already set by Set")
+	}
+
+	if mmConnect.defaultExpectation == nil {
+		mmConnect.defaultExpectation = &PresenceServiceMockConnectExpectation{}
+	}
+
+	if mmConnect.defaultExpectation.paramPtrs != nil {
+		mmConnect.mock.t.Fatalf("PresenceServiceMock.Connect mock is already set by ExpectParams functions")
+	}
+
+	mmConnect.defaultExpectation.params = &PresenceServiceMockConnectParams{ctx, params}
+	for _, e := range mmConnect.expectations {
+		if minimock.Equal(e.params, mmConnect.defaultExpectation.params) {
+			mmConnect.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConnect.defaultExpectation.params)
+		}
+	}
+
+	return mmConnect
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PresenceService.Connect
+func (mmConnect *mPresenceServiceMockConnect) ExpectCtxParam1(ctx context.Context) *mPresenceServiceMockConnect {
+	if mmConnect.mock.funcConnect != nil {
+		mmConnect.mock.t.Fatalf("PresenceServiceMock.Connect mock is already set by Set")
+	}
+
+	if mmConnect.defaultExpectation == nil {
+		mmConnect.defaultExpectation = &PresenceServiceMockConnectExpectation{}
+	}
+
+	if mmConnect.defaultExpectation.params != nil {
+		mmConnect.mock.t.Fatalf("PresenceServiceMock.Connect mock is already set by Expect")
+	}
+
+	if mmConnect.defaultExpectation.paramPtrs == nil {
+		mmConnect.defaultExpectation.paramPtrs = &PresenceServiceMockConnectParamPtrs{}
+	}
+	mmConnect.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmConnect
+}
+
+// ExpectParamsParam2 sets up expected param params for PresenceService.Connect
+func (mmConnect *mPresenceServiceMockConnect) ExpectParamsParam2(params model.ConnectParams) *mPresenceServiceMockConnect {
+	if mmConnect.mock.funcConnect != nil {
+		mmConnect.mock.t.Fatalf("PresenceServiceMock.Connect mock is already set by Set")
+	}
+
+	if mmConnect.defaultExpectation == nil {
+		mmConnect.defaultExpectation = &PresenceServiceMockConnectExpectation{}
+	}
+
+	if mmConnect.defaultExpectation.params != nil {
+		mmConnect.mock.t.Fatalf("PresenceServiceMock.Connect mock is already set by Expect")
+	}
+
+	if mmConnect.defaultExpectation.paramPtrs == nil {
+		mmConnect.defaultExpectation.paramPtrs = &PresenceServiceMockConnectParamPtrs{}
+	}
+	mmConnect.defaultExpectation.paramPtrs.params = &params
+
+	return mmConnect
+}
+
+// Inspect accepts an inspector function that has same arguments as the PresenceService.Connect
+func (mmConnect *mPresenceServiceMockConnect) Inspect(f func(ctx context.Context, params model.ConnectParams)) *mPresenceServiceMockConnect {
+	if mmConnect.mock.inspectFuncConnect != nil {
+		mmConnect.mock.t.Fatalf("Inspect function is already set for PresenceServiceMock.Connect")
+	}
+
+	mmConnect.mock.inspectFuncConnect = f
+
+	return mmConnect
+}
+
+// Return sets up results that will be returned by PresenceService.Connect
+func (mmConnect *mPresenceServiceMockConnect) Return(err error) *PresenceServiceMock {
+	if mmConnect.mock.funcConnect != nil {
+		mmConnect.mock.t.Fatalf("PresenceServiceMock.Connect mock is already set by Set")
+	}
+
+	if mmConnect.defaultExpectation == nil {
+		mmConnect.defaultExpectation = &PresenceServiceMockConnectExpectation{mock: mmConnect.mock}
+	}
+	mmConnect.defaultExpectation.results = &PresenceServiceMockConnectResults{err}
+	return mmConnect.mock
+}
+
+// Set uses given function f to mock the PresenceService.Connect method
+func (mmConnect *mPresenceServiceMockConnect) Set(f func(ctx context.Context, params model.ConnectParams) (err error)) *PresenceServiceMock {
+	if mmConnect.defaultExpectation != nil {
+		mmConnect.mock.t.Fatalf("Default expectation is already set for the PresenceService.Connect method")
+	}
+
+	if len(mmConnect.expectations) > 0 {
+		mmConnect.mock.t.Fatalf("Some expectations are already set for the PresenceService.Connect method")
+	}
+
+	mmConnect.mock.funcConnect = f
+	return mmConnect.mock
+}
+
+// When sets expectation for the PresenceService.Connect which will trigger the result defined by the following
+// Then helper
+func (mmConnect *mPresenceServiceMockConnect) When(ctx context.Context, params model.ConnectParams) *PresenceServiceMockConnectExpectation {
+	if mmConnect.mock.funcConnect != nil {
+		mmConnect.mock.t.Fatalf("PresenceServiceMock.Connect mock is already set by Set")
+	}
+
+	expectation := &PresenceServiceMockConnectExpectation{
+		mock:   mmConnect.mock,
+		params: &PresenceServiceMockConnectParams{ctx, params},
+	}
+	mmConnect.expectations = append(mmConnect.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PresenceService.Connect return parameters for the expectation previously defined by the When method
+func (e *PresenceServiceMockConnectExpectation) Then(err error) *PresenceServiceMock {
+	e.results = &PresenceServiceMockConnectResults{err}
+	return e.mock
+}
+
+// Times sets number of times PresenceService.Connect should be invoked
+func (mmConnect *mPresenceServiceMockConnect) Times(n uint64) *mPresenceServiceMockConnect {
+	if n == 0 {
+		mmConnect.mock.t.Fatalf("Times of PresenceServiceMock.Connect mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConnect.expectedInvocations, n)
+	return mmConnect
+}
+
+func (mmConnect *mPresenceServiceMockConnect) invocationsDone() bool {
+	if len(mmConnect.expectations) == 0 && mmConnect.defaultExpectation == nil && mmConnect.mock.funcConnect == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConnect.mock.afterConnectCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConnect.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Connect implements service.PresenceService
+func (mmConnect *PresenceServiceMock) Connect(ctx context.Context, params model.ConnectParams) (err error) {
+	mm_atomic.AddUint64(&mmConnect.beforeConnectCounter, 1)
+	defer mm_atomic.AddUint64(&mmConnect.afterConnectCounter, 1)
+
+	if mmConnect.inspectFuncConnect != nil {
+		mmConnect.inspectFuncConnect(ctx, params)
+	}
+
+	mm_params := PresenceServiceMockConnectParams{ctx, params}
+
+	// Record call args
+	mmConnect.ConnectMock.mutex.Lock()
+	mmConnect.ConnectMock.callArgs = append(mmConnect.ConnectMock.callArgs, &mm_params)
+	mmConnect.ConnectMock.mutex.Unlock()
+
+	for _, e := range mmConnect.ConnectMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConnect.ConnectMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConnect.ConnectMock.defaultExpectation.Counter, 1)
+		mm_want := mmConnect.ConnectMock.defaultExpectation.params
+		mm_want_ptrs := mmConnect.ConnectMock.defaultExpectation.paramPtrs
+
+		mm_got := PresenceServiceMockConnectParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConnect.t.Errorf("PresenceServiceMock.Connect got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmConnect.t.Errorf("PresenceServiceMock.Connect got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConnect.t.Errorf("PresenceServiceMock.Connect got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConnect.ConnectMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConnect.t.Fatal("No results are set for the PresenceServiceMock.Connect")
+		}
+		return (*mm_results).err
+	}
+	if mmConnect.funcConnect != nil {
+		return mmConnect.funcConnect(ctx, params)
+	}
+	mmConnect.t.Fatalf("Unexpected call to PresenceServiceMock.Connect. %v %v", ctx, params)
+	return
+}
+
+// ConnectAfterCounter returns a count of finished PresenceServiceMock.Connect invocations
+func (mmConnect *PresenceServiceMock) ConnectAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnect.afterConnectCounter)
+}
+
+// ConnectBeforeCounter returns a count of PresenceServiceMock.Connect invocations
+func (mmConnect *PresenceServiceMock) ConnectBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnect.beforeConnectCounter)
+}
+
+// Calls returns a list of arguments used in each call to PresenceServiceMock.Connect.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConnect *mPresenceServiceMockConnect) Calls() []*PresenceServiceMockConnectParams {
+	mmConnect.mutex.RLock()
+
+	argCopy := make([]*PresenceServiceMockConnectParams, len(mmConnect.callArgs))
+	copy(argCopy, mmConnect.callArgs)
+
+	mmConnect.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConnectDone returns true if the count of the Connect invocations corresponds
+// the number of defined expectations
+func (m *PresenceServiceMock) MinimockConnectDone() bool {
+	if m.ConnectMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConnectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConnectMock.invocationsDone()
+}
+
+// MinimockConnectInspect logs each unmet expectation
+func (m *PresenceServiceMock) MinimockConnectInspect() {
+	for _, e := range m.ConnectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PresenceServiceMock.Connect with params: %#v", *e.params)
+		}
+	}
+
+	afterConnectCounter := mm_atomic.LoadUint64(&m.afterConnectCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConnectMock.defaultExpectation != nil && afterConnectCounter < 1 {
+		if m.ConnectMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PresenceServiceMock.Connect")
+		} else {
+			m.t.Errorf("Expected call to PresenceServiceMock.Connect with params: %#v", *m.ConnectMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConnect != nil && afterConnectCounter < 1 {
+		m.t.Error("Expected call to PresenceServiceMock.Connect")
+	}
+
+	if !m.ConnectMock.invocationsDone() && afterConnectCounter > 0 {
+		m.t.Errorf("Expected %d calls to PresenceServiceMock.Connect but found %d calls",
+			mm_atomic.LoadUint64(&m.ConnectMock.expectedInvocations), afterConnectCounter)
+	}
+}
+
+type mPresenceServiceMockGetPresence struct {
+	optional           bool
+	mock               *PresenceServiceMock
+	defaultExpectation *PresenceServiceMockGetPresenceExpectation
+	expectations       []*PresenceServiceMockGetPresenceExpectation
+
+	callArgs []*PresenceServiceMockGetPresenceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PresenceServiceMockGetPresenceExpectation specifies expectation struct of the PresenceService.GetPresence
+type PresenceServiceMockGetPresenceExpectation struct {
+	mock      *PresenceServiceMock
+	params    *PresenceServiceMockGetPresenceParams
+	paramPtrs *PresenceServiceMockGetPresenceParamPtrs
+	results   *PresenceServiceMockGetPresenceResults
+	Counter   uint64
+}
+
+// PresenceServiceMockGetPresenceParams contains parameters of the PresenceService.GetPresence
+type PresenceServiceMockGetPresenceParams struct {
+	ctx    context.Context
+	params model.GetPresenceParams
+}
+
+// PresenceServiceMockGetPresenceParamPtrs contains pointers to parameters of the PresenceService.GetPresence
+type PresenceServiceMockGetPresenceParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetPresenceParams
+}
+
+// PresenceServiceMockGetPresenceResults contains results of the PresenceService.GetPresence
+type PresenceServiceMockGetPresenceResults struct {
+	presences []model.Presence
+	err       error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPresence *mPresenceServiceMockGetPresence) Optional() *mPresenceServiceMockGetPresence {
+	mmGetPresence.optional = true
+	return mmGetPresence
+}
+
+// Expect sets up expected params for PresenceService.GetPresence
+func (mmGetPresence *mPresenceServiceMockGetPresence) Expect(ctx context.Context, params model.GetPresenceParams) *mPresenceServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("PresenceServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &PresenceServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs != nil {
+		mmGetPresence.mock.t.Fatalf("PresenceServiceMock.GetPresence mock is already set by ExpectParams functions")
+	}
+
+	mmGetPresence.defaultExpectation.params = &PresenceServiceMockGetPresenceParams{ctx, params}
+	for _, e := range mmGetPresence.expectations {
+		if minimock.Equal(e.params, mmGetPresence.defaultExpectation.params) {
+			mmGetPresence.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPresence.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPresence
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PresenceService.GetPresence
+func (mmGetPresence *mPresenceServiceMockGetPresence) ExpectCtxParam1(ctx context.Context) *mPresenceServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("PresenceServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &PresenceServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.params != nil {
+		mmGetPresence.mock.t.Fatalf("PresenceServiceMock.GetPresence mock is already set by Expect")
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs == nil {
+		mmGetPresence.defaultExpectation.paramPtrs = &PresenceServiceMockGetPresenceParamPtrs{}
+	}
+	mmGetPresence.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetPresence
+}
+
+// ExpectParamsParam2 sets up expected param params for PresenceService.GetPresence
+func (mmGetPresence *mPresenceServiceMockGetPresence) ExpectParamsParam2(params model.GetPresenceParams) *mPresenceServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("PresenceServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &PresenceServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.params != nil {
+		mmGetPresence.mock.t.Fatalf("PresenceServiceMock.GetPresence mock is already set by Expect")
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs == nil {
+		mmGetPresence.defaultExpectation.paramPtrs = &PresenceServiceMockGetPresenceParamPtrs{}
+	}
+	mmGetPresence.defaultExpectation.paramPtrs.params = &params
+
+	return mmGetPresence
+}
+
+// Inspect accepts an inspector function that has same arguments as the PresenceService.GetPresence
+func (mmGetPresence *mPresenceServiceMockGetPresence) Inspect(f func(ctx context.Context, params model.GetPresenceParams)) *mPresenceServiceMockGetPresence {
+	if mmGetPresence.mock.inspectFuncGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("Inspect function is already set for PresenceServiceMock.GetPresence")
+	}
+
+	mmGetPresence.mock.inspectFuncGetPresence = f
+
+	return mmGetPresence
+}
+
+// Return sets up results that will be returned by PresenceService.GetPresence
+func (mmGetPresence *mPresenceServiceMockGetPresence) Return(presences []model.Presence, err error) *PresenceServiceMock {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("PresenceServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &PresenceServiceMockGetPresenceExpectation{mock: mmGetPresence.mock}
+	}
+	mmGetPresence.defaultExpectation.results = &PresenceServiceMockGetPresenceResults{presences, err}
+	return mmGetPresence.mock
+}
+
+// Set uses given function f to mock the PresenceService.GetPresence method
+func (mmGetPresence *mPresenceServiceMockGetPresence) Set(f func(ctx context.Context, params model.GetPresenceParams) (presences []model.Presence, err error)) *PresenceServiceMock {
+	if mmGetPresence.defaultExpectation != nil {
+		mmGetPresence.mock.t.Fatalf("Default expectation is already set for the PresenceService.GetPresence method")
+	}
+
+	if len(mmGetPresence.expectations) > 0 {
+		mmGetPresence.mock.t.Fatalf("Some expectations are already set for the PresenceService.GetPresence method")
+	}
+
+	mmGetPresence.mock.funcGetPresence = f
+	return mmGetPresence.mock
+}
+
+// When sets expectation for the PresenceService.GetPresence which will trigger the result defined by the following
+// Then helper
+func (mmGetPresence *mPresenceServiceMockGetPresence) When(ctx context.Context, params model.GetPresenceParams) *PresenceServiceMockGetPresenceExpectation {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("PresenceServiceMock.GetPresence mock is already set by Set")
+	}
+
+	expectation := &PresenceServiceMockGetPresenceExpectation{
+		mock:   mmGetPresence.mock,
+		params: &PresenceServiceMockGetPresenceParams{ctx, params},
+	}
+	mmGetPresence.expectations = append(mmGetPresence.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PresenceService.GetPresence return parameters for the expectation previously defined by the When method
+func (e *PresenceServiceMockGetPresenceExpectation) Then(presences []model.Presence, err error) *PresenceServiceMock {
+	e.results = &PresenceServiceMockGetPresenceResults{presences, err}
+	return e.mock
+}
+
+// Times sets number of times PresenceService.GetPresence should be invoked
+func (mmGetPresence *mPresenceServiceMockGetPresence) Times(n uint64) *mPresenceServiceMockGetPresence {
+	if n == 0 {
+		mmGetPresence.mock.t.Fatalf("Times of PresenceServiceMock.GetPresence mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPresence.expectedInvocations, n)
+	return mmGetPresence
+}
+
+func (mmGetPresence *mPresenceServiceMockGetPresence) invocationsDone() bool {
+	if len(mmGetPresence.expectations) == 0 && mmGetPresence.defaultExpectation == nil && mmGetPresence.mock.funcGetPresence == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPresence.mock.afterGetPresenceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPresence.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPresence implements service.PresenceService
+func (mmGetPresence *PresenceServiceMock) GetPresence(ctx context.Context, params model.GetPresenceParams) (presences []model.Presence, err error) {
+	mm_atomic.AddUint64(&mmGetPresence.beforeGetPresenceCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPresence.afterGetPresenceCounter, 1)
+
+	if mmGetPresence.inspectFuncGetPresence != nil {
+		mmGetPresence.inspectFuncGetPresence(ctx, params)
+	}
+
+	mm_params := PresenceServiceMockGetPresenceParams{ctx, params}
+
+	// Record call args
+	mmGetPresence.GetPresenceMock.mutex.Lock()
+	mmGetPresence.GetPresenceMock.callArgs = append(mmGetPresence.GetPresenceMock.callArgs, &mm_params)
+	mmGetPresence.GetPresenceMock.mutex.Unlock()
+
+	for _, e := range mmGetPresence.GetPresenceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.presences, e.results.err
+		}
+	}
+
+	if mmGetPresence.GetPresenceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPresence.GetPresenceMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPresence.GetPresenceMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPresence.GetPresenceMock.defaultExpectation.paramPtrs
+
+		mm_got := PresenceServiceMockGetPresenceParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPresence.t.Errorf("PresenceServiceMock.GetPresence got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmGetPresence.t.Errorf("PresenceServiceMock.GetPresence got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPresence.t.Errorf("PresenceServiceMock.GetPresence got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPresence.GetPresenceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPresence.t.Fatal("No results are set for the PresenceServiceMock.GetPresence")
+		}
+		return (*mm_results).presences, (*mm_results).err
+	}
+	if mmGetPresence.funcGetPresence != nil {
+		return mmGetPresence.funcGetPresence(ctx, params)
+	}
+	mmGetPresence.t.Fatalf("Unexpected call to PresenceServiceMock.GetPresence. %v %v", ctx, params)
+	return
+}
+
+// GetPresenceAfterCounter returns a count of finished PresenceServiceMock.GetPresence invocations
+func (mmGetPresence *PresenceServiceMock) GetPresenceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPresence.afterGetPresenceCounter)
+}
+
+// GetPresenceBeforeCounter returns a count of PresenceServiceMock.GetPresence invocations
+func (mmGetPresence *PresenceServiceMock) GetPresenceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPresence.beforeGetPresenceCounter)
+}
+
+// Calls returns a list of arguments used in each call to PresenceServiceMock.GetPresence.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPresence *mPresenceServiceMockGetPresence) Calls() []*PresenceServiceMockGetPresenceParams {
+	mmGetPresence.mutex.RLock()
+
+	argCopy := make([]*PresenceServiceMockGetPresenceParams, len(mmGetPresence.callArgs))
+	copy(argCopy, mmGetPresence.callArgs)
+
+	mmGetPresence.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPresenceDone returns true if the count of the GetPresence invocations corresponds
+// the number of defined expectations
+func (m *PresenceServiceMock) MinimockGetPresenceDone() bool {
+	if m.GetPresenceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPresenceMock.invocationsDone()
+}
+
+// MinimockGetPresenceInspect logs each unmet expectation
+func (m *PresenceServiceMock) MinimockGetPresenceInspect() {
+	for _, e := range m.GetPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PresenceServiceMock.GetPresence with params: %#v", *e.params)
+		}
+	}
+
+	afterGetPresenceCounter := mm_atomic.LoadUint64(&m.afterGetPresenceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPresenceMock.defaultExpectation != nil && afterGetPresenceCounter < 1 {
+		if m.GetPresenceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PresenceServiceMock.GetPresence")
+		} else {
+			m.t.Errorf("Expected call to PresenceServiceMock.GetPresence with params: %#v", *m.GetPresenceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPresence != nil && afterGetPresenceCounter < 1 {
+		m.t.Error("Expected call to PresenceServiceMock.GetPresence")
+	}
+
+	if !m.GetPresenceMock.invocationsDone() && afterGetPresenceCounter > 0 {
+		m.t.Errorf("Expected %d calls to PresenceServiceMock.GetPresence but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPresenceMock.expectedInvocations), afterGetPresenceCounter)
+	}
+}
+
+type mPresenceServiceMockHeartbeat struct {
+	optional           bool
+	mock               *PresenceServiceMock
+	defaultExpectation *PresenceServiceMockHeartbeatExpectation
+	expectations       []*PresenceServiceMockHeartbeatExpectation
+
+	callArgs []*PresenceServiceMockHeartbeatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PresenceServiceMockHeartbeatExpectation specifies expectation struct of the PresenceService.Heartbeat
+type PresenceServiceMockHeartbeatExpectation struct {
+	mock      *PresenceServiceMock
+	params    *PresenceServiceMockHeartbeatParams
+	paramPtrs *PresenceServiceMockHeartbeatParamPtrs
+	results   *PresenceServiceMockHeartbeatResults
+	Counter   uint64
+}
+
+// PresenceServiceMockHeartbeatParams contains parameters of the PresenceService.Heartbeat
+type PresenceServiceMockHeartbeatParams struct {
+	ctx    context.Context
+	params model.HeartbeatParams
+}
+
+// PresenceServiceMockHeartbeatParamPtrs contains pointers to parameters of the PresenceService.Heartbeat
+type PresenceServiceMockHeartbeatParamPtrs struct {
+	ctx    *context.Context
+	params *model.HeartbeatParams
+}
+
+// PresenceServiceMockHeartbeatResults contains results of the PresenceService.Heartbeat
+type PresenceServiceMockHeartbeatResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) Optional() *mPresenceServiceMockHeartbeat {
+	mmHeartbeat.optional = true
+	return mmHeartbeat
+}
+
+// Expect sets up expected params for PresenceService.Heartbeat
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) Expect(ctx context.Context, params model.HeartbeatParams) *mPresenceServiceMockHeartbeat {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("PresenceServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &PresenceServiceMockHeartbeatExpectation{}
+	}
+
+	if mmHeartbeat.defaultExpectation.paramPtrs != nil {
+		mmHeartbeat.mock.t.Fatalf("PresenceServiceMock.Heartbeat mock is already set by ExpectParams functions")
+	}
+
+	mmHeartbeat.defaultExpectation.params = &PresenceServiceMockHeartbeatParams{ctx, params}
+	for _, e := range mmHeartbeat.expectations {
+		if minimock.Equal(e.params, mmHeartbeat.defaultExpectation.params) {
+			mmHeartbeat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHeartbeat.defaultExpectation.params)
+		}
+	}
+
+	return mmHeartbeat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PresenceService.Heartbeat
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) ExpectCtxParam1(ctx context.Context) *mPresenceServiceMockHeartbeat {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("PresenceServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &PresenceServiceMockHeartbeatExpectation{}
+	}
+
+	if mmHeartbeat.defaultExpectation.params != nil {
+		mmHeartbeat.mock.t.Fatalf("PresenceServiceMock.Heartbeat mock is already set by Expect")
+	}
+
+	if mmHeartbeat.defaultExpectation.paramPtrs == nil {
+		mmHeartbeat.defaultExpectation.paramPtrs = &PresenceServiceMockHeartbeatParamPtrs{}
+	}
+	mmHeartbeat.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmHeartbeat
+}
+
+// ExpectParamsParam2 sets up expected param params for PresenceService.Heartbeat
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) ExpectParamsParam2(params model.HeartbeatParams) *mPresenceServiceMockHeartbeat {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("PresenceServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &PresenceServiceMockHeartbeatExpectation{}
+	}
+
+	if mmHeartbeat.defaultExpectation.params != nil {
+		mmHeartbeat.mock.t.Fatalf("PresenceServiceMock.Heartbeat mock is already set by Expect")
+	}
+
+	if mmHeartbeat.defaultExpectation.paramPtrs == nil {
+		mmHeartbeat.defaultExpectation.paramPtrs = &PresenceServiceMockHeartbeatParamPtrs{}
+	}
+	mmHeartbeat.defaultExpectation.paramPtrs.params = &params
+
+	return mmHeartbeat
+}
+
+// Inspect accepts an inspector function that has same arguments as the PresenceService.Heartbeat
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) Inspect(f func(ctx context.Context, params model.HeartbeatParams)) *mPresenceServiceMockHeartbeat {
+	if mmHeartbeat.mock.inspectFuncHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("Inspect function is already set for PresenceServiceMock.Heartbeat")
+	}
+
+	mmHeartbeat.mock.inspectFuncHeartbeat = f
+
+	return mmHeartbeat
+}
+
+// Return sets up results that will be returned by PresenceService.Heartbeat
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) Return(err error) *PresenceServiceMock {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("PresenceServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &PresenceServiceMockHeartbeatExpectation{mock: mmHeartbeat.mock}
+	}
+	mmHeartbeat.defaultExpectation.results = &PresenceServiceMockHeartbeatResults{err}
+	return mmHeartbeat.mock
+}
+
+// Set uses given function f to mock the PresenceService.Heartbeat method
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) Set(f func(ctx context.Context, params model.HeartbeatParams) (err error)) *PresenceServiceMock {
+	if mmHeartbeat.defaultExpectation != nil {
+		mmHeartbeat.mock.t.Fatalf("Default expectation is already set for the PresenceService.Heartbeat method")
+	}
+
+	if len(mmHeartbeat.expectations) > 0 {
+		mmHeartbeat.mock.t.Fatalf("Some expectations are already set for the PresenceService.Heartbeat method")
+	}
+
+	mmHeartbeat.mock.funcHeartbeat = f
+	return mmHeartbeat.mock
+}
+
+// When sets expectation for the PresenceService.Heartbeat which will trigger the result defined by the following
+// Then helper
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) When(ctx context.Context, params model.HeartbeatParams) *PresenceServiceMockHeartbeatExpectation {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("PresenceServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	expectation := &PresenceServiceMockHeartbeatExpectation{
+		mock:   mmHeartbeat.mock,
+		params: &PresenceServiceMockHeartbeatParams{ctx, params},
+	}
+	mmHeartbeat.expectations = append(mmHeartbeat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PresenceService.Heartbeat return parameters for the expectation previously defined by the When method
+func (e *PresenceServiceMockHeartbeatExpectation) Then(err error) *PresenceServiceMock {
+	e.results = &PresenceServiceMockHeartbeatResults{err}
+	return e.mock
+}
+
+// Times sets number of times PresenceService.Heartbeat should be invoked
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) Times(n uint64) *mPresenceServiceMockHeartbeat {
+	if n == 0 {
+		mmHeartbeat.mock.t.Fatalf("Times of PresenceServiceMock.Heartbeat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHeartbeat.expectedInvocations, n)
+	return mmHeartbeat
+}
+
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) invocationsDone() bool {
+	if len(mmHeartbeat.expectations) == 0 && mmHeartbeat.defaultExpectation == nil && mmHeartbeat.mock.funcHeartbeat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHeartbeat.mock.afterHeartbeatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHeartbeat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Heartbeat implements service.PresenceService
+func (mmHeartbeat *PresenceServiceMock) Heartbeat(ctx context.Context, params model.HeartbeatParams) (err error) {
+	mm_atomic.AddUint64(&mmHeartbeat.beforeHeartbeatCounter, 1)
+	defer mm_atomic.AddUint64(&mmHeartbeat.afterHeartbeatCounter, 1)
+
+	if mmHeartbeat.inspectFuncHeartbeat != nil {
+		mmHeartbeat.inspectFuncHeartbeat(ctx, params)
+	}
+
+	mm_params := PresenceServiceMockHeartbeatParams{ctx, params}
+
+	// Record call args
+	mmHeartbeat.HeartbeatMock.mutex.Lock()
+	mmHeartbeat.HeartbeatMock.callArgs = append(mmHeartbeat.HeartbeatMock.callArgs, &mm_params)
+	mmHeartbeat.HeartbeatMock.mutex.Unlock()
+
+	for _, e := range mmHeartbeat.HeartbeatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmHeartbeat.HeartbeatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHeartbeat.HeartbeatMock.defaultExpectation.Counter, 1)
+		mm_want := mmHeartbeat.HeartbeatMock.defaultExpectation.params
+		mm_want_ptrs := mmHeartbeat.HeartbeatMock.defaultExpectation.paramPtrs
+
+		mm_got := PresenceServiceMockHeartbeatParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHeartbeat.t.Errorf("PresenceServiceMock.Heartbeat got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmHeartbeat.t.Errorf("PresenceServiceMock.Heartbeat got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHeartbeat.t.Errorf("PresenceServiceMock.Heartbeat got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHeartbeat.HeartbeatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHeartbeat.t.Fatal("No results are set for the PresenceServiceMock.Heartbeat")
+		}
+		return (*mm_results).err
+	}
+	if mmHeartbeat.funcHeartbeat != nil {
+		return mmHeartbeat.funcHeartbeat(ctx, params)
+	}
+	mmHeartbeat.t.Fatalf("Unexpected call to PresenceServiceMock.Heartbeat. %v %v", ctx, params)
+	return
+}
+
+// HeartbeatAfterCounter returns a count of finished PresenceServiceMock.Heartbeat invocations
+func (mmHeartbeat *PresenceServiceMock) HeartbeatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHeartbeat.afterHeartbeatCounter)
+}
+
+// HeartbeatBeforeCounter returns a count of PresenceServiceMock.Heartbeat invocations
+func (mmHeartbeat *PresenceServiceMock) HeartbeatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHeartbeat.beforeHeartbeatCounter)
+}
+
+// Calls returns a list of arguments used in each call to PresenceServiceMock.Heartbeat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHeartbeat *mPresenceServiceMockHeartbeat) Calls() []*PresenceServiceMockHeartbeatParams {
+	mmHeartbeat.mutex.RLock()
+
+	argCopy := make([]*PresenceServiceMockHeartbeatParams, len(mmHeartbeat.callArgs))
+	copy(argCopy, mmHeartbeat.callArgs)
+
+	mmHeartbeat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHeartbeatDone returns true if the count of the Heartbeat invocations corresponds
+// the number of defined expectations
+func (m *PresenceServiceMock) MinimockHeartbeatDone() bool {
+	if m.HeartbeatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HeartbeatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HeartbeatMock.invocationsDone()
+}
+
+// MinimockHeartbeatInspect logs each unmet expectation
+func (m *PresenceServiceMock) MinimockHeartbeatInspect() {
+	for _, e := range m.HeartbeatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PresenceServiceMock.Heartbeat with params: %#v", *e.params)
+		}
+	}
+
+	afterHeartbeatCounter := mm_atomic.LoadUint64(&m.afterHeartbeatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HeartbeatMock.defaultExpectation != nil && afterHeartbeatCounter < 1 {
+		if m.HeartbeatMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PresenceServiceMock.Heartbeat")
+		} else {
+			m.t.Errorf("Expected call to PresenceServiceMock.Heartbeat with params: %#v", *m.HeartbeatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHeartbeat != nil && afterHeartbeatCounter < 1 {
+		m.t.Error("Expected call to PresenceServiceMock.Heartbeat")
+	}
+
+	if !m.HeartbeatMock.invocationsDone() && afterHeartbeatCounter > 0 {
+		m.t.Errorf("Expected %d calls to PresenceServiceMock.Heartbeat but found %d calls",
+			mm_atomic.LoadUint64(&m.HeartbeatMock.expectedInvocations), afterHeartbeatCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PresenceServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockConnectInspect()
+
+			m.MinimockGetPresenceInspect()
+
+			m.MinimockHeartbeatInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PresenceServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PresenceServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockConnectDone() &&
+		m.MinimockGetPresenceDone() &&
+		m.MinimockHeartbeatDone()
+}
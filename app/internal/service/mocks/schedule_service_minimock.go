@@ -0,0 +1,1060 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.ScheduleService -o schedule_service_minimock.go -n ScheduleServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// ScheduleServiceMock implements service.ScheduleService
+type ScheduleServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCancelScheduledMessage          func(ctx context.Context, params model.CancelScheduledMessageParams) (err error)
+	inspectFuncCancelScheduledMessage   func(ctx context.Context, params model.CancelScheduledMessageParams)
+	afterCancelScheduledMessageCounter  uint64
+	beforeCancelScheduledMessageCounter uint64
+	CancelScheduledMessageMock          mScheduleServiceMockCancelScheduledMessage
+
+	funcListScheduledMessages          func(ctx context.Context, params model.ListScheduledMessagesParams) (messages []model.ScheduledMessage, err error)
+	inspectFuncListScheduledMessages   func(ctx context.Context, params model.ListScheduledMessagesParams)
+	afterListScheduledMessagesCounter  uint64
+	beforeListScheduledMessagesCounter uint64
+	ListScheduledMessagesMock          mScheduleServiceMockListScheduledMessages
+
+	funcScheduleMessage          func(ctx context.Context, params model.ScheduleMessageParams) (scheduledMessageID int64, err error)
+	inspectFuncScheduleMessage   func(ctx context.Context, params model.ScheduleMessageParams)
+	afterScheduleMessageCounter  uint64
+	beforeScheduleMessageCounter uint64
+	ScheduleMessageMock          mScheduleServiceMockScheduleMessage
+}
+
+// NewScheduleServiceMock returns a mock for service.ScheduleService
+func NewScheduleServiceMock(t minimock.Tester) *ScheduleServiceMock {
+	m := &ScheduleServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CancelScheduledMessageMock = mScheduleServiceMockCancelScheduledMessage{mock: m}
+	m.CancelScheduledMessageMock.callArgs = []*ScheduleServiceMockCancelScheduledMessageParams{}
+
+	m.ListScheduledMessagesMock = mScheduleServiceMockListScheduledMessages{mock: m}
+	m.ListScheduledMessagesMock.callArgs = []*ScheduleServiceMockListScheduledMessagesParams{}
+
+	m.ScheduleMessageMock = mScheduleServiceMockScheduleMessage{mock: m}
+	m.ScheduleMessageMock.callArgs = []*ScheduleServiceMockScheduleMessageParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mScheduleServiceMockCancelScheduledMessage struct {
+	optional           bool
+	mock               *ScheduleServiceMock
+	defaultExpectation *ScheduleServiceMockCancelScheduledMessageExpectation
+	expectations       []*ScheduleServiceMockCancelScheduledMessageExpectation
+
+	callArgs []*ScheduleServiceMockCancelScheduledMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ScheduleServiceMockCancelScheduledMessageExpectation specifies expectation struct of the ScheduleService.CancelScheduledMessage
+type ScheduleServiceMockCancelScheduledMessageExpectation struct {
+	mock      *ScheduleServiceMock
+	params    *ScheduleServiceMockCancelScheduledMessageParams
+	paramPtrs *ScheduleServiceMockCancelScheduledMessageParamPtrs
+	results   *ScheduleServiceMockCancelScheduledMessageResults
+	Counter   uint64
+}
+
+// ScheduleServiceMockCancelScheduledMessageParams contains parameters of the ScheduleService.CancelScheduledMessage
+type ScheduleServiceMockCancelScheduledMessageParams struct {
+	ctx    context.Context
+	params model.CancelScheduledMessageParams
+}
+
+// ScheduleServiceMockCancelScheduledMessageParamPtrs contains pointers to parameters of the ScheduleService.CancelScheduledMessage
+type ScheduleServiceMockCancelScheduledMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.CancelScheduledMessageParams
+}
+
+// ScheduleServiceMockCancelScheduledMessageResults contains results of the ScheduleService.CancelScheduledMessage
+type ScheduleServiceMockCancelScheduledMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) Optional() *mScheduleServiceMockCancelScheduledMessage {
+	mmCancelScheduledMessage.optional = true
+	return mmCancelScheduledMessage
+}
+
+// Expect sets up expected params for ScheduleService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) Expect(ctx context.Context, params model.CancelScheduledMessageParams) *mScheduleServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduleServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ScheduleServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduleServiceMock.CancelScheduledMessage mock is already set by ExpectParams functions")
+	}
+
+	mmCancelScheduledMessage.defaultExpectation.params = &ScheduleServiceMockCancelScheduledMessageParams{ctx, params}
+	for _, e := range mmCancelScheduledMessage.expectations {
+		if minimock.Equal(e.params, mmCancelScheduledMessage.defaultExpectation.params) {
+			mmCancelScheduledMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancelScheduledMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmCancelScheduledMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduleService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) ExpectCtxParam1(ctx context.Context) *mScheduleServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduleServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ScheduleServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.params != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduleServiceMock.CancelScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduledMessage.defaultExpectation.paramPtrs = &ScheduleServiceMockCancelScheduledMessageParamPtrs{}
+	}
+	mmCancelScheduledMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCancelScheduledMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for ScheduleService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) ExpectParamsParam2(params model.CancelScheduledMessageParams) *mScheduleServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduleServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ScheduleServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.params != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduleServiceMock.CancelScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduledMessage.defaultExpectation.paramPtrs = &ScheduleServiceMockCancelScheduledMessageParamPtrs{}
+	}
+	mmCancelScheduledMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmCancelScheduledMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduleService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) Inspect(f func(ctx context.Context, params model.CancelScheduledMessageParams)) *mScheduleServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.inspectFuncCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("Inspect function is already set for ScheduleServiceMock.CancelScheduledMessage")
+	}
+
+	mmCancelScheduledMessage.mock.inspectFuncCancelScheduledMessage = f
+
+	return mmCancelScheduledMessage
+}
+
+// Return sets up results that will be returned by ScheduleService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) Return(err error) *ScheduleServiceMock {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduleServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ScheduleServiceMockCancelScheduledMessageExpectation{mock: mmCancelScheduledMessage.mock}
+	}
+	mmCancelScheduledMessage.defaultExpectation.results = &ScheduleServiceMockCancelScheduledMessageResults{err}
+	return mmCancelScheduledMessage.mock
+}
+
+// Set uses given function f to mock the ScheduleService.CancelScheduledMessage method
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) Set(f func(ctx context.Context, params model.CancelScheduledMessageParams) (err error)) *ScheduleServiceMock {
+	if mmCancelScheduledMessage.defaultExpectation != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("Default expectation is already set for the ScheduleService.CancelScheduledMessage method")
+	}
+
+	if len(mmCancelScheduledMessage.expectations) > 0 {
+		mmCancelScheduledMessage.mock.t.Fatalf("Some expectations are already set for the ScheduleService.CancelScheduledMessage method")
+	}
+
+	mmCancelScheduledMessage.mock.funcCancelScheduledMessage = f
+	return mmCancelScheduledMessage.mock
+}
+
+// When sets expectation for the ScheduleService.CancelScheduledMessage which will trigger the result defined by the following
+// Then helper
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) When(ctx context.Context, params model.CancelScheduledMessageParams) *ScheduleServiceMockCancelScheduledMessageExpectation {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ScheduleServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	expectation := &ScheduleServiceMockCancelScheduledMessageExpectation{
+		mock:   mmCancelScheduledMessage.mock,
+		params: &ScheduleServiceMockCancelScheduledMessageParams{ctx, params},
+	}
+	mmCancelScheduledMessage.expectations = append(mmCancelScheduledMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduleService.CancelScheduledMessage return parameters for the expectation previously defined by the When method
+func (e *ScheduleServiceMockCancelScheduledMessageExpectation) Then(err error) *ScheduleServiceMock {
+	e.results = &ScheduleServiceMockCancelScheduledMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ScheduleService.CancelScheduledMessage should be invoked
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) Times(n uint64) *mScheduleServiceMockCancelScheduledMessage {
+	if n == 0 {
+		mmCancelScheduledMessage.mock.t.Fatalf("Times of ScheduleServiceMock.CancelScheduledMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancelScheduledMessage.expectedInvocations, n)
+	return mmCancelScheduledMessage
+}
+
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) invocationsDone() bool {
+	if len(mmCancelScheduledMessage.expectations) == 0 && mmCancelScheduledMessage.defaultExpectation == nil && mmCancelScheduledMessage.mock.funcCancelScheduledMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancelScheduledMessage.mock.afterCancelScheduledMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancelScheduledMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancelScheduledMessage implements service.ScheduleService
+func (mmCancelScheduledMessage *ScheduleServiceMock) CancelScheduledMessage(ctx context.Context, params model.CancelScheduledMessageParams) (err error) {
+	mm_atomic.AddUint64(&mmCancelScheduledMessage.beforeCancelScheduledMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancelScheduledMessage.afterCancelScheduledMessageCounter, 1)
+
+	if mmCancelScheduledMessage.inspectFuncCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.inspectFuncCancelScheduledMessage(ctx, params)
+	}
+
+	mm_params := ScheduleServiceMockCancelScheduledMessageParams{ctx, params}
+
+	// Record call args
+	mmCancelScheduledMessage.CancelScheduledMessageMock.mutex.Lock()
+	mmCancelScheduledMessage.CancelScheduledMessageMock.callArgs = append(mmCancelScheduledMessage.CancelScheduledMessageMock.callArgs, &mm_params)
+	mmCancelScheduledMessage.CancelScheduledMessageMock.mutex.Unlock()
+
+	for _, e := range mmCancelScheduledMessage.CancelScheduledMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduleServiceMockCancelScheduledMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancelScheduledMessage.t.Errorf("ScheduleServiceMock.CancelScheduledMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCancelScheduledMessage.t.Errorf("ScheduleServiceMock.CancelScheduledMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancelScheduledMessage.t.Errorf("ScheduleServiceMock.CancelScheduledMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancelScheduledMessage.t.Fatal("No results are set for the ScheduleServiceMock.CancelScheduledMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmCancelScheduledMessage.funcCancelScheduledMessage != nil {
+		return mmCancelScheduledMessage.funcCancelScheduledMessage(ctx, params)
+	}
+	mmCancelScheduledMessage.t.Fatalf("Unexpected call to ScheduleServiceMock.CancelScheduledMessage. %v %v", ctx, params)
+	return
+}
+
+// CancelScheduledMessageAfterCounter returns a count of finished ScheduleServiceMock.CancelScheduledMessage invocations
+func (mmCancelScheduledMessage *ScheduleServiceMock) CancelScheduledMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelScheduledMessage.afterCancelScheduledMessageCounter)
+}
+
+// CancelScheduledMessageBeforeCounter returns a count of ScheduleServiceMock.CancelScheduledMessage invocations
+func (mmCancelScheduledMessage *ScheduleServiceMock) CancelScheduledMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelScheduledMessage.beforeCancelScheduledMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduleServiceMock.CancelScheduledMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancelScheduledMessage *mScheduleServiceMockCancelScheduledMessage) Calls() []*ScheduleServiceMockCancelScheduledMessageParams {
+	mmCancelScheduledMessage.mutex.RLock()
+
+	argCopy := make([]*ScheduleServiceMockCancelScheduledMessageParams, len(mmCancelScheduledMessage.callArgs))
+	copy(argCopy, mmCancelScheduledMessage.callArgs)
+
+	mmCancelScheduledMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelScheduledMessageDone returns true if the count of the CancelScheduledMessage invocations corresponds
+// the number of defined expectations
+func (m *ScheduleServiceMock) MinimockCancelScheduledMessageDone() bool {
+	if m.CancelScheduledMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelScheduledMessageMock.invocationsDone()
+}
+
+// MinimockCancelScheduledMessageInspect logs each unmet expectation
+func (m *ScheduleServiceMock) MinimockCancelScheduledMessageInspect() {
+	for _, e := range m.CancelScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduleServiceMock.CancelScheduledMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterCancelScheduledMessageCounter := mm_atomic.LoadUint64(&m.afterCancelScheduledMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelScheduledMessageMock.defaultExpectation != nil && afterCancelScheduledMessageCounter < 1 {
+		if m.CancelScheduledMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ScheduleServiceMock.CancelScheduledMessage")
+		} else {
+			m.t.Errorf("Expected call to ScheduleServiceMock.CancelScheduledMessage with params: %#v", *m.CancelScheduledMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancelScheduledMessage != nil && afterCancelScheduledMessageCounter < 1 {
+		m.t.Error("Expected call to ScheduleServiceMock.CancelScheduledMessage")
+	}
+
+	if !m.CancelScheduledMessageMock.invocationsDone() && afterCancelScheduledMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduleServiceMock.CancelScheduledMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelScheduledMessageMock.expectedInvocations), afterCancelScheduledMessageCounter)
+	}
+}
+
+type mScheduleServiceMockListScheduledMessages struct {
+	optional           bool
+	mock               *ScheduleServiceMock
+	defaultExpectation *ScheduleServiceMockListScheduledMessagesExpectation
+	expectations       []*ScheduleServiceMockListScheduledMessagesExpectation
+
+	callArgs []*ScheduleServiceMockListScheduledMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ScheduleServiceMockListScheduledMessagesExpectation specifies expectation struct of the ScheduleService.ListScheduledMessages
+type ScheduleServiceMockListScheduledMessagesExpectation struct {
+	mock      *ScheduleServiceMock
+	params    *ScheduleServiceMockListScheduledMessagesParams
+	paramPtrs *ScheduleServiceMockListScheduledMessagesParamPtrs
+	results   *ScheduleServiceMockListScheduledMessagesResults
+	Counter   uint64
+}
+
+// ScheduleServiceMockListScheduledMessagesParams contains parameters of the ScheduleService.ListScheduledMessages
+type ScheduleServiceMockListScheduledMessagesParams struct {
+	ctx    context.Context
+	params model.ListScheduledMessagesParams
+}
+
+// ScheduleServiceMockListScheduledMessagesParamPtrs contains pointers to parameters of the ScheduleService.ListScheduledMessages
+type ScheduleServiceMockListScheduledMessagesParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListScheduledMessagesParams
+}
+
+// ScheduleServiceMockListScheduledMessagesResults contains results of the ScheduleService.ListScheduledMessages
+type ScheduleServiceMockListScheduledMessagesResults struct {
+	messages []model.ScheduledMessage
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) Optional() *mScheduleServiceMockListScheduledMessages {
+	mmListScheduledMessages.optional = true
+	return mmListScheduledMessages
+}
+
+// Expect sets up expected params for ScheduleService.ListScheduledMessages
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) Expect(ctx context.Context, params model.ListScheduledMessagesParams) *mScheduleServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduleServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ScheduleServiceMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduleServiceMock.ListScheduledMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListScheduledMessages.defaultExpectation.params = &ScheduleServiceMockListScheduledMessagesParams{ctx, params}
+	for _, e := range mmListScheduledMessages.expectations {
+		if minimock.Equal(e.params, mmListScheduledMessages.defaultExpectation.params) {
+			mmListScheduledMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListScheduledMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListScheduledMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduleService.ListScheduledMessages
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) ExpectCtxParam1(ctx context.Context) *mScheduleServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduleServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ScheduleServiceMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.params != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduleServiceMock.ListScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListScheduledMessages.defaultExpectation.paramPtrs = &ScheduleServiceMockListScheduledMessagesParamPtrs{}
+	}
+	mmListScheduledMessages.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListScheduledMessages
+}
+
+// ExpectParamsParam2 sets up expected param params for ScheduleService.ListScheduledMessages
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) ExpectParamsParam2(params model.ListScheduledMessagesParams) *mScheduleServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduleServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ScheduleServiceMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.params != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduleServiceMock.ListScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListScheduledMessages.defaultExpectation.paramPtrs = &ScheduleServiceMockListScheduledMessagesParamPtrs{}
+	}
+	mmListScheduledMessages.defaultExpectation.paramPtrs.params = &params
+
+	return mmListScheduledMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduleService.ListScheduledMessages
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) Inspect(f func(ctx context.Context, params model.ListScheduledMessagesParams)) *mScheduleServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.inspectFuncListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("Inspect function is already set for ScheduleServiceMock.ListScheduledMessages")
+	}
+
+	mmListScheduledMessages.mock.inspectFuncListScheduledMessages = f
+
+	return mmListScheduledMessages
+}
+
+// Return sets up results that will be returned by ScheduleService.ListScheduledMessages
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) Return(messages []model.ScheduledMessage, err error) *ScheduleServiceMock {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduleServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ScheduleServiceMockListScheduledMessagesExpectation{mock: mmListScheduledMessages.mock}
+	}
+	mmListScheduledMessages.defaultExpectation.results = &ScheduleServiceMockListScheduledMessagesResults{messages, err}
+	return mmListScheduledMessages.mock
+}
+
+// Set uses given function f to mock the ScheduleService.ListScheduledMessages method
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) Set(f func(ctx context.Context, params model.ListScheduledMessagesParams) (messages []model.ScheduledMessage, err error)) *ScheduleServiceMock {
+	if mmListScheduledMessages.defaultExpectation != nil {
+		mmListScheduledMessages.mock.t.Fatalf("Default expectation is already set for the ScheduleService.ListScheduledMessages method")
+	}
+
+	if len(mmListScheduledMessages.expectations) > 0 {
+		mmListScheduledMessages.mock.t.Fatalf("Some expectations are already set for the ScheduleService.ListScheduledMessages method")
+	}
+
+	mmListScheduledMessages.mock.funcListScheduledMessages = f
+	return mmListScheduledMessages.mock
+}
+
+// When sets expectation for the ScheduleService.ListScheduledMessages which will trigger the result defined by the following
+// Then helper
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) When(ctx context.Context, params model.ListScheduledMessagesParams) *ScheduleServiceMockListScheduledMessagesExpectation {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ScheduleServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	expectation := &ScheduleServiceMockListScheduledMessagesExpectation{
+		mock:   mmListScheduledMessages.mock,
+		params: &ScheduleServiceMockListScheduledMessagesParams{ctx, params},
+	}
+	mmListScheduledMessages.expectations = append(mmListScheduledMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduleService.ListScheduledMessages return parameters for the expectation previously defined by the When method
+func (e *ScheduleServiceMockListScheduledMessagesExpectation) Then(messages []model.ScheduledMessage, err error) *ScheduleServiceMock {
+	e.results = &ScheduleServiceMockListScheduledMessagesResults{messages, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduleService.ListScheduledMessages should be invoked
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) Times(n uint64) *mScheduleServiceMockListScheduledMessages {
+	if n == 0 {
+		mmListScheduledMessages.mock.t.Fatalf("Times of ScheduleServiceMock.ListScheduledMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListScheduledMessages.expectedInvocations, n)
+	return mmListScheduledMessages
+}
+
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) invocationsDone() bool {
+	if len(mmListScheduledMessages.expectations) == 0 && mmListScheduledMessages.defaultExpectation == nil && mmListScheduledMessages.mock.funcListScheduledMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListScheduledMessages.mock.afterListScheduledMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListScheduledMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListScheduledMessages implements service.ScheduleService
+func (mmListScheduledMessages *ScheduleServiceMock) ListScheduledMessages(ctx context.Context, params model.ListScheduledMessagesParams) (messages []model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmListScheduledMessages.beforeListScheduledMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListScheduledMessages.afterListScheduledMessagesCounter, 1)
+
+	if mmListScheduledMessages.inspectFuncListScheduledMessages != nil {
+		mmListScheduledMessages.inspectFuncListScheduledMessages(ctx, params)
+	}
+
+	mm_params := ScheduleServiceMockListScheduledMessagesParams{ctx, params}
+
+	// Record call args
+	mmListScheduledMessages.ListScheduledMessagesMock.mutex.Lock()
+	mmListScheduledMessages.ListScheduledMessagesMock.callArgs = append(mmListScheduledMessages.ListScheduledMessagesMock.callArgs, &mm_params)
+	mmListScheduledMessages.ListScheduledMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListScheduledMessages.ListScheduledMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.messages, e.results.err
+		}
+	}
+
+	if mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduleServiceMockListScheduledMessagesParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListScheduledMessages.t.Errorf("ScheduleServiceMock.ListScheduledMessages got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListScheduledMessages.t.Errorf("ScheduleServiceMock.ListScheduledMessages got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListScheduledMessages.t.Errorf("ScheduleServiceMock.ListScheduledMessages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListScheduledMessages.t.Fatal("No results are set for the ScheduleServiceMock.ListScheduledMessages")
+		}
+		return (*mm_results).messages, (*mm_results).err
+	}
+	if mmListScheduledMessages.funcListScheduledMessages != nil {
+		return mmListScheduledMessages.funcListScheduledMessages(ctx, params)
+	}
+	mmListScheduledMessages.t.Fatalf("Unexpected call to ScheduleServiceMock.ListScheduledMessages. %v %v", ctx, params)
+	return
+}
+
+// ListScheduledMessagesAfterCounter returns a count of finished ScheduleServiceMock.ListScheduledMessages invocations
+func (mmListScheduledMessages *ScheduleServiceMock) ListScheduledMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduledMessages.afterListScheduledMessagesCounter)
+}
+
+// ListScheduledMessagesBeforeCounter returns a count of ScheduleServiceMock.ListScheduledMessages invocations
+func (mmListScheduledMessages *ScheduleServiceMock) ListScheduledMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduledMessages.beforeListScheduledMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduleServiceMock.ListScheduledMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListScheduledMessages *mScheduleServiceMockListScheduledMessages) Calls() []*ScheduleServiceMockListScheduledMessagesParams {
+	mmListScheduledMessages.mutex.RLock()
+
+	argCopy := make([]*ScheduleServiceMockListScheduledMessagesParams, len(mmListScheduledMessages.callArgs))
+	copy(argCopy, mmListScheduledMessages.callArgs)
+
+	mmListScheduledMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListScheduledMessagesDone returns true if the count of the ListScheduledMessages invocations corresponds
+// the number of defined expectations
+func (m *ScheduleServiceMock) MinimockListScheduledMessagesDone() bool {
+	if m.ListScheduledMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListScheduledMessagesMock.invocationsDone()
+}
+
+// MinimockListScheduledMessagesInspect logs each unmet expectation
+func (m *ScheduleServiceMock) MinimockListScheduledMessagesInspect() {
+	for _, e := range m.ListScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduleServiceMock.ListScheduledMessages with params: %#v", *e.params)
+		}
+	}
+
+	afterListScheduledMessagesCounter := mm_atomic.LoadUint64(&m.afterListScheduledMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListScheduledMessagesMock.defaultExpectation != nil && afterListScheduledMessagesCounter < 1 {
+		if m.ListScheduledMessagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ScheduleServiceMock.ListScheduledMessages")
+		} else {
+			m.t.Errorf("Expected call to ScheduleServiceMock.ListScheduledMessages with params: %#v", *m.ListScheduledMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListScheduledMessages != nil && afterListScheduledMessagesCounter < 1 {
+		m.t.Error("Expected call to ScheduleServiceMock.ListScheduledMessages")
+	}
+
+	if !m.ListScheduledMessagesMock.invocationsDone() && afterListScheduledMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduleServiceMock.ListScheduledMessages but found %d calls",
+			mm_atomic.LoadUint64(&m.ListScheduledMessagesMock.expectedInvocations), afterListScheduledMessagesCounter)
+	}
+}
+
+type mScheduleServiceMockScheduleMessage struct {
+	optional           bool
+	mock               *ScheduleServiceMock
+	defaultExpectation *ScheduleServiceMockScheduleMessageExpectation
+	expectations       []*ScheduleServiceMockScheduleMessageExpectation
+
+	callArgs []*ScheduleServiceMockScheduleMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ScheduleServiceMockScheduleMessageExpectation specifies expectation struct of the ScheduleService.ScheduleMessage
+type ScheduleServiceMockScheduleMessageExpectation struct {
+	mock      *ScheduleServiceMock
+	params    *ScheduleServiceMockScheduleMessageParams
+	paramPtrs *ScheduleServiceMockScheduleMessageParamPtrs
+	results   *ScheduleServiceMockScheduleMessageResults
+	Counter   uint64
+}
+
+// ScheduleServiceMockScheduleMessageParams contains parameters of the ScheduleService.ScheduleMessage
+type ScheduleServiceMockScheduleMessageParams struct {
+	ctx    context.Context
+	params model.ScheduleMessageParams
+}
+
+// ScheduleServiceMockScheduleMessageParamPtrs contains pointers to parameters of the ScheduleService.ScheduleMessage
+type ScheduleServiceMockScheduleMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.ScheduleMessageParams
+}
+
+// ScheduleServiceMockScheduleMessageResults contains results of the ScheduleService.ScheduleMessage
+type ScheduleServiceMockScheduleMessageResults struct {
+	scheduledMessageID int64
+	err                error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) Optional() *mScheduleServiceMockScheduleMessage {
+	mmScheduleMessage.optional = true
+	return mmScheduleMessage
+}
+
+// Expect sets up expected params for ScheduleService.ScheduleMessage
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) Expect(ctx context.Context, params model.ScheduleMessageParams) *mScheduleServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ScheduleServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ScheduleServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs != nil {
+		mmScheduleMessage.mock.t.Fatalf("ScheduleServiceMock.ScheduleMessage mock is already set by ExpectParams functions")
+	}
+
+	mmScheduleMessage.defaultExpectation.params = &ScheduleServiceMockScheduleMessageParams{ctx, params}
+	for _, e := range mmScheduleMessage.expectations {
+		if minimock.Equal(e.params, mmScheduleMessage.defaultExpectation.params) {
+			mmScheduleMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScheduleMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmScheduleMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduleService.ScheduleMessage
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) ExpectCtxParam1(ctx context.Context) *mScheduleServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ScheduleServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ScheduleServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.params != nil {
+		mmScheduleMessage.mock.t.Fatalf("ScheduleServiceMock.ScheduleMessage mock is already set by Expect")
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs == nil {
+		mmScheduleMessage.defaultExpectation.paramPtrs = &ScheduleServiceMockScheduleMessageParamPtrs{}
+	}
+	mmScheduleMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmScheduleMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for ScheduleService.ScheduleMessage
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) ExpectParamsParam2(params model.ScheduleMessageParams) *mScheduleServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ScheduleServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ScheduleServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.params != nil {
+		mmScheduleMessage.mock.t.Fatalf("ScheduleServiceMock.ScheduleMessage mock is already set by Expect")
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs == nil {
+		mmScheduleMessage.defaultExpectation.paramPtrs = &ScheduleServiceMockScheduleMessageParamPtrs{}
+	}
+	mmScheduleMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmScheduleMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduleService.ScheduleMessage
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) Inspect(f func(ctx context.Context, params model.ScheduleMessageParams)) *mScheduleServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.inspectFuncScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("Inspect function is already set for ScheduleServiceMock.ScheduleMessage")
+	}
+
+	mmScheduleMessage.mock.inspectFuncScheduleMessage = f
+
+	return mmScheduleMessage
+}
+
+// Return sets up results that will be returned by ScheduleService.ScheduleMessage
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) Return(scheduledMessageID int64, err error) *ScheduleServiceMock {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ScheduleServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ScheduleServiceMockScheduleMessageExpectation{mock: mmScheduleMessage.mock}
+	}
+	mmScheduleMessage.defaultExpectation.results = &ScheduleServiceMockScheduleMessageResults{scheduledMessageID, err}
+	return mmScheduleMessage.mock
+}
+
+// Set uses given function f to mock the ScheduleService.ScheduleMessage method
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) Set(f func(ctx context.Context, params model.ScheduleMessageParams) (scheduledMessageID int64, err error)) *ScheduleServiceMock {
+	if mmScheduleMessage.defaultExpectation != nil {
+		mmScheduleMessage.mock.t.Fatalf("Default expectation is already set for the ScheduleService.ScheduleMessage method")
+	}
+
+	if len(mmScheduleMessage.expectations) > 0 {
+		mmScheduleMessage.mock.t.Fatalf("Some expectations are already set for the ScheduleService.ScheduleMessage method")
+	}
+
+	mmScheduleMessage.mock.funcScheduleMessage = f
+	return mmScheduleMessage.mock
+}
+
+// When sets expectation for the ScheduleService.ScheduleMessage which will trigger the result defined by the following
+// Then helper
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) When(ctx context.Context, params model.ScheduleMessageParams) *ScheduleServiceMockScheduleMessageExpectation {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ScheduleServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	expectation := &ScheduleServiceMockScheduleMessageExpectation{
+		mock:   mmScheduleMessage.mock,
+		params: &ScheduleServiceMockScheduleMessageParams{ctx, params},
+	}
+	mmScheduleMessage.expectations = append(mmScheduleMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduleService.ScheduleMessage return parameters for the expectation previously defined by the When method
+func (e *ScheduleServiceMockScheduleMessageExpectation) Then(scheduledMessageID int64, err error) *ScheduleServiceMock {
+	e.results = &ScheduleServiceMockScheduleMessageResults{scheduledMessageID, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduleService.ScheduleMessage should be invoked
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) Times(n uint64) *mScheduleServiceMockScheduleMessage {
+	if n == 0 {
+		mmScheduleMessage.mock.t.Fatalf("Times of ScheduleServiceMock.ScheduleMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmScheduleMessage.expectedInvocations, n)
+	return mmScheduleMessage
+}
+
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) invocationsDone() bool {
+	if len(mmScheduleMessage.expectations) == 0 && mmScheduleMessage.defaultExpectation == nil && mmScheduleMessage.mock.funcScheduleMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmScheduleMessage.mock.afterScheduleMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmScheduleMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ScheduleMessage implements service.ScheduleService
+func (mmScheduleMessage *ScheduleServiceMock) ScheduleMessage(ctx context.Context, params model.ScheduleMessageParams) (scheduledMessageID int64, err error) {
+	mm_atomic.AddUint64(&mmScheduleMessage.beforeScheduleMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmScheduleMessage.afterScheduleMessageCounter, 1)
+
+	if mmScheduleMessage.inspectFuncScheduleMessage != nil {
+		mmScheduleMessage.inspectFuncScheduleMessage(ctx, params)
+	}
+
+	mm_params := ScheduleServiceMockScheduleMessageParams{ctx, params}
+
+	// Record call args
+	mmScheduleMessage.ScheduleMessageMock.mutex.Lock()
+	mmScheduleMessage.ScheduleMessageMock.callArgs = append(mmScheduleMessage.ScheduleMessageMock.callArgs, &mm_params)
+	mmScheduleMessage.ScheduleMessageMock.mutex.Unlock()
+
+	for _, e := range mmScheduleMessage.ScheduleMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.scheduledMessageID, e.results.err
+		}
+	}
+
+	if mmScheduleMessage.ScheduleMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScheduleMessage.ScheduleMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduleServiceMockScheduleMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmScheduleMessage.t.Errorf("ScheduleServiceMock.ScheduleMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmScheduleMessage.t.Errorf("ScheduleServiceMock.ScheduleMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScheduleMessage.t.Errorf("ScheduleServiceMock.ScheduleMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScheduleMessage.t.Fatal("No results are set for the ScheduleServiceMock.ScheduleMessage")
+		}
+		return (*mm_results).scheduledMessageID, (*mm_results).err
+	}
+	if mmScheduleMessage.funcScheduleMessage != nil {
+		return mmScheduleMessage.funcScheduleMessage(ctx, params)
+	}
+	mmScheduleMessage.t.Fatalf("Unexpected call to ScheduleServiceMock.ScheduleMessage. %v %v", ctx, params)
+	return
+}
+
+// ScheduleMessageAfterCounter returns a count of finished ScheduleServiceMock.ScheduleMessage invocations
+func (mmScheduleMessage *ScheduleServiceMock) ScheduleMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScheduleMessage.afterScheduleMessageCounter)
+}
+
+// ScheduleMessageBeforeCounter returns a count of ScheduleServiceMock.ScheduleMessage invocations
+func (mmScheduleMessage *ScheduleServiceMock) ScheduleMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScheduleMessage.beforeScheduleMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduleServiceMock.ScheduleMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScheduleMessage *mScheduleServiceMockScheduleMessage) Calls() []*ScheduleServiceMockScheduleMessageParams {
+	mmScheduleMessage.mutex.RLock()
+
+	argCopy := make([]*ScheduleServiceMockScheduleMessageParams, len(mmScheduleMessage.callArgs))
+	copy(argCopy, mmScheduleMessage.callArgs)
+
+	mmScheduleMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScheduleMessageDone returns true if the count of the ScheduleMessage invocations corresponds
+// the number of defined expectations
+func (m *ScheduleServiceMock) MinimockScheduleMessageDone() bool {
+	if m.ScheduleMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ScheduleMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ScheduleMessageMock.invocationsDone()
+}
+
+// MinimockScheduleMessageInspect logs each unmet expectation
+func (m *ScheduleServiceMock) MinimockScheduleMessageInspect() {
+	for _, e := range m.ScheduleMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduleServiceMock.ScheduleMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterScheduleMessageCounter := mm_atomic.LoadUint64(&m.afterScheduleMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScheduleMessageMock.defaultExpectation != nil && afterScheduleMessageCounter < 1 {
+		if m.ScheduleMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ScheduleServiceMock.ScheduleMessage")
+		} else {
+			m.t.Errorf("Expected call to ScheduleServiceMock.ScheduleMessage with params: %#v", *m.ScheduleMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScheduleMessage != nil && afterScheduleMessageCounter < 1 {
+		m.t.Error("Expected call to ScheduleServiceMock.ScheduleMessage")
+	}
+
+	if !m.ScheduleMessageMock.invocationsDone() && afterScheduleMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduleServiceMock.ScheduleMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.ScheduleMessageMock.expectedInvocations), afterScheduleMessageCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ScheduleServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCancelScheduledMessageInspect()
+
+			m.MinimockListScheduledMessagesInspect()
+
+			m.MinimockScheduleMessageInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ScheduleServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ScheduleServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCancelScheduledMessageDone() &&
+		m.MinimockListScheduledMessagesDone() &&
+		m.MinimockScheduleMessageDone()
+}
@@ -0,0 +1,395 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.SubscriptionService -o subscription_service_minimock.go -n SubscriptionServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// SubscriptionServiceMock implements service.SubscriptionService
+type SubscriptionServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSubscribe          func(ctx context.Context, params model.SubscribeParams) (updates <-chan model.ChatUpdate, err error)
+	inspectFuncSubscribe   func(ctx context.Context, params model.SubscribeParams)
+	afterSubscribeCounter  uint64
+	beforeSubscribeCounter uint64
+	SubscribeMock          mSubscriptionServiceMockSubscribe
+}
+
+// NewSubscriptionServiceMock returns a mock for service.SubscriptionService
+func NewSubscriptionServiceMock(t minimock.Tester) *SubscriptionServiceMock {
+	m := &SubscriptionServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SubscribeMock = mSubscriptionServiceMockSubscribe{mock: m}
+	m.SubscribeMock.callArgs = []*SubscriptionServiceMockSubscribeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSubscriptionServiceMockSubscribe struct {
+	optional           bool
+	mock               *SubscriptionServiceMock
+	defaultExpectation *SubscriptionServiceMockSubscribeExpectation
+	expectations       []*SubscriptionServiceMockSubscribeExpectation
+
+	callArgs []*SubscriptionServiceMockSubscribeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// SubscriptionServiceMockSubscribeExpectation specifies expectation struct of the SubscriptionService.Subscribe
+type SubscriptionServiceMockSubscribeExpectation struct {
+	mock      *SubscriptionServiceMock
+	params    *SubscriptionServiceMockSubscribeParams
+	paramPtrs *SubscriptionServiceMockSubscribeParamPtrs
+	results   *SubscriptionServiceMockSubscribeResults
+	Counter   uint64
+}
+
+// SubscriptionServiceMockSubscribeParams contains parameters of the SubscriptionService.Subscribe
+type SubscriptionServiceMockSubscribeParams struct {
+	ctx    context.Context
+	params model.SubscribeParams
+}
+
+// SubscriptionServiceMockSubscribeParamPtrs contains pointers to parameters of the SubscriptionService.Subscribe
+type SubscriptionServiceMockSubscribeParamPtrs struct {
+	ctx    *context.Context
+	params *model.SubscribeParams
+}
+
+// SubscriptionServiceMockSubscribeResults contains results of the SubscriptionService.Subscribe
+type SubscriptionServiceMockSubscribeResults struct {
+	updates <-chan model.ChatUpdate
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) Optional() *mSubscriptionServiceMockSubscribe {
+	mmSubscribe.optional = true
+	return mmSubscribe
+}
+
+// Expect sets up expected params for SubscriptionService.Subscribe
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) Expect(ctx context.Context, params model.SubscribeParams) *mSubscriptionServiceMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("SubscriptionServiceMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &SubscriptionServiceMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs != nil {
+		mmSubscribe.mock.t.Fatalf("SubscriptionServiceMock.Subscribe mock is already set by ExpectParams functions")
+	}
+
+	mmSubscribe.defaultExpectation.params = &SubscriptionServiceMockSubscribeParams{ctx, params}
+	for _, e := range mmSubscribe.expectations {
+		if minimock.Equal(e.params, mmSubscribe.defaultExpectation.params) {
+			mmSubscribe.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSubscribe.defaultExpectation.params)
+		}
+	}
+
+	return mmSubscribe
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SubscriptionService.Subscribe
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) ExpectCtxParam1(ctx context.Context) *mSubscriptionServiceMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("SubscriptionServiceMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &SubscriptionServiceMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.params != nil {
+		mmSubscribe.mock.t.Fatalf("SubscriptionServiceMock.Subscribe mock is already set by Expect")
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs == nil {
+		mmSubscribe.defaultExpectation.paramPtrs = &SubscriptionServiceMockSubscribeParamPtrs{}
+	}
+	mmSubscribe.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSubscribe
+}
+
+// ExpectParamsParam2 sets up expected param params for SubscriptionService.Subscribe
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) ExpectParamsParam2(params model.SubscribeParams) *mSubscriptionServiceMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("SubscriptionServiceMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &SubscriptionServiceMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.params != nil {
+		mmSubscribe.mock.t.Fatalf("SubscriptionServiceMock.Subscribe mock is already set by Expect")
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs == nil {
+		mmSubscribe.defaultExpectation.paramPtrs = &SubscriptionServiceMockSubscribeParamPtrs{}
+	}
+	mmSubscribe.defaultExpectation.paramPtrs.params = &params
+
+	return mmSubscribe
+}
+
+// Inspect accepts an inspector function that has same arguments as the SubscriptionService.Subscribe
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) Inspect(f func(ctx context.Context, params model.SubscribeParams)) *mSubscriptionServiceMockSubscribe {
+	if mmSubscribe.mock.inspectFuncSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("Inspect function is already set for SubscriptionServiceMock.Subscribe")
+	}
+
+	mmSubscribe.mock.inspectFuncSubscribe = f
+
+	return mmSubscribe
+}
+
+// Return sets up results that will be returned by SubscriptionService.Subscribe
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) Return(updates <-chan model.ChatUpdate, err error) *SubscriptionServiceMock {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("SubscriptionServiceMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &SubscriptionServiceMockSubscribeExpectation{mock: mmSubscribe.mock}
+	}
+	mmSubscribe.defaultExpectation.results = &SubscriptionServiceMockSubscribeResults{updates, err}
+	return mmSubscribe.mock
+}
+
+// Set uses given function f to mock the SubscriptionService.Subscribe method
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) Set(f func(ctx context.Context, params model.SubscribeParams) (updates <-chan model.ChatUpdate, err error)) *SubscriptionServiceMock {
+	if mmSubscribe.defaultExpectation != nil {
+		mmSubscribe.mock.t.Fatalf("Default expectation is already set for the SubscriptionService.Subscribe method")
+	}
+
+	if len(mmSubscribe.expectations) > 0 {
+		mmSubscribe.mock.t.Fatalf("Some expectations are already set for the SubscriptionService.Subscribe method")
+	}
+
+	mmSubscribe.mock.funcSubscribe = f
+	return mmSubscribe.mock
+}
+
+// When sets expectation for the SubscriptionService.Subscribe which will trigger the result defined by the following
+// Then helper
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) When(ctx context.Context, params model.SubscribeParams) *SubscriptionServiceMockSubscribeExpectation {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("SubscriptionServiceMock.Subscribe mock is already set by Set")
+	}
+
+	expectation := &SubscriptionServiceMockSubscribeExpectation{
+		mock:   mmSubscribe.mock,
+		params: &SubscriptionServiceMockSubscribeParams{ctx, params},
+	}
+	mmSubscribe.expectations = append(mmSubscribe.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SubscriptionService.Subscribe return parameters for the expectation previously defined by the When method
+func (e *SubscriptionServiceMockSubscribeExpectation) Then(updates <-chan model.ChatUpdate, err error) *SubscriptionServiceMock {
+	e.results = &SubscriptionServiceMockSubscribeResults{updates, err}
+	return e.mock
+}
+
+// Times sets number of times SubscriptionService.Subscribe should be invoked
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) Times(n uint64) *mSubscriptionServiceMockSubscribe {
+	if n == 0 {
+		mmSubscribe.mock.t.Fatalf("Times of SubscriptionServiceMock.Subscribe mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSubscribe.expectedInvocations, n)
+	return mmSubscribe
+}
+
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) invocationsDone() bool {
+	if len(mmSubscribe.expectations) == 0 && mmSubscribe.defaultExpectation == nil && mmSubscribe.mock.funcSubscribe == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSubscribe.mock.afterSubscribeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSubscribe.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Subscribe implements service.SubscriptionService
+func (mmSubscribe *SubscriptionServiceMock) Subscribe(ctx context.Context, params model.SubscribeParams) (updates <-chan model.ChatUpdate, err error) {
+	mm_atomic.AddUint64(&mmSubscribe.beforeSubscribeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSubscribe.afterSubscribeCounter, 1)
+
+	if mmSubscribe.inspectFuncSubscribe != nil {
+		mmSubscribe.inspectFuncSubscribe(ctx, params)
+	}
+
+	mm_params := SubscriptionServiceMockSubscribeParams{ctx, params}
+
+	// Record call args
+	mmSubscribe.SubscribeMock.mutex.Lock()
+	mmSubscribe.SubscribeMock.callArgs = append(mmSubscribe.SubscribeMock.callArgs, &mm_params)
+	mmSubscribe.SubscribeMock.mutex.Unlock()
+
+	for _, e := range mmSubscribe.SubscribeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.updates, e.results.err
+		}
+	}
+
+	if mmSubscribe.SubscribeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSubscribe.SubscribeMock.defaultExpectation.Counter, 1)
+		mm_want := mmSubscribe.SubscribeMock.defaultExpectation.params
+		mm_want_ptrs := mmSubscribe.SubscribeMock.defaultExpectation.paramPtrs
+
+		mm_got := SubscriptionServiceMockSubscribeParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSubscribe.t.Errorf("SubscriptionServiceMock.Subscribe got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmSubscribe.t.Errorf("SubscriptionServiceMock.Subscribe got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSubscribe.t.Errorf("SubscriptionServiceMock.Subscribe got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSubscribe.SubscribeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSubscribe.t.Fatal("No results are set for the SubscriptionServiceMock.Subscribe")
+		}
+		return (*mm_results).updates, (*mm_results).err
+	}
+	if mmSubscribe.funcSubscribe != nil {
+		return mmSubscribe.funcSubscribe(ctx, params)
+	}
+	mmSubscribe.t.Fatalf("Unexpected call to SubscriptionServiceMock.Subscribe. %v %v", ctx, params)
+	return
+}
+
+// SubscribeAfterCounter returns a count of finished SubscriptionServiceMock.Subscribe invocations
+func (mmSubscribe *SubscriptionServiceMock) SubscribeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubscribe.afterSubscribeCounter)
+}
+
+// SubscribeBeforeCounter returns a count of SubscriptionServiceMock.Subscribe invocations
+func (mmSubscribe *SubscriptionServiceMock) SubscribeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubscribe.beforeSubscribeCounter)
+}
+
+// Calls returns a list of arguments used in each call to SubscriptionServiceMock.Subscribe.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSubscribe *mSubscriptionServiceMockSubscribe) Calls() []*SubscriptionServiceMockSubscribeParams {
+	mmSubscribe.mutex.RLock()
+
+	argCopy := make([]*SubscriptionServiceMockSubscribeParams, len(mmSubscribe.callArgs))
+	copy(argCopy, mmSubscribe.callArgs)
+
+	mmSubscribe.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSubscribeDone returns true if the count of the Subscribe invocations corresponds
+// the number of defined expectations
+func (m *SubscriptionServiceMock) MinimockSubscribeDone() bool {
+	if m.SubscribeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SubscribeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SubscribeMock.invocationsDone()
+}
+
+// MinimockSubscribeInspect logs each unmet expectation
+func (m *SubscriptionServiceMock) MinimockSubscribeInspect() {
+	for _, e := range m.SubscribeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SubscriptionServiceMock.Subscribe with params: %#v", *e.params)
+		}
+	}
+
+	afterSubscribeCounter := mm_atomic.LoadUint64(&m.afterSubscribeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SubscribeMock.defaultExpectation != nil && afterSubscribeCounter < 1 {
+		if m.SubscribeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SubscriptionServiceMock.Subscribe")
+		} else {
+			m.t.Errorf("Expected call to SubscriptionServiceMock.Subscribe with params: %#v", *m.SubscribeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSubscribe != nil && afterSubscribeCounter < 1 {
+		m.t.Error("Expected call to SubscriptionServiceMock.Subscribe")
+	}
+
+	if !m.SubscribeMock.invocationsDone() && afterSubscribeCounter > 0 {
+		m.t.Errorf("Expected %d calls to SubscriptionServiceMock.Subscribe but found %d calls",
+			mm_atomic.LoadUint64(&m.SubscribeMock.expectedInvocations), afterSubscribeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SubscriptionServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSubscribeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SubscriptionServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SubscriptionServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSubscribeDone()
+}
@@ -0,0 +1,1061 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.UserService -o user_service_minimock.go -n UserServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// UserServiceMock implements service.UserService
+type UserServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetUser          func(ctx context.Context, params model.GetUserParams) (user model.User, err error)
+	inspectFuncGetUser   func(ctx context.Context, params model.GetUserParams)
+	afterGetUserCounter  uint64
+	beforeGetUserCounter uint64
+	GetUserMock          mUserServiceMockGetUser
+
+	funcSearchUsers          func(ctx context.Context, params model.SearchUsersParams) (users []model.User, err error)
+	inspectFuncSearchUsers   func(ctx context.Context, params model.SearchUsersParams)
+	afterSearchUsersCounter  uint64
+	beforeSearchUsersCounter uint64
+	SearchUsersMock          mUserServiceMockSearchUsers
+
+	funcUpdateProfile          func(ctx context.Context, params model.UpdateProfileParams) (user model.User, err error)
+	inspectFuncUpdateProfile   func(ctx context.Context, params model.UpdateProfileParams)
+	afterUpdateProfileCounter  uint64
+	beforeUpdateProfileCounter uint64
+	UpdateProfileMock          mUserServiceMockUpdateProfile
+}
+
+// NewUserServiceMock returns a mock for service.UserService
+func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
+	m := &UserServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetUserMock = mUserServiceMockGetUser{mock: m}
+	m.GetUserMock.callArgs = []*UserServiceMockGetUserParams{}
+
+	m.SearchUsersMock = mUserServiceMockSearchUsers{mock: m}
+	m.SearchUsersMock.callArgs = []*UserServiceMockSearchUsersParams{}
+
+	m.UpdateProfileMock = mUserServiceMockUpdateProfile{mock: m}
+	m.UpdateProfileMock.callArgs = []*UserServiceMockUpdateProfileParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUserServiceMockGetUser struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockGetUserExpectation
+	expectations       []*UserServiceMockGetUserExpectation
+
+	callArgs []*UserServiceMockGetUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserServiceMockGetUserExpectation specifies expectation struct of the UserService.GetUser
+type UserServiceMockGetUserExpectation struct {
+	mock      *UserServiceMock
+	params    *UserServiceMockGetUserParams
+	paramPtrs *UserServiceMockGetUserParamPtrs
+	results   *UserServiceMockGetUserResults
+	Counter   uint64
+}
+
+// UserServiceMockGetUserParams contains parameters of the UserService.GetUser
+type UserServiceMockGetUserParams struct {
+	ctx    context.Context
+	params model.GetUserParams
+}
+
+// UserServiceMockGetUserParamPtrs contains pointers to parameters of the UserService.GetUser
+type UserServiceMockGetUserParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetUserParams
+}
+
+// UserServiceMockGetUserResults contains results of the UserService.GetUser
+type UserServiceMockGetUserResults struct {
+	user model.User
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUser *mUserServiceMockGetUser) Optional() *mUserServiceMockGetUser {
+	mmGetUser.optional = true
+	return mmGetUser
+}
+
+// Expect sets up expected params for UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) Expect(ctx context.Context, params model.GetUserParams) *mUserServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by ExpectParams functions")
+	}
+
+	mmGetUser.defaultExpectation.params = &UserServiceMockGetUserParams{ctx, params}
+	for _, e := range mmGetUser.expectations {
+		if minimock.Equal(e.params, mmGetUser.defaultExpectation.params) {
+			mmGetUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUser.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserServiceMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetUser
+}
+
+// ExpectParamsParam2 sets up expected param params for UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) ExpectParamsParam2(params model.GetUserParams) *mUserServiceMockGetUser {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{}
+	}
+
+	if mmGetUser.defaultExpectation.params != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Expect")
+	}
+
+	if mmGetUser.defaultExpectation.paramPtrs == nil {
+		mmGetUser.defaultExpectation.paramPtrs = &UserServiceMockGetUserParamPtrs{}
+	}
+	mmGetUser.defaultExpectation.paramPtrs.params = &params
+
+	return mmGetUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) Inspect(f func(ctx context.Context, params model.GetUserParams)) *mUserServiceMockGetUser {
+	if mmGetUser.mock.inspectFuncGetUser != nil {
+		mmGetUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.GetUser")
+	}
+
+	mmGetUser.mock.inspectFuncGetUser = f
+
+	return mmGetUser
+}
+
+// Return sets up results that will be returned by UserService.GetUser
+func (mmGetUser *mUserServiceMockGetUser) Return(user model.User, err error) *UserServiceMock {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	if mmGetUser.defaultExpectation == nil {
+		mmGetUser.defaultExpectation = &UserServiceMockGetUserExpectation{mock: mmGetUser.mock}
+	}
+	mmGetUser.defaultExpectation.results = &UserServiceMockGetUserResults{user, err}
+	return mmGetUser.mock
+}
+
+// Set uses given function f to mock the UserService.GetUser method
+func (mmGetUser *mUserServiceMockGetUser) Set(f func(ctx context.Context, params model.GetUserParams) (user model.User, err error)) *UserServiceMock {
+	if mmGetUser.defaultExpectation != nil {
+		mmGetUser.mock.t.Fatalf("Default expectation is already set for the UserService.GetUser method")
+	}
+
+	if len(mmGetUser.expectations) > 0 {
+		mmGetUser.mock.t.Fatalf("Some expectations are already set for the UserService.GetUser method")
+	}
+
+	mmGetUser.mock.funcGetUser = f
+	return mmGetUser.mock
+}
+
+// When sets expectation for the UserService.GetUser which will trigger the result defined by the following
+// Then helper
+func (mmGetUser *mUserServiceMockGetUser) When(ctx context.Context, params model.GetUserParams) *UserServiceMockGetUserExpectation {
+	if mmGetUser.mock.funcGetUser != nil {
+		mmGetUser.mock.t.Fatalf("UserServiceMock.GetUser mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockGetUserExpectation{
+		mock:   mmGetUser.mock,
+		params: &UserServiceMockGetUserParams{ctx, params},
+	}
+	mmGetUser.expectations = append(mmGetUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.GetUser return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockGetUserExpectation) Then(user model.User, err error) *UserServiceMock {
+	e.results = &UserServiceMockGetUserResults{user, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.GetUser should be invoked
+func (mmGetUser *mUserServiceMockGetUser) Times(n uint64) *mUserServiceMockGetUser {
+	if n == 0 {
+		mmGetUser.mock.t.Fatalf("Times of UserServiceMock.GetUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUser.expectedInvocations, n)
+	return mmGetUser
+}
+
+func (mmGetUser *mUserServiceMockGetUser) invocationsDone() bool {
+	if len(mmGetUser.expectations) == 0 && mmGetUser.defaultExpectation == nil && mmGetUser.mock.funcGetUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUser.mock.afterGetUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUser implements service.UserService
+func (mmGetUser *UserServiceMock) GetUser(ctx context.Context, params model.GetUserParams) (user model.User, err error) {
+	mm_atomic.AddUint64(&mmGetUser.beforeGetUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUser.afterGetUserCounter, 1)
+
+	if mmGetUser.inspectFuncGetUser != nil {
+		mmGetUser.inspectFuncGetUser(ctx, params)
+	}
+
+	mm_params := UserServiceMockGetUserParams{ctx, params}
+
+	// Record call args
+	mmGetUser.GetUserMock.mutex.Lock()
+	mmGetUser.GetUserMock.callArgs = append(mmGetUser.GetUserMock.callArgs, &mm_params)
+	mmGetUser.GetUserMock.mutex.Unlock()
+
+	for _, e := range mmGetUser.GetUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.user, e.results.err
+		}
+	}
+
+	if mmGetUser.GetUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUser.GetUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUser.GetUserMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUser.GetUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockGetUserParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUser.t.Errorf("UserServiceMock.GetUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUser.GetUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUser.t.Fatal("No results are set for the UserServiceMock.GetUser")
+		}
+		return (*mm_results).user, (*mm_results).err
+	}
+	if mmGetUser.funcGetUser != nil {
+		return mmGetUser.funcGetUser(ctx, params)
+	}
+	mmGetUser.t.Fatalf("Unexpected call to UserServiceMock.GetUser. %v %v", ctx, params)
+	return
+}
+
+// GetUserAfterCounter returns a count of finished UserServiceMock.GetUser invocations
+func (mmGetUser *UserServiceMock) GetUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.afterGetUserCounter)
+}
+
+// GetUserBeforeCounter returns a count of UserServiceMock.GetUser invocations
+func (mmGetUser *UserServiceMock) GetUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUser.beforeGetUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.GetUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUser *mUserServiceMockGetUser) Calls() []*UserServiceMockGetUserParams {
+	mmGetUser.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockGetUserParams, len(mmGetUser.callArgs))
+	copy(argCopy, mmGetUser.callArgs)
+
+	mmGetUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserDone returns true if the count of the GetUser invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockGetUserDone() bool {
+	if m.GetUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserMock.invocationsDone()
+}
+
+// MinimockGetUserInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockGetUserInspect() {
+	for _, e := range m.GetUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.GetUser with params: %#v", *e.params)
+		}
+	}
+
+	afterGetUserCounter := mm_atomic.LoadUint64(&m.afterGetUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserMock.defaultExpectation != nil && afterGetUserCounter < 1 {
+		if m.GetUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.GetUser")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.GetUser with params: %#v", *m.GetUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUser != nil && afterGetUserCounter < 1 {
+		m.t.Error("Expected call to UserServiceMock.GetUser")
+	}
+
+	if !m.GetUserMock.invocationsDone() && afterGetUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.GetUser but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserMock.expectedInvocations), afterGetUserCounter)
+	}
+}
+
+type mUserServiceMockSearchUsers struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockSearchUsersExpectation
+	expectations       []*UserServiceMockSearchUsersExpectation
+
+	callArgs []*UserServiceMockSearchUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserServiceMockSearchUsersExpectation specifies expectation struct of the UserService.SearchUsers
+type UserServiceMockSearchUsersExpectation struct {
+	mock      *UserServiceMock
+	params    *UserServiceMockSearchUsersParams
+	paramPtrs *UserServiceMockSearchUsersParamPtrs
+	results   *UserServiceMockSearchUsersResults
+	Counter   uint64
+}
+
+// UserServiceMockSearchUsersParams contains parameters of the UserService.SearchUsers
+type UserServiceMockSearchUsersParams struct {
+	ctx    context.Context
+	params model.SearchUsersParams
+}
+
+// UserServiceMockSearchUsersParamPtrs contains pointers to parameters of the UserService.SearchUsers
+type UserServiceMockSearchUsersParamPtrs struct {
+	ctx    *context.Context
+	params *model.SearchUsersParams
+}
+
+// UserServiceMockSearchUsersResults contains results of the UserService.SearchUsers
+type UserServiceMockSearchUsersResults struct {
+	users []model.User
+	err   error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchUsers *mUserServiceMockSearchUsers) Optional() *mUserServiceMockSearchUsers {
+	mmSearchUsers.optional = true
+	return mmSearchUsers
+}
+
+// Expect sets up expected params for UserService.SearchUsers
+func (mmSearchUsers *mUserServiceMockSearchUsers) Expect(ctx context.Context, params model.SearchUsersParams) *mUserServiceMockSearchUsers {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by Set")
+	}
+
+	if mmSearchUsers.defaultExpectation == nil {
+		mmSearchUsers.defaultExpectation = &UserServiceMockSearchUsersExpectation{}
+	}
+
+	if mmSearchUsers.defaultExpectation.paramPtrs != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by ExpectParams functions")
+	}
+
+	mmSearchUsers.defaultExpectation.params = &UserServiceMockSearchUsersParams{ctx, params}
+	for _, e := range mmSearchUsers.expectations {
+		if minimock.Equal(e.params, mmSearchUsers.defaultExpectation.params) {
+			mmSearchUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.SearchUsers
+func (mmSearchUsers *mUserServiceMockSearchUsers) ExpectCtxParam1(ctx context.Context) *mUserServiceMockSearchUsers {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by Set")
+	}
+
+	if mmSearchUsers.defaultExpectation == nil {
+		mmSearchUsers.defaultExpectation = &UserServiceMockSearchUsersExpectation{}
+	}
+
+	if mmSearchUsers.defaultExpectation.params != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by Expect")
+	}
+
+	if mmSearchUsers.defaultExpectation.paramPtrs == nil {
+		mmSearchUsers.defaultExpectation.paramPtrs = &UserServiceMockSearchUsersParamPtrs{}
+	}
+	mmSearchUsers.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSearchUsers
+}
+
+// ExpectParamsParam2 sets up expected param params for UserService.SearchUsers
+func (mmSearchUsers *mUserServiceMockSearchUsers) ExpectParamsParam2(params model.SearchUsersParams) *mUserServiceMockSearchUsers {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by Set")
+	}
+
+	if mmSearchUsers.defaultExpectation == nil {
+		mmSearchUsers.defaultExpectation = &UserServiceMockSearchUsersExpectation{}
+	}
+
+	if mmSearchUsers.defaultExpectation.params != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by Expect")
+	}
+
+	if mmSearchUsers.defaultExpectation.paramPtrs == nil {
+		mmSearchUsers.defaultExpectation.paramPtrs = &UserServiceMockSearchUsersParamPtrs{}
+	}
+	mmSearchUsers.defaultExpectation.paramPtrs.params = &params
+
+	return mmSearchUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.SearchUsers
+func (mmSearchUsers *mUserServiceMockSearchUsers) Inspect(f func(ctx context.Context, params model.SearchUsersParams)) *mUserServiceMockSearchUsers {
+	if mmSearchUsers.mock.inspectFuncSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.SearchUsers")
+	}
+
+	mmSearchUsers.mock.inspectFuncSearchUsers = f
+
+	return mmSearchUsers
+}
+
+// Return sets up results that will be returned by UserService.SearchUsers
+func (mmSearchUsers *mUserServiceMockSearchUsers) Return(users []model.User, err error) *UserServiceMock {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by Set")
+	}
+
+	if mmSearchUsers.defaultExpectation == nil {
+		mmSearchUsers.defaultExpectation = &UserServiceMockSearchUsersExpectation{mock: mmSearchUsers.mock}
+	}
+	mmSearchUsers.defaultExpectation.results = &UserServiceMockSearchUsersResults{users, err}
+	return mmSearchUsers.mock
+}
+
+// Set uses given function f to mock the UserService.SearchUsers method
+func (mmSearchUsers *mUserServiceMockSearchUsers) Set(f func(ctx context.Context, params model.SearchUsersParams) (users []model.User, err error)) *UserServiceMock {
+	if mmSearchUsers.defaultExpectation != nil {
+		mmSearchUsers.mock.t.Fatalf("Default expectation is already set for the UserService.SearchUsers method")
+	}
+
+	if len(mmSearchUsers.expectations) > 0 {
+		mmSearchUsers.mock.t.Fatalf("Some expectations are already set for the UserService.SearchUsers method")
+	}
+
+	mmSearchUsers.mock.funcSearchUsers = f
+	return mmSearchUsers.mock
+}
+
+// When sets expectation for the UserService.SearchUsers which will trigger the result defined by the following
+// Then helper
+func (mmSearchUsers *mUserServiceMockSearchUsers) When(ctx context.Context, params model.SearchUsersParams) *UserServiceMockSearchUsersExpectation {
+	if mmSearchUsers.mock.funcSearchUsers != nil {
+		mmSearchUsers.mock.t.Fatalf("UserServiceMock.SearchUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockSearchUsersExpectation{
+		mock:   mmSearchUsers.mock,
+		params: &UserServiceMockSearchUsersParams{ctx, params},
+	}
+	mmSearchUsers.expectations = append(mmSearchUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.SearchUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockSearchUsersExpectation) Then(users []model.User, err error) *UserServiceMock {
+	e.results = &UserServiceMockSearchUsersResults{users, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.SearchUsers should be invoked
+func (mmSearchUsers *mUserServiceMockSearchUsers) Times(n uint64) *mUserServiceMockSearchUsers {
+	if n == 0 {
+		mmSearchUsers.mock.t.Fatalf("Times of UserServiceMock.SearchUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchUsers.expectedInvocations, n)
+	return mmSearchUsers
+}
+
+func (mmSearchUsers *mUserServiceMockSearchUsers) invocationsDone() bool {
+	if len(mmSearchUsers.expectations) == 0 && mmSearchUsers.defaultExpectation == nil && mmSearchUsers.mock.funcSearchUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchUsers.mock.afterSearchUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchUsers implements service.UserService
+func (mmSearchUsers *UserServiceMock) SearchUsers(ctx context.Context, params model.SearchUsersParams) (users []model.User, err error) {
+	mm_atomic.AddUint64(&mmSearchUsers.beforeSearchUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchUsers.afterSearchUsersCounter, 1)
+
+	if mmSearchUsers.inspectFuncSearchUsers != nil {
+		mmSearchUsers.inspectFuncSearchUsers(ctx, params)
+	}
+
+	mm_params := UserServiceMockSearchUsersParams{ctx, params}
+
+	// Record call args
+	mmSearchUsers.SearchUsersMock.mutex.Lock()
+	mmSearchUsers.SearchUsersMock.callArgs = append(mmSearchUsers.SearchUsersMock.callArgs, &mm_params)
+	mmSearchUsers.SearchUsersMock.mutex.Unlock()
+
+	for _, e := range mmSearchUsers.SearchUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.users, e.results.err
+		}
+	}
+
+	if mmSearchUsers.SearchUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchUsers.SearchUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchUsers.SearchUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchUsers.SearchUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockSearchUsersParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchUsers.t.Errorf("UserServiceMock.SearchUsers got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmSearchUsers.t.Errorf("UserServiceMock.SearchUsers got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchUsers.t.Errorf("UserServiceMock.SearchUsers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchUsers.SearchUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchUsers.t.Fatal("No results are set for the UserServiceMock.SearchUsers")
+		}
+		return (*mm_results).users, (*mm_results).err
+	}
+	if mmSearchUsers.funcSearchUsers != nil {
+		return mmSearchUsers.funcSearchUsers(ctx, params)
+	}
+	mmSearchUsers.t.Fatalf("Unexpected call to UserServiceMock.SearchUsers. %v %v", ctx, params)
+	return
+}
+
+// SearchUsersAfterCounter returns a count of finished UserServiceMock.SearchUsers invocations
+func (mmSearchUsers *UserServiceMock) SearchUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchUsers.afterSearchUsersCounter)
+}
+
+// SearchUsersBeforeCounter returns a count of UserServiceMock.SearchUsers invocations
+func (mmSearchUsers *UserServiceMock) SearchUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchUsers.beforeSearchUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.SearchUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchUsers *mUserServiceMockSearchUsers) Calls() []*UserServiceMockSearchUsersParams {
+	mmSearchUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockSearchUsersParams, len(mmSearchUsers.callArgs))
+	copy(argCopy, mmSearchUsers.callArgs)
+
+	mmSearchUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchUsersDone returns true if the count of the SearchUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockSearchUsersDone() bool {
+	if m.SearchUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchUsersMock.invocationsDone()
+}
+
+// MinimockSearchUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockSearchUsersInspect() {
+	for _, e := range m.SearchUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.SearchUsers with params: %#v", *e.params)
+		}
+	}
+
+	afterSearchUsersCounter := mm_atomic.LoadUint64(&m.afterSearchUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchUsersMock.defaultExpectation != nil && afterSearchUsersCounter < 1 {
+		if m.SearchUsersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.SearchUsers")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.SearchUsers with params: %#v", *m.SearchUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchUsers != nil && afterSearchUsersCounter < 1 {
+		m.t.Error("Expected call to UserServiceMock.SearchUsers")
+	}
+
+	if !m.SearchUsersMock.invocationsDone() && afterSearchUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.SearchUsers but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchUsersMock.expectedInvocations), afterSearchUsersCounter)
+	}
+}
+
+type mUserServiceMockUpdateProfile struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockUpdateProfileExpectation
+	expectations       []*UserServiceMockUpdateProfileExpectation
+
+	callArgs []*UserServiceMockUpdateProfileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserServiceMockUpdateProfileExpectation specifies expectation struct of the UserService.UpdateProfile
+type UserServiceMockUpdateProfileExpectation struct {
+	mock      *UserServiceMock
+	params    *UserServiceMockUpdateProfileParams
+	paramPtrs *UserServiceMockUpdateProfileParamPtrs
+	results   *UserServiceMockUpdateProfileResults
+	Counter   uint64
+}
+
+// UserServiceMockUpdateProfileParams contains parameters of the UserService.UpdateProfile
+type UserServiceMockUpdateProfileParams struct {
+	ctx    context.Context
+	params model.UpdateProfileParams
+}
+
+// UserServiceMockUpdateProfileParamPtrs contains pointers to parameters of the UserService.UpdateProfile
+type UserServiceMockUpdateProfileParamPtrs struct {
+	ctx    *context.Context
+	params *model.UpdateProfileParams
+}
+
+// UserServiceMockUpdateProfileResults contains results of the UserService.UpdateProfile
+type UserServiceMockUpdateProfileResults struct {
+	user model.User
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) Optional() *mUserServiceMockUpdateProfile {
+	mmUpdateProfile.optional = true
+	return mmUpdateProfile
+}
+
+// Expect sets up expected params for UserService.UpdateProfile
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) Expect(ctx context.Context, params model.UpdateProfileParams) *mUserServiceMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserServiceMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &UserServiceMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserServiceMock.UpdateProfile mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateProfile.defaultExpectation.params = &UserServiceMockUpdateProfileParams{ctx, params}
+	for _, e := range mmUpdateProfile.expectations {
+		if minimock.Equal(e.params, mmUpdateProfile.defaultExpectation.params) {
+			mmUpdateProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateProfile.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateProfile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.UpdateProfile
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) ExpectCtxParam1(ctx context.Context) *mUserServiceMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserServiceMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &UserServiceMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserServiceMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &UserServiceMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateProfile
+}
+
+// ExpectParamsParam2 sets up expected param params for UserService.UpdateProfile
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) ExpectParamsParam2(params model.UpdateProfileParams) *mUserServiceMockUpdateProfile {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserServiceMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &UserServiceMockUpdateProfileExpectation{}
+	}
+
+	if mmUpdateProfile.defaultExpectation.params != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserServiceMock.UpdateProfile mock is already set by Expect")
+	}
+
+	if mmUpdateProfile.defaultExpectation.paramPtrs == nil {
+		mmUpdateProfile.defaultExpectation.paramPtrs = &UserServiceMockUpdateProfileParamPtrs{}
+	}
+	mmUpdateProfile.defaultExpectation.paramPtrs.params = &params
+
+	return mmUpdateProfile
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.UpdateProfile
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) Inspect(f func(ctx context.Context, params model.UpdateProfileParams)) *mUserServiceMockUpdateProfile {
+	if mmUpdateProfile.mock.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("Inspect function is already set for UserServiceMock.UpdateProfile")
+	}
+
+	mmUpdateProfile.mock.inspectFuncUpdateProfile = f
+
+	return mmUpdateProfile
+}
+
+// Return sets up results that will be returned by UserService.UpdateProfile
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) Return(user model.User, err error) *UserServiceMock {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserServiceMock.UpdateProfile mock is already set by Set")
+	}
+
+	if mmUpdateProfile.defaultExpectation == nil {
+		mmUpdateProfile.defaultExpectation = &UserServiceMockUpdateProfileExpectation{mock: mmUpdateProfile.mock}
+	}
+	mmUpdateProfile.defaultExpectation.results = &UserServiceMockUpdateProfileResults{user, err}
+	return mmUpdateProfile.mock
+}
+
+// Set uses given function f to mock the UserService.UpdateProfile method
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) Set(f func(ctx context.Context, params model.UpdateProfileParams) (user model.User, err error)) *UserServiceMock {
+	if mmUpdateProfile.defaultExpectation != nil {
+		mmUpdateProfile.mock.t.Fatalf("Default expectation is already set for the UserService.UpdateProfile method")
+	}
+
+	if len(mmUpdateProfile.expectations) > 0 {
+		mmUpdateProfile.mock.t.Fatalf("Some expectations are already set for the UserService.UpdateProfile method")
+	}
+
+	mmUpdateProfile.mock.funcUpdateProfile = f
+	return mmUpdateProfile.mock
+}
+
+// When sets expectation for the UserService.UpdateProfile which will trigger the result defined by the following
+// Then helper
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) When(ctx context.Context, params model.UpdateProfileParams) *UserServiceMockUpdateProfileExpectation {
+	if mmUpdateProfile.mock.funcUpdateProfile != nil {
+		mmUpdateProfile.mock.t.Fatalf("UserServiceMock.UpdateProfile mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockUpdateProfileExpectation{
+		mock:   mmUpdateProfile.mock,
+		params: &UserServiceMockUpdateProfileParams{ctx, params},
+	}
+	mmUpdateProfile.expectations = append(mmUpdateProfile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.UpdateProfile return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockUpdateProfileExpectation) Then(user model.User, err error) *UserServiceMock {
+	e.results = &UserServiceMockUpdateProfileResults{user, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.UpdateProfile should be invoked
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) Times(n uint64) *mUserServiceMockUpdateProfile {
+	if n == 0 {
+		mmUpdateProfile.mock.t.Fatalf("Times of UserServiceMock.UpdateProfile mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateProfile.expectedInvocations, n)
+	return mmUpdateProfile
+}
+
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) invocationsDone() bool {
+	if len(mmUpdateProfile.expectations) == 0 && mmUpdateProfile.defaultExpectation == nil && mmUpdateProfile.mock.funcUpdateProfile == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.mock.afterUpdateProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateProfile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateProfile implements service.UserService
+func (mmUpdateProfile *UserServiceMock) UpdateProfile(ctx context.Context, params model.UpdateProfileParams) (user model.User, err error) {
+	mm_atomic.AddUint64(&mmUpdateProfile.beforeUpdateProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateProfile.afterUpdateProfileCounter, 1)
+
+	if mmUpdateProfile.inspectFuncUpdateProfile != nil {
+		mmUpdateProfile.inspectFuncUpdateProfile(ctx, params)
+	}
+
+	mm_params := UserServiceMockUpdateProfileParams{ctx, params}
+
+	// Record call args
+	mmUpdateProfile.UpdateProfileMock.mutex.Lock()
+	mmUpdateProfile.UpdateProfileMock.callArgs = append(mmUpdateProfile.UpdateProfileMock.callArgs, &mm_params)
+	mmUpdateProfile.UpdateProfileMock.mutex.Unlock()
+
+	for _, e := range mmUpdateProfile.UpdateProfileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.user, e.results.err
+		}
+	}
+
+	if mmUpdateProfile.UpdateProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateProfile.UpdateProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateProfile.UpdateProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateProfile.UpdateProfileMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockUpdateProfileParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateProfile.t.Errorf("UserServiceMock.UpdateProfile got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUpdateProfile.t.Errorf("UserServiceMock.UpdateProfile got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateProfile.t.Errorf("UserServiceMock.UpdateProfile got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateProfile.UpdateProfileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateProfile.t.Fatal("No results are set for the UserServiceMock.UpdateProfile")
+		}
+		return (*mm_results).user, (*mm_results).err
+	}
+	if mmUpdateProfile.funcUpdateProfile != nil {
+		return mmUpdateProfile.funcUpdateProfile(ctx, params)
+	}
+	mmUpdateProfile.t.Fatalf("Unexpected call to UserServiceMock.UpdateProfile. %v %v", ctx, params)
+	return
+}
+
+// UpdateProfileAfterCounter returns a count of finished UserServiceMock.UpdateProfile invocations
+func (mmUpdateProfile *UserServiceMock) UpdateProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.afterUpdateProfileCounter)
+}
+
+// UpdateProfileBeforeCounter returns a count of UserServiceMock.UpdateProfile invocations
+func (mmUpdateProfile *UserServiceMock) UpdateProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateProfile.beforeUpdateProfileCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.UpdateProfile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateProfile *mUserServiceMockUpdateProfile) Calls() []*UserServiceMockUpdateProfileParams {
+	mmUpdateProfile.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockUpdateProfileParams, len(mmUpdateProfile.callArgs))
+	copy(argCopy, mmUpdateProfile.callArgs)
+
+	mmUpdateProfile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateProfileDone returns true if the count of the UpdateProfile invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockUpdateProfileDone() bool {
+	if m.UpdateProfileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateProfileMock.invocationsDone()
+}
+
+// MinimockUpdateProfileInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockUpdateProfileInspect() {
+	for _, e := range m.UpdateProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.UpdateProfile with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateProfileCounter := mm_atomic.LoadUint64(&m.afterUpdateProfileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateProfileMock.defaultExpectation != nil && afterUpdateProfileCounter < 1 {
+		if m.UpdateProfileMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.UpdateProfile")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.UpdateProfile with params: %#v", *m.UpdateProfileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateProfile != nil && afterUpdateProfileCounter < 1 {
+		m.t.Error("Expected call to UserServiceMock.UpdateProfile")
+	}
+
+	if !m.UpdateProfileMock.invocationsDone() && afterUpdateProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.UpdateProfile but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateProfileMock.expectedInvocations), afterUpdateProfileCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetUserInspect()
+
+			m.MinimockSearchUsersInspect()
+
+			m.MinimockUpdateProfileInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UserServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetUserDone() &&
+		m.MinimockSearchUsersDone() &&
+		m.MinimockUpdateProfileDone()
+}
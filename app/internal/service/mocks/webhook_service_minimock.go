@@ -0,0 +1,2390 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/chat-server/internal/service.WebhookService -o webhook_service_minimock.go -n WebhookServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// WebhookServiceMock implements service.WebhookService
+type WebhookServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateIncomingWebhook          func(ctx context.Context, params model.CreateIncomingWebhookParams) (resp model.CreateIncomingWebhookResponse, err error)
+	inspectFuncCreateIncomingWebhook   func(ctx context.Context, params model.CreateIncomingWebhookParams)
+	afterCreateIncomingWebhookCounter  uint64
+	beforeCreateIncomingWebhookCounter uint64
+	CreateIncomingWebhookMock          mWebhookServiceMockCreateIncomingWebhook
+
+	funcCreateWebhook          func(ctx context.Context, params model.CreateWebhookParams) (resp model.CreateWebhookResponse, err error)
+	inspectFuncCreateWebhook   func(ctx context.Context, params model.CreateWebhookParams)
+	afterCreateWebhookCounter  uint64
+	beforeCreateWebhookCounter uint64
+	CreateWebhookMock          mWebhookServiceMockCreateWebhook
+
+	funcDeleteIncomingWebhook          func(ctx context.Context, params model.DeleteIncomingWebhookParams) (err error)
+	inspectFuncDeleteIncomingWebhook   func(ctx context.Context, params model.DeleteIncomingWebhookParams)
+	afterDeleteIncomingWebhookCounter  uint64
+	beforeDeleteIncomingWebhookCounter uint64
+	DeleteIncomingWebhookMock          mWebhookServiceMockDeleteIncomingWebhook
+
+	funcDeleteWebhook          func(ctx context.Context, params model.DeleteWebhookParams) (err error)
+	inspectFuncDeleteWebhook   func(ctx context.Context, params model.DeleteWebhookParams)
+	afterDeleteWebhookCounter  uint64
+	beforeDeleteWebhookCounter uint64
+	DeleteWebhookMock          mWebhookServiceMockDeleteWebhook
+
+	funcListIncomingWebhooks          func(ctx context.Context, params model.ListIncomingWebhooksParams) (webhooks []model.IncomingWebhook, err error)
+	inspectFuncListIncomingWebhooks   func(ctx context.Context, params model.ListIncomingWebhooksParams)
+	afterListIncomingWebhooksCounter  uint64
+	beforeListIncomingWebhooksCounter uint64
+	ListIncomingWebhooksMock          mWebhookServiceMockListIncomingWebhooks
+
+	funcListWebhooks          func(ctx context.Context, params model.ListWebhooksParams) (webhooks []model.Webhook, err error)
+	inspectFuncListWebhooks   func(ctx context.Context, params model.ListWebhooksParams)
+	afterListWebhooksCounter  uint64
+	beforeListWebhooksCounter uint64
+	ListWebhooksMock          mWebhookServiceMockListWebhooks
+
+	funcPostIncomingMessage          func(ctx context.Context, params model.PostIncomingMessageParams) (err error)
+	inspectFuncPostIncomingMessage   func(ctx context.Context, params model.PostIncomingMessageParams)
+	afterPostIncomingMessageCounter  uint64
+	beforePostIncomingMessageCounter uint64
+	PostIncomingMessageMock          mWebhookServiceMockPostIncomingMessage
+}
+
+// NewWebhookServiceMock returns a mock for service.WebhookService
+func NewWebhookServiceMock(t minimock.Tester) *WebhookServiceMock {
+	m := &WebhookServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateIncomingWebhookMock = mWebhookServiceMockCreateIncomingWebhook{mock: m}
+	m.CreateIncomingWebhookMock.callArgs = []*WebhookServiceMockCreateIncomingWebhookParams{}
+
+	m.CreateWebhookMock = mWebhookServiceMockCreateWebhook{mock: m}
+	m.CreateWebhookMock.callArgs = []*WebhookServiceMockCreateWebhookParams{}
+
+	m.DeleteIncomingWebhookMock = mWebhookServiceMockDeleteIncomingWebhook{mock: m}
+	m.DeleteIncomingWebhookMock.callArgs = []*WebhookServiceMockDeleteIncomingWebhookParams{}
+
+	m.DeleteWebhookMock = mWebhookServiceMockDeleteWebhook{mock: m}
+	m.DeleteWebhookMock.callArgs = []*WebhookServiceMockDeleteWebhookParams{}
+
+	m.ListIncomingWebhooksMock = mWebhookServiceMockListIncomingWebhooks{mock: m}
+	m.ListIncomingWebhooksMock.callArgs = []*WebhookServiceMockListIncomingWebhooksParams{}
+
+	m.ListWebhooksMock = mWebhookServiceMockListWebhooks{mock: m}
+	m.ListWebhooksMock.callArgs = []*WebhookServiceMockListWebhooksParams{}
+
+	m.PostIncomingMessageMock = mWebhookServiceMockPostIncomingMessage{mock: m}
+	m.PostIncomingMessageMock.callArgs = []*WebhookServiceMockPostIncomingMessageParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mWebhookServiceMockCreateIncomingWebhook struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockCreateIncomingWebhookExpectation
+	expectations       []*WebhookServiceMockCreateIncomingWebhookExpectation
+
+	callArgs []*WebhookServiceMockCreateIncomingWebhookParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookServiceMockCreateIncomingWebhookExpectation specifies expectation struct of the WebhookService.CreateIncomingWebhook
+type WebhookServiceMockCreateIncomingWebhookExpectation struct {
+	mock      *WebhookServiceMock
+	params    *WebhookServiceMockCreateIncomingWebhookParams
+	paramPtrs *WebhookServiceMockCreateIncomingWebhookParamPtrs
+	results   *WebhookServiceMockCreateIncomingWebhookResults
+	Counter   uint64
+}
+
+// WebhookServiceMockCreateIncomingWebhookParams contains parameters of the WebhookService.CreateIncomingWebhook
+type WebhookServiceMockCreateIncomingWebhookParams struct {
+	ctx    context.Context
+	params model.CreateIncomingWebhookParams
+}
+
+// WebhookServiceMockCreateIncomingWebhookParamPtrs contains pointers to parameters of the WebhookService.CreateIncomingWebhook
+type WebhookServiceMockCreateIncomingWebhookParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateIncomingWebhookParams
+}
+
+// WebhookServiceMockCreateIncomingWebhookResults contains results of the WebhookService.CreateIncomingWebhook
+type WebhookServiceMockCreateIncomingWebhookResults struct {
+	resp model.CreateIncomingWebhookResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) Optional() *mWebhookServiceMockCreateIncomingWebhook {
+	mmCreateIncomingWebhook.optional = true
+	return mmCreateIncomingWebhook
+}
+
+// Expect sets up expected params for WebhookService.CreateIncomingWebhook
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) Expect(ctx context.Context, params model.CreateIncomingWebhookParams) *mWebhookServiceMockCreateIncomingWebhook {
+	if mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.CreateIncomingWebhook mock is already set by Set")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation == nil {
+		mmCreateIncomingWebhook.defaultExpectation = &WebhookServiceMockCreateIncomingWebhookExpectation{}
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation.paramPtrs != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.CreateIncomingWebhook mock is already set by ExpectParams functions")
+	}
+
+	mmCreateIncomingWebhook.defaultExpectation.params = &WebhookServiceMockCreateIncomingWebhookParams{ctx, params}
+	for _, e := range mmCreateIncomingWebhook.expectations {
+		if minimock.Equal(e.params, mmCreateIncomingWebhook.defaultExpectation.params) {
+			mmCreateIncomingWebhook.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateIncomingWebhook.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateIncomingWebhook
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.CreateIncomingWebhook
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockCreateIncomingWebhook {
+	if mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.CreateIncomingWebhook mock is already set by Set")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation == nil {
+		mmCreateIncomingWebhook.defaultExpectation = &WebhookServiceMockCreateIncomingWebhookExpectation{}
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation.params != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.CreateIncomingWebhook mock is already set by Expect")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation.paramPtrs == nil {
+		mmCreateIncomingWebhook.defaultExpectation.paramPtrs = &WebhookServiceMockCreateIncomingWebhookParamPtrs{}
+	}
+	mmCreateIncomingWebhook.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateIncomingWebhook
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookService.CreateIncomingWebhook
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) ExpectParamsParam2(params model.CreateIncomingWebhookParams) *mWebhookServiceMockCreateIncomingWebhook {
+	if mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.CreateIncomingWebhook mock is already set by Set")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation == nil {
+		mmCreateIncomingWebhook.defaultExpectation = &WebhookServiceMockCreateIncomingWebhookExpectation{}
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation.params != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.CreateIncomingWebhook mock is already set by Expect")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation.paramPtrs == nil {
+		mmCreateIncomingWebhook.defaultExpectation.paramPtrs = &WebhookServiceMockCreateIncomingWebhookParamPtrs{}
+	}
+	mmCreateIncomingWebhook.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateIncomingWebhook
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.CreateIncomingWebhook
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) Inspect(f func(ctx context.Context, params model.CreateIncomingWebhookParams)) *mWebhookServiceMockCreateIncomingWebhook {
+	if mmCreateIncomingWebhook.mock.inspectFuncCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.CreateIncomingWebhook")
+	}
+
+	mmCreateIncomingWebhook.mock.inspectFuncCreateIncomingWebhook = f
+
+	return mmCreateIncomingWebhook
+}
+
+// Return sets up results that will be returned by WebhookService.CreateIncomingWebhook
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) Return(resp model.CreateIncomingWebhookResponse, err error) *WebhookServiceMock {
+	if mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.CreateIncomingWebhook mock is already set by Set")
+	}
+
+	if mmCreateIncomingWebhook.defaultExpectation == nil {
+		mmCreateIncomingWebhook.defaultExpectation = &WebhookServiceMockCreateIncomingWebhookExpectation{mock: mmCreateIncomingWebhook.mock}
+	}
+	mmCreateIncomingWebhook.defaultExpectation.results = &WebhookServiceMockCreateIncomingWebhookResults{resp, err}
+	return mmCreateIncomingWebhook.mock
+}
+
+// Set uses given function f to mock the WebhookService.CreateIncomingWebhook method
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) Set(f func(ctx context.Context, params model.CreateIncomingWebhookParams) (resp model.CreateIncomingWebhookResponse, err error)) *WebhookServiceMock {
+	if mmCreateIncomingWebhook.defaultExpectation != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("Default expectation is already set for the WebhookService.CreateIncomingWebhook method")
+	}
+
+	if len(mmCreateIncomingWebhook.expectations) > 0 {
+		mmCreateIncomingWebhook.mock.t.Fatalf("Some expectations are already set for the WebhookService.CreateIncomingWebhook method")
+	}
+
+	mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook = f
+	return mmCreateIncomingWebhook.mock
+}
+
+// When sets expectation for the WebhookService.CreateIncomingWebhook which will trigger the result defined by the following
+// Then helper
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) When(ctx context.Context, params model.CreateIncomingWebhookParams) *WebhookServiceMockCreateIncomingWebhookExpectation {
+	if mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.CreateIncomingWebhook mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockCreateIncomingWebhookExpectation{
+		mock:   mmCreateIncomingWebhook.mock,
+		params: &WebhookServiceMockCreateIncomingWebhookParams{ctx, params},
+	}
+	mmCreateIncomingWebhook.expectations = append(mmCreateIncomingWebhook.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.CreateIncomingWebhook return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockCreateIncomingWebhookExpectation) Then(resp model.CreateIncomingWebhookResponse, err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockCreateIncomingWebhookResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.CreateIncomingWebhook should be invoked
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) Times(n uint64) *mWebhookServiceMockCreateIncomingWebhook {
+	if n == 0 {
+		mmCreateIncomingWebhook.mock.t.Fatalf("Times of WebhookServiceMock.CreateIncomingWebhook mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateIncomingWebhook.expectedInvocations, n)
+	return mmCreateIncomingWebhook
+}
+
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) invocationsDone() bool {
+	if len(mmCreateIncomingWebhook.expectations) == 0 && mmCreateIncomingWebhook.defaultExpectation == nil && mmCreateIncomingWebhook.mock.funcCreateIncomingWebhook == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateIncomingWebhook.mock.afterCreateIncomingWebhookCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateIncomingWebhook.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateIncomingWebhook implements service.WebhookService
+func (mmCreateIncomingWebhook *WebhookServiceMock) CreateIncomingWebhook(ctx context.Context, params model.CreateIncomingWebhookParams) (resp model.CreateIncomingWebhookResponse, err error) {
+	mm_atomic.AddUint64(&mmCreateIncomingWebhook.beforeCreateIncomingWebhookCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateIncomingWebhook.afterCreateIncomingWebhookCounter, 1)
+
+	if mmCreateIncomingWebhook.inspectFuncCreateIncomingWebhook != nil {
+		mmCreateIncomingWebhook.inspectFuncCreateIncomingWebhook(ctx, params)
+	}
+
+	mm_params := WebhookServiceMockCreateIncomingWebhookParams{ctx, params}
+
+	// Record call args
+	mmCreateIncomingWebhook.CreateIncomingWebhookMock.mutex.Lock()
+	mmCreateIncomingWebhook.CreateIncomingWebhookMock.callArgs = append(mmCreateIncomingWebhook.CreateIncomingWebhookMock.callArgs, &mm_params)
+	mmCreateIncomingWebhook.CreateIncomingWebhookMock.mutex.Unlock()
+
+	for _, e := range mmCreateIncomingWebhook.CreateIncomingWebhookMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmCreateIncomingWebhook.CreateIncomingWebhookMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateIncomingWebhook.CreateIncomingWebhookMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateIncomingWebhook.CreateIncomingWebhookMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateIncomingWebhook.CreateIncomingWebhookMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockCreateIncomingWebhookParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateIncomingWebhook.t.Errorf("WebhookServiceMock.CreateIncomingWebhook got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateIncomingWebhook.t.Errorf("WebhookServiceMock.CreateIncomingWebhook got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateIncomingWebhook.t.Errorf("WebhookServiceMock.CreateIncomingWebhook got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateIncomingWebhook.CreateIncomingWebhookMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateIncomingWebhook.t.Fatal("No results are set for the WebhookServiceMock.CreateIncomingWebhook")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmCreateIncomingWebhook.funcCreateIncomingWebhook != nil {
+		return mmCreateIncomingWebhook.funcCreateIncomingWebhook(ctx, params)
+	}
+	mmCreateIncomingWebhook.t.Fatalf("Unexpected call to WebhookServiceMock.CreateIncomingWebhook. %v %v", ctx, params)
+	return
+}
+
+// CreateIncomingWebhookAfterCounter returns a count of finished WebhookServiceMock.CreateIncomingWebhook invocations
+func (mmCreateIncomingWebhook *WebhookServiceMock) CreateIncomingWebhookAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateIncomingWebhook.afterCreateIncomingWebhookCounter)
+}
+
+// CreateIncomingWebhookBeforeCounter returns a count of WebhookServiceMock.CreateIncomingWebhook invocations
+func (mmCreateIncomingWebhook *WebhookServiceMock) CreateIncomingWebhookBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateIncomingWebhook.beforeCreateIncomingWebhookCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.CreateIncomingWebhook.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateIncomingWebhook *mWebhookServiceMockCreateIncomingWebhook) Calls() []*WebhookServiceMockCreateIncomingWebhookParams {
+	mmCreateIncomingWebhook.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockCreateIncomingWebhookParams, len(mmCreateIncomingWebhook.callArgs))
+	copy(argCopy, mmCreateIncomingWebhook.callArgs)
+
+	mmCreateIncomingWebhook.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateIncomingWebhookDone returns true if the count of the CreateIncomingWebhook invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockCreateIncomingWebhookDone() bool {
+	if m.CreateIncomingWebhookMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateIncomingWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateIncomingWebhookMock.invocationsDone()
+}
+
+// MinimockCreateIncomingWebhookInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockCreateIncomingWebhookInspect() {
+	for _, e := range m.CreateIncomingWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.CreateIncomingWebhook with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateIncomingWebhookCounter := mm_atomic.LoadUint64(&m.afterCreateIncomingWebhookCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateIncomingWebhookMock.defaultExpectation != nil && afterCreateIncomingWebhookCounter < 1 {
+		if m.CreateIncomingWebhookMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookServiceMock.CreateIncomingWebhook")
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.CreateIncomingWebhook with params: %#v", *m.CreateIncomingWebhookMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateIncomingWebhook != nil && afterCreateIncomingWebhookCounter < 1 {
+		m.t.Error("Expected call to WebhookServiceMock.CreateIncomingWebhook")
+	}
+
+	if !m.CreateIncomingWebhookMock.invocationsDone() && afterCreateIncomingWebhookCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.CreateIncomingWebhook but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateIncomingWebhookMock.expectedInvocations), afterCreateIncomingWebhookCounter)
+	}
+}
+
+type mWebhookServiceMockCreateWebhook struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockCreateWebhookExpectation
+	expectations       []*WebhookServiceMockCreateWebhookExpectation
+
+	callArgs []*WebhookServiceMockCreateWebhookParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookServiceMockCreateWebhookExpectation specifies expectation struct of the WebhookService.CreateWebhook
+type WebhookServiceMockCreateWebhookExpectation struct {
+	mock      *WebhookServiceMock
+	params    *WebhookServiceMockCreateWebhookParams
+	paramPtrs *WebhookServiceMockCreateWebhookParamPtrs
+	results   *WebhookServiceMockCreateWebhookResults
+	Counter   uint64
+}
+
+// WebhookServiceMockCreateWebhookParams contains parameters of the WebhookService.CreateWebhook
+type WebhookServiceMockCreateWebhookParams struct {
+	ctx    context.Context
+	params model.CreateWebhookParams
+}
+
+// WebhookServiceMockCreateWebhookParamPtrs contains pointers to parameters of the WebhookService.CreateWebhook
+type WebhookServiceMockCreateWebhookParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateWebhookParams
+}
+
+// WebhookServiceMockCreateWebhookResults contains results of the WebhookService.CreateWebhook
+type WebhookServiceMockCreateWebhookResults struct {
+	resp model.CreateWebhookResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) Optional() *mWebhookServiceMockCreateWebhook {
+	mmCreateWebhook.optional = true
+	return mmCreateWebhook
+}
+
+// Expect sets up expected params for WebhookService.CreateWebhook
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) Expect(ctx context.Context, params model.CreateWebhookParams) *mWebhookServiceMockCreateWebhook {
+	if mmCreateWebhook.mock.funcCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookServiceMock.CreateWebhook mock is already set by Set")
+	}
+
+	if mmCreateWebhook.defaultExpectation == nil {
+		mmCreateWebhook.defaultExpectation = &WebhookServiceMockCreateWebhookExpectation{}
+	}
+
+	if mmCreateWebhook.defaultExpectation.paramPtrs != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookServiceMock.CreateWebhook mock is already set by ExpectParams functions")
+	}
+
+	mmCreateWebhook.defaultExpectation.params = &WebhookServiceMockCreateWebhookParams{ctx, params}
+	for _, e := range mmCreateWebhook.expectations {
+		if minimock.Equal(e.params, mmCreateWebhook.defaultExpectation.params) {
+			mmCreateWebhook.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateWebhook.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateWebhook
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.CreateWebhook
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockCreateWebhook {
+	if mmCreateWebhook.mock.funcCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookServiceMock.CreateWebhook mock is already set by Set")
+	}
+
+	if mmCreateWebhook.defaultExpectation == nil {
+		mmCreateWebhook.defaultExpectation = &WebhookServiceMockCreateWebhookExpectation{}
+	}
+
+	if mmCreateWebhook.defaultExpectation.params != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookServiceMock.CreateWebhook mock is already set by Expect")
+	}
+
+	if mmCreateWebhook.defaultExpectation.paramPtrs == nil {
+		mmCreateWebhook.defaultExpectation.paramPtrs = &WebhookServiceMockCreateWebhookParamPtrs{}
+	}
+	mmCreateWebhook.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateWebhook
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookService.CreateWebhook
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) ExpectParamsParam2(params model.CreateWebhookParams) *mWebhookServiceMockCreateWebhook {
+	if mmCreateWebhook.mock.funcCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookServiceMock.CreateWebhook mock is already set by Set")
+	}
+
+	if mmCreateWebhook.defaultExpectation == nil {
+		mmCreateWebhook.defaultExpectation = &WebhookServiceMockCreateWebhookExpectation{}
+	}
+
+	if mmCreateWebhook.defaultExpectation.params != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookServiceMock.CreateWebhook mock is already set by Expect")
+	}
+
+	if mmCreateWebhook.defaultExpectation.paramPtrs == nil {
+		mmCreateWebhook.defaultExpectation.paramPtrs = &WebhookServiceMockCreateWebhookParamPtrs{}
+	}
+	mmCreateWebhook.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateWebhook
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.CreateWebhook
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) Inspect(f func(ctx context.Context, params model.CreateWebhookParams)) *mWebhookServiceMockCreateWebhook {
+	if mmCreateWebhook.mock.inspectFuncCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.CreateWebhook")
+	}
+
+	mmCreateWebhook.mock.inspectFuncCreateWebhook = f
+
+	return mmCreateWebhook
+}
+
+// Return sets up results that will be returned by WebhookService.CreateWebhook
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) Return(resp model.CreateWebhookResponse, err error) *WebhookServiceMock {
+	if mmCreateWebhook.mock.funcCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookServiceMock.CreateWebhook mock is already set by Set")
+	}
+
+	if mmCreateWebhook.defaultExpectation == nil {
+		mmCreateWebhook.defaultExpectation = &WebhookServiceMockCreateWebhookExpectation{mock: mmCreateWebhook.mock}
+	}
+	mmCreateWebhook.defaultExpectation.results = &WebhookServiceMockCreateWebhookResults{resp, err}
+	return mmCreateWebhook.mock
+}
+
+// Set uses given function f to mock the WebhookService.CreateWebhook method
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) Set(f func(ctx context.Context, params model.CreateWebhookParams) (resp model.CreateWebhookResponse, err error)) *WebhookServiceMock {
+	if mmCreateWebhook.defaultExpectation != nil {
+		mmCreateWebhook.mock.t.Fatalf("Default expectation is already set for the WebhookService.CreateWebhook method")
+	}
+
+	if len(mmCreateWebhook.expectations) > 0 {
+		mmCreateWebhook.mock.t.Fatalf("Some expectations are already set for the WebhookService.CreateWebhook method")
+	}
+
+	mmCreateWebhook.mock.funcCreateWebhook = f
+	return mmCreateWebhook.mock
+}
+
+// When sets expectation for the WebhookService.CreateWebhook which will trigger the result defined by the following
+// Then helper
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) When(ctx context.Context, params model.CreateWebhookParams) *WebhookServiceMockCreateWebhookExpectation {
+	if mmCreateWebhook.mock.funcCreateWebhook != nil {
+		mmCreateWebhook.mock.t.Fatalf("WebhookServiceMock.CreateWebhook mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockCreateWebhookExpectation{
+		mock:   mmCreateWebhook.mock,
+		params: &WebhookServiceMockCreateWebhookParams{ctx, params},
+	}
+	mmCreateWebhook.expectations = append(mmCreateWebhook.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.CreateWebhook return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockCreateWebhookExpectation) Then(resp model.CreateWebhookResponse, err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockCreateWebhookResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.CreateWebhook should be invoked
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) Times(n uint64) *mWebhookServiceMockCreateWebhook {
+	if n == 0 {
+		mmCreateWebhook.mock.t.Fatalf("Times of WebhookServiceMock.CreateWebhook mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateWebhook.expectedInvocations, n)
+	return mmCreateWebhook
+}
+
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) invocationsDone() bool {
+	if len(mmCreateWebhook.expectations) == 0 && mmCreateWebhook.defaultExpectation == nil && mmCreateWebhook.mock.funcCreateWebhook == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateWebhook.mock.afterCreateWebhookCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateWebhook.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateWebhook implements service.WebhookService
+func (mmCreateWebhook *WebhookServiceMock) CreateWebhook(ctx context.Context, params model.CreateWebhookParams) (resp model.CreateWebhookResponse, err error) {
+	mm_atomic.AddUint64(&mmCreateWebhook.beforeCreateWebhookCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateWebhook.afterCreateWebhookCounter, 1)
+
+	if mmCreateWebhook.inspectFuncCreateWebhook != nil {
+		mmCreateWebhook.inspectFuncCreateWebhook(ctx, params)
+	}
+
+	mm_params := WebhookServiceMockCreateWebhookParams{ctx, params}
+
+	// Record call args
+	mmCreateWebhook.CreateWebhookMock.mutex.Lock()
+	mmCreateWebhook.CreateWebhookMock.callArgs = append(mmCreateWebhook.CreateWebhookMock.callArgs, &mm_params)
+	mmCreateWebhook.CreateWebhookMock.mutex.Unlock()
+
+	for _, e := range mmCreateWebhook.CreateWebhookMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmCreateWebhook.CreateWebhookMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateWebhook.CreateWebhookMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateWebhook.CreateWebhookMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateWebhook.CreateWebhookMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockCreateWebhookParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateWebhook.t.Errorf("WebhookServiceMock.CreateWebhook got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateWebhook.t.Errorf("WebhookServiceMock.CreateWebhook got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateWebhook.t.Errorf("WebhookServiceMock.CreateWebhook got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateWebhook.CreateWebhookMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateWebhook.t.Fatal("No results are set for the WebhookServiceMock.CreateWebhook")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmCreateWebhook.funcCreateWebhook != nil {
+		return mmCreateWebhook.funcCreateWebhook(ctx, params)
+	}
+	mmCreateWebhook.t.Fatalf("Unexpected call to WebhookServiceMock.CreateWebhook. %v %v", ctx, params)
+	return
+}
+
+// CreateWebhookAfterCounter returns a count of finished WebhookServiceMock.CreateWebhook invocations
+func (mmCreateWebhook *WebhookServiceMock) CreateWebhookAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateWebhook.afterCreateWebhookCounter)
+}
+
+// CreateWebhookBeforeCounter returns a count of WebhookServiceMock.CreateWebhook invocations
+func (mmCreateWebhook *WebhookServiceMock) CreateWebhookBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateWebhook.beforeCreateWebhookCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.CreateWebhook.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateWebhook *mWebhookServiceMockCreateWebhook) Calls() []*WebhookServiceMockCreateWebhookParams {
+	mmCreateWebhook.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockCreateWebhookParams, len(mmCreateWebhook.callArgs))
+	copy(argCopy, mmCreateWebhook.callArgs)
+
+	mmCreateWebhook.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateWebhookDone returns true if the count of the CreateWebhook invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockCreateWebhookDone() bool {
+	if m.CreateWebhookMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateWebhookMock.invocationsDone()
+}
+
+// MinimockCreateWebhookInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockCreateWebhookInspect() {
+	for _, e := range m.CreateWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.CreateWebhook with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateWebhookCounter := mm_atomic.LoadUint64(&m.afterCreateWebhookCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateWebhookMock.defaultExpectation != nil && afterCreateWebhookCounter < 1 {
+		if m.CreateWebhookMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookServiceMock.CreateWebhook")
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.CreateWebhook with params: %#v", *m.CreateWebhookMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateWebhook != nil && afterCreateWebhookCounter < 1 {
+		m.t.Error("Expected call to WebhookServiceMock.CreateWebhook")
+	}
+
+	if !m.CreateWebhookMock.invocationsDone() && afterCreateWebhookCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.CreateWebhook but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateWebhookMock.expectedInvocations), afterCreateWebhookCounter)
+	}
+}
+
+type mWebhookServiceMockDeleteIncomingWebhook struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockDeleteIncomingWebhookExpectation
+	expectations       []*WebhookServiceMockDeleteIncomingWebhookExpectation
+
+	callArgs []*WebhookServiceMockDeleteIncomingWebhookParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookServiceMockDeleteIncomingWebhookExpectation specifies expectation struct of the WebhookService.DeleteIncomingWebhook
+type WebhookServiceMockDeleteIncomingWebhookExpectation struct {
+	mock      *WebhookServiceMock
+	params    *WebhookServiceMockDeleteIncomingWebhookParams
+	paramPtrs *WebhookServiceMockDeleteIncomingWebhookParamPtrs
+	results   *WebhookServiceMockDeleteIncomingWebhookResults
+	Counter   uint64
+}
+
+// WebhookServiceMockDeleteIncomingWebhookParams contains parameters of the WebhookService.DeleteIncomingWebhook
+type WebhookServiceMockDeleteIncomingWebhookParams struct {
+	ctx    context.Context
+	params model.DeleteIncomingWebhookParams
+}
+
+// WebhookServiceMockDeleteIncomingWebhookParamPtrs contains pointers to parameters of the WebhookService.DeleteIncomingWebhook
+type WebhookServiceMockDeleteIncomingWebhookParamPtrs struct {
+	ctx    *context.Context
+	params *model.DeleteIncomingWebhookParams
+}
+
+// WebhookServiceMockDeleteIncomingWebhookResults contains results of the WebhookService.DeleteIncomingWebhook
+type WebhookServiceMockDeleteIncomingWebhookResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) Optional() *mWebhookServiceMockDeleteIncomingWebhook {
+	mmDeleteIncomingWebhook.optional = true
+	return mmDeleteIncomingWebhook
+}
+
+// Expect sets up expected params for WebhookService.DeleteIncomingWebhook
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) Expect(ctx context.Context, params model.DeleteIncomingWebhookParams) *mWebhookServiceMockDeleteIncomingWebhook {
+	if mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteIncomingWebhook mock is already set by Set")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation == nil {
+		mmDeleteIncomingWebhook.defaultExpectation = &WebhookServiceMockDeleteIncomingWebhookExpectation{}
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation.paramPtrs != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteIncomingWebhook mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteIncomingWebhook.defaultExpectation.params = &WebhookServiceMockDeleteIncomingWebhookParams{ctx, params}
+	for _, e := range mmDeleteIncomingWebhook.expectations {
+		if minimock.Equal(e.params, mmDeleteIncomingWebhook.defaultExpectation.params) {
+			mmDeleteIncomingWebhook.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteIncomingWebhook.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteIncomingWebhook
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.DeleteIncomingWebhook
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockDeleteIncomingWebhook {
+	if mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteIncomingWebhook mock is already set by Set")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation == nil {
+		mmDeleteIncomingWebhook.defaultExpectation = &WebhookServiceMockDeleteIncomingWebhookExpectation{}
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation.params != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteIncomingWebhook mock is already set by Expect")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation.paramPtrs == nil {
+		mmDeleteIncomingWebhook.defaultExpectation.paramPtrs = &WebhookServiceMockDeleteIncomingWebhookParamPtrs{}
+	}
+	mmDeleteIncomingWebhook.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteIncomingWebhook
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookService.DeleteIncomingWebhook
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) ExpectParamsParam2(params model.DeleteIncomingWebhookParams) *mWebhookServiceMockDeleteIncomingWebhook {
+	if mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteIncomingWebhook mock is already set by Set")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation == nil {
+		mmDeleteIncomingWebhook.defaultExpectation = &WebhookServiceMockDeleteIncomingWebhookExpectation{}
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation.params != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteIncomingWebhook mock is already set by Expect")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation.paramPtrs == nil {
+		mmDeleteIncomingWebhook.defaultExpectation.paramPtrs = &WebhookServiceMockDeleteIncomingWebhookParamPtrs{}
+	}
+	mmDeleteIncomingWebhook.defaultExpectation.paramPtrs.params = &params
+
+	return mmDeleteIncomingWebhook
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.DeleteIncomingWebhook
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) Inspect(f func(ctx context.Context, params model.DeleteIncomingWebhookParams)) *mWebhookServiceMockDeleteIncomingWebhook {
+	if mmDeleteIncomingWebhook.mock.inspectFuncDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.DeleteIncomingWebhook")
+	}
+
+	mmDeleteIncomingWebhook.mock.inspectFuncDeleteIncomingWebhook = f
+
+	return mmDeleteIncomingWebhook
+}
+
+// Return sets up results that will be returned by WebhookService.DeleteIncomingWebhook
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) Return(err error) *WebhookServiceMock {
+	if mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteIncomingWebhook mock is already set by Set")
+	}
+
+	if mmDeleteIncomingWebhook.defaultExpectation == nil {
+		mmDeleteIncomingWebhook.defaultExpectation = &WebhookServiceMockDeleteIncomingWebhookExpectation{mock: mmDeleteIncomingWebhook.mock}
+	}
+	mmDeleteIncomingWebhook.defaultExpectation.results = &WebhookServiceMockDeleteIncomingWebhookResults{err}
+	return mmDeleteIncomingWebhook.mock
+}
+
+// Set uses given function f to mock the WebhookService.DeleteIncomingWebhook method
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) Set(f func(ctx context.Context, params model.DeleteIncomingWebhookParams) (err error)) *WebhookServiceMock {
+	if mmDeleteIncomingWebhook.defaultExpectation != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("Default expectation is already set for the WebhookService.DeleteIncomingWebhook method")
+	}
+
+	if len(mmDeleteIncomingWebhook.expectations) > 0 {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("Some expectations are already set for the WebhookService.DeleteIncomingWebhook method")
+	}
+
+	mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook = f
+	return mmDeleteIncomingWebhook.mock
+}
+
+// When sets expectation for the WebhookService.DeleteIncomingWebhook which will trigger the result defined by the following
+// Then helper
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) When(ctx context.Context, params model.DeleteIncomingWebhookParams) *WebhookServiceMockDeleteIncomingWebhookExpectation {
+	if mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteIncomingWebhook mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockDeleteIncomingWebhookExpectation{
+		mock:   mmDeleteIncomingWebhook.mock,
+		params: &WebhookServiceMockDeleteIncomingWebhookParams{ctx, params},
+	}
+	mmDeleteIncomingWebhook.expectations = append(mmDeleteIncomingWebhook.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.DeleteIncomingWebhook return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockDeleteIncomingWebhookExpectation) Then(err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockDeleteIncomingWebhookResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.DeleteIncomingWebhook should be invoked
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) Times(n uint64) *mWebhookServiceMockDeleteIncomingWebhook {
+	if n == 0 {
+		mmDeleteIncomingWebhook.mock.t.Fatalf("Times of WebhookServiceMock.DeleteIncomingWebhook mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteIncomingWebhook.expectedInvocations, n)
+	return mmDeleteIncomingWebhook
+}
+
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) invocationsDone() bool {
+	if len(mmDeleteIncomingWebhook.expectations) == 0 && mmDeleteIncomingWebhook.defaultExpectation == nil && mmDeleteIncomingWebhook.mock.funcDeleteIncomingWebhook == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteIncomingWebhook.mock.afterDeleteIncomingWebhookCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteIncomingWebhook.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteIncomingWebhook implements service.WebhookService
+func (mmDeleteIncomingWebhook *WebhookServiceMock) DeleteIncomingWebhook(ctx context.Context, params model.DeleteIncomingWebhookParams) (err error) {
+	mm_atomic.AddUint64(&mmDeleteIncomingWebhook.beforeDeleteIncomingWebhookCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteIncomingWebhook.afterDeleteIncomingWebhookCounter, 1)
+
+	if mmDeleteIncomingWebhook.inspectFuncDeleteIncomingWebhook != nil {
+		mmDeleteIncomingWebhook.inspectFuncDeleteIncomingWebhook(ctx, params)
+	}
+
+	mm_params := WebhookServiceMockDeleteIncomingWebhookParams{ctx, params}
+
+	// Record call args
+	mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.mutex.Lock()
+	mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.callArgs = append(mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.callArgs, &mm_params)
+	mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.mutex.Unlock()
+
+	for _, e := range mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockDeleteIncomingWebhookParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteIncomingWebhook.t.Errorf("WebhookServiceMock.DeleteIncomingWebhook got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmDeleteIncomingWebhook.t.Errorf("WebhookServiceMock.DeleteIncomingWebhook got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteIncomingWebhook.t.Errorf("WebhookServiceMock.DeleteIncomingWebhook got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteIncomingWebhook.DeleteIncomingWebhookMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteIncomingWebhook.t.Fatal("No results are set for the WebhookServiceMock.DeleteIncomingWebhook")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteIncomingWebhook.funcDeleteIncomingWebhook != nil {
+		return mmDeleteIncomingWebhook.funcDeleteIncomingWebhook(ctx, params)
+	}
+	mmDeleteIncomingWebhook.t.Fatalf("Unexpected call to WebhookServiceMock.DeleteIncomingWebhook. %v %v", ctx, params)
+	return
+}
+
+// DeleteIncomingWebhookAfterCounter returns a count of finished WebhookServiceMock.DeleteIncomingWebhook invocations
+func (mmDeleteIncomingWebhook *WebhookServiceMock) DeleteIncomingWebhookAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteIncomingWebhook.afterDeleteIncomingWebhookCounter)
+}
+
+// DeleteIncomingWebhookBeforeCounter returns a count of WebhookServiceMock.DeleteIncomingWebhook invocations
+func (mmDeleteIncomingWebhook *WebhookServiceMock) DeleteIncomingWebhookBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteIncomingWebhook.beforeDeleteIncomingWebhookCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.DeleteIncomingWebhook.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteIncomingWebhook *mWebhookServiceMockDeleteIncomingWebhook) Calls() []*WebhookServiceMockDeleteIncomingWebhookParams {
+	mmDeleteIncomingWebhook.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockDeleteIncomingWebhookParams, len(mmDeleteIncomingWebhook.callArgs))
+	copy(argCopy, mmDeleteIncomingWebhook.callArgs)
+
+	mmDeleteIncomingWebhook.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteIncomingWebhookDone returns true if the count of the DeleteIncomingWebhook invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockDeleteIncomingWebhookDone() bool {
+	if m.DeleteIncomingWebhookMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteIncomingWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteIncomingWebhookMock.invocationsDone()
+}
+
+// MinimockDeleteIncomingWebhookInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockDeleteIncomingWebhookInspect() {
+	for _, e := range m.DeleteIncomingWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.DeleteIncomingWebhook with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteIncomingWebhookCounter := mm_atomic.LoadUint64(&m.afterDeleteIncomingWebhookCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteIncomingWebhookMock.defaultExpectation != nil && afterDeleteIncomingWebhookCounter < 1 {
+		if m.DeleteIncomingWebhookMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookServiceMock.DeleteIncomingWebhook")
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.DeleteIncomingWebhook with params: %#v", *m.DeleteIncomingWebhookMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteIncomingWebhook != nil && afterDeleteIncomingWebhookCounter < 1 {
+		m.t.Error("Expected call to WebhookServiceMock.DeleteIncomingWebhook")
+	}
+
+	if !m.DeleteIncomingWebhookMock.invocationsDone() && afterDeleteIncomingWebhookCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.DeleteIncomingWebhook but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteIncomingWebhookMock.expectedInvocations), afterDeleteIncomingWebhookCounter)
+	}
+}
+
+type mWebhookServiceMockDeleteWebhook struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockDeleteWebhookExpectation
+	expectations       []*WebhookServiceMockDeleteWebhookExpectation
+
+	callArgs []*WebhookServiceMockDeleteWebhookParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookServiceMockDeleteWebhookExpectation specifies expectation struct of the WebhookService.DeleteWebhook
+type WebhookServiceMockDeleteWebhookExpectation struct {
+	mock      *WebhookServiceMock
+	params    *WebhookServiceMockDeleteWebhookParams
+	paramPtrs *WebhookServiceMockDeleteWebhookParamPtrs
+	results   *WebhookServiceMockDeleteWebhookResults
+	Counter   uint64
+}
+
+// WebhookServiceMockDeleteWebhookParams contains parameters of the WebhookService.DeleteWebhook
+type WebhookServiceMockDeleteWebhookParams struct {
+	ctx    context.Context
+	params model.DeleteWebhookParams
+}
+
+// WebhookServiceMockDeleteWebhookParamPtrs contains pointers to parameters of the WebhookService.DeleteWebhook
+type WebhookServiceMockDeleteWebhookParamPtrs struct {
+	ctx    *context.Context
+	params *model.DeleteWebhookParams
+}
+
+// WebhookServiceMockDeleteWebhookResults contains results of the WebhookService.DeleteWebhook
+type WebhookServiceMockDeleteWebhookResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) Optional() *mWebhookServiceMockDeleteWebhook {
+	mmDeleteWebhook.optional = true
+	return mmDeleteWebhook
+}
+
+// Expect sets up expected params for WebhookService.DeleteWebhook
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) Expect(ctx context.Context, params model.DeleteWebhookParams) *mWebhookServiceMockDeleteWebhook {
+	if mmDeleteWebhook.mock.funcDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteWebhook mock is already set by Set")
+	}
+
+	if mmDeleteWebhook.defaultExpectation == nil {
+		mmDeleteWebhook.defaultExpectation = &WebhookServiceMockDeleteWebhookExpectation{}
+	}
+
+	if mmDeleteWebhook.defaultExpectation.paramPtrs != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteWebhook mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteWebhook.defaultExpectation.params = &WebhookServiceMockDeleteWebhookParams{ctx, params}
+	for _, e := range mmDeleteWebhook.expectations {
+		if minimock.Equal(e.params, mmDeleteWebhook.defaultExpectation.params) {
+			mmDeleteWebhook.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteWebhook.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteWebhook
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.DeleteWebhook
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockDeleteWebhook {
+	if mmDeleteWebhook.mock.funcDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteWebhook mock is already set by Set")
+	}
+
+	if mmDeleteWebhook.defaultExpectation == nil {
+		mmDeleteWebhook.defaultExpectation = &WebhookServiceMockDeleteWebhookExpectation{}
+	}
+
+	if mmDeleteWebhook.defaultExpectation.params != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteWebhook mock is already set by Expect")
+	}
+
+	if mmDeleteWebhook.defaultExpectation.paramPtrs == nil {
+		mmDeleteWebhook.defaultExpectation.paramPtrs = &WebhookServiceMockDeleteWebhookParamPtrs{}
+	}
+	mmDeleteWebhook.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteWebhook
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookService.DeleteWebhook
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) ExpectParamsParam2(params model.DeleteWebhookParams) *mWebhookServiceMockDeleteWebhook {
+	if mmDeleteWebhook.mock.funcDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteWebhook mock is already set by Set")
+	}
+
+	if mmDeleteWebhook.defaultExpectation == nil {
+		mmDeleteWebhook.defaultExpectation = &WebhookServiceMockDeleteWebhookExpectation{}
+	}
+
+	if mmDeleteWebhook.defaultExpectation.params != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteWebhook mock is already set by Expect")
+	}
+
+	if mmDeleteWebhook.defaultExpectation.paramPtrs == nil {
+		mmDeleteWebhook.defaultExpectation.paramPtrs = &WebhookServiceMockDeleteWebhookParamPtrs{}
+	}
+	mmDeleteWebhook.defaultExpectation.paramPtrs.params = &params
+
+	return mmDeleteWebhook
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.DeleteWebhook
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) Inspect(f func(ctx context.Context, params model.DeleteWebhookParams)) *mWebhookServiceMockDeleteWebhook {
+	if mmDeleteWebhook.mock.inspectFuncDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.DeleteWebhook")
+	}
+
+	mmDeleteWebhook.mock.inspectFuncDeleteWebhook = f
+
+	return mmDeleteWebhook
+}
+
+// Return sets up results that will be returned by WebhookService.DeleteWebhook
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) Return(err error) *WebhookServiceMock {
+	if mmDeleteWebhook.mock.funcDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteWebhook mock is already set by Set")
+	}
+
+	if mmDeleteWebhook.defaultExpectation == nil {
+		mmDeleteWebhook.defaultExpectation = &WebhookServiceMockDeleteWebhookExpectation{mock: mmDeleteWebhook.mock}
+	}
+	mmDeleteWebhook.defaultExpectation.results = &WebhookServiceMockDeleteWebhookResults{err}
+	return mmDeleteWebhook.mock
+}
+
+// Set uses given function f to mock the WebhookService.DeleteWebhook method
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) Set(f func(ctx context.Context, params model.DeleteWebhookParams) (err error)) *WebhookServiceMock {
+	if mmDeleteWebhook.defaultExpectation != nil {
+		mmDeleteWebhook.mock.t.Fatalf("Default expectation is already set for the WebhookService.DeleteWebhook method")
+	}
+
+	if len(mmDeleteWebhook.expectations) > 0 {
+		mmDeleteWebhook.mock.t.Fatalf("Some expectations are already set for the WebhookService.DeleteWebhook method")
+	}
+
+	mmDeleteWebhook.mock.funcDeleteWebhook = f
+	return mmDeleteWebhook.mock
+}
+
+// When sets expectation for the WebhookService.DeleteWebhook which will trigger the result defined by the following
+// Then helper
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) When(ctx context.Context, params model.DeleteWebhookParams) *WebhookServiceMockDeleteWebhookExpectation {
+	if mmDeleteWebhook.mock.funcDeleteWebhook != nil {
+		mmDeleteWebhook.mock.t.Fatalf("WebhookServiceMock.DeleteWebhook mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockDeleteWebhookExpectation{
+		mock:   mmDeleteWebhook.mock,
+		params: &WebhookServiceMockDeleteWebhookParams{ctx, params},
+	}
+	mmDeleteWebhook.expectations = append(mmDeleteWebhook.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.DeleteWebhook return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockDeleteWebhookExpectation) Then(err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockDeleteWebhookResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.DeleteWebhook should be invoked
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) Times(n uint64) *mWebhookServiceMockDeleteWebhook {
+	if n == 0 {
+		mmDeleteWebhook.mock.t.Fatalf("Times of WebhookServiceMock.DeleteWebhook mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteWebhook.expectedInvocations, n)
+	return mmDeleteWebhook
+}
+
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) invocationsDone() bool {
+	if len(mmDeleteWebhook.expectations) == 0 && mmDeleteWebhook.defaultExpectation == nil && mmDeleteWebhook.mock.funcDeleteWebhook == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteWebhook.mock.afterDeleteWebhookCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteWebhook.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteWebhook implements service.WebhookService
+func (mmDeleteWebhook *WebhookServiceMock) DeleteWebhook(ctx context.Context, params model.DeleteWebhookParams) (err error) {
+	mm_atomic.AddUint64(&mmDeleteWebhook.beforeDeleteWebhookCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteWebhook.afterDeleteWebhookCounter, 1)
+
+	if mmDeleteWebhook.inspectFuncDeleteWebhook != nil {
+		mmDeleteWebhook.inspectFuncDeleteWebhook(ctx, params)
+	}
+
+	mm_params := WebhookServiceMockDeleteWebhookParams{ctx, params}
+
+	// Record call args
+	mmDeleteWebhook.DeleteWebhookMock.mutex.Lock()
+	mmDeleteWebhook.DeleteWebhookMock.callArgs = append(mmDeleteWebhook.DeleteWebhookMock.callArgs, &mm_params)
+	mmDeleteWebhook.DeleteWebhookMock.mutex.Unlock()
+
+	for _, e := range mmDeleteWebhook.DeleteWebhookMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteWebhook.DeleteWebhookMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteWebhook.DeleteWebhookMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteWebhook.DeleteWebhookMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteWebhook.DeleteWebhookMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockDeleteWebhookParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteWebhook.t.Errorf("WebhookServiceMock.DeleteWebhook got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmDeleteWebhook.t.Errorf("WebhookServiceMock.DeleteWebhook got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteWebhook.t.Errorf("WebhookServiceMock.DeleteWebhook got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteWebhook.DeleteWebhookMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteWebhook.t.Fatal("No results are set for the WebhookServiceMock.DeleteWebhook")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteWebhook.funcDeleteWebhook != nil {
+		return mmDeleteWebhook.funcDeleteWebhook(ctx, params)
+	}
+	mmDeleteWebhook.t.Fatalf("Unexpected call to WebhookServiceMock.DeleteWebhook. %v %v", ctx, params)
+	return
+}
+
+// DeleteWebhookAfterCounter returns a count of finished WebhookServiceMock.DeleteWebhook invocations
+func (mmDeleteWebhook *WebhookServiceMock) DeleteWebhookAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteWebhook.afterDeleteWebhookCounter)
+}
+
+// DeleteWebhookBeforeCounter returns a count of WebhookServiceMock.DeleteWebhook invocations
+func (mmDeleteWebhook *WebhookServiceMock) DeleteWebhookBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteWebhook.beforeDeleteWebhookCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.DeleteWebhook.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteWebhook *mWebhookServiceMockDeleteWebhook) Calls() []*WebhookServiceMockDeleteWebhookParams {
+	mmDeleteWebhook.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockDeleteWebhookParams, len(mmDeleteWebhook.callArgs))
+	copy(argCopy, mmDeleteWebhook.callArgs)
+
+	mmDeleteWebhook.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteWebhookDone returns true if the count of the DeleteWebhook invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockDeleteWebhookDone() bool {
+	if m.DeleteWebhookMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteWebhookMock.invocationsDone()
+}
+
+// MinimockDeleteWebhookInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockDeleteWebhookInspect() {
+	for _, e := range m.DeleteWebhookMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.DeleteWebhook with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteWebhookCounter := mm_atomic.LoadUint64(&m.afterDeleteWebhookCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteWebhookMock.defaultExpectation != nil && afterDeleteWebhookCounter < 1 {
+		if m.DeleteWebhookMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookServiceMock.DeleteWebhook")
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.DeleteWebhook with params: %#v", *m.DeleteWebhookMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteWebhook != nil && afterDeleteWebhookCounter < 1 {
+		m.t.Error("Expected call to WebhookServiceMock.DeleteWebhook")
+	}
+
+	if !m.DeleteWebhookMock.invocationsDone() && afterDeleteWebhookCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.DeleteWebhook but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteWebhookMock.expectedInvocations), afterDeleteWebhookCounter)
+	}
+}
+
+type mWebhookServiceMockListIncomingWebhooks struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockListIncomingWebhooksExpectation
+	expectations       []*WebhookServiceMockListIncomingWebhooksExpectation
+
+	callArgs []*WebhookServiceMockListIncomingWebhooksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookServiceMockListIncomingWebhooksExpectation specifies expectation struct of the WebhookService.ListIncomingWebhooks
+type WebhookServiceMockListIncomingWebhooksExpectation struct {
+	mock      *WebhookServiceMock
+	params    *WebhookServiceMockListIncomingWebhooksParams
+	paramPtrs *WebhookServiceMockListIncomingWebhooksParamPtrs
+	results   *WebhookServiceMockListIncomingWebhooksResults
+	Counter   uint64
+}
+
+// WebhookServiceMockListIncomingWebhooksParams contains parameters of the WebhookService.ListIncomingWebhooks
+type WebhookServiceMockListIncomingWebhooksParams struct {
+	ctx    context.Context
+	params model.ListIncomingWebhooksParams
+}
+
+// WebhookServiceMockListIncomingWebhooksParamPtrs contains pointers to parameters of the WebhookService.ListIncomingWebhooks
+type WebhookServiceMockListIncomingWebhooksParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListIncomingWebhooksParams
+}
+
+// WebhookServiceMockListIncomingWebhooksResults contains results of the WebhookService.ListIncomingWebhooks
+type WebhookServiceMockListIncomingWebhooksResults struct {
+	webhooks []model.IncomingWebhook
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) Optional() *mWebhookServiceMockListIncomingWebhooks {
+	mmListIncomingWebhooks.optional = true
+	return mmListIncomingWebhooks
+}
+
+// Expect sets up expected params for WebhookService.ListIncomingWebhooks
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) Expect(ctx context.Context, params model.ListIncomingWebhooksParams) *mWebhookServiceMockListIncomingWebhooks {
+	if mmListIncomingWebhooks.mock.funcListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookServiceMock.ListIncomingWebhooks mock is already set by Set")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation == nil {
+		mmListIncomingWebhooks.defaultExpectation = &WebhookServiceMockListIncomingWebhooksExpectation{}
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation.paramPtrs != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookServiceMock.ListIncomingWebhooks mock is already set by ExpectParams functions")
+	}
+
+	mmListIncomingWebhooks.defaultExpectation.params = &WebhookServiceMockListIncomingWebhooksParams{ctx, params}
+	for _, e := range mmListIncomingWebhooks.expectations {
+		if minimock.Equal(e.params, mmListIncomingWebhooks.defaultExpectation.params) {
+			mmListIncomingWebhooks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListIncomingWebhooks.defaultExpectation.params)
+		}
+	}
+
+	return mmListIncomingWebhooks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.ListIncomingWebhooks
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockListIncomingWebhooks {
+	if mmListIncomingWebhooks.mock.funcListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookServiceMock.ListIncomingWebhooks mock is already set by Set")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation == nil {
+		mmListIncomingWebhooks.defaultExpectation = &WebhookServiceMockListIncomingWebhooksExpectation{}
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation.params != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookServiceMock.ListIncomingWebhooks mock is already set by Expect")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation.paramPtrs == nil {
+		mmListIncomingWebhooks.defaultExpectation.paramPtrs = &WebhookServiceMockListIncomingWebhooksParamPtrs{}
+	}
+	mmListIncomingWebhooks.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListIncomingWebhooks
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookService.ListIncomingWebhooks
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) ExpectParamsParam2(params model.ListIncomingWebhooksParams) *mWebhookServiceMockListIncomingWebhooks {
+	if mmListIncomingWebhooks.mock.funcListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookServiceMock.ListIncomingWebhooks mock is already set by Set")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation == nil {
+		mmListIncomingWebhooks.defaultExpectation = &WebhookServiceMockListIncomingWebhooksExpectation{}
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation.params != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookServiceMock.ListIncomingWebhooks mock is already set by Expect")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation.paramPtrs == nil {
+		mmListIncomingWebhooks.defaultExpectation.paramPtrs = &WebhookServiceMockListIncomingWebhooksParamPtrs{}
+	}
+	mmListIncomingWebhooks.defaultExpectation.paramPtrs.params = &params
+
+	return mmListIncomingWebhooks
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.ListIncomingWebhooks
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) Inspect(f func(ctx context.Context, params model.ListIncomingWebhooksParams)) *mWebhookServiceMockListIncomingWebhooks {
+	if mmListIncomingWebhooks.mock.inspectFuncListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.ListIncomingWebhooks")
+	}
+
+	mmListIncomingWebhooks.mock.inspectFuncListIncomingWebhooks = f
+
+	return mmListIncomingWebhooks
+}
+
+// Return sets up results that will be returned by WebhookService.ListIncomingWebhooks
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) Return(webhooks []model.IncomingWebhook, err error) *WebhookServiceMock {
+	if mmListIncomingWebhooks.mock.funcListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookServiceMock.ListIncomingWebhooks mock is already set by Set")
+	}
+
+	if mmListIncomingWebhooks.defaultExpectation == nil {
+		mmListIncomingWebhooks.defaultExpectation = &WebhookServiceMockListIncomingWebhooksExpectation{mock: mmListIncomingWebhooks.mock}
+	}
+	mmListIncomingWebhooks.defaultExpectation.results = &WebhookServiceMockListIncomingWebhooksResults{webhooks, err}
+	return mmListIncomingWebhooks.mock
+}
+
+// Set uses given function f to mock the WebhookService.ListIncomingWebhooks method
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) Set(f func(ctx context.Context, params model.ListIncomingWebhooksParams) (webhooks []model.IncomingWebhook, err error)) *WebhookServiceMock {
+	if mmListIncomingWebhooks.defaultExpectation != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("Default expectation is already set for the WebhookService.ListIncomingWebhooks method")
+	}
+
+	if len(mmListIncomingWebhooks.expectations) > 0 {
+		mmListIncomingWebhooks.mock.t.Fatalf("Some expectations are already set for the WebhookService.ListIncomingWebhooks method")
+	}
+
+	mmListIncomingWebhooks.mock.funcListIncomingWebhooks = f
+	return mmListIncomingWebhooks.mock
+}
+
+// When sets expectation for the WebhookService.ListIncomingWebhooks which will trigger the result defined by the following
+// Then helper
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) When(ctx context.Context, params model.ListIncomingWebhooksParams) *WebhookServiceMockListIncomingWebhooksExpectation {
+	if mmListIncomingWebhooks.mock.funcListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.mock.t.Fatalf("WebhookServiceMock.ListIncomingWebhooks mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockListIncomingWebhooksExpectation{
+		mock:   mmListIncomingWebhooks.mock,
+		params: &WebhookServiceMockListIncomingWebhooksParams{ctx, params},
+	}
+	mmListIncomingWebhooks.expectations = append(mmListIncomingWebhooks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.ListIncomingWebhooks return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockListIncomingWebhooksExpectation) Then(webhooks []model.IncomingWebhook, err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockListIncomingWebhooksResults{webhooks, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.ListIncomingWebhooks should be invoked
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) Times(n uint64) *mWebhookServiceMockListIncomingWebhooks {
+	if n == 0 {
+		mmListIncomingWebhooks.mock.t.Fatalf("Times of WebhookServiceMock.ListIncomingWebhooks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListIncomingWebhooks.expectedInvocations, n)
+	return mmListIncomingWebhooks
+}
+
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) invocationsDone() bool {
+	if len(mmListIncomingWebhooks.expectations) == 0 && mmListIncomingWebhooks.defaultExpectation == nil && mmListIncomingWebhooks.mock.funcListIncomingWebhooks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListIncomingWebhooks.mock.afterListIncomingWebhooksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListIncomingWebhooks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListIncomingWebhooks implements service.WebhookService
+func (mmListIncomingWebhooks *WebhookServiceMock) ListIncomingWebhooks(ctx context.Context, params model.ListIncomingWebhooksParams) (webhooks []model.IncomingWebhook, err error) {
+	mm_atomic.AddUint64(&mmListIncomingWebhooks.beforeListIncomingWebhooksCounter, 1)
+	defer mm_atomic.AddUint64(&mmListIncomingWebhooks.afterListIncomingWebhooksCounter, 1)
+
+	if mmListIncomingWebhooks.inspectFuncListIncomingWebhooks != nil {
+		mmListIncomingWebhooks.inspectFuncListIncomingWebhooks(ctx, params)
+	}
+
+	mm_params := WebhookServiceMockListIncomingWebhooksParams{ctx, params}
+
+	// Record call args
+	mmListIncomingWebhooks.ListIncomingWebhooksMock.mutex.Lock()
+	mmListIncomingWebhooks.ListIncomingWebhooksMock.callArgs = append(mmListIncomingWebhooks.ListIncomingWebhooksMock.callArgs, &mm_params)
+	mmListIncomingWebhooks.ListIncomingWebhooksMock.mutex.Unlock()
+
+	for _, e := range mmListIncomingWebhooks.ListIncomingWebhooksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.webhooks, e.results.err
+		}
+	}
+
+	if mmListIncomingWebhooks.ListIncomingWebhooksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListIncomingWebhooks.ListIncomingWebhooksMock.defaultExpectation.Counter, 1)
+		mm_want := mmListIncomingWebhooks.ListIncomingWebhooksMock.defaultExpectation.params
+		mm_want_ptrs := mmListIncomingWebhooks.ListIncomingWebhooksMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockListIncomingWebhooksParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListIncomingWebhooks.t.Errorf("WebhookServiceMock.ListIncomingWebhooks got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListIncomingWebhooks.t.Errorf("WebhookServiceMock.ListIncomingWebhooks got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListIncomingWebhooks.t.Errorf("WebhookServiceMock.ListIncomingWebhooks got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListIncomingWebhooks.ListIncomingWebhooksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListIncomingWebhooks.t.Fatal("No results are set for the WebhookServiceMock.ListIncomingWebhooks")
+		}
+		return (*mm_results).webhooks, (*mm_results).err
+	}
+	if mmListIncomingWebhooks.funcListIncomingWebhooks != nil {
+		return mmListIncomingWebhooks.funcListIncomingWebhooks(ctx, params)
+	}
+	mmListIncomingWebhooks.t.Fatalf("Unexpected call to WebhookServiceMock.ListIncomingWebhooks. %v %v", ctx, params)
+	return
+}
+
+// ListIncomingWebhooksAfterCounter returns a count of finished WebhookServiceMock.ListIncomingWebhooks invocations
+func (mmListIncomingWebhooks *WebhookServiceMock) ListIncomingWebhooksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListIncomingWebhooks.afterListIncomingWebhooksCounter)
+}
+
+// ListIncomingWebhooksBeforeCounter returns a count of WebhookServiceMock.ListIncomingWebhooks invocations
+func (mmListIncomingWebhooks *WebhookServiceMock) ListIncomingWebhooksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListIncomingWebhooks.beforeListIncomingWebhooksCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.ListIncomingWebhooks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListIncomingWebhooks *mWebhookServiceMockListIncomingWebhooks) Calls() []*WebhookServiceMockListIncomingWebhooksParams {
+	mmListIncomingWebhooks.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockListIncomingWebhooksParams, len(mmListIncomingWebhooks.callArgs))
+	copy(argCopy, mmListIncomingWebhooks.callArgs)
+
+	mmListIncomingWebhooks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListIncomingWebhooksDone returns true if the count of the ListIncomingWebhooks invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockListIncomingWebhooksDone() bool {
+	if m.ListIncomingWebhooksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListIncomingWebhooksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListIncomingWebhooksMock.invocationsDone()
+}
+
+// MinimockListIncomingWebhooksInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockListIncomingWebhooksInspect() {
+	for _, e := range m.ListIncomingWebhooksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.ListIncomingWebhooks with params: %#v", *e.params)
+		}
+	}
+
+	afterListIncomingWebhooksCounter := mm_atomic.LoadUint64(&m.afterListIncomingWebhooksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListIncomingWebhooksMock.defaultExpectation != nil && afterListIncomingWebhooksCounter < 1 {
+		if m.ListIncomingWebhooksMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookServiceMock.ListIncomingWebhooks")
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.ListIncomingWebhooks with params: %#v", *m.ListIncomingWebhooksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListIncomingWebhooks != nil && afterListIncomingWebhooksCounter < 1 {
+		m.t.Error("Expected call to WebhookServiceMock.ListIncomingWebhooks")
+	}
+
+	if !m.ListIncomingWebhooksMock.invocationsDone() && afterListIncomingWebhooksCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.ListIncomingWebhooks but found %d calls",
+			mm_atomic.LoadUint64(&m.ListIncomingWebhooksMock.expectedInvocations), afterListIncomingWebhooksCounter)
+	}
+}
+
+type mWebhookServiceMockListWebhooks struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockListWebhooksExpectation
+	expectations       []*WebhookServiceMockListWebhooksExpectation
+
+	callArgs []*WebhookServiceMockListWebhooksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookServiceMockListWebhooksExpectation specifies expectation struct of the WebhookService.ListWebhooks
+type WebhookServiceMockListWebhooksExpectation struct {
+	mock      *WebhookServiceMock
+	params    *WebhookServiceMockListWebhooksParams
+	paramPtrs *WebhookServiceMockListWebhooksParamPtrs
+	results   *WebhookServiceMockListWebhooksResults
+	Counter   uint64
+}
+
+// WebhookServiceMockListWebhooksParams contains parameters of the WebhookService.ListWebhooks
+type WebhookServiceMockListWebhooksParams struct {
+	ctx    context.Context
+	params model.ListWebhooksParams
+}
+
+// WebhookServiceMockListWebhooksParamPtrs contains pointers to parameters of the WebhookService.ListWebhooks
+type WebhookServiceMockListWebhooksParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListWebhooksParams
+}
+
+// WebhookServiceMockListWebhooksResults contains results of the WebhookService.ListWebhooks
+type WebhookServiceMockListWebhooksResults struct {
+	webhooks []model.Webhook
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) Optional() *mWebhookServiceMockListWebhooks {
+	mmListWebhooks.optional = true
+	return mmListWebhooks
+}
+
+// Expect sets up expected params for WebhookService.ListWebhooks
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) Expect(ctx context.Context, params model.ListWebhooksParams) *mWebhookServiceMockListWebhooks {
+	if mmListWebhooks.mock.funcListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookServiceMock.ListWebhooks mock is already set by Set")
+	}
+
+	if mmListWebhooks.defaultExpectation == nil {
+		mmListWebhooks.defaultExpectation = &WebhookServiceMockListWebhooksExpectation{}
+	}
+
+	if mmListWebhooks.defaultExpectation.paramPtrs != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookServiceMock.ListWebhooks mock is already set by ExpectParams functions")
+	}
+
+	mmListWebhooks.defaultExpectation.params = &WebhookServiceMockListWebhooksParams{ctx, params}
+	for _, e := range mmListWebhooks.expectations {
+		if minimock.Equal(e.params, mmListWebhooks.defaultExpectation.params) {
+			mmListWebhooks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListWebhooks.defaultExpectation.params)
+		}
+	}
+
+	return mmListWebhooks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.ListWebhooks
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockListWebhooks {
+	if mmListWebhooks.mock.funcListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookServiceMock.ListWebhooks mock is already set by Set")
+	}
+
+	if mmListWebhooks.defaultExpectation == nil {
+		mmListWebhooks.defaultExpectation = &WebhookServiceMockListWebhooksExpectation{}
+	}
+
+	if mmListWebhooks.defaultExpectation.params != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookServiceMock.ListWebhooks mock is already set by Expect")
+	}
+
+	if mmListWebhooks.defaultExpectation.paramPtrs == nil {
+		mmListWebhooks.defaultExpectation.paramPtrs = &WebhookServiceMockListWebhooksParamPtrs{}
+	}
+	mmListWebhooks.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListWebhooks
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookService.ListWebhooks
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) ExpectParamsParam2(params model.ListWebhooksParams) *mWebhookServiceMockListWebhooks {
+	if mmListWebhooks.mock.funcListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookServiceMock.ListWebhooks mock is already set by Set")
+	}
+
+	if mmListWebhooks.defaultExpectation == nil {
+		mmListWebhooks.defaultExpectation = &WebhookServiceMockListWebhooksExpectation{}
+	}
+
+	if mmListWebhooks.defaultExpectation.params != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookServiceMock.ListWebhooks mock is already set by Expect")
+	}
+
+	if mmListWebhooks.defaultExpectation.paramPtrs == nil {
+		mmListWebhooks.defaultExpectation.paramPtrs = &WebhookServiceMockListWebhooksParamPtrs{}
+	}
+	mmListWebhooks.defaultExpectation.paramPtrs.params = &params
+
+	return mmListWebhooks
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.ListWebhooks
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) Inspect(f func(ctx context.Context, params model.ListWebhooksParams)) *mWebhookServiceMockListWebhooks {
+	if mmListWebhooks.mock.inspectFuncListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.ListWebhooks")
+	}
+
+	mmListWebhooks.mock.inspectFuncListWebhooks = f
+
+	return mmListWebhooks
+}
+
+// Return sets up results that will be returned by WebhookService.ListWebhooks
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) Return(webhooks []model.Webhook, err error) *WebhookServiceMock {
+	if mmListWebhooks.mock.funcListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookServiceMock.ListWebhooks mock is already set by Set")
+	}
+
+	if mmListWebhooks.defaultExpectation == nil {
+		mmListWebhooks.defaultExpectation = &WebhookServiceMockListWebhooksExpectation{mock: mmListWebhooks.mock}
+	}
+	mmListWebhooks.defaultExpectation.results = &WebhookServiceMockListWebhooksResults{webhooks, err}
+	return mmListWebhooks.mock
+}
+
+// Set uses given function f to mock the WebhookService.ListWebhooks method
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) Set(f func(ctx context.Context, params model.ListWebhooksParams) (webhooks []model.Webhook, err error)) *WebhookServiceMock {
+	if mmListWebhooks.defaultExpectation != nil {
+		mmListWebhooks.mock.t.Fatalf("Default expectation is already set for the WebhookService.ListWebhooks method")
+	}
+
+	if len(mmListWebhooks.expectations) > 0 {
+		mmListWebhooks.mock.t.Fatalf("Some expectations are already set for the WebhookService.ListWebhooks method")
+	}
+
+	mmListWebhooks.mock.funcListWebhooks = f
+	return mmListWebhooks.mock
+}
+
+// When sets expectation for the WebhookService.ListWebhooks which will trigger the result defined by the following
+// Then helper
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) When(ctx context.Context, params model.ListWebhooksParams) *WebhookServiceMockListWebhooksExpectation {
+	if mmListWebhooks.mock.funcListWebhooks != nil {
+		mmListWebhooks.mock.t.Fatalf("WebhookServiceMock.ListWebhooks mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockListWebhooksExpectation{
+		mock:   mmListWebhooks.mock,
+		params: &WebhookServiceMockListWebhooksParams{ctx, params},
+	}
+	mmListWebhooks.expectations = append(mmListWebhooks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.ListWebhooks return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockListWebhooksExpectation) Then(webhooks []model.Webhook, err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockListWebhooksResults{webhooks, err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.ListWebhooks should be invoked
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) Times(n uint64) *mWebhookServiceMockListWebhooks {
+	if n == 0 {
+		mmListWebhooks.mock.t.Fatalf("Times of WebhookServiceMock.ListWebhooks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListWebhooks.expectedInvocations, n)
+	return mmListWebhooks
+}
+
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) invocationsDone() bool {
+	if len(mmListWebhooks.expectations) == 0 && mmListWebhooks.defaultExpectation == nil && mmListWebhooks.mock.funcListWebhooks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListWebhooks.mock.afterListWebhooksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListWebhooks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListWebhooks implements service.WebhookService
+func (mmListWebhooks *WebhookServiceMock) ListWebhooks(ctx context.Context, params model.ListWebhooksParams) (webhooks []model.Webhook, err error) {
+	mm_atomic.AddUint64(&mmListWebhooks.beforeListWebhooksCounter, 1)
+	defer mm_atomic.AddUint64(&mmListWebhooks.afterListWebhooksCounter, 1)
+
+	if mmListWebhooks.inspectFuncListWebhooks != nil {
+		mmListWebhooks.inspectFuncListWebhooks(ctx, params)
+	}
+
+	mm_params := WebhookServiceMockListWebhooksParams{ctx, params}
+
+	// Record call args
+	mmListWebhooks.ListWebhooksMock.mutex.Lock()
+	mmListWebhooks.ListWebhooksMock.callArgs = append(mmListWebhooks.ListWebhooksMock.callArgs, &mm_params)
+	mmListWebhooks.ListWebhooksMock.mutex.Unlock()
+
+	for _, e := range mmListWebhooks.ListWebhooksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.webhooks, e.results.err
+		}
+	}
+
+	if mmListWebhooks.ListWebhooksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListWebhooks.ListWebhooksMock.defaultExpectation.Counter, 1)
+		mm_want := mmListWebhooks.ListWebhooksMock.defaultExpectation.params
+		mm_want_ptrs := mmListWebhooks.ListWebhooksMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockListWebhooksParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListWebhooks.t.Errorf("WebhookServiceMock.ListWebhooks got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListWebhooks.t.Errorf("WebhookServiceMock.ListWebhooks got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListWebhooks.t.Errorf("WebhookServiceMock.ListWebhooks got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListWebhooks.ListWebhooksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListWebhooks.t.Fatal("No results are set for the WebhookServiceMock.ListWebhooks")
+		}
+		return (*mm_results).webhooks, (*mm_results).err
+	}
+	if mmListWebhooks.funcListWebhooks != nil {
+		return mmListWebhooks.funcListWebhooks(ctx, params)
+	}
+	mmListWebhooks.t.Fatalf("Unexpected call to WebhookServiceMock.ListWebhooks. %v %v", ctx, params)
+	return
+}
+
+// ListWebhooksAfterCounter returns a count of finished WebhookServiceMock.ListWebhooks invocations
+func (mmListWebhooks *WebhookServiceMock) ListWebhooksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListWebhooks.afterListWebhooksCounter)
+}
+
+// ListWebhooksBeforeCounter returns a count of WebhookServiceMock.ListWebhooks invocations
+func (mmListWebhooks *WebhookServiceMock) ListWebhooksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListWebhooks.beforeListWebhooksCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.ListWebhooks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListWebhooks *mWebhookServiceMockListWebhooks) Calls() []*WebhookServiceMockListWebhooksParams {
+	mmListWebhooks.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockListWebhooksParams, len(mmListWebhooks.callArgs))
+	copy(argCopy, mmListWebhooks.callArgs)
+
+	mmListWebhooks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListWebhooksDone returns true if the count of the ListWebhooks invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockListWebhooksDone() bool {
+	if m.ListWebhooksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListWebhooksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListWebhooksMock.invocationsDone()
+}
+
+// MinimockListWebhooksInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockListWebhooksInspect() {
+	for _, e := range m.ListWebhooksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.ListWebhooks with params: %#v", *e.params)
+		}
+	}
+
+	afterListWebhooksCounter := mm_atomic.LoadUint64(&m.afterListWebhooksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListWebhooksMock.defaultExpectation != nil && afterListWebhooksCounter < 1 {
+		if m.ListWebhooksMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookServiceMock.ListWebhooks")
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.ListWebhooks with params: %#v", *m.ListWebhooksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListWebhooks != nil && afterListWebhooksCounter < 1 {
+		m.t.Error("Expected call to WebhookServiceMock.ListWebhooks")
+	}
+
+	if !m.ListWebhooksMock.invocationsDone() && afterListWebhooksCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.ListWebhooks but found %d calls",
+			mm_atomic.LoadUint64(&m.ListWebhooksMock.expectedInvocations), afterListWebhooksCounter)
+	}
+}
+
+type mWebhookServiceMockPostIncomingMessage struct {
+	optional           bool
+	mock               *WebhookServiceMock
+	defaultExpectation *WebhookServiceMockPostIncomingMessageExpectation
+	expectations       []*WebhookServiceMockPostIncomingMessageExpectation
+
+	callArgs []*WebhookServiceMockPostIncomingMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// WebhookServiceMockPostIncomingMessageExpectation specifies expectation struct of the WebhookService.PostIncomingMessage
+type WebhookServiceMockPostIncomingMessageExpectation struct {
+	mock      *WebhookServiceMock
+	params    *WebhookServiceMockPostIncomingMessageParams
+	paramPtrs *WebhookServiceMockPostIncomingMessageParamPtrs
+	results   *WebhookServiceMockPostIncomingMessageResults
+	Counter   uint64
+}
+
+// WebhookServiceMockPostIncomingMessageParams contains parameters of the WebhookService.PostIncomingMessage
+type WebhookServiceMockPostIncomingMessageParams struct {
+	ctx    context.Context
+	params model.PostIncomingMessageParams
+}
+
+// WebhookServiceMockPostIncomingMessageParamPtrs contains pointers to parameters of the WebhookService.PostIncomingMessage
+type WebhookServiceMockPostIncomingMessageParamPtrs struct {
+	ctx    *context.Context
+	params *model.PostIncomingMessageParams
+}
+
+// WebhookServiceMockPostIncomingMessageResults contains results of the WebhookService.PostIncomingMessage
+type WebhookServiceMockPostIncomingMessageResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) Optional() *mWebhookServiceMockPostIncomingMessage {
+	mmPostIncomingMessage.optional = true
+	return mmPostIncomingMessage
+}
+
+// Expect sets up expected params for WebhookService.PostIncomingMessage
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) Expect(ctx context.Context, params model.PostIncomingMessageParams) *mWebhookServiceMockPostIncomingMessage {
+	if mmPostIncomingMessage.mock.funcPostIncomingMessage != nil {
+		mmPostIncomingMessage.mock.t.Fatalf("WebhookServiceMock.PostIncomingMessage mock is already set by Set")
+	}
+
+	if mmPostIncomingMessage.defaultExpectation == nil {
+		mmPostIncomingMessage.defaultExpectation = &WebhookServiceMockPostIncomingMessageExpectation{}
+	}
+
+	if mmPostIncomingMessage.defaultExpectation.paramPtrs != nil {
+		mmPostIncomingMessage.mock.t.Fatalf("WebhookServiceMock.PostIncomingMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPostIncomingMessage.defaultExpectation.params = &WebhookServiceMockPostIncomingMessageParams{ctx, params}
+	for _, e := range mmPostIncomingMessage.expectations {
+		if minimock.Equal(e.params, mmPostIncomingMessage.defaultExpectation.params) {
+			mmPostIncomingMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPostIncomingMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPostIncomingMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for WebhookService.PostIncomingMessage
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) ExpectCtxParam1(ctx context.Context) *mWebhookServiceMockPostIncomingMessage {
+	if mmPostIncomingMessage.mock.funcPostIncomingMessage != nil {
+		mmPostIncomingMessage.mock.t.Fatalf("WebhookServiceMock.PostIncomingMessage mock is already set by Set")
+	}
+
+	if mmPostIncomingMessage.defaultExpectation == nil {
+		mmPostIncomingMessage.defaultExpectation = &WebhookServiceMockPostIncomingMessageExpectation{}
+	}
+
+	if mmPostIncomingMessage.defaultExpectation.params != nil {
+		mmPostIncomingMessage.mock.t.Fatalf("WebhookServiceMock.PostIncomingMessage mock is already set by Expect")
+	}
+
+	if mmPostIncomingMessage.defaultExpectation.paramPtrs == nil {
+		mmPostIncomingMessage.defaultExpectation.paramPtrs = &WebhookServiceMockPostIncomingMessageParamPtrs{}
+	}
+	mmPostIncomingMessage.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPostIncomingMessage
+}
+
+// ExpectParamsParam2 sets up expected param params for WebhookService.PostIncomingMessage
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) ExpectParamsParam2(params model.PostIncomingMessageParams) *mWebhookServiceMockPostIncomingMessage {
+	if mmPostIncomingMessage.mock.funcPostIncomingMessage != nil {
+		mmPostIncomingMessage.mock.t.Fatalf("WebhookServiceMock.PostIncomingMessage mock is already set by Set")
+	}
+
+	if mmPostIncomingMessage.defaultExpectation == nil {
+		mmPostIncomingMessage.defaultExpectation = &WebhookServiceMockPostIncomingMessageExpectation{}
+	}
+
+	if mmPostIncomingMessage.defaultExpectation.params != nil {
+		mmPostIncomingMessage.mock.t.Fatalf("WebhookServiceMock.PostIncomingMessage mock is already set by Expect")
+	}
+
+	if mmPostIncomingMessage.defaultExpectation.paramPtrs == nil {
+		mmPostIncomingMessage.defaultExpectation.paramPtrs = &WebhookServiceMockPostIncomingMessageParamPtrs{}
+	}
+	mmPostIncomingMessage.defaultExpectation.paramPtrs.params = &params
+
+	return mmPostIncomingMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the WebhookService.PostIncomingMessage
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) Inspect(f func(ctx context.Context, params model.PostIncomingMessageParams)) *mWebhookServiceMockPostIncomingMessage {
+	if mmPostIncomingMessage.mock.inspectFuncPostIncomingMessage != nil {
+		mmPostIncomingMessage.mock.t.Fatalf("Inspect function is already set for WebhookServiceMock.PostIncomingMessage")
+	}
+
+	mmPostIncomingMessage.mock.inspectFuncPostIncomingMessage = f
+
+	return mmPostIncomingMessage
+}
+
+// Return sets up results that will be returned by WebhookService.PostIncomingMessage
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) Return(err error) *WebhookServiceMock {
+	if mmPostIncomingMessage.mock.funcPostIncomingMessage != nil {
+		mmPostIncomingMessage.mock.t.Fatalf("WebhookServiceMock.PostIncomingMessage mock is already set by Set")
+	}
+
+	if mmPostIncomingMessage.defaultExpectation == nil {
+		mmPostIncomingMessage.defaultExpectation = &WebhookServiceMockPostIncomingMessageExpectation{mock: mmPostIncomingMessage.mock}
+	}
+	mmPostIncomingMessage.defaultExpectation.results = &WebhookServiceMockPostIncomingMessageResults{err}
+	return mmPostIncomingMessage.mock
+}
+
+// Set uses given function f to mock the WebhookService.PostIncomingMessage method
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) Set(f func(ctx context.Context, params model.PostIncomingMessageParams) (err error)) *WebhookServiceMock {
+	if mmPostIncomingMessage.defaultExpectation != nil {
+		mmPostIncomingMessage.mock.t.Fatalf("Default expectation is already set for the WebhookService.PostIncomingMessage method")
+	}
+
+	if len(mmPostIncomingMessage.expectations) > 0 {
+		mmPostIncomingMessage.mock.t.Fatalf("Some expectations are already set for the WebhookService.PostIncomingMessage method")
+	}
+
+	mmPostIncomingMessage.mock.funcPostIncomingMessage = f
+	return mmPostIncomingMessage.mock
+}
+
+// When sets expectation for the WebhookService.PostIncomingMessage which will trigger the result defined by the following
+// Then helper
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) When(ctx context.Context, params model.PostIncomingMessageParams) *WebhookServiceMockPostIncomingMessageExpectation {
+	if mmPostIncomingMessage.mock.funcPostIncomingMessage != nil {
+		mmPostIncomingMessage.mock.t.Fatalf("WebhookServiceMock.PostIncomingMessage mock is already set by Set")
+	}
+
+	expectation := &WebhookServiceMockPostIncomingMessageExpectation{
+		mock:   mmPostIncomingMessage.mock,
+		params: &WebhookServiceMockPostIncomingMessageParams{ctx, params},
+	}
+	mmPostIncomingMessage.expectations = append(mmPostIncomingMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WebhookService.PostIncomingMessage return parameters for the expectation previously defined by the When method
+func (e *WebhookServiceMockPostIncomingMessageExpectation) Then(err error) *WebhookServiceMock {
+	e.results = &WebhookServiceMockPostIncomingMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times WebhookService.PostIncomingMessage should be invoked
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) Times(n uint64) *mWebhookServiceMockPostIncomingMessage {
+	if n == 0 {
+		mmPostIncomingMessage.mock.t.Fatalf("Times of WebhookServiceMock.PostIncomingMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPostIncomingMessage.expectedInvocations, n)
+	return mmPostIncomingMessage
+}
+
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) invocationsDone() bool {
+	if len(mmPostIncomingMessage.expectations) == 0 && mmPostIncomingMessage.defaultExpectation == nil && mmPostIncomingMessage.mock.funcPostIncomingMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPostIncomingMessage.mock.afterPostIncomingMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPostIncomingMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PostIncomingMessage implements service.WebhookService
+func (mmPostIncomingMessage *WebhookServiceMock) PostIncomingMessage(ctx context.Context, params model.PostIncomingMessageParams) (err error) {
+	mm_atomic.AddUint64(&mmPostIncomingMessage.beforePostIncomingMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPostIncomingMessage.afterPostIncomingMessageCounter, 1)
+
+	if mmPostIncomingMessage.inspectFuncPostIncomingMessage != nil {
+		mmPostIncomingMessage.inspectFuncPostIncomingMessage(ctx, params)
+	}
+
+	mm_params := WebhookServiceMockPostIncomingMessageParams{ctx, params}
+
+	// Record call args
+	mmPostIncomingMessage.PostIncomingMessageMock.mutex.Lock()
+	mmPostIncomingMessage.PostIncomingMessageMock.callArgs = append(mmPostIncomingMessage.PostIncomingMessageMock.callArgs, &mm_params)
+	mmPostIncomingMessage.PostIncomingMessageMock.mutex.Unlock()
+
+	for _, e := range mmPostIncomingMessage.PostIncomingMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPostIncomingMessage.PostIncomingMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPostIncomingMessage.PostIncomingMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPostIncomingMessage.PostIncomingMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPostIncomingMessage.PostIncomingMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := WebhookServiceMockPostIncomingMessageParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPostIncomingMessage.t.Errorf("WebhookServiceMock.PostIncomingMessage got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmPostIncomingMessage.t.Errorf("WebhookServiceMock.PostIncomingMessage got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPostIncomingMessage.t.Errorf("WebhookServiceMock.PostIncomingMessage got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPostIncomingMessage.PostIncomingMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPostIncomingMessage.t.Fatal("No results are set for the WebhookServiceMock.PostIncomingMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmPostIncomingMessage.funcPostIncomingMessage != nil {
+		return mmPostIncomingMessage.funcPostIncomingMessage(ctx, params)
+	}
+	mmPostIncomingMessage.t.Fatalf("Unexpected call to WebhookServiceMock.PostIncomingMessage. %v %v", ctx, params)
+	return
+}
+
+// PostIncomingMessageAfterCounter returns a count of finished WebhookServiceMock.PostIncomingMessage invocations
+func (mmPostIncomingMessage *WebhookServiceMock) PostIncomingMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPostIncomingMessage.afterPostIncomingMessageCounter)
+}
+
+// PostIncomingMessageBeforeCounter returns a count of WebhookServiceMock.PostIncomingMessage invocations
+func (mmPostIncomingMessage *WebhookServiceMock) PostIncomingMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPostIncomingMessage.beforePostIncomingMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to WebhookServiceMock.PostIncomingMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPostIncomingMessage *mWebhookServiceMockPostIncomingMessage) Calls() []*WebhookServiceMockPostIncomingMessageParams {
+	mmPostIncomingMessage.mutex.RLock()
+
+	argCopy := make([]*WebhookServiceMockPostIncomingMessageParams, len(mmPostIncomingMessage.callArgs))
+	copy(argCopy, mmPostIncomingMessage.callArgs)
+
+	mmPostIncomingMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPostIncomingMessageDone returns true if the count of the PostIncomingMessage invocations corresponds
+// the number of defined expectations
+func (m *WebhookServiceMock) MinimockPostIncomingMessageDone() bool {
+	if m.PostIncomingMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PostIncomingMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PostIncomingMessageMock.invocationsDone()
+}
+
+// MinimockPostIncomingMessageInspect logs each unmet expectation
+func (m *WebhookServiceMock) MinimockPostIncomingMessageInspect() {
+	for _, e := range m.PostIncomingMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WebhookServiceMock.PostIncomingMessage with params: %#v", *e.params)
+		}
+	}
+
+	afterPostIncomingMessageCounter := mm_atomic.LoadUint64(&m.afterPostIncomingMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PostIncomingMessageMock.defaultExpectation != nil && afterPostIncomingMessageCounter < 1 {
+		if m.PostIncomingMessageMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WebhookServiceMock.PostIncomingMessage")
+		} else {
+			m.t.Errorf("Expected call to WebhookServiceMock.PostIncomingMessage with params: %#v", *m.PostIncomingMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPostIncomingMessage != nil && afterPostIncomingMessageCounter < 1 {
+		m.t.Error("Expected call to WebhookServiceMock.PostIncomingMessage")
+	}
+
+	if !m.PostIncomingMessageMock.invocationsDone() && afterPostIncomingMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to WebhookServiceMock.PostIncomingMessage but found %d calls",
+			mm_atomic.LoadUint64(&m.PostIncomingMessageMock.expectedInvocations), afterPostIncomingMessageCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *WebhookServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateIncomingWebhookInspect()
+
+			m.MinimockCreateWebhookInspect()
+
+			m.MinimockDeleteIncomingWebhookInspect()
+
+			m.MinimockDeleteWebhookInspect()
+
+			m.MinimockListIncomingWebhooksInspect()
+
+			m.MinimockListWebhooksInspect()
+
+			m.MinimockPostIncomingMessageInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *WebhookServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *WebhookServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateIncomingWebhookDone() &&
+		m.MinimockCreateWebhookDone() &&
+		m.MinimockDeleteIncomingWebhookDone() &&
+		m.MinimockDeleteWebhookDone() &&
+		m.MinimockListIncomingWebhooksDone() &&
+		m.MinimockListWebhooksDone() &&
+		m.MinimockPostIncomingMessageDone()
+}
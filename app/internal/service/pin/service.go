@@ -0,0 +1,156 @@
+package pin
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
+)
+
+type pinService struct {
+	pinRepository    repository.PinRepository
+	updateRepository repository.ChatUpdateRepository
+	txManager        db.TxManager
+}
+
+// NewService creates a new instance of pinService with the provided PinRepository, ChatUpdateRepository
+// and TxManager.
+func NewService(
+	pinRepository repository.PinRepository,
+	updateRepository repository.ChatUpdateRepository,
+	txManager db.TxManager,
+) service.PinService {
+	return &pinService{
+		pinRepository:    pinRepository,
+		updateRepository: updateRepository,
+		txManager:        txManager,
+	}
+}
+
+// PinMessage pins the message within a transaction, in which the pin is broadcast to the subscribers
+// of the chat.
+func (s *pinService) PinMessage(
+	ctx context.Context,
+	params model.PinMessageParams,
+) (pinned model.PinnedMessage, err error) {
+	logger.FromContext(ctx).Debug("pinService.PinMessage", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "pinService.PinMessage")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		txErr := s.checkPolicy(ctx, params.ChatID, params.From, params.Admin)
+		if txErr != nil {
+			return txErr
+		}
+
+		pinned, txErr = s.pinRepository.PinMessage(ctx, params)
+		if txErr != nil {
+			return txErr
+		}
+
+		update := pinned
+		update.Text, update.Truncated = model.TruncateUpdateText(pinned.Text)
+
+		return s.updateRepository.NotifyChatUpdate(ctx, model.CreateChatUpdateParams{
+			ChatID:  params.ChatID,
+			Type:    model.UpdateTypeMessagePinned,
+			Sender:  params.From,
+			Payload: update,
+		})
+	})
+	if err != nil {
+		return model.PinnedMessage{}, errors.Wrapf(err, "Transaction failed")
+	}
+
+	return pinned, nil
+}
+
+// UnpinMessage unpins the message within a transaction, in which the unpin is broadcast to the subscribers
+// of the chat.
+func (s *pinService) UnpinMessage(ctx context.Context, params model.UnpinMessageParams) (err error) {
+	logger.FromContext(ctx).Debug("pinService.UnpinMessage", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "pinService.UnpinMessage")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		txErr := s.checkPolicy(ctx, params.ChatID, params.From, params.Admin)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.pinRepository.UnpinMessage(ctx, params)
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.updateRepository.NotifyChatUpdate(ctx, model.CreateChatUpdateParams{
+			ChatID: params.ChatID,
+			Type:   model.UpdateTypeMessageUnpinned,
+			Sender: params.From,
+			Payload: model.UnpinnedMessage{
+				ChatID:     params.ChatID,
+				MessageID:  params.MessageID,
+				UnpinnedBy: params.From,
+			},
+		})
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Transaction failed")
+	}
+
+	return nil
+}
+
+// ListPinnedMessages returns the pinned messages of the chat.
+func (s *pinService) ListPinnedMessages(
+	ctx context.Context,
+	params model.ListPinnedMessagesParams,
+) (pinned []model.PinnedMessage, err error) {
+	logger.FromContext(ctx).Debug("pinService.ListPinnedMessages", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "pinService.ListPinnedMessages")
+	defer func() { tracing.End(span, err) }()
+
+	return s.pinRepository.ListPinnedMessages(ctx, params)
+}
+
+// SetPinPolicy sets the pin policy of the chat.
+func (s *pinService) SetPinPolicy(ctx context.Context, params model.SetPinPolicyParams) (err error) {
+	logger.FromContext(ctx).Debug("pinService.SetPinPolicy", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "pinService.SetPinPolicy")
+	defer func() { tracing.End(span, err) }()
+
+	switch params.Policy {
+	case model.PinPolicyAdmins, model.PinPolicyParticipants:
+	default:
+		return errors.Wrapf(model.ErrInvalidArgument, "unknown pin policy %q", params.Policy)
+	}
+
+	return s.pinRepository.SetPinPolicy(ctx, params)
+}
+
+// checkPolicy returns model.ErrPermissionDenied unless the pin policy of the chat allows the caller
+// to pin and unpin its messages.
+func (s *pinService) checkPolicy(ctx context.Context, chatID int64, from string, admin bool) error {
+	policy, err := s.pinRepository.GetPinPolicy(ctx, model.GetPinPolicyParams{ChatID: chatID, From: from})
+	if err != nil {
+		return err
+	}
+
+	if !policy.Allows(admin) {
+		return errors.Wrapf(model.ErrPermissionDenied, "pins of chat(chatID: %d) are restricted to %s",
+			chatID, policy.Policy)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	pinService "github.com/Prrromanssss/chat-server/internal/service/pin"
+)
+
+func TestPinMessage(t *testing.T) {
+	t.Parallel()
+
+	type (
+		pinRepositoryMockFunc    func(mc *minimock.Controller) repository.PinRepository
+		updateRepositoryMockFunc func(mc *minimock.Controller) repository.ChatUpdateRepository
+	)
+
+	const (
+		chatID    = int64(7)
+		messageID = int64(42)
+		from      = "bob@example.com"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ErrPinRepository = errors.New("pin repository error")
+
+		participantReq = model.PinMessageParams{ChatID: chatID, MessageID: messageID, From: from}
+		adminReq       = model.PinMessageParams{ChatID: chatID, MessageID: messageID, From: "support", Admin: true}
+
+		pinned = model.PinnedMessage{
+			ChatID:    chatID,
+			MessageID: messageID,
+			From:      "alice@example.com",
+			Text:      "Release on Friday",
+			Type:      "text",
+			SentAt:    time.Date(2024, time.October, 20, 9, 0, 0, 0, time.UTC),
+			PinnedBy:  from,
+			PinnedAt:  time.Date(2024, time.October, 20, 10, 0, 0, 0, time.UTC),
+		}
+
+		withPolicy = func(policy model.PinPolicy, req model.PinMessageParams) pinRepositoryMockFunc {
+			return func(mc *minimock.Controller) repository.PinRepository {
+				mock := repositoryMocks.NewPinRepositoryMock(mc)
+				mock.GetPinPolicyMock.Expect(minimock.AnyContext, model.GetPinPolicyParams{ChatID: chatID, From: req.From}).
+					Return(policy, nil)
+				mock.PinMessageMock.Expect(minimock.AnyContext, req).Return(pinned, nil)
+
+				return mock
+			}
+		}
+
+		denied = func(policy model.PinPolicy) pinRepositoryMockFunc {
+			return func(mc *minimock.Controller) repository.PinRepository {
+				mock := repositoryMocks.NewPinRepositoryMock(mc)
+				mock.GetPinPolicyMock.Return(policy, nil)
+
+				return mock
+			}
+		}
+
+		notified = func(sender string) updateRepositoryMockFunc {
+			return func(mc *minimock.Controller) repository.ChatUpdateRepository {
+				mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+				mock.NotifyChatUpdateMock.Expect(minimock.AnyContext, model.CreateChatUpdateParams{
+					ChatID:  chatID,
+					Type:    model.UpdateTypeMessagePinned,
+					Sender:  sender,
+					Payload: pinned,
+				}).Return(nil)
+
+				return mock
+			}
+		}
+
+		noUpdate = func(mc *minimock.Controller) repository.ChatUpdateRepository {
+			return repositoryMocks.NewChatUpdateRepositoryMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		req                  model.PinMessageParams
+		err                  error
+		pinRepositoryMock    pinRepositoryMockFunc
+		updateRepositoryMock updateRepositoryMockFunc
+	}{
+		{
+			name:                 "administrator pins in a chat restricted to the administrators",
+			req:                  adminReq,
+			pinRepositoryMock:    withPolicy(model.PinPolicy{Policy: model.PinPolicyAdmins}, adminReq),
+			updateRepositoryMock: notified(adminReq.From),
+		},
+		{
+			name: "participant pins in a chat open to the participants",
+			req:  participantReq,
+			pinRepositoryMock: withPolicy(
+				model.PinPolicy{Policy: model.PinPolicyParticipants, Participant: true},
+				participantReq,
+			),
+			updateRepositoryMock: notified(from),
+		},
+		{
+			name:                 "participant denied in a chat restricted to the administrators",
+			req:                  participantReq,
+			err:                  model.ErrPermissionDenied,
+			pinRepositoryMock:    denied(model.PinPolicy{Policy: model.PinPolicyAdmins, Participant: true}),
+			updateRepositoryMock: noUpdate,
+		},
+		{
+			name:                 "outsider denied in a chat open to the participants",
+			req:                  participantReq,
+			err:                  model.ErrPermissionDenied,
+			pinRepositoryMock:    denied(model.PinPolicy{Policy: model.PinPolicyParticipants}),
+			updateRepositoryMock: noUpdate,
+		},
+		{
+			name: "unknown chat",
+			req:  participantReq,
+			err:  model.ErrNotFound,
+			pinRepositoryMock: func(mc *minimock.Controller) repository.PinRepository {
+				mock := repositoryMocks.NewPinRepositoryMock(mc)
+				mock.GetPinPolicyMock.Return(model.PinPolicy{}, errors.Wrap(model.ErrNotFound, "chat"))
+
+				return mock
+			},
+			updateRepositoryMock: noUpdate,
+		},
+		{
+			name: "message already pinned",
+			req:  adminReq,
+			err:  model.ErrAlreadyExists,
+			pinRepositoryMock: func(mc *minimock.Controller) repository.PinRepository {
+				mock := repositoryMocks.NewPinRepositoryMock(mc)
+				mock.GetPinPolicyMock.Return(model.PinPolicy{Policy: model.PinPolicyAdmins}, nil)
+				mock.PinMessageMock.Return(model.PinnedMessage{}, errors.Wrap(model.ErrAlreadyExists, "pin"))
+
+				return mock
+			},
+			updateRepositoryMock: noUpdate,
+		},
+		{
+			name: "pin repository error",
+			req:  adminReq,
+			err:  ErrPinRepository,
+			pinRepositoryMock: func(mc *minimock.Controller) repository.PinRepository {
+				mock := repositoryMocks.NewPinRepositoryMock(mc)
+				mock.GetPinPolicyMock.Return(model.PinPolicy{Policy: model.PinPolicyAdmins}, nil)
+				mock.PinMessageMock.Return(model.PinnedMessage{}, ErrPinRepository)
+
+				return mock
+			},
+			updateRepositoryMock: noUpdate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			service := pinService.NewService(tt.pinRepositoryMock(mc), tt.updateRepositoryMock(mc), txManagerMock)
+
+			resp, err := service.PinMessage(ctx, tt.req)
+			require.ErrorIs(t, err, tt.err)
+
+			if tt.err == nil {
+				require.Equal(t, pinned, resp)
+			}
+		})
+	}
+}
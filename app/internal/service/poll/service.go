@@ -0,0 +1,242 @@
+package poll
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/metric"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
+)
+
+type pollService struct {
+	chatRepository   repository.ChatRepository
+	pollRepository   repository.PollRepository
+	outboxRepository repository.OutboxRepository
+	updateRepository repository.ChatUpdateRepository
+	txManager        db.TxManager
+}
+
+// NewService creates a new instance of pollService with the provided ChatRepository, PollRepository,
+// OutboxRepository, ChatUpdateRepository and TxManager.
+func NewService(
+	chatRepository repository.ChatRepository,
+	pollRepository repository.PollRepository,
+	outboxRepository repository.OutboxRepository,
+	updateRepository repository.ChatUpdateRepository,
+	txManager db.TxManager,
+) service.PollService {
+	return &pollService{
+		chatRepository:   chatRepository,
+		pollRepository:   pollRepository,
+		outboxRepository: outboxRepository,
+		updateRepository: updateRepository,
+		txManager:        txManager,
+	}
+}
+
+// CreatePoll posts the question of a participant of the chat as a message of the poll type and creates
+// the poll attached to it within a transaction, in which the message.sent event is stored in the outbox and the poll is broadcast
+// to the subscribers of the chat.
+func (s *pollService) CreatePoll(
+	ctx context.Context,
+	params model.CreatePollParams,
+) (resp model.CreatePollResponse, err error) {
+	logger.FromContext(ctx).Debug("pollService.CreatePoll", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "pollService.CreatePoll")
+	defer func() { tracing.End(span, err) }()
+
+	sentAt := time.Now().UTC()
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		participant, txErr := s.chatRepository.IsParticipant(ctx, params.ChatID, params.From)
+		if txErr != nil {
+			return txErr
+		}
+
+		if !participant {
+			return errors.Wrapf(model.ErrPermissionDenied, "not a participant of chat(chatID: %d)", params.ChatID)
+		}
+
+		message, txErr := s.chatRepository.SendMessage(ctx, model.SendMessageParams{
+			ChatID: params.ChatID,
+			From:   params.From,
+			Text:   params.Question,
+			SentAt: sentAt,
+			Type:   model.MessageTypePoll,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		params.MessageID = message.MessageID
+
+		pollID, txErr := s.pollRepository.CreatePoll(ctx, params)
+		if txErr != nil {
+			return txErr
+		}
+
+		resp = model.CreatePollResponse{
+			PollID:    pollID,
+			MessageID: message.MessageID,
+		}
+
+		txErr = s.outboxRepository.CreateEvent(ctx, model.CreateEventParams{
+			Type:   model.EventTypeMessageSent,
+			ChatID: params.ChatID,
+			Payload: model.MessageSentEvent{
+				MessageID: message.MessageID,
+				ChatID:    params.ChatID,
+				From:      params.From,
+				Text:      params.Question,
+				SentAt:    sentAt,
+				Type:      model.MessageTypePoll,
+			},
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.notifyPollUpdated(ctx, pollID)
+	})
+	if err != nil {
+		return model.CreatePollResponse{}, errors.Wrapf(err, "Transaction failed")
+	}
+
+	metric.IncMessagesSent()
+
+	return resp, nil
+}
+
+// Vote checks the ballot, with its repeated options counted once, against the poll and stores it within
+// a transaction, in which the new results are broadcast to the subscribers of the chat. The database allows
+// a single ballot per participant.
+func (s *pollService) Vote(ctx context.Context, params model.VoteParams) (err error) {
+	logger.FromContext(ctx).Debug("pollService.Vote", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "pollService.Vote")
+	defer func() { tracing.End(span, err) }()
+
+	params.OptionIDs = uniqueOptionIDs(params.OptionIDs)
+	if len(params.OptionIDs) == 0 {
+		return errors.Wrap(model.ErrInvalidArgument, "no option chosen")
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		poll, txErr := s.pollRepository.GetPoll(ctx, model.GetPollParams{PollID: params.PollID})
+		if txErr != nil {
+			return txErr
+		}
+
+		if poll.IsClosed(time.Now().UTC()) {
+			return errors.Wrapf(model.ErrPollClosed, "poll(pollID: %d)", params.PollID)
+		}
+
+		if !poll.MultiChoice && len(params.OptionIDs) != 1 {
+			return errors.Wrap(model.ErrInvalidArgument, "the poll allows a single option")
+		}
+
+		for _, optionID := range params.OptionIDs {
+			if !poll.HasOption(optionID) {
+				return errors.Wrapf(model.ErrInvalidArgument, "option %d is not an option of the poll", optionID)
+			}
+		}
+
+		txErr = s.pollRepository.Vote(ctx, params)
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.notifyPollUpdated(ctx, params.PollID)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Transaction failed")
+	}
+
+	return nil
+}
+
+// ClosePoll closes the poll on behalf of its author or an administrator within a transaction,
+// in which the final results are broadcast to the subscribers of the chat.
+func (s *pollService) ClosePoll(ctx context.Context, params model.ClosePollParams) (err error) {
+	logger.FromContext(ctx).Debug("pollService.ClosePoll", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "pollService.ClosePoll")
+	defer func() { tracing.End(span, err) }()
+
+	params.ClosedAt = time.Now().UTC()
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		poll, txErr := s.pollRepository.GetPoll(ctx, model.GetPollParams{PollID: params.PollID})
+		if txErr != nil {
+			return txErr
+		}
+
+		if !params.Admin && poll.CreatedBy != params.From {
+			return errors.Wrapf(model.ErrPermissionDenied, "only the author may close poll(pollID: %d)", params.PollID)
+		}
+
+		txErr = s.pollRepository.ClosePoll(ctx, params)
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.notifyPollUpdated(ctx, params.PollID)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Transaction failed")
+	}
+
+	return nil
+}
+
+// GetPoll returns the poll with its results.
+func (s *pollService) GetPoll(ctx context.Context, params model.GetPollParams) (poll model.Poll, err error) {
+	logger.FromContext(ctx).Debug("pollService.GetPoll", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "pollService.GetPoll")
+	defer func() { tracing.End(span, err) }()
+
+	return s.pollRepository.GetPoll(ctx, params)
+}
+
+// uniqueOptionIDs returns the option ids without the repeated ones, in the order they are first given.
+func uniqueOptionIDs(optionIDs []int64) []int64 {
+	seen := make(map[int64]struct{}, len(optionIDs))
+	unique := make([]int64, 0, len(optionIDs))
+
+	for _, optionID := range optionIDs {
+		if _, ok := seen[optionID]; ok {
+			continue
+		}
+
+		seen[optionID] = struct{}{}
+		unique = append(unique, optionID)
+	}
+
+	return unique
+}
+
+// notifyPollUpdated broadcasts the current results of the poll to the subscribers of its chat
+// once the transaction commits.
+func (s *pollService) notifyPollUpdated(ctx context.Context, pollID int64) error {
+	poll, err := s.pollRepository.GetPoll(ctx, model.GetPollParams{PollID: pollID})
+	if err != nil {
+		return err
+	}
+
+	return s.updateRepository.NotifyChatUpdate(ctx, model.CreateChatUpdateParams{
+		ChatID:  poll.ChatID,
+		Type:    model.UpdateTypePollUpdated,
+		Sender:  poll.CreatedBy,
+		Payload: poll,
+	})
+}
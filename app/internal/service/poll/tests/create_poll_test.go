@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	pollService "github.com/Prrromanssss/chat-server/internal/service/poll"
+)
+
+func TestCreatePoll(t *testing.T) {
+	t.Parallel()
+
+	type (
+		chatRepositoryMockFunc   func(mc *minimock.Controller) repository.ChatRepository
+		pollRepositoryMockFunc   func(mc *minimock.Controller) repository.PollRepository
+		outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+		updateRepositoryMockFunc func(mc *minimock.Controller) repository.ChatUpdateRepository
+	)
+
+	const (
+		pollID    = int64(3)
+		messageID = int64(5)
+		chatID    = int64(7)
+		author    = "alice@example.com"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ErrChatRepository = errors.New("chat repository error")
+
+		req = model.CreatePollParams{
+			ChatID:   chatID,
+			From:     author,
+			Question: "Lunch?",
+			Options:  []string{"Pizza", "Sushi"},
+		}
+
+		poll = model.Poll{
+			ID:        pollID,
+			ChatID:    chatID,
+			MessageID: messageID,
+			CreatedBy: author,
+			Question:  "Lunch?",
+			Options:   []model.PollOption{{ID: 10, Text: "Pizza"}, {ID: 11, Text: "Sushi"}},
+		}
+
+		participant = func(participant bool, err error) chatRepositoryMockFunc {
+			return func(mc *minimock.Controller) repository.ChatRepository {
+				mock := repositoryMocks.NewChatRepositoryMock(mc)
+				mock.IsParticipantMock.Expect(minimock.AnyContext, chatID, author).Return(participant, err)
+
+				return mock
+			}
+		}
+
+		noPoll = func(mc *minimock.Controller) repository.PollRepository {
+			return repositoryMocks.NewPollRepositoryMock(mc)
+		}
+
+		noEvent = func(mc *minimock.Controller) repository.OutboxRepository {
+			return repositoryMocks.NewOutboxRepositoryMock(mc)
+		}
+
+		noUpdate = func(mc *minimock.Controller) repository.ChatUpdateRepository {
+			return repositoryMocks.NewChatUpdateRepositoryMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		expected             model.CreatePollResponse
+		err                  error
+		chatRepositoryMock   chatRepositoryMockFunc
+		pollRepositoryMock   pollRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+		updateRepositoryMock updateRepositoryMockFunc
+	}{
+		{
+			name:     "success case posts the question and broadcasts the poll",
+			expected: model.CreatePollResponse{PollID: pollID, MessageID: messageID},
+			chatRepositoryMock: func(mc *minimock.Controller) repository.ChatRepository {
+				mock := participant(true, nil)(mc).(*repositoryMocks.ChatRepositoryMock)
+				mock.SendMessageMock.Return(model.SendMessageResponse{MessageID: messageID}, nil)
+
+				return mock
+			},
+			pollRepositoryMock: func(mc *minimock.Controller) repository.PollRepository {
+				created := req
+				created.MessageID = messageID
+
+				mock := repositoryMocks.NewPollRepositoryMock(mc)
+				mock.CreatePollMock.Expect(minimock.AnyContext, created).Return(pollID, nil)
+				mock.GetPollMock.Expect(minimock.AnyContext, model.GetPollParams{PollID: pollID}).Return(poll, nil)
+
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateEventMock.Return(nil)
+
+				return mock
+			},
+			updateRepositoryMock: func(mc *minimock.Controller) repository.ChatUpdateRepository {
+				mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+				mock.NotifyChatUpdateMock.Expect(minimock.AnyContext, model.CreateChatUpdateParams{
+					ChatID:  chatID,
+					Type:    model.UpdateTypePollUpdated,
+					Sender:  author,
+					Payload: poll,
+				}).Return(nil)
+
+				return mock
+			},
+		},
+		{
+			name:                 "not a participant",
+			err:                  model.ErrPermissionDenied,
+			chatRepositoryMock:   participant(false, nil),
+			pollRepositoryMock:   noPoll,
+			outboxRepositoryMock: noEvent,
+			updateRepositoryMock: noUpdate,
+		},
+		{
+			name:                 "chat repository error",
+			err:                  ErrChatRepository,
+			chatRepositoryMock:   participant(false, ErrChatRepository),
+			pollRepositoryMock:   noPoll,
+			outboxRepositoryMock: noEvent,
+			updateRepositoryMock: noUpdate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			service := pollService.NewService(
+				tt.chatRepositoryMock(mc),
+				tt.pollRepositoryMock(mc),
+				tt.outboxRepositoryMock(mc),
+				tt.updateRepositoryMock(mc),
+				txManagerMock,
+			)
+
+			resp, err := service.CreatePoll(ctx, req)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.expected, resp)
+		})
+	}
+}
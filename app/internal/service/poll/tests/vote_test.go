@@ -0,0 +1,226 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	pollService "github.com/Prrromanssss/chat-server/internal/service/poll"
+)
+
+func TestVote(t *testing.T) {
+	t.Parallel()
+
+	type (
+		pollRepositoryMockFunc   func(mc *minimock.Controller) repository.PollRepository
+		updateRepositoryMockFunc func(mc *minimock.Controller) repository.ChatUpdateRepository
+	)
+
+	const (
+		pollID = int64(3)
+		chatID = int64(7)
+		voter  = "bob@example.com"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ErrPollRepository = errors.New("poll repository error")
+
+		past = time.Now().Add(-time.Hour)
+
+		poll = model.Poll{
+			ID:        pollID,
+			ChatID:    chatID,
+			CreatedBy: "alice@example.com",
+			Question:  "Lunch?",
+			Options:   []model.PollOption{{ID: 10, Text: "Pizza"}, {ID: 11, Text: "Sushi"}},
+		}
+
+		voted = model.Poll{
+			ID:        pollID,
+			ChatID:    chatID,
+			CreatedBy: "alice@example.com",
+			Question:  "Lunch?",
+			Options:   []model.PollOption{{ID: 10, Text: "Pizza", Votes: 1}, {ID: 11, Text: "Sushi"}},
+			Voters:    1,
+		}
+
+		withPoll = func(poll model.Poll) pollRepositoryMockFunc {
+			return func(mc *minimock.Controller) repository.PollRepository {
+				mock := repositoryMocks.NewPollRepositoryMock(mc)
+				mock.GetPollMock.Expect(minimock.AnyContext, model.GetPollParams{PollID: pollID}).Return(poll, nil)
+
+				return mock
+			}
+		}
+
+		noUpdate = func(mc *minimock.Controller) repository.ChatUpdateRepository {
+			return repositoryMocks.NewChatUpdateRepositoryMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		req                  model.VoteParams
+		err                  error
+		pollRepositoryMock   pollRepositoryMockFunc
+		updateRepositoryMock updateRepositoryMockFunc
+	}{
+		{
+			name: "success case broadcasts the results",
+			req:  model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{10}},
+			pollRepositoryMock: func(mc *minimock.Controller) repository.PollRepository {
+				mock := repositoryMocks.NewPollRepositoryMock(mc)
+				mock.VoteMock.Expect(minimock.AnyContext, model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{10}}).
+					Return(nil)
+				mock.GetPollMock.Set(func(_ context.Context, _ model.GetPollParams) (model.Poll, error) {
+					if len(mock.VoteMock.Calls()) > 0 {
+						return voted, nil
+					}
+
+					return poll, nil
+				})
+
+				return mock
+			},
+			updateRepositoryMock: func(mc *minimock.Controller) repository.ChatUpdateRepository {
+				mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+				mock.NotifyChatUpdateMock.Expect(minimock.AnyContext, model.CreateChatUpdateParams{
+					ChatID:  chatID,
+					Type:    model.UpdateTypePollUpdated,
+					Sender:  voted.CreatedBy,
+					Payload: voted,
+				}).Return(nil)
+
+				return mock
+			},
+		},
+		{
+			name:                 "unknown poll",
+			req:                  model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{10}},
+			err:                  model.ErrNotFound,
+			updateRepositoryMock: noUpdate,
+			pollRepositoryMock: func(mc *minimock.Controller) repository.PollRepository {
+				mock := repositoryMocks.NewPollRepositoryMock(mc)
+				mock.GetPollMock.Return(model.Poll{}, errors.Wrap(model.ErrNotFound, "poll"))
+
+				return mock
+			},
+		},
+		{
+			name:                 "closed poll",
+			req:                  model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{10}},
+			err:                  model.ErrPollClosed,
+			updateRepositoryMock: noUpdate,
+			pollRepositoryMock:   withPoll(model.Poll{ID: pollID, ChatID: chatID, ClosedAt: &past}),
+		},
+		{
+			name:                 "expired poll",
+			req:                  model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{10}},
+			err:                  model.ErrPollClosed,
+			updateRepositoryMock: noUpdate,
+			pollRepositoryMock:   withPoll(model.Poll{ID: pollID, ChatID: chatID, ClosesAt: &past}),
+		},
+		{
+			name:                 "several options of a single choice poll",
+			req:                  model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{10, 11}},
+			err:                  model.ErrInvalidArgument,
+			updateRepositoryMock: noUpdate,
+			pollRepositoryMock:   withPoll(poll),
+		},
+		{
+			name: "repeated option counted once",
+			req:  model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{10, 10}},
+			pollRepositoryMock: func(mc *minimock.Controller) repository.PollRepository {
+				mock := repositoryMocks.NewPollRepositoryMock(mc)
+				mock.GetPollMock.Return(poll, nil)
+				mock.VoteMock.Expect(minimock.AnyContext, model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{10}}).
+					Return(nil)
+
+				return mock
+			},
+			updateRepositoryMock: func(mc *minimock.Controller) repository.ChatUpdateRepository {
+				mock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+				mock.NotifyChatUpdateMock.Return(nil)
+
+				return mock
+			},
+		},
+		{
+			name:                 "no option",
+			req:                  model.VoteParams{PollID: pollID, From: voter},
+			err:                  model.ErrInvalidArgument,
+			updateRepositoryMock: noUpdate,
+			pollRepositoryMock: func(mc *minimock.Controller) repository.PollRepository {
+				return repositoryMocks.NewPollRepositoryMock(mc)
+			},
+		},
+		{
+			name:                 "option of another poll",
+			req:                  model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{12}},
+			err:                  model.ErrInvalidArgument,
+			updateRepositoryMock: noUpdate,
+			pollRepositoryMock:   withPoll(poll),
+		},
+		{
+			name:                 "second ballot",
+			req:                  model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{10}},
+			err:                  model.ErrAlreadyExists,
+			updateRepositoryMock: noUpdate,
+			pollRepositoryMock: func(mc *minimock.Controller) repository.PollRepository {
+				mock := repositoryMocks.NewPollRepositoryMock(mc)
+				mock.GetPollMock.Return(poll, nil)
+				mock.VoteMock.Return(errors.Wrap(model.ErrAlreadyExists, "ballot"))
+
+				return mock
+			},
+		},
+		{
+			name:                 "poll repository error",
+			req:                  model.VoteParams{PollID: pollID, From: voter, OptionIDs: []int64{10}},
+			err:                  ErrPollRepository,
+			updateRepositoryMock: noUpdate,
+			pollRepositoryMock: func(mc *minimock.Controller) repository.PollRepository {
+				mock := repositoryMocks.NewPollRepositoryMock(mc)
+				mock.GetPollMock.Return(poll, nil)
+				mock.VoteMock.Return(ErrPollRepository)
+
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) error {
+				return f(ctx)
+			})
+
+			service := pollService.NewService(
+				nil,
+				tt.pollRepositoryMock(mc),
+				nil,
+				tt.updateRepositoryMock(mc),
+				txManagerMock,
+			)
+
+			err := service.Vote(ctx, tt.req)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
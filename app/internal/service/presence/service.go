@@ -0,0 +1,79 @@
+package presence
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/presence"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+)
+
+// disconnectTimeout bounds the time spent recording a disconnection after the stream has ended.
+const disconnectTimeout = 5 * time.Second
+
+type presenceService struct {
+	tracker            *presence.Tracker
+	presenceRepository repository.PresenceRepository
+	cfg                config.Presence
+}
+
+// NewService creates a new instance of presenceService with the provided Tracker of the connections
+// of this instance and PresenceRepository.
+func NewService(
+	tracker *presence.Tracker,
+	presenceRepository repository.PresenceRepository,
+	cfg config.Presence,
+) service.PresenceService {
+	return &presenceService{
+		tracker:            tracker,
+		presenceRepository: presenceRepository,
+		cfg:                cfg,
+	}
+}
+
+// Connect marks the user online until the context is done.
+func (s *presenceService) Connect(ctx context.Context, params model.ConnectParams) (err error) {
+	logger.FromContext(ctx).Debug("presenceService.Connect", slog.Any("params", params))
+
+	disconnect, err := s.tracker.Connect(ctx, params.Email)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		disconnectCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), disconnectTimeout)
+		defer cancel()
+
+		disconnect(disconnectCtx)
+	}()
+
+	return nil
+}
+
+// Heartbeat marks the user seen, away if idle.
+func (s *presenceService) Heartbeat(ctx context.Context, params model.HeartbeatParams) (err error) {
+	logger.FromContext(ctx).Debug("presenceService.Heartbeat", slog.Any("params", params))
+
+	return s.tracker.Heartbeat(ctx, params)
+}
+
+// GetPresence returns the presence of the known users among the emails, computed from the sessions
+// seen within the timeout.
+func (s *presenceService) GetPresence(
+	ctx context.Context,
+	params model.GetPresenceParams,
+) (presences []model.Presence, err error) {
+	logger.FromContext(ctx).Debug("presenceService.GetPresence", slog.Any("params", params))
+
+	return s.presenceRepository.ListPresence(ctx, model.ListPresenceParams{
+		Emails: params.Emails,
+		Since:  time.Now().UTC().Add(-s.cfg.Timeout),
+	})
+}
@@ -0,0 +1,80 @@
+package schedule
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+)
+
+type scheduleService struct {
+	scheduledMessageRepository repository.ScheduledMessageRepository
+	cfg                        config.Scheduler
+}
+
+// NewService creates a new instance of scheduleService with the provided ScheduledMessageRepository
+// and settings.
+func NewService(
+	scheduledMessageRepository repository.ScheduledMessageRepository,
+	cfg config.Scheduler,
+) service.ScheduleService {
+	return &scheduleService{
+		scheduledMessageRepository: scheduledMessageRepository,
+		cfg:                        cfg,
+	}
+}
+
+// ScheduleMessage stores the message for the scheduler to send it at params.SendAt.
+func (s *scheduleService) ScheduleMessage(
+	ctx context.Context,
+	params model.ScheduleMessageParams,
+) (scheduledMessageID int64, err error) {
+	logger.FromContext(ctx).Debug("scheduleService.ScheduleMessage", slog.Any("params", params))
+
+	if strings.TrimSpace(params.Text) == "" {
+		return 0, errors.Wrap(model.ErrInvalidArgument, "text is empty")
+	}
+
+	now := time.Now()
+	params.SendAt = params.SendAt.UTC()
+
+	if !params.SendAt.After(now) {
+		return 0, errors.Wrap(model.ErrInvalidArgument, "send_at is not in the future")
+	}
+
+	if s.cfg.MaxDelay > 0 && params.SendAt.After(now.Add(s.cfg.MaxDelay)) {
+		return 0, errors.Wrapf(model.ErrInvalidArgument, "send_at is more than %s ahead", s.cfg.MaxDelay)
+	}
+
+	return s.scheduledMessageRepository.CreateScheduledMessage(ctx, params)
+}
+
+// ListScheduledMessages returns the messages scheduled by the sender and not sent yet.
+func (s *scheduleService) ListScheduledMessages(
+	ctx context.Context,
+	params model.ListScheduledMessagesParams,
+) (messages []model.ScheduledMessage, err error) {
+	logger.FromContext(ctx).Debug("scheduleService.ListScheduledMessages", slog.Any("params", params))
+
+	return s.scheduledMessageRepository.ListScheduledMessages(ctx, params)
+}
+
+// CancelScheduledMessage cancels a message scheduled by the sender and not sent yet.
+func (s *scheduleService) CancelScheduledMessage(
+	ctx context.Context,
+	params model.CancelScheduledMessageParams,
+) (err error) {
+	logger.FromContext(ctx).Debug("scheduleService.CancelScheduledMessage", slog.Any("params", params))
+
+	params.CancelledAt = time.Now().UTC()
+
+	return s.scheduledMessageRepository.CancelScheduledMessage(ctx, params)
+}
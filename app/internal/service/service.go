@@ -16,4 +16,204 @@ type ChatService interface {
 
 	// SendMessage sends a message with the specified parameters.
 	SendMessage(ctx context.Context, params model.SendMessageParams) (err error)
+
+	// PostMessage sends a message like SendMessage without running the command it starts with, so that
+	// a caller sending it within its own transaction runs the command with RunCommand once it commits.
+	PostMessage(ctx context.Context, params model.SendMessageParams) (err error)
+
+	// RunCommand runs the command the sent message starts with, if any, and sends its reply to the chat.
+	RunCommand(ctx context.Context, params model.SendMessageParams)
+
+	// ListMessages returns a page of the history of the chat, newest first, without the messages
+	// of the users blocked by the reader. Only the participants of the chat may read it.
+	ListMessages(ctx context.Context, params model.ListMessagesParams) (messages []model.Message, err error)
+
+	// UpdateChatSettings updates the settings of the chat for the participant and returns the settings,
+	// or returns model.ErrNotFound if the user does not participate in the chat.
+	UpdateChatSettings(
+		ctx context.Context,
+		params model.UpdateChatSettingsParams,
+	) (settings model.ChatSettings, err error)
+
+	// ListChats returns the chats of the participant with their settings.
+	ListChats(ctx context.Context, params model.ListChatsParams) (chats []model.UserChat, err error)
+
+	// ListMentions returns a page of the mentions of the user, newest first.
+	ListMentions(ctx context.Context, params model.ListMentionsParams) (mentions []model.Mention, err error)
+}
+
+// AuditService defines methods for investigating the audit log of api actions.
+type AuditService interface {
+	// ListAuditLog returns a page of the audit log entries matching the filters, newest first.
+	ListAuditLog(ctx context.Context, params model.ListAuditLogParams) (resp model.ListAuditLogResponse, err error)
+}
+
+// WebhookService defines methods for managing the outgoing and incoming webhooks.
+type WebhookService interface {
+	// CreateWebhook subscribes an endpoint to chat events and returns its ID and signing secret.
+	CreateWebhook(ctx context.Context, params model.CreateWebhookParams) (resp model.CreateWebhookResponse, err error)
+
+	// ListWebhooks returns the webhooks matching the filter.
+	ListWebhooks(ctx context.Context, params model.ListWebhooksParams) (webhooks []model.Webhook, err error)
+
+	// DeleteWebhook removes a webhook with its pending deliveries.
+	DeleteWebhook(ctx context.Context, params model.DeleteWebhookParams) (err error)
+
+	// CreateIncomingWebhook creates an incoming webhook of a chat and returns its ID and token.
+	CreateIncomingWebhook(
+		ctx context.Context,
+		params model.CreateIncomingWebhookParams,
+	) (resp model.CreateIncomingWebhookResponse, err error)
+
+	// ListIncomingWebhooks returns the incoming webhooks matching the filter.
+	ListIncomingWebhooks(
+		ctx context.Context,
+		params model.ListIncomingWebhooksParams,
+	) (webhooks []model.IncomingWebhook, err error)
+
+	// DeleteIncomingWebhook revokes an incoming webhook.
+	DeleteIncomingWebhook(ctx context.Context, params model.DeleteIncomingWebhookParams) (err error)
+
+	// PostIncomingMessage sends the message to the chat of the incoming webhook authenticated by the token,
+	// as the bot named after the webhook. It returns model.ErrNotFound for an unknown token.
+	PostIncomingMessage(ctx context.Context, params model.PostIncomingMessageParams) (err error)
+}
+
+// BotService defines methods for managing the bot users.
+type BotService interface {
+	// CreateBot creates a bot with its commands and returns its ID and API token.
+	CreateBot(ctx context.Context, params model.CreateBotParams) (resp model.CreateBotResponse, err error)
+
+	// ListBots returns the bots with their commands.
+	ListBots(ctx context.Context) (bots []model.Bot, err error)
+
+	// DeleteBot removes a bot and revokes its token.
+	DeleteBot(ctx context.Context, params model.DeleteBotParams) (err error)
+
+	// Authenticate returns the bot owning the API token, or model.ErrNotFound.
+	Authenticate(ctx context.Context, token string) (bot model.Bot, err error)
+}
+
+// CommandService defines methods for running the slash commands sent to chats.
+type CommandService interface {
+	// Dispatch runs the command with its in-process handler or the bot handling it, and returns the reply
+	// to post to the chat. Failures of the command are reported in the reply, an error is returned
+	// only if the command could not be dispatched.
+	Dispatch(ctx context.Context, cmd model.Command) (reply model.CommandReply, err error)
+}
+
+// PollService defines methods for managing the polls of the chats.
+type PollService interface {
+	// CreatePoll posts the poll to the chat as a message and returns the IDs of the poll and of the message.
+	CreatePoll(ctx context.Context, params model.CreatePollParams) (resp model.CreatePollResponse, err error)
+
+	// Vote records the ballot of a participant of the chat and broadcasts the new results. It returns
+	// model.ErrNotFound for an unknown poll, model.ErrPollClosed once the voting is over,
+	// model.ErrInvalidArgument for options not fitting the poll, model.ErrPermissionDenied if the voter
+	// does not participate in the chat and model.ErrAlreadyExists if the voter has already voted.
+	Vote(ctx context.Context, params model.VoteParams) (err error)
+
+	// ClosePoll stops the voting and broadcasts the final results. It returns model.ErrNotFound
+	// for an unknown poll, model.ErrPermissionDenied unless called by the author of the poll
+	// or an administrator and model.ErrPollClosed if the poll is already closed.
+	ClosePoll(ctx context.Context, params model.ClosePollParams) (err error)
+
+	// GetPoll returns the poll with its results, or model.ErrNotFound.
+	GetPoll(ctx context.Context, params model.GetPollParams) (poll model.Poll, err error)
+}
+
+// EphemeralService defines methods for broadcasting the events of the chats that are not stored,
+// such as the typing indicators.
+type EphemeralService interface {
+	// Publish broadcasts the event to the subscribers of its chat and reports whether it was published.
+	// The events sent again by the same participant of the chat before the throttle interval are dropped.
+	Publish(ctx context.Context, event model.EphemeralEvent) (published bool, err error)
+
+	// SendTypingEvent broadcasts that the participant is typing in the chat, until the event expires.
+	SendTypingEvent(ctx context.Context, params model.SendTypingEventParams) (err error)
+}
+
+// SubscriptionService defines methods for streaming the live updates of the chats.
+type SubscriptionService interface {
+	// Subscribe returns the live updates of the chat until the context is done, when the channel is closed.
+	// The channel is closed early if the subscriber does not keep up with the updates.
+	// The updates sent by the users blocked by the subscriber are left out. Only the participants
+	// of the chat may subscribe to it.
+	Subscribe(ctx context.Context, params model.SubscribeParams) (updates <-chan model.ChatUpdate, err error)
+}
+
+// PresenceService defines methods for tracking whether the users are online, away or offline.
+type PresenceService interface {
+	// Connect marks the user online until the context is done, as long as the user is connected to a stream.
+	// It returns model.ErrNotFound for an unknown user.
+	Connect(ctx context.Context, params model.ConnectParams) (err error)
+
+	// Heartbeat marks the user seen, away if idle, or returns model.ErrNotFound for an unknown user.
+	Heartbeat(ctx context.Context, params model.HeartbeatParams) (err error)
+
+	// GetPresence returns the presence of the known users among the emails.
+	GetPresence(ctx context.Context, params model.GetPresenceParams) (presences []model.Presence, err error)
+}
+
+// UserService defines methods for managing the profiles of the users and searching the directory.
+type UserService interface {
+	// GetUser returns the user with their profile, or model.ErrNotFound.
+	GetUser(ctx context.Context, params model.GetUserParams) (user model.User, err error)
+
+	// UpdateProfile updates the profile of the user and returns the user, or model.ErrNotFound.
+	UpdateProfile(ctx context.Context, params model.UpdateProfileParams) (user model.User, err error)
+
+	// SearchUsers returns the users whose email or display name starts with the query, ignoring the case.
+	SearchUsers(ctx context.Context, params model.SearchUsersParams) (users []model.User, err error)
+}
+
+// BlockService defines methods for blocking the users, hiding their messages and live updates from the blocker.
+type BlockService interface {
+	// BlockUser blocks the user, or returns model.ErrInvalidArgument for the user blocking themselves
+	// and model.ErrNotFound if either user is unknown. The group chats shared with the blocked user are kept.
+	BlockUser(ctx context.Context, params model.BlockUserParams) (err error)
+
+	// UnblockUser lifts the block of the user, if any.
+	UnblockUser(ctx context.Context, params model.UnblockUserParams) (err error)
+
+	// ListBlocked returns the users blocked by the user, most recently blocked first.
+	ListBlocked(ctx context.Context, params model.ListBlockedParams) (users []model.BlockedUser, err error)
+}
+
+// PinService defines methods for pinning the messages of the chats. Only the administrators pin and unpin
+// the messages of a chat, unless its pin policy lets its participants do so.
+type PinService interface {
+	// PinMessage pins the message and broadcasts the pin to the subscribers of the chat. It returns
+	// model.ErrPermissionDenied if the pin policy of the chat does not allow the caller to pin,
+	// model.ErrNotFound for an unknown chat or message and model.ErrAlreadyExists if the message is already pinned.
+	PinMessage(ctx context.Context, params model.PinMessageParams) (pinned model.PinnedMessage, err error)
+
+	// UnpinMessage unpins the message and broadcasts it to the subscribers of the chat. It returns
+	// model.ErrPermissionDenied if the pin policy of the chat does not allow the caller to unpin,
+	// and model.ErrNotFound for an unknown chat or a message not pinned.
+	UnpinMessage(ctx context.Context, params model.UnpinMessageParams) (err error)
+
+	// ListPinnedMessages returns the pinned messages of the chat, most recently pinned first.
+	ListPinnedMessages(ctx context.Context, params model.ListPinnedMessagesParams) (pinned []model.PinnedMessage, err error)
+
+	// SetPinPolicy sets who besides the administrators may pin the messages of the chat. It returns
+	// model.ErrInvalidArgument for an unknown policy and model.ErrNotFound for an unknown chat.
+	SetPinPolicy(ctx context.Context, params model.SetPinPolicyParams) (err error)
+}
+
+// ScheduleService defines methods for scheduling messages, sent later by the scheduler as with SendMessage.
+type ScheduleService interface {
+	// ScheduleMessage schedules the message and returns its ID, or returns model.ErrInvalidArgument
+	// for a send time not in the future or too far ahead.
+	ScheduleMessage(ctx context.Context, params model.ScheduleMessageParams) (scheduledMessageID int64, err error)
+
+	// ListScheduledMessages returns the messages scheduled by the sender and not sent yet, the soonest first.
+	ListScheduledMessages(
+		ctx context.Context,
+		params model.ListScheduledMessagesParams,
+	) (messages []model.ScheduledMessage, err error)
+
+	// CancelScheduledMessage cancels a message scheduled by the sender, or returns model.ErrNotFound
+	// if there is no such message pending.
+	CancelScheduledMessage(ctx context.Context, params model.CancelScheduledMessageParams) (err error)
 }
@@ -0,0 +1,136 @@
+package subscription
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/broadcast"
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+)
+
+type subscriptionService struct {
+	hub             *broadcast.Hub
+	chatRepository  repository.ChatRepository
+	blockRepository repository.BlockRepository
+	cfg             config.Updates
+}
+
+// NewService creates a new instance of subscriptionService with the provided Hub, checking the subscribers
+// against the participants with the provided ChatRepository and hiding the updates of the users blocked
+// by the subscribers with the provided BlockRepository.
+func NewService(
+	hub *broadcast.Hub,
+	chatRepository repository.ChatRepository,
+	blockRepository repository.BlockRepository,
+	cfg config.Updates,
+) service.SubscriptionService {
+	return &subscriptionService{
+		hub:             hub,
+		chatRepository:  chatRepository,
+		blockRepository: blockRepository,
+		cfg:             cfg,
+	}
+}
+
+// Subscribe subscribes a participant to the updates of the chat until the context is done. The updates sent
+// by the users blocked by the subscriber are dropped, the blocked users being reloaded periodically.
+func (s *subscriptionService) Subscribe(
+	ctx context.Context,
+	params model.SubscribeParams,
+) (updates <-chan model.ChatUpdate, err error) {
+	logger.FromContext(ctx).Debug("subscriptionService.Subscribe", slog.Any("params", params))
+
+	if params.From == "" {
+		return nil, errors.Wrap(model.ErrInvalidArgument, "from is required")
+	}
+
+	participant, err := s.chatRepository.IsParticipant(ctx, params.ChatID, params.From)
+	if err != nil {
+		return nil, err
+	}
+
+	if !participant {
+		return nil, errors.Wrapf(model.ErrPermissionDenied, "not a participant of chat(chatID: %d)", params.ChatID)
+	}
+
+	blocked, err := s.listBlocked(ctx, params.From)
+	if err != nil {
+		return nil, err
+	}
+
+	all, unsubscribe := s.hub.Subscribe(params.ChatID)
+	filtered := make(chan model.ChatUpdate)
+
+	go func() {
+		defer close(filtered)
+		defer unsubscribe()
+
+		s.filter(ctx, params.From, blocked, all, filtered)
+	}()
+
+	return filtered, nil
+}
+
+// filter forwards the updates not sent by the blocked users until the context is done or the subscription
+// ends. A failed reload of the blocked users keeps the previous ones.
+func (s *subscriptionService) filter(
+	ctx context.Context,
+	from string,
+	blocked map[string]struct{},
+	in <-chan model.ChatUpdate,
+	out chan<- model.ChatUpdate,
+) {
+	ticker := time.NewTicker(s.cfg.BlocksRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := s.listBlocked(ctx, from)
+			if err != nil {
+				logger.FromContext(ctx).Warn("blocked users not reloaded", slog.String("error", err.Error()))
+				continue
+			}
+
+			blocked = reloaded
+		case update, ok := <-in:
+			if !ok {
+				return
+			}
+
+			if _, ok := blocked[update.Sender]; ok && update.Sender != "" {
+				continue
+			}
+
+			select {
+			case out <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// listBlocked returns the set of the emails of the users blocked by the user.
+func (s *subscriptionService) listBlocked(ctx context.Context, from string) (map[string]struct{}, error) {
+	users, err := s.blockRepository.ListBlocked(ctx, model.ListBlockedParams{From: from})
+	if err != nil {
+		return nil, err
+	}
+
+	blocked := make(map[string]struct{}, len(users))
+	for _, user := range users {
+		blocked[user.Email] = struct{}{}
+	}
+
+	return blocked, nil
+}
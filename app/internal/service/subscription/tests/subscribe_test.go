@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/broadcast"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	subscriptionService "github.com/Prrromanssss/chat-server/internal/service/subscription"
+)
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	const chatID = 7
+
+	var (
+		cfg = config.Updates{BlocksRefreshInterval: time.Hour}
+
+		ErrBlockRepository = errors.New("block repository error")
+	)
+
+	tests := []struct {
+		name    string
+		from    string
+		blocked []model.BlockedUser
+		listErr error
+		err     error
+		senders []string
+	}{
+		{
+			name:    "updates of the blocked users are dropped",
+			from:    "alice@example.com",
+			blocked: []model.BlockedUser{{Email: "bob@example.com"}},
+			senders: []string{"", "carol@example.com"},
+		},
+		{
+			name: "anonymous subscribers are rejected",
+			err:  model.ErrInvalidArgument,
+		},
+		{
+			name: "non-participants are denied",
+			from: "eve@example.com",
+			err:  model.ErrPermissionDenied,
+		},
+		{
+			name:    "block repository error",
+			from:    "alice@example.com",
+			listErr: ErrBlockRepository,
+			err:     ErrBlockRepository,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			blockRepositoryMock := repositoryMocks.NewBlockRepositoryMock(mc)
+			blockRepositoryMock.ListBlockedMock.Optional().Return(tt.blocked, tt.listErr)
+
+			chatRepositoryMock := repositoryMocks.NewChatRepositoryMock(mc)
+			chatRepositoryMock.IsParticipantMock.Optional().Set(func(_ context.Context, _ int64, email string) (bool, error) {
+				return email != "eve@example.com", nil
+			})
+
+			hub := broadcast.NewHub(8)
+			service := subscriptionService.NewService(hub, chatRepositoryMock, blockRepositoryMock, cfg)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			updates, err := service.Subscribe(ctx, model.SubscribeParams{ChatID: chatID, From: tt.from})
+			require.ErrorIs(t, err, tt.err)
+
+			if tt.err != nil {
+				return
+			}
+
+			for _, sender := range []string{"bob@example.com", "", "carol@example.com"} {
+				hub.Publish(model.ChatUpdate{ChatID: chatID, Type: model.UpdateTypeMessageSent, Sender: sender})
+			}
+
+			for _, want := range tt.senders {
+				select {
+				case update := <-updates:
+					require.Equal(t, want, update.Sender)
+				case <-time.After(time.Second):
+					t.Fatalf("update of %q not received", want)
+				}
+			}
+
+			cancel()
+
+			for range updates {
+				t.Fatal("unexpected update")
+			}
+		})
+	}
+}
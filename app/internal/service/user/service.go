@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+)
+
+type userService struct {
+	userRepository repository.UserRepository
+}
+
+// NewService creates a new instance of userService with the provided UserRepository.
+func NewService(userRepository repository.UserRepository) service.UserService {
+	return &userService{
+		userRepository: userRepository,
+	}
+}
+
+// GetUser returns the user with their profile.
+func (s *userService) GetUser(ctx context.Context, params model.GetUserParams) (user model.User, err error) {
+	logger.FromContext(ctx).Debug("userService.GetUser", slog.Any("params", params))
+
+	return s.userRepository.GetUser(ctx, params)
+}
+
+// UpdateProfile updates the profile of the user, leaving the unset fields unchanged.
+func (s *userService) UpdateProfile(ctx context.Context, params model.UpdateProfileParams) (user model.User, err error) {
+	logger.FromContext(ctx).Debug("userService.UpdateProfile", slog.Any("params", params))
+
+	return s.userRepository.UpdateProfile(ctx, params)
+}
+
+// SearchUsers returns the users matching the query, at most SearchUsersDefaultLimit of them
+// unless another limit is set.
+func (s *userService) SearchUsers(ctx context.Context, params model.SearchUsersParams) (users []model.User, err error) {
+	logger.FromContext(ctx).Debug("userService.SearchUsers", slog.Any("params", params))
+
+	if params.Limit <= 0 {
+		params.Limit = model.SearchUsersDefaultLimit
+	}
+
+	return s.userRepository.SearchUsers(ctx, params)
+}
@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/internal/logger"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	"github.com/Prrromanssss/chat-server/internal/token"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
+)
+
+// Number of random bytes of the signing secrets of the webhooks and of the tokens of the incoming webhooks.
+const (
+	secretSize = 32
+	tokenSize  = 32
+)
+
+type webhookService struct {
+	webhookRepository repository.WebhookRepository
+	chatService       service.ChatService
+}
+
+// NewService creates a new instance of webhookService with the provided WebhookRepository
+// and the ChatService the incoming messages are sent through.
+func NewService(webhookRepository repository.WebhookRepository, chatService service.ChatService) service.WebhookService {
+	return &webhookService{
+		webhookRepository: webhookRepository,
+		chatService:       chatService,
+	}
+}
+
+// CreateWebhook generates the signing secret of the webhook and creates it.
+func (s *webhookService) CreateWebhook(
+	ctx context.Context,
+	params model.CreateWebhookParams,
+) (resp model.CreateWebhookResponse, err error) {
+	logger.FromContext(ctx).Debug("webhookService.CreateWebhook",
+		slog.Int64("chat_id", params.ChatID),
+		slog.Any("event_types", params.EventTypes),
+	)
+
+	ctx, span := tracing.Start(ctx, "webhookService.CreateWebhook")
+	defer func() { tracing.End(span, err) }()
+
+	params.Secret, err = token.Generate(secretSize)
+	if err != nil {
+		return model.CreateWebhookResponse{}, errors.Wrap(err, "Cannot generate webhook secret")
+	}
+
+	webhookID, err := s.webhookRepository.CreateWebhook(ctx, params)
+	if err != nil {
+		return model.CreateWebhookResponse{}, err
+	}
+
+	return model.CreateWebhookResponse{
+		WebhookID: webhookID,
+		Secret:    params.Secret,
+	}, nil
+}
+
+// ListWebhooks returns the webhooks matching the filter.
+func (s *webhookService) ListWebhooks(
+	ctx context.Context,
+	params model.ListWebhooksParams,
+) (webhooks []model.Webhook, err error) {
+	logger.FromContext(ctx).Debug("webhookService.ListWebhooks", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "webhookService.ListWebhooks")
+	defer func() { tracing.End(span, err) }()
+
+	return s.webhookRepository.ListWebhooks(ctx, params)
+}
+
+// DeleteWebhook removes a webhook with its pending deliveries.
+func (s *webhookService) DeleteWebhook(ctx context.Context, params model.DeleteWebhookParams) (err error) {
+	logger.FromContext(ctx).Debug("webhookService.DeleteWebhook", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "webhookService.DeleteWebhook")
+	defer func() { tracing.End(span, err) }()
+
+	return s.webhookRepository.DeleteWebhook(ctx, params)
+}
+
+// CreateIncomingWebhook generates the token of the incoming webhook and creates it, storing only the token hash.
+func (s *webhookService) CreateIncomingWebhook(
+	ctx context.Context,
+	params model.CreateIncomingWebhookParams,
+) (resp model.CreateIncomingWebhookResponse, err error) {
+	logger.FromContext(ctx).Debug("webhookService.CreateIncomingWebhook",
+		slog.Int64("chat_id", params.ChatID),
+		slog.String("name", params.Name),
+	)
+
+	ctx, span := tracing.Start(ctx, "webhookService.CreateIncomingWebhook")
+	defer func() { tracing.End(span, err) }()
+
+	incomingToken, err := token.Generate(tokenSize)
+	if err != nil {
+		return model.CreateIncomingWebhookResponse{}, errors.Wrap(err, "Cannot generate incoming webhook token")
+	}
+
+	params.TokenHash = token.Hash(incomingToken)
+
+	incomingWebhookID, err := s.webhookRepository.CreateIncomingWebhook(ctx, params)
+	if err != nil {
+		return model.CreateIncomingWebhookResponse{}, err
+	}
+
+	return model.CreateIncomingWebhookResponse{
+		IncomingWebhookID: incomingWebhookID,
+		Token:             incomingToken,
+	}, nil
+}
+
+// ListIncomingWebhooks returns the incoming webhooks matching the filter.
+func (s *webhookService) ListIncomingWebhooks(
+	ctx context.Context,
+	params model.ListIncomingWebhooksParams,
+) (webhooks []model.IncomingWebhook, err error) {
+	logger.FromContext(ctx).Debug("webhookService.ListIncomingWebhooks", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "webhookService.ListIncomingWebhooks")
+	defer func() { tracing.End(span, err) }()
+
+	return s.webhookRepository.ListIncomingWebhooks(ctx, params)
+}
+
+// DeleteIncomingWebhook revokes an incoming webhook.
+func (s *webhookService) DeleteIncomingWebhook(
+	ctx context.Context,
+	params model.DeleteIncomingWebhookParams,
+) (err error) {
+	logger.FromContext(ctx).Debug("webhookService.DeleteIncomingWebhook", slog.Any("params", params))
+
+	ctx, span := tracing.Start(ctx, "webhookService.DeleteIncomingWebhook")
+	defer func() { tracing.End(span, err) }()
+
+	return s.webhookRepository.DeleteIncomingWebhook(ctx, params)
+}
+
+// PostIncomingMessage authenticates the token and sends the message to the chat of the incoming webhook
+// with the webhook name as the sender.
+func (s *webhookService) PostIncomingMessage(ctx context.Context, params model.PostIncomingMessageParams) (err error) {
+	logger.FromContext(ctx).Debug("webhookService.PostIncomingMessage")
+
+	ctx, span := tracing.Start(ctx, "webhookService.PostIncomingMessage")
+	defer func() { tracing.End(span, err) }()
+
+	webhook, err := s.webhookRepository.GetIncomingWebhookByTokenHash(ctx, token.Hash(params.Token))
+	if err != nil {
+		return err
+	}
+
+	return s.chatService.SendMessage(ctx, model.SendMessageParams{
+		ChatID: webhook.ChatID,
+		From:   webhook.Name,
+		Text:   params.Text,
+		SentAt: time.Now().UTC(),
+	})
+}
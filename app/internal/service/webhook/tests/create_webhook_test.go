@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	webhookService "github.com/Prrromanssss/chat-server/internal/service/webhook"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id = gofakeit.Int64()
+
+		ErrWebhookRepository = errors.New("webhook repository error")
+
+		req = model.CreateWebhookParams{
+			URL:        gofakeit.URL(),
+			ChatID:     gofakeit.Int64(),
+			EventTypes: []string{model.EventTypeMessageSent},
+		}
+	)
+
+	tests := []struct {
+		name    string
+		repoErr error
+		err     error
+	}{
+		{
+			name: "success case",
+		},
+		{
+			name:    "webhook repository error",
+			repoErr: ErrWebhookRepository,
+			err:     ErrWebhookRepository,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			var secret string
+
+			webhookRepositoryMock := repositoryMocks.NewWebhookRepositoryMock(mc)
+			webhookRepositoryMock.CreateWebhookMock.Set(
+				func(_ context.Context, params model.CreateWebhookParams) (int64, error) {
+					secret = params.Secret
+					params.Secret = ""
+					require.Equal(t, req, params)
+
+					return id, tt.repoErr
+				},
+			)
+
+			service := webhookService.NewService(webhookRepositoryMock, nil)
+
+			resp, err := service.CreateWebhook(ctx, req)
+			require.ErrorIs(t, err, tt.err)
+
+			decoded, decodeErr := hex.DecodeString(secret)
+			require.NoError(t, decodeErr)
+			require.Len(t, decoded, 32)
+
+			if tt.err == nil {
+				require.Equal(t, model.CreateWebhookResponse{WebhookID: id, Secret: secret}, resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	"github.com/Prrromanssss/chat-server/internal/service"
+	serviceMocks "github.com/Prrromanssss/chat-server/internal/service/mocks"
+	webhookService "github.com/Prrromanssss/chat-server/internal/service/webhook"
+)
+
+func TestPostIncomingMessage(t *testing.T) {
+	t.Parallel()
+
+	type (
+		webhookRepositoryMockFunc func(mc *minimock.Controller) repository.WebhookRepository
+		chatServiceMockFunc       func(mc *minimock.Controller) service.ChatService
+	)
+
+	var (
+		ctx = context.Background()
+
+		token     = gofakeit.UUID()
+		sum       = sha256.Sum256([]byte(token))
+		tokenHash = hex.EncodeToString(sum[:])
+
+		ErrChatService = errors.New("chat service error")
+
+		req = model.PostIncomingMessageParams{
+			Token: token,
+			Text:  gofakeit.Sentence(5),
+		}
+
+		webhook = model.IncomingWebhook{
+			ID:     gofakeit.Int64(),
+			ChatID: gofakeit.Int64(),
+			Name:   "CI",
+		}
+	)
+
+	tests := []struct {
+		name                  string
+		err                   error
+		webhookRepositoryMock webhookRepositoryMockFunc
+		chatServiceMock       chatServiceMockFunc
+	}{
+		{
+			name: "success case",
+			webhookRepositoryMock: func(mc *minimock.Controller) repository.WebhookRepository {
+				mock := repositoryMocks.NewWebhookRepositoryMock(mc)
+				mock.GetIncomingWebhookByTokenHashMock.Expect(minimock.AnyContext, tokenHash).Return(webhook, nil)
+
+				return mock
+			},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.SendMessageMock.Set(func(_ context.Context, params model.SendMessageParams) error {
+					require.WithinDuration(t, time.Now(), params.SentAt, time.Minute)
+					params.SentAt = time.Time{}
+					require.Equal(t, model.SendMessageParams{
+						ChatID: webhook.ChatID,
+						From:   webhook.Name,
+						Text:   req.Text,
+					}, params)
+
+					return nil
+				})
+
+				return mock
+			},
+		},
+		{
+			name: "unknown token",
+			err:  model.ErrNotFound,
+			webhookRepositoryMock: func(mc *minimock.Controller) repository.WebhookRepository {
+				mock := repositoryMocks.NewWebhookRepositoryMock(mc)
+				mock.GetIncomingWebhookByTokenHashMock.Expect(minimock.AnyContext, tokenHash).
+					Return(model.IncomingWebhook{}, errors.Wrap(model.ErrNotFound, "incoming webhook"))
+
+				return mock
+			},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name: "chat service error",
+			err:  ErrChatService,
+			webhookRepositoryMock: func(mc *minimock.Controller) repository.WebhookRepository {
+				mock := repositoryMocks.NewWebhookRepositoryMock(mc)
+				mock.GetIncomingWebhookByTokenHashMock.Expect(minimock.AnyContext, tokenHash).Return(webhook, nil)
+
+				return mock
+			},
+			chatServiceMock: func(mc *minimock.Controller) service.ChatService {
+				mock := serviceMocks.NewChatServiceMock(mc)
+				mock.SendMessageMock.Return(ErrChatService)
+
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			service := webhookService.NewService(tt.webhookRepositoryMock(mc), tt.chatServiceMock(mc))
+
+			err := service.PostIncomingMessage(ctx, req)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
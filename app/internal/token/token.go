@@ -0,0 +1,28 @@
+// Package token generates the random secrets handed out to API clients, such as the tokens
+// of the incoming webhooks and of the bots, and hashes them for storage.
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+)
+
+// Generate returns size random bytes encoded in hex.
+func Generate(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "Cannot read random bytes")
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// Hash returns the hex encoded SHA-256 of the token, as stored in the database.
+// The tokens are random, so a plain hash is enough to make a leak of the stored hashes harmless.
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,133 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/Prrromanssss/platform_common/pkg/db/pg"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// batchSpanName names the spans of batches, which are sent without a db.Query name.
+const batchSpanName = "db.SendBatch"
+
+type dbClient struct {
+	client db.Client
+	db     db.DB
+}
+
+// NewDBClient wraps the database client so that every named query is executed within its own span.
+func NewDBClient(client db.Client) db.Client {
+	return &dbClient{
+		client: client,
+		db:     &tracedDB{DB: client.DB()},
+	}
+}
+
+// DB returns the traced database connection.
+func (c *dbClient) DB() db.DB {
+	return c.db
+}
+
+// Close closes the underlying database client.
+func (c *dbClient) Close() error {
+	return c.client.Close()
+}
+
+type tracedDB struct {
+	db.DB
+}
+
+func startQuery(ctx context.Context, q db.Query) (context.Context, trace.Span) {
+	return Start(
+		ctx,
+		q.Name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBQueryText(q.QueryRaw),
+		),
+	)
+}
+
+// ScanOneContext executes a query within a span and scans a single row into dest.
+func (d *tracedDB) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) (err error) {
+	ctx, span := startQuery(ctx, q)
+	defer func() { End(span, err) }()
+
+	return d.DB.ScanOneContext(ctx, dest, q, args...)
+}
+
+// ScanAllContext executes a query within a span and scans all rows into dest.
+func (d *tracedDB) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) (err error) {
+	ctx, span := startQuery(ctx, q)
+	defer func() { End(span, err) }()
+
+	return d.DB.ScanAllContext(ctx, dest, q, args...)
+}
+
+// ExecContext executes a query without returning any rows within a span.
+func (d *tracedDB) ExecContext(
+	ctx context.Context,
+	q db.Query,
+	args ...interface{},
+) (tag pgconn.CommandTag, err error) {
+	ctx, span := startQuery(ctx, q)
+	defer func() { End(span, err) }()
+
+	return d.DB.ExecContext(ctx, q, args...)
+}
+
+// QueryContext executes a query within a span that ends once the rows are available.
+func (d *tracedDB) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (rows pgx.Rows, err error) {
+	ctx, span := startQuery(ctx, q)
+	defer func() { End(span, err) }()
+
+	return d.DB.QueryContext(ctx, q, args...)
+}
+
+// QueryRowContext executes a query returning a single row within a span.
+func (d *tracedDB) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
+	ctx, span := startQuery(ctx, q)
+	defer span.End()
+
+	return d.DB.QueryRowContext(ctx, q, args...)
+}
+
+// SendBatchContext sends a batch within a span.
+func (d *tracedDB) SendBatchContext(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	ctx, span := Start(
+		ctx,
+		batchSpanName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL),
+	)
+	defer span.End()
+
+	return d.DB.SendBatchContext(ctx, b)
+}
+
+type txManager struct {
+	txManager db.TxManager
+}
+
+// NewTxManager wraps the transaction manager so that every top-level transaction is executed within a span.
+func NewTxManager(manager db.TxManager) db.TxManager {
+	return &txManager{txManager: manager}
+}
+
+// ReadCommitted executes the handler within a read committed transaction and a span covering it.
+func (m *txManager) ReadCommitted(ctx context.Context, f db.Handler) (err error) {
+	// Nested transactions are executed within the outer one and are traced there.
+	if _, ok := ctx.Value(pg.TxKey).(pgx.Tx); ok {
+		return m.txManager.ReadCommitted(ctx, f)
+	}
+
+	ctx, span := Start(ctx, "txManager.ReadCommitted")
+	defer func() { End(span, err) }()
+
+	return m.txManager.ReadCommitted(ctx, f)
+}
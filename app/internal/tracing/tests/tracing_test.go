@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Prrromanssss/chat-server/internal/interceptor"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	chatService "github.com/Prrromanssss/chat-server/internal/service/chat"
+	"github.com/Prrromanssss/chat-server/internal/tracing"
+)
+
+const (
+	traceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	traceparent = "00-" + traceID + "-00f067aa0ba902b7-01"
+)
+
+type fakeDB struct {
+	db.DB
+}
+
+func (f *fakeDB) ExecContext(_ context.Context, _ db.Query, _ ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag("INSERT 0 1"), nil
+}
+
+type fakeClient struct {
+	db db.DB
+}
+
+func (f *fakeClient) DB() db.DB {
+	return f.db
+}
+
+func (f *fakeClient) Close() error {
+	return nil
+}
+
+func TestTracing(t *testing.T) {
+	var (
+		mc = minimock.NewController(t)
+
+		exporter = tracetest.NewInMemoryExporter()
+		provider = tracing.NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exporter), "chat-server", 1)
+
+		params = model.SendMessageParams{
+			From:   "user@example.com",
+			Text:   "hello",
+			SentAt: time.Now(),
+		}
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	dbClient := tracing.NewDBClient(&fakeClient{db: &fakeDB{}})
+
+	chatRepositoryMock := repositoryMocks.NewChatRepositoryMock(mc)
+	chatRepositoryMock.SendMessageMock.Set(func(ctx context.Context, _ model.SendMessageParams) (model.SendMessageResponse, error) {
+		_, err := dbClient.DB().ExecContext(ctx, db.Query{Name: "chatPGRepo.SendMessage"})
+		return model.SendMessageResponse{}, err
+	})
+
+	outboxRepositoryMock := repositoryMocks.NewOutboxRepositoryMock(mc)
+	outboxRepositoryMock.CreateEventMock.Return(nil)
+
+	updateRepositoryMock := repositoryMocks.NewChatUpdateRepositoryMock(mc)
+	updateRepositoryMock.NotifyChatUpdateMock.Return(nil)
+
+	txManagerMock := dbMocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	service := chatService.NewService(
+		chatRepositoryMock,
+		outboxRepositoryMock,
+		updateRepositoryMock,
+		nil,
+		nil,
+		tracing.NewTxManager(txManagerMock),
+		nil,
+		nil,
+	)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", traceparent))
+	info := &grpc.UnaryServerInfo{FullMethod: "/chat_v1.ChatV1/SendMessage"}
+
+	_, err := interceptor.TracingInterceptor(ctx, params, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, service.SendMessage(ctx, req.(model.SendMessageParams))
+	})
+	require.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 4)
+
+	byName := make(map[string]tracetest.SpanStub, len(spans))
+	for _, span := range spans {
+		require.Equal(t, traceID, span.SpanContext.TraceID().String())
+		byName[span.Name] = span
+	}
+
+	rpc := byName["chat_v1.ChatV1/SendMessage"]
+	svc := byName["chatService.SendMessage"]
+	tx := byName["txManager.ReadCommitted"]
+	query := byName["chatPGRepo.SendMessage"]
+
+	require.Equal(t, "00f067aa0ba902b7", rpc.Parent.SpanID().String())
+	require.Equal(t, rpc.SpanContext.SpanID(), svc.Parent.SpanID())
+	require.Equal(t, svc.SpanContext.SpanID(), tx.Parent.SpanID())
+	require.Equal(t, tx.SpanContext.SpanID(), query.Parent.SpanID())
+}
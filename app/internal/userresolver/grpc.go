@@ -0,0 +1,109 @@
+package userresolver
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	userV1 "github.com/Prrromanssss/chat-server/pkg/user_v1"
+)
+
+// cacheEntry records whether an email belongs to a registered user until it expires.
+type cacheEntry struct {
+	known     bool
+	expiresAt time.Time
+}
+
+// GRPCResolver is a UserResolver checking the emails against the user service of the auth service.
+// The answers are cached, the unknown users for a shorter time than the registered ones.
+type GRPCResolver struct {
+	client userV1.UserV1Client
+	cfg    config.AuthUsers
+
+	mu        sync.Mutex
+	cache     map[string]cacheEntry
+	lastPrune time.Time
+}
+
+// NewGRPCResolver creates a new instance of GRPCResolver calling the user service with the provided client.
+func NewGRPCResolver(client userV1.UserV1Client, cfg config.AuthUsers) *GRPCResolver {
+	return &GRPCResolver{
+		client: client,
+		cfg:    cfg,
+		cache:  make(map[string]cacheEntry),
+	}
+}
+
+// Resolve checks the emails missing from the cache with a single call to the user service.
+func (r *GRPCResolver) Resolve(ctx context.Context, emails []string) error {
+	now := time.Now()
+
+	unknown, missing := r.lookup(emails, now)
+
+	if len(missing) > 0 {
+		ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+		defer cancel()
+
+		resp, err := r.client.GetByEmails(ctx, &userV1.GetByEmailsRequest{Emails: missing})
+		if err != nil {
+			return errors.Wrap(err, "Cannot get users from auth service")
+		}
+
+		registered := make(map[string]struct{}, len(resp.Users))
+		for _, user := range resp.Users {
+			registered[user.Email] = struct{}{}
+		}
+
+		r.mu.Lock()
+		for _, email := range missing {
+			_, known := registered[email]
+			if known {
+				r.cache[email] = cacheEntry{known: true, expiresAt: now.Add(r.cfg.CacheTTL)}
+			} else {
+				r.cache[email] = cacheEntry{known: false, expiresAt: now.Add(r.cfg.NegativeCacheTTL)}
+				unknown = append(unknown, email)
+			}
+		}
+		r.mu.Unlock()
+	}
+
+	if len(unknown) > 0 {
+		return errors.Wrapf(model.ErrNotFound, "users %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// lookup returns the emails cached as unknown and the ones missing from the cache. The expired entries
+// are pruned at most once per CacheTTL.
+func (r *GRPCResolver) lookup(emails []string, now time.Time) (unknown, missing []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now.Sub(r.lastPrune) >= r.cfg.CacheTTL {
+		for email, entry := range r.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(r.cache, email)
+			}
+		}
+
+		r.lastPrune = now
+	}
+
+	for _, email := range emails {
+		entry, ok := r.cache[email]
+		switch {
+		case !ok || !now.Before(entry.expiresAt):
+			missing = append(missing, email)
+		case !entry.known:
+			unknown = append(unknown, email)
+		}
+	}
+
+	return unknown, missing
+}
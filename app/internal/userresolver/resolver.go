@@ -0,0 +1,9 @@
+package userresolver
+
+import "context"
+
+// UserResolver checks that the participants of the chats are known users.
+type UserResolver interface {
+	// Resolve returns nil if all the emails belong to known users, or model.ErrNotFound naming the unknown ones.
+	Resolve(ctx context.Context, emails []string) error
+}
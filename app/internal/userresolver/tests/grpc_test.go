@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/userresolver"
+	"github.com/Prrromanssss/chat-server/internal/userresolver/userresolvertest"
+	userV1 "github.com/Prrromanssss/chat-server/pkg/user_v1"
+)
+
+// dialFake serves the fake over an in-memory listener and returns a client connected to it.
+func dialFake(t *testing.T, fake *userresolvertest.UserServer) userV1.UserV1Client {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+
+	server := grpc.NewServer()
+	userV1.RegisterUserV1Server(server, fake)
+
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return userV1.NewUserV1Client(conn)
+}
+
+func TestGRPCResolver(t *testing.T) {
+	t.Parallel()
+
+	const (
+		alice = "alice@example.com"
+		bob   = "bob@example.com"
+	)
+
+	var (
+		ctx = context.Background()
+		cfg = config.AuthUsers{Timeout: time.Second, CacheTTL: time.Hour, NegativeCacheTTL: time.Hour}
+	)
+
+	t.Run("registered users are cached", func(t *testing.T) {
+		t.Parallel()
+
+		fake := userresolvertest.NewUserServer(alice, bob)
+		resolver := userresolver.NewGRPCResolver(dialFake(t, fake), cfg)
+
+		require.NoError(t, resolver.Resolve(ctx, []string{alice, bob}))
+		require.NoError(t, resolver.Resolve(ctx, []string{bob, alice}))
+
+		require.Equal(t, 1, fake.Calls())
+	})
+
+	t.Run("unknown users are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		fake := userresolvertest.NewUserServer(alice)
+		resolver := userresolver.NewGRPCResolver(dialFake(t, fake), cfg)
+
+		err := resolver.Resolve(ctx, []string{alice, bob})
+		require.ErrorIs(t, err, model.ErrNotFound)
+		require.ErrorContains(t, err, bob)
+		require.NotContains(t, err.Error(), alice)
+
+		// The unknown user is cached as well.
+		require.ErrorIs(t, resolver.Resolve(ctx, []string{bob}), model.ErrNotFound)
+		require.Equal(t, 1, fake.Calls())
+	})
+
+	t.Run("unknown users are checked again once expired", func(t *testing.T) {
+		t.Parallel()
+
+		fake := userresolvertest.NewUserServer(alice)
+		resolver := userresolver.NewGRPCResolver(dialFake(t, fake), config.AuthUsers{
+			Timeout:          time.Second,
+			CacheTTL:         time.Hour,
+			NegativeCacheTTL: time.Millisecond,
+		})
+
+		require.ErrorIs(t, resolver.Resolve(ctx, []string{bob}), model.ErrNotFound)
+
+		fake.Register(bob)
+		time.Sleep(5 * time.Millisecond)
+
+		require.NoError(t, resolver.Resolve(ctx, []string{alice, bob}))
+		require.Equal(t, 2, fake.Calls())
+	})
+
+	t.Run("unavailable user service", func(t *testing.T) {
+		t.Parallel()
+
+		fake := userresolvertest.NewUserServer(alice)
+		client := dialFake(t, fake)
+
+		resolver := userresolver.NewGRPCResolver(client, cfg)
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := resolver.Resolve(cancelled, []string{alice})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, model.ErrNotFound)
+
+		// The failure is not cached.
+		require.NoError(t, resolver.Resolve(ctx, []string{alice}))
+	})
+}
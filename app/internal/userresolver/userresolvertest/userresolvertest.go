@@ -0,0 +1,91 @@
+// Package userresolvertest provides a user service of the auth service keeping its users in memory,
+// to test the user resolvers and their callers without the auth service.
+package userresolvertest
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	userV1 "github.com/Prrromanssss/chat-server/pkg/user_v1"
+)
+
+// UserServer is a user service of the auth service keeping the registered users in memory.
+type UserServer struct {
+	userV1.UnimplementedUserV1Server
+
+	mu     sync.Mutex
+	users  map[string]*userV1.User
+	nextID int64
+	calls  int
+}
+
+// NewUserServer creates a new instance of UserServer with the users of the emails registered.
+func NewUserServer(emails ...string) *UserServer {
+	s := &UserServer{
+		users: make(map[string]*userV1.User),
+	}
+
+	for _, email := range emails {
+		s.Register(email)
+	}
+
+	return s
+}
+
+// Register registers the user of the email.
+func (s *UserServer) Register(email string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	s.users[email] = &userV1.User{Id: s.nextID, Email: email}
+}
+
+// GetByEmails returns the registered users among the emails.
+func (s *UserServer) GetByEmails(
+	_ context.Context,
+	req *userV1.GetByEmailsRequest,
+) (*userV1.GetByEmailsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+
+	resp := &userV1.GetByEmailsResponse{}
+	for _, email := range req.Emails {
+		if user, ok := s.users[email]; ok {
+			resp.Users = append(resp.Users, user)
+		}
+	}
+
+	return resp, nil
+}
+
+// Calls returns the number of calls to GetByEmails.
+func (s *UserServer) Calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls
+}
+
+// Client returns a client of the user service calling the server in process, without a connection.
+func (s *UserServer) Client() userV1.UserV1Client {
+	return userClient{server: s}
+}
+
+// userClient calls a UserServer in process.
+type userClient struct {
+	server *UserServer
+}
+
+// GetByEmails calls GetByEmails of the server.
+func (c userClient) GetByEmails(
+	ctx context.Context,
+	req *userV1.GetByEmailsRequest,
+	_ ...grpc.CallOption,
+) (*userV1.GetByEmailsResponse, error) {
+	return c.server.GetByEmails(ctx, req)
+}
@@ -0,0 +1,257 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+)
+
+// Headers of the deliveries. The signature is the hex encoded HMAC-SHA256 of "<timestamp>.<body>"
+// keyed by the secret of the webhook, prefixed with "sha256=".
+const (
+	HeaderEvent     = "X-Chat-Event"
+	HeaderDelivery  = "X-Chat-Delivery"
+	HeaderTimestamp = "X-Chat-Timestamp"
+	HeaderSignature = "X-Chat-Signature"
+)
+
+// maxErrorBodySize is the number of bytes of the body of a failed response recorded with the attempt.
+const maxErrorBodySize = 512
+
+// payload is the JSON body of a delivery.
+type payload struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	ChatID    int64           `json:"chat_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Sign returns the value of the signature header of a delivery sent at the given Unix time.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatcher sends the pending deliveries to the webhooks. A delivery succeeds on a 2xx response,
+// otherwise it is retried with an exponential backoff until MaxAttempts, and the webhook is disabled
+// after DisableAfter consecutive failures. Several instances share the deliveries without sending one twice:
+// a batch is claimed in a short transaction, sent outside of any transaction, and the outcome of every
+// delivery is recorded in its own transaction.
+type Dispatcher struct {
+	webhookRepository repository.WebhookRepository
+	txManager         db.TxManager
+	client            *http.Client
+	cfg               config.Webhooks
+}
+
+// NewDispatcher creates a new instance of Dispatcher with the provided repository, transaction manager
+// and settings.
+func NewDispatcher(
+	webhookRepository repository.WebhookRepository,
+	txManager db.TxManager,
+	cfg config.Webhooks,
+) *Dispatcher {
+	return &Dispatcher{
+		webhookRepository: webhookRepository,
+		txManager:         txManager,
+		client:            &http.Client{Timeout: cfg.Timeout},
+		cfg:               cfg,
+	}
+}
+
+// Run sends the due deliveries immediately and then periodically until the context is cancelled.
+// A full batch is followed by the next one without waiting, to catch up with a backlog.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		processed, err := d.RunOnce(ctx, time.Now())
+		if err != nil {
+			slog.Error("webhook dispatch failed", slog.String("error", err.Error()))
+		}
+
+		if err == nil && processed == d.cfg.BatchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce sends a batch of deliveries due at now and deletes the deliveries finished before
+// the retention period. It returns the number of attempted deliveries.
+func (d *Dispatcher) RunOnce(ctx context.Context, now time.Time) (processed int, err error) {
+	now = now.UTC()
+
+	deliveries, err := d.webhookRepository.ClaimDueDeliveries(ctx, now, now.Add(d.cfg.Lease), d.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		err = d.deliver(ctx, delivery, now)
+		if err != nil {
+			return processed, err
+		}
+
+		processed++
+	}
+
+	if d.cfg.Retention > 0 {
+		_, err = d.webhookRepository.DeleteFinishedDeliveries(ctx, now.Add(-d.cfg.Retention))
+		if err != nil {
+			return processed, err
+		}
+	}
+
+	return processed, nil
+}
+
+// deliver makes an attempt to send the delivery and records its outcome.
+func (d *Dispatcher) deliver(ctx context.Context, delivery model.WebhookDelivery, now time.Time) error {
+	start := time.Now()
+	statusCode, sendErr := d.send(ctx, delivery, now)
+
+	attempt := model.CreateWebhookAttemptParams{
+		DeliveryID: delivery.ID,
+		StatusCode: statusCode,
+		Duration:   time.Since(start),
+	}
+	if sendErr != nil {
+		attempt.Error = sendErr.Error()
+	}
+
+	update := model.UpdateWebhookDeliveryParams{
+		DeliveryID:    delivery.ID,
+		Status:        model.WebhookDeliveryDelivered,
+		Attempts:      delivery.Attempts + 1,
+		NextAttemptAt: now,
+	}
+
+	if sendErr != nil {
+		slog.Warn("webhook delivery failed",
+			slog.Int64("webhook_id", delivery.WebhookID),
+			slog.Int64("delivery_id", delivery.ID),
+			slog.Int("attempts", update.Attempts),
+			slog.String("error", sendErr.Error()),
+		)
+
+		update.Status = model.WebhookDeliveryPending
+		update.NextAttemptAt = now.Add(d.backoff(update.Attempts))
+
+		if update.Attempts >= d.cfg.MaxAttempts {
+			update.Status = model.WebhookDeliveryFailed
+		}
+	}
+
+	var disabled bool
+
+	err := d.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		txErr := d.webhookRepository.CreateDeliveryAttempt(ctx, attempt)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = d.webhookRepository.UpdateDelivery(ctx, update)
+		if txErr != nil {
+			return txErr
+		}
+
+		disabled, txErr = d.webhookRepository.RecordWebhookResult(ctx, model.RecordWebhookResultParams{
+			WebhookID:    delivery.WebhookID,
+			Success:      sendErr == nil,
+			DisableAfter: d.cfg.DisableAfter,
+		})
+
+		return txErr
+	})
+	if err != nil {
+		return err
+	}
+
+	if disabled {
+		slog.Warn("webhook disabled after consecutive failures",
+			slog.Int64("webhook_id", delivery.WebhookID),
+			slog.Int("failures", d.cfg.DisableAfter),
+		)
+	}
+
+	return nil
+}
+
+// send POSTs the signed delivery and returns the status code of the response, if any,
+// with an error unless the status code is 2xx.
+func (d *Dispatcher) send(ctx context.Context, delivery model.WebhookDelivery, now time.Time) (int, error) {
+	body, err := json.Marshal(payload{
+		ID:        delivery.EventID,
+		Type:      delivery.EventType,
+		ChatID:    delivery.ChatID,
+		CreatedAt: delivery.EventCreatedAt,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Cannot encode delivery")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, errors.Wrap(err, "Cannot create request")
+	}
+
+	timestamp := now.Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return resp.StatusCode, errors.Errorf("unexpected status %d: %s", resp.StatusCode, respBody)
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay before the attempt following the given number of attempts.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.InitialBackoff
+	for i := 1; i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, d.cfg.MaxBackoff)
+}
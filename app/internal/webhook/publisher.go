@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/chat-server/internal/model"
+	"github.com/Prrromanssss/chat-server/internal/outbox"
+	"github.com/Prrromanssss/chat-server/internal/repository"
+)
+
+type publisher struct {
+	webhookRepository repository.WebhookRepository
+}
+
+// NewPublisher creates an EventPublisher scheduling the delivery of the events to the subscribed webhooks.
+// The outbox relay publishes an event at least once and outside of its transactions, so the deliveries of
+// an event published again are created only once. They are sent by the Dispatcher.
+func NewPublisher(webhookRepository repository.WebhookRepository) outbox.EventPublisher {
+	return &publisher{
+		webhookRepository: webhookRepository,
+	}
+}
+
+// Publish schedules the deliveries of the event.
+func (p *publisher) Publish(ctx context.Context, event model.Event) error {
+	return p.webhookRepository.CreateDeliveries(ctx, event)
+}
+
+// Close does nothing, deliveries are sent by the Dispatcher.
+func (p *publisher) Close() error {
+	return nil
+}
@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/chat-server/config"
+	"github.com/Prrromanssss/chat-server/internal/model"
+	repositoryMocks "github.com/Prrromanssss/chat-server/internal/repository/mocks"
+	"github.com/Prrromanssss/chat-server/internal/webhook"
+)
+
+func txManagerMock(mc *minimock.Controller) db.TxManager {
+	mock := dbMocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	return mock
+}
+
+func TestDispatcherRunOnce(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		now = time.Date(2024, time.October, 19, 12, 0, 0, 0, time.UTC)
+		cfg = config.Webhooks{
+			BatchSize:      10,
+			MaxAttempts:    3,
+			InitialBackoff: 10 * time.Second,
+			MaxBackoff:     time.Minute,
+			DisableAfter:   5,
+			Timeout:        time.Second,
+			Retention:      time.Hour,
+			Lease:          time.Minute,
+		}
+
+		secret = "secret"
+	)
+
+	tests := []struct {
+		name       string
+		statusCode int
+		attempts   int
+		disabled   bool
+		expected   model.UpdateWebhookDeliveryParams
+	}{
+		{
+			name:       "delivered",
+			statusCode: http.StatusNoContent,
+			expected: model.UpdateWebhookDeliveryParams{
+				DeliveryID:    1,
+				Status:        model.WebhookDeliveryDelivered,
+				Attempts:      1,
+				NextAttemptAt: now,
+			},
+		},
+		{
+			name:       "retried with backoff",
+			statusCode: http.StatusInternalServerError,
+			attempts:   1,
+			expected: model.UpdateWebhookDeliveryParams{
+				DeliveryID:    1,
+				Status:        model.WebhookDeliveryPending,
+				Attempts:      2,
+				NextAttemptAt: now.Add(20 * time.Second),
+			},
+		},
+		{
+			name:       "failed after max attempts and webhook disabled",
+			statusCode: http.StatusBadGateway,
+			attempts:   2,
+			disabled:   true,
+			expected: model.UpdateWebhookDeliveryParams{
+				DeliveryID:    1,
+				Status:        model.WebhookDeliveryFailed,
+				Attempts:      3,
+				NextAttemptAt: now.Add(40 * time.Second),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				timestamp, err := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+				require.NoError(t, err)
+				require.Equal(t, now.Unix(), timestamp)
+				require.Equal(t, webhook.Sign(secret, timestamp, body), r.Header.Get(webhook.HeaderSignature))
+				require.Equal(t, model.EventTypeMessageSent, r.Header.Get(webhook.HeaderEvent))
+				require.Equal(t, "1", r.Header.Get(webhook.HeaderDelivery))
+
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			delivery := model.WebhookDelivery{
+				ID:        1,
+				WebhookID: 2,
+				URL:       server.URL,
+				Secret:    secret,
+				EventID:   3,
+				EventType: model.EventTypeMessageSent,
+				ChatID:    4,
+				Payload:   []byte(`{"message_id":5}`),
+				Attempts:  tt.attempts,
+			}
+
+			webhookRepositoryMock := repositoryMocks.NewWebhookRepositoryMock(mc)
+			webhookRepositoryMock.ClaimDueDeliveriesMock.
+				Expect(minimock.AnyContext, now, now.Add(cfg.Lease), cfg.BatchSize).
+				Return([]model.WebhookDelivery{delivery}, nil)
+			webhookRepositoryMock.CreateDeliveryAttemptMock.Set(
+				func(_ context.Context, params model.CreateWebhookAttemptParams) error {
+					require.Equal(t, delivery.ID, params.DeliveryID)
+					require.Equal(t, tt.statusCode, params.StatusCode)
+					require.Equal(t, tt.statusCode >= http.StatusMultipleChoices, params.Error != "")
+
+					return nil
+				},
+			)
+			webhookRepositoryMock.UpdateDeliveryMock.Expect(minimock.AnyContext, tt.expected).Return(nil)
+			webhookRepositoryMock.RecordWebhookResultMock.Expect(minimock.AnyContext, model.RecordWebhookResultParams{
+				WebhookID:    delivery.WebhookID,
+				Success:      tt.expected.Status == model.WebhookDeliveryDelivered,
+				DisableAfter: cfg.DisableAfter,
+			}).Return(tt.disabled, nil)
+			webhookRepositoryMock.DeleteFinishedDeliveriesMock.Expect(minimock.AnyContext, now.Add(-cfg.Retention)).
+				Return(0, nil)
+
+			dispatcher := webhook.NewDispatcher(webhookRepositoryMock, txManagerMock(mc), cfg)
+
+			processed, err := dispatcher.RunOnce(ctx, now)
+			require.NoError(t, err)
+			require.Equal(t, 1, processed)
+		})
+	}
+}
@@ -0,0 +1,1285 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: chat.proto
+
+package chat_v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	chat_v1 "github.com/Prrromanssss/chat-server/pkg/chat_v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ChatV1Name is the fully-qualified name of the ChatV1 service.
+	ChatV1Name = "chat_v1.ChatV1"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ChatV1CreateProcedure is the fully-qualified name of the ChatV1's Create RPC.
+	ChatV1CreateProcedure = "/chat_v1.ChatV1/Create"
+	// ChatV1DeleteProcedure is the fully-qualified name of the ChatV1's Delete RPC.
+	ChatV1DeleteProcedure = "/chat_v1.ChatV1/Delete"
+	// ChatV1SendMessageProcedure is the fully-qualified name of the ChatV1's SendMessage RPC.
+	ChatV1SendMessageProcedure = "/chat_v1.ChatV1/SendMessage"
+	// ChatV1ListMessagesProcedure is the fully-qualified name of the ChatV1's ListMessages RPC.
+	ChatV1ListMessagesProcedure = "/chat_v1.ChatV1/ListMessages"
+	// ChatV1ListChatsProcedure is the fully-qualified name of the ChatV1's ListChats RPC.
+	ChatV1ListChatsProcedure = "/chat_v1.ChatV1/ListChats"
+	// ChatV1UpdateChatSettingsProcedure is the fully-qualified name of the ChatV1's UpdateChatSettings
+	// RPC.
+	ChatV1UpdateChatSettingsProcedure = "/chat_v1.ChatV1/UpdateChatSettings"
+	// ChatV1ListMentionsProcedure is the fully-qualified name of the ChatV1's ListMentions RPC.
+	ChatV1ListMentionsProcedure = "/chat_v1.ChatV1/ListMentions"
+	// ChatV1PinMessageProcedure is the fully-qualified name of the ChatV1's PinMessage RPC.
+	ChatV1PinMessageProcedure = "/chat_v1.ChatV1/PinMessage"
+	// ChatV1UnpinMessageProcedure is the fully-qualified name of the ChatV1's UnpinMessage RPC.
+	ChatV1UnpinMessageProcedure = "/chat_v1.ChatV1/UnpinMessage"
+	// ChatV1ListPinnedMessagesProcedure is the fully-qualified name of the ChatV1's ListPinnedMessages
+	// RPC.
+	ChatV1ListPinnedMessagesProcedure = "/chat_v1.ChatV1/ListPinnedMessages"
+	// ChatV1SetPinPolicyProcedure is the fully-qualified name of the ChatV1's SetPinPolicy RPC.
+	ChatV1SetPinPolicyProcedure = "/chat_v1.ChatV1/SetPinPolicy"
+	// ChatV1ScheduleMessageProcedure is the fully-qualified name of the ChatV1's ScheduleMessage RPC.
+	ChatV1ScheduleMessageProcedure = "/chat_v1.ChatV1/ScheduleMessage"
+	// ChatV1ListScheduledMessagesProcedure is the fully-qualified name of the ChatV1's
+	// ListScheduledMessages RPC.
+	ChatV1ListScheduledMessagesProcedure = "/chat_v1.ChatV1/ListScheduledMessages"
+	// ChatV1CancelScheduledMessageProcedure is the fully-qualified name of the ChatV1's
+	// CancelScheduledMessage RPC.
+	ChatV1CancelScheduledMessageProcedure = "/chat_v1.ChatV1/CancelScheduledMessage"
+	// ChatV1ListAuditLogProcedure is the fully-qualified name of the ChatV1's ListAuditLog RPC.
+	ChatV1ListAuditLogProcedure = "/chat_v1.ChatV1/ListAuditLog"
+	// ChatV1CreateWebhookProcedure is the fully-qualified name of the ChatV1's CreateWebhook RPC.
+	ChatV1CreateWebhookProcedure = "/chat_v1.ChatV1/CreateWebhook"
+	// ChatV1ListWebhooksProcedure is the fully-qualified name of the ChatV1's ListWebhooks RPC.
+	ChatV1ListWebhooksProcedure = "/chat_v1.ChatV1/ListWebhooks"
+	// ChatV1DeleteWebhookProcedure is the fully-qualified name of the ChatV1's DeleteWebhook RPC.
+	ChatV1DeleteWebhookProcedure = "/chat_v1.ChatV1/DeleteWebhook"
+	// ChatV1CreateIncomingWebhookProcedure is the fully-qualified name of the ChatV1's
+	// CreateIncomingWebhook RPC.
+	ChatV1CreateIncomingWebhookProcedure = "/chat_v1.ChatV1/CreateIncomingWebhook"
+	// ChatV1ListIncomingWebhooksProcedure is the fully-qualified name of the ChatV1's
+	// ListIncomingWebhooks RPC.
+	ChatV1ListIncomingWebhooksProcedure = "/chat_v1.ChatV1/ListIncomingWebhooks"
+	// ChatV1DeleteIncomingWebhookProcedure is the fully-qualified name of the ChatV1's
+	// DeleteIncomingWebhook RPC.
+	ChatV1DeleteIncomingWebhookProcedure = "/chat_v1.ChatV1/DeleteIncomingWebhook"
+	// ChatV1CreateBotProcedure is the fully-qualified name of the ChatV1's CreateBot RPC.
+	ChatV1CreateBotProcedure = "/chat_v1.ChatV1/CreateBot"
+	// ChatV1ListBotsProcedure is the fully-qualified name of the ChatV1's ListBots RPC.
+	ChatV1ListBotsProcedure = "/chat_v1.ChatV1/ListBots"
+	// ChatV1DeleteBotProcedure is the fully-qualified name of the ChatV1's DeleteBot RPC.
+	ChatV1DeleteBotProcedure = "/chat_v1.ChatV1/DeleteBot"
+	// ChatV1CreatePollProcedure is the fully-qualified name of the ChatV1's CreatePoll RPC.
+	ChatV1CreatePollProcedure = "/chat_v1.ChatV1/CreatePoll"
+	// ChatV1VoteProcedure is the fully-qualified name of the ChatV1's Vote RPC.
+	ChatV1VoteProcedure = "/chat_v1.ChatV1/Vote"
+	// ChatV1ClosePollProcedure is the fully-qualified name of the ChatV1's ClosePoll RPC.
+	ChatV1ClosePollProcedure = "/chat_v1.ChatV1/ClosePoll"
+	// ChatV1GetPollProcedure is the fully-qualified name of the ChatV1's GetPoll RPC.
+	ChatV1GetPollProcedure = "/chat_v1.ChatV1/GetPoll"
+	// ChatV1SubscribeProcedure is the fully-qualified name of the ChatV1's Subscribe RPC.
+	ChatV1SubscribeProcedure = "/chat_v1.ChatV1/Subscribe"
+	// ChatV1SendTypingEventProcedure is the fully-qualified name of the ChatV1's SendTypingEvent RPC.
+	ChatV1SendTypingEventProcedure = "/chat_v1.ChatV1/SendTypingEvent"
+	// ChatV1HeartbeatProcedure is the fully-qualified name of the ChatV1's Heartbeat RPC.
+	ChatV1HeartbeatProcedure = "/chat_v1.ChatV1/Heartbeat"
+	// ChatV1GetPresenceProcedure is the fully-qualified name of the ChatV1's GetPresence RPC.
+	ChatV1GetPresenceProcedure = "/chat_v1.ChatV1/GetPresence"
+	// ChatV1GetUserProcedure is the fully-qualified name of the ChatV1's GetUser RPC.
+	ChatV1GetUserProcedure = "/chat_v1.ChatV1/GetUser"
+	// ChatV1UpdateProfileProcedure is the fully-qualified name of the ChatV1's UpdateProfile RPC.
+	ChatV1UpdateProfileProcedure = "/chat_v1.ChatV1/UpdateProfile"
+	// ChatV1SearchUsersProcedure is the fully-qualified name of the ChatV1's SearchUsers RPC.
+	ChatV1SearchUsersProcedure = "/chat_v1.ChatV1/SearchUsers"
+	// ChatV1BlockUserProcedure is the fully-qualified name of the ChatV1's BlockUser RPC.
+	ChatV1BlockUserProcedure = "/chat_v1.ChatV1/BlockUser"
+	// ChatV1UnblockUserProcedure is the fully-qualified name of the ChatV1's UnblockUser RPC.
+	ChatV1UnblockUserProcedure = "/chat_v1.ChatV1/UnblockUser"
+	// ChatV1ListBlockedProcedure is the fully-qualified name of the ChatV1's ListBlocked RPC.
+	ChatV1ListBlockedProcedure = "/chat_v1.ChatV1/ListBlocked"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	chatV1ServiceDescriptor                      = chat_v1.File_chat_proto.Services().ByName("ChatV1")
+	chatV1CreateMethodDescriptor                 = chatV1ServiceDescriptor.Methods().ByName("Create")
+	chatV1DeleteMethodDescriptor                 = chatV1ServiceDescriptor.Methods().ByName("Delete")
+	chatV1SendMessageMethodDescriptor            = chatV1ServiceDescriptor.Methods().ByName("SendMessage")
+	chatV1ListMessagesMethodDescriptor           = chatV1ServiceDescriptor.Methods().ByName("ListMessages")
+	chatV1ListChatsMethodDescriptor              = chatV1ServiceDescriptor.Methods().ByName("ListChats")
+	chatV1UpdateChatSettingsMethodDescriptor     = chatV1ServiceDescriptor.Methods().ByName("UpdateChatSettings")
+	chatV1ListMentionsMethodDescriptor           = chatV1ServiceDescriptor.Methods().ByName("ListMentions")
+	chatV1PinMessageMethodDescriptor             = chatV1ServiceDescriptor.Methods().ByName("PinMessage")
+	chatV1UnpinMessageMethodDescriptor           = chatV1ServiceDescriptor.Methods().ByName("UnpinMessage")
+	chatV1ListPinnedMessagesMethodDescriptor     = chatV1ServiceDescriptor.Methods().ByName("ListPinnedMessages")
+	chatV1SetPinPolicyMethodDescriptor           = chatV1ServiceDescriptor.Methods().ByName("SetPinPolicy")
+	chatV1ScheduleMessageMethodDescriptor        = chatV1ServiceDescriptor.Methods().ByName("ScheduleMessage")
+	chatV1ListScheduledMessagesMethodDescriptor  = chatV1ServiceDescriptor.Methods().ByName("ListScheduledMessages")
+	chatV1CancelScheduledMessageMethodDescriptor = chatV1ServiceDescriptor.Methods().ByName("CancelScheduledMessage")
+	chatV1ListAuditLogMethodDescriptor           = chatV1ServiceDescriptor.Methods().ByName("ListAuditLog")
+	chatV1CreateWebhookMethodDescriptor          = chatV1ServiceDescriptor.Methods().ByName("CreateWebhook")
+	chatV1ListWebhooksMethodDescriptor           = chatV1ServiceDescriptor.Methods().ByName("ListWebhooks")
+	chatV1DeleteWebhookMethodDescriptor          = chatV1ServiceDescriptor.Methods().ByName("DeleteWebhook")
+	chatV1CreateIncomingWebhookMethodDescriptor  = chatV1ServiceDescriptor.Methods().ByName("CreateIncomingWebhook")
+	chatV1ListIncomingWebhooksMethodDescriptor   = chatV1ServiceDescriptor.Methods().ByName("ListIncomingWebhooks")
+	chatV1DeleteIncomingWebhookMethodDescriptor  = chatV1ServiceDescriptor.Methods().ByName("DeleteIncomingWebhook")
+	chatV1CreateBotMethodDescriptor              = chatV1ServiceDescriptor.Methods().ByName("CreateBot")
+	chatV1ListBotsMethodDescriptor               = chatV1ServiceDescriptor.Methods().ByName("ListBots")
+	chatV1DeleteBotMethodDescriptor              = chatV1ServiceDescriptor.Methods().ByName("DeleteBot")
+	chatV1CreatePollMethodDescriptor             = chatV1ServiceDescriptor.Methods().ByName("CreatePoll")
+	chatV1VoteMethodDescriptor                   = chatV1ServiceDescriptor.Methods().ByName("Vote")
+	chatV1ClosePollMethodDescriptor              = chatV1ServiceDescriptor.Methods().ByName("ClosePoll")
+	chatV1GetPollMethodDescriptor                = chatV1ServiceDescriptor.Methods().ByName("GetPoll")
+	chatV1SubscribeMethodDescriptor              = chatV1ServiceDescriptor.Methods().ByName("Subscribe")
+	chatV1SendTypingEventMethodDescriptor        = chatV1ServiceDescriptor.Methods().ByName("SendTypingEvent")
+	chatV1HeartbeatMethodDescriptor              = chatV1ServiceDescriptor.Methods().ByName("Heartbeat")
+	chatV1GetPresenceMethodDescriptor            = chatV1ServiceDescriptor.Methods().ByName("GetPresence")
+	chatV1GetUserMethodDescriptor                = chatV1ServiceDescriptor.Methods().ByName("GetUser")
+	chatV1UpdateProfileMethodDescriptor          = chatV1ServiceDescriptor.Methods().ByName("UpdateProfile")
+	chatV1SearchUsersMethodDescriptor            = chatV1ServiceDescriptor.Methods().ByName("SearchUsers")
+	chatV1BlockUserMethodDescriptor              = chatV1ServiceDescriptor.Methods().ByName("BlockUser")
+	chatV1UnblockUserMethodDescriptor            = chatV1ServiceDescriptor.Methods().ByName("UnblockUser")
+	chatV1ListBlockedMethodDescriptor            = chatV1ServiceDescriptor.Methods().ByName("ListBlocked")
+)
+
+// ChatV1Client is a client for the chat_v1.ChatV1 service.
+type ChatV1Client interface {
+	Create(context.Context, *connect.Request[chat_v1.CreateRequest]) (*connect.Response[chat_v1.CreateResponse], error)
+	Delete(context.Context, *connect.Request[chat_v1.DeleteRequest]) (*connect.Response[emptypb.Empty], error)
+	SendMessage(context.Context, *connect.Request[chat_v1.SendMessageRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListMessages returns the history of a chat, newest first, without the messages of the users
+	// blocked by the reader.
+	ListMessages(context.Context, *connect.Request[chat_v1.ListMessagesRequest]) (*connect.Response[chat_v1.ListMessagesResponse], error)
+	// ListChats returns the chats of a participant with their settings, the pinned ones first.
+	ListChats(context.Context, *connect.Request[chat_v1.ListChatsRequest]) (*connect.Response[chat_v1.ListChatsResponse], error)
+	// UpdateChatSettings updates the mute, the notification level and the pinned and archived flags
+	// of a chat for a participant, the unset fields are left unchanged.
+	UpdateChatSettings(context.Context, *connect.Request[chat_v1.UpdateChatSettingsRequest]) (*connect.Response[chat_v1.ChatSettings], error)
+	// ListMentions returns the messages mentioning a user as @email or @display_name, newest first.
+	ListMentions(context.Context, *connect.Request[chat_v1.ListMentionsRequest]) (*connect.Response[chat_v1.ListMentionsResponse], error)
+	// PinMessage pins a message of a chat. Only the administrators pin messages, unless the pin policy
+	// of the chat lets its participants do so. The pin is broadcast to the subscribers as "message.pinned".
+	PinMessage(context.Context, *connect.Request[chat_v1.PinMessageRequest]) (*connect.Response[chat_v1.PinnedMessage], error)
+	// UnpinMessage unpins a message of a chat, under the pin policy of PinMessage. The unpin is broadcast
+	// to the subscribers as "message.unpinned".
+	UnpinMessage(context.Context, *connect.Request[chat_v1.UnpinMessageRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListPinnedMessages returns the pinned messages of a chat, most recently pinned first.
+	ListPinnedMessages(context.Context, *connect.Request[chat_v1.ListPinnedMessagesRequest]) (*connect.Response[chat_v1.ListPinnedMessagesResponse], error)
+	// SetPinPolicy sets whether the participants of a chat may pin its messages, besides the administrators.
+	// Admin only.
+	SetPinPolicy(context.Context, *connect.Request[chat_v1.SetPinPolicyRequest]) (*connect.Response[emptypb.Empty], error)
+	// ScheduleMessage schedules a message to be sent to a chat at send_at, as with SendMessage, and returns
+	// its id. The message is sent once, by any instance of the server, even across restarts.
+	ScheduleMessage(context.Context, *connect.Request[chat_v1.ScheduleMessageRequest]) (*connect.Response[chat_v1.ScheduleMessageResponse], error)
+	// ListScheduledMessages returns the messages scheduled by a sender and not sent yet, the soonest first.
+	ListScheduledMessages(context.Context, *connect.Request[chat_v1.ListScheduledMessagesRequest]) (*connect.Response[chat_v1.ListScheduledMessagesResponse], error)
+	// CancelScheduledMessage cancels a message scheduled by a sender, unless it was already sent.
+	CancelScheduledMessage(context.Context, *connect.Request[chat_v1.CancelScheduledMessageRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListAuditLog returns the audit log of api actions, newest first. Admin only.
+	ListAuditLog(context.Context, *connect.Request[chat_v1.ListAuditLogRequest]) (*connect.Response[chat_v1.ListAuditLogResponse], error)
+	// CreateWebhook subscribes an endpoint to the events of a chat, or of all chats. Admin only.
+	CreateWebhook(context.Context, *connect.Request[chat_v1.CreateWebhookRequest]) (*connect.Response[chat_v1.CreateWebhookResponse], error)
+	// ListWebhooks returns the webhooks of a chat, or all webhooks. Admin only.
+	ListWebhooks(context.Context, *connect.Request[chat_v1.ListWebhooksRequest]) (*connect.Response[chat_v1.ListWebhooksResponse], error)
+	// DeleteWebhook removes a webhook with its pending deliveries. Admin only.
+	DeleteWebhook(context.Context, *connect.Request[chat_v1.DeleteWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreateIncomingWebhook creates a token allowing external tools to post messages to a chat
+	// over HTTP as a named bot. Admin only.
+	CreateIncomingWebhook(context.Context, *connect.Request[chat_v1.CreateIncomingWebhookRequest]) (*connect.Response[chat_v1.CreateIncomingWebhookResponse], error)
+	// ListIncomingWebhooks returns the incoming webhooks of a chat, or all of them. Admin only.
+	ListIncomingWebhooks(context.Context, *connect.Request[chat_v1.ListIncomingWebhooksRequest]) (*connect.Response[chat_v1.ListIncomingWebhooksResponse], error)
+	// DeleteIncomingWebhook revokes the token of an incoming webhook. Admin only.
+	DeleteIncomingWebhook(context.Context, *connect.Request[chat_v1.DeleteIncomingWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreateBot creates a bot user authenticating with an API token and handling slash commands. Admin only.
+	CreateBot(context.Context, *connect.Request[chat_v1.CreateBotRequest]) (*connect.Response[chat_v1.CreateBotResponse], error)
+	// ListBots returns the bots with their commands. Admin only.
+	ListBots(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[chat_v1.ListBotsResponse], error)
+	// DeleteBot removes a bot and revokes its token. Admin only.
+	DeleteBot(context.Context, *connect.Request[chat_v1.DeleteBotRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreatePoll posts a poll to a chat.
+	CreatePoll(context.Context, *connect.Request[chat_v1.CreatePollRequest]) (*connect.Response[chat_v1.CreatePollResponse], error)
+	// Vote records the ballot of a participant, each participant votes once.
+	Vote(context.Context, *connect.Request[chat_v1.VoteRequest]) (*connect.Response[emptypb.Empty], error)
+	// ClosePoll stops the voting, only the author of the poll or an administrator may close it.
+	ClosePoll(context.Context, *connect.Request[chat_v1.ClosePollRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetPoll returns a poll with its results.
+	GetPoll(context.Context, *connect.Request[chat_v1.GetPollRequest]) (*connect.Response[chat_v1.Poll], error)
+	// Subscribe streams the live updates of a chat, such as the results of its polls and the presence
+	// of its participants. The subscriber given as "from" is online while subscribed.
+	Subscribe(context.Context, *connect.Request[chat_v1.SubscribeRequest]) (*connect.ServerStreamForClient[chat_v1.ChatUpdate], error)
+	// SendTypingEvent tells the subscribers of a chat that a participant is typing. The event is not stored
+	// and expires after a few seconds, the events sent too often are dropped.
+	SendTypingEvent(context.Context, *connect.Request[chat_v1.SendTypingEventRequest]) (*connect.Response[emptypb.Empty], error)
+	// Heartbeat keeps a user online without a subscription, or tells that the user is idle.
+	Heartbeat(context.Context, *connect.Request[chat_v1.HeartbeatRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetPresence returns whether the users are online, away or offline, and when they were last seen.
+	GetPresence(context.Context, *connect.Request[chat_v1.GetPresenceRequest]) (*connect.Response[chat_v1.GetPresenceResponse], error)
+	// GetUser returns a user with their profile.
+	GetUser(context.Context, *connect.Request[chat_v1.GetUserRequest]) (*connect.Response[chat_v1.User], error)
+	// UpdateProfile updates the display name, the avatar or the status text of a user, the unset fields
+	// are left unchanged.
+	UpdateProfile(context.Context, *connect.Request[chat_v1.UpdateProfileRequest]) (*connect.Response[chat_v1.User], error)
+	// SearchUsers returns the users whose email or display name starts with the query, ignoring the case.
+	SearchUsers(context.Context, *connect.Request[chat_v1.SearchUsersRequest]) (*connect.Response[chat_v1.SearchUsersResponse], error)
+	// BlockUser hides the messages and the live updates of a user from the blocker and prevents any direct
+	// chat between them. The group chats they share are kept.
+	BlockUser(context.Context, *connect.Request[chat_v1.BlockUserRequest]) (*connect.Response[emptypb.Empty], error)
+	// UnblockUser lifts the block of a user.
+	UnblockUser(context.Context, *connect.Request[chat_v1.UnblockUserRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListBlocked returns the users blocked by a user.
+	ListBlocked(context.Context, *connect.Request[chat_v1.ListBlockedRequest]) (*connect.Response[chat_v1.ListBlockedResponse], error)
+}
+
+// NewChatV1Client constructs a client for the chat_v1.ChatV1 service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewChatV1Client(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ChatV1Client {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &chatV1Client{
+		create: connect.NewClient[chat_v1.CreateRequest, chat_v1.CreateResponse](
+			httpClient,
+			baseURL+ChatV1CreateProcedure,
+			connect.WithSchema(chatV1CreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		delete: connect.NewClient[chat_v1.DeleteRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1DeleteProcedure,
+			connect.WithSchema(chatV1DeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		sendMessage: connect.NewClient[chat_v1.SendMessageRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1SendMessageProcedure,
+			connect.WithSchema(chatV1SendMessageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listMessages: connect.NewClient[chat_v1.ListMessagesRequest, chat_v1.ListMessagesResponse](
+			httpClient,
+			baseURL+ChatV1ListMessagesProcedure,
+			connect.WithSchema(chatV1ListMessagesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listChats: connect.NewClient[chat_v1.ListChatsRequest, chat_v1.ListChatsResponse](
+			httpClient,
+			baseURL+ChatV1ListChatsProcedure,
+			connect.WithSchema(chatV1ListChatsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateChatSettings: connect.NewClient[chat_v1.UpdateChatSettingsRequest, chat_v1.ChatSettings](
+			httpClient,
+			baseURL+ChatV1UpdateChatSettingsProcedure,
+			connect.WithSchema(chatV1UpdateChatSettingsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listMentions: connect.NewClient[chat_v1.ListMentionsRequest, chat_v1.ListMentionsResponse](
+			httpClient,
+			baseURL+ChatV1ListMentionsProcedure,
+			connect.WithSchema(chatV1ListMentionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		pinMessage: connect.NewClient[chat_v1.PinMessageRequest, chat_v1.PinnedMessage](
+			httpClient,
+			baseURL+ChatV1PinMessageProcedure,
+			connect.WithSchema(chatV1PinMessageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unpinMessage: connect.NewClient[chat_v1.UnpinMessageRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1UnpinMessageProcedure,
+			connect.WithSchema(chatV1UnpinMessageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listPinnedMessages: connect.NewClient[chat_v1.ListPinnedMessagesRequest, chat_v1.ListPinnedMessagesResponse](
+			httpClient,
+			baseURL+ChatV1ListPinnedMessagesProcedure,
+			connect.WithSchema(chatV1ListPinnedMessagesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setPinPolicy: connect.NewClient[chat_v1.SetPinPolicyRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1SetPinPolicyProcedure,
+			connect.WithSchema(chatV1SetPinPolicyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		scheduleMessage: connect.NewClient[chat_v1.ScheduleMessageRequest, chat_v1.ScheduleMessageResponse](
+			httpClient,
+			baseURL+ChatV1ScheduleMessageProcedure,
+			connect.WithSchema(chatV1ScheduleMessageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listScheduledMessages: connect.NewClient[chat_v1.ListScheduledMessagesRequest, chat_v1.ListScheduledMessagesResponse](
+			httpClient,
+			baseURL+ChatV1ListScheduledMessagesProcedure,
+			connect.WithSchema(chatV1ListScheduledMessagesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		cancelScheduledMessage: connect.NewClient[chat_v1.CancelScheduledMessageRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1CancelScheduledMessageProcedure,
+			connect.WithSchema(chatV1CancelScheduledMessageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditLog: connect.NewClient[chat_v1.ListAuditLogRequest, chat_v1.ListAuditLogResponse](
+			httpClient,
+			baseURL+ChatV1ListAuditLogProcedure,
+			connect.WithSchema(chatV1ListAuditLogMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createWebhook: connect.NewClient[chat_v1.CreateWebhookRequest, chat_v1.CreateWebhookResponse](
+			httpClient,
+			baseURL+ChatV1CreateWebhookProcedure,
+			connect.WithSchema(chatV1CreateWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhooks: connect.NewClient[chat_v1.ListWebhooksRequest, chat_v1.ListWebhooksResponse](
+			httpClient,
+			baseURL+ChatV1ListWebhooksProcedure,
+			connect.WithSchema(chatV1ListWebhooksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[chat_v1.DeleteWebhookRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1DeleteWebhookProcedure,
+			connect.WithSchema(chatV1DeleteWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createIncomingWebhook: connect.NewClient[chat_v1.CreateIncomingWebhookRequest, chat_v1.CreateIncomingWebhookResponse](
+			httpClient,
+			baseURL+ChatV1CreateIncomingWebhookProcedure,
+			connect.WithSchema(chatV1CreateIncomingWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listIncomingWebhooks: connect.NewClient[chat_v1.ListIncomingWebhooksRequest, chat_v1.ListIncomingWebhooksResponse](
+			httpClient,
+			baseURL+ChatV1ListIncomingWebhooksProcedure,
+			connect.WithSchema(chatV1ListIncomingWebhooksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteIncomingWebhook: connect.NewClient[chat_v1.DeleteIncomingWebhookRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1DeleteIncomingWebhookProcedure,
+			connect.WithSchema(chatV1DeleteIncomingWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createBot: connect.NewClient[chat_v1.CreateBotRequest, chat_v1.CreateBotResponse](
+			httpClient,
+			baseURL+ChatV1CreateBotProcedure,
+			connect.WithSchema(chatV1CreateBotMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listBots: connect.NewClient[emptypb.Empty, chat_v1.ListBotsResponse](
+			httpClient,
+			baseURL+ChatV1ListBotsProcedure,
+			connect.WithSchema(chatV1ListBotsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteBot: connect.NewClient[chat_v1.DeleteBotRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1DeleteBotProcedure,
+			connect.WithSchema(chatV1DeleteBotMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createPoll: connect.NewClient[chat_v1.CreatePollRequest, chat_v1.CreatePollResponse](
+			httpClient,
+			baseURL+ChatV1CreatePollProcedure,
+			connect.WithSchema(chatV1CreatePollMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		vote: connect.NewClient[chat_v1.VoteRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1VoteProcedure,
+			connect.WithSchema(chatV1VoteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		closePoll: connect.NewClient[chat_v1.ClosePollRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1ClosePollProcedure,
+			connect.WithSchema(chatV1ClosePollMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getPoll: connect.NewClient[chat_v1.GetPollRequest, chat_v1.Poll](
+			httpClient,
+			baseURL+ChatV1GetPollProcedure,
+			connect.WithSchema(chatV1GetPollMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		subscribe: connect.NewClient[chat_v1.SubscribeRequest, chat_v1.ChatUpdate](
+			httpClient,
+			baseURL+ChatV1SubscribeProcedure,
+			connect.WithSchema(chatV1SubscribeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		sendTypingEvent: connect.NewClient[chat_v1.SendTypingEventRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1SendTypingEventProcedure,
+			connect.WithSchema(chatV1SendTypingEventMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		heartbeat: connect.NewClient[chat_v1.HeartbeatRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1HeartbeatProcedure,
+			connect.WithSchema(chatV1HeartbeatMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getPresence: connect.NewClient[chat_v1.GetPresenceRequest, chat_v1.GetPresenceResponse](
+			httpClient,
+			baseURL+ChatV1GetPresenceProcedure,
+			connect.WithSchema(chatV1GetPresenceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getUser: connect.NewClient[chat_v1.GetUserRequest, chat_v1.User](
+			httpClient,
+			baseURL+ChatV1GetUserProcedure,
+			connect.WithSchema(chatV1GetUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateProfile: connect.NewClient[chat_v1.UpdateProfileRequest, chat_v1.User](
+			httpClient,
+			baseURL+ChatV1UpdateProfileProcedure,
+			connect.WithSchema(chatV1UpdateProfileMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		searchUsers: connect.NewClient[chat_v1.SearchUsersRequest, chat_v1.SearchUsersResponse](
+			httpClient,
+			baseURL+ChatV1SearchUsersProcedure,
+			connect.WithSchema(chatV1SearchUsersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		blockUser: connect.NewClient[chat_v1.BlockUserRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1BlockUserProcedure,
+			connect.WithSchema(chatV1BlockUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unblockUser: connect.NewClient[chat_v1.UnblockUserRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ChatV1UnblockUserProcedure,
+			connect.WithSchema(chatV1UnblockUserMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listBlocked: connect.NewClient[chat_v1.ListBlockedRequest, chat_v1.ListBlockedResponse](
+			httpClient,
+			baseURL+ChatV1ListBlockedProcedure,
+			connect.WithSchema(chatV1ListBlockedMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// chatV1Client implements ChatV1Client.
+type chatV1Client struct {
+	create                 *connect.Client[chat_v1.CreateRequest, chat_v1.CreateResponse]
+	delete                 *connect.Client[chat_v1.DeleteRequest, emptypb.Empty]
+	sendMessage            *connect.Client[chat_v1.SendMessageRequest, emptypb.Empty]
+	listMessages           *connect.Client[chat_v1.ListMessagesRequest, chat_v1.ListMessagesResponse]
+	listChats              *connect.Client[chat_v1.ListChatsRequest, chat_v1.ListChatsResponse]
+	updateChatSettings     *connect.Client[chat_v1.UpdateChatSettingsRequest, chat_v1.ChatSettings]
+	listMentions           *connect.Client[chat_v1.ListMentionsRequest, chat_v1.ListMentionsResponse]
+	pinMessage             *connect.Client[chat_v1.PinMessageRequest, chat_v1.PinnedMessage]
+	unpinMessage           *connect.Client[chat_v1.UnpinMessageRequest, emptypb.Empty]
+	listPinnedMessages     *connect.Client[chat_v1.ListPinnedMessagesRequest, chat_v1.ListPinnedMessagesResponse]
+	setPinPolicy           *connect.Client[chat_v1.SetPinPolicyRequest, emptypb.Empty]
+	scheduleMessage        *connect.Client[chat_v1.ScheduleMessageRequest, chat_v1.ScheduleMessageResponse]
+	listScheduledMessages  *connect.Client[chat_v1.ListScheduledMessagesRequest, chat_v1.ListScheduledMessagesResponse]
+	cancelScheduledMessage *connect.Client[chat_v1.CancelScheduledMessageRequest, emptypb.Empty]
+	listAuditLog           *connect.Client[chat_v1.ListAuditLogRequest, chat_v1.ListAuditLogResponse]
+	createWebhook          *connect.Client[chat_v1.CreateWebhookRequest, chat_v1.CreateWebhookResponse]
+	listWebhooks           *connect.Client[chat_v1.ListWebhooksRequest, chat_v1.ListWebhooksResponse]
+	deleteWebhook          *connect.Client[chat_v1.DeleteWebhookRequest, emptypb.Empty]
+	createIncomingWebhook  *connect.Client[chat_v1.CreateIncomingWebhookRequest, chat_v1.CreateIncomingWebhookResponse]
+	listIncomingWebhooks   *connect.Client[chat_v1.ListIncomingWebhooksRequest, chat_v1.ListIncomingWebhooksResponse]
+	deleteIncomingWebhook  *connect.Client[chat_v1.DeleteIncomingWebhookRequest, emptypb.Empty]
+	createBot              *connect.Client[chat_v1.CreateBotRequest, chat_v1.CreateBotResponse]
+	listBots               *connect.Client[emptypb.Empty, chat_v1.ListBotsResponse]
+	deleteBot              *connect.Client[chat_v1.DeleteBotRequest, emptypb.Empty]
+	createPoll             *connect.Client[chat_v1.CreatePollRequest, chat_v1.CreatePollResponse]
+	vote                   *connect.Client[chat_v1.VoteRequest, emptypb.Empty]
+	closePoll              *connect.Client[chat_v1.ClosePollRequest, emptypb.Empty]
+	getPoll                *connect.Client[chat_v1.GetPollRequest, chat_v1.Poll]
+	subscribe              *connect.Client[chat_v1.SubscribeRequest, chat_v1.ChatUpdate]
+	sendTypingEvent        *connect.Client[chat_v1.SendTypingEventRequest, emptypb.Empty]
+	heartbeat              *connect.Client[chat_v1.HeartbeatRequest, emptypb.Empty]
+	getPresence            *connect.Client[chat_v1.GetPresenceRequest, chat_v1.GetPresenceResponse]
+	getUser                *connect.Client[chat_v1.GetUserRequest, chat_v1.User]
+	updateProfile          *connect.Client[chat_v1.UpdateProfileRequest, chat_v1.User]
+	searchUsers            *connect.Client[chat_v1.SearchUsersRequest, chat_v1.SearchUsersResponse]
+	blockUser              *connect.Client[chat_v1.BlockUserRequest, emptypb.Empty]
+	unblockUser            *connect.Client[chat_v1.UnblockUserRequest, emptypb.Empty]
+	listBlocked            *connect.Client[chat_v1.ListBlockedRequest, chat_v1.ListBlockedResponse]
+}
+
+// Create calls chat_v1.ChatV1.Create.
+func (c *chatV1Client) Create(ctx context.Context, req *connect.Request[chat_v1.CreateRequest]) (*connect.Response[chat_v1.CreateResponse], error) {
+	return c.create.CallUnary(ctx, req)
+}
+
+// Delete calls chat_v1.ChatV1.Delete.
+func (c *chatV1Client) Delete(ctx context.Context, req *connect.Request[chat_v1.DeleteRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.delete.CallUnary(ctx, req)
+}
+
+// SendMessage calls chat_v1.ChatV1.SendMessage.
+func (c *chatV1Client) SendMessage(ctx context.Context, req *connect.Request[chat_v1.SendMessageRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.sendMessage.CallUnary(ctx, req)
+}
+
+// ListMessages calls chat_v1.ChatV1.ListMessages.
+func (c *chatV1Client) ListMessages(ctx context.Context, req *connect.Request[chat_v1.ListMessagesRequest]) (*connect.Response[chat_v1.ListMessagesResponse], error) {
+	return c.listMessages.CallUnary(ctx, req)
+}
+
+// ListChats calls chat_v1.ChatV1.ListChats.
+func (c *chatV1Client) ListChats(ctx context.Context, req *connect.Request[chat_v1.ListChatsRequest]) (*connect.Response[chat_v1.ListChatsResponse], error) {
+	return c.listChats.CallUnary(ctx, req)
+}
+
+// UpdateChatSettings calls chat_v1.ChatV1.UpdateChatSettings.
+func (c *chatV1Client) UpdateChatSettings(ctx context.Context, req *connect.Request[chat_v1.UpdateChatSettingsRequest]) (*connect.Response[chat_v1.ChatSettings], error) {
+	return c.updateChatSettings.CallUnary(ctx, req)
+}
+
+// ListMentions calls chat_v1.ChatV1.ListMentions.
+func (c *chatV1Client) ListMentions(ctx context.Context, req *connect.Request[chat_v1.ListMentionsRequest]) (*connect.Response[chat_v1.ListMentionsResponse], error) {
+	return c.listMentions.CallUnary(ctx, req)
+}
+
+// PinMessage calls chat_v1.ChatV1.PinMessage.
+func (c *chatV1Client) PinMessage(ctx context.Context, req *connect.Request[chat_v1.PinMessageRequest]) (*connect.Response[chat_v1.PinnedMessage], error) {
+	return c.pinMessage.CallUnary(ctx, req)
+}
+
+// UnpinMessage calls chat_v1.ChatV1.UnpinMessage.
+func (c *chatV1Client) UnpinMessage(ctx context.Context, req *connect.Request[chat_v1.UnpinMessageRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.unpinMessage.CallUnary(ctx, req)
+}
+
+// ListPinnedMessages calls chat_v1.ChatV1.ListPinnedMessages.
+func (c *chatV1Client) ListPinnedMessages(ctx context.Context, req *connect.Request[chat_v1.ListPinnedMessagesRequest]) (*connect.Response[chat_v1.ListPinnedMessagesResponse], error) {
+	return c.listPinnedMessages.CallUnary(ctx, req)
+}
+
+// SetPinPolicy calls chat_v1.ChatV1.SetPinPolicy.
+func (c *chatV1Client) SetPinPolicy(ctx context.Context, req *connect.Request[chat_v1.SetPinPolicyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.setPinPolicy.CallUnary(ctx, req)
+}
+
+// ScheduleMessage calls chat_v1.ChatV1.ScheduleMessage.
+func (c *chatV1Client) ScheduleMessage(ctx context.Context, req *connect.Request[chat_v1.ScheduleMessageRequest]) (*connect.Response[chat_v1.ScheduleMessageResponse], error) {
+	return c.scheduleMessage.CallUnary(ctx, req)
+}
+
+// ListScheduledMessages calls chat_v1.ChatV1.ListScheduledMessages.
+func (c *chatV1Client) ListScheduledMessages(ctx context.Context, req *connect.Request[chat_v1.ListScheduledMessagesRequest]) (*connect.Response[chat_v1.ListScheduledMessagesResponse], error) {
+	return c.listScheduledMessages.CallUnary(ctx, req)
+}
+
+// CancelScheduledMessage calls chat_v1.ChatV1.CancelScheduledMessage.
+func (c *chatV1Client) CancelScheduledMessage(ctx context.Context, req *connect.Request[chat_v1.CancelScheduledMessageRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.cancelScheduledMessage.CallUnary(ctx, req)
+}
+
+// ListAuditLog calls chat_v1.ChatV1.ListAuditLog.
+func (c *chatV1Client) ListAuditLog(ctx context.Context, req *connect.Request[chat_v1.ListAuditLogRequest]) (*connect.Response[chat_v1.ListAuditLogResponse], error) {
+	return c.listAuditLog.CallUnary(ctx, req)
+}
+
+// CreateWebhook calls chat_v1.ChatV1.CreateWebhook.
+func (c *chatV1Client) CreateWebhook(ctx context.Context, req *connect.Request[chat_v1.CreateWebhookRequest]) (*connect.Response[chat_v1.CreateWebhookResponse], error) {
+	return c.createWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhooks calls chat_v1.ChatV1.ListWebhooks.
+func (c *chatV1Client) ListWebhooks(ctx context.Context, req *connect.Request[chat_v1.ListWebhooksRequest]) (*connect.Response[chat_v1.ListWebhooksResponse], error) {
+	return c.listWebhooks.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls chat_v1.ChatV1.DeleteWebhook.
+func (c *chatV1Client) DeleteWebhook(ctx context.Context, req *connect.Request[chat_v1.DeleteWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// CreateIncomingWebhook calls chat_v1.ChatV1.CreateIncomingWebhook.
+func (c *chatV1Client) CreateIncomingWebhook(ctx context.Context, req *connect.Request[chat_v1.CreateIncomingWebhookRequest]) (*connect.Response[chat_v1.CreateIncomingWebhookResponse], error) {
+	return c.createIncomingWebhook.CallUnary(ctx, req)
+}
+
+// ListIncomingWebhooks calls chat_v1.ChatV1.ListIncomingWebhooks.
+func (c *chatV1Client) ListIncomingWebhooks(ctx context.Context, req *connect.Request[chat_v1.ListIncomingWebhooksRequest]) (*connect.Response[chat_v1.ListIncomingWebhooksResponse], error) {
+	return c.listIncomingWebhooks.CallUnary(ctx, req)
+}
+
+// DeleteIncomingWebhook calls chat_v1.ChatV1.DeleteIncomingWebhook.
+func (c *chatV1Client) DeleteIncomingWebhook(ctx context.Context, req *connect.Request[chat_v1.DeleteIncomingWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteIncomingWebhook.CallUnary(ctx, req)
+}
+
+// CreateBot calls chat_v1.ChatV1.CreateBot.
+func (c *chatV1Client) CreateBot(ctx context.Context, req *connect.Request[chat_v1.CreateBotRequest]) (*connect.Response[chat_v1.CreateBotResponse], error) {
+	return c.createBot.CallUnary(ctx, req)
+}
+
+// ListBots calls chat_v1.ChatV1.ListBots.
+func (c *chatV1Client) ListBots(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[chat_v1.ListBotsResponse], error) {
+	return c.listBots.CallUnary(ctx, req)
+}
+
+// DeleteBot calls chat_v1.ChatV1.DeleteBot.
+func (c *chatV1Client) DeleteBot(ctx context.Context, req *connect.Request[chat_v1.DeleteBotRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteBot.CallUnary(ctx, req)
+}
+
+// CreatePoll calls chat_v1.ChatV1.CreatePoll.
+func (c *chatV1Client) CreatePoll(ctx context.Context, req *connect.Request[chat_v1.CreatePollRequest]) (*connect.Response[chat_v1.CreatePollResponse], error) {
+	return c.createPoll.CallUnary(ctx, req)
+}
+
+// Vote calls chat_v1.ChatV1.Vote.
+func (c *chatV1Client) Vote(ctx context.Context, req *connect.Request[chat_v1.VoteRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.vote.CallUnary(ctx, req)
+}
+
+// ClosePoll calls chat_v1.ChatV1.ClosePoll.
+func (c *chatV1Client) ClosePoll(ctx context.Context, req *connect.Request[chat_v1.ClosePollRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.closePoll.CallUnary(ctx, req)
+}
+
+// GetPoll calls chat_v1.ChatV1.GetPoll.
+func (c *chatV1Client) GetPoll(ctx context.Context, req *connect.Request[chat_v1.GetPollRequest]) (*connect.Response[chat_v1.Poll], error) {
+	return c.getPoll.CallUnary(ctx, req)
+}
+
+// Subscribe calls chat_v1.ChatV1.Subscribe.
+func (c *chatV1Client) Subscribe(ctx context.Context, req *connect.Request[chat_v1.SubscribeRequest]) (*connect.ServerStreamForClient[chat_v1.ChatUpdate], error) {
+	return c.subscribe.CallServerStream(ctx, req)
+}
+
+// SendTypingEvent calls chat_v1.ChatV1.SendTypingEvent.
+func (c *chatV1Client) SendTypingEvent(ctx context.Context, req *connect.Request[chat_v1.SendTypingEventRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.sendTypingEvent.CallUnary(ctx, req)
+}
+
+// Heartbeat calls chat_v1.ChatV1.Heartbeat.
+func (c *chatV1Client) Heartbeat(ctx context.Context, req *connect.Request[chat_v1.HeartbeatRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.heartbeat.CallUnary(ctx, req)
+}
+
+// GetPresence calls chat_v1.ChatV1.GetPresence.
+func (c *chatV1Client) GetPresence(ctx context.Context, req *connect.Request[chat_v1.GetPresenceRequest]) (*connect.Response[chat_v1.GetPresenceResponse], error) {
+	return c.getPresence.CallUnary(ctx, req)
+}
+
+// GetUser calls chat_v1.ChatV1.GetUser.
+func (c *chatV1Client) GetUser(ctx context.Context, req *connect.Request[chat_v1.GetUserRequest]) (*connect.Response[chat_v1.User], error) {
+	return c.getUser.CallUnary(ctx, req)
+}
+
+// UpdateProfile calls chat_v1.ChatV1.UpdateProfile.
+func (c *chatV1Client) UpdateProfile(ctx context.Context, req *connect.Request[chat_v1.UpdateProfileRequest]) (*connect.Response[chat_v1.User], error) {
+	return c.updateProfile.CallUnary(ctx, req)
+}
+
+// SearchUsers calls chat_v1.ChatV1.SearchUsers.
+func (c *chatV1Client) SearchUsers(ctx context.Context, req *connect.Request[chat_v1.SearchUsersRequest]) (*connect.Response[chat_v1.SearchUsersResponse], error) {
+	return c.searchUsers.CallUnary(ctx, req)
+}
+
+// BlockUser calls chat_v1.ChatV1.BlockUser.
+func (c *chatV1Client) BlockUser(ctx context.Context, req *connect.Request[chat_v1.BlockUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.blockUser.CallUnary(ctx, req)
+}
+
+// UnblockUser calls chat_v1.ChatV1.UnblockUser.
+func (c *chatV1Client) UnblockUser(ctx context.Context, req *connect.Request[chat_v1.UnblockUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.unblockUser.CallUnary(ctx, req)
+}
+
+// ListBlocked calls chat_v1.ChatV1.ListBlocked.
+func (c *chatV1Client) ListBlocked(ctx context.Context, req *connect.Request[chat_v1.ListBlockedRequest]) (*connect.Response[chat_v1.ListBlockedResponse], error) {
+	return c.listBlocked.CallUnary(ctx, req)
+}
+
+// ChatV1Handler is an implementation of the chat_v1.ChatV1 service.
+type ChatV1Handler interface {
+	Create(context.Context, *connect.Request[chat_v1.CreateRequest]) (*connect.Response[chat_v1.CreateResponse], error)
+	Delete(context.Context, *connect.Request[chat_v1.DeleteRequest]) (*connect.Response[emptypb.Empty], error)
+	SendMessage(context.Context, *connect.Request[chat_v1.SendMessageRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListMessages returns the history of a chat, newest first, without the messages of the users
+	// blocked by the reader.
+	ListMessages(context.Context, *connect.Request[chat_v1.ListMessagesRequest]) (*connect.Response[chat_v1.ListMessagesResponse], error)
+	// ListChats returns the chats of a participant with their settings, the pinned ones first.
+	ListChats(context.Context, *connect.Request[chat_v1.ListChatsRequest]) (*connect.Response[chat_v1.ListChatsResponse], error)
+	// UpdateChatSettings updates the mute, the notification level and the pinned and archived flags
+	// of a chat for a participant, the unset fields are left unchanged.
+	UpdateChatSettings(context.Context, *connect.Request[chat_v1.UpdateChatSettingsRequest]) (*connect.Response[chat_v1.ChatSettings], error)
+	// ListMentions returns the messages mentioning a user as @email or @display_name, newest first.
+	ListMentions(context.Context, *connect.Request[chat_v1.ListMentionsRequest]) (*connect.Response[chat_v1.ListMentionsResponse], error)
+	// PinMessage pins a message of a chat. Only the administrators pin messages, unless the pin policy
+	// of the chat lets its participants do so. The pin is broadcast to the subscribers as "message.pinned".
+	PinMessage(context.Context, *connect.Request[chat_v1.PinMessageRequest]) (*connect.Response[chat_v1.PinnedMessage], error)
+	// UnpinMessage unpins a message of a chat, under the pin policy of PinMessage. The unpin is broadcast
+	// to the subscribers as "message.unpinned".
+	UnpinMessage(context.Context, *connect.Request[chat_v1.UnpinMessageRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListPinnedMessages returns the pinned messages of a chat, most recently pinned first.
+	ListPinnedMessages(context.Context, *connect.Request[chat_v1.ListPinnedMessagesRequest]) (*connect.Response[chat_v1.ListPinnedMessagesResponse], error)
+	// SetPinPolicy sets whether the participants of a chat may pin its messages, besides the administrators.
+	// Admin only.
+	SetPinPolicy(context.Context, *connect.Request[chat_v1.SetPinPolicyRequest]) (*connect.Response[emptypb.Empty], error)
+	// ScheduleMessage schedules a message to be sent to a chat at send_at, as with SendMessage, and returns
+	// its id. The message is sent once, by any instance of the server, even across restarts.
+	ScheduleMessage(context.Context, *connect.Request[chat_v1.ScheduleMessageRequest]) (*connect.Response[chat_v1.ScheduleMessageResponse], error)
+	// ListScheduledMessages returns the messages scheduled by a sender and not sent yet, the soonest first.
+	ListScheduledMessages(context.Context, *connect.Request[chat_v1.ListScheduledMessagesRequest]) (*connect.Response[chat_v1.ListScheduledMessagesResponse], error)
+	// CancelScheduledMessage cancels a message scheduled by a sender, unless it was already sent.
+	CancelScheduledMessage(context.Context, *connect.Request[chat_v1.CancelScheduledMessageRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListAuditLog returns the audit log of api actions, newest first. Admin only.
+	ListAuditLog(context.Context, *connect.Request[chat_v1.ListAuditLogRequest]) (*connect.Response[chat_v1.ListAuditLogResponse], error)
+	// CreateWebhook subscribes an endpoint to the events of a chat, or of all chats. Admin only.
+	CreateWebhook(context.Context, *connect.Request[chat_v1.CreateWebhookRequest]) (*connect.Response[chat_v1.CreateWebhookResponse], error)
+	// ListWebhooks returns the webhooks of a chat, or all webhooks. Admin only.
+	ListWebhooks(context.Context, *connect.Request[chat_v1.ListWebhooksRequest]) (*connect.Response[chat_v1.ListWebhooksResponse], error)
+	// DeleteWebhook removes a webhook with its pending deliveries. Admin only.
+	DeleteWebhook(context.Context, *connect.Request[chat_v1.DeleteWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreateIncomingWebhook creates a token allowing external tools to post messages to a chat
+	// over HTTP as a named bot. Admin only.
+	CreateIncomingWebhook(context.Context, *connect.Request[chat_v1.CreateIncomingWebhookRequest]) (*connect.Response[chat_v1.CreateIncomingWebhookResponse], error)
+	// ListIncomingWebhooks returns the incoming webhooks of a chat, or all of them. Admin only.
+	ListIncomingWebhooks(context.Context, *connect.Request[chat_v1.ListIncomingWebhooksRequest]) (*connect.Response[chat_v1.ListIncomingWebhooksResponse], error)
+	// DeleteIncomingWebhook revokes the token of an incoming webhook. Admin only.
+	DeleteIncomingWebhook(context.Context, *connect.Request[chat_v1.DeleteIncomingWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreateBot creates a bot user authenticating with an API token and handling slash commands. Admin only.
+	CreateBot(context.Context, *connect.Request[chat_v1.CreateBotRequest]) (*connect.Response[chat_v1.CreateBotResponse], error)
+	// ListBots returns the bots with their commands. Admin only.
+	ListBots(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[chat_v1.ListBotsResponse], error)
+	// DeleteBot removes a bot and revokes its token. Admin only.
+	DeleteBot(context.Context, *connect.Request[chat_v1.DeleteBotRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreatePoll posts a poll to a chat.
+	CreatePoll(context.Context, *connect.Request[chat_v1.CreatePollRequest]) (*connect.Response[chat_v1.CreatePollResponse], error)
+	// Vote records the ballot of a participant, each participant votes once.
+	Vote(context.Context, *connect.Request[chat_v1.VoteRequest]) (*connect.Response[emptypb.Empty], error)
+	// ClosePoll stops the voting, only the author of the poll or an administrator may close it.
+	ClosePoll(context.Context, *connect.Request[chat_v1.ClosePollRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetPoll returns a poll with its results.
+	GetPoll(context.Context, *connect.Request[chat_v1.GetPollRequest]) (*connect.Response[chat_v1.Poll], error)
+	// Subscribe streams the live updates of a chat, such as the results of its polls and the presence
+	// of its participants. The subscriber given as "from" is online while subscribed.
+	Subscribe(context.Context, *connect.Request[chat_v1.SubscribeRequest], *connect.ServerStream[chat_v1.ChatUpdate]) error
+	// SendTypingEvent tells the subscribers of a chat that a participant is typing. The event is not stored
+	// and expires after a few seconds, the events sent too often are dropped.
+	SendTypingEvent(context.Context, *connect.Request[chat_v1.SendTypingEventRequest]) (*connect.Response[emptypb.Empty], error)
+	// Heartbeat keeps a user online without a subscription, or tells that the user is idle.
+	Heartbeat(context.Context, *connect.Request[chat_v1.HeartbeatRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetPresence returns whether the users are online, away or offline, and when they were last seen.
+	GetPresence(context.Context, *connect.Request[chat_v1.GetPresenceRequest]) (*connect.Response[chat_v1.GetPresenceResponse], error)
+	// GetUser returns a user with their profile.
+	GetUser(context.Context, *connect.Request[chat_v1.GetUserRequest]) (*connect.Response[chat_v1.User], error)
+	// UpdateProfile updates the display name, the avatar or the status text of a user, the unset fields
+	// are left unchanged.
+	UpdateProfile(context.Context, *connect.Request[chat_v1.UpdateProfileRequest]) (*connect.Response[chat_v1.User], error)
+	// SearchUsers returns the users whose email or display name starts with the query, ignoring the case.
+	SearchUsers(context.Context, *connect.Request[chat_v1.SearchUsersRequest]) (*connect.Response[chat_v1.SearchUsersResponse], error)
+	// BlockUser hides the messages and the live updates of a user from the blocker and prevents any direct
+	// chat between them. The group chats they share are kept.
+	BlockUser(context.Context, *connect.Request[chat_v1.BlockUserRequest]) (*connect.Response[emptypb.Empty], error)
+	// UnblockUser lifts the block of a user.
+	UnblockUser(context.Context, *connect.Request[chat_v1.UnblockUserRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListBlocked returns the users blocked by a user.
+	ListBlocked(context.Context, *connect.Request[chat_v1.ListBlockedRequest]) (*connect.Response[chat_v1.ListBlockedResponse], error)
+}
+
+// NewChatV1Handler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewChatV1Handler(svc ChatV1Handler, opts ...connect.HandlerOption) (string, http.Handler) {
+	chatV1CreateHandler := connect.NewUnaryHandler(
+		ChatV1CreateProcedure,
+		svc.Create,
+		connect.WithSchema(chatV1CreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1DeleteHandler := connect.NewUnaryHandler(
+		ChatV1DeleteProcedure,
+		svc.Delete,
+		connect.WithSchema(chatV1DeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1SendMessageHandler := connect.NewUnaryHandler(
+		ChatV1SendMessageProcedure,
+		svc.SendMessage,
+		connect.WithSchema(chatV1SendMessageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ListMessagesHandler := connect.NewUnaryHandler(
+		ChatV1ListMessagesProcedure,
+		svc.ListMessages,
+		connect.WithSchema(chatV1ListMessagesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ListChatsHandler := connect.NewUnaryHandler(
+		ChatV1ListChatsProcedure,
+		svc.ListChats,
+		connect.WithSchema(chatV1ListChatsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1UpdateChatSettingsHandler := connect.NewUnaryHandler(
+		ChatV1UpdateChatSettingsProcedure,
+		svc.UpdateChatSettings,
+		connect.WithSchema(chatV1UpdateChatSettingsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ListMentionsHandler := connect.NewUnaryHandler(
+		ChatV1ListMentionsProcedure,
+		svc.ListMentions,
+		connect.WithSchema(chatV1ListMentionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1PinMessageHandler := connect.NewUnaryHandler(
+		ChatV1PinMessageProcedure,
+		svc.PinMessage,
+		connect.WithSchema(chatV1PinMessageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1UnpinMessageHandler := connect.NewUnaryHandler(
+		ChatV1UnpinMessageProcedure,
+		svc.UnpinMessage,
+		connect.WithSchema(chatV1UnpinMessageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ListPinnedMessagesHandler := connect.NewUnaryHandler(
+		ChatV1ListPinnedMessagesProcedure,
+		svc.ListPinnedMessages,
+		connect.WithSchema(chatV1ListPinnedMessagesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1SetPinPolicyHandler := connect.NewUnaryHandler(
+		ChatV1SetPinPolicyProcedure,
+		svc.SetPinPolicy,
+		connect.WithSchema(chatV1SetPinPolicyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ScheduleMessageHandler := connect.NewUnaryHandler(
+		ChatV1ScheduleMessageProcedure,
+		svc.ScheduleMessage,
+		connect.WithSchema(chatV1ScheduleMessageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ListScheduledMessagesHandler := connect.NewUnaryHandler(
+		ChatV1ListScheduledMessagesProcedure,
+		svc.ListScheduledMessages,
+		connect.WithSchema(chatV1ListScheduledMessagesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1CancelScheduledMessageHandler := connect.NewUnaryHandler(
+		ChatV1CancelScheduledMessageProcedure,
+		svc.CancelScheduledMessage,
+		connect.WithSchema(chatV1CancelScheduledMessageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ListAuditLogHandler := connect.NewUnaryHandler(
+		ChatV1ListAuditLogProcedure,
+		svc.ListAuditLog,
+		connect.WithSchema(chatV1ListAuditLogMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1CreateWebhookHandler := connect.NewUnaryHandler(
+		ChatV1CreateWebhookProcedure,
+		svc.CreateWebhook,
+		connect.WithSchema(chatV1CreateWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ListWebhooksHandler := connect.NewUnaryHandler(
+		ChatV1ListWebhooksProcedure,
+		svc.ListWebhooks,
+		connect.WithSchema(chatV1ListWebhooksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1DeleteWebhookHandler := connect.NewUnaryHandler(
+		ChatV1DeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(chatV1DeleteWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1CreateIncomingWebhookHandler := connect.NewUnaryHandler(
+		ChatV1CreateIncomingWebhookProcedure,
+		svc.CreateIncomingWebhook,
+		connect.WithSchema(chatV1CreateIncomingWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ListIncomingWebhooksHandler := connect.NewUnaryHandler(
+		ChatV1ListIncomingWebhooksProcedure,
+		svc.ListIncomingWebhooks,
+		connect.WithSchema(chatV1ListIncomingWebhooksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1DeleteIncomingWebhookHandler := connect.NewUnaryHandler(
+		ChatV1DeleteIncomingWebhookProcedure,
+		svc.DeleteIncomingWebhook,
+		connect.WithSchema(chatV1DeleteIncomingWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1CreateBotHandler := connect.NewUnaryHandler(
+		ChatV1CreateBotProcedure,
+		svc.CreateBot,
+		connect.WithSchema(chatV1CreateBotMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ListBotsHandler := connect.NewUnaryHandler(
+		ChatV1ListBotsProcedure,
+		svc.ListBots,
+		connect.WithSchema(chatV1ListBotsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1DeleteBotHandler := connect.NewUnaryHandler(
+		ChatV1DeleteBotProcedure,
+		svc.DeleteBot,
+		connect.WithSchema(chatV1DeleteBotMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1CreatePollHandler := connect.NewUnaryHandler(
+		ChatV1CreatePollProcedure,
+		svc.CreatePoll,
+		connect.WithSchema(chatV1CreatePollMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1VoteHandler := connect.NewUnaryHandler(
+		ChatV1VoteProcedure,
+		svc.Vote,
+		connect.WithSchema(chatV1VoteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ClosePollHandler := connect.NewUnaryHandler(
+		ChatV1ClosePollProcedure,
+		svc.ClosePoll,
+		connect.WithSchema(chatV1ClosePollMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1GetPollHandler := connect.NewUnaryHandler(
+		ChatV1GetPollProcedure,
+		svc.GetPoll,
+		connect.WithSchema(chatV1GetPollMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1SubscribeHandler := connect.NewServerStreamHandler(
+		ChatV1SubscribeProcedure,
+		svc.Subscribe,
+		connect.WithSchema(chatV1SubscribeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1SendTypingEventHandler := connect.NewUnaryHandler(
+		ChatV1SendTypingEventProcedure,
+		svc.SendTypingEvent,
+		connect.WithSchema(chatV1SendTypingEventMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1HeartbeatHandler := connect.NewUnaryHandler(
+		ChatV1HeartbeatProcedure,
+		svc.Heartbeat,
+		connect.WithSchema(chatV1HeartbeatMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1GetPresenceHandler := connect.NewUnaryHandler(
+		ChatV1GetPresenceProcedure,
+		svc.GetPresence,
+		connect.WithSchema(chatV1GetPresenceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1GetUserHandler := connect.NewUnaryHandler(
+		ChatV1GetUserProcedure,
+		svc.GetUser,
+		connect.WithSchema(chatV1GetUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1UpdateProfileHandler := connect.NewUnaryHandler(
+		ChatV1UpdateProfileProcedure,
+		svc.UpdateProfile,
+		connect.WithSchema(chatV1UpdateProfileMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1SearchUsersHandler := connect.NewUnaryHandler(
+		ChatV1SearchUsersProcedure,
+		svc.SearchUsers,
+		connect.WithSchema(chatV1SearchUsersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1BlockUserHandler := connect.NewUnaryHandler(
+		ChatV1BlockUserProcedure,
+		svc.BlockUser,
+		connect.WithSchema(chatV1BlockUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1UnblockUserHandler := connect.NewUnaryHandler(
+		ChatV1UnblockUserProcedure,
+		svc.UnblockUser,
+		connect.WithSchema(chatV1UnblockUserMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatV1ListBlockedHandler := connect.NewUnaryHandler(
+		ChatV1ListBlockedProcedure,
+		svc.ListBlocked,
+		connect.WithSchema(chatV1ListBlockedMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/chat_v1.ChatV1/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ChatV1CreateProcedure:
+			chatV1CreateHandler.ServeHTTP(w, r)
+		case ChatV1DeleteProcedure:
+			chatV1DeleteHandler.ServeHTTP(w, r)
+		case ChatV1SendMessageProcedure:
+			chatV1SendMessageHandler.ServeHTTP(w, r)
+		case ChatV1ListMessagesProcedure:
+			chatV1ListMessagesHandler.ServeHTTP(w, r)
+		case ChatV1ListChatsProcedure:
+			chatV1ListChatsHandler.ServeHTTP(w, r)
+		case ChatV1UpdateChatSettingsProcedure:
+			chatV1UpdateChatSettingsHandler.ServeHTTP(w, r)
+		case ChatV1ListMentionsProcedure:
+			chatV1ListMentionsHandler.ServeHTTP(w, r)
+		case ChatV1PinMessageProcedure:
+			chatV1PinMessageHandler.ServeHTTP(w, r)
+		case ChatV1UnpinMessageProcedure:
+			chatV1UnpinMessageHandler.ServeHTTP(w, r)
+		case ChatV1ListPinnedMessagesProcedure:
+			chatV1ListPinnedMessagesHandler.ServeHTTP(w, r)
+		case ChatV1SetPinPolicyProcedure:
+			chatV1SetPinPolicyHandler.ServeHTTP(w, r)
+		case ChatV1ScheduleMessageProcedure:
+			chatV1ScheduleMessageHandler.ServeHTTP(w, r)
+		case ChatV1ListScheduledMessagesProcedure:
+			chatV1ListScheduledMessagesHandler.ServeHTTP(w, r)
+		case ChatV1CancelScheduledMessageProcedure:
+			chatV1CancelScheduledMessageHandler.ServeHTTP(w, r)
+		case ChatV1ListAuditLogProcedure:
+			chatV1ListAuditLogHandler.ServeHTTP(w, r)
+		case ChatV1CreateWebhookProcedure:
+			chatV1CreateWebhookHandler.ServeHTTP(w, r)
+		case ChatV1ListWebhooksProcedure:
+			chatV1ListWebhooksHandler.ServeHTTP(w, r)
+		case ChatV1DeleteWebhookProcedure:
+			chatV1DeleteWebhookHandler.ServeHTTP(w, r)
+		case ChatV1CreateIncomingWebhookProcedure:
+			chatV1CreateIncomingWebhookHandler.ServeHTTP(w, r)
+		case ChatV1ListIncomingWebhooksProcedure:
+			chatV1ListIncomingWebhooksHandler.ServeHTTP(w, r)
+		case ChatV1DeleteIncomingWebhookProcedure:
+			chatV1DeleteIncomingWebhookHandler.ServeHTTP(w, r)
+		case ChatV1CreateBotProcedure:
+			chatV1CreateBotHandler.ServeHTTP(w, r)
+		case ChatV1ListBotsProcedure:
+			chatV1ListBotsHandler.ServeHTTP(w, r)
+		case ChatV1DeleteBotProcedure:
+			chatV1DeleteBotHandler.ServeHTTP(w, r)
+		case ChatV1CreatePollProcedure:
+			chatV1CreatePollHandler.ServeHTTP(w, r)
+		case ChatV1VoteProcedure:
+			chatV1VoteHandler.ServeHTTP(w, r)
+		case ChatV1ClosePollProcedure:
+			chatV1ClosePollHandler.ServeHTTP(w, r)
+		case ChatV1GetPollProcedure:
+			chatV1GetPollHandler.ServeHTTP(w, r)
+		case ChatV1SubscribeProcedure:
+			chatV1SubscribeHandler.ServeHTTP(w, r)
+		case ChatV1SendTypingEventProcedure:
+			chatV1SendTypingEventHandler.ServeHTTP(w, r)
+		case ChatV1HeartbeatProcedure:
+			chatV1HeartbeatHandler.ServeHTTP(w, r)
+		case ChatV1GetPresenceProcedure:
+			chatV1GetPresenceHandler.ServeHTTP(w, r)
+		case ChatV1GetUserProcedure:
+			chatV1GetUserHandler.ServeHTTP(w, r)
+		case ChatV1UpdateProfileProcedure:
+			chatV1UpdateProfileHandler.ServeHTTP(w, r)
+		case ChatV1SearchUsersProcedure:
+			chatV1SearchUsersHandler.ServeHTTP(w, r)
+		case ChatV1BlockUserProcedure:
+			chatV1BlockUserHandler.ServeHTTP(w, r)
+		case ChatV1UnblockUserProcedure:
+			chatV1UnblockUserHandler.ServeHTTP(w, r)
+		case ChatV1ListBlockedProcedure:
+			chatV1ListBlockedHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedChatV1Handler returns CodeUnimplemented from all methods.
+type UnimplementedChatV1Handler struct{}
+
+func (UnimplementedChatV1Handler) Create(context.Context, *connect.Request[chat_v1.CreateRequest]) (*connect.Response[chat_v1.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.Create is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) Delete(context.Context, *connect.Request[chat_v1.DeleteRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.Delete is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) SendMessage(context.Context, *connect.Request[chat_v1.SendMessageRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.SendMessage is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ListMessages(context.Context, *connect.Request[chat_v1.ListMessagesRequest]) (*connect.Response[chat_v1.ListMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ListMessages is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ListChats(context.Context, *connect.Request[chat_v1.ListChatsRequest]) (*connect.Response[chat_v1.ListChatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ListChats is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) UpdateChatSettings(context.Context, *connect.Request[chat_v1.UpdateChatSettingsRequest]) (*connect.Response[chat_v1.ChatSettings], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.UpdateChatSettings is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ListMentions(context.Context, *connect.Request[chat_v1.ListMentionsRequest]) (*connect.Response[chat_v1.ListMentionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ListMentions is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) PinMessage(context.Context, *connect.Request[chat_v1.PinMessageRequest]) (*connect.Response[chat_v1.PinnedMessage], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.PinMessage is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) UnpinMessage(context.Context, *connect.Request[chat_v1.UnpinMessageRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.UnpinMessage is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ListPinnedMessages(context.Context, *connect.Request[chat_v1.ListPinnedMessagesRequest]) (*connect.Response[chat_v1.ListPinnedMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ListPinnedMessages is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) SetPinPolicy(context.Context, *connect.Request[chat_v1.SetPinPolicyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.SetPinPolicy is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ScheduleMessage(context.Context, *connect.Request[chat_v1.ScheduleMessageRequest]) (*connect.Response[chat_v1.ScheduleMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ScheduleMessage is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ListScheduledMessages(context.Context, *connect.Request[chat_v1.ListScheduledMessagesRequest]) (*connect.Response[chat_v1.ListScheduledMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ListScheduledMessages is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) CancelScheduledMessage(context.Context, *connect.Request[chat_v1.CancelScheduledMessageRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.CancelScheduledMessage is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ListAuditLog(context.Context, *connect.Request[chat_v1.ListAuditLogRequest]) (*connect.Response[chat_v1.ListAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ListAuditLog is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) CreateWebhook(context.Context, *connect.Request[chat_v1.CreateWebhookRequest]) (*connect.Response[chat_v1.CreateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.CreateWebhook is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ListWebhooks(context.Context, *connect.Request[chat_v1.ListWebhooksRequest]) (*connect.Response[chat_v1.ListWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ListWebhooks is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) DeleteWebhook(context.Context, *connect.Request[chat_v1.DeleteWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) CreateIncomingWebhook(context.Context, *connect.Request[chat_v1.CreateIncomingWebhookRequest]) (*connect.Response[chat_v1.CreateIncomingWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.CreateIncomingWebhook is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ListIncomingWebhooks(context.Context, *connect.Request[chat_v1.ListIncomingWebhooksRequest]) (*connect.Response[chat_v1.ListIncomingWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ListIncomingWebhooks is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) DeleteIncomingWebhook(context.Context, *connect.Request[chat_v1.DeleteIncomingWebhookRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.DeleteIncomingWebhook is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) CreateBot(context.Context, *connect.Request[chat_v1.CreateBotRequest]) (*connect.Response[chat_v1.CreateBotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.CreateBot is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ListBots(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[chat_v1.ListBotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ListBots is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) DeleteBot(context.Context, *connect.Request[chat_v1.DeleteBotRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.DeleteBot is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) CreatePoll(context.Context, *connect.Request[chat_v1.CreatePollRequest]) (*connect.Response[chat_v1.CreatePollResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.CreatePoll is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) Vote(context.Context, *connect.Request[chat_v1.VoteRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.Vote is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ClosePoll(context.Context, *connect.Request[chat_v1.ClosePollRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ClosePoll is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) GetPoll(context.Context, *connect.Request[chat_v1.GetPollRequest]) (*connect.Response[chat_v1.Poll], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.GetPoll is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) Subscribe(context.Context, *connect.Request[chat_v1.SubscribeRequest], *connect.ServerStream[chat_v1.ChatUpdate]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.Subscribe is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) SendTypingEvent(context.Context, *connect.Request[chat_v1.SendTypingEventRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.SendTypingEvent is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) Heartbeat(context.Context, *connect.Request[chat_v1.HeartbeatRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.Heartbeat is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) GetPresence(context.Context, *connect.Request[chat_v1.GetPresenceRequest]) (*connect.Response[chat_v1.GetPresenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.GetPresence is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) GetUser(context.Context, *connect.Request[chat_v1.GetUserRequest]) (*connect.Response[chat_v1.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.GetUser is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) UpdateProfile(context.Context, *connect.Request[chat_v1.UpdateProfileRequest]) (*connect.Response[chat_v1.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.UpdateProfile is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) SearchUsers(context.Context, *connect.Request[chat_v1.SearchUsersRequest]) (*connect.Response[chat_v1.SearchUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.SearchUsers is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) BlockUser(context.Context, *connect.Request[chat_v1.BlockUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.BlockUser is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) UnblockUser(context.Context, *connect.Request[chat_v1.UnblockUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.UnblockUser is not implemented"))
+}
+
+func (UnimplementedChatV1Handler) ListBlocked(context.Context, *connect.Request[chat_v1.ListBlockedRequest]) (*connect.Response[chat_v1.ListBlockedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat_v1.ChatV1.ListBlocked is not implemented"))
+}
@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.27.1
+// source: user.proto
+
+package user_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// UserV1Client is the client API for UserV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserV1Client interface {
+	// GetByEmails returns the registered users among the given emails, the unknown ones are left out.
+	GetByEmails(ctx context.Context, in *GetByEmailsRequest, opts ...grpc.CallOption) (*GetByEmailsResponse, error)
+}
+
+type userV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserV1Client(cc grpc.ClientConnInterface) UserV1Client {
+	return &userV1Client{cc}
+}
+
+func (c *userV1Client) GetByEmails(ctx context.Context, in *GetByEmailsRequest, opts ...grpc.CallOption) (*GetByEmailsResponse, error) {
+	out := new(GetByEmailsResponse)
+	err := c.cc.Invoke(ctx, "/user_v1.UserV1/GetByEmails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserV1Server is the server API for UserV1 service.
+// All implementations must embed UnimplementedUserV1Server
+// for forward compatibility
+type UserV1Server interface {
+	// GetByEmails returns the registered users among the given emails, the unknown ones are left out.
+	GetByEmails(context.Context, *GetByEmailsRequest) (*GetByEmailsResponse, error)
+	mustEmbedUnimplementedUserV1Server()
+}
+
+// UnimplementedUserV1Server must be embedded to have forward compatible implementations.
+type UnimplementedUserV1Server struct {
+}
+
+func (UnimplementedUserV1Server) GetByEmails(context.Context, *GetByEmailsRequest) (*GetByEmailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByEmails not implemented")
+}
+func (UnimplementedUserV1Server) mustEmbedUnimplementedUserV1Server() {}
+
+// UnsafeUserV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserV1Server will
+// result in compilation errors.
+type UnsafeUserV1Server interface {
+	mustEmbedUnimplementedUserV1Server()
+}
+
+func RegisterUserV1Server(s grpc.ServiceRegistrar, srv UserV1Server) {
+	s.RegisterService(&UserV1_ServiceDesc, srv)
+}
+
+func _UserV1_GetByEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByEmailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserV1Server).GetByEmails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_v1.UserV1/GetByEmails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserV1Server).GetByEmails(ctx, req.(*GetByEmailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserV1_ServiceDesc is the grpc.ServiceDesc for UserV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user_v1.UserV1",
+	HandlerType: (*UserV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetByEmails",
+			Handler:    _UserV1_GetByEmails_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user.proto",
+}
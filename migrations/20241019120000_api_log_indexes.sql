@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE chats.api_chat_log ALTER COLUMN timestamp SET NOT NULL;
+
+-- Newest first pagination on (timestamp, id), with or without a filter on the action.
+CREATE INDEX api_chat_log_timestamp_id_idx ON chats.api_chat_log (timestamp DESC, id DESC);
+CREATE INDEX api_chat_log_action_type_timestamp_id_idx ON chats.api_chat_log (action_type, timestamp DESC, id DESC);
+
+-- Containment predicates (@>) on the request and response data, e.g. chat id or sender.
+CREATE INDEX api_chat_log_request_data_idx ON chats.api_chat_log USING GIN (request_data jsonb_path_ops);
+CREATE INDEX api_chat_log_response_data_idx ON chats.api_chat_log USING GIN (response_data jsonb_path_ops);
+
+-- +goose Down
+DROP INDEX chats.api_chat_log_response_data_idx;
+DROP INDEX chats.api_chat_log_request_data_idx;
+DROP INDEX chats.api_chat_log_action_type_timestamp_id_idx;
+DROP INDEX chats.api_chat_log_timestamp_id_idx;
+
+ALTER TABLE chats.api_chat_log ALTER COLUMN timestamp DROP NOT NULL;
@@ -0,0 +1,74 @@
+-- +goose Up
+DROP INDEX chats.api_chat_log_response_data_idx;
+DROP INDEX chats.api_chat_log_request_data_idx;
+DROP INDEX chats.api_chat_log_action_type_timestamp_id_idx;
+DROP INDEX chats.api_chat_log_timestamp_id_idx;
+
+ALTER TABLE chats.api_chat_log RENAME TO api_chat_log_legacy;
+ALTER TABLE chats.api_chat_log_legacy RENAME CONSTRAINT api_chat_log_pkey TO api_chat_log_legacy_pkey;
+ALTER TABLE chats.api_chat_log_legacy ALTER COLUMN id DROP IDENTITY;
+
+CREATE SEQUENCE chats.api_chat_log_id_seq AS integer;
+SELECT setval('chats.api_chat_log_id_seq', COALESCE(MAX(id), 0) + 1, false) FROM chats.api_chat_log_legacy;
+
+CREATE TABLE chats.api_chat_log (
+    id integer NOT NULL DEFAULT nextval('chats.api_chat_log_id_seq'),
+    action_type VARCHAR(50) NOT NULL,
+    request_data JSONB NOT NULL,
+    response_data JSONB,
+    timestamp TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (id, timestamp)
+) PARTITION BY RANGE (timestamp);
+
+ALTER SEQUENCE chats.api_chat_log_id_seq OWNED BY chats.api_chat_log.id;
+
+-- The existing entries become a single partition ending tomorrow. Daily partitions
+-- of the following days are created, and expired ones dropped, by the application.
+-- +goose StatementBegin
+DO $$
+BEGIN
+    EXECUTE format(
+        'ALTER TABLE chats.api_chat_log ATTACH PARTITION chats.api_chat_log_legacy FOR VALUES FROM (MINVALUE) TO (%L)',
+        (current_date + 1)::timestamp
+    );
+END $$;
+-- +goose StatementEnd
+
+CREATE INDEX api_chat_log_timestamp_id_idx ON chats.api_chat_log (timestamp DESC, id DESC);
+CREATE INDEX api_chat_log_action_type_timestamp_id_idx ON chats.api_chat_log (action_type, timestamp DESC, id DESC);
+CREATE INDEX api_chat_log_request_data_idx ON chats.api_chat_log USING GIN (request_data jsonb_path_ops);
+CREATE INDEX api_chat_log_response_data_idx ON chats.api_chat_log USING GIN (response_data jsonb_path_ops);
+
+-- +goose Down
+CREATE TABLE chats.api_chat_log_unpartitioned (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    action_type VARCHAR(50) NOT NULL,
+    request_data JSONB NOT NULL,
+    response_data JSONB,
+    timestamp TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (id)
+);
+
+INSERT INTO chats.api_chat_log_unpartitioned
+    (id, action_type, request_data, response_data, timestamp)
+OVERRIDING SYSTEM VALUE
+SELECT id, action_type, request_data, response_data, timestamp
+FROM chats.api_chat_log;
+
+SELECT setval(
+    pg_get_serial_sequence('chats.api_chat_log_unpartitioned', 'id'),
+    COALESCE(MAX(id), 0) + 1,
+    false
+) FROM chats.api_chat_log_unpartitioned;
+
+DROP TABLE chats.api_chat_log;
+
+ALTER TABLE chats.api_chat_log_unpartitioned RENAME TO api_chat_log;
+ALTER TABLE chats.api_chat_log RENAME CONSTRAINT api_chat_log_unpartitioned_pkey TO api_chat_log_pkey;
+
+CREATE INDEX api_chat_log_timestamp_id_idx ON chats.api_chat_log (timestamp DESC, id DESC);
+CREATE INDEX api_chat_log_action_type_timestamp_id_idx ON chats.api_chat_log (action_type, timestamp DESC, id DESC);
+CREATE INDEX api_chat_log_request_data_idx ON chats.api_chat_log USING GIN (request_data jsonb_path_ops);
+CREATE INDEX api_chat_log_response_data_idx ON chats.api_chat_log USING GIN (response_data jsonb_path_ops);
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE chats.api_chat_log
+    ADD COLUMN caller varchar(255),
+    ADD COLUMN peer varchar(255),
+    ADD COLUMN request_id varchar(64),
+    ADD COLUMN status_code varchar(32) NOT NULL DEFAULT 'OK',
+    ADD COLUMN duration_us bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE chats.api_chat_log
+    DROP COLUMN duration_us,
+    DROP COLUMN status_code,
+    DROP COLUMN request_id,
+    DROP COLUMN peer,
+    DROP COLUMN caller;
@@ -0,0 +1,29 @@
+-- +goose Up
+ALTER TABLE chats.messages
+    ADD COLUMN chat_id integer REFERENCES chats.chat (id) ON DELETE CASCADE;
+
+CREATE INDEX messages_chat_id_idx ON chats.messages (chat_id);
+
+CREATE TABLE chats.outbox (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    event_type varchar(50) NOT NULL,
+    chat_id integer NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+    published_at timestamp,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text,
+
+    PRIMARY KEY (id)
+);
+
+-- The relay scans the pending events in order, the cleanup the published ones by age.
+CREATE INDEX outbox_pending_idx ON chats.outbox (id) WHERE published_at IS NULL;
+CREATE INDEX outbox_published_at_idx ON chats.outbox (published_at) WHERE published_at IS NOT NULL;
+
+-- +goose Down
+DROP TABLE chats.outbox;
+
+DROP INDEX chats.messages_chat_id_idx;
+
+ALTER TABLE chats.messages DROP COLUMN chat_id;
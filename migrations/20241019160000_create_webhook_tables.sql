@@ -0,0 +1,58 @@
+-- +goose Up
+CREATE TABLE chats.webhooks (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    url text NOT NULL,
+    secret varchar(64) NOT NULL,
+    chat_id integer REFERENCES chats.chat (id) ON DELETE CASCADE,
+    event_types varchar(50)[] NOT NULL DEFAULT '{}',
+    consecutive_failures integer NOT NULL DEFAULT 0,
+    disabled_at timestamp,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX webhooks_chat_id_idx ON chats.webhooks (chat_id);
+
+CREATE TABLE chats.webhook_deliveries (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    webhook_id integer NOT NULL REFERENCES chats.webhooks (id) ON DELETE CASCADE,
+    event_id bigint NOT NULL,
+    event_type varchar(50) NOT NULL,
+    chat_id integer NOT NULL,
+    payload jsonb NOT NULL,
+    event_created_at timestamp NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp NOT NULL DEFAULT now(),
+    created_at timestamp NOT NULL DEFAULT now(),
+    finished_at timestamp,
+
+    PRIMARY KEY (id),
+    UNIQUE (webhook_id, event_id)
+);
+
+-- The dispatcher scans the pending deliveries by due time, the cleanup the finished ones by age.
+CREATE INDEX webhook_deliveries_due_idx ON chats.webhook_deliveries (next_attempt_at, id) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_finished_at_idx ON chats.webhook_deliveries (finished_at)
+    WHERE finished_at IS NOT NULL;
+
+CREATE TABLE chats.webhook_delivery_attempts (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    delivery_id bigint NOT NULL REFERENCES chats.webhook_deliveries (id) ON DELETE CASCADE,
+    status_code integer,
+    error text,
+    duration_us bigint NOT NULL,
+    attempted_at timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX webhook_delivery_attempts_delivery_id_idx ON chats.webhook_delivery_attempts (delivery_id);
+
+-- +goose Down
+DROP TABLE chats.webhook_delivery_attempts;
+
+DROP TABLE chats.webhook_deliveries;
+
+DROP TABLE chats.webhooks;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE chats.incoming_webhooks (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    chat_id integer NOT NULL REFERENCES chats.chat (id) ON DELETE CASCADE,
+    name varchar(100) NOT NULL,
+    token_hash char(64) NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id),
+    UNIQUE (token_hash)
+);
+
+CREATE INDEX incoming_webhooks_chat_id_idx ON chats.incoming_webhooks (chat_id);
+
+-- +goose Down
+DROP TABLE chats.incoming_webhooks;
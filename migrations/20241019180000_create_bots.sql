@@ -0,0 +1,26 @@
+-- +goose Up
+-- Bots are users identified by their name, stored in the email column.
+ALTER TABLE chats.users
+    ADD COLUMN user_type varchar(16) NOT NULL DEFAULT 'user',
+    ADD COLUMN api_token_hash char(64) UNIQUE,
+    ADD COLUMN webhook_url text;
+
+CREATE TABLE chats.bot_commands (
+    command varchar(32) NOT NULL,
+    bot_id integer NOT NULL REFERENCES chats.users (id) ON DELETE CASCADE,
+    description text NOT NULL DEFAULT '',
+
+    PRIMARY KEY (command)
+);
+
+CREATE INDEX bot_commands_bot_id_idx ON chats.bot_commands (bot_id);
+
+-- +goose Down
+DROP TABLE chats.bot_commands;
+
+DELETE FROM chats.users WHERE user_type = 'bot';
+
+ALTER TABLE chats.users
+    DROP COLUMN webhook_url,
+    DROP COLUMN api_token_hash,
+    DROP COLUMN user_type;
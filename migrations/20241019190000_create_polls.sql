@@ -0,0 +1,59 @@
+-- +goose Up
+ALTER TABLE chats.messages
+    ADD COLUMN message_type varchar(16) NOT NULL DEFAULT 'text';
+
+-- A poll is posted as a message of the poll type.
+CREATE TABLE chats.polls (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    chat_id integer NOT NULL REFERENCES chats.chat (id) ON DELETE CASCADE,
+    message_id integer NOT NULL REFERENCES chats.messages (id) ON DELETE CASCADE,
+    created_by text NOT NULL,
+    question text NOT NULL,
+    multi_choice boolean NOT NULL DEFAULT false,
+    closes_at timestamp,
+    closed_at timestamp,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id),
+    UNIQUE (message_id)
+);
+
+CREATE INDEX polls_chat_id_idx ON chats.polls (chat_id);
+
+CREATE TABLE chats.poll_options (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    poll_id integer NOT NULL REFERENCES chats.polls (id) ON DELETE CASCADE,
+    position smallint NOT NULL,
+    option_text text NOT NULL,
+
+    PRIMARY KEY (id),
+    UNIQUE (poll_id, position)
+);
+
+-- The primary key allows a single ballot per participant and poll.
+CREATE TABLE chats.poll_ballots (
+    poll_id integer NOT NULL REFERENCES chats.polls (id) ON DELETE CASCADE,
+    voter text NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (poll_id, voter)
+);
+
+CREATE TABLE chats.poll_ballot_options (
+    poll_id integer NOT NULL,
+    voter text NOT NULL,
+    option_id integer NOT NULL REFERENCES chats.poll_options (id) ON DELETE CASCADE,
+
+    PRIMARY KEY (poll_id, voter, option_id),
+    FOREIGN KEY (poll_id, voter) REFERENCES chats.poll_ballots (poll_id, voter) ON DELETE CASCADE
+);
+
+CREATE INDEX poll_ballot_options_option_id_idx ON chats.poll_ballot_options (option_id);
+
+-- +goose Down
+DROP TABLE chats.poll_ballot_options;
+DROP TABLE chats.poll_ballots;
+DROP TABLE chats.poll_options;
+DROP TABLE chats.polls;
+
+ALTER TABLE chats.messages DROP COLUMN message_type;
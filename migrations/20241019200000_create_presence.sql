@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE chats.users
+    ADD COLUMN last_seen_at timestamp,
+    ADD COLUMN presence_status varchar(16) NOT NULL DEFAULT 'offline';
+
+-- A session is the presence of a user on an instance, the user is online while any session is fresh.
+CREATE TABLE chats.presence_sessions (
+    user_id integer NOT NULL REFERENCES chats.users (id) ON DELETE CASCADE,
+    instance_id text NOT NULL,
+    away boolean NOT NULL DEFAULT false,
+    seen_at timestamp NOT NULL,
+
+    PRIMARY KEY (user_id, instance_id)
+);
+
+CREATE INDEX presence_sessions_seen_at_idx ON chats.presence_sessions (seen_at);
+
+-- +goose Down
+DROP TABLE chats.presence_sessions;
+
+ALTER TABLE chats.users
+    DROP COLUMN presence_status,
+    DROP COLUMN last_seen_at;
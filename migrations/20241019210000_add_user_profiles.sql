@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE chats.users
+    ADD COLUMN display_name varchar(64) NOT NULL DEFAULT '',
+    ADD COLUMN avatar_url varchar(2048) NOT NULL DEFAULT '',
+    ADD COLUMN status_text varchar(140) NOT NULL DEFAULT '';
+
+-- The directory is searched by case-insensitive prefix of the email or of the display name.
+CREATE INDEX users_email_prefix_idx ON chats.users (lower(email) text_pattern_ops);
+CREATE INDEX users_display_name_prefix_idx ON chats.users (lower(display_name) text_pattern_ops);
+
+-- +goose Down
+DROP INDEX chats.users_display_name_prefix_idx;
+DROP INDEX chats.users_email_prefix_idx;
+
+ALTER TABLE chats.users
+    DROP COLUMN status_text,
+    DROP COLUMN avatar_url,
+    DROP COLUMN display_name;
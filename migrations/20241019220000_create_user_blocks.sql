@@ -0,0 +1,16 @@
+-- +goose Up
+-- A block hides the messages and the live updates of the blocked user from the blocker,
+-- and prevents any direct chat between them.
+CREATE TABLE chats.user_blocks (
+    blocker_id integer NOT NULL REFERENCES chats.users (id) ON DELETE CASCADE,
+    blocked_id integer NOT NULL REFERENCES chats.users (id) ON DELETE CASCADE,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX user_blocks_blocked_id_idx ON chats.user_blocks (blocked_id);
+
+-- +goose Down
+DROP TABLE chats.user_blocks;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- The settings of a chat are kept per participant, the notifications are sent according to them.
+ALTER TABLE chats.chat_participants
+    ADD COLUMN muted_until timestamp,
+    ADD COLUMN notification_level varchar(16) NOT NULL DEFAULT 'all'
+        CHECK (notification_level IN ('all', 'mentions', 'none')),
+    ADD COLUMN pinned boolean NOT NULL DEFAULT false,
+    ADD COLUMN archived boolean NOT NULL DEFAULT false;
+
+-- The chats of a user are listed through their participations.
+CREATE INDEX chat_participants_user_id_idx ON chats.chat_participants (user_id);
+
+-- +goose Down
+DROP INDEX chats.chat_participants_user_id_idx;
+
+ALTER TABLE chats.chat_participants
+    DROP COLUMN archived,
+    DROP COLUMN pinned,
+    DROP COLUMN notification_level,
+    DROP COLUMN muted_until;
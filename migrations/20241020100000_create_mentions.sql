@@ -0,0 +1,19 @@
+-- +goose Up
+-- A mention links a message to a participant of its chat named in its text.
+CREATE TABLE chats.mentions (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    message_id integer NOT NULL REFERENCES chats.messages (id) ON DELETE CASCADE,
+    chat_id integer NOT NULL REFERENCES chats.chat (id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES chats.users (id) ON DELETE CASCADE,
+    mentioned_by text NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id),
+    UNIQUE (message_id, user_id)
+);
+
+-- The mentions inbox of a user is listed newest first.
+CREATE INDEX mentions_user_id_id_idx ON chats.mentions (user_id, id DESC);
+
+-- +goose Down
+DROP TABLE chats.mentions;
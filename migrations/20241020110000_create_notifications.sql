@@ -0,0 +1,36 @@
+-- +goose Up
+-- A notification tells an offline participant about a message, they are sent batched in digests.
+CREATE TABLE chats.notifications (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    user_id integer NOT NULL REFERENCES chats.users (id) ON DELETE CASCADE,
+    event_id bigint NOT NULL,
+    event_type varchar(50) NOT NULL,
+    chat_id integer NOT NULL,
+    payload jsonb NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    created_at timestamp NOT NULL DEFAULT now(),
+    finished_at timestamp,
+
+    PRIMARY KEY (id),
+    UNIQUE (event_id, user_id)
+);
+
+CREATE INDEX notifications_pending_idx ON chats.notifications (user_id, id) WHERE status = 'pending';
+CREATE INDEX notifications_finished_at_idx ON chats.notifications (finished_at)
+    WHERE finished_at IS NOT NULL;
+
+-- A recipient holds the time of the last digest sent or attempted to a user, which the digests are rate limited by.
+-- An instance claims a recipient until leased_until, added with the lease, while its digest is sent, so that several
+-- instances do not send the same digest at once. A digest is sent again only if its instance dies before recording it.
+CREATE TABLE chats.notification_recipients (
+    user_id integer NOT NULL REFERENCES chats.users (id) ON DELETE CASCADE,
+    last_digest_at timestamp,
+
+    PRIMARY KEY (user_id)
+);
+
+-- +goose Down
+DROP TABLE chats.notification_recipients;
+
+DROP TABLE chats.notifications;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Only the administrators pin the messages of a chat, unless its pin policy lets its participants do so.
+ALTER TABLE chats.chat
+    ADD COLUMN pin_policy varchar(16) NOT NULL DEFAULT 'admins'
+        CHECK (pin_policy IN ('admins', 'participants'));
+
+CREATE TABLE chats.pinned_messages (
+    chat_id integer NOT NULL REFERENCES chats.chat (id) ON DELETE CASCADE,
+    message_id integer NOT NULL REFERENCES chats.messages (id) ON DELETE CASCADE,
+    pinned_by text NOT NULL,
+    pinned_at timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (chat_id, message_id)
+);
+
+-- +goose Down
+DROP TABLE chats.pinned_messages;
+
+ALTER TABLE chats.chat
+    DROP COLUMN pin_policy;
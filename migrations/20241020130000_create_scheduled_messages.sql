@@ -0,0 +1,27 @@
+-- +goose Up
+-- A scheduled message is sent to its chat once due, in the transaction marking it sent.
+CREATE TABLE chats.scheduled_messages (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    chat_id integer NOT NULL REFERENCES chats.chat (id) ON DELETE CASCADE,
+    sender text NOT NULL,
+    message_text text NOT NULL,
+    send_at timestamp NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp NOT NULL,
+    last_error text,
+    created_at timestamp NOT NULL DEFAULT now(),
+    finished_at timestamp,
+
+    PRIMARY KEY (id)
+);
+
+-- The scheduler scans the pending messages by due time, the senders list theirs by send time
+-- and the cleanup removes the finished ones by age.
+CREATE INDEX scheduled_messages_due_idx ON chats.scheduled_messages (next_attempt_at, id) WHERE status = 'pending';
+CREATE INDEX scheduled_messages_sender_idx ON chats.scheduled_messages (sender, send_at) WHERE status = 'pending';
+CREATE INDEX scheduled_messages_finished_at_idx ON chats.scheduled_messages (finished_at)
+    WHERE finished_at IS NOT NULL;
+
+-- +goose Down
+DROP TABLE chats.scheduled_messages;
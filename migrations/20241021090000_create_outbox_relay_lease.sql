@@ -0,0 +1,16 @@
+-- +goose Up
+-- The lease of the outbox relay: a single instance publishes the events at a time, without holding
+-- a transaction open while it publishes them.
+CREATE TABLE chats.outbox_relay (
+    id boolean DEFAULT true,
+    holder text NOT NULL DEFAULT '',
+    locked_until timestamp NOT NULL DEFAULT '-infinity',
+
+    PRIMARY KEY (id),
+    CHECK (id)
+);
+
+INSERT INTO chats.outbox_relay DEFAULT VALUES;
+
+-- +goose Down
+DROP TABLE chats.outbox_relay;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Key of the signature of the commands POSTed to the webhook of a bot. The bots created before have none,
+-- their commands are not sent until they are created again.
+ALTER TABLE chats.users
+    ADD COLUMN signing_secret varchar(64);
+
+-- +goose Down
+ALTER TABLE chats.users
+    DROP COLUMN signing_secret;
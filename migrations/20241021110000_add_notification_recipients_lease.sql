@@ -0,0 +1,8 @@
+-- +goose Up
+-- A recipient is claimed by an instance until leased_until while its digest is sent.
+ALTER TABLE chats.notification_recipients
+    ADD COLUMN leased_until timestamp NOT NULL DEFAULT '-infinity';
+
+-- +goose Down
+ALTER TABLE chats.notification_recipients
+    DROP COLUMN leased_until;